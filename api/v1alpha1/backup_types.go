@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// BackupRestoreAnnotation requests the restore of the cluster from the backup, its value is the time of the request.
+	// A new value requests another restore.
+	BackupRestoreAnnotation = "edge-orchestrator.intel.com/restore-requested-at"
+
+	// BackupPolicyLabel is set on the backups created by a BackupPolicy to the name of the policy.
+	BackupPolicyLabel = "edge-orchestrator.intel.com/backup-policy"
+)
+
+// BackupPhase is the progress of a Backup.
+type BackupPhase string
+
+const (
+	// BackupPending backups wait for the etcd snapshot to be started.
+	BackupPending BackupPhase = "Pending"
+
+	// BackupRunning backups wait for the etcd snapshot to complete.
+	BackupRunning BackupPhase = "Running"
+
+	// BackupCompleted backups hold a snapshot the cluster can be restored from.
+	BackupCompleted BackupPhase = "Completed"
+
+	// BackupFailed backups could not take a snapshot, see the status message.
+	BackupFailed BackupPhase = "Failed"
+)
+
+// RestorePhase is the progress of the restore of a cluster from a Backup.
+type RestorePhase string
+
+const (
+	// RestoreRunning restores wait for the etcd of the cluster to be reset to the snapshot.
+	RestoreRunning RestorePhase = "Running"
+
+	// RestoreCompleted restores reset the etcd of the cluster to the snapshot.
+	RestoreCompleted RestorePhase = "Completed"
+
+	// RestoreFailed restores could not reset the etcd of the cluster, see the restore message.
+	RestoreFailed RestorePhase = "Failed"
+)
+
+// BackupSpec defines the desired state of Backup.
+type BackupSpec struct {
+	// ClusterName is the name of the cluster in the namespace of the backup whose etcd is snapshotted.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterName is immutable"
+	ClusterName string `json:"clusterName" yaml:"clusterName"`
+}
+
+// BackupStatus defines the observed state of Backup.
+type BackupStatus struct {
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty" yaml:"phase,omitempty"`
+
+	// SnapshotName is the name of the etcd snapshot on the control plane nodes.
+	// +optional
+	SnapshotName string `json:"snapshotName,omitempty" yaml:"snapshotName,omitempty"`
+
+	// Location is where the control plane provider stored the snapshot, e.g. a file URL on the control plane node.
+	// +optional
+	Location string `json:"location,omitempty" yaml:"location,omitempty"`
+
+	// NodeName is the control plane node the snapshot was taken on.
+	// +optional
+	NodeName string `json:"nodeName,omitempty" yaml:"nodeName,omitempty"`
+
+	// Size is the size of the snapshot.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty" yaml:"size,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" yaml:"completionTime,omitempty"`
+
+	// Message explains why the backup failed.
+	// +optional
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	// Restore is the progress of the last restore of the cluster from the backup.
+	// +optional
+	Restore *RestoreStatus `json:"restore,omitempty" yaml:"restore,omitempty"`
+}
+
+// RestoreStatus is the progress of the restore of a cluster from a Backup.
+type RestoreStatus struct {
+	Phase RestorePhase `json:"phase" yaml:"phase"`
+
+	// RequestedAt is the value of the BackupRestoreAnnotation the restore was started for.
+	RequestedAt string `json:"requestedAt" yaml:"requestedAt"`
+
+	// JobName is the job running the restore in the workload cluster.
+	// +optional
+	JobName string `json:"jobName,omitempty" yaml:"jobName,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" yaml:"completionTime,omitempty"`
+
+	// Message explains why the restore failed.
+	// +optional
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Snapshot",type=string,JSONPath=".status.snapshotName"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+
+// Backup is an etcd snapshot of a cluster taken through its control plane provider.
+type Backup struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+
+	Spec   BackupSpec   `json:"spec,omitempty" yaml:"spec,omitempty"`
+	Status BackupStatus `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupList contains a list of Backup.
+type BackupList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Items           []Backup `json:"items" yaml:"items"`
+}
+
+// BackupPolicySpec defines the desired state of BackupPolicy.
+type BackupPolicySpec struct {
+	// ClusterName is the name of the cluster in the namespace of the policy that is backed up.
+	// +kubebuilder:validation:MinLength=1
+	ClusterName string `json:"clusterName" yaml:"clusterName"`
+
+	// Interval is the time between two backups of the cluster.
+	Interval metav1.Duration `json:"interval" yaml:"interval"`
+
+	// Retention is the number of completed backups of the policy that are kept, older backups are deleted.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=7
+	Retention int32 `json:"retention,omitempty" yaml:"retention,omitempty"`
+}
+
+// BackupPolicyStatus defines the observed state of BackupPolicy.
+type BackupPolicyStatus struct {
+	// LastBackupName is the name of the last backup created by the policy.
+	// +optional
+	LastBackupName string `json:"lastBackupName,omitempty" yaml:"lastBackupName,omitempty"`
+
+	// LastBackupTime is when the last backup was created by the policy.
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty" yaml:"lastBackupTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="Interval",type=string,JSONPath=".spec.interval"
+// +kubebuilder:printcolumn:name="Last Backup",type=date,JSONPath=".status.lastBackupTime"
+
+// BackupPolicy creates Backups of a cluster periodically and deletes the backups beyond its retention.
+type BackupPolicy struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+
+	Spec   BackupPolicySpec   `json:"spec,omitempty" yaml:"spec,omitempty"`
+	Status BackupPolicyStatus `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupPolicyList contains a list of BackupPolicy.
+type BackupPolicyList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Items           []BackupPolicy `json:"items" yaml:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backup{}, &BackupList{}, &BackupPolicy{}, &BackupPolicyList{})
+}
@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// Hub marks v1alpha1, the stored version, as the version the other versions of ClusterTemplate are converted to and
+// from.
+func (*ClusterTemplate) Hub() {}
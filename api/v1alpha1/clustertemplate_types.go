@@ -8,6 +8,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
@@ -15,6 +16,18 @@ import (
 const (
 	ClusterTemplateFinalizer = "clustertemplates.edge-orchestrator.intel.com/finalizer"
 
+	// ProvidersValidatedCondition documents whether the combination of the control plane and infrastructure provider
+	// types of the ClusterTemplate is supported.
+	ProvidersValidatedCondition clusterv1.ConditionType = "ProvidersValidated"
+
+	// UnsupportedProvidersReason is the reason of the ProvidersValidatedCondition if the combination of the provider
+	// types is not supported.
+	UnsupportedProvidersReason = "UnsupportedProviders"
+
+	// ConfigRenderedCondition documents the status of the rendering of the cluster configuration into the control plane
+	// and worker templates, including the resolution of its secret references.
+	ConfigRenderedCondition clusterv1.ConditionType = "ConfigRendered"
+
 	// PrerequisitesCondition documents the status of the CAPI Resources prerequisites installation.
 	PrerequisitesCondition clusterv1.ConditionType = "PrerequisitesInstalled"
 
@@ -27,8 +40,68 @@ const (
 	// ProviderClusterTemplateCondition documents the status of the <infrastructure provider>ClusterTemplate creation.
 	InfraProviderClusterTemplateCondition clusterv1.ConditionType = "InfraProviderClusterTemplateCreated"
 
+	// WorkerTemplatesCondition documents the status of the worker bootstrap and machine templates creation.
+	WorkerTemplatesCondition clusterv1.ConditionType = "WorkerTemplatesCreated"
+
 	// ClusterClassCondition documents the status of the ClusterClassCondition creation.
 	ClusterClassCondition clusterv1.ConditionType = "ClusterClassCreated"
+
+	// DriftRepairedCondition documents the last repair of the ClusterClass or the control plane and worker templates
+	// after they were edited or deleted out-of-band. It is informational and not part of the Ready summary.
+	DriftRepairedCondition clusterv1.ConditionType = "DriftRepaired"
+
+	// DriftRepairedReason is the reason of the DriftRepairedCondition.
+	DriftRepairedReason = "ChangedOutOfBand"
+)
+
+// TemplateLifecycleState is the promotion stage of a ClusterTemplate.
+type TemplateLifecycleState string
+
+const (
+	// TemplateDraft templates are being authored and cannot be used to create clusters.
+	TemplateDraft TemplateLifecycleState = "draft"
+
+	// TemplatePublished templates are available for cluster creation.
+	TemplatePublished TemplateLifecycleState = "published"
+
+	// TemplateDeprecated templates are kept for existing clusters but cannot be used to create new ones.
+	TemplateDeprecated TemplateLifecycleState = "deprecated"
+)
+
+// CanTransitionTo reports whether a template in state s may be moved to state next.
+// Templates only move forward: draft -> published -> deprecated.
+func (s TemplateLifecycleState) CanTransitionTo(next TemplateLifecycleState) bool {
+	switch s {
+	case TemplateDraft:
+		return next == TemplatePublished
+	case TemplatePublished, "":
+		return next == TemplateDeprecated
+	}
+	return false
+}
+
+// LabelPropagationPolicy is how changes of the cluster labels of a ClusterTemplate reach the clusters created from it.
+type LabelPropagationPolicy string
+
+const (
+	// LabelPropagationNone only applies the cluster labels to clusters created after the change.
+	LabelPropagationNone LabelPropagationPolicy = "none"
+
+	// LabelPropagationPropagate also applies added and changed cluster labels to the existing clusters of the
+	// template, except for the labels the users of a cluster overrode or removed.
+	LabelPropagationPropagate LabelPropagationPolicy = "propagate"
+)
+
+// RejoinPolicy is what a reimaged host of a single-node cluster does when it bootstraps its node again.
+type RejoinPolicy string
+
+const (
+	// RejoinPolicyRestore restores the state of the cluster from the latest local etcd snapshot of the node, so that
+	// the host rejoins its cluster with its workloads.
+	RejoinPolicyRestore RejoinPolicy = "restore"
+
+	// RejoinPolicyNone bootstraps the cluster anew, without the state it had before the host was reimaged.
+	RejoinPolicyNone RejoinPolicy = "none"
 )
 
 // ClusterTemplateSpec defines the desired state of ClusterTemplate.
@@ -39,12 +112,15 @@ type ClusterTemplateSpec struct {
 	ControlPlaneProviderType string `json:"controlPlaneProviderType,omitempty" yaml:"controlPlaneProviderType"`
 
 	// +optional
-	// +kubebuilder:validation:Enum=intel;docker
+	// +kubebuilder:validation:Enum=intel;docker;vsphere
 	InfraProviderType string `json:"infraProviderType,omitempty" yaml:"infraProviderType,omitempty"`
 
 	// +required
 	KubernetesVersion string `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion"`
 
+	// ClusterConfiguration is the control plane template of the clusters created from the template. Credentials, e.g.
+	// of registries, are referenced as ${secret:<name>/<key>} from a Secret in the namespace of the template instead of
+	// stored in cleartext; the template controller resolves the references when it renders the templates.
 	// +optional
 	ClusterConfiguration string `json:"clusterConfiguration,omitempty" yaml:"clusterConfiguration,omitempty"`
 
@@ -53,6 +129,368 @@ type ClusterTemplateSpec struct {
 
 	// +optional
 	ClusterLabels map[string]string `json:"clusterLabels,omitempty" yaml:"clusterLabels,omitempty"`
+
+	// LabelPropagationPolicy controls whether changes of ClusterLabels are propagated to the existing clusters of
+	// the template.
+	// +optional
+	// +kubebuilder:validation:Enum=none;propagate
+	// +kubebuilder:default=none
+	LabelPropagationPolicy LabelPropagationPolicy `json:"labelPropagationPolicy,omitempty" yaml:"labelPropagationPolicy,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=draft;published;deprecated
+	// +kubebuilder:default=published
+	LifecycleState TemplateLifecycleState `json:"lifecycleState,omitempty" yaml:"lifecycleState,omitempty"`
+
+	// SunsetDate is the date after which clusters still using the template once it is deprecated are no longer
+	// supported and should have moved to a newer template.
+	// +optional
+	SunsetDate *metav1.Time `json:"sunsetDate,omitempty" yaml:"sunsetDate,omitempty"`
+
+	// AirGapped marks the clusters created from the template as installed without internet access from the settings
+	// of AirGap, which it requires. kubeadm clusters are only rendered air-gapped with the flag; k3s clusters are
+	// rendered air-gapped whenever AirGap is set.
+	// +optional
+	AirGapped bool `json:"airGapped,omitempty" yaml:"airGapped,omitempty"`
+
+	// AirGap configures clusters to install k3s from site-local artifacts, or kubeadm to pull its images from
+	// site-local registries, instead of the internet.
+	// +optional
+	AirGap *AirGapConfig `json:"airGap,omitempty" yaml:"airGap,omitempty"`
+
+	// SSHAccess configures break-glass SSH access to the nodes of the clusters created from the template.
+	// +optional
+	SSHAccess *SSHAccessConfig `json:"sshAccess,omitempty" yaml:"sshAccess,omitempty"`
+
+	// ReservedResources are the CPU and memory the kubelet of every node keeps from the pods of the clusters created
+	// from the template; clusters may override the amounts if the template reserves resources.
+	// +optional
+	ReservedResources *ReservedResources `json:"reservedResources,omitempty" yaml:"reservedResources,omitempty"`
+
+	// MinNodeResources are the CPU and memory every host of the clusters created from the template needs at least;
+	// the hosts of the inventory are checked when the clusters are created.
+	// +optional
+	MinNodeResources *ResourceReservation `json:"minNodeResources,omitempty" yaml:"minNodeResources,omitempty"`
+
+	// RequireTrustedCompute marks the clusters created from the template as running trusted compute workloads, which
+	// requires the Intel infra provider and hosts whose measured boot passed the attestation.
+	// +optional
+	RequireTrustedCompute bool `json:"requireTrustedCompute,omitempty" yaml:"requireTrustedCompute,omitempty"`
+
+	// VSphere places the virtual machines of the clusters created from the template in vCenter; required by the
+	// vsphere infra provider.
+	// +optional
+	VSphere *VSphereConfig `json:"vsphere,omitempty" yaml:"vsphere,omitempty"`
+
+	// SingleNode tunes the clusters created from the template for a single node running the control plane and the
+	// workloads; the clusters are created with exactly one node. Only supported by the k3s control plane provider.
+	// +optional
+	SingleNode *SingleNodeConfig `json:"singleNode,omitempty" yaml:"singleNode,omitempty"`
+
+	// Remediation configures the MachineHealthChecks of the clusters created from the template, which replace the
+	// machines of the control plane and of the node pools whose nodes do not start or become unhealthy.
+	// +optional
+	Remediation *RemediationConfig `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// Variables are the typed variables the clusters created from the template may set; they are declared as
+	// variables of the ClusterClass and applied to the control plane and worker templates by their patches.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Variables []TemplateVariable `json:"variables,omitempty" yaml:"variables,omitempty"`
+}
+
+// AirGapConfig specifies where the nodes of an air-gapped cluster get the k3s artifacts or the kubeadm images from.
+// ArtifactURL, ImageTarballs, SystemDefaultRegistry and InstallScriptPath apply to k3s; ImageRepository,
+// CoreDNSImageRepository, EtcdImageRepository and RegistryMirrors apply to kubeadm; Images applies to both.
+type AirGapConfig struct {
+	// ArtifactURL is the base URL of the site artifact server hosting the k3s binary ("k3s") and install script ("install.sh").
+	// Required by k3s.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	ArtifactURL string `json:"artifactURL,omitempty" yaml:"artifactURL,omitempty"`
+
+	// ImageTarballs are the k3s airgap image tarballs preloaded on the nodes, either as absolute URLs or as paths relative to ArtifactURL.
+	// +optional
+	ImageTarballs []string `json:"imageTarballs,omitempty" yaml:"imageTarballs,omitempty"`
+
+	// SystemDefaultRegistry is the private registry k3s pulls its system images from.
+	// +optional
+	SystemDefaultRegistry string `json:"systemDefaultRegistry,omitempty" yaml:"systemDefaultRegistry,omitempty"`
+
+	// InstallScriptPath is where the install script is stored on the nodes (default: "/opt/install.sh").
+	// +optional
+	InstallScriptPath string `json:"installScriptPath,omitempty" yaml:"installScriptPath,omitempty"`
+
+	// ImageRepository is the private registry repository kubeadm pulls the control plane images from, e.g.
+	// "registry.site.local:5000/k8s". Required by kubeadm.
+	// +optional
+	ImageRepository string `json:"imageRepository,omitempty" yaml:"imageRepository,omitempty"`
+
+	// CoreDNSImageRepository is the repository kubeadm pulls the CoreDNS image from (default: ImageRepository).
+	// +optional
+	CoreDNSImageRepository string `json:"coreDNSImageRepository,omitempty" yaml:"coreDNSImageRepository,omitempty"`
+
+	// EtcdImageRepository is the repository kubeadm pulls the etcd image from (default: ImageRepository).
+	// +optional
+	EtcdImageRepository string `json:"etcdImageRepository,omitempty" yaml:"etcdImageRepository,omitempty"`
+
+	// RegistryMirrors are the site-local mirrors containerd pulls the images of public registries from, e.g. those of
+	// the workloads and of the sandbox image.
+	// +optional
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty" yaml:"registryMirrors,omitempty"`
+
+	// Images is the image report of the template: the control plane images its clusters pull from the site-local
+	// registries, e.g. "registry.site.local:5000/k8s/kube-apiserver:v1.30.6". Clusters may request them to be pulled
+	// onto their hosts before they are provisioned.
+	// +optional
+	Images []string `json:"images,omitempty" yaml:"images,omitempty"`
+}
+
+// RegistryMirror is a site-local mirror of a public registry.
+type RegistryMirror struct {
+	// Registry is the host name of the mirrored registry with an optional port, e.g. "docker.io".
+	// +kubebuilder:validation:MinLength=1
+	Registry string `json:"registry" yaml:"registry"`
+
+	// Endpoint is the URL of the mirror, e.g. "https://registry.site.local:5000".
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+}
+
+// SSHAccessConfig specifies who may log in to the nodes over SSH, either with an authorized key or with a user
+// certificate signed by a trusted CA.
+type SSHAccessConfig struct {
+	// User is the user the authorized keys are installed for; it is created with passwordless sudo (default: "breakglass").
+	// +kubebuilder:validation:Pattern=`^[a-z_][a-z0-9_-]{0,31}$`
+	// +optional
+	User string `json:"user,omitempty" yaml:"user,omitempty"`
+
+	// AuthorizedKeys are the SSH public keys, in authorized_keys format, that may log in as User.
+	// +optional
+	AuthorizedKeys []string `json:"authorizedKeys,omitempty" yaml:"authorizedKeys,omitempty"`
+
+	// TrustedUserCAKeys are the SSH CA public keys whose signed user certificates sshd accepts for the principals of the certificates.
+	// +optional
+	TrustedUserCAKeys []string `json:"trustedUserCAKeys,omitempty" yaml:"trustedUserCAKeys,omitempty"`
+}
+
+// VSphereConfig specifies the vCenter the virtual machines of a vSphere cluster are cloned in and where they are placed.
+type VSphereConfig struct {
+	// Server is the address of the vCenter server, e.g. "vcenter.site.local".
+	// +kubebuilder:validation:MinLength=1
+	Server string `json:"server" yaml:"server"`
+
+	// Thumbprint is the SHA-1 thumbprint of the certificate of the vCenter server; the certificate is verified
+	// against the trusted CAs if it is empty.
+	// +optional
+	Thumbprint string `json:"thumbprint,omitempty" yaml:"thumbprint,omitempty"`
+
+	// CredentialsSecret is the secret in the namespace of the cluster holding the username and password the
+	// vSphere provider logs in to vCenter with; the credentials of the provider are used if it is empty.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty" yaml:"credentialsSecret,omitempty"`
+
+	// Datacenter is the name or inventory path of the datacenter the virtual machines are created in.
+	// +kubebuilder:validation:MinLength=1
+	Datacenter string `json:"datacenter" yaml:"datacenter"`
+
+	// Datastore is the name or inventory path of the datastore of the virtual machines (default: the datastore of
+	// Template).
+	// +optional
+	Datastore string `json:"datastore,omitempty" yaml:"datastore,omitempty"`
+
+	// Folder is the name or inventory path of the folder of the virtual machines.
+	// +optional
+	Folder string `json:"folder,omitempty" yaml:"folder,omitempty"`
+
+	// ResourcePool is the name or inventory path of the resource pool of the virtual machines.
+	// +optional
+	ResourcePool string `json:"resourcePool,omitempty" yaml:"resourcePool,omitempty"`
+
+	// Network is the name or inventory path of the network the virtual machines are connected to with DHCP.
+	// +kubebuilder:validation:MinLength=1
+	Network string `json:"network" yaml:"network"`
+
+	// Template is the name or inventory path of the virtual machine template the virtual machines are cloned from.
+	// +kubebuilder:validation:MinLength=1
+	Template string `json:"template" yaml:"template"`
+}
+
+// RemediationConfig specifies when Cluster API considers the machines of a cluster unhealthy and replaces them.
+type RemediationConfig struct {
+	// NodeStartupTimeout is how long a machine may take to join the cluster as a node before it is remediated, e.g.
+	// "20m"; "0s" disables the check (default: "10m").
+	// +optional
+	NodeStartupTimeout *metav1.Duration `json:"nodeStartupTimeout,omitempty" yaml:"nodeStartupTimeout,omitempty"`
+
+	// UnhealthyConditions are the node conditions marking a node unhealthy once they last longer than their timeout
+	// (default: Ready Unknown or False for 5m).
+	// +optional
+	UnhealthyConditions []UnhealthyCondition `json:"unhealthyConditions,omitempty" yaml:"unhealthyConditions,omitempty"`
+
+	// MaxUnhealthy is the number or percentage of unhealthy machines of the control plane or of a node pool above
+	// which no machine is remediated, e.g. "40%" (default: "100%").
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MaxUnhealthy *intstr.IntOrString `json:"maxUnhealthy,omitempty" yaml:"maxUnhealthy,omitempty"`
+}
+
+// SingleNodeConfig specifies where the node of a single-node cluster keeps its etcd snapshots and how it rejoins its
+// cluster once its host is reimaged.
+type SingleNodeConfig struct {
+	// EtcdSnapshotDir is the directory of the local disk the etcd snapshots are written to; it must be on a partition
+	// that is kept when the host is reimaged for the node to rejoin its cluster (default:
+	// "/var/lib/rancher/k3s/server/db/snapshots").
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9._/-]*$`
+	// +optional
+	EtcdSnapshotDir string `json:"etcdSnapshotDir,omitempty" yaml:"etcdSnapshotDir,omitempty"`
+
+	// EtcdSnapshotSchedule is the cron schedule of the etcd snapshots (default: "0 */6 * * *").
+	// +optional
+	EtcdSnapshotSchedule string `json:"etcdSnapshotSchedule,omitempty" yaml:"etcdSnapshotSchedule,omitempty"`
+
+	// EtcdSnapshotRetention is the number of etcd snapshots kept (default: 5).
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	EtcdSnapshotRetention int32 `json:"etcdSnapshotRetention,omitempty" yaml:"etcdSnapshotRetention,omitempty"`
+
+	// RejoinPolicy is what a reimaged host does when it bootstraps its node again (default: "restore").
+	// +kubebuilder:validation:Enum=restore;none
+	// +optional
+	RejoinPolicy RejoinPolicy `json:"rejoinPolicy,omitempty" yaml:"rejoinPolicy,omitempty"`
+}
+
+// UnhealthyCondition is a node condition marking a node unhealthy once it lasts longer than the timeout.
+type UnhealthyCondition struct {
+	// Type is the type of the node condition, e.g. "Ready".
+	// +kubebuilder:validation:MinLength=1
+	Type corev1.NodeConditionType `json:"type" yaml:"type"`
+
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status corev1.ConditionStatus `json:"status" yaml:"status"`
+
+	// Timeout is how long the node condition may last before the node is unhealthy, e.g. "5m".
+	Timeout metav1.Duration `json:"timeout" yaml:"timeout"`
+}
+
+// TemplateVariableType is the type of the value of a template variable
+// +kubebuilder:validation:Enum=string;integer;boolean;enum
+type TemplateVariableType string
+
+const (
+	StringVariable  TemplateVariableType = "string"
+	IntegerVariable TemplateVariableType = "integer"
+	BooleanVariable TemplateVariableType = "boolean"
+	// EnumVariable is a string variable whose value is one of the values of Enum
+	EnumVariable TemplateVariableType = "enum"
+)
+
+// TemplateVariable is a variable of a template whose value is set per cluster, so that a knob of the template does not
+// require a copy of the template for every value.
+type TemplateVariable struct {
+	// Name is the name of the variable in the cluster spec and in the value templates of the patches, e.g. "maxPods";
+	// it must not be a variable of the control plane and infra providers.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9]{0,62}$`
+	Name string `json:"name" yaml:"name"`
+
+	Type TemplateVariableType `json:"type" yaml:"type"`
+
+	// +optional
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Required rejects clusters that do not set the variable; ignored if the variable has a default.
+	// +optional
+	Required bool `json:"required,omitempty" yaml:"required,omitempty"`
+
+	// Default is the value of the clusters that do not set the variable, in its string form, e.g. "110" or "true".
+	// +optional
+	Default *string `json:"default,omitempty" yaml:"default,omitempty"`
+
+	// Enum are the values of an enum variable.
+	// +optional
+	Enum []string `json:"enum,omitempty" yaml:"enum,omitempty"`
+
+	// Minimum and Maximum bound the value of an integer variable.
+	// +optional
+	Minimum *int64 `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+
+	// +optional
+	Maximum *int64 `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+
+	// Pattern is the regular expression the value of a string variable must match.
+	// +optional
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+
+	// MinLength and MaxLength bound the length of the value of a string variable.
+	// +optional
+	MinLength *int64 `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+
+	// +optional
+	MaxLength *int64 `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+
+	// Patches set the value of the variable in the control plane or worker templates of the clusters. Patches of
+	// variables that are neither required nor defaulted only apply to the clusters setting a non-empty value.
+	// +optional
+	Patches []TemplateVariablePatch `json:"patches,omitempty" yaml:"patches,omitempty"`
+}
+
+// TemplateVariablePatch is a JSON patch of the control plane template or of the bootstrap template of the worker
+// node pools setting the value of a template variable.
+type TemplateVariablePatch struct {
+	// +kubebuilder:validation:Enum=controlPlane;workers
+	Target string `json:"target" yaml:"target"`
+
+	// +kubebuilder:validation:Enum=add;replace
+	// +kubebuilder:default=add
+	// +optional
+	Op string `json:"op,omitempty" yaml:"op,omitempty"`
+
+	// Path is the JSON pointer of the patched field, e.g. "/spec/template/spec/kthreesConfigSpec/agentConfig/kubeletArgs/-".
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path" yaml:"path"`
+
+	// ValueTemplate is the Go template rendering the patched value from the variables, e.g. a kubelet argument with the
+	// value of the variable; the value of the variable is patched as is if it is empty.
+	// +optional
+	ValueTemplate string `json:"valueTemplate,omitempty" yaml:"valueTemplate,omitempty"`
+}
+
+// ReservedResources specifies the resources kept from the pods of a node, so that the allocatable capacity of the node
+// accounts for the system daemons and the edge agents running next to the workloads.
+type ReservedResources struct {
+	// System are the resources reserved for the operating system daemons and the edge agents (kubelet system-reserved).
+	// +optional
+	System *ResourceReservation `json:"system,omitempty" yaml:"system,omitempty"`
+
+	// Kube are the resources reserved for the kubelet and the container runtime (kubelet kube-reserved).
+	// +optional
+	Kube *ResourceReservation `json:"kube,omitempty" yaml:"kube,omitempty"`
+}
+
+// ResourceReservation is an amount of CPU and memory in the Kubernetes quantity format, e.g. "500m" and "1Gi".
+type ResourceReservation struct {
+	// +optional
+	CPU string `json:"cpu,omitempty" yaml:"cpu,omitempty"`
+
+	// +optional
+	Memory string `json:"memory,omitempty" yaml:"memory,omitempty"`
+}
+
+// KubeletValue returns the reservation in the format of the kubelet system-reserved and kube-reserved settings, e.g.
+// "cpu=500m,memory=1Gi"; it is empty if nothing is reserved.
+func (r *ResourceReservation) KubeletValue() string {
+	if r == nil {
+		return ""
+	}
+	values := []string{}
+	if r.CPU != "" {
+		values = append(values, "cpu="+r.CPU)
+	}
+	if r.Memory != "" {
+		values = append(values, "memory="+r.Memory)
+	}
+	return strings.Join(values, ",")
 }
 
 // ClusterNetwork specifies the different networking
@@ -85,6 +523,26 @@ type ClusterTemplateStatus struct {
 	Ready           bool                    `json:"ready" yaml:"ready"`
 	ClusterClassRef *corev1.ObjectReference `json:"clusterClassRef,omitempty" yaml:"clusterClassRef,omitempty"`
 
+	// controlPlaneMachineTemplateRef references the infrastructure machine template of the control plane machines
+	// rendered for the ClusterClass, so that clients do not depend on its naming
+	// +optional
+	ControlPlaneMachineTemplateRef *corev1.ObjectReference `json:"controlPlaneMachineTemplateRef,omitempty" yaml:"controlPlaneMachineTemplateRef,omitempty"`
+
+	// workerMachineTemplateRef references the infrastructure machine template of the worker machines rendered for the
+	// ClusterClass, so that clients do not depend on its naming
+	// +optional
+	WorkerMachineTemplateRef *corev1.ObjectReference `json:"workerMachineTemplateRef,omitempty" yaml:"workerMachineTemplateRef,omitempty"`
+
+	// propagatedClusterLabels are the cluster labels of the template as of their last propagation to its clusters;
+	// cluster labels whose values differ from them were overridden on the cluster and are not propagated
+	// +optional
+	PropagatedClusterLabels map[string]string `json:"propagatedClusterLabels,omitempty" yaml:"propagatedClusterLabels,omitempty"`
+
+	// observedGeneration is the generation of the spec the resources of the ClusterClass were last rendered from; a
+	// spec updated in place renders them again
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" yaml:"observedGeneration,omitempty"`
+
 	// v1beta2 groups all the fields that will be added or modified in ClusterTemplate's status with the V1Beta2 version.
 	// +optional
 	V1Beta2 *ClusterTemplateV1Beta2Status `json:"v1beta2,omitempty" yaml:"v1beta2,omitempty"`
@@ -106,6 +564,7 @@ type ClusterTemplateV1Beta2Status struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Ready",type=boolean, JSONPath=".status.ready", description="ClusterTemplate readiness status such as True/False"
 
 // ClusterTemplate is the Schema for the clustertemplates API.
@@ -117,6 +576,20 @@ type ClusterTemplate struct {
 	Status ClusterTemplateStatus `json:"status,omitempty" yaml:"status,omitempty"`
 }
 
+// LifecycleState returns the template's lifecycle state. Templates created before
+// lifecycle states were introduced have no state set and are treated as published.
+func (c *ClusterTemplate) LifecycleState() TemplateLifecycleState {
+	if c.Spec.LifecycleState == "" {
+		return TemplatePublished
+	}
+	return c.Spec.LifecycleState
+}
+
+// PropagatesLabels returns whether changes of the template's cluster labels are propagated to its existing clusters.
+func (c *ClusterTemplate) PropagatesLabels() bool {
+	return c.Spec.LabelPropagationPolicy == LabelPropagationPropagate
+}
+
 // GetConditions returns the set of conditions for this object.
 func (c *ClusterTemplate) GetConditions() clusterv1.Conditions {
 	return c.Status.Conditions
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// ConvertTo converts the ClusterTemplate to the v1alpha1 hub version, the cluster configuration object to its JSON
+// string.
+func (src *ClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.ClusterTemplate)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 ClusterTemplate but got %T", dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.ClusterTemplateSpec{
+		ControlPlaneProviderType: src.Spec.ControlPlaneProviderType,
+		InfraProviderType:        src.Spec.InfraProviderType,
+		KubernetesVersion:        src.Spec.KubernetesVersion,
+		ClusterNetwork:           src.Spec.ClusterNetwork,
+		ClusterLabels:            src.Spec.ClusterLabels,
+		LabelPropagationPolicy:   src.Spec.LabelPropagationPolicy,
+		LifecycleState:           src.Spec.LifecycleState,
+		SunsetDate:               src.Spec.SunsetDate,
+		AirGapped:                src.Spec.AirGapped,
+		AirGap:                   src.Spec.AirGap,
+		SSHAccess:                src.Spec.SSHAccess,
+		ReservedResources:        src.Spec.ReservedResources,
+		MinNodeResources:         src.Spec.MinNodeResources,
+		RequireTrustedCompute:    src.Spec.RequireTrustedCompute,
+		VSphere:                  src.Spec.VSphere,
+		SingleNode:               src.Spec.SingleNode,
+		Remediation:              src.Spec.Remediation,
+		Variables:                src.Spec.Variables,
+	}
+	if src.Spec.ClusterConfiguration != nil && len(src.Spec.ClusterConfiguration.Raw) > 0 {
+		dst.Spec.ClusterConfiguration = string(src.Spec.ClusterConfiguration.Raw)
+	}
+	dst.Status = src.Status
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to the ClusterTemplate, the JSON string of the cluster configuration
+// to its object.
+func (dst *ClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.ClusterTemplate)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 ClusterTemplate but got %T", srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = ClusterTemplateSpec{
+		ControlPlaneProviderType: src.Spec.ControlPlaneProviderType,
+		InfraProviderType:        src.Spec.InfraProviderType,
+		KubernetesVersion:        src.Spec.KubernetesVersion,
+		ClusterNetwork:           src.Spec.ClusterNetwork,
+		ClusterLabels:            src.Spec.ClusterLabels,
+		LabelPropagationPolicy:   src.Spec.LabelPropagationPolicy,
+		LifecycleState:           src.Spec.LifecycleState,
+		SunsetDate:               src.Spec.SunsetDate,
+		AirGapped:                src.Spec.AirGapped,
+		AirGap:                   src.Spec.AirGap,
+		SSHAccess:                src.Spec.SSHAccess,
+		ReservedResources:        src.Spec.ReservedResources,
+		MinNodeResources:         src.Spec.MinNodeResources,
+		RequireTrustedCompute:    src.Spec.RequireTrustedCompute,
+		VSphere:                  src.Spec.VSphere,
+		SingleNode:               src.Spec.SingleNode,
+		Remediation:              src.Spec.Remediation,
+		Variables:                src.Spec.Variables,
+	}
+	if src.Spec.ClusterConfiguration != "" {
+		// the webhook of v1alpha1 rejects cluster configurations that are not control plane templates, so only
+		// templates stored before it was enabled can fail here
+		if !json.Valid([]byte(src.Spec.ClusterConfiguration)) {
+			return fmt.Errorf("cluster configuration of template %s/%s is not valid JSON", src.Namespace, src.Name)
+		}
+		dst.Spec.ClusterConfiguration = &runtime.RawExtension{Raw: []byte(src.Spec.ClusterConfiguration)}
+	}
+	dst.Status = src.Status
+	return nil
+}
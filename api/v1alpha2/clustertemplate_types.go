@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// ClusterTemplateSpec defines the desired state of ClusterTemplate. It is the spec of v1alpha1 with the cluster
+// configuration as an object instead of a JSON string; the nested types are shared with v1alpha1.
+type ClusterTemplateSpec struct {
+	// +optional
+	// +kubebuilder:validation:Enum=kubeadm;k3s
+	// +kubebuilder:default=k3s
+	ControlPlaneProviderType string `json:"controlPlaneProviderType,omitempty" yaml:"controlPlaneProviderType"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=intel;docker;vsphere
+	InfraProviderType string `json:"infraProviderType,omitempty" yaml:"infraProviderType,omitempty"`
+
+	// +required
+	KubernetesVersion string `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion"`
+
+	// ClusterConfiguration is the control plane template of the clusters created from the template, a
+	// KubeadmControlPlaneTemplate or a KThreesControlPlaneTemplate depending on ControlPlaneProviderType. Credentials
+	// are referenced as ${secret:<name>/<key>} from a Secret in the namespace of the template, see v1alpha1.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ClusterConfiguration *runtime.RawExtension `json:"clusterConfiguration,omitempty" yaml:"clusterConfiguration,omitempty"`
+
+	// +optional
+	ClusterNetwork v1alpha1.ClusterNetwork `json:"clusterNetwork,omitempty" yaml:"clusterNetwork,omitempty"`
+
+	// +optional
+	ClusterLabels map[string]string `json:"clusterLabels,omitempty" yaml:"clusterLabels,omitempty"`
+
+	// LabelPropagationPolicy controls whether changes of ClusterLabels are propagated to the existing clusters of
+	// the template.
+	// +optional
+	// +kubebuilder:validation:Enum=none;propagate
+	// +kubebuilder:default=none
+	LabelPropagationPolicy v1alpha1.LabelPropagationPolicy `json:"labelPropagationPolicy,omitempty" yaml:"labelPropagationPolicy,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=draft;published;deprecated
+	// +kubebuilder:default=published
+	LifecycleState v1alpha1.TemplateLifecycleState `json:"lifecycleState,omitempty" yaml:"lifecycleState,omitempty"`
+
+	// SunsetDate is the date after which clusters still using the template once it is deprecated are no longer
+	// supported and should have moved to a newer template.
+	// +optional
+	SunsetDate *metav1.Time `json:"sunsetDate,omitempty" yaml:"sunsetDate,omitempty"`
+
+	// AirGapped marks the clusters created from the template as installed without internet access from the settings
+	// of AirGap, which it requires. kubeadm clusters are only rendered air-gapped with the flag; k3s clusters are
+	// rendered air-gapped whenever AirGap is set.
+	// +optional
+	AirGapped bool `json:"airGapped,omitempty" yaml:"airGapped,omitempty"`
+
+	// AirGap configures clusters to install k3s from site-local artifacts, or kubeadm to pull its images from
+	// site-local registries, instead of the internet.
+	// +optional
+	AirGap *v1alpha1.AirGapConfig `json:"airGap,omitempty" yaml:"airGap,omitempty"`
+
+	// SSHAccess configures break-glass SSH access to the nodes of the clusters created from the template.
+	// +optional
+	SSHAccess *v1alpha1.SSHAccessConfig `json:"sshAccess,omitempty" yaml:"sshAccess,omitempty"`
+
+	// ReservedResources are the CPU and memory the kubelet of every node keeps from the pods of the clusters created
+	// from the template; clusters may override the amounts if the template reserves resources.
+	// +optional
+	ReservedResources *v1alpha1.ReservedResources `json:"reservedResources,omitempty" yaml:"reservedResources,omitempty"`
+
+	// MinNodeResources are the CPU and memory every host of the clusters created from the template needs at least;
+	// the hosts of the inventory are checked when the clusters are created.
+	// +optional
+	MinNodeResources *v1alpha1.ResourceReservation `json:"minNodeResources,omitempty" yaml:"minNodeResources,omitempty"`
+
+	// RequireTrustedCompute marks the clusters created from the template as running trusted compute workloads, which
+	// requires the Intel infra provider and hosts whose measured boot passed the attestation.
+	// +optional
+	RequireTrustedCompute bool `json:"requireTrustedCompute,omitempty" yaml:"requireTrustedCompute,omitempty"`
+
+	// VSphere places the virtual machines of the clusters created from the template in vCenter; required by the
+	// vsphere infra provider.
+	// +optional
+	VSphere *v1alpha1.VSphereConfig `json:"vsphere,omitempty" yaml:"vsphere,omitempty"`
+
+	// SingleNode tunes the clusters created from the template for a single node running the control plane and the
+	// workloads; the clusters are created with exactly one node. Only supported by the k3s control plane provider.
+	// +optional
+	SingleNode *v1alpha1.SingleNodeConfig `json:"singleNode,omitempty" yaml:"singleNode,omitempty"`
+
+	// Remediation configures the MachineHealthChecks of the clusters created from the template, which replace the
+	// machines of the control plane and of the node pools whose nodes do not start or become unhealthy.
+	// +optional
+	Remediation *v1alpha1.RemediationConfig `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// Variables are the typed variables the clusters created from the template may set; they are declared as
+	// variables of the ClusterClass and applied to the control plane and worker templates by their patches.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Variables []v1alpha1.TemplateVariable `json:"variables,omitempty" yaml:"variables,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Ready",type=boolean, JSONPath=".status.ready", description="ClusterTemplate readiness status such as True/False"
+
+// ClusterTemplate is the Schema for the clustertemplates API.
+// The version is only served where the CRD converts its objects with the webhook of the template controller, see
+// config/crd/patches.
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+
+	Spec   ClusterTemplateSpec            `json:"spec,omitempty" yaml:"spec,omitempty"`
+	Status v1alpha1.ClusterTemplateStatus `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTemplateList contains a list of ClusterTemplate.
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Items           []ClusterTemplate `json:"items" yaml:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterTemplate{}, &ClusterTemplateList{})
+}
@@ -6,19 +6,53 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
 
+	"google.golang.org/grpc/credentials"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/audit"
+	cmauth "github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/clustermetrics"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/drain"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/extensions"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/gitops"
+	cmgrpc "github.com/open-edge-platform/cluster-manager/v2/internal/grpc"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/health"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/kubeconfigs"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/leader"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/machinelogs"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/mocks"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/naming"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/notification"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/offboarding"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/preflight"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/rest"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/search"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportbundle"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
 )
 
 const controllerName = "cluster-manager"
@@ -44,6 +78,10 @@ func main() {
 		os.Exit(0)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "support-bundle" {
+		os.Exit(supportBundle(os.Args[2:]))
+	}
+
 	slog.Info("Cluster Manager started", "version", version)
 
 	config := config.ParseConfig()
@@ -53,38 +91,276 @@ func main() {
 	}
 	slog.Info("Cluster Manager configuration ", "config", config)
 
-	logger.InitializeLogger(config)
+	recorder := logger.InitializeLogger(config)
 	initializeSystemLabels(config)
+	initializeNodeMetadata(config)
 
 	// Create a root context that is canceled on SIGTERM or SIGINT so background
 	// goroutines (e.g. the tenancy poller) can shut down gracefully.
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
+	// the informers and workers are stopped only once the server drained its in-flight requests, which still read from
+	// the informer caches
+	background, cancelBackground := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelBackground()
+	var workers sync.WaitGroup
 
-	if err := multitenancy.InitializeRuntime(ctx, config, controllerName); err != nil {
-		slog.Error("failed to initialize multitenancy", "error", err)
-		os.Exit(2)
+	// the m2m tokens, e.g. of the kubeconfigs and the tenancy poller, are issued by the configured oidc provider
+	oidcProvider, err := cmauth.NewOIDCProvider(config.OidcProvider)
+	if err != nil {
+		slog.Error("failed to create oidc provider", "error", err)
+		os.Exit(4)
 	}
+	cmauth.SetOIDCProvider(oidcProvider)
 
-	k8sclient := initializeK8sClient()
+	// the tenancy watcher of several replicas runs on their leader only, see startLeaderElection
+	if config.ReplicaMode == "" {
+		if err := multitenancy.InitializeRuntime(ctx, config, controllerName); err != nil {
+			slog.Error("failed to initialize multitenancy", "error", err)
+			os.Exit(2)
+		}
+	}
 
-	auth, err := rest.GetAuthenticator(config)
+	k8sclient := initializeK8sClient(ctx, config)
+	if config.CAPIVersion == convert.CAPIVersionV1Beta2 {
+		// the v1beta1 objects are converted from and to the v1beta2 objects of the management cluster
+		k8sclient.Dyn = k8s.NewV1Beta2Client(k8sclient.Dyn)
+	}
+	if config.K8sCallDiagnostics {
+		k8sclient.Dyn = k8s.NewCountingClient(k8sclient.Dyn)
+	}
+	// transient errors of the api server are retried, each attempt is counted by the counting client
+	resilient := k8s.NewResilientClient(k8sclient.Dyn, k8s.ResilienceConfig{
+		MaxRetries:       config.K8sMaxRetries,
+		BreakerThreshold: config.K8sBreakerThreshold,
+		BreakerTimeout:   config.K8sBreakerTimeout,
+	})
+	k8sclient.Dyn = resilient
+	elector := startLeaderElection(background, config, k8sclient, &workers)
+
+	auth, err := rest.GetAuthenticator(ctx, config)
 	if err != nil {
 		slog.Error("failed to get authenticator", "error", err)
 		os.Exit(4)
 	}
 
-	inv, err := rest.GetInventory(config, k8sclient)
+	// the credentials of the m2m client the kubeconfig tokens are issued for are read from the configured backend
+	secrets, err := cmauth.NewSecretProvider(config.M2MSecretBackend, config.M2MSecret, k8sclient.Dyn)
+	if err != nil {
+		slog.Error("failed to create m2m secret provider", "error", err)
+		os.Exit(4)
+	}
+	cmauth.SetSecretProvider(secrets)
+
+	var isLeader func() bool
+	if elector != nil {
+		isLeader = elector.IsLeader
+	}
+	inv, err := rest.GetInventory(config, k8sclient, isLeader)
 	if err != nil {
 		slog.Error("failed to start inventory client", "error", err)
 		os.Exit(7)
 	}
 
-	s := rest.NewServer(k8sclient.Dyn, rest.WithAuth(auth), rest.WithConfig(config), rest.WithInventory(inv))
-	if err := s.Serve(); err != nil {
+	// the informers resume their initial lists from the watch bookmarks saved before the restart, if enabled
+	informerClient := k8sclient.Dyn
+	var bookmarks *k8s.WatchBookmarks
+	if config.WatchBookmarksConfigMap != "" {
+		bookmarks = loadWatchBookmarks(ctx, config, k8sclient)
+		informerClient = bookmarks.Client(k8sclient.Dyn)
+	}
+
+	clusterEvents, err := k8s.NewClusterInformer(informerClient)
+	if err != nil {
+		slog.Error("failed to create cluster informer", "error", err)
+		os.Exit(8)
+	}
+	var readCache *k8s.ReadCache
+	if config.DisableReadCache {
+		if err := clusterEvents.Start(background); err != nil {
+			slog.Error("failed to start cluster informer", "error", err)
+			os.Exit(8)
+		}
+	} else {
+		// requests are served while the caches are synced, the most recently active projects are warmed up first
+		readCache = k8s.NewReadCache(k8sclient.Dyn, clusterEvents)
+		readCache.Start(background)
+		go readCache.Warmup(background)
+		go func() {
+			if err := clusterEvents.Start(background); err != nil {
+				slog.Warn("cluster informer stopped before its cache was synced", "error", err)
+			}
+		}()
+	}
+	if bookmarks != nil {
+		clusterEvents.TrackBookmarks(bookmarks)
+		if readCache != nil {
+			readCache.TrackBookmarks(bookmarks)
+		}
+		// the bookmarks are saved a last time on shutdown
+		runSingleton(background, &workers, elector, "watch-bookmarks", func(ctx context.Context) { bookmarks.Run(ctx, config.WatchBookmarksInterval) })
+	}
+
+	if !config.DisableMetrics {
+		recorder := clustermetrics.NewRecorder()
+		if err := clusterEvents.AddHandler(recorder.ClusterChanged); err != nil {
+			slog.Error("failed to subscribe to cluster changes", "error", err)
+			os.Exit(16)
+		}
+	}
+
+	prober := health.NewProber(k8sclient, health.WithInterval(config.HealthProbeInterval))
+	if !config.DisableKubeconfigCleanup {
+		startKubeconfigCleaner(background, &workers, elector, config, k8sclient, clusterEvents, prober)
+	}
+	// the hosts reserved for the clusters in the inventory are released once the clusters are removed
+	if err := clusterEvents.AddHandler(leaderOnly(elector, inventory.ReleaseOnRemoval(inv))); err != nil {
+		slog.Error("failed to subscribe to cluster changes", "error", err)
+		os.Exit(16)
+	}
+
+	tracker := operations.NewTracker(k8sclient)
+	var schedulerOptions []func(*scheduling.Scheduler)
+	if config.ImagePreflightGatewayURL != "" {
+		schedulerOptions = append(schedulerOptions, scheduling.WithImagePuller(preflight.NewGatewayPuller(config.ImagePreflightGatewayURL)),
+			scheduling.WithPreflightTimeout(config.ImagePreflightTimeout))
+	}
+	pending := scheduling.NewScheduler(k8sclient, schedulerOptions...)
+	var machineLogOptions []func(*machinelogs.Fetcher)
+	if config.DockerHost != "" {
+		machineLogOptions = append(machineLogOptions, machinelogs.WithDockerHost(config.DockerHost))
+	}
+	options := []func(*rest.Server){rest.WithAuth(auth), rest.WithConfig(config), rest.WithInventory(inv), rest.WithClusterEvents(clusterEvents), rest.WithClusterIndex(clusterEvents),
+		rest.WithHealthChecks(clusterEvents), rest.WithOperations(tracker), rest.WithPendingClusters(pending),
+		rest.WithK8sAvailability(resilient.RetryAfter),
+		rest.WithTemplateUploads(uploads.NewStore(k8sclient)),
+		rest.WithClusterHealth(prober),
+		rest.WithMachineLogs(machinelogs.NewFetcher(k8sclient, machineLogOptions...)),
+		rest.WithSupportBundles(supportbundle.NewCollector(k8sclient, supportbundle.WithLogSource(recorder), supportbundle.WithOperations(tracker)))}
+	if readCache != nil {
+		options = append(options, rest.WithReadCache(readCache), rest.WithCacheWarmup(readCache))
+	}
+	if config.ReplicaMode == leader.ModeActivePassive {
+		options = append(options, rest.WithLeadership(elector))
+	}
+	// the nodes of clusters in maintenance are cordoned with the default timeout, also if removed nodes are not drained
+	options = append(options, rest.WithNodeCordoner(drain.NewDrainer(k8sclient)))
+	if config.NodeDrainTimeout > 0 {
+		options = append(options, rest.WithNodeDrainer(drain.NewDrainer(k8sclient, drain.WithTimeout(config.NodeDrainTimeout))))
+	}
+	if config.OffboardingExportDir != "" {
+		options = append(options, rest.WithExportStore(offboarding.NewDirStore(config.OffboardingExportDir)))
+	}
+	if config.QuotaConfigPath != "" {
+		quotas, err := multitenancy.LoadQuotaConfig(config.QuotaConfigPath)
+		if err != nil {
+			slog.Error("failed to load quota config", "error", err)
+			os.Exit(9)
+		}
+		options = append(options, rest.WithQuotas(multitenancy.NewQuotaEnforcer(k8sclient, quotas)))
+	}
+	if config.NamingPolicyPath != "" {
+		policies, err := naming.LoadConfig(config.NamingPolicyPath)
+		if err != nil {
+			slog.Error("failed to load naming policy config", "error", err)
+			os.Exit(17)
+		}
+		options = append(options, rest.WithNamingPolicies(naming.NewPolicies(policies)))
+	}
+	if config.SupportMatrixPath != "" {
+		matrix, err := supportmatrix.Load(config.SupportMatrixPath)
+		if err != nil {
+			slog.Error("failed to load support matrix", "error", err)
+			os.Exit(13)
+		}
+		options = append(options, rest.WithSupportMatrix(matrix))
+	}
+	if config.TrustedKeysPath != "" {
+		keys, err := signature.LoadTrustedKeys(config.TrustedKeysPath)
+		if err != nil {
+			slog.Error("failed to load trusted template signature keys", "error", err)
+			os.Exit(23)
+		}
+		options = append(options, rest.WithTrustedKeys(keys))
+	}
+	if config.ExtensionCatalogPath != "" {
+		catalog, err := extensions.Load(config.ExtensionCatalogPath)
+		if err != nil {
+			slog.Error("failed to load extension catalog", "error", err)
+			os.Exit(22)
+		}
+		options = append(options, rest.WithExtensionCatalog(catalog))
+	}
+	if config.ShadowValidationRules != "" {
+		shadowed, err := validation.ParseShadowed(config.ShadowValidationRules)
+		if err != nil {
+			slog.Error("failed to parse shadowed validation rules", "error", err)
+			os.Exit(15)
+		}
+		options = append(options, rest.WithValidationRules(validation.NewRules(shadowed)))
+	}
+	if len(config.ReservedNetworks) > 0 {
+		reserved, err := network.ParseReserved(config.ReservedNetworks)
+		if err != nil {
+			slog.Error("failed to parse reserved networks", "error", err)
+			os.Exit(18)
+		}
+		options = append(options, rest.WithReservedNetworks(reserved))
+	}
+	options = append(options, rest.WithKubeconfigPipeline(kubeconfigPipeline(config)))
+	var destinationPolicy *notification.DestinationPolicy
+	if config.WebhookDestinationsPath != "" {
+		destinations, err := notification.LoadDestinationConfig(config.WebhookDestinationsPath)
+		if err != nil {
+			slog.Error("failed to load webhook destination config", "error", err)
+			os.Exit(10)
+		}
+		destinationPolicy = notification.NewDestinationPolicy(destinations)
+		options = append(options, rest.WithWebhookDestinations(destinationPolicy))
+	}
+	if config.WebhookTargetsPath != "" {
+		startNotifier(background, &workers, elector, config, clusterEvents, destinationPolicy)
+	}
+	if config.GitopsMode != "" {
+		repositories, err := gitops.LoadConfig(config.GitopsConfigPath)
+		if err != nil {
+			slog.Error("failed to load gitops config", "error", err)
+			os.Exit(21)
+		}
+		options = append(options, rest.WithGitOps(gitops.NewPublisher(repositories, gitops.WithWorkDir(config.GitopsWorkDir))))
+		slog.Info("publishing cluster manifests to gitops repositories", "mode", config.GitopsMode, "default", repositories.Default.URL, "projects", len(repositories.Projects))
+	}
+
+	if config.InventoryExportURL != "" {
+		exporter := search.NewExporter(k8sclient, config.InventoryExportURL, search.WithInterval(config.InventoryExportInterval))
+		runSingleton(background, &workers, elector, "inventory-exporter", exporter.Run)
+		slog.Info("exporting cluster inventory", "endpoint", config.InventoryExportURL, "interval", config.InventoryExportInterval)
+	}
+
+	if len(config.AuditSinks) > 0 {
+		options = append(options, rest.WithAuditLogger(initializeAuditLogger(config)))
+	}
+
+	s := rest.NewServer(k8sclient.Dyn, options...)
+	runSingleton(background, &workers, elector, "scheduler", func(ctx context.Context) { pending.Run(ctx, s) })
+	// the clusters pending deletion are deleted by the server, like the clusters whose deletion is requested right away
+	startDeletionTracker(background, &workers, elector, config, k8sclient, clusterEvents, s)
+	if config.GRPCPort != 0 {
+		startGRPCServer(ctx, config, s)
+	}
+	// the server drains its in-flight requests on SIGTERM before the informers, workers and clients are stopped
+	if err := s.Serve(ctx); err != nil {
 		slog.Error("server failed", "error", err)
 		os.Exit(5)
 	}
+	cancelBackground()
+	workers.Wait()
+	if closer, ok := inv.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			slog.Warn("failed to close inventory client", "error", err)
+		}
+	}
+	slog.Info("Cluster Manager stopped")
 }
 
 func initializeSystemLabels(config *config.Config) {
@@ -94,10 +370,186 @@ func initializeSystemLabels(config *config.Config) {
 	}
 }
 
-func initializeK8sClient() *k8s.Client {
-	k8sclient := k8s.New().WithInClusterConfig()
-	if k8sclient == nil {
-		slog.Error("failed to initialize k8s clientset")
+func initializeNodeMetadata(config *config.Config) {
+	if len(config.NodeMetadataKeys) > 0 {
+		slog.Info(fmt.Sprintf("overriding node metadata keys with %v", config.NodeMetadataKeys))
+		nodemetadata.OverrideAllowedKeys(config.NodeMetadataKeys)
+	}
+}
+
+func startNotifier(ctx context.Context, workers *sync.WaitGroup, elector *leader.Elector, config *config.Config, clusterEvents *k8s.ClusterInformer, destinationPolicy *notification.DestinationPolicy) {
+	targets, err := notification.LoadTargetConfig(config.WebhookTargetsPath)
+	if err != nil {
+		slog.Error("failed to load webhook target config", "error", err)
+		os.Exit(11)
+	}
+
+	var options []func(*notification.Notifier)
+	if destinationPolicy != nil {
+		options = append(options, notification.WithDestinationPolicy(destinationPolicy))
+	}
+	notifier := notification.NewNotifier(targets, options...)
+	if err := clusterEvents.AddHandler(leaderOnly(elector, notifier.ClusterChanged)); err != nil {
+		slog.Error("failed to subscribe to cluster changes", "error", err)
+		os.Exit(11)
+	}
+	runSingleton(ctx, workers, elector, "notifier", notifier.Run)
+	slog.Info("posting cluster lifecycle events to webhook targets", "global", len(targets.Global), "projects", len(targets.Projects))
+}
+
+// startLeaderElection campaigns for the leader lease if several replicas share the work, see config.ReplicaMode; there
+// is no leader election for a single replica
+func startLeaderElection(ctx context.Context, config *config.Config, k8sclient *k8s.Client, workers *sync.WaitGroup) *leader.Elector {
+	if config.ReplicaMode == "" {
+		return nil
+	}
+
+	// the hostname of a pod is its name
+	identity, err := os.Hostname()
+	if err != nil {
+		slog.Error("failed to get the identity of the replica", "error", err)
+		os.Exit(24)
+	}
+	namespace, name, _ := strings.Cut(config.LeaderElectionLease, "/")
+	elector := leader.NewElector(k8sclient.Dyn, namespace, name, identity)
+	// the workers of the leader are stopped and the lease is released on shutdown
+	workers.Go(func() { elector.Run(ctx) })
+
+	elector.Go("tenancy", func(ctx context.Context) {
+		if err := multitenancy.InitializeRuntime(ctx, config, controllerName); err != nil {
+			slog.Error("failed to initialize multitenancy", "error", err)
+			return
+		}
+		<-ctx.Done()
+	})
+	slog.Info("electing the leader of the replicas", "mode", config.ReplicaMode, "lease", config.LeaderElectionLease, "identity", identity)
+	return elector
+}
+
+// runSingleton runs a worker that must run once: on this replica if it is the only one, else while it is the leader
+// of the replicas
+func runSingleton(ctx context.Context, workers *sync.WaitGroup, elector *leader.Elector, name string, run func(ctx context.Context)) {
+	if elector == nil {
+		workers.Go(func() { run(ctx) })
+		return
+	}
+	elector.Go(name, run)
+}
+
+// leaderOnly returns a cluster handler that handles the changes of the clusters on the leader of the replicas only,
+// so that they are handled once
+func leaderOnly(elector *leader.Elector, handler k8s.ClusterHandler) k8s.ClusterHandler {
+	if elector == nil {
+		return handler
+	}
+	return func(old, new *capi.Cluster) {
+		if elector.IsLeader() {
+			handler(old, new)
+		}
+	}
+}
+
+// loadWatchBookmarks loads the watch bookmarks of the configured ConfigMap; the informers list all objects again if
+// they cannot be loaded
+func loadWatchBookmarks(ctx context.Context, config *config.Config, k8sclient *k8s.Client) *k8s.WatchBookmarks {
+	namespace, name, _ := strings.Cut(config.WatchBookmarksConfigMap, "/")
+	bookmarks := k8s.NewWatchBookmarks(k8sclient.Dyn, namespace, name)
+	if err := bookmarks.Load(ctx); err != nil {
+		slog.Warn("failed to load watch bookmarks, informers list all objects again", "error", err)
+	}
+	return bookmarks
+}
+
+func startKubeconfigCleaner(ctx context.Context, workers *sync.WaitGroup, elector *leader.Elector, config *config.Config, k8sclient *k8s.Client, clusterEvents *k8s.ClusterInformer, prober *health.Prober) {
+	cleaner := kubeconfigs.NewCleaner(k8sclient, kubeconfigs.WithRetention(config.KubeconfigRetention), kubeconfigs.WithCacheInvalidation(prober.Forget))
+	if err := clusterEvents.AddHandler(leaderOnly(elector, cleaner.ClusterChanged)); err != nil {
+		slog.Error("failed to subscribe to cluster changes", "error", err)
+		os.Exit(14)
+	}
+	runSingleton(ctx, workers, elector, "kubeconfig-cleaner", cleaner.Run)
+	slog.Info("cleaning up the kubeconfig secrets of deleted clusters", "retention", config.KubeconfigRetention)
+}
+
+func startDeletionTracker(ctx context.Context, workers *sync.WaitGroup, elector *leader.Elector, config *config.Config, k8sclient *k8s.Client, clusterEvents *k8s.ClusterInformer, deleter deletion.Deleter) {
+	tracker := deletion.NewTracker(k8sclient, deletion.WithDeleter(deleter))
+	if err := clusterEvents.AddHandler(leaderOnly(elector, tracker.ClusterChanged)); err != nil {
+		slog.Error("failed to subscribe to cluster changes", "error", err)
+		os.Exit(20)
+	}
+	runSingleton(ctx, workers, elector, "deletion-tracker", tracker.Run)
+	slog.Info("tracking the deletion of clusters", "gracePeriod", config.ClusterDeleteGracePeriod, "forceDeleteTimeout", config.ForceDeleteTimeout)
+}
+
+// kubeconfigPipeline returns the pipeline the kubeconfigs served to the users are processed with: the server URL is
+// rewritten to the connect gateway, the context is renamed and the CA bundle is merged if configured
+func kubeconfigPipeline(config *config.Config) *kubeconfigs.Pipeline {
+	serverURL := config.KubeconfigServerURL
+	if serverURL == "" {
+		serverURL = kubeconfigs.DefaultGatewayURL(config.ClusterDomain)
+	}
+	steps := []kubeconfigs.Step{kubeconfigs.RewriteServerURL(serverURL)}
+	if config.KubeconfigContextName != "" {
+		steps = append(steps, kubeconfigs.RenameContext(config.KubeconfigContextName))
+	}
+	if config.KubeconfigCABundlePath != "" {
+		bundle, err := kubeconfigs.LoadCABundle(config.KubeconfigCABundlePath)
+		if err != nil {
+			slog.Error("failed to load kubeconfig ca bundle", "error", err)
+			os.Exit(19)
+		}
+		steps = append(steps, kubeconfigs.MergeCABundle(bundle))
+	}
+	return kubeconfigs.NewPipeline(steps...)
+}
+
+func startGRPCServer(ctx context.Context, config *config.Config, s *rest.Server) {
+	handler, err := s.ConfigureHandler()
+	if err != nil {
+		slog.Error("failed to initialize grpc handler", "error", err)
+		os.Exit(12)
+	}
+
+	var options []func(*cmgrpc.Server)
+	if !config.DisableAuth {
+		options = append(options, cmgrpc.WithTokenRequired())
+	}
+	if config.GRPCTLSCert != "" {
+		creds, err := credentials.NewServerTLSFromFile(config.GRPCTLSCert, config.GRPCTLSKey)
+		if err != nil {
+			slog.Error("failed to load grpc tls credentials", "error", err)
+			os.Exit(12)
+		}
+		options = append(options, cmgrpc.WithTLS(creds))
+	}
+
+	go func() {
+		if err := cmgrpc.NewServer(handler, options...).Serve(ctx, fmt.Sprintf("0.0.0.0:%d", config.GRPCPort)); err != nil {
+			slog.Error("grpc server failed", "error", err)
+			os.Exit(12)
+		}
+	}()
+}
+
+func initializeAuditLogger(config *config.Config) *audit.Logger {
+	var sinks []audit.Sink
+	for _, sink := range config.AuditSinks {
+		switch sink {
+		case "stdout":
+			sinks = append(sinks, audit.NewWriterSink(os.Stdout))
+		case "file":
+			sinks = append(sinks, audit.NewFileSink(config.AuditLogDir))
+		case "kafka":
+			sinks = append(sinks, audit.NewKafkaSink(config.AuditKafkaURL, config.AuditKafkaTopic))
+		}
+	}
+	slog.Info("auditing mutating requests", "sinks", config.AuditSinks)
+	return audit.NewLogger(sinks...)
+}
+
+func initializeK8sClient(ctx context.Context, config *config.Config) *k8s.Client {
+	k8sclient, err := k8s.Connect(ctx, config.Kubeconfig, config.K8sConnectTimeout)
+	if err != nil {
+		slog.Error("failed to initialize k8s clientset", "error", err)
 		os.Exit(3)
 	}
 	return k8sclient
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// supportBundle downloads the support bundle of a cluster from the admin API of a running cluster-manager, by default
+// the one of the pod it is run in, e.g. with kubectl exec, and returns the exit code
+func supportBundle(args []string) int {
+	fs := flag.NewFlagSet("support-bundle", flag.ContinueOnError)
+	cluster := fs.String("cluster", "", "The name of the cluster")
+	project := fs.String("project", "", "The ID of the project of the cluster")
+	output := fs.String("output", "", "The file the bundle is written to, <cluster>-support-bundle.tar.gz if empty, - for stdout")
+	server := fs.String("server", "http://localhost:8080", "The address of the cluster-manager REST API")
+	token := fs.String("token", os.Getenv("CLUSTER_MANAGER_TOKEN"), "The bearer token of a cl-admin user, defaults to $CLUSTER_MANAGER_TOKEN")
+	timeout := fs.Duration("timeout", 2*time.Minute, "The time to wait for the bundle")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *cluster == "" || *project == "" {
+		fmt.Fprintln(os.Stderr, "support-bundle: --cluster and --project are required")
+		fs.Usage()
+		return 2
+	}
+	if *output == "" {
+		*output = *cluster + "-support-bundle.tar.gz"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	endpoint := fmt.Sprintf("%s/v2/admin/support-bundles/%s/clusters/%s", *server, url.PathEscape(*project), url.PathEscape(*cluster))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "support-bundle: %v\n", err)
+		return 1
+	}
+	if *token != "" {
+		req.Header.Set("Authorization", "Bearer "+*token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "support-bundle: %v\n", err)
+		return 1
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		fmt.Fprintf(os.Stderr, "support-bundle: %s: %s\n", resp.Status, body)
+		return 1
+	}
+
+	out := os.Stdout
+	if *output != "-" {
+		out, err = os.OpenFile(*output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "support-bundle: %v\n", err)
+			return 1
+		}
+		defer out.Close()
+	}
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		fmt.Fprintf(os.Stderr, "support-bundle: failed to write bundle: %v\n", err)
+		return 1
+	}
+	if *output != "-" {
+		fmt.Fprintf(os.Stderr, "support bundle of cluster %s written to %s (correlation ID %s)\n", *cluster, *output, resp.Header.Get("X-Correlation-ID"))
+	}
+	return 0
+}
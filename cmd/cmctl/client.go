@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// options are the flags common to the commands calling the cluster-manager REST API
+type options struct {
+	server  string
+	project string
+	token   string
+	output  string
+	timeout time.Duration
+}
+
+// register registers the common flags in the flag set of a command
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.server, "server", envOr("CMCTL_SERVER", "http://localhost:8080"), "The address of the cluster-manager REST API, defaults to $CMCTL_SERVER")
+	fs.StringVar(&o.project, "project", os.Getenv("CMCTL_PROJECT"), "The ID of the active project, defaults to $CMCTL_PROJECT")
+	fs.StringVar(&o.token, "token", os.Getenv("CLUSTER_MANAGER_TOKEN"), "The bearer token, defaults to $CLUSTER_MANAGER_TOKEN or the token of 'cmctl login'")
+	fs.StringVar(&o.output, "output", "table", "The output format, table or json")
+	fs.DurationVar(&o.timeout, "timeout", time.Minute, "The time to wait for the response")
+}
+
+// validate validates the common flags
+func (o *options) validate() error {
+	if o.project == "" {
+		return errors.New("--project is required")
+	}
+	if _, err := uuid.Parse(o.project); err != nil {
+		return fmt.Errorf("invalid --project %q: %w", o.project, err)
+	}
+	if o.output != "table" && o.output != "json" {
+		return fmt.Errorf("invalid --output %q, must be table or json", o.output)
+	}
+	return nil
+}
+
+// projectId returns the ID of the active project, validated beforehand
+func (o *options) projectId() api.ActiveProjectIdHeader {
+	return uuid.MustParse(o.project)
+}
+
+// client returns a client of the REST API authenticating the requests with the token of the flags, or with the token
+// of the credentials saved by 'cmctl login', refreshed if it expired, which becomes the token of the options
+func (o *options) client(ctx context.Context) (*api.ClientWithResponses, error) {
+	if o.token == "" {
+		creds, err := loadCredentials(ctx)
+		if err != nil {
+			return nil, err
+		}
+		o.token = creds.AccessToken
+	}
+
+	return api.NewClientWithResponses(o.server, api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+o.token)
+		return nil
+	}))
+}
+
+// responseError returns the error of a response of the REST API with the message of its problem details if any
+func responseError(resp *http.Response, body []byte) error {
+	var problem struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &problem); err != nil || problem.Message == "" {
+		return fmt.Errorf("%s: %s", resp.Status, body)
+	}
+	if problem.Code != "" {
+		return fmt.Errorf("%s: %s (%s)", resp.Status, problem.Message, problem.Code)
+	}
+	return fmt.Errorf("%s: %s", resp.Status, problem.Message)
+}
+
+// envOr returns the value of the environment variable, the fallback if it is not set
+func envOr(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+// fail prints the error of the command and returns the exit code of failed commands
+func fail(command string, err error) int {
+	fmt.Fprintf(os.Stderr, "%s: %v\n", command, err)
+	return 1
+}
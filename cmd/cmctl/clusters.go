@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// clusters runs the clusters subcommands
+func clusters(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "list":
+		return listClusters(args[1:])
+	case "create":
+		return createCluster(args[1:])
+	case "delete":
+		return deleteCluster(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "clusters: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
+
+// listClusters lists the clusters of the project, all pages of them
+func listClusters(args []string) int {
+	var opts options
+	fs := flag.NewFlagSet("clusters list", flag.ContinueOnError)
+	opts.register(fs)
+	filter := fs.String("filter", "", "The filter of the clusters, e.g. 'labels.site=s1'")
+	if _, err := parse(fs, args); err != nil {
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("clusters list", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("clusters list", err)
+	}
+
+	params := &api.GetV2ClustersParams{Activeprojectid: opts.projectId()}
+	if *filter != "" {
+		params.Filter = filter
+	}
+	clusters := []api.ClusterInfo{}
+	for {
+		resp, err := client.GetV2ClustersWithResponse(ctx, params)
+		if err != nil {
+			return fail("clusters list", err)
+		}
+		if resp.JSON200 == nil {
+			return fail("clusters list", responseError(resp.HTTPResponse, resp.Body))
+		}
+		clusters = append(clusters, deref(resp.JSON200.Clusters)...)
+		if resp.JSON200.NextPageToken == nil {
+			break
+		}
+		params.PageToken = resp.JSON200.NextPageToken
+	}
+
+	rows := make([][]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		rows = append(rows, []string{
+			deref(cluster.Name),
+			deref(cluster.KubernetesVersion),
+			strconv.Itoa(deref(cluster.NodeQuantity)),
+			message(cluster.LifecyclePhase),
+			message(cluster.ProviderStatus),
+		})
+	}
+	if err := render(opts.output, clusters, []string{"NAME", "KUBERNETES", "NODES", "LIFECYCLE", "STATUS"}, rows); err != nil {
+		return fail("clusters list", err)
+	}
+	return 0
+}
+
+// createCluster creates a cluster from the spec of a file, or from the spec of the flags
+func createCluster(args []string) int {
+	var opts options
+	var nodes, labels stringList
+	fs := flag.NewFlagSet("clusters create", flag.ContinueOnError)
+	opts.register(fs)
+	file := fs.String("f", "", "The JSON or YAML file of the cluster spec, - for stdin; the other cluster flags are ignored if set")
+	name := fs.String("name", "", "The name of the cluster, generated if empty")
+	template := fs.String("template", "", "The template of the cluster, <name>-<version>, the default template of the project if empty")
+	fs.Var(&nodes, "node", "A node of the cluster, <host-id>[:<role>] with role all, controlplane, worker or etcd (default all); repeatable")
+	fs.Var(&labels, "label", "A label of the cluster, <key>=<value>; repeatable")
+	idempotencyKey := fs.String("idempotency-key", "", "The key of the request, e.g. a UUID; running the command again with the same key returns the cluster created by the first run")
+	if _, err := parse(fs, args); err != nil {
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("clusters create", err)
+	}
+
+	body, err := clusterSpec(*file, *name, *template, nodes, labels)
+	if err != nil {
+		return fail("clusters create", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("clusters create", err)
+	}
+
+	params := &api.PostV2ClustersParams{Activeprojectid: opts.projectId()}
+	if *idempotencyKey != "" {
+		params.IdempotencyKey = idempotencyKey
+	}
+	resp, err := client.PostV2ClustersWithBodyWithResponse(ctx, params, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fail("clusters create", err)
+	}
+	switch {
+	case resp.JSON201 != nil:
+		fmt.Fprintln(stdout, *resp.JSON201)
+	case resp.JSON202 != nil:
+		// the cluster waits for its scheduled provisioning or for the clusters it depends on
+		fmt.Fprintf(stdout, "cluster %s is pending\n", resp.JSON202.Name)
+	default:
+		return fail("clusters create", responseError(resp.HTTPResponse, resp.Body))
+	}
+	return 0
+}
+
+// clusterSpec returns the JSON body of the cluster spec of the file, converted from YAML if needed, or of the flags
+func clusterSpec(file, name, template string, nodes, labels []string) ([]byte, error) {
+	if file != "" {
+		var data []byte
+		var err error
+		if file == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cluster spec: %w", err)
+		}
+		return yaml.YAMLToJSON(data)
+	}
+
+	if len(nodes) == 0 {
+		return nil, errors.New("at least one --node is required")
+	}
+	spec := api.ClusterSpec{Nodes: make([]api.NodeSpec, 0, len(nodes))}
+	if name != "" {
+		spec.Name = &name
+	}
+	if template != "" {
+		spec.Template = &template
+	}
+	for _, node := range nodes {
+		id, role, _ := strings.Cut(node, ":")
+		if role == "" {
+			role = string(api.All)
+		}
+		spec.Nodes = append(spec.Nodes, api.NodeSpec{Id: id, Role: api.NodeSpecRole(role)})
+	}
+	if len(labels) > 0 {
+		specLabels := make(map[string]string, len(labels))
+		for _, label := range labels {
+			key, value, ok := strings.Cut(label, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid --label %q, must be <key>=<value>", label)
+			}
+			specLabels[key] = value
+		}
+		spec.Labels = &specLabels
+	}
+	return json.Marshal(spec)
+}
+
+// deleteCluster deletes a cluster
+func deleteCluster(args []string) int {
+	var opts options
+	fs := flag.NewFlagSet("clusters delete", flag.ContinueOnError)
+	opts.register(fs)
+	force := fs.Bool("force", false, "Delete the cluster without draining its nodes first")
+	forceFinalize := fs.Bool("force-finalize", false, "Strip the finalizers of the cluster if it is still deleting after the force delete timeout")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fmt.Fprintln(os.Stderr, "clusters delete: the name of the cluster is required")
+		fs.Usage()
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("clusters delete", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("clusters delete", err)
+	}
+
+	params := &api.DeleteV2ClustersNameParams{Activeprojectid: opts.projectId()}
+	if *force {
+		params.Force = force
+	}
+	if *forceFinalize {
+		params.ForceFinalize = forceFinalize
+	}
+	resp, err := client.DeleteV2ClustersNameWithResponse(ctx, positional[0], params)
+	if err != nil {
+		return fail("clusters delete", err)
+	}
+	if resp.StatusCode() != http.StatusAccepted {
+		return fail("clusters delete", responseError(resp.HTTPResponse, resp.Body))
+	}
+	fmt.Fprintf(stdout, "cluster %s is being deleted\n", positional[0])
+	return 0
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// kubeconfig downloads the kubeconfig of a cluster
+func kubeconfig(args []string) int {
+	var opts options
+	fs := flag.NewFlagSet("kubeconfig", flag.ContinueOnError)
+	opts.register(fs)
+	output := fs.String("f", "", "The file the kubeconfig is written to, <cluster>.kubeconfig if empty, - for stdout")
+	authType := fs.String("auth-type", string(api.GetV2ClustersNameKubeconfigsParamsAuthTypeToken), "The authentication of the kubeconfig, token or oidc-exec")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fmt.Fprintln(os.Stderr, "kubeconfig: the name of the cluster is required")
+		fs.Usage()
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("kubeconfig", err)
+	}
+	cluster := positional[0]
+	if *output == "" {
+		*output = cluster + ".kubeconfig"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("kubeconfig", err)
+	}
+
+	params := &api.GetV2ClustersNameKubeconfigsParams{
+		AuthType:        (*api.GetV2ClustersNameKubeconfigsParamsAuthType)(authType),
+		Activeprojectid: opts.projectId(),
+		Authorization:   "Bearer " + opts.token,
+	}
+	resp, err := client.GetV2ClustersNameKubeconfigsWithResponse(ctx, cluster, params)
+	if err != nil {
+		return fail("kubeconfig", err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Kubeconfig == nil {
+		return fail("kubeconfig", responseError(resp.HTTPResponse, resp.Body))
+	}
+
+	if *output == "-" {
+		fmt.Fprint(stdout, *resp.JSON200.Kubeconfig)
+		return 0
+	}
+	if err := os.WriteFile(*output, []byte(*resp.JSON200.Kubeconfig), 0o600); err != nil {
+		return fail("kubeconfig", err)
+	}
+	fmt.Fprintf(stdout, "kubeconfig of cluster %s written to %s\n", cluster, *output)
+	return 0
+}
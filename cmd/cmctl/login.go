@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// minPollInterval is the minimum time between two polls of the token endpoint while the user logs in
+var minPollInterval = 5 * time.Second
+
+// credentials are the tokens of the user saved by 'cmctl login'
+type credentials struct {
+	Issuer       string    `json:"issuer"`
+	ClientID     string    `json:"clientId"`
+	AccessToken  string    `json:"accessToken"`
+	RefreshToken string    `json:"refreshToken,omitempty"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// tokenResponse is the response of the token endpoint of the OIDC provider
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	Error        string `json:"error"`
+	Description  string `json:"error_description"`
+}
+
+// login logs the user in to Keycloak with the OAuth 2.0 device authorization flow, so that it works without a browser
+// on the machine cmctl runs on, and saves the tokens for the other commands
+func login(args []string) int {
+	fs := flag.NewFlagSet("login", flag.ContinueOnError)
+	issuer := fs.String("issuer", os.Getenv("CMCTL_ISSUER"), "The issuer URL of Keycloak, e.g. https://keycloak.<clusterdomain>/realms/master, defaults to $CMCTL_ISSUER")
+	clientID := fs.String("client-id", "system-client", "The public OIDC client to log in with")
+	timeout := fs.Duration("timeout", 5*time.Minute, "The time to wait for the user to log in")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *issuer == "" {
+		fmt.Fprintln(os.Stderr, "login: --issuer is required")
+		fs.Usage()
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	config, err := discover(ctx, *issuer)
+	if err != nil {
+		return fail("login", err)
+	}
+
+	var device struct {
+		DeviceCode              string `json:"device_code"`
+		UserCode                string `json:"user_code"`
+		VerificationURI         string `json:"verification_uri"`
+		VerificationURIComplete string `json:"verification_uri_complete"`
+		Interval                int    `json:"interval"`
+	}
+	form := url.Values{"client_id": {*clientID}, "scope": {"openid"}}
+	if err := postForm(ctx, config.DeviceAuthorizationEndpoint, form, &device); err != nil {
+		return fail("login", fmt.Errorf("failed to start device authorization: %w", err))
+	}
+	if device.VerificationURIComplete != "" {
+		fmt.Fprintf(os.Stderr, "Open %s to log in\n", device.VerificationURIComplete)
+	} else {
+		fmt.Fprintf(os.Stderr, "Open %s and enter the code %s to log in\n", device.VerificationURI, device.UserCode)
+	}
+
+	// poll the token endpoint until the user logged in, slowing down if asked to
+	interval := max(time.Duration(device.Interval)*time.Second, minPollInterval)
+	form = url.Values{"grant_type": {deviceCodeGrantType}, "device_code": {device.DeviceCode}, "client_id": {*clientID}}
+	for {
+		select {
+		case <-ctx.Done():
+			return fail("login", errors.New("timed out waiting for the login"))
+		case <-time.After(interval):
+		}
+
+		var token tokenResponse
+		err := postForm(ctx, config.TokenEndpoint, form, &token)
+		switch {
+		case token.Error == "authorization_pending":
+			continue
+		case token.Error == "slow_down":
+			interval += 5 * time.Second
+			continue
+		case err != nil:
+			return fail("login", err)
+		}
+
+		creds := credentials{Issuer: *issuer, ClientID: *clientID}
+		creds.update(token)
+		if err := creds.save(); err != nil {
+			return fail("login", err)
+		}
+		fmt.Fprintln(stdout, "logged in")
+		return 0
+	}
+}
+
+// loadCredentials loads the credentials saved by 'cmctl login', refreshing the access token if it expired
+func loadCredentials(ctx context.Context) (*credentials, error) {
+	path, err := credentialsPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.New("no token, run 'cmctl login' or set --token")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read credentials: %w", err)
+	}
+
+	var creds credentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials %s: %w", path, err)
+	}
+	if time.Now().Before(creds.Expiry.Add(-30 * time.Second)) {
+		return &creds, nil
+	}
+	if creds.RefreshToken == "" {
+		return nil, errors.New("the token expired, run 'cmctl login' again")
+	}
+
+	config, err := discover(ctx, creds.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	var token tokenResponse
+	form := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {creds.RefreshToken}, "client_id": {creds.ClientID}}
+	if err := postForm(ctx, config.TokenEndpoint, form, &token); err != nil {
+		return nil, fmt.Errorf("failed to refresh the token, run 'cmctl login' again: %w", err)
+	}
+	creds.update(token)
+	if err := creds.save(); err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
+
+// update updates the credentials with the tokens of a token response
+func (c *credentials) update(token tokenResponse) {
+	c.AccessToken = token.AccessToken
+	if token.RefreshToken != "" {
+		c.RefreshToken = token.RefreshToken
+	}
+	c.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+}
+
+// save saves the credentials, readable by the user only
+func (c *credentials) save() error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create credentials directory: %w", err)
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+	return nil
+}
+
+// credentialsPath returns the path of the credentials file in the user configuration directory
+func credentialsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user configuration directory: %w", err)
+	}
+	return filepath.Join(dir, "cmctl", "credentials.json"), nil
+}
+
+// providerConfig are the endpoints of the OIDC provider used by the device authorization flow
+type providerConfig struct {
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+}
+
+// discover gets the endpoints of the OIDC provider from its well-known configuration
+func discover(ctx context.Context, issuer string) (*providerConfig, error) {
+	endpoint, err := url.JoinPath(issuer, ".well-known/openid-configuration")
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer %q: %w", issuer, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get oidc configuration: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get oidc configuration: %s", resp.Status)
+	}
+
+	var config providerConfig
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse oidc configuration: %w", err)
+	}
+	if config.DeviceAuthorizationEndpoint == "" {
+		return nil, fmt.Errorf("issuer %s does not support the device authorization flow", issuer)
+	}
+	return &config, nil
+}
+
+// postForm posts the form to the endpoint and decodes the JSON response into the target, also if the status is not
+// successful so that the OAuth 2.0 errors can be handled; it returns an error if the status is not successful
+func postForm(ctx context.Context, endpoint string, form url.Values, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("%s: %s", resp.Status, body)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", resp.Status, body)
+	}
+	return nil
+}
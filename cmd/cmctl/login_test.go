@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// oidcProvider is an OIDC provider serving the device authorization flow and the refresh of tokens
+type oidcProvider struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// pending is the number of polls of the token endpoint answered with authorization_pending
+	pending int
+	// forms are the forms posted to the token endpoint
+	forms []map[string]string
+	// refreshStatus is the status of the refresh token grants, refreshed is OK
+	refreshStatus int
+}
+
+func newOIDCProvider(t *testing.T, pending int) *oidcProvider {
+	provider := &oidcProvider{pending: pending, refreshStatus: http.StatusOK}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, providerConfig{
+			DeviceAuthorizationEndpoint: provider.URL + "/device",
+			TokenEndpoint:               provider.URL + "/token",
+		})
+	})
+	mux.HandleFunc("POST /device", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "cmctl", r.PostForm.Get("client_id"))
+		writeJSON(w, http.StatusOK, map[string]any{
+			"device_code":               "device-1",
+			"user_code":                 "ABCD-EFGH",
+			"verification_uri":          provider.URL + "/activate",
+			"verification_uri_complete": provider.URL + "/activate?user_code=ABCD-EFGH",
+			"interval":                  0,
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form := map[string]string{}
+		for key := range r.PostForm {
+			form[key] = r.PostForm.Get(key)
+		}
+
+		provider.mu.Lock()
+		defer provider.mu.Unlock()
+		provider.forms = append(provider.forms, form)
+		switch form["grant_type"] {
+		case deviceCodeGrantType:
+			if provider.pending > 0 {
+				provider.pending--
+				writeJSON(w, http.StatusBadRequest, tokenResponse{Error: "authorization_pending"})
+				return
+			}
+			writeJSON(w, http.StatusOK, tokenResponse{AccessToken: "access-1", RefreshToken: "refresh-1", ExpiresIn: 300})
+		case "refresh_token":
+			if provider.refreshStatus != http.StatusOK {
+				writeJSON(w, provider.refreshStatus, tokenResponse{Error: "invalid_grant", Description: "Token is not active"})
+				return
+			}
+			writeJSON(w, http.StatusOK, tokenResponse{AccessToken: "access-2", ExpiresIn: 300})
+		default:
+			writeJSON(w, http.StatusBadRequest, tokenResponse{Error: "unsupported_grant_type"})
+		}
+	})
+	provider.Server = httptest.NewServer(mux)
+	t.Cleanup(provider.Close)
+	return provider
+}
+
+func (p *oidcProvider) tokenRequests() []map[string]string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.forms
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// useConfigDir makes the user configuration directory, which the credentials are saved in, a temporary directory
+func useConfigDir(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+}
+
+func readCredentials(t *testing.T) credentials {
+	path, err := credentialsPath()
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var creds credentials
+	require.NoError(t, json.Unmarshal(data, &creds))
+	return creds
+}
+
+func TestLogin(t *testing.T) {
+	original := minPollInterval
+	minPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { minPollInterval = original })
+
+	t.Run("tokens are saved once the user logged in", func(t *testing.T) {
+		useConfigDir(t)
+		out := captureStdout(t)
+		provider := newOIDCProvider(t, 2)
+
+		require.Equal(t, 0, run([]string{"login", "--issuer", provider.URL, "--client-id", "cmctl"}))
+		require.Equal(t, "logged in\n", out.String())
+
+		// the token endpoint is polled until the user logged in
+		requests := provider.tokenRequests()
+		require.Len(t, requests, 3)
+		for _, form := range requests {
+			require.Equal(t, map[string]string{"grant_type": deviceCodeGrantType, "device_code": "device-1", "client_id": "cmctl"}, form)
+		}
+
+		creds := readCredentials(t)
+		require.Equal(t, provider.URL, creds.Issuer)
+		require.Equal(t, "cmctl", creds.ClientID)
+		require.Equal(t, "access-1", creds.AccessToken)
+		require.Equal(t, "refresh-1", creds.RefreshToken)
+		require.WithinDuration(t, time.Now().Add(300*time.Second), creds.Expiry, 10*time.Second)
+	})
+
+	t.Run("login times out", func(t *testing.T) {
+		useConfigDir(t)
+		out := captureStdout(t)
+		provider := newOIDCProvider(t, 1000)
+
+		require.Equal(t, 1, run([]string{"login", "--issuer", provider.URL, "--client-id", "cmctl", "--timeout", "100ms"}))
+		require.Empty(t, out.String())
+		path, err := credentialsPath()
+		require.NoError(t, err)
+		require.NoFileExists(t, path)
+	})
+
+	t.Run("issuer without device authorization", func(t *testing.T) {
+		useConfigDir(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, providerConfig{TokenEndpoint: "http://" + r.Host + "/token"})
+		}))
+		t.Cleanup(server.Close)
+
+		require.Equal(t, 1, run([]string{"login", "--issuer", server.URL}))
+	})
+
+	t.Run("issuer is required", func(t *testing.T) {
+		t.Setenv("CMCTL_ISSUER", "")
+		require.Equal(t, 2, run([]string{"login"}))
+	})
+}
+
+func TestLoadCredentials(t *testing.T) {
+	save := func(t *testing.T, creds credentials) {
+		require.NoError(t, creds.save())
+	}
+
+	t.Run("valid token is not refreshed", func(t *testing.T) {
+		useConfigDir(t)
+		provider := newOIDCProvider(t, 0)
+		save(t, credentials{Issuer: provider.URL, ClientID: "cmctl", AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: time.Now().Add(time.Hour)})
+
+		creds, err := loadCredentials(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "access-1", creds.AccessToken)
+		require.Empty(t, provider.tokenRequests())
+	})
+
+	t.Run("expired token is refreshed and saved", func(t *testing.T) {
+		useConfigDir(t)
+		provider := newOIDCProvider(t, 0)
+		// tokens expiring within 30 seconds are refreshed as well
+		save(t, credentials{Issuer: provider.URL, ClientID: "cmctl", AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: time.Now().Add(10 * time.Second)})
+
+		creds, err := loadCredentials(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "access-2", creds.AccessToken)
+		// the refresh token is kept if the provider does not rotate it
+		require.Equal(t, "refresh-1", creds.RefreshToken)
+		require.Equal(t, []map[string]string{{"grant_type": "refresh_token", "refresh_token": "refresh-1", "client_id": "cmctl"}}, provider.tokenRequests())
+
+		saved := readCredentials(t)
+		require.Equal(t, "access-2", saved.AccessToken)
+		require.True(t, saved.Expiry.After(time.Now().Add(time.Minute)))
+	})
+
+	t.Run("rejected refresh", func(t *testing.T) {
+		useConfigDir(t)
+		provider := newOIDCProvider(t, 0)
+		provider.refreshStatus = http.StatusBadRequest
+		save(t, credentials{Issuer: provider.URL, ClientID: "cmctl", AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: time.Now().Add(-time.Minute)})
+
+		_, err := loadCredentials(context.Background())
+		require.ErrorContains(t, err, "run 'cmctl login' again")
+		require.ErrorContains(t, err, "invalid_grant")
+		require.Equal(t, "access-1", readCredentials(t).AccessToken)
+	})
+
+	t.Run("expired token without refresh token", func(t *testing.T) {
+		useConfigDir(t)
+		save(t, credentials{Issuer: "http://127.0.0.1:0", ClientID: "cmctl", AccessToken: "access-1", Expiry: time.Now().Add(-time.Minute)})
+
+		_, err := loadCredentials(context.Background())
+		require.EqualError(t, err, "the token expired, run 'cmctl login' again")
+	})
+
+	t.Run("not logged in", func(t *testing.T) {
+		useConfigDir(t)
+		_, err := loadCredentials(context.Background())
+		require.EqualError(t, err, "no token, run 'cmctl login' or set --token")
+	})
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// cmctl is the command line client of the cluster-manager REST API for the common workflows of the field engineers,
+// e.g. creating a cluster and downloading its kubeconfig, without scripting curl against the API.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// version injected at build time
+var version string
+
+const usage = `cmctl is the command line client of the cluster-manager REST API.
+
+Usage:
+  cmctl login                         Log in to Keycloak with the device authorization flow
+  cmctl clusters list                 List the clusters of the project
+  cmctl clusters create               Create a cluster from a template
+  cmctl clusters delete <name>        Delete a cluster
+  cmctl kubeconfig <name>             Download the kubeconfig of a cluster
+  cmctl templates list                List the templates of the project
+  cmctl templates get <name> <ver>    Get a template
+  cmctl templates import <file>       Import a template, signed with -signature
+  cmctl templates payload <file>      Print the payload to sign of a template
+  cmctl templates delete <name> <ver> Delete a template
+  cmctl version                       Print the version of cmctl
+
+Run 'cmctl <command> -h' for the flags of a command.
+`
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run runs the command of the arguments and returns the exit code
+func run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "login":
+		return login(args[1:])
+	case "clusters":
+		return clusters(args[1:])
+	case "kubeconfig":
+		return kubeconfig(args[1:])
+	case "templates":
+		return templates(args[1:])
+	case "version":
+		fmt.Fprintln(stdout, version)
+		return 0
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "cmctl: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
+
+// parse parses the flags of a command, also after its positional arguments, and returns the positional arguments
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// stringList is a flag that can be repeated
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
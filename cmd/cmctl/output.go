@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// stdout is where the commands print their results, the errors and prompts are printed to os.Stderr so that the
+// results can be piped
+var stdout io.Writer = os.Stdout
+
+// render prints the value as indented JSON, or as a table of the rows with the header
+func render(output string, value any, header []string, rows [][]string) error {
+	if output == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// deref returns the value of the pointer, the zero value if it is nil
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+// message returns the message of the status, empty if it is unknown
+func message(status *api.GenericStatus) string {
+	if status == nil {
+		return ""
+	}
+	return deref(status.Message)
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// captureStdout returns the results the commands print while the test runs
+func captureStdout(t *testing.T) *bytes.Buffer {
+	var out bytes.Buffer
+	original := stdout
+	stdout = &out
+	t.Cleanup(func() { stdout = original })
+	return &out
+}
+
+func TestRender(t *testing.T) {
+	value := map[string]any{"name": "cluster-1", "nodes": 3}
+	header := []string{"NAME", "NODES"}
+
+	tests := []struct {
+		name     string
+		output   string
+		header   []string
+		rows     [][]string
+		expected string
+	}{
+		{
+			name:     "json",
+			output:   "json",
+			header:   header,
+			rows:     [][]string{{"cluster-1", "3"}},
+			expected: "{\n  \"name\": \"cluster-1\",\n  \"nodes\": 3\n}\n",
+		},
+		{
+			name:     "table",
+			output:   "table",
+			header:   header,
+			rows:     [][]string{{"cluster-1", "3"}, {"edge-cluster-2", "12"}},
+			expected: "NAME             NODES\ncluster-1        3\nedge-cluster-2   12\n",
+		},
+		{
+			name:     "table without rows",
+			output:   "table",
+			header:   header,
+			expected: "NAME   NODES\n",
+		},
+		{
+			name:     "unknown output is a table",
+			output:   "yaml",
+			header:   header,
+			rows:     [][]string{{"cluster-1", "3"}},
+			expected: "NAME        NODES\ncluster-1   3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureStdout(t)
+			require.NoError(t, render(tt.output, value, tt.header, tt.rows))
+			require.Equal(t, tt.expected, out.String())
+		})
+	}
+}
+
+func TestDeref(t *testing.T) {
+	name := "cluster-1"
+	nodes := 3
+
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{name: "string", value: deref(&name), expected: "cluster-1"},
+		{name: "nil string", value: deref[string](nil), expected: ""},
+		{name: "int", value: deref(&nodes), expected: 3},
+		{name: "nil int", value: deref[int](nil), expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.value)
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	text := "2/3 nodes ready"
+
+	tests := []struct {
+		name     string
+		status   *api.GenericStatus
+		expected string
+	}{
+		{name: "status with message", status: &api.GenericStatus{Message: &text}, expected: text},
+		{name: "status without message", status: &api.GenericStatus{}, expected: ""},
+		{name: "unknown status", status: nil, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, message(tt.status))
+		})
+	}
+}
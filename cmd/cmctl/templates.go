@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// templates runs the templates subcommands
+func templates(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "list":
+		return listTemplates(args[1:])
+	case "get":
+		return getTemplate(args[1:])
+	case "import":
+		return importTemplate(args[1:])
+	case "payload":
+		return templatePayload(args[1:])
+	case "delete":
+		return deleteTemplate(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "templates: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
+
+// listTemplates lists the templates of the project
+func listTemplates(args []string) int {
+	var opts options
+	fs := flag.NewFlagSet("templates list", flag.ContinueOnError)
+	opts.register(fs)
+	if _, err := parse(fs, args); err != nil {
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("templates list", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("templates list", err)
+	}
+
+	resp, err := client.GetV2TemplatesWithResponse(ctx, &api.GetV2TemplatesParams{Activeprojectid: opts.projectId()})
+	if err != nil {
+		return fail("templates list", err)
+	}
+	if resp.JSON200 == nil {
+		return fail("templates list", responseError(resp.HTTPResponse, resp.Body))
+	}
+
+	var defaultName, defaultVersion string
+	if resp.JSON200.DefaultTemplateInfo != nil {
+		defaultName = deref(resp.JSON200.DefaultTemplateInfo.Name)
+		defaultVersion = resp.JSON200.DefaultTemplateInfo.Version
+	}
+	templates := deref(resp.JSON200.TemplateInfoList)
+	rows := make([][]string, 0, len(templates))
+	for _, template := range templates {
+		isDefault := ""
+		if template.Name == defaultName && template.Version == defaultVersion {
+			isDefault = "*"
+		}
+		rows = append(rows, []string{
+			template.Name,
+			template.Version,
+			template.KubernetesVersion,
+			string(deref(template.Controlplaneprovidertype)),
+			string(deref(template.Infraprovidertype)),
+			string(deref(template.LifecycleState)),
+			isDefault,
+		})
+	}
+	header := []string{"NAME", "VERSION", "KUBERNETES", "CONTROL PLANE", "INFRA", "STATE", "DEFAULT"}
+	if err := render(opts.output, resp.JSON200, header, rows); err != nil {
+		return fail("templates list", err)
+	}
+	return 0
+}
+
+// getTemplate gets a template, always as JSON as templates do not fit in a table
+func getTemplate(args []string) int {
+	var opts options
+	fs := flag.NewFlagSet("templates get", flag.ContinueOnError)
+	opts.register(fs)
+	positional, err := parse(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 2 {
+		fmt.Fprintln(os.Stderr, "templates get: the name and version of the template are required")
+		fs.Usage()
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("templates get", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("templates get", err)
+	}
+
+	params := &api.GetV2TemplatesNameVersionParams{Activeprojectid: opts.projectId()}
+	resp, err := client.GetV2TemplatesNameVersionWithResponse(ctx, positional[0], positional[1], params)
+	if err != nil {
+		return fail("templates get", err)
+	}
+	if resp.JSON200 == nil {
+		return fail("templates get", responseError(resp.HTTPResponse, resp.Body))
+	}
+	if err := render("json", resp.JSON200, nil, nil); err != nil {
+		return fail("templates get", err)
+	}
+	return 0
+}
+
+// importTemplate imports a template of a JSON or YAML file
+func importTemplate(args []string) int {
+	var opts options
+	fs := flag.NewFlagSet("templates import", flag.ContinueOnError)
+	opts.register(fs)
+	idempotencyKey := fs.String("idempotency-key", "", "The key of the request, e.g. a UUID; running the command again with the same key succeeds instead of failing because the template exists")
+	signatureFile := fs.String("signature", "", "The file with the base64 encoded cosign signature of the payload of the template, see 'cmctl templates payload'")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fmt.Fprintln(os.Stderr, "templates import: the file of the template is required")
+		fs.Usage()
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("templates import", err)
+	}
+
+	data, err := os.ReadFile(positional[0])
+	if err != nil {
+		return fail("templates import", err)
+	}
+	// YAML templates are converted by the API
+	contentType := "application/json"
+	if ext := filepath.Ext(positional[0]); ext == ".yaml" || ext == ".yml" {
+		contentType = "application/yaml"
+	}
+	if *signatureFile != "" {
+		if data, err = signTemplate(positional[0], *signatureFile); err != nil {
+			return fail("templates import", err)
+		}
+		contentType = "application/json"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("templates import", err)
+	}
+
+	params := &api.PostV2TemplatesParams{Activeprojectid: opts.projectId()}
+	if *idempotencyKey != "" {
+		params.IdempotencyKey = idempotencyKey
+	}
+	resp, err := client.PostV2TemplatesWithBodyWithResponse(ctx, params, contentType, bytes.NewReader(data))
+	if err != nil {
+		return fail("templates import", err)
+	}
+	if resp.JSON201 == nil {
+		return fail("templates import", responseError(resp.HTTPResponse, resp.Body))
+	}
+	fmt.Fprintln(stdout, *resp.JSON201)
+	return 0
+}
+
+// templatePayload prints the payload the signature of a template signs, e.g. to sign it with
+// `cosign sign-blob --key cosign.key`; the payload is printed without a trailing newline as it is signed byte by byte
+func templatePayload(args []string) int {
+	fs := flag.NewFlagSet("templates payload", flag.ContinueOnError)
+	positional, err := parse(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fmt.Fprintln(os.Stderr, "templates payload: the file of the template is required")
+		fs.Usage()
+		return 2
+	}
+
+	info, err := readTemplate(positional[0])
+	if err != nil {
+		return fail("templates payload", err)
+	}
+	payload, err := template.SignaturePayload(info)
+	if err != nil {
+		return fail("templates payload", err)
+	}
+	if _, err := stdout.Write(payload); err != nil {
+		return fail("templates payload", err)
+	}
+	return 0
+}
+
+// readTemplate reads the template of a JSON or YAML file
+func readTemplate(path string) (api.TemplateInfo, error) {
+	var info api.TemplateInfo
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return info, err
+	}
+	if err := yaml.Unmarshal(data, &info); err != nil {
+		return info, fmt.Errorf("invalid template %s: %w", path, err)
+	}
+	return info, nil
+}
+
+// signTemplate returns the template of the file as JSON with the signature of the signature file
+func signTemplate(path, signatureFile string) ([]byte, error) {
+	signature, err := os.ReadFile(signatureFile)
+	if err != nil {
+		return nil, err
+	}
+	info, err := readTemplate(path)
+	if err != nil {
+		return nil, err
+	}
+	encoded := strings.TrimSpace(string(signature))
+	info.Signature = &encoded
+	return json.Marshal(info)
+}
+
+// deleteTemplate deletes a template
+func deleteTemplate(args []string) int {
+	var opts options
+	fs := flag.NewFlagSet("templates delete", flag.ContinueOnError)
+	opts.register(fs)
+	positional, err := parse(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 2 {
+		fmt.Fprintln(os.Stderr, "templates delete: the name and version of the template are required")
+		fs.Usage()
+		return 2
+	}
+	if err := opts.validate(); err != nil {
+		return fail("templates delete", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	client, err := opts.client(ctx)
+	if err != nil {
+		return fail("templates delete", err)
+	}
+
+	params := &api.DeleteV2TemplatesNameVersionParams{Activeprojectid: opts.projectId()}
+	resp, err := client.DeleteV2TemplatesNameVersionWithResponse(ctx, positional[0], positional[1], params)
+	if err != nil {
+		return fail("templates delete", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fail("templates delete", responseError(resp.HTTPResponse, resp.Body))
+	}
+	fmt.Fprintf(stdout, "template %s-%s deleted\n", positional[0], positional[1])
+	return 0
+}
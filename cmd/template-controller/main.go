@@ -9,6 +9,8 @@ import (
 	"flag"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -25,9 +27,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	clusterv1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	clusterv1alpha2 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha2"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/controller"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
 	webhookclusterv1alpha1 "github.com/open-edge-platform/cluster-manager/v2/internal/webhook/v1alpha1"
+	webhookclusterv1alpha2 "github.com/open-edge-platform/cluster-manager/v2/internal/webhook/v1alpha2"
 
 	// +kubebuilder:scaffold:imports
 
@@ -35,6 +43,7 @@ import (
 	kthreesbootstrapv1beta2 "github.com/k3s-io/cluster-api-k3s/bootstrap/api/v1beta2"
 	kthreescpv1beta2 "github.com/k3s-io/cluster-api-k3s/controlplane/api/v1beta2"
 	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
+	vspherev1beta1 "sigs.k8s.io/cluster-api-provider-vsphere/apis/v1beta1"
 	kubeadmbootstrapv1beta1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta1"
 	kubeadmcp "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta1"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -50,6 +59,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(clusterv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1alpha2.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 
 	capiSchemeAdders := []func(*runtime.Scheme) error{
@@ -57,6 +67,8 @@ func init() {
 		dockerv1beta1.AddToScheme,
 		// Intel infrastructure provider
 		intelv1alpha1.AddToScheme,
+		// vSphere infrastructure provider
+		vspherev1beta1.AddToScheme,
 		// Kubeadm bootstrap provider
 		kubeadmbootstrapv1beta1.AddToScheme,
 		// Kubeadm control plane provider
@@ -97,6 +109,12 @@ func main() {
 	var enableHTTP2 bool
 	var webhookCertPath string
 	var enableWebhook bool
+	var snapshotJobImage string
+	var deprecationCheckInterval time.Duration
+	var supportMatrixPath string
+	var shadowValidationRules string
+	var reservedNetworks string
+	var templateSignatureKeys string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -111,6 +129,20 @@ func main() {
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.BoolVar(&enableWebhook, "webhook-enabled", false,
 		"enables validating webhook for the cluster template")
+	flag.StringVar(&snapshotJobImage, "snapshot-job-image", controller.DefaultSnapshotJobImage,
+		"The image of the jobs taking and restoring etcd snapshots on the control plane nodes of k3s clusters")
+	flag.DurationVar(&deprecationCheckInterval, "deprecation-check-interval", controller.DefaultDeprecationCheckInterval,
+		"The time between two checks flagging the clusters still using deprecated cluster templates")
+	flag.StringVar(&supportMatrixPath, "support-matrix-config", "",
+		"The file with the Kubernetes versions supported by the control plane providers, overriding the embedded support matrix")
+	flag.StringVar(&shadowValidationRules, "shadow-validation-rules", "",
+		"The validation rules of the webhook whose violations are logged and counted but not enforced, each optionally "+
+			"until the end of its shadow period, e.g. air-gap=2026-12-01,ssh-access")
+	flag.StringVar(&reservedNetworks, "reserved-networks", "",
+		"The comma separated CIDRs of the orchestrator infrastructure networks (gateway networks, management cluster CIDRs) "+
+			"the pod and service networks of cluster templates must not overlap")
+	flag.StringVar(&templateSignatureKeys, "template-signature-keys", "",
+		"The PEM file with the public keys cluster templates must be signed with, templates are not verified if unset")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -191,12 +223,69 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterTemplate")
 		os.Exit(1)
 	}
+	if err = (&controller.BackupReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Snapshotters: map[string]controller.Snapshotter{
+			"KThreesControlPlane": &controller.K3sSnapshotter{Client: mgr.GetClient(), Image: snapshotJobImage},
+		},
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Backup")
+		os.Exit(1)
+	}
+	if err = (&controller.BackupPolicyReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BackupPolicy")
+		os.Exit(1)
+	}
+	if err = (&controller.DeprecatedTemplateChecker{
+		Client:   mgr.GetClient(),
+		Interval: deprecationCheckInterval,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create runnable", "runnable", "DeprecatedTemplateChecker")
+		os.Exit(1)
+	}
 	if enableWebhook {
 		setupLog.Info("enabling webhook for ClusterTemplate")
-		if err := (&webhookclusterv1alpha1.ClusterTemplateCustomValidator{Client: mgr.GetClient()}).SetupClusterTemplateWebhookWithManager(mgr); err != nil {
+		var matrix *supportmatrix.Matrix
+		if supportMatrixPath != "" {
+			if matrix, err = supportmatrix.Load(supportMatrixPath); err != nil {
+				setupLog.Error(err, "unable to load support matrix", "path", supportMatrixPath)
+				os.Exit(1)
+			}
+		}
+		shadowed, err := validation.ParseShadowed(shadowValidationRules)
+		if err != nil {
+			setupLog.Error(err, "invalid shadowed validation rules", "rules", shadowValidationRules)
+			os.Exit(1)
+		}
+		var reserved network.Reserved
+		if reservedNetworks != "" {
+			if reserved, err = network.ParseReserved(strings.Split(reservedNetworks, ",")); err != nil {
+				setupLog.Error(err, "invalid reserved networks", "networks", reservedNetworks)
+				os.Exit(1)
+			}
+		}
+		var trustedKeys signature.TrustedKeys
+		if templateSignatureKeys != "" {
+			if trustedKeys, err = signature.LoadTrustedKeys(templateSignatureKeys); err != nil {
+				setupLog.Error(err, "unable to load template signature keys", "path", templateSignatureKeys)
+				os.Exit(1)
+			}
+		}
+		validator := &webhookclusterv1alpha1.ClusterTemplateCustomValidator{Client: mgr.GetClient(), SupportMatrix: matrix, Rules: validation.NewRules(shadowed),
+			ReservedNetworks: reserved, TrustedKeys: trustedKeys}
+		if err := validator.SetupClusterTemplateWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "Unable to create webhook", "webhook", "ClusterClass")
 			os.Exit(1)
 		}
+		// the objects of the other API versions are converted to and from the stored v1alpha1 by the webhook server
+		if err := webhookclusterv1alpha2.SetupClusterTemplateWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "Unable to create conversion webhook", "webhook", "ClusterTemplate")
+			os.Exit(1)
+		}
 	}
 	// creates an index to match clusters with a specific cluster class
 	if err := mgr.GetCache().IndexField(context.Background(), &capi.Cluster{},
@@ -6,30 +6,99 @@ package authz
 default allow := false
 
 allow if { # /v2/clusters write access: cl-rw
-    startswith(input.path, "/v2/clusters")
+    clusters_path
     input.method == { "GET", "POST", "PUT", "PATCH", "DELETE" }[_]
 
     # check for '<project_uuid>_cl-rw' role
     role := sprintf("%s_cl-rw", [input.project_id])
     input.roles[_] == role
 } { # /v2/clusters read access: cl-r
-    startswith(input.path, "/v2/clusters")
+    clusters_path
     input.method == { "GET" }[_]
 
     # check for '<project_uuid>_cl-r' role
     role := sprintf("%s_cl-r", [input.project_id])
     input.roles[_] == role
-} { # /v2/templates write access: cl-tpl-rw
-    startswith(input.path, "/v2/templates")
+} { # /v2/templates write access: cl-tpl-rw, publishing and deprecating templates is reserved to cl-tpl-admin
+    templates_path
     input.method == { "GET", "POST", "PUT", "PATCH", "DELETE" }[_]
+    not template_lifecycle_transition
 
     # check for '<project_uuid>_cl-tpl-rw' role
     role := sprintf("%s_cl-tpl-rw", [input.project_id])
     input.roles[_] == role
+} { # /v2/templates admin access including lifecycle transitions: cl-tpl-admin
+    templates_path
+    input.method == { "GET", "POST", "PUT", "PATCH", "DELETE" }[_]
+
+    # check for '<project_uuid>_cl-tpl-admin' role
+    input.roles[_] == sprintf("%s_cl-tpl-admin", [input.project_id])
 } { # /v2/templates read access: cl-tpl-r
-    startswith(input.path, "/v2/templates")
+    templates_path
     input.method == { "GET" }[_]
 
     # check for '<project_uuid>_cl-tpl-r' role
     input.roles[_] == sprintf("%s_cl-tpl-r", [input.project_id])
+} { # /v2/summary read access: cl-r or cl-rw, the summary counts the clusters and the templates they use
+    input.path == "/v2/summary"
+    input.method == { "GET" }[_]
+
+    input.roles[_] == { sprintf("%s_cl-r", [input.project_id]), sprintf("%s_cl-rw", [input.project_id]) }[_]
+} { # /v2/webhooks read access: cl-r or cl-rw, the destinations are managed by the platform administrators
+    startswith(input.path, "/v2/webhooks")
+    input.method == { "GET" }[_]
+
+    input.roles[_] == { sprintf("%s_cl-r", [input.project_id]), sprintf("%s_cl-rw", [input.project_id]) }[_]
+} { # /v2/admin read access: cl-admin
+    startswith(input.path, "/v2/admin/")
+    input.method == { "GET" }[_]
+
+    # admin endpoints are not project scoped, check for 'cl-admin' role
+    input.roles[_] == "cl-admin"
+} { # /v2/docs, /v2/apichangelog and /v2/openapi.json read access: any authenticated user
+    api_documentation
+    input.method == { "GET" }[_]
+} { # /v2/supportmatrix read access: any authenticated user
+    input.path == "/v2/supportmatrix"
+    input.method == { "GET" }[_]
+} { # /v2/extensions read access: any authenticated user
+    input.path == "/v2/extensions"
+    input.method == { "GET" }[_]
+} { # /v2/authz/self read access: any authenticated user, the permissions are evaluated for the active project
+    input.path == "/v2/authz/self"
+    input.method == { "GET" }[_]
+    input.project_id != ""
+}
+
+# clusters_path matches the endpoints that manage the clusters of the project, including the pending clusters and
+# the operations on them
+clusters_path if {
+    startswith(input.path, "/v2/clusters")
+}
+
+clusters_path if {
+    startswith(input.path, "/v2/pending-clusters")
+}
+
+clusters_path if {
+    startswith(input.path, "/v2/operations")
+}
+
+# templates_path matches the endpoints that manage the cluster templates of the project, including their chunked uploads
+templates_path if {
+    startswith(input.path, "/v2/templates")
+}
+
+templates_path if {
+    startswith(input.path, "/v2/template-uploads")
+}
+
+# api_documentation matches the endpoints that document the API, they are not project scoped
+api_documentation if {
+    input.path == { "/v2/docs", "/v2/apichangelog", "/v2/openapi.json" }[_]
+}
+
+# template_lifecycle_transition matches the endpoints that publish or deprecate a template version
+template_lifecycle_transition if {
+    regex.match(`^/v2/templates/[^/]+/[^/]+/(publish|deprecate)$`, input.path)
 }
@@ -143,4 +143,171 @@ test_write_templates_deny_roles_clusters if {
 
 test_write_templates_deny_project if {
     not authz.allow with input as {"path": "/v2/templates", "method": "DELETE", "project_id": "123", "roles": ["456_cl-tpl-rw"]}
-}
\ No newline at end of file
+}
+
+# template lifecycle
+test_template_publish_allow_tpl_admin if {
+    authz.allow with input as {"path": "/v2/templates/baseline/v1.0.0/publish", "method": "POST", "project_id": "123", "roles": ["123_cl-tpl-admin"]}
+}
+
+test_template_deprecate_allow_tpl_admin if {
+    authz.allow with input as {"path": "/v2/templates/baseline/v1.0.0/deprecate", "method": "POST", "project_id": "123", "roles": ["123_cl-tpl-admin"]}
+}
+
+test_template_publish_deny_tpl_rw if {
+    not authz.allow with input as {"path": "/v2/templates/baseline/v1.0.0/publish", "method": "POST", "project_id": "123", "roles": ["123_cl-tpl-rw"]}
+}
+
+test_template_deprecate_deny_tpl_rw if {
+    not authz.allow with input as {"path": "/v2/templates/baseline/v1.0.0/deprecate", "method": "POST", "project_id": "123", "roles": ["123_cl-tpl-rw"]}
+}
+
+test_template_publish_deny_tpl_admin_project if {
+    not authz.allow with input as {"path": "/v2/templates/baseline/v1.0.0/publish", "method": "POST", "project_id": "123", "roles": ["456_cl-tpl-admin"]}
+}
+
+test_template_create_allow_tpl_rw if {
+    authz.allow with input as {"path": "/v2/templates", "method": "POST", "project_id": "123", "roles": ["123_cl-tpl-rw"]}
+}
+
+# webhooks
+test_webhook_destinations_allow_r_get if {
+    authz.allow with input as {"path": "/v2/webhooks/destinations", "method": "GET", "project_id": "123", "roles": ["123_cl-r"]}
+}
+
+test_webhook_destinations_allow_rw_get if {
+    authz.allow with input as {"path": "/v2/webhooks/destinations", "method": "GET", "project_id": "123", "roles": ["123_cl-rw"]}
+}
+
+test_webhook_destinations_deny_other_project if {
+    not authz.allow with input as {"path": "/v2/webhooks/destinations", "method": "GET", "project_id": "123", "roles": ["456_cl-rw"]}
+}
+
+test_webhook_destinations_deny_rw_put if {
+    not authz.allow with input as {"path": "/v2/webhooks/destinations", "method": "PUT", "project_id": "123", "roles": ["123_cl-rw"]}
+}
+
+# pending clusters
+test_pending_clusters_allow_r_get if {
+    authz.allow with input as {"path": "/v2/pending-clusters", "method": "GET", "project_id": "123", "roles": ["123_cl-r"]}
+}
+
+test_pending_clusters_deny_r_delete if {
+    not authz.allow with input as {"path": "/v2/pending-clusters/cluster-1", "method": "DELETE", "project_id": "123", "roles": ["123_cl-r"]}
+}
+
+test_pending_clusters_allow_rw_put if {
+    authz.allow with input as {"path": "/v2/pending-clusters/cluster-1", "method": "PUT", "project_id": "123", "roles": ["123_cl-rw"]}
+}
+
+test_pending_clusters_allow_rw_delete if {
+    authz.allow with input as {"path": "/v2/pending-clusters/cluster-1", "method": "DELETE", "project_id": "123", "roles": ["123_cl-rw"]}
+}
+
+test_pending_clusters_deny_project if {
+    not authz.allow with input as {"path": "/v2/pending-clusters", "method": "GET", "project_id": "123", "roles": ["456_cl-rw"]}
+}
+
+# operations
+test_operations_allow_r_get if {
+    authz.allow with input as {"path": "/v2/operations/op-1", "method": "GET", "project_id": "123", "roles": ["123_cl-r"]}
+}
+
+test_operations_deny_r_delete if {
+    not authz.allow with input as {"path": "/v2/operations/op-1", "method": "DELETE", "project_id": "123", "roles": ["123_cl-r"]}
+}
+
+# summary
+test_summary_allow_r_get if {
+    authz.allow with input as {"path": "/v2/summary", "method": "GET", "project_id": "123", "roles": ["123_cl-r"]}
+}
+
+test_summary_deny_tpl_r_get if {
+    not authz.allow with input as {"path": "/v2/summary", "method": "GET", "project_id": "123", "roles": ["123_cl-tpl-r"]}
+}
+
+# template uploads
+test_template_uploads_allow_tpl_rw_post if {
+    authz.allow with input as {"path": "/v2/template-uploads/64e797f6-db22-445e-b606-4228d4f1c2bd/chunks", "method": "POST", "project_id": "123", "roles": ["123_cl-tpl-rw"]}
+}
+
+test_template_uploads_allow_tpl_r_get if {
+    authz.allow with input as {"path": "/v2/template-uploads/64e797f6-db22-445e-b606-4228d4f1c2bd", "method": "GET", "project_id": "123", "roles": ["123_cl-tpl-r"]}
+}
+
+test_template_uploads_deny_tpl_r_commit if {
+    not authz.allow with input as {"path": "/v2/template-uploads/64e797f6-db22-445e-b606-4228d4f1c2bd/commit", "method": "POST", "project_id": "123", "roles": ["123_cl-tpl-r"]}
+}
+
+test_template_uploads_deny_cluster_rw if {
+    not authz.allow with input as {"path": "/v2/template-uploads", "method": "POST", "project_id": "123", "roles": ["123_cl-rw"]}
+}
+
+# admin
+test_admin_exports_allow_admin_get if {
+    authz.allow with input as {"path": "/v2/admin/exports/123", "method": "GET", "project_id": "", "roles": ["cl-admin"]}
+}
+
+test_admin_exports_deny_admin_delete if {
+    not authz.allow with input as {"path": "/v2/admin/exports/123", "method": "DELETE", "project_id": "", "roles": ["cl-admin"]}
+}
+
+test_admin_exports_deny_project_rw if {
+    not authz.allow with input as {"path": "/v2/admin/exports/123", "method": "GET", "project_id": "123", "roles": ["123_cl-rw"]}
+}
+
+test_admin_support_bundles_allow_admin_get if {
+    authz.allow with input as {"path": "/v2/admin/support-bundles/123/clusters/cluster-1", "method": "GET", "project_id": "", "roles": ["cl-admin"]}
+}
+
+test_admin_support_bundles_deny_project_rw if {
+    not authz.allow with input as {"path": "/v2/admin/support-bundles/123/clusters/cluster-1", "method": "GET", "project_id": "123", "roles": ["123_cl-rw"]}
+}
+
+# api documentation
+test_docs_allow_authenticated_get if {
+    authz.allow with input as {"path": "/v2/docs", "method": "GET", "project_id": "", "roles": []}
+}
+
+test_apichangelog_allow_authenticated_get if {
+    authz.allow with input as {"path": "/v2/apichangelog", "method": "GET", "project_id": "", "roles": []}
+}
+
+test_apichangelog_deny_post if {
+    not authz.allow with input as {"path": "/v2/apichangelog", "method": "POST", "project_id": "", "roles": ["cl-admin"]}
+}
+
+test_openapi_document_allow_authenticated_get if {
+    authz.allow with input as {"path": "/v2/openapi.json", "method": "GET", "project_id": "", "roles": []}
+}
+
+# support matrix
+test_supportmatrix_allow_authenticated_get if {
+    authz.allow with input as {"path": "/v2/supportmatrix", "method": "GET", "project_id": "", "roles": []}
+}
+
+test_supportmatrix_deny_put if {
+    not authz.allow with input as {"path": "/v2/supportmatrix", "method": "PUT", "project_id": "", "roles": ["cl-admin"]}
+}
+
+# extension catalog
+test_extensions_allow_authenticated_get if {
+    authz.allow with input as {"path": "/v2/extensions", "method": "GET", "project_id": "", "roles": []}
+}
+
+test_extensions_deny_post if {
+    not authz.allow with input as {"path": "/v2/extensions", "method": "POST", "project_id": "", "roles": ["cl-admin"]}
+}
+
+# effective permissions of the caller
+test_authz_self_allow_authenticated_get if {
+    authz.allow with input as {"path": "/v2/authz/self", "method": "GET", "project_id": "123", "roles": []}
+}
+
+test_authz_self_deny_no_project if {
+    not authz.allow with input as {"path": "/v2/authz/self", "method": "GET", "project_id": "", "roles": []}
+}
+
+test_authz_self_deny_post if {
+    not authz.allow with input as {"path": "/v2/authz/self", "method": "POST", "project_id": "123", "roles": ["123_cl-rw"]}
+}
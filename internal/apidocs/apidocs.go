@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apidocs provides the documentation of the Cluster Manager REST API: the Swagger UI, the OpenAPI document and
+// the API changelog
+package apidocs
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// openAPIVersion is the version of the OpenAPI specification of the served document
+const openAPIVersion = "3.1.0"
+
+// problemSchemas are the schemas of the problem details of the error responses, whose codes are enumerated
+var problemSchemas = []string{"ProblemDetails", "NodeValidationProblem"}
+
+var (
+	//go:embed changelog.yaml
+	changelogYAML []byte
+
+	//go:embed swaggerui.html
+	swaggerUIHTML string
+
+	swaggerUITemplate = template.Must(template.New("swaggerui").Parse(swaggerUIHTML))
+)
+
+// Changelog returns the changelog of the API, most recent version first
+var Changelog = sync.OnceValues(func() (api.ApiChangelog, error) {
+	var changelog api.ApiChangelog
+	if err := yaml.UnmarshalStrict(changelogYAML, &changelog); err != nil {
+		return api.ApiChangelog{}, fmt.Errorf("failed to parse api changelog: %w", err)
+	}
+	return changelog, nil
+})
+
+// SwaggerUI returns the Swagger UI page with the OpenAPI specification of the API embedded
+// The Swagger UI assets are loaded from a CDN by the browser
+var SwaggerUI = sync.OnceValues(func() ([]byte, error) {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get swagger spec: %w", err)
+	}
+	// requests are sent to the server that serves the page
+	swagger.Servers = nil
+
+	var page bytes.Buffer
+	if err := swaggerUITemplate.Execute(&page, map[string]any{"Title": swagger.Info.Title, "Spec": swagger}); err != nil {
+		return nil, fmt.Errorf("failed to render swagger ui: %w", err)
+	}
+	return page.Bytes(), nil
+})
+
+// OpenAPIDocument returns the OpenAPI document of the API in OpenAPI 3.1, with the codes of the problem details of the
+// error responses enumerated. The specification is written in OpenAPI 3.0, which the request validator and the code
+// generator support; nullable, which OpenAPI 3.1 replaced by the null type, is converted.
+var OpenAPIDocument = sync.OnceValues(func() (map[string]any, error) {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get swagger spec: %w", err)
+	}
+	// requests are sent to the server that serves the document
+	swagger.Servers = nil
+
+	data, err := json.Marshal(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal swagger spec: %w", err)
+	}
+	var document map[string]any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal swagger spec: %w", err)
+	}
+	document["openapi"] = openAPIVersion
+	convertNullable(document)
+
+	codes := []any{}
+	for _, code := range messages.Codes() {
+		codes = append(codes, string(code))
+	}
+	for _, name := range problemSchemas {
+		code, ok := nestedMap(document, "components", "schemas", name, "properties", "code")
+		if !ok {
+			return nil, fmt.Errorf("schema %s has no code", name)
+		}
+		code["enum"] = codes
+	}
+	return document, nil
+})
+
+// convertNullable replaces the nullable schemas of the document by schemas whose type includes null
+func convertNullable(node any) {
+	switch node := node.(type) {
+	case map[string]any:
+		if nullable, ok := node["nullable"].(bool); ok {
+			if typ, ok := node["type"].(string); ok && nullable {
+				node["type"] = []any{typ, "null"}
+			}
+			delete(node, "nullable")
+		}
+		for _, child := range node {
+			convertNullable(child)
+		}
+	case []any:
+		for _, child := range node {
+			convertNullable(child)
+		}
+	}
+}
+
+// nestedMap returns the object at the given path of the document
+func nestedMap(node map[string]any, path ...string) (map[string]any, bool) {
+	for _, key := range path {
+		child, ok := node[key].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		node = child
+	}
+	return node, true
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package apidocs
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestChangelog(t *testing.T) {
+	changelog, err := Changelog()
+	require.NoError(t, err)
+	require.NotEmpty(t, changelog.Releases)
+
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+	require.Equal(t, swagger.Info.Version, changelog.Releases[0].Version, "the changelog must cover the current api version")
+
+	versions := map[string]bool{}
+	deprecated := map[string]bool{}
+	for _, release := range changelog.Releases {
+		require.False(t, versions[release.Version], "duplicate release %s", release.Version)
+		versions[release.Version] = true
+
+		for _, change := range release.Changes {
+			require.NotEmpty(t, change.Description)
+			require.Equal(t, change.Method == nil, change.Path == nil, "method and path must be set together: %s", change.Description)
+			if change.Path == nil {
+				continue
+			}
+
+			// removed endpoints are no longer part of the spec
+			if change.Type == api.ApiChangeTypeRemoved {
+				continue
+			}
+			path := swagger.Paths.Find(*change.Path)
+			require.NotNil(t, path, "unknown path %s", *change.Path)
+			require.NotNil(t, path.GetOperation(*change.Method), "unknown operation %s %s", *change.Method, *change.Path)
+
+			if change.Type == api.ApiChangeTypeDeprecated {
+				deprecated[*change.Method+" "+*change.Path] = true
+			}
+		}
+	}
+
+	// every deprecated endpoint is announced in the changelog
+	for path, item := range swagger.Paths.Map() {
+		if strings.HasPrefix(path, "/v2/projects/") {
+			continue
+		}
+		for method, op := range item.Operations() {
+			if op.Deprecated {
+				require.True(t, deprecated[method+" "+path], "deprecation of %s %s is missing from the changelog", method, path)
+			}
+		}
+	}
+}
+
+func TestSwaggerUI(t *testing.T) {
+	page, err := SwaggerUI()
+	require.NoError(t, err)
+
+	require.Contains(t, string(page), "<title>Cluster Manager 2.0</title>")
+	require.Contains(t, string(page), `"operationId":"GetV2Docs"`)
+	require.NotContains(t, string(page), `"servers"`)
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	document, err := OpenAPIDocument()
+	require.NoError(t, err)
+
+	require.Equal(t, "3.1.0", document["openapi"])
+	require.NotContains(t, document, "servers")
+
+	data, err := json.Marshal(document)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), `"nullable"`)
+	require.Contains(t, string(data), `"type":["string","null"]`)
+
+	for _, name := range problemSchemas {
+		code, ok := nestedMap(document, "components", "schemas", name, "properties", "code")
+		require.True(t, ok, "schema %s has no code", name)
+		require.Contains(t, code["enum"], string(messages.TemplateNotFound))
+	}
+}
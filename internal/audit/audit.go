@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records the mutating requests of the REST API as a tamper-evident trail: every record carries the hash
+// of the previous record of the same instance and project, so removing or changing a record breaks the chain
+package audit
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// MaxRequestSize is the size of the largest request body that is included in an audit record
+const MaxRequestSize = 64 * 1024
+
+// Record is the audit record of a mutating request
+type Record struct {
+	// Time is when the request was completed
+	Time time.Time `json:"time"`
+	// Instance is the cluster-manager instance that served the request, the hash chains are kept per instance and project
+	Instance string `json:"instance"`
+	// Actor is the user that sent the request according to its token
+	Actor string `json:"actor"`
+	// ProjectID is the project the request acted upon, empty for requests that are not project scoped
+	ProjectID string `json:"projectId,omitempty"`
+	// Verb is the HTTP method of the request
+	Verb string `json:"verb"`
+	// Resource is the path of the request
+	Resource string `json:"resource"`
+	// Request is the JSON body of the request, i.e. the requested change, omitted if larger than MaxRequestSize
+	Request json.RawMessage `json:"request,omitempty"`
+	// Status is the status code of the response
+	Status int `json:"status"`
+	// PrevHash is the hash of the previous record of the instance and project
+	PrevHash string `json:"prevHash"`
+	// Hash is the hash of the record including PrevHash
+	Hash string `json:"hash"`
+}
+
+// hash returns the hash of the record without its Hash field
+func (r Record) hash() (string, error) {
+	r.Hash = ""
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Sink stores or forwards audit records
+type Sink interface {
+	Write(ctx context.Context, record Record) error
+}
+
+// Logger chains the audit records and writes them to its sinks
+type Logger struct {
+	instance string
+	sinks    []Sink
+
+	mu         sync.Mutex
+	prevHashes map[string]string
+	now        func() time.Time
+}
+
+// NewLogger creates a new Logger writing to the given sinks, the records are attributed to the host name of the instance
+func NewLogger(sinks ...Sink) *Logger {
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = "unknown"
+	}
+	return &Logger{instance: instance, sinks: sinks, prevHashes: map[string]string{}, now: time.Now}
+}
+
+// Log chains the record to the previous records and writes it to all sinks
+// The request the record belongs to is already served, so failures to write it are only logged
+func (l *Logger) Log(ctx context.Context, record Record) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record.Time = l.now().UTC()
+	record.Instance = l.instance
+	record.PrevHash = l.prevHashes[record.ProjectID]
+
+	hash, err := record.hash()
+	if err != nil {
+		slog.Error("failed to hash audit record", "error", err, "verb", record.Verb, "resource", record.Resource)
+		return
+	}
+	record.Hash = hash
+	l.prevHashes[record.ProjectID] = hash
+
+	// the sinks are written while holding the lock so that they receive the records in chain order
+	for _, sink := range l.sinks {
+		if err := sink.Write(ctx, record); err != nil {
+			slog.Error("failed to write audit record", "error", err, "verb", record.Verb, "resource", record.Resource)
+		}
+	}
+}
+
+// Verify checks the hash chains of the audit records read from r, one JSON record per line
+// Records of different instances and projects may be interleaved, each chain is verified separately
+func Verify(r io.Reader) error {
+	prevHashes := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*MaxRequestSize)
+	for line := 1; scanner.Scan(); line++ {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return fmt.Errorf("invalid audit record on line %d: %w", line, err)
+		}
+
+		hash, err := record.hash()
+		if err != nil {
+			return fmt.Errorf("failed to hash audit record on line %d: %w", line, err)
+		}
+		if hash != record.Hash {
+			return fmt.Errorf("audit record on line %d was modified", line)
+		}
+		chain := record.Instance + "/" + record.ProjectID
+		if prevHash, ok := prevHashes[chain]; ok && prevHash != record.PrevHash {
+			return fmt.Errorf("audit records of instance %s before line %d are missing", record.Instance, line)
+		}
+		prevHashes[chain] = record.Hash
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+func newTestLogger(sinks ...Sink) *Logger {
+	logger := NewLogger(sinks...)
+	logger.instance = "cluster-manager-0"
+	logger.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	return logger
+}
+
+func TestLoggerChainsRecords(t *testing.T) {
+	var out bytes.Buffer
+	logger := newTestLogger(NewWriterSink(&out))
+
+	logger.Log(context.Background(), Record{Actor: "alice", ProjectID: projectID, Verb: "POST", Resource: "/v2/clusters", Request: json.RawMessage(`{"name": "cluster-1"}`), Status: 201})
+	logger.Log(context.Background(), Record{Actor: "admin", Verb: "POST", Resource: "/v2/admin/something", Status: 200})
+	logger.Log(context.Background(), Record{Actor: "bob", ProjectID: projectID, Verb: "DELETE", Resource: "/v2/clusters/cluster-1", Status: 204})
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+
+	var records []Record
+	for _, line := range lines {
+		var record Record
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	require.Equal(t, "cluster-manager-0", records[0].Instance)
+	require.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), records[0].Time)
+	require.Empty(t, records[0].PrevHash)
+	require.Empty(t, records[1].PrevHash, "the chains are kept per project")
+	require.Equal(t, records[0].Hash, records[2].PrevHash)
+
+	require.NoError(t, Verify(strings.NewReader(out.String())))
+}
+
+func TestVerify(t *testing.T) {
+	var out bytes.Buffer
+	logger := newTestLogger(NewWriterSink(&out))
+	for _, actor := range []string{"alice", "bob", "carol"} {
+		logger.Log(context.Background(), Record{Actor: actor, ProjectID: projectID, Verb: "POST", Resource: "/v2/clusters", Status: 201})
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+
+	t.Run("modified record", func(t *testing.T) {
+		modified := strings.Join([]string{lines[0], strings.Replace(lines[1], `"bob"`, `"mallory"`, 1), lines[2]}, "\n")
+		require.EqualError(t, Verify(strings.NewReader(modified)), "audit record on line 2 was modified")
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		removed := strings.Join([]string{lines[0], lines[2]}, "\n")
+		require.EqualError(t, Verify(strings.NewReader(removed)), "audit records of instance cluster-manager-0 before line 2 are missing")
+	})
+
+	t.Run("invalid record", func(t *testing.T) {
+		require.ErrorContains(t, Verify(strings.NewReader("not json")), "invalid audit record on line 1")
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink := NewFileSink(dir)
+	logger := newTestLogger(sink)
+
+	logger.Log(context.Background(), Record{Actor: "alice", ProjectID: projectID, Verb: "POST", Resource: "/v2/clusters", Status: 201})
+	logger.Log(context.Background(), Record{Actor: "mallory", ProjectID: "../../etc/passwd", Verb: "POST", Resource: "/v2/clusters", Status: 400})
+	logger.Log(context.Background(), Record{Actor: "bob", ProjectID: projectID, Verb: "DELETE", Resource: "/v2/clusters/cluster-1", Status: 204})
+
+	logs, err := sink.AuditLogs(context.Background(), projectID)
+	require.NoError(t, err)
+	require.Equal(t, 2, bytes.Count(logs, []byte("\n")))
+	require.NoError(t, Verify(bytes.NewReader(logs)))
+
+	// records with invalid project ids are kept with the platform records
+	logs, err = sink.AuditLogs(context.Background(), "")
+	require.NoError(t, err)
+	require.Contains(t, string(logs), "mallory")
+
+	logs, err = sink.AuditLogs(context.Background(), "64e797f6-db22-445e-b606-4228d4f1c2bd")
+	require.NoError(t, err)
+	require.Empty(t, logs)
+}
+
+func TestKafkaSink(t *testing.T) {
+	var received kafkaRecords
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/topics/cluster-manager-audit", r.URL.Path)
+		require.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := NewKafkaSink(server.URL, "cluster-manager-audit")
+	record := Record{Actor: "alice", ProjectID: projectID, Verb: "POST", Resource: "/v2/clusters", Status: 201, Hash: "abc"}
+	require.NoError(t, sink.Write(context.Background(), record))
+	require.Equal(t, kafkaRecords{Records: []kafkaRecord{{Key: projectID, Value: record}}}, received)
+
+	status = http.StatusInternalServerError
+	require.ErrorContains(t, sink.Write(context.Background(), record), "failed to produce audit record: 500 Internal Server Error")
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WriterSink writes the audit records as JSON lines, e.g. to stdout
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink creates a new WriterSink writing to w
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Write writes the record as a JSON line
+func (s *WriterSink) Write(_ context.Context, record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return json.NewEncoder(s.w).Encode(record)
+}
+
+// platformLog is the file of the audit records that are not project scoped
+const platformLog = "platform.log"
+
+// FileSink appends the audit records as JSON lines to a file per project in a directory, e.g. a persistent volume
+// It also provides the audit logs of a project to its export bundle on offboarding
+type FileSink struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileSink creates a new FileSink writing to the given directory
+func NewFileSink(dir string) *FileSink {
+	return &FileSink{dir: dir}
+}
+
+// Write appends the record to the file of its project
+func (s *FileSink) Write(_ context.Context, record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	f, err := os.OpenFile(s.path(record.ProjectID), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close() // nolint: errcheck
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return f.Close()
+}
+
+// AuditLogs returns the audit log of the given project, empty if the project has no audit records
+func (s *FileSink) AuditLogs(_ context.Context, projectID string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(projectID))
+	if errors.Is(err, os.ErrNotExist) {
+		return []byte{}, nil
+	}
+	return data, err
+}
+
+// path returns the audit log file of the given project
+func (s *FileSink) path(projectID string) string {
+	// project ids are only used as file names if they are valid uuids
+	if _, err := uuid.Parse(projectID); err != nil {
+		return filepath.Join(s.dir, platformLog)
+	}
+	return filepath.Join(s.dir, projectID+".log")
+}
+
+// KafkaSink produces the audit records to a Kafka topic through a Kafka REST Proxy, keyed by project so that the
+// records of a project stay ordered
+type KafkaSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewKafkaSink creates a new KafkaSink producing to the given topic of the Kafka REST Proxy at proxyURL
+func NewKafkaSink(proxyURL, topic string) *KafkaSink {
+	return &KafkaSink{
+		url:    proxyURL + "/topics/" + url.PathEscape(topic),
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value Record `json:"value"`
+}
+
+// Write produces the record to the topic
+func (s *KafkaSink) Write(ctx context.Context, record Record) error {
+	data, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: record.ProjectID, Value: record}}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.WithoutCancel(ctx), http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create kafka request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to produce audit record: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to produce audit record: %s: %s", resp.Status, body)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/rsa"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -23,7 +24,7 @@ func TestAuthN(t *testing.T) {
 	validMethod := jwt.SigningMethodPS512
 	invalidMethod := jwt.SigningMethodPS256
 
-	validClaims := jwt.MapClaims{"iss": "not-empty"}
+	validClaims := jwt.MapClaims{"iss": "not-empty", "realm_access": map[string]interface{}{"roles": []string{"test-project_cl-r"}}}
 	noIssClaims := jwt.MapClaims{}
 	expiredClaims := jwt.MapClaims{"iss": "not-empty", "exp": 1}
 	notBeforeClaims := jwt.MapClaims{"iss": "not-empty", "nbf": time.Now().Add(time.Minute).Unix()}
@@ -41,7 +42,9 @@ func TestAuthN(t *testing.T) {
 	constructInput := func(token string) *openapi3filter.AuthenticationInput {
 		return &openapi3filter.AuthenticationInput{RequestValidationInput: &openapi3filter.RequestValidationInput{
 			Request: &http.Request{
-				Header: http.Header{auth.AuthorizationHeaderKey: {token}},
+				Method: http.MethodGet,
+				URL:    &url.URL{Path: "/v2/clusters"},
+				Header: http.Header{auth.AuthorizationHeaderKey: {token}, auth.ActiveProjectIdHeaderKey: {"test-project"}},
 			},
 		}}
 	}
@@ -96,7 +99,7 @@ func TestAuthN(t *testing.T) {
 			expected: "authorization failed: unauthorized",
 		},
 		{
-			name:  "valid token", // no error is expected because authz is not tested here - the provided input to `Authencicate` isn't enough to pass authz
+			name:  "valid token", // the request is allowed by the read-only role of the token, authz is tested separately
 			token: auth.BearerPrefix + validToken,
 			kid:   kid,
 			key:   validTokenPublicKey,
@@ -72,9 +72,13 @@ func TestAuthZ(t *testing.T) {
 		mockedError  error
 	}{
 		{
-			name:  "opa disabled",
-			token: validToken,
-			key:   validTokenPublicKey,
+			name:      "opa disabled",
+			method:    "GET",
+			path:      "/v2/clusters",
+			token:     validToken,
+			key:       validTokenPublicKey,
+			projectId: "test-project",
+			expected:  "authorization failed: authorization denied",
 		},
 		{
 			name:         "no active project id",
@@ -109,13 +113,13 @@ func TestAuthZ(t *testing.T) {
 			mockedResult: &emptyResult,
 		},
 		{
-			name:         "unauthorized",
+			name:         "denied",
 			method:       "GET",
 			path:         "/v2/clusters",
 			token:        validToken,
 			key:          validTokenPublicKey,
 			projectId:    "test-project",
-			expected:     "authorization failed: unauthorized",
+			expected:     "authorization failed: authorization denied",
 			mockedResult: &falseResult,
 		},
 		{
@@ -127,6 +131,14 @@ func TestAuthZ(t *testing.T) {
 			projectId:    "test-project",
 			mockedResult: &trueResult,
 		},
+		{
+			name:         "admin path without active project id",
+			method:       "GET",
+			path:         "/v2/admin/exports/test-project",
+			token:        validToken,
+			key:          validTokenPublicKey,
+			mockedResult: &trueResult,
+		},
 	}
 
 	for _, tc := range cases {
@@ -157,3 +169,121 @@ func TestAuthZ(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthorize(t *testing.T) {
+	mockController := gomock.NewController(gomock.TestReporter(t))
+
+	kid := "test-key"
+	claims := jwt.MapClaims{
+		"iss": "not-empty",
+		"realm_access": map[string]interface{}{
+			"roles": []string{"test-project_cl-r"},
+		},
+	}
+	token, publicKey := signToken(t, newToken(jwt.SigningMethodPS512, map[string]interface{}{"kid": kid}, claims))
+
+	trueResult := opa.OpaResponse_Result{}
+	trueResult.FromOpaResponseResult1(true)
+	falseResult := opa.OpaResponse_Result{}
+	falseResult.FromOpaResponseResult1(false)
+
+	cases := []struct {
+		name     string
+		header   string
+		method   string
+		result   *opa.OpaResponse_Result
+		expected error
+	}{
+		{name: "allowed", header: auth.BearerPrefix + token, method: "DELETE", result: &trueResult},
+		{name: "denied", header: auth.BearerPrefix + token, method: "DELETE", result: &falseResult, expected: auth.ErrAuthorizationDenied},
+		{name: "opa disabled read", header: auth.BearerPrefix + token, method: "GET"},
+		{name: "opa disabled write by read-only role", header: auth.BearerPrefix + token, method: "DELETE", expected: auth.ErrAuthorizationDenied},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockProvider := NewMockProvider(t)
+			mockProvider.On("GetSigningKey", kid).Return(publicKey, nil)
+
+			authenticator, err := auth.NewOidcAuthenticator(mockProvider, nil)
+			assert.NoError(t, err)
+			if tc.result != nil {
+				mockOpa := opa.NewMockClientWithResponsesInterface(mockController)
+				mockOpa.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(
+					context.Background(), "authz", "allow", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&opa.PostV1DataPackageRuleResponse{JSON200: &opa.OpaResponse{Result: *tc.result}}, nil)
+				authenticator, err = auth.NewOidcAuthenticator(mockProvider, mockOpa)
+				assert.NoError(t, err)
+			}
+
+			err = authenticator.Authorize(context.Background(), tc.header, "test-project", tc.method, "/v2/clusters/{name}")
+			if tc.expected != nil {
+				assert.ErrorIs(t, err, tc.expected)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid token", func(t *testing.T) {
+		authenticator, err := auth.NewOidcAuthenticator(NewMockProvider(t), nil)
+		assert.NoError(t, err)
+		assert.ErrorContains(t, authenticator.Authorize(context.Background(), "", "test-project", "GET", "/v2/clusters"), "missing authentication token")
+	})
+}
+
+func TestAuthorizeGenericProvider(t *testing.T) {
+	kid := "test-key"
+	claims := jwt.MapClaims{
+		"iss":    "not-empty",
+		"groups": []string{"test-project_cl-r"},
+	}
+	token, publicKey := signToken(t, newToken(jwt.SigningMethodRS256, map[string]interface{}{"kid": kid}, claims))
+
+	t.Run("roles of the configured claim", func(t *testing.T) {
+		mockProvider := NewMockProvider(t)
+		mockProvider.On("GetSigningKey", kid).Return(publicKey, nil)
+		authenticator, err := auth.NewOidcAuthenticator(mockProvider, nil, auth.WithRolesClaim("groups"), auth.WithSigningMethods("RS256"))
+		assert.NoError(t, err)
+
+		assert.NoError(t, authenticator.Authorize(context.Background(), auth.BearerPrefix+token, "test-project", "GET", "/v2/clusters/{name}"))
+		assert.ErrorIs(t, authenticator.Authorize(context.Background(), auth.BearerPrefix+token, "test-project", "DELETE", "/v2/clusters/{name}"), auth.ErrAuthorizationDenied)
+	})
+
+	t.Run("roles of the keycloak claim by default", func(t *testing.T) {
+		mockProvider := NewMockProvider(t)
+		mockProvider.On("GetSigningKey", kid).Return(publicKey, nil)
+		authenticator, err := auth.NewOidcAuthenticator(mockProvider, nil, auth.WithSigningMethods("RS256"))
+		assert.NoError(t, err)
+
+		assert.ErrorContains(t, authenticator.Authorize(context.Background(), auth.BearerPrefix+token, "test-project", "GET", "/v2/clusters/{name}"), "realm_access.roles claim is missing")
+	})
+
+	t.Run("signing method not accepted", func(t *testing.T) {
+		authenticator, err := auth.NewOidcAuthenticator(NewMockProvider(t), nil, auth.WithRolesClaim("groups"))
+		assert.NoError(t, err)
+
+		assert.ErrorContains(t, authenticator.Authorize(context.Background(), auth.BearerPrefix+token, "test-project", "GET", "/v2/clusters/{name}"), "signing method RS256 is invalid")
+	})
+}
+
+func TestSubject(t *testing.T) {
+	kid := "test-key"
+	token, publicKey := signToken(t, newToken(jwt.SigningMethodPS512, map[string]interface{}{"kid": kid}, jwt.MapClaims{"iss": "not-empty", "sub": "alice"}))
+	forged, _ := signToken(t, newToken(jwt.SigningMethodPS512, map[string]interface{}{"kid": kid}, jwt.MapClaims{"iss": "not-empty", "sub": "mallory"}))
+
+	mockProvider := NewMockProvider(t)
+	mockProvider.On("GetSigningKey", kid).Return(publicKey, nil)
+	authenticator, err := auth.NewOidcAuthenticator(mockProvider, nil)
+	assert.NoError(t, err)
+
+	subject, err := authenticator.Subject(auth.BearerPrefix + token)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", subject)
+
+	_, err = authenticator.Subject(auth.BearerPrefix + forged)
+	assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+
+	_, err = authenticator.Subject("")
+	assert.Error(t, err)
+}
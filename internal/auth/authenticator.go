@@ -15,19 +15,58 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	opa "github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
 )
 
 var (
-	// validSigningMethods is a list of all valid signing methods to verify the jwt
-	validSigningMethods = []string{"PS512"}
+	// defaultSigningMethods are the signing methods of the tokens issued by Keycloak
+	defaultSigningMethods = []string{"PS512"}
+
+	errMissingToken   = errors.New("missing authentication token")
+	errMalformedToken = errors.New("malformed token")
+
+	// ErrAuthorizationDenied is returned when the policy does not allow the request
+	ErrAuthorizationDenied = errors.New("authorization denied")
 )
 
-// NewOidcAuthenticator returns a new OIDC Authenticator
-func NewOidcAuthenticator(provider provider, opa opa.ClientWithResponsesInterface) (*oidcAuthenticator, error) {
-	return &oidcAuthenticator{
-		provider: provider,
-		opa:      opa,
-	}, nil
+// AuthenticatorOption is a functional option for configuring an OIDC Authenticator
+type AuthenticatorOption func(*oidcAuthenticator)
+
+// WithRolesClaim is a functional option for configuring the dot-separated path of the claim the roles are read from,
+// DefaultRolesClaim if not set
+func WithRolesClaim(claim string) AuthenticatorOption {
+	return func(auth *oidcAuthenticator) {
+		if claim != "" {
+			auth.rolesClaim = claim
+		}
+	}
+}
+
+// WithSigningMethods is a functional option for configuring the signing methods the tokens are accepted with, PS512 if
+// not set
+func WithSigningMethods(methods ...string) AuthenticatorOption {
+	return func(auth *oidcAuthenticator) {
+		if len(methods) > 0 {
+			auth.signingMethods = methods
+		}
+	}
+}
+
+// NewOidcAuthenticator returns a new OIDC Authenticator authorizing the requests with the given OPA client, or with the
+// DefaultPolicy if it is nil
+func NewOidcAuthenticator(provider provider, opa opa.ClientWithResponsesInterface, options ...AuthenticatorOption) (*oidcAuthenticator, error) {
+	auth := &oidcAuthenticator{
+		provider:       provider,
+		opa:            opa,
+		policy:         DefaultPolicy,
+		rolesClaim:     DefaultRolesClaim,
+		signingMethods: defaultSigningMethods,
+	}
+	for _, o := range options {
+		o(auth)
+	}
+	return auth, nil
 }
 
 // Authenticate is used as AuthenticationFunc in the openapi3filter
@@ -37,8 +76,9 @@ func (auth oidcAuthenticator) Authenticate(ctx context.Context, input *openapi3f
 		return errors.New("authorization failed: unauthorized")
 	}
 
-	token, err := auth.authn(input.RequestValidationInput.Request)
+	token, err := auth.authn(getAuthHeader(input.RequestValidationInput.Request))
 	if err != nil {
+		metrics.TokenValidationFailureCounter.WithLabelValues(validationFailureReason(err)).Inc()
 		return newAuthError(input, fmt.Errorf("authn: %w", err))
 	}
 
@@ -49,22 +89,45 @@ func (auth oidcAuthenticator) Authenticate(ctx context.Context, input *openapi3f
 	return nil
 }
 
-// authn authenticates the token using the OIDC server
-func (auth oidcAuthenticator) authn(req *http.Request) (*jwt.Token, error) {
-	// extract bearer token from request header
-	bearerToken := getAuthHeader(req)
+// Authorize evaluates whether the token of the Authorization header is allowed a request with the method and path in
+// the project by the same policy the requests are authorized with, without making the request
+func (auth oidcAuthenticator) Authorize(ctx context.Context, authHeader, projectId, method, path string) error {
+	token, err := auth.authn(authHeader)
+	if err != nil {
+		return fmt.Errorf("authn: %w", err)
+	}
+
+	roles, err := extractRolesFromToken(token, auth.rolesClaim)
+	if err != nil {
+		return fmt.Errorf("failed to extract roles from token: %w", err)
+	}
+
+	return auth.evaluate(ctx, roles, method, path, projectId)
+}
+
+// Subject returns the subject of the bearer token of the Authorization header once the token is verified
+func (auth oidcAuthenticator) Subject(authHeader string) (string, error) {
+	token, err := auth.authn(authHeader)
+	if err != nil {
+		return "", fmt.Errorf("authn: %w", err)
+	}
+	return token.Claims.GetSubject()
+}
+
+// authn authenticates the bearer token of the Authorization header using the OIDC server
+func (auth oidcAuthenticator) authn(bearerToken string) (*jwt.Token, error) {
 	if bearerToken == "" {
-		return nil, errors.New("missing authentication token")
+		return nil, errMissingToken
 	}
 
 	// remove 'Bearer ' prefix from token
 	rawToken, ok := strings.CutPrefix(bearerToken, "Bearer ")
 	if !ok || rawToken == "" {
-		return nil, errors.New("malformed token")
+		return nil, errMalformedToken
 	}
 
 	claims := jwt.MapClaims{}
-	token, err := jwt.ParseWithClaims(rawToken, claims, auth.getKeyFunc(), jwt.WithValidMethods(validSigningMethods))
+	token, err := jwt.ParseWithClaims(rawToken, claims, auth.getKeyFunc(), jwt.WithValidMethods(auth.signingMethods))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token with claims: %w", err)
 	}
@@ -72,27 +135,55 @@ func (auth oidcAuthenticator) authn(req *http.Request) (*jwt.Token, error) {
 	return token, nil
 }
 
-// authz authorizes the token based on the claims
-func (auth oidcAuthenticator) authz(req *http.Request, token *jwt.Token) error {
-	if auth.opa == nil {
-		slog.Warn("opa is not enabled, skipping authorization")
-		return nil
+// validationFailureReason returns the reason a token failed validation as reported in the metrics
+func validationFailureReason(err error) string {
+	switch {
+	case errors.Is(err, errMissingToken):
+		return "missing_token"
+	case errors.Is(err, errMalformedToken), errors.Is(err, jwt.ErrTokenMalformed):
+		return "malformed_token"
+	case errors.Is(err, ErrKeyNotFound):
+		return "unknown_key"
+	case errors.Is(err, ErrKeySetUnavailable):
+		return "key_set_unavailable"
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return "expired"
+	case errors.Is(err, jwt.ErrTokenNotValidYet), errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return "not_valid_yet"
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		return "invalid_signature"
+	case errors.Is(err, jwt.ErrTokenUnverifiable):
+		return "unverifiable"
+	default:
+		return "invalid_claims"
 	}
+}
 
+// authz authorizes the token based on the claims
+func (auth oidcAuthenticator) authz(req *http.Request, token *jwt.Token) error {
 	// extract active project id from request header
+	// admin and documentation endpoints are not scoped to a project
 	projectId := getProjectHeader(req)
-	if projectId == "" {
+	if projectId == "" && !auth.policy.Unscoped(req.URL.Path) {
 		return errors.New("missing active project id")
 	}
 
 	// extract roles from token
-	roles, err := extractRolesFromToken(token)
+	roles, err := extractRolesFromToken(token, auth.rolesClaim)
 	if err != nil {
 		return fmt.Errorf("failed to extract roles from token: %w", err)
 	}
 
 	// evaluate policy
-	return evaluatePolicy(req.Context(), auth.opa, roles, req.Method, req.URL.Path, projectId)
+	return auth.evaluate(req.Context(), roles, req.Method, req.URL.Path, projectId)
+}
+
+// evaluate evaluates the OPA policy if OPA is enabled, the RBAC policy of the authenticator otherwise
+func (auth oidcAuthenticator) evaluate(ctx context.Context, roles []string, method, path, projectId string) error {
+	if auth.opa == nil {
+		return auth.policy.Evaluate(roles, method, path, projectId)
+	}
+	return evaluatePolicy(ctx, auth.opa, roles, method, path, projectId)
 }
 
 // getKeyFunc returns a jwt.Keyfunc that dynamically selects the key based on the issuer
@@ -126,3 +217,8 @@ func NewNoopAuthenticator() *noopAuthenticator {
 func (auth noopAuthenticator) Authenticate(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 	return openapi3filter.NoopAuthenticationFunc(ctx, input)
 }
+
+// Authorize allows every request as the authorization is disabled
+func (auth noopAuthenticator) Authorize(context.Context, string, string, string, string) error {
+	return nil
+}
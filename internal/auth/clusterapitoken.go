@@ -12,7 +12,6 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -28,13 +27,13 @@ type TokenResponse struct {
 // NewVaultAuthFunc allows tests to inject a mock VaultAuth implementation
 var NewVaultAuthFunc = NewVaultAuth
 
-// cached M2M client credentials (populated at startup to avoid Vault lookups per token request)
+// cached M2M client credentials (populated at startup to avoid secret provider lookups per token request)
 var cachedClientID string
 var cachedClientSecret string
 var credsMu sync.Mutex
 
 // SetCachedM2MCredentials allows the main package (or tests) to preload client credentials so that
-// JwtTokenWithM2M does not need to contact Vault on each invocation. Safe for concurrent reads after set
+// JwtTokenWithM2M does not need to contact the secret provider on each invocation. Safe for concurrent reads after set
 func SetCachedM2MCredentials(id, secret string) {
 	credsMu.Lock()
 	defer credsMu.Unlock()
@@ -42,6 +41,12 @@ func SetCachedM2MCredentials(id, secret string) {
 	cachedClientSecret = secret
 }
 
+// InvalidateM2MCredentials drops the cached M2M client credentials, so that they are loaded from the secret provider
+// again with the next token request, e.g. after the tokens of the client were revoked
+func InvalidateM2MCredentials() {
+	SetCachedM2MCredentials("", "")
+}
+
 // GetM2MClientID returns the currently cached M2M client ID (empty if not yet loaded)
 func GetM2MClientID() string {
 	credsMu.Lock()
@@ -49,16 +54,16 @@ func GetM2MClientID() string {
 	return cachedClientID
 }
 
-// EnsureM2MCredentials loads M2M credentials from Vault if not yet cached (force refresh if forceRefresh)
+// EnsureM2MCredentials loads M2M credentials from the secret provider if not yet cached (force refresh if forceRefresh)
 // Exposed so other packages (e.g. rest) can guarantee the client ID prior to admin enforcement operations
 func EnsureM2MCredentials(forceRefresh bool) error {
 	return ensureM2MCredentials(context.Background(), forceRefresh)
 }
 
-// ensureM2MCredentials loads credentials from Vault if cache empty or forceRefresh requested
+// ensureM2MCredentials loads credentials from the secret provider if cache empty or forceRefresh requested
 // returns error if access fails
 func ensureM2MCredentials(ctx context.Context, forceRefresh bool) error {
-	// avoiding to defer here so the mutex is released before any network / vault calls
+	// avoiding to defer here so the mutex is released before any network / secret provider calls
 	credsMu.Lock()
 	idEmpty := cachedClientID == "" || cachedClientSecret == ""
 	credsMu.Unlock()
@@ -67,22 +72,22 @@ func ensureM2MCredentials(ctx context.Context, forceRefresh bool) error {
 		return nil
 	}
 
-	vaultAuth, err := NewVaultAuthFunc(VaultServer, ServiceAccount)
+	provider, err := getSecretProvider()
 	if err != nil {
-		return fmt.Errorf("failed to create vault auth: %w", err)
+		return fmt.Errorf("failed to create secret provider: %w", err)
 	}
 
-	id, secret, err := vaultAuth.GetClientCredentials(ctx)
+	id, secret, err := provider.GetClientCredentials(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to fetch M2M credentials from Vault: %w", err)
+		return fmt.Errorf("failed to fetch M2M credentials: %w", err)
 	}
 
 	SetCachedM2MCredentials(id, secret)
 	if forceRefresh {
-		slog.Warn("M2M credentials force refreshed from Vault")
+		slog.Warn("M2M credentials force refreshed")
 		return nil
 	}
-	slog.Debug("loaded M2M credentials from Vault")
+	slog.Debug("loaded M2M credentials")
 	return nil
 }
 
@@ -112,23 +117,23 @@ func ExtractClaims(tokenString string) (string, string, time.Time, error) {
 	return azp, preferredUsername, expirationTime, nil
 }
 
-// JwtTokenWithM2M retrieves a new token from Keycloak using M2M authentication with configurable TTL
+// JwtTokenWithM2M retrieves a new token from the OIDC provider using M2M authentication with configurable TTL
 func JwtTokenWithM2M(ctx context.Context, ttl *time.Duration) (string, error) {
 	if err := ensureM2MCredentials(ctx, false); err != nil {
-		return "", fmt.Errorf("error loading credentials from vault, %w", err)
+		return "", fmt.Errorf("error loading M2M credentials, %w", err)
 	}
 
 	credsMu.Lock()
 	clientID, clientSecret := cachedClientID, cachedClientSecret
 	credsMu.Unlock()
 
-	keycloakURL := os.Getenv(KeycloakUrlEnvVar)
-	if keycloakURL == "" { // use OIDC server when KEYCLOAK_URL isn't available
-		keycloakURL = os.Getenv(OidcUrlEnvVar)
+	issuer, err := oidcIssuer()
+	if err != nil {
+		return "", err
 	}
-
-	if keycloakURL == "" {
-		return "", fmt.Errorf("%s (or %s) environment variable not set", KeycloakUrlEnvVar, OidcUrlEnvVar)
+	tokenURL, err := GetOIDCProvider().TokenURL(ctx, issuer)
+	if err != nil {
+		return "", fmt.Errorf("failed to get token endpoint: %w", err)
 	}
 
 	// prepare for M2M token request
@@ -137,7 +142,6 @@ func JwtTokenWithM2M(ctx context.Context, ttl *time.Duration) (string, error) {
 	data.Set("client_id", clientID)
 	data.Set("client_secret", clientSecret)
 
-	tokenURL := fmt.Sprintf("%s/protocol/openid-connect/token", keycloakURL)
 	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, bytes.NewBufferString(data.Encode()))
 	if err != nil {
 		return "", fmt.Errorf("failed to create token request: %w", err)
@@ -456,6 +456,25 @@ func TestJwtTokenWithM2M_LazyAndRetryScenarios(t *testing.T) {
 				assert.NotEmpty(t, tok2)
 			},
 		},
+		{
+			name: "invalidated credentials are loaded again",
+			keycloakHandler: func() http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(fmt.Sprintf("{\"access_token\":\"%s\"}", makeSuccessToken())))
+				})
+			},
+			expectedVaultCalls: 1,
+			extraAssertion: func(t *testing.T) {
+				InvalidateM2MCredentials()
+				assert.Empty(t, GetM2MClientID())
+				tok2, err2 := JwtTokenWithM2M(context.Background(), nil)
+				assert.NoError(t, err2)
+				assert.NotEmpty(t, tok2)
+				assert.Equal(t, int32(2), atomic.LoadInt32(&vaultCalls), "invalidated credentials were not loaded from vault again")
+				assert.Equal(t, "client-id-2", GetM2MClientID())
+			},
+		},
 		{
 			name: "rotation retry success (401 then 200)",
 			keycloakHandler: func() http.Handler {
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+var (
+	// ErrKeyNotFound is returned when the signing key of a token is not in the key set of the OIDC provider
+	ErrKeyNotFound = errors.New("key not found")
+
+	// ErrKeySetUnavailable is returned when the key set of the OIDC provider could not be fetched
+	ErrKeySetUnavailable = errors.New("key set unavailable")
+)
+
+const (
+	// DefaultJWKSRefreshInterval is how often the key set is refreshed in the background
+	DefaultJWKSRefreshInterval = 15 * time.Minute
+
+	// DefaultJWKSMinRefreshInterval is how long to wait after a refresh before a token with an unknown key id triggers
+	// the next refresh, so that tokens with bogus key ids can not flood the OIDC provider
+	DefaultJWKSMinRefreshInterval = 10 * time.Second
+
+	// DefaultStaleKeyGrace is how long keys removed from the key set are still accepted, so that tokens signed before
+	// a key rotation remain valid until they are refreshed
+	DefaultStaleKeyGrace = 10 * time.Minute
+)
+
+// cachedKey is a public key of the key set
+type cachedKey struct {
+	key any
+	// removedAt is when the key was removed from the key set, zero while it is part of it
+	removedAt time.Time
+}
+
+// jwksCache caches the public signing keys of an OIDC provider by key id and refreshes them in the background
+type jwksCache struct {
+	url    string
+	client *http.Client
+
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+	staleKeyGrace      time.Duration
+	now                func() time.Time
+
+	// refreshMu serializes refreshes, mu guards the keys
+	refreshMu   sync.Mutex
+	mu          sync.RWMutex
+	keys        map[string]cachedKey
+	lastRefresh time.Time
+}
+
+// newJWKSCache creates a new empty jwksCache for the key set at the given URL
+func newJWKSCache(url string, client *http.Client) *jwksCache {
+	return &jwksCache{
+		url:                url,
+		client:             client,
+		refreshInterval:    DefaultJWKSRefreshInterval,
+		minRefreshInterval: DefaultJWKSMinRefreshInterval,
+		staleKeyGrace:      DefaultStaleKeyGrace,
+		now:                time.Now,
+		keys:               map[string]cachedKey{},
+	}
+}
+
+// start refreshes the key set every refreshInterval until the context is canceled
+// A failed refresh keeps the cached keys, so that tokens are still validated while the OIDC provider is unavailable
+func (c *jwksCache) start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(c.refreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.refresh(ctx, 0); err != nil {
+					slog.Warn("failed to refresh jwks, keeping the cached keys", "url", c.url, "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// get returns the public key with the given key id, the key set is refreshed if the key is unknown
+func (c *jwksCache) get(ctx context.Context, kid string) (any, error) {
+	if key, ok := c.lookup(kid); ok {
+		return key, nil
+	}
+
+	if err := c.refresh(ctx, c.minRefreshInterval); err != nil {
+		return nil, err
+	}
+
+	if key, ok := c.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+}
+
+// lookup returns the cached key with the given key id unless it was removed longer than the grace period ago
+func (c *jwksCache) lookup(kid string) (any, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cached, ok := c.keys[kid]
+	if !ok || (!cached.removedAt.IsZero() && c.now().Sub(cached.removedAt) >= c.staleKeyGrace) {
+		return nil, false
+	}
+	return cached.key, true
+}
+
+// refresh fetches the key set unless it was refreshed less than minAge ago
+// Keys missing from the fetched key set are kept for the grace period, new and removed keys are logged as rotations
+func (c *jwksCache) refresh(ctx context.Context, minAge time.Duration) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.RLock()
+	lastRefresh := c.lastRefresh
+	c.mu.RUnlock()
+	if minAge > 0 && c.now().Sub(lastRefresh) < minAge {
+		return nil
+	}
+
+	set, err := jwk.Fetch(ctx, c.url, jwk.WithHTTPClient(c.client))
+	if err != nil {
+		metrics.JWKSRefreshCounter.WithLabelValues("failure").Inc()
+		return fmt.Errorf("%w: %v", ErrKeySetUnavailable, err)
+	}
+
+	fetched := map[string]any{}
+	for i := 0; i < set.Len(); i++ {
+		key, _ := set.Key(i)
+		// keycloak publishes encryption keys in the same key set
+		if key.KeyID() == "" || key.KeyUsage() == string(jwk.ForEncryption) {
+			continue
+		}
+		var raw any
+		if err := key.Raw(&raw); err != nil {
+			slog.Warn("ignoring invalid jwk", "kid", key.KeyID(), "error", err)
+			continue
+		}
+		fetched[key.KeyID()] = raw
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	keys := make(map[string]cachedKey, len(fetched))
+	for kid, key := range fetched {
+		if _, ok := c.keys[kid]; !ok && !c.lastRefresh.IsZero() {
+			slog.Info("jwks key rotation detected, new signing key", "kid", kid)
+		}
+		keys[kid] = cachedKey{key: key}
+	}
+	for kid, cached := range c.keys {
+		if _, ok := fetched[kid]; ok {
+			continue
+		}
+		if cached.removedAt.IsZero() {
+			slog.Info("jwks key rotation detected, signing key removed", "kid", kid, "grace", c.staleKeyGrace)
+			cached.removedAt = now
+		}
+		if now.Sub(cached.removedAt) < c.staleKeyGrace {
+			keys[kid] = cached
+		}
+	}
+
+	c.keys = keys
+	c.lastRefresh = now
+	metrics.JWKSRefreshCounter.WithLabelValues("success").Inc()
+	return nil
+}
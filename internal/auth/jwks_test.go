@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJWKSServer serves a key set that can be rotated and counts the requests
+type fakeJWKSServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	keys     map[string]*rsa.PrivateKey
+	usage    map[string]string
+	requests int
+	fail     bool
+}
+
+func newFakeJWKSServer(t *testing.T) *fakeJWKSServer {
+	s := &fakeJWKSServer{keys: map[string]*rsa.PrivateKey{}, usage: map[string]string{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests++
+		if s.fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		set := jwk.NewSet()
+		for kid, private := range s.keys {
+			key, err := jwk.FromRaw(private.Public())
+			require.NoError(t, err)
+			require.NoError(t, key.Set(jwk.KeyIDKey, kid))
+			require.NoError(t, key.Set(jwk.KeyUsageKey, s.usage[kid]))
+			require.NoError(t, set.AddKey(key))
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(set))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeJWKSServer) setKeys(t *testing.T, kids ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := map[string]*rsa.PrivateKey{}
+	for _, kid := range kids {
+		if key, ok := s.keys[kid]; ok {
+			keys[kid] = key
+			continue
+		}
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		keys[kid] = key
+		s.usage[kid] = string(jwk.ForSignature)
+	}
+	s.keys = keys
+}
+
+func (s *fakeJWKSServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func TestJWKSCache(t *testing.T) {
+	server := newFakeJWKSServer(t)
+	server.setKeys(t, "key-1", "enc-1")
+	server.usage["enc-1"] = string(jwk.ForEncryption)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cache := newJWKSCache(server.URL, server.Client())
+	cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	require.NoError(t, cache.refresh(ctx, 0))
+	require.Equal(t, 1, server.requestCount())
+
+	t.Run("known key", func(t *testing.T) {
+		key, err := cache.get(ctx, "key-1")
+		require.NoError(t, err)
+		require.Equal(t, server.keys["key-1"].PublicKey, *key.(*rsa.PublicKey))
+	})
+
+	t.Run("encryption keys are ignored", func(t *testing.T) {
+		_, err := cache.get(ctx, "enc-1")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("unknown keys do not refresh more than once per interval", func(t *testing.T) {
+		requests := server.requestCount()
+		for range 10 {
+			_, err := cache.get(ctx, "bogus")
+			require.ErrorIs(t, err, ErrKeyNotFound)
+		}
+		require.Equal(t, requests, server.requestCount())
+	})
+
+	t.Run("rotated key is fetched on first use", func(t *testing.T) {
+		server.setKeys(t, "key-2")
+		now = now.Add(DefaultJWKSMinRefreshInterval)
+
+		key, err := cache.get(ctx, "key-2")
+		require.NoError(t, err)
+		require.Equal(t, server.keys["key-2"].PublicKey, *key.(*rsa.PublicKey))
+	})
+
+	t.Run("rotated out key is accepted during the grace period", func(t *testing.T) {
+		now = now.Add(DefaultStaleKeyGrace - time.Second)
+		_, err := cache.get(ctx, "key-1")
+		require.NoError(t, err)
+
+		now = now.Add(time.Second)
+		_, err = cache.get(ctx, "key-1")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		require.NoError(t, cache.refresh(ctx, 0))
+		require.NotContains(t, cache.keys, "key-1")
+	})
+
+	t.Run("cached keys are kept while the provider is unavailable", func(t *testing.T) {
+		server.mu.Lock()
+		server.fail = true
+		server.mu.Unlock()
+		now = now.Add(DefaultJWKSMinRefreshInterval)
+
+		require.ErrorIs(t, cache.refresh(ctx, 0), ErrKeySetUnavailable)
+		_, err := cache.get(ctx, "key-2")
+		require.NoError(t, err)
+
+		_, err = cache.get(ctx, "key-3")
+		require.ErrorIs(t, err, ErrKeySetUnavailable)
+	})
+}
+
+func TestValidationFailureReason(t *testing.T) {
+	for err, reason := range map[error]string{
+		errMissingToken: "missing_token",
+		fmt.Errorf("failed to parse token with claims: %w", errors.Join(jwt.ErrTokenMalformed, errors.New("bad base64"))):        "malformed_token",
+		fmt.Errorf("failed to parse token with claims: %w", errors.Join(jwt.ErrTokenUnverifiable, ErrKeyNotFound)):               "unknown_key",
+		fmt.Errorf("failed to parse token with claims: %w", errors.Join(jwt.ErrTokenUnverifiable, ErrKeySetUnavailable)):         "key_set_unavailable",
+		fmt.Errorf("failed to parse token with claims: %w", errors.Join(jwt.ErrTokenInvalidClaims, jwt.ErrTokenExpired)):         "expired",
+		fmt.Errorf("failed to parse token with claims: %w", errors.Join(jwt.ErrTokenSignatureInvalid, errors.New("bad method"))): "invalid_signature",
+		fmt.Errorf("failed to parse token with claims: %w", errors.Join(jwt.ErrTokenUnverifiable, errors.New("no kid"))):         "unverifiable",
+	} {
+		require.Equal(t, reason, validationFailureReason(err), err.Error())
+	}
+}
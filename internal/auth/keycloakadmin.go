@@ -23,6 +23,8 @@ type kcClient struct {
 	ID         string            `json:"id"`
 	ClientID   string            `json:"clientId"`
 	Attributes map[string]string `json:"attributes"`
+	// NotBefore is the unix time before which the tokens issued for the client are revoked, 0 if none are revoked
+	NotBefore int64 `json:"notBefore,omitempty"`
 }
 
 // EnforceClientAccessTokenTTL sets the client's access token lifespan if different from desired value.
@@ -127,6 +129,40 @@ func ClearClientAccessTokenTTL(ctx context.Context, oidcURL string, realm string
 	return true
 }
 
+// RevokeClientTokens revokes the tokens issued for the client before the given time by moving its not-before policy
+// forward, keycloak rejects these tokens from then on; the policy is pushed to the admin URL of the client if it has one
+func RevokeClientTokens(ctx context.Context, oidcURL string, realm string, clientID string, notBefore time.Time, adminToken string) error {
+	if clientID == "" {
+		return errors.New("empty clientID")
+	}
+	base, derivedRealm, err := deriveBaseAndRealm(oidcURL)
+	if err != nil {
+		return fmt.Errorf("cannot derive keycloak endpoint: %w", err)
+	}
+	if realm == "" {
+		realm = derivedRealm
+	}
+	cl, uuid, err := kcGetClientByClientID(ctx, base, realm, clientID, adminToken)
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+
+	previous := cl.NotBefore
+	cl.NotBefore = notBefore.Unix()
+	if err := kcUpdateClient(ctx, base, realm, uuid, adminToken, cl); err != nil {
+		return fmt.Errorf("failed to update client not-before policy: %w", err)
+	}
+
+	// clients without admin URL reject the push, their tokens are rejected by keycloak regardless
+	reqURL := fmt.Sprintf("%s/admin/realms/%s/clients/%s/push-revocation", base, realm, uuid)
+	if err := kcDoJSON(ctx, http.MethodPost, reqURL, adminToken, nil, nil); err != nil {
+		slog.Debug("failed to push client not-before policy", "error", err)
+	}
+
+	slog.Info("client tokens revoked", "previous", previous, "new", cl.NotBefore)
+	return nil
+}
+
 // deriveBaseAndRealm extracts base host (scheme://host[:port]) and realm name from a standard keycloak OIDC issuer URL
 func deriveBaseAndRealm(oidc string) (string, string, error) {
 	if oidc == "" {
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// OIDCProviderKeycloak is a Keycloak realm, whose admin API the kubeconfig token TTL is enforced and the kubeconfig
+	// tokens are revoked with
+	OIDCProviderKeycloak = "keycloak"
+
+	// OIDCProviderGeneric is any OpenID Connect issuer, e.g. Dex or Azure AD; the kubeconfig tokens keep the lifespan
+	// of the issuer and cannot be revoked
+	OIDCProviderGeneric = "generic"
+
+	// DefaultRolesClaim is the claim the roles are read from in the tokens issued by Keycloak
+	DefaultRolesClaim = "realm_access.roles"
+)
+
+// OIDCProviders are the kinds of OIDC providers the cluster manager can be used with
+var OIDCProviders = []string{OIDCProviderKeycloak, OIDCProviderGeneric}
+
+// ErrUnsupported is returned by the OIDC providers for the features they do not support
+var ErrUnsupported = errors.New("not supported by the oidc provider")
+
+// OIDCProvider is the provider the kubeconfig tokens are issued by, for the M2M client of the cluster manager
+type OIDCProvider interface {
+	// Name returns the kind of the provider
+	Name() string
+
+	// TokenURL returns the token endpoint of the issuer
+	TokenURL(ctx context.Context, issuer string) (string, error)
+
+	// EnforceAccessTokenTTL sets the lifespan of the access tokens issued for the client, ErrUnsupported if the
+	// provider cannot
+	EnforceAccessTokenTTL(ctx context.Context, issuer, clientID string, ttl time.Duration, adminToken string) error
+
+	// RevokeTokens revokes the tokens issued for the client before the given time, ErrUnsupported if the provider
+	// cannot
+	RevokeTokens(ctx context.Context, issuer, clientID string, notBefore time.Time, adminToken string) error
+}
+
+var (
+	// tokenIssuer is the OIDC provider the kubeconfig tokens are issued by, Keycloak if not set
+	tokenIssuer   OIDCProvider
+	tokenIssuerMu sync.Mutex
+)
+
+// SetOIDCProvider sets the provider the kubeconfig tokens are issued by, nil for Keycloak
+func SetOIDCProvider(provider OIDCProvider) {
+	tokenIssuerMu.Lock()
+	defer tokenIssuerMu.Unlock()
+	tokenIssuer = provider
+}
+
+// GetOIDCProvider returns the provider set by SetOIDCProvider, Keycloak if none is
+func GetOIDCProvider() OIDCProvider {
+	tokenIssuerMu.Lock()
+	defer tokenIssuerMu.Unlock()
+	if tokenIssuer == nil {
+		return keycloakProvider{}
+	}
+	return tokenIssuer
+}
+
+// NewOIDCProvider returns the OIDC provider of the kind
+func NewOIDCProvider(kind string) (OIDCProvider, error) {
+	switch kind {
+	case "", OIDCProviderKeycloak:
+		return keycloakProvider{}, nil
+	case OIDCProviderGeneric:
+		return &genericProvider{}, nil
+	default:
+		return nil, fmt.Errorf("unknown oidc provider %q, must be one of %v", kind, OIDCProviders)
+	}
+}
+
+// keycloakProvider issues the kubeconfig tokens with Keycloak and manages them with its admin API
+type keycloakProvider struct{}
+
+func (keycloakProvider) Name() string {
+	return OIDCProviderKeycloak
+}
+
+// TokenURL returns the token endpoint of the realm
+func (keycloakProvider) TokenURL(_ context.Context, issuer string) (string, error) {
+	return strings.TrimSuffix(issuer, "/") + "/protocol/openid-connect/token", nil
+}
+
+func (keycloakProvider) EnforceAccessTokenTTL(ctx context.Context, issuer, clientID string, ttl time.Duration, adminToken string) error {
+	if !EnforceClientAccessTokenTTL(ctx, issuer, "", clientID, ttl, adminToken) {
+		return fmt.Errorf("failed to set the access token lifespan of client %s", clientID)
+	}
+	return nil
+}
+
+func (keycloakProvider) RevokeTokens(ctx context.Context, issuer, clientID string, notBefore time.Time, adminToken string) error {
+	return RevokeClientTokens(ctx, issuer, "", clientID, notBefore, adminToken)
+}
+
+// genericProvider issues the kubeconfig tokens with the token endpoint of the well-known configuration of the issuer;
+// the lifespan of the tokens is the one of the issuer and they cannot be revoked
+type genericProvider struct {
+	mu sync.Mutex
+	// tokenURLs caches the token endpoints of the issuers
+	tokenURLs map[string]string
+}
+
+func (p *genericProvider) Name() string {
+	return OIDCProviderGeneric
+}
+
+// TokenURL returns the token endpoint of the well-known configuration of the issuer, fetched once
+func (p *genericProvider) TokenURL(_ context.Context, issuer string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if tokenURL, ok := p.tokenURLs[issuer]; ok {
+		return tokenURL, nil
+	}
+
+	config, err := getWellKnownConfig(httpClient, issuer)
+	if err != nil {
+		return "", err
+	}
+	if config.TokenUrl == "" {
+		return "", fmt.Errorf("well-known configuration of %s has no token endpoint", issuer)
+	}
+	if p.tokenURLs == nil {
+		p.tokenURLs = map[string]string{}
+	}
+	p.tokenURLs[issuer] = config.TokenUrl
+	return config.TokenUrl, nil
+}
+
+func (p *genericProvider) EnforceAccessTokenTTL(context.Context, string, string, time.Duration, string) error {
+	return ErrUnsupported
+}
+
+func (p *genericProvider) RevokeTokens(context.Context, string, string, time.Time, string) error {
+	return ErrUnsupported
+}
+
+// oidcIssuer returns the issuer the kubeconfig tokens are issued by, KEYCLOAK_URL if set and OIDC_SERVER_URL otherwise
+func oidcIssuer() (string, error) {
+	issuer := os.Getenv(KeycloakUrlEnvVar)
+	if issuer == "" { // use OIDC server when KEYCLOAK_URL isn't available
+		issuer = os.Getenv(OidcUrlEnvVar)
+	}
+	if issuer == "" {
+		return "", fmt.Errorf("%s (or %s) environment variable not set", KeycloakUrlEnvVar, OidcUrlEnvVar)
+	}
+	return issuer, nil
+}
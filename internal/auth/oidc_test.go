@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOIDCProvider(t *testing.T) {
+	cases := []struct {
+		kind     string
+		expected string
+	}{
+		{kind: "", expected: OIDCProviderKeycloak},
+		{kind: OIDCProviderKeycloak, expected: OIDCProviderKeycloak},
+		{kind: OIDCProviderGeneric, expected: OIDCProviderGeneric},
+		{kind: "okta"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.kind, func(t *testing.T) {
+			provider, err := NewOIDCProvider(tc.kind)
+			if tc.expected == "" {
+				assert.ErrorContains(t, err, "must be one of")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, provider.Name())
+		})
+	}
+}
+
+func TestGetOIDCProvider(t *testing.T) {
+	t.Cleanup(func() { SetOIDCProvider(nil) })
+
+	assert.Equal(t, OIDCProviderKeycloak, GetOIDCProvider().Name())
+
+	generic, err := NewOIDCProvider(OIDCProviderGeneric)
+	require.NoError(t, err)
+	SetOIDCProvider(generic)
+	assert.Equal(t, OIDCProviderGeneric, GetOIDCProvider().Name())
+}
+
+func TestKeycloakProviderTokenURL(t *testing.T) {
+	tokenURL, err := keycloakProvider{}.TokenURL(context.Background(), "https://keycloak.kind.internal/realms/master/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://keycloak.kind.internal/realms/master/protocol/openid-connect/token", tokenURL)
+}
+
+func TestGenericProvider(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"issuer":"https://dex.kind.internal","token_endpoint":"https://dex.kind.internal/token"}`)
+	}))
+	defer server.Close()
+
+	provider := &genericProvider{}
+
+	t.Run("token endpoint of the well-known configuration", func(t *testing.T) {
+		for range 2 {
+			tokenURL, err := provider.TokenURL(context.Background(), server.URL)
+			require.NoError(t, err)
+			assert.Equal(t, "https://dex.kind.internal/token", tokenURL)
+		}
+		assert.Equal(t, 1, requests, "the well-known configuration is fetched once")
+	})
+
+	t.Run("token ttl and revocation are not supported", func(t *testing.T) {
+		assert.ErrorIs(t, provider.EnforceAccessTokenTTL(context.Background(), server.URL, "client", time.Hour, "token"), ErrUnsupported)
+		assert.ErrorIs(t, provider.RevokeTokens(context.Background(), server.URL, "client", time.Now(), "token"), ErrUnsupported)
+	})
+}
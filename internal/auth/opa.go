@@ -7,7 +7,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log/slog"
 
@@ -60,7 +59,7 @@ func evaluatePolicy(ctx context.Context, client opa.ClientWithResponsesInterface
 		return fmt.Errorf("failed to parse policy result: %w", err)
 	}
 	if !allowed {
-		return errors.New("authorization denied")
+		return ErrAuthorizationDenied
 	}
 
 	return nil
@@ -9,8 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-
-	"github.com/lestrrat-go/jwx/v2/jwk"
+	"time"
 )
 
 const (
@@ -18,8 +17,24 @@ const (
 	oidConfigPath = ".well-known/openid-configuration"
 )
 
+// WithJWKSRefreshInterval is a functional option for configuring how often the provider refreshes its signing keys
+func WithJWKSRefreshInterval(interval time.Duration) func(*oidcProvider) {
+	return func(p *oidcProvider) {
+		p.jwks.refreshInterval = interval
+	}
+}
+
+// WithStaleKeyGrace is a functional option for configuring how long the provider accepts signing keys after they
+// were rotated out of its key set
+func WithStaleKeyGrace(grace time.Duration) func(*oidcProvider) {
+	return func(p *oidcProvider) {
+		p.jwks.staleKeyGrace = grace
+	}
+}
+
 // NewOidcProvider creates a new OIDC provider using it's well-known configuration
-func NewOidcProvider(endpoint string) (*oidcProvider, error) {
+// The signing keys of the provider are fetched once and then refreshed in the background until ctx is canceled
+func NewOidcProvider(ctx context.Context, endpoint string, options ...func(*oidcProvider)) (*oidcProvider, error) {
 	if endpoint == "" {
 		return nil, errors.New("failed to create oidc provider: endpoint is empty")
 	}
@@ -32,51 +47,33 @@ func NewOidcProvider(endpoint string) (*oidcProvider, error) {
 		return nil, fmt.Errorf("error getting keycloak well known config: %w", err)
 	}
 
-	// create and refresh jwks cache
-	jwks := jwk.NewCache(context.Background())
-	if err := jwks.Register(config.JwksUrl, jwk.WithHTTPClient(client)); err != nil {
-		return nil, fmt.Errorf("error registering jwks cache: %w", err)
+	p := &oidcProvider{
+		endpoint: endpoint,
+		client:   client,
+		config:   config,
+		jwks:     newJWKSCache(config.JwksUrl, client),
+	}
+	for _, o := range options {
+		o(p)
 	}
-	if _, err := jwks.Refresh(context.Background(), config.JwksUrl); err != nil {
+
+	// fill the jwks cache and keep it fresh
+	if err := p.jwks.refresh(ctx, 0); err != nil {
 		return nil, fmt.Errorf("error refreshing jwks cache: %w", err)
 	}
+	p.jwks.start(ctx)
 
-	return &oidcProvider{
-		endpoint: endpoint,
-		client:   client,
-		config:   config,
-		jwks:     jwks,
-	}, nil
+	return p, nil
 }
 
 // GetSigningKey gets the public signing key from the jwks cache
-// If the key is not found in the cache, it will refresh the cache and try again
+// If the key is not found in the cache, the cache is refreshed at most once per DefaultJWKSMinRefreshInterval and
+// the key looked up again; keys rotated out of the key set are still returned for DefaultStaleKeyGrace
 func (p *oidcProvider) GetSigningKey(kid string) (interface{}, error) {
-	// get jwks from cache
-	set, err := p.jwks.Get(context.Background(), p.config.JwksUrl)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get jwks from cache: %w", err)
-	}
-
-	// get the key that was used to sign the jwt
-	key, found := set.LookupKeyID(kid)
-	if !found {
-		// refresh the cache and try again
-		set, err = p.jwks.Refresh(context.Background(), p.config.JwksUrl)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get jwks from provider: %w", err)
-		}
-
-		key, found = set.LookupKeyID(kid)
-		if !found {
-			return nil, errors.New("key not found")
-		}
-	}
-
-	var rawKey interface{}
-	if err = key.Raw(&rawKey); err != nil {
-		return nil, fmt.Errorf("failed to create public key: %w", err)
-	}
+	return p.jwks.get(context.Background(), kid)
+}
 
-	return rawKey, nil
+// SigningAlgorithms returns the algorithms the provider advertises it signs the tokens with
+func (p *oidcProvider) SigningAlgorithms() []string {
+	return p.config.Algorithms
 }
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+)
+
+// Permission is an operation on the resources of a project, or of the orchestrator, granted by roles
+type Permission string
+
+const (
+	ReadClusters        Permission = "clusters:read"
+	WriteClusters       Permission = "clusters:write"
+	ReadTemplates       Permission = "templates:read"
+	WriteTemplates      Permission = "templates:write"
+	TransitionTemplates Permission = "templates:transition"
+	Administrate        Permission = "admin"
+	// Authenticated is granted to every authenticated user
+	Authenticated Permission = "authenticated"
+)
+
+var (
+	readMethods  = []string{http.MethodGet}
+	writeMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	allMethods   = append(slices.Clone(readMethods), writeMethods...)
+)
+
+// Role grants permissions to the users with the role in the realm_access claim of their token
+type Role struct {
+	Name string
+	// Project roles are granted per project, the claim has them prefixed with the project id, e.g. '<project-id>_cl-r'
+	Project     bool
+	Permissions []Permission
+}
+
+// Route requires a permission for the requests of its methods whose path matches
+type Route struct {
+	Methods    []string
+	Path       *regexp.Regexp
+	Permission Permission
+	// Unscoped routes are not scoped to the active project, e.g. the admin endpoints
+	Unscoped bool
+}
+
+// Policy authorizes the requests by the roles of the users: the first route matching a request requires a
+// permission, which one of the roles must grant. Requests matching no route are denied.
+type Policy struct {
+	Roles  []Role
+	Routes []Route
+}
+
+// DefaultPolicy is the policy of the cluster manager roles, the same as the OPA policy of the Helm chart. Read-only
+// roles are granted the read permissions only, so their writes are denied.
+var DefaultPolicy = Policy{
+	Roles: []Role{
+		{Name: "cl-r", Project: true, Permissions: []Permission{ReadClusters}},
+		{Name: "cl-rw", Project: true, Permissions: []Permission{ReadClusters, WriteClusters}},
+		{Name: "cl-tpl-r", Project: true, Permissions: []Permission{ReadTemplates}},
+		{Name: "cl-tpl-rw", Project: true, Permissions: []Permission{ReadTemplates, WriteTemplates}},
+		{Name: "cl-tpl-admin", Project: true, Permissions: []Permission{ReadTemplates, WriteTemplates, TransitionTemplates}},
+		{Name: "cl-admin", Permissions: []Permission{Administrate}},
+	},
+	Routes: []Route{
+		{Methods: readMethods, Path: regexp.MustCompile(`^/v2/(clusters|pending-clusters|operations)`), Permission: ReadClusters},
+		{Methods: writeMethods, Path: regexp.MustCompile(`^/v2/(clusters|pending-clusters|operations)`), Permission: WriteClusters},
+		// publishing and deprecating template versions is reserved to the template administrators
+		{Methods: allMethods, Path: regexp.MustCompile(`^/v2/templates/[^/]+/[^/]+/(publish|deprecate)$`), Permission: TransitionTemplates},
+		{Methods: readMethods, Path: regexp.MustCompile(`^/v2/(templates|template-uploads)`), Permission: ReadTemplates},
+		{Methods: writeMethods, Path: regexp.MustCompile(`^/v2/(templates|template-uploads)`), Permission: WriteTemplates},
+		// the summary of the project counts its clusters and the templates they use
+		{Methods: readMethods, Path: regexp.MustCompile(`^/v2/summary$`), Permission: ReadClusters},
+		// the webhook destinations are managed by the platform administrators
+		{Methods: readMethods, Path: regexp.MustCompile(`^/v2/webhooks`), Permission: ReadClusters},
+		{Methods: readMethods, Path: regexp.MustCompile(`^/v2/admin/`), Permission: Administrate, Unscoped: true},
+		{Methods: readMethods, Path: regexp.MustCompile(`^/v2/(docs|apichangelog|openapi\.json|supportmatrix|extensions)$`), Permission: Authenticated, Unscoped: true},
+		// the permissions are evaluated for the active project
+		{Methods: readMethods, Path: regexp.MustCompile(`^/v2/authz/self$`), Permission: Authenticated},
+	},
+}
+
+// Evaluate returns ErrAuthorizationDenied unless the given roles grant the permission the request with the method and
+// path requires in the project; an error if the request requires a project but none is active
+func (p Policy) Evaluate(roles []string, method, path, projectId string) error {
+	route := p.route(method, path)
+	if route == nil {
+		return fmt.Errorf("%w: no route for %s %s", ErrAuthorizationDenied, method, path)
+	}
+	if !route.Unscoped && projectId == "" {
+		return errors.New("missing active project id")
+	}
+	if route.Permission == Authenticated {
+		return nil
+	}
+
+	for _, role := range p.Roles {
+		if !slices.Contains(role.Permissions, route.Permission) {
+			continue
+		}
+		name := role.Name
+		if role.Project {
+			name = projectId + "_" + role.Name
+		}
+		if slices.Contains(roles, name) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s %s requires the %s permission", ErrAuthorizationDenied, method, path, route.Permission)
+}
+
+// Unscoped returns whether the requests with the path are not scoped to the active project
+func (p Policy) Unscoped(path string) bool {
+	return slices.ContainsFunc(p.Routes, func(route Route) bool {
+		return route.Unscoped && route.Path.MatchString(path)
+	})
+}
+
+// route returns the first route matching the request, nil if none does
+func (p Policy) route(method, path string) *Route {
+	for i, route := range p.Routes {
+		if slices.Contains(route.Methods, method) && route.Path.MatchString(path) {
+			return &p.Routes[i]
+		}
+	}
+	return nil
+}
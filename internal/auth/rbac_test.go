@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	cases := []struct {
+		name      string
+		roles     []string
+		method    string
+		path      string
+		projectId string
+		allowed   bool
+	}{
+		{"read clusters", []string{"p_cl-r"}, http.MethodGet, "/v2/clusters", "p", true},
+		{"read pending clusters", []string{"p_cl-r"}, http.MethodGet, "/v2/pending-clusters", "p", true},
+		{"read operations", []string{"p_cl-r"}, http.MethodGet, "/v2/operations/op-1", "p", true},
+		{"read summary", []string{"p_cl-r"}, http.MethodGet, "/v2/summary", "p", true},
+		{"read summary with template role", []string{"p_cl-tpl-r"}, http.MethodGet, "/v2/summary", "p", false},
+		{"create cluster with read-only role", []string{"p_cl-r"}, http.MethodPost, "/v2/clusters", "p", false},
+		{"delete cluster with read-only role", []string{"p_cl-r"}, http.MethodDelete, "/v2/clusters/c", "p", false},
+		{"create cluster", []string{"p_cl-rw"}, http.MethodPost, "/v2/clusters", "p", true},
+		{"update cluster", []string{"p_cl-rw"}, http.MethodPut, "/v2/clusters/c/labels", "p", true},
+		{"role of another project", []string{"q_cl-rw"}, http.MethodGet, "/v2/clusters", "p", false},
+		{"unprefixed project role", []string{"cl-rw"}, http.MethodGet, "/v2/clusters", "p", false},
+		{"read clusters with template role", []string{"p_cl-tpl-rw"}, http.MethodGet, "/v2/clusters", "p", false},
+		{"read templates", []string{"p_cl-tpl-r"}, http.MethodGet, "/v2/templates", "p", true},
+		{"import template with read-only role", []string{"p_cl-tpl-r"}, http.MethodPost, "/v2/templates", "p", false},
+		{"import template", []string{"p_cl-tpl-rw"}, http.MethodPost, "/v2/templates", "p", true},
+		{"upload template", []string{"p_cl-tpl-rw"}, http.MethodPatch, "/v2/template-uploads/u", "p", true},
+		{"publish template", []string{"p_cl-tpl-rw"}, http.MethodPost, "/v2/templates/t/v1.0.0/publish", "p", false},
+		{"publish template as administrator", []string{"p_cl-tpl-admin"}, http.MethodPost, "/v2/templates/t/v1.0.0/publish", "p", true},
+		{"deprecate template as administrator", []string{"p_cl-tpl-admin"}, http.MethodPost, "/v2/templates/t/v1.0.0/deprecate", "p", true},
+		{"read webhooks", []string{"p_cl-r"}, http.MethodGet, "/v2/webhooks", "p", true},
+		{"write webhooks", []string{"p_cl-rw"}, http.MethodPost, "/v2/webhooks", "p", false},
+		{"admin", []string{"cl-admin"}, http.MethodGet, "/v2/admin/exports/p", "", true},
+		{"admin with project role", []string{"p_cl-rw"}, http.MethodGet, "/v2/admin/exports/p", "p", false},
+		{"docs", nil, http.MethodGet, "/v2/docs", "", true},
+		{"support matrix", nil, http.MethodGet, "/v2/supportmatrix", "", true},
+		{"extension catalog", nil, http.MethodGet, "/v2/extensions", "", true},
+		{"openapi document", nil, http.MethodGet, "/v2/openapi.json", "", true},
+		{"change cluster extension", []string{"p_cl-rw"}, http.MethodPut, "/v2/clusters/c/extensions", "p", true},
+		{"own permissions", nil, http.MethodGet, "/v2/authz/self", "p", true},
+		{"unknown route", []string{"p_cl-rw"}, http.MethodGet, "/v2/unknown", "p", false},
+		{"unknown method", []string{"p_cl-rw"}, http.MethodOptions, "/v2/clusters", "p", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := DefaultPolicy.Evaluate(tc.roles, tc.method, tc.path, tc.projectId)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrAuthorizationDenied)
+			}
+		})
+	}
+}
+
+func TestPolicyMissingProject(t *testing.T) {
+	err := DefaultPolicy.Evaluate([]string{"p_cl-r"}, http.MethodGet, "/v2/clusters", "")
+	assert.ErrorContains(t, err, "missing active project id")
+	assert.NotErrorIs(t, err, ErrAuthorizationDenied)
+}
+
+func TestPolicyUnscoped(t *testing.T) {
+	assert.True(t, DefaultPolicy.Unscoped("/v2/admin/exports/p"))
+	assert.True(t, DefaultPolicy.Unscoped("/v2/docs"))
+	assert.True(t, DefaultPolicy.Unscoped("/v2/apichangelog"))
+	assert.True(t, DefaultPolicy.Unscoped("/v2/openapi.json"))
+	assert.False(t, DefaultPolicy.Unscoped("/v2/clusters"))
+	assert.False(t, DefaultPolicy.Unscoped("/v2/authz/self"))
+}
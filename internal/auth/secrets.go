@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// SecretBackendVault reads the M2M client credentials from Vault, logging in with the service account token
+	SecretBackendVault = "vault"
+
+	// SecretBackendKubernetes reads the M2M client credentials from a Kubernetes Secret
+	SecretBackendKubernetes = "kubernetes"
+
+	// SecretBackendFile reads the M2M client credentials from files, e.g. of a mounted Secret
+	SecretBackendFile = "file"
+
+	// keys of the M2M client credentials in the Kubernetes Secrets and the file names of the file backend, the same as
+	// the keys of the Vault secret
+	clientIDKey     = "client_id"
+	clientSecretKey = "client_secret" // #nosec G101
+)
+
+// SecretBackends are the backends the M2M client credentials can be read from
+var SecretBackends = []string{SecretBackendVault, SecretBackendKubernetes, SecretBackendFile}
+
+var secretGVR = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+// SecretProvider provides the credentials of the M2M client the kubeconfig tokens are issued for
+type SecretProvider interface {
+	GetClientCredentials(ctx context.Context) (string, string, error)
+}
+
+var (
+	// secretProvider provides the M2M client credentials, Vault if not set
+	secretProvider   SecretProvider
+	secretProviderMu sync.Mutex
+)
+
+// SetSecretProvider sets the provider the M2M client credentials are loaded from, nil to load them from Vault
+func SetSecretProvider(provider SecretProvider) {
+	secretProviderMu.Lock()
+	defer secretProviderMu.Unlock()
+	secretProvider = provider
+}
+
+// getSecretProvider returns the provider set by SetSecretProvider, Vault if none is
+func getSecretProvider() (SecretProvider, error) {
+	secretProviderMu.Lock()
+	provider := secretProvider
+	secretProviderMu.Unlock()
+	if provider != nil {
+		return provider, nil
+	}
+	return NewVaultAuthFunc(VaultServer, ServiceAccount)
+}
+
+// NewSecretProvider returns the provider of the M2M client credentials of the backend. The location is the Secret, as
+// namespace/name, of the kubernetes backend and the directory of the client_id and client_secret files of the file
+// backend; the vault backend is located by the VAULT_* environment variables.
+func NewSecretProvider(backend, location string, dyn dynamic.Interface) (SecretProvider, error) {
+	switch backend {
+	case "", SecretBackendVault:
+		return NewVaultAuthFunc(VaultServer, ServiceAccount)
+	case SecretBackendKubernetes:
+		namespace, name, ok := strings.Cut(location, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("secret of the kubernetes backend must be namespace/name, got %q", location)
+		}
+		if dyn == nil {
+			return nil, errors.New("kubernetes backend requires a kubernetes client")
+		}
+		return &kubernetesSecretProvider{dyn: dyn, namespace: namespace, name: name}, nil
+	case SecretBackendFile:
+		if location == "" {
+			return nil, errors.New("file backend requires the directory of the credential files")
+		}
+		return &fileSecretProvider{dir: location}, nil
+	default:
+		return nil, fmt.Errorf("secret backend must be one of %v, got %q", SecretBackends, backend)
+	}
+}
+
+// kubernetesSecretProvider reads the M2M client credentials from the client_id and client_secret keys of a Secret
+type kubernetesSecretProvider struct {
+	dyn       dynamic.Interface
+	namespace string
+	name      string
+}
+
+func (p *kubernetesSecretProvider) GetClientCredentials(ctx context.Context) (string, string, error) {
+	secret, err := p.dyn.Resource(secretGVR).Namespace(p.namespace).Get(ctx, p.name, metav1.GetOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get secret %s/%s: %w", p.namespace, p.name, err)
+	}
+
+	credentials := make([]string, 0, 2)
+	for _, key := range []string{clientIDKey, clientSecretKey} {
+		encoded, found, err := unstructured.NestedString(secret.Object, "data", key)
+		if err != nil || !found {
+			return "", "", fmt.Errorf("secret %s/%s has no %s", p.namespace, p.name, key)
+		}
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to decode %s of secret %s/%s: %w", key, p.namespace, p.name, err)
+		}
+		credentials = append(credentials, string(value))
+	}
+	return credentials[0], credentials[1], nil
+}
+
+// fileSecretProvider reads the M2M client credentials from the client_id and client_secret files of a directory, the
+// layout of a mounted Secret, so that rotated credentials are read with the next load
+type fileSecretProvider struct {
+	dir string
+}
+
+func (p *fileSecretProvider) GetClientCredentials(context.Context) (string, string, error) {
+	credentials := make([]string, 0, 2)
+	for _, key := range []string{clientIDKey, clientSecretKey} {
+		value, err := os.ReadFile(filepath.Join(p.dir, key))
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		credentials = append(credentials, strings.TrimSpace(string(value)))
+	}
+	return credentials[0], credentials[1], nil
+}
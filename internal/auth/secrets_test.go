@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestNewSecretProvider(t *testing.T) {
+	dyn := fake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	cases := []struct {
+		name     string
+		backend  string
+		location string
+		expected string
+	}{
+		{name: "kubernetes", backend: SecretBackendKubernetes, location: "orch-cluster/m2m"},
+		{name: "kubernetes without namespace", backend: SecretBackendKubernetes, location: "m2m", expected: "must be namespace/name"},
+		{name: "file", backend: SecretBackendFile, location: "/etc/m2m"},
+		{name: "file without directory", backend: SecretBackendFile, expected: "requires the directory"},
+		{name: "unknown backend", backend: "aws", expected: "secret backend must be one of"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider, err := NewSecretProvider(tc.backend, tc.location, dyn)
+			if tc.expected != "" {
+				assert.ErrorContains(t, err, tc.expected)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, provider)
+		})
+	}
+}
+
+func TestKubernetesSecretProvider(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "m2m", "namespace": "orch-cluster"},
+		"data": map[string]any{
+			clientIDKey:     base64.StdEncoding.EncodeToString([]byte("co-manager-m2m-client")),
+			clientSecretKey: base64.StdEncoding.EncodeToString([]byte("s3cr3t")),
+		},
+	}}
+	incomplete := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "incomplete", "namespace": "orch-cluster"},
+		"data":       map[string]any{clientIDKey: base64.StdEncoding.EncodeToString([]byte("co-manager-m2m-client"))},
+	}}
+	dyn := fake.NewSimpleDynamicClient(runtime.NewScheme(), secret, incomplete)
+
+	provider, err := NewSecretProvider(SecretBackendKubernetes, "orch-cluster/m2m", dyn)
+	require.NoError(t, err)
+	id, clientSecret, err := provider.GetClientCredentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "co-manager-m2m-client", id)
+	assert.Equal(t, "s3cr3t", clientSecret)
+
+	provider, err = NewSecretProvider(SecretBackendKubernetes, "orch-cluster/incomplete", dyn)
+	require.NoError(t, err)
+	_, _, err = provider.GetClientCredentials(context.Background())
+	assert.ErrorContains(t, err, "has no client_secret")
+
+	provider, err = NewSecretProvider(SecretBackendKubernetes, "orch-cluster/missing", dyn)
+	require.NoError(t, err)
+	_, _, err = provider.GetClientCredentials(context.Background())
+	assert.ErrorContains(t, err, "failed to get secret orch-cluster/missing")
+}
+
+func TestFileSecretProvider(t *testing.T) {
+	dir := t.TempDir()
+	provider, err := NewSecretProvider(SecretBackendFile, dir, nil)
+	require.NoError(t, err)
+
+	_, _, err = provider.GetClientCredentials(context.Background())
+	assert.ErrorContains(t, err, "failed to read client_id")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, clientIDKey), []byte("co-manager-m2m-client\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, clientSecretKey), []byte("s3cr3t\n"), 0o600))
+	id, secret, err := provider.GetClientCredentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "co-manager-m2m-client", id)
+	assert.Equal(t, "s3cr3t", secret)
+}
+
+func TestSetSecretProvider(t *testing.T) {
+	t.Cleanup(func() {
+		SetSecretProvider(nil)
+		InvalidateM2MCredentials()
+	})
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, clientIDKey), []byte("file-client"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, clientSecretKey), []byte("file-secret"), 0o600))
+	provider, err := NewSecretProvider(SecretBackendFile, dir, nil)
+	require.NoError(t, err)
+
+	SetSecretProvider(provider)
+	require.NoError(t, EnsureM2MCredentials(true))
+	assert.Equal(t, "file-client", GetM2MClientID())
+}
@@ -6,7 +6,6 @@ package auth
 import (
 	"net/http"
 
-	"github.com/lestrrat-go/jwx/v2/jwk"
 	opa "github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
 )
 
@@ -17,10 +16,16 @@ const (
 	OpaPortEnvVar     = "OPA_PORT"
 )
 
-// oidcAuthenticator is an implementation of the Authenticator interface that uses OIDC for authentication
+// oidcAuthenticator is an implementation of the Authenticator interface that uses OIDC for authentication, and OPA or
+// its RBAC policy for authorization
 type oidcAuthenticator struct {
 	provider provider
 	opa      opa.ClientWithResponsesInterface
+	policy   Policy
+	// rolesClaim is the dot-separated path of the claim the roles are read from
+	rolesClaim string
+	// signingMethods are the signing methods the tokens are accepted with
+	signingMethods []string
 }
 
 // noopAuthenticator is an implementation of the Authenticator interface that does nothing
@@ -36,7 +41,7 @@ type oidcProvider struct {
 	endpoint string
 	client   *http.Client
 	config   *oidcProviderConfig
-	jwks     *jwk.Cache
+	jwks     *jwksCache
 }
 
 // oidcProviderConfig represents the OIDC provider's configuration
@@ -57,21 +57,28 @@ func getWellKnownConfig(client *http.Client, endpoint string) (*oidcProviderConf
 	return &config, nil
 }
 
-// extractRolesFromToken extracts roles from the JWT token.
-func extractRolesFromToken(token *jwt.Token) ([]string, error) {
+// extractRolesFromToken extracts roles from the claim of the JWT token; the claim is a dot-separated path to a list of
+// roles, e.g. realm_access.roles for Keycloak, groups for Dex or roles for Azure AD
+func extractRolesFromToken(token *jwt.Token, claim string) ([]string, error) {
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
 		return nil, errors.New("invalid token claims")
 	}
 
-	realmAccess, ok := claims["realm_access"].(map[string]interface{})
-	if !ok {
-		return nil, errors.New("realm_access claim is missing or invalid")
+	var value interface{} = map[string]interface{}(claims)
+	for _, key := range strings.Split(claim, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s claim is missing or invalid", claim)
+		}
+		if value, ok = object[key]; !ok {
+			return nil, fmt.Errorf("%s claim is missing or invalid", claim)
+		}
 	}
 
-	rolesInterface, ok := realmAccess["roles"].([]interface{})
+	rolesInterface, ok := value.([]interface{})
 	if !ok {
-		return nil, errors.New("roles are missing or invalid in realm_access claim")
+		return nil, fmt.Errorf("roles are missing or invalid in %s claim", claim)
 	}
 
 	roles := make([]string, 0, len(rolesInterface))
@@ -108,6 +115,10 @@ func newAuthError(input *openapi3filter.AuthenticationInput, err error) error {
 	}
 
 	slog.Error("request failed authentication/authorization", "method", input.RequestValidationInput.Request.Method, "path", path, "error", err.Error())
+	// denied requests are authenticated, so they are forbidden rather than unauthorized
+	if errors.Is(err, ErrAuthorizationDenied) {
+		return input.NewError(ErrAuthorizationDenied)
+	}
 	return input.NewError(errors.New("unauthorized"))
 }
 
@@ -115,3 +126,24 @@ func newAuthError(input *openapi3filter.AuthenticationInput, err error) error {
 func GetAccessToken(authHeader string) string {
 	return strings.TrimPrefix(authHeader, "Bearer ")
 }
+
+// GetUsername returns the user name of the access token of the Authorization header, its preferred_username or sub
+// claim, or an empty string if the header has no such token. The signature of the token is not verified, which is left
+// to the authenticator of the request.
+func GetUsername(authHeader string) string {
+	rawToken, ok := strings.CutPrefix(authHeader, BearerPrefix)
+	if !ok || rawToken == "" {
+		return ""
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(rawToken, claims); err != nil {
+		return ""
+	}
+	for _, claim := range []string{"preferred_username", "sub"} {
+		if name, ok := claims[claim].(string); ok && name != "" {
+			return name
+		}
+	}
+	return ""
+}
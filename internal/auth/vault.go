@@ -29,9 +29,8 @@ const (
 	envVaultServiceAcct = "VAULT_SERVICE_ACCOUNT" // overrides ServiceAccount when set
 )
 
-type VaultAuth interface {
-	GetClientCredentials(ctx context.Context) (string, string, error)
-}
+// VaultAuth is the SecretProvider reading the M2M client credentials from Vault
+type VaultAuth = SecretProvider
 
 type vaultAuth struct {
 	vaultServer    string
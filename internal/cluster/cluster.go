@@ -4,18 +4,20 @@ package cluster
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+	corev1 "k8s.io/api/core/v1"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
 const (
 	// HostIdAnnotationKey is the key used to store the host ID in the annotations of the provider machine.
-	HostIdAnnotationKey = "intelmachine.infrastructure.cluster.x-k8s.io/host-id"
+	HostIdAnnotationKey = nodemetadata.HostIdAnnotationKey
 	roleAll             = "all"
+	roleWorker          = "worker"
 )
 
 // Nodes returns the list of nodes in the cluster.
@@ -28,18 +30,115 @@ func Nodes(ctx context.Context, cli *k8s.Client, cluster *capi.Cluster) ([]api.N
 	}
 
 	for _, m := range machines {
-		id, err := getNodeId(ctx, cli, m)
+		annotations, err := cli.ProviderMachineAnnotations(ctx, m.Namespace, m.Spec.InfrastructureRef.Kind, m.Spec.InfrastructureRef.Name)
 		if err != nil {
 			return nodes, err
 		}
-		role := nodeRole(m)
-		status := getNodeStatus(m)
-		nodes = append(nodes, api.NodeInfo{Id: &id, Role: &role, Status: &status})
+		nodes = append(nodes, newNode(m, annotations))
 	}
 
 	return nodes, nil
 }
 
+// NodesWithMachines returns the list of nodes in the cluster with the details of the cluster-api and provider machines
+// backing them. Unlike Nodes, it lists the nodes whose provider machine is not created yet.
+func NodesWithMachines(ctx context.Context, cli *k8s.Client, cluster *capi.Cluster) ([]api.NodeInfo, error) {
+	nodes := []api.NodeInfo{}
+
+	details, err := cli.MachineDetails(ctx, cluster.Namespace, cluster.Name)
+	if err != nil {
+		return nodes, err
+	}
+
+	for _, d := range details {
+		var annotations map[string]string
+		if d.ProviderMachine != nil {
+			annotations = d.ProviderMachine.GetAnnotations()
+		}
+		node := newNode(d.Machine, annotations)
+		node.Machine = machineInfo(d)
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+func newNode(m capi.Machine, annotations map[string]string) api.NodeInfo {
+	id := annotations[HostIdAnnotationKey]
+	role := nodeRole(m)
+	status := getNodeStatus(m)
+	node := api.NodeInfo{Id: &id, Role: &role, Status: &status}
+	if metadata := nodemetadata.FromAnnotations(annotations); len(metadata) > 0 {
+		node.Metadata = &metadata
+	}
+	node.Remediation = nodeRemediation(m)
+	return node
+}
+
+// nodeRemediation returns the health check of the machine by the MachineHealthCheck of the cluster, nil if no
+// MachineHealthCheck checks the machine; unhealthy machines are remediating once their owner was asked to replace them
+func nodeRemediation(m capi.Machine) *api.NodeRemediation {
+	var healthCheck, ownerRemediated *capi.Condition
+	for i, condition := range m.Status.Conditions {
+		switch condition.Type {
+		case capi.MachineHealthCheckSucceededCondition:
+			healthCheck = &m.Status.Conditions[i]
+		case capi.MachineOwnerRemediatedCondition:
+			ownerRemediated = &m.Status.Conditions[i]
+		}
+	}
+
+	var state api.NodeRemediationState
+	condition := healthCheck
+	switch {
+	case ownerRemediated != nil && ownerRemediated.Status == corev1.ConditionFalse:
+		state, condition = api.NodeRemediationStateRemediating, ownerRemediated
+	case healthCheck == nil:
+		return nil
+	case healthCheck.Status == corev1.ConditionTrue:
+		state = api.NodeRemediationStateHealthy
+	default:
+		state = api.NodeRemediationStateUnhealthy
+	}
+
+	remediation := &api.NodeRemediation{State: &state}
+	if condition.Reason != "" {
+		remediation.Reason = convert.Ptr(condition.Reason)
+	}
+	if condition.Message != "" {
+		remediation.Message = convert.Ptr(condition.Message)
+	}
+	if !condition.LastTransitionTime.IsZero() {
+		remediation.Since = convert.Ptr(condition.LastTransitionTime.Time)
+	}
+	return remediation
+}
+
+func machineInfo(d k8s.MachineDetails) *api.MachineInfo {
+	m := d.Machine
+	info := &api.MachineInfo{
+		Name:                convert.Ptr(m.Name),
+		Phase:               convert.Ptr(string(m.Status.GetTypedPhase())),
+		BootstrapReady:      convert.Ptr(m.Status.BootstrapReady),
+		InfrastructureReady: convert.Ptr(m.Status.InfrastructureReady),
+		ProviderKind:        convert.Ptr(m.Spec.InfrastructureRef.Kind),
+		ProviderMachineName: convert.Ptr(m.Spec.InfrastructureRef.Name),
+	}
+	if hostID := d.HostID(); hostID != "" {
+		info.HostId = &hostID
+	}
+	if containerName := d.ContainerName(); containerName != "" {
+		info.ContainerName = &containerName
+	}
+	if d.Binding != nil {
+		info.BindingName = convert.Ptr(d.Binding.Name)
+	}
+	if message := d.FailureMessage(); message != "" {
+		info.FailureMessage = &message
+	}
+	return info
+}
+
 // Template returns the cluster template name.
 func Template(c *capi.Cluster) string {
 	if c == nil || c.Spec.Topology == nil {
@@ -79,27 +178,10 @@ func getNodeStatus(machine capi.Machine) api.StatusInfo {
 	return status
 }
 
-func getNodeId(ctx context.Context, cli *k8s.Client, machine capi.Machine) (string, error) {
-	providerMachineName := machine.Spec.InfrastructureRef.Name
-	providerMachineKind := machine.Spec.InfrastructureRef.Kind
-	switch providerMachineKind {
-	case "IntelMachine":
-		providerMachine, err := cli.IntelMachine(ctx, machine.Namespace, providerMachineName)
-		if err != nil {
-			return "", err
-		}
-		return providerMachine.Annotations[HostIdAnnotationKey], nil
-	case "DockerMachine":
-		providerMachine, err := cli.DockerMachine(ctx, machine.Namespace, providerMachineName)
-		if err != nil {
-			return "", err
-		}
-		return providerMachine.Annotations[HostIdAnnotationKey], nil
-	}
-	return "", fmt.Errorf("unsupported provider machine kind %s", providerMachineKind)
-}
-
-// TODO: add multi-node support
+// nodeRole returns the role of the node backed by the machine, machines of a node pool are worker nodes
 func nodeRole(machine capi.Machine) string {
+	if _, ok := machine.Labels[capi.ClusterTopologyMachineDeploymentNameLabel]; ok {
+		return roleWorker
+	}
 	return roleAll
 }
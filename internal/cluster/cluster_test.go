@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	intelInfraProvider "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
@@ -13,12 +14,15 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	k8score "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sapimachinery "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	_ "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta1"
@@ -42,12 +46,13 @@ func WithIntelMachinesMock(t *testing.T, namespace, clusterName string, machines
 	c.EXPECT().Resource(core.MachineResourceSchema).Return(nMachinesResource)
 
 	// intel machines
-	for _, im := range intelMachines {
-		um, err := convert.ToUnstructured(im)
-		require.NoError(t, err)
-
+	if len(intelMachines) > 0 {
 		intelMachineResource := k8s.NewMockResourceInterface(t)
-		intelMachineResource.EXPECT().Get(mock.Anything, im.ObjectMeta.Name, k8sapimachinery.GetOptions{}).Return(um, nil)
+		for _, im := range intelMachines {
+			um, err := convert.ToUnstructured(im)
+			require.NoError(t, err)
+			intelMachineResource.EXPECT().Get(mock.Anything, im.ObjectMeta.Name, k8sapimachinery.GetOptions{}).Return(um, nil)
+		}
 
 		nIntelMachineResource := k8s.NewMockNamespaceableResourceInterface(t)
 		nIntelMachineResource.EXPECT().Namespace(namespace).Return(intelMachineResource)
@@ -80,12 +85,15 @@ func TestNodes(t *testing.T) {
 	intelMachineName := "test-intel-machine"
 	intelMachineNameId := "host-abcd"
 	intelMachines := []intelInfraProvider.IntelMachine{{
-		ObjectMeta: k8sapimachinery.ObjectMeta{Name: intelMachineName, Namespace: namespace, Annotations: map[string]string{cluster.HostIdAnnotationKey: intelMachineNameId}}}}
+		ObjectMeta: k8sapimachinery.ObjectMeta{Name: intelMachineName, Namespace: namespace, Annotations: map[string]string{
+			cluster.HostIdAnnotationKey:            intelMachineNameId,
+			nodemetadata.AnnotationPrefix + "rack": "r12",
+		}}}}
 
 	// expected output node
 	condition := api.STATUSCONDITIONUNKNOWN
 	status := api.StatusInfo{Condition: &condition, Reason: convert.Ptr("Unknown"), Timestamp: nil}
-	expectedNode := []api.NodeInfo{{Id: convert.Ptr(intelMachineNameId), Role: convert.Ptr("all"), Status: &status}}
+	expectedNode := []api.NodeInfo{{Id: convert.Ptr(intelMachineNameId), Role: convert.Ptr("all"), Status: &status, Metadata: &map[string]string{"rack": "r12"}}}
 
 	// mock k8s client
 	cli := k8s.New(WithIntelMachinesMock(t, namespace, clusterName, machines, intelMachines))
@@ -99,6 +107,149 @@ func TestNodes(t *testing.T) {
 	}
 }
 
+func TestNodesRemediation(t *testing.T) {
+	namespace := "test-namespace"
+	clusterName := "test-cluster"
+	c := &capi.Cluster{ObjectMeta: k8sapimachinery.ObjectMeta{Name: clusterName, Namespace: namespace}}
+	since := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	conditions := map[string]capi.Conditions{
+		"unchecked": nil,
+		"healthy": {
+			{Type: capi.MachineHealthCheckSucceededCondition, Status: k8score.ConditionTrue, LastTransitionTime: k8sapimachinery.NewTime(since)},
+		},
+		"unhealthy": {
+			{Type: capi.MachineHealthCheckSucceededCondition, Status: k8score.ConditionFalse, Reason: capi.NodeStartupTimeoutReason,
+				Message: "Node failed to report startup in 20m0s", LastTransitionTime: k8sapimachinery.NewTime(since)},
+		},
+		"remediating": {
+			{Type: capi.MachineHealthCheckSucceededCondition, Status: k8score.ConditionFalse, Reason: capi.UnhealthyNodeConditionReason,
+				Message: "Condition Ready on node is reporting status False for more than 5m0s", LastTransitionTime: k8sapimachinery.NewTime(since)},
+			{Type: capi.MachineOwnerRemediatedCondition, Status: k8score.ConditionFalse, Reason: capi.WaitingForRemediationReason,
+				LastTransitionTime: k8sapimachinery.NewTime(since.Add(time.Minute))},
+		},
+	}
+	names := []string{"unchecked", "healthy", "unhealthy", "remediating"}
+	machines := []capi.Machine{}
+	intelMachines := []intelInfraProvider.IntelMachine{}
+	for _, name := range names {
+		machines = append(machines, capi.Machine{
+			ObjectMeta: k8sapimachinery.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       capi.MachineSpec{InfrastructureRef: k8score.ObjectReference{Name: name, Kind: "IntelMachine"}},
+			Status:     capi.MachineStatus{Conditions: conditions[name]},
+		})
+		intelMachines = append(intelMachines, intelInfraProvider.IntelMachine{ObjectMeta: k8sapimachinery.ObjectMeta{Name: name, Namespace: namespace}})
+	}
+
+	nodes, err := cluster.Nodes(context.Background(), k8s.New(WithIntelMachinesMock(t, namespace, clusterName, machines, intelMachines)), c)
+	require.NoError(t, err)
+	require.Len(t, nodes, len(names))
+
+	require.Nil(t, nodes[0].Remediation)
+	expected := []struct {
+		state  api.NodeRemediationState
+		reason string
+		since  time.Time
+	}{
+		{state: api.NodeRemediationStateHealthy, since: since},
+		{state: api.NodeRemediationStateUnhealthy, reason: capi.NodeStartupTimeoutReason, since: since},
+		{state: api.NodeRemediationStateRemediating, reason: capi.WaitingForRemediationReason, since: since.Add(time.Minute)},
+	}
+	for i, want := range expected {
+		remediation := nodes[i+1].Remediation
+		require.NotNil(t, remediation, names[i+1])
+		assert.Equal(t, want.state, *remediation.State, names[i+1])
+		if want.reason == "" {
+			assert.Nil(t, remediation.Reason, names[i+1])
+		} else {
+			assert.Equal(t, want.reason, *remediation.Reason, names[i+1])
+		}
+		assert.True(t, want.since.Equal(*remediation.Since), names[i+1])
+	}
+	assert.Equal(t, "Node failed to report startup in 20m0s", *nodes[2].Remediation.Message)
+}
+
+func TestNodesWithMachines(t *testing.T) {
+	namespace := "test-namespace"
+	clusterName := "test-cluster"
+	c := &capi.Cluster{ObjectMeta: k8sapimachinery.ObjectMeta{Name: clusterName, Namespace: namespace}}
+	hostID := "host-abcd"
+
+	machines := []capi.Machine{
+		{
+			ObjectMeta: k8sapimachinery.ObjectMeta{Name: "intel-machine", Namespace: namespace},
+			Spec:       capi.MachineSpec{ClusterName: clusterName, InfrastructureRef: k8score.ObjectReference{Name: "test-intel-machine", Kind: "IntelMachine"}},
+			Status:     capi.MachineStatus{Phase: string(capi.MachinePhaseRunning), BootstrapReady: true, InfrastructureReady: true},
+		},
+		{
+			ObjectMeta: k8sapimachinery.ObjectMeta{Name: "docker-machine", Namespace: namespace},
+			Spec:       capi.MachineSpec{ClusterName: clusterName, InfrastructureRef: k8score.ObjectReference{Name: "workers-x7k2p", Kind: "DockerMachine"}},
+			Status:     capi.MachineStatus{Phase: string(capi.MachinePhaseFailed), FailureMessage: convert.Ptr("bootstrap failed")},
+		},
+	}
+	intelMachine, err := convert.ToUnstructured(intelInfraProvider.IntelMachine{
+		ObjectMeta: k8sapimachinery.ObjectMeta{Name: "test-intel-machine", Namespace: namespace, Annotations: map[string]string{
+			cluster.HostIdAnnotationKey: hostID,
+		}}})
+	require.NoError(t, err)
+	bindings, err := convert.ToUnstructuredList([]intelInfraProvider.IntelMachineBinding{
+		{
+			ObjectMeta: k8sapimachinery.ObjectMeta{Name: "other-cluster-" + hostID, Namespace: namespace},
+			Spec:       intelInfraProvider.IntelMachineBindingSpec{NodeGUID: hostID, ClusterName: "other-cluster"},
+		},
+		{
+			ObjectMeta: k8sapimachinery.ObjectMeta{Name: clusterName + "-" + hostID, Namespace: namespace},
+			Spec:       intelInfraProvider.IntelMachineBindingSpec{NodeGUID: hostID, ClusterName: clusterName},
+		},
+	})
+	require.NoError(t, err)
+	um, err := convert.ToUnstructuredList(machines)
+	require.NoError(t, err)
+
+	resources := map[schema.GroupVersionResource]*k8s.MockResourceInterface{}
+	for _, resourceSchema := range []schema.GroupVersionResource{core.MachineResourceSchema, k8s.IntelMachineResourceSchema, k8s.DockerMachineResourceSchema, core.BindingsResourceSchema} {
+		resources[resourceSchema] = k8s.NewMockResourceInterface(t)
+	}
+	resources[core.MachineResourceSchema].EXPECT().List(mock.Anything, k8sapimachinery.ListOptions{LabelSelector: "cluster.x-k8s.io/cluster-name=" + clusterName}).Return(um, nil)
+	resources[k8s.IntelMachineResourceSchema].EXPECT().Get(mock.Anything, "test-intel-machine", k8sapimachinery.GetOptions{}).Return(intelMachine, nil)
+	resources[k8s.DockerMachineResourceSchema].EXPECT().Get(mock.Anything, "workers-x7k2p", k8sapimachinery.GetOptions{}).Return(nil, apierrors.NewNotFound(schema.GroupResource{Resource: "dockermachines"}, "workers-x7k2p"))
+	resources[core.BindingsResourceSchema].EXPECT().List(mock.Anything, k8sapimachinery.ListOptions{}).Return(bindings, nil)
+	dyn := k8s.NewMockInterface(t)
+	for resourceSchema, resource := range resources {
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(namespace).Return(resource)
+		dyn.EXPECT().Resource(resourceSchema).Return(nsResource)
+	}
+
+	nodes, err := cluster.NodesWithMachines(context.Background(), k8s.New(dyn), c)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	require.Equal(t, hostID, *nodes[0].Id)
+	require.Equal(t, &api.MachineInfo{
+		Name:                convert.Ptr("intel-machine"),
+		Phase:               convert.Ptr("Running"),
+		BootstrapReady:      convert.Ptr(true),
+		InfrastructureReady: convert.Ptr(true),
+		ProviderKind:        convert.Ptr("IntelMachine"),
+		ProviderMachineName: convert.Ptr("test-intel-machine"),
+		HostId:              convert.Ptr(hostID),
+		BindingName:         convert.Ptr(clusterName + "-" + hostID),
+	}, nodes[0].Machine)
+
+	// the node of a provider machine that is not created yet is listed with the failure of its machine
+	require.Equal(t, "", *nodes[1].Id)
+	require.Equal(t, &api.MachineInfo{
+		Name:                convert.Ptr("docker-machine"),
+		Phase:               convert.Ptr("Failed"),
+		BootstrapReady:      convert.Ptr(false),
+		InfrastructureReady: convert.Ptr(false),
+		ProviderKind:        convert.Ptr("DockerMachine"),
+		ProviderMachineName: convert.Ptr("workers-x7k2p"),
+		ContainerName:       convert.Ptr(clusterName + "-workers-x7k2p"),
+		FailureMessage:      convert.Ptr("bootstrap failed"),
+	}, nodes[1].Machine)
+}
+
 func TestTemplate(t *testing.T) {
 	expectedTemplate := "test-template"
 	c := &capi.Cluster{Spec: capi.ClusterSpec{Topology: &capi.Topology{Class: expectedTemplate}}}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+var ErrDependencyCycle = errors.New("cluster dependencies form a cycle")
+
+// Dependencies returns the names of the clusters the cluster depends on, e.g. a local registry cluster an app cluster pulls from.
+func Dependencies(c *capi.Cluster) []string {
+	if c == nil || c.Annotations[core.DependsOnAnnotationKey] == "" {
+		return nil
+	}
+	return strings.Split(c.Annotations[core.DependsOnAnnotationKey], ",")
+}
+
+// SetDependencies records the names of the clusters the cluster depends on.
+func SetDependencies(c *capi.Cluster, dependencies []string) {
+	if len(dependencies) == 0 {
+		delete(c.Annotations, core.DependsOnAnnotationKey)
+		return
+	}
+	if c.Annotations == nil {
+		c.Annotations = map[string]string{}
+	}
+	c.Annotations[core.DependsOnAnnotationKey] = strings.Join(dependencies, ",")
+}
+
+// Dependents returns the sorted names of the clusters that depend on the cluster with the given name.
+func Dependents(clusters []capi.Cluster, name string) []string {
+	var dependents []string
+	for i := range clusters {
+		if slices.Contains(Dependencies(&clusters[i]), name) {
+			dependents = append(dependents, clusters[i].Name)
+		}
+	}
+	slices.Sort(dependents)
+	return dependents
+}
+
+// OrderByDependencies groups the clusters into stages so that every cluster comes after the clusters it depends on.
+// Operations on the clusters of a stage can run in parallel once all earlier stages completed. Dependencies on
+// clusters outside the given set do not constrain the order.
+func OrderByDependencies(clusters []capi.Cluster) ([][]capi.Cluster, error) {
+	remaining := map[string]capi.Cluster{}
+	for _, c := range clusters {
+		remaining[c.Name] = c
+	}
+
+	var stages [][]capi.Cluster
+	for len(remaining) > 0 {
+		var stage []capi.Cluster
+		for _, c := range remaining {
+			blocked := slices.ContainsFunc(Dependencies(&c), func(dependency string) bool {
+				_, ok := remaining[dependency]
+				return ok && dependency != c.Name
+			})
+			if !blocked {
+				stage = append(stage, c)
+			}
+		}
+		if len(stage) == 0 {
+			names := make([]string, 0, len(remaining))
+			for name := range remaining {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			return nil, fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(names, ", "))
+		}
+
+		slices.SortFunc(stage, func(a, b capi.Cluster) int { return strings.Compare(a.Name, b.Name) })
+		for _, c := range stage {
+			delete(remaining, c.Name)
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8sapimachinery "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+func dependentCluster(name string, dependencies ...string) capi.Cluster {
+	c := capi.Cluster{ObjectMeta: k8sapimachinery.ObjectMeta{Name: name}}
+	cluster.SetDependencies(&c, dependencies)
+	return c
+}
+
+func names(clusters []capi.Cluster) []string {
+	var n []string
+	for _, c := range clusters {
+		n = append(n, c.Name)
+	}
+	return n
+}
+
+func TestDependencies(t *testing.T) {
+	c := dependentCluster("app", "registry", "dns")
+	require.Equal(t, "registry,dns", c.Annotations[core.DependsOnAnnotationKey])
+	require.Equal(t, []string{"registry", "dns"}, cluster.Dependencies(&c))
+
+	cluster.SetDependencies(&c, nil)
+	require.NotContains(t, c.Annotations, core.DependsOnAnnotationKey)
+	require.Empty(t, cluster.Dependencies(&c))
+	require.Empty(t, cluster.Dependencies(nil))
+}
+
+func TestDependents(t *testing.T) {
+	clusters := []capi.Cluster{
+		dependentCluster("registry"),
+		dependentCluster("app-2", "registry"),
+		dependentCluster("app-1", "registry", "dns"),
+		dependentCluster("dns"),
+	}
+
+	require.Equal(t, []string{"app-1", "app-2"}, cluster.Dependents(clusters, "registry"))
+	require.Equal(t, []string{"app-1"}, cluster.Dependents(clusters, "dns"))
+	require.Empty(t, cluster.Dependents(clusters, "app-1"))
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	t.Run("dependencies come first", func(t *testing.T) {
+		stages, err := cluster.OrderByDependencies([]capi.Cluster{
+			dependentCluster("app-1", "registry", "gateway"),
+			dependentCluster("gateway", "registry"),
+			dependentCluster("registry"),
+			dependentCluster("app-2", "registry"),
+			dependentCluster("standalone"),
+		})
+		require.NoError(t, err)
+		require.Len(t, stages, 3)
+		require.Equal(t, []string{"registry", "standalone"}, names(stages[0]))
+		require.Equal(t, []string{"app-2", "gateway"}, names(stages[1]))
+		require.Equal(t, []string{"app-1"}, names(stages[2]))
+	})
+
+	t.Run("dependencies outside the set are ignored", func(t *testing.T) {
+		stages, err := cluster.OrderByDependencies([]capi.Cluster{dependentCluster("app-1", "registry")})
+		require.NoError(t, err)
+		require.Len(t, stages, 1)
+		require.Equal(t, []string{"app-1"}, names(stages[0]))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		_, err := cluster.OrderByDependencies([]capi.Cluster{
+			dependentCluster("a", "b"),
+			dependentCluster("b", "a"),
+			dependentCluster("c"),
+		})
+		require.ErrorIs(t, err, cluster.ErrDependencyCycle)
+		require.EqualError(t, err, "cluster dependencies form a cycle: a, b")
+	})
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster
+
+import (
+	"strings"
+
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+// ReservedHosts returns the ids of the hosts reserved for the cluster in the inventory.
+func ReservedHosts(c *capi.Cluster) []string {
+	if c == nil || c.Annotations[core.ReservedHostsAnnotationKey] == "" {
+		return nil
+	}
+	return strings.Split(c.Annotations[core.ReservedHostsAnnotationKey], ",")
+}
+
+// SetReservedHosts records the ids of the hosts reserved for the cluster in the inventory.
+func SetReservedHosts(c *capi.Cluster, hosts []string) {
+	if len(hosts) == 0 {
+		delete(c.Annotations, core.ReservedHostsAnnotationKey)
+		return
+	}
+	if c.Annotations == nil {
+		c.Annotations = map[string]string{}
+	}
+	c.Annotations[core.ReservedHostsAnnotationKey] = strings.Join(hosts, ",")
+}
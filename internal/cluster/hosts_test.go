@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8sapimachinery "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+func TestReservedHosts(t *testing.T) {
+	c := capi.Cluster{ObjectMeta: k8sapimachinery.ObjectMeta{Name: "store-001"}}
+	require.Empty(t, cluster.ReservedHosts(&c))
+
+	cluster.SetReservedHosts(&c, []string{"host-1", "host-2"})
+	require.Equal(t, "host-1,host-2", c.Annotations[core.ReservedHostsAnnotationKey])
+	require.Equal(t, []string{"host-1", "host-2"}, cluster.ReservedHosts(&c))
+
+	cluster.SetReservedHosts(&c, nil)
+	require.NotContains(t, c.Annotations, core.ReservedHostsAnnotationKey)
+	require.Empty(t, cluster.ReservedHosts(nil))
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster
+
+import (
+	"encoding/json"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+)
+
+// ReservedResources returns the resources reserved on the nodes of the cluster, nil if the cluster reserves none.
+func ReservedResources(c *capi.Cluster) *ct.ReservedResources {
+	if c == nil || c.Spec.Topology == nil {
+		return nil
+	}
+	var reserved ct.ReservedResources
+	for _, variable := range c.Spec.Topology.Variables {
+		switch variable.Name {
+		case providers.SystemReserved:
+			reserved.System = parseReservation(variable.Value)
+		case providers.KubeReserved:
+			reserved.Kube = parseReservation(variable.Value)
+		}
+	}
+	if reserved.System == nil && reserved.Kube == nil {
+		return nil
+	}
+	return &reserved
+}
+
+// SetReservedResources sets the resources reserved on the nodes of the cluster as variables of its topology, which
+// the patches of the cluster class set as kubelet arguments of the nodes.
+func SetReservedResources(c *capi.Cluster, reserved *ct.ReservedResources) {
+	var system, kube *ct.ResourceReservation
+	if reserved != nil {
+		system, kube = reserved.System, reserved.Kube
+	}
+	setReservation(c, providers.SystemReserved, system)
+	setReservation(c, providers.KubeReserved, kube)
+}
+
+// MergeReservedResources returns the resources reserved by the template with the amounts set by the override taking
+// precedence.
+func MergeReservedResources(template, override *ct.ReservedResources) *ct.ReservedResources {
+	if template == nil && override == nil {
+		return nil
+	}
+	merged := &ct.ReservedResources{}
+	if template != nil {
+		merged = template.DeepCopy()
+	}
+	if override != nil {
+		merged.System = mergeReservation(merged.System, override.System)
+		merged.Kube = mergeReservation(merged.Kube, override.Kube)
+	}
+	return merged
+}
+
+func mergeReservation(reservation, override *ct.ResourceReservation) *ct.ResourceReservation {
+	if override == nil {
+		return reservation
+	}
+	if reservation == nil {
+		reservation = &ct.ResourceReservation{}
+	}
+	if override.CPU != "" {
+		reservation.CPU = override.CPU
+	}
+	if override.Memory != "" {
+		reservation.Memory = override.Memory
+	}
+	return reservation
+}
+
+func setReservation(c *capi.Cluster, name string, reservation *ct.ResourceReservation) {
+	variables := []capi.ClusterVariable{}
+	for _, variable := range c.Spec.Topology.Variables {
+		if variable.Name != name {
+			variables = append(variables, variable)
+		}
+	}
+	if value := reservation.KubeletValue(); value != "" {
+		raw, _ := json.Marshal(value)
+		variables = append(variables, capi.ClusterVariable{Name: name, Value: apiextensionsv1.JSON{Raw: raw}})
+	}
+	c.Spec.Topology.Variables = variables
+}
+
+// parseReservation parses a reservation in the kubelet format of "cpu=500m,memory=1Gi"
+func parseReservation(value apiextensionsv1.JSON) *ct.ResourceReservation {
+	var kubeletValue string
+	if err := json.Unmarshal(value.Raw, &kubeletValue); err != nil || kubeletValue == "" {
+		return nil
+	}
+	reservation := &ct.ResourceReservation{}
+	for _, amount := range strings.Split(kubeletValue, ",") {
+		resource, quantity, _ := strings.Cut(amount, "=")
+		switch resource {
+		case "cpu":
+			reservation.CPU = quantity
+		case "memory":
+			reservation.Memory = quantity
+		}
+	}
+	return reservation
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+)
+
+func TestReservedResources(t *testing.T) {
+	c := capi.Cluster{Spec: capi.ClusterSpec{Topology: &capi.Topology{
+		Variables: []capi.ClusterVariable{{Name: providers.ReadOnly}},
+	}}}
+	require.Nil(t, cluster.ReservedResources(&c))
+
+	reserved := &ct.ReservedResources{
+		System: &ct.ResourceReservation{CPU: "500m", Memory: "1Gi"},
+		Kube:   &ct.ResourceReservation{Memory: "512Mi"},
+	}
+	cluster.SetReservedResources(&c, reserved)
+	require.Len(t, c.Spec.Topology.Variables, 3)
+	require.Equal(t, `"cpu=500m,memory=1Gi"`, string(c.Spec.Topology.Variables[1].Value.Raw))
+	require.Equal(t, reserved, cluster.ReservedResources(&c))
+
+	cluster.SetReservedResources(&c, nil)
+	require.Len(t, c.Spec.Topology.Variables, 1)
+	require.Nil(t, cluster.ReservedResources(&c))
+	require.Nil(t, cluster.ReservedResources(nil))
+}
+
+func TestMergeReservedResources(t *testing.T) {
+	template := &ct.ReservedResources{
+		System: &ct.ResourceReservation{CPU: "500m", Memory: "1Gi"},
+	}
+	override := &ct.ReservedResources{
+		System: &ct.ResourceReservation{Memory: "2Gi"},
+		Kube:   &ct.ResourceReservation{CPU: "250m"},
+	}
+
+	require.Equal(t, &ct.ReservedResources{
+		System: &ct.ResourceReservation{CPU: "500m", Memory: "2Gi"},
+		Kube:   &ct.ResourceReservation{CPU: "250m"},
+	}, cluster.MergeReservedResources(template, override))
+	require.Equal(t, "1Gi", template.System.Memory, "the template is not modified")
+
+	require.Equal(t, template, cluster.MergeReservedResources(template, nil))
+	require.Nil(t, cluster.MergeReservedResources(nil, nil))
+}
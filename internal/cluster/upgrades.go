@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// UpgradeWarningType is the kind of version skew an upgrade would create
+type UpgradeWarningType string
+
+const (
+	// ControlPlaneMinorSkip warns that the upgrade skips control plane minor versions, which Kubernetes does not support
+	ControlPlaneMinorSkip UpgradeWarningType = "controlPlaneMinorSkip"
+
+	// KubeletSkew warns that kubelets would fall further behind the control plane during the rollout than supported
+	KubeletSkew UpgradeWarningType = "kubeletSkew"
+
+	// NoUpgradePath warns that no published templates exist for the intermediate steps of the upgrade
+	NoUpgradePath UpgradeWarningType = "noUpgradePath"
+
+	// UnsupportedKubernetesVersion warns that the Kubernetes version of the template is outside the support windows of
+	// its control plane provider
+	UnsupportedKubernetesVersion UpgradeWarningType = "unsupportedKubernetesVersion"
+)
+
+// UpgradeWarning is a version skew problem of an upgrade
+type UpgradeWarning struct {
+	Type    UpgradeWarningType
+	Message string
+}
+
+// Upgrade is a template a cluster can be upgraded to
+type Upgrade struct {
+	Template          string
+	KubernetesVersion string
+	Warnings          []UpgradeWarning
+	// Path are the templates to upgrade through in order without creating version skew, ending with Template;
+	// empty if there is no such path
+	Path []string
+}
+
+// MaxKubeletSkew returns how many minor versions kubelets may be behind a control plane of the given version
+func MaxKubeletSkew(controlPlane *version.Version) uint {
+	// the supported skew was raised from two to three minor versions with Kubernetes 1.28
+	if controlPlane.AtLeast(version.MajorMinor(1, 28)) {
+		return 3
+	}
+	return 2
+}
+
+// KubeletVersions returns the kubelet versions of the machines, as reported by their nodes or as requested if their
+// nodes did not report yet
+func KubeletVersions(machines []capi.Machine) []string {
+	var versions []string
+	for _, machine := range machines {
+		switch {
+		case machine.Status.NodeInfo != nil && machine.Status.NodeInfo.KubeletVersion != "":
+			versions = append(versions, machine.Status.NodeInfo.KubeletVersion)
+		case machine.Spec.Version != nil:
+			versions = append(versions, *machine.Spec.Version)
+		}
+	}
+	return versions
+}
+
+// candidate is a template with its parsed Kubernetes version
+type candidate struct {
+	name              string
+	kubernetesVersion string
+	version           *version.Version
+}
+
+// Upgrades returns the published templates with the providers of the current template and a newer Kubernetes version,
+// sorted by version, with the version skew each would create mid-rollout and the recommended path of upgrades that
+// avoids it. Templates with unparsable Kubernetes versions are ignored.
+func Upgrades(current v1alpha1.ClusterTemplate, templates []v1alpha1.ClusterTemplate, kubeletVersions []string) ([]Upgrade, error) {
+	currentVersion, err := version.ParseGeneric(current.Spec.KubernetesVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubernetes version of template '%s': %w", current.Name, err)
+	}
+
+	// the oldest kubelet decides the skew, the control plane version counts as well for nodes that did not report yet
+	oldestKubelet := currentVersion
+	for _, v := range kubeletVersions {
+		kubelet, err := version.ParseGeneric(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubelet version '%s': %w", v, err)
+		}
+		if kubelet.LessThan(oldestKubelet) {
+			oldestKubelet = kubelet
+		}
+	}
+
+	var candidates []candidate
+	for _, template := range templates {
+		if template.Name == current.Name || template.LifecycleState() != v1alpha1.TemplatePublished ||
+			template.Spec.ControlPlaneProviderType != current.Spec.ControlPlaneProviderType ||
+			template.Spec.InfraProviderType != current.Spec.InfraProviderType {
+			continue
+		}
+		v, err := version.ParseGeneric(template.Spec.KubernetesVersion)
+		if err != nil || !v.GreaterThan(currentVersion) {
+			continue
+		}
+		candidates = append(candidates, candidate{name: template.Name, kubernetesVersion: template.Spec.KubernetesVersion, version: v})
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		switch {
+		case a.version.LessThan(b.version):
+			return -1
+		case b.version.LessThan(a.version):
+			return 1
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	upgrades := []Upgrade{}
+	for _, target := range candidates {
+		upgrade := Upgrade{Template: target.name, KubernetesVersion: target.kubernetesVersion, Warnings: []UpgradeWarning{}}
+
+		if distance := minorDistance(currentVersion, target.version); distance > 1 {
+			upgrade.Warnings = append(upgrade.Warnings, UpgradeWarning{
+				Type: ControlPlaneMinorSkip,
+				Message: fmt.Sprintf("upgrading the control plane from v%d.%d to v%d.%d skips %d minor version(s)",
+					currentVersion.Major(), currentVersion.Minor(), target.version.Major(), target.version.Minor(), distance-1),
+			})
+		}
+		if skew, maxSkew := minorDistance(oldestKubelet, target.version), MaxKubeletSkew(target.version); skew > maxSkew {
+			upgrade.Warnings = append(upgrade.Warnings, UpgradeWarning{
+				Type: KubeletSkew,
+				Message: fmt.Sprintf("kubelets at v%d.%d would be %d minor versions behind the v%d.%d control plane during the rollout, at most %d are supported",
+					oldestKubelet.Major(), oldestKubelet.Minor(), skew, target.version.Major(), target.version.Minor(), maxSkew),
+			})
+		}
+
+		upgrade.Path = upgradePath(currentVersion, target, candidates)
+		if upgrade.Path == nil {
+			upgrade.Path = []string{}
+			upgrade.Warnings = append(upgrade.Warnings, UpgradeWarning{
+				Type:    NoUpgradePath,
+				Message: fmt.Sprintf("there are no published templates for the minor versions between v%d.%d and v%d.%d", currentVersion.Major(), currentVersion.Minor(), target.version.Major(), target.version.Minor()),
+			})
+		}
+		upgrades = append(upgrades, upgrade)
+	}
+	return upgrades, nil
+}
+
+// upgradePath returns the templates to upgrade through to the target one minor version at a time, taking the newest
+// template of each minor version, or nil if a minor version has no template
+// Workers are upgraded with the control plane in every step, so one minor version per step keeps the kubelet skew at one
+func upgradePath(from *version.Version, target candidate, candidates []candidate) []string {
+	var path []string
+	for minorDistance(from, target.version) > 1 {
+		var next *candidate
+		for i, c := range candidates {
+			if c.version.GreaterThan(from) && !c.version.GreaterThan(target.version) && minorDistance(from, c.version) <= 1 {
+				next = &candidates[i]
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		path = append(path, next.name)
+		from = next.version
+	}
+	return append(path, target.name)
+}
+
+// minorDistance returns how many minor versions to is ahead of from, assuming major version 1
+func minorDistance(from, to *version.Version) uint {
+	if to.Minor() <= from.Minor() {
+		return 0
+	}
+	return to.Minor() - from.Minor()
+}
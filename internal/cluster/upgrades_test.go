@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sapimachinery "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+)
+
+func upgradeTemplate(name, kubernetesVersion string) v1alpha1.ClusterTemplate {
+	return v1alpha1.ClusterTemplate{
+		ObjectMeta: k8sapimachinery.ObjectMeta{Name: name},
+		Spec: v1alpha1.ClusterTemplateSpec{
+			ControlPlaneProviderType: "k3s",
+			InfraProviderType:        "intel",
+			KubernetesVersion:        kubernetesVersion,
+		},
+	}
+}
+
+func warningTypes(upgrade cluster.Upgrade) []cluster.UpgradeWarningType {
+	types := []cluster.UpgradeWarningType{}
+	for _, warning := range upgrade.Warnings {
+		types = append(types, warning.Type)
+	}
+	return types
+}
+
+func TestUpgrades(t *testing.T) {
+	current := upgradeTemplate("baseline-v1.0.0", "v1.27.4+k3s1")
+
+	t.Run("only published templates of the same providers with newer versions are offered", func(t *testing.T) {
+		draft := upgradeTemplate("draft-v1.0.0", "v1.28.1+k3s1")
+		draft.Spec.LifecycleState = v1alpha1.TemplateDraft
+		kubeadm := upgradeTemplate("kubeadm-v1.0.0", "v1.28.1")
+		kubeadm.Spec.ControlPlaneProviderType = "kubeadm"
+
+		upgrades, err := cluster.Upgrades(current, []v1alpha1.ClusterTemplate{
+			current,
+			upgradeTemplate("old-v1.0.0", "v1.26.0+k3s1"),
+			draft,
+			kubeadm,
+			upgradeTemplate("baseline-v1.0.1", "v1.27.9+k3s1"),
+			upgradeTemplate("baseline-v2.0.0", "v1.28.1+k3s1"),
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, upgrades, 2)
+
+		require.Equal(t, "baseline-v1.0.1", upgrades[0].Template)
+		require.Equal(t, "v1.27.9+k3s1", upgrades[0].KubernetesVersion)
+		require.Empty(t, upgrades[0].Warnings)
+		require.Equal(t, []string{"baseline-v1.0.1"}, upgrades[0].Path)
+
+		require.Equal(t, "baseline-v2.0.0", upgrades[1].Template)
+		require.Empty(t, upgrades[1].Warnings)
+		require.Equal(t, []string{"baseline-v2.0.0"}, upgrades[1].Path)
+	})
+
+	t.Run("skipping minor versions is warned about and a multi-step path is recommended", func(t *testing.T) {
+		upgrades, err := cluster.Upgrades(current, []v1alpha1.ClusterTemplate{
+			upgradeTemplate("baseline-v2.0.0", "v1.28.1+k3s1"),
+			upgradeTemplate("baseline-v2.0.1", "v1.28.5+k3s1"),
+			upgradeTemplate("baseline-v3.0.0", "v1.29.2+k3s1"),
+			upgradeTemplate("baseline-v4.0.0", "v1.30.6+k3s1"),
+		}, []string{"v1.27.4+k3s1"})
+		require.NoError(t, err)
+		require.Len(t, upgrades, 4)
+
+		upgrade := upgrades[3]
+		require.Equal(t, "baseline-v4.0.0", upgrade.Template)
+		require.Equal(t, []cluster.UpgradeWarningType{cluster.ControlPlaneMinorSkip}, warningTypes(upgrade))
+		require.Equal(t, []string{"baseline-v2.0.1", "baseline-v3.0.0", "baseline-v4.0.0"}, upgrade.Path)
+	})
+
+	t.Run("kubelets lagging behind the control plane are warned about", func(t *testing.T) {
+		upgrades, err := cluster.Upgrades(current, []v1alpha1.ClusterTemplate{
+			upgradeTemplate("baseline-v2.0.0", "v1.28.1+k3s1"),
+		}, []string{"v1.27.4+k3s1", "v1.24.3+k3s1"})
+		require.NoError(t, err)
+		require.Len(t, upgrades, 1)
+		require.Equal(t, []cluster.UpgradeWarningType{cluster.KubeletSkew}, warningTypes(upgrades[0]))
+		require.Contains(t, upgrades[0].Warnings[0].Message, "4 minor versions behind")
+	})
+
+	t.Run("missing intermediate templates leave no path", func(t *testing.T) {
+		upgrades, err := cluster.Upgrades(current, []v1alpha1.ClusterTemplate{
+			upgradeTemplate("baseline-v4.0.0", "v1.30.6+k3s1"),
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, upgrades, 1)
+		require.Equal(t, []cluster.UpgradeWarningType{cluster.ControlPlaneMinorSkip, cluster.NoUpgradePath}, warningTypes(upgrades[0]))
+		require.Empty(t, upgrades[0].Path)
+	})
+
+	t.Run("invalid kubelet versions are rejected", func(t *testing.T) {
+		_, err := cluster.Upgrades(current, nil, []string{"latest"})
+		require.Error(t, err)
+	})
+}
+
+func TestMaxKubeletSkew(t *testing.T) {
+	require.Equal(t, uint(2), cluster.MaxKubeletSkew(version.MustParseGeneric("v1.27.4+k3s1")))
+	require.Equal(t, uint(3), cluster.MaxKubeletSkew(version.MustParseGeneric("v1.28.0")))
+}
+
+func TestKubeletVersions(t *testing.T) {
+	requested := "v1.30.6+k3s1"
+	machines := []capi.Machine{
+		{Spec: capi.MachineSpec{Version: &requested}, Status: capi.MachineStatus{NodeInfo: &corev1.NodeSystemInfo{KubeletVersion: "v1.29.2+k3s1"}}},
+		{Spec: capi.MachineSpec{Version: &requested}},
+		{},
+	}
+	require.Equal(t, []string{"v1.29.2+k3s1", "v1.30.6+k3s1"}, cluster.KubeletVersions(machines))
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clustermetrics records the lifecycle metrics of the clusters from the changes the cluster informer observes:
+// how long clusters take to be provisioned and deleted, the clusters per phase and the clusters using each template.
+// Clusters in maintenance are counted in the Maintenance phase rather than in their Cluster API phase.
+package clustermetrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+// MaintenancePhase is the phase the clusters in maintenance are counted in instead of their Cluster API phase
+const MaintenancePhase = "Maintenance"
+
+// Recorder records the lifecycle metrics of the clusters. The provisioning duration of a cluster is observed when it
+// becomes ready for the first time since it was created, from its creation to the transition of its ready condition;
+// clusters that were not ready when the recorder started are assumed to be provisioned when they become ready.
+type Recorder struct {
+	provisioning prometheus.Observer
+	deletion     prometheus.Observer
+	phases       *prometheus.GaugeVec
+	templates    *prometheus.GaugeVec
+	now          func() time.Time
+
+	mu sync.Mutex
+	// ready are the clusters that were ready, whose provisioning is completed
+	ready map[types.NamespacedName]struct{}
+	// phaseCounts and templateCounts are the clusters per project and phase or template, the gauges of the label
+	// values without clusters are removed
+	phaseCounts    map[[2]string]int
+	templateCounts map[[2]string]int
+}
+
+// NewRecorder creates a new Recorder of the cluster lifecycle metrics
+func NewRecorder() *Recorder {
+	return &Recorder{
+		provisioning:   metrics.ClusterProvisioningDuration,
+		deletion:       metrics.ClusterDeletionDuration,
+		phases:         metrics.ClustersByPhaseGauge,
+		templates:      metrics.TemplateInUseGauge,
+		now:            time.Now,
+		ready:          map[types.NamespacedName]struct{}{},
+		phaseCounts:    map[[2]string]int{},
+		templateCounts: map[[2]string]int{},
+	}
+}
+
+// ClusterChanged updates the metrics with a change of a cluster, it is meant to be added as handler of the
+// ClusterInformer
+func (r *Recorder) ClusterChanged(old, new *capi.Cluster) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if old != nil {
+		r.count(r.phases, r.phaseCounts, old.Namespace, Phase(old), -1)
+		r.count(r.templates, r.templateCounts, old.Namespace, old.Annotations[core.TemplateLabelKey], -1)
+	}
+	if new != nil {
+		r.count(r.phases, r.phaseCounts, new.Namespace, Phase(new), 1)
+		r.count(r.templates, r.templateCounts, new.Namespace, new.Annotations[core.TemplateLabelKey], 1)
+	}
+
+	switch {
+	case new == nil:
+		r.clusterDeleted(old)
+	case old == nil:
+		// the provisioning of the clusters that are already ready when they are added was observed before
+		if readyCondition(new) != nil {
+			r.ready[key(new)] = struct{}{}
+		}
+	default:
+		r.clusterUpdated(new)
+	}
+}
+
+func (r *Recorder) clusterUpdated(cluster *capi.Cluster) {
+	ready := readyCondition(cluster)
+	if ready == nil {
+		return
+	}
+	if _, ok := r.ready[key(cluster)]; ok {
+		return
+	}
+	r.ready[key(cluster)] = struct{}{}
+	r.provisioning.Observe(ready.LastTransitionTime.Sub(cluster.CreationTimestamp.Time).Seconds())
+}
+
+func (r *Recorder) clusterDeleted(cluster *capi.Cluster) {
+	delete(r.ready, key(cluster))
+	// the deletion timestamp is not known if the deletion of the cluster was missed while the watch was disconnected
+	if cluster.DeletionTimestamp != nil {
+		r.deletion.Observe(r.now().Sub(cluster.DeletionTimestamp.Time).Seconds())
+	}
+}
+
+// count adds delta to the clusters of the project with the given label value and updates the gauge
+func (r *Recorder) count(gauge *prometheus.GaugeVec, counts map[[2]string]int, project, value string, delta int) {
+	if value == "" {
+		return
+	}
+	labels := [2]string{project, value}
+	counts[labels] += delta
+	if counts[labels] <= 0 {
+		delete(counts, labels)
+		gauge.DeleteLabelValues(project, value)
+		return
+	}
+	gauge.WithLabelValues(project, value).Set(float64(counts[labels]))
+}
+
+func key(cluster *capi.Cluster) types.NamespacedName {
+	return types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name}
+}
+
+// Phase returns the phase the cluster is counted in, clusters in maintenance are counted in the MaintenancePhase so
+// that the alerts on the phases of clusters do not fire for clusters whose nodes are shut down on purpose
+func Phase(cluster *capi.Cluster) string {
+	if _, ok := cluster.Annotations[core.MaintenanceSinceAnnotationKey]; ok {
+		return MaintenancePhase
+	}
+	return string(cluster.Status.GetTypedPhase())
+}
+
+// readyCondition returns the ready condition of the cluster if it is true
+func readyCondition(cluster *capi.Cluster) *capi.Condition {
+	for i, condition := range cluster.Status.Conditions {
+		if condition.Type == capi.ReadyCondition && condition.Status == corev1.ConditionTrue {
+			return &cluster.Status.Conditions[i]
+		}
+	}
+	return nil
+}
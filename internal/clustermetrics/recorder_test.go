@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package clustermetrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+var created = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// observations are the values observed by a histogram
+type observations []float64
+
+func (o *observations) Observe(value float64) {
+	*o = append(*o, value)
+}
+
+// testRecorder returns a Recorder of unregistered metrics and the observations of its provisioning and deletion
+// histograms
+func testRecorder(now time.Time) (*Recorder, *observations, *observations) {
+	provisioning, deletion := &observations{}, &observations{}
+	r := NewRecorder()
+	r.provisioning = provisioning
+	r.deletion = deletion
+	r.phases = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "phases"}, []string{"project", "phase"})
+	r.templates = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "templates"}, []string{"project", "template"})
+	r.now = func() time.Time { return now }
+	return r, provisioning, deletion
+}
+
+func cluster(name string, phase capi.ClusterPhase, readyAt *time.Time) *capi.Cluster {
+	c := &capi.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         projectID,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+			Annotations:       map[string]string{core.TemplateLabelKey: "baseline-v1.0.0"},
+		},
+	}
+	c.Status.SetTypedPhase(phase)
+	if readyAt != nil {
+		c.Status.Conditions = capi.Conditions{{Type: capi.ReadyCondition, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(*readyAt)}}
+	}
+	return c
+}
+
+func TestRecorder(t *testing.T) {
+	readyAt := created.Add(10 * time.Minute)
+
+	t.Run("provisioning duration is observed when a cluster becomes ready for the first time", func(t *testing.T) {
+		r, provisioning, _ := testRecorder(created)
+
+		provisioningCluster := cluster("edge-1", capi.ClusterPhaseProvisioning, nil)
+		r.ClusterChanged(nil, provisioningCluster)
+		ready := cluster("edge-1", capi.ClusterPhaseProvisioned, &readyAt)
+		r.ClusterChanged(provisioningCluster, ready)
+		// a cluster ready again is not provisioned again
+		notReady := cluster("edge-1", capi.ClusterPhaseProvisioned, nil)
+		r.ClusterChanged(ready, notReady)
+		r.ClusterChanged(notReady, cluster("edge-1", capi.ClusterPhaseProvisioned, &readyAt))
+
+		assert.Equal(t, &observations{(10 * time.Minute).Seconds()}, provisioning)
+	})
+
+	t.Run("clusters ready when added were provisioned before", func(t *testing.T) {
+		r, provisioning, _ := testRecorder(created)
+
+		ready := cluster("edge-1", capi.ClusterPhaseProvisioned, &readyAt)
+		r.ClusterChanged(nil, ready)
+		r.ClusterChanged(ready, ready)
+
+		assert.Empty(t, *provisioning)
+	})
+
+	t.Run("deletion duration is observed when a cluster is removed", func(t *testing.T) {
+		r, _, deletion := testRecorder(created.Add(time.Hour + 3*time.Minute))
+
+		deleting := cluster("edge-1", capi.ClusterPhaseDeleting, &readyAt)
+		deletionTimestamp := metav1.NewTime(created.Add(time.Hour))
+		deleting.DeletionTimestamp = &deletionTimestamp
+		r.ClusterChanged(nil, deleting)
+		r.ClusterChanged(deleting, nil)
+		// the deletion of clusters without deletion timestamp was missed
+		r.ClusterChanged(nil, cluster("edge-2", capi.ClusterPhaseProvisioned, &readyAt))
+		r.ClusterChanged(cluster("edge-2", capi.ClusterPhaseProvisioned, &readyAt), nil)
+
+		assert.Equal(t, &observations{(3 * time.Minute).Seconds()}, deletion)
+	})
+
+	t.Run("clusters are counted per phase and template", func(t *testing.T) {
+		r, _, _ := testRecorder(created)
+
+		provisioning := cluster("edge-1", capi.ClusterPhaseProvisioning, nil)
+		r.ClusterChanged(nil, provisioning)
+		r.ClusterChanged(nil, cluster("edge-2", capi.ClusterPhaseProvisioning, nil))
+		assert.Equal(t, 2.0, testutil.ToFloat64(r.phases.WithLabelValues(projectID, "Provisioning")))
+		assert.Equal(t, 2.0, testutil.ToFloat64(r.templates.WithLabelValues(projectID, "baseline-v1.0.0")))
+
+		provisioned := cluster("edge-1", capi.ClusterPhaseProvisioned, &readyAt)
+		r.ClusterChanged(provisioning, provisioned)
+		assert.Equal(t, 1.0, testutil.ToFloat64(r.phases.WithLabelValues(projectID, "Provisioning")))
+		assert.Equal(t, 1.0, testutil.ToFloat64(r.phases.WithLabelValues(projectID, "Provisioned")))
+		assert.Equal(t, 2.0, testutil.ToFloat64(r.templates.WithLabelValues(projectID, "baseline-v1.0.0")))
+
+		r.ClusterChanged(provisioned, nil)
+		assert.Equal(t, 1, testutil.CollectAndCount(r.phases), "the phases without clusters are removed")
+		assert.Equal(t, 1.0, testutil.ToFloat64(r.templates.WithLabelValues(projectID, "baseline-v1.0.0")))
+	})
+
+	t.Run("clusters in maintenance are counted in the maintenance phase", func(t *testing.T) {
+		r, _, _ := testRecorder(created)
+
+		provisioned := cluster("edge-1", capi.ClusterPhaseProvisioned, &readyAt)
+		r.ClusterChanged(nil, provisioned)
+		maintenance := provisioned.DeepCopy()
+		maintenance.Annotations[core.MaintenanceSinceAnnotationKey] = created.Format(time.RFC3339)
+		r.ClusterChanged(provisioned, maintenance)
+		assert.Equal(t, 1.0, testutil.ToFloat64(r.phases.WithLabelValues(projectID, MaintenancePhase)))
+		assert.Equal(t, 1, testutil.CollectAndCount(r.phases), "clusters in maintenance are not counted in their phase")
+
+		r.ClusterChanged(maintenance, provisioned)
+		assert.Equal(t, 1.0, testutil.ToFloat64(r.phases.WithLabelValues(projectID, "Provisioned")))
+		assert.Equal(t, 1, testutil.CollectAndCount(r.phases))
+	})
+}
@@ -5,14 +5,20 @@ package common
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 )
 
+// WorkerClass is the MachineDeployment class used by the worker node pools of a cluster
+const WorkerClass = "default-worker"
+
 func GetClusterClass(name types.NamespacedName) capiv1beta1.ClusterClass {
 	return capiv1beta1.ClusterClass{
 		ObjectMeta: metav1.ObjectMeta{
@@ -34,10 +40,7 @@ func GetClusterClass(name types.NamespacedName) capiv1beta1.ClusterClass {
 					},
 				},
 				MachineHealthCheck: &capiv1beta1.MachineHealthCheckClass{
-					UnhealthyConditions: []capiv1beta1.UnhealthyCondition{
-						{Status: corev1.ConditionUnknown, Timeout: metav1.Duration{Duration: 300 * time.Second}, Type: corev1.NodeReady},
-						{Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: 300 * time.Second}, Type: corev1.NodeReady},
-					},
+					UnhealthyConditions: defaultUnhealthyConditions(),
 				},
 			},
 			Infrastructure: capiv1beta1.LocalObjectTemplate{
@@ -46,6 +49,70 @@ func GetClusterClass(name types.NamespacedName) capiv1beta1.ClusterClass {
 					Name:       name.Name,
 				},
 			},
+			Workers: capiv1beta1.WorkersClass{
+				MachineDeployments: []capiv1beta1.MachineDeploymentClass{
+					{
+						Class: WorkerClass,
+						Template: capiv1beta1.MachineDeploymentClassTemplate{
+							Bootstrap: capiv1beta1.LocalObjectTemplate{
+								Ref: &corev1.ObjectReference{
+									APIVersion: "bootstrap.cluster.x-k8s.io/v1beta1",
+									Name:       fmt.Sprintf("%s-worker", name.Name),
+								},
+							},
+							Infrastructure: capiv1beta1.LocalObjectTemplate{
+								Ref: &corev1.ObjectReference{
+									APIVersion: "infrastructure.cluster.x-k8s.io/v1beta1",
+									Name:       fmt.Sprintf("%s-worker", name.Name),
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
+
+// defaultUnhealthyConditions are the node conditions marking the nodes of the control plane unhealthy, and those of
+// the node pools if the template remediates them without unhealthy conditions
+func defaultUnhealthyConditions() []capiv1beta1.UnhealthyCondition {
+	return []capiv1beta1.UnhealthyCondition{
+		{Status: corev1.ConditionUnknown, Timeout: metav1.Duration{Duration: 300 * time.Second}, Type: corev1.NodeReady},
+		{Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: 300 * time.Second}, Type: corev1.NodeReady},
+	}
+}
+
+// SetMachineHealthChecks sets the health checks of the control plane and of the worker class of the ClusterClass from
+// the remediation settings of the ClusterTemplate; the topology of the clusters renders them into a MachineHealthCheck
+// for the control plane and for every node pool. Without remediation settings only the control plane is checked.
+func SetMachineHealthChecks(cc *capiv1beta1.ClusterClass, remediation *v1alpha1.RemediationConfig) {
+	if remediation == nil {
+		return
+	}
+
+	unhealthyConditions := defaultUnhealthyConditions()
+	if len(remediation.UnhealthyConditions) > 0 {
+		unhealthyConditions = make([]capiv1beta1.UnhealthyCondition, 0, len(remediation.UnhealthyConditions))
+		for _, condition := range remediation.UnhealthyConditions {
+			unhealthyConditions = append(unhealthyConditions, capiv1beta1.UnhealthyCondition{
+				Type:    condition.Type,
+				Status:  condition.Status,
+				Timeout: condition.Timeout,
+			})
+		}
+	}
+	healthCheck := func() *capiv1beta1.MachineHealthCheckClass {
+		copied := remediation.DeepCopy()
+		return &capiv1beta1.MachineHealthCheckClass{
+			UnhealthyConditions: slices.Clone(unhealthyConditions),
+			MaxUnhealthy:        copied.MaxUnhealthy,
+			NodeStartupTimeout:  copied.NodeStartupTimeout,
+		}
+	}
+
+	cc.Spec.ControlPlane.MachineHealthCheck = healthCheck()
+	for i := range cc.Spec.Workers.MachineDeployments {
+		cc.Spec.Workers.MachineDeployments[i].MachineHealthCheck = healthCheck()
+	}
+}
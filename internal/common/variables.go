@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// SetTemplateVariables declares the variables of the ClusterTemplate as variables of the ClusterClass and adds their
+// patches; the patches select the control plane and worker bootstrap templates the provider set on the ClusterClass.
+func SetTemplateVariables(cc *capiv1beta1.ClusterClass, variables []v1alpha1.TemplateVariable) {
+	for _, variable := range variables {
+		cc.Spec.Variables = append(cc.Spec.Variables, capiv1beta1.ClusterClassVariable{
+			Name:     variable.Name,
+			Required: variable.Required,
+			Schema: capiv1beta1.VariableSchema{
+				OpenAPIV3Schema: variableSchema(variable),
+			},
+		})
+
+		var definitions []capiv1beta1.PatchDefinition
+		for _, patch := range variable.Patches {
+			selector, ok := patchSelector(cc, patch.Target)
+			if !ok {
+				continue
+			}
+			jsonPatch := capiv1beta1.JSONPatch{
+				Op:        patch.Op,
+				Path:      patch.Path,
+				ValueFrom: &capiv1beta1.JSONPatchValue{},
+			}
+			if jsonPatch.Op == "" {
+				jsonPatch.Op = "add"
+			}
+			if patch.ValueTemplate != "" {
+				jsonPatch.ValueFrom.Template = &patch.ValueTemplate
+			} else {
+				jsonPatch.ValueFrom.Variable = &variable.Name
+			}
+			definitions = append(definitions, capiv1beta1.PatchDefinition{
+				Selector:    selector,
+				JSONPatches: []capiv1beta1.JSONPatch{jsonPatch},
+			})
+		}
+		if len(definitions) == 0 {
+			continue
+		}
+
+		ccPatch := capiv1beta1.ClusterClassPatch{
+			Name:        "template-variable-" + variable.Name,
+			Description: fmt.Sprintf("This patch will set the template variable %s.", variable.Name),
+			Definitions: definitions,
+		}
+		// the variables of the clusters not setting optional variables are unset, so their patches have no value
+		if !variable.Required && variable.Default == nil {
+			enabledIf := fmt.Sprintf("{{ if .%s }}true{{ end }}", variable.Name)
+			ccPatch.EnabledIf = &enabledIf
+		}
+		cc.Spec.Patches = append(cc.Spec.Patches, ccPatch)
+	}
+}
+
+// patchSelector selects the control plane template or the bootstrap templates of the worker classes of the ClusterClass
+func patchSelector(cc *capiv1beta1.ClusterClass, target string) (capiv1beta1.PatchSelector, bool) {
+	switch target {
+	case "controlPlane":
+		ref := cc.Spec.ControlPlane.Ref
+		if ref == nil {
+			return capiv1beta1.PatchSelector{}, false
+		}
+		return capiv1beta1.PatchSelector{
+			APIVersion:     ref.APIVersion,
+			Kind:           ref.Kind,
+			MatchResources: capiv1beta1.PatchSelectorMatch{ControlPlane: true},
+		}, true
+	case "workers":
+		if len(cc.Spec.Workers.MachineDeployments) == 0 || cc.Spec.Workers.MachineDeployments[0].Template.Bootstrap.Ref == nil {
+			return capiv1beta1.PatchSelector{}, false
+		}
+		ref := cc.Spec.Workers.MachineDeployments[0].Template.Bootstrap.Ref
+		names := []string{}
+		for _, md := range cc.Spec.Workers.MachineDeployments {
+			names = append(names, md.Class)
+		}
+		return capiv1beta1.PatchSelector{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			MatchResources: capiv1beta1.PatchSelectorMatch{
+				MachineDeploymentClass: &capiv1beta1.PatchSelectorMatchMachineDeploymentClass{Names: names},
+			},
+		}, true
+	default:
+		return capiv1beta1.PatchSelector{}, false
+	}
+}
+
+// variableSchema returns the schema of the ClusterClass variable of a template variable; Cluster API validates the
+// values of the clusters against it once more
+func variableSchema(variable v1alpha1.TemplateVariable) capiv1beta1.JSONSchemaProps {
+	schema := capiv1beta1.JSONSchemaProps{
+		Description: variable.Description,
+		Type:        string(variable.Type),
+	}
+	switch variable.Type {
+	case v1alpha1.IntegerVariable:
+		schema.Minimum = variable.Minimum
+		schema.Maximum = variable.Maximum
+	case v1alpha1.StringVariable:
+		schema.Pattern = variable.Pattern
+		schema.MinLength = variable.MinLength
+		schema.MaxLength = variable.MaxLength
+	case v1alpha1.EnumVariable:
+		schema.Type = "string"
+		for _, value := range variable.Enum {
+			raw, _ := json.Marshal(value)
+			schema.Enum = append(schema.Enum, apiextensionsv1.JSON{Raw: raw})
+		}
+	}
+	if variable.Default != nil {
+		if value, err := ParseVariableValue(variable, *variable.Default); err == nil {
+			raw, _ := json.Marshal(value)
+			schema.Default = &apiextensionsv1.JSON{Raw: raw}
+		}
+	}
+	return schema
+}
+
+// ParseVariableValue returns the value of the variable in its string form, e.g. its default
+func ParseVariableValue(variable v1alpha1.TemplateVariable, value string) (interface{}, error) {
+	switch variable.Type {
+	case v1alpha1.IntegerVariable:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("variable %s must be an integer, got %q", variable.Name, value)
+		}
+		return parsed, nil
+	case v1alpha1.BooleanVariable:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("variable %s must be a boolean, got %q", variable.Name, value)
+		}
+		return parsed, nil
+	default:
+		return value, nil
+	}
+}
+
+// ValidateVariableValue returns an error if the value, as decoded from JSON, does not have the type of the variable or
+// does not pass its validation
+func ValidateVariableValue(variable v1alpha1.TemplateVariable, value interface{}) error {
+	switch variable.Type {
+	case v1alpha1.IntegerVariable:
+		var number int64
+		switch v := value.(type) {
+		case int64:
+			number = v
+		case int:
+			number = int64(v)
+		case float64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v > math.MaxInt64 {
+				return fmt.Errorf("variable %s must be an integer, got %v", variable.Name, v)
+			}
+			number = int64(v)
+		default:
+			return fmt.Errorf("variable %s must be an integer, got %v", variable.Name, value)
+		}
+		if variable.Minimum != nil && number < *variable.Minimum {
+			return fmt.Errorf("variable %s must be at least %d, got %d", variable.Name, *variable.Minimum, number)
+		}
+		if variable.Maximum != nil && number > *variable.Maximum {
+			return fmt.Errorf("variable %s must be at most %d, got %d", variable.Name, *variable.Maximum, number)
+		}
+	case v1alpha1.BooleanVariable:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("variable %s must be a boolean, got %v", variable.Name, value)
+		}
+	case v1alpha1.StringVariable:
+		text, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("variable %s must be a string, got %v", variable.Name, value)
+		}
+		length := int64(utf8.RuneCountInString(text))
+		if variable.MinLength != nil && length < *variable.MinLength {
+			return fmt.Errorf("variable %s must be at least %d characters long", variable.Name, *variable.MinLength)
+		}
+		if variable.MaxLength != nil && length > *variable.MaxLength {
+			return fmt.Errorf("variable %s must be at most %d characters long", variable.Name, *variable.MaxLength)
+		}
+		if variable.Pattern != "" {
+			pattern, err := regexp.Compile(variable.Pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern of variable %s: %w", variable.Name, err)
+			}
+			if !pattern.MatchString(text) {
+				return fmt.Errorf("variable %s must match %s, got %q", variable.Name, variable.Pattern, text)
+			}
+		}
+	case v1alpha1.EnumVariable:
+		text, ok := value.(string)
+		if !ok || !slices.Contains(variable.Enum, text) {
+			return fmt.Errorf("variable %s must be one of %v, got %v", variable.Name, variable.Enum, value)
+		}
+	default:
+		return fmt.Errorf("variable %s has the unknown type %q", variable.Name, variable.Type)
+	}
+	return nil
+}
+
+// ResolveVariableValues validates the values a cluster sets for the variables of its template and returns them with
+// the defaults of the variables it does not set; a value of a variable the template does not declare is rejected, as
+// is a cluster not setting a required variable.
+func ResolveVariableValues(variables []v1alpha1.TemplateVariable, values map[string]interface{}) (map[string]interface{}, error) {
+	declared := make(map[string]bool, len(variables))
+	resolved := make(map[string]interface{}, len(variables))
+	for _, variable := range variables {
+		declared[variable.Name] = true
+		value, ok := values[variable.Name]
+		switch {
+		case ok:
+			if err := ValidateVariableValue(variable, value); err != nil {
+				return nil, err
+			}
+			resolved[variable.Name] = value
+		case variable.Default != nil:
+			value, err := ParseVariableValue(variable, *variable.Default)
+			if err != nil {
+				return nil, err
+			}
+			resolved[variable.Name] = value
+		case variable.Required:
+			return nil, fmt.Errorf("variable %s is required", variable.Name)
+		}
+	}
+
+	var unknown []string
+	for name := range values {
+		if !declared[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown variables %v", unknown)
+	}
+	return resolved, nil
+}
@@ -9,12 +9,19 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/gitops"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/leader"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/naming"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
 )
 
 type Config struct {
@@ -27,21 +34,235 @@ type Config struct {
 	// DisableInventory disables inventory integration, should be false for production and true in integration without infra-manager's inventory
 	DisableInventory bool
 
+	// InventoryStub replaces the inventory with a stub returning deterministic fake host data, so that the flows depending
+	// on the inventory can be exercised in development environments without it, e.g. kind based ones
+	InventoryStub bool
+
 	// DisableMetrics disables metrics, should be false for production and true in integration without prometheus
 	DisableMetrics bool
 
+	// DisableReadCache sends all reads of the GET handlers to the Kubernetes API server instead of the informer cache
+	DisableReadCache bool
+
+	// DisableKubeconfigCleanup keeps the kubeconfig secrets of deleted clusters instead of deleting them
+	DisableKubeconfigCleanup bool
+
 	// Default template name to use for new projects
 	DefaultTemplate string
 
 	// KubeconfigTTL specifies the TTL for kubeconfig JWT tokens
 	KubeconfigTTL time.Duration
 
+	// KubeconfigOidcIssuer is the issuer URL of the OIDC provider, as reachable by the users, that the kubeconfigs with
+	// the OIDC exec credential plugin get tokens from; empty uses the keycloak of the cluster domain
+	KubeconfigOidcIssuer string
+
+	// KubeconfigOidcClientID is the public OIDC client the kubeconfigs with the OIDC exec credential plugin log in with
+	KubeconfigOidcClientID string
+
+	// KubeconfigServerURL is the URL of the connect gateway, as reachable by the users, the server URLs of the kubeconfigs
+	// are rewritten to; empty uses the connect gateway of the cluster domain
+	KubeconfigServerURL string
+
+	// KubeconfigContextName is the name of the context of the kubeconfigs, in which {project}, {cluster} and {user} are
+	// replaced; empty names the contexts {user}@{cluster}
+	KubeconfigContextName string
+
+	// KubeconfigCABundlePath is the file with PEM encoded CA certificates added to the certificate authority of the
+	// kubeconfigs, e.g. of a TLS terminating proxy in front of the connect gateway; empty adds none
+	KubeconfigCABundlePath string
+
+	// M2MSecretBackend is the backend the credentials of the M2M client the kubeconfig tokens are issued for are read
+	// from [vault|kubernetes|file]
+	M2MSecretBackend string
+
+	// M2MSecret locates the M2M client credentials in their backend: the Secret, as namespace/name, of the kubernetes
+	// backend and the directory of the client_id and client_secret files of the file backend
+	M2MSecret string
+
+	// OidcProvider is the kind of the OIDC provider the API requests are authenticated with and the kubeconfig tokens
+	// are issued by [keycloak|generic]; the kubeconfig token TTL and revocation require keycloak
+	OidcProvider string
+
+	// OidcRolesClaim is the dot-separated path of the claim of the tokens the roles are read from
+	OidcRolesClaim string
+
+	// KubeconfigRetention is how long the kubeconfig secret of a deleted cluster is retained before it is deleted; 0 deletes it immediately
+	KubeconfigRetention time.Duration
+
+	// EnableAPIDocs serves the Swagger UI of the REST API at /v2/docs
+	EnableAPIDocs bool
+
+	// ValidateNodes checks that the hosts of the nodes of created clusters exist in the inventory, are not bound to
+	// other clusters and have the minimum resources of the template of the cluster
+	ValidateNodes bool
+
+	// QuotaConfigPath is the file with the per-project quotas of clusters and nodes; empty disables the quotas
+	QuotaConfigPath string
+
+	// NamingPolicyPath is the file with the per-project naming policies of the cluster names; empty allows any name
+	NamingPolicyPath string
+
+	// ClusterNameStrategy is the strategy the names of the clusters created without a name are generated with, see
+	// naming.Strategies; empty generates timestamp names
+	ClusterNameStrategy string
+
+	// CAPIVersion is the Cluster API version of the management cluster, see convert.CAPIVersions; with v1beta2 the
+	// v1beta1 Cluster API objects are converted from and to v1beta2, see k8s.V1Beta2Client
+	CAPIVersion string
+
+	// SupportMatrixPath is the file with the Kubernetes versions supported by the control plane providers; empty uses the embedded support matrix
+	SupportMatrixPath string
+
+	// ExtensionCatalogPath is the file with the cluster extensions of the app deployment manager; empty uses the embedded
+	// extension catalog
+	ExtensionCatalogPath string
+
+	// TrustedKeysPath is the PEM file with the public keys the signatures of the templates are verified with; empty
+	// does not report the verification status of the templates
+	TrustedKeysPath string
+
+	// ShadowValidationRules are the validation rules whose violations are logged and counted but not enforced, each
+	// optionally until the end of its shadow period, see validation.ParseShadowed
+	ShadowValidationRules string
+
+	// ReservedNetworks are the CIDRs of the networks of the orchestrator infrastructure, e.g. the gateway networks of
+	// the sites and the management cluster CIDRs, the pod and service networks of clusters must not overlap
+	ReservedNetworks []string
+
+	// WebhookDestinationsPath is the file with the per-project destinations of outbound webhook calls; empty disables webhook calls
+	WebhookDestinationsPath string
+
+	// WebhookTargetsPath is the file with the global and per-project targets of the cluster lifecycle events; empty disables the notifications
+	WebhookTargetsPath string
+
+	// AuditSinks are the sinks audit records of mutating requests are written to [stdout|file|kafka]; empty disables auditing
+	AuditSinks []string
+
+	// AuditLogDir is the directory of the file audit sink
+	AuditLogDir string
+
+	// AuditKafkaURL is the URL of the Kafka REST Proxy of the kafka audit sink
+	AuditKafkaURL string
+
+	// AuditKafkaTopic is the topic of the kafka audit sink
+	AuditKafkaTopic string
+
+	// Kubeconfig is the kubeconfig file of the Kubernetes API server to use instead of the in-cluster config, e.g. for local development
+	Kubeconfig string
+
+	// K8sConnectTimeout is how long to retry reaching the Kubernetes API server on startup before giving up
+	K8sConnectTimeout time.Duration
+
+	// HealthProbeInterval is the minimum time between two probes of the health of a workload cluster
+	HealthProbeInterval time.Duration
+
+	// NodeDrainTimeout is the time the nodes of a cluster have to evict their pods before they are removed or the
+	// cluster is deleted; 0 removes the nodes without draining them
+	NodeDrainTimeout time.Duration
+
+	// ForceDeleteTimeout is the time a cluster whose force deletion was requested is deleting before the finalizers of
+	// the cluster, its machines, control plane and infrastructure cluster are stripped
+	ForceDeleteTimeout time.Duration
+
+	// ClusterDeleteGracePeriod is the time a cluster is pending deletion before it is deleted, its deletion can be
+	// canceled until then; 0 deletes the clusters right away
+	ClusterDeleteGracePeriod time.Duration
+
+	// ShutdownDelay is the time the server keeps serving after the termination signal while reporting not to be ready,
+	// so that the pod is removed from the endpoints of the Service before it stops accepting connections
+	ShutdownDelay time.Duration
+
+	// ShutdownTimeout is the time the in-flight requests are drained for on shutdown before their connections are closed
+	ShutdownTimeout time.Duration
+
+	// DockerHost is the Docker Engine API the logs of the DockerMachine containers are read from, either a unix socket
+	// (unix://) or a TCP address (tcp://); empty disables the logs of DockerMachines
+	DockerHost string
+
+	// GRPCPort is the port of the gRPC server; 0 disables the gRPC server
+	GRPCPort int
+
+	// GRPCTLSCert and GRPCTLSKey are the certificate and key files the gRPC server serves TLS with; empty serves plaintext
+	GRPCTLSCert string
+	GRPCTLSKey  string
+
+	// OffboardingExportDir is the directory where project export bundles are stored before a project is deleted; empty disables the export
+	OffboardingExportDir string
+
+	// InventoryExportURL is the endpoint of the search index the cluster inventory is periodically published to; empty disables the export
+	InventoryExportURL string
+
+	// InventoryExportInterval is the time between two exports of the cluster inventory
+	InventoryExportInterval time.Duration
+
+	// ClientRateLimit and ClientBurst limit the requests per second of each client of the REST API, identified by the
+	// verified subject of its token or its address; 0 disables the limit and a burst of 0 defaults to the rate
+	ClientRateLimit float64
+	ClientBurst     int
+
+	// GlobalRateLimit and GlobalBurst limit the requests per second of all clients of the REST API together; 0 disables the limit
+	GlobalRateLimit float64
+	GlobalBurst     int
+
+	// MaxInFlightRequests limits the requests the REST API serves concurrently; 0 disables the limit
+	MaxInFlightRequests int
+
+	// K8sCallDiagnostics reports the number and latency of the Kubernetes calls of each request in its Server-Timing
+	// response header and the debug log, e.g. to find handlers issuing a call per listed item
+	K8sCallDiagnostics bool
+
+	// K8sMaxRetries is the number of retries of the Kubernetes calls failing with a transient error, like timeouts,
+	// throttling and etcd leader changes; 0 disables the retries
+	K8sMaxRetries int
+
+	// K8sBreakerThreshold is the number of consecutive failed Kubernetes calls that opens the circuit breaker, which
+	// fails the calls and the REST requests fast with 503 Service Unavailable; 0 disables the circuit breaker
+	K8sBreakerThreshold int
+
+	// K8sBreakerTimeout is how long the open circuit breaker fails the Kubernetes calls fast before it probes the API
+	// server again
+	K8sBreakerTimeout time.Duration
+
+	// WatchBookmarksConfigMap is the ConfigMap, as namespace/name, the resource versions the informers last observed
+	// are saved in so that they resume their watches from them after a restart; empty disables the bookmarks
+	WatchBookmarksConfigMap string
+
+	// WatchBookmarksInterval is the time between two saves of the watch bookmarks
+	WatchBookmarksInterval time.Duration
+
+	// ReplicaMode is how the replicas of the cluster manager share the work [active-active|active-passive]: the workers
+	// that must run once run on the leader of the replicas only, which active-passive also serves the requests alone
+	// with; empty runs a single replica without leader election
+	ReplicaMode string
+
+	// LeaderElectionLease is the Lease, as namespace/name, the replicas elect their leader with
+	LeaderElectionLease string
+
+	// ImagePreflightGatewayURL is the site gateway the hosts of air-gapped clusters are instructed through to pull the
+	// images of their template before the clusters are provisioned; empty disables the image preflight
+	ImagePreflightGatewayURL string
+
+	// ImagePreflightTimeout is how long the hosts of a cluster may take to pull the images of its template
+	ImagePreflightTimeout time.Duration
+
+	// GitopsMode commits the manifests of created clusters to the Git repository of their project [export|both]: export
+	// instead of creating the clusters, both in addition to creating them; empty disables the GitOps export
+	GitopsMode string
+
+	// GitopsConfigPath is the file with the default and per-project Git repositories of the GitOps export
+	GitopsConfigPath string
+
+	// GitopsWorkDir is the directory the Git repositories of the GitOps export are checked out in
+	GitopsWorkDir string
+
 	OidcUrl              string
 	OpaEnabled           bool
 	OpaPort              int
 	LogLevel             int
 	LogFormat            string
 	SystemLabelsPrefixes []string
+	NodeMetadataKeys     []string
 	ClusterDomain        string
 	Username             string
 	InventoryAddress     string
@@ -55,37 +276,177 @@ func ParseConfig() *Config {
 	// Deprecated: use --disable-multi-tenancy instead
 	disableMt := flag.Bool("disable-mt", false, "(deprecated) disable multi-tenancy integration (use --disable-multi-tenancy)")
 	disableInv := flag.Bool("disable-inventory", false, "(optional) disable inventory integration")
+	inventoryStub := flag.Bool("inventory-stub", false, "(optional) replace the inventory with a stub returning deterministic fake host data, for development environments without the inventory; takes precedence over disable-inventory")
 	disableMetrics := flag.Bool("disable-metrics", false, "(optional) disable prometheus metrics handler")
+	disableKubeconfigCleanup := flag.Bool("disable-kubeconfig-cleanup", false, "(optional) keep the kubeconfig secrets of deleted clusters instead of deleting them")
+	disableReadCache := flag.Bool("disable-read-cache", false, "(optional) read the clusters, templates, machines and kubeconfig secrets of GET requests from the kubernetes api server instead of the informer cache")
 	defaultTemplate := flag.String("default-template", "", "(optional) default template to use for new projects")
 	logLevel := flag.Int("loglevel", 0, "(optional) log level [trace:-8|debug:-4|info:0|warn:4|error:8]")
 	logFormat := flag.String("logformat", "json", "(optional) log format [json|human]")
 	prefixes := flag.String("system-labels-prefixes", "", "(optional) comma separated list of system labels prefixes; if not provided, sane defaults are used")
+	nodeMetadataKeys := flag.String("node-metadata-keys", "", "(optional) comma separated list of host metadata keys copied from the inventory onto the nodes; if not provided, sane defaults are used")
 	clusterDomain := flag.String("clusterdomain", "kind.internal", "(optional) cluster domain")
 	userName := flag.String("username", "admin", "(optional) user")
 	inventoryAddress := flag.String("inventory-endpoint", "mi-inventory:50051", "(optional) inventory address")
 	projectServiceURL := flag.String("nexus-api-url", "", "(optional) URL of the Nexus project service used to resolve project names to UUIDs")
 	kubeconfigTTLHours := flag.Float64("kubeconfig-ttl-hours", 3.0, "(optional) default TTL for kubeconfig JWTs in hours")
+	kubeconfigOidcIssuer := flag.String("kubeconfig-oidc-issuer", "", "(optional) issuer URL of the OIDC provider reachable by the users, configured in the kubeconfigs with the OIDC exec credential plugin; defaults to https://keycloak.<clusterdomain>/realms/master")
+	kubeconfigRetentionDays := flag.Int("kubeconfig-retention-days", 0, "(optional) days the kubeconfig secret of a deleted cluster is retained before it is deleted; 0 deletes it immediately")
+	kubeconfigOidcClientID := flag.String("kubeconfig-oidc-client-id", "system-client", "(optional) public OIDC client configured in the kubeconfigs with the OIDC exec credential plugin")
+	m2mSecretBackend := flag.String("m2m-secret-backend", auth.SecretBackendVault, "(optional) backend the credentials of the m2m client the kubeconfig tokens are issued for are read from [vault|kubernetes|file]")
+	oidcProvider := flag.String("oidc-provider", auth.OIDCProviderKeycloak, "(optional) kind of the oidc provider the api requests are authenticated with and the kubeconfig tokens are issued by [keycloak|generic]")
+	oidcRolesClaim := flag.String("oidc-roles-claim", auth.DefaultRolesClaim, "(optional) dot-separated path of the claim of the tokens the roles are read from, e.g. groups for dex or roles for azure ad")
+	m2mSecret := flag.String("m2m-secret", "", "(optional) secret (namespace/name) of the kubernetes m2m secret backend, directory of the client_id and client_secret files of the file backend")
+	kubeconfigServerURL := flag.String("kubeconfig-server-url", "", "(optional) url of the connect gateway, as reachable by the users, the server urls of the kubeconfigs are rewritten to; defaults to https://connect-gateway.<clusterdomain>:443")
+	kubeconfigContextName := flag.String("kubeconfig-context-name", "", "(optional) name of the context of the kubeconfigs, in which {project}, {cluster} and {user} are replaced; defaults to {user}@{cluster}")
+	kubeconfigCABundlePath := flag.String("kubeconfig-ca-bundle", "", "(optional) file with pem encoded ca certificates added to the certificate authority of the kubeconfigs, e.g. of a tls terminating proxy in front of the connect gateway")
+	enableAPIDocs := flag.Bool("enable-api-docs", false, "(optional) serve the Swagger UI of the REST API at /v2/docs")
+	validateNodes := flag.Bool("validate-nodes", true, "(optional) check that the hosts of the nodes of created clusters exist in the inventory, are not bound to other clusters and have the minimum resources of the template")
+	quotaConfigPath := flag.String("quota-config", "", "(optional) file with the per-project quotas of clusters and nodes")
+	namingPolicyPath := flag.String("naming-policy-config", "", "(optional) file with the per-project naming policies of the cluster names, e.g. a site code prefix")
+	clusterNameStrategy := flag.String("cluster-name-strategy", string(naming.StrategyTimestamp), "(optional) strategy the names of clusters created without a name are generated with [timestamp|random|site|sequential]")
+	capiVersion := flag.String("capi-version", convert.CAPIVersionV1Beta1, "(optional) Cluster API version of the management cluster [v1beta1|v1beta2]")
+	shadowValidationRules := flag.String("shadow-validation-rules", "", "(optional) validation rules whose violations are logged and counted but not enforced, each optionally until the end of its shadow period, e.g. reserved-resources=2026-12-01")
+	reservedNetworks := flag.String("reserved-networks", "", "(optional) comma separated list of CIDRs of the orchestrator infrastructure networks (gateway networks, management cluster CIDRs) the pod and service networks of clusters must not overlap")
+	supportMatrixPath := flag.String("support-matrix-config", "", "(optional) file with the Kubernetes versions supported by the control plane providers, overriding the embedded support matrix")
+	extensionCatalogPath := flag.String("extension-catalog-config", "", "(optional) file with the cluster extensions of the app deployment manager, overriding the embedded extension catalog")
+	trustedKeysPath := flag.String("template-signature-keys", "", "(optional) PEM file with the public keys the signatures of the templates are verified with")
+	webhookDestinationsPath := flag.String("webhook-destinations-config", "", "(optional) file with the per-project destinations outbound webhook calls may be sent to")
+	webhookTargetsPath := flag.String("webhook-targets-config", "", "(optional) file with the global and per-project webhook targets the cluster lifecycle events are posted to")
+	auditSinks := flag.String("audit-sinks", "", "(optional) comma separated list of sinks audit records of mutating requests are written to [stdout|file|kafka]")
+	auditLogDir := flag.String("audit-log-dir", "", "(optional) directory of the file audit sink, e.g. a persistent volume")
+	auditKafkaURL := flag.String("audit-kafka-url", "", "(optional) URL of the Kafka REST Proxy of the kafka audit sink")
+	auditKafkaTopic := flag.String("audit-kafka-topic", "cluster-manager-audit", "(optional) topic of the kafka audit sink")
+	// the kubeconfig flag is already registered if controller-runtime is linked in, see its client/config package
+	if flag.Lookup("kubeconfig") == nil {
+		flag.String("kubeconfig", "", "(optional) kubeconfig file to use instead of the in-cluster config, e.g. for local development")
+	}
+	k8sConnectTimeout := flag.Duration("k8s-connect-timeout", 2*time.Minute, "(optional) how long to retry reaching the kubernetes api server on startup")
+	healthProbeInterval := flag.Duration("health-probe-interval", time.Minute, "(optional) minimum time between two probes of the health of a workload cluster; reports are cached in between")
+	shutdownDelay := flag.Duration("shutdown-delay", 5*time.Second, "(optional) time the server keeps serving after the termination signal while reporting not to be ready; 0 stops accepting connections right away")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 30*time.Second, "(optional) time the in-flight requests are drained for on shutdown before their connections are closed")
+	forceDeleteTimeout := flag.Duration("force-delete-timeout", 30*time.Minute, "(optional) time a cluster whose force deletion was requested is deleting before the finalizers of the cluster and its machines, control plane and infrastructure cluster are stripped")
+	clusterDeleteGracePeriod := flag.Duration("cluster-delete-grace-period", time.Hour, "(optional) time a cluster is pending deletion before it is deleted, its deletion can be canceled until then; 0 deletes the clusters right away")
+	nodeDrainTimeout := flag.Duration("node-drain-timeout", 2*time.Minute, "(optional) time the nodes of a cluster have to evict their pods before they are removed or the cluster is deleted; 0 removes the nodes without draining them")
+	dockerHost := flag.String("docker-host", "", "(optional) docker engine api (unix:///var/run/docker.sock or tcp://host:port) the logs of the DockerMachine containers are read from")
+	grpcPort := flag.Int("grpc-port", 0, "(optional) port of the grpc server; 0 disables the grpc server")
+	grpcTLSCert := flag.String("grpc-tls-cert", "", "(optional) certificate file of the grpc server; requires grpc-tls-key")
+	grpcTLSKey := flag.String("grpc-tls-key", "", "(optional) key file of the grpc server; requires grpc-tls-cert")
+	offboardingExportDir := flag.String("offboarding-export-dir", "", "(optional) directory (e.g. a mounted object store bucket) to store project export bundles in before a project is deleted")
+	inventoryExportURL := flag.String("inventory-export-url", "", "(optional) endpoint of the search index (e.g. an opensearch ingestion pipeline) the cluster inventory is periodically published to")
+	inventoryExportInterval := flag.Duration("inventory-export-interval", 5*time.Minute, "(optional) time between two exports of the cluster inventory")
+	clientRateLimit := flag.Float64("client-rate-limit", 0, "(optional) requests per second of each client (verified token subject or address) of the rest api; 0 disables the limit")
+	clientBurst := flag.Int("client-burst", 0, "(optional) burst of the requests of each client of the rest api; 0 defaults to the client rate limit")
+	globalRateLimit := flag.Float64("global-rate-limit", 0, "(optional) requests per second of all clients of the rest api together; 0 disables the limit")
+	globalBurst := flag.Int("global-burst", 0, "(optional) burst of the requests of all clients of the rest api; 0 defaults to the global rate limit")
+	maxInFlightRequests := flag.Int("max-in-flight-requests", 0, "(optional) requests the rest api serves concurrently; 0 disables the limit")
+	k8sCallDiagnostics := flag.Bool("k8s-call-diagnostics", false, "(optional) report the kubernetes calls of each request and their latency in the Server-Timing response header and the debug log")
+	k8sMaxRetries := flag.Int("k8s-max-retries", 3, "(optional) retries of the kubernetes calls failing with a transient error; 0 disables the retries")
+	k8sBreakerThreshold := flag.Int("k8s-breaker-threshold", 10, "(optional) consecutive failed kubernetes calls that open the circuit breaker, failing the requests fast with 503; 0 disables the circuit breaker")
+	k8sBreakerTimeout := flag.Duration("k8s-breaker-timeout", 30*time.Second, "(optional) time the open circuit breaker fails the kubernetes calls fast before it probes the api server again")
+	watchBookmarksConfigMap := flag.String("watch-bookmarks-configmap", "", "(optional) configmap (namespace/name) to save the resource versions the informers observed in and to resume their watches from after a restart; empty disables the bookmarks")
+	watchBookmarksInterval := flag.Duration("watch-bookmarks-interval", time.Minute, "(optional) time between two saves of the watch bookmarks")
+	replicaMode := flag.String("replica-mode", "", "(optional) how the replicas share the work [active-active|active-passive]: the leader of the replicas runs the background workers and, in active-passive mode, serves all requests; empty runs a single replica without leader election")
+	leaderElectionLease := flag.String("leader-election-lease", "", "(optional) lease (namespace/name) the replicas elect their leader with; required by replica-mode")
+	imagePreflightGatewayURL := flag.String("image-preflight-gateway-url", "", "(optional) url of the site gateway the hosts of air-gapped clusters are instructed through to pull the images of their template before the clusters are provisioned; empty disables the image preflight")
+	imagePreflightTimeout := flag.Duration("image-preflight-timeout", time.Hour, "(optional) time the hosts of a cluster have to pull the images of its template")
+	gitopsMode := flag.String("gitops-mode", "", "(optional) commit the manifests of created clusters to the git repository of their project instead of (export) or in addition to (both) creating them [export|both]; empty disables the gitops export")
+	gitopsConfigPath := flag.String("gitops-config", "", "(optional) file with the default and per-project git repositories of the gitops export; required by gitops-mode")
+	gitopsWorkDir := flag.String("gitops-work-dir", filepath.Join(os.TempDir(), "gitops"), "(optional) directory the git repositories of the gitops export are checked out in")
 	flag.Parse()
 
 	cfg := &Config{
-		DisableAuth:         *disableAuth,
-		DisableMultitenancy: *disableMultitenancy || *disableMt,
-		DisableInventory:    *disableInv,
-		DisableMetrics:      *disableMetrics,
-		DefaultTemplate:     *defaultTemplate,
-		KubeconfigTTL:       time.Duration(*kubeconfigTTLHours * float64(time.Hour)),
-		LogLevel:            *logLevel,
-		LogFormat:           strings.ToLower(*logFormat),
-		ClusterDomain:       *clusterDomain,
-		Username:            *userName,
-		InventoryAddress:    *inventoryAddress,
-		ProjectServiceURL:   *projectServiceURL,
+		DisableAuth:              *disableAuth,
+		DisableMultitenancy:      *disableMultitenancy || *disableMt,
+		DisableInventory:         *disableInv,
+		InventoryStub:            *inventoryStub,
+		DisableMetrics:           *disableMetrics,
+		DisableReadCache:         *disableReadCache,
+		DisableKubeconfigCleanup: *disableKubeconfigCleanup,
+		DefaultTemplate:          *defaultTemplate,
+		KubeconfigTTL:            time.Duration(*kubeconfigTTLHours * float64(time.Hour)),
+		KubeconfigOidcIssuer:     *kubeconfigOidcIssuer,
+		KubeconfigOidcClientID:   *kubeconfigOidcClientID,
+		KubeconfigRetention:      time.Duration(*kubeconfigRetentionDays) * 24 * time.Hour,
+		M2MSecretBackend:         *m2mSecretBackend,
+		M2MSecret:                *m2mSecret,
+		OidcProvider:             *oidcProvider,
+		OidcRolesClaim:           *oidcRolesClaim,
+		KubeconfigServerURL:      *kubeconfigServerURL,
+		KubeconfigContextName:    *kubeconfigContextName,
+		KubeconfigCABundlePath:   *kubeconfigCABundlePath,
+		EnableAPIDocs:            *enableAPIDocs,
+		ValidateNodes:            *validateNodes,
+		QuotaConfigPath:          *quotaConfigPath,
+		NamingPolicyPath:         *namingPolicyPath,
+		ClusterNameStrategy:      *clusterNameStrategy,
+		CAPIVersion:              *capiVersion,
+		SupportMatrixPath:        *supportMatrixPath,
+		ExtensionCatalogPath:     *extensionCatalogPath,
+		TrustedKeysPath:          *trustedKeysPath,
+		ShadowValidationRules:    *shadowValidationRules,
+		WebhookDestinationsPath:  *webhookDestinationsPath,
+		WebhookTargetsPath:       *webhookTargetsPath,
+		AuditLogDir:              *auditLogDir,
+		AuditKafkaURL:            *auditKafkaURL,
+		AuditKafkaTopic:          *auditKafkaTopic,
+		Kubeconfig:               flag.Lookup("kubeconfig").Value.String(),
+		K8sConnectTimeout:        *k8sConnectTimeout,
+		HealthProbeInterval:      *healthProbeInterval,
+		NodeDrainTimeout:         *nodeDrainTimeout,
+		ForceDeleteTimeout:       *forceDeleteTimeout,
+		ClusterDeleteGracePeriod: *clusterDeleteGracePeriod,
+		ShutdownDelay:            *shutdownDelay,
+		ShutdownTimeout:          *shutdownTimeout,
+		DockerHost:               *dockerHost,
+		GRPCPort:                 *grpcPort,
+		GRPCTLSCert:              *grpcTLSCert,
+		GRPCTLSKey:               *grpcTLSKey,
+		OffboardingExportDir:     *offboardingExportDir,
+		InventoryExportURL:       *inventoryExportURL,
+		InventoryExportInterval:  *inventoryExportInterval,
+		ClientRateLimit:          *clientRateLimit,
+		ClientBurst:              *clientBurst,
+		GlobalRateLimit:          *globalRateLimit,
+		GlobalBurst:              *globalBurst,
+		MaxInFlightRequests:      *maxInFlightRequests,
+		K8sCallDiagnostics:       *k8sCallDiagnostics,
+		K8sMaxRetries:            *k8sMaxRetries,
+		K8sBreakerThreshold:      *k8sBreakerThreshold,
+		K8sBreakerTimeout:        *k8sBreakerTimeout,
+		WatchBookmarksConfigMap:  *watchBookmarksConfigMap,
+		WatchBookmarksInterval:   *watchBookmarksInterval,
+		ReplicaMode:              *replicaMode,
+		LeaderElectionLease:      *leaderElectionLease,
+		ImagePreflightGatewayURL: *imagePreflightGatewayURL,
+		ImagePreflightTimeout:    *imagePreflightTimeout,
+		GitopsMode:               *gitopsMode,
+		GitopsConfigPath:         *gitopsConfigPath,
+		GitopsWorkDir:            *gitopsWorkDir,
+		LogLevel:                 *logLevel,
+		LogFormat:                strings.ToLower(*logFormat),
+		ClusterDomain:            *clusterDomain,
+		Username:                 *userName,
+		InventoryAddress:         *inventoryAddress,
+		ProjectServiceURL:        *projectServiceURL,
 	}
 
 	if *prefixes != "" {
 		cfg.SystemLabelsPrefixes = strings.Split(*prefixes, ",")
 	}
 
+	if *nodeMetadataKeys != "" {
+		cfg.NodeMetadataKeys = strings.Split(*nodeMetadataKeys, ",")
+	}
+
+	if *auditSinks != "" {
+		cfg.AuditSinks = strings.Split(*auditSinks, ",")
+	}
+
+	if *reservedNetworks != "" {
+		cfg.ReservedNetworks = strings.Split(*reservedNetworks, ",")
+	}
+
 	if !cfg.DisableAuth {
 		cfg.OidcUrl = os.Getenv(auth.OidcUrlEnvVar)
 	}
@@ -131,11 +492,57 @@ func (c *Config) Validate() error {
 		}
 	}
 
-	if !c.DisableInventory && c.InventoryAddress == "" {
+	if !c.DisableInventory && !c.InventoryStub && c.InventoryAddress == "" {
 		slog.Error("inventory address is required to enable inventory integration")
 		return fmt.Errorf("inventory address is required to enable inventory integration")
 	}
 
+	if c.KubeconfigOidcIssuer != "" {
+		if _, err := url.ParseRequestURI(c.KubeconfigOidcIssuer); err != nil {
+			slog.Error("invalid kubeconfig oidc issuer url 'kubeconfig-oidc-issuer' provided", "error", err)
+			return fmt.Errorf("invalid kubeconfig oidc issuer url provided: %w", err)
+		}
+	}
+
+	if c.M2MSecretBackend != "" && !slices.Contains(auth.SecretBackends, c.M2MSecretBackend) {
+		slog.Error("invalid m2m secret backend 'm2m-secret-backend' provided", "provided", c.M2MSecretBackend, "valid", auth.SecretBackends)
+		return fmt.Errorf("m2m secret backend must be one of %v but got %v", auth.SecretBackends, c.M2MSecretBackend)
+	}
+
+	if c.OidcProvider != "" && !slices.Contains(auth.OIDCProviders, c.OidcProvider) {
+		slog.Error("invalid oidc provider 'oidc-provider' provided", "provided", c.OidcProvider, "valid", auth.OIDCProviders)
+		return fmt.Errorf("oidc provider must be one of %v but got %v", auth.OIDCProviders, c.OidcProvider)
+	}
+
+	if c.M2MSecretBackend == auth.SecretBackendKubernetes {
+		if namespace, name, ok := strings.Cut(c.M2MSecret, "/"); !ok || namespace == "" || name == "" {
+			slog.Error("invalid m2m secret 'm2m-secret' provided", "provided", c.M2MSecret)
+			return fmt.Errorf("m2m secret of the kubernetes backend must be namespace/name, got %v", c.M2MSecret)
+		}
+	}
+
+	if c.M2MSecretBackend == auth.SecretBackendFile && c.M2MSecret == "" {
+		slog.Error("m2m secret directory 'm2m-secret' is required for the file m2m secret backend")
+		return fmt.Errorf("m2m secret directory is required for the file m2m secret backend")
+	}
+
+	if c.ClusterNameStrategy != "" && !slices.Contains(naming.Strategies, c.ClusterNameStrategy) {
+		slog.Error("invalid cluster name strategy 'cluster-name-strategy' provided", "provided", c.ClusterNameStrategy, "valid", naming.Strategies)
+		return fmt.Errorf("cluster name strategy must be one of %v but got %v", naming.Strategies, c.ClusterNameStrategy)
+	}
+
+	if c.CAPIVersion != "" && !slices.Contains(convert.CAPIVersions, c.CAPIVersion) {
+		slog.Error("invalid cluster api version 'capi-version' provided", "provided", c.CAPIVersion, "valid", convert.CAPIVersions)
+		return fmt.Errorf("cluster api version must be one of %v but got %v", convert.CAPIVersions, c.CAPIVersion)
+	}
+
+	if c.KubeconfigServerURL != "" {
+		if _, err := url.ParseRequestURI(c.KubeconfigServerURL); err != nil {
+			slog.Error("invalid kubeconfig server url 'kubeconfig-server-url' provided", "error", err)
+			return fmt.Errorf("invalid kubeconfig server url provided: %w", err)
+		}
+	}
+
 	if c.ProjectServiceURL != "" {
 		if _, err := url.ParseRequestURI(c.ProjectServiceURL); err != nil {
 			slog.Error("invalid project service url 'nexus-api-url' provided", "error", err)
@@ -143,11 +550,157 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	for _, sink := range c.AuditSinks {
+		validSinks := []string{"stdout", "file", "kafka"}
+		if !slices.Contains(validSinks, sink) {
+			slog.Error("invalid audit sink 'audit-sinks' provided", "provided", sink, "valid", validSinks)
+			return fmt.Errorf("audit sinks must be one of %v but got %v", validSinks, sink)
+		}
+	}
+
+	if slices.Contains(c.AuditSinks, "file") && c.AuditLogDir == "" {
+		slog.Error("audit log directory 'audit-log-dir' is required for the file audit sink")
+		return fmt.Errorf("audit log directory is required for the file audit sink")
+	}
+
+	if slices.Contains(c.AuditSinks, "kafka") {
+		if _, err := url.ParseRequestURI(c.AuditKafkaURL); err != nil {
+			slog.Error("invalid kafka rest proxy url 'audit-kafka-url' provided", "error", err)
+			return fmt.Errorf("invalid kafka rest proxy url provided: %w", err)
+		}
+	}
+
+	if c.GRPCPort < 0 || c.GRPCPort > 65535 {
+		slog.Error("invalid grpc port 'grpc-port' provided", "provided", c.GRPCPort)
+		return fmt.Errorf("grpc port must be between 0 and 65535, got %v", c.GRPCPort)
+	}
+
+	if (c.GRPCTLSCert == "") != (c.GRPCTLSKey == "") {
+		slog.Error("grpc tls certificate 'grpc-tls-cert' and key 'grpc-tls-key' must be provided together")
+		return fmt.Errorf("grpc tls certificate and key must be provided together")
+	}
+
+	if c.InventoryExportURL != "" {
+		if _, err := url.ParseRequestURI(c.InventoryExportURL); err != nil {
+			slog.Error("invalid inventory export url 'inventory-export-url' provided", "error", err)
+			return fmt.Errorf("invalid inventory export url provided: %w", err)
+		}
+
+		if c.InventoryExportInterval <= 0 {
+			slog.Error("inventory export interval must be > 0", "provided", c.InventoryExportInterval)
+			return fmt.Errorf("inventory export interval must be > 0, got %v", c.InventoryExportInterval)
+		}
+	}
+
+	if c.DockerHost != "" && !strings.HasPrefix(c.DockerHost, "unix://") && !strings.HasPrefix(c.DockerHost, "tcp://") {
+		slog.Error("invalid docker host 'docker-host' provided", "provided", c.DockerHost)
+		return fmt.Errorf("docker host must start with unix:// or tcp://, got %v", c.DockerHost)
+	}
+
+	if c.ClientRateLimit < 0 || c.ClientBurst < 0 || c.GlobalRateLimit < 0 || c.GlobalBurst < 0 || c.MaxInFlightRequests < 0 {
+		slog.Error("rate limits, bursts and max in-flight requests must be >= 0", "clientRateLimit", c.ClientRateLimit, "clientBurst", c.ClientBurst,
+			"globalRateLimit", c.GlobalRateLimit, "globalBurst", c.GlobalBurst, "maxInFlightRequests", c.MaxInFlightRequests)
+		return fmt.Errorf("rate limits, bursts and max in-flight requests must be >= 0")
+	}
+
+	if c.K8sMaxRetries < 0 || c.K8sBreakerThreshold < 0 {
+		slog.Error("k8s max retries and breaker threshold must be >= 0", "k8sMaxRetries", c.K8sMaxRetries, "k8sBreakerThreshold", c.K8sBreakerThreshold)
+		return fmt.Errorf("k8s max retries and breaker threshold must be >= 0")
+	}
+
+	if c.K8sBreakerThreshold > 0 && c.K8sBreakerTimeout <= 0 {
+		slog.Error("k8s breaker timeout must be > 0", "provided", c.K8sBreakerTimeout)
+		return fmt.Errorf("k8s breaker timeout must be > 0, got %v", c.K8sBreakerTimeout)
+	}
+
+	if c.WatchBookmarksConfigMap != "" {
+		if namespace, name, ok := strings.Cut(c.WatchBookmarksConfigMap, "/"); !ok || namespace == "" || name == "" {
+			slog.Error("invalid watch bookmarks configmap 'watch-bookmarks-configmap' provided", "provided", c.WatchBookmarksConfigMap)
+			return fmt.Errorf("watch bookmarks configmap must be namespace/name, got %v", c.WatchBookmarksConfigMap)
+		}
+
+		if c.WatchBookmarksInterval <= 0 {
+			slog.Error("watch bookmarks interval must be > 0", "provided", c.WatchBookmarksInterval)
+			return fmt.Errorf("watch bookmarks interval must be > 0, got %v", c.WatchBookmarksInterval)
+		}
+	}
+
+	if c.ReplicaMode != "" {
+		if !slices.Contains(leader.Modes, c.ReplicaMode) {
+			slog.Error("invalid replica mode 'replica-mode' provided", "provided", c.ReplicaMode, "valid", leader.Modes)
+			return fmt.Errorf("replica mode must be one of %v but got %v", leader.Modes, c.ReplicaMode)
+		}
+
+		if namespace, name, ok := strings.Cut(c.LeaderElectionLease, "/"); !ok || namespace == "" || name == "" {
+			slog.Error("invalid leader election lease 'leader-election-lease' provided", "provided", c.LeaderElectionLease)
+			return fmt.Errorf("leader election lease must be namespace/name, got %v", c.LeaderElectionLease)
+		}
+	}
+
+	if c.ImagePreflightGatewayURL != "" {
+		if _, err := url.ParseRequestURI(c.ImagePreflightGatewayURL); err != nil {
+			slog.Error("invalid image preflight gateway url 'image-preflight-gateway-url' provided", "error", err)
+			return fmt.Errorf("invalid image preflight gateway url provided: %w", err)
+		}
+
+		if c.ImagePreflightTimeout <= 0 {
+			slog.Error("image preflight timeout must be > 0", "provided", c.ImagePreflightTimeout)
+			return fmt.Errorf("image preflight timeout must be > 0, got %v", c.ImagePreflightTimeout)
+		}
+	}
+
+	if c.GitopsMode != "" {
+		if !slices.Contains(gitops.Modes, c.GitopsMode) {
+			slog.Error("invalid gitops mode 'gitops-mode' provided", "provided", c.GitopsMode, "valid", gitops.Modes)
+			return fmt.Errorf("gitops mode must be one of %v but got %v", gitops.Modes, c.GitopsMode)
+		}
+
+		if c.GitopsConfigPath == "" {
+			slog.Error("gitops config 'gitops-config' is required to enable the gitops export")
+			return fmt.Errorf("gitops config is required to enable the gitops export")
+		}
+	}
+
+	if _, err := validation.ParseShadowed(c.ShadowValidationRules); err != nil {
+		slog.Error("invalid shadowed validation rules 'shadow-validation-rules' provided", "error", err)
+		return fmt.Errorf("invalid shadowed validation rules provided: %w", err)
+	}
+
+	if _, err := network.ParseReserved(c.ReservedNetworks); err != nil {
+		slog.Error("invalid reserved networks 'reserved-networks' provided", "error", err)
+		return fmt.Errorf("invalid reserved networks provided: %w", err)
+	}
+
 	// TTL=0 expires immediately
 	if c.KubeconfigTTL < 0 {
 		slog.Error("kubeconfig TTL must be >= 0", "provided", c.KubeconfigTTL)
 		return fmt.Errorf("kubeconfig TTL must be >= 0, got %v", c.KubeconfigTTL)
 	}
 
+	if c.ForceDeleteTimeout <= 0 {
+		slog.Error("force delete timeout must be > 0", "provided", c.ForceDeleteTimeout)
+		return fmt.Errorf("force delete timeout must be > 0, got %v", c.ForceDeleteTimeout)
+	}
+
+	if c.ClusterDeleteGracePeriod < 0 {
+		slog.Error("cluster delete grace period must be >= 0", "provided", c.ClusterDeleteGracePeriod)
+		return fmt.Errorf("cluster delete grace period must be >= 0, got %v", c.ClusterDeleteGracePeriod)
+	}
+
+	if c.ShutdownDelay < 0 {
+		slog.Error("shutdown delay must be >= 0", "provided", c.ShutdownDelay)
+		return fmt.Errorf("shutdown delay must be >= 0, got %v", c.ShutdownDelay)
+	}
+
+	if c.ShutdownTimeout <= 0 {
+		slog.Error("shutdown timeout must be > 0", "provided", c.ShutdownTimeout)
+		return fmt.Errorf("shutdown timeout must be > 0, got %v", c.ShutdownTimeout)
+	}
+
+	if c.KubeconfigRetention < 0 {
+		slog.Error("kubeconfig retention must be >= 0", "provided", c.KubeconfigRetention)
+		return fmt.Errorf("kubeconfig retention must be >= 0, got %v", c.KubeconfigRetention)
+	}
+
 	return nil
 }
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// snapshotPollInterval is how often running snapshots and restores are checked
+const snapshotPollInterval = 10 * time.Second
+
+// BackupReconciler reconciles a Backup object
+type BackupReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Snapshotters are the snapshotters by control plane kind, e.g. KThreesControlPlane
+	Snapshotters map[string]Snapshotter
+}
+
+// +kubebuilder:rbac:groups=edge-orchestrator.intel.com,resources=backups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edge-orchestrator.intel.com,resources=backups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	logger := log.FromContext(ctx)
+
+	backup := &clustertemplatev1alpha1.Backup{}
+	if err := r.Get(ctx, req.NamespacedName, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "failed to get Backup", "namespace", req.Namespace, "name", req.Name)
+		return ctrl.Result{}, err
+	}
+	if !backup.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(backup, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, backup); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	cluster := &capiv1beta1.Cluster{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.ClusterName}, cluster)
+	if apierrors.IsNotFound(err) {
+		if backup.Status.Phase != clustertemplatev1alpha1.BackupCompleted {
+			failBackup(backup, fmt.Sprintf("cluster %s not found", backup.Spec.ClusterName))
+		}
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "failed to get Cluster", "namespace", backup.Namespace, "name", backup.Spec.ClusterName)
+		return ctrl.Result{}, err
+	}
+
+	// the backups of a cluster are of no use without it, so they are deleted along with it
+	if err := controllerutil.SetOwnerReference(cluster, backup, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var snapshotter Snapshotter
+	if cluster.Spec.ControlPlaneRef != nil {
+		snapshotter = r.Snapshotters[cluster.Spec.ControlPlaneRef.Kind]
+	}
+
+	switch backup.Status.Phase {
+	case "", clustertemplatev1alpha1.BackupPending, clustertemplatev1alpha1.BackupRunning:
+		return r.reconcileSnapshot(ctx, snapshotter, cluster, backup)
+	case clustertemplatev1alpha1.BackupCompleted:
+		return r.reconcileRestore(ctx, snapshotter, cluster, backup)
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *BackupReconciler) reconcileSnapshot(ctx context.Context, snapshotter Snapshotter, cluster *capiv1beta1.Cluster, backup *clustertemplatev1alpha1.Backup) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if backup.Status.Phase == "" {
+		now := metav1.Now()
+		backup.Status.Phase = clustertemplatev1alpha1.BackupPending
+		backup.Status.StartTime = &now
+	}
+	if snapshotter == nil {
+		failBackup(backup, "etcd snapshots are not supported by the control plane provider of the cluster")
+		return ctrl.Result{}, nil
+	}
+	if !cluster.Status.ControlPlaneReady {
+		logger.Info("Waiting for the control plane to take the etcd snapshot", "namespace", backup.Namespace, "name", backup.Name)
+		return ctrl.Result{RequeueAfter: snapshotPollInterval}, nil
+	}
+
+	snapshot, err := snapshotter.Save(ctx, cluster, backup)
+	switch {
+	case errors.Is(err, ErrSnapshotFailed):
+		logger.Error(err, "etcd snapshot failed", "namespace", backup.Namespace, "name", backup.Name)
+		failBackup(backup, err.Error())
+		return ctrl.Result{}, nil
+	case err != nil:
+		logger.Error(err, "failed to take etcd snapshot", "namespace", backup.Namespace, "name", backup.Name)
+		return ctrl.Result{}, err
+	case snapshot == nil:
+		backup.Status.Phase = clustertemplatev1alpha1.BackupRunning
+		return ctrl.Result{RequeueAfter: snapshotPollInterval}, nil
+	}
+
+	logger.Info("etcd snapshot completed", "namespace", backup.Namespace, "name", backup.Name, "snapshot", snapshot.Name)
+	now := metav1.Now()
+	backup.Status.Phase = clustertemplatev1alpha1.BackupCompleted
+	backup.Status.SnapshotName = snapshot.Name
+	backup.Status.Location = snapshot.Location
+	backup.Status.NodeName = snapshot.NodeName
+	backup.Status.Size = snapshot.Size
+	backup.Status.CompletionTime = &now
+	return ctrl.Result{}, nil
+}
+
+func (r *BackupReconciler) reconcileRestore(ctx context.Context, snapshotter Snapshotter, cluster *capiv1beta1.Cluster, backup *clustertemplatev1alpha1.Backup) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	requestedAt := backup.Annotations[clustertemplatev1alpha1.BackupRestoreAnnotation]
+	if requestedAt == "" {
+		return ctrl.Result{}, nil
+	}
+	if backup.Status.Restore == nil || backup.Status.Restore.RequestedAt != requestedAt {
+		logger.Info("Restoring cluster from etcd snapshot", "namespace", backup.Namespace, "name", backup.Name, "cluster", cluster.Name)
+		now := metav1.Now()
+		backup.Status.Restore = &clustertemplatev1alpha1.RestoreStatus{
+			Phase:       clustertemplatev1alpha1.RestoreRunning,
+			RequestedAt: requestedAt,
+			StartTime:   &now,
+		}
+	}
+	if backup.Status.Restore.Phase != clustertemplatev1alpha1.RestoreRunning {
+		return ctrl.Result{}, nil
+	}
+	if snapshotter == nil {
+		failRestore(backup, "etcd snapshots are not supported by the control plane provider of the cluster")
+		return ctrl.Result{}, nil
+	}
+
+	done, err := snapshotter.Restore(ctx, cluster, backup)
+	switch {
+	case errors.Is(err, ErrSnapshotFailed):
+		logger.Error(err, "etcd restore failed", "namespace", backup.Namespace, "name", backup.Name)
+		failRestore(backup, err.Error())
+		return ctrl.Result{}, nil
+	case err != nil:
+		logger.Error(err, "failed to restore etcd snapshot", "namespace", backup.Namespace, "name", backup.Name)
+		return ctrl.Result{}, err
+	case !done:
+		return ctrl.Result{RequeueAfter: snapshotPollInterval}, nil
+	}
+
+	logger.Info("Cluster restored from etcd snapshot", "namespace", backup.Namespace, "name", backup.Name, "cluster", cluster.Name)
+	now := metav1.Now()
+	backup.Status.Restore.Phase = clustertemplatev1alpha1.RestoreCompleted
+	backup.Status.Restore.CompletionTime = &now
+	return ctrl.Result{}, nil
+}
+
+func failBackup(backup *clustertemplatev1alpha1.Backup, message string) {
+	now := metav1.Now()
+	backup.Status.Phase = clustertemplatev1alpha1.BackupFailed
+	backup.Status.Message = message
+	backup.Status.CompletionTime = &now
+}
+
+func failRestore(backup *clustertemplatev1alpha1.Backup, message string) {
+	now := metav1.Now()
+	backup.Status.Restore.Phase = clustertemplatev1alpha1.RestoreFailed
+	backup.Status.Restore.Message = message
+	backup.Status.Restore.CompletionTime = &now
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&clustertemplatev1alpha1.Backup{}).
+		Named("backup").
+		Complete(r)
+}
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// fakeSnapshotter completes snapshots and restores on the second call
+type fakeSnapshotter struct {
+	saves, restores int
+	err             error
+}
+
+func (s *fakeSnapshotter) Save(context.Context, *capiv1beta1.Cluster, *clustertemplatev1alpha1.Backup) (*Snapshot, error) {
+	s.saves++
+	if s.err != nil || s.saves < 2 {
+		return nil, s.err
+	}
+	return &Snapshot{Name: "backup-node-1", Location: "file:///var/lib/rancher/k3s/server/db/snapshots/backup-node-1", NodeName: "node"}, nil
+}
+
+func (s *fakeSnapshotter) Restore(_ context.Context, _ *capiv1beta1.Cluster, backup *clustertemplatev1alpha1.Backup) (bool, error) {
+	s.restores++
+	backup.Status.Restore.JobName = "etcd-restore"
+	return s.restores >= 2, s.err
+}
+
+func backupScheme(t *testing.T) *k8sruntime.Scheme {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, clustertemplatev1alpha1.AddToScheme(scheme))
+	require.NoError(t, capiv1beta1.AddToScheme(scheme))
+	return scheme
+}
+
+func backupCluster() *capiv1beta1.Cluster {
+	return &capiv1beta1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "project", UID: "cluster-uid"},
+		Spec: capiv1beta1.ClusterSpec{
+			ControlPlaneRef: &corev1.ObjectReference{Kind: "KThreesControlPlane", Name: "cluster"},
+		},
+		Status: capiv1beta1.ClusterStatus{ControlPlaneReady: true},
+	}
+}
+
+func reconcileBackup(t *testing.T, r *BackupReconciler) (ctrl.Result, *clustertemplatev1alpha1.Backup) {
+	key := types.NamespacedName{Namespace: "project", Name: "backup"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	backup := &clustertemplatev1alpha1.Backup{}
+	require.NoError(t, r.Get(context.Background(), key, backup))
+	return result, backup
+}
+
+func TestBackupReconciler(t *testing.T) {
+	newReconciler := func(t *testing.T, snapshotter Snapshotter, objects ...client.Object) *BackupReconciler {
+		scheme := backupScheme(t)
+		backup := &clustertemplatev1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "project"},
+			Spec:       clustertemplatev1alpha1.BackupSpec{ClusterName: "cluster"},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objects, backup)...).
+			WithStatusSubresource(&clustertemplatev1alpha1.Backup{}).Build()
+		return &BackupReconciler{Client: c, Scheme: scheme, Snapshotters: map[string]Snapshotter{"KThreesControlPlane": snapshotter}}
+	}
+
+	t.Run("snapshot is taken and restored", func(t *testing.T) {
+		snapshotter := &fakeSnapshotter{}
+		r := newReconciler(t, snapshotter, backupCluster())
+
+		result, backup := reconcileBackup(t, r)
+		require.Equal(t, clustertemplatev1alpha1.BackupRunning, backup.Status.Phase)
+		require.NotNil(t, backup.Status.StartTime)
+		require.NotZero(t, result.RequeueAfter)
+		require.Len(t, backup.OwnerReferences, 1)
+		require.Equal(t, "cluster", backup.OwnerReferences[0].Name)
+
+		_, backup = reconcileBackup(t, r)
+		require.Equal(t, clustertemplatev1alpha1.BackupCompleted, backup.Status.Phase)
+		require.Equal(t, "backup-node-1", backup.Status.SnapshotName)
+		require.Equal(t, "node", backup.Status.NodeName)
+		require.NotNil(t, backup.Status.CompletionTime)
+
+		backup.Annotations = map[string]string{clustertemplatev1alpha1.BackupRestoreAnnotation: "2026-10-16T10:00:00Z"}
+		require.NoError(t, r.Update(context.Background(), backup))
+
+		_, backup = reconcileBackup(t, r)
+		require.Equal(t, clustertemplatev1alpha1.RestoreRunning, backup.Status.Restore.Phase)
+		require.Equal(t, "2026-10-16T10:00:00Z", backup.Status.Restore.RequestedAt)
+		require.Equal(t, "etcd-restore", backup.Status.Restore.JobName)
+
+		_, backup = reconcileBackup(t, r)
+		require.Equal(t, clustertemplatev1alpha1.RestoreCompleted, backup.Status.Restore.Phase)
+		require.NotNil(t, backup.Status.Restore.CompletionTime)
+
+		// completed restores are not repeated until requested again
+		reconcileBackup(t, r)
+		require.Equal(t, 2, snapshotter.restores)
+	})
+
+	t.Run("failed snapshots fail the backup", func(t *testing.T) {
+		r := newReconciler(t, &fakeSnapshotter{err: fmt.Errorf("%w: job failed", ErrSnapshotFailed)}, backupCluster())
+
+		_, backup := reconcileBackup(t, r)
+		require.Equal(t, clustertemplatev1alpha1.BackupFailed, backup.Status.Phase)
+		require.Contains(t, backup.Status.Message, "job failed")
+	})
+
+	t.Run("unsupported control plane providers fail the backup", func(t *testing.T) {
+		cluster := backupCluster()
+		cluster.Spec.ControlPlaneRef.Kind = "KubeadmControlPlane"
+		r := newReconciler(t, &fakeSnapshotter{}, cluster)
+
+		_, backup := reconcileBackup(t, r)
+		require.Equal(t, clustertemplatev1alpha1.BackupFailed, backup.Status.Phase)
+		require.Contains(t, backup.Status.Message, "not supported")
+	})
+
+	t.Run("missing clusters fail the backup", func(t *testing.T) {
+		r := newReconciler(t, &fakeSnapshotter{})
+
+		_, backup := reconcileBackup(t, r)
+		require.Equal(t, clustertemplatev1alpha1.BackupFailed, backup.Status.Phase)
+		require.Equal(t, "cluster cluster not found", backup.Status.Message)
+	})
+}
+
+func TestBackupPolicyReconciler(t *testing.T) {
+	scheme := backupScheme(t)
+
+	policy := &clustertemplatev1alpha1.BackupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "daily", Namespace: "project", UID: "policy-uid"},
+		Spec: clustertemplatev1alpha1.BackupPolicySpec{
+			ClusterName: "cluster",
+			Interval:    metav1.Duration{Duration: 24 * time.Hour},
+			Retention:   2,
+		},
+	}
+	objects := []client.Object{policy}
+	phases := []clustertemplatev1alpha1.BackupPhase{
+		clustertemplatev1alpha1.BackupCompleted,
+		clustertemplatev1alpha1.BackupFailed,
+		clustertemplatev1alpha1.BackupCompleted,
+		clustertemplatev1alpha1.BackupCompleted,
+		clustertemplatev1alpha1.BackupFailed,
+	}
+	for i, phase := range phases {
+		objects = append(objects, &clustertemplatev1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("daily-%d", i),
+				Namespace:         "project",
+				Labels:            map[string]string{clustertemplatev1alpha1.BackupPolicyLabel: "daily"},
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Duration(i) * time.Hour)),
+			},
+			Spec:   clustertemplatev1alpha1.BackupSpec{ClusterName: "cluster"},
+			Status: clustertemplatev1alpha1.BackupStatus{Phase: phase},
+		})
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+		WithStatusSubresource(&clustertemplatev1alpha1.BackupPolicy{}, &clustertemplatev1alpha1.Backup{}).Build()
+	r := &BackupPolicyReconciler{Client: c, Scheme: scheme}
+
+	key := types.NamespacedName{Namespace: "project", Name: "daily"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	require.Equal(t, 24*time.Hour, result.RequeueAfter)
+
+	backups := &clustertemplatev1alpha1.BackupList{}
+	require.NoError(t, c.List(context.Background(), backups, client.InNamespace("project")))
+	var names []string
+	for _, backup := range backups.Items {
+		names = append(names, backup.Name)
+	}
+	// the two newest completed backups and the failed backup in between are kept, a new backup is created
+	require.Len(t, names, 4)
+	require.Subset(t, names, []string{"daily-0", "daily-1", "daily-2"})
+
+	require.NoError(t, c.Get(context.Background(), key, policy))
+	require.NotEmpty(t, policy.Status.LastBackupName)
+	require.NotNil(t, policy.Status.LastBackupTime)
+
+	// no backup is due before the interval passed
+	result, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	require.Greater(t, result.RequeueAfter, 23*time.Hour)
+	require.NoError(t, c.List(context.Background(), backups, client.InNamespace("project")))
+	require.Len(t, backups.Items, 4)
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// defaultBackupRetention is the number of completed backups kept by policies without retention
+const defaultBackupRetention = 7
+
+// BackupPolicyReconciler reconciles a BackupPolicy object
+type BackupPolicyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=edge-orchestrator.intel.com,resources=backuppolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=edge-orchestrator.intel.com,resources=backuppolicies/status,verbs=get;update;patch
+
+func (r *BackupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	logger := log.FromContext(ctx)
+
+	policy := &clustertemplatev1alpha1.BackupPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "failed to get BackupPolicy", "namespace", req.Namespace, "name", req.Name)
+		return ctrl.Result{}, err
+	}
+	if !policy.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(policy, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, policy); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	if err := r.pruneBackups(ctx, policy); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	interval := policy.Spec.Interval.Duration
+	if interval <= 0 {
+		logger.Info("BackupPolicy has no interval, no backups are created", "namespace", policy.Namespace, "name", policy.Name)
+		return ctrl.Result{}, nil
+	}
+	if policy.Status.LastBackupTime != nil {
+		if next := policy.Status.LastBackupTime.Add(interval); time.Now().Before(next) {
+			return ctrl.Result{RequeueAfter: time.Until(next)}, nil
+		}
+	}
+
+	backup := &clustertemplatev1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: policy.Name + "-",
+			Namespace:    policy.Namespace,
+			Labels:       map[string]string{clustertemplatev1alpha1.BackupPolicyLabel: policy.Name},
+		},
+		Spec: clustertemplatev1alpha1.BackupSpec{ClusterName: policy.Spec.ClusterName},
+	}
+	if err := controllerutil.SetControllerReference(policy, backup, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.Create(ctx, backup); err != nil {
+		logger.Error(err, "failed to create Backup", "namespace", policy.Namespace, "policy", policy.Name)
+		return ctrl.Result{}, err
+	}
+	logger.Info("Created Backup", "namespace", policy.Namespace, "name", backup.Name, "policy", policy.Name)
+
+	now := metav1.Now()
+	policy.Status.LastBackupName = backup.Name
+	policy.Status.LastBackupTime = &now
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// pruneBackups deletes the completed backups of the policy beyond its retention and the failed backups older than
+// the oldest completed backup that is kept
+func (r *BackupPolicyReconciler) pruneBackups(ctx context.Context, policy *clustertemplatev1alpha1.BackupPolicy) error {
+	logger := log.FromContext(ctx)
+
+	backups := &clustertemplatev1alpha1.BackupList{}
+	if err := r.List(ctx, backups, client.InNamespace(policy.Namespace),
+		client.MatchingLabels{clustertemplatev1alpha1.BackupPolicyLabel: policy.Name}); err != nil {
+		logger.Error(err, "failed to list Backups", "namespace", policy.Namespace, "policy", policy.Name)
+		return err
+	}
+
+	// newest first
+	slices.SortFunc(backups.Items, func(a, b clustertemplatev1alpha1.Backup) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+
+	retention := int(policy.Spec.Retention)
+	if retention <= 0 {
+		retention = defaultBackupRetention
+	}
+
+	completed := 0
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		switch backup.Status.Phase {
+		case clustertemplatev1alpha1.BackupCompleted:
+			completed++
+			if completed <= retention {
+				continue
+			}
+		case clustertemplatev1alpha1.BackupFailed:
+			if completed < retention {
+				continue
+			}
+		default:
+			continue
+		}
+
+		logger.Info("Deleting Backup beyond retention", "namespace", backup.Namespace, "name", backup.Name, "policy", policy.Name)
+		if err := r.Delete(ctx, backup); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "failed to delete Backup", "namespace", backup.Namespace, "name", backup.Name)
+			return err
+		}
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&clustertemplatev1alpha1.BackupPolicy{}).
+		Owns(&clustertemplatev1alpha1.Backup{}).
+		Named("backuppolicy").
+		Complete(r)
+}
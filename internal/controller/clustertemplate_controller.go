@@ -5,6 +5,8 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -16,9 +18,11 @@ import (
 	conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
@@ -29,6 +33,9 @@ import (
 type ClusterTemplateReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// DriftCheckInterval is the time between two checks of the rendered templates for out-of-band changes,
+	// DefaultDriftCheckInterval if zero
+	DriftCheckInterval time.Duration
 }
 
 func markConditionTrue(clusterTemplate *clustertemplatev1alpha1.ClusterTemplate, conditionType capiv1beta1.ConditionType) {
@@ -52,17 +59,27 @@ func isConditionTrue(clusterTemplate *clustertemplatev1alpha1.ClusterTemplate, c
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=dockerclustertemplates,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=intelmachinetemplates,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=intelclustertemplates,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=vspheremachinetemplates,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=vsphereclustertemplates,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=kubeadmcontrolplanetemplates,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=kthreescontrolplanes,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=kthreescontrolplanetemplates,verbs=get;list;watch;create;delete
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses,verbs=get;list;watch;create;delete
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=bootstrap.cluster.x-k8s.io,resources=kubeadmconfigtemplates,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=bootstrap.cluster.x-k8s.io,resources=kthreesconfigtemplates,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch;create;patch;delete
 
 // RBAC for dependencies
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *ClusterTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	logger := log.FromContext(ctx)
+	start := time.Now()
+	step := stepGet
+	defer func() {
+		observeReconcile(start, step, reterr)
+	}()
 
 	// Fetch the ClusterTemplate instance
 	clusterTemplate := &clustertemplatev1alpha1.ClusterTemplate{}
@@ -77,13 +94,6 @@ func (r *ClusterTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	// Get the provider based on controlPlaneProviderType and infraProviderType
-	provider := capiProvider.GetCapiProvider(clusterTemplate.Spec.ControlPlaneProviderType, clusterTemplate.Spec.InfraProviderType)
-	if provider == nil {
-		logger.Error(nil, "unsupported provider combination", "controlPlaneProviderType", clusterTemplate.Spec.ControlPlaneProviderType, "infraProviderType", clusterTemplate.Spec.InfraProviderType)
-		return ctrl.Result{}, nil
-	}
-
 	namespacedName := types.NamespacedName{
 		Name:      clusterTemplate.Name,
 		Namespace: clusterTemplate.Namespace,
@@ -94,19 +104,46 @@ func (r *ClusterTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+
+	// Get the provider based on controlPlaneProviderType and infraProviderType
+	provider := capiProvider.GetCapiProvider(clusterTemplate.Spec.ControlPlaneProviderType, clusterTemplate.Spec.InfraProviderType)
 	defer func() {
 		if err := r.patchClusterTemplate(ctx, logger, namespacedName, patchHelper, clusterTemplate, provider); err != nil && reterr == nil {
+			step = stepPatch
 			reterr = err
 		}
 	}()
+	if provider == nil {
+		logger.Error(nil, "unsupported provider combination", "controlPlaneProviderType", clusterTemplate.Spec.ControlPlaneProviderType, "infraProviderType", clusterTemplate.Spec.InfraProviderType)
+		conditions.MarkFalse(clusterTemplate, capiv1beta2.ConditionType(clustertemplatev1alpha1.ProvidersValidatedCondition), clustertemplatev1alpha1.UnsupportedProvidersReason,
+			capiv1beta2.ConditionSeverity(capiv1beta1.ConditionSeverityError), "control plane provider %q is not supported with infrastructure provider %q",
+			clusterTemplate.Spec.ControlPlaneProviderType, clusterTemplate.Spec.InfraProviderType)
+		return ctrl.Result{}, nil
+	}
+	markConditionTrue(clusterTemplate, clustertemplatev1alpha1.ProvidersValidatedCondition)
 
 	// Handle clusterTemplate deletion
 	if !clusterTemplate.ObjectMeta.DeletionTimestamp.IsZero() {
+		step = stepDelete
 		return ctrl.Result{}, r.reconcileDelete(ctx, logger, clusterTemplate, namespacedName, provider)
 	}
 
+	// Repair the resources rendered by previous reconciliations that were changed out-of-band
+	step = stepDrift
+	if err := r.reconcileDrift(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Handle non-deleted machines
-	if err := r.reconcileClusterTemplate(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
+	if failed, err := r.reconcileClusterTemplate(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
+		step = failed
+		return ctrl.Result{}, err
+	}
+
+	// Propagate the changes of the cluster labels to the clusters of the template
+	step = stepLabelPropagation
+	if err := r.reconcileLabelPropagation(ctx, logger, clusterTemplate); err != nil {
+		logger.Error(err, "failed to propagate cluster labels", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
 		return ctrl.Result{}, err
 	}
 
@@ -115,33 +152,48 @@ func (r *ClusterTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		controllerutil.AddFinalizer(clusterTemplate, clustertemplatev1alpha1.ClusterTemplateFinalizer)
 	}
 
-	return ctrl.Result{}, nil
-}
+	clusterTemplate.Status.ObservedGeneration = clusterTemplate.Generation
 
-func (r *ClusterTemplateReconciler) reconcileClusterTemplate(ctx context.Context, logger logr.Logger, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) error {
-	if err := r.reconcileControlPlaneTemplate(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
-		return err
-	}
-	if err := r.reconcilePrerequisites(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
-		return err
-	}
-	if err := r.reconcileControlPlaneMachineTemplate(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
-		return err
+	// the templates are not watched, so they are checked for drift periodically
+	interval := r.DriftCheckInterval
+	if interval <= 0 {
+		interval = DefaultDriftCheckInterval
 	}
-	if err := r.reconcileProviderClusterTemplate(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
-		return err
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// reconcileClusterTemplate creates the resources of the ClusterTemplate that do not exist yet, and returns the step
+// that failed if any
+func (r *ClusterTemplateReconciler) reconcileClusterTemplate(ctx context.Context, logger logr.Logger, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) (string, error) {
+	steps := []struct {
+		name      string
+		reconcile func(context.Context, logr.Logger, types.NamespacedName, capiProvider.Provider, *clustertemplatev1alpha1.ClusterTemplate) error
+	}{
+		{stepControlPlaneTemplate, r.reconcileControlPlaneTemplate},
+		{stepPrerequisites, r.reconcilePrerequisites},
+		{stepControlPlaneMachineTemplate, r.reconcileControlPlaneMachineTemplate},
+		{stepInfraProviderClusterTemplate, r.reconcileProviderClusterTemplate},
+		{stepWorkerTemplates, r.reconcileWorkerTemplates},
+		{stepClusterClass, r.reconcileClusterClass},
 	}
-	if err := r.reconcileClusterClass(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
-		return err
+	for _, step := range steps {
+		if err := step.reconcile(ctx, logger, namespacedName, provider, clusterTemplate); err != nil {
+			return step.name, err
+		}
 	}
-	return nil
+	return "", nil
 }
 
 func (r *ClusterTemplateReconciler) reconcileControlPlaneTemplate(ctx context.Context, logger logr.Logger, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) error {
 	err := provider.GetControlPlaneTemplate(ctx, r.Client, namespacedName)
 	if err != nil && errors.IsNotFound(err) {
 		logger.Info("Creating ControlPlaneTemplate", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
-		err = provider.CreateControlPlaneTemplate(ctx, r.Client, namespacedName, clusterTemplate.Spec.ClusterConfiguration)
+		var config string
+		var secrets []string
+		config, secrets, err = r.renderClusterConfiguration(ctx, clusterTemplate)
+		if err == nil {
+			err = capiProvider.RedactError(provider.CreateControlPlaneTemplate(ctx, r.Client, namespacedName, config), secrets)
+		}
 		if err != nil {
 			logger.Error(err, "failed to create ControlPlaneTemplate", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
 			markConditionFalse(clusterTemplate, clustertemplatev1alpha1.ControlPlaneTemplateCondition, err.Error())
@@ -221,9 +273,76 @@ func (r *ClusterTemplateReconciler) reconcileProviderClusterTemplate(ctx context
 	return nil
 }
 
-func (r *ClusterTemplateReconciler) reconcileClusterClass(ctx context.Context, logger logr.Logger, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) error {
+func (r *ClusterTemplateReconciler) reconcileWorkerTemplates(ctx context.Context, logger logr.Logger, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) error {
+	err := provider.GetWorkerTemplates(ctx, r.Client, namespacedName)
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("Creating worker templates", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
+		var config string
+		var secrets []string
+		config, secrets, err = r.renderClusterConfiguration(ctx, clusterTemplate)
+		if err == nil {
+			err = capiProvider.RedactError(provider.CreateWorkerTemplates(ctx, r.Client, namespacedName, config), secrets)
+		}
+		if err != nil {
+			logger.Error(err, "failed to create worker templates", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
+			markConditionFalse(clusterTemplate, clustertemplatev1alpha1.WorkerTemplatesCondition, err.Error())
+			return err
+		}
+		markConditionTrue(clusterTemplate, clustertemplatev1alpha1.WorkerTemplatesCondition)
+	} else if err != nil {
+		logger.Error(err, "failed to get worker templates", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
+		markConditionFalse(clusterTemplate, clustertemplatev1alpha1.WorkerTemplatesCondition, err.Error())
+		return err
+	} else {
+		markConditionTrue(clusterTemplate, clustertemplatev1alpha1.WorkerTemplatesCondition)
+	}
+	return nil
+}
+
+// renderClusterConfiguration returns the control plane template of the ClusterTemplate rendered by its provider, with
+// its secret references resolved from the Secrets of the namespace of the template, and records the result in the
+// ConfigRenderedCondition. The resolved values are returned as well to redact them from the errors of the requests
+// creating the templates.
+func (r *ClusterTemplateReconciler) renderClusterConfiguration(ctx context.Context, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) (string, []string, error) {
+	config, secrets, err := r.resolveClusterConfiguration(ctx, clusterTemplate.Namespace, clusterTemplate.Spec)
+	if err != nil {
+		markConditionFalse(clusterTemplate, clustertemplatev1alpha1.ConfigRenderedCondition, err.Error())
+		return "", nil, err
+	}
+	markConditionTrue(clusterTemplate, clustertemplatev1alpha1.ConfigRenderedCondition)
+	return config, secrets, nil
+}
+
+func (r *ClusterTemplateReconciler) resolveClusterConfiguration(ctx context.Context, namespace string, spec clustertemplatev1alpha1.ClusterTemplateSpec) (string, []string, error) {
+	config, err := capiProvider.RenderClusterConfiguration(spec)
+	if err != nil {
+		return "", nil, err
+	}
+	return capiProvider.ResolveSecretReferences(config, func(ref capiProvider.SecretReference) ([]byte, error) {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+			return nil, err
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s has no key %s", ref.Name, ref.Key)
+		}
+		return value, nil
+	})
+}
+
+// renderClusterClass returns the ClusterClass of the ClusterTemplate as rendered by its provider, with the health
+// checks of its remediation settings and the variables of the template
+func renderClusterClass(namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) capiv1beta1.ClusterClass {
 	cc := common.GetClusterClass(namespacedName)
 	provider.AlterClusterClass(&cc)
+	common.SetMachineHealthChecks(&cc, clusterTemplate.Spec.Remediation)
+	common.SetTemplateVariables(&cc, clusterTemplate.Spec.Variables)
+	return cc
+}
+
+func (r *ClusterTemplateReconciler) reconcileClusterClass(ctx context.Context, logger logr.Logger, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) error {
+	cc := renderClusterClass(namespacedName, provider, clusterTemplate)
 
 	err := r.Get(ctx, namespacedName, &cc)
 	if err != nil && errors.IsNotFound(err) {
@@ -259,17 +378,20 @@ func (r *ClusterTemplateReconciler) patchClusterTemplate(ctx context.Context, lo
 	// Always update the readyCondition by summarizing the state of other conditions.
 	conditions.SetSummary(clusterTemplate,
 		conditions.WithConditions(
+			capiv1beta2.ConditionType(clustertemplatev1alpha1.ProvidersValidatedCondition),
+			capiv1beta2.ConditionType(clustertemplatev1alpha1.ConfigRenderedCondition),
 			capiv1beta2.ConditionType(clustertemplatev1alpha1.PrerequisitesCondition),
 			capiv1beta2.ConditionType(clustertemplatev1alpha1.ControlPlaneTemplateCondition),
 			capiv1beta2.ConditionType(clustertemplatev1alpha1.ControlPlaneMachineTemplateCondition),
 			capiv1beta2.ConditionType(clustertemplatev1alpha1.InfraProviderClusterTemplateCondition),
+			capiv1beta2.ConditionType(clustertemplatev1alpha1.WorkerTemplatesCondition),
 			capiv1beta2.ConditionType(clustertemplatev1alpha1.ClusterClassCondition),
 		),
 		conditions.WithStepCounterIf(clusterTemplate.ObjectMeta.DeletionTimestamp.IsZero()),
 	)
 
 	// Set the ClusterClass reference if the ClusterTemplate's ClusterClass condition is ready and ClusterTemplate is not being deleted
-	if isConditionTrue(clusterTemplate, clustertemplatev1alpha1.ClusterClassCondition) && clusterTemplate.ObjectMeta.DeletionTimestamp.IsZero() {
+	if isConditionTrue(clusterTemplate, clustertemplatev1alpha1.ClusterClassCondition) && clusterTemplate.ObjectMeta.DeletionTimestamp.IsZero() && provider != nil {
 		cc := common.GetClusterClass(namespacedName)
 		provider.AlterClusterClass(&cc)
 
@@ -287,6 +409,7 @@ func (r *ClusterTemplateReconciler) patchClusterTemplate(ctx context.Context, lo
 			Name:       cc.Name,
 			Namespace:  cc.Namespace,
 		}
+		clusterTemplate.Status.ControlPlaneMachineTemplateRef, clusterTemplate.Status.WorkerMachineTemplateRef = machineTemplateRefs(&cc)
 	}
 
 	// Set the Ready status based on the summary condition
@@ -300,15 +423,35 @@ func (r *ClusterTemplateReconciler) patchClusterTemplate(ctx context.Context, lo
 		clusterTemplate,
 		patch.WithOwnedConditions{Conditions: []string{
 			string(capiv1beta1.ReadyCondition),
+			string(clustertemplatev1alpha1.ProvidersValidatedCondition),
+			string(clustertemplatev1alpha1.ConfigRenderedCondition),
 			string(clustertemplatev1alpha1.PrerequisitesCondition),
 			string(clustertemplatev1alpha1.ControlPlaneTemplateCondition),
 			string(clustertemplatev1alpha1.ControlPlaneMachineTemplateCondition),
 			string(clustertemplatev1alpha1.InfraProviderClusterTemplateCondition),
+			string(clustertemplatev1alpha1.WorkerTemplatesCondition),
 			string(clustertemplatev1alpha1.ClusterClassCondition),
+			string(clustertemplatev1alpha1.DriftRepairedCondition),
 		}},
 	)
 }
 
+// machineTemplateRefs returns the references to the infrastructure machine templates of the control plane and worker
+// machines of the ClusterClass, nil if the ClusterClass has none
+func machineTemplateRefs(cc *capiv1beta1.ClusterClass) (controlPlane, worker *corev1.ObjectReference) {
+	if mi := cc.Spec.ControlPlane.MachineInfrastructure; mi != nil && mi.Ref != nil {
+		controlPlane = mi.Ref.DeepCopy()
+		controlPlane.Namespace = cc.Namespace
+	}
+	for _, md := range cc.Spec.Workers.MachineDeployments {
+		if md.Class == common.WorkerClass && md.Template.Infrastructure.Ref != nil {
+			worker = md.Template.Infrastructure.Ref.DeepCopy()
+			worker.Namespace = cc.Namespace
+		}
+	}
+	return controlPlane, worker
+}
+
 func (r *ClusterTemplateReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate, namespacedName types.NamespacedName, provider capiProvider.Provider) error {
 	logger.Info("Running ClusterTemplate reconciliation delete for ClusterTemplate", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
 
@@ -332,6 +475,8 @@ func (r *ClusterTemplateReconciler) reconcileDelete(ctx context.Context, logger
 func (r *ClusterTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&clustertemplatev1alpha1.ClusterTemplate{}).
+		// edits and deletions of the ClusterClass are repaired as soon as they are observed
+		Owns(&capiv1beta1.ClusterClass{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Named("clustertemplate").
 		Complete(r)
 }
@@ -6,19 +6,28 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	kubeadmbootstrapv1beta1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta1"
 	kubeadmcpv1beta1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta1"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	capiv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	dockerv1beta1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta1"
+	conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 
+	kthreesbootstrapv1beta2 "github.com/k3s-io/cluster-api-k3s/bootstrap/api/v1beta2"
 	kthreescpv1beta2 "github.com/k3s-io/cluster-api-k3s/controlplane/api/v1beta2"
 	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
 	clusterv1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
@@ -79,6 +88,9 @@ var _ = Describe("ClusterTemplate Controller", func() {
 
 			Expect(k8sClient.Get(ctx, typeNamespacedName, clustertemplate)).To(Succeed())
 			Expect(clustertemplate.Status.Ready).To(BeTrue())
+			Expect(isConditionTrue(clustertemplate, clusterv1alpha1.ProvidersValidatedCondition)).To(BeTrue())
+			Expect(isConditionTrue(clustertemplate, clusterv1alpha1.ConfigRenderedCondition)).To(BeTrue())
+			Expect(isConditionTrue(clustertemplate, clusterv1alpha1.ClusterClassCondition)).To(BeTrue())
 
 			// Different combinations of control-plane and infrastructure provider types
 			// result in the creation of various resources. The validation function
@@ -97,6 +109,12 @@ var _ = Describe("ClusterTemplate Controller", func() {
 			By("validating the ClusterClass Reference is set")
 			Expect(resource.Status.ClusterClassRef).NotTo(BeNil())
 
+			By("validating the machine template references are set")
+			Expect(resource.Status.ControlPlaneMachineTemplateRef).NotTo(BeNil())
+			Expect(resource.Status.ControlPlaneMachineTemplateRef.Name).To(Equal(typeNamespacedName.Name + "-controlplane"))
+			Expect(resource.Status.WorkerMachineTemplateRef).NotTo(BeNil())
+			Expect(resource.Status.WorkerMachineTemplateRef.Name).To(Equal(typeNamespacedName.Name + "-worker"))
+
 			By("validating the finalizer is present")
 			Expect(controllerutil.ContainsFinalizer(resource, clusterv1alpha1.ClusterTemplateFinalizer)).To(BeTrue())
 
@@ -134,6 +152,16 @@ var _ = Describe("ClusterTemplate Controller", func() {
 				By("validating the KubeadmControlPlaneTemplate is created")
 				err = k8sClient.Get(ctx, typeNamespacedName, &kubeadmcpv1beta1.KubeadmControlPlaneTemplate{})
 				Expect(err).NotTo(HaveOccurred())
+
+				By("validating the worker templates are created")
+				workerName := types.NamespacedName{
+					Name:      fmt.Sprintf("%s-worker", typeNamespacedName.Name),
+					Namespace: typeNamespacedName.Namespace,
+				}
+				err = k8sClient.Get(ctx, workerName, &dockerv1beta1.DockerMachineTemplate{})
+				Expect(err).NotTo(HaveOccurred())
+				err = k8sClient.Get(ctx, workerName, &kubeadmbootstrapv1beta1.KubeadmConfigTemplate{})
+				Expect(err).NotTo(HaveOccurred())
 			},
 		),
 
@@ -155,6 +183,16 @@ var _ = Describe("ClusterTemplate Controller", func() {
 				By("validating the KThreesControlPlaneTemplate is created")
 				err = k8sClient.Get(ctx, typeNamespacedName, &kthreescpv1beta2.KThreesControlPlaneTemplate{})
 				Expect(err).NotTo(HaveOccurred())
+
+				By("validating the worker templates are created")
+				workerName := types.NamespacedName{
+					Name:      fmt.Sprintf("%s-worker", typeNamespacedName.Name),
+					Namespace: typeNamespacedName.Namespace,
+				}
+				err = k8sClient.Get(ctx, workerName, &dockerv1beta1.DockerMachineTemplate{})
+				Expect(err).NotTo(HaveOccurred())
+				err = k8sClient.Get(ctx, workerName, &kthreesbootstrapv1beta2.KThreesConfigTemplate{})
+				Expect(err).NotTo(HaveOccurred())
 			},
 		),
 
@@ -176,7 +214,382 @@ var _ = Describe("ClusterTemplate Controller", func() {
 				By("validating the KThreesControlPlaneTemplate is created")
 				err = k8sClient.Get(ctx, typeNamespacedName, &kthreescpv1beta2.KThreesControlPlaneTemplate{})
 				Expect(err).NotTo(HaveOccurred())
+
+				By("validating the worker templates are created")
+				workerName := types.NamespacedName{
+					Name:      fmt.Sprintf("%s-worker", typeNamespacedName.Name),
+					Namespace: typeNamespacedName.Namespace,
+				}
+				err = k8sClient.Get(ctx, workerName, &intelv1alpha1.IntelMachineTemplate{})
+				Expect(err).NotTo(HaveOccurred())
+				err = k8sClient.Get(ctx, workerName, &kthreesbootstrapv1beta2.KThreesConfigTemplate{})
+				Expect(err).NotTo(HaveOccurred())
 			},
 		),
 	)
+
+	It("should render the resources changed out-of-band again", func() {
+		driftName := types.NamespacedName{Name: "drift-resource", Namespace: "default"}
+		workerName := types.NamespacedName{Name: fmt.Sprintf("%s-worker", driftName.Name), Namespace: driftName.Namespace}
+		controlPlaneTemplate := func() *unstructured.Unstructured {
+			cpt := &unstructured.Unstructured{}
+			cpt.SetAPIVersion("controlplane.cluster.x-k8s.io/v1beta2")
+			cpt.SetKind("KThreesControlPlaneTemplate")
+			Expect(k8sClient.Get(ctx, driftName, cpt)).To(Succeed())
+			return cpt
+		}
+
+		By("creating and reconciling the ClusterTemplate")
+		clusterTemplate := &clusterv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: driftName.Name, Namespace: driftName.Namespace},
+			Spec: clusterv1alpha1.ClusterTemplateSpec{
+				ControlPlaneProviderType: "k3s",
+				InfraProviderType:        "docker",
+				KubernetesVersion:        "v1.33.5+k3s1",
+				ClusterConfiguration:     "{\"kind\":\"KThreesControlPlaneTemplate\",\"apiVersion\":\"controlplane.cluster.x-k8s.io/v1beta2\",\"spec\":{\"template\":{\"spec\":{\"kthreesConfigSpec\":{\"agentConfig\":{\"airGapped\":false},\"preK3sCommands\":[\"echo hello\"]}}}}}",
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterTemplate)).To(Succeed())
+		controllerReconciler := &ClusterTemplateReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+		}
+		for range 2 {
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: driftName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(DefaultDriftCheckInterval))
+		}
+		Expect(k8sClient.Get(ctx, driftName, clusterTemplate)).To(Succeed())
+		Expect(isConditionTrue(clusterTemplate, clusterv1alpha1.DriftRepairedCondition)).To(BeFalse())
+
+		By("editing the control plane template and deleting the worker bootstrap template")
+		cpt := controlPlaneTemplate()
+		Expect(unstructured.SetNestedStringSlice(cpt.Object, []string{"echo drift"}, "spec", "template", "spec", "kthreesConfigSpec", "preK3sCommands")).To(Succeed())
+		Expect(k8sClient.Update(ctx, cpt)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, &kthreesbootstrapv1beta2.KThreesConfigTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: workerName.Name, Namespace: workerName.Namespace},
+		})).To(Succeed())
+
+		By("editing the ClusterClass")
+		cc := &capiv1beta1.ClusterClass{}
+		Expect(k8sClient.Get(ctx, driftName, cc)).To(Succeed())
+		cc.Spec.ControlPlane.MachineHealthCheck = nil
+		Expect(k8sClient.Update(ctx, cc)).To(Succeed())
+
+		_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: driftName})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("validating the resources are rendered again")
+		commands, _, err := unstructured.NestedStringSlice(controlPlaneTemplate().Object, "spec", "template", "spec", "kthreesConfigSpec", "preK3sCommands")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commands).To(Equal([]string{"echo hello"}))
+		Expect(k8sClient.Get(ctx, workerName, &kthreesbootstrapv1beta2.KThreesConfigTemplate{})).To(Succeed())
+		Expect(k8sClient.Get(ctx, driftName, cc)).To(Succeed())
+		Expect(cc.Spec.ControlPlane.MachineHealthCheck).NotTo(BeNil())
+
+		By("validating the repair is recorded")
+		Expect(k8sClient.Get(ctx, driftName, clusterTemplate)).To(Succeed())
+		Expect(isConditionTrue(clusterTemplate, clusterv1alpha1.DriftRepairedCondition)).To(BeTrue())
+
+		By("Cleanup the ClusterTemplate")
+		Expect(k8sClient.Delete(ctx, clusterTemplate)).To(Succeed())
+		_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: driftName})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not be ready with an unsupported provider combination", func() {
+		unsupportedName := types.NamespacedName{Name: "unsupported-resource", Namespace: "default"}
+		clusterTemplate := &clusterv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: unsupportedName.Name, Namespace: unsupportedName.Namespace},
+			Spec: clusterv1alpha1.ClusterTemplateSpec{
+				ControlPlaneProviderType: "kubeadm",
+				InfraProviderType:        "intel",
+				KubernetesVersion:        "v1.30.6",
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterTemplate)).To(Succeed())
+		controllerReconciler := &ClusterTemplateReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+		}
+		_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: unsupportedName})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, unsupportedName, clusterTemplate)).To(Succeed())
+		Expect(clusterTemplate.Status.Ready).To(BeFalse())
+		condition := conditions.Get(clusterTemplate, capiv1beta2.ConditionType(clusterv1alpha1.ProvidersValidatedCondition))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(clusterv1alpha1.UnsupportedProvidersReason))
+		Expect(condition.Message).To(ContainSubstring(`"kubeadm" is not supported with infrastructure provider "intel"`))
+
+		By("Cleanup the ClusterTemplate")
+		Expect(k8sClient.Delete(ctx, clusterTemplate)).To(Succeed())
+	})
+
+	It("should count the failed reconciliations per step", func() {
+		missingName := types.NamespacedName{Name: "missing-secret-resource", Namespace: "default"}
+		clusterTemplate := &clusterv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: missingName.Name, Namespace: missingName.Namespace},
+			Spec: clusterv1alpha1.ClusterTemplateSpec{
+				ControlPlaneProviderType: "k3s",
+				InfraProviderType:        "docker",
+				KubernetesVersion:        "v1.33.5+k3s1",
+				ClusterConfiguration:     "{\"kind\":\"KThreesControlPlaneTemplate\",\"apiVersion\":\"controlplane.cluster.x-k8s.io/v1beta2\",\"spec\":{\"template\":{\"spec\":{\"kthreesConfigSpec\":{\"preK3sCommands\":[\"echo ${secret:missing/token}\"]}}}}}",
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterTemplate)).To(Succeed())
+		controllerReconciler := &ClusterTemplateReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+		}
+		failures := testutil.ToFloat64(templateReconcileErrors.WithLabelValues(stepControlPlaneTemplate))
+		_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: missingName})
+		Expect(err).To(HaveOccurred())
+
+		Expect(testutil.ToFloat64(templateReconcileErrors.WithLabelValues(stepControlPlaneTemplate))).To(Equal(failures + 1))
+		Expect(k8sClient.Get(ctx, missingName, clusterTemplate)).To(Succeed())
+		Expect(clusterTemplate.Status.Ready).To(BeFalse())
+		Expect(isConditionTrue(clusterTemplate, clusterv1alpha1.ProvidersValidatedCondition)).To(BeTrue())
+		condition := conditions.Get(clusterTemplate, capiv1beta2.ConditionType(clusterv1alpha1.ConfigRenderedCondition))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(ContainSubstring("missing"))
+
+		By("Cleanup the ClusterTemplate")
+		Expect(k8sClient.Delete(ctx, clusterTemplate)).To(Succeed())
+	})
+
+	It("should render the remediation settings into the health checks of the ClusterClass", func() {
+		remediationName := types.NamespacedName{Name: "remediation-resource", Namespace: "default"}
+		maxUnhealthy := intstr.FromString("40%")
+
+		By("creating and reconciling the ClusterTemplate")
+		clusterTemplate := &clusterv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: remediationName.Name, Namespace: remediationName.Namespace},
+			Spec: clusterv1alpha1.ClusterTemplateSpec{
+				ControlPlaneProviderType: "k3s",
+				InfraProviderType:        "docker",
+				KubernetesVersion:        "v1.33.5+k3s1",
+				ClusterConfiguration:     "{\"kind\":\"KThreesControlPlaneTemplate\",\"apiVersion\":\"controlplane.cluster.x-k8s.io/v1beta2\",\"spec\":{\"template\":{\"spec\":{\"kthreesConfigSpec\":{\"agentConfig\":{\"airGapped\":false}}}}}}",
+				Remediation: &clusterv1alpha1.RemediationConfig{
+					NodeStartupTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+					UnhealthyConditions: []clusterv1alpha1.UnhealthyCondition{
+						{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: 2 * time.Minute}},
+					},
+					MaxUnhealthy: &maxUnhealthy,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterTemplate)).To(Succeed())
+		controllerReconciler := &ClusterTemplateReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+		}
+		for range 2 {
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: remediationName})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		By("validating the control plane and the node pools are checked")
+		cc := &capiv1beta1.ClusterClass{}
+		Expect(k8sClient.Get(ctx, remediationName, cc)).To(Succeed())
+		expected := &capiv1beta1.MachineHealthCheckClass{
+			UnhealthyConditions: []capiv1beta1.UnhealthyCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: 2 * time.Minute}},
+			},
+			MaxUnhealthy:       &maxUnhealthy,
+			NodeStartupTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+		}
+		Expect(cc.Spec.ControlPlane.MachineHealthCheck).To(Equal(expected))
+		Expect(cc.Spec.Workers.MachineDeployments).To(HaveLen(1))
+		Expect(cc.Spec.Workers.MachineDeployments[0].MachineHealthCheck).To(Equal(expected))
+
+		By("removing the remediation settings")
+		Expect(k8sClient.Get(ctx, remediationName, clusterTemplate)).To(Succeed())
+		clusterTemplate.Spec.Remediation = nil
+		Expect(k8sClient.Update(ctx, clusterTemplate)).To(Succeed())
+		_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: remediationName})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("validating only the control plane is checked")
+		Expect(k8sClient.Get(ctx, remediationName, cc)).To(Succeed())
+		Expect(cc.Spec.ControlPlane.MachineHealthCheck).NotTo(BeNil())
+		Expect(cc.Spec.ControlPlane.MachineHealthCheck.NodeStartupTimeout).To(BeNil())
+		Expect(cc.Spec.Workers.MachineDeployments[0].MachineHealthCheck).To(BeNil())
+		Expect(k8sClient.Get(ctx, remediationName, clusterTemplate)).To(Succeed())
+		Expect(isConditionTrue(clusterTemplate, clusterv1alpha1.DriftRepairedCondition)).To(BeFalse())
+
+		By("Cleanup the ClusterTemplate")
+		Expect(k8sClient.Delete(ctx, clusterTemplate)).To(Succeed())
+		_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: remediationName})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should render the single-node settings into the control plane template only", func() {
+		singleNodeName := types.NamespacedName{Name: "single-node-resource", Namespace: "default"}
+		workerName := types.NamespacedName{Name: fmt.Sprintf("%s-worker", singleNodeName.Name), Namespace: singleNodeName.Namespace}
+		k3sConfigSpec := func(kind string, name types.NamespacedName, path ...string) map[string]interface{} {
+			u := &unstructured.Unstructured{}
+			u.SetAPIVersion("controlplane.cluster.x-k8s.io/v1beta2")
+			if kind == "KThreesConfigTemplate" {
+				u.SetAPIVersion("bootstrap.cluster.x-k8s.io/v1beta2")
+			}
+			u.SetKind(kind)
+			Expect(k8sClient.Get(ctx, name, u)).To(Succeed())
+			spec, found, err := unstructured.NestedMap(u.Object, path...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			return spec
+		}
+		filePaths := func(spec map[string]interface{}) []string {
+			paths := []string{}
+			files, _, _ := unstructured.NestedSlice(spec, "files")
+			for _, f := range files {
+				paths = append(paths, f.(map[string]interface{})["path"].(string))
+			}
+			return paths
+		}
+
+		By("creating and reconciling the ClusterTemplate")
+		clusterTemplate := &clusterv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: singleNodeName.Name, Namespace: singleNodeName.Namespace},
+			Spec: clusterv1alpha1.ClusterTemplateSpec{
+				ControlPlaneProviderType: "k3s",
+				InfraProviderType:        "docker",
+				KubernetesVersion:        "v1.33.5+k3s1",
+				ClusterConfiguration:     "{\"kind\":\"KThreesControlPlaneTemplate\",\"apiVersion\":\"controlplane.cluster.x-k8s.io/v1beta2\",\"spec\":{\"template\":{\"spec\":{\"kthreesConfigSpec\":{\"agentConfig\":{\"airGapped\":false,\"nodeTaints\":[\"node-role.kubernetes.io/control-plane:NoSchedule\",\"gpu=true:NoSchedule\"]}}}}}}",
+				SingleNode: &clusterv1alpha1.SingleNodeConfig{
+					EtcdSnapshotDir: "/var/lib/edge/snapshots",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterTemplate)).To(Succeed())
+		controllerReconciler := &ClusterTemplateReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+		}
+		for range 2 {
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: singleNodeName})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		By("validating the control plane runs the workloads and rejoins its cluster")
+		cpSpec := k3sConfigSpec("KThreesControlPlaneTemplate", singleNodeName, "spec", "template", "spec", "kthreesConfigSpec")
+		taints, _, err := unstructured.NestedStringSlice(cpSpec, "agentConfig", "nodeTaints")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taints).To(Equal([]string{"gpu=true:NoSchedule"}))
+		Expect(filePaths(cpSpec)).To(ContainElements("/etc/rancher/k3s/config.yaml.d/60-single-node.yaml", "/usr/local/bin/k3s-rejoin.sh"))
+		postCommands, _, err := unstructured.NestedStringSlice(cpSpec, "postK3sCommands")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(postCommands).To(ContainElement("/usr/local/bin/k3s-rejoin.sh"))
+
+		By("validating the workers do not take the single-node settings")
+		workerSpec := k3sConfigSpec("KThreesConfigTemplate", workerName, "spec", "template", "spec")
+		Expect(filePaths(workerSpec)).NotTo(ContainElement("/usr/local/bin/k3s-rejoin.sh"))
+		Expect(filePaths(workerSpec)).NotTo(ContainElement("/etc/rancher/k3s/config.yaml.d/60-single-node.yaml"))
+		postCommands, _, err = unstructured.NestedStringSlice(workerSpec, "postK3sCommands")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(postCommands).NotTo(ContainElement("/usr/local/bin/k3s-rejoin.sh"))
+
+		By("Cleanup the ClusterTemplate")
+		Expect(k8sClient.Delete(ctx, clusterTemplate)).To(Succeed())
+		_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: singleNodeName})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should render the template variables into the variables and patches of the ClusterClass", func() {
+		variablesName := types.NamespacedName{Name: "variables-resource", Namespace: "default"}
+		maxPodsDefault := "110"
+		minimum := int64(10)
+
+		By("creating and reconciling the ClusterTemplate")
+		clusterTemplate := &clusterv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: variablesName.Name, Namespace: variablesName.Namespace},
+			Spec: clusterv1alpha1.ClusterTemplateSpec{
+				ControlPlaneProviderType: "k3s",
+				InfraProviderType:        "docker",
+				KubernetesVersion:        "v1.33.5+k3s1",
+				ClusterConfiguration:     "{\"kind\":\"KThreesControlPlaneTemplate\",\"apiVersion\":\"controlplane.cluster.x-k8s.io/v1beta2\",\"spec\":{\"template\":{\"spec\":{\"kthreesConfigSpec\":{\"agentConfig\":{\"airGapped\":false}}}}}}",
+				Variables: []clusterv1alpha1.TemplateVariable{
+					{
+						Name:    "maxPods",
+						Type:    clusterv1alpha1.IntegerVariable,
+						Default: &maxPodsDefault,
+						Minimum: &minimum,
+						Patches: []clusterv1alpha1.TemplateVariablePatch{
+							{Target: "controlPlane", Path: "/spec/template/spec/kthreesConfigSpec/agentConfig/kubeletArgs/-", ValueTemplate: "max-pods={{ .maxPods }}"},
+							{Target: "workers", Path: "/spec/template/spec/agentConfig/kubeletArgs/-", ValueTemplate: "max-pods={{ .maxPods }}"},
+						},
+					},
+					{
+						Name: "profile",
+						Type: clusterv1alpha1.EnumVariable,
+						Enum: []string{"small", "large"},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterTemplate)).To(Succeed())
+		controllerReconciler := &ClusterTemplateReconciler{
+			Client: k8sClient,
+			Scheme: k8sClient.Scheme(),
+		}
+		for range 2 {
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: variablesName})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		By("validating the variables are declared with their schema")
+		cc := &capiv1beta1.ClusterClass{}
+		Expect(k8sClient.Get(ctx, variablesName, cc)).To(Succeed())
+		variables := map[string]capiv1beta1.ClusterClassVariable{}
+		for _, variable := range cc.Spec.Variables {
+			variables[variable.Name] = variable
+		}
+		Expect(variables).To(HaveKey("maxPods"))
+		Expect(variables["maxPods"].Schema.OpenAPIV3Schema.Type).To(Equal("integer"))
+		Expect(variables["maxPods"].Schema.OpenAPIV3Schema.Minimum).To(Equal(&minimum))
+		Expect(string(variables["maxPods"].Schema.OpenAPIV3Schema.Default.Raw)).To(Equal("110"))
+		Expect(variables).To(HaveKey("profile"))
+		Expect(variables["profile"].Schema.OpenAPIV3Schema.Type).To(Equal("string"))
+		Expect(variables["profile"].Schema.OpenAPIV3Schema.Enum).To(HaveLen(2))
+
+		By("validating the patches select the control plane and worker templates")
+		var patch *capiv1beta1.ClusterClassPatch
+		for i := range cc.Spec.Patches {
+			if cc.Spec.Patches[i].Name == "template-variable-maxPods" {
+				patch = &cc.Spec.Patches[i]
+			}
+		}
+		Expect(patch).NotTo(BeNil())
+		Expect(patch.EnabledIf).To(BeNil())
+		Expect(patch.Definitions).To(HaveLen(2))
+		Expect(patch.Definitions[0].Selector.Kind).To(Equal("KThreesControlPlaneTemplate"))
+		Expect(patch.Definitions[0].Selector.MatchResources.ControlPlane).To(BeTrue())
+		Expect(patch.Definitions[1].Selector.Kind).To(Equal("KThreesConfigTemplate"))
+		Expect(patch.Definitions[1].Selector.MatchResources.MachineDeploymentClass.Names).To(Equal([]string{"default-worker"}))
+		Expect(*patch.Definitions[1].JSONPatches[0].ValueFrom.Template).To(Equal("max-pods={{ .maxPods }}"))
+
+		By("removing the variables")
+		Expect(k8sClient.Get(ctx, variablesName, clusterTemplate)).To(Succeed())
+		clusterTemplate.Spec.Variables = nil
+		Expect(k8sClient.Update(ctx, clusterTemplate)).To(Succeed())
+		_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: variablesName})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("validating the variables and their patches are removed")
+		Expect(k8sClient.Get(ctx, variablesName, cc)).To(Succeed())
+		for _, variable := range cc.Spec.Variables {
+			Expect(variable.Name).NotTo(BeElementOf("maxPods", "profile"))
+		}
+		for _, patch := range cc.Spec.Patches {
+			Expect(patch.Name).NotTo(Equal("template-variable-maxPods"))
+		}
+
+		By("Cleanup the ClusterTemplate")
+		Expect(k8sClient.Delete(ctx, clusterTemplate)).To(Succeed())
+		_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: variablesName})
+		Expect(err).NotTo(HaveOccurred())
+	})
 })
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	capiv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	capiProvider "github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+)
+
+// DefaultDriftCheckInterval is the default time between two checks of the templates rendered from a ClusterTemplate
+// for out-of-band changes; changes of the ClusterClass are repaired as soon as they are observed
+const DefaultDriftCheckInterval = 10 * time.Minute
+
+const (
+	driftEdited  = "edited"
+	driftDeleted = "deleted"
+)
+
+// templateDriftRepairs is the number of resources rendered from cluster templates that were rendered again after
+// they were edited or deleted out-of-band
+var templateDriftRepairs = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "cluster_manager_template_drift_repairs_counter",
+		Help: "Count of the resources rendered from cluster templates that were rendered again after being edited or deleted out-of-band per kind and change",
+	},
+	[]string{"kind", "change"},
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(templateDriftRepairs)
+}
+
+// reconcileDrift renders the control plane and worker templates and the ClusterClass created by previous
+// reconciliations again if they were edited or deleted out-of-band, and records the repairs in the
+// DriftRepairedCondition. The templates are replaced since they are immutable; a deleted ClusterClass is created
+// again by reconcileClusterClass. After the spec of the ClusterTemplate was updated in place, the templates differ
+// from their rendering as well and are replaced the same way, but that is not drift.
+func (r *ClusterTemplateReconciler) reconcileDrift(ctx context.Context, logger logr.Logger, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) error {
+	specUpdated := clusterTemplate.Status.ObservedGeneration != 0 && clusterTemplate.Status.ObservedGeneration != clusterTemplate.Generation
+	rendering := &renderingClient{Client: r.Client}
+	var secrets []string
+	controlPlaneCreated := isConditionTrue(clusterTemplate, clustertemplatev1alpha1.ControlPlaneTemplateCondition)
+	workersCreated := isConditionTrue(clusterTemplate, clustertemplatev1alpha1.WorkerTemplatesCondition)
+	if controlPlaneCreated || workersCreated {
+		config, resolved, err := r.renderClusterConfiguration(ctx, clusterTemplate)
+		if err != nil {
+			return err
+		}
+		secrets = resolved
+		if controlPlaneCreated {
+			if err := provider.CreateControlPlaneTemplate(ctx, rendering, namespacedName, config); err != nil {
+				return err
+			}
+		}
+		if workersCreated {
+			if err := provider.CreateWorkerTemplates(ctx, rendering, namespacedName, config); err != nil {
+				return err
+			}
+		}
+	}
+
+	repaired, updated := []string{}, []string{}
+	for _, rendered := range rendering.objects {
+		change, err := r.repairTemplate(ctx, rendered)
+		if err != nil {
+			err = capiProvider.RedactError(err, secrets)
+			logger.Error(err, "failed to repair drift of template", "namespace", rendered.GetNamespace(), "name", rendered.GetName())
+			return err
+		}
+		if change == "" {
+			continue
+		}
+		gvk, _ := apiutil.GVKForObject(rendered, r.Scheme)
+		if specUpdated && change == driftEdited {
+			updated = append(updated, fmt.Sprintf("%s %s", gvk.Kind, rendered.GetName()))
+			continue
+		}
+		templateDriftRepairs.WithLabelValues(gvk.Kind, change).Inc()
+		repaired = append(repaired, fmt.Sprintf("%s %s (%s)", gvk.Kind, rendered.GetName(), change))
+	}
+	if len(updated) > 0 {
+		logger.Info("Rendered templates again for the updated spec of ClusterTemplate", "namespace", namespacedName.Namespace, "name", namespacedName.Name,
+			"generation", clusterTemplate.Generation, "rendered", updated)
+	}
+
+	if isConditionTrue(clusterTemplate, clustertemplatev1alpha1.ClusterClassCondition) {
+		change, err := r.repairClusterClass(ctx, namespacedName, provider, clusterTemplate)
+		if err != nil {
+			logger.Error(err, "failed to repair drift of ClusterClass", "namespace", namespacedName.Namespace, "name", namespacedName.Name)
+			return err
+		}
+		if specUpdated && change == driftEdited {
+			// e.g. the health checks of updated remediation settings
+			logger.Info("Rendered ClusterClass again for the updated spec of ClusterTemplate", "namespace", namespacedName.Namespace, "name", namespacedName.Name,
+				"generation", clusterTemplate.Generation)
+		} else if change != "" {
+			templateDriftRepairs.WithLabelValues("ClusterClass", change).Inc()
+			repaired = append(repaired, fmt.Sprintf("ClusterClass %s (%s)", namespacedName.Name, change))
+		}
+	}
+
+	if len(repaired) > 0 {
+		message := "rendered again: " + strings.Join(repaired, ", ")
+		logger.Info("Repaired drift of resources rendered from ClusterTemplate", "namespace", namespacedName.Namespace, "name", namespacedName.Name, "repaired", repaired)
+		conditions.MarkTrueWithNegativePolarity(clusterTemplate, capiv1beta2.ConditionType(clustertemplatev1alpha1.DriftRepairedCondition),
+			clustertemplatev1alpha1.DriftRepairedReason, capiv1beta2.ConditionSeverity(capiv1beta1.ConditionSeverityInfo), "%s", message)
+	}
+	return nil
+}
+
+// repairTemplate creates the rendered template again if it was deleted and replaces it if its spec was edited; it
+// returns the change that was repaired, empty if the template did not drift
+func (r *ClusterTemplateReconciler) repairTemplate(ctx context.Context, rendered client.Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(rendered, r.Scheme)
+	if err != nil {
+		return "", err
+	}
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(gvk)
+
+	change := driftDeleted
+	err = r.Get(ctx, client.ObjectKeyFromObject(rendered), live)
+	switch {
+	case errors.IsNotFound(err):
+	case err != nil:
+		return "", err
+	default:
+		drifted, err := r.specDrifted(rendered, live)
+		if err != nil || !drifted {
+			return "", err
+		}
+		change = driftEdited
+		uid := live.GetUID()
+		if err := r.Delete(ctx, live, client.Preconditions{UID: &uid}); err != nil && !errors.IsNotFound(err) {
+			return "", err
+		}
+	}
+
+	if err := r.Create(ctx, rendered); err != nil {
+		if errors.IsAlreadyExists(err) && change == driftDeleted {
+			return "", nil // the template was created after the cache was read
+		}
+		return "", err
+	}
+	return change, nil
+}
+
+// repairClusterClass updates the spec of the ClusterClass to its rendering if it was edited or the spec of the
+// ClusterTemplate was updated; it returns the change that was repaired, empty if the ClusterClass did not drift
+func (r *ClusterTemplateReconciler) repairClusterClass(ctx context.Context, namespacedName types.NamespacedName, provider capiProvider.Provider, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) (string, error) {
+	rendered := renderClusterClass(namespacedName, provider, clusterTemplate)
+
+	live := &capiv1beta1.ClusterClass{}
+	err := r.Get(ctx, namespacedName, live)
+	if errors.IsNotFound(err) {
+		return driftDeleted, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	// the fields defaulted by the API server are not drift, but health checks, variables and patches removed from the
+	// rendering are
+	if equality.Semantic.DeepDerivative(rendered.Spec, live.Spec) && sameMachineHealthChecks(&rendered, live) &&
+		len(rendered.Spec.Variables) == len(live.Spec.Variables) && len(rendered.Spec.Patches) == len(live.Spec.Patches) {
+		return "", nil
+	}
+
+	live.Spec = rendered.Spec
+	if err := r.Update(ctx, live); err != nil {
+		return "", err
+	}
+	return driftEdited, nil
+}
+
+// sameMachineHealthChecks returns whether the ClusterClasses check the health of the same machines, e.g. not if the
+// remediation settings of the node pools were removed from the ClusterTemplate
+func sameMachineHealthChecks(rendered, live *capiv1beta1.ClusterClass) bool {
+	if (rendered.Spec.ControlPlane.MachineHealthCheck == nil) != (live.Spec.ControlPlane.MachineHealthCheck == nil) {
+		return false
+	}
+	if len(rendered.Spec.Workers.MachineDeployments) != len(live.Spec.Workers.MachineDeployments) {
+		return false
+	}
+	for i, class := range rendered.Spec.Workers.MachineDeployments {
+		if (class.MachineHealthCheck == nil) != (live.Spec.Workers.MachineDeployments[i].MachineHealthCheck == nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// specDrifted returns whether the spec of the live object differs from the rendered one, ignoring the fields the
+// rendered object does not set, e.g. those defaulted by the API server
+func (r *ClusterTemplateReconciler) specDrifted(rendered client.Object, live *unstructured.Unstructured) (bool, error) {
+	want, err := r.normalizedSpec(rendered)
+	if err != nil {
+		return false, err
+	}
+	have, err := r.normalizedSpec(live)
+	if err != nil {
+		return false, err
+	}
+	return !equality.Semantic.DeepDerivative(want, have), nil
+}
+
+// normalizedSpec returns the spec of the object as unstructured content; objects of kinds known by the scheme are
+// converted to their types first, so that rendered and live objects are serialized alike
+func (r *ClusterTemplateReconciler) normalizedSpec(obj client.Object) (any, error) {
+	var typed runtime.Object = obj
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		if known, err := r.Scheme.New(u.GroupVersionKind()); err == nil {
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, known); err != nil {
+				return nil, err
+			}
+			typed = known
+		}
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		return nil, err
+	}
+	return content["spec"], nil
+}
+
+// renderingClient records the objects the providers create instead of creating them, so that the templates can be
+// rendered with the same code that creates them
+type renderingClient struct {
+	client.Client
+	objects []client.Object
+}
+
+func (c *renderingClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	c.objects = append(c.objects, obj)
+	return nil
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// the steps of the reconciliation of a ClusterTemplate the reconcile errors are counted by
+const (
+	stepGet                          = "get"
+	stepDrift                        = "drift"
+	stepControlPlaneTemplate         = "control_plane_template"
+	stepPrerequisites                = "prerequisites"
+	stepControlPlaneMachineTemplate  = "control_plane_machine_template"
+	stepInfraProviderClusterTemplate = "infra_provider_cluster_template"
+	stepWorkerTemplates              = "worker_templates"
+	stepClusterClass                 = "cluster_class"
+	stepLabelPropagation             = "label_propagation"
+	stepDelete                       = "delete"
+	stepPatch                        = "patch"
+)
+
+const (
+	reconcileSuccess = "success"
+	reconcileError   = "error"
+)
+
+var (
+	// templateReconcileErrors is the number of failed reconciliations of cluster templates per failed step
+	templateReconcileErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_template_reconcile_errors_counter",
+			Help: "Count of the failed reconciliations of cluster templates per failed step",
+		},
+		[]string{"step"},
+	)
+
+	// templateReconcileDuration is the duration of the reconciliations of cluster templates
+	templateReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "cluster_manager_template_reconcile_duration_seconds",
+			Help:    "Duration of the reconciliations of cluster templates in seconds per result",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"result"},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(templateReconcileErrors, templateReconcileDuration)
+}
+
+// observeReconcile records the duration of the reconciliation of a ClusterTemplate started at the given time and, if
+// it failed, the step it failed at
+func observeReconcile(start time.Time, step string, err error) {
+	result := reconcileSuccess
+	if err != nil {
+		result = reconcileError
+		templateReconcileErrors.WithLabelValues(step).Inc()
+	}
+	templateReconcileDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
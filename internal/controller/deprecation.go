@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// DefaultDeprecationCheckInterval is the default time between two checks for clusters using deprecated templates
+const DefaultDeprecationCheckInterval = time.Hour
+
+const (
+	// TemplateDeprecatedCondition is true on clusters whose template is deprecated; it has a negative polarity, so
+	// it is a warning while true and removed once the cluster moved to another template
+	TemplateDeprecatedCondition capiv1beta1.ConditionType = "TemplateDeprecated"
+
+	// TemplateDeprecatedReason is the reason of the condition while the sunset date of the template is not reached
+	TemplateDeprecatedReason = "TemplateDeprecated"
+	// TemplateSunsetReason is the reason of the condition once the sunset date of the template passed
+	TemplateSunsetReason = "TemplateSunsetDatePassed"
+)
+
+// clustersOnDeprecatedTemplates is the number of clusters still using a deprecated template
+var clustersOnDeprecatedTemplates = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "cluster_manager_clusters_on_deprecated_template",
+		Help: "Number of clusters still using a deprecated cluster template per project and template",
+	},
+	[]string{"namespace", "template", "sunset"},
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(clustersOnDeprecatedTemplates)
+}
+
+// DeprecatedTemplateChecker periodically flags the clusters still using deprecated templates with the
+// TemplateDeprecatedCondition and counts them in the cluster_manager_clusters_on_deprecated_template metric
+type DeprecatedTemplateChecker struct {
+	client.Client
+	// Interval is the time between two checks, DefaultDeprecationCheckInterval if zero
+	Interval time.Duration
+	// Now returns the current time, time.Now if nil
+	Now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters/status,verbs=get;patch
+
+// SetupWithManager runs the checker with the manager
+func (c *DeprecatedTemplateChecker) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(c)
+}
+
+// NeedLeaderElection makes only the leader patch the clusters
+func (c *DeprecatedTemplateChecker) NeedLeaderElection() bool {
+	return true
+}
+
+// Start checks the clusters on start and then every interval until the context is canceled; failed checks are
+// logged and retried with the next check
+func (c *DeprecatedTemplateChecker) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("deprecated-template-checker")
+
+	interval := c.Interval
+	if interval <= 0 {
+		interval = DefaultDeprecationCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Check(ctx); err != nil {
+			logger.Error(err, "failed to check clusters for deprecated templates")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check sets the TemplateDeprecatedCondition on the clusters of deprecated templates, removes it from the other
+// clusters and updates the metric
+func (c *DeprecatedTemplateChecker) Check(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	now := time.Now
+	if c.Now != nil {
+		now = c.Now
+	}
+
+	templates := &clustertemplatev1alpha1.ClusterTemplateList{}
+	if err := c.List(ctx, templates); err != nil {
+		return fmt.Errorf("failed to list cluster templates: %w", err)
+	}
+	// clusters reference the cluster class of their template, which is named after the template
+	deprecated := map[types.NamespacedName]*clustertemplatev1alpha1.ClusterTemplate{}
+	for i := range templates.Items {
+		template := &templates.Items[i]
+		if template.LifecycleState() != clustertemplatev1alpha1.TemplateDeprecated {
+			continue
+		}
+		clusterClassName := template.Name
+		if template.Status.ClusterClassRef != nil {
+			clusterClassName = template.Status.ClusterClassRef.Name
+		}
+		deprecated[types.NamespacedName{Namespace: template.Namespace, Name: clusterClassName}] = template
+	}
+
+	clusters := &capiv1beta1.ClusterList{}
+	if err := c.List(ctx, clusters); err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	clustersOnDeprecatedTemplates.Reset()
+	var errs []error
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+
+		var template *clustertemplatev1alpha1.ClusterTemplate
+		if cluster.Spec.Topology != nil {
+			template = deprecated[cluster.GetClassKey()]
+		}
+
+		before := cluster.DeepCopy()
+		if template == nil {
+			if !v1beta1conditions.Has(cluster, TemplateDeprecatedCondition) {
+				continue
+			}
+			v1beta1conditions.Delete(cluster, TemplateDeprecatedCondition)
+		} else {
+			sunset := template.Spec.SunsetDate != nil && !now().Before(template.Spec.SunsetDate.Time)
+			clustersOnDeprecatedTemplates.WithLabelValues(cluster.Namespace, template.Name, strconv.FormatBool(sunset)).Inc()
+
+			condition := deprecatedCondition(template, sunset)
+			if current := v1beta1conditions.Get(cluster, TemplateDeprecatedCondition); current != nil && v1beta1conditions.HasSameState(current, condition) {
+				continue
+			}
+			v1beta1conditions.Set(cluster, condition)
+		}
+
+		// the optimistic lock keeps the conditions set by the cluster-api controllers meanwhile, the condition is
+		// patched again with the next check on conflicts
+		if err := c.Status().Patch(ctx, cluster, client.MergeFromWithOptions(before, client.MergeFromWithOptimisticLock{})); err != nil {
+			errs = append(errs, fmt.Errorf("failed to patch cluster %s/%s: %w", cluster.Namespace, cluster.Name, err))
+			continue
+		}
+		logger.Info("updated deprecated template condition of cluster", "namespace", cluster.Namespace, "name", cluster.Name, "deprecated", template != nil)
+	}
+	return errors.Join(errs...)
+}
+
+// deprecatedCondition returns the condition of the clusters of the deprecated template
+func deprecatedCondition(template *clustertemplatev1alpha1.ClusterTemplate, sunset bool) *capiv1beta1.Condition {
+	switch {
+	case sunset:
+		return v1beta1conditions.TrueConditionWithNegativePolarity(TemplateDeprecatedCondition, TemplateSunsetReason, capiv1beta1.ConditionSeverityError,
+			"template %s is deprecated and no longer supported since %s, move the cluster to a newer template", template.Name, template.Spec.SunsetDate.Format(time.DateOnly))
+	case template.Spec.SunsetDate != nil:
+		return v1beta1conditions.TrueConditionWithNegativePolarity(TemplateDeprecatedCondition, TemplateDeprecatedReason, capiv1beta1.ConditionSeverityWarning,
+			"template %s is deprecated and no longer supported after %s, move the cluster to a newer template", template.Name, template.Spec.SunsetDate.Format(time.DateOnly))
+	default:
+		return v1beta1conditions.TrueConditionWithNegativePolarity(TemplateDeprecatedCondition, TemplateDeprecatedReason, capiv1beta1.ConditionSeverityWarning,
+			"template %s is deprecated, move the cluster to a newer template", template.Name)
+	}
+}
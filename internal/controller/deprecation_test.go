@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+func deprecationTemplate(name string, state clustertemplatev1alpha1.TemplateLifecycleState, sunsetDate *metav1.Time) *clustertemplatev1alpha1.ClusterTemplate {
+	return &clustertemplatev1alpha1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "project"},
+		Spec:       clustertemplatev1alpha1.ClusterTemplateSpec{LifecycleState: state, SunsetDate: sunsetDate},
+		Status:     clustertemplatev1alpha1.ClusterTemplateStatus{ClusterClassRef: &corev1.ObjectReference{Name: name}},
+	}
+}
+
+func deprecationCluster(name, class string) *capiv1beta1.Cluster {
+	return &capiv1beta1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "project"},
+		Spec:       capiv1beta1.ClusterSpec{Topology: &capiv1beta1.Topology{Class: class, Version: "v1.30.6"}},
+	}
+}
+
+func TestDeprecatedTemplateChecker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	sunsetDate := metav1.NewTime(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	moved := deprecationCluster("moved", "baseline-v2.0.0")
+	v1beta1conditions.MarkTrueWithNegativePolarity(moved, TemplateDeprecatedCondition, TemplateDeprecatedReason, capiv1beta1.ConditionSeverityWarning, "")
+
+	c := fake.NewClientBuilder().WithScheme(backupScheme(t)).
+		WithObjects(
+			deprecationTemplate("baseline-v1.0.0", clustertemplatev1alpha1.TemplateDeprecated, &sunsetDate),
+			deprecationTemplate("baseline-v2.0.0", clustertemplatev1alpha1.TemplatePublished, nil),
+			deprecationCluster("old", "baseline-v1.0.0"),
+			deprecationCluster("current", "baseline-v2.0.0"),
+			moved,
+		).
+		WithStatusSubresource(&capiv1beta1.Cluster{}).
+		Build()
+	checker := &DeprecatedTemplateChecker{Client: c, Now: func() time.Time { return now }}
+
+	getCluster := func(name string) *capiv1beta1.Cluster {
+		cluster := &capiv1beta1.Cluster{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "project", Name: name}, cluster))
+		return cluster
+	}
+
+	t.Run("flags the clusters of deprecated templates", func(t *testing.T) {
+		require.NoError(t, checker.Check(ctx))
+
+		condition := v1beta1conditions.Get(getCluster("old"), TemplateDeprecatedCondition)
+		require.NotNil(t, condition)
+		require.Equal(t, corev1.ConditionTrue, condition.Status)
+		require.Equal(t, TemplateDeprecatedReason, condition.Reason)
+		require.Contains(t, condition.Message, "no longer supported after 2026-07-01")
+
+		require.False(t, v1beta1conditions.Has(getCluster("current"), TemplateDeprecatedCondition))
+		require.False(t, v1beta1conditions.Has(getCluster("moved"), TemplateDeprecatedCondition), "condition of a cluster on a published template is removed")
+
+		require.Equal(t, 1.0, testutil.ToFloat64(clustersOnDeprecatedTemplates.WithLabelValues("project", "baseline-v1.0.0", "false")))
+	})
+
+	t.Run("escalates the condition after the sunset date", func(t *testing.T) {
+		now = sunsetDate.Add(time.Hour)
+		require.NoError(t, checker.Check(ctx))
+
+		condition := v1beta1conditions.Get(getCluster("old"), TemplateDeprecatedCondition)
+		require.NotNil(t, condition)
+		require.Equal(t, TemplateSunsetReason, condition.Reason)
+		require.Equal(t, capiv1beta1.ConditionSeverityError, condition.Severity)
+
+		require.Equal(t, 1.0, testutil.ToFloat64(clustersOnDeprecatedTemplates.WithLabelValues("project", "baseline-v1.0.0", "true")))
+		require.Equal(t, 1, testutil.CollectAndCount(clustersOnDeprecatedTemplates))
+	})
+}
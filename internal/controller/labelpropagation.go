@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+)
+
+// reconcileLabelPropagation propagates the changes of the cluster labels of templates with the propagate policy to the
+// clusters of the template and records the propagated labels in the status. The labels of templates with the none
+// policy are recorded without propagating them, so that enabling the policy only propagates the following changes.
+func (r *ClusterTemplateReconciler) reconcileLabelPropagation(ctx context.Context, logger logr.Logger, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) error {
+	previous, current := clusterTemplate.Status.PropagatedClusterLabels, clusterTemplate.Spec.ClusterLabels
+	if maps.Equal(previous, current) {
+		return nil
+	}
+
+	if clusterTemplate.PropagatesLabels() {
+		clusters, err := templateClusters(ctx, r.Client, clusterTemplate)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for i := range clusters {
+			cluster := &clusters[i]
+			changes := labels.Propagation(cluster.Labels, previous, current)
+			if len(changes) == 0 {
+				continue
+			}
+
+			before := cluster.DeepCopy()
+			cluster.Labels = labels.Merge(cluster.Labels, changes)
+			if err := r.Patch(ctx, cluster, client.MergeFrom(before)); err != nil {
+				errs = append(errs, fmt.Errorf("failed to propagate labels to cluster %s/%s: %w", cluster.Namespace, cluster.Name, err))
+				continue
+			}
+			logger.Info("propagated template labels to cluster", "namespace", cluster.Namespace, "name", cluster.Name, "template", clusterTemplate.Name, "labels", changes)
+		}
+		// the labels are propagated again with the next reconciliation, the clusters that were patched already have
+		// no changes left
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	clusterTemplate.Status.PropagatedClusterLabels = maps.Clone(current)
+	return nil
+}
+
+// templateClusters returns the clusters created from the template, which reference the cluster class of the template
+func templateClusters(ctx context.Context, c client.Client, clusterTemplate *clustertemplatev1alpha1.ClusterTemplate) ([]capiv1beta1.Cluster, error) {
+	clusterClassName := clusterTemplate.Name
+	if clusterTemplate.Status.ClusterClassRef != nil {
+		clusterClassName = clusterTemplate.Status.ClusterClassRef.Name
+	}
+	classKey := types.NamespacedName{Namespace: clusterTemplate.Namespace, Name: clusterClassName}
+
+	clusters := &capiv1beta1.ClusterList{}
+	if err := c.List(ctx, clusters, client.InNamespace(clusterTemplate.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var matching []capiv1beta1.Cluster
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.Topology != nil && cluster.GetClassKey() == classKey {
+			matching = append(matching, cluster)
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+func labeledCluster(name, class string, labels map[string]string) *capiv1beta1.Cluster {
+	cluster := deprecationCluster(name, class)
+	cluster.Labels = labels
+	return cluster
+}
+
+func TestReconcileLabelPropagation(t *testing.T) {
+	ctx := context.Background()
+
+	template := deprecationTemplate("baseline-v1.0.0", clustertemplatev1alpha1.TemplatePublished, nil)
+	template.Spec.ClusterLabels = map[string]string{"region": "us", "tier": "gold", "added": "true"}
+	template.Status.PropagatedClusterLabels = map[string]string{"region": "eu", "tier": "gold"}
+
+	c := fake.NewClientBuilder().WithScheme(backupScheme(t)).
+		WithObjects(
+			labeledCluster("unchanged", "baseline-v1.0.0", map[string]string{"region": "eu", "tier": "gold"}),
+			labeledCluster("overridden", "baseline-v1.0.0", map[string]string{"region": "apac", "tier": "gold"}),
+			labeledCluster("other", "baseline-v2.0.0", map[string]string{"region": "eu"}),
+		).
+		Build()
+	r := &ClusterTemplateReconciler{Client: c}
+
+	clusterLabels := func(name string) map[string]string {
+		cluster := &capiv1beta1.Cluster{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "project", Name: name}, cluster))
+		return cluster.Labels
+	}
+
+	t.Run("labels are only recorded without propagation", func(t *testing.T) {
+		template := template.DeepCopy()
+		template.Spec.LabelPropagationPolicy = clustertemplatev1alpha1.LabelPropagationNone
+
+		require.NoError(t, r.reconcileLabelPropagation(ctx, logr.Discard(), template))
+		require.Equal(t, template.Spec.ClusterLabels, template.Status.PropagatedClusterLabels)
+		require.Equal(t, map[string]string{"region": "eu", "tier": "gold"}, clusterLabels("unchanged"))
+	})
+
+	t.Run("changes are propagated to the clusters of the template", func(t *testing.T) {
+		template := template.DeepCopy()
+		template.Spec.LabelPropagationPolicy = clustertemplatev1alpha1.LabelPropagationPropagate
+
+		require.NoError(t, r.reconcileLabelPropagation(ctx, logr.Discard(), template))
+		require.Equal(t, template.Spec.ClusterLabels, template.Status.PropagatedClusterLabels)
+
+		require.Equal(t, map[string]string{"region": "us", "tier": "gold", "added": "true"}, clusterLabels("unchanged"))
+		require.Equal(t, map[string]string{"region": "apac", "tier": "gold", "added": "true"}, clusterLabels("overridden"), "overridden labels are kept")
+		require.Equal(t, map[string]string{"region": "eu"}, clusterLabels("other"), "clusters of other templates are not changed")
+	})
+}
@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clustertemplatev1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// ErrSnapshotFailed is returned when a snapshot or restore failed and retrying it does not help
+var ErrSnapshotFailed = errors.New("etcd snapshot failed")
+
+// DefaultSnapshotJobImage is the image of the jobs running the k3s etcd snapshot commands on the control plane nodes,
+// it only needs chroot since the commands run in the root file system of the node
+const DefaultSnapshotJobImage = "busybox:1.37"
+
+const (
+	snapshotJobNamespace = "kube-system"
+	snapshotFilePrefix   = "file://"
+)
+
+// etcdSnapshotFileList are the k3s etcd snapshot files the k3s servers publish in the workload cluster
+var etcdSnapshotFileList = schema.GroupVersionKind{Group: "k3s.cattle.io", Version: "v1", Kind: "ETCDSnapshotFileList"}
+
+// Snapshot is an etcd snapshot taken by a Snapshotter
+type Snapshot struct {
+	Name     string
+	Location string
+	NodeName string
+	Size     *resource.Quantity
+}
+
+// Snapshotter takes and restores etcd snapshots of the clusters of a control plane provider
+// Both calls are repeated on every reconciliation until they complete, so they must be idempotent
+type Snapshotter interface {
+	// Save starts the snapshot of the cluster for the backup and returns it once completed, nil while it is running
+	Save(ctx context.Context, cluster *capiv1beta1.Cluster, backup *clustertemplatev1alpha1.Backup) (*Snapshot, error)
+
+	// Restore starts the restore of the cluster from the snapshot of the backup and returns true once completed, the
+	// job running the restore is recorded in the restore status of the backup
+	Restore(ctx context.Context, cluster *capiv1beta1.Cluster, backup *clustertemplatev1alpha1.Backup) (bool, error)
+}
+
+// K3sSnapshotter takes etcd snapshots of k3s clusters with jobs running the k3s etcd-snapshot command on a control
+// plane node of the workload cluster, the snapshots are stored on the node as configured for the k3s server
+type K3sSnapshotter struct {
+	client.Client
+	// Image is the image of the snapshot jobs, DefaultSnapshotJobImage if empty
+	Image string
+	// ClusterClient returns the client of the workload cluster, remote.NewClusterClient if nil
+	ClusterClient remote.ClusterClientGetter
+}
+
+func (s *K3sSnapshotter) Save(ctx context.Context, cluster *capiv1beta1.Cluster, backup *clustertemplatev1alpha1.Backup) (*Snapshot, error) {
+	workload, err := s.workloadClient(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	job := s.job(snapshotJobName("etcd-snapshot", string(backup.UID)),
+		[]string{"k3s", "etcd-snapshot", "save", "--name", backup.Name}, "")
+	done, err := runJob(ctx, workload, job)
+	if err != nil || !done {
+		return nil, err
+	}
+
+	// k3s names the snapshot files after the requested name, the node and the time of the snapshot
+	files := &unstructured.UnstructuredList{}
+	files.SetGroupVersionKind(etcdSnapshotFileList)
+	if err := workload.List(ctx, files); err != nil {
+		return nil, fmt.Errorf("failed to list etcd snapshot files: %w", err)
+	}
+	for _, file := range files.Items {
+		name, _, _ := unstructured.NestedString(file.Object, "spec", "snapshotName")
+		if !strings.HasPrefix(name, backup.Name+"-") {
+			continue
+		}
+		snapshot := &Snapshot{Name: name}
+		snapshot.Location, _, _ = unstructured.NestedString(file.Object, "spec", "location")
+		snapshot.NodeName, _, _ = unstructured.NestedString(file.Object, "spec", "nodeName")
+		if size, ok, _ := unstructured.NestedString(file.Object, "status", "size"); ok {
+			if quantity, err := resource.ParseQuantity(size); err == nil {
+				snapshot.Size = &quantity
+			}
+		}
+		return snapshot, nil
+	}
+	return nil, fmt.Errorf("%w: the snapshot job completed without creating a snapshot file", ErrSnapshotFailed)
+}
+
+func (s *K3sSnapshotter) Restore(ctx context.Context, cluster *capiv1beta1.Cluster, backup *clustertemplatev1alpha1.Backup) (bool, error) {
+	// the other k3s servers would rejoin with the etcd members the reset removes, so they have to be reset as well,
+	// which a job on one node can not do
+	if cluster.Spec.Topology != nil && cluster.Spec.Topology.ControlPlane.Replicas != nil && *cluster.Spec.Topology.ControlPlane.Replicas > 1 {
+		return false, fmt.Errorf("%w: only clusters with a single control plane node can be restored", ErrSnapshotFailed)
+	}
+	path, ok := strings.CutPrefix(backup.Status.Location, snapshotFilePrefix)
+	if !ok {
+		return false, fmt.Errorf("%w: only snapshots stored on the control plane node can be restored", ErrSnapshotFailed)
+	}
+	if backup.Status.Restore == nil {
+		return false, fmt.Errorf("restore of backup %s is not requested", backup.Name)
+	}
+
+	workload, err := s.workloadClient(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+
+	// k3s can only reset its etcd while it is stopped; the job survives the restart because k3s leaves the
+	// containers running when it stops
+	script := fmt.Sprintf("systemctl stop k3s && k3s server --cluster-reset --cluster-reset-restore-path=%s; status=$?; systemctl start k3s; exit $status", path)
+	job := s.job(snapshotJobName("etcd-restore", string(backup.UID)+"/"+backup.Status.Restore.RequestedAt),
+		[]string{"sh", "-c", script}, backup.Status.NodeName)
+	job.Spec.Template.Spec.HostPID = true
+
+	// the restored etcd does not know the job as it was created after the snapshot, so a job that was created but is
+	// not found anymore completed the reset
+	existing := &batchv1.Job{}
+	err = workload.Get(ctx, client.ObjectKeyFromObject(job), existing)
+	switch {
+	case apierrors.IsNotFound(err) && backup.Status.Restore.JobName == job.Name:
+		return true, nil
+	case apierrors.IsNotFound(err):
+		if err := workload.Create(ctx, job); err != nil {
+			return false, fmt.Errorf("failed to create the restore job: %w", err)
+		}
+		backup.Status.Restore.JobName = job.Name
+		return false, nil
+	case err != nil:
+		// the API server of the cluster is unavailable while k3s restarts
+		return false, nil
+	}
+	return jobDone(existing)
+}
+
+func (s *K3sSnapshotter) workloadClient(ctx context.Context, cluster *capiv1beta1.Cluster) (client.Client, error) {
+	clusterClient := s.ClusterClient
+	if clusterClient == nil {
+		clusterClient = remote.NewClusterClient
+	}
+	workload, err := clusterClient(ctx, "backup-controller", s.Client, client.ObjectKeyFromObject(cluster))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the client of cluster %s: %w", cluster.Name, err)
+	}
+	return workload, nil
+}
+
+// job returns a job running the command in the root file system of a control plane node, on the given node if set
+func (s *K3sSnapshotter) job(name string, command []string, nodeName string) *batchv1.Job {
+	image := s.Image
+	if image == "" {
+		image = DefaultSnapshotJobImage
+	}
+	backoffLimit := int32(0)
+	ttl := int32(3600)
+	privileged := true
+
+	nodeSelector := map[string]string{"node-role.kubernetes.io/control-plane": "true"}
+	if nodeName != "" {
+		nodeSelector = map[string]string{corev1.LabelHostname: nodeName}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: snapshotJobNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "cluster-manager"},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					HostNetwork:   true,
+					NodeSelector:  nodeSelector,
+					Tolerations:   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{{
+						Name:            "etcd-snapshot",
+						Image:           image,
+						Command:         append([]string{"chroot", "/host"}, command...),
+						SecurityContext: &corev1.SecurityContext{Privileged: &privileged},
+						VolumeMounts:    []corev1.VolumeMount{{Name: "host", MountPath: "/host"}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name:         "host",
+						VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// runJob creates the job unless it exists and returns true once it succeeded
+func runJob(ctx context.Context, c client.Client, job *batchv1.Job) (bool, error) {
+	existing := &batchv1.Job{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(job), existing)
+	if apierrors.IsNotFound(err) {
+		if err := c.Create(ctx, job); err != nil {
+			return false, fmt.Errorf("failed to create job %s: %w", job.Name, err)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get job %s: %w", job.Name, err)
+	}
+	return jobDone(existing)
+}
+
+// jobDone returns true if the job succeeded and an error wrapping ErrSnapshotFailed if it failed
+func jobDone(job *batchv1.Job) (bool, error) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			return false, fmt.Errorf("%w: job %s failed: %s", ErrSnapshotFailed, job.Name, condition.Message)
+		}
+	}
+	return false, nil
+}
+
+// snapshotJobName returns a job name that is unique for the given key and short enough for the job-name label
+func snapshotJobName(prefix, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%s-%x", prefix, sum[:8])
+}
@@ -83,6 +83,7 @@ func buildCRDPaths() []string {
 	paths := []string{
 		filepath.Join("..", "..", "config", "crd", "bases"),
 		filepath.Join(capiDir, "controlplane", "kubeadm", "config", "crd", "bases"),
+		filepath.Join(capiDir, "bootstrap", "kubeadm", "config", "crd", "bases"),
 		filepath.Join(capiDir, "config", "crd", "bases"),
 		filepath.Join(capiTestDir, "infrastructure", "docker", "config", "crd", "bases"),
 		filepath.Join(intelDir, "config", "crd", "bases"),
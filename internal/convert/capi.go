@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package convert
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	capiv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// the Cluster API versions the cluster manager can run against
+const (
+	CAPIVersionV1Beta1 = "v1beta1"
+	CAPIVersionV1Beta2 = "v1beta2"
+)
+
+// CAPIVersions are the supported Cluster API versions
+var CAPIVersions = []string{CAPIVersionV1Beta1, CAPIVersionV1Beta2}
+
+// ErrNotConvertible is returned for objects of kinds that cannot be converted between the Cluster API versions
+var ErrNotConvertible = errors.New("kind cannot be converted between cluster api versions")
+
+// capiObjects returns new v1beta1 and v1beta2 objects of a Cluster API kind
+type capiObjects func() (conversion.Convertible, conversion.Hub)
+
+// capiKinds are the Cluster API kinds that can be converted
+var capiKinds = map[string]capiObjects{
+	"Cluster": func() (conversion.Convertible, conversion.Hub) {
+		return &capi.Cluster{}, &capiv1beta2.Cluster{}
+	},
+	"ClusterClass": func() (conversion.Convertible, conversion.Hub) {
+		return &capi.ClusterClass{}, &capiv1beta2.ClusterClass{}
+	},
+	"Machine": func() (conversion.Convertible, conversion.Hub) {
+		return &capi.Machine{}, &capiv1beta2.Machine{}
+	},
+	"MachineDeployment": func() (conversion.Convertible, conversion.Hub) {
+		return &capi.MachineDeployment{}, &capiv1beta2.MachineDeployment{}
+	},
+	"MachineSet": func() (conversion.Convertible, conversion.Hub) {
+		return &capi.MachineSet{}, &capiv1beta2.MachineSet{}
+	},
+}
+
+// conditionMapping maps a v1beta1 condition to the v1beta2 condition it is derived from
+type conditionMapping struct {
+	v1beta1 capi.ConditionType
+	v1beta2 string
+}
+
+var (
+	// clusterConditions are the conditions of the clusters the status of the v2 API is computed from, the Ready
+	// condition first as set by Cluster API
+	clusterConditions = []conditionMapping{
+		{capi.ReadyCondition, capiv1beta2.ClusterAvailableCondition},
+		{capi.ControlPlaneReadyCondition, capiv1beta2.ClusterControlPlaneAvailableCondition},
+		{capi.InfrastructureReadyCondition, capiv1beta2.ClusterInfrastructureReadyCondition},
+	}
+	// machineConditions are the conditions of the machines the node health of the v2 API is computed from
+	machineConditions = []conditionMapping{
+		{capi.ReadyCondition, capiv1beta2.MachineReadyCondition},
+		{capi.BootstrapReadyCondition, capiv1beta2.MachineBootstrapConfigReadyCondition},
+		{capi.InfrastructureReadyCondition, capiv1beta2.MachineInfrastructureReadyCondition},
+		{capi.MachineNodeHealthyCondition, capiv1beta2.MachineNodeHealthyCondition},
+		{capi.MachineHealthCheckSucceededCondition, capiv1beta2.MachineHealthCheckSucceededCondition},
+	}
+)
+
+// IsCAPIConvertible returns whether objects of the given kind can be converted between the Cluster API versions
+func IsCAPIConvertible(kind string) bool {
+	_, ok := capiKinds[kind]
+	return ok
+}
+
+// ToV1Beta1 converts a Cluster API v1beta2 object to v1beta1. The v1beta1 conditions of objects whose deprecated
+// v1beta1 conditions were dropped by Cluster API are derived from their v1beta2 conditions, so the status of the
+// objects in the v2 API does not depend on the Cluster API version.
+func ToV1Beta1(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	newObjects, ok := capiKinds[obj.GetKind()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotConvertible, obj.GetKind())
+	}
+	spoke, hub := newObjects()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, hub); err != nil {
+		return nil, fmt.Errorf("failed to decode %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		return nil, fmt.Errorf("failed to convert %s %s to %s: %w", obj.GetKind(), obj.GetName(), capi.GroupVersion, err)
+	}
+	NormalizeConditions(spoke)
+	return toUnstructuredKind(spoke, capi.GroupVersion.String(), obj.GetKind())
+}
+
+// ToV1Beta2 converts a Cluster API v1beta1 object to v1beta2
+func ToV1Beta2(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	newObjects, ok := capiKinds[obj.GetKind()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotConvertible, obj.GetKind())
+	}
+	spoke, hub := newObjects()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, spoke); err != nil {
+		return nil, fmt.Errorf("failed to decode %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if err := spoke.ConvertTo(hub); err != nil {
+		return nil, fmt.Errorf("failed to convert %s %s to %s: %w", obj.GetKind(), obj.GetName(), capiv1beta2.GroupVersion, err)
+	}
+	return toUnstructuredKind(hub, capiv1beta2.GroupVersion.String(), obj.GetKind())
+}
+
+// toUnstructuredKind converts the typed object to an unstructured object of the given apiVersion and kind, which the
+// conversions do not set
+func toUnstructuredKind(obj runtime.Object, apiVersion, kind string) (*unstructured.Unstructured, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: m}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	return u, nil
+}
+
+// NormalizeConditions derives the v1beta1 conditions of clusters and machines without v1beta1 conditions from their
+// v1beta2 conditions; objects of other kinds, or with v1beta1 conditions, are left as they are
+func NormalizeConditions(obj runtime.Object) {
+	switch o := obj.(type) {
+	case *capi.Cluster:
+		if len(o.Status.Conditions) == 0 && o.Status.V1Beta2 != nil {
+			o.Status.Conditions = fromV1Beta2Conditions(o.Status.V1Beta2.Conditions, clusterConditions)
+		}
+	case *capi.Machine:
+		if len(o.Status.Conditions) == 0 && o.Status.V1Beta2 != nil {
+			o.Status.Conditions = fromV1Beta2Conditions(o.Status.V1Beta2.Conditions, machineConditions)
+		}
+	}
+}
+
+// fromV1Beta2Conditions returns the v1beta1 conditions of the mappings derived from the given v1beta2 conditions;
+// false conditions are informational, Cluster API reports the severity in the reasons of v1beta2 conditions
+func fromV1Beta2Conditions(conditions []metav1.Condition, mappings []conditionMapping) capi.Conditions {
+	var converted capi.Conditions
+	for _, mapping := range mappings {
+		for _, condition := range conditions {
+			if condition.Type != mapping.v1beta2 {
+				continue
+			}
+			c := capi.Condition{
+				Type:               mapping.v1beta1,
+				Status:             corev1.ConditionStatus(condition.Status),
+				LastTransitionTime: condition.LastTransitionTime,
+				Reason:             condition.Reason,
+				Message:            condition.Message,
+			}
+			if c.Status == corev1.ConditionFalse {
+				c.Severity = capi.ConditionSeverityInfo
+			}
+			converted = append(converted, c)
+			break
+		}
+	}
+	return converted
+}
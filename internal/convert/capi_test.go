@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	capiv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestToV1Beta1(t *testing.T) {
+	// the times are decoded in the local time zone
+	transition := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local))
+	cluster := capiv1beta2.Cluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: capiv1beta2.GroupVersion.String(), Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{Name: "example-cluster", Namespace: "655a6892-4280-4c37-97b1-31161ac0b99e"},
+		Spec: capiv1beta2.ClusterSpec{
+			Topology: capiv1beta2.Topology{ClassRef: capiv1beta2.ClusterClassRef{Name: "baseline-k3s"}, Version: "v1.33.5+k3s1"},
+		},
+		Status: capiv1beta2.ClusterStatus{
+			Phase: string(capiv1beta2.ClusterPhaseProvisioned),
+			Conditions: []metav1.Condition{
+				{Type: capiv1beta2.ClusterInfrastructureReadyCondition, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: transition},
+				{Type: capiv1beta2.ClusterAvailableCondition, Status: metav1.ConditionFalse, Reason: "NotAvailable", Message: "control plane not available", LastTransitionTime: transition},
+				{Type: capiv1beta2.ClusterControlPlaneAvailableCondition, Status: metav1.ConditionFalse, Reason: "NotAvailable", LastTransitionTime: transition},
+			},
+		},
+	}
+	obj, err := ToUnstructured(cluster)
+	require.NoError(t, err)
+
+	converted, err := ToV1Beta1(obj)
+	require.NoError(t, err)
+	require.Equal(t, capi.GroupVersion.String(), converted.GetAPIVersion())
+	require.Equal(t, "Cluster", converted.GetKind())
+
+	var v1beta1 capi.Cluster
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(converted.Object, &v1beta1))
+	require.Equal(t, "baseline-k3s", v1beta1.Spec.Topology.Class)
+	require.Equal(t, "v1.33.5+k3s1", v1beta1.Spec.Topology.Version)
+	require.Equal(t, string(capi.ClusterPhaseProvisioned), v1beta1.Status.Phase)
+	// the v1beta1 conditions are derived from the v1beta2 conditions, the Ready condition first
+	require.Equal(t, capi.Conditions{
+		{Type: capi.ReadyCondition, Status: corev1.ConditionFalse, Severity: capi.ConditionSeverityInfo, Reason: "NotAvailable", Message: "control plane not available", LastTransitionTime: transition},
+		{Type: capi.ControlPlaneReadyCondition, Status: corev1.ConditionFalse, Severity: capi.ConditionSeverityInfo, Reason: "NotAvailable", LastTransitionTime: transition},
+		{Type: capi.InfrastructureReadyCondition, Status: corev1.ConditionTrue, Reason: "Ready", LastTransitionTime: transition},
+	}, v1beta1.Status.Conditions)
+
+	t.Run("deprecated v1beta1 conditions are kept", func(t *testing.T) {
+		cluster := cluster.DeepCopy()
+		cluster.Status.Deprecated = &capiv1beta2.ClusterDeprecatedStatus{V1Beta1: &capiv1beta2.ClusterV1Beta1DeprecatedStatus{
+			Conditions: capiv1beta2.Conditions{{Type: capiv1beta2.ReadyV1Beta1Condition, Status: corev1.ConditionTrue, LastTransitionTime: transition}},
+		}}
+		obj, err := ToUnstructured(*cluster)
+		require.NoError(t, err)
+
+		converted, err := ToV1Beta1(obj)
+		require.NoError(t, err)
+		conditions, _, err := unstructured.NestedSlice(converted.Object, "status", "conditions")
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		require.Equal(t, "True", conditions[0].(map[string]any)["status"])
+	})
+
+	t.Run("kind that cannot be converted", func(t *testing.T) {
+		obj := &unstructured.Unstructured{}
+		obj.SetKind("IntelMachine")
+		_, err := ToV1Beta1(obj)
+		require.ErrorIs(t, err, ErrNotConvertible)
+	})
+}
+
+func TestToV1Beta2(t *testing.T) {
+	cluster := capi.Cluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: capi.GroupVersion.String(), Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{Name: "example-cluster", Namespace: "655a6892-4280-4c37-97b1-31161ac0b99e"},
+		Spec: capi.ClusterSpec{
+			Paused:   true,
+			Topology: &capi.Topology{Class: "baseline-k3s", Version: "v1.33.5+k3s1"},
+		},
+	}
+	obj, err := ToUnstructured(cluster)
+	require.NoError(t, err)
+
+	converted, err := ToV1Beta2(obj)
+	require.NoError(t, err)
+	require.Equal(t, capiv1beta2.GroupVersion.String(), converted.GetAPIVersion())
+
+	var v1beta2 capiv1beta2.Cluster
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(converted.Object, &v1beta2))
+	require.Equal(t, "baseline-k3s", v1beta2.Spec.Topology.ClassRef.Name)
+	require.Equal(t, "v1.33.5+k3s1", v1beta2.Spec.Topology.Version)
+	require.NotNil(t, v1beta2.Spec.Paused)
+	require.True(t, *v1beta2.Spec.Paused)
+}
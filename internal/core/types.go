@@ -14,6 +14,28 @@ const (
 	ClusterOrchResourceVersion = "v1alpha1"
 
 	TemplateLabelKey = ClusterOrchResourceGroup + "/template"
+	// DependsOnAnnotationKey holds the comma separated names of the clusters a cluster depends on
+	DependsOnAnnotationKey = ClusterOrchResourceGroup + "/depends-on"
+	// ReservedHostsAnnotationKey holds the comma separated ids of the hosts reserved for a cluster in the inventory,
+	// they are released once the cluster is removed
+	ReservedHostsAnnotationKey = ClusterOrchResourceGroup + "/reserved-hosts"
+	// MaintenanceSinceAnnotationKey holds the RFC 3339 time a cluster was put in maintenance, it is set as long as the
+	// cluster is in maintenance; MaintenanceByAnnotationKey and MaintenanceReasonAnnotationKey hold who put it in
+	// maintenance and why
+	MaintenanceSinceAnnotationKey  = ClusterOrchResourceGroup + "/maintenance-since"
+	MaintenanceByAnnotationKey     = ClusterOrchResourceGroup + "/maintenance-by"
+	MaintenanceReasonAnnotationKey = ClusterOrchResourceGroup + "/maintenance-reason"
+	// MaintenanceWindowEndAnnotationKey holds the RFC 3339 time the maintenance window of a host ends, e.g. while its
+	// OS is updated; it is set on the provider machine of the host as long as its node is cordoned for the maintenance
+	MaintenanceWindowEndAnnotationKey = ClusterOrchResourceGroup + "/maintenance-window-end"
+	// IdempotencyKeyAnnotationKey holds the Idempotency-Key of the request that created a cluster or template and
+	// IdempotencyDigestAnnotationKey the digest of its body, so that retries of the request are recognized
+	IdempotencyKeyAnnotationKey    = ClusterOrchResourceGroup + "/idempotency-key"
+	IdempotencyDigestAnnotationKey = ClusterOrchResourceGroup + "/idempotency-digest"
+	// SignatureAnnotationKey holds the base64 encoded cosign signature of a template and SignedPayloadAnnotationKey
+	// the payload it signs, the template as imported through the REST API; see internal/signature
+	SignatureAnnotationKey     = ClusterOrchResourceGroup + "/signature"
+	SignedPayloadAnnotationKey = ClusterOrchResourceGroup + "/signed-payload"
 
 	ActiveProjectIdHeaderKey             = "Activeprojectid"
 	ActiveProjectIdContextKey ContextKey = ActiveProjectIdHeaderKey
@@ -44,11 +66,21 @@ var (
 		Version:  "v1beta1",
 		Resource: "clusters",
 	}
+	ClusterClassResourceSchema = schema.GroupVersionResource{
+		Group:    "cluster.x-k8s.io",
+		Version:  "v1beta1",
+		Resource: "clusterclasses",
+	}
 	TemplateResourceSchema = schema.GroupVersionResource{
 		Group:    TemplateResourceGroup,
 		Version:  TemplateResourceVersion,
 		Resource: TemplateResourceKind,
 	}
+	BackupResourceSchema = schema.GroupVersionResource{
+		Group:    TemplateResourceGroup,
+		Version:  TemplateResourceVersion,
+		Resource: "backups",
+	}
 	MachineResourceSchema = schema.GroupVersionResource{
 		Group:    "cluster.x-k8s.io",
 		Version:  "v1beta1",
@@ -59,4 +91,19 @@ var (
 		Version:  "v1",
 		Resource: "secrets",
 	}
+	ConfigMapResourceSchema = schema.GroupVersionResource{
+		Group:    "",
+		Version:  "v1",
+		Resource: "configmaps",
+	}
+	LeaseResourceSchema = schema.GroupVersionResource{
+		Group:    "coordination.k8s.io",
+		Version:  "v1",
+		Resource: "leases",
+	}
+	EventResourceSchema = schema.GroupVersionResource{
+		Group:    "",
+		Version:  "v1",
+		Resource: "events",
+	}
 )
@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deletion tracks the deletion of the clusters. Cluster API deletes a cluster in the background once its
+// deletion is requested: the machines are deleted first, then the control plane and the infrastructure cluster, and the
+// cluster is removed once their finalizers and its own are removed. A provider that cannot reach the hosts of a cluster
+// may never remove its finalizers, so the finalizers of the clusters whose force deletion was requested are stripped
+// once the clusters were deleting for the force delete timeout. The deletion of a cluster may also be deferred for a
+// grace period, during which it can be canceled; the clusters pending deletion are deleted once their grace period
+// passed.
+package deletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+const (
+	// DefaultInterval is the default time between two sweeps of the deleting clusters of all projects
+	DefaultInterval = time.Minute
+	// ForceFinalizeAtAnnotationKey annotates a cluster whose force deletion was requested with the time the finalizers
+	// of the cluster, its machines, control plane and infrastructure cluster are stripped if it is still deleting
+	ForceFinalizeAtAnnotationKey = core.ClusterOrchResourceGroup + "/force-finalize-at"
+	// DeleteAtAnnotationKey annotates a cluster pending deletion with the time its grace period ends and it is deleted
+	DeleteAtAnnotationKey = core.ClusterOrchResourceGroup + "/delete-at"
+
+	// finalizeTimeout bounds the stripping of the finalizers of a cluster
+	finalizeTimeout = 30 * time.Second
+)
+
+// State is the state of the teardown of an object of a deleting cluster
+type State string
+
+const (
+	// Pending objects are not deleted yet, Cluster API deletes them after the machines of the cluster
+	Pending State = "pending"
+	// Deleting objects wait for their finalizers to be removed
+	Deleting State = "deleting"
+	// Deleted objects are removed
+	Deleted State = "deleted"
+)
+
+// Progress is the progress of the deletion of a cluster
+type Progress struct {
+	// RequestedAt is the time the deletion of the cluster was requested
+	RequestedAt time.Time
+	// MachinesRemaining is the number of machines of the cluster that are not removed yet
+	MachinesRemaining int
+	// ControlPlane is the state of the teardown of the control plane, empty if the cluster has none
+	ControlPlane State
+	// Infrastructure is the state of the teardown of the infrastructure cluster, empty if the cluster has none
+	Infrastructure State
+	// Finalizers are the finalizers of the cluster that are not removed yet
+	Finalizers []string
+	// ForceFinalizeAt is the time the finalizers are stripped, nil unless the force deletion was requested
+	ForceFinalizeAt *time.Time
+}
+
+// GetProgress returns the progress of the deletion of the given deleting cluster
+func GetProgress(ctx context.Context, cli *k8s.Client, cluster *capi.Cluster) (Progress, error) {
+	progress := Progress{Finalizers: cluster.Finalizers}
+	if cluster.DeletionTimestamp != nil {
+		progress.RequestedAt = cluster.DeletionTimestamp.UTC()
+	}
+	if at, ok := ForceFinalizeAt(cluster); ok {
+		progress.ForceFinalizeAt = &at
+	}
+
+	machines, err := cli.GetMachines(ctx, cluster.Namespace, cluster.Name)
+	if err != nil {
+		return Progress{}, fmt.Errorf("failed to get machines: %w", err)
+	}
+	progress.MachinesRemaining = len(machines)
+
+	if ref := cluster.Spec.ControlPlaneRef; ref != nil {
+		if progress.ControlPlane, err = teardownState(ctx, cli, cluster.Namespace, ref); err != nil {
+			return Progress{}, fmt.Errorf("failed to get control plane: %w", err)
+		}
+	}
+	if ref := cluster.Spec.InfrastructureRef; ref != nil {
+		if progress.Infrastructure, err = teardownState(ctx, cli, cluster.Namespace, ref); err != nil {
+			return Progress{}, fmt.Errorf("failed to get infrastructure cluster: %w", err)
+		}
+	}
+	return progress, nil
+}
+
+// ForceFinalizeAt returns the time the finalizers of the cluster are stripped, false unless its force deletion was
+// requested
+func ForceFinalizeAt(cluster *capi.Cluster) (time.Time, bool) {
+	value, ok := cluster.Annotations[ForceFinalizeAtAnnotationKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+// DeleteAt returns the time the grace period of the cluster ends, false unless it is pending deletion
+func DeleteAt(cluster *capi.Cluster) (time.Time, bool) {
+	value, ok := cluster.Annotations[DeleteAtAnnotationKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+// Deleter deletes a cluster pending deletion once its grace period passed, the same way as its deletion is requested
+// through the API
+type Deleter interface {
+	DeletePendingCluster(ctx context.Context, projectID, name string) error
+}
+
+// Tracker logs the removal of the finalizers of the deleting clusters, deletes the clusters pending deletion with its
+// Deleter once their grace period passed and strips the finalizers of the clusters whose force deletion was requested
+// once their force delete timeout passed
+type Tracker struct {
+	k8s      *k8s.Client
+	deleter  Deleter
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewTracker creates a new Tracker of the deleting clusters read and finalized with the given client
+func NewTracker(k8sClient *k8s.Client, options ...func(*Tracker)) *Tracker {
+	t := &Tracker{
+		k8s:      k8sClient,
+		interval: DefaultInterval,
+		now:      time.Now,
+	}
+
+	for _, o := range options {
+		o(t)
+	}
+
+	return t
+}
+
+// WithInterval is a functional option for configuring the time between two sweeps of the deleting clusters
+func WithInterval(interval time.Duration) func(*Tracker) {
+	return func(t *Tracker) {
+		t.interval = interval
+	}
+}
+
+// WithDeleter is a functional option for configuring a Tracker to delete the clusters pending deletion once their
+// grace period passed; the clusters pending deletion are kept without a Deleter
+func WithDeleter(deleter Deleter) func(*Tracker) {
+	return func(t *Tracker) {
+		t.deleter = deleter
+	}
+}
+
+// WithClock is a functional option for configuring a Tracker with the given clock
+func WithClock(now func() time.Time) func(*Tracker) {
+	return func(t *Tracker) {
+		t.now = now
+	}
+}
+
+// ClusterChanged logs the start of the deletion of a cluster, the removal of its finalizers and its removal, see
+// k8s.ClusterHandler
+func (t *Tracker) ClusterChanged(old, new *capi.Cluster) {
+	switch {
+	case new == nil:
+		if old != nil && old.DeletionTimestamp != nil {
+			slog.Info("cluster deleted", "namespace", old.Namespace, "name", old.Name, "duration", t.now().Sub(old.DeletionTimestamp.Time).Round(time.Second))
+		}
+	case new.DeletionTimestamp == nil:
+		// not deleting
+	case old == nil || old.DeletionTimestamp == nil:
+		slog.Info("cluster deletion started", "namespace", new.Namespace, "name", new.Name, "finalizers", new.Finalizers)
+	default:
+		for _, finalizer := range old.Finalizers {
+			if !slices.Contains(new.Finalizers, finalizer) {
+				slog.Info("finalizer of deleting cluster removed", "namespace", new.Namespace, "name", new.Name, "finalizer", finalizer, "remaining", new.Finalizers)
+			}
+		}
+	}
+}
+
+// Run sweeps the deleting clusters on start and then every interval until the context is canceled; failures are
+// logged and retried with the next sweep
+func (t *Tracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	if err := t.Sweep(ctx); err != nil {
+		slog.Error("failed to force finalize deleting clusters", "error", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.Sweep(ctx); err != nil {
+				slog.Error("failed to force finalize deleting clusters", "error", err)
+			}
+		}
+	}
+}
+
+// Sweep deletes the clusters pending deletion of all projects whose grace period passed and strips the finalizers of
+// the deleting clusters whose force delete timeout passed
+func (t *Tracker) Sweep(ctx context.Context) error {
+	clusters, err := k8s.ListClusters(ctx, t.k8s.Dyn, "", k8slabels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var errs []error
+	for _, item := range clusters {
+		var cluster capi.Cluster
+		if err := convert.FromUnstructured(item, &cluster); err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", item.GetNamespace(), item.GetName(), err))
+			continue
+		}
+		if cluster.DeletionTimestamp == nil {
+			if at, ok := DeleteAt(&cluster); ok && !t.now().Before(at) {
+				if err := t.deletePending(ctx, &cluster); err != nil {
+					errs = append(errs, fmt.Errorf("%s/%s: %w", cluster.Namespace, cluster.Name, err))
+				}
+			}
+			continue
+		}
+		at, ok := ForceFinalizeAt(&cluster)
+		if !ok || t.now().Before(at) {
+			continue
+		}
+
+		finalizeCtx, cancel := context.WithTimeout(ctx, finalizeTimeout)
+		err := t.ForceFinalize(finalizeCtx, &cluster)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", cluster.Namespace, cluster.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deletePending deletes the cluster whose grace period passed with the Deleter of the tracker
+func (t *Tracker) deletePending(ctx context.Context, cluster *capi.Cluster) error {
+	if t.deleter == nil {
+		return nil
+	}
+	if err := t.deleter.DeletePendingCluster(ctx, cluster.Namespace, cluster.Name); err != nil {
+		return fmt.Errorf("failed to delete cluster: %w", err)
+	}
+	slog.Info("cluster deleted after its grace period", "namespace", cluster.Namespace, "name", cluster.Name,
+		"deleteAt", cluster.Annotations[DeleteAtAnnotationKey])
+	return nil
+}
+
+// ForceFinalize deletes the machines, provider machines, control plane and infrastructure cluster of the cluster and
+// strips their finalizers and those of the cluster, so that the cluster is removed without waiting for the providers
+func (t *Tracker) ForceFinalize(ctx context.Context, cluster *capi.Cluster) error {
+	namespace := cluster.Namespace
+	machines, err := t.k8s.GetMachines(ctx, namespace, cluster.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get machines: %w", err)
+	}
+	for _, machine := range machines {
+		if ref := machine.Spec.InfrastructureRef; ref.Name != "" {
+			if err := t.forceDeleteReferenced(ctx, namespace, &ref); err != nil {
+				return err
+			}
+		}
+		if err := t.k8s.ForceDelete(ctx, core.MachineResourceSchema, namespace, machine.Name); err != nil {
+			return err
+		}
+	}
+	if ref := cluster.Spec.ControlPlaneRef; ref != nil {
+		if err := t.forceDeleteReferenced(ctx, namespace, ref); err != nil {
+			return err
+		}
+	}
+	if ref := cluster.Spec.InfrastructureRef; ref != nil {
+		if err := t.forceDeleteReferenced(ctx, namespace, ref); err != nil {
+			return err
+		}
+	}
+	if err := t.k8s.ForceDelete(ctx, core.ClusterResourceSchema, namespace, cluster.Name); err != nil {
+		return err
+	}
+
+	metrics.ForceFinalizedClusterCounter.Inc()
+	slog.Warn("finalizers of deleting cluster stripped after the force delete timeout", "namespace", namespace, "name", cluster.Name,
+		"requestedAt", cluster.DeletionTimestamp, "finalizers", cluster.Finalizers, "machines", len(machines))
+	return nil
+}
+
+// forceDeleteReferenced force deletes the object the cluster or one of its machines references
+func (t *Tracker) forceDeleteReferenced(ctx context.Context, namespace string, ref *corev1.ObjectReference) error {
+	gvr, err := k8s.ReferenceSchema(ref)
+	if err != nil {
+		return err
+	}
+	return t.k8s.ForceDelete(ctx, gvr, namespace, ref.Name)
+}
+
+// teardownState returns the state of the teardown of the referenced object of a deleting cluster
+func teardownState(ctx context.Context, cli *k8s.Client, namespace string, ref *corev1.ObjectReference) (State, error) {
+	obj, err := cli.ReferencedObject(ctx, namespace, ref)
+	if k8serrors.IsNotFound(err) {
+		return Deleted, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		return Deleting, nil
+	}
+	return Pending, nil
+}
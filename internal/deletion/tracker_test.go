@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package deletion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+var (
+	controlPlaneSchema = schema.GroupVersionResource{Group: "controlplane.cluster.x-k8s.io", Version: "v1beta2", Resource: "kthreescontrolplanes"}
+	intelClusterSchema = schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1alpha1", Resource: "intelclusters"}
+)
+
+func create(t *testing.T, client *k8s.Client, resource schema.GroupVersionResource, obj *unstructured.Unstructured) {
+	obj.SetNamespace(projectID)
+	_, err := client.Dyn.Resource(resource).Namespace(projectID).Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func exists(t *testing.T, client *k8s.Client, resource schema.GroupVersionResource, name string) bool {
+	_, err := client.Dyn.Resource(resource).Namespace(projectID).Get(context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return false
+	}
+	require.NoError(t, err)
+	return true
+}
+
+func object(apiVersion, kind, name string, deleting bool) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetFinalizers([]string{"finalizer.test"})
+	if deleting {
+		obj.SetDeletionTimestamp(&metav1.Time{Time: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)})
+	}
+	return obj
+}
+
+// createDeletingCluster creates a deleting cluster with a machine, a deleting control plane and an infrastructure
+// cluster that is not deleted yet
+func createDeletingCluster(t *testing.T, client *k8s.Client, name string, annotations map[string]string) *capi.Cluster {
+	cluster := object(capi.GroupVersion.String(), "Cluster", name, true)
+	cluster.SetAnnotations(annotations)
+	cluster.Object["spec"] = map[string]any{
+		"controlPlaneRef":   map[string]any{"apiVersion": "controlplane.cluster.x-k8s.io/v1beta2", "kind": "KThreesControlPlane", "name": name},
+		"infrastructureRef": map[string]any{"apiVersion": "infrastructure.cluster.x-k8s.io/v1alpha1", "kind": "IntelCluster", "name": name},
+	}
+	create(t, client, core.ClusterResourceSchema, cluster)
+
+	machine := object(capi.GroupVersion.String(), "Machine", name+"-machine", true)
+	machine.SetLabels(map[string]string{capi.ClusterNameLabel: name})
+	machine.Object["spec"] = map[string]any{
+		"clusterName":       name,
+		"infrastructureRef": map[string]any{"apiVersion": "infrastructure.cluster.x-k8s.io/v1alpha1", "kind": "IntelMachine", "name": name + "-machine"},
+	}
+	create(t, client, core.MachineResourceSchema, machine)
+	create(t, client, core.IntelMachineResourceSchema, object("infrastructure.cluster.x-k8s.io/v1alpha1", "IntelMachine", name+"-machine", true))
+	create(t, client, controlPlaneSchema, object("controlplane.cluster.x-k8s.io/v1beta2", "KThreesControlPlane", name, true))
+	create(t, client, intelClusterSchema, object("infrastructure.cluster.x-k8s.io/v1alpha1", "IntelCluster", name, false))
+
+	var c capi.Cluster
+	require.NoError(t, convert.FromUnstructured(*cluster, &c))
+	return &c
+}
+
+func TestGetProgress(t *testing.T) {
+	forceFinalizeAt := time.Date(2026, 6, 1, 12, 30, 0, 0, time.UTC)
+	client := k8s.New().WithFakeClient()
+	cluster := createDeletingCluster(t, client, "edge-1", map[string]string{ForceFinalizeAtAnnotationKey: forceFinalizeAt.Format(time.RFC3339)})
+
+	progress, err := GetProgress(context.Background(), client, cluster)
+	require.NoError(t, err)
+	require.Equal(t, Progress{
+		RequestedAt:       time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC),
+		MachinesRemaining: 1,
+		ControlPlane:      Deleting,
+		Infrastructure:    Pending,
+		Finalizers:        []string{"finalizer.test"},
+		ForceFinalizeAt:   &forceFinalizeAt,
+	}, progress)
+
+	require.NoError(t, client.Dyn.Resource(intelClusterSchema).Namespace(projectID).Delete(context.Background(), "edge-1", metav1.DeleteOptions{}))
+	progress, err = GetProgress(context.Background(), client, cluster)
+	require.NoError(t, err)
+	require.Equal(t, Deleted, progress.Infrastructure)
+}
+
+func TestTracker(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	forceFinalizeAt := now.Add(30 * time.Minute).Format(time.RFC3339)
+
+	t.Run("finalizers are stripped once the force delete timeout passed", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		createDeletingCluster(t, client, "edge-1", map[string]string{ForceFinalizeAtAnnotationKey: forceFinalizeAt})
+
+		tracker := NewTracker(client, WithClock(clock))
+		require.NoError(t, tracker.Sweep(context.Background()))
+		require.True(t, exists(t, client, core.ClusterResourceSchema, "edge-1"))
+
+		now = now.Add(30 * time.Minute)
+		require.NoError(t, tracker.Sweep(context.Background()))
+		require.False(t, exists(t, client, core.ClusterResourceSchema, "edge-1"))
+		require.False(t, exists(t, client, core.MachineResourceSchema, "edge-1-machine"))
+		require.False(t, exists(t, client, core.IntelMachineResourceSchema, "edge-1-machine"))
+		require.False(t, exists(t, client, controlPlaneSchema, "edge-1"))
+		require.False(t, exists(t, client, intelClusterSchema, "edge-1"))
+	})
+
+	t.Run("finalizers are kept unless the force deletion was requested", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		createDeletingCluster(t, client, "edge-1", nil)
+
+		tracker := NewTracker(client, WithClock(func() time.Time { return now.Add(24 * time.Hour) }))
+		require.NoError(t, tracker.Sweep(context.Background()))
+		require.True(t, exists(t, client, core.ClusterResourceSchema, "edge-1"))
+		require.True(t, exists(t, client, core.MachineResourceSchema, "edge-1-machine"))
+	})
+
+	t.Run("clusters that are not deleting are kept", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		cluster := object(capi.GroupVersion.String(), "Cluster", "edge-1", false)
+		cluster.SetAnnotations(map[string]string{ForceFinalizeAtAnnotationKey: forceFinalizeAt})
+		create(t, client, core.ClusterResourceSchema, cluster)
+
+		tracker := NewTracker(client, WithClock(func() time.Time { return now.Add(24 * time.Hour) }))
+		require.NoError(t, tracker.Sweep(context.Background()))
+		require.True(t, exists(t, client, core.ClusterResourceSchema, "edge-1"))
+	})
+	t.Run("clusters pending deletion are deleted once the grace period passed", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		deleteAt := time.Date(2026, 6, 1, 13, 0, 0, 0, time.UTC)
+		cluster := object(capi.GroupVersion.String(), "Cluster", "edge-1", false)
+		cluster.SetAnnotations(map[string]string{DeleteAtAnnotationKey: deleteAt.Format(time.RFC3339)})
+		create(t, client, core.ClusterResourceSchema, cluster)
+
+		deleter := &fakeDeleter{}
+		tracker := NewTracker(client, WithDeleter(deleter), WithClock(func() time.Time { return deleteAt.Add(-time.Second) }))
+		require.NoError(t, tracker.Sweep(context.Background()))
+		require.Empty(t, deleter.deleted)
+
+		tracker = NewTracker(client, WithDeleter(deleter), WithClock(func() time.Time { return deleteAt }))
+		require.NoError(t, tracker.Sweep(context.Background()))
+		require.Equal(t, []string{projectID + "/edge-1"}, deleter.deleted)
+	})
+
+	t.Run("failed deletions of clusters pending deletion are retried", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		cluster := object(capi.GroupVersion.String(), "Cluster", "edge-1", false)
+		cluster.SetAnnotations(map[string]string{DeleteAtAnnotationKey: now.Format(time.RFC3339)})
+		create(t, client, core.ClusterResourceSchema, cluster)
+
+		deleter := &fakeDeleter{err: errors.New("cluster edge-1 has dependents")}
+		tracker := NewTracker(client, WithDeleter(deleter), WithClock(clock))
+		require.ErrorContains(t, tracker.Sweep(context.Background()), "has dependents")
+		require.ErrorContains(t, tracker.Sweep(context.Background()), "has dependents")
+		require.Len(t, deleter.deleted, 2)
+	})
+}
+
+type fakeDeleter struct {
+	err     error
+	deleted []string
+}
+
+func (f *fakeDeleter) DeletePendingCluster(_ context.Context, projectID, name string) error {
+	f.deleted = append(f.deleted, projectID+"/"+name)
+	return f.err
+}
@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package drain drains the nodes of workload clusters through the connect gateway before they are removed: the nodes
+// are cordoned and their pods evicted, so that the workloads move to the remaining nodes within their pod disruption
+// budgets instead of being killed with the node. Nodes can also be cordoned only, e.g. while their cluster is in
+// maintenance.
+package drain
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const (
+	// DefaultTimeout is the default time the nodes of a drain have to evict their pods
+	DefaultTimeout = 2 * time.Minute
+	// defaultPollInterval is the time between two attempts to evict the pods that are left on the nodes
+	defaultPollInterval = 2 * time.Second
+	// mirrorPodAnnotation marks the static pods of the kubelet, which cannot be evicted through the API server
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+var (
+	nodeResourceSchema = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
+	podResourceSchema  = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+
+	// ErrTimeout is returned if pods are left on the nodes when the drain timeout expires, e.g. because their pod
+	// disruption budgets do not allow evicting them
+	ErrTimeout = errors.New("timed out draining nodes")
+)
+
+// Drainer drains the nodes of workload clusters
+type Drainer struct {
+	k8s          *k8s.Client
+	timeout      time.Duration
+	pollInterval time.Duration
+	connect      func(kubeconfig []byte) (dynamic.Interface, error)
+}
+
+// NewDrainer creates a new Drainer reading the kubeconfigs of the clusters with the given client
+func NewDrainer(k8sClient *k8s.Client, options ...func(*Drainer)) *Drainer {
+	d := &Drainer{
+		k8s:          k8sClient,
+		timeout:      DefaultTimeout,
+		pollInterval: defaultPollInterval,
+		connect:      connect,
+	}
+
+	for _, o := range options {
+		o(d)
+	}
+
+	return d
+}
+
+// WithTimeout is a functional option for configuring the time the nodes of a drain have to evict their pods
+func WithTimeout(timeout time.Duration) func(*Drainer) {
+	return func(d *Drainer) {
+		d.timeout = timeout
+	}
+}
+
+// WithPollInterval is a functional option for configuring the time between two attempts to evict the pods that are
+// left on the nodes
+func WithPollInterval(interval time.Duration) func(*Drainer) {
+	return func(d *Drainer) {
+		d.pollInterval = interval
+	}
+}
+
+// WithConnector is a functional option for configuring how a Drainer connects to a workload cluster with its kubeconfig
+func WithConnector(connect func(kubeconfig []byte) (dynamic.Interface, error)) func(*Drainer) {
+	return func(d *Drainer) {
+		d.connect = connect
+	}
+}
+
+// Drain cordons the named nodes of the cluster in the project and evicts their pods, except for the pods of daemon sets
+// and the static pods, until no pods are left or the timeout expires. Evictions the pod disruption budgets do not allow
+// yet are retried. Clusters without a kubeconfig never came up and have nothing to drain, nodes that do not exist
+// anymore are skipped.
+func (d *Drainer) Drain(ctx context.Context, projectID, clusterName string, nodeNames ...string) error {
+	if len(nodeNames) == 0 {
+		return nil
+	}
+
+	workload, err := d.workload(ctx, projectID, clusterName)
+	if err != nil || workload == nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	nodes, err := cordon(ctx, workload, true, nodeNames)
+	if err != nil {
+		return err
+	}
+
+	for {
+		left, err := d.evict(ctx, workload, nodes)
+		if err != nil {
+			return err
+		}
+		if left == 0 {
+			slog.Info("nodes drained", "namespace", projectID, "name", clusterName, "nodes", nodes)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %d pods left on nodes %v", ErrTimeout, left, nodes)
+		case <-time.After(d.pollInterval):
+		}
+	}
+}
+
+// Cordon marks the named nodes of the cluster in the project unschedulable, or schedulable again if unschedulable is
+// false, without evicting their pods, e.g. while the cluster is in maintenance. Clusters without a kubeconfig never came
+// up and have no nodes, nodes that do not exist anymore are skipped.
+func (d *Drainer) Cordon(ctx context.Context, projectID, clusterName string, unschedulable bool, nodeNames ...string) error {
+	if len(nodeNames) == 0 {
+		return nil
+	}
+
+	workload, err := d.workload(ctx, projectID, clusterName)
+	if err != nil || workload == nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	nodes, err := cordon(ctx, workload, unschedulable, nodeNames)
+	if err != nil {
+		return err
+	}
+	slog.Info("nodes cordoned", "namespace", projectID, "name", clusterName, "nodes", nodes, "unschedulable", unschedulable)
+	return nil
+}
+
+// workload connects to the workload cluster with its kubeconfig, it returns nil if the cluster has no kubeconfig
+func (d *Drainer) workload(ctx context.Context, projectID, clusterName string) (dynamic.Interface, error) {
+	secret, err := d.k8s.Dyn.Resource(core.SecretResourceSchema).Namespace(projectID).Get(ctx, clusterName+"-kubeconfig", metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		slog.Info("cluster has no kubeconfig, skipping its nodes", "namespace", projectID, "name", clusterName)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the kubeconfig of the cluster: %w", err)
+	}
+	value, _, _ := unstructured.NestedString(secret.Object, "data", "value")
+	kubeconfig, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(kubeconfig) == 0 {
+		return nil, errors.New("the kubeconfig of the cluster is invalid")
+	}
+
+	workload, err := d.connect(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the cluster: %w", err)
+	}
+	return workload, nil
+}
+
+// cordon sets whether the nodes are unschedulable and returns the nodes that exist
+func cordon(ctx context.Context, workload dynamic.Interface, unschedulable bool, nodeNames []string) ([]string, error) {
+	patch := []byte(fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable))
+	var nodes []string
+	for _, name := range nodeNames {
+		_, err := workload.Resource(nodeResourceSchema).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to set node %s unschedulable=%t: %w", name, unschedulable, err)
+		}
+		nodes = append(nodes, name)
+	}
+	return nodes, nil
+}
+
+// evict requests the eviction of the pods left on the nodes and returns how many are left
+func (d *Drainer) evict(ctx context.Context, workload dynamic.Interface, nodes []string) (int, error) {
+	left := 0
+	for _, node := range nodes {
+		pods, err := workload.Resource(podResourceSchema).List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=" + node})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list the pods of node %s: %w", node, err)
+		}
+
+		for _, pod := range pods.Items {
+			if !evictable(pod) {
+				continue
+			}
+			left++
+			if pod.GetDeletionTimestamp() != nil {
+				continue
+			}
+
+			eviction := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "policy/v1",
+				"kind":       "Eviction",
+				"metadata":   map[string]any{"name": pod.GetName(), "namespace": pod.GetNamespace()},
+			}}
+			_, err := workload.Resource(podResourceSchema).Namespace(pod.GetNamespace()).Create(ctx, eviction, metav1.CreateOptions{}, "eviction")
+			switch {
+			case k8serrors.IsNotFound(err):
+				left--
+			case k8serrors.IsTooManyRequests(err):
+				// the pod disruption budget of the pod does not allow its eviction yet
+				slog.Debug("eviction of pod not allowed yet", "namespace", pod.GetNamespace(), "pod", pod.GetName(), "node", node, "error", err)
+			case err != nil:
+				return 0, fmt.Errorf("failed to evict pod %s/%s: %w", pod.GetNamespace(), pod.GetName(), err)
+			}
+		}
+	}
+	return left, nil
+}
+
+// evictable returns whether the pod has to leave the node, which is not the case for completed pods, static pods and
+// pods of daemon sets, which tolerate cordoned nodes
+func evictable(pod unstructured.Unstructured) bool {
+	phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
+	if phase == "Succeeded" || phase == "Failed" {
+		return false
+	}
+	if _, ok := pod.GetAnnotations()[mirrorPodAnnotation]; ok {
+		return false
+	}
+	for _, owner := range pod.GetOwnerReferences() {
+		if owner.Controller != nil && *owner.Controller && owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
+
+// connect creates a client for the workload cluster of the kubeconfig, which points to the cluster through the
+// connect gateway
+func connect(kubeconfig []byte) (dynamic.Interface, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(cfg)
+}
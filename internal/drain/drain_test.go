@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+func toUnstructured(t *testing.T, obj any) *unstructured.Unstructured {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: data}
+}
+
+// managementClient returns a client of the management cluster with, if withKubeconfig is set, the kubeconfig secret
+// of the cluster
+func managementClient(t *testing.T, withKubeconfig bool) *k8s.Client {
+	var objects []runtime.Object
+	if withKubeconfig {
+		secret := toUnstructured(t, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: projectID, Name: "edge-1-kubeconfig"},
+		})
+		secret.Object["data"] = map[string]any{"value": base64.StdEncoding.EncodeToString([]byte("kubeconfig"))}
+		objects = append(objects, secret)
+	}
+	return k8s.New(fake.NewSimpleDynamicClient(runtime.NewScheme(), objects...))
+}
+
+func node(t *testing.T, name string) runtime.Object {
+	return toUnstructured(t, &corev1.Node{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	})
+}
+
+func pod(t *testing.T, name string, modify ...func(*corev1.Pod)) runtime.Object {
+	p := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, m := range modify {
+		m(p)
+	}
+	return toUnstructured(t, p)
+}
+
+func daemonSetPod(p *corev1.Pod) {
+	controller := true
+	p.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "agent", Controller: &controller}}
+}
+
+func mirrorPod(p *corev1.Pod) {
+	p.Annotations = map[string]string{mirrorPodAnnotation: "static"}
+}
+
+// workloadCluster returns a workload cluster with the given objects that evicts the pods by deleting them, except for
+// the blocked pods whose pod disruption budgets do not allow their eviction
+func workloadCluster(objects []runtime.Object, blocked ...string) *fake.FakeDynamicClient {
+	workload := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		nodeResourceSchema: "NodeList",
+		podResourceSchema:  "PodList",
+	}, objects...)
+	workload.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		for _, name := range blocked {
+			if name == eviction.GetName() {
+				return true, nil, k8serrors.NewTooManyRequests("cannot evict pod as it would violate the pod's disruption budget", 0)
+			}
+		}
+		return true, nil, workload.Tracker().Delete(podResourceSchema, action.GetNamespace(), eviction.GetName())
+	})
+	return workload
+}
+
+func connector(workload dynamic.Interface) func([]byte) (dynamic.Interface, error) {
+	return func(kubeconfig []byte) (dynamic.Interface, error) {
+		if string(kubeconfig) != "kubeconfig" {
+			return nil, errors.New("unexpected kubeconfig")
+		}
+		return workload, nil
+	}
+}
+
+func TestDrain(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("node is cordoned and its pods evicted", func(t *testing.T) {
+		workload := workloadCluster([]runtime.Object{
+			node(t, "node-1"),
+			pod(t, "app"),
+			pod(t, "agent", daemonSetPod),
+			pod(t, "kube-apiserver", mirrorPod),
+			pod(t, "job", func(p *corev1.Pod) { p.Status.Phase = corev1.PodSucceeded }),
+		})
+		drainer := NewDrainer(managementClient(t, true), WithConnector(connector(workload)))
+
+		require.NoError(t, drainer.Drain(ctx, projectID, "edge-1", "node-1"))
+
+		cordoned, err := workload.Resource(nodeResourceSchema).Get(ctx, "node-1", metav1.GetOptions{})
+		require.NoError(t, err)
+		unschedulable, _, _ := unstructured.NestedBool(cordoned.Object, "spec", "unschedulable")
+		assert.True(t, unschedulable)
+
+		pods, err := workload.Resource(podResourceSchema).List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		var names []string
+		for _, p := range pods.Items {
+			names = append(names, p.GetName())
+		}
+		assert.ElementsMatch(t, []string{"agent", "kube-apiserver", "job"}, names)
+	})
+
+	t.Run("pods whose disruption budget blocks the eviction time out the drain", func(t *testing.T) {
+		workload := workloadCluster([]runtime.Object{node(t, "node-1"), pod(t, "app"), pod(t, "database")}, "database")
+		drainer := NewDrainer(managementClient(t, true), WithConnector(connector(workload)),
+			WithTimeout(50*time.Millisecond), WithPollInterval(10*time.Millisecond))
+
+		err := drainer.Drain(ctx, projectID, "edge-1", "node-1")
+		require.ErrorIs(t, err, ErrTimeout)
+
+		_, err = workload.Resource(podResourceSchema).Namespace("default").Get(ctx, "app", metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("missing nodes are skipped", func(t *testing.T) {
+		drainer := NewDrainer(managementClient(t, true), WithConnector(connector(workloadCluster(nil))))
+		require.NoError(t, drainer.Drain(ctx, projectID, "edge-1", "node-1"))
+	})
+
+	t.Run("clusters without kubeconfig have nothing to drain", func(t *testing.T) {
+		drainer := NewDrainer(managementClient(t, false), WithConnector(func([]byte) (dynamic.Interface, error) {
+			return nil, errors.New("unexpected connection")
+		}))
+		require.NoError(t, drainer.Drain(ctx, projectID, "edge-1", "node-1"))
+	})
+
+	t.Run("unreachable clusters fail the drain", func(t *testing.T) {
+		drainer := NewDrainer(managementClient(t, true), WithConnector(func([]byte) (dynamic.Interface, error) {
+			return nil, errors.New("connection refused")
+		}))
+		require.ErrorContains(t, drainer.Drain(ctx, projectID, "edge-1", "node-1"), "connection refused")
+	})
+}
+
+func TestCordon(t *testing.T) {
+	ctx := context.Background()
+	unschedulable := func(t *testing.T, workload dynamic.Interface) bool {
+		n, err := workload.Resource(nodeResourceSchema).Get(ctx, "node-1", metav1.GetOptions{})
+		require.NoError(t, err)
+		value, _, _ := unstructured.NestedBool(n.Object, "spec", "unschedulable")
+		return value
+	}
+
+	t.Run("nodes are cordoned and uncordoned without evicting their pods", func(t *testing.T) {
+		workload := workloadCluster([]runtime.Object{node(t, "node-1"), pod(t, "app")})
+		drainer := NewDrainer(managementClient(t, true), WithConnector(connector(workload)))
+
+		require.NoError(t, drainer.Cordon(ctx, projectID, "edge-1", true, "node-1", "node-2"))
+		assert.True(t, unschedulable(t, workload))
+		_, err := workload.Resource(podResourceSchema).Namespace("default").Get(ctx, "app", metav1.GetOptions{})
+		assert.NoError(t, err)
+
+		require.NoError(t, drainer.Cordon(ctx, projectID, "edge-1", false, "node-1"))
+		assert.False(t, unschedulable(t, workload))
+	})
+
+	t.Run("clusters without kubeconfig have nothing to cordon", func(t *testing.T) {
+		drainer := NewDrainer(managementClient(t, false), WithConnector(func([]byte) (dynamic.Interface, error) {
+			return nil, errors.New("unexpected connection")
+		}))
+		require.NoError(t, drainer.Cordon(ctx, projectID, "edge-1", true, "node-1"))
+	})
+
+	t.Run("unreachable clusters fail the cordon", func(t *testing.T) {
+		drainer := NewDrainer(managementClient(t, true), WithConnector(func([]byte) (dynamic.Interface, error) {
+			return nil, errors.New("connection refused")
+		}))
+		require.ErrorContains(t, drainer.Cordon(ctx, projectID, "edge-1", true, "node-1"), "connection refused")
+	})
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package extensions provides the catalog of the cluster extensions, the add-ons the app deployment manager deploys
+// to the clusters by their default-extension label, see catalog.yaml for the catalog embedded in the binaries
+package extensions
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+)
+
+// LabelKey is the cluster label the app deployment manager selects the extension of a cluster by
+const LabelKey = "default-extension"
+
+// ErrUnavailableExtension is returned when an extension is not in the catalog or not available for a provider
+var ErrUnavailableExtension = errors.New("unavailable extension")
+
+//go:embed catalog.yaml
+var catalogYAML []byte
+
+// Extension is a set of add-ons deployed to the clusters whose default-extension label is its name
+type Extension struct {
+	// Name is the value of the default-extension label of the clusters the extension is deployed to
+	Name string `json:"name"`
+	// Description describes the add-ons of the extension
+	Description string `json:"description,omitempty"`
+	// Providers are the control plane provider types whose clusters the extension can be deployed to
+	Providers []string `json:"providers"`
+}
+
+// Catalog is the catalog of the cluster extensions, see Default and Load
+type Catalog struct {
+	Extensions []Extension `json:"extensions"`
+}
+
+// Default returns the catalog embedded in the binary
+var Default = sync.OnceValues(func() (*Catalog, error) {
+	return Parse(catalogYAML)
+})
+
+// Load reads the catalog from the given YAML or JSON file, e.g. a mounted ConfigMap overriding the default
+func Load(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read extension catalog: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates the catalog
+func Parse(data []byte) (*Catalog, error) {
+	var catalog Catalog
+	if err := yaml.UnmarshalStrict(data, &catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse extension catalog: %w", err)
+	}
+
+	names := map[string]bool{}
+	for i, extension := range catalog.Extensions {
+		if extension.Name == "" || len(extension.Providers) == 0 {
+			return nil, fmt.Errorf("invalid extension catalog entry %d: name and providers are required", i)
+		}
+		if !labels.Valid(map[string]string{LabelKey: extension.Name}) {
+			return nil, fmt.Errorf("invalid extension name %q: not a valid label value", extension.Name)
+		}
+		if names[extension.Name] {
+			return nil, fmt.Errorf("duplicate extension %q", extension.Name)
+		}
+		names[extension.Name] = true
+	}
+	return &catalog, nil
+}
+
+// Available returns the extensions available for the control plane provider, all extensions if the provider is empty
+func (c *Catalog) Available(provider string) []Extension {
+	available := []Extension{}
+	for _, extension := range c.Extensions {
+		if provider == "" || slices.Contains(extension.Providers, provider) {
+			available = append(available, extension)
+		}
+	}
+	return available
+}
+
+// Check returns ErrUnavailableExtension if the extension is not in the catalog or not available for the control plane
+// provider
+func (c *Catalog) Check(name, provider string) error {
+	i := slices.IndexFunc(c.Extensions, func(extension Extension) bool { return extension.Name == name })
+	if i < 0 {
+		return fmt.Errorf("%w: %s is not in the extension catalog", ErrUnavailableExtension, name)
+	}
+	if !slices.Contains(c.Extensions[i].Providers, provider) {
+		return fmt.Errorf("%w: %s is not available for the %s control plane provider, only for %s", ErrUnavailableExtension,
+			name, provider, strings.Join(c.Extensions[i].Providers, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault(t *testing.T) {
+	catalog, err := Default()
+	require.NoError(t, err)
+	require.NotEmpty(t, catalog.Extensions)
+
+	// the extension of the example templates is available
+	require.NoError(t, catalog.Check("baseline", "kubeadm"))
+	require.NoError(t, catalog.Check("baseline", "k3s"))
+}
+
+func TestCatalog(t *testing.T) {
+	catalog, err := Parse([]byte(`
+extensions:
+  - name: baseline
+    providers: [kubeadm, k3s]
+  - name: edge-ai
+    description: AI inference add-ons
+    providers: [k3s]
+`))
+	require.NoError(t, err)
+
+	require.Len(t, catalog.Available(""), 2)
+	require.Len(t, catalog.Available("k3s"), 2)
+	require.Equal(t, []Extension{{Name: "baseline", Providers: []string{"kubeadm", "k3s"}}}, catalog.Available("kubeadm"))
+	require.Empty(t, catalog.Available("rke2"))
+
+	require.NoError(t, catalog.Check("edge-ai", "k3s"))
+	err = catalog.Check("edge-ai", "kubeadm")
+	require.ErrorIs(t, err, ErrUnavailableExtension)
+	require.ErrorContains(t, err, "only for k3s")
+	require.ErrorContains(t, catalog.Check("demo", "k3s"), "not in the extension catalog")
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse([]byte("extensions:\n  - name: baseline\n"))
+	require.ErrorContains(t, err, "name and providers are required")
+
+	_, err = Parse([]byte("extensions:\n  - name: base line\n    providers: [k3s]\n"))
+	require.ErrorContains(t, err, "not a valid label value")
+
+	_, err = Parse([]byte("extensions:\n  - name: baseline\n    providers: [k3s]\n  - name: baseline\n    providers: [kubeadm]\n"))
+	require.ErrorContains(t, err, "duplicate extension")
+
+	_, err = Parse([]byte("addons: []\n"))
+	require.ErrorContains(t, err, "failed to parse extension catalog")
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "extensions.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`{"extensions":[{"name":"restricted","providers":["kubeadm"]}]}`), 0o600))
+
+	catalog, err := Load(path)
+	require.NoError(t, err)
+	require.NoError(t, catalog.Check("restricted", "kubeadm"))
+	require.ErrorIs(t, catalog.Check("baseline", "kubeadm"), ErrUnavailableExtension)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "failed to read extension catalog")
+}
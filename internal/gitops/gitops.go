@@ -0,0 +1,343 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitops commits the manifests of the clusters to a Git repository per project, so that a GitOps controller
+// like Flux or Argo CD applies them to the management cluster instead of or in addition to the cluster manager
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ModeExport commits the manifests of the clusters to the repositories instead of creating the clusters
+	ModeExport = "export"
+	// ModeBoth commits the manifests of the clusters to the repositories and creates the clusters
+	ModeBoth = "both"
+
+	defaultBranch   = "main"
+	defaultPath     = "clusters"
+	defaultUsername = "git"
+	// maxAttempts is the number of times a commit is made on the latest branch of the repository if its push is rejected
+	maxAttempts = 3
+)
+
+// Modes are the valid GitOps modes
+var Modes = []string{ModeExport, ModeBoth}
+
+// errPushRejected is returned when the branch of the repository moved on since it was fetched
+var errPushRejected = errors.New("push rejected")
+
+// Repository is the Git repository the manifests of the clusters of a project are committed to
+type Repository struct {
+	// URL is the https or ssh URL the repository is fetched from and pushed to
+	URL string `json:"url"`
+	// Branch is the branch the manifests are committed to, main if empty
+	Branch string `json:"branch,omitempty"`
+	// Path is the directory of the repository the manifests are written to in a directory per project, clusters if empty
+	Path string `json:"path,omitempty"`
+	// Username is the user authenticated with the token of TokenFile, git if empty
+	Username string `json:"username,omitempty"`
+	// TokenFile is the file with the access token of https URLs, e.g. a mounted secret; ssh URLs are authenticated with
+	// the key of the ssh configuration of the git client
+	TokenFile string `json:"tokenFile,omitempty"`
+}
+
+// Config is the GitOps repository configuration file, see LoadConfig
+type Config struct {
+	// Default is the repository of projects without a repository of their own
+	Default Repository `json:"default"`
+	// Projects override the repository of individual projects by project id; the branch and path of projects setting
+	// no URL of their own override the ones of the default repository
+	Projects map[string]Repository `json:"projects,omitempty"`
+}
+
+// LoadConfig reads the GitOps repository configuration from the given YAML or JSON file
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read gitops config: %w", err)
+	}
+
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to parse gitops config: %w", err)
+	}
+
+	if err := config.Default.validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid default gitops repository: %w", err)
+	}
+	for projectID := range config.Projects {
+		if err := config.Repository(projectID).validate(); err != nil {
+			return Config{}, fmt.Errorf("invalid gitops repository of project %s: %w", projectID, err)
+		}
+	}
+	return config, nil
+}
+
+// Repository returns the repository of the given project
+func (c Config) Repository(projectID string) Repository {
+	repository := c.Default
+	if override, ok := c.Projects[projectID]; ok {
+		if override.URL != "" {
+			// the credentials of the default repository are not sent to the repositories of the projects
+			repository = override
+		}
+		if override.Branch != "" {
+			repository.Branch = override.Branch
+		}
+		if override.Path != "" {
+			repository.Path = override.Path
+		}
+	}
+
+	if repository.Branch == "" {
+		repository.Branch = defaultBranch
+	}
+	if repository.Path == "" {
+		repository.Path = defaultPath
+	}
+	if repository.Username == "" {
+		repository.Username = defaultUsername
+	}
+	return repository
+}
+
+// validate checks that the manifests can be committed to the repository; the git command line arguments must not be
+// options
+func (r Repository) validate() error {
+	if r.URL == "" || strings.HasPrefix(r.URL, "-") {
+		return fmt.Errorf("invalid url '%s'", r.URL)
+	}
+	if u, err := url.Parse(r.URL); err == nil && u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			return fmt.Errorf("url '%s' must not contain credentials, use a token file instead", u.Redacted())
+		}
+	}
+	if r.Branch != "" && (strings.HasPrefix(r.Branch, "-") || strings.ContainsAny(r.Branch, " ~^:?*[\\")) {
+		return fmt.Errorf("invalid branch '%s'", r.Branch)
+	}
+	if r.Path != "" && !filepath.IsLocal(r.Path) {
+		return fmt.Errorf("path '%s' must be relative to the root of the repository", r.Path)
+	}
+	return nil
+}
+
+// Publisher commits the manifests of the clusters to the repositories of their projects; each repository is checked
+// out once in the work directory and its commits are serialized
+type Publisher struct {
+	config      Config
+	workDir     string
+	authorName  string
+	authorEmail string
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewPublisher creates a new Publisher of the repositories of the given configuration
+func NewPublisher(config Config, options ...func(*Publisher)) *Publisher {
+	p := &Publisher{
+		config:      config,
+		workDir:     filepath.Join(os.TempDir(), "gitops"),
+		authorName:  "Cluster Manager",
+		authorEmail: "cluster-manager@open-edge-platform.local",
+		locks:       map[string]*sync.Mutex{},
+	}
+	for _, o := range options {
+		o(p)
+	}
+	return p
+}
+
+// WithWorkDir is a functional option for configuring the directory the repositories are checked out in
+func WithWorkDir(dir string) func(*Publisher) {
+	return func(p *Publisher) {
+		p.workDir = dir
+	}
+}
+
+// WithAuthor is a functional option for configuring the author of the commits
+func WithAuthor(name, email string) func(*Publisher) {
+	return func(p *Publisher) {
+		p.authorName = name
+		p.authorEmail = email
+	}
+}
+
+// Publish commits the manifests of the given objects as the manifest file of the cluster of the given project, the
+// objects are written in the given order
+func (p *Publisher) Publish(ctx context.Context, projectID, clusterName string, objects ...any) error {
+	var manifest bytes.Buffer
+	for i, object := range objects {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal manifest of cluster %s: %w", clusterName, err)
+		}
+		if i > 0 {
+			manifest.WriteString("---\n")
+		}
+		manifest.Write(data)
+	}
+	return p.commit(ctx, projectID, clusterName, manifest.Bytes(), fmt.Sprintf("Publish cluster %s of project %s", clusterName, projectID))
+}
+
+// Remove commits the removal of the manifest file of the cluster of the given project, the GitOps controller prunes
+// its objects; clusters without manifest file are ignored
+func (p *Publisher) Remove(ctx context.Context, projectID, clusterName string) error {
+	return p.commit(ctx, projectID, clusterName, nil, fmt.Sprintf("Remove cluster %s of project %s", clusterName, projectID))
+}
+
+// commit writes the manifest file of the cluster, or removes it if the manifest is nil, and pushes the change to the
+// branch of the repository of the project; the change is made again on the latest branch if the push is rejected
+func (p *Publisher) commit(ctx context.Context, projectID, clusterName string, manifest []byte, message string) error {
+	repository := p.config.Repository(projectID)
+	dir := p.checkoutDir(repository)
+	file := filepath.Join(repository.Path, filepath.Base(projectID), filepath.Base(clusterName)+".yaml")
+
+	lock := p.lock(dir)
+	lock.Lock()
+	defer lock.Unlock()
+
+	var err error
+	for range maxAttempts {
+		err = p.tryCommit(ctx, repository, dir, file, manifest, message)
+		if !errors.Is(err, errPushRejected) {
+			break
+		}
+		slog.Info("push of cluster manifest rejected, retrying on the latest branch", "repository", repository.URL, "branch", repository.Branch, "file", file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to commit %s to %s: %w", file, repository.URL, err)
+	}
+	return nil
+}
+
+// tryCommit commits the change of the manifest file onto the latest branch of the repository and pushes it
+func (p *Publisher) tryCommit(ctx context.Context, repository Repository, dir, file string, manifest []byte, message string) error {
+	if err := p.checkout(ctx, repository, dir); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, file)
+	current, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && manifest == nil:
+		slog.Debug("cluster manifest not in repository, nothing to remove", "repository", repository.URL, "file", file)
+		return nil
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to read manifest: %w", err)
+	case err == nil && manifest != nil && bytes.Equal(current, manifest):
+		slog.Debug("cluster manifest unchanged", "repository", repository.URL, "file", file)
+		return nil
+	}
+
+	if manifest == nil {
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to remove manifest: %w", err)
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			return fmt.Errorf("failed to create manifest directory: %w", err)
+		}
+		if err := os.WriteFile(path, manifest, 0o640); err != nil {
+			return fmt.Errorf("failed to write manifest: %w", err)
+		}
+	}
+
+	if _, err := p.git(ctx, repository, dir, "add", "--all", "--", file); err != nil {
+		return err
+	}
+	if _, err := p.git(ctx, repository, dir, "commit", "--quiet", "--message", message); err != nil {
+		return err
+	}
+	output, err := p.git(ctx, repository, dir, "push", "--porcelain", "origin", "HEAD:refs/heads/"+repository.Branch)
+	if err != nil && strings.Contains(output, "[rejected]") {
+		return fmt.Errorf("%w: %w", errPushRejected, err)
+	}
+	return err
+}
+
+// checkout resets the checkout of the repository to the latest commit of its branch, the repository is initialized
+// on the first checkout; only the latest commit is fetched
+func (p *Publisher) checkout(ctx context.Context, repository Repository, dir string) error {
+	if _, err := os.Stat(filepath.Join(dir, ".git")); errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return fmt.Errorf("failed to create checkout directory: %w", err)
+		}
+		if _, err := p.git(ctx, repository, dir, "init", "--quiet"); err != nil {
+			return err
+		}
+		if _, err := p.git(ctx, repository, dir, "remote", "add", "origin", repository.URL); err != nil {
+			return err
+		}
+	}
+
+	if _, err := p.git(ctx, repository, dir, "fetch", "--quiet", "--depth=1", "origin", "refs/heads/"+repository.Branch); err != nil {
+		return err
+	}
+	if _, err := p.git(ctx, repository, dir, "checkout", "--quiet", "--force", "-B", repository.Branch, "FETCH_HEAD"); err != nil {
+		return err
+	}
+	_, err := p.git(ctx, repository, dir, "clean", "--quiet", "--force", "-d")
+	return err
+}
+
+// git runs the git command with the given arguments in the given directory and returns its output; the token of the
+// repository is passed in the environment, never on the command line
+func (p *Publisher) git(ctx context.Context, repository Repository, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_AUTHOR_NAME="+p.authorName, "GIT_AUTHOR_EMAIL="+p.authorEmail,
+		"GIT_COMMITTER_NAME="+p.authorName, "GIT_COMMITTER_EMAIL="+p.authorEmail,
+	)
+	if repository.TokenFile != "" {
+		// the token is read on every call, so that rotated tokens are picked up
+		token, err := os.ReadFile(repository.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token file: %w", err)
+		}
+		credentials := base64.StdEncoding.EncodeToString([]byte(repository.Username + ":" + strings.TrimSpace(string(token))))
+		cmd.Env = append(cmd.Env, "GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=http.extraHeader", "GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return stdout.String(), fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// checkoutDir returns the directory the branch of the repository is checked out in
+func (p *Publisher) checkoutDir(repository Repository) string {
+	sum := sha256.Sum256([]byte(repository.URL + "\x00" + repository.Branch))
+	return filepath.Join(p.workDir, hex.EncodeToString(sum[:8]))
+}
+
+// lock returns the lock of the given checkout directory
+func (p *Publisher) lock(dir string) *sync.Mutex {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.locks[dir]; !ok {
+		p.locks[dir] = &sync.Mutex{}
+	}
+	return p.locks[dir]
+}
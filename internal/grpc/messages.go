@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// The request and response messages of the services. Payloads are the schemas of the REST API; the messages only add
+// the path and query parameters of the REST operations.
+
+// Empty is the message of methods without parameters or response body
+type Empty struct{}
+
+// ListRequest has the paging, ordering and filtering parameters of the list methods
+type ListRequest struct {
+	PageSize  *int    `json:"pageSize,omitempty"`
+	Offset    *int    `json:"offset,omitempty"`
+	PageToken *string `json:"pageToken,omitempty"`
+	OrderBy   *string `json:"orderBy,omitempty"`
+	Filter    *string `json:"filter,omitempty"`
+}
+
+func (r *ListRequest) query() url.Values {
+	query := url.Values{}
+	if r.PageSize != nil {
+		query.Set("pageSize", strconv.Itoa(*r.PageSize))
+	}
+	if r.Offset != nil {
+		query.Set("offset", strconv.Itoa(*r.Offset))
+	}
+	if r.PageToken != nil {
+		query.Set("pageToken", *r.PageToken)
+	}
+	if r.OrderBy != nil {
+		query.Set("orderBy", *r.OrderBy)
+	}
+	if r.Filter != nil {
+		query.Set("filter", *r.Filter)
+	}
+	return query
+}
+
+// ListClustersResponse is a page of clusters, see GET /v2/clusters
+type ListClustersResponse struct {
+	Clusters      *[]api.ClusterInfo `json:"clusters,omitempty"`
+	NextPageToken *string            `json:"nextPageToken,omitempty"`
+	TotalElements int32              `json:"totalElements"`
+}
+
+// ClusterRequest identifies a cluster of the active project
+type ClusterRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateClusterResponse has the message of a created cluster, or the pending cluster if its provisioning is scheduled
+type CreateClusterResponse struct {
+	Message        string              `json:"message,omitempty"`
+	PendingCluster *api.PendingCluster `json:"pendingCluster,omitempty"`
+}
+
+func (r *CreateClusterResponse) decodeStatus(code int, body []byte) error {
+	var err error
+	if code == http.StatusAccepted {
+		r.PendingCluster = &api.PendingCluster{}
+		err = json.Unmarshal(body, r.PendingCluster)
+	} else {
+		err = json.Unmarshal(body, &r.Message)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to decode response: %v", err)
+	}
+	return nil
+}
+
+// UpdateClusterLabelsRequest replaces the user labels of a cluster
+type UpdateClusterLabelsRequest struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+}
+
+// ListTemplatesRequest lists the templates of the active project, optionally only the default template
+type ListTemplatesRequest struct {
+	ListRequest
+	Default *bool `json:"default,omitempty"`
+}
+
+// TemplateRequest identifies a template version of the active project
+type TemplateRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// TemplateNameRequest identifies all versions of a template of the active project
+type TemplateNameRequest struct {
+	Name string `json:"name"`
+}
+
+// SetDefaultTemplateRequest makes a version of a template the default template of the active project, the latest
+// version if Version is empty
+type SetDefaultTemplateRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// MessageResponse has the message of a REST operation that answers with a message only
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+func (r *MessageResponse) decodeStatus(_ int, body []byte) error {
+	if err := json.Unmarshal(body, &r.Message); err != nil {
+		return status.Errorf(codes.Internal, "failed to decode response: %v", err)
+	}
+	return nil
+}
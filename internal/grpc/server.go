@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package grpc serves the ClusterService and TemplateService gRPC services. Every method runs the equivalent v2 REST
+// operation in-process, so gRPC calls share the authentication, authorization, multitenancy and validation of the REST
+// server; the metadata of a call is passed as the headers of the REST request.
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	handler      http.Handler
+	creds        credentials.TransportCredentials
+	requireToken bool
+}
+
+// NewServer creates a new Server that runs the REST operations of its methods with the given REST handler
+func NewServer(handler http.Handler, options ...func(*Server)) *Server {
+	s := &Server{handler: handler}
+	for _, o := range options {
+		o(s)
+	}
+	return s
+}
+
+// WithTLS is a functional option for configuring a Server to serve TLS with the given credentials
+func WithTLS(creds credentials.TransportCredentials) func(*Server) {
+	return func(s *Server) {
+		s.creds = creds
+	}
+}
+
+// WithTokenRequired is a functional option for configuring a Server to reject calls without a bearer token
+func WithTokenRequired() func(*Server) {
+	return func(s *Server) {
+		s.requireToken = true
+	}
+}
+
+// Serve starts the server on the given address and serves until the context is canceled; it then stops accepting
+// connections and returns once the in-flight calls are drained
+func (s *Server) Serve(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := s.GRPCServer()
+	stop := context.AfterFunc(ctx, func() {
+		slog.Info("shutting down grpc server")
+		server.GracefulStop()
+	})
+	defer stop()
+
+	slog.Info("starting grpc server", "addr", addr, "tls", s.creds != nil)
+	return server.Serve(lis)
+}
+
+// GRPCServer returns a gRPC server with the services of the server registered
+func (s *Server) GRPCServer() *grpc.Server {
+	options := []grpc.ServerOption{grpc.ForceServerCodec(jsonCodec{})}
+	if s.creds != nil {
+		options = append(options, grpc.Creds(s.creds))
+	}
+	if s.requireToken {
+		options = append(options, grpc.UnaryInterceptor(tokenInterceptor))
+	}
+
+	server := grpc.NewServer(options...)
+	server.RegisterService(&clusterServiceDesc, s)
+	server.RegisterService(&templateServiceDesc, s)
+	return server
+}
+
+// tokenInterceptor rejects calls without a bearer token early; the token itself is validated by the REST server the
+// same way as for REST requests
+func tokenInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "missing authentication token")
+	}
+	return handler(ctx, req)
+}
+
+// operation is the REST operation a gRPC method runs
+type operation struct {
+	method string
+	path   string
+	query  url.Values
+	body   any
+}
+
+// statusDecoder is implemented by responses whose REST operation answers a different body per status code
+type statusDecoder interface {
+	decodeStatus(code int, body []byte) error
+}
+
+// call runs the REST operation with the metadata of the call as headers and decodes its response into resp
+func (s *Server) call(ctx context.Context, op operation, resp any) error {
+	var body io.Reader = http.NoBody
+	if op.body != nil {
+		data, err := json.Marshal(op.body)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to encode request: %v", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	target := url.URL{Path: op.path, RawQuery: op.query.Encode()}
+	req, err := http.NewRequestWithContext(ctx, op.method, target.String(), body)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create request: %v", err)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		if forwardedMetadata(key) {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+	}
+	if op.body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rw := &responseWriter{header: http.Header{}, code: http.StatusOK}
+	s.handler.ServeHTTP(rw, req)
+
+	if rw.code >= http.StatusBadRequest {
+		return statusError(op.method, rw.code, rw.body.Bytes())
+	}
+	if decoder, ok := resp.(statusDecoder); ok {
+		return decoder.decodeStatus(rw.code, rw.body.Bytes())
+	}
+	if rw.body.Len() == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(rw.body.Bytes(), resp); err != nil {
+		return status.Errorf(codes.Internal, "failed to decode response: %v", err)
+	}
+	return nil
+}
+
+// forwardedMetadata returns whether the metadata key is passed as a header of the REST request, which excludes the
+// pseudo-headers and the headers of the gRPC protocol itself
+func forwardedMetadata(key string) bool {
+	switch key {
+	case "content-type", "user-agent", "te":
+		return false
+	}
+	return !strings.HasPrefix(key, ":") && !strings.HasPrefix(key, "grpc-")
+}
+
+// statusError returns the gRPC status of a failed REST operation with the message of its problem details
+func statusError(method string, code int, body []byte) error {
+	message := http.StatusText(code)
+	var problem struct {
+		Message *string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &problem); err == nil && problem.Message != nil {
+		message = *problem.Message
+	} else if text := strings.TrimSpace(string(body)); text != "" {
+		message = text
+	}
+
+	switch code {
+	case http.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, message)
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, message)
+	case http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, message)
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, message)
+	case http.StatusConflict:
+		if method == http.MethodPost {
+			return status.Error(codes.AlreadyExists, message)
+		}
+		return status.Error(codes.FailedPrecondition, message)
+	case http.StatusTooManyRequests:
+		return status.Error(codes.ResourceExhausted, message)
+	case http.StatusNotImplemented:
+		return status.Error(codes.Unimplemented, message)
+	case http.StatusServiceUnavailable:
+		return status.Error(codes.Unavailable, message)
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%d: %s", code, message))
+	}
+}
+
+// responseWriter records the response of a REST operation
+type responseWriter struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *responseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *responseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	w.code = code
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// dial serves the services with the given REST handler and returns a client connection to them
+func dial(t *testing.T, handler http.Handler, options ...func(*Server)) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	server := NewServer(handler, options...).GRPCServer()
+	go server.Serve(lis) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(codecName)))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestClusterService(t *testing.T) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"authorization", "Bearer token", "activeprojectid", "655a6892-4280-4c37-97b1-31161ac0b99e")
+
+	t.Run("operation is run with the metadata as headers", func(t *testing.T) {
+		var got *http.Request
+		conn := dial(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"clusters":[{"name":"edge-1"}],"totalElements":1}`)) //nolint:errcheck
+		}))
+
+		pageSize, filter := 10, "labels.env=prod"
+		var resp ListClustersResponse
+		err := conn.Invoke(ctx, "/"+clusterServiceName+"/ListClusters", &ListRequest{PageSize: &pageSize, Filter: &filter}, &resp)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.MethodGet, got.Method)
+		assert.Equal(t, "/v2/clusters", got.URL.Path)
+		assert.Equal(t, "filter=labels.env%3Dprod&pageSize=10", got.URL.RawQuery)
+		assert.Equal(t, "Bearer token", got.Header.Get("Authorization"))
+		assert.Equal(t, "655a6892-4280-4c37-97b1-31161ac0b99e", got.Header.Get("Activeprojectid"))
+		assert.Empty(t, got.Header.Get("Grpc-Timeout"))
+		assert.EqualValues(t, 1, resp.TotalElements)
+		require.NotNil(t, resp.Clusters)
+		assert.Equal(t, "edge-1", *(*resp.Clusters)[0].Name)
+	})
+
+	t.Run("scheduled cluster returns the pending cluster", func(t *testing.T) {
+		var body api.ClusterSpec
+		conn := dial(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(data, &body))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"name":"edge-1"}`)) //nolint:errcheck
+		}))
+
+		name := "edge-1"
+		var resp CreateClusterResponse
+		err := conn.Invoke(ctx, "/"+clusterServiceName+"/CreateCluster", &api.ClusterSpec{Name: &name}, &resp)
+		require.NoError(t, err)
+
+		assert.Equal(t, "edge-1", *body.Name)
+		require.NotNil(t, resp.PendingCluster)
+		assert.Empty(t, resp.Message)
+	})
+
+	t.Run("created cluster returns the message", func(t *testing.T) {
+		conn := dial(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`"successfully created cluster edge-1"`)) //nolint:errcheck
+		}))
+
+		var resp CreateClusterResponse
+		err := conn.Invoke(ctx, "/"+clusterServiceName+"/CreateCluster", &api.ClusterSpec{}, &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "successfully created cluster edge-1", resp.Message)
+		assert.Nil(t, resp.PendingCluster)
+	})
+
+	t.Run("failed operation returns the status of its problem details", func(t *testing.T) {
+		conn := dial(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v2/clusters/edge-1", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"cluster edge-1 not found"}`)) //nolint:errcheck
+		}))
+
+		err := conn.Invoke(ctx, "/"+clusterServiceName+"/GetCluster", &ClusterRequest{Name: "edge-1"}, &api.ClusterDetailInfo{})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "cluster edge-1 not found", status.Convert(err).Message())
+	})
+}
+
+func TestTemplateService(t *testing.T) {
+	var got *http.Request
+	conn := dial(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"message":"template is in use"}`)) //nolint:errcheck
+	}))
+
+	err := conn.Invoke(context.Background(), "/"+templateServiceName+"/DeleteTemplate", &TemplateRequest{Name: "baseline", Version: "v1.0.0"}, &Empty{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, http.MethodDelete, got.Method)
+	assert.Equal(t, "/v2/templates/baseline/v1.0.0", got.URL.Path)
+
+	err = conn.Invoke(context.Background(), "/"+templateServiceName+"/ImportTemplate", &api.TemplateInfo{}, &MessageResponse{})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestTokenRequired(t *testing.T) {
+	called := false
+	conn := dial(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}), WithTokenRequired())
+
+	err := conn.Invoke(context.Background(), "/"+clusterServiceName+"/GetClustersSummary", &Empty{}, &api.ClusterSummary{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, called)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	err = conn.Invoke(ctx, "/"+clusterServiceName+"/GetClustersSummary", &Empty{}, &api.ClusterSummary{})
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"google.golang.org/grpc"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+const (
+	clusterServiceName  = "cluster_manager.v2.ClusterService"
+	templateServiceName = "cluster_manager.v2.TemplateService"
+)
+
+// clusterServiceDesc describes the ClusterService, the equivalent of the /v2/clusters operations
+var clusterServiceDesc = grpc.ServiceDesc{
+	ServiceName: clusterServiceName,
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		unary[ListRequest, ListClustersResponse](clusterServiceName, "ListClusters", func(r *ListRequest) operation {
+			return operation{method: http.MethodGet, path: "/v2/clusters", query: r.query()}
+		}),
+		unary[Empty, api.ClusterSummary](clusterServiceName, "GetClustersSummary", func(*Empty) operation {
+			return operation{method: http.MethodGet, path: "/v2/clusters/summary"}
+		}),
+		unary[api.ClusterSpec, CreateClusterResponse](clusterServiceName, "CreateCluster", func(r *api.ClusterSpec) operation {
+			return operation{method: http.MethodPost, path: "/v2/clusters", body: r}
+		}),
+		unary[ClusterRequest, api.ClusterDetailInfo](clusterServiceName, "GetCluster", func(r *ClusterRequest) operation {
+			return operation{method: http.MethodGet, path: "/v2/clusters/" + url.PathEscape(r.Name)}
+		}),
+		unary[ClusterRequest, Empty](clusterServiceName, "DeleteCluster", func(r *ClusterRequest) operation {
+			return operation{method: http.MethodDelete, path: "/v2/clusters/" + url.PathEscape(r.Name)}
+		}),
+		unary[UpdateClusterLabelsRequest, Empty](clusterServiceName, "UpdateClusterLabels", func(r *UpdateClusterLabelsRequest) operation {
+			return operation{method: http.MethodPut, path: "/v2/clusters/" + url.PathEscape(r.Name) + "/labels", body: api.ClusterLabels{Labels: &r.Labels}}
+		}),
+		unary[ClusterRequest, api.KubeconfigInfo](clusterServiceName, "GetClusterKubeconfig", func(r *ClusterRequest) operation {
+			return operation{method: http.MethodGet, path: "/v2/clusters/" + url.PathEscape(r.Name) + "/kubeconfigs"}
+		}),
+	},
+}
+
+// templateServiceDesc describes the TemplateService, the equivalent of the /v2/templates operations
+var templateServiceDesc = grpc.ServiceDesc{
+	ServiceName: templateServiceName,
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		unary[ListTemplatesRequest, api.TemplateInfoList](templateServiceName, "ListTemplates", func(r *ListTemplatesRequest) operation {
+			query := r.query()
+			if r.Default != nil {
+				query.Set("default", strconv.FormatBool(*r.Default))
+			}
+			return operation{method: http.MethodGet, path: "/v2/templates", query: query}
+		}),
+		unary[api.TemplateInfo, MessageResponse](templateServiceName, "ImportTemplate", func(r *api.TemplateInfo) operation {
+			return operation{method: http.MethodPost, path: "/v2/templates", body: r}
+		}),
+		unary[TemplateRequest, api.TemplateInfo](templateServiceName, "GetTemplate", func(r *TemplateRequest) operation {
+			return operation{method: http.MethodGet, path: templatePath(r.Name, r.Version)}
+		}),
+		unary[TemplateRequest, Empty](templateServiceName, "DeleteTemplate", func(r *TemplateRequest) operation {
+			return operation{method: http.MethodDelete, path: templatePath(r.Name, r.Version)}
+		}),
+		unary[TemplateNameRequest, api.VersionList](templateServiceName, "ListTemplateVersions", func(r *TemplateNameRequest) operation {
+			return operation{method: http.MethodGet, path: "/v2/templates/" + url.PathEscape(r.Name) + "/versions"}
+		}),
+		unary[SetDefaultTemplateRequest, Empty](templateServiceName, "SetDefaultTemplate", func(r *SetDefaultTemplateRequest) operation {
+			return operation{method: http.MethodPut, path: "/v2/templates/" + url.PathEscape(r.Name) + "/default", body: api.DefaultTemplateInfo{Version: r.Version}}
+		}),
+	},
+}
+
+func templatePath(name, version string) string {
+	return "/v2/templates/" + url.PathEscape(name) + "/" + url.PathEscape(version)
+}
+
+// unary returns the descriptor of a unary method that runs the REST operation returned by route for its request
+func unary[Req, Resp any](service, method string, route func(*Req) operation) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			req := new(Req)
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				resp := new(Resp)
+				if err := srv.(*Server).call(ctx, route(req.(*Req)), resp); err != nil {
+					return nil, err
+				}
+				return resp, nil
+			}
+			if interceptor == nil {
+				return handler(ctx, req)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + service + "/" + method}
+			return interceptor(ctx, req, info, handler)
+		},
+	}
+}
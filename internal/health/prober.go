@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health probes workload clusters through the connect gateway for the conditions of their nodes and the health
+// of their kube-system pods, which the phases of the Cluster API machines do not reflect
+package health
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const (
+	// DefaultInterval is the default minimum time between two probes of a cluster
+	DefaultInterval = time.Minute
+	// probeTimeout bounds the requests to the workload cluster, so that an unreachable cluster does not block callers
+	probeTimeout = 10 * time.Second
+)
+
+var (
+	nodeResourceSchema = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
+	podResourceSchema  = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+)
+
+// Status is the overall health of a cluster
+type Status string
+
+const (
+	// Healthy clusters have only ready nodes without pressure and healthy kube-system pods
+	Healthy Status = "healthy"
+	// Degraded clusters have nodes under pressure or unhealthy kube-system pods
+	Degraded Status = "degraded"
+	// Unhealthy clusters have no nodes or nodes that are not ready
+	Unhealthy Status = "unhealthy"
+	// Unreachable clusters could not be probed, see Report.Message
+	Unreachable Status = "unreachable"
+)
+
+// NodeCondition is a condition of a node that deviates from the healthy state
+type NodeCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// NodeReport is the health of a node of the workload cluster
+type NodeReport struct {
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+	// Conditions are the conditions of the node that deviate from the healthy state, e.g. not ready or memory pressure
+	Conditions []NodeCondition `json:"conditions"`
+}
+
+// PodReport is an unhealthy kube-system pod of the workload cluster
+type PodReport struct {
+	Name     string `json:"name"`
+	Phase    string `json:"phase"`
+	Reason   string `json:"reason,omitempty"`
+	Restarts int32  `json:"restarts"`
+}
+
+// Report is the health of a workload cluster at the time it was probed
+type Report struct {
+	Status   Status       `json:"status"`
+	Message  string       `json:"message,omitempty"`
+	ProbedAt time.Time    `json:"probedAt"`
+	Nodes    []NodeReport `json:"nodes"`
+	// SystemPods and ReadySystemPods count the kube-system pods and those of them that are healthy
+	SystemPods      int         `json:"systemPods"`
+	ReadySystemPods int         `json:"readySystemPods"`
+	UnhealthyPods   []PodReport `json:"unhealthyPods"`
+}
+
+// Prober probes the health of workload clusters and caches their reports for the probe interval
+type Prober struct {
+	k8s      *k8s.Client
+	interval time.Duration
+	now      func() time.Time
+	connect  func(kubeconfig []byte) (dynamic.Interface, error)
+
+	mu      sync.Mutex
+	reports map[string]*cachedReport
+}
+
+// cachedReport is the last report of a cluster; its mutex is held while the cluster is probed, so that concurrent
+// callers wait for the same probe instead of probing the cluster again
+type cachedReport struct {
+	mu     sync.Mutex
+	report *Report
+}
+
+// NewProber creates a new Prober reading the kubeconfigs of the clusters with the given client
+func NewProber(k8sClient *k8s.Client, options ...func(*Prober)) *Prober {
+	p := &Prober{
+		k8s:      k8sClient,
+		interval: DefaultInterval,
+		now:      time.Now,
+		connect:  connect,
+		reports:  map[string]*cachedReport{},
+	}
+
+	for _, o := range options {
+		o(p)
+	}
+
+	return p
+}
+
+// WithInterval is a functional option for configuring the minimum time between two probes of a cluster
+func WithInterval(interval time.Duration) func(*Prober) {
+	return func(p *Prober) {
+		p.interval = interval
+	}
+}
+
+// WithClock is a functional option for configuring a Prober with the given clock
+func WithClock(now func() time.Time) func(*Prober) {
+	return func(p *Prober) {
+		p.now = now
+	}
+}
+
+// WithConnector is a functional option for configuring how a Prober connects to a workload cluster with its kubeconfig
+func WithConnector(connect func(kubeconfig []byte) (dynamic.Interface, error)) func(*Prober) {
+	return func(p *Prober) {
+		p.connect = connect
+	}
+}
+
+// Report returns the health of the cluster in the project, probing the cluster if its last report is older than the
+// probe interval; it returns k8s.ErrClusterNotFound if the cluster does not exist. A cluster that can not be probed is
+// reported as Unreachable rather than failing the report.
+func (p *Prober) Report(ctx context.Context, projectID, clusterName string) (Report, error) {
+	key := projectID + "/" + clusterName
+
+	p.mu.Lock()
+	cached, ok := p.reports[key]
+	if !ok {
+		cached = &cachedReport{}
+		p.reports[key] = cached
+	}
+	p.mu.Unlock()
+
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+	if cached.report != nil && p.now().Sub(cached.report.ProbedAt) < p.interval {
+		return *cached.report, nil
+	}
+
+	_, err := p.k8s.Dyn.Resource(core.ClusterResourceSchema).Namespace(projectID).Get(ctx, clusterName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		p.mu.Lock()
+		delete(p.reports, key)
+		p.mu.Unlock()
+		return Report{}, k8s.ErrClusterNotFound
+	}
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	report := p.probe(ctx, projectID, clusterName)
+	cached.report = &report
+	return report, nil
+}
+
+// Forget drops the cached report of the cluster in the project, e.g. once the cluster and its kubeconfig are deleted
+func (p *Prober) Forget(projectID, clusterName string) {
+	p.mu.Lock()
+	delete(p.reports, projectID+"/"+clusterName)
+	p.mu.Unlock()
+}
+
+// probe queries the nodes and the kube-system pods of the workload cluster
+func (p *Prober) probe(ctx context.Context, projectID, clusterName string) Report {
+	report := Report{ProbedAt: p.now().UTC(), Nodes: []NodeReport{}, UnhealthyPods: []PodReport{}}
+	unreachable := func(format string, args ...any) Report {
+		report.Status, report.Message = Unreachable, fmt.Sprintf(format, args...)
+		return report
+	}
+
+	secret, err := p.k8s.Dyn.Resource(core.SecretResourceSchema).Namespace(projectID).Get(ctx, clusterName+"-kubeconfig", metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return unreachable("the kubeconfig of the cluster is not available yet")
+	}
+	if err != nil {
+		return unreachable("failed to get the kubeconfig of the cluster: %v", err)
+	}
+	value, _, _ := unstructured.NestedString(secret.Object, "data", "value")
+	kubeconfig, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(kubeconfig) == 0 {
+		return unreachable("the kubeconfig of the cluster is invalid")
+	}
+
+	workload, err := p.connect(kubeconfig)
+	if err != nil {
+		return unreachable("failed to connect to the cluster: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	nodes, err := workload.Resource(nodeResourceSchema).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return unreachable("failed to list the nodes of the cluster: %v", err)
+	}
+	pods, err := workload.Resource(podResourceSchema).Namespace(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return unreachable("failed to list the kube-system pods of the cluster: %v", err)
+	}
+
+	report.Status = Healthy
+	if len(nodes.Items) == 0 {
+		report.Status, report.Message = Unhealthy, "the cluster has no nodes"
+	}
+	for _, item := range nodes.Items {
+		var node corev1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &node); err != nil {
+			return unreachable("failed to convert node %s: %v", item.GetName(), err)
+		}
+		nodeReport := nodeHealth(node)
+		switch {
+		case !nodeReport.Ready:
+			report.Status = Unhealthy
+		case len(nodeReport.Conditions) > 0 && report.Status == Healthy:
+			report.Status = Degraded
+		}
+		report.Nodes = append(report.Nodes, nodeReport)
+	}
+
+	for _, item := range pods.Items {
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &pod); err != nil {
+			return unreachable("failed to convert pod %s: %v", item.GetName(), err)
+		}
+		report.SystemPods++
+		if podReport, healthy := podHealth(pod); healthy {
+			report.ReadySystemPods++
+		} else {
+			report.UnhealthyPods = append(report.UnhealthyPods, podReport)
+		}
+	}
+	if len(report.UnhealthyPods) > 0 && report.Status == Healthy {
+		report.Status = Degraded
+	}
+
+	return report
+}
+
+// nodeHealth returns the report of the node with the conditions that deviate from the healthy state: a Ready condition
+// that is not True and any other condition, e.g. MemoryPressure, that is True
+func nodeHealth(node corev1.Node) NodeReport {
+	report := NodeReport{Name: node.Name, Conditions: []NodeCondition{}}
+	for _, condition := range node.Status.Conditions {
+		healthy := condition.Status == corev1.ConditionFalse
+		if condition.Type == corev1.NodeReady {
+			report.Ready = condition.Status == corev1.ConditionTrue
+			healthy = report.Ready
+		}
+		if !healthy {
+			report.Conditions = append(report.Conditions, NodeCondition{
+				Type:    string(condition.Type),
+				Status:  string(condition.Status),
+				Reason:  condition.Reason,
+				Message: condition.Message,
+			})
+		}
+	}
+	return report
+}
+
+// podHealth returns the report of the pod and whether it is healthy, that is completed or running with all its
+// containers ready
+func podHealth(pod corev1.Pod) (PodReport, bool) {
+	report := PodReport{Name: pod.Name, Phase: string(pod.Status.Phase), Reason: pod.Status.Reason}
+	healthy := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodRunning
+	for _, container := range pod.Status.ContainerStatuses {
+		report.Restarts += container.RestartCount
+		if pod.Status.Phase == corev1.PodRunning && !container.Ready {
+			healthy = false
+		}
+		if report.Reason == "" && container.State.Waiting != nil {
+			report.Reason = container.State.Waiting.Reason
+		}
+	}
+	return report, healthy
+}
+
+// connect creates a client for the workload cluster of the kubeconfig, which points to the cluster through the
+// connect gateway
+func connect(kubeconfig []byte) (dynamic.Interface, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Timeout = probeTimeout
+	return dynamic.NewForConfig(cfg)
+}
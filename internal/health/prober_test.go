@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+func toUnstructured(t *testing.T, obj any) *unstructured.Unstructured {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: data}
+}
+
+// managementClient returns a client of the management cluster with the given cluster and, if withKubeconfig is set,
+// its kubeconfig secret
+func managementClient(t *testing.T, withKubeconfig bool) *k8s.Client {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetAPIVersion("cluster.x-k8s.io/v1beta1")
+	cluster.SetKind("Cluster")
+	cluster.SetNamespace(projectID)
+	cluster.SetName("edge-1")
+	objects := []runtime.Object{cluster}
+
+	if withKubeconfig {
+		secret := toUnstructured(t, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: projectID, Name: "edge-1-kubeconfig"},
+		})
+		secret.Object["data"] = map[string]any{"value": base64.StdEncoding.EncodeToString([]byte("kubeconfig"))}
+		objects = append(objects, secret)
+	}
+
+	return k8s.New(fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{core.ClusterResourceSchema: "ClusterList"}, objects...))
+}
+
+func node(t *testing.T, name string, conditions ...corev1.NodeCondition) runtime.Object {
+	return toUnstructured(t, &corev1.Node{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.NodeStatus{Conditions: conditions},
+	})
+}
+
+func pod(t *testing.T, name string, phase corev1.PodPhase, containers ...corev1.ContainerStatus) runtime.Object {
+	return toUnstructured(t, &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: name},
+		Status:     corev1.PodStatus{Phase: phase, ContainerStatuses: containers},
+	})
+}
+
+// workloadConnector returns a connector to a workload cluster with the given objects that counts the connections
+func workloadConnector(connections *int, objects ...runtime.Object) func([]byte) (dynamic.Interface, error) {
+	return func(kubeconfig []byte) (dynamic.Interface, error) {
+		*connections++
+		if string(kubeconfig) != "kubeconfig" {
+			return nil, errors.New("unexpected kubeconfig")
+		}
+		return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			nodeResourceSchema: "NodeList",
+			podResourceSchema:  "PodList",
+		}, objects...), nil
+	}
+}
+
+var (
+	ready          = corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue}
+	notReady       = corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Reason: "KubeletNotReady"}
+	memoryPressure = corev1.NodeCondition{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue}
+	noPressure     = corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse}
+	readyContainer = corev1.ContainerStatus{Name: "main", Ready: true}
+)
+
+func TestProber(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("healthy cluster", func(t *testing.T) {
+		var connections int
+		prober := NewProber(managementClient(t, true), WithConnector(workloadConnector(&connections,
+			node(t, "node-1", ready, noPressure),
+			pod(t, "coredns", corev1.PodRunning, readyContainer),
+			pod(t, "install", corev1.PodSucceeded))))
+
+		report, err := prober.Report(ctx, projectID, "edge-1")
+		require.NoError(t, err)
+		assert.Equal(t, Healthy, report.Status)
+		assert.Equal(t, []NodeReport{{Name: "node-1", Ready: true, Conditions: []NodeCondition{}}}, report.Nodes)
+		assert.Equal(t, 2, report.SystemPods)
+		assert.Equal(t, 2, report.ReadySystemPods)
+		assert.Empty(t, report.UnhealthyPods)
+	})
+
+	t.Run("node under pressure and crashing pod degrade the cluster", func(t *testing.T) {
+		var connections int
+		crashing := corev1.ContainerStatus{Name: "main", RestartCount: 7,
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}
+		prober := NewProber(managementClient(t, true), WithConnector(workloadConnector(&connections,
+			node(t, "node-1", ready, memoryPressure),
+			pod(t, "coredns", corev1.PodRunning, crashing))))
+
+		report, err := prober.Report(ctx, projectID, "edge-1")
+		require.NoError(t, err)
+		assert.Equal(t, Degraded, report.Status)
+		assert.Equal(t, []NodeCondition{{Type: "MemoryPressure", Status: "True"}}, report.Nodes[0].Conditions)
+		assert.Equal(t, []PodReport{{Name: "coredns", Phase: "Running", Reason: "CrashLoopBackOff", Restarts: 7}}, report.UnhealthyPods)
+	})
+
+	t.Run("node not ready makes the cluster unhealthy", func(t *testing.T) {
+		var connections int
+		prober := NewProber(managementClient(t, true), WithConnector(workloadConnector(&connections,
+			node(t, "node-1", ready), node(t, "node-2", notReady, memoryPressure))))
+
+		report, err := prober.Report(ctx, projectID, "edge-1")
+		require.NoError(t, err)
+		assert.Equal(t, Unhealthy, report.Status)
+		assert.False(t, report.Nodes[1].Ready)
+		assert.Len(t, report.Nodes[1].Conditions, 2)
+	})
+
+	t.Run("cluster without kubeconfig is unreachable", func(t *testing.T) {
+		var connections int
+		prober := NewProber(managementClient(t, false), WithConnector(workloadConnector(&connections)))
+
+		report, err := prober.Report(ctx, projectID, "edge-1")
+		require.NoError(t, err)
+		assert.Equal(t, Unreachable, report.Status)
+		assert.Equal(t, "the kubeconfig of the cluster is not available yet", report.Message)
+		assert.Zero(t, connections)
+	})
+
+	t.Run("missing cluster", func(t *testing.T) {
+		var connections int
+		prober := NewProber(managementClient(t, true), WithConnector(workloadConnector(&connections)))
+
+		_, err := prober.Report(ctx, projectID, "edge-2")
+		require.ErrorIs(t, err, k8s.ErrClusterNotFound)
+	})
+
+	t.Run("reports are cached for the probe interval", func(t *testing.T) {
+		var connections int
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		prober := NewProber(managementClient(t, true), WithInterval(time.Minute), WithClock(func() time.Time { return now }),
+			WithConnector(workloadConnector(&connections, node(t, "node-1", ready))))
+
+		first, err := prober.Report(ctx, projectID, "edge-1")
+		require.NoError(t, err)
+		now = now.Add(30 * time.Second)
+		cached, err := prober.Report(ctx, projectID, "edge-1")
+		require.NoError(t, err)
+		assert.Equal(t, first, cached)
+		assert.Equal(t, 1, connections)
+
+		now = now.Add(30 * time.Second)
+		probed, err := prober.Report(ctx, projectID, "edge-1")
+		require.NoError(t, err)
+		assert.Equal(t, now, probed.ProbedAt)
+		assert.Equal(t, 2, connections)
+	})
+}
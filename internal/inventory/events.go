@@ -10,6 +10,7 @@ import (
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/events"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
 )
 
 var (
@@ -112,5 +113,14 @@ func (e HostUpdated) Handle(ctx context.Context) error {
 	}
 
 	slog.Info("updated machine labels", "projectID", e.ProjectId, "hostID", e.HostId, "machine name", m.Name, "labels", e.Labels)
+
+	// copy the allowed host metadata (e.g. asset tag, site, rack) onto the provider machine
+	metadata := nodemetadata.Filter(e.Labels)
+	err = e.K8scli.SetProviderMachineMetadata(timeoutCtx, e.ProjectId, m, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to set provider machine metadata: %w", err)
+	}
+
+	slog.Debug("updated provider machine metadata", "projectID", e.ProjectId, "hostID", e.HostId, "machine name", m.Name, "metadata", metadata)
 	return nil
 }
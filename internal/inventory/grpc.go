@@ -7,9 +7,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/events"
@@ -18,7 +20,9 @@ import (
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	inventoryv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
 	osv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
+	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	inverrors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 )
 
@@ -32,14 +36,28 @@ const (
 
 var (
 	GetInventoryClientFunc = client.NewTenantAwareInventoryClient
+
+	// ErrHostNotFound is returned when a host does not exist in the inventory of the tenant
+	ErrHostNotFound = errors.New("host not found")
 )
 
+// HostResources are the CPU cores and memory of a host, zero if the host did not report them (yet)
+type HostResources struct {
+	CPUCores    uint32
+	MemoryBytes uint64
+}
+
 // InventoryClient is a tenant-aware grpc client for the inventory service
 type InventoryClient struct {
 	client    client.TenantAwareInventoryClient
 	events    chan *client.WatchEvents
 	term      chan bool
+	closeOnce sync.Once
 	k8sclient k8s.K8sWrapperClient
+	// isLeader returns whether the replica handles the host events, nil if it is the only replica
+	isLeader func() bool
+	// reservations serializes the reservations of the hosts, see ReserveHosts
+	reservations sync.Mutex
 }
 
 // clientInstance is the singleton instance of the inventory client
@@ -74,6 +92,7 @@ func NewInventoryClientWithOptions(opt Options) (*InventoryClient, error) {
 
 	cli, err := &InventoryClient{client: taic, events: eventsWatcher, term: make(chan bool)}, nil
 	cli.k8sclient = opt.k8sClient
+	cli.isLeader = opt.isLeader
 	cli.WatchHosts(events.NewSink(context.TODO()))
 	return cli, err
 }
@@ -92,6 +111,21 @@ func (c *InventoryClient) GetHostTrustedCompute(ctx context.Context, tenantId, h
 	return host.Instance.SecurityFeature == osv1.SecurityFeature_SECURITY_FEATURE_SECURE_BOOT_AND_FULL_DISK_ENCRYPTION, nil
 }
 
+// IsAttested returns true if the attestation service verified the measured boot of the host, i.e. the trusted
+// attestation status of its instance is idle rather than unknown, in progress or failed
+func (c *InventoryClient) IsAttested(ctx context.Context, tenantId, hostUuid string) (bool, error) {
+	host, err := c.getHost(ctx, tenantId, hostUuid)
+	if err != nil {
+		return false, err
+	}
+
+	if host.Instance == nil {
+		return false, errors.New("host instance is nil")
+	}
+
+	return host.Instance.TrustedAttestationStatusIndicator == statusv1.StatusIndication_STATUS_INDICATION_IDLE, nil
+}
+
 // EnableAirGapInstall returns true if the host OS type is immutable (e.g. EMT with pre-installed K8s packages)
 func (c *InventoryClient) IsImmutable(ctx context.Context, tenantId, hostUuid string) (bool, error) {
 	host, err := c.getHost(ctx, tenantId, hostUuid)
@@ -114,6 +148,30 @@ func (c *InventoryClient) IsImmutable(ctx context.Context, tenantId, hostUuid st
 	return false, nil
 }
 
+// HostSite returns the resource id of the site of the host, empty if the host is not assigned to a site
+func (c *InventoryClient) HostSite(ctx context.Context, tenantId, hostUuid string) (string, error) {
+	host, err := c.getHost(ctx, tenantId, hostUuid)
+	if err != nil {
+		return "", err
+	}
+
+	return host.GetSite().GetResourceId(), nil
+}
+
+// HostResources returns the CPU cores and memory of the host; the error is ErrHostNotFound if the host does not exist
+// in the inventory of the tenant, the hosts of other tenants are not visible to it
+func (c *InventoryClient) HostResources(ctx context.Context, tenantId, hostUuid string) (HostResources, error) {
+	host, err := c.getHost(ctx, tenantId, hostUuid)
+	if inverrors.IsNotFound(err) {
+		return HostResources{}, fmt.Errorf("%w: %s", ErrHostNotFound, hostUuid)
+	}
+	if err != nil {
+		return HostResources{}, err
+	}
+
+	return HostResources{CPUCores: host.GetCpuCores(), MemoryBytes: host.GetMemoryBytes()}, nil
+}
+
 // getHost returns the host resource for the given tenant and host uuid
 func (c *InventoryClient) getHost(ctx context.Context, tenantId, hostUuid string) (*computev1.HostResource, error) {
 	ctx, cancel := context.WithTimeout(ctx, defaultInventoryTimeout)
@@ -179,11 +237,37 @@ func (auth noopInventoryClient) GetHostTrustedCompute(ctx context.Context, tenan
 	return false, nil
 }
 
+// IsAttested is a no-op implementation of the InventoryClient's IsAttested method that always returns false
+func (auth noopInventoryClient) IsAttested(ctx context.Context, tenantId, hostUuid string) (bool, error) {
+	return false, nil
+}
+
 // IsImmutable is a no-op implementation of the InventoryClient's IsImmutable method that always returns false
 func (auth noopInventoryClient) IsImmutable(ctx context.Context, tenantId, hostUuid string) (bool, error) {
 	return false, nil
 }
 
+// HostSite is a no-op implementation of the InventoryClient's HostSite method that always returns no site
+func (auth noopInventoryClient) HostSite(ctx context.Context, tenantId, hostUuid string) (string, error) {
+	return "", nil
+}
+
+// HostResources is a no-op implementation of the InventoryClient's HostResources method that always returns resources
+// that are not reported, so the hosts are not checked
+func (auth noopInventoryClient) HostResources(ctx context.Context, tenantId, hostUuid string) (HostResources, error) {
+	return HostResources{}, nil
+}
+
+// ReserveHosts is a no-op implementation of the InventoryClient's ReserveHosts method, the hosts are not reserved
+func (auth noopInventoryClient) ReserveHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) ([]string, error) {
+	return nil, nil
+}
+
+// ReleaseHosts is a no-op implementation of the InventoryClient's ReleaseHosts method
+func (auth noopInventoryClient) ReleaseHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) error {
+	return nil
+}
+
 // WatchHosts watches for host resource events and sends them to the given channel
 func (c *InventoryClient) WatchHosts(hostEvents chan<- events.Event) {
 	go func() {
@@ -194,6 +278,10 @@ func (c *InventoryClient) WatchHosts(hostEvents chan<- events.Event) {
 					slog.Warn("events channel closed")
 					return
 				}
+				// all replicas receive the host events, only the leader handles them
+				if c.isLeader != nil && !c.isLeader() {
+					continue
+				}
 
 				host := event.Event.Resource.GetHost()
 				if err := c.validateHostResource(host); err != nil {
@@ -406,17 +494,16 @@ func JsonStringToMap(jsonString string) (map[string]string, error) {
 	return out, nil
 }
 
-/*
-// TODO: implement termination event handling in main.go
-// IMPORTANT: always close the Inventory client in case of errors
-// or signals like syscall.SIGTERM, syscall.SIGINT etc.
-func (c *InventoryClient) close() {
-	close(c.term)
-
-	if err := c.Client.Close(); err != nil {
-		slog.Error("failed to stop inventory client", "error", err)
-	}
-
-	slog.Info("inventory client stopped")
+// Close stops watching the host events and closes the connection to the inventory; closing the client again is a
+// no-op
+func (c *InventoryClient) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.term)
+		if c.client != nil {
+			err = c.client.Close()
+		}
+		slog.Info("inventory client stopped")
+	})
+	return err
 }
-*/
@@ -15,6 +15,7 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/events"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -59,10 +60,10 @@ func TestHostUpdateHandle(t *testing.T) {
 	// Test data
 	projectID := "64e797f6-db23-445e-b606-4228d4f1c2bd"
 	hostId := "host-12345"
-	labels := map[string]string{"key": "value"}
+	labels := map[string]string{"key": "value", "site": "santa-clara"}
 
 	// Setup mock k8s client and resources
-	cli, machine := setupMockK8sClient(t, projectID, hostId)
+	cli, machine, intelMachine := setupMockK8sClient(t, projectID, hostId)
 
 	// Test multiple host update events
 	out := make(chan error, 1)
@@ -73,9 +74,15 @@ func TestHostUpdateHandle(t *testing.T) {
 	}
 
 	// Verify the results
-	require.Equal(t, 1, len(machine.GetLabels()))
+	require.Equal(t, 2, len(machine.GetLabels()))
 	require.Equal(t, "value", machine.GetLabels()["key"])
 
+	// only the allowed host metadata is copied onto the provider machine
+	require.Equal(t, map[string]string{
+		nodemetadata.HostIdAnnotationKey:       hostId,
+		nodemetadata.AnnotationPrefix + "site": "santa-clara",
+	}, intelMachine.GetAnnotations())
+
 	close(sink)
 }
 
@@ -112,7 +119,7 @@ func createHostUpdatedEvent(hostId, projectID string, labels map[string]string,
 	}
 }
 
-func setupMockK8sClient(t *testing.T, projectID, hostId string) (*k8s.Client, *unstructured.Unstructured) {
+func setupMockK8sClient(t *testing.T, projectID, hostId string) (*k8s.Client, *unstructured.Unstructured, *unstructured.Unstructured) {
 	// Create a new mocked k8s client
 	mockedk8sclient := k8s.NewMockInterface(t)
 
@@ -153,8 +160,27 @@ func setupMockK8sClient(t *testing.T, projectID, hostId string) (*k8s.Client, *u
 	nsMachineResource.EXPECT().Namespace(projectID).Return(machineResource)
 	mockedk8sclient.EXPECT().Resource(core.MachineResourceSchema).Return(nsMachineResource)
 
+	// Mocked IntelMachine object backing the Machine
+	intelMachine := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "infrastructure.cluster.x-k8s.io/v1alpha1",
+		"kind":       "IntelMachine",
+		"metadata": map[string]any{
+			"name":        "example-infrastructure",
+			"namespace":   projectID,
+			"annotations": map[string]any{nodemetadata.HostIdAnnotationKey: hostId},
+		},
+	}}
+
+	intelMachineResource := k8s.NewMockResourceInterface(t)
+	intelMachineResource.EXPECT().Get(mock.Anything, "example-infrastructure", mock.Anything).Return(intelMachine, nil)
+	intelMachineResource.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(intelMachine, nil)
+
+	nsIntelMachineResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsIntelMachineResource.EXPECT().Namespace(projectID).Return(intelMachineResource)
+	mockedk8sclient.EXPECT().Resource(core.IntelMachineResourceSchema).Return(nsIntelMachineResource)
+
 	cli := k8s.New(mockedk8sclient)
 	require.NotNil(t, cli)
 
-	return cli, machine
+	return cli, machine, intelMachine
 }
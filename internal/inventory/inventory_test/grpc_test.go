@@ -12,12 +12,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	inventoryv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
 	osv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
+	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/events"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
@@ -147,6 +151,88 @@ func TestGetHostTrustedCompute(t *testing.T) {
 	}
 }
 
+func TestIsAttested(t *testing.T) {
+	mockClient := mocks.NewMockTenantAwareInventoryClient(t)
+	inventory.GetInventoryClientFunc = func(ctx context.Context, cfg client.InventoryClientConfig) (client.TenantAwareInventoryClient, error) {
+		return mockClient, nil
+	}
+
+	host := func(indicator statusv1.StatusIndication, status string) *computev1.HostResource {
+		return &computev1.HostResource{Instance: &computev1.InstanceResource{
+			TrustedAttestationStatusIndicator: indicator,
+			TrustedAttestationStatus:          status,
+		}}
+	}
+
+	cases := []struct {
+		name        string
+		mock        func()
+		expectedVal bool
+		expectedErr error
+	}{
+		{
+			name: "attestation verified",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(
+					host(statusv1.StatusIndication_STATUS_INDICATION_IDLE, "Attestation verified"), nil).Once()
+			},
+			expectedVal: true,
+		},
+		{
+			name: "attestation in progress",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(
+					host(statusv1.StatusIndication_STATUS_INDICATION_IN_PROGRESS, "Attestation in progress"), nil).Once()
+			},
+			expectedVal: false,
+		},
+		{
+			name: "attestation failed",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(
+					host(statusv1.StatusIndication_STATUS_INDICATION_ERROR, "Attestation failed"), nil).Once()
+			},
+			expectedVal: false,
+		},
+		{
+			name: "attestation unknown",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(
+					host(statusv1.StatusIndication_STATUS_INDICATION_UNSPECIFIED, "Unknown"), nil).Once()
+			},
+			expectedVal: false,
+		},
+		{
+			name: "host instance nil",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(&computev1.HostResource{}, nil).Once()
+			},
+			expectedErr: errors.New("host instance is nil"),
+		},
+		{
+			name: "error getting host",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+				mockClient.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mock()
+
+			invClient, err := inventory.NewInventoryClientWithOptions(inventory.Options{})
+			require.NoError(t, err)
+
+			attested, err := invClient.IsAttested(context.Background(), "test_tenant_id", "test_host_uuid")
+			assert.Equal(t, tc.expectedVal, attested)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
 func TestIsImmutable(t *testing.T) {
 	mockClient := mocks.NewMockTenantAwareInventoryClient(t)
 	inventory.GetInventoryClientFunc = func(ctx context.Context, cfg client.InventoryClientConfig) (client.TenantAwareInventoryClient, error) {
@@ -237,6 +323,109 @@ func TestIsImmutable(t *testing.T) {
 	}
 }
 
+func TestHostSite(t *testing.T) {
+	mockClient := mocks.NewMockTenantAwareInventoryClient(t)
+	inventory.GetInventoryClientFunc = func(ctx context.Context, cfg client.InventoryClientConfig) (client.TenantAwareInventoryClient, error) {
+		return mockClient, nil
+	}
+
+	cases := []struct {
+		name        string
+		mock        func()
+		expectedVal string
+		expectedErr error
+	}{
+		{
+			name: "host on a site",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(
+					&computev1.HostResource{Site: &locationv1.SiteResource{ResourceId: "site-0a1b2c3d"}}, nil).Once()
+			},
+			expectedVal: "site-0a1b2c3d",
+		},
+		{
+			name: "host without site",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(&computev1.HostResource{}, nil).Once()
+			},
+			expectedVal: "",
+		},
+		{
+			name: "error getting host",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+				mockClient.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mock()
+
+			invClient, err := inventory.NewInventoryClientWithOptions(inventory.Options{})
+			require.NoError(t, err)
+
+			site, err := invClient.HostSite(context.Background(), "test_tenant_id", "test_host_uuid")
+			assert.Equal(t, tc.expectedVal, site)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestHostResources(t *testing.T) {
+	mockClient := mocks.NewMockTenantAwareInventoryClient(t)
+	inventory.GetInventoryClientFunc = func(ctx context.Context, cfg client.InventoryClientConfig) (client.TenantAwareInventoryClient, error) {
+		return mockClient, nil
+	}
+
+	cases := []struct {
+		name        string
+		mock        func()
+		expectedVal inventory.HostResources
+		expectedErr error
+	}{
+		{
+			name: "host with resources",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(
+					&computev1.HostResource{CpuCores: 16, MemoryBytes: 32 << 30}, nil).Once()
+			},
+			expectedVal: inventory.HostResources{CPUCores: 16, MemoryBytes: 32 << 30},
+		},
+		{
+			name: "host not in the inventory of the tenant",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(nil, status.Error(codes.NotFound, "not found")).Once()
+				mockClient.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(nil, status.Error(codes.NotFound, "not found")).Once()
+			},
+			expectedErr: inventory.ErrHostNotFound,
+		},
+		{
+			name: "error getting host",
+			mock: func() {
+				mockClient.EXPECT().GetHostByUUID(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+				mockClient.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mock()
+
+			invClient, err := inventory.NewInventoryClientWithOptions(inventory.Options{})
+			require.NoError(t, err)
+
+			resources, err := invClient.HostResources(context.Background(), "test_tenant_id", "test_host_uuid")
+			assert.Equal(t, tc.expectedVal, resources)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
 func TestJsonStringToMap(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -588,3 +777,15 @@ func TestWatchHosts_DeleteClusterOnDeauthorizedHost(t *testing.T) {
 		})
 	}
 }
+
+func TestClose(t *testing.T) {
+	mockClient := mocks.NewMockTenantAwareInventoryClient(t)
+	mockClient.EXPECT().Close().Return(nil).Once()
+
+	inventoryClient := inventory.NewTestInventoryClient(k8s.NewMockK8sWrapperClient(t), mockClient)
+	inventoryClient.WatchHosts(make(chan events.Event))
+
+	require.NoError(t, inventoryClient.Close())
+	// closing the client again neither closes the connection nor the term channel again
+	require.NoError(t, inventoryClient.Close())
+}
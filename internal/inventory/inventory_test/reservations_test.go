@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventoryv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
+	mocks "github.com/open-edge-platform/cluster-manager/v2/internal/mocks/client"
+)
+
+// hostMetadata records the metadata the hosts are updated with
+func hostMetadata(t *testing.T, mockClient *mocks.MockTenantAwareInventoryClient, updates map[string]string) {
+	mockClient.EXPECT().Update(mock.Anything, "test_tenant_id", mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _, id string, fm *fieldmaskpb.FieldMask, res *inventoryv1.Resource) (*inventoryv1.Resource, error) {
+			require.Equal(t, []string{computev1.HostResourceFieldMetadata}, fm.GetPaths())
+			updates[id] = res.GetHost().GetMetadata()
+			return res, nil
+		})
+}
+
+func TestReserveHosts(t *testing.T) {
+	t.Run("free hosts are reserved", func(t *testing.T) {
+		mockClient := mocks.NewMockTenantAwareInventoryClient(t)
+		mockClient.EXPECT().GetHostByUUID(mock.Anything, "test_tenant_id", "host-0a1b2c3d").
+			Return(&computev1.HostResource{ResourceId: "host-0a1b2c3d", Metadata: `[{"key":"site","value":"store-001"}]`}, nil)
+		mockClient.EXPECT().GetHostByUUID(mock.Anything, "test_tenant_id", "host-1a2b3c4d").
+			Return(&computev1.HostResource{ResourceId: "host-1a2b3c4d", Metadata: `[{"key":"cluster-name","value":"example-cluster"}]`}, nil)
+		updates := map[string]string{}
+		hostMetadata(t, mockClient, updates)
+
+		reserved, err := inventory.NewTestInventoryClient(nil, mockClient).ReserveHosts(context.Background(), "test_tenant_id", "example-cluster", []string{"host-0a1b2c3d", "host-1a2b3c4d"})
+		require.NoError(t, err)
+		// host-1a2b3c4d is already reserved for the cluster
+		require.Equal(t, []string{"host-0a1b2c3d"}, reserved)
+		require.Equal(t, map[string]string{"host-0a1b2c3d": `[{"key":"site","value":"store-001"},{"key":"cluster-name","value":"example-cluster"}]`}, updates)
+	})
+
+	t.Run("host reserved for another cluster", func(t *testing.T) {
+		mockClient := mocks.NewMockTenantAwareInventoryClient(t)
+		mockClient.EXPECT().GetHostByUUID(mock.Anything, "test_tenant_id", "host-0a1b2c3d").
+			Return(&computev1.HostResource{ResourceId: "host-0a1b2c3d"}, nil).Once()
+		mockClient.EXPECT().GetHostByUUID(mock.Anything, "test_tenant_id", "host-1a2b3c4d").
+			Return(&computev1.HostResource{ResourceId: "host-1a2b3c4d", Metadata: `[{"key":"cluster-name","value":"other-cluster"}]`}, nil)
+		// host-0a1b2c3d is released again
+		mockClient.EXPECT().GetHostByUUID(mock.Anything, "test_tenant_id", "host-0a1b2c3d").
+			Return(&computev1.HostResource{ResourceId: "host-0a1b2c3d", Metadata: `[{"key":"cluster-name","value":"example-cluster"}]`}, nil).Once()
+		updates := map[string]string{}
+		hostMetadata(t, mockClient, updates)
+
+		reserved, err := inventory.NewTestInventoryClient(nil, mockClient).ReserveHosts(context.Background(), "test_tenant_id", "example-cluster", []string{"host-0a1b2c3d", "host-1a2b3c4d"})
+		require.ErrorIs(t, err, inventory.ErrHostReserved)
+		require.ErrorContains(t, err, "host host-1a2b3c4d is reserved for cluster other-cluster")
+		require.Empty(t, reserved)
+		require.Equal(t, map[string]string{"host-0a1b2c3d": `[]`}, updates)
+	})
+}
+
+func TestReleaseHosts(t *testing.T) {
+	mockClient := mocks.NewMockTenantAwareInventoryClient(t)
+	mockClient.EXPECT().GetHostByUUID(mock.Anything, "test_tenant_id", "host-0a1b2c3d").
+		Return(&computev1.HostResource{ResourceId: "host-0a1b2c3d", Metadata: `[{"key":"cluster-name","value":"example-cluster"},{"key":"rack","value":"rack-1"}]`}, nil)
+	mockClient.EXPECT().GetHostByUUID(mock.Anything, "test_tenant_id", "host-1a2b3c4d").
+		Return(&computev1.HostResource{ResourceId: "host-1a2b3c4d", Metadata: `[{"key":"cluster-name","value":"other-cluster"}]`}, nil)
+	updates := map[string]string{}
+	hostMetadata(t, mockClient, updates)
+
+	err := inventory.NewTestInventoryClient(nil, mockClient).ReleaseHosts(context.Background(), "test_tenant_id", "example-cluster", []string{"host-0a1b2c3d", "host-1a2b3c4d"})
+	require.NoError(t, err)
+	// host-1a2b3c4d is reserved for another cluster and left as it is
+	require.Equal(t, map[string]string{"host-0a1b2c3d": `[{"key":"rack","value":"rack-1"}]`}, updates)
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/events"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
+)
+
+func stubObject(t *testing.T, obj any) *unstructured.Unstructured {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: data}
+}
+
+func stubMachine(t *testing.T, name, nodeName string) runtime.Object {
+	machine := &capi.Machine{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "cluster.x-k8s.io/v1beta1", Kind: "Machine"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "project", Name: name, Labels: map[string]string{"cluster.x-k8s.io/cluster-name": "edge-1", "team": "edge"}},
+		Spec: capi.MachineSpec{
+			ClusterName:       "edge-1",
+			InfrastructureRef: corev1.ObjectReference{Kind: "DockerMachine", Name: name},
+		},
+	}
+	if nodeName != "" {
+		machine.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: nodeName}
+	}
+	return stubObject(t, machine)
+}
+
+func stubDockerMachine(name string, annotations map[string]string) runtime.Object {
+	dockerMachine := &unstructured.Unstructured{}
+	dockerMachine.SetAPIVersion("infrastructure.cluster.x-k8s.io/v1beta1")
+	dockerMachine.SetKind("DockerMachine")
+	dockerMachine.SetNamespace("project")
+	dockerMachine.SetName(name)
+	dockerMachine.SetAnnotations(annotations)
+	return dockerMachine
+}
+
+func TestStubHostMetadata(t *testing.T) {
+	metadata := inventory.StubHostMetadata("node-1")
+	assert.Equal(t, metadata, inventory.StubHostMetadata("node-1"), "the metadata of a host is deterministic")
+	assert.Equal(t, metadata, nodemetadata.Filter(metadata), "the metadata consists of the keys copied onto the nodes")
+	assert.NotEqual(t, metadata["asset-tag"], inventory.StubHostMetadata("node-2")["asset-tag"])
+}
+
+func TestStubInventoryClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stub hosts are trusted compute compatible, attested and immutable", func(t *testing.T) {
+		stub := inventory.NewStubInventoryClient(k8s.New(fake.NewSimpleDynamicClient(runtime.NewScheme())))
+
+		trusted, err := stub.GetHostTrustedCompute(ctx, "project", "host-1")
+		require.NoError(t, err)
+		assert.True(t, trusted)
+
+		attested, err := stub.IsAttested(ctx, "project", "host-1")
+		require.NoError(t, err)
+		assert.True(t, attested)
+
+		immutable, err := stub.IsImmutable(ctx, "project", "host-1")
+		require.NoError(t, err)
+		assert.True(t, immutable)
+
+		resources, err := stub.HostResources(ctx, "project", "host-1")
+		require.NoError(t, err)
+		assert.NotZero(t, resources.CPUCores)
+		assert.NotZero(t, resources.MemoryBytes)
+	})
+
+	t.Run("metadata of the hosts of joined machines without metadata is sent", func(t *testing.T) {
+		dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			core.MachineResourceSchema:      "MachineList",
+			k8s.DockerMachineResourceSchema: "DockerMachineList",
+		},
+			stubMachine(t, "joined", "node-1"),
+			stubDockerMachine("joined", nil),
+			stubMachine(t, "synced", "node-2"),
+			stubDockerMachine("synced", map[string]string{nodemetadata.AnnotationPrefix + "site": "site-1"}),
+			stubMachine(t, "provisioning", ""),
+			stubDockerMachine("provisioning", nil),
+		)
+		stub := inventory.NewStubInventoryClient(k8s.New(dyn))
+
+		hostEvents := make(chan events.Event, 3)
+		require.NoError(t, stub.SyncHosts(ctx, hostEvents))
+		close(hostEvents)
+
+		var updates []*inventory.HostUpdated
+		for event := range hostEvents {
+			update, ok := event.(*inventory.HostUpdated)
+			require.True(t, ok, "unexpected event %T", event)
+			updates = append(updates, update)
+		}
+		require.Len(t, updates, 1)
+		assert.Equal(t, "node-1", updates[0].HostId)
+		assert.Equal(t, "project", updates[0].ProjectId)
+		assert.Equal(t, "edge", updates[0].Labels["team"], "the labels of the machine are kept")
+		for key, value := range inventory.StubHostMetadata("node-1") {
+			assert.Equal(t, value, updates[0].Labels[key])
+		}
+	})
+}
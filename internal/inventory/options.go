@@ -15,6 +15,7 @@ type Options struct {
 	enableTracing    bool
 	enableMetrics    bool
 	k8sClient        k8s.K8sWrapperClient
+	isLeader         func() bool
 }
 
 func (o *Options) WaitGroup() *sync.WaitGroup {
@@ -43,6 +44,7 @@ type OptionsBuilder interface {
 	WithTracing(enableTracing bool) OptionsBuilder
 	WithMetrics(enableMetrics bool) OptionsBuilder
 	WithK8sClient(client k8s.K8sWrapperClient) OptionsBuilder
+	WithLeaderCheck(isLeader func() bool) OptionsBuilder
 	Build() Options
 }
 
@@ -77,6 +79,13 @@ func (b *optionsBuilder) WithK8sClient(client k8s.K8sWrapperClient) OptionsBuild
 	return b
 }
 
+// WithLeaderCheck makes only the leader of the replicas of the cluster manager handle the host events, which all
+// replicas receive
+func (b *optionsBuilder) WithLeaderCheck(isLeader func() bool) OptionsBuilder {
+	b.options.isLeader = isLeader
+	return b
+}
+
 func (b *optionsBuilder) Build() Options {
 	return *b.options
 }
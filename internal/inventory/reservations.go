@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inventoryv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inverrors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// releaseTimeout bounds the release of the hosts of a removed cluster
+const releaseTimeout = 30 * time.Second
+
+// ErrHostReserved is returned when a host is reserved for another cluster
+var ErrHostReserved = errors.New("host is reserved for another cluster")
+
+// HostReleaser releases the hosts reserved for the clusters, see InventoryClient.ReleaseHosts
+type HostReleaser interface {
+	ReleaseHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) error
+}
+
+// metadataItem is an entry of the metadata of a host, see JsonStringToMap
+type metadataItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ReserveHosts reserves the hosts for the cluster by setting their cluster-name metadata in the inventory of the
+// tenant and returns the hosts reserved by the call; hosts already reserved for the cluster stay reserved. If a host is
+// reserved for another cluster the error is ErrHostReserved, and the hosts reserved by the call are released again. The
+// inventory has no conditional updates, so the reservations of the client are serialized to keep concurrent creates
+// from reserving the same host.
+func (c *InventoryClient) ReserveHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) ([]string, error) {
+	c.reservations.Lock()
+	defer c.reservations.Unlock()
+
+	var reserved []string
+	for _, hostUuid := range hostUuids {
+		reservedNow, err := c.reserveHost(ctx, tenantId, clusterName, hostUuid)
+		if err != nil {
+			if releaseErr := c.releaseHosts(ctx, tenantId, clusterName, reserved); releaseErr != nil {
+				slog.Warn("failed to release hosts after failed reservation", "error", releaseErr, "tenantId", tenantId, "cluster", clusterName, "hosts", reserved)
+			}
+			return nil, err
+		}
+		if reservedNow {
+			reserved = append(reserved, hostUuid)
+		}
+	}
+
+	slog.Info("reserved hosts", "tenantId", tenantId, "cluster", clusterName, "hosts", reserved)
+	return reserved, nil
+}
+
+// reserveHost reserves the host for the cluster and returns whether it was reserved by the call, false if it was
+// already reserved for the cluster
+func (c *InventoryClient) reserveHost(ctx context.Context, tenantId, clusterName, hostUuid string) (bool, error) {
+	host, err := c.getHost(ctx, tenantId, hostUuid)
+	if inverrors.IsNotFound(err) {
+		return false, fmt.Errorf("%w: %s", ErrHostNotFound, hostUuid)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	owner, err := hostCluster(host)
+	switch {
+	case err != nil:
+		return false, err
+	case owner == clusterName:
+		return false, nil
+	case owner != "":
+		return false, fmt.Errorf("%w: host %s is reserved for cluster %s", ErrHostReserved, hostUuid, owner)
+	}
+	if err := c.setHostCluster(ctx, tenantId, host, clusterName); err != nil {
+		return false, fmt.Errorf("failed to reserve host %s: %w", hostUuid, err)
+	}
+	return true, nil
+}
+
+// ReleaseHosts releases the hosts reserved for the cluster by removing their cluster-name metadata in the inventory of
+// the tenant; hosts that are not reserved for the cluster, or no longer exist, are left as they are
+func (c *InventoryClient) ReleaseHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) error {
+	c.reservations.Lock()
+	defer c.reservations.Unlock()
+
+	return c.releaseHosts(ctx, tenantId, clusterName, hostUuids)
+}
+
+func (c *InventoryClient) releaseHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) error {
+	var errs []error
+	for _, hostUuid := range hostUuids {
+		host, err := c.getHost(ctx, tenantId, hostUuid)
+		if inverrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get host %s: %w", hostUuid, err))
+			continue
+		}
+		owner, err := hostCluster(host)
+		if err != nil || owner != clusterName {
+			continue
+		}
+		if err := c.setHostCluster(ctx, tenantId, host, ""); err != nil {
+			errs = append(errs, fmt.Errorf("failed to release host %s: %w", hostUuid, err))
+			continue
+		}
+		slog.Info("released host", "tenantId", tenantId, "cluster", clusterName, "hostUuid", hostUuid)
+	}
+	return errors.Join(errs...)
+}
+
+// hostCluster returns the cluster the host is reserved for, empty if it is not reserved
+func hostCluster(host *computev1.HostResource) (string, error) {
+	metadata, err := JsonStringToMap(host.GetMetadata())
+	if err != nil {
+		return "", fmt.Errorf("invalid metadata of host %s: %w", host.GetResourceId(), err)
+	}
+	return metadata[hostMetadataClusterName], nil
+}
+
+// setHostCluster sets the cluster-name metadata of the host to the given cluster, or removes it if the cluster is
+// empty; the other metadata of the host is kept
+func (c *InventoryClient) setHostCluster(ctx context.Context, tenantId string, host *computev1.HostResource, clusterName string) error {
+	items := []metadataItem{}
+	if host.GetMetadata() != "" {
+		if err := json.Unmarshal([]byte(host.GetMetadata()), &items); err != nil {
+			return fmt.Errorf("invalid metadata of host %s: %w", host.GetResourceId(), err)
+		}
+	}
+	items = slices.DeleteFunc(items, func(item metadataItem) bool { return item.Key == hostMetadataClusterName })
+	if clusterName != "" {
+		items = append(items, metadataItem{Key: hostMetadataClusterName, Value: clusterName})
+	}
+	metadata, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultInventoryTimeout)
+	defer cancel()
+
+	_, err = c.client.Update(ctx, tenantId, host.GetResourceId(),
+		&fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldMetadata}},
+		&inventoryv1.Resource{Resource: &inventoryv1.Resource_Host{Host: &computev1.HostResource{
+			ResourceId: host.GetResourceId(),
+			TenantId:   tenantId,
+			Metadata:   string(metadata),
+		}}})
+	return err
+}
+
+// ReleaseOnRemoval returns a cluster handler releasing the hosts reserved for the clusters once they are removed, see
+// k8s.ClusterHandler
+func ReleaseOnRemoval(hosts HostReleaser) k8s.ClusterHandler {
+	return func(old, new *capi.Cluster) {
+		if old == nil || new != nil {
+			return
+		}
+		reserved := cluster.ReservedHosts(old)
+		if len(reserved) == 0 {
+			return
+		}
+
+		// the handlers are notified by the informer, which must not wait for the inventory
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+			defer cancel()
+			if err := hosts.ReleaseHosts(ctx, old.Namespace, old.Name, reserved); err != nil {
+				slog.Warn("failed to release the hosts of removed cluster", "namespace", old.Namespace, "name", old.Name, "hosts", reserved, "error", err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/events"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
+)
+
+// DefaultStubSyncInterval is the default time between two passes of the stub inventory over the machines whose hosts
+// have no metadata yet
+const DefaultStubSyncInterval = 30 * time.Second
+
+// StubInventoryClient replaces the inventory in development environments without it, e.g. kind based ones. Every host
+// exists and has deterministic fake data derived from its id: it is trusted compute compatible, runs an immutable OS
+// with the k3s packages preinstalled like the nodes of kind clusters, and has an asset tag, a site and a rack. The
+// metadata of the hosts is sent as host updates, like the inventory does, once their machines joined a cluster.
+type StubInventoryClient struct {
+	k8sclient *k8s.Client
+	interval  time.Duration
+}
+
+// NewStubInventoryClient creates a new StubInventoryClient setting the metadata of the hosts on the machines of the
+// given client
+func NewStubInventoryClient(k8sClient *k8s.Client) *StubInventoryClient {
+	return &StubInventoryClient{k8sclient: k8sClient, interval: DefaultStubSyncInterval}
+}
+
+// StubHostMetadata returns the fake metadata of the host with the given id
+func StubHostMetadata(hostId string) map[string]string {
+	sum := sha256.Sum256([]byte(hostId))
+	return map[string]string{
+		"asset-tag": fmt.Sprintf("stub-%x", sum[:4]),
+		"site":      fmt.Sprintf("site-%d", sum[4]%4),
+		"rack":      fmt.Sprintf("rack-%d", sum[5]%8),
+	}
+}
+
+// GetHostTrustedCompute returns true, the stub hosts have secure boot and full disk encryption enabled
+func (c *StubInventoryClient) GetHostTrustedCompute(ctx context.Context, tenantId, hostUuid string) (bool, error) {
+	return true, nil
+}
+
+// IsAttested returns true, the stub hosts pass the attestation of their measured boot
+func (c *StubInventoryClient) IsAttested(ctx context.Context, tenantId, hostUuid string) (bool, error) {
+	return true, nil
+}
+
+// IsImmutable returns true, the stub hosts have the k3s packages preinstalled, so k3s clusters are installed in air-gap
+// mode as without the inventory
+func (c *StubInventoryClient) IsImmutable(ctx context.Context, tenantId, hostUuid string) (bool, error) {
+	return true, nil
+}
+
+// HostSite returns the fake site of the host, see StubHostMetadata
+func (c *StubInventoryClient) HostSite(ctx context.Context, tenantId, hostUuid string) (string, error) {
+	return StubHostMetadata(hostUuid)["site"], nil
+}
+
+// HostResources returns the fake resources of the stub hosts, 8 CPU cores and 16 GiB of memory
+func (c *StubInventoryClient) HostResources(ctx context.Context, tenantId, hostUuid string) (HostResources, error) {
+	return HostResources{CPUCores: 8, MemoryBytes: 16 << 30}, nil
+}
+
+// ReserveHosts does nothing, the stub hosts are always available
+func (c *StubInventoryClient) ReserveHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) ([]string, error) {
+	return nil, nil
+}
+
+// ReleaseHosts does nothing, see ReserveHosts
+func (c *StubInventoryClient) ReleaseHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) error {
+	return nil
+}
+
+// WatchHosts sends the updates of the hosts whose machines joined a cluster since the last pass to the given channel
+// until the context is done
+func (c *StubInventoryClient) WatchHosts(ctx context.Context, hostEvents chan<- events.Event) {
+	go func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			if err := c.SyncHosts(ctx, hostEvents); err != nil {
+				slog.Warn("failed to sync stub host metadata", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// SyncHosts sends an update with the fake metadata of each host whose machine joined a cluster but whose provider
+// machine has no host metadata yet; the labels the machine already has are kept
+func (c *StubInventoryClient) SyncHosts(ctx context.Context, hostEvents chan<- events.Event) error {
+	machines, err := c.k8sclient.Dyn.Resource(core.MachineResourceSchema).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	for _, item := range machines.Items {
+		var machine capi.Machine
+		if err := convert.FromUnstructured(item, &machine); err != nil || machine.Status.NodeRef == nil {
+			continue
+		}
+		annotations, err := c.k8sclient.ProviderMachineAnnotations(ctx, machine.Namespace, machine.Spec.InfrastructureRef.Kind, machine.Spec.InfrastructureRef.Name)
+		if err != nil || len(nodemetadata.FromAnnotations(annotations)) > 0 {
+			continue
+		}
+
+		hostId := machine.Status.NodeRef.Name
+		slog.Debug("sending stub host metadata", "projectID", machine.Namespace, "hostID", hostId, "machine name", machine.Name)
+		event := &HostUpdated{
+			HostEventBase: HostEventBase{HostId: hostId, ProjectId: machine.Namespace},
+			Labels:        labels.Merge(labels.UserLabels(machine.Labels), StubHostMetadata(hostId)),
+			K8scli:        c.k8sclient,
+		}
+		select {
+		case hostEvents <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+)
+
+// CreateBackup creates a backup of the cluster with the given name in the given namespace, the template-controller
+// takes the etcd snapshot of the cluster for it
+func (c *Client) CreateBackup(ctx context.Context, namespace, clusterName string) (v1alpha1.Backup, error) {
+	backup := v1alpha1.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.GroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: clusterName + "-",
+			Namespace:    namespace,
+		},
+		Spec: v1alpha1.BackupSpec{ClusterName: clusterName},
+	}
+
+	unstructuredBackup, err := convert.ToUnstructured(backup)
+	if err != nil {
+		return backup, err
+	}
+
+	created, err := c.Dyn.Resource(backupResourceSchema).Namespace(namespace).Create(ctx, unstructuredBackup, metav1.CreateOptions{})
+	if err != nil {
+		return backup, err
+	}
+
+	err = convert.FromUnstructured(*created, &backup)
+	return backup, err
+}
+
+// Backups returns the backups of the cluster with the given name in the given namespace, newest first
+func (c *Client) Backups(ctx context.Context, namespace, clusterName string) ([]v1alpha1.Backup, error) {
+	var backups []v1alpha1.Backup
+
+	unstructuredBackupList, err := c.Dyn.Resource(backupResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return backups, err
+	}
+
+	for _, item := range unstructuredBackupList.Items {
+		var backup v1alpha1.Backup
+		if err = convert.FromUnstructured(item, &backup); err != nil {
+			return backups, err
+		}
+		if backup.Spec.ClusterName == clusterName {
+			backups = append(backups, backup)
+		}
+	}
+
+	slices.SortFunc(backups, func(a, b v1alpha1.Backup) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	return backups, nil
+}
+
+// GetBackup returns the backup with the given name in the given namespace
+func (c *Client) GetBackup(ctx context.Context, namespace, name string) (v1alpha1.Backup, error) {
+	var backup v1alpha1.Backup
+
+	unstructuredBackup, err := c.Dyn.Resource(backupResourceSchema).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return backup, ErrBackupNotFound
+		}
+		return backup, err
+	}
+
+	err = convert.FromUnstructured(*unstructuredBackup, &backup)
+	return backup, err
+}
+
+// RequestRestore requests the restore of the cluster of the backup with the given name in the given namespace, the
+// requestedAt value distinguishes the request from earlier restores of the same backup
+func (c *Client) RequestRestore(ctx context.Context, namespace, name, requestedAt string) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{v1alpha1.BackupRestoreAnnotation: requestedAt},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Dyn.Resource(backupResourceSchema).Namespace(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if errors.IsNotFound(err) {
+		return ErrBackupNotFound
+	}
+	return err
+}
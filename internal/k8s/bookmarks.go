@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+// bookmarkSaveTimeout is how long the bookmarks are saved for on shutdown
+const bookmarkSaveTimeout = 5 * time.Second
+
+// errInitialEventsNotEnded is returned by the initial lists resumed from a bookmark whose watch was closed before the
+// API server sent all objects
+var errInitialEventsNotEnded = errors.New("watch closed before the initial events ended")
+
+// WatchBookmarks keeps the resource versions the informers last observed, their watch bookmarks, per resource in a
+// ConfigMap so that the informers of a restarted instance resume from them. Without bookmarks, the initial list of an
+// informer is a list of all objects at any resource version; with them, it is a watch from the bookmark that streams
+// the objects from the watch cache of the API server at a resource version not older than the bookmark, so restarts
+// neither hit etcd nor buffer full lists in the API server nor go back in time. The informer lists all objects again
+// only if the API server answers the watch with 410 Gone, e.g. because the bookmark is from another etcd; the watch is
+// retried from the bookmark after any other error.
+type WatchBookmarks struct {
+	dyn       dynamic.Interface
+	namespace string
+	name      string
+
+	mu sync.Mutex
+	// versions are the persisted resource versions by resource the initial lists have not resumed from yet
+	versions  map[string]string
+	informers map[schema.GroupVersionResource]cache.SharedIndexInformer
+}
+
+// NewWatchBookmarks creates new WatchBookmarks persisted in the ConfigMap of the given namespace and name with the
+// given dynamic client
+func NewWatchBookmarks(dyn dynamic.Interface, namespace, name string) *WatchBookmarks {
+	return &WatchBookmarks{
+		dyn:       dyn,
+		namespace: namespace,
+		name:      name,
+		versions:  map[string]string{},
+		informers: map[schema.GroupVersionResource]cache.SharedIndexInformer{},
+	}
+}
+
+// Load reads the persisted bookmarks; there are none if the ConfigMap does not exist yet
+func (b *WatchBookmarks) Load(ctx context.Context) error {
+	configMap, err := b.dyn.Resource(core.ConfigMapResourceSchema).Namespace(b.namespace).Get(ctx, b.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get watch bookmarks configmap %s/%s: %w", b.namespace, b.name, err)
+	}
+	versions, _, err := unstructured.NestedStringMap(configMap.Object, "data")
+	if err != nil {
+		return fmt.Errorf("invalid watch bookmarks configmap %s/%s: %w", b.namespace, b.name, err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.versions = versions
+	slog.Info("watch bookmarks loaded", "configmap", b.namespace+"/"+b.name, "resources", len(versions))
+	return nil
+}
+
+// Client returns the given dynamic client whose first list of each resource in all namespaces resumes from the
+// bookmark of the resource; it is meant for the informer factories only
+func (b *WatchBookmarks) Client(dyn dynamic.Interface) dynamic.Interface {
+	return &bookmarkClient{Interface: dyn, bookmarks: b}
+}
+
+// Track adds the informer of the given resource to the informers whose bookmarks are saved
+func (b *WatchBookmarks) Track(gvr schema.GroupVersionResource, informer cache.SharedIndexInformer) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.informers[gvr] = informer
+}
+
+// Run saves the bookmarks of the tracked informers at the given interval until the context is canceled, and once more
+// afterward so that a graceful restart resumes from the latest ones
+func (b *WatchBookmarks) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), bookmarkSaveTimeout)
+			err := b.Save(saveCtx)
+			cancel()
+			if err != nil {
+				slog.Warn("failed to save watch bookmarks on shutdown", "error", err)
+			}
+			return
+		case <-ticker.C:
+			if err := b.Save(ctx); err != nil {
+				slog.Warn("failed to save watch bookmarks", "error", err)
+			}
+		}
+	}
+}
+
+// Save writes the resource versions the tracked informers last synced to to the ConfigMap, creating it if needed;
+// informers that did not sync yet keep their previous bookmark
+func (b *WatchBookmarks) Save(ctx context.Context) error {
+	data := map[string]any{}
+	b.mu.Lock()
+	for resource, version := range b.versions {
+		data[resource] = version
+	}
+	for gvr, informer := range b.informers {
+		if version := informer.LastSyncResourceVersion(); version != "" {
+			data[gvr.GroupResource().String()] = version
+		}
+	}
+	b.mu.Unlock()
+	if len(data) == 0 {
+		return nil
+	}
+
+	// the ConfigMap is written by the previous leader until it shuts down, so the write is retried with the latest
+	// version of it if another instance created or updated it meanwhile
+	configMaps := b.dyn.Resource(core.ConfigMapResourceSchema).Namespace(b.namespace)
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		configMap, err := configMaps.Get(ctx, b.name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			configMap = &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"namespace": b.namespace, "name": b.name},
+				"data":       data,
+			}}
+			_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		configMap.Object["data"] = data
+		_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// take returns the bookmark of the resource if its initial list did not resume from it yet
+func (b *WatchBookmarks) take(gvr schema.GroupVersionResource) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	resource := gvr.GroupResource().String()
+	version := b.versions[resource]
+	delete(b.versions, resource)
+	return version
+}
+
+// restore returns the bookmark of the resource taken by an initial list that failed, so that the informer resumes
+// from it when it retries the list
+func (b *WatchBookmarks) restore(gvr schema.GroupVersionResource, version string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.versions[gvr.GroupResource().String()] = version
+}
+
+// bookmarkClient is a dynamic client whose lists of all namespaces resume from the bookmarks
+type bookmarkClient struct {
+	dynamic.Interface
+	bookmarks *WatchBookmarks
+}
+
+func (c *bookmarkClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &bookmarkNamespaceableResource{NamespaceableResourceInterface: c.Interface.Resource(gvr), gvr: gvr, bookmarks: c.bookmarks}
+}
+
+type bookmarkNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	gvr       schema.GroupVersionResource
+	bookmarks *WatchBookmarks
+}
+
+// Namespace returns the client of the resource in the namespace; only the lists of all namespaces the informers of
+// the factories issue resume from the bookmarks
+func (c *bookmarkNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	client := c.NamespaceableResourceInterface.Namespace(namespace)
+	if namespace != metav1.NamespaceAll {
+		return client
+	}
+	return &bookmarkResource{ResourceInterface: client, gvr: c.gvr, bookmarks: c.bookmarks}
+}
+
+type bookmarkResource struct {
+	dynamic.ResourceInterface
+	gvr       schema.GroupVersionResource
+	bookmarks *WatchBookmarks
+}
+
+// List resumes the watch of the resource from its bookmark if it is the initial list of an informer, which accepts
+// any resource version, and lists with the given options otherwise or if the API server answers with 410 Gone
+func (c *bookmarkResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if opts.ResourceVersion != "" && opts.ResourceVersion != "0" {
+		return c.ResourceInterface.List(ctx, opts)
+	}
+	version := c.bookmarks.take(c.gvr)
+	if version == "" {
+		return c.ResourceInterface.List(ctx, opts)
+	}
+
+	list, err := c.resume(ctx, opts, version)
+	if apierrors.IsGone(err) || apierrors.IsResourceExpired(err) {
+		logger.FromContext(ctx).Warn("watch bookmark expired, relisting", "resource", c.gvr.Resource, "resourceVersion", version, "error", err)
+		return c.ResourceInterface.List(ctx, opts)
+	}
+	if err != nil {
+		c.bookmarks.restore(c.gvr, version)
+		return nil, fmt.Errorf("failed to resume watch of %s from bookmark %s: %w", c.gvr.Resource, version, err)
+	}
+	logger.FromContext(ctx).Info("watch resumed from bookmark", "resource", c.gvr.Resource, "bookmark", version, "resourceVersion", list.GetResourceVersion(), "objects", len(list.Items))
+	return list, nil
+}
+
+// resume watches the resource from the given bookmark and returns the objects the API server sends as initial events
+// as a list at the resource version of the bookmark event that ends them; the informer watches from it afterward
+func (c *bookmarkResource) resume(ctx context.Context, opts metav1.ListOptions, version string) (*unstructured.UnstructuredList, error) {
+	sendInitialEvents := true
+	watcher, err := c.ResourceInterface.Watch(ctx, metav1.ListOptions{
+		LabelSelector:        opts.LabelSelector,
+		FieldSelector:        opts.FieldSelector,
+		ResourceVersion:      version,
+		ResourceVersionMatch: metav1.ResourceVersionMatchNotOlderThan,
+		SendInitialEvents:    &sendInitialEvents,
+		AllowWatchBookmarks:  true,
+		TimeoutSeconds:       opts.TimeoutSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	objects := map[string]unstructured.Unstructured{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, errInitialEventsNotEnded
+			}
+			if event.Type == watch.Error {
+				return nil, apierrors.FromObject(event.Object)
+			}
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object %T in watch of %s", event.Object, c.gvr.Resource)
+			}
+			key := obj.GetNamespace() + "/" + obj.GetName()
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				objects[key] = *obj
+			case watch.Deleted:
+				delete(objects, key)
+			case watch.Bookmark:
+				if obj.GetAnnotations()[metav1.InitialEventsAnnotationKey] != "true" {
+					continue
+				}
+				list := &unstructured.UnstructuredList{Items: make([]unstructured.Unstructured, 0, len(objects))}
+				for _, object := range objects {
+					list.Items = append(list.Items, object)
+				}
+				list.SetResourceVersion(obj.GetResourceVersion())
+				return list, nil
+			}
+		}
+	}
+}
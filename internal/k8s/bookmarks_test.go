@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+const bookmarksNamespace = "cluster-manager"
+
+// watchResource serves the watches of a resource with the given events or error and counts the lists
+type watchResource struct {
+	dynamic.ResourceInterface
+	events   []watch.Event
+	watchErr error
+
+	lists       int
+	watchOpts   []metav1.ListOptions
+	listVersion string
+}
+
+func (r *watchResource) List(_ context.Context, _ metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	r.lists++
+	list := &unstructured.UnstructuredList{}
+	list.SetResourceVersion(r.listVersion)
+	return list, nil
+}
+
+func (r *watchResource) Watch(_ context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	r.watchOpts = append(r.watchOpts, opts)
+	if r.watchErr != nil {
+		return nil, r.watchErr
+	}
+	watcher := watch.NewFakeWithChanSize(len(r.events), false)
+	for _, event := range r.events {
+		watcher.Action(event.Type, event.Object)
+	}
+	watcher.Stop()
+	return watcher, nil
+}
+
+func bookmarkedCluster(name, version string) *unstructured.Unstructured {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetNamespace(bookmarksNamespace)
+	cluster.SetName(name)
+	cluster.SetResourceVersion(version)
+	return cluster
+}
+
+func initialEventsEnd(version string) *unstructured.Unstructured {
+	bookmark := &unstructured.Unstructured{}
+	bookmark.SetResourceVersion(version)
+	bookmark.SetAnnotations(map[string]string{metav1.InitialEventsAnnotationKey: "true"})
+	return bookmark
+}
+
+func bookmarkedResource(resource *watchResource, version string) (*bookmarkResource, *WatchBookmarks) {
+	bookmarks := NewWatchBookmarks(nil, bookmarksNamespace, "watch-bookmarks")
+	if version != "" {
+		bookmarks.versions[clusterResourceSchema.GroupResource().String()] = version
+	}
+	return &bookmarkResource{ResourceInterface: resource, gvr: clusterResourceSchema, bookmarks: bookmarks}, bookmarks
+}
+
+func TestBookmarkResourceList(t *testing.T) {
+	t.Run("initial list resumes the watch from the bookmark", func(t *testing.T) {
+		resource := &watchResource{events: []watch.Event{
+			{Type: watch.Added, Object: bookmarkedCluster("a", "10")},
+			{Type: watch.Added, Object: bookmarkedCluster("b", "11")},
+			{Type: watch.Modified, Object: bookmarkedCluster("a", "12")},
+			{Type: watch.Added, Object: bookmarkedCluster("c", "13")},
+			{Type: watch.Deleted, Object: bookmarkedCluster("c", "14")},
+			{Type: watch.Bookmark, Object: bookmarkedCluster("", "14")},
+			{Type: watch.Bookmark, Object: initialEventsEnd("15")},
+		}}
+		client, bookmarks := bookmarkedResource(resource, "9")
+
+		list, err := client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0", LabelSelector: "a=b", Limit: 500})
+		require.NoError(t, err)
+		require.Zero(t, resource.lists)
+		require.Equal(t, "15", list.GetResourceVersion())
+		versions := map[string]string{}
+		for _, item := range list.Items {
+			versions[item.GetName()] = item.GetResourceVersion()
+		}
+		require.Equal(t, map[string]string{"a": "12", "b": "11"}, versions)
+
+		require.Len(t, resource.watchOpts, 1)
+		opts := resource.watchOpts[0]
+		require.Equal(t, "9", opts.ResourceVersion)
+		require.Equal(t, metav1.ResourceVersionMatchNotOlderThan, opts.ResourceVersionMatch)
+		require.NotNil(t, opts.SendInitialEvents)
+		require.True(t, *opts.SendInitialEvents)
+		require.True(t, opts.AllowWatchBookmarks)
+		require.Equal(t, "a=b", opts.LabelSelector)
+
+		// the bookmark is used by the initial list only
+		require.Empty(t, bookmarks.versions)
+		_, err = client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0"})
+		require.NoError(t, err)
+		require.Equal(t, 1, resource.lists)
+	})
+
+	t.Run("lists without bookmark or at a resource version are not resumed", func(t *testing.T) {
+		resource := &watchResource{listVersion: "20"}
+		client, bookmarks := bookmarkedResource(resource, "")
+		list, err := client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0"})
+		require.NoError(t, err)
+		require.Equal(t, "20", list.GetResourceVersion())
+
+		bookmarks.versions[clusterResourceSchema.GroupResource().String()] = "9"
+		_, err = client.List(context.Background(), metav1.ListOptions{ResourceVersion: "18"})
+		require.NoError(t, err)
+		require.Equal(t, 2, resource.lists)
+		require.Empty(t, resource.watchOpts)
+	})
+
+	t.Run("relists if the bookmark is gone", func(t *testing.T) {
+		resource := &watchResource{watchErr: apierrors.NewGone("too old resource version"), listVersion: "20"}
+		client, bookmarks := bookmarkedResource(resource, "9")
+		list, err := client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0"})
+		require.NoError(t, err)
+		require.Equal(t, "20", list.GetResourceVersion())
+		require.Equal(t, 1, resource.lists)
+		require.Empty(t, bookmarks.versions)
+	})
+
+	t.Run("relists if the watch expires the bookmark", func(t *testing.T) {
+		expired := apierrors.NewResourceExpired("too old resource version")
+		resource := &watchResource{events: []watch.Event{{Type: watch.Error, Object: &expired.ErrStatus}}, listVersion: "20"}
+		client, _ := bookmarkedResource(resource, "9")
+		list, err := client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0"})
+		require.NoError(t, err)
+		require.Equal(t, "20", list.GetResourceVersion())
+		require.Equal(t, 1, resource.lists)
+	})
+
+	t.Run("other failures keep the bookmark for the retry", func(t *testing.T) {
+		resource := &watchResource{watchErr: apierrors.NewServiceUnavailable("etcd leader changed")}
+		client, _ := bookmarkedResource(resource, "9")
+		_, err := client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0"})
+		require.True(t, apierrors.IsServiceUnavailable(err), err)
+
+		resource.watchErr = nil
+		_, err = client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0"})
+		require.ErrorIs(t, err, errInitialEventsNotEnded)
+
+		resource.events = []watch.Event{{Type: watch.Bookmark, Object: initialEventsEnd("15")}}
+		list, err := client.List(context.Background(), metav1.ListOptions{ResourceVersion: "0"})
+		require.NoError(t, err)
+		require.Equal(t, "15", list.GetResourceVersion())
+		require.Len(t, resource.watchOpts, 3)
+		for _, opts := range resource.watchOpts {
+			require.Equal(t, "9", opts.ResourceVersion)
+		}
+		require.Zero(t, resource.lists)
+	})
+}
+
+func TestWatchBookmarksSave(t *testing.T) {
+	getData := func(t *testing.T, dyn dynamic.Interface) map[string]string {
+		configMap, err := dyn.Resource(core.ConfigMapResourceSchema).Namespace(bookmarksNamespace).Get(context.Background(), "watch-bookmarks", metav1.GetOptions{})
+		require.NoError(t, err)
+		data, _, err := unstructured.NestedStringMap(configMap.Object, "data")
+		require.NoError(t, err)
+		return data
+	}
+
+	t.Run("bookmarks are saved and loaded", func(t *testing.T) {
+		dyn := New().WithFakeClient().Dyn
+		bookmarks := NewWatchBookmarks(dyn, bookmarksNamespace, "watch-bookmarks")
+		require.NoError(t, bookmarks.Load(context.Background()))
+		require.NoError(t, bookmarks.Save(context.Background()))
+		_, err := dyn.Resource(core.ConfigMapResourceSchema).Namespace(bookmarksNamespace).Get(context.Background(), "watch-bookmarks", metav1.GetOptions{})
+		require.True(t, apierrors.IsNotFound(err), "no configmap is created without bookmarks")
+
+		bookmarks.versions["clusters.cluster.x-k8s.io"] = "10"
+		require.NoError(t, bookmarks.Save(context.Background()))
+		bookmarks.versions["clusters.cluster.x-k8s.io"] = "11"
+		require.NoError(t, bookmarks.Save(context.Background()))
+		require.Equal(t, map[string]string{"clusters.cluster.x-k8s.io": "11"}, getData(t, dyn))
+
+		loaded := NewWatchBookmarks(dyn, bookmarksNamespace, "watch-bookmarks")
+		require.NoError(t, loaded.Load(context.Background()))
+		require.Equal(t, "11", loaded.take(clusterResourceSchema))
+	})
+
+	t.Run("conflicting updates are retried", func(t *testing.T) {
+		dyn := New().WithFakeClient().Dyn
+		bookmarks := NewWatchBookmarks(dyn, bookmarksNamespace, "watch-bookmarks")
+		bookmarks.versions["clusters.cluster.x-k8s.io"] = "10"
+		require.NoError(t, bookmarks.Save(context.Background()))
+
+		conflicts := 0
+		dyn.(*fake.FakeDynamicClient).PrependReactor("update", "configmaps", func(k8stesting.Action) (bool, runtime.Object, error) {
+			if conflicts < 2 {
+				conflicts++
+				return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "watch-bookmarks", errors.New("modified"))
+			}
+			return false, nil, nil
+		})
+		bookmarks.versions["clusters.cluster.x-k8s.io"] = "12"
+		require.NoError(t, bookmarks.Save(context.Background()))
+		require.Equal(t, 2, conflicts)
+		require.Equal(t, map[string]string{"clusters.cluster.x-k8s.io": "12"}, getData(t, dyn))
+	})
+}
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+// CallSource is where the Kubernetes calls of a request are served from
+type CallSource string
+
+const (
+	// APIServerCalls are the calls sent to the Kubernetes API server, including the reads the read cache falls back on
+	APIServerCalls CallSource = "api"
+	// CacheCalls are the reads served by the read cache
+	CacheCalls CallSource = "cache"
+)
+
+// CallCount is the number of calls from a source and their cumulative latency
+type CallCount struct {
+	Calls    int
+	Duration time.Duration
+}
+
+// CallStats counts the Kubernetes calls triggered by a request, so that handlers issuing a call per item of a list
+// stand out; it is safe for concurrent use by the goroutines of the request
+type CallStats struct {
+	mu     sync.Mutex
+	counts map[CallSource]CallCount
+}
+
+type callStatsKey struct{}
+
+// WithCallStats returns a context counting the Kubernetes calls made with it in the returned CallStats
+func WithCallStats(ctx context.Context) (context.Context, *CallStats) {
+	stats := &CallStats{counts: map[CallSource]CallCount{}}
+	return context.WithValue(ctx, callStatsKey{}, stats), stats
+}
+
+// Count returns the number of calls from the source and their cumulative latency
+func (s *CallStats) Count(source CallSource) CallCount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counts[source]
+}
+
+// recordCall adds the call started at the given time to the CallStats of the context, if any
+func recordCall(ctx context.Context, source CallSource, verb string, gvr schema.GroupVersionResource, namespace, name string, start time.Time) {
+	stats, ok := ctx.Value(callStatsKey{}).(*CallStats)
+	if !ok {
+		return
+	}
+	duration := time.Since(start)
+
+	stats.mu.Lock()
+	count := stats.counts[source]
+	count.Calls++
+	count.Duration += duration
+	stats.counts[source] = count
+	stats.mu.Unlock()
+
+	logger.FromContext(ctx).Debug("kubernetes call", "source", source, "verb", verb, "resource", gvr.Resource, "namespace", namespace, "name", name, "duration", duration)
+}
+
+// CountingClient is a dynamic client counting its calls in the CallStats of their contexts as APIServerCalls
+type CountingClient struct {
+	dynamic.Interface
+}
+
+// NewCountingClient creates a new CountingClient sending the calls to the given dynamic client
+func NewCountingClient(dyn dynamic.Interface) *CountingClient {
+	return &CountingClient{Interface: dyn}
+}
+
+// Resource returns the client of the given resource, counting its calls
+func (c *CountingClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	client := c.Interface.Resource(gvr)
+	return &countingNamespaceableResource{
+		NamespaceableResourceInterface: client,
+		countingResource:               countingResource{ResourceInterface: client, gvr: gvr},
+	}
+}
+
+type countingNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	countingResource
+}
+
+func (c *countingNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &countingResource{ResourceInterface: c.NamespaceableResourceInterface.Namespace(namespace), gvr: c.gvr, namespace: namespace}
+}
+
+// the methods of the embedded countingResource are ambiguous with those of the NamespaceableResourceInterface, so
+// they are promoted explicitly
+
+func (c *countingNamespaceableResource) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.countingResource.Create(ctx, obj, options, subresources...)
+}
+
+func (c *countingNamespaceableResource) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.countingResource.Update(ctx, obj, options, subresources...)
+}
+
+func (c *countingNamespaceableResource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return c.countingResource.UpdateStatus(ctx, obj, options)
+}
+
+func (c *countingNamespaceableResource) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	return c.countingResource.Delete(ctx, name, options, subresources...)
+}
+
+func (c *countingNamespaceableResource) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	return c.countingResource.DeleteCollection(ctx, options, listOptions)
+}
+
+func (c *countingNamespaceableResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.countingResource.Get(ctx, name, options, subresources...)
+}
+
+func (c *countingNamespaceableResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return c.countingResource.List(ctx, opts)
+}
+
+func (c *countingNamespaceableResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.countingResource.Watch(ctx, opts)
+}
+
+func (c *countingNamespaceableResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.countingResource.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func (c *countingNamespaceableResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.countingResource.Apply(ctx, name, obj, options, subresources...)
+}
+
+func (c *countingNamespaceableResource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	return c.countingResource.ApplyStatus(ctx, name, obj, options)
+}
+
+// countingResource counts the calls of a resource in a namespace, or of a cluster-scoped resource
+type countingResource struct {
+	dynamic.ResourceInterface
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+func (c *countingResource) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	defer recordCall(ctx, APIServerCalls, "create", c.gvr, c.namespace, obj.GetName(), time.Now())
+	return c.ResourceInterface.Create(ctx, obj, options, subresources...)
+}
+
+func (c *countingResource) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	defer recordCall(ctx, APIServerCalls, "update", c.gvr, c.namespace, obj.GetName(), time.Now())
+	return c.ResourceInterface.Update(ctx, obj, options, subresources...)
+}
+
+func (c *countingResource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	defer recordCall(ctx, APIServerCalls, "update", c.gvr, c.namespace, obj.GetName(), time.Now())
+	return c.ResourceInterface.UpdateStatus(ctx, obj, options)
+}
+
+func (c *countingResource) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	defer recordCall(ctx, APIServerCalls, "delete", c.gvr, c.namespace, name, time.Now())
+	return c.ResourceInterface.Delete(ctx, name, options, subresources...)
+}
+
+func (c *countingResource) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	defer recordCall(ctx, APIServerCalls, "deletecollection", c.gvr, c.namespace, "", time.Now())
+	return c.ResourceInterface.DeleteCollection(ctx, options, listOptions)
+}
+
+func (c *countingResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	defer recordCall(ctx, APIServerCalls, "get", c.gvr, c.namespace, name, time.Now())
+	return c.ResourceInterface.Get(ctx, name, options, subresources...)
+}
+
+func (c *countingResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	defer recordCall(ctx, APIServerCalls, "list", c.gvr, c.namespace, "", time.Now())
+	return c.ResourceInterface.List(ctx, opts)
+}
+
+func (c *countingResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	defer recordCall(ctx, APIServerCalls, "watch", c.gvr, c.namespace, "", time.Now())
+	return c.ResourceInterface.Watch(ctx, opts)
+}
+
+func (c *countingResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	defer recordCall(ctx, APIServerCalls, "patch", c.gvr, c.namespace, name, time.Now())
+	return c.ResourceInterface.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func (c *countingResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	defer recordCall(ctx, APIServerCalls, "apply", c.gvr, c.namespace, name, time.Now())
+	return c.ResourceInterface.Apply(ctx, name, obj, options, subresources...)
+}
+
+func (c *countingResource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	defer recordCall(ctx, APIServerCalls, "apply", c.gvr, c.namespace, name, time.Now())
+	return c.ResourceInterface.ApplyStatus(ctx, name, obj, options)
+}
@@ -0,0 +1,357 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	capiv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+// apiVersionTimeout bounds the lookup of the api version of a kind referenced by a Cluster API object
+const apiVersionTimeout = 10 * time.Second
+
+var (
+	// capiResources are the Cluster API resources served in v1beta2 by a V1Beta2Client
+	capiResources = map[string]bool{
+		"clusters":           true,
+		"clusterclasses":     true,
+		"machines":           true,
+		"machinedeployments": true,
+		"machinesets":        true,
+	}
+
+	crdResourceSchema = schema.GroupVersionResource{
+		Group:    "apiextensions.k8s.io",
+		Version:  "v1",
+		Resource: "customresourcedefinitions",
+	}
+)
+
+// V1Beta2Client is a dynamic client serving the v1beta1 Cluster API resources from the v1beta2 resources of management
+// clusters with newer Cluster API versions: the objects are converted to v1beta2 before they are written and back to
+// v1beta1 once they are read, with their conditions normalized, see convert.ToV1Beta1. Merge and JSON patches of fields
+// other than the metadata are applied to the v1beta1 objects, which are updated. The other resources are passed
+// through.
+type V1Beta2Client struct {
+	dynamic.Interface
+	// apiVersions caches the api versions of the kinds referenced by the Cluster API objects
+	apiVersions sync.Map
+}
+
+// NewV1Beta2Client creates a new V1Beta2Client sending the calls to the given dynamic client. The references of v1beta2
+// objects have no api version, Cluster API looks them up with the getter of the client.
+func NewV1Beta2Client(dyn dynamic.Interface) *V1Beta2Client {
+	c := &V1Beta2Client{Interface: dyn}
+	capi.SetAPIVersionGetter(c.apiVersion)
+	return c
+}
+
+// Resource returns the client of the given resource, converting the objects of the v1beta1 Cluster API resources
+func (c *V1Beta2Client) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	if gvr.GroupVersion() != capi.GroupVersion || !capiResources[gvr.Resource] {
+		return c.Interface.Resource(gvr)
+	}
+	gvr.Version = capiv1beta2.GroupVersion.Version
+	client := c.Interface.Resource(gvr)
+	return &v1beta2NamespaceableResource{
+		NamespaceableResourceInterface: client,
+		v1beta2Resource:                v1beta2Resource{ResourceInterface: client},
+	}
+}
+
+// apiVersion returns the latest api version of the given kind listed in the Cluster API contract labels of its CRD, or
+// the storage version of the CRD if it has no contract labels
+func (c *V1Beta2Client) apiVersion(gk schema.GroupKind) (string, error) {
+	if version, ok := c.apiVersions.Load(gk); ok {
+		return version.(string), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiVersionTimeout)
+	defer cancel()
+	// the CRDs of the providers are named after the plural of their kinds
+	name := strings.ToLower(gk.Kind) + "s." + gk.Group
+	crd, err := c.Interface.Resource(crdResourceSchema).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get crd %s: %w", name, err)
+	}
+
+	version := ""
+	for _, contract := range []string{capiv1beta2.GroupVersion.String(), capi.GroupVersion.String()} {
+		if versions := crd.GetLabels()[contract]; versions != "" {
+			supported := strings.Split(versions, "_")
+			version = supported[len(supported)-1]
+			break
+		}
+	}
+	if version == "" {
+		versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		for _, v := range versions {
+			if v, ok := v.(map[string]any); ok && v["storage"] == true {
+				version, _ = v["name"].(string)
+			}
+		}
+	}
+	if version == "" {
+		return "", fmt.Errorf("crd %s has no contract labels nor storage version", name)
+	}
+
+	apiVersion := schema.GroupVersion{Group: gk.Group, Version: version}.String()
+	c.apiVersions.Store(gk, apiVersion)
+	return apiVersion, nil
+}
+
+type v1beta2NamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	v1beta2Resource
+}
+
+func (c *v1beta2NamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &v1beta2Resource{ResourceInterface: c.NamespaceableResourceInterface.Namespace(namespace)}
+}
+
+// the methods of the embedded v1beta2Resource are ambiguous with those of the NamespaceableResourceInterface, so they
+// are promoted explicitly
+
+func (c *v1beta2NamespaceableResource) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.v1beta2Resource.Create(ctx, obj, options, subresources...)
+}
+
+func (c *v1beta2NamespaceableResource) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.v1beta2Resource.Update(ctx, obj, options, subresources...)
+}
+
+func (c *v1beta2NamespaceableResource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return c.v1beta2Resource.UpdateStatus(ctx, obj, options)
+}
+
+func (c *v1beta2NamespaceableResource) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	return c.v1beta2Resource.Delete(ctx, name, options, subresources...)
+}
+
+func (c *v1beta2NamespaceableResource) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	return c.v1beta2Resource.DeleteCollection(ctx, options, listOptions)
+}
+
+func (c *v1beta2NamespaceableResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.v1beta2Resource.Get(ctx, name, options, subresources...)
+}
+
+func (c *v1beta2NamespaceableResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return c.v1beta2Resource.List(ctx, opts)
+}
+
+func (c *v1beta2NamespaceableResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.v1beta2Resource.Watch(ctx, opts)
+}
+
+func (c *v1beta2NamespaceableResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.v1beta2Resource.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func (c *v1beta2NamespaceableResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.v1beta2Resource.Apply(ctx, name, obj, options, subresources...)
+}
+
+func (c *v1beta2NamespaceableResource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	return c.v1beta2Resource.ApplyStatus(ctx, name, obj, options)
+}
+
+// v1beta2Resource converts the objects of a v1beta2 Cluster API resource in a namespace, or of all namespaces
+type v1beta2Resource struct {
+	dynamic.ResourceInterface
+}
+
+func (c *v1beta2Resource) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	converted, err := convert.ToV1Beta2(obj)
+	if err != nil {
+		return nil, err
+	}
+	return toV1Beta1(c.ResourceInterface.Create(ctx, converted, options, subresources...))
+}
+
+func (c *v1beta2Resource) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	converted, err := convert.ToV1Beta2(obj)
+	if err != nil {
+		return nil, err
+	}
+	return toV1Beta1(c.ResourceInterface.Update(ctx, converted, options, subresources...))
+}
+
+func (c *v1beta2Resource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	converted, err := convert.ToV1Beta2(obj)
+	if err != nil {
+		return nil, err
+	}
+	return toV1Beta1(c.ResourceInterface.UpdateStatus(ctx, converted, options))
+}
+
+func (c *v1beta2Resource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return toV1Beta1(c.ResourceInterface.Get(ctx, name, options, subresources...))
+}
+
+func (c *v1beta2Resource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := c.ResourceInterface.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		converted, err := convert.ToV1Beta1(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		list.Items[i] = *converted
+	}
+	list.SetAPIVersion(capi.GroupVersion.String())
+	return list, nil
+}
+
+// Watch converts the objects of the events; the events of objects that cannot be converted are dropped
+func (c *v1beta2Resource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := c.ResourceInterface.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return event, true
+		}
+		if event.Type == watch.Bookmark {
+			obj.SetAPIVersion(capi.GroupVersion.String())
+			return event, true
+		}
+		converted, err := convert.ToV1Beta1(obj)
+		if err != nil {
+			logger.FromContext(ctx).Warn("dropped watch event of object that cannot be converted", "type", event.Type, "kind", obj.GetKind(), "namespace", obj.GetNamespace(), "name", obj.GetName(), "error", err)
+			return event, false
+		}
+		event.Object = converted
+		return event, true
+	}), nil
+}
+
+// Patch sends patches of the metadata as they are, the other patches are applied to the v1beta1 object, whose fields
+// they patch, which is converted and updated
+func (c *v1beta2Resource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if metadataPatch(pt, data) {
+		return toV1Beta1(c.ResourceInterface.Patch(ctx, name, pt, data, options, subresources...))
+	}
+
+	var result *unstructured.Unstructured
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.Get(ctx, name, metav1.GetOptions{}, subresources...)
+		if err != nil {
+			return err
+		}
+		patched, err := applyPatch(current, pt, data)
+		if err != nil {
+			return err
+		}
+		result, err = c.Update(ctx, patched, metav1.UpdateOptions{DryRun: options.DryRun, FieldManager: options.FieldManager}, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *v1beta2Resource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	converted, err := convert.ToV1Beta2(obj)
+	if err != nil {
+		return nil, err
+	}
+	return toV1Beta1(c.ResourceInterface.Apply(ctx, name, converted, options, subresources...))
+}
+
+func (c *v1beta2Resource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	converted, err := convert.ToV1Beta2(obj)
+	if err != nil {
+		return nil, err
+	}
+	return toV1Beta1(c.ResourceInterface.ApplyStatus(ctx, name, converted, options))
+}
+
+// toV1Beta1 converts the object returned by a call, unless the call failed
+func toV1Beta1(obj *unstructured.Unstructured, err error) (*unstructured.Unstructured, error) {
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToV1Beta1(obj)
+}
+
+// metadataPatch returns whether the merge or JSON patch only patches the metadata, which is the same in all versions
+func metadataPatch(pt types.PatchType, data []byte) bool {
+	switch pt {
+	case types.MergePatchType:
+		var patch map[string]any
+		if err := json.Unmarshal(data, &patch); err != nil {
+			return false
+		}
+		for field := range patch {
+			if field != "metadata" {
+				return false
+			}
+		}
+		return true
+	case types.JSONPatchType:
+		var operations []struct {
+			Path string `json:"path"`
+		}
+		if err := json.Unmarshal(data, &operations); err != nil {
+			return false
+		}
+		for _, operation := range operations {
+			if !strings.HasPrefix(operation.Path, "/metadata/") {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// applyPatch returns the object with the merge or JSON patch applied
+func applyPatch(obj *unstructured.Unstructured, pt types.PatchType, data []byte) (*unstructured.Unstructured, error) {
+	doc, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pt {
+	case types.MergePatchType:
+		doc, err = jsonpatch.MergePatch(doc, data)
+	case types.JSONPatchType:
+		var patch jsonpatch.Patch
+		if patch, err = jsonpatch.DecodePatch(data); err == nil {
+			doc, err = patch.Apply(doc)
+		}
+	default:
+		return nil, fmt.Errorf("patches of type %s of cluster api %s objects are not supported", pt, capiv1beta2.GroupVersion.Version)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	patched := &unstructured.Unstructured{}
+	if err := patched.UnmarshalJSON(doc); err != nil {
+		return nil, err
+	}
+	return patched, nil
+}
@@ -20,16 +20,22 @@ import (
 	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
 	v1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	dockerProvider "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta1"
 )
@@ -42,12 +48,13 @@ const (
 	rateLimiterBurst = "RATE_LIMITER_BURST"
 	defaultQPS       = 30
 	defaultBurst     = 100
-	hostIDAnnotation = "intelmachine.infrastructure.cluster.x-k8s.io/host-id"
 )
 
 var ErrDefaultTemplateNotFound = fmt.Errorf("default template not found")
+var ErrMultipleDefaultTemplates = fmt.Errorf("multiple default templates found")
 var ErrClusterNotFound = fmt.Errorf("cluster not found")
 var ErrMachineNotFound = fmt.Errorf("machine not found")
+var ErrBackupNotFound = fmt.Errorf("backup not found")
 
 // K8s object schemas
 var (
@@ -65,6 +72,11 @@ var (
 		Version:  templateResourceVersion,
 		Resource: templateResourceKind,
 	}
+	backupResourceSchema = schema.GroupVersionResource{
+		Group:    templateResourceGroup,
+		Version:  templateResourceVersion,
+		Resource: "backups",
+	}
 
 	bindingsResourceSchema = schema.GroupVersionResource{
 		Group:    intelProvider.GroupVersion.Group,
@@ -95,6 +107,12 @@ var (
 		Version:  "v1beta1",
 		Resource: "dockermachines",
 	}
+
+	VSphereMachineResourceSchema = schema.GroupVersionResource{
+		Group:    "infrastructure.cluster.x-k8s.io",
+		Version:  "v1beta1",
+		Resource: "vspheremachines",
+	}
 )
 
 type Client struct {
@@ -120,12 +138,70 @@ func New(dyn ...dynamic.Interface) *Client {
 }
 
 func (c *Client) WithInClusterConfig() *Client {
-	cfg, err := rest.InClusterConfig()
+	cfg, err := restConfig("")
 	if err != nil {
 		slog.Error("failed to get in-cluster config", "error", err)
 		return nil
 	}
 
+	c.Dyn, err = dynamic.NewForConfig(cfg)
+	if err != nil {
+		slog.Error("failed to create dynamic client", "error", err)
+		return nil
+	}
+	return c
+}
+
+// Connect creates a client for the Kubernetes API server of the given kubeconfig file, or of the cluster it runs in if
+// kubeconfig is empty. It retries with backoff until the API server is reachable, the timeout expired or ctx is done.
+func Connect(ctx context.Context, kubeconfig string, timeout time.Duration) (*Client, error) {
+	cfg, err := restConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dyn, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	b := backoff.NewExponentialBackOff(backoff.WithMaxInterval(10*time.Second), backoff.WithMaxElapsedTime(timeout))
+	connect := func() error {
+		version, err := disco.ServerVersion()
+		if err != nil {
+			return err
+		}
+		slog.Info("connected to the kubernetes api server", "host", cfg.Host, "version", version.GitVersion)
+		return nil
+	}
+	notify := func(err error, next time.Duration) {
+		slog.Warn("kubernetes api server is not reachable; retrying", "host", cfg.Host, "retryIn", next, "error", err)
+	}
+	if err := backoff.RetryNotify(connect, backoff.WithContext(b, ctx), notify); err != nil {
+		return nil, fmt.Errorf("failed to reach the kubernetes api server %s: %w", cfg.Host, err)
+	}
+
+	return New(dyn), nil
+}
+
+// restConfig returns the rest config of the given kubeconfig file, or the in-cluster config if kubeconfig is empty,
+// with the rate limiter params applied
+func restConfig(kubeconfig string) (*rest.Config, error) {
+	var cfg *rest.Config
+	var err error
+	if kubeconfig != "" {
+		cfg, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	} else {
+		cfg, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+
 	qpsValue, burstValue, err := getRateLimiterParams()
 	if err != nil {
 		slog.Warn("unable to get rate limiter params; using default values", "error", err)
@@ -133,13 +209,7 @@ func (c *Client) WithInClusterConfig() *Client {
 	slog.Debug("rate limiter params", "qps", qpsValue, "burst", burstValue)
 	cfg.QPS = float32(qpsValue)
 	cfg.Burst = int(burstValue)
-
-	c.Dyn, err = dynamic.NewForConfig(cfg)
-	if err != nil {
-		slog.Error("failed to create dynamic client", "error", err)
-		return nil
-	}
-	return c
+	return cfg, nil
 }
 
 func (c *Client) WithFakeClient() *Client {
@@ -150,6 +220,9 @@ func (c *Client) WithFakeClient() *Client {
 			{Group: intelProvider.GroupVersion.Group, Version: intelProvider.GroupVersion.Version, Resource: "intelmachines"}:        "IntelMachineList",
 			{Group: intelProvider.GroupVersion.Group, Version: intelProvider.GroupVersion.Version, Resource: "intelmachinebindings"}: "IntelMachineBindingList",
 			{Group: "edge-orchestrator.intel.com", Version: "v1alpha1", Resource: "clustertemplates"}:                                "ClusterTemplateList",
+			{Group: "", Version: "v1", Resource: "configmaps"}:                                                                       "ConfigMapList",
+			{Group: "", Version: "v1", Resource: "secrets"}:                                                                          "SecretList",
+			{Group: "", Version: "v1", Resource: "namespaces"}:                                                                       "NamespaceList",
 		})
 	return c
 }
@@ -197,7 +270,7 @@ func (c *Client) CreateSecret(ctx context.Context, namespace string, name string
 	_, err = c.Dyn.Resource(secretRes).Namespace(namespace).Create(ctx, secretManifest, metav1.CreateOptions{})
 
 	if err != nil && !errors.IsAlreadyExists(err) {
-		slog.Error("failed to create secret", "namespace", namespace, "name", name, "error", err)
+		logger.FromContext(ctx).Error("failed to create secret", "namespace", namespace, "name", name, "error", err)
 		return fmt.Errorf("failed to create secret %s in namespace %s: %w", name, namespace, err)
 	}
 
@@ -226,7 +299,7 @@ func (c *Client) CreateCluster(ctx context.Context, namespace string, cluster ca
 		return "", err
 	}
 
-	slog.Debug("creating cluster", "namespace", namespace, "cluster", unstructuredCluster)
+	logger.FromContext(ctx).Debug("creating cluster", "namespace", namespace, "cluster", unstructuredCluster)
 
 	clusterCreationResponse, err := c.Dyn.Resource(clusterResourceSchema).Namespace(namespace).Create(ctx, unstructuredCluster, metav1.CreateOptions{})
 	if err != nil {
@@ -273,12 +346,12 @@ func (c *Client) DeleteClusterForCleanup(ctx context.Context, namespace, cluster
 	}
 
 	if err := c.deleteReferencedIntelCluster(ctx, namespace, clusterName); err != nil {
-		slog.Warn("failed to delete referenced IntelCluster during assigned cluster cleanup", "error", err, "cluster", clusterName, "tenantId", namespace)
+		logger.FromContext(ctx).Warn("failed to delete referenced IntelCluster during assigned cluster cleanup", "error", err, "cluster", clusterName, "tenantId", namespace)
 	}
 
 	if forceFinalize {
 		if err := c.forceFinalizeClusterIfDeleting(ctx, namespace, clusterName); err != nil {
-			slog.Warn("failed to force finalize deleting assigned cluster", "error", err, "cluster", clusterName, "tenantId", namespace)
+			logger.FromContext(ctx).Warn("failed to force finalize deleting assigned cluster", "error", err, "cluster", clusterName, "tenantId", namespace)
 		}
 	}
 
@@ -315,6 +388,50 @@ func (c *Client) UnpauseClusterIfPaused(ctx context.Context, namespace, name str
 	return nil
 }
 
+// StartClusterMaintenance pauses the reconciliation of the cluster and records who put it in maintenance, when and why
+// in its annotations. The patch fails with a conflict if the cluster was modified since resourceVersion.
+func (c *Client) StartClusterMaintenance(ctx context.Context, namespace, name, resourceVersion, user, reason string, since time.Time) error {
+	return c.patchClusterMaintenance(ctx, namespace, name, map[string]any{
+		"metadata": map[string]any{
+			"resourceVersion": resourceVersion,
+			"annotations": map[string]any{
+				core.MaintenanceSinceAnnotationKey:  since.UTC().Format(time.RFC3339),
+				core.MaintenanceByAnnotationKey:     user,
+				core.MaintenanceReasonAnnotationKey: reason,
+			},
+		},
+		"spec": map[string]any{"paused": true},
+	})
+}
+
+// EndClusterMaintenance resumes the reconciliation of the cluster and removes its maintenance annotations. The patch
+// fails with a conflict if the cluster was modified since resourceVersion.
+func (c *Client) EndClusterMaintenance(ctx context.Context, namespace, name, resourceVersion string) error {
+	return c.patchClusterMaintenance(ctx, namespace, name, map[string]any{
+		"metadata": map[string]any{
+			"resourceVersion": resourceVersion,
+			"annotations": map[string]any{
+				core.MaintenanceSinceAnnotationKey:  nil,
+				core.MaintenanceByAnnotationKey:     nil,
+				core.MaintenanceReasonAnnotationKey: nil,
+			},
+		},
+		"spec": map[string]any{"paused": false},
+	})
+}
+
+func (c *Client) patchClusterMaintenance(ctx context.Context, namespace, name string, patch map[string]any) error {
+	patchData, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	_, err = c.Dyn.Resource(clusterResourceSchema).Namespace(namespace).Patch(ctx, name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	if errors.IsNotFound(err) {
+		return ErrClusterNotFound
+	}
+	return err
+}
+
 func (c *Client) removeIntelMachineHostCleanupFinalizers(ctx context.Context, namespace, clusterName string) error {
 	selector := fmt.Sprintf("cluster.x-k8s.io/cluster-name=%s", clusterName)
 	intelMachines, err := c.Dyn.Resource(IntelMachineResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
@@ -476,6 +593,48 @@ func (c *Client) SetClusterLabels(ctx context.Context, namespace string, cluster
 	})
 }
 
+// PatchClusterLabels applies the JSON merge patch to the user labels of the cluster object in the given namespace, keys
+// with nil values are removed and the system labels are kept. If resourceVersion is set, the cluster is only updated
+// if it has this version and a conflict error is returned otherwise; without it, updates conflicting with concurrent
+// modifications are retried on the latest version. It returns the updated cluster.
+func (c *Client) PatchClusterLabels(ctx context.Context, namespace, clusterName string, patch map[string]*string, resourceVersion string) (*unstructured.Unstructured, error) {
+	var updated *unstructured.Unstructured
+	transaction := func() error {
+		cluster, err := c.Dyn.Resource(clusterResourceSchema).Namespace(namespace).Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if resourceVersion != "" && cluster.GetResourceVersion() != resourceVersion {
+			return backoff.Permanent(errors.NewConflict(clusterResourceSchema.GroupResource(), clusterName,
+				fmt.Errorf("resource version is %s, not %s", cluster.GetResourceVersion(), resourceVersion)))
+		}
+
+		userLabels := labels.UserLabels(cluster.GetLabels())
+		for key, value := range patch {
+			if value == nil {
+				delete(userLabels, key)
+			} else {
+				userLabels[key] = *value
+			}
+		}
+		cluster.SetLabels(labels.Merge(labels.SystemLabels(cluster.GetLabels()), userLabels))
+
+		updated, err = c.Dyn.Resource(clusterResourceSchema).Namespace(namespace).Update(ctx, cluster, metav1.UpdateOptions{})
+		switch {
+		case errors.IsConflict(err) && resourceVersion == "":
+			return err // retry on the latest version
+		case err != nil:
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	if err := backoff.Retry(transaction, backoff.WithMaxRetries(backoff.NewConstantBackOff(retryInterval), maxRetries)); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
 // SetMachineLabels overrides the labels of the machine object in the given namespace
 func (c *Client) SetMachineLabels(ctx context.Context, namespace string, machineName string, newUserLabels map[string]string) error {
 	if newUserLabels == nil {
@@ -544,7 +703,7 @@ func (c *Client) DefaultTemplate(ctx context.Context, namespace string) (v1alpha
 		return template, err
 	}
 	if len(unstructuredClusterTemplatesList.Items) > 1 {
-		return template, fmt.Errorf("multiple default templates found")
+		return template, ErrMultipleDefaultTemplates
 	}
 	if len(unstructuredClusterTemplatesList.Items) == 0 {
 		return template, ErrDefaultTemplateNotFound
@@ -620,6 +779,86 @@ func (c *Client) GetCluster(ctx context.Context, namespace, name string) (*capi.
 	return &cluster, nil
 }
 
+// ListClusters returns the clusters in the given namespace that match the label selector
+// The selector is evaluated by the API server, so only the matching clusters are listed
+func ListClusters(ctx context.Context, dyn dynamic.Interface, namespace string, selector k8slabels.Selector) ([]unstructured.Unstructured, error) {
+	opts := metav1.ListOptions{}
+	if !selector.Empty() {
+		opts.LabelSelector = selector.String()
+	}
+
+	list, err := dyn.Resource(clusterResourceSchema).Namespace(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// ListClustersPage returns at most limit clusters in the given namespace that match the label selector, starting at the
+// given list continue token; the continue token of the next page and the count of the remaining clusters are set on the
+// returned list if there are more clusters
+func ListClustersPage(ctx context.Context, dyn dynamic.Interface, namespace string, selector k8slabels.Selector, limit int64, continueToken string) (*unstructured.UnstructuredList, error) {
+	opts := metav1.ListOptions{Limit: limit, Continue: continueToken}
+	if !selector.Empty() {
+		opts.LabelSelector = selector.String()
+	}
+	return dyn.Resource(clusterResourceSchema).Namespace(namespace).List(ctx, opts)
+}
+
+// UpdateClusterWorkers applies the given operation to the worker topology of the cluster with the given name in the given namespace
+func (c *Client) UpdateClusterWorkers(ctx context.Context, namespace, clusterName string, op func(*capi.WorkersTopology) error) error {
+	return c.UpdateClusterTopology(ctx, namespace, clusterName, func(topology *capi.Topology) error {
+		if topology.Workers == nil {
+			topology.Workers = &capi.WorkersTopology{}
+		}
+		return op(topology.Workers)
+	})
+}
+
+// UpdateClusterTopology applies the given operation to the managed topology of the cluster with the given name in the given namespace
+// It retries on transient "the object has been modified" error, like modifyLabels does; errors returned by the operation are not retried
+func (c *Client) UpdateClusterTopology(ctx context.Context, namespace, clusterName string, op func(*capi.Topology) error) error {
+	transientError := func(err error) bool {
+		tryAgainErrPattern := "the object has been modified; please apply your changes to the latest version and try again"
+		return strings.Contains(err.Error(), tryAgainErrPattern)
+	}
+
+	transaction := func() error {
+		unstructuredCluster, err := c.Dyn.Resource(clusterResourceSchema).Namespace(namespace).Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return backoff.Permanent(ErrClusterNotFound)
+			}
+			return backoff.Permanent(err)
+		}
+
+		var cluster capi.Cluster
+		if err = convert.FromUnstructured(*unstructuredCluster, &cluster); err != nil {
+			return backoff.Permanent(err)
+		}
+		if cluster.Spec.Topology == nil {
+			return backoff.Permanent(fmt.Errorf("cluster %s has no managed topology", clusterName))
+		}
+		if err = op(cluster.Spec.Topology); err != nil {
+			return backoff.Permanent(err)
+		}
+
+		updated, err := convert.ToUnstructured(cluster)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if _, err = c.Dyn.Resource(clusterResourceSchema).Namespace(namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			if transientError(err) {
+				return err // retry on transient error
+			}
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	return backoff.Retry(transaction, backoff.WithMaxRetries(backoff.NewConstantBackOff(retryInterval), maxRetries))
+}
+
 // GetMachineByHostID returns the machine with the given host ID in the given namespace for the given cluster
 func (c *Client) GetMachineByHostID(ctx context.Context, namespace, hostID string) (capi.Machine, error) {
 	opts := metav1.ListOptions{}
@@ -685,30 +924,12 @@ func (c *Client) GetMachineByProviderHostID(ctx context.Context, namespace, host
 }
 
 func (c *Client) providerHostIDFromMachine(ctx context.Context, namespace, kind, name string) (string, bool) {
-	var annotations map[string]string
-
-	switch kind {
-	case "IntelMachine":
-		providerMachine, err := c.IntelMachine(ctx, namespace, name)
-		if err != nil {
-			return "", false
-		}
-		annotations = providerMachine.Annotations
-	case "DockerMachine":
-		providerMachine, err := c.DockerMachine(ctx, namespace, name)
-		if err != nil {
-			return "", false
-		}
-		annotations = providerMachine.Annotations
-	default:
+	annotations, err := c.ProviderMachineAnnotations(ctx, namespace, kind, name)
+	if err != nil || annotations == nil {
 		return "", false
 	}
 
-	if annotations == nil {
-		return "", false
-	}
-
-	hostID, found := annotations[hostIDAnnotation]
+	hostID, found := annotations[nodemetadata.HostIdAnnotationKey]
 	if !found || hostID == "" {
 		return "", false
 	}
@@ -716,6 +937,81 @@ func (c *Client) providerHostIDFromMachine(ctx context.Context, namespace, kind,
 	return hostID, true
 }
 
+// ProviderMachineAnnotations returns the annotations of the provider machine with the given kind and name
+func (c *Client) ProviderMachineAnnotations(ctx context.Context, namespace, kind, name string) (map[string]string, error) {
+	providerSchema, err := providerMachineSchema(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	providerMachine, err := c.Dyn.Resource(providerSchema).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return providerMachine.GetAnnotations(), nil
+}
+
+// SetProviderMachineMetadata replaces the host metadata annotations of the provider machine backing the given machine
+func (c *Client) SetProviderMachineMetadata(ctx context.Context, namespace string, machine capi.Machine, metadata map[string]string) error {
+	providerSchema, err := providerMachineSchema(machine.Spec.InfrastructureRef.Kind)
+	if err != nil {
+		return err
+	}
+
+	return modifyLabels(ctx, c, namespace, providerSchema, machine.Spec.InfrastructureRef.Name, func(providerMachine *unstructured.Unstructured) {
+		providerMachine.SetAnnotations(nodemetadata.Merge(providerMachine.GetAnnotations(), metadata))
+	})
+}
+
+// SetMachineMaintenanceWindow records the end of the maintenance window of the host on the provider machine backing
+// the given machine and excludes the machine from the remediation of its MachineHealthCheck, so that a host that
+// reboots during the window is not replaced; a nil end removes both.
+func (c *Client) SetMachineMaintenanceWindow(ctx context.Context, namespace string, machine capi.Machine, end *time.Time) error {
+	providerSchema, err := providerMachineSchema(machine.Spec.InfrastructureRef.Kind)
+	if err != nil {
+		return err
+	}
+
+	var window, skipRemediation any
+	if end != nil {
+		window = end.UTC().Format(time.RFC3339)
+		skipRemediation = ""
+	}
+	if err := c.patchAnnotations(ctx, namespace, providerSchema, machine.Spec.InfrastructureRef.Name, map[string]any{
+		core.MaintenanceWindowEndAnnotationKey: window,
+	}); err != nil {
+		return fmt.Errorf("failed to annotate the provider machine: %w", err)
+	}
+	if err := c.patchAnnotations(ctx, namespace, machineResourceSchema, machine.Name, map[string]any{
+		capi.MachineSkipRemediationAnnotation: skipRemediation,
+	}); err != nil {
+		return fmt.Errorf("failed to annotate the machine: %w", err)
+	}
+	return nil
+}
+
+// patchAnnotations merges the annotations into the annotations of the resource, nil values remove the annotation
+func (c *Client) patchAnnotations(ctx context.Context, namespace string, resourceSchema schema.GroupVersionResource, name string, annotations map[string]any) error {
+	patchData, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": annotations}})
+	if err != nil {
+		return err
+	}
+	_, err = c.Dyn.Resource(resourceSchema).Namespace(namespace).Patch(ctx, name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	return err
+}
+
+func providerMachineSchema(kind string) (schema.GroupVersionResource, error) {
+	switch kind {
+	case "IntelMachine":
+		return IntelMachineResourceSchema, nil
+	case "DockerMachine":
+		return DockerMachineResourceSchema, nil
+	case "VSphereMachine":
+		return VSphereMachineResourceSchema, nil
+	}
+	return schema.GroupVersionResource{}, fmt.Errorf("unsupported provider machine kind %s", kind)
+}
+
 // GetMachines returns the machine with the given name in the given namespace for the given cluster
 func (c *Client) GetMachines(ctx context.Context, namespace, clusterName string) ([]capi.Machine, error) {
 	var machines []capi.Machine
@@ -770,6 +1066,27 @@ func (c *Client) CreateMachineBinding(ctx context.Context, namespace string, bin
 	return err
 }
 
+// DeleteMachineBinding deletes the machine binding with the given name in the given namespace, a missing binding is not an error
+func (c *Client) DeleteMachineBinding(ctx context.Context, namespace, name string) error {
+	err := c.Dyn.Resource(bindingsResourceSchema).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MarkMachineForDeletion annotates the machine so it is the first one removed when its owner scales down
+func (c *Client) MarkMachineForDeletion(ctx context.Context, namespace, machineName string) error {
+	return modifyLabels(ctx, c, namespace, machineResourceSchema, machineName, func(machine *unstructured.Unstructured) {
+		annotations := machine.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[capi.DeleteMachineAnnotation] = ""
+		machine.SetAnnotations(annotations)
+	})
+}
+
 // IntelMachines returns all IntelMachine objects in the given namespace for the given cluster
 func (c *Client) IntelMachines(ctx context.Context, namespace, clusterName string) ([]intelProvider.IntelMachine, error) {
 	return providerMachines[intelProvider.IntelMachine](ctx, c, namespace, clusterName, IntelMachineResourceSchema)
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+// Secondary indexes of the cluster informer cache. Indexed values are scoped by namespace, see ClusterIndexValue,
+// except for the ClusterTemplateNameIndex.
+const (
+	// ClusterTemplateIndex indexes clusters by the name of the template they were created from
+	ClusterTemplateIndex = "template"
+	// ClusterTemplateNameIndex indexes clusters by the name of the template they were created from in all namespaces,
+	// e.g. to count the clusters of a template across projects
+	ClusterTemplateNameIndex = "template-name"
+	// ClusterPhaseIndex indexes clusters by their lifecycle phase, e.g. Provisioned
+	ClusterPhaseIndex = "phase"
+	// ClusterLabelIndex indexes clusters by each of their labels, see ClusterLabelIndexValue
+	ClusterLabelIndex = "label"
+)
+
+// ClusterIndexers returns the indexers of the cluster informer cache
+func ClusterIndexers() cache.Indexers {
+	return cache.Indexers{
+		ClusterTemplateIndex:     clusterTemplateIndexFunc,
+		ClusterTemplateNameIndex: clusterTemplateNameIndexFunc,
+		ClusterPhaseIndex:        clusterPhaseIndexFunc,
+		ClusterLabelIndex:        clusterLabelIndexFunc,
+	}
+}
+
+// ClusterIndexValue returns the indexed value of the template or phase index for the given namespace
+func ClusterIndexValue(namespace, value string) string {
+	return namespace + "/" + value
+}
+
+// ClusterLabelIndexValue returns the indexed value of the label index for the given namespace and label
+func ClusterLabelIndexValue(namespace, key, value string) string {
+	return ClusterIndexValue(namespace, key+"="+value)
+}
+
+// ByIndex returns the cached clusters whose index matches the given indexed value
+// The cache is eventually consistent, so very recent changes may not be reflected yet
+func (ci *ClusterInformer) ByIndex(indexName, indexedValue string) ([]unstructured.Unstructured, error) {
+	return clustersByIndex(ci.informer.GetIndexer(), indexName, indexedValue)
+}
+
+// ClusterIndexer indexes a given list of clusters the same way as the cluster informer cache,
+// so that clusters listed from the API server can be looked up the same way
+type ClusterIndexer struct {
+	indexer cache.Indexer
+}
+
+// NewClusterIndexer creates a new ClusterIndexer over the given clusters
+func NewClusterIndexer(clusters []unstructured.Unstructured) (*ClusterIndexer, error) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, ClusterIndexers())
+	for i := range clusters {
+		if err := indexer.Add(&clusters[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &ClusterIndexer{indexer: indexer}, nil
+}
+
+// ByIndex returns the clusters whose index matches the given indexed value
+func (ci *ClusterIndexer) ByIndex(indexName, indexedValue string) ([]unstructured.Unstructured, error) {
+	return clustersByIndex(ci.indexer, indexName, indexedValue)
+}
+
+func clustersByIndex(indexer cache.Indexer, indexName, indexedValue string) ([]unstructured.Unstructured, error) {
+	objs, err := indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+		clusters = append(clusters, *u)
+	}
+	return clusters, nil
+}
+
+func clusterTemplateIndexFunc(obj any) ([]string, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	template := m.GetAnnotations()[core.TemplateLabelKey]
+	if template == "" {
+		return nil, nil
+	}
+	return []string{ClusterIndexValue(m.GetNamespace(), template)}, nil
+}
+
+func clusterTemplateNameIndexFunc(obj any) ([]string, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	template := m.GetAnnotations()[core.TemplateLabelKey]
+	if template == "" {
+		return nil, nil
+	}
+	return []string{template}, nil
+}
+
+func clusterPhaseIndexFunc(obj any) ([]string, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+	phase, _, err := unstructured.NestedString(u.Object, "status", "phase")
+	if err != nil || phase == "" {
+		return nil, err
+	}
+	return []string{ClusterIndexValue(u.GetNamespace(), phase)}, nil
+}
+
+func clusterLabelIndexFunc(obj any) ([]string, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(m.GetLabels()))
+	for key, value := range m.GetLabels() {
+		values = append(values, ClusterLabelIndexValue(m.GetNamespace(), key, value))
+	}
+	return values, nil
+}
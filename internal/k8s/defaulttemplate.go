@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+const (
+	// DefaultTemplateConfigMapName is the ConfigMap that pins the default template of a namespace, it also serializes
+	// the changes of the default template of the namespace
+	DefaultTemplateConfigMapName = "default-template"
+
+	defaultTemplateDataKey = "template"
+	// defaultTemplateLeaseKey annotates the ConfigMap with the time until which a change of the default template holds it
+	defaultTemplateLeaseKey = core.ClusterOrchResourceGroup + "/default-template-lease"
+	// defaultTemplateLeaseDuration bounds how long a change that failed to release the lease blocks the next changes
+	defaultTemplateLeaseDuration = 30 * time.Second
+)
+
+// ErrDefaultTemplateBusy is returned if the default template of the namespace is being changed concurrently
+var ErrDefaultTemplateBusy = fmt.Errorf("default template is being changed")
+
+// SetDefaultTemplate makes the template the default template of the namespace and pins it, so that it stays the
+// default when the templates of the project are set up again. The changes of the default template of a namespace are
+// serialized by a lease on the ConfigMap pinning it, and the default label is removed from the previous default templates
+// before it is added to the template, so no two templates of the namespace are ever labeled default at the same time.
+// A non-empty resourceVersion is the version the template must still have, else a conflict error is returned.
+func (c *Client) SetDefaultTemplate(ctx context.Context, namespace, templateName, resourceVersion string) error {
+	pin, err := c.leaseDefaultTemplate(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := c.labelDefaultTemplate(ctx, namespace, templateName, resourceVersion); err != nil {
+		if releaseErr := c.releaseDefaultTemplate(ctx, pin, ""); releaseErr != nil {
+			logger.FromContext(ctx).Warn("failed to release the default template lease", "namespace", namespace, "error", releaseErr)
+		}
+		return err
+	}
+
+	if err := c.releaseDefaultTemplate(ctx, pin, templateName); err != nil {
+		return fmt.Errorf("failed to pin default template: %w", err)
+	}
+	return nil
+}
+
+// PinnedDefaultTemplate returns the name of the template pinned as default template of the namespace, empty if none is
+func (c *Client) PinnedDefaultTemplate(ctx context.Context, namespace string) (string, error) {
+	pin, err := c.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Get(ctx, DefaultTemplateConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	templateName, _, err := unstructured.NestedString(pin.Object, "data", defaultTemplateDataKey)
+	return templateName, err
+}
+
+// labelDefaultTemplate moves the default label from the current default templates to the template
+func (c *Client) labelDefaultTemplate(ctx context.Context, namespace, templateName, resourceVersion string) error {
+	templates := c.Dyn.Resource(templateResourceSchema).Namespace(namespace)
+
+	template, err := templates.Get(ctx, templateName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if resourceVersion != "" && template.GetResourceVersion() != resourceVersion {
+		return errors.NewConflict(templateResourceSchema.GroupResource(), templateName,
+			fmt.Errorf("the template was modified since resource version %s", resourceVersion))
+	}
+
+	listOptions := metav1.ListOptions{LabelSelector: fmt.Sprintf("%v=%v", labels.DefaultLabelKey, labels.DefaultLabelVal)}
+	defaults, err := templates.List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, item := range defaults.Items {
+		if item.GetName() == templateName {
+			continue
+		}
+		item.SetLabels(labels.Remove(item.GetLabels(), labels.DefaultLabelKey))
+		if _, err := templates.Update(ctx, &item, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		logger.FromContext(ctx).Info("default cluster template unset", "namespace", namespace, "name", item.GetName())
+	}
+
+	if template.GetLabels()[labels.DefaultLabelKey] == labels.DefaultLabelVal {
+		return nil
+	}
+	template.SetLabels(labels.Merge(template.GetLabels(), map[string]string{labels.DefaultLabelKey: labels.DefaultLabelVal}))
+	_, err = templates.Update(ctx, template, metav1.UpdateOptions{})
+	return err
+}
+
+// leaseDefaultTemplate takes the lease on the ConfigMap pinning the default template of the namespace, creating the
+// ConfigMap if needed. It retries while another change holds the lease and returns ErrDefaultTemplateBusy if it is
+// not released in time.
+func (c *Client) leaseDefaultTemplate(ctx context.Context, namespace string) (*unstructured.Unstructured, error) {
+	configMaps := c.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace)
+
+	var leased *unstructured.Unstructured
+	transaction := func() error {
+		pin, err := configMaps.Get(ctx, DefaultTemplateConfigMapName, metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+			pin = &unstructured.Unstructured{}
+			pin.SetAPIVersion("v1")
+			pin.SetKind("ConfigMap")
+			pin.SetName(DefaultTemplateConfigMapName)
+			pin.SetNamespace(namespace)
+			setDefaultTemplateLease(pin)
+			leased, err = configMaps.Create(ctx, pin, metav1.CreateOptions{})
+		case err != nil:
+			return backoff.Permanent(err)
+		default:
+			if until, err := time.Parse(time.RFC3339, pin.GetAnnotations()[defaultTemplateLeaseKey]); err == nil && time.Now().Before(until) {
+				return ErrDefaultTemplateBusy
+			}
+			setDefaultTemplateLease(pin)
+			leased, err = configMaps.Update(ctx, pin, metav1.UpdateOptions{})
+		}
+		if errors.IsAlreadyExists(err) || errors.IsConflict(err) {
+			return ErrDefaultTemplateBusy // another change took the lease first
+		}
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	policy := backoff.WithContext(backoff.WithMaxRetries(backoff.NewConstantBackOff(retryInterval), maxRetries), ctx)
+	if err := backoff.Retry(transaction, policy); err != nil {
+		return nil, err
+	}
+	return leased, nil
+}
+
+// releaseDefaultTemplate releases the lease on the ConfigMap, pinning the template unless its name is empty
+func (c *Client) releaseDefaultTemplate(ctx context.Context, pin *unstructured.Unstructured, templateName string) error {
+	annotations := pin.GetAnnotations()
+	delete(annotations, defaultTemplateLeaseKey)
+	pin.SetAnnotations(annotations)
+	if templateName != "" {
+		if err := unstructured.SetNestedField(pin.Object, templateName, "data", defaultTemplateDataKey); err != nil {
+			return err
+		}
+	}
+
+	_, err := c.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(pin.GetNamespace()).Update(ctx, pin, metav1.UpdateOptions{})
+	return err
+}
+
+func setDefaultTemplateLease(pin *unstructured.Unstructured) {
+	annotations := pin.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[defaultTemplateLeaseKey] = time.Now().Add(defaultTemplateLeaseDuration).UTC().Format(time.RFC3339)
+	pin.SetAnnotations(annotations)
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+// ClusterEvents returns the Kubernetes events of the cluster with the given name in the given namespace, of its control
+// plane, its machines and their provider machines, oldest first; ErrClusterNotFound if the cluster does not exist
+func (c *Client) ClusterEvents(ctx context.Context, namespace, clusterName string) ([]corev1.Event, error) {
+	cluster, err := c.GetCluster(ctx, namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	involved := map[string]bool{"Cluster/" + clusterName: true}
+	if ref := cluster.Spec.ControlPlaneRef; ref != nil {
+		involved[ref.Kind+"/"+ref.Name] = true
+	}
+	machines, err := c.GetMachines(ctx, namespace, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machines: %w", err)
+	}
+	for _, machine := range machines {
+		involved["Machine/"+machine.Name] = true
+		if ref := machine.Spec.InfrastructureRef; ref.Name != "" {
+			involved[ref.Kind+"/"+ref.Name] = true
+		}
+	}
+
+	list, err := c.Dyn.Resource(core.EventResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	events := []corev1.Event{}
+	for _, item := range list.Items {
+		var event corev1.Event
+		if err := convert.FromUnstructured(item, &event); err != nil {
+			return nil, err
+		}
+		if involved[event.InvolvedObject.Kind+"/"+event.InvolvedObject.Name] {
+			events = append(events, event)
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b corev1.Event) int {
+		return EventTime(a).Compare(EventTime(b))
+	})
+	return events, nil
+}
+
+// EventTime returns the time the event last occurred; events recorded with the events.k8s.io API only have an event
+// time, the events of a series have the time of their last occurrence in the series
+func EventTime(event corev1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ReferenceSchema returns the resource of the object a cluster or machine references, e.g. its control plane or its
+// infrastructure cluster; the resources of the providers are the lowercase plural of their kind
+func ReferenceSchema(ref *corev1.ObjectReference) (schema.GroupVersionResource, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("invalid api version of %s %s: %w", ref.Kind, ref.Name, err)
+	}
+	if ref.Kind == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("missing kind of %s", ref.Name)
+	}
+	return gv.WithResource(strings.ToLower(ref.Kind) + "s"), nil
+}
+
+// ReferencedObject returns the object with the given reference in the given namespace; the error is a NotFound error
+// if the object does not exist (anymore)
+func (c *Client) ReferencedObject(ctx context.Context, namespace string, ref *corev1.ObjectReference) (*unstructured.Unstructured, error) {
+	gvr, err := ReferenceSchema(ref)
+	if err != nil {
+		return nil, err
+	}
+	return c.Dyn.Resource(gvr).Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+}
+
+// ForceDelete deletes the object with the given name and strips all of its finalizers, so that it is removed without
+// waiting for its controller; objects that do not exist (anymore) are ignored
+func (c *Client) ForceDelete(ctx context.Context, resource schema.GroupVersionResource, namespace, name string) error {
+	cli := c.Dyn.Resource(resource).Namespace(namespace)
+	if err := cli.Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete %s %s: %w", resource.Resource, name, err)
+	}
+
+	patchBody, err := json.Marshal(map[string]any{"metadata": map[string]any{"finalizers": []string{}}})
+	if err != nil {
+		return err
+	}
+	if _, err := cli.Patch(ctx, name, types.MergePatchType, patchBody, metav1.PatchOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil // removed by its controller meanwhile
+		}
+		return fmt.Errorf("failed to remove the finalizers of %s %s: %w", resource.Resource, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+)
+
+const (
+	defaultInformerResync = 10 * time.Minute
+	// watchErrorTTL is how long a failed list or watch is reported by Health; the informer retries with a backoff of
+	// at most 30 seconds, so a persistent failure is reported until it is resolved
+	watchErrorTTL = time.Minute
+)
+
+// ErrCacheNotSynced is returned by the health checks of the caches that are not synced yet
+var ErrCacheNotSynced = errors.New("cache is not synced")
+
+// ClusterEvent is a change of a cluster object observed by the ClusterInformer
+type ClusterEvent struct {
+	Type    watch.EventType
+	Cluster *capi.Cluster
+}
+
+// ClusterInformer maintains an indexed cache of the cluster objects in all namespaces and
+// notifies subscribers about changes of the clusters they are interested in
+type ClusterInformer struct {
+	factory  dynamicinformer.DynamicSharedInformerFactory
+	informer cache.SharedIndexInformer
+
+	mu          sync.RWMutex
+	subscribers map[string]map[chan ClusterEvent]struct{}
+
+	healthMu   sync.Mutex
+	watchErr   error
+	watchErrAt time.Time
+}
+
+// NewClusterInformer creates a new ClusterInformer backed by the given dynamic client
+func NewClusterInformer(dyn dynamic.Interface) (*ClusterInformer, error) {
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(dyn, defaultInformerResync)
+	ci := &ClusterInformer{
+		factory:     factory,
+		informer:    factory.ForResource(clusterResourceSchema).Informer(),
+		subscribers: map[string]map[chan ClusterEvent]struct{}{},
+	}
+
+	if err := ci.informer.AddIndexers(ClusterIndexers()); err != nil {
+		return nil, fmt.Errorf("failed to add cluster indexers: %w", err)
+	}
+	if err := ci.informer.SetWatchErrorHandlerWithContext(ci.handleWatchError); err != nil {
+		return nil, fmt.Errorf("failed to set cluster watch error handler: %w", err)
+	}
+
+	_, err := ci.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			ci.notify(watch.Added, obj)
+		},
+		UpdateFunc: func(_, obj any) {
+			ci.notify(watch.Modified, obj)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			ci.notify(watch.Deleted, obj)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add cluster event handler: %w", err)
+	}
+
+	return ci, nil
+}
+
+// Start starts the informer and blocks until its cache is synced or the context is canceled
+func (ci *ClusterInformer) Start(ctx context.Context) error {
+	ci.factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), ci.informer.HasSynced) {
+		return fmt.Errorf("failed to sync cluster informer cache")
+	}
+	slog.Info("cluster informer cache synced")
+	return nil
+}
+
+// TrackBookmarks saves the bookmarks of the cluster informer with the given WatchBookmarks
+func (ci *ClusterInformer) TrackBookmarks(bookmarks *WatchBookmarks) {
+	bookmarks.Track(clusterResourceSchema, ci.informer)
+}
+
+// Health returns an error if the cache is not synced yet or the clusters failed to be listed or watched recently,
+// in which case the cache may be stale
+func (ci *ClusterInformer) Health() error {
+	if !ci.informer.HasSynced() {
+		return fmt.Errorf("cluster informer %w", ErrCacheNotSynced)
+	}
+
+	ci.healthMu.Lock()
+	defer ci.healthMu.Unlock()
+	if ci.watchErr != nil && time.Since(ci.watchErrAt) < watchErrorTTL {
+		return fmt.Errorf("failed to watch clusters: %w", ci.watchErr)
+	}
+	return nil
+}
+
+// handleWatchError records the failures of the informer to list or watch the clusters for Health; watches that are
+// closed normally or expired are not failures
+func (ci *ClusterInformer) handleWatchError(ctx context.Context, r *cache.Reflector, err error) {
+	if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) && !apierrors.IsResourceExpired(err) && !apierrors.IsGone(err) {
+		ci.healthMu.Lock()
+		ci.watchErr, ci.watchErrAt = err, time.Now()
+		ci.healthMu.Unlock()
+	}
+	cache.DefaultWatchErrorHandler(ctx, r, err)
+}
+
+// Subscribe returns a channel that receives the changes of the given cluster; the current state of the
+// cluster is delivered first. Only the latest pending event is kept for slow receivers. The returned
+// function must be called to release the subscription.
+func (ci *ClusterInformer) Subscribe(namespace, name string) (<-chan ClusterEvent, func(), error) {
+	key := namespace + "/" + name
+	ch := make(chan ClusterEvent, 1)
+
+	ci.mu.Lock()
+	obj, exists, err := ci.informer.GetStore().GetByKey(key)
+	if err != nil {
+		ci.mu.Unlock()
+		return nil, nil, err
+	}
+	if !exists {
+		ci.mu.Unlock()
+		return nil, nil, ErrClusterNotFound
+	}
+	if ci.subscribers[key] == nil {
+		ci.subscribers[key] = map[chan ClusterEvent]struct{}{}
+	}
+	ci.subscribers[key][ch] = struct{}{}
+	if cluster, err := toCluster(obj); err == nil {
+		ch <- ClusterEvent{Type: watch.Added, Cluster: cluster}
+	}
+	ci.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			ci.mu.Lock()
+			defer ci.mu.Unlock()
+			delete(ci.subscribers[key], ch)
+			if len(ci.subscribers[key]) == 0 {
+				delete(ci.subscribers, key)
+			}
+		})
+	}
+
+	return ch, unsubscribe, nil
+}
+
+// ClusterHandler is notified about the changes of all clusters; old is nil for added clusters and new is nil for
+// deleted clusters
+type ClusterHandler func(old, new *capi.Cluster)
+
+// AddHandler registers a handler that is notified about the changes of all clusters in all namespaces
+func (ci *ClusterInformer) AddHandler(handler ClusterHandler) error {
+	_, err := ci.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if cluster, err := toCluster(obj); err == nil {
+				handler(nil, cluster)
+			}
+		},
+		UpdateFunc: func(oldObj, obj any) {
+			old, err := toCluster(oldObj)
+			if err != nil {
+				return
+			}
+			if cluster, err := toCluster(obj); err == nil {
+				handler(old, cluster)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if cluster, err := toCluster(obj); err == nil {
+				handler(cluster, nil)
+			}
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add cluster handler: %w", err)
+	}
+	return nil
+}
+
+func (ci *ClusterInformer) notify(eventType watch.EventType, obj any) {
+	cluster, err := toCluster(obj)
+	if err != nil {
+		slog.Warn("failed to convert cluster from informer cache", "error", err)
+		return
+	}
+
+	ci.mu.RLock()
+	defer ci.mu.RUnlock()
+	for ch := range ci.subscribers[cluster.Namespace+"/"+cluster.Name] {
+		event := ClusterEvent{Type: eventType, Cluster: cluster}
+		// drop the stale pending event, if any, so that the subscriber always gets the latest state
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+func toCluster(obj any) (*capi.Cluster, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	var cluster capi.Cluster
+	if err := convert.FromUnstructured(*u, &cluster); err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
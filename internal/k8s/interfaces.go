@@ -19,4 +19,5 @@ type K8sWrapperClient interface {
 	DeleteClusterForCleanup(ctx context.Context, namespace, clusterName string, forceFinalize bool) error
 	DeleteCluster(ctx context.Context, namespace string, clusterName string) error
 	SetMachineLabels(ctx context.Context, namespace string, machineName string, newUserLabels map[string]string) error
+	SetProviderMachineMetadata(ctx context.Context, namespace string, machine capi.Machine, metadata map[string]string) error
 }
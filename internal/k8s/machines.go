@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	intelProvider "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
+)
+
+const dockerProviderIDPrefix = "docker:////"
+
+// MachineDetails joins a cluster-api machine with the provider machine backing it and, for the Intel provider, the
+// binding of its host to the cluster
+type MachineDetails struct {
+	Machine capi.Machine
+	// ProviderMachine is nil until the provider machine is created
+	ProviderMachine *unstructured.Unstructured
+	// Binding is nil for the other providers and until the host is bound
+	Binding *intelProvider.IntelMachineBinding
+}
+
+// HostID returns the id of the host the IntelMachine is bound to, empty for the other providers
+func (d MachineDetails) HostID() string {
+	if d.ProviderMachine == nil {
+		return ""
+	}
+	return d.ProviderMachine.GetAnnotations()[nodemetadata.HostIdAnnotationKey]
+}
+
+// ContainerName returns the name of the container of the DockerMachine, empty for the other providers
+func (d MachineDetails) ContainerName() string {
+	if d.Machine.Spec.InfrastructureRef.Kind != "DockerMachine" {
+		return ""
+	}
+	if d.Machine.Spec.ProviderID != nil && strings.HasPrefix(*d.Machine.Spec.ProviderID, dockerProviderIDPrefix) {
+		return strings.TrimPrefix(*d.Machine.Spec.ProviderID, dockerProviderIDPrefix)
+	}
+	// until the machine is provisioned, the container name follows the naming of the docker provider
+	clusterName, machineName := d.Machine.Spec.ClusterName, d.Machine.Spec.InfrastructureRef.Name
+	if strings.HasPrefix(machineName, clusterName) {
+		return machineName
+	}
+	return fmt.Sprintf("%s-%s", clusterName, machineName)
+}
+
+// FailureMessage returns the last failure reported by the machine or else by its provider machine
+func (d MachineDetails) FailureMessage() string {
+	if d.Machine.Status.FailureMessage != nil && *d.Machine.Status.FailureMessage != "" {
+		return *d.Machine.Status.FailureMessage
+	}
+	if d.ProviderMachine == nil {
+		return ""
+	}
+	message, _, _ := unstructured.NestedString(d.ProviderMachine.Object, "status", "failureMessage")
+	return message
+}
+
+// MachineDetails returns the machines of the cluster with their provider machines and host bindings; provider machines
+// that are not created yet are not an error
+func (c *Client) MachineDetails(ctx context.Context, namespace, clusterName string) ([]MachineDetails, error) {
+	machines, err := c.GetMachines(ctx, namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	details := []MachineDetails{}
+	var bindings map[string]*intelProvider.IntelMachineBinding
+	for _, machine := range machines {
+		detail := MachineDetails{Machine: machine}
+
+		ref := machine.Spec.InfrastructureRef
+		providerSchema, err := providerMachineSchema(ref.Kind)
+		if err != nil {
+			return nil, err
+		}
+		providerMachine, err := c.Dyn.Resource(providerSchema).Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+		case err != nil:
+			return nil, err
+		default:
+			detail.ProviderMachine = providerMachine
+		}
+
+		if hostID := detail.HostID(); hostID != "" {
+			if bindings == nil {
+				if bindings, err = c.machineBindings(ctx, namespace, clusterName); err != nil {
+					return nil, err
+				}
+			}
+			detail.Binding = bindings[hostID]
+		}
+
+		details = append(details, detail)
+	}
+
+	return details, nil
+}
+
+// machineBindings returns the bindings of hosts to the cluster by host id
+func (c *Client) machineBindings(ctx context.Context, namespace, clusterName string) (map[string]*intelProvider.IntelMachineBinding, error) {
+	list, err := c.Dyn.Resource(bindingsResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := map[string]*intelProvider.IntelMachineBinding{}
+	for _, item := range list.Items {
+		var binding intelProvider.IntelMachineBinding
+		if err := convert.FromUnstructured(item, &binding); err != nil {
+			return nil, err
+		}
+		if binding.Spec.ClusterName == clusterName {
+			bindings[binding.Spec.NodeGUID] = &binding
+		}
+	}
+	return bindings, nil
+}
+
+// HostClusters returns the names of the clusters of the namespace the hosts are bound to by host id; only the hosts of
+// the Intel infra provider are bound
+func (c *Client) HostClusters(ctx context.Context, namespace string) (map[string]string, error) {
+	list, err := c.Dyn.Resource(bindingsResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list machine bindings: %w", err)
+	}
+
+	clusters := map[string]string{}
+	for _, item := range list.Items {
+		var binding intelProvider.IntelMachineBinding
+		if err := convert.FromUnstructured(item, &binding); err != nil {
+			return nil, err
+		}
+		clusters[binding.Spec.NodeGUID] = binding.Spec.ClusterName
+	}
+	return clusters, nil
+}
@@ -264,6 +264,55 @@ func (_c *MockK8sWrapperClient_GetCluster_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// SetProviderMachineMetadata provides a mock function with given fields: ctx, namespace, machine, metadata
+func (_m *MockK8sWrapperClient) SetProviderMachineMetadata(ctx context.Context, namespace string, machine capi.Machine, metadata map[string]string) error {
+	ret := _m.Called(ctx, namespace, machine, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProviderMachineMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, capi.Machine, map[string]string) error); ok {
+		r0 = rf(ctx, namespace, machine, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockK8sWrapperClient_SetProviderMachineMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProviderMachineMetadata'
+type MockK8sWrapperClient_SetProviderMachineMetadata_Call struct {
+	*mock.Call
+}
+
+// SetProviderMachineMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - machine capi.Machine
+//   - metadata map[string]string
+func (_e *MockK8sWrapperClient_Expecter) SetProviderMachineMetadata(ctx interface{}, namespace interface{}, machine interface{}, metadata interface{}) *MockK8sWrapperClient_SetProviderMachineMetadata_Call {
+	return &MockK8sWrapperClient_SetProviderMachineMetadata_Call{Call: _e.mock.On("SetProviderMachineMetadata", ctx, namespace, machine, metadata)}
+}
+
+func (_c *MockK8sWrapperClient_SetProviderMachineMetadata_Call) Run(run func(ctx context.Context, namespace string, machine capi.Machine, metadata map[string]string)) *MockK8sWrapperClient_SetProviderMachineMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(capi.Machine), args[3].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MockK8sWrapperClient_SetProviderMachineMetadata_Call) Return(_a0 error) *MockK8sWrapperClient_SetProviderMachineMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockK8sWrapperClient_SetProviderMachineMetadata_Call) RunAndReturn(run func(context.Context, string, capi.Machine, map[string]string) error) *MockK8sWrapperClient_SetProviderMachineMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockK8sWrapperClient creates a new instance of MockK8sWrapperClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func NewMockK8sWrapperClient(t interface {
 	mock.TestingT
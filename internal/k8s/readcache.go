@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+// clusterNameLabelKey labels the objects cluster-api creates for a cluster, e.g. its kubeconfig secret
+const clusterNameLabelKey = "cluster.x-k8s.io/cluster-name"
+
+// ReadCache is a dynamic client that serves the reads of the clusters, templates, machines and cluster secrets from
+// informer caches instead of the API server; all other requests are sent to the API server.
+//
+// Reads fall back to the API server while the cache of the resource is not synced, if the list options cannot be
+// answered from the cache (pages, field selectors or resource versions) and if the object is not in the cache, e.g.
+// because it was just created. Until the caches are synced, the reads of the namespaces warmed up by Warmup are served
+// from the caches of these namespaces. The cache is eventually consistent, so it must not be used to read objects that
+// are modified afterward.
+type ReadCache struct {
+	dynamic.Interface
+
+	factory   dynamicinformer.DynamicSharedInformerFactory
+	secrets   cache.SharedIndexInformer
+	resources map[schema.GroupVersionResource]*cachedResource
+
+	warmupMu sync.Mutex
+	warmup   WarmupProgress
+
+	// activity is when the activity of the namespaces was last recorded, see recordActivity
+	activityMu sync.Mutex
+	activity   map[string]time.Time
+}
+
+// NewReadCache creates a new ReadCache backed by the given dynamic client; the cluster cache of the given
+// ClusterInformer is shared if it is not nil
+func NewReadCache(dyn dynamic.Interface, clusters *ClusterInformer) *ReadCache {
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(dyn, defaultInformerResync)
+	if clusters != nil {
+		factory = clusters.factory
+	}
+	// only the secrets cluster-api creates for the clusters are cached, so lists of secrets are not complete
+	clusterSecrets := func(options *metav1.ListOptions) {
+		options.LabelSelector = clusterNameLabelKey
+	}
+	secrets := dynamicinformer.NewFilteredDynamicInformer(dyn, core.SecretResourceSchema, metav1.NamespaceAll, defaultInformerResync,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, clusterSecrets).Informer()
+
+	rc := &ReadCache{
+		Interface: dyn,
+		factory:   factory,
+		secrets:   secrets,
+		resources: map[schema.GroupVersionResource]*cachedResource{
+			core.SecretResourceSchema: {informer: secrets, partial: true, tweak: clusterSecrets},
+		},
+		activity: map[string]time.Time{},
+	}
+	for _, gvr := range []schema.GroupVersionResource{clusterResourceSchema, templateResourceSchema, machineResourceSchema} {
+		rc.resources[gvr] = &cachedResource{informer: factory.ForResource(gvr).Informer()}
+	}
+	for gvr, resource := range rc.resources {
+		resource.gvr = gvr
+		resource.warm = map[string]cache.SharedIndexInformer{}
+	}
+	return rc
+}
+
+// Start starts the informers of the cache without waiting for them to sync; reads are sent to the API server until
+// the cache of their resource is synced
+func (rc *ReadCache) Start(ctx context.Context) {
+	rc.factory.Start(ctx.Done())
+	go rc.secrets.Run(ctx.Done())
+	slog.Info("read cache started", "resources", len(rc.resources))
+}
+
+// TrackBookmarks saves the bookmarks of the informers of the cache with the given WatchBookmarks; the informers of
+// partial caches do not list all objects, so they have no bookmarks
+func (rc *ReadCache) TrackBookmarks(bookmarks *WatchBookmarks) {
+	for gvr, resource := range rc.resources {
+		if !resource.partial {
+			bookmarks.Track(gvr, resource.informer)
+		}
+	}
+}
+
+// HasSynced returns whether the caches of all resources are synced
+func (rc *ReadCache) HasSynced() bool {
+	for _, resource := range rc.resources {
+		if !resource.informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// WaitForSync blocks until the caches of all resources are synced, false if the context is canceled before
+func (rc *ReadCache) WaitForSync(ctx context.Context) bool {
+	synced := make([]cache.InformerSynced, 0, len(rc.resources))
+	for _, resource := range rc.resources {
+		synced = append(synced, resource.informer.HasSynced)
+	}
+	return cache.WaitForCacheSync(ctx.Done(), synced...)
+}
+
+// Resource returns the client of the given resource, reading from the cache if the resource is cached
+func (rc *ReadCache) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	client := rc.Interface.Resource(gvr)
+	resource, ok := rc.resources[gvr]
+	if !ok {
+		return client
+	}
+	return &cachedNamespaceableResource{NamespaceableResourceInterface: client, resource: resource, cache: rc}
+}
+
+// cachedResource is the informer cache of a resource
+type cachedResource struct {
+	gvr      schema.GroupVersionResource
+	informer cache.SharedIndexInformer
+	// partial is set if only some objects of the resource are cached, lists are then sent to the API server
+	partial bool
+	// tweak filters the objects of partial caches
+	tweak dynamicinformer.TweakListOptionsFunc
+
+	// warm are the caches of the namespaces warmed up until the informer is synced
+	mu   sync.RWMutex
+	warm map[string]cache.SharedIndexInformer
+}
+
+// indexer returns the cache to read the objects of the namespace from: the cache of the resource once synced, else
+// the cache of the namespace if it was warmed up; the reason it cannot be read from the cache otherwise
+func (r *cachedResource) indexer(namespace string) (cache.Indexer, string) {
+	if r.informer.HasSynced() {
+		return r.informer.GetIndexer(), ""
+	}
+	if namespace == "" {
+		return nil, "not synced"
+	}
+
+	r.mu.RLock()
+	warm, ok := r.warm[namespace]
+	r.mu.RUnlock()
+	if !ok || !warm.HasSynced() {
+		return nil, "not synced"
+	}
+	return warm.GetIndexer(), ""
+}
+
+// get returns a copy of the cached object, false if the cache is not synced or the object is not cached
+func (r *cachedResource) get(ctx context.Context, namespace, name string) (*unstructured.Unstructured, bool) {
+	indexer, reason := r.indexer(namespace)
+	if indexer == nil {
+		return nil, r.fallback(ctx, "get", reason)
+	}
+
+	key := name
+	if namespace != "" {
+		key = namespace + "/" + name
+	}
+	obj, exists, err := indexer.GetByKey(key)
+	if err != nil || !exists {
+		return nil, r.fallback(ctx, "get", "not cached")
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, r.fallback(ctx, "get", fmt.Sprintf("unexpected object type %T", obj))
+	}
+
+	metrics.ReadCacheCounter.WithLabelValues(r.gvr.Resource, "hit").Inc()
+	return u.DeepCopy(), true
+}
+
+// list returns copies of the cached objects matching the options, false if the cache cannot answer the options
+func (r *cachedResource) list(ctx context.Context, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, bool) {
+	if r.partial {
+		return nil, r.fallback(ctx, "list", "partial cache")
+	}
+	if opts.Limit > 0 || opts.Continue != "" || opts.FieldSelector != "" || opts.ResourceVersion != "" {
+		return nil, r.fallback(ctx, "list", "unsupported list options")
+	}
+	indexer, reason := r.indexer(namespace)
+	if indexer == nil {
+		return nil, r.fallback(ctx, "list", reason)
+	}
+
+	selector, err := k8slabels.Parse(opts.LabelSelector)
+	if err != nil {
+		// the API server returns the error of the invalid selector
+		return nil, r.fallback(ctx, "list", "invalid label selector")
+	}
+
+	var objs []any
+	if namespace == "" {
+		objs = indexer.List()
+	} else if objs, err = indexer.ByIndex(cache.NamespaceIndex, namespace); err != nil {
+		return nil, r.fallback(ctx, "list", err.Error())
+	}
+
+	list := &unstructured.UnstructuredList{Object: map[string]any{"apiVersion": r.gvr.GroupVersion().String()}}
+	list.SetResourceVersion(r.informer.LastSyncResourceVersion())
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, r.fallback(ctx, "list", fmt.Sprintf("unexpected object type %T", obj))
+		}
+		if selector.Matches(k8slabels.Set(u.GetLabels())) {
+			list.Items = append(list.Items, *u.DeepCopy())
+		}
+	}
+	// the API server lists the objects ordered by namespace and name
+	sort.Slice(list.Items, func(i, j int) bool {
+		if list.Items[i].GetNamespace() != list.Items[j].GetNamespace() {
+			return list.Items[i].GetNamespace() < list.Items[j].GetNamespace()
+		}
+		return list.Items[i].GetName() < list.Items[j].GetName()
+	})
+
+	metrics.ReadCacheCounter.WithLabelValues(r.gvr.Resource, "hit").Inc()
+	return list, true
+}
+
+// fallback records that a read is sent to the API server and returns false
+func (r *cachedResource) fallback(ctx context.Context, verb, reason string) bool {
+	logger.FromContext(ctx).Debug("read cache fallback", "resource", r.gvr.Resource, "verb", verb, "reason", reason)
+	metrics.ReadCacheCounter.WithLabelValues(r.gvr.Resource, "fallback").Inc()
+	return false
+}
+
+type cachedNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	resource *cachedResource
+	cache    *ReadCache
+}
+
+func (c *cachedNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	c.cache.recordActivity(namespace)
+	return &cachedNamespacedResource{
+		ResourceInterface: c.NamespaceableResourceInterface.Namespace(namespace),
+		resource:          c.resource,
+		namespace:         namespace,
+	}
+}
+
+func (c *cachedNamespaceableResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if len(subresources) == 0 && options.ResourceVersion == "" {
+		start := time.Now()
+		if obj, ok := c.resource.get(ctx, "", name); ok {
+			recordCall(ctx, CacheCalls, "get", c.resource.gvr, "", name, start)
+			return obj, nil
+		}
+	}
+	return c.NamespaceableResourceInterface.Get(ctx, name, options, subresources...)
+}
+
+func (c *cachedNamespaceableResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	start := time.Now()
+	if list, ok := c.resource.list(ctx, "", opts); ok {
+		recordCall(ctx, CacheCalls, "list", c.resource.gvr, "", "", start)
+		return list, nil
+	}
+	return c.NamespaceableResourceInterface.List(ctx, opts)
+}
+
+type cachedNamespacedResource struct {
+	dynamic.ResourceInterface
+	resource  *cachedResource
+	namespace string
+}
+
+func (c *cachedNamespacedResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if len(subresources) == 0 && options.ResourceVersion == "" {
+		start := time.Now()
+		if obj, ok := c.resource.get(ctx, c.namespace, name); ok {
+			recordCall(ctx, CacheCalls, "get", c.resource.gvr, c.namespace, name, start)
+			return obj, nil
+		}
+	}
+	return c.ResourceInterface.Get(ctx, name, options, subresources...)
+}
+
+func (c *cachedNamespacedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	start := time.Now()
+	if list, ok := c.resource.list(ctx, c.namespace, opts); ok {
+		recordCall(ctx, CacheCalls, "list", c.resource.gvr, c.namespace, "", start)
+		return list, nil
+	}
+	return c.ResourceInterface.List(ctx, opts)
+}
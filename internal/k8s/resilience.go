@@ -0,0 +1,413 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+const (
+	// retryInitialInterval and retryMaxInterval bound the exponential backoff between the retries of a call
+	retryInitialInterval = 100 * time.Millisecond
+	retryMaxInterval     = 2 * time.Second
+
+	// breaker states reported by the cluster_manager_k8s_circuit_breaker_state gauge
+	breakerClosed   = 0
+	breakerHalfOpen = 1
+	breakerOpen     = 2
+)
+
+// ErrCircuitOpen is returned by the calls of a ResilientClient that are failed fast while its circuit breaker is open;
+// the errors are also reported as 503 Service Unavailable by k8serrors.IsServiceUnavailable
+var ErrCircuitOpen = errors.New("kubernetes api server is unavailable, circuit breaker is open")
+
+// ResilienceConfig configures the retries and the circuit breaker of a ResilientClient
+type ResilienceConfig struct {
+	// MaxRetries is the number of retries of a call failing with a transient error; 0 disables the retries
+	MaxRetries int
+	// BreakerThreshold is the number of consecutive calls failing with a transient error that opens the circuit
+	// breaker; 0 disables the circuit breaker
+	BreakerThreshold int
+	// BreakerTimeout is how long the open circuit breaker fails the calls fast before it lets a probe call through
+	BreakerTimeout time.Duration
+}
+
+// ResilientClient is a dynamic client retrying the calls failing with transient errors of the API server, like
+// timeouts, throttling and etcd leader changes, with exponential backoff. Writes are only retried if the API server
+// did not process them. Once BreakerThreshold consecutive calls failed, the circuit breaker opens and all calls fail
+// fast with ErrCircuitOpen until a probe call after BreakerTimeout succeeds. Watches are not retried, the informers
+// restart them on their own.
+type ResilientClient struct {
+	dynamic.Interface
+	config  ResilienceConfig
+	breaker *circuitBreaker
+}
+
+// NewResilientClient creates a new ResilientClient sending the calls to the given dynamic client
+func NewResilientClient(dyn dynamic.Interface, config ResilienceConfig) *ResilientClient {
+	metrics.K8sCircuitBreakerState.Set(breakerClosed)
+	return &ResilientClient{
+		Interface: dyn,
+		config:    config,
+		breaker:   &circuitBreaker{threshold: config.BreakerThreshold, timeout: config.BreakerTimeout, now: time.Now},
+	}
+}
+
+// RetryAfter returns how long the circuit breaker stays open, 0 if it lets calls through
+func (c *ResilientClient) RetryAfter() time.Duration {
+	return c.breaker.retryAfter()
+}
+
+// Resource returns the client of the given resource, retrying its calls
+func (c *ResilientClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	client := c.Interface.Resource(gvr)
+	return &resilientNamespaceableResource{
+		NamespaceableResourceInterface: client,
+		resilientResource:              resilientResource{ResourceInterface: client, client: c, gvr: gvr},
+	}
+}
+
+// call runs the call of the verb, retrying it while it fails with a transient error; writes are retried only if the
+// API server did not process them
+func (c *ResilientClient) call(ctx context.Context, verb string, gvr schema.GroupVersionResource, write bool, call func() error) error {
+	b := backoff.NewExponentialBackOff(backoff.WithInitialInterval(retryInitialInterval), backoff.WithMaxInterval(retryMaxInterval),
+		backoff.WithMaxElapsedTime(0))
+	if retryAfter := c.breaker.allow(); retryAfter > 0 {
+		return &circuitOpenError{gvr: gvr, retryAfter: retryAfter}
+	}
+	for attempt := 0; ; attempt++ {
+		err := call()
+		reason := transientReason(err)
+		c.breaker.record(reason != "")
+		if reason == "" || attempt >= c.config.MaxRetries || write && !unprocessed(reason) || ctx.Err() != nil {
+			return err
+		}
+
+		delay := b.NextBackOff()
+		if seconds, ok := k8serrors.SuggestsClientDelay(err); ok {
+			delay = max(delay, time.Duration(seconds)*time.Second)
+		}
+		metrics.K8sRetryCounter.WithLabelValues(verb, reason).Inc()
+		logger.FromContext(ctx).Debug("retrying kubernetes call", "verb", verb, "resource", gvr.Resource, "reason", reason, "retryIn", delay, "error", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		// the retries stop once the failures opened the breaker
+		if c.breaker.allow() > 0 {
+			return err
+		}
+	}
+}
+
+// transientReason returns why the error of a call is transient, empty if it is not
+func transientReason(err error) string {
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return ""
+	case k8serrors.IsTooManyRequests(err):
+		return "throttled"
+	case k8serrors.IsServiceUnavailable(err):
+		return "unavailable"
+	case k8serrors.IsInternalError(err) && strings.Contains(err.Error(), "leader changed"):
+		return "leader-changed"
+	case k8serrors.IsServerTimeout(err), k8serrors.IsTimeout(err):
+		return "timeout"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case utilnet.IsConnectionRefused(err):
+		return "connection-refused"
+	case utilnet.IsConnectionReset(err), utilnet.IsProbableEOF(err):
+		return "connection-reset"
+	}
+	return ""
+}
+
+// unprocessed returns whether the API server did not process a call failing for the transient reason, so that a write
+// can be retried without applying it twice
+func unprocessed(reason string) bool {
+	switch reason {
+	case "throttled", "unavailable", "leader-changed", "connection-refused":
+		return true
+	}
+	return false
+}
+
+// circuitBreaker fails the calls fast once threshold consecutive calls failed, until a probe call succeeds after the
+// timeout; it is safe for concurrent use
+type circuitBreaker struct {
+	threshold int
+	timeout   time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	open     bool
+	probing  bool
+}
+
+// allow returns how long the breaker stays open, 0 if the call may be sent; once the timeout expired a single probe
+// call is let through
+func (b *circuitBreaker) allow() time.Duration {
+	if b.threshold <= 0 {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return 0
+	}
+	if remaining := b.openedAt.Add(b.timeout).Sub(b.now()); remaining > 0 {
+		return remaining
+	}
+	if b.probing {
+		return b.timeout
+	}
+	b.probing = true
+	metrics.K8sCircuitBreakerState.Set(breakerHalfOpen)
+	return 0
+}
+
+// record records whether a call failed with a transient error
+func (b *circuitBreaker) record(failed bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !failed {
+		if b.open {
+			slog.Info("kubernetes api server is available again, circuit breaker closed")
+			metrics.K8sCircuitBreakerState.Set(breakerClosed)
+		}
+		b.failures, b.open, b.probing = 0, false, false
+		return
+	}
+
+	b.failures++
+	if b.probing || !b.open && b.failures >= b.threshold {
+		if !b.open {
+			slog.Warn("kubernetes api server is unavailable, circuit breaker opened", "failures", b.failures, "timeout", b.timeout)
+		}
+		b.open, b.probing, b.openedAt = true, false, b.now()
+		metrics.K8sCircuitBreakerState.Set(breakerOpen)
+	}
+}
+
+// retryAfter returns how long the breaker stays open, 0 if it is closed or lets a probe call through
+func (b *circuitBreaker) retryAfter() time.Duration {
+	if b.threshold <= 0 {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return 0
+	}
+	return max(0, b.openedAt.Add(b.timeout).Sub(b.now()))
+}
+
+// circuitOpenError is the error of a call failed fast by the open circuit breaker
+type circuitOpenError struct {
+	gvr        schema.GroupVersionResource
+	retryAfter time.Duration
+}
+
+func (e *circuitOpenError) Error() string {
+	return fmt.Sprintf("%v: %s not called, retry in %v", ErrCircuitOpen, e.gvr.Resource, e.retryAfter.Round(time.Second))
+}
+
+func (e *circuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// Status implements k8serrors.APIStatus, so that the error is reported as 503 Service Unavailable
+func (e *circuitOpenError) Status() metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusServiceUnavailable,
+		Reason:  metav1.StatusReasonServiceUnavailable,
+		Message: e.Error(),
+		Details: &metav1.StatusDetails{RetryAfterSeconds: int32(max(1, e.retryAfter.Round(time.Second)/time.Second))},
+	}
+}
+
+type resilientNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	resilientResource
+}
+
+func (c *resilientNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &resilientResource{ResourceInterface: c.NamespaceableResourceInterface.Namespace(namespace), client: c.client, gvr: c.gvr}
+}
+
+// the methods of the embedded resilientResource are ambiguous with those of the NamespaceableResourceInterface, so
+// they are promoted explicitly
+
+func (c *resilientNamespaceableResource) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.resilientResource.Create(ctx, obj, options, subresources...)
+}
+
+func (c *resilientNamespaceableResource) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.resilientResource.Update(ctx, obj, options, subresources...)
+}
+
+func (c *resilientNamespaceableResource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return c.resilientResource.UpdateStatus(ctx, obj, options)
+}
+
+func (c *resilientNamespaceableResource) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	return c.resilientResource.Delete(ctx, name, options, subresources...)
+}
+
+func (c *resilientNamespaceableResource) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	return c.resilientResource.DeleteCollection(ctx, options, listOptions)
+}
+
+func (c *resilientNamespaceableResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.resilientResource.Get(ctx, name, options, subresources...)
+}
+
+func (c *resilientNamespaceableResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return c.resilientResource.List(ctx, opts)
+}
+
+func (c *resilientNamespaceableResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.resilientResource.Watch(ctx, opts)
+}
+
+func (c *resilientNamespaceableResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.resilientResource.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func (c *resilientNamespaceableResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.resilientResource.Apply(ctx, name, obj, options, subresources...)
+}
+
+func (c *resilientNamespaceableResource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	return c.resilientResource.ApplyStatus(ctx, name, obj, options)
+}
+
+// resilientResource retries the calls of a resource in a namespace, or of a cluster-scoped resource
+type resilientResource struct {
+	dynamic.ResourceInterface
+	client *ResilientClient
+	gvr    schema.GroupVersionResource
+}
+
+func (c *resilientResource) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (result *unstructured.Unstructured, err error) {
+	err = c.client.call(ctx, "create", c.gvr, true, func() error {
+		result, err = c.ResourceInterface.Create(ctx, obj, options, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientResource) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (result *unstructured.Unstructured, err error) {
+	err = c.client.call(ctx, "update", c.gvr, true, func() error {
+		result, err = c.ResourceInterface.Update(ctx, obj, options, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientResource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (result *unstructured.Unstructured, err error) {
+	err = c.client.call(ctx, "update", c.gvr, true, func() error {
+		result, err = c.ResourceInterface.UpdateStatus(ctx, obj, options)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientResource) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	return c.client.call(ctx, "delete", c.gvr, true, func() error {
+		return c.ResourceInterface.Delete(ctx, name, options, subresources...)
+	})
+}
+
+func (c *resilientResource) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	return c.client.call(ctx, "deletecollection", c.gvr, true, func() error {
+		return c.ResourceInterface.DeleteCollection(ctx, options, listOptions)
+	})
+}
+
+func (c *resilientResource) Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (result *unstructured.Unstructured, err error) {
+	err = c.client.call(ctx, "get", c.gvr, false, func() error {
+		result, err = c.ResourceInterface.Get(ctx, name, options, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientResource) List(ctx context.Context, opts metav1.ListOptions) (result *unstructured.UnstructuredList, err error) {
+	err = c.client.call(ctx, "list", c.gvr, false, func() error {
+		result, err = c.ResourceInterface.List(ctx, opts)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientResource) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if retryAfter := c.client.breaker.allow(); retryAfter > 0 {
+		return nil, &circuitOpenError{gvr: c.gvr, retryAfter: retryAfter}
+	}
+	result, err := c.ResourceInterface.Watch(ctx, opts)
+	c.client.breaker.record(transientReason(err) != "")
+	return result, err
+}
+
+func (c *resilientResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (result *unstructured.Unstructured, err error) {
+	err = c.client.call(ctx, "patch", c.gvr, true, func() error {
+		result, err = c.ResourceInterface.Patch(ctx, name, pt, data, options, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (result *unstructured.Unstructured, err error) {
+	err = c.client.call(ctx, "apply", c.gvr, true, func() error {
+		result, err = c.ResourceInterface.Apply(ctx, name, obj, options, subresources...)
+		return err
+	})
+	return result, err
+}
+
+func (c *resilientResource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (result *unstructured.Unstructured, err error) {
+	err = c.client.call(ctx, "apply", c.gvr, true, func() error {
+		result, err = c.ResourceInterface.ApplyStatus(ctx, name, obj, options)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+const (
+	// ProjectActivityAnnotationKey annotates the namespace of a project with the last time its objects were read, so
+	// that the most recently active projects are warmed up first after a restart
+	ProjectActivityAnnotationKey = core.ClusterOrchResourceGroup + "/last-active"
+
+	// activityInterval is how often the activity of a project is recorded at most
+	activityInterval = time.Hour
+	// maxWarmupNamespaces bounds the namespaces watched in addition to the cluster-wide caches during the warmup
+	maxWarmupNamespaces = 100
+	// warmupNamespaceTimeout is how long to wait for the caches of a namespace to sync before warming up the next one
+	warmupNamespaceTimeout = 30 * time.Second
+)
+
+var namespaceResourceSchema = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+// WarmupProgress is the progress of the warmup of the read cache
+type WarmupProgress struct {
+	// Projects is the count of the projects to warm up, the most recently active ones
+	Projects int
+	// Warmed is the count of the projects whose reads are served from the cache
+	Warmed int
+	// Synced is set once the caches of all namespaces are synced, which ends the warmup
+	Synced bool
+}
+
+// Warmup warms up the caches of the most recently active projects one by one until the cluster-wide caches are
+// synced, so that their reads are served from the cache as soon as possible on large fleets; the reads of the other
+// projects are sent to the API server until then. It returns once the cluster-wide caches are synced.
+func (rc *ReadCache) Warmup(ctx context.Context) {
+	namespaces, err := New(rc.Interface).ProjectsByActivity(ctx)
+	if err != nil {
+		slog.Warn("failed to get the projects by activity, the read cache is not warmed up", "error", err)
+	}
+	if len(namespaces) > maxWarmupNamespaces {
+		namespaces = namespaces[:maxWarmupNamespaces]
+	}
+	rc.setWarmup(WarmupProgress{Projects: len(namespaces)})
+
+	warmupCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	warmed := 0
+	for _, namespace := range namespaces {
+		if rc.HasSynced() {
+			break
+		}
+		if err := rc.warmupNamespace(warmupCtx, namespace); err != nil {
+			slog.Warn("failed to warm up the read cache of the project", "namespace", namespace, "error", err)
+			continue
+		}
+		warmed++
+		rc.setWarmup(WarmupProgress{Projects: len(namespaces), Warmed: warmed})
+		slog.Debug("read cache of the project warmed up", "namespace", namespace, "warmed", warmed, "projects", len(namespaces))
+	}
+
+	if !rc.WaitForSync(ctx) {
+		return
+	}
+	rc.setWarmup(WarmupProgress{Projects: len(namespaces), Warmed: len(namespaces), Synced: true})
+	slog.Info("read cache synced", "warmed", warmed, "projects", len(namespaces))
+
+	// the caches of the namespaces are not read anymore
+	stop()
+	for _, resource := range rc.resources {
+		resource.mu.Lock()
+		resource.warm = map[string]cache.SharedIndexInformer{}
+		resource.mu.Unlock()
+	}
+}
+
+// WarmupProgress returns the progress of the warmup of the read cache
+func (rc *ReadCache) WarmupProgress() WarmupProgress {
+	rc.warmupMu.Lock()
+	defer rc.warmupMu.Unlock()
+	progress := rc.warmup
+	if !progress.Synced && rc.HasSynced() {
+		// the caches may be synced before the warmup got the projects
+		progress.Synced = true
+	}
+	return progress
+}
+
+func (rc *ReadCache) setWarmup(progress WarmupProgress) {
+	rc.warmupMu.Lock()
+	rc.warmup = progress
+	rc.warmupMu.Unlock()
+}
+
+// warmupNamespace starts the caches of the resources in the namespace and waits for them to sync
+func (rc *ReadCache) warmupNamespace(ctx context.Context, namespace string) error {
+	synced := make([]cache.InformerSynced, 0, len(rc.resources))
+	for gvr, resource := range rc.resources {
+		informer := dynamicinformer.NewFilteredDynamicInformer(rc.Interface, gvr, namespace, defaultInformerResync,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, resource.tweak).Informer()
+		go informer.Run(ctx.Done())
+		synced = append(synced, informer.HasSynced)
+
+		resource.mu.Lock()
+		resource.warm[namespace] = informer
+		resource.mu.Unlock()
+	}
+
+	syncCtx, cancel := context.WithTimeout(ctx, warmupNamespaceTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), synced...) {
+		return syncCtx.Err()
+	}
+	return nil
+}
+
+// recordActivity records the activity of the project of the namespace at most every activityInterval
+func (rc *ReadCache) recordActivity(namespace string) {
+	if namespace == "" {
+		return
+	}
+
+	now := time.Now()
+	rc.activityMu.Lock()
+	recorded := now.Sub(rc.activity[namespace]) < activityInterval
+	if !recorded {
+		rc.activity[namespace] = now
+	}
+	rc.activityMu.Unlock()
+	if recorded {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := New(rc.Interface).RecordProjectActivity(ctx, namespace, now); err != nil {
+			slog.Debug("failed to record project activity", "namespace", namespace, "error", err)
+		}
+	}()
+}
+
+// RecordProjectActivity annotates the namespace of the project with the time of its activity
+func (c *Client) RecordProjectActivity(ctx context.Context, namespace string, at time.Time) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{ProjectActivityAnnotationKey: at.UTC().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.Dyn.Resource(namespaceResourceSchema).Patch(ctx, namespace, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// ProjectsByActivity returns the namespaces of the projects that were active, the most recently active first
+func (c *Client) ProjectsByActivity(ctx context.Context) ([]string, error) {
+	list, err := c.Dyn.Resource(namespaceResourceSchema).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	activity := map[string]time.Time{}
+	namespaces := []string{}
+	for _, namespace := range list.Items {
+		at, err := time.Parse(time.RFC3339, namespace.GetAnnotations()[ProjectActivityAnnotationKey])
+		if err != nil {
+			continue
+		}
+		activity[namespace.GetName()] = at
+		namespaces = append(namespaces, namespace.GetName())
+	}
+	sort.SliceStable(namespaces, func(i, j int) bool {
+		return activity[namespaces[i]].After(activity[namespaces[j]])
+	})
+	return namespaces, nil
+}
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kubeconfigs processes the kubeconfigs of the clusters served to the users with a pipeline of steps configured
+// per deployment, and cleans up the kubeconfig secrets that outlive their cluster. Cluster API deletes the kubeconfig
+// secret of a cluster with the cluster through its owner reference, but a secret whose owner reference is missing,
+// e.g. because the cluster was moved or restored from a backup, is kept after the cluster is deleted.
+package kubeconfigs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+const (
+	// DefaultInterval is the default time between two sweeps of the kubeconfig secrets of all projects
+	DefaultInterval = time.Hour
+	// OrphanedAtAnnotationKey annotates a kubeconfig secret with the time its cluster was found deleted, the secret is
+	// deleted once it was retained for the retention
+	OrphanedAtAnnotationKey = core.ClusterOrchResourceGroup + "/orphaned-at"
+
+	kubeconfigSuffix = "-kubeconfig"
+	// queueSize bounds the deleted clusters waiting for the cleanup of their kubeconfig, the clusters of a full queue
+	// are cleaned up by the next sweep
+	queueSize = 100
+	// cleanupTimeout bounds the cleanup of the kubeconfig of a deleted cluster
+	cleanupTimeout = 30 * time.Second
+)
+
+// Cleaner deletes the kubeconfig secrets of the deleted clusters, either as soon as the deletion of the cluster is
+// observed or once they were retained for the retention, and drops the copies of the kubeconfigs cached in memory
+type Cleaner struct {
+	k8s        *k8s.Client
+	retention  time.Duration
+	interval   time.Duration
+	now        func() time.Time
+	invalidate []func(projectID, clusterName string)
+
+	deleted chan types.NamespacedName
+}
+
+// NewCleaner creates a new Cleaner of the kubeconfig secrets read and deleted with the given client
+func NewCleaner(k8sClient *k8s.Client, options ...func(*Cleaner)) *Cleaner {
+	c := &Cleaner{
+		k8s:      k8sClient,
+		interval: DefaultInterval,
+		now:      time.Now,
+		deleted:  make(chan types.NamespacedName, queueSize),
+	}
+
+	for _, o := range options {
+		o(c)
+	}
+
+	return c
+}
+
+// WithRetention is a functional option for configuring how long the kubeconfig secret of a deleted cluster is retained
+// before it is deleted; 0 deletes it immediately
+func WithRetention(retention time.Duration) func(*Cleaner) {
+	return func(c *Cleaner) {
+		c.retention = retention
+	}
+}
+
+// WithInterval is a functional option for configuring the time between two sweeps of the kubeconfig secrets
+func WithInterval(interval time.Duration) func(*Cleaner) {
+	return func(c *Cleaner) {
+		c.interval = interval
+	}
+}
+
+// WithClock is a functional option for configuring a Cleaner with the given clock
+func WithClock(now func() time.Time) func(*Cleaner) {
+	return func(c *Cleaner) {
+		c.now = now
+	}
+}
+
+// WithCacheInvalidation is a functional option for dropping the copies of the kubeconfig of a deleted cluster cached
+// in memory, e.g. by the health prober, once the deletion is observed
+func WithCacheInvalidation(invalidate func(projectID, clusterName string)) func(*Cleaner) {
+	return func(c *Cleaner) {
+		c.invalidate = append(c.invalidate, invalidate)
+	}
+}
+
+// ClusterChanged queues the cleanup of the kubeconfig of a deleted cluster, see k8s.ClusterHandler. It never blocks
+// the informer, the cleanup is left to the next sweep if the queue is full.
+func (c *Cleaner) ClusterChanged(old, new *capi.Cluster) {
+	if old == nil || new != nil {
+		return
+	}
+
+	select {
+	case c.deleted <- types.NamespacedName{Namespace: old.Namespace, Name: old.Name}:
+	default:
+		slog.Debug("kubeconfig cleanup queue full, the kubeconfig is cleaned up by the next sweep", "namespace", old.Namespace, "name", old.Name)
+	}
+}
+
+// Run sweeps the kubeconfig secrets on start and then every interval and cleans up the kubeconfigs of the deleted
+// clusters as they are queued, until the context is canceled; failures are logged and retried with the next sweep
+func (c *Cleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	if err := c.Sweep(ctx); err != nil {
+		slog.Error("failed to clean up the kubeconfig secrets of deleted clusters", "error", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case cluster := <-c.deleted:
+			cleanupCtx, cancel := context.WithTimeout(ctx, cleanupTimeout)
+			if err := c.ClusterDeleted(cleanupCtx, cluster.Namespace, cluster.Name); err != nil {
+				slog.Warn("failed to clean up the kubeconfig secret of deleted cluster", "namespace", cluster.Namespace, "name", cluster.Name, "error", err)
+			}
+			cancel()
+		case <-ticker.C:
+			if err := c.Sweep(ctx); err != nil {
+				slog.Error("failed to clean up the kubeconfig secrets of deleted clusters", "error", err)
+			}
+		}
+	}
+}
+
+// ClusterDeleted drops the cached copies of the kubeconfig of the deleted cluster and deletes or retains its secret
+func (c *Cleaner) ClusterDeleted(ctx context.Context, namespace, clusterName string) error {
+	c.drop(namespace, clusterName)
+
+	secret, err := c.k8s.Dyn.Resource(core.SecretResourceSchema).Namespace(namespace).Get(ctx, clusterName+kubeconfigSuffix, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil // deleted with the cluster
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get kubeconfig secret: %w", err)
+	}
+	if name, ok := kubeconfigOf(secret); !ok || name != clusterName {
+		return nil
+	}
+
+	// the cluster may have been created again meanwhile
+	_, err = c.k8s.Dyn.Resource(core.ClusterResourceSchema).Namespace(namespace).Get(ctx, clusterName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+	return c.reconcile(ctx, secret, false)
+}
+
+// Sweep detects the kubeconfig secrets of all projects whose cluster does not exist anymore, marks them as orphaned
+// and deletes those retained for the retention
+func (c *Cleaner) Sweep(ctx context.Context) error {
+	secrets, err := c.k8s.Dyn.Resource(core.SecretResourceSchema).List(ctx, metav1.ListOptions{LabelSelector: capi.ClusterNameLabel})
+	if err != nil {
+		return fmt.Errorf("failed to list kubeconfig secrets: %w", err)
+	}
+	// the clusters are listed after the secrets, so that the cluster of a secret created meanwhile is found
+	clusters, err := k8s.ListClusters(ctx, c.k8s.Dyn, "", k8slabels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+	exists := make(map[types.NamespacedName]bool, len(clusters))
+	for _, cluster := range clusters {
+		exists[types.NamespacedName{Namespace: cluster.GetNamespace(), Name: cluster.GetName()}] = true
+	}
+
+	var errs []error
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		clusterName, ok := kubeconfigOf(secret)
+		if !ok {
+			continue
+		}
+		if err := c.reconcile(ctx, secret, exists[types.NamespacedName{Namespace: secret.GetNamespace(), Name: clusterName}]); err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", secret.GetNamespace(), secret.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reconcile marks the kubeconfig secret of a deleted cluster as orphaned and deletes it once it was retained for the
+// retention; the mark is removed if the cluster was created again
+func (c *Cleaner) reconcile(ctx context.Context, secret *unstructured.Unstructured, clusterExists bool) error {
+	secrets := c.k8s.Dyn.Resource(core.SecretResourceSchema).Namespace(secret.GetNamespace())
+	clusterName, _ := kubeconfigOf(secret)
+	annotations := secret.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	mark, marked := annotations[OrphanedAtAnnotationKey]
+
+	if clusterExists {
+		if !marked {
+			return nil
+		}
+		delete(annotations, OrphanedAtAnnotationKey)
+		secret.SetAnnotations(annotations)
+		_, err := secrets.Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	}
+
+	now := c.now()
+	if !marked {
+		metrics.OrphanedKubeconfigCounter.WithLabelValues("detected").Inc()
+		slog.Info("kubeconfig secret of deleted cluster detected", "namespace", secret.GetNamespace(), "name", secret.GetName(), "retention", c.retention)
+		c.drop(secret.GetNamespace(), clusterName)
+	}
+	orphanedAt, err := time.Parse(time.RFC3339, mark)
+	if err != nil {
+		// not marked yet or the mark is invalid, the secret is retained from now on
+		orphanedAt = now
+		if c.retention > 0 {
+			annotations[OrphanedAtAnnotationKey] = now.UTC().Format(time.RFC3339)
+			secret.SetAnnotations(annotations)
+			_, err := secrets.Update(ctx, secret, metav1.UpdateOptions{})
+			return err
+		}
+	}
+	if now.Sub(orphanedAt) < c.retention {
+		return nil
+	}
+
+	// the precondition keeps a secret created again for a new cluster of the same name
+	uid := secret.GetUID()
+	err = secrets.Delete(ctx, secret.GetName(), metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	metrics.OrphanedKubeconfigCounter.WithLabelValues("cleaned").Inc()
+	slog.Info("kubeconfig secret of deleted cluster deleted", "namespace", secret.GetNamespace(), "name", secret.GetName(), "orphanedAt", orphanedAt)
+	return nil
+}
+
+// drop drops the cached copies of the kubeconfig of the cluster
+func (c *Cleaner) drop(namespace, clusterName string) {
+	for _, invalidate := range c.invalidate {
+		invalidate(namespace, clusterName)
+	}
+}
+
+// kubeconfigOf returns the name of the cluster of a kubeconfig secret cluster-api created, false for other secrets
+func kubeconfigOf(secret *unstructured.Unstructured) (string, bool) {
+	clusterName := secret.GetLabels()[capi.ClusterNameLabel]
+	if clusterName == "" || secret.GetName() != clusterName+kubeconfigSuffix {
+		return "", false
+	}
+	return clusterName, true
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeconfigs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+func createCluster(t *testing.T, client *k8s.Client, name string) {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(capi.GroupVersion.WithKind("Cluster"))
+	cluster.SetNamespace(projectID)
+	cluster.SetName(name)
+	_, err := client.Dyn.Resource(core.ClusterResourceSchema).Namespace(projectID).Create(context.Background(), cluster, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func deleteCluster(t *testing.T, client *k8s.Client, name string) {
+	err := client.Dyn.Resource(core.ClusterResourceSchema).Namespace(projectID).Delete(context.Background(), name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+}
+
+func createSecret(t *testing.T, client *k8s.Client, clusterName, name string) {
+	secret := &unstructured.Unstructured{}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetNamespace(projectID)
+	secret.SetName(name)
+	secret.SetLabels(map[string]string{capi.ClusterNameLabel: clusterName})
+	_, err := client.Dyn.Resource(core.SecretResourceSchema).Namespace(projectID).Create(context.Background(), secret, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func getSecret(t *testing.T, client *k8s.Client, name string) *unstructured.Unstructured {
+	secret, err := client.Dyn.Resource(core.SecretResourceSchema).Namespace(projectID).Get(context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	return secret
+}
+
+func TestCleaner(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	t.Run("orphaned kubeconfigs are deleted immediately without retention", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		createCluster(t, client, "edge-1")
+		createSecret(t, client, "edge-1", "edge-1-kubeconfig")
+		createSecret(t, client, "edge-2", "edge-2-kubeconfig")
+		createSecret(t, client, "edge-2", "edge-2-ca")
+
+		var dropped []string
+		cleaner := NewCleaner(client, WithClock(clock), WithCacheInvalidation(func(projectID, clusterName string) {
+			dropped = append(dropped, projectID+"/"+clusterName)
+		}))
+		require.NoError(t, cleaner.Sweep(context.Background()))
+
+		require.NotNil(t, getSecret(t, client, "edge-1-kubeconfig"))
+		require.Nil(t, getSecret(t, client, "edge-2-kubeconfig"))
+		// only the kubeconfig secrets are cleaned up
+		require.NotNil(t, getSecret(t, client, "edge-2-ca"))
+		require.Equal(t, []string{projectID + "/edge-2"}, dropped)
+	})
+
+	t.Run("orphaned kubeconfigs are retained for the retention", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		createSecret(t, client, "edge-1", "edge-1-kubeconfig")
+
+		cleaner := NewCleaner(client, WithClock(clock), WithRetention(7*24*time.Hour))
+		require.NoError(t, cleaner.Sweep(context.Background()))
+		secret := getSecret(t, client, "edge-1-kubeconfig")
+		require.NotNil(t, secret)
+		require.Equal(t, now.Format(time.RFC3339), secret.GetAnnotations()[OrphanedAtAnnotationKey])
+
+		now = now.Add(6 * 24 * time.Hour)
+		require.NoError(t, cleaner.Sweep(context.Background()))
+		require.NotNil(t, getSecret(t, client, "edge-1-kubeconfig"))
+
+		now = now.Add(24 * time.Hour)
+		require.NoError(t, cleaner.Sweep(context.Background()))
+		require.Nil(t, getSecret(t, client, "edge-1-kubeconfig"))
+	})
+
+	t.Run("kubeconfigs of clusters created again are not orphaned anymore", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		createSecret(t, client, "edge-1", "edge-1-kubeconfig")
+
+		cleaner := NewCleaner(client, WithClock(clock), WithRetention(24*time.Hour))
+		require.NoError(t, cleaner.Sweep(context.Background()))
+		require.Contains(t, getSecret(t, client, "edge-1-kubeconfig").GetAnnotations(), OrphanedAtAnnotationKey)
+
+		createCluster(t, client, "edge-1")
+		now = now.Add(48 * time.Hour)
+		require.NoError(t, cleaner.Sweep(context.Background()))
+		secret := getSecret(t, client, "edge-1-kubeconfig")
+		require.NotNil(t, secret)
+		require.NotContains(t, secret.GetAnnotations(), OrphanedAtAnnotationKey)
+	})
+
+	t.Run("kubeconfig of a deleted cluster", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		createCluster(t, client, "edge-1")
+		createSecret(t, client, "edge-1", "edge-1-kubeconfig")
+
+		var dropped []string
+		cleaner := NewCleaner(client, WithClock(clock), WithCacheInvalidation(func(projectID, clusterName string) {
+			dropped = append(dropped, projectID+"/"+clusterName)
+		}))
+		// the cluster still exists, e.g. it was created again meanwhile
+		require.NoError(t, cleaner.ClusterDeleted(context.Background(), projectID, "edge-1"))
+		require.NotNil(t, getSecret(t, client, "edge-1-kubeconfig"))
+
+		deleteCluster(t, client, "edge-1")
+		require.NoError(t, cleaner.ClusterDeleted(context.Background(), projectID, "edge-1"))
+		require.Nil(t, getSecret(t, client, "edge-1-kubeconfig"))
+		require.Contains(t, dropped, projectID+"/edge-1")
+
+		// the secret was already deleted, e.g. by cluster-api
+		require.NoError(t, cleaner.ClusterDeleted(context.Background(), projectID, "edge-1"))
+	})
+}
@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeconfigs
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Kubeconfig is the kubeconfig of a cluster as it is processed by the steps of a Pipeline. The kubeconfig served to
+// the users has a single cluster, user and context built from its fields; the other fields of the kubeconfig of the
+// cluster, e.g. its preferences, are kept.
+type Kubeconfig struct {
+	// Namespace and ClusterName identify the cluster of the kubeconfig
+	Namespace   string
+	ClusterName string
+	// Server is the server URL of the cluster, the internal URL of the connect gateway until it is rewritten
+	Server string
+	// CAData is the base64 encoded certificate authority data of the server
+	CAData string
+	// UserName is the name of the user of the kubeconfig
+	UserName string
+	// ContextName is the name of the context of the kubeconfig, '{user}@{cluster}' if empty
+	ContextName string
+	// User are the credentials of the user, e.g. a token or an exec credential plugin
+	User map[string]interface{}
+
+	config map[string]interface{}
+}
+
+// Parse parses the kubeconfig of the given cluster as stored in its kubeconfig secret
+func Parse(raw, namespace, clusterName string) (*Kubeconfig, error) {
+	var config map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal kubeconfig: %w", err)
+	}
+
+	var parsed struct {
+		Clusters []struct {
+			Cluster struct {
+				Server string `yaml:"server"`
+				CAData string `yaml:"certificate-authority-data"`
+			} `yaml:"cluster"`
+		} `yaml:"clusters"`
+	}
+	if err := yaml.Unmarshal([]byte(raw), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig data: %w", err)
+	}
+	if len(parsed.Clusters) == 0 {
+		return nil, fmt.Errorf("no clusters found in kubeconfig")
+	}
+
+	return &Kubeconfig{
+		Namespace:   namespace,
+		ClusterName: clusterName,
+		Server:      parsed.Clusters[0].Cluster.Server,
+		CAData:      parsed.Clusters[0].Cluster.CAData,
+		UserName:    clusterName,
+		config:      config,
+	}, nil
+}
+
+// Marshal returns the YAML of the kubeconfig
+func (k *Kubeconfig) Marshal() (string, error) {
+	contextName := k.ContextName
+	if contextName == "" {
+		contextName = k.UserName + "@" + k.ClusterName
+	}
+
+	config := k.config
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	config["apiVersion"] = "v1"
+	config["kind"] = "Config"
+	config["clusters"] = []map[string]interface{}{
+		{
+			"name": k.ClusterName,
+			"cluster": map[string]interface{}{
+				"server":                     k.Server,
+				"certificate-authority-data": k.CAData,
+			},
+		},
+	}
+	config["users"] = []map[string]interface{}{
+		{
+			"name": k.UserName,
+			"user": k.User,
+		},
+	}
+	config["contexts"] = []map[string]interface{}{
+		{
+			"name": contextName,
+			"context": map[string]interface{}{
+				"user":    k.UserName,
+				"cluster": k.ClusterName,
+			},
+		},
+	}
+	config["current-context"] = contextName
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal updated kubeconfig: %w", err)
+	}
+	return string(data), nil
+}
+
+// Step is a step of a Pipeline modifying the kubeconfig of a cluster
+type Step interface {
+	Process(ctx context.Context, kubeconfig *Kubeconfig) error
+}
+
+// StepFunc is a function implementing Step
+type StepFunc func(ctx context.Context, kubeconfig *Kubeconfig) error
+
+// Process calls the function
+func (f StepFunc) Process(ctx context.Context, kubeconfig *Kubeconfig) error {
+	return f(ctx, kubeconfig)
+}
+
+// Pipeline processes the kubeconfigs of the clusters with the steps configured for the deployment, e.g. to rewrite the
+// server URL, followed by the steps of the request, e.g. to set the credentials of the user
+type Pipeline struct {
+	steps []Step
+}
+
+// NewPipeline creates a new Pipeline with the given steps
+func NewPipeline(steps ...Step) *Pipeline {
+	return &Pipeline{steps: steps}
+}
+
+// Process applies the steps of the pipeline and then the given steps to the kubeconfig and returns its YAML
+func (p *Pipeline) Process(ctx context.Context, kubeconfig *Kubeconfig, steps ...Step) (string, error) {
+	for _, step := range append(append([]Step{}, p.steps...), steps...) {
+		if err := step.Process(ctx, kubeconfig); err != nil {
+			return "", err
+		}
+	}
+	return kubeconfig.Marshal()
+}
+
+// DefaultGatewayURL returns the URL of the connect gateway of the given cluster domain the users reach the clusters
+// through
+func DefaultGatewayURL(clusterDomain string) string {
+	return fmt.Sprintf("https://connect-gateway.%s:443", clusterDomain)
+}
+
+// RewriteServerURL returns a Step rewriting the internal URL of the connect gateway of the kubeconfig secrets, e.g.
+// http://edge-connect-gateway-cluster-connect-gateway.orch-cluster.svc:8080/kubernetes/<project-id>-<cluster-name>,
+// to the URL of the gateway the users reach the clusters through, e.g.
+// https://connect-gateway.<domain>:443/kubernetes/<project-id>-<cluster-name>
+func RewriteServerURL(gatewayURL string) Step {
+	gatewayURL = strings.TrimSuffix(gatewayURL, "/")
+	return StepFunc(func(_ context.Context, k *Kubeconfig) error {
+		path := fmt.Sprintf("/kubernetes/%s-%s", k.Namespace, k.ClusterName)
+		index := strings.Index(k.Server, path)
+		if index == -1 {
+			return fmt.Errorf("known part not found in URL")
+		}
+		k.Server = gatewayURL + path + k.Server[index+len(path):]
+		return nil
+	})
+}
+
+// RenameContext returns a Step naming the context of the kubeconfig after the given format, in which '{project}',
+// '{cluster}' and '{user}' are replaced with the project id, the cluster name and the user name
+func RenameContext(format string) Step {
+	return StepFunc(func(_ context.Context, k *Kubeconfig) error {
+		k.ContextName = strings.NewReplacer("{project}", k.Namespace, "{cluster}", k.ClusterName, "{user}", k.UserName).Replace(format)
+		return nil
+	})
+}
+
+// LoadCABundle reads the PEM encoded CA certificates of the given file, see MergeCABundle
+func LoadCABundle(path string) ([]byte, error) {
+	bundle, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig CA bundle: %w", err)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("no PEM encoded certificates found in kubeconfig CA bundle %s", path)
+	}
+	return bundle, nil
+}
+
+// MergeCABundle returns a Step adding the given PEM encoded CA certificates to the certificate authority of the
+// server, e.g. the CA of a TLS terminating proxy in front of the connect gateway
+func MergeCABundle(bundle []byte) Step {
+	return StepFunc(func(_ context.Context, k *Kubeconfig) error {
+		ca, err := base64.StdEncoding.DecodeString(k.CAData)
+		if err != nil {
+			return fmt.Errorf("failed to decode certificate authority data: %w", err)
+		}
+		if len(ca) > 0 && !strings.HasSuffix(string(ca), "\n") {
+			ca = append(ca, '\n')
+		}
+		k.CAData = base64.StdEncoding.EncodeToString(append(ca, bundle...))
+		return nil
+	})
+}
+
+// InjectToken returns a Step authenticating the user of the kubeconfig with the given token
+func InjectToken(token string) Step {
+	return StepFunc(func(_ context.Context, k *Kubeconfig) error {
+		k.User = map[string]interface{}{"token": token}
+		return nil
+	})
+}
+
+// OIDCExec returns a Step configuring the user of the kubeconfig with the kubectl oidc-login exec credential plugin
+// instead of a token, so that kubectl logs in to the OIDC provider and refreshes the tokens itself
+func OIDCExec(issuer, clientID string) Step {
+	return StepFunc(func(_ context.Context, k *Kubeconfig) error {
+		k.User = map[string]interface{}{
+			"exec": map[string]interface{}{
+				"apiVersion": "client.authentication.k8s.io/v1beta1",
+				"command":    "kubectl",
+				"args": []string{
+					"oidc-login",
+					"get-token",
+					"--oidc-issuer-url=" + issuer,
+					"--oidc-client-id=" + clientID,
+				},
+				"interactiveMode": "IfAvailable",
+			},
+		}
+		return nil
+	})
+}
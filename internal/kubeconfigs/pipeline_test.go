@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeconfigs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secretKubeconfig = `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2x1c3Rlci1jYQ==
+    server: http://edge-connect-gateway-cluster-connect-gateway.orch-cluster.svc:8080/kubernetes/655a6892-4280-4c37-97b1-31161ac0b99e-edge
+  name: edge
+contexts:
+- context:
+    cluster: edge
+    user: edge-admin
+  name: edge-admin@edge
+current-context: edge-admin@edge
+kind: Config
+preferences: {}
+users:
+- name: edge-admin
+  user:
+    client-key-data: a2V5
+`
+
+func testCA(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "proxy-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestPipeline(t *testing.T) {
+	t.Run("deployment and request steps", func(t *testing.T) {
+		kubeconfig, err := Parse(secretKubeconfig, projectID, "edge")
+		require.NoError(t, err)
+		require.Equal(t, "Y2x1c3Rlci1jYQ==", kubeconfig.CAData)
+		kubeconfig.UserName = "edge-admin"
+
+		pipeline := NewPipeline(RewriteServerURL("https://clusters.example.com/"), RenameContext("{project}-{cluster}"))
+		processed, err := pipeline.Process(context.Background(), kubeconfig, InjectToken("token"))
+		require.NoError(t, err)
+		assert.YAMLEq(t, `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2x1c3Rlci1jYQ==
+    server: https://clusters.example.com/kubernetes/655a6892-4280-4c37-97b1-31161ac0b99e-edge
+  name: edge
+contexts:
+- context:
+    cluster: edge
+    user: edge-admin
+  name: 655a6892-4280-4c37-97b1-31161ac0b99e-edge
+current-context: 655a6892-4280-4c37-97b1-31161ac0b99e-edge
+kind: Config
+preferences: {}
+users:
+- name: edge-admin
+  user:
+    token: token
+`, processed)
+	})
+
+	t.Run("oidc exec plugin", func(t *testing.T) {
+		kubeconfig, err := Parse(secretKubeconfig, projectID, "edge")
+		require.NoError(t, err)
+
+		_, err = NewPipeline().Process(context.Background(), kubeconfig, OIDCExec("https://keycloak.example.com/realms/master", "system-client"))
+		require.NoError(t, err)
+		require.Contains(t, kubeconfig.User, "exec")
+		assert.Equal(t, "edge@edge", kubeconfig.config["current-context"])
+	})
+
+	t.Run("server of another cluster", func(t *testing.T) {
+		kubeconfig, err := Parse(secretKubeconfig, projectID, "other")
+		require.NoError(t, err)
+
+		_, err = NewPipeline(RewriteServerURL(DefaultGatewayURL("kind.internal"))).Process(context.Background(), kubeconfig)
+		require.ErrorContains(t, err, "known part not found in URL")
+	})
+
+	t.Run("invalid kubeconfigs", func(t *testing.T) {
+		_, err := Parse("invalid-kubeconfig", projectID, "edge")
+		require.ErrorContains(t, err, "failed to unmarshal kubeconfig")
+		_, err = Parse("apiVersion: v1\nkind: Config", projectID, "edge")
+		require.ErrorContains(t, err, "no clusters found in kubeconfig")
+	})
+}
+
+func TestMergeCABundle(t *testing.T) {
+	bundle := testCA(t)
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(path, bundle, 0o600))
+	loaded, err := LoadCABundle(path)
+	require.NoError(t, err)
+
+	kubeconfig := &Kubeconfig{CAData: base64.StdEncoding.EncodeToString([]byte("cluster-ca"))}
+	require.NoError(t, MergeCABundle(loaded).Process(context.Background(), kubeconfig))
+	ca, err := base64.StdEncoding.DecodeString(kubeconfig.CAData)
+	require.NoError(t, err)
+	assert.Equal(t, "cluster-ca\n"+string(bundle), string(ca))
+
+	require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0o600))
+	_, err = LoadCABundle(path)
+	require.ErrorContains(t, err, "no PEM encoded certificates found")
+}
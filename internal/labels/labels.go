@@ -98,3 +98,22 @@ func Remove(labels map[string]string, keys ...string) map[string]string {
 	}
 	return labels
 }
+
+// Propagation returns the labels of a cluster created from a template that change when the template's labels change
+// from previous to current: labels added to the template are added unless the cluster has its own value, and labels
+// changed on the template are updated where the cluster still has the previous value. Labels overridden or removed on
+// the cluster and labels removed from the template are left alone.
+func Propagation(clusterLabels, previous, current map[string]string) map[string]string {
+	changes := map[string]string{}
+	for key, value := range current {
+		clusterValue, onCluster := clusterLabels[key]
+		previousValue, onTemplate := previous[key]
+		switch {
+		case onCluster && clusterValue == value:
+			continue
+		case !onCluster && !onTemplate, onCluster && onTemplate && clusterValue == previousValue:
+			changes[key] = value
+		}
+	}
+	return changes
+}
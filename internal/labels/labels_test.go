@@ -221,3 +221,40 @@ func TestValidFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestPropagation(t *testing.T) {
+	previous := map[string]string{
+		"region":  "eu",
+		"tier":    "gold",
+		"team":    "edge",
+		"removed": "true",
+	}
+	current := map[string]string{
+		"region":  "us",
+		"tier":    "silver",
+		"team":    "core",
+		"added":   "true",
+		"present": "cluster",
+	}
+	clusterLabels := map[string]string{
+		"region":  "eu",     // unchanged since creation, updated
+		"tier":    "custom", // overridden on the cluster, kept
+		"removed": "true",   // removed from the template, kept
+		"present": "user",   // set on the cluster before it was added to the template, kept
+		// team was removed from the cluster, not added again
+	}
+
+	want := map[string]string{
+		"region": "us",
+		"added":  "true",
+	}
+
+	got := labels.Propagation(clusterLabels, previous, current)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("propagation mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := labels.Propagation(labels.Merge(clusterLabels, want), previous, current); len(got) != 0 {
+		t.Errorf("propagated labels are propagated again: %v", got)
+	}
+}
@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package leader elects the leader of the replicas of the cluster manager with a Lease, so that the workers that must
+// run once, e.g. the tenancy watcher and the sweeps of the deleted clusters, run on a single replica only. The leader
+// renews the Lease every retry period; another replica takes over once the Lease was not renewed for the lease
+// duration. The expiry is measured with the clock of the observing replica, so the clocks of the replicas need not be
+// in sync.
+package leader
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+const (
+	// ModeActiveActive serves the requests on all replicas, the workers that must run once run on the leader only
+	ModeActiveActive = "active-active"
+	// ModeActivePassive serves the requests on the leader only, the other replicas are ready to take over
+	ModeActivePassive = "active-passive"
+
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+)
+
+// Modes are the valid modes of the replicas
+var Modes = []string{ModeActiveActive, ModeActivePassive}
+
+type worker struct {
+	name string
+	run  func(ctx context.Context)
+}
+
+// Elector campaigns for the Lease of the leader and runs the registered workers while it holds it
+type Elector struct {
+	dyn       dynamic.Interface
+	namespace string
+	name      string
+	identity  string
+
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	now           func() time.Time
+
+	leading atomic.Bool
+
+	// observed is the last observed spec of the Lease and observedAt the local time it was observed at
+	observed   coordinationv1.LeaseSpec
+	observedAt time.Time
+	renewedAt  time.Time
+
+	mu      sync.Mutex
+	workers []worker
+	ctx     context.Context
+	cancel  context.CancelFunc
+	running sync.WaitGroup
+}
+
+// NewElector creates a new Elector campaigning for the Lease of the given namespace and name as the given identity,
+// e.g. the name of the pod
+func NewElector(dyn dynamic.Interface, namespace, name, identity string, options ...func(*Elector)) *Elector {
+	e := &Elector{
+		dyn:           dyn,
+		namespace:     namespace,
+		name:          name,
+		identity:      identity,
+		leaseDuration: defaultLeaseDuration,
+		renewDeadline: defaultRenewDeadline,
+		retryPeriod:   defaultRetryPeriod,
+		now:           time.Now,
+	}
+	for _, option := range options {
+		option(e)
+	}
+	return e
+}
+
+// WithTiming is a functional option for configuring the lease duration, the time the leader keeps leading without
+// renewing the Lease and the time between two attempts to acquire or renew it; the renew deadline must be shorter
+// than the lease duration, so that the leader stops its workers before another replica takes over
+func WithTiming(leaseDuration, renewDeadline, retryPeriod time.Duration) func(*Elector) {
+	return func(e *Elector) {
+		e.leaseDuration = leaseDuration
+		e.renewDeadline = renewDeadline
+		e.retryPeriod = retryPeriod
+	}
+}
+
+// WithClock is a functional option for configuring an Elector with the given clock
+func WithClock(now func() time.Time) func(*Elector) {
+	return func(e *Elector) {
+		e.now = now
+	}
+}
+
+// IsLeader returns whether the replica currently holds the Lease
+func (e *Elector) IsLeader() bool {
+	return e.leading.Load()
+}
+
+// Go registers a worker that runs while the replica is the leader: it is started whenever the replica acquires the
+// Lease and its context is canceled when the replica loses it
+func (e *Elector) Go(name string, run func(ctx context.Context)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	w := worker{name: name, run: run}
+	e.workers = append(e.workers, w)
+	if e.ctx != nil {
+		e.start(w)
+	}
+}
+
+// Run campaigns for the Lease until the context is canceled; the workers are stopped and the Lease is released before
+// it returns, so that another replica takes over right away
+func (e *Elector) Run(ctx context.Context) {
+	slog.Info("campaigning for the leader lease", "lease", e.namespace+"/"+e.name, "identity", e.identity)
+	ticker := time.NewTicker(e.retryPeriod)
+	defer ticker.Stop()
+
+	for {
+		e.campaign(ctx)
+		select {
+		case <-ctx.Done():
+			if e.IsLeader() {
+				e.stepDown()
+				e.release(context.WithoutCancel(ctx))
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// campaign tries to acquire or renew the Lease once; the leader steps down if it failed to renew it for the renew
+// deadline
+func (e *Elector) campaign(ctx context.Context) {
+	acquired, err := e.tryAcquireOrRenew(ctx)
+	if err != nil {
+		slog.Warn("failed to acquire or renew the leader lease", "lease", e.namespace+"/"+e.name, "error", err)
+	}
+	switch {
+	case acquired:
+		e.renewedAt = e.now()
+		if !e.IsLeader() {
+			slog.Info("acquired the leader lease", "lease", e.namespace+"/"+e.name, "identity", e.identity)
+			e.lead(ctx)
+		}
+	case e.IsLeader() && (err == nil || e.now().Sub(e.renewedAt) >= e.renewDeadline):
+		slog.Warn("lost the leader lease", "lease", e.namespace+"/"+e.name, "identity", e.identity, "holder", holderOf(e.observed))
+		e.stepDown()
+	}
+}
+
+// tryAcquireOrRenew returns true if the replica holds the Lease, after creating it, taking it over if it expired or
+// renewing it
+func (e *Elector) tryAcquireOrRenew(ctx context.Context) (bool, error) {
+	now := e.now()
+	leases := e.dyn.Resource(core.LeaseResourceSchema).Namespace(e.namespace)
+
+	item, err := leases.Get(ctx, e.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		lease := coordinationv1.Lease{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "coordination.k8s.io/v1", Kind: "Lease"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: e.namespace, Name: e.name},
+			Spec:       e.spec(coordinationv1.LeaseSpec{}, now),
+		}
+		u, err := convert.ToUnstructured(lease)
+		if err != nil {
+			return false, err
+		}
+		if _, err := leases.Create(ctx, u, metav1.CreateOptions{}); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to create lease: %w", err)
+		}
+		e.observe(lease.Spec, now)
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get lease: %w", err)
+	}
+
+	var lease coordinationv1.Lease
+	if err := convert.FromUnstructured(*item, &lease); err != nil {
+		return false, fmt.Errorf("invalid lease: %w", err)
+	}
+	if !reflect.DeepEqual(lease.Spec, e.observed) {
+		e.observe(lease.Spec, now)
+	}
+	if holder := holderOf(lease.Spec); holder != "" && holder != e.identity && e.observedAt.Add(e.leaseDuration).After(now) {
+		return false, nil
+	}
+
+	lease.Spec = e.spec(lease.Spec, now)
+	u, err := convert.ToUnstructured(lease)
+	if err != nil {
+		return false, err
+	}
+	// the update fails with a conflict if another replica updated the Lease since it was read
+	if _, err := leases.Update(ctx, u, metav1.UpdateOptions{}); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to update lease: %w", err)
+	}
+	e.observe(lease.Spec, now)
+	return true, nil
+}
+
+// spec returns the spec of the Lease held by the replica, renewed at the given time
+func (e *Elector) spec(spec coordinationv1.LeaseSpec, now time.Time) coordinationv1.LeaseSpec {
+	if holder := holderOf(spec); holder != e.identity {
+		transitions := int32(0)
+		if spec.LeaseTransitions != nil {
+			transitions = *spec.LeaseTransitions
+		}
+		if holder != "" {
+			transitions++
+		}
+		spec.HolderIdentity = convert.Ptr(e.identity)
+		spec.AcquireTime = convert.Ptr(metav1.NewMicroTime(now))
+		spec.LeaseTransitions = convert.Ptr(transitions)
+	}
+	spec.LeaseDurationSeconds = convert.Ptr(int32(e.leaseDuration / time.Second))
+	spec.RenewTime = convert.Ptr(metav1.NewMicroTime(now))
+	return spec
+}
+
+// holderOf returns the identity of the replica holding the Lease, empty if it is not held
+func holderOf(spec coordinationv1.LeaseSpec) string {
+	if spec.HolderIdentity == nil {
+		return ""
+	}
+	return *spec.HolderIdentity
+}
+
+func (e *Elector) observe(spec coordinationv1.LeaseSpec, now time.Time) {
+	e.observed = spec
+	e.observedAt = now
+}
+
+// release clears the holder of the Lease so that another replica acquires it with its next attempt
+func (e *Elector) release(ctx context.Context) {
+	leases := e.dyn.Resource(core.LeaseResourceSchema).Namespace(e.namespace)
+	item, err := leases.Get(ctx, e.name, metav1.GetOptions{})
+	if err != nil {
+		slog.Warn("failed to release the leader lease", "lease", e.namespace+"/"+e.name, "error", err)
+		return
+	}
+	var lease coordinationv1.Lease
+	if err := convert.FromUnstructured(*item, &lease); err != nil || holderOf(lease.Spec) != e.identity {
+		return
+	}
+
+	lease.Spec.HolderIdentity = convert.Ptr("")
+	u, err := convert.ToUnstructured(lease)
+	if err == nil {
+		_, err = leases.Update(ctx, u, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		slog.Warn("failed to release the leader lease", "lease", e.namespace+"/"+e.name, "error", err)
+		return
+	}
+	slog.Info("released the leader lease", "lease", e.namespace+"/"+e.name, "identity", e.identity)
+}
+
+// lead starts the workers with a context that is canceled when the replica steps down
+func (e *Elector) lead(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.ctx, e.cancel = context.WithCancel(ctx)
+	e.leading.Store(true)
+	for _, w := range e.workers {
+		e.start(w)
+	}
+}
+
+func (e *Elector) start(w worker) {
+	slog.Debug("starting leader worker", "worker", w.name)
+	ctx := e.ctx
+	e.running.Add(1)
+	go func() {
+		defer e.running.Done()
+		w.run(ctx)
+	}()
+}
+
+// stepDown stops the workers and waits for them to return
+func (e *Elector) stepDown() {
+	e.mu.Lock()
+	e.leading.Store(false)
+	e.cancel()
+	e.ctx, e.cancel = nil, nil
+	e.mu.Unlock()
+	e.running.Wait()
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+)
+
+func TestElector(t *testing.T) {
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		core.LeaseResourceSchema: "LeaseList",
+	})
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	first := NewElector(dyn, "orch-cluster", "cluster-manager-leader", "cluster-manager-0", WithClock(clock))
+	second := NewElector(dyn, "orch-cluster", "cluster-manager-leader", "cluster-manager-1", WithClock(clock))
+
+	stopped := make(chan struct{})
+	first.Go("worker", func(ctx context.Context) {
+		<-ctx.Done()
+		close(stopped)
+	})
+	ctx := context.Background()
+
+	// the first replica creates the Lease and leads
+	first.campaign(ctx)
+	require.True(t, first.IsLeader())
+	second.campaign(ctx)
+	require.False(t, second.IsLeader())
+
+	// the Lease is renewed within the lease duration
+	now = now.Add(10 * time.Second)
+	first.campaign(ctx)
+	second.campaign(ctx)
+	require.True(t, first.IsLeader())
+	require.False(t, second.IsLeader())
+
+	// the second replica takes over once the Lease was not renewed for the lease duration
+	now = now.Add(defaultLeaseDuration + time.Second)
+	second.campaign(ctx)
+	require.True(t, second.IsLeader())
+	lease, err := dyn.Resource(core.LeaseResourceSchema).Namespace("orch-cluster").Get(ctx, "cluster-manager-leader", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "cluster-manager-1", lease.Object["spec"].(map[string]any)["holderIdentity"])
+	require.EqualValues(t, 1, lease.Object["spec"].(map[string]any)["leaseTransitions"])
+
+	// the first replica stops its workers when it observes the new holder
+	first.campaign(ctx)
+	require.False(t, first.IsLeader())
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("worker of the former leader was not stopped")
+	}
+
+	// the Lease is released on shutdown and acquired by the other replica right away
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		second.Run(runCtx)
+		close(done)
+	}()
+	cancel()
+	<-done
+	require.False(t, second.IsLeader())
+	first.campaign(ctx)
+	require.True(t, first.IsLeader())
+}
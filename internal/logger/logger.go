@@ -12,8 +12,9 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
 )
 
-// initializeLogger initializes the logger with the given config
-func InitializeLogger(cfg *config.Config) {
+// InitializeLogger initializes the logger with the given config, the returned recorder keeps the recent log records
+// of all levels for support bundles
+func InitializeLogger(cfg *config.Config) *Recorder {
 	replaceAttributes := func(groups []string, a slog.Attr) slog.Attr {
 		switch a.Value.Kind() {
 		case slog.KindTime:
@@ -31,17 +32,17 @@ func InitializeLogger(cfg *config.Config) {
 		return a
 	}
 
-	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	options := &slog.HandlerOptions{
 		Level:       slog.Level(cfg.LogLevel),
 		ReplaceAttr: replaceAttributes,
 		AddSource:   true,
-	})))
-
+	}
+	var handler slog.Handler = slog.NewJSONHandler(os.Stdout, options)
 	if cfg.LogFormat == "human" {
-		slog.SetDefault(slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-			Level:       slog.Level(cfg.LogLevel),
-			ReplaceAttr: replaceAttributes,
-			AddSource:   true,
-		})))
+		handler = slog.NewTextHandler(os.Stdout, options)
 	}
+
+	recorder := NewRecorder(DefaultRecorderSize)
+	slog.SetDefault(slog.New(correlationHandler{recorder.Handler(handler)}))
+	return recorder
 }
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DefaultRecorderSize is the number of recent log records kept by the recorder of the default logger
+const DefaultRecorderSize = 10000
+
+// Entry is a log record kept by a Recorder
+type Entry struct {
+	Time    time.Time         `json:"time"`
+	Level   string            `json:"level"`
+	Message string            `json:"msg"`
+	Attrs   map[string]string `json:"attrs,omitempty"`
+}
+
+// Recorder keeps the most recent log records in memory, including debug records that are not logged, so they can be
+// included in support bundles
+type Recorder struct {
+	mu      sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// NewRecorder creates a new Recorder keeping the given number of records
+func NewRecorder(size int) *Recorder {
+	return &Recorder{entries: make([]Entry, size)}
+}
+
+// Entries returns the recorded records, oldest first
+func (r *Recorder) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.full {
+		return append([]Entry(nil), r.entries[:r.next]...)
+	}
+	return append(append([]Entry(nil), r.entries[r.next:]...), r.entries[:r.next]...)
+}
+
+func (r *Recorder) add(entry Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.entries) == 0 {
+		return
+	}
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Handler returns a handler recording all records before passing those enabled by the given handler on to it
+func (r *Recorder) Handler(next slog.Handler) slog.Handler {
+	return &recordingHandler{recorder: r, next: next}
+}
+
+type recordingHandler struct {
+	recorder *Recorder
+	next     slog.Handler
+	// attrs are the attributes added to the handler, keyed by their group-qualified name
+	attrs map[string]string
+	group string
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *recordingHandler) Handle(ctx context.Context, record slog.Record) error {
+	entry := Entry{
+		Time:    record.Time,
+		Level:   record.Level.String(),
+		Message: record.Message,
+		Attrs:   map[string]string{},
+	}
+	for key, value := range h.attrs {
+		entry.Attrs[key] = value
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		entry.Attrs[h.key(attr.Key)] = attr.Value.Resolve().String()
+		return true
+	})
+	h.recorder.add(entry)
+
+	if !h.next.Enabled(ctx, record.Level) {
+		return nil
+	}
+	return h.next.Handle(ctx, record)
+}
+
+func (h *recordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = map[string]string{}
+	for key, value := range h.attrs {
+		clone.attrs[key] = value
+	}
+	for _, attr := range attrs {
+		clone.attrs[h.key(attr.Key)] = attr.Value.Resolve().String()
+	}
+	clone.next = h.next.WithAttrs(attrs)
+	return &clone
+}
+
+func (h *recordingHandler) WithGroup(name string) slog.Handler {
+	clone := *h
+	clone.group = h.key(name)
+	clone.next = h.next.WithGroup(name)
+	return &clone
+}
+
+func (h *recordingHandler) key(name string) string {
+	if h.group == "" {
+		return name
+	}
+	return h.group + "." + name
+}
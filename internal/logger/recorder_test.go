@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	var out bytes.Buffer
+	recorder := NewRecorder(3)
+	log := slog.New(correlationHandler{recorder.Handler(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelInfo}))})
+
+	ctx := WithCorrelationID(context.Background(), "req-1")
+	log.DebugContext(ctx, "received request", "path", "/v2/clusters")
+	log.With("namespace", "project").WithGroup("cluster").Info("created", "name", "cluster-1")
+	log.Info("first")
+	log.Info("second")
+
+	// debug records are recorded but not logged
+	require.NotContains(t, out.String(), "received request")
+	require.Contains(t, out.String(), "namespace=project cluster.name=cluster-1")
+
+	entries := recorder.Entries()
+	require.Len(t, entries, 3)
+	require.Equal(t, "created", entries[0].Message)
+	require.Equal(t, map[string]string{"namespace": "project", "cluster.name": "cluster-1"}, entries[0].Attrs)
+	require.Equal(t, "second", entries[2].Message)
+}
+
+func TestRecorderCorrelationID(t *testing.T) {
+	recorder := NewRecorder(10)
+	log := slog.New(correlationHandler{recorder.Handler(slog.DiscardHandler)})
+
+	log.InfoContext(WithCorrelationID(context.Background(), "req-1"), "with request")
+	log.Info("without request")
+
+	entries := recorder.Entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, "req-1", entries[0].Attrs[CorrelationIDKey])
+	require.NotContains(t, entries[1].Attrs, CorrelationIDKey)
+}
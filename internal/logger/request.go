@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ProjectIDKey and SubjectKey are the keys of the attributes of the log records written with the logger of a request:
+// its active project and the subject of its token
+const (
+	ProjectIDKey = "project_id"
+	SubjectKey   = "subject"
+)
+
+type loggerContextKey struct{}
+
+// WithLogger returns a copy of the context carrying the request-scoped logger
+func WithLogger(ctx context.Context, log *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, log)
+}
+
+// FromContext returns the request-scoped logger of the context or the default logger if it has none
+func FromContext(ctx context.Context) *slog.Logger {
+	if log, ok := ctx.Value(loggerContextKey{}).(*slog.Logger); ok {
+		return log
+	}
+	return slog.Default()
+}
+
+// NewRequestLogger returns a logger of the default handler writing its records with the given attributes and with the
+// request context, so that they carry its correlation ID although they are written without a context
+func NewRequestLogger(ctx context.Context, attrs ...any) *slog.Logger {
+	return slog.New(requestHandler{Handler: slog.Default().Handler(), ctx: ctx}).With(attrs...)
+}
+
+// requestHandler passes the records on with the request context instead of the context they are written with
+type requestHandler struct {
+	slog.Handler
+	ctx context.Context
+}
+
+func (h requestHandler) Handle(_ context.Context, record slog.Record) error {
+	return h.Handler.Handle(h.ctx, record)
+}
+
+func (h requestHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return requestHandler{Handler: h.Handler.WithAttrs(attrs), ctx: h.ctx}
+}
+
+func (h requestHandler) WithGroup(name string) slog.Handler {
+	return requestHandler{Handler: h.Handler.WithGroup(name), ctx: h.ctx}
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogger(t *testing.T) {
+	recorder := NewRecorder(10)
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(correlationHandler{recorder.Handler(slog.DiscardHandler)}))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	// without a request-scoped logger, the default logger is used
+	require.Equal(t, slog.Default(), FromContext(context.Background()))
+
+	ctx := WithCorrelationID(context.Background(), "corr-1")
+	ctx = WithLogger(ctx, NewRequestLogger(ctx, ProjectIDKey, "project-1"))
+	FromContext(ctx).With(SubjectKey, "user-1").Warn("cluster not found", "cluster", "cluster-1")
+
+	entries := recorder.Entries()
+	require.Len(t, entries, 1)
+	require.Equal(t, map[string]string{
+		ProjectIDKey:     "project-1",
+		SubjectKey:       "user-1",
+		CorrelationIDKey: "corr-1",
+		"cluster":        "cluster-1",
+	}, entries[0].Attrs)
+}
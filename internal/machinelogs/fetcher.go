@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package machinelogs retrieves the bootstrap logs of the machines of a cluster from their infrastructure provider, so
+// that stuck provisioning can be debugged without access to the management cluster
+package machinelogs
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const (
+	// DefaultTailLines is the default count of the last lines of the logs that are returned
+	DefaultTailLines = 1000
+	// MaxTailLines bounds the count of the last lines of the logs that are returned
+	MaxTailLines = 10000
+
+	// maxLogBytes bounds the logs read from the provider, the oldest lines of larger logs are dropped
+	maxLogBytes = 4 << 20
+	// fetchTimeout bounds the requests to the provider, so that an unreachable log source does not block callers
+	fetchTimeout = 30 * time.Second
+	// dockerAPIHost is the host of the requests to the Docker Engine API over its unix socket
+	dockerAPIHost = "docker"
+)
+
+// logURLField is the field of the IntelMachine status with the URL the provider serves the cloud-init log of the host at
+var logURLField = []string{"status", "logURL"}
+
+var (
+	// ErrNodeNotFound is returned if the cluster has no machine of the node
+	ErrNodeNotFound = errors.New("node not found")
+	// ErrLogsNotAvailable is returned if the provider does not serve the logs of the machine yet, e.g. before its host
+	// is bound or its container is created
+	ErrLogsNotAvailable = errors.New("the logs of the node are not available yet")
+	// ErrUnsupported is returned if the logs of the provider of the machine can not be retrieved
+	ErrUnsupported = errors.New("the logs of the provider of the node can not be retrieved")
+)
+
+// Fetcher retrieves the bootstrap logs of machines: the cloud-init log an IntelMachine reports the URL of in its status
+// and the logs of the container of a DockerMachine, read through the Docker Engine API
+type Fetcher struct {
+	k8s    *k8s.Client
+	client *http.Client
+	// docker and dockerURL reach the Docker Engine API, docker is nil unless a docker host is configured
+	docker    *http.Client
+	dockerURL string
+}
+
+// NewFetcher creates a new Fetcher reading the machines of the clusters with the given client
+func NewFetcher(k8sClient *k8s.Client, options ...func(*Fetcher)) *Fetcher {
+	f := &Fetcher{
+		k8s:    k8sClient,
+		client: &http.Client{Timeout: fetchTimeout},
+	}
+
+	for _, o := range options {
+		o(f)
+	}
+
+	return f
+}
+
+// WithHTTPClient is a functional option for configuring the client the logs at the URLs reported by the IntelMachines
+// are fetched with
+func WithHTTPClient(client *http.Client) func(*Fetcher) {
+	return func(f *Fetcher) {
+		f.client = client
+	}
+}
+
+// WithDockerHost is a functional option for configuring the Docker Engine API the logs of the DockerMachine containers
+// are read from, either a unix socket (unix:///var/run/docker.sock) or a TCP address (tcp://localhost:2375); the logs
+// of DockerMachines are unsupported without it
+func WithDockerHost(host string) func(*Fetcher) {
+	return func(f *Fetcher) {
+		if path, ok := strings.CutPrefix(host, "unix://"); ok {
+			dialer := &net.Dialer{}
+			f.docker = &http.Client{Timeout: fetchTimeout, Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", path)
+				},
+			}}
+			f.dockerURL = "http://" + dockerAPIHost
+			return
+		}
+		f.docker = &http.Client{Timeout: fetchTimeout}
+		f.dockerURL = "http://" + strings.TrimPrefix(host, "tcp://")
+	}
+}
+
+// Logs returns the last tailLines lines of the bootstrap logs of the node of the cluster in the project. The node is
+// identified by the id of its host, the name of its machine or the name of its node in the workload cluster. It returns
+// k8s.ErrClusterNotFound if the cluster does not exist.
+func (f *Fetcher) Logs(ctx context.Context, projectID, clusterName, nodeID string, tailLines int) ([]byte, error) {
+	if tailLines <= 0 || tailLines > MaxTailLines {
+		tailLines = DefaultTailLines
+	}
+
+	if _, err := f.k8s.GetCluster(ctx, projectID, clusterName); err != nil {
+		return nil, err
+	}
+	details, err := f.k8s.MachineDetails(ctx, projectID, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machines: %w", err)
+	}
+
+	for _, detail := range details {
+		if !matches(detail, nodeID) {
+			continue
+		}
+		switch detail.Machine.Spec.InfrastructureRef.Kind {
+		case "IntelMachine":
+			return f.intelMachineLogs(ctx, detail, tailLines)
+		case "DockerMachine":
+			return f.dockerMachineLogs(ctx, detail, tailLines)
+		default:
+			return nil, ErrUnsupported
+		}
+	}
+	return nil, ErrNodeNotFound
+}
+
+// matches returns whether the machine is the one of the node with the given id
+func matches(detail k8s.MachineDetails, nodeID string) bool {
+	if nodeID == detail.HostID() || nodeID == detail.Machine.Name {
+		return true
+	}
+	return detail.Machine.Status.NodeRef != nil && nodeID == detail.Machine.Status.NodeRef.Name
+}
+
+// intelMachineLogs fetches the cloud-init log of the host of the IntelMachine from the URL reported in its status
+func (f *Fetcher) intelMachineLogs(ctx context.Context, detail k8s.MachineDetails, tailLines int) ([]byte, error) {
+	if detail.ProviderMachine == nil {
+		return nil, ErrLogsNotAvailable
+	}
+	logURL, _, _ := unstructured.NestedString(detail.ProviderMachine.Object, logURLField...)
+	if logURL == "" {
+		return nil, ErrLogsNotAvailable
+	}
+	if u, err := url.Parse(logURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid log URL %q", logURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// only the end of the log is needed, sources that do not support ranges send the whole log
+	req.Header.Set("Range", fmt.Sprintf("bytes=-%d", maxLogBytes))
+	logs, err := fetch(f.client, req)
+	if err != nil {
+		return nil, err
+	}
+	return lastLines(logs, tailLines), nil
+}
+
+// dockerMachineLogs reads the logs of the container of the DockerMachine from the Docker Engine API
+func (f *Fetcher) dockerMachineLogs(ctx context.Context, detail k8s.MachineDetails, tailLines int) ([]byte, error) {
+	if f.docker == nil {
+		return nil, ErrUnsupported
+	}
+
+	query := url.Values{"stdout": {"1"}, "stderr": {"1"}, "tail": {strconv.Itoa(tailLines)}}
+	logsURL := fmt.Sprintf("%s/containers/%s/logs?%s", f.dockerURL, url.PathEscape(detail.ContainerName()), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := fetch(f.docker, req)
+	if err != nil {
+		return nil, err
+	}
+	return lastLines(demultiplex(logs), tailLines), nil
+}
+
+// fetch sends the request and returns the last maxLogBytes of the response; a missing log is ErrLogsNotAvailable
+func fetch(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch logs: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrLogsNotAvailable
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return nil, fmt.Errorf("failed to fetch logs: %s", resp.Status)
+	}
+	return readTail(resp.Body, maxLogBytes)
+}
+
+// readTail reads the reader to its end and returns its last limit bytes
+func readTail(r io.Reader, limit int) ([]byte, error) {
+	var data []byte
+	chunk := make([]byte, 32<<10)
+	for {
+		n, err := r.Read(chunk)
+		data = append(data, chunk[:n]...)
+		if len(data) > 2*limit {
+			data = append(data[:0], data[len(data)-limit:]...)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read logs: %w", err)
+		}
+	}
+	if len(data) > limit {
+		data = data[len(data)-limit:]
+	}
+	return data, nil
+}
+
+// lastLines returns the last count lines of the logs
+func lastLines(logs []byte, count int) []byte {
+	end := len(logs)
+	if end > 0 && logs[end-1] == '\n' {
+		end--
+	}
+	start := end
+	for lines := 0; lines < count; lines++ {
+		i := bytes.LastIndexByte(logs[:start], '\n')
+		if i < 0 {
+			return logs
+		}
+		start = i
+	}
+	return logs[start+1:]
+}
+
+// demultiplex returns the logs of a container without a TTY without the headers the Docker Engine API prefixes the
+// frames of its stdout and stderr with; the logs of a container with a TTY are returned as they are
+func demultiplex(logs []byte) []byte {
+	var out []byte
+	for rest := logs; len(rest) > 0; {
+		if len(rest) < 8 || rest[0] > 2 || rest[1] != 0 || rest[2] != 0 || rest[3] != 0 {
+			return logs
+		}
+		size := int(binary.BigEndian.Uint32(rest[4:8]))
+		if len(rest) < 8+size {
+			return logs
+		}
+		out = append(out, rest[8:8+size]...)
+		rest = rest[8+size:]
+	}
+	return out
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package machinelogs
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
+)
+
+const (
+	projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+	hostID    = "64e797f6-db22-445e-b606-4228d4f1c2bd"
+)
+
+func create(t *testing.T, client *k8s.Client, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) {
+	obj.SetNamespace(projectID)
+	_, err := client.Dyn.Resource(gvr).Namespace(projectID).Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+// createMachine creates a machine of the cluster backed by a provider machine of the given kind and content
+func createMachine(t *testing.T, client *k8s.Client, name, kind string, providerMachine map[string]any) {
+	machine := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"clusterName":       "edge-1",
+			"infrastructureRef": map[string]any{"kind": kind, "name": name},
+		},
+	}}
+	machine.SetGroupVersionKind(capi.GroupVersion.WithKind("Machine"))
+	machine.SetName(name)
+	machine.SetLabels(map[string]string{capi.ClusterNameLabel: "edge-1"})
+	create(t, client, core.MachineResourceSchema, machine)
+
+	if providerMachine == nil {
+		return
+	}
+	gvr := k8s.IntelMachineResourceSchema
+	if kind == "DockerMachine" {
+		gvr = k8s.DockerMachineResourceSchema
+	}
+	obj := &unstructured.Unstructured{Object: providerMachine}
+	obj.SetAPIVersion(gvr.GroupVersion().String())
+	obj.SetKind(kind)
+	obj.SetName(name)
+	create(t, client, gvr, obj)
+}
+
+func newClient(t *testing.T) *k8s.Client {
+	client := k8s.New().WithFakeClient()
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(capi.GroupVersion.WithKind("Cluster"))
+	cluster.SetName("edge-1")
+	create(t, client, core.ClusterResourceSchema, cluster)
+	return client
+}
+
+func TestLogs(t *testing.T) {
+	t.Run("cloud-init log of an IntelMachine", func(t *testing.T) {
+		var rangeHeader string
+		source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rangeHeader = r.Header.Get("Range")
+			_, _ = w.Write([]byte("line 1\nline 2\nline 3\n"))
+		}))
+		defer source.Close()
+
+		client := newClient(t)
+		createMachine(t, client, "edge-1-cp-abcde", "IntelMachine", map[string]any{
+			"metadata": map[string]any{"annotations": map[string]any{nodemetadata.HostIdAnnotationKey: hostID}},
+			"status":   map[string]any{"logURL": source.URL + "/cloud-init.log"},
+		})
+
+		fetcher := NewFetcher(client)
+		logs, err := fetcher.Logs(context.Background(), projectID, "edge-1", hostID, 2)
+		require.NoError(t, err)
+		require.Equal(t, "line 2\nline 3\n", string(logs))
+		require.Equal(t, "bytes=-4194304", rangeHeader)
+
+		// the machine name identifies the node as well
+		logs, err = fetcher.Logs(context.Background(), projectID, "edge-1", "edge-1-cp-abcde", 0)
+		require.NoError(t, err)
+		require.Equal(t, "line 1\nline 2\nline 3\n", string(logs))
+	})
+
+	t.Run("logs of an IntelMachine without log URL are not available", func(t *testing.T) {
+		client := newClient(t)
+		createMachine(t, client, "edge-1-cp-abcde", "IntelMachine", map[string]any{
+			"metadata": map[string]any{"annotations": map[string]any{nodemetadata.HostIdAnnotationKey: hostID}},
+		})
+		createMachine(t, client, "edge-1-cp-fghij", "IntelMachine", nil)
+
+		fetcher := NewFetcher(client)
+		_, err := fetcher.Logs(context.Background(), projectID, "edge-1", hostID, 0)
+		require.ErrorIs(t, err, ErrLogsNotAvailable)
+		_, err = fetcher.Logs(context.Background(), projectID, "edge-1", "edge-1-cp-fghij", 0)
+		require.ErrorIs(t, err, ErrLogsNotAvailable)
+	})
+
+	t.Run("logs of a DockerMachine container", func(t *testing.T) {
+		var path, tail string
+		docker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path, tail = r.URL.Path, r.URL.Query().Get("tail")
+			// the logs of a container without a TTY are multiplexed
+			for _, line := range []string{"stdout line\n", "stderr line\n"} {
+				header := make([]byte, 8)
+				header[0] = 1
+				binary.BigEndian.PutUint32(header[4:], uint32(len(line)))
+				_, _ = w.Write(append(header, line...))
+			}
+		}))
+		defer docker.Close()
+
+		client := newClient(t)
+		createMachine(t, client, "edge-1-md-abcde", "DockerMachine", map[string]any{})
+
+		fetcher := NewFetcher(client, WithDockerHost("tcp://"+strings.TrimPrefix(docker.URL, "http://")))
+		logs, err := fetcher.Logs(context.Background(), projectID, "edge-1", "edge-1-md-abcde", 10)
+		require.NoError(t, err)
+		require.Equal(t, "stdout line\nstderr line\n", string(logs))
+		require.Equal(t, "/containers/edge-1-md-abcde/logs", path)
+		require.Equal(t, "10", tail)
+	})
+
+	t.Run("logs of a DockerMachine are unsupported without docker host", func(t *testing.T) {
+		client := newClient(t)
+		createMachine(t, client, "edge-1-md-abcde", "DockerMachine", map[string]any{})
+
+		_, err := NewFetcher(client).Logs(context.Background(), projectID, "edge-1", "edge-1-md-abcde", 0)
+		require.ErrorIs(t, err, ErrUnsupported)
+	})
+
+	t.Run("unknown node and cluster", func(t *testing.T) {
+		client := newClient(t)
+		fetcher := NewFetcher(client)
+
+		_, err := fetcher.Logs(context.Background(), projectID, "edge-1", hostID, 0)
+		require.ErrorIs(t, err, ErrNodeNotFound)
+		_, err = fetcher.Logs(context.Background(), projectID, "edge-2", hostID, 0)
+		require.ErrorIs(t, err, k8s.ErrClusterNotFound)
+	})
+}
+
+func TestLastLines(t *testing.T) {
+	require.Equal(t, "c\n", string(lastLines([]byte("a\nb\nc\n"), 1)))
+	require.Equal(t, "b\nc", string(lastLines([]byte("a\nb\nc"), 2)))
+	require.Equal(t, "a\nb\n", string(lastLines([]byte("a\nb\n"), 5)))
+	require.Equal(t, "", string(lastLines([]byte{}, 5)))
+}
+
+func TestReadTail(t *testing.T) {
+	logs, err := readTail(strings.NewReader(strings.Repeat("x", 100)+"tail"), 10)
+	require.NoError(t, err)
+	require.Equal(t, "xxxxxxtail", string(logs))
+}
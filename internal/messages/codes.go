@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package messages
+
+// codes of the messages of the service and its optional features
+const (
+	ServiceNotReady                  Code = "SERVICE_NOT_READY"
+	K8sClientFailed                  Code = "K8S_CLIENT_FAILED"
+	ActiveProjectIDMissing           Code = "ACTIVE_PROJECT_ID_MISSING"
+	RequestBodyMissing               Code = "REQUEST_BODY_MISSING"
+	RequestBodyReadFailed            Code = "REQUEST_BODY_READ_FAILED"
+	InvalidYAMLRequestBody           Code = "INVALID_YAML_REQUEST_BODY"
+	InvalidParameters                Code = "INVALID_PARAMETERS"
+	InvalidRequest                   Code = "INVALID_REQUEST"
+	AuthenticationFailed             Code = "AUTHENTICATION_FAILED"
+	RouteNotFound                    Code = "ROUTE_NOT_FOUND"
+	InternalError                    Code = "INTERNAL_ERROR"
+	TooManyRequests                  Code = "TOO_MANY_REQUESTS"
+	TooManyConcurrentRequests        Code = "TOO_MANY_CONCURRENT_REQUESTS"
+	K8sUnavailable                   Code = "K8S_UNAVAILABLE"
+	QuotaExceeded                    Code = "QUOTA_EXCEEDED"
+	QuotaCheckFailed                 Code = "QUOTA_CHECK_FAILED"
+	IdempotencyKeyReused             Code = "IDEMPOTENCY_KEY_REUSED"
+	IdempotencyCheckFailed           Code = "IDEMPOTENCY_CHECK_FAILED"
+	APIDocsDisabled                  Code = "API_DOCS_DISABLED"
+	APIDocsFailed                    Code = "API_DOCS_FAILED"
+	APIChangelogFailed               Code = "API_CHANGELOG_FAILED"
+	OpenAPIDocumentFailed            Code = "OPENAPI_DOCUMENT_FAILED"
+	SupportMatrixFailed              Code = "SUPPORT_MATRIX_FAILED"
+	ExtensionCatalogFailed           Code = "EXTENSION_CATALOG_FAILED"
+	ProjectExportDisabled            Code = "PROJECT_EXPORT_DISABLED"
+	ExportBundleNotFound             Code = "EXPORT_BUNDLE_NOT_FOUND"
+	ExportBundleFailed               Code = "EXPORT_BUNDLE_FAILED"
+	SupportBundlesDisabled           Code = "SUPPORT_BUNDLES_DISABLED"
+	SupportBundleFailed              Code = "SUPPORT_BUNDLE_FAILED"
+	WebhookDestinationsNotConfigured Code = "WEBHOOK_DESTINATIONS_NOT_CONFIGURED"
+	OperationTrackingDisabled        Code = "OPERATION_TRACKING_DISABLED"
+	OperationNotFound                Code = "OPERATION_NOT_FOUND"
+	OperationGetFailed               Code = "OPERATION_GET_FAILED"
+	OperationsListFailed             Code = "OPERATIONS_LIST_FAILED"
+	PermissionsFailed                Code = "PERMISSIONS_FAILED"
+)
+
+// codes of the messages about clusters
+const (
+	ClusterNameMissing            Code = "CLUSTER_NAME_MISSING"
+	InvalidClusterName            Code = "INVALID_CLUSTER_NAME"
+	ClusterNamePolicyViolated     Code = "CLUSTER_NAME_POLICY_VIOLATED"
+	ClusterNameNotGenerated       Code = "CLUSTER_NAME_NOT_GENERATED"
+	ClusterNotFound               Code = "CLUSTER_NOT_FOUND"
+	ClusterOfNodeNotFound         Code = "CLUSTER_OF_NODE_NOT_FOUND"
+	ClusterExists                 Code = "CLUSTER_EXISTS"
+	ClusterInvalid                Code = "CLUSTER_INVALID"
+	ClusterGetFailed              Code = "CLUSTER_GET_FAILED"
+	ClustersListFailed            Code = "CLUSTERS_LIST_FAILED"
+	ClusterCreateFailed           Code = "CLUSTER_CREATE_FAILED"
+	ClusterUpdateFailed           Code = "CLUSTER_UPDATE_FAILED"
+	ClusterDeleteFailed           Code = "CLUSTER_DELETE_FAILED"
+	ClusterPublishFailed          Code = "CLUSTER_PUBLISH_FAILED"
+	ClusterUnpublishFailed        Code = "CLUSTER_UNPUBLISH_FAILED"
+	ClusterDrainFailed            Code = "CLUSTER_DRAIN_FAILED"
+	ClusterForceFinalizeFailed    Code = "CLUSTER_FORCE_FINALIZE_FAILED"
+	ClusterMarkDeleteFailed       Code = "CLUSTER_MARK_DELETE_FAILED"
+	ClusterNotPendingDelete       Code = "CLUSTER_NOT_PENDING_DELETE"
+	ClusterDeleteCancelFailed     Code = "CLUSTER_DELETE_CANCEL_FAILED"
+	ClusterAlreadyManaged         Code = "CLUSTER_ALREADY_MANAGED"
+	ClusterNotImportable          Code = "CLUSTER_NOT_IMPORTABLE"
+	ClusterImportFailed           Code = "CLUSTER_IMPORT_FAILED"
+	ClusterUnpauseFailed          Code = "CLUSTER_UNPAUSE_FAILED"
+	ClusterNotClonable            Code = "CLUSTER_NOT_CLONABLE"
+	ClusterCloneNodeInUse         Code = "CLUSTER_CLONE_NODE_IN_USE"
+	ClusterSummaryMismatch        Code = "CLUSTER_SUMMARY_MISMATCH"
+	ClusterLabelsMissing          Code = "CLUSTER_LABELS_MISSING"
+	InvalidClusterLabels          Code = "INVALID_CLUSTER_LABELS"
+	InvalidClusterLabelKeys       Code = "INVALID_CLUSTER_LABEL_KEYS"
+	SystemClusterLabelKeys        Code = "SYSTEM_CLUSTER_LABEL_KEYS"
+	ClusterModified               Code = "CLUSTER_MODIFIED"
+	InPlaceUpdatesNotSupported    Code = "IN_PLACE_UPDATES_NOT_SUPPORTED"
+	ClusterDependentsCheckFailed  Code = "CLUSTER_DEPENDENTS_CHECK_FAILED"
+	ClusterHasDependents          Code = "CLUSTER_HAS_DEPENDENTS"
+	DependencyOnItself            Code = "DEPENDENCY_ON_ITSELF"
+	DuplicateDependency           Code = "DUPLICATE_DEPENDENCY"
+	DependencyNotFound            Code = "DEPENDENCY_NOT_FOUND"
+	DependencyDeleting            Code = "DEPENDENCY_DELETING"
+	DependencyCheckFailed         Code = "DEPENDENCY_CHECK_FAILED"
+	PageTokenWithOffset           Code = "PAGE_TOKEN_WITH_OFFSET"
+	InvalidPageToken              Code = "INVALID_PAGE_TOKEN"
+	PageTokenMismatch             Code = "PAGE_TOKEN_MISMATCH"
+	PageTokenExpired              Code = "PAGE_TOKEN_EXPIRED"
+	TooManyClusters               Code = "TOO_MANY_CLUSTERS"
+	PaginationFailed              Code = "PAGINATION_FAILED"
+	UpgradesFailed                Code = "UPGRADES_FAILED"
+	ClusterEventsDisabled         Code = "CLUSTER_EVENTS_DISABLED"
+	ClusterEventsFailed           Code = "CLUSTER_EVENTS_FAILED"
+	ClusterEventsListFailed       Code = "CLUSTER_EVENTS_LIST_FAILED"
+	ClusterHealthDisabled         Code = "CLUSTER_HEALTH_DISABLED"
+	ClusterHealthFailed           Code = "CLUSTER_HEALTH_FAILED"
+	InvalidAuthorizationHeader    Code = "INVALID_AUTHORIZATION_HEADER"
+	AuthorizationDenied           Code = "AUTHORIZATION_DENIED"
+	KubeconfigNotFound            Code = "KUBECONFIG_NOT_FOUND"
+	KubeconfigFailed              Code = "KUBECONFIG_FAILED"
+	KubeconfigRevokeFailed        Code = "KUBECONFIG_REVOKE_FAILED"
+	KubeconfigRevokeNotSupported  Code = "KUBECONFIG_REVOKE_NOT_SUPPORTED"
+	ReservedResourcesNotSupported Code = "RESERVED_RESOURCES_NOT_SUPPORTED"
+	InvalidReservedResources      Code = "INVALID_RESERVED_RESOURCES"
+	ClusterNetworkReserved        Code = "CLUSTER_NETWORK_RESERVED"
+	InvalidClusterNetwork         Code = "INVALID_CLUSTER_NETWORK"
+	ClusterNetworkConflict        Code = "CLUSTER_NETWORK_CONFLICT"
+	ClusterNetworkCheckFailed     Code = "CLUSTER_NETWORK_CHECK_FAILED"
+	CNIOptionsNotSupported        Code = "CNI_OPTIONS_NOT_SUPPORTED"
+	InvalidClusterVariables       Code = "INVALID_CLUSTER_VARIABLES"
+)
+
+// codes of the messages about the nodes and node pools of clusters
+const (
+	NodesRequired                Code = "NODES_REQUIRED"
+	DuplicateNode                Code = "DUPLICATE_NODE"
+	WorkerNodesRequireNodePool   Code = "WORKER_NODES_REQUIRE_NODE_POOL"
+	NodeRoleNotSupported         Code = "NODE_ROLE_NOT_SUPPORTED"
+	ExtensionNotAvailable        Code = "EXTENSION_NOT_AVAILABLE"
+	ControlPlaneReplicasMismatch Code = "CONTROL_PLANE_REPLICAS_MISMATCH"
+	ControlPlaneSizeUnsupported  Code = "CONTROL_PLANE_SIZE_UNSUPPORTED"
+	SingleNodeOnly               Code = "SINGLE_NODE_ONLY"
+	NodesInvalid                 Code = "NODES_INVALID"
+	NodesGetFailed               Code = "NODES_GET_FAILED"
+	NodesAddFailed               Code = "NODES_ADD_FAILED"
+	NodesUnusable                Code = "NODES_UNUSABLE"
+	NodesCheckFailed             Code = "NODES_CHECK_FAILED"
+	HostsNotReservable           Code = "HOSTS_NOT_RESERVABLE"
+	HostsReservationFailed       Code = "HOSTS_RESERVATION_FAILED"
+	MachinesGetFailed            Code = "MACHINES_GET_FAILED"
+	MachinesListFailed           Code = "MACHINES_LIST_FAILED"
+	MachineBindingsFailed        Code = "MACHINE_BINDINGS_FAILED"
+	NodeIDMissing                Code = "NODE_ID_MISSING"
+	NodeNotInCluster             Code = "NODE_NOT_IN_CLUSTER"
+	NodeRemovalNotAllowed        Code = "NODE_REMOVAL_NOT_ALLOWED"
+	NodeRemoveFailed             Code = "NODE_REMOVE_FAILED"
+	NodeDrainFailed              Code = "NODE_DRAIN_FAILED"
+	IntelMachinesGetFailed       Code = "INTEL_MACHINES_GET_FAILED"
+	NodePoolMissing              Code = "NODE_POOL_MISSING"
+	NodePoolUpdateMissing        Code = "NODE_POOL_UPDATE_MISSING"
+	InvalidNodePoolLabelKeys     Code = "INVALID_NODE_POOL_LABEL_KEYS"
+	InvalidNodePoolTaintKeys     Code = "INVALID_NODE_POOL_TAINT_KEYS"
+	NodePoolReplicasMismatch     Code = "NODE_POOL_REPLICAS_MISMATCH"
+	NodePoolNodesRequired        Code = "NODE_POOL_NODES_REQUIRED"
+	NodePoolExists               Code = "NODE_POOL_EXISTS"
+	NodePoolNotFound             Code = "NODE_POOL_NOT_FOUND"
+	NodePoolInvalid              Code = "NODE_POOL_INVALID"
+	NodePoolUpdateInvalid        Code = "NODE_POOL_UPDATE_INVALID"
+	NodePoolReadFailed           Code = "NODE_POOL_READ_FAILED"
+	NodePoolAddFailed            Code = "NODE_POOL_ADD_FAILED"
+	NodePoolUpdateFailed         Code = "NODE_POOL_UPDATE_FAILED"
+	NodeLogsDisabled             Code = "NODE_LOGS_DISABLED"
+	NodeLogsNotAvailable         Code = "NODE_LOGS_NOT_AVAILABLE"
+	NodeLogsNotSupported         Code = "NODE_LOGS_NOT_SUPPORTED"
+	NodeLogsFailed               Code = "NODE_LOGS_FAILED"
+	NodeCordonDisabled           Code = "NODE_CORDON_DISABLED"
+	NodeNotJoined                Code = "NODE_NOT_JOINED"
+	MaintenanceWindowInvalid     Code = "MAINTENANCE_WINDOW_INVALID"
+	NodeCordonFailed             Code = "NODE_CORDON_FAILED"
+	NodeCordonDrainFailed        Code = "NODE_CORDON_DRAIN_FAILED"
+	NodeUncordonFailed           Code = "NODE_UNCORDON_FAILED"
+	MaintenanceWindowFailed      Code = "MAINTENANCE_WINDOW_FAILED"
+	HostsNotAttested             Code = "HOSTS_NOT_ATTESTED"
+)
+
+// codes of the messages about backups and restores of clusters
+const (
+	BackupMissing        Code = "BACKUP_MISSING"
+	BackupNotFound       Code = "BACKUP_NOT_FOUND"
+	BackupNotCompleted   Code = "BACKUP_NOT_COMPLETED"
+	BackupGetFailed      Code = "BACKUP_GET_FAILED"
+	BackupsListFailed    Code = "BACKUPS_LIST_FAILED"
+	BackupCreateFailed   Code = "BACKUP_CREATE_FAILED"
+	RestoreInProgress    Code = "RESTORE_IN_PROGRESS"
+	RestoreRequestFailed Code = "RESTORE_REQUEST_FAILED"
+)
+
+// codes of the messages about the maintenance mode of clusters
+const (
+	MaintenanceReasonMissing  Code = "MAINTENANCE_REASON_MISSING"
+	ClusterInMaintenance      Code = "CLUSTER_IN_MAINTENANCE"
+	ClusterNotInMaintenance   Code = "CLUSTER_NOT_IN_MAINTENANCE"
+	MaintenanceCordonFailed   Code = "MAINTENANCE_CORDON_FAILED"
+	MaintenanceUncordonFailed Code = "MAINTENANCE_UNCORDON_FAILED"
+	MaintenanceStartFailed    Code = "MAINTENANCE_START_FAILED"
+	MaintenanceEndFailed      Code = "MAINTENANCE_END_FAILED"
+)
+
+// codes of the messages about clusters scheduled for deferred provisioning
+const (
+	DeferredProvisioningDisabled Code = "DEFERRED_PROVISIONING_DISABLED"
+	ProvisionAtRequiresDeferred  Code = "PROVISION_AT_REQUIRES_DEFERRED_PROVISIONING"
+	ProvisionAtNotInFuture       Code = "PROVISION_AT_NOT_IN_FUTURE"
+	PendingClusterNotFound       Code = "PENDING_CLUSTER_NOT_FOUND"
+	PendingClusterExists         Code = "PENDING_CLUSTER_EXISTS"
+	PendingClusterRenamed        Code = "PENDING_CLUSTER_RENAMED"
+	PendingClusterGetFailed      Code = "PENDING_CLUSTER_GET_FAILED"
+	PendingClustersListFailed    Code = "PENDING_CLUSTERS_LIST_FAILED"
+	PendingClusterScheduleFailed Code = "PENDING_CLUSTER_SCHEDULE_FAILED"
+	PendingClusterModifyFailed   Code = "PENDING_CLUSTER_MODIFY_FAILED"
+	PendingClusterCancelFailed   Code = "PENDING_CLUSTER_CANCEL_FAILED"
+	ImagePreflightDisabled       Code = "IMAGE_PREFLIGHT_DISABLED"
+	ImagePreflightNoImages       Code = "IMAGE_PREFLIGHT_NO_IMAGES"
+)
+
+// codes of the messages about cluster templates
+const (
+	TemplateNotFound            Code = "TEMPLATE_NOT_FOUND"
+	TemplateVersionsNotFound    Code = "TEMPLATE_VERSIONS_NOT_FOUND"
+	TemplateOfClusterNotFound   Code = "TEMPLATE_OF_CLUSTER_NOT_FOUND"
+	TemplateExists              Code = "TEMPLATE_EXISTS"
+	TemplateInvalid             Code = "TEMPLATE_INVALID"
+	TemplateInUse               Code = "TEMPLATE_IN_USE"
+	TemplateNotPublished        Code = "TEMPLATE_NOT_PUBLISHED"
+	TemplateDeprecated          Code = "TEMPLATE_DEPRECATED"
+	TemplateGetFailed           Code = "TEMPLATE_GET_FAILED"
+	TemplateOfClusterGetFailed  Code = "TEMPLATE_OF_CLUSTER_GET_FAILED"
+	TemplatesListFailed         Code = "TEMPLATES_LIST_FAILED"
+	TemplateConvertFailed       Code = "TEMPLATE_CONVERT_FAILED"
+	TemplateImportFailed        Code = "TEMPLATE_IMPORT_FAILED"
+	TemplateDeleteFailed        Code = "TEMPLATE_DELETE_FAILED"
+	TemplateUpdateFailed        Code = "TEMPLATE_UPDATE_FAILED"
+	TemplatePathMismatch        Code = "TEMPLATE_PATH_MISMATCH"
+	TemplatePageFailed          Code = "TEMPLATE_PAGE_FAILED"
+	ClusterClassGetFailed       Code = "CLUSTER_CLASS_GET_FAILED"
+	TemplatePublishFailed       Code = "TEMPLATE_PUBLISH_FAILED"
+	TemplateCannotBePublished   Code = "TEMPLATE_CANNOT_BE_PUBLISHED"
+	TemplateDeprecateFailed     Code = "TEMPLATE_DEPRECATE_FAILED"
+	TemplateCannotBeDeprecated  Code = "TEMPLATE_CANNOT_BE_DEPRECATED"
+	TemplateModified            Code = "TEMPLATE_MODIFIED"
+	DefaultTemplateNotFound     Code = "DEFAULT_TEMPLATE_NOT_FOUND"
+	MultipleDefaultTemplates    Code = "MULTIPLE_DEFAULT_TEMPLATES"
+	DefaultTemplateGetFailed    Code = "DEFAULT_TEMPLATE_GET_FAILED"
+	DefaultTemplateInvalid      Code = "DEFAULT_TEMPLATE_INVALID"
+	DefaultTemplateSetFailed    Code = "DEFAULT_TEMPLATE_SET_FAILED"
+	DefaultTemplateBusy         Code = "DEFAULT_TEMPLATE_BUSY"
+	LatestTemplateVersionFailed Code = "LATEST_TEMPLATE_VERSION_FAILED"
+	InvalidClusterConfiguration Code = "INVALID_CLUSTER_CONFIGURATION"
+)
+
+// codes of the messages about templates uploaded in chunks
+const (
+	TemplateUploadsDisabled        Code = "TEMPLATE_UPLOADS_DISABLED"
+	TemplateUploadNotFound         Code = "TEMPLATE_UPLOAD_NOT_FOUND"
+	TemplateUploadOffsetMismatch   Code = "TEMPLATE_UPLOAD_OFFSET_MISMATCH"
+	TemplateUploadTooLarge         Code = "TEMPLATE_UPLOAD_TOO_LARGE"
+	TemplateUploadSizeExceeded     Code = "TEMPLATE_UPLOAD_SIZE_EXCEEDED"
+	TemplateUploadIncomplete       Code = "TEMPLATE_UPLOAD_INCOMPLETE"
+	TemplateUploadChecksumMismatch Code = "TEMPLATE_UPLOAD_CHECKSUM_MISMATCH"
+	TemplateUploadInvalid          Code = "TEMPLATE_UPLOAD_INVALID"
+	TemplateUploadCreateFailed     Code = "TEMPLATE_UPLOAD_CREATE_FAILED"
+	TemplateUploadGetFailed        Code = "TEMPLATE_UPLOAD_GET_FAILED"
+	TemplateUploadAppendFailed     Code = "TEMPLATE_UPLOAD_APPEND_FAILED"
+	TemplateUploadAssembleFailed   Code = "TEMPLATE_UPLOAD_ASSEMBLE_FAILED"
+	TemplateUploadDeleteFailed     Code = "TEMPLATE_UPLOAD_DELETE_FAILED"
+)
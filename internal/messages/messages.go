@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package messages is the catalog of the messages returned to the users of the REST API. Messages are keyed by a code
+// that clients and tests can rely on, while their text is kept in per-language catalogs, so that it can be adjusted and
+// localized without touching the handlers.
+package messages
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+	"sigs.k8s.io/yaml"
+)
+
+// Code identifies a message independently of its language
+type Code string
+
+// Message is a message of the catalog with the arguments of its format
+// Message implements error, so that helpers can return messages to the handlers; the error text is in English.
+type Message struct {
+	Code Code
+	Args []any
+}
+
+// New returns the message of the code with the given arguments
+func New(code Code, args ...any) Message {
+	return Message{Code: code, Args: args}
+}
+
+// String returns the message in English, which is the language of the logs
+func (m Message) String() string {
+	return m.format(language.English)
+}
+
+func (m Message) Error() string {
+	return m.String()
+}
+
+// Localize returns the message in the language of the context, see WithAcceptLanguage
+func (m Message) Localize(ctx context.Context) string {
+	return m.format(Language(ctx))
+}
+
+// format returns the message in the given language, falling back to English if the catalog of the language has no
+// entry for the code and to the code itself if there is no English entry either
+func (m Message) format(lang language.Tag) string {
+	format, ok := catalogs[lang][m.Code]
+	if !ok {
+		format, ok = catalogs[language.English][m.Code]
+	}
+	if !ok {
+		return string(m.Code)
+	}
+	return fmt.Sprintf(format, m.Args...)
+}
+
+var (
+	//go:embed catalog/*.yaml
+	catalogFS embed.FS
+
+	// catalogs are the formats of the messages by language and code
+	catalogs = mustLoadCatalogs()
+
+	// supported are the languages with a catalog, English first as it is the fallback of the matcher
+	supported = supportedLanguages()
+	matcher   = language.NewMatcher(supported)
+)
+
+// mustLoadCatalogs parses the embedded catalogs, which are named after their language, e.g. en.yaml
+// The catalogs are part of the binary, so an invalid catalog is a programming error.
+func mustLoadCatalogs() map[language.Tag]map[Code]string {
+	entries, err := catalogFS.ReadDir("catalog")
+	if err != nil {
+		panic(fmt.Sprintf("failed to read message catalogs: %v", err))
+	}
+
+	loaded := map[language.Tag]map[Code]string{}
+	for _, entry := range entries {
+		lang, err := language.Parse(strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+		if err != nil {
+			panic(fmt.Sprintf("invalid language of message catalog %s: %v", entry.Name(), err))
+		}
+		data, err := catalogFS.ReadFile(path.Join("catalog", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("failed to read message catalog %s: %v", entry.Name(), err))
+		}
+		catalog := map[Code]string{}
+		if err := yaml.UnmarshalStrict(data, &catalog); err != nil {
+			panic(fmt.Sprintf("failed to parse message catalog %s: %v", entry.Name(), err))
+		}
+		loaded[lang] = catalog
+	}
+	return loaded
+}
+
+// Codes returns the codes of all messages, sorted, e.g. to document the codes of the errors clients can branch on
+func Codes() []Code {
+	return slices.Sorted(maps.Keys(catalogs[language.English]))
+}
+
+func supportedLanguages() []language.Tag {
+	var tags []language.Tag
+	for tag := range catalogs {
+		if tag != language.English {
+			tags = append(tags, tag)
+		}
+	}
+	slices.SortFunc(tags, func(a, b language.Tag) int { return strings.Compare(a.String(), b.String()) })
+	return append([]language.Tag{language.English}, tags...)
+}
+
+type languageKey struct{}
+
+// WithAcceptLanguage returns a context with the supported language that best matches the value of an Accept-Language
+// header; English is used if none of the requested languages is supported or the header is invalid
+func WithAcceptLanguage(ctx context.Context, acceptLanguage string) context.Context {
+	lang := language.English
+	if requested, _, err := language.ParseAcceptLanguage(acceptLanguage); err == nil && len(requested) > 0 {
+		_, index, confidence := matcher.Match(requested...)
+		if confidence != language.No {
+			lang = supported[index]
+		}
+	}
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// Language returns the language of the messages of the context, English if none was set
+func Language(ctx context.Context) language.Tag {
+	if lang, ok := ctx.Value(languageKey{}).(language.Tag); ok {
+		return lang
+	}
+	return language.English
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package messages
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+// codes returns the codes declared in codes.go
+func codes(t *testing.T) []Code {
+	file, err := parser.ParseFile(token.NewFileSet(), "codes.go", nil, 0)
+	require.NoError(t, err)
+
+	var codes []Code
+	ast.Inspect(file, func(node ast.Node) bool {
+		if spec, ok := node.(*ast.ValueSpec); ok {
+			for _, value := range spec.Values {
+				code, err := strconv.Unquote(value.(*ast.BasicLit).Value)
+				require.NoError(t, err)
+				codes = append(codes, Code(code))
+			}
+		}
+		return true
+	})
+	require.NotEmpty(t, codes)
+	return codes
+}
+
+// verbs matches the verbs of a format, ignoring escaped percent signs
+var verbs = regexp.MustCompile(`%[^%]`)
+
+func TestCatalogs(t *testing.T) {
+	known := map[Code]bool{}
+	for _, code := range codes(t) {
+		known[code] = true
+		require.Contains(t, catalogs[language.English], code, "code %s has no English message", code)
+	}
+
+	for lang, catalog := range catalogs {
+		for code, format := range catalog {
+			require.True(t, known[code], "catalog %s has unknown code %s", lang, code)
+			require.Equal(t, verbs.FindAllString(catalogs[language.English][code], -1), verbs.FindAllString(format, -1),
+				"verbs of code %s in catalog %s differ from English", code, lang)
+		}
+	}
+}
+
+func TestCodes(t *testing.T) {
+	declared := codes(t)
+	slices.Sort(declared)
+	require.Equal(t, declared, Codes())
+}
+
+func TestMessage(t *testing.T) {
+	message := New(ClusterGetFailed, "demo", errors.New("boom"))
+	require.Equal(t, "failed to get cluster 'demo': boom", message.String())
+	require.Equal(t, message.String(), message.Error())
+
+	var target Message
+	require.True(t, errors.As(error(message), &target))
+	require.Equal(t, ClusterGetFailed, target.Code)
+
+	require.Equal(t, "UNKNOWN_CODE", New("UNKNOWN_CODE").String())
+	require.False(t, strings.Contains(New(NodePoolReplicasMismatch, "pool", 3, 1).String(), "%!"))
+}
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+		language       language.Tag
+	}{
+		{name: "no language", expected: "cluster 'demo' not found", language: language.English},
+		{name: "german", acceptLanguage: "de", expected: "Cluster 'demo' nicht gefunden", language: language.German},
+		{name: "german region", acceptLanguage: "de-AT", expected: "Cluster 'demo' nicht gefunden", language: language.German},
+		{name: "preferred supported language", acceptLanguage: "fr;q=0.9, de;q=0.8", expected: "Cluster 'demo' nicht gefunden", language: language.German},
+		{name: "unsupported language", acceptLanguage: "fr", expected: "cluster 'demo' not found", language: language.English},
+		{name: "invalid header", acceptLanguage: "=;", expected: "cluster 'demo' not found", language: language.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.acceptLanguage != "" {
+				ctx = WithAcceptLanguage(ctx, tt.acceptLanguage)
+			}
+			require.Equal(t, tt.language, Language(ctx))
+			require.Equal(t, tt.expected, New(ClusterNotFound, "demo").Localize(ctx))
+		})
+	}
+}
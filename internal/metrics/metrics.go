@@ -21,12 +21,119 @@ var (
 		},
 		[]string{"method", "path", "code"},
 	)
+
+	TokenValidationFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_token_validation_failures_counter",
+			Help: "Count of rejected bearer tokens per reason",
+		},
+		[]string{"reason"},
+	)
+
+	JWKSRefreshCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_jwks_refresh_counter",
+			Help: "Count of refreshes of the signing keys of the OIDC provider per result",
+		},
+		[]string{"result"},
+	)
+
+	ReadCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_read_cache_counter",
+			Help: "Count of reads of the REST handlers served from the informer cache or sent to the API server per resource",
+		},
+		[]string{"resource", "result"},
+	)
+
+	OrphanedKubeconfigCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_orphaned_kubeconfig_secrets_counter",
+			Help: "Count of kubeconfig secrets whose cluster was deleted per event [detected|cleaned]",
+		},
+		[]string{"event"},
+	)
+
+	RateLimitedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_rate_limited_requests_counter",
+			Help: "Count of requests rejected with 429 Too Many Requests per limit [client|global|inflight]",
+		},
+		[]string{"limit"},
+	)
+
+	ValidationViolationCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_validation_violations_counter",
+			Help: "Count of requests violating a validation rule per rule and mode [enforce|shadow]",
+		},
+		[]string{"rule", "mode"},
+	)
+
+	K8sRetryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_manager_k8s_retries_counter",
+			Help: "Count of retried Kubernetes calls per verb and transient error [throttled|unavailable|leader-changed|timeout|connection-refused|connection-reset]",
+		},
+		[]string{"verb", "reason"},
+	)
+
+	K8sCircuitBreakerState = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cluster_manager_k8s_circuit_breaker_state",
+		Help: "State of the circuit breaker of the Kubernetes calls, 0 closed, 1 half-open and 2 open",
+	})
+
+	ClusterProvisioningDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "cluster_manager_cluster_provisioning_duration_seconds",
+		Help:    "Time from the creation of a cluster until it is ready for the first time in seconds",
+		Buckets: prometheus.ExponentialBuckets(60, 2, 8),
+	})
+
+	ClusterDeletionDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "cluster_manager_cluster_deletion_duration_seconds",
+		Help:    "Time from the deletion request of a cluster until it is removed in seconds",
+		Buckets: prometheus.ExponentialBuckets(15, 2, 8),
+	})
+
+	ForceFinalizedClusterCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cluster_manager_force_finalized_clusters_counter",
+		Help: "Count of deleting clusters whose finalizers were stripped after the force delete timeout",
+	})
+
+	ClustersByPhaseGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cluster_manager_clusters_by_phase",
+			Help: "Number of clusters per project and phase, clusters in maintenance are counted in the Maintenance phase",
+		},
+		[]string{"project", "phase"},
+	)
+
+	TemplateInUseGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cluster_manager_template_in_use",
+			Help: "Number of clusters using a cluster template per project and template",
+		},
+		[]string{"project", "template"},
+	)
 )
 
 func GetRegistry() *prometheus.Registry {
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(ResponseTime)
 	registry.MustRegister(HttpResponseCounter)
+	registry.MustRegister(TokenValidationFailureCounter)
+	registry.MustRegister(JWKSRefreshCounter)
+	registry.MustRegister(ReadCacheCounter)
+	registry.MustRegister(OrphanedKubeconfigCounter)
+	registry.MustRegister(RateLimitedCounter)
+	registry.MustRegister(ValidationViolationCounter)
+	registry.MustRegister(K8sRetryCounter)
+	registry.MustRegister(K8sCircuitBreakerState)
+	registry.MustRegister(ClusterProvisioningDuration)
+	registry.MustRegister(ClusterDeletionDuration)
+	registry.MustRegister(ForceFinalizedClusterCounter)
+	registry.MustRegister(ClustersByPhaseGauge)
+	registry.MustRegister(TemplateInUseGauge)
 
 	return registry
 }
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/audit"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+)
+
+var auditedMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// AuditLogger is an interface that can be used to record audit records
+type AuditLogger interface {
+	Log(ctx context.Context, record audit.Record)
+}
+
+// Audit records an audit record for every mutating request once it is served
+// The actor is taken from the claims of the bearer token, whose signature is verified by the authenticator further
+// down the chain; requests that fail authentication are recorded with the claimed actor and their error status
+func Audit(logger AuditLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !slices.Contains(auditedMethods, r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			record := audit.Record{
+				Actor:     actor(r),
+				ProjectID: r.Header.Get("Activeprojectid"),
+				Verb:      r.Method,
+				Resource:  r.URL.Path,
+			}
+
+			if r.Body != nil {
+				// read one byte more than recorded to tell whether the body is too large to be recorded
+				body, err := io.ReadAll(io.LimitReader(r.Body, audit.MaxRequestSize+1))
+				if err == nil && len(body) <= audit.MaxRequestSize && json.Valid(body) {
+					record.Request = json.RawMessage(body)
+				}
+				r.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+			}
+
+			sw := &auditResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+
+			record.Status = sw.status
+			logger.Log(r.Context(), record)
+		})
+	}
+}
+
+// actor returns the user name of the bearer token of the request
+func actor(r *http.Request) string {
+	rawToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || rawToken == "" {
+		return "anonymous"
+	}
+	if name := auth.GetUsername(r.Header.Get("Authorization")); name != "" {
+		return name
+	}
+	return "unknown"
+}
+
+// auditResponseWriter records the status code of the audited response
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *auditResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *auditResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
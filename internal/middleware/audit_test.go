@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/audit"
+)
+
+// fakeAuditLogger collects the logged audit records
+type fakeAuditLogger struct {
+	records []audit.Record
+}
+
+func (f *fakeAuditLogger) Log(_ context.Context, record audit.Record) {
+	f.records = append(f.records, record)
+}
+
+func TestAudit(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"preferred_username": "alice", "sub": "1234"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.URL.Path == "/v2/clusters/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	})
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		body           string
+		expectedRecord *audit.Record
+	}{
+		{
+			name:   "create is audited with its request",
+			method: http.MethodPost,
+			path:   "/v2/clusters",
+			token:  token,
+			body:   `{"name":"cluster-1"}`,
+			expectedRecord: &audit.Record{Actor: "alice", ProjectID: "655a6892-4280-4c37-97b1-31161ac0b99e", Verb: http.MethodPost,
+				Resource: "/v2/clusters", Request: json.RawMessage(`{"name":"cluster-1"}`), Status: http.StatusCreated},
+		},
+		{
+			name:   "failed delete is audited with its status",
+			method: http.MethodDelete,
+			path:   "/v2/clusters/missing",
+			token:  token,
+			expectedRecord: &audit.Record{Actor: "alice", ProjectID: "655a6892-4280-4c37-97b1-31161ac0b99e", Verb: http.MethodDelete,
+				Resource: "/v2/clusters/missing", Status: http.StatusNotFound},
+		},
+		{
+			name:   "request without token",
+			method: http.MethodPut,
+			path:   "/v2/templates/baseline/default",
+			body:   "not json",
+			expectedRecord: &audit.Record{Actor: "anonymous", ProjectID: "655a6892-4280-4c37-97b1-31161ac0b99e", Verb: http.MethodPut,
+				Resource: "/v2/templates/baseline/default", Status: http.StatusCreated},
+		},
+		{
+			name:   "oversized request is audited without its body",
+			method: http.MethodPatch,
+			path:   "/v2/clusters/cluster-1/labels",
+			token:  "malformed",
+			body:   `{"labels":"` + strings.Repeat("a", audit.MaxRequestSize) + `"}`,
+			expectedRecord: &audit.Record{Actor: "unknown", ProjectID: "655a6892-4280-4c37-97b1-31161ac0b99e", Verb: http.MethodPatch,
+				Resource: "/v2/clusters/cluster-1/labels", Status: http.StatusCreated},
+		},
+		{
+			name:   "reads are not audited",
+			method: http.MethodGet,
+			path:   "/v2/clusters",
+			token:  token,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &fakeAuditLogger{}
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Activeprojectid", "655a6892-4280-4c37-97b1-31161ac0b99e")
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rr := httptest.NewRecorder()
+			Audit(logger)(echo).ServeHTTP(rr, req)
+
+			// the request body is still passed on in full
+			if rr.Code == http.StatusCreated {
+				require.Equal(t, tt.body, rr.Body.String())
+			}
+
+			if tt.expectedRecord == nil {
+				require.Empty(t, logger.records)
+				return
+			}
+			require.Equal(t, []audit.Record{*tt.expectedRecord}, logger.records)
+		})
+	}
+}
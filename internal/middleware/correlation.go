@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+// validCorrelationID limits the correlation IDs sent by clients to what can safely be logged and echoed
+var validCorrelationID = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`)
+
+// CorrelationID tags the request with the correlation ID sent by the client or a new one, the ID is returned in the
+// response, so that support tickets can refer to the request, and the request-scoped logger of the request context
+// writes it with every record
+func CorrelationID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(logger.CorrelationIDHeader)
+		if !validCorrelationID.MatchString(id) {
+			id = uuid.NewString()
+		}
+
+		w.Header().Set(logger.CorrelationIDHeader, id)
+		ctx := logger.WithCorrelationID(r.Context(), id)
+		next.ServeHTTP(w, r.WithContext(logger.WithLogger(ctx, logger.NewRequestLogger(ctx))))
+	})
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+func TestCorrelationID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{name: "client ID is kept", header: "3f1c2a9e-support-42", keep: true},
+		{name: "missing ID is generated"},
+		{name: "invalid ID is replaced", header: "bad id\nwith newline"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var contextID string
+			handler := CorrelationID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				contextID = logger.CorrelationID(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v2/clusters", nil)
+			if tt.header != "" {
+				req.Header.Set(logger.CorrelationIDHeader, tt.header)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.NotEmpty(t, contextID)
+			require.Equal(t, contextID, rr.Header().Get(logger.CorrelationIDHeader))
+			if tt.keep {
+				require.Equal(t, tt.header, contextID)
+			} else {
+				require.NotEqual(t, tt.header, contextID)
+			}
+		})
+	}
+}
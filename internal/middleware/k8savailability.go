@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+)
+
+// K8sAvailability rejects the requests with 503 Service Unavailable and a Retry-After header while the circuit breaker
+// of the Kubernetes client is open, so that clients fail fast instead of waiting for calls that fail anyway; retryAfter
+// returns how long the breaker stays open, e.g. k8s.ResilientClient.RetryAfter. Health and metrics requests are served,
+// so that the probes report the outage.
+func K8sAvailability(retryAfter func() time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if retryAfter == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			delay := retryAfter()
+			if delay <= 0 || slices.Contains(ignoredPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			seconds := max(1, int(math.Ceil(delay.Seconds())))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			writeProblem(w, r, http.StatusServiceUnavailable, messages.New(messages.K8sUnavailable, seconds))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestK8sAvailability(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cases := []struct {
+		name           string
+		retryAfter     time.Duration
+		path           string
+		expectedStatus int
+		expectedHeader string
+	}{
+		{name: "closed breaker", path: "/v2/clusters", expectedStatus: http.StatusOK},
+		{name: "open breaker", retryAfter: 1500 * time.Millisecond, path: "/v2/clusters", expectedStatus: http.StatusServiceUnavailable, expectedHeader: "2"},
+		{name: "health probe with open breaker", retryAfter: time.Minute, path: "/v2/healthz", expectedStatus: http.StatusOK},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := K8sAvailability(func() time.Duration { return tc.retryAfter })(next)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			require.Equal(t, tc.expectedStatus, rr.Code)
+			assert.Equal(t, tc.expectedHeader, rr.Header().Get("Retry-After"))
+			if tc.expectedStatus == http.StatusServiceUnavailable {
+				assert.Contains(t, rr.Body.String(), `"code":"K8S_UNAVAILABLE"`)
+			}
+		})
+	}
+}
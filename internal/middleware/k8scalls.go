@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+// ServerTimingHeader reports the Kubernetes calls of a request, see K8sCalls
+const ServerTimingHeader = "Server-Timing"
+
+// K8sCalls counts the Kubernetes calls each request triggers and reports their number and cumulative latency per
+// source in the Server-Timing header of the response, e.g. `k8s-api;desc="2 calls";dur=41.2, k8s-cache;desc="12
+// calls";dur=0.3`, and in a debug record logged once the request is served. It is meant for performance tuning, to
+// find the handlers whose calls grow with the number of items they return; only the calls of the clients wrapped by
+// k8s.CountingClient and of the read cache are counted. The header reports the calls made until the response is
+// written, the log record all calls of the request.
+func K8sCalls(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(ignoredPaths, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, stats := k8s.WithCallStats(r.Context())
+		start := time.Now()
+		next.ServeHTTP(&serverTimingResponseWriter{ResponseWriter: w, stats: stats}, r.WithContext(ctx))
+
+		apiCalls, cacheCalls := stats.Count(k8s.APIServerCalls), stats.Count(k8s.CacheCalls)
+		slog.DebugContext(ctx, "kubernetes calls of request", "method", r.Method, "path", r.URL.Path,
+			"apiCalls", apiCalls.Calls, "apiDuration", apiCalls.Duration, "cacheCalls", cacheCalls.Calls, "cacheDuration", cacheCalls.Duration,
+			"requestDuration", time.Since(start))
+	})
+}
+
+// serverTiming returns the Server-Timing header value of the calls counted so far
+func serverTiming(stats *k8s.CallStats) string {
+	apiCalls, cacheCalls := stats.Count(k8s.APIServerCalls), stats.Count(k8s.CacheCalls)
+	return fmt.Sprintf(`k8s-api;desc="%d calls";dur=%.1f, k8s-cache;desc="%d calls";dur=%.1f`,
+		apiCalls.Calls, milliseconds(apiCalls.Duration), cacheCalls.Calls, milliseconds(cacheCalls.Duration))
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// serverTimingResponseWriter adds the Server-Timing header when the response is written
+type serverTimingResponseWriter struct {
+	http.ResponseWriter
+	stats       *k8s.CallStats
+	wroteHeader bool
+}
+
+func (w *serverTimingResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.Header().Add(ServerTimingHeader, serverTiming(w.stats))
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *serverTimingResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher so that streamed responses keep working
+func (w *serverTimingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *serverTimingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
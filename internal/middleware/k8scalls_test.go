@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+func TestK8sCalls(t *testing.T) {
+	dyn := k8s.NewCountingClient(fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		core.ClusterResourceSchema: "ClusterList",
+	}))
+
+	// lists the clusters and gets each of them, like a handler with an N+1 pattern
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := dyn.Resource(core.ClusterResourceSchema).Namespace("project").List(r.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		for _, name := range []string{"cluster-1", "cluster-2"} {
+			_, _ = dyn.Resource(core.ClusterResourceSchema).Namespace("project").Get(r.Context(), name, metav1.GetOptions{})
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("reports the calls of the request", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		K8sCalls(handler).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v2/clusters", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Regexp(t, `^k8s-api;desc="3 calls";dur=[0-9.]+, k8s-cache;desc="0 calls";dur=0\.0$`, rr.Header().Get(ServerTimingHeader))
+	})
+
+	t.Run("ignored paths are not reported", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		K8sCalls(handler).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v2/healthz", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Empty(t, rr.Header().Get(ServerTimingHeader))
+	})
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// Language selects the language of the messages of the request from its Accept-Language header, the selected language
+// is returned in the Content-Language header of the response
+func Language(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := messages.WithAcceptLanguage(r.Context(), r.Header.Get("Accept-Language"))
+
+		w.Header().Set("Content-Language", messages.Language(ctx).String())
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// writeProblem writes the problem details of a message in the language of the request, as the handlers do
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, message messages.Message) {
+	code, text := string(message.Code), message.Localize(r.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(api.ProblemDetails{Code: &code, Message: &text}); err != nil {
+		slog.Error("failed to encode problem details", "status", status, "error", err)
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+)
+
+func TestLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "no header is english", expected: "en"},
+		{name: "supported language", header: "de-DE,de;q=0.9,en;q=0.8", expected: "de"},
+		{name: "unsupported language is english", header: "ja", expected: "en"},
+		{name: "invalid header is english", header: ";;q=x", expected: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var message string
+			handler := Language(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				message = messages.New(messages.ClusterNotFound, "demo").Localize(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v2/clusters/demo", nil)
+			if tt.header != "" {
+				req.Header.Set("Accept-Language", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expected, rr.Header().Get("Content-Language"))
+			if tt.expected == "de" {
+				require.Equal(t, "Cluster 'demo' nicht gefunden", message)
+			} else {
+				require.Equal(t, "cluster 'demo' not found", message)
+			}
+		})
+	}
+}
@@ -4,9 +4,10 @@
 package middleware
 
 import (
-	"log/slog"
 	"net/http"
 	"slices"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
 )
 
 // Logger logs the request and response
@@ -17,7 +18,7 @@ func Logger(next http.Handler) http.Handler {
 			return
 		}
 
-		slog.Debug("received request", "method", r.Method, "path", r.URL.Path)
+		logger.FromContext(r.Context()).Debug("received request", "method", r.Method, "path", r.URL.Path, "activeprojectid", r.Header.Get("Activeprojectid"))
 		next.ServeHTTP(w, r)
 	})
 }
@@ -65,6 +65,11 @@ func (srw *statusResponseWriter) Header() http.Header {
 	return srw.wr.Header()
 }
 
+// Unwrap returns the underlying http.ResponseWriter so that http.ResponseController can reach it, e.g. to flush streamed responses
+func (srw *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return srw.wr
+}
+
 func (srw *statusResponseWriter) Status() string {
 	return strconv.Itoa(srw.status)
 }
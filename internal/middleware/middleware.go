@@ -10,8 +10,19 @@ import (
 var (
 	ignoredPaths = []string{
 		"/v2/healthz",
+		"/v2/readyz",
 		"/metrics",
 	}
+
+	// admin, documentation, support matrix and extension catalog endpoints are not scoped to a project
+	unscopedPathPrefixes = []string{
+		"/v2/admin/",
+		"/v2/docs",
+		"/v2/apichangelog",
+		"/v2/openapi.json",
+		"/v2/supportmatrix",
+		"/v2/extensions",
+	}
 )
 
 // middleware is a function definition that wraps an http.Handler
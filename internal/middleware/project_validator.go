@@ -6,6 +6,9 @@ package middleware
 import (
 	"net/http"
 	"slices"
+	"strings"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 )
 
 // ProjectIDValidator validates the project ID in the request header
@@ -13,15 +16,16 @@ import (
 func ProjectIDValidator(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Skip endpoints that do not require a project id
-		if slices.Contains(ignoredPaths, r.URL.Path) {
+		if slices.Contains(ignoredPaths, r.URL.Path) || slices.ContainsFunc(unscopedPathPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(r.URL.Path, prefix)
+		}) {
 			next.ServeHTTP(w, r)
 			return
 		}
 
 		activeProjectId := r.Header.Get("Activeprojectid")
 		if activeProjectId == "" || activeProjectId == "00000000-0000-0000-0000-000000000000" {
-			w.Header().Set("Content-Type", "application/json")
-			http.Error(w, `{"message": "no active project id provided"}`, http.StatusBadRequest)
+			writeProblem(w, r, http.StatusBadRequest, messages.New(messages.ActiveProjectIDMissing))
 			return
 		}
 
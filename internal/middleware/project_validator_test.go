@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestProjectIDValidator(t *testing.T) {
@@ -29,14 +31,14 @@ func TestProjectIDValidator(t *testing.T) {
 			projectID:      "",
 			path:           "/v2/clusters",
 			expectedStatus: http.StatusBadRequest,
-			expectedBody:   `{"message": "no active project id provided"}`,
+			expectedBody:   `{"code": "ACTIVE_PROJECT_ID_MISSING", "message": "no active project id provided"}`,
 		},
 		{
 			name:           "Zero UUID project ID",
 			projectID:      "00000000-0000-0000-0000-000000000000",
 			path:           "/v2/clusters",
 			expectedStatus: http.StatusBadRequest,
-			expectedBody:   `{"message": "no active project id provided"}`,
+			expectedBody:   `{"code": "ACTIVE_PROJECT_ID_MISSING", "message": "no active project id provided"}`,
 		},
 		{
 			name:           "Ignored /healthz path",
@@ -66,6 +68,41 @@ func TestProjectIDValidator(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody:   "handler called",
 		},
+		{
+			name:           "Ignored admin path without project ID",
+			projectID:      "",
+			path:           "/v2/admin/exports/12345678-1234-1234-1234-123456789012",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "handler called",
+		},
+		{
+			name:           "Ignored docs path without project ID",
+			projectID:      "",
+			path:           "/v2/docs",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "handler called",
+		},
+		{
+			name:           "Ignored api changelog path without project ID",
+			projectID:      "",
+			path:           "/v2/apichangelog",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "handler called",
+		},
+		{
+			name:           "Ignored support matrix path without project ID",
+			projectID:      "",
+			path:           "/v2/supportmatrix",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "handler called",
+		},
+		{
+			name:           "Ignored extension catalog path without project ID",
+			projectID:      "",
+			path:           "/v2/extensions",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "handler called",
+		},
 	}
 
 	for _, tt := range tests {
@@ -110,10 +147,7 @@ func TestProjectIDValidator(t *testing.T) {
 
 			// For error cases, check the response body
 			if tt.expectedStatus != http.StatusOK {
-				if rr.Body.String() != tt.expectedBody+"\n" {
-					t.Errorf("handler returned unexpected body: got %v want %v",
-						rr.Body.String(), tt.expectedBody+"\n")
-				}
+				require.JSONEq(t, tt.expectedBody, rr.Body.String())
 			}
 		})
 	}
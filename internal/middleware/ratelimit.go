@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+const (
+	// clientIdleTimeout is how long the limiter of a client is kept after its last request
+	clientIdleTimeout = 10 * time.Minute
+	// inFlightRetryAfter is the Retry-After of the requests rejected because of too many concurrent requests
+	inFlightRetryAfter = time.Second
+)
+
+// RateLimits configures the RateLimit middleware; a zero rate or maximum disables the respective limit
+type RateLimits struct {
+	// ClientRate and ClientBurst limit the requests per second of each client, identified by the verified subject of its
+	// bearer token or else by its address; the burst defaults to the rate
+	ClientRate  float64
+	ClientBurst int
+	// GlobalRate and GlobalBurst limit the requests per second of all clients together
+	GlobalRate  float64
+	GlobalBurst int
+	// MaxInFlight limits the requests served concurrently
+	MaxInFlight int
+	// Subject returns the subject of the bearer token of the request once its signature is verified, or an empty string
+	// if the request has no valid token; the limiter runs ahead of the authentication, so unverified claims are never
+	// trusted to identify the client
+	Subject func(r *http.Request) string
+}
+
+// RateLimit rejects the requests exceeding the rate limits of their client or of all clients, or the maximum of
+// concurrent requests, with 429 Too Many Requests and a Retry-After header, so that a single tenant can not exhaust
+// the requests the Kubernetes client may send on behalf of all tenants. Health and metrics requests are not limited.
+func RateLimit(limits RateLimits) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limits.ClientRate <= 0 && limits.GlobalRate <= 0 && limits.MaxInFlight <= 0 {
+			return next
+		}
+
+		clients := newClientLimiters(limits.ClientRate, burst(limits.ClientRate, limits.ClientBurst))
+		var global *rate.Limiter
+		if limits.GlobalRate > 0 {
+			global = rate.NewLimiter(rate.Limit(limits.GlobalRate), burst(limits.GlobalRate, limits.GlobalBurst))
+		}
+		var inFlight chan struct{}
+		if limits.MaxInFlight > 0 {
+			inFlight = make(chan struct{}, limits.MaxInFlight)
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(ignoredPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			now := time.Now()
+			var reservations []*rate.Reservation
+			if clients != nil {
+				reservations = append(reservations, clients.get(clientKey(r, limits.Subject), now).ReserveN(now, 1))
+			}
+			if global != nil {
+				reservations = append(reservations, global.ReserveN(now, 1))
+			}
+			for i, reservation := range reservations {
+				delay := reservation.DelayFrom(now)
+				if reservation.OK() && delay == 0 {
+					continue
+				}
+				// the tokens of the other limits are returned, the request is not served
+				for _, other := range reservations {
+					other.CancelAt(now)
+				}
+				limit := "global"
+				if clients != nil && i == 0 {
+					limit = "client"
+				}
+				rejectRequest(w, r, limit, delay)
+				return
+			}
+
+			if inFlight != nil {
+				select {
+				case inFlight <- struct{}{}:
+					defer func() { <-inFlight }()
+				default:
+					rejectRequest(w, r, "inflight", inFlightRetryAfter)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rejectRequest writes the 429 Too Many Requests response of a request rejected by the limit
+func rejectRequest(w http.ResponseWriter, r *http.Request, limit string, retryAfter time.Duration) {
+	seconds := max(1, int(math.Ceil(retryAfter.Seconds())))
+	metrics.RateLimitedCounter.WithLabelValues(limit).Inc()
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+
+	message := messages.New(messages.TooManyRequests, seconds)
+	if limit == "inflight" {
+		message = messages.New(messages.TooManyConcurrentRequests, seconds)
+	}
+	writeProblem(w, r, http.StatusTooManyRequests, message)
+}
+
+// burst returns the configured burst of a rate limit, defaulting to the rate
+func burst(limit float64, burst int) int {
+	if burst > 0 {
+		return burst
+	}
+	return max(1, int(math.Ceil(limit)))
+}
+
+// clientKey identifies the client of the request by the verified subject of its bearer token, or else by its address
+func clientKey(r *http.Request, subject func(r *http.Request) string) string {
+	if subject != nil {
+		if sub := subject(r); sub != "" {
+			return "sub:" + sub
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "addr:" + host
+}
+
+// clientLimiters are the rate limiters of the clients; the limiters of idle clients are dropped
+type clientLimiters struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	limiters  map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+type clientLimiter struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+// newClientLimiters returns the rate limiters of the clients, nil if the clients are not limited
+func newClientLimiters(limit float64, burst int) *clientLimiters {
+	if limit <= 0 {
+		return nil
+	}
+	return &clientLimiters{limit: rate.Limit(limit), burst: burst, limiters: map[string]*clientLimiter{}}
+}
+
+// get returns the rate limiter of the client, creating it on its first request
+func (c *clientLimiters) get(key string, now time.Time) *rate.Limiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.Sub(c.lastSweep) > clientIdleTimeout {
+		for k, limiter := range c.limiters {
+			if now.Sub(limiter.lastSeen) > clientIdleTimeout {
+				delete(c.limiters, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	limiter, ok := c.limiters[key]
+	if !ok {
+		limiter = &clientLimiter{Limiter: rate.NewLimiter(c.limit, c.burst)}
+		c.limiters[key] = limiter
+	}
+	limiter.lastSeen = now
+	return limiter.Limiter
+}
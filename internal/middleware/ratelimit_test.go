@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func token(t *testing.T, subject, key string) string {
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": subject}).SignedString([]byte(key))
+	require.NoError(t, err)
+	return "Bearer " + signed
+}
+
+// verifiedSubject returns the subject of the bearer tokens signed with the key "secret"
+func verifiedSubject(r *http.Request) string {
+	rawToken, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	token, err := jwt.Parse(rawToken, func(*jwt.Token) (any, error) { return []byte("secret"), nil })
+	if err != nil {
+		return ""
+	}
+	subject, _ := token.Claims.GetSubject()
+	return subject
+}
+
+func serveRateLimited(handler http.Handler, path, authorization, projectID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	if projectID != "" {
+		req.Header.Set("Activeprojectid", projectID)
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestRateLimit(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("clients are limited by their verified token subject", func(t *testing.T) {
+		handler := RateLimit(RateLimits{ClientRate: 0.01, ClientBurst: 2, Subject: verifiedSubject})(ok)
+		alice, bob := token(t, "alice", "secret"), token(t, "bob", "secret")
+
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", alice, "").Code)
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", alice, "").Code)
+		rr := serveRateLimited(handler, "/v2/clusters", alice, "")
+		require.Equal(t, http.StatusTooManyRequests, rr.Code)
+		require.Equal(t, "100", rr.Header().Get("Retry-After"))
+
+		var problem api.ProblemDetails
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+		require.Equal(t, "TOO_MANY_REQUESTS", *problem.Code)
+		require.Equal(t, "too many requests, retry after 100 seconds", *problem.Message)
+
+		// other clients have their own limits
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", bob, "").Code)
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", "", "").Code)
+
+		// health requests are not limited
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/healthz", alice, "").Code)
+	})
+
+	t.Run("clients without a verified token are limited by their address", func(t *testing.T) {
+		handler := RateLimit(RateLimits{ClientRate: 0.01, ClientBurst: 2, Subject: verifiedSubject})(ok)
+
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", "", "655a6892-4280-4c37-97b1-31161ac0b99e").Code)
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", "", "").Code)
+
+		// neither a forged token nor another project escapes the limit of the address
+		require.Equal(t, http.StatusTooManyRequests, serveRateLimited(handler, "/v2/clusters", token(t, "mallory", "forged"), "").Code)
+		require.Equal(t, http.StatusTooManyRequests, serveRateLimited(handler, "/v2/clusters", "", "2d3b8c1e-5f6a-4b7c-8d9e-0f1a2b3c4d5e").Code)
+
+		// without a verifier the tokens are not trusted at all
+		handler = RateLimit(RateLimits{ClientRate: 0.01, ClientBurst: 1})(ok)
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", token(t, "alice", "secret"), "").Code)
+		require.Equal(t, http.StatusTooManyRequests, serveRateLimited(handler, "/v2/clusters", token(t, "bob", "secret"), "").Code)
+	})
+
+	t.Run("all clients are limited together", func(t *testing.T) {
+		handler := RateLimit(RateLimits{ClientRate: 100, GlobalRate: 0.01, GlobalBurst: 1})(ok)
+
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", token(t, "alice", "secret"), "").Code)
+		rr := serveRateLimited(handler, "/v2/clusters", token(t, "bob", "secret"), "")
+		require.Equal(t, http.StatusTooManyRequests, rr.Code)
+		require.NotEmpty(t, rr.Header().Get("Retry-After"))
+	})
+
+	t.Run("concurrent requests are limited", func(t *testing.T) {
+		entered, release := make(chan struct{}), make(chan struct{})
+		handler := RateLimit(RateLimits{MaxInFlight: 1})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			entered <- struct{}{}
+			<-release
+		}))
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			serveRateLimited(handler, "/v2/clusters", "", "")
+		}()
+		<-entered
+
+		rr := serveRateLimited(handler, "/v2/clusters", "", "")
+		require.Equal(t, http.StatusTooManyRequests, rr.Code)
+		require.Equal(t, "1", rr.Header().Get("Retry-After"))
+
+		close(release)
+		wg.Wait()
+		go func() { <-entered }()
+		require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", "", "").Code)
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		handler := RateLimit(RateLimits{})(ok)
+		for range 100 {
+			require.Equal(t, http.StatusOK, serveRateLimited(handler, "/v2/clusters", "", "").Code)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+// RequestLogger adds the active project of the request and the subject of its bearer token, whose signature is
+// verified by the authenticator further down the chain, to the request-scoped logger of the request context; it runs
+// once the active project of the request is resolved
+func RequestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var attrs []any
+		if projectID := r.Header.Get("Activeprojectid"); projectID != "" {
+			attrs = append(attrs, logger.ProjectIDKey, projectID)
+		}
+		if subject := tokenSubject(r); subject != "" {
+			attrs = append(attrs, logger.SubjectKey, subject)
+		}
+		if len(attrs) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		next.ServeHTTP(w, r.WithContext(logger.WithLogger(ctx, logger.FromContext(ctx).With(attrs...))))
+	})
+}
+
+// tokenSubject returns the subject of the bearer token of the request without verifying its signature, or an empty
+// string if the request has no such token
+func tokenSubject(r *http.Request) string {
+	rawToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || rawToken == "" {
+		return ""
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(rawToken, claims); err != nil {
+		return ""
+	}
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return ""
+	}
+	return subject
+}
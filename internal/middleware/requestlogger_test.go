@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+)
+
+// correlatedHandler writes the correlation ID of the context of the records like the default handler of the logger
+type correlatedHandler struct {
+	slog.Handler
+}
+
+func (h correlatedHandler) Handle(ctx context.Context, record slog.Record) error {
+	record.AddAttrs(slog.String(logger.CorrelationIDKey, logger.CorrelationID(ctx)))
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h correlatedHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return correlatedHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func TestRequestLogger(t *testing.T) {
+	var out bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(correlatedHandler{slog.NewTextHandler(&out, nil)}))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user-1"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		header   string
+		keep     bool
+		token    string
+		expected []string
+	}{
+		{
+			name:     "client ID is kept",
+			header:   "3f1c2a9e-support-42",
+			keep:     true,
+			token:    token,
+			expected: []string{"correlation_id=3f1c2a9e-support-42", "project_id=655a6892-4280-4c37-97b1-31161ac0b99e", "subject=user-1"},
+		},
+		{
+			name:     "missing ID is generated",
+			expected: []string{"correlation_id=", "project_id=655a6892-4280-4c37-97b1-31161ac0b99e"},
+		},
+		{
+			name:   "invalid ID is replaced",
+			header: "bad id\nwith newline",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out.Reset()
+			handler := Append(CorrelationID, RequestLogger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				logger.FromContext(r.Context()).Info("handled request")
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v2/clusters", nil)
+			req.Header.Set("Activeprojectid", "655a6892-4280-4c37-97b1-31161ac0b99e")
+			if tt.header != "" {
+				req.Header.Set(logger.CorrelationIDHeader, tt.header)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			id := rr.Header().Get(logger.CorrelationIDHeader)
+			require.NotEmpty(t, id)
+			require.Contains(t, out.String(), "correlation_id="+id)
+			if tt.keep {
+				require.Equal(t, tt.header, id)
+			} else {
+				require.NotEqual(t, tt.header, id)
+			}
+			for _, expected := range tt.expected {
+				require.Contains(t, out.String(), expected)
+			}
+			if tt.token == "" {
+				require.NotContains(t, out.String(), "subject=")
+			}
+		})
+	}
+}
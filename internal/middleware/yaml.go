@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+)
+
+var yamlMediaTypes = []string{"application/yaml", "application/x-yaml", "text/yaml"}
+
+// YAMLContentNegotiation lets clients send and receive YAML instead of JSON
+// YAML request bodies are converted to JSON before they are validated against the OpenAPI spec, and JSON responses
+// are converted to YAML when the client accepts YAML but not JSON; all other requests and responses pass through
+func YAMLContentNegotiation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isYAML(r.Header.Get("Content-Type")) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeProblem(w, r, http.StatusBadRequest, messages.New(messages.RequestBodyReadFailed))
+				return
+			}
+			body, err = yaml.YAMLToJSON(body)
+			if err != nil {
+				slog.Debug("invalid yaml request body", "error", err)
+				writeProblem(w, r, http.StatusBadRequest, messages.New(messages.InvalidYAMLRequestBody))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+
+		if !acceptsYAML(r.Header.Get("Accept")) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		yw := &yamlResponseWriter{ResponseWriter: w}
+		r.Header.Set("Accept", "application/json")
+		next.ServeHTTP(yw, r)
+		yw.flushYAML()
+	})
+}
+
+// yamlResponseWriter buffers JSON responses to convert them to YAML; other responses are written through
+type yamlResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	buffering   bool
+	body        bytes.Buffer
+}
+
+func (yw *yamlResponseWriter) WriteHeader(code int) {
+	if yw.wroteHeader {
+		return
+	}
+	yw.wroteHeader = true
+	yw.status = code
+
+	mediaType, _, _ := mime.ParseMediaType(yw.Header().Get("Content-Type"))
+	if mediaType == "application/json" {
+		yw.buffering = true
+		return
+	}
+	yw.ResponseWriter.WriteHeader(code)
+}
+
+func (yw *yamlResponseWriter) Write(b []byte) (int, error) {
+	if !yw.wroteHeader {
+		yw.WriteHeader(http.StatusOK)
+	}
+	if yw.buffering {
+		return yw.body.Write(b)
+	}
+	return yw.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher so that streamed responses keep working
+func (yw *yamlResponseWriter) Flush() {
+	if yw.buffering {
+		return
+	}
+	if f, ok := yw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (yw *yamlResponseWriter) flushYAML() {
+	if !yw.buffering {
+		return
+	}
+
+	body := yw.body.Bytes()
+	if converted, err := yaml.JSONToYAML(body); err != nil {
+		// not valid JSON after all, send it unchanged
+		slog.Warn("failed to convert response to yaml", "error", err)
+	} else {
+		body = converted
+		yw.Header().Set("Content-Type", "application/yaml")
+	}
+
+	yw.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	yw.ResponseWriter.WriteHeader(yw.status)
+	if _, err := yw.ResponseWriter.Write(body); err != nil {
+		slog.Error("failed to write yaml response", "error", err)
+	}
+}
+
+func isYAML(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(yamlMediaTypes, mediaType)
+}
+
+// acceptsYAML returns true if the Accept header lists a YAML media type, unless JSON is preferred
+func acceptsYAML(accept string) bool {
+	yamlQ, jsonQ := -1.0, -1.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		switch {
+		case isYAML(mediaType):
+			yamlQ = max(yamlQ, q)
+		case mediaType == "application/json":
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	return yamlQ > 0 && yamlQ >= jsonQ
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAMLContentNegotiation(t *testing.T) {
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	})
+
+	tests := []struct {
+		name                string
+		contentType         string
+		accept              string
+		body                string
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "json in, json out",
+			contentType:         "application/json",
+			body:                `{"name":"baseline","version":"v1.0.0"}`,
+			expectedStatus:      http.StatusCreated,
+			expectedContentType: "application/json",
+			expectedBody:        `{"name":"baseline","version":"v1.0.0"}`,
+		},
+		{
+			name:                "yaml in, json out",
+			contentType:         "application/yaml",
+			body:                "name: baseline\nversion: v1.0.0\n",
+			expectedStatus:      http.StatusCreated,
+			expectedContentType: "application/json",
+			expectedBody:        `{"name":"baseline","version":"v1.0.0"}`,
+		},
+		{
+			name:                "yaml in, yaml out",
+			contentType:         "application/x-yaml",
+			accept:              "application/yaml",
+			body:                "name: baseline\nversion: v1.0.0\n",
+			expectedStatus:      http.StatusCreated,
+			expectedContentType: "application/yaml",
+			expectedBody:        "name: baseline\nversion: v1.0.0\n",
+		},
+		{
+			name:                "json preferred over yaml",
+			contentType:         "application/json",
+			accept:              "application/yaml;q=0.5, application/json",
+			body:                `{"name":"baseline"}`,
+			expectedStatus:      http.StatusCreated,
+			expectedContentType: "application/json",
+			expectedBody:        `{"name":"baseline"}`,
+		},
+		{
+			name:                "invalid yaml",
+			contentType:         "application/yaml",
+			body:                "name: [baseline",
+			expectedStatus:      http.StatusBadRequest,
+			expectedContentType: "application/json",
+			expectedBody:        `{"code":"INVALID_YAML_REQUEST_BODY","message":"request body is not valid yaml"}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v2/templates", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rr := httptest.NewRecorder()
+
+			YAMLContentNegotiation(echo).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Equal(t, tt.expectedContentType, rr.Header().Get("Content-Type"))
+			require.Equal(t, tt.expectedBody, rr.Body.String())
+		})
+	}
+}
+
+func TestYAMLContentNegotiationPassesNonJSONResponses(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("data: {}\n\n"))
+		w.(http.Flusher).Flush()
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/clusters/example-cluster/events", nil)
+	req.Header.Set("Accept", "application/yaml")
+	rr := httptest.NewRecorder()
+
+	YAMLContentNegotiation(handler).ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+	require.Equal(t, "data: {}\n\n", rr.Body.String())
+	require.True(t, rr.Flushed)
+}
@@ -20,6 +20,7 @@ import (
 	v1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/offboarding"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/providers"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
 )
@@ -44,6 +45,8 @@ var (
 	}
 
 	defaultTemplate string
+	exportStore     offboarding.Store
+	auditLogSource  offboarding.AuditLogSource
 )
 
 // TenancyDatamodel implements tenancy.Handler and manages per-project k8s resources.
@@ -53,6 +56,7 @@ type TenancyDatamodel struct {
 	templates       []*v1alpha1.ClusterTemplate
 	psaData         map[string][]byte
 	defaultTemplate string
+	exporter        *offboarding.Exporter
 }
 
 func (t *TenancyDatamodel) Start() error {
@@ -128,12 +132,21 @@ func NewDatamodelClient() (*TenancyDatamodel, error) {
 		return nil, fmt.Errorf("failed to read pod security admission configs: %w", err)
 	}
 
-	return &TenancyDatamodel{
+	t := &TenancyDatamodel{
 		k8s:             k8sClient,
 		templates:       templates,
 		psaData:         psaData,
 		defaultTemplate: defaultTemplate,
-	}, nil
+	}
+	if exportStore != nil {
+		var options []func(*offboarding.Exporter)
+		if auditLogSource != nil {
+			options = append(options, offboarding.WithAuditLogSource(auditLogSource))
+		}
+		t.exporter = offboarding.NewExporter(k8sClient, exportStore, options...)
+	}
+
+	return t, nil
 }
 
 // SetDefaultTemplate allows setting the default template name used for new projects.
@@ -141,6 +154,16 @@ func SetDefaultTemplate(name string) {
 	defaultTemplate = name
 }
 
+// SetExportStore allows setting the store for the export bundles generated before projects are deleted.
+func SetExportStore(store offboarding.Store) {
+	exportStore = store
+}
+
+// SetAuditLogSource allows setting the source of the audit logs included in the export bundles of deleted projects.
+func SetAuditLogSource(source offboarding.AuditLogSource) {
+	auditLogSource = source
+}
+
 // HandleEvent implements tenancy.Handler. It is called for every project lifecycle
 // event (both replay on startup and incremental). Handlers must be idempotent.
 func (t *TenancyDatamodel) HandleEvent(ctx context.Context, event tenancy.Event) error {
@@ -253,8 +276,9 @@ func (t *TenancyDatamodel) setupProject(ctx context.Context, projectId, projectN
 	// Set the default template for the project.
 	// Selection order:
 	// 1. Use an existing valid default template if already set for the project.
-	// 2. Use the default template specified in the configuration, if available.
-	// 3. Otherwise, use the first template from the available template list.
+	// 2. Use the template pinned as default of the project, if available.
+	// 3. Use the default template specified in the configuration, if available.
+	// 4. Otherwise, use the first template from the available template list.
 	if err := t.setDefaultTemplate(ctx, projectId); err != nil {
 		return fmt.Errorf("failed to set default template for project '%s': %w", projectName, err)
 	}
@@ -265,6 +289,7 @@ func (t *TenancyDatamodel) setupProject(ctx context.Context, projectId, projectN
 
 func (t *TenancyDatamodel) setDefaultTemplate(ctx context.Context, projectId string) error {
 	// Skip if valid default template is already set for the project
+	invalidTemplate := ""
 	if template, err := t.k8s.DefaultTemplate(ctx, projectId); err == nil && template.Name != "" {
 		// Check if the provider type is in supported control plane provider types
 		// Otherwise, proceed to find a suitable template
@@ -279,6 +304,20 @@ func (t *TenancyDatamodel) setDefaultTemplate(ctx context.Context, projectId str
 			return fmt.Errorf("failed to remove default label from template '%s' in namespace '%s': %v", template.Name, projectId, err)
 		}
 		slog.Debug("removed default label from invalid template", "namespace", projectId, "template", template.Name)
+		invalidTemplate = template.Name
+	}
+
+	// Restore the template pinned as default of the project, e.g. after its templates were created again
+	pinned, err := t.k8s.PinnedDefaultTemplate(ctx, projectId)
+	if err != nil {
+		return fmt.Errorf("failed to get pinned default template in namespace '%s': %w", projectId, err)
+	}
+	if pinned != "" && pinned != invalidTemplate && t.k8s.HasTemplate(ctx, projectId, pinned) {
+		if err := t.k8s.SetDefaultTemplate(ctx, projectId, pinned, ""); err != nil {
+			return fmt.Errorf("failed to set pinned default template %s in namespace %s: %w", pinned, projectId, err)
+		}
+		slog.Debug("restored pinned default template", "namespace", projectId, "template", pinned)
+		return nil
 	}
 
 	// If no default template is set in the configuration or configured default template is not available,
@@ -292,9 +331,7 @@ func (t *TenancyDatamodel) setDefaultTemplate(ctx context.Context, projectId str
 	}
 
 	// Apply default label to the default template
-	if err := t.k8s.AddTemplateLabels(ctx, projectId, t.defaultTemplate, map[string]string{
-		labels.DefaultLabelKey: labels.DefaultLabelVal,
-	}); err != nil {
+	if err := t.k8s.SetDefaultTemplate(ctx, projectId, t.defaultTemplate, ""); err != nil {
 		return fmt.Errorf("failed to label default template %s in namespace %s: %v", t.defaultTemplate, projectId, err)
 	}
 
@@ -302,6 +339,15 @@ func (t *TenancyDatamodel) setDefaultTemplate(ctx context.Context, projectId str
 }
 
 func (t *TenancyDatamodel) cleanupProject(ctx context.Context, projectId, projectName string) error {
+	// Export the project before anything is deleted. The export is skipped if the bundle already
+	// exists, so replayed delete events do not overwrite it with an empty one.
+	if t.exporter != nil {
+		if err := t.exporter.Export(ctx, projectId, projectName); err != nil {
+			return fmt.Errorf("failed to export project: %w", err)
+		}
+		slog.Debug("exported project", "namespace", projectId, "project", projectName)
+	}
+
 	// Delete all clusters. Ignore not-found: the namespace may have been removed already
 	// on a previous run (the poller replays all delete events on startup for idempotency).
 	if err := t.k8s.DeleteClusters(ctx, projectId); err != nil && !k8serrors.IsNotFound(err) {
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package multitenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+// ErrQuotaExceeded is returned when a request would exceed the quota of a project
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Quota limits the resources of a project, a zero limit means unlimited
+type Quota struct {
+	// MaxClusters is the maximum number of clusters of the project
+	MaxClusters int `json:"maxClusters,omitempty"`
+	// MaxNodes is the maximum number of control plane and worker nodes of all clusters of the project
+	MaxNodes int `json:"maxNodes,omitempty"`
+	// MaxControlPlaneReplicas is the maximum number of control plane nodes of a cluster of the project
+	MaxControlPlaneReplicas int `json:"maxControlPlaneReplicas,omitempty"`
+}
+
+// QuotaConfig is the quota configuration file, see LoadQuotaConfig
+type QuotaConfig struct {
+	// Default is the quota of projects without a quota of their own
+	Default Quota `json:"default"`
+	// Projects are the quotas of individual projects by project id
+	Projects map[string]Quota `json:"projects,omitempty"`
+}
+
+// QuotaRequest is the usage a request adds to a project
+type QuotaRequest struct {
+	// Clusters is the number of clusters created
+	Clusters int
+	// Nodes is the number of nodes added to the project
+	Nodes int
+	// ControlPlaneReplicas is the number of control plane nodes the created or scaled cluster ends up with, zero if unchanged
+	ControlPlaneReplicas int
+}
+
+// LoadQuotaConfig reads the quota configuration from the given YAML or JSON file
+func LoadQuotaConfig(path string) (QuotaConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return QuotaConfig{}, fmt.Errorf("failed to read quota config: %w", err)
+	}
+
+	var config QuotaConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return QuotaConfig{}, fmt.Errorf("failed to parse quota config: %w", err)
+	}
+	return config, nil
+}
+
+// QuotaEnforcer checks requests against the quotas of the projects
+// The usage of a project is computed from its clusters when a request is checked, so concurrent requests of the same
+// project may together exceed its quota
+type QuotaEnforcer struct {
+	k8s    *k8s.Client
+	config QuotaConfig
+}
+
+// NewQuotaEnforcer creates a new QuotaEnforcer with the given configuration
+func NewQuotaEnforcer(k8s *k8s.Client, config QuotaConfig) *QuotaEnforcer {
+	return &QuotaEnforcer{k8s: k8s, config: config}
+}
+
+// Quota returns the quota of the given project
+func (q *QuotaEnforcer) Quota(projectID string) Quota {
+	if quota, ok := q.config.Projects[projectID]; ok {
+		return quota
+	}
+	return q.config.Default
+}
+
+// Check returns an error wrapping ErrQuotaExceeded if the request would exceed the quota of the project in the given namespace
+func (q *QuotaEnforcer) Check(ctx context.Context, namespace string, request QuotaRequest) error {
+	quota := q.Quota(namespace)
+
+	if quota.MaxControlPlaneReplicas > 0 && request.ControlPlaneReplicas > quota.MaxControlPlaneReplicas {
+		return fmt.Errorf("%w: clusters of the project are limited to %d control plane nodes, %d requested",
+			ErrQuotaExceeded, quota.MaxControlPlaneReplicas, request.ControlPlaneReplicas)
+	}
+
+	if (quota.MaxClusters == 0 || request.Clusters == 0) && (quota.MaxNodes == 0 || request.Nodes == 0) {
+		return nil
+	}
+
+	clusters, nodes, err := q.usage(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get the usage of the project: %w", err)
+	}
+
+	if quota.MaxClusters > 0 && request.Clusters > 0 && clusters+request.Clusters > quota.MaxClusters {
+		return fmt.Errorf("%w: the project is limited to %d clusters and already has %d",
+			ErrQuotaExceeded, quota.MaxClusters, clusters)
+	}
+	if quota.MaxNodes > 0 && request.Nodes > 0 && nodes+request.Nodes > quota.MaxNodes {
+		return fmt.Errorf("%w: the project is limited to %d nodes and already has %d, %d requested",
+			ErrQuotaExceeded, quota.MaxNodes, nodes, request.Nodes)
+	}
+	return nil
+}
+
+// usage returns the number of clusters and nodes of the project in the given namespace
+func (q *QuotaEnforcer) usage(ctx context.Context, namespace string) (int, int, error) {
+	list, err := q.k8s.Dyn.Resource(core.ClusterResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	nodes := 0
+	for _, item := range list.Items {
+		var cluster capi.Cluster
+		if err := convert.FromUnstructured(item, &cluster); err != nil {
+			return 0, 0, err
+		}
+		nodes += clusterNodes(cluster)
+	}
+	return len(list.Items), nodes, nil
+}
+
+// clusterNodes returns the number of nodes of the cluster according to its topology, a topology without control plane
+// replicas has a single control plane node
+func clusterNodes(cluster capi.Cluster) int {
+	topology := cluster.Spec.Topology
+	if topology == nil {
+		return 0
+	}
+
+	nodes := 1
+	if topology.ControlPlane.Replicas != nil {
+		nodes = int(*topology.ControlPlane.Replicas)
+	}
+	if topology.Workers != nil {
+		for _, md := range topology.Workers.MachineDeployments {
+			if md.Replicas != nil {
+				nodes += int(*md.Replicas)
+			}
+		}
+	}
+	return nodes
+}
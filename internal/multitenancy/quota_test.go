@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package multitenancy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const quotaProjectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+func createQuotaCluster(t *testing.T, client *k8s.Client, name string, controlPlaneReplicas int32, workerReplicas ...int32) {
+	cluster := capi.Cluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: core.ClusterResourceSchema.GroupVersion().String(), Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: quotaProjectID},
+		Spec: capi.ClusterSpec{Topology: &capi.Topology{
+			Class:        "baseline-v1.0.0",
+			Version:      "v1.30.6+k3s1",
+			ControlPlane: capi.ControlPlaneTopology{Replicas: convert.Ptr(controlPlaneReplicas)},
+			Workers:      &capi.WorkersTopology{},
+		}},
+	}
+	for _, replicas := range workerReplicas {
+		cluster.Spec.Topology.Workers.MachineDeployments = append(cluster.Spec.Topology.Workers.MachineDeployments,
+			capi.MachineDeploymentTopology{Class: "worker", Name: "workers", Replicas: convert.Ptr(replicas)})
+	}
+
+	obj, err := convert.ToUnstructured(cluster)
+	require.NoError(t, err)
+	_, err = client.Dyn.Resource(core.ClusterResourceSchema).Namespace(quotaProjectID).Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func TestLoadQuotaConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quotas.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+default:
+  maxClusters: 10
+  maxNodes: 50
+projects:
+  655a6892-4280-4c37-97b1-31161ac0b99e:
+    maxClusters: 2
+    maxControlPlaneReplicas: 3
+`), 0o600))
+
+	config, err := LoadQuotaConfig(path)
+	require.NoError(t, err)
+
+	enforcer := NewQuotaEnforcer(nil, config)
+	require.Equal(t, Quota{MaxClusters: 2, MaxControlPlaneReplicas: 3}, enforcer.Quota(quotaProjectID))
+	require.Equal(t, Quota{MaxClusters: 10, MaxNodes: 50}, enforcer.Quota("64e797f6-db22-445e-b606-4228d4f1c2bd"))
+
+	require.NoError(t, os.WriteFile(path, []byte("default:\n  maxClusterz: 10\n"), 0o600))
+	_, err = LoadQuotaConfig(path)
+	require.ErrorContains(t, err, "failed to parse quota config")
+}
+
+func TestQuotaEnforcerCheck(t *testing.T) {
+	client := k8s.New().WithFakeClient()
+	createQuotaCluster(t, client, "cluster-1", 1, 2)
+	createQuotaCluster(t, client, "cluster-2", 3)
+
+	enforcer := NewQuotaEnforcer(client, QuotaConfig{
+		Projects: map[string]Quota{quotaProjectID: {MaxClusters: 3, MaxNodes: 8, MaxControlPlaneReplicas: 3}},
+	})
+
+	tests := []struct {
+		name          string
+		request       QuotaRequest
+		expectedError string
+	}{
+		{
+			name:    "cluster within quota",
+			request: QuotaRequest{Clusters: 1, Nodes: 2, ControlPlaneReplicas: 2},
+		},
+		{
+			name:          "too many control plane replicas",
+			request:       QuotaRequest{Clusters: 1, Nodes: 5, ControlPlaneReplicas: 5},
+			expectedError: "quota exceeded: clusters of the project are limited to 3 control plane nodes, 5 requested",
+		},
+		{
+			name:          "too many nodes",
+			request:       QuotaRequest{Nodes: 3},
+			expectedError: "quota exceeded: the project is limited to 8 nodes and already has 6, 3 requested",
+		},
+		{
+			name:    "nodes within quota",
+			request: QuotaRequest{Nodes: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := enforcer.Check(context.Background(), quotaProjectID, tt.request)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrQuotaExceeded)
+			require.EqualError(t, err, tt.expectedError)
+		})
+	}
+
+	t.Run("too many clusters", func(t *testing.T) {
+		createQuotaCluster(t, client, "cluster-3", 1)
+		err := enforcer.Check(context.Background(), quotaProjectID, QuotaRequest{Clusters: 1, Nodes: 1, ControlPlaneReplicas: 1})
+		require.ErrorIs(t, err, ErrQuotaExceeded)
+		require.EqualError(t, err, "quota exceeded: the project is limited to 3 clusters and already has 3")
+	})
+
+	t.Run("unlimited project", func(t *testing.T) {
+		err := NewQuotaEnforcer(client, QuotaConfig{}).Check(context.Background(), quotaProjectID, QuotaRequest{Clusters: 100, Nodes: 100, ControlPlaneReplicas: 7})
+		require.NoError(t, err)
+	})
+}
@@ -8,12 +8,15 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"strings"
 
 	libtenancy "github.com/open-edge-platform/orch-library/go/pkg/tenancy"
 
+	"github.com/open-edge-platform/cluster-manager/v2/internal/audit"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/offboarding"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/tenancyclient"
 )
 
@@ -46,6 +49,12 @@ var (
 // It supports explicit legacy watcher mode and explicit poller mode.
 func InitializeRuntime(ctx context.Context, cfg *config.Config, controllerName string) error {
 	SetDefaultTemplate(cfg.DefaultTemplate)
+	if cfg.OffboardingExportDir != "" {
+		SetExportStore(offboarding.NewDirStore(cfg.OffboardingExportDir))
+	}
+	if slices.Contains(cfg.AuditSinks, "file") {
+		SetAuditLogSource(audit.NewFileSink(cfg.AuditLogDir))
+	}
 
 	if cfg.DisableMultitenancy {
 		return nil
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package naming provides the naming policies the cluster names of the projects have to follow, e.g. the site code
+// prefix of an organization, and generates names complying with them
+package naming
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ErrNoCompliantName is returned when no name complying with a naming policy can be generated
+var ErrNoCompliantName = errors.New("no compliant name")
+
+const (
+	// maxNameLength is the maximum length of cluster names, which are used as label values
+	maxNameLength = 63
+	// defaultBase is the base of generated names without base
+	defaultBase = "cluster"
+	// maxSuggestions is the number of suffixes tried for a suggested name before giving up
+	maxSuggestions = 100
+)
+
+var (
+	prefixPattern  = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	namePattern    = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	invalidPattern = regexp.MustCompile(`[^a-z0-9-]+`)
+	dashesPattern  = regexp.MustCompile(`-{2,}`)
+)
+
+// Policy is the naming policy of the clusters of a project, the zero policy allows any name
+type Policy struct {
+	// Prefix is the prefix cluster names must start with, e.g. the site code 'fra1-'
+	Prefix string `json:"prefix,omitempty"`
+	// MaxLength is the maximum length of cluster names, zero allows names of up to 63 characters
+	MaxLength int `json:"maxLength,omitempty"`
+	// ForbiddenWords are the words cluster names must not contain, regardless of their case
+	ForbiddenWords []string `json:"forbiddenWords,omitempty"`
+}
+
+// Config is the naming policy configuration file, see LoadConfig
+type Config struct {
+	// Default is the policy of projects without a policy of their own
+	Default Policy `json:"default"`
+	// Projects are the policies of individual projects by project id
+	Projects map[string]Policy `json:"projects,omitempty"`
+}
+
+// LoadConfig reads the naming policy configuration from the given YAML or JSON file
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read naming policy config: %w", err)
+	}
+
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to parse naming policy config: %w", err)
+	}
+
+	if err := config.Default.validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid default naming policy: %w", err)
+	}
+	for projectID, policy := range config.Projects {
+		if err := policy.validate(); err != nil {
+			return Config{}, fmt.Errorf("invalid naming policy of project %s: %w", projectID, err)
+		}
+	}
+	return config, nil
+}
+
+// validate checks that names complying with the policy can exist
+func (p Policy) validate() error {
+	if p.Prefix != "" && !prefixPattern.MatchString(p.Prefix) {
+		return fmt.Errorf("invalid prefix '%s', only lowercase alphanumeric characters and '-' are allowed", p.Prefix)
+	}
+	if p.MaxLength < 0 || p.MaxLength > maxNameLength {
+		return fmt.Errorf("invalid max length %d, it must be between 1 and %d", p.MaxLength, maxNameLength)
+	}
+	if p.MaxLength > 0 && p.MaxLength <= len(p.Prefix) {
+		return fmt.Errorf("max length %d leaves no room after prefix '%s'", p.MaxLength, p.Prefix)
+	}
+	for _, word := range p.ForbiddenWords {
+		if word == "" {
+			return errors.New("forbidden words must not be empty")
+		}
+		if strings.Contains(strings.ToLower(p.Prefix), strings.ToLower(word)) {
+			return fmt.Errorf("prefix '%s' contains forbidden word '%s'", p.Prefix, word)
+		}
+	}
+	return nil
+}
+
+// maxLength returns the maximum length of the names of the policy
+func (p Policy) maxLength() int {
+	if p.MaxLength > 0 {
+		return p.MaxLength
+	}
+	return maxNameLength
+}
+
+// Violations returns the rules of the policy the given name violates, none if the name complies with the policy
+func (p Policy) Violations(name string) []string {
+	var violations []string
+	if !strings.HasPrefix(name, p.Prefix) {
+		violations = append(violations, fmt.Sprintf("it must start with '%s'", p.Prefix))
+	}
+	if p.MaxLength > 0 && len(name) > p.MaxLength {
+		violations = append(violations, fmt.Sprintf("it must not be longer than %d characters", p.MaxLength))
+	}
+	lower := strings.ToLower(name)
+	for _, word := range p.ForbiddenWords {
+		if strings.Contains(lower, strings.ToLower(word)) {
+			violations = append(violations, fmt.Sprintf("it must not contain '%s'", word))
+		}
+	}
+	return violations
+}
+
+// Generate returns a name complying with the policy from the given base and suffix: the base is turned into lowercase
+// alphanumeric characters and dashes, its forbidden words are removed and it is shortened to fit the prefix and the
+// suffix into the maximum length. The returned error wraps ErrNoCompliantName if there is no such name.
+func (p Policy) Generate(base, suffix string) (string, error) {
+	base = strings.TrimLeft(strings.TrimPrefix(p.sanitize(base), p.Prefix), "-")
+	if base == "" && suffix == "" {
+		base = p.sanitize(defaultBase)
+	}
+	suffix = p.sanitize(suffix)
+	if suffix != "" && base != "" {
+		suffix = "-" + suffix
+	}
+
+	if room := max(p.maxLength()-len(p.Prefix)-len(suffix), 0); len(base) > room {
+		base = strings.TrimRight(base[:room], "-")
+		suffix = strings.TrimPrefix(suffix, "-")
+		if base != "" {
+			suffix = "-" + suffix
+		}
+	}
+
+	name := p.Prefix + base + suffix
+	if len(name) > p.maxLength() {
+		return "", fmt.Errorf("%w: suffix '%s' does not fit into %d characters", ErrNoCompliantName, suffix, p.maxLength())
+	}
+	if !namePattern.MatchString(name) {
+		return "", fmt.Errorf("%w: '%s' is not a valid cluster name", ErrNoCompliantName, name)
+	}
+	if violations := p.Violations(name); len(violations) > 0 {
+		return "", fmt.Errorf("%w: '%s' violates the policy: %s", ErrNoCompliantName, name, strings.Join(violations, "; "))
+	}
+	return name, nil
+}
+
+// Suggest returns a name complying with the policy from the given base that is not taken yet, the name gets a numeric
+// suffix if the name without suffix is taken. The returned error wraps ErrNoCompliantName if there is no such name.
+func (p Policy) Suggest(base string, taken func(name string) bool) (string, error) {
+	name, err := p.Generate(base, "")
+	if err != nil {
+		return "", err
+	}
+	for i := 2; taken(name); i++ {
+		if i > maxSuggestions {
+			return "", fmt.Errorf("%w: the names of base '%s' are taken", ErrNoCompliantName, base)
+		}
+		if name, err = p.Generate(base, strconv.Itoa(i)); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+// sanitize turns the given name part into lowercase alphanumeric characters and dashes without the forbidden words of
+// the policy
+func (p Policy) sanitize(part string) string {
+	part = invalidPattern.ReplaceAllString(strings.ToLower(part), "-")
+	for removed := true; removed; {
+		removed = false
+		for _, word := range p.ForbiddenWords {
+			if word = strings.ToLower(word); strings.Contains(part, word) {
+				part = strings.ReplaceAll(part, word, "")
+				removed = true
+			}
+		}
+	}
+	return strings.Trim(dashesPattern.ReplaceAllString(part, "-"), "-")
+}
+
+// Policies are the naming policies of the projects
+type Policies struct {
+	config Config
+}
+
+// NewPolicies creates new Policies with the given configuration
+func NewPolicies(config Config) *Policies {
+	return &Policies{config: config}
+}
+
+// Policy returns the naming policy of the given project
+func (p *Policies) Policy(projectID string) Policy {
+	if policy, ok := p.config.Projects[projectID]; ok {
+		return policy
+	}
+	return p.config.Default
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package naming
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sitePolicy = Policy{Prefix: "fra1-", MaxLength: 20, ForbiddenWords: []string{"Prod", "test"}}
+
+func TestLoadConfig(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "naming.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("policies of the projects", func(t *testing.T) {
+		config, err := LoadConfig(write(t, `
+default:
+  maxLength: 30
+projects:
+  655a6892-4280-4c37-97b1-31161ac0b99e:
+    prefix: fra1-
+    maxLength: 20
+    forbiddenWords: [Prod, test]
+`))
+		require.NoError(t, err)
+
+		policies := NewPolicies(config)
+		assert.Equal(t, sitePolicy, policies.Policy("655a6892-4280-4c37-97b1-31161ac0b99e"))
+		assert.Equal(t, Policy{MaxLength: 30}, policies.Policy("other"))
+	})
+
+	for name, content := range map[string]string{
+		"unknown field":         "default:\n  suffix: -fra1",
+		"invalid prefix":        "default:\n  prefix: Fra1_",
+		"too long":              "default:\n  maxLength: 64",
+		"no room after prefix":  "default:\n  prefix: fra1-\n  maxLength: 5",
+		"empty forbidden word":  "default:\n  forbiddenWords: ['']",
+		"prefix with forbidden": "projects:\n  p:\n    prefix: prod-\n    forbiddenWords: [prod]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadConfig(write(t, content))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPolicyViolations(t *testing.T) {
+	assert.Empty(t, sitePolicy.Violations("fra1-edge"))
+	assert.Empty(t, Policy{}.Violations("Production-Cluster"))
+	assert.Equal(t, []string{"it must start with 'fra1-'"}, sitePolicy.Violations("edge"))
+	assert.Equal(t, []string{
+		"it must not be longer than 20 characters",
+		"it must not contain 'Prod'",
+	}, sitePolicy.Violations("fra1-edge-production1"))
+	assert.Equal(t, []string{
+		"it must start with 'fra1-'",
+		"it must not contain 'test'",
+	}, sitePolicy.Violations("Test-Cluster"))
+}
+
+func TestPolicyGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   Policy
+		base     string
+		suffix   string
+		expected string
+	}{
+		{name: "no policy", base: "cluster", suffix: "1767225600", expected: "cluster-1767225600"},
+		{name: "prefix", policy: sitePolicy, base: "edge", expected: "fra1-edge"},
+		{name: "prefix of the base", policy: sitePolicy, base: "fra1-edge", expected: "fra1-edge"},
+		{name: "invalid characters", policy: sitePolicy, base: "Edge Node_1", expected: "fra1-edge-node-1"},
+		{name: "forbidden words", policy: sitePolicy, base: "prod-edge-TEST", expected: "fra1-edge"},
+		{name: "default base", policy: sitePolicy, base: "prod", expected: "fra1-cluster"},
+		{name: "shortened base", policy: sitePolicy, base: "warehouse-gateway", suffix: "12", expected: "fra1-warehouse-ga-12"},
+		{name: "suffix only", policy: Policy{Prefix: "fra1-", MaxLength: 8}, base: "edge", suffix: "123", expected: "fra1-123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := tt.policy.Generate(tt.base, tt.suffix)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, name)
+			assert.Empty(t, tt.policy.Violations(name))
+		})
+	}
+
+	t.Run("suffix too long", func(t *testing.T) {
+		_, err := Policy{Prefix: "fra1-", MaxLength: 8}.Generate("edge", "1767225600")
+		require.ErrorIs(t, err, ErrNoCompliantName)
+	})
+}
+
+func TestPolicySuggest(t *testing.T) {
+	taken := map[string]bool{"fra1-edge": true, "fra1-edge-2": true}
+	name, err := sitePolicy.Suggest("edge", func(name string) bool { return taken[name] })
+	require.NoError(t, err)
+	assert.Equal(t, "fra1-edge-3", name)
+
+	_, err = sitePolicy.Suggest("edge", func(string) bool { return true })
+	require.ErrorIs(t, err, ErrNoCompliantName)
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package naming
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"time"
+)
+
+// Strategy is the strategy the names of the clusters created without a name are generated with
+type Strategy string
+
+const (
+	// StrategyTimestamp generates '<prefix>cluster-<unix time>' names
+	StrategyTimestamp Strategy = "timestamp"
+	// StrategyRandom generates '<prefix>cluster-<random suffix>' names
+	StrategyRandom Strategy = "random"
+	// StrategySite generates '<prefix><site>-<n>' names from the site of the hosts of the cluster, numbered per site
+	StrategySite Strategy = "site"
+	// StrategySequential generates '<prefix>cluster-<n>' names, numbered per project
+	StrategySequential Strategy = "sequential"
+)
+
+// Strategies are the supported name generation strategies
+var Strategies = []string{string(StrategyTimestamp), string(StrategyRandom), string(StrategySite), string(StrategySequential)}
+
+const (
+	// maxAttempts is the number of random names tried before giving up
+	maxAttempts = 10
+	// maxSequence is the highest number of the numbered names
+	maxSequence = 10000
+	// randomLength is the length of the random suffixes
+	randomLength = 5
+	// randomAlphabet are the characters of the random suffixes
+	randomAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// Request is the cluster a name is generated for
+type Request struct {
+	// Site is the site code of the hosts of the cluster the site strategy generates the name from, empty if the hosts
+	// are not assigned to a site
+	Site string
+	// Now is the time of the request the timestamp strategy generates the name from
+	Now time.Time
+	// Taken reports whether a name is taken by a cluster of the project
+	Taken func(name string) bool
+}
+
+// GenerateName returns a name complying with the policy that is not taken yet, generated with the given strategy; the
+// empty strategy is the timestamp strategy. Taken names are retried: the numbered names of the site and sequential
+// strategies with the next number, the others with a new random suffix. The returned error wraps ErrNoCompliantName
+// if there is no such name.
+func (p Policy) GenerateName(strategy Strategy, request Request) (string, error) {
+	switch strategy {
+	case StrategyTimestamp, "":
+		timestamp := strconv.FormatInt(request.Now.Unix(), 10)
+		return p.firstFree(request.Taken, maxAttempts, func(attempt int) (string, error) {
+			if attempt == 0 {
+				return p.Generate(defaultBase, timestamp)
+			}
+			return p.Generate(defaultBase, timestamp+"-"+randomSuffix())
+		})
+	case StrategyRandom:
+		return p.firstFree(request.Taken, maxAttempts, func(int) (string, error) {
+			return p.Generate(defaultBase, randomSuffix())
+		})
+	case StrategySite:
+		base := request.Site
+		if p.sanitize(base) == "" {
+			base = defaultBase
+		}
+		return p.firstFree(request.Taken, maxSequence, func(attempt int) (string, error) {
+			return p.Generate(base, strconv.Itoa(attempt+1))
+		})
+	case StrategySequential:
+		return p.firstFree(request.Taken, maxSequence, func(attempt int) (string, error) {
+			return p.Generate(defaultBase, strconv.Itoa(attempt+1))
+		})
+	default:
+		return "", fmt.Errorf("unknown name generation strategy '%s'", strategy)
+	}
+}
+
+// firstFree returns the first of the candidate names that is not taken, trying the given number of candidates
+func (p Policy) firstFree(taken func(name string) bool, attempts int, candidate func(attempt int) (string, error)) (string, error) {
+	for attempt := 0; attempt < attempts; attempt++ {
+		name, err := candidate(attempt)
+		if err != nil {
+			return "", err
+		}
+		if taken == nil || !taken(name) {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("%w: the %d generated names are taken", ErrNoCompliantName, attempts)
+}
+
+// randomSuffix returns a random suffix of lowercase alphanumeric characters
+func randomSuffix() string {
+	suffix := make([]byte, randomLength)
+	for i := range suffix {
+		suffix[i] = randomAlphabet[rand.IntN(len(randomAlphabet))]
+	}
+	return string(suffix)
+}
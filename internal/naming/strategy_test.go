@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package naming
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyGenerateName(t *testing.T) {
+	now := time.Unix(1767225600, 0)
+	taken := map[string]bool{
+		"fra1-cluster-1767225600": true,
+		"fra1-cluster-1":          true,
+		"fra1-cluster-2":          true,
+		"fra1-site-0a1b2c3d-1":    true,
+	}
+	request := Request{Now: now, Taken: func(name string) bool { return taken[name] }}
+
+	tests := []struct {
+		name     string
+		strategy Strategy
+		site     string
+		taken    func(string) bool
+		expected string
+	}{
+		{name: "timestamp", strategy: StrategyTimestamp, taken: func(string) bool { return false }, expected: `^fra1-cluster-1767225600$`},
+		{name: "default strategy", taken: func(string) bool { return false }, expected: `^fra1-cluster-1767225600$`},
+		{name: "timestamp taken", strategy: StrategyTimestamp, expected: `^fra1-cluster-1767225600-[a-z0-9]{5}$`},
+		{name: "random", strategy: StrategyRandom, expected: `^fra1-cluster-[a-z0-9]{5}$`},
+		{name: "sequential", strategy: StrategySequential, expected: `^fra1-cluster-3$`},
+		{name: "site", strategy: StrategySite, site: "site-0a1b2c3d", expected: `^fra1-site-0a1b2c3d-2$`},
+		{name: "site of another site", strategy: StrategySite, site: "site-1a2b3c4d", expected: `^fra1-site-1a2b3c4d-1$`},
+		{name: "site without site", strategy: StrategySite, expected: `^fra1-cluster-3$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := request
+			request.Site = tt.site
+			if tt.taken != nil {
+				request.Taken = tt.taken
+			}
+			policy := Policy{Prefix: "fra1-", MaxLength: 30}
+			name, err := policy.GenerateName(tt.strategy, request)
+			require.NoError(t, err)
+			assert.Regexp(t, tt.expected, name)
+			assert.Empty(t, policy.Violations(name))
+		})
+	}
+
+	t.Run("all names taken", func(t *testing.T) {
+		_, err := sitePolicy.GenerateName(StrategyRandom, Request{Now: now, Taken: func(string) bool { return true }})
+		require.ErrorIs(t, err, ErrNoCompliantName)
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, err := sitePolicy.GenerateName("hostname", request)
+		require.ErrorContains(t, err, "unknown name generation strategy 'hostname'")
+	})
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package network checks the cluster networks of templates and clusters against the networks reserved by the
+// orchestrator infrastructure, e.g. the gateway networks of the sites and the pod and service CIDRs of the management
+// cluster. The nodes of a cluster route the addresses of its pod and service networks into the cluster, so an
+// overlapping infrastructure network becomes unreachable from them only after the cluster is deployed.
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+// Reserved are the networks reserved by the orchestrator infrastructure, see ParseReserved
+type Reserved []netip.Prefix
+
+// ParseReserved parses the reserved networks of the given CIDRs, e.g. "10.0.0.0/16"
+func ParseReserved(cidrs []string) (Reserved, error) {
+	reserved := make(Reserved, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reserved network '%s': %w", cidr, err)
+		}
+		reserved = append(reserved, prefix.Masked())
+	}
+	return reserved, nil
+}
+
+// Check returns an error listing the pod and service CIDR blocks of the cluster network that are invalid or overlap
+// a reserved network; cluster networks are not checked if no networks are reserved
+func (r Reserved) Check(clusterNetwork *v1alpha1.ClusterNetwork) error {
+	if len(r) == 0 || clusterNetwork == nil {
+		return nil
+	}
+
+	var errs []error
+	if clusterNetwork.Pods != nil {
+		errs = append(errs, r.checkBlocks("pod", clusterNetwork.Pods.CIDRBlocks)...)
+	}
+	if clusterNetwork.Services != nil {
+		errs = append(errs, r.checkBlocks("service", clusterNetwork.Services.CIDRBlocks)...)
+	}
+	return errors.Join(errs...)
+}
+
+// checkBlocks checks the CIDR blocks of the given kind against the reserved networks
+func (r Reserved) checkBlocks(kind string, blocks []string) []error {
+	var errs []error
+	for _, block := range blocks {
+		prefix, err := netip.ParsePrefix(block)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s CIDR block '%s'", kind, block))
+			continue
+		}
+		for _, reserved := range r {
+			if prefix.Overlaps(reserved) {
+				errs = append(errs, fmt.Errorf("%s CIDR block %s overlaps reserved infrastructure network %s", kind, block, reserved))
+			}
+		}
+	}
+	return errs
+}
+
+// Overlaps returns an error listing the pod and service CIDR blocks of the cluster network that overlap the pod or
+// service CIDR blocks of the cluster network of the other cluster with the given name; invalid CIDR blocks do not
+// overlap, Check reports them
+func Overlaps(clusterNetwork, other *v1alpha1.ClusterNetwork, otherName string) error {
+	if clusterNetwork == nil || other == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, block := range prefixes(clusterNetwork) {
+		for _, otherBlock := range prefixes(other) {
+			if block.prefix.Overlaps(otherBlock.prefix) {
+				errs = append(errs, fmt.Errorf("%s CIDR block %s overlaps %s CIDR block %s of cluster %s",
+					block.kind, block.prefix, otherBlock.kind, otherBlock.prefix, otherName))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// kindPrefix is a valid pod or service CIDR block of a cluster network
+type kindPrefix struct {
+	kind   string
+	prefix netip.Prefix
+}
+
+// prefixes returns the valid pod and service CIDR blocks of the cluster network
+func prefixes(clusterNetwork *v1alpha1.ClusterNetwork) []kindPrefix {
+	var result []kindPrefix
+	for _, ranges := range []struct {
+		kind   string
+		ranges *v1alpha1.NetworkRanges
+	}{{"pod", clusterNetwork.Pods}, {"service", clusterNetwork.Services}} {
+		if ranges.ranges == nil {
+			continue
+		}
+		for _, block := range ranges.ranges.CIDRBlocks {
+			if prefix, err := netip.ParsePrefix(block); err == nil {
+				result = append(result, kindPrefix{kind: ranges.kind, prefix: prefix})
+			}
+		}
+	}
+	return result
+}
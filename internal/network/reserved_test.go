@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+func clusterNetwork(pods, services string) *v1alpha1.ClusterNetwork {
+	return &v1alpha1.ClusterNetwork{
+		Pods:     &v1alpha1.NetworkRanges{CIDRBlocks: []string{pods}},
+		Services: &v1alpha1.NetworkRanges{CIDRBlocks: []string{services}},
+	}
+}
+
+func TestParseReserved(t *testing.T) {
+	reserved, err := ParseReserved([]string{"10.0.0.0/16", " 192.168.1.7/24", "fd00::/64"})
+	require.NoError(t, err)
+	assert.Equal(t, Reserved{
+		netip.MustParsePrefix("10.0.0.0/16"),
+		netip.MustParsePrefix("192.168.1.0/24"),
+		netip.MustParsePrefix("fd00::/64"),
+	}, reserved)
+
+	_, err = ParseReserved([]string{"10.0.0.0"})
+	require.ErrorContains(t, err, "invalid reserved network '10.0.0.0'")
+}
+
+func TestReservedCheck(t *testing.T) {
+	reserved, err := ParseReserved([]string{"10.0.0.0/16", "192.168.0.0/24"})
+	require.NoError(t, err)
+
+	assert.NoError(t, reserved.Check(clusterNetwork("10.42.0.0/16", "10.43.0.0/16")))
+	assert.NoError(t, reserved.Check(&v1alpha1.ClusterNetwork{}))
+	assert.NoError(t, Reserved{}.Check(clusterNetwork("10.0.0.0/8", "invalid")))
+
+	err = reserved.Check(clusterNetwork("10.0.128.0/17", "192.168.0.0/16"))
+	require.ErrorContains(t, err, "pod CIDR block 10.0.128.0/17 overlaps reserved infrastructure network 10.0.0.0/16")
+	require.ErrorContains(t, err, "service CIDR block 192.168.0.0/16 overlaps reserved infrastructure network 192.168.0.0/24")
+
+	err = reserved.Check(clusterNetwork("10.42.0.0", "10.43.0.0/16"))
+	require.ErrorContains(t, err, "invalid pod CIDR block '10.42.0.0'")
+}
+
+func TestOverlaps(t *testing.T) {
+	assert.NoError(t, Overlaps(clusterNetwork("10.42.0.0/16", "10.43.0.0/16"), clusterNetwork("10.44.0.0/16", "10.45.0.0/16"), "edge"))
+	assert.NoError(t, Overlaps(clusterNetwork("10.42.0.0/16", "10.43.0.0/16"), &v1alpha1.ClusterNetwork{}, "edge"))
+	assert.NoError(t, Overlaps(clusterNetwork("10.42.0.0/16", "10.43.0.0/16"), clusterNetwork("invalid", "10.45.0.0/16"), "edge"))
+	assert.NoError(t, Overlaps(nil, clusterNetwork("10.42.0.0/16", "10.43.0.0/16"), "edge"))
+
+	err := Overlaps(clusterNetwork("10.42.0.0/16", "10.43.0.0/16"), clusterNetwork("10.42.128.0/17", "10.42.0.0/24"), "edge")
+	require.ErrorContains(t, err, "pod CIDR block 10.42.0.0/16 overlaps pod CIDR block 10.42.128.0/17 of cluster edge")
+	require.ErrorContains(t, err, "pod CIDR block 10.42.0.0/16 overlaps service CIDR block 10.42.0.0/24 of cluster edge")
+	require.NotContains(t, err.Error(), "service CIDR block 10.43.0.0/16")
+}
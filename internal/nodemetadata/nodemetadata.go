@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package nodemetadata
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// HostIdAnnotationKey is the key used to store the host ID in the annotations of the provider machine.
+	HostIdAnnotationKey = "intelmachine.infrastructure.cluster.x-k8s.io/host-id"
+
+	// AnnotationPrefix is the prefix of the provider machine annotations that hold the host metadata copied from the inventory
+	AnnotationPrefix = "node-metadata.edge-orchestrator.intel.com/"
+)
+
+var (
+	// allowedKeys is the list of host metadata keys that are copied from the inventory onto the provider machines
+	allowedKeys = []string{"asset-tag", "site", "rack"}
+)
+
+func OverrideAllowedKeys(keys []string) {
+	allowedKeys = keys
+}
+
+// Filter returns new map with only the allowed host metadata
+func Filter(metadata map[string]string) map[string]string {
+	f := map[string]string{}
+	for key, value := range metadata {
+		if slices.Contains(allowedKeys, key) {
+			f[key] = value
+		}
+	}
+	return f
+}
+
+// FromAnnotations returns the host metadata stored in the provider machine annotations
+func FromAnnotations(annotations map[string]string) map[string]string {
+	metadata := map[string]string{}
+	for key, value := range annotations {
+		if k, ok := strings.CutPrefix(key, AnnotationPrefix); ok {
+			metadata[k] = value
+		}
+	}
+	return metadata
+}
+
+// Merge returns new annotations with the host metadata annotations replaced by the given metadata
+func Merge(annotations, metadata map[string]string) map[string]string {
+	merged := map[string]string{}
+	for key, value := range annotations {
+		if !strings.HasPrefix(key, AnnotationPrefix) {
+			merged[key] = value
+		}
+	}
+	for key, value := range metadata {
+		merged[AnnotationPrefix+key] = value
+	}
+	return merged
+}
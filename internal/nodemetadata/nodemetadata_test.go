@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package nodemetadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	metadata := map[string]string{"site": "santa-clara", "rack": "r12", "owner": "team-a"}
+	require.Equal(t, map[string]string{"site": "santa-clara", "rack": "r12"}, Filter(metadata))
+	require.Empty(t, Filter(nil))
+
+	defer OverrideAllowedKeys(allowedKeys)
+	OverrideAllowedKeys([]string{"owner"})
+	require.Equal(t, map[string]string{"owner": "team-a"}, Filter(metadata))
+}
+
+func TestMerge(t *testing.T) {
+	annotations := map[string]string{
+		HostIdAnnotationKey:       "host-1",
+		AnnotationPrefix + "site": "santa-clara",
+		AnnotationPrefix + "rack": "r12",
+	}
+
+	merged := Merge(annotations, map[string]string{"site": "hillsboro", "asset-tag": "at-001"})
+	require.Equal(t, map[string]string{
+		HostIdAnnotationKey:            "host-1",
+		AnnotationPrefix + "site":      "hillsboro",
+		AnnotationPrefix + "asset-tag": "at-001",
+	}, merged)
+
+	require.Equal(t, map[string]string{"site": "hillsboro", "asset-tag": "at-001"}, FromAnnotations(merged))
+	require.Empty(t, FromAnnotations(Merge(merged, nil)))
+}
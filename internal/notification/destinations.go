@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package notification sends the outbound webhook calls of the projects to the destinations allowed by the platform
+// administrators
+package notification
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ErrDestinationNotAllowed is returned when a webhook call targets a destination outside the allow-list of the project
+var ErrDestinationNotAllowed = errors.New("destination not allowed")
+
+// DefaultTimeout is the timeout of a webhook call
+const DefaultTimeout = 10 * time.Second
+
+// Destination is a host webhook calls may be sent to
+type Destination struct {
+	// Host is the host name of the destination, a leading '*.' matches any subdomain
+	Host string `json:"host"`
+	// Port is the HTTPS port of the destination, 443 if unset
+	Port int `json:"port,omitempty"`
+	// AllowPrivateNetwork allows the destination to resolve to loopback, private or link-local addresses
+	AllowPrivateNetwork bool `json:"allowPrivateNetwork,omitempty"`
+	// PinnedPublicKeys are the base64-encoded SHA-256 hashes of the subject public key info of the certificates the
+	// destination must present, any certificate trusted by the system is accepted if empty
+	PinnedPublicKeys []string `json:"pinnedPublicKeys,omitempty"`
+}
+
+// DestinationConfig is the destination configuration file, see LoadDestinationConfig
+type DestinationConfig struct {
+	// Default are the destinations of projects without destinations of their own
+	Default []Destination `json:"default,omitempty"`
+	// Projects are the destinations of individual projects by project id
+	Projects map[string][]Destination `json:"projects,omitempty"`
+}
+
+// LoadDestinationConfig reads the destination configuration from the given YAML or JSON file
+func LoadDestinationConfig(path string) (DestinationConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DestinationConfig{}, fmt.Errorf("failed to read webhook destination config: %w", err)
+	}
+
+	var config DestinationConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return DestinationConfig{}, fmt.Errorf("failed to parse webhook destination config: %w", err)
+	}
+
+	if err := validateDestinations(config.Default); err != nil {
+		return DestinationConfig{}, err
+	}
+	for _, destinations := range config.Projects {
+		if err := validateDestinations(destinations); err != nil {
+			return DestinationConfig{}, err
+		}
+	}
+	return config, nil
+}
+
+// validateDestinations checks the destinations and defaults their ports
+func validateDestinations(destinations []Destination) error {
+	for i := range destinations {
+		if err := destinations[i].validate(); err != nil {
+			return fmt.Errorf("invalid webhook destination config: %w", err)
+		}
+	}
+	return nil
+}
+
+// validate checks the destination and defaults its port
+func (d *Destination) validate() error {
+	host := strings.TrimPrefix(d.Host, "*.")
+	if host == "" || strings.ContainsAny(host, "*/:") {
+		return fmt.Errorf("invalid host '%s'", d.Host)
+	}
+	if d.Port == 0 {
+		d.Port = 443
+	}
+	if d.Port < 1 || d.Port > 65535 {
+		return fmt.Errorf("invalid port %d of host '%s'", d.Port, d.Host)
+	}
+	for _, pin := range d.PinnedPublicKeys {
+		if hash, err := base64.StdEncoding.DecodeString(pin); err != nil || len(hash) != sha256.Size {
+			return fmt.Errorf("invalid pinned public key '%s' of host '%s'", pin, d.Host)
+		}
+	}
+	return nil
+}
+
+// matches returns true if the destination allows calls to the given host and port
+func (d Destination) matches(host string, port int) bool {
+	if port != d.Port && (d.Port != 0 || port != 443) {
+		return false
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if suffix, ok := strings.CutPrefix(strings.ToLower(d.Host), "*"); ok {
+		return strings.HasSuffix(host, suffix)
+	}
+	return host == strings.ToLower(d.Host)
+}
+
+// DestinationPolicy restricts the outbound webhook calls of the projects to their allowed destinations
+type DestinationPolicy struct {
+	config DestinationConfig
+
+	// rootCAs are the CAs trusted for the destinations, the system CAs if nil
+	rootCAs *x509.CertPool
+}
+
+// NewDestinationPolicy creates a new DestinationPolicy with the given configuration
+func NewDestinationPolicy(config DestinationConfig) *DestinationPolicy {
+	return &DestinationPolicy{config: config}
+}
+
+// Destinations returns the allowed destinations of the given project
+func (p *DestinationPolicy) Destinations(projectID string) []Destination {
+	if destinations, ok := p.config.Projects[projectID]; ok {
+		return destinations
+	}
+	return p.config.Default
+}
+
+// Allowed returns the destination of the project that allows calls to the given URL, the returned error wraps
+// ErrDestinationNotAllowed if there is none
+func (p *DestinationPolicy) Allowed(projectID, rawURL string) (Destination, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Destination{}, fmt.Errorf("%w: invalid url: %v", ErrDestinationNotAllowed, err)
+	}
+	if u.Scheme != "https" {
+		return Destination{}, fmt.Errorf("%w: only https urls are allowed", ErrDestinationNotAllowed)
+	}
+	if u.User != nil {
+		return Destination{}, fmt.Errorf("%w: urls with credentials are not allowed", ErrDestinationNotAllowed)
+	}
+
+	port := 443
+	if u.Port() != "" {
+		if port, err = strconv.Atoi(u.Port()); err != nil {
+			return Destination{}, fmt.Errorf("%w: invalid port '%s'", ErrDestinationNotAllowed, u.Port())
+		}
+	}
+
+	for _, destination := range p.Destinations(projectID) {
+		if destination.matches(u.Hostname(), port) {
+			return destination, nil
+		}
+	}
+	return Destination{}, fmt.Errorf("%w: %s is not in the allow-list of the project", ErrDestinationNotAllowed, u.Host)
+}
+
+// Client returns an HTTP client for webhook calls of the project to the given URL
+// The client refuses redirects, connects only to public addresses unless the destination allows private networks and
+// verifies the pinned public keys of the destination, so the URL can not be used to reach internal endpoints
+func (p *DestinationPolicy) Client(projectID, rawURL string) (*http.Client, error) {
+	destination, err := p.Allowed(projectID, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// the address is checked after name resolution so that DNS changes can not redirect calls to internal endpoints
+	dialer := &net.Dialer{
+		Timeout: DefaultTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: invalid address %s", ErrDestinationNotAllowed, address)
+			}
+			if !destination.AllowPrivateNetwork && isPrivate(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s resolves to the private address %s", ErrDestinationNotAllowed, destination.Host, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: p.rootCAs}
+	if len(destination.PinnedPublicKeys) > 0 {
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			for _, cert := range state.PeerCertificates {
+				hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+				if slices.Contains(destination.PinnedPublicKeys, base64.StdEncoding.EncodeToString(hash[:])) {
+					return nil
+				}
+			}
+			return fmt.Errorf("%w: no certificate of %s matches its pinned public keys", ErrDestinationNotAllowed, destination.Host)
+		}
+	}
+
+	return &http.Client{
+		Timeout: DefaultTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSClientConfig:     tlsConfig,
+			TLSHandshakeTimeout: DefaultTimeout,
+			// webhook calls must not be sent through a proxy that could reach internal endpoints on their behalf
+			Proxy: nil,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
+
+// cgnat is the shared address space of carrier-grade NATs, it is not public either
+var cgnat = netip.MustParsePrefix("100.64.0.0/10")
+
+// isPrivate returns true if the address is not a public unicast address
+func isPrivate(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() || cgnat.Contains(addr)
+}
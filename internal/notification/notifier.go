@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/google/uuid"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+// EventType is the type of a cluster lifecycle event
+type EventType string
+
+const (
+	// EventClusterReady is posted when a cluster finished provisioning
+	EventClusterReady EventType = "cluster.ready"
+	// EventClusterFailed is posted when a cluster failed
+	EventClusterFailed EventType = "cluster.failed"
+	// EventClusterDeleted is posted when a cluster was deleted
+	EventClusterDeleted EventType = "cluster.deleted"
+)
+
+// EventTypes are all types of the cluster lifecycle events
+var EventTypes = []EventType{EventClusterReady, EventClusterFailed, EventClusterDeleted}
+
+// Headers of the webhook calls
+const (
+	// EventHeader is the type of the posted event
+	EventHeader = "X-Cluster-Manager-Event"
+	// DeliveryHeader is the id of the posted event, it is the same for all attempts to post the event
+	DeliveryHeader = "X-Cluster-Manager-Delivery"
+	// TimestampHeader is the unix time the call was signed at
+	TimestampHeader = "X-Cluster-Manager-Timestamp"
+	// SignatureHeader is the signature of the call, see Sign
+	SignatureHeader = "X-Cluster-Manager-Signature"
+)
+
+const (
+	// DefaultQueueSize is the number of events waiting to be posted before new events are dropped
+	DefaultQueueSize = 1000
+	// DefaultWorkers is the number of events posted concurrently
+	DefaultWorkers = 4
+	// DefaultMaxElapsedTime is the time after which the retries of an event are given up
+	DefaultMaxElapsedTime = 15 * time.Minute
+)
+
+// Event is a cluster lifecycle event posted to the webhook targets
+type Event struct {
+	ID            string    `json:"id"`
+	Type          EventType `json:"type"`
+	Time          time.Time `json:"time"`
+	ProjectID     string    `json:"projectId"`
+	Cluster       string    `json:"cluster"`
+	PreviousPhase string    `json:"previousPhase"`
+	Phase         string    `json:"phase"`
+}
+
+// Transition returns the type of the lifecycle event of the given change of a cluster, false if the change is no
+// lifecycle transition: provisioning to provisioned (ready), any other phase to failed, and the deletion of the cluster
+// old is nil for added and new is nil for deleted clusters, see k8s.ClusterHandler
+func Transition(old, new *capi.Cluster) (EventType, bool) {
+	switch {
+	case old == nil:
+		// the clusters existing at startup are added as well, their previous phase is unknown
+		return "", false
+	case new == nil:
+		return EventClusterDeleted, true
+	case old.Status.Phase == new.Status.Phase:
+		return "", false
+	case new.Status.Phase == string(capi.ClusterPhaseProvisioned) &&
+		(old.Status.Phase == string(capi.ClusterPhasePending) || old.Status.Phase == string(capi.ClusterPhaseProvisioning)):
+		return EventClusterReady, true
+	case new.Status.Phase == string(capi.ClusterPhaseFailed):
+		return EventClusterFailed, true
+	}
+	return "", false
+}
+
+// Sign returns the signature of a webhook call: the hex-encoded HMAC-SHA256 of the timestamp header, a dot and the
+// payload keyed with the secret of the target, prefixed with "sha256="
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// delivery is an event to be posted to a target
+type delivery struct {
+	target Target
+	// project is true for the targets of the project, which are restricted to its allowed destinations
+	project bool
+	event   Event
+	payload []byte
+}
+
+// Notifier posts the lifecycle events of the clusters to their webhook targets
+type Notifier struct {
+	config TargetConfig
+	policy *DestinationPolicy
+	client *http.Client
+
+	queue      chan delivery
+	workers    int
+	newBackOff func() backoff.BackOff
+	now        func() time.Time
+}
+
+// NewNotifier creates a new Notifier posting to the targets of the given configuration
+func NewNotifier(config TargetConfig, options ...func(*Notifier)) *Notifier {
+	n := &Notifier{
+		config: config,
+		client: &http.Client{
+			Timeout: DefaultTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		queue:   make(chan delivery, DefaultQueueSize),
+		workers: DefaultWorkers,
+		newBackOff: func() backoff.BackOff {
+			b := backoff.NewExponentialBackOff()
+			b.MaxElapsedTime = DefaultMaxElapsedTime
+			return b
+		},
+		now: time.Now,
+	}
+	for _, option := range options {
+		option(n)
+	}
+	return n
+}
+
+// WithDestinationPolicy restricts the calls to the project targets to the allowed destinations of the project
+func WithDestinationPolicy(policy *DestinationPolicy) func(*Notifier) {
+	return func(n *Notifier) {
+		n.policy = policy
+	}
+}
+
+// WithClient sets the HTTP client of the calls to the targets not restricted by a destination policy
+func WithClient(client *http.Client) func(*Notifier) {
+	return func(n *Notifier) {
+		n.client = client
+	}
+}
+
+// WithBackOff sets the retry policy of the calls
+func WithBackOff(newBackOff func() backoff.BackOff) func(*Notifier) {
+	return func(n *Notifier) {
+		n.newBackOff = newBackOff
+	}
+}
+
+// WithClock sets the clock of the event times and signatures
+func WithClock(now func() time.Time) func(*Notifier) {
+	return func(n *Notifier) {
+		n.now = now
+	}
+}
+
+// ClusterChanged queues the lifecycle event of the given change of a cluster for its targets, see k8s.ClusterHandler
+// It never blocks the informer, events are dropped if the queue is full
+func (n *Notifier) ClusterChanged(old, new *capi.Cluster) {
+	eventType, ok := Transition(old, new)
+	if !ok {
+		return
+	}
+
+	event := Event{
+		ID:   uuid.NewString(),
+		Type: eventType,
+		Time: n.now().UTC(),
+	}
+	if new != nil {
+		event.ProjectID, event.Cluster, event.Phase = new.Namespace, new.Name, new.Status.Phase
+	} else {
+		event.ProjectID, event.Cluster, event.Phase = old.Namespace, old.Name, "Deleted"
+	}
+	if old != nil {
+		event.PreviousPhase = old.Status.Phase
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		slog.Error("failed to marshal cluster event", "error", err, "namespace", event.ProjectID, "name", event.Cluster)
+		return
+	}
+
+	for _, target := range n.config.Global {
+		n.enqueue(delivery{target: target, event: event, payload: payload})
+	}
+	for _, target := range n.config.Projects[event.ProjectID] {
+		n.enqueue(delivery{target: target, project: true, event: event, payload: payload})
+	}
+}
+
+// enqueue queues the delivery if the target accepts its event
+func (n *Notifier) enqueue(d delivery) {
+	if !d.target.accepts(d.event.Type) {
+		return
+	}
+	select {
+	case n.queue <- d:
+	default:
+		slog.Warn("webhook queue is full, dropping cluster event", "event", d.event.Type, "delivery", d.event.ID,
+			"namespace", d.event.ProjectID, "name", d.event.Cluster, "url", d.target.URL)
+	}
+}
+
+// Run posts the queued events until the context is canceled
+func (n *Notifier) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range n.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case d := <-n.queue:
+					n.deliver(ctx, d)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// deliver posts the event to the target, retrying with exponential backoff until it is accepted or the retries are
+// given up
+func (n *Notifier) deliver(ctx context.Context, d delivery) {
+	attempts := 0
+	post := func() error {
+		attempts++
+		return n.post(ctx, d)
+	}
+
+	if err := backoff.Retry(post, backoff.WithContext(n.newBackOff(), ctx)); err != nil {
+		slog.Error("failed to post cluster event", "error", err, "event", d.event.Type, "delivery", d.event.ID,
+			"namespace", d.event.ProjectID, "name", d.event.Cluster, "url", d.target.URL, "attempts", attempts)
+		return
+	}
+	slog.Debug("posted cluster event", "event", d.event.Type, "delivery", d.event.ID,
+		"namespace", d.event.ProjectID, "name", d.event.Cluster, "url", d.target.URL, "attempts", attempts)
+}
+
+// post makes one attempt to post the event to the target, errors that can not be fixed by a retry are permanent
+func (n *Notifier) post(ctx context.Context, d delivery) error {
+	client := n.client
+	if d.project && n.policy != nil {
+		var err error
+		if client, err = n.policy.Client(d.event.ProjectID, d.target.URL); err != nil {
+			return backoff.Permanent(err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.target.URL, bytes.NewReader(d.payload))
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("failed to create webhook request: %w", err))
+	}
+	timestamp := strconv.FormatInt(n.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(d.event.Type))
+	req.Header.Set(DeliveryHeader, d.event.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(d.target.Secret, timestamp, d.payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("failed to post webhook: %s: %s", resp.Status, body)
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout {
+		return err
+	}
+	return backoff.Permanent(err)
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+func testCluster(phase capi.ClusterPhase) *capi.Cluster {
+	return &capi.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-cluster", Namespace: projectID},
+		Status:     capi.ClusterStatus{Phase: string(phase)},
+	}
+}
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new *capi.Cluster
+		expected EventType
+	}{
+		{"provisioning to provisioned", testCluster(capi.ClusterPhaseProvisioning), testCluster(capi.ClusterPhaseProvisioned), EventClusterReady},
+		{"pending to provisioned", testCluster(capi.ClusterPhasePending), testCluster(capi.ClusterPhaseProvisioned), EventClusterReady},
+		{"provisioned to failed", testCluster(capi.ClusterPhaseProvisioned), testCluster(capi.ClusterPhaseFailed), EventClusterFailed},
+		{"deleting to deleted", testCluster(capi.ClusterPhaseDeleting), nil, EventClusterDeleted},
+		{"added", nil, testCluster(capi.ClusterPhaseProvisioned), ""},
+		{"resync", testCluster(capi.ClusterPhaseProvisioned), testCluster(capi.ClusterPhaseProvisioned), ""},
+		{"provisioned to deleting", testCluster(capi.ClusterPhaseProvisioned), testCluster(capi.ClusterPhaseDeleting), ""},
+		{"failed to provisioned", testCluster(capi.ClusterPhaseFailed), testCluster(capi.ClusterPhaseProvisioned), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventType, ok := Transition(tt.old, tt.new)
+			require.Equal(t, tt.expected != "", ok)
+			require.Equal(t, tt.expected, eventType)
+		})
+	}
+}
+
+func TestNotifierPostsSignedEvents(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	calls := make(chan *http.Request, 10)
+	bodies := make(chan []byte, 10)
+	var attempts atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		// the first attempt fails and is retried
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		calls <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	n := NewNotifier(TargetConfig{
+		Global: []Target{{URL: server.URL + "/global", Secret: "global-secret"}},
+		Projects: map[string][]Target{
+			projectID:       {{URL: server.URL + "/project", Secret: "project-secret", Events: []EventType{EventClusterFailed}}},
+			"other-project": {{URL: server.URL + "/other", Secret: "other-secret"}},
+		},
+	}, WithClient(server.Client()), WithClock(func() time.Time { return now }), WithBackOff(func() backoff.BackOff {
+		return backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond), 3)
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go n.Run(ctx)
+
+	n.ClusterChanged(testCluster(capi.ClusterPhaseProvisioning), testCluster(capi.ClusterPhaseProvisioned))
+
+	select {
+	case r := <-calls:
+		body := <-bodies
+		require.Equal(t, "/global", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, string(EventClusterReady), r.Header.Get(EventHeader))
+		require.Equal(t, "1792152000", r.Header.Get(TimestampHeader))
+		require.Equal(t, Sign("global-secret", "1792152000", body), r.Header.Get(SignatureHeader))
+
+		var event Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		require.Equal(t, r.Header.Get(DeliveryHeader), event.ID)
+		require.Equal(t, Event{
+			ID:            event.ID,
+			Type:          EventClusterReady,
+			Time:          now,
+			ProjectID:     projectID,
+			Cluster:       "example-cluster",
+			PreviousPhase: "Provisioning",
+			Phase:         "Provisioned",
+		}, event)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not posted")
+	}
+	require.Equal(t, int32(2), attempts.Load())
+
+	// the project target only accepts failed events
+	n.ClusterChanged(testCluster(capi.ClusterPhaseProvisioned), testCluster(capi.ClusterPhaseFailed))
+	paths := map[string]string{}
+	for range 2 {
+		select {
+		case r := <-calls:
+			paths[r.URL.Path] = r.Header.Get(EventHeader)
+			<-bodies
+		case <-time.After(5 * time.Second):
+			t.Fatal("event was not posted")
+		}
+	}
+	require.Equal(t, map[string]string{"/global": string(EventClusterFailed), "/project": string(EventClusterFailed)}, paths)
+}
+
+func TestNotifierGivesUpOnClientErrors(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(TargetConfig{}, WithClient(server.Client()), WithBackOff(func() backoff.BackOff {
+		return backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond), 3)
+	}))
+	n.deliver(context.Background(), delivery{target: Target{URL: server.URL, Secret: "secret"}, event: Event{Type: EventClusterDeleted}})
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestNotifierRestrictsProjectTargets(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(TargetConfig{}, WithClient(server.Client()),
+		WithDestinationPolicy(NewDestinationPolicy(DestinationConfig{Default: []Destination{{Host: "hooks.example.com", Port: 443}}})))
+
+	d := delivery{target: Target{URL: server.URL, Secret: "secret"}, event: Event{Type: EventClusterDeleted, ProjectID: projectID}}
+	require.NoError(t, n.post(context.Background(), d))
+
+	d.project = true
+	require.ErrorIs(t, n.post(context.Background(), d), ErrDestinationNotAllowed)
+	require.Equal(t, int32(1), attempts.Load())
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Target is a webhook the cluster lifecycle events are posted to
+type Target struct {
+	// URL is the https URL the events are posted to
+	URL string `json:"url"`
+	// Secret is the key of the HMAC-SHA256 signature of the events
+	Secret string `json:"secret"`
+	// Events are the types of the events posted to the target, all types if empty
+	Events []EventType `json:"events,omitempty"`
+}
+
+// TargetConfig is the webhook target configuration file, see LoadTargetConfig
+type TargetConfig struct {
+	// Global are the targets receiving the events of the clusters of all projects
+	Global []Target `json:"global,omitempty"`
+	// Projects are the targets receiving the events of the clusters of individual projects by project id
+	Projects map[string][]Target `json:"projects,omitempty"`
+}
+
+// LoadTargetConfig reads the webhook target configuration from the given YAML or JSON file
+func LoadTargetConfig(path string) (TargetConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TargetConfig{}, fmt.Errorf("failed to read webhook target config: %w", err)
+	}
+
+	var config TargetConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return TargetConfig{}, fmt.Errorf("failed to parse webhook target config: %w", err)
+	}
+
+	if err := validateTargets(config.Global); err != nil {
+		return TargetConfig{}, err
+	}
+	for _, targets := range config.Projects {
+		if err := validateTargets(targets); err != nil {
+			return TargetConfig{}, err
+		}
+	}
+	return config, nil
+}
+
+// validateTargets checks the targets
+func validateTargets(targets []Target) error {
+	for _, target := range targets {
+		if err := target.validate(); err != nil {
+			return fmt.Errorf("invalid webhook target config: %w", err)
+		}
+	}
+	return nil
+}
+
+// validate checks the target
+func (t Target) validate() error {
+	u, err := url.Parse(t.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil {
+		return fmt.Errorf("invalid url '%s', only https urls without credentials are allowed", t.URL)
+	}
+	if t.Secret == "" {
+		return fmt.Errorf("missing secret of url '%s'", t.URL)
+	}
+	for _, event := range t.Events {
+		if !slices.Contains(EventTypes, event) {
+			return fmt.Errorf("invalid event type '%s' of url '%s'", event, t.URL)
+		}
+	}
+	return nil
+}
+
+// accepts returns true if the events of the given type are posted to the target
+func (t Target) accepts(eventType EventType) bool {
+	return len(t.Events) == 0 || slices.Contains(t.Events, eventType)
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package offboarding
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+// AuditLogSource provides the audit trail of a project to be included in its export bundle
+type AuditLogSource interface {
+	AuditLogs(ctx context.Context, projectID string) ([]byte, error)
+}
+
+// Manifest describes the content of an export bundle
+type Manifest struct {
+	ProjectID   string    `json:"projectId"`
+	ProjectName string    `json:"projectName"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Clusters    []string  `json:"clusters"`
+	Machines    []string  `json:"machines"`
+	Templates   []string  `json:"templates"`
+	Kubeconfigs []string  `json:"kubeconfigs"`
+	AuditLogs   bool      `json:"auditLogs"`
+}
+
+// Exporter generates the export bundle of a project before the project resources are deleted
+type Exporter struct {
+	k8s   *k8s.Client
+	store Store
+	audit AuditLogSource
+	now   func() time.Time
+}
+
+// NewExporter creates a new Exporter that writes the bundles to the given store
+func NewExporter(k8sClient *k8s.Client, store Store, options ...func(*Exporter)) *Exporter {
+	e := &Exporter{
+		k8s:   k8sClient,
+		store: store,
+		now:   time.Now,
+	}
+
+	for _, o := range options {
+		o(e)
+	}
+
+	return e
+}
+
+// WithAuditLogSource is a functional option for including the project audit logs in the bundles
+func WithAuditLogSource(audit AuditLogSource) func(*Exporter) {
+	return func(e *Exporter) {
+		e.audit = audit
+	}
+}
+
+// Export writes the bundle with the cluster inventory, templates, kubeconfigs and audit logs of the project
+// to the store. Projects that were already exported or have no resources left are skipped, so that the export
+// is safe to run again when project deletion events are replayed.
+func (e *Exporter) Export(ctx context.Context, projectID, projectName string) error {
+	exists, err := e.store.Exists(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to check for existing export bundle: %w", err)
+	}
+	if exists {
+		slog.Debug("export bundle already exists for project", "project_id", projectID, "project", projectName)
+		return nil
+	}
+
+	clusters, err := e.list(ctx, core.ClusterResourceSchema, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+	templates, err := e.list(ctx, core.TemplateResourceSchema, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list templates: %w", err)
+	}
+	if len(clusters) == 0 && len(templates) == 0 {
+		slog.Debug("nothing to export for project", "project_id", projectID, "project", projectName)
+		return nil
+	}
+	machines, err := e.list(ctx, core.MachineResourceSchema, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	manifest := Manifest{
+		ProjectID:   projectID,
+		ProjectName: projectName,
+		CreatedAt:   e.now().UTC(),
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	write := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), ModTime: manifest.CreatedAt}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+	writeObjects := func(dir string, objects []unstructured.Unstructured, names *[]string) error {
+		for _, obj := range objects {
+			data, err := json.MarshalIndent(obj.Object, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := write(fmt.Sprintf("%s/%s.json", dir, obj.GetName()), data); err != nil {
+				return err
+			}
+			*names = append(*names, obj.GetName())
+		}
+		return nil
+	}
+
+	if err := writeObjects("clusters", clusters, &manifest.Clusters); err != nil {
+		return fmt.Errorf("failed to write clusters to export bundle: %w", err)
+	}
+	if err := writeObjects("machines", machines, &manifest.Machines); err != nil {
+		return fmt.Errorf("failed to write machines to export bundle: %w", err)
+	}
+	if err := writeObjects("templates", templates, &manifest.Templates); err != nil {
+		return fmt.Errorf("failed to write templates to export bundle: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		kubeconfig, err := e.kubeconfig(ctx, projectID, cluster.GetName())
+		if err != nil {
+			return fmt.Errorf("failed to get kubeconfig of cluster %s: %w", cluster.GetName(), err)
+		}
+		if kubeconfig == nil {
+			continue
+		}
+		if err := write(fmt.Sprintf("kubeconfigs/%s.kubeconfig", cluster.GetName()), kubeconfig); err != nil {
+			return fmt.Errorf("failed to write kubeconfig to export bundle: %w", err)
+		}
+		manifest.Kubeconfigs = append(manifest.Kubeconfigs, cluster.GetName())
+	}
+
+	if e.audit != nil {
+		logs, err := e.audit.AuditLogs(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get audit logs: %w", err)
+		}
+		if err := write("audit.log", logs); err != nil {
+			return fmt.Errorf("failed to write audit logs to export bundle: %w", err)
+		}
+		manifest.AuditLogs = true
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := write("manifest.json", data); err != nil {
+		return fmt.Errorf("failed to write manifest to export bundle: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	if err := e.store.Put(ctx, projectID, &buf); err != nil {
+		return fmt.Errorf("failed to store export bundle: %w", err)
+	}
+	slog.Info("stored project export bundle", "project_id", projectID, "project", projectName, "clusters", len(manifest.Clusters), "templates", len(manifest.Templates))
+
+	return nil
+}
+
+func (e *Exporter) list(ctx context.Context, resource schema.GroupVersionResource, namespace string) ([]unstructured.Unstructured, error) {
+	list, err := e.k8s.Dyn.Resource(resource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for i := range list.Items {
+		unstructured.RemoveNestedField(list.Items[i].Object, "metadata", "managedFields")
+	}
+	return list.Items, nil
+}
+
+// kubeconfig returns the admin kubeconfig of the cluster or nil if the cluster has none yet
+func (e *Exporter) kubeconfig(ctx context.Context, namespace, clusterName string) ([]byte, error) {
+	secret, err := e.k8s.Dyn.Resource(core.SecretResourceSchema).Namespace(namespace).Get(ctx, clusterName+"-kubeconfig", metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	value, found, err := unstructured.NestedString(secret.Object, "data", "value")
+	if err != nil || !found {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(value)
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package offboarding
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+type fakeAuditLogSource struct{}
+
+func (fakeAuditLogSource) AuditLogs(_ context.Context, projectID string) ([]byte, error) {
+	return []byte("audit entry of " + projectID), nil
+}
+
+func createObject(t *testing.T, client *k8s.Client, resource schema.GroupVersionResource, kind, name string, fields map[string]any) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resource.GroupVersion().String(),
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name, "namespace": projectID},
+	}}
+	for k, v := range fields {
+		obj.Object[k] = v
+	}
+	_, err := client.Dyn.Resource(resource).Namespace(projectID).Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+// readBundle returns the content of the files in the bundle by name
+func readBundle(t *testing.T, store Store) map[string][]byte {
+	rc, err := store.Open(context.Background(), projectID)
+	require.NoError(t, err)
+	defer rc.Close()
+
+	gz, err := gzip.NewReader(rc)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	files := map[string][]byte{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = data
+	}
+	return files
+}
+
+func TestExport(t *testing.T) {
+	client := k8s.New().WithFakeClient()
+	createObject(t, client, core.ClusterResourceSchema, "Cluster", "cluster-1", nil)
+	createObject(t, client, core.MachineResourceSchema, "Machine", "machine-1", nil)
+	createObject(t, client, core.TemplateResourceSchema, "ClusterTemplate", "template-v1.0.0", nil)
+	createObject(t, client, core.SecretResourceSchema, "Secret", "cluster-1-kubeconfig", map[string]any{
+		"data": map[string]any{"value": base64.StdEncoding.EncodeToString([]byte("kubeconfig of cluster-1"))},
+	})
+
+	store := NewDirStore(t.TempDir())
+	exporter := NewExporter(client, store, WithAuditLogSource(fakeAuditLogSource{}))
+	require.NoError(t, exporter.Export(context.Background(), projectID, "project"))
+
+	files := readBundle(t, store)
+	require.Contains(t, files, "clusters/cluster-1.json")
+	require.Contains(t, files, "machines/machine-1.json")
+	require.Contains(t, files, "templates/template-v1.0.0.json")
+	require.Equal(t, "kubeconfig of cluster-1", string(files["kubeconfigs/cluster-1.kubeconfig"]))
+	require.Equal(t, "audit entry of "+projectID, string(files["audit.log"]))
+
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(files["manifest.json"], &manifest))
+	require.Equal(t, projectID, manifest.ProjectID)
+	require.Equal(t, "project", manifest.ProjectName)
+	require.Equal(t, []string{"cluster-1"}, manifest.Clusters)
+	require.Equal(t, []string{"machine-1"}, manifest.Machines)
+	require.Equal(t, []string{"template-v1.0.0"}, manifest.Templates)
+	require.Equal(t, []string{"cluster-1"}, manifest.Kubeconfigs)
+	require.True(t, manifest.AuditLogs)
+}
+
+func TestExportEmptyProject(t *testing.T) {
+	store := NewDirStore(t.TempDir())
+	exporter := NewExporter(k8s.New().WithFakeClient(), store)
+	require.NoError(t, exporter.Export(context.Background(), projectID, "project"))
+
+	exists, err := store.Exists(context.Background(), projectID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = store.Open(context.Background(), projectID)
+	require.ErrorIs(t, err, ErrBundleNotFound)
+}
+
+func TestExportIsIdempotent(t *testing.T) {
+	client := k8s.New().WithFakeClient()
+	createObject(t, client, core.TemplateResourceSchema, "ClusterTemplate", "template-v1.0.0", nil)
+
+	store := NewDirStore(t.TempDir())
+	exporter := NewExporter(client, store)
+	require.NoError(t, exporter.Export(context.Background(), projectID, "project"))
+
+	// resources created after the first export are not added to the existing bundle
+	createObject(t, client, core.ClusterResourceSchema, "Cluster", "cluster-1", nil)
+	require.NoError(t, exporter.Export(context.Background(), projectID, "project"))
+
+	files := readBundle(t, store)
+	require.Contains(t, files, "templates/template-v1.0.0.json")
+	require.NotContains(t, files, "clusters/cluster-1.json")
+	require.NotContains(t, files, "audit.log")
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package offboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+var ErrBundleNotFound = errors.New("export bundle not found")
+
+// Store persists project export bundles
+type Store interface {
+	Put(ctx context.Context, projectID string, bundle io.Reader) error
+	Open(ctx context.Context, projectID string) (io.ReadCloser, error)
+	Exists(ctx context.Context, projectID string) (bool, error)
+}
+
+// DirStore is a Store that keeps the export bundles as files in a directory, e.g. a persistent
+// volume or an object store bucket mounted into the pod
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore creates a new DirStore rooted at the given directory
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{dir: dir}
+}
+
+// Put writes the bundle of the given project; the bundle becomes visible only once it is completely written
+func (s *DirStore) Put(_ context.Context, projectID string, bundle io.Reader) error {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, projectID+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create export bundle file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+
+	if _, err := io.Copy(tmp, bundle); err != nil {
+		tmp.Close() // nolint: errcheck
+		return fmt.Errorf("failed to write export bundle: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write export bundle: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), s.path(projectID))
+}
+
+// Open returns a reader for the bundle of the given project
+func (s *DirStore) Open(_ context.Context, projectID string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(projectID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBundleNotFound
+	}
+	return f, err
+}
+
+// Exists reports whether a bundle was already stored for the given project
+func (s *DirStore) Exists(_ context.Context, projectID string) (bool, error) {
+	_, err := os.Stat(s.path(projectID))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *DirStore) path(projectID string) string {
+	return filepath.Join(s.dir, filepath.Base(projectID)+".tar.gz")
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+// Type is the kind of long-running cluster operation
+type Type string
+
+const (
+	Create  Type = "create"
+	Delete  Type = "delete"
+	Upgrade Type = "upgrade"
+)
+
+// State is the progress state of an operation
+type State string
+
+const (
+	Running   State = "running"
+	Succeeded State = "succeeded"
+	Failed    State = "failed"
+)
+
+// Operation records a long-running request against a cluster, e.g. its creation, so that clients can poll its outcome
+type Operation struct {
+	ID          string     `json:"id"`
+	Type        Type       `json:"type"`
+	Cluster     string     `json:"cluster"`
+	Template    string     `json:"template,omitempty"`
+	State       State      `json:"state"`
+	Progress    string     `json:"progress,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// DefaultRetention is how long completed operations are kept
+const DefaultRetention = 7 * 24 * time.Hour
+
+// Tracker records operations and derives their progress from the clusters they act upon
+type Tracker struct {
+	k8s       *k8s.Client
+	store     *Store
+	retention time.Duration
+	now       func() time.Time
+}
+
+// NewTracker creates a new Tracker that keeps the operations in ConfigMaps of the project namespaces
+func NewTracker(k8sClient *k8s.Client, options ...func(*Tracker)) *Tracker {
+	t := &Tracker{
+		k8s:       k8sClient,
+		store:     NewStore(k8sClient),
+		retention: DefaultRetention,
+		now:       time.Now,
+	}
+
+	for _, o := range options {
+		o(t)
+	}
+
+	return t
+}
+
+// WithRetention is a functional option for configuring how long a Tracker keeps completed operations
+func WithRetention(retention time.Duration) func(*Tracker) {
+	return func(t *Tracker) {
+		t.retention = retention
+	}
+}
+
+// WithClock is a functional option for configuring a Tracker with the given clock
+func WithClock(now func() time.Time) func(*Tracker) {
+	return func(t *Tracker) {
+		t.now = now
+	}
+}
+
+// Start records a new running operation of the given type on the cluster; template is the
+// cluster template the cluster is created from or upgraded to and is empty for deletions
+func (t *Tracker) Start(ctx context.Context, namespace string, opType Type, cluster, template string) (Operation, error) {
+	now := t.now().UTC()
+	op := Operation{
+		ID:        uuid.NewString(),
+		Type:      opType,
+		Cluster:   cluster,
+		Template:  template,
+		State:     Running,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := t.store.Create(ctx, namespace, op); err != nil {
+		return Operation{}, err
+	}
+	return op, nil
+}
+
+// Get returns the operation with the given id; a running operation is refreshed from its cluster first
+func (t *Tracker) Get(ctx context.Context, namespace, id string) (Operation, error) {
+	op, err := t.store.Get(ctx, namespace, id)
+	if err != nil {
+		return Operation{}, err
+	}
+	return t.refresh(ctx, namespace, op)
+}
+
+// List returns the operations of the namespace, optionally only those of the given cluster, most recent first
+// Completed operations older than the retention period are deleted instead of being returned
+func (t *Tracker) List(ctx context.Context, namespace, cluster string) ([]Operation, error) {
+	ops, err := t.store.List(ctx, namespace, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := ops[:0]
+	for _, op := range ops {
+		if op, err = t.refresh(ctx, namespace, op); err != nil {
+			return nil, err
+		}
+		if t.expired(op) {
+			if err := t.store.Delete(ctx, namespace, op.ID); err != nil {
+				slog.Warn("failed to delete expired operation", "namespace", namespace, "id", op.ID, "error", err)
+			}
+			continue
+		}
+		kept = append(kept, op)
+	}
+	ops = kept
+
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].CreatedAt.After(ops[j].CreatedAt)
+	})
+	return ops, nil
+}
+
+// refresh updates a running operation from the current state of its cluster and persists any change
+func (t *Tracker) refresh(ctx context.Context, namespace string, op Operation) (Operation, error) {
+	if op.State != Running {
+		return op, nil
+	}
+
+	cluster, err := t.k8s.GetCluster(ctx, namespace, op.Cluster)
+	if err != nil && !errors.Is(err, k8s.ErrClusterNotFound) {
+		return op, err
+	}
+
+	state, progress, message := evaluate(op, cluster)
+	if state == op.State && progress == op.Progress {
+		return op, nil
+	}
+
+	now := t.now().UTC()
+	op.State, op.Progress, op.Error, op.UpdatedAt = state, progress, message, now
+	if state != Running {
+		op.CompletedAt = &now
+	}
+
+	// another request may have refreshed the operation concurrently, the next poll picks up the stored state
+	if err := t.store.Update(ctx, namespace, op); err != nil {
+		slog.Warn("failed to update operation", "namespace", namespace, "id", op.ID, "error", err)
+	}
+	return op, nil
+}
+
+// expired reports whether the operation completed longer than the retention period ago
+func (t *Tracker) expired(op Operation) bool {
+	return op.CompletedAt != nil && t.now().Sub(*op.CompletedAt) > t.retention
+}
+
+// evaluate returns the state, progress and error message of the operation given its cluster, which is nil once deleted
+func evaluate(op Operation, cluster *capi.Cluster) (State, string, string) {
+	if cluster == nil {
+		if op.Type == Delete {
+			return Succeeded, "cluster deleted", ""
+		}
+		return Failed, "", fmt.Sprintf("cluster %s no longer exists", op.Cluster)
+	}
+
+	progress := clusterProgress(cluster)
+	switch {
+	case op.Type == Delete:
+		return Running, progress, ""
+	case cluster.Status.GetTypedPhase() == capi.ClusterPhaseFailed:
+		message := "cluster failed"
+		if cluster.Status.FailureMessage != nil {
+			message = *cluster.Status.FailureMessage
+		}
+		return Failed, progress, message
+	case op.Type == Upgrade && !upgraded(cluster, op.Template):
+		return Running, progress, ""
+	case conditionTrue(cluster, capi.ReadyCondition):
+		return Succeeded, progress, ""
+	}
+	return Running, progress, ""
+}
+
+// upgraded reports whether the topology of the cluster was reconciled with the given cluster template
+func upgraded(cluster *capi.Cluster, template string) bool {
+	if cluster.Spec.Topology == nil || cluster.Spec.Topology.Class != template {
+		return false
+	}
+	return cluster.Status.ObservedGeneration >= cluster.Generation && conditionTrue(cluster, capi.TopologyReconciledCondition)
+}
+
+// clusterProgress describes how far the cluster got, e.g. "Provisioned: control plane not ready"
+func clusterProgress(cluster *capi.Cluster) string {
+	phase := string(cluster.Status.GetTypedPhase())
+	components := []struct {
+		condition capi.ConditionType
+		name      string
+	}{
+		{condition: capi.InfrastructureReadyCondition, name: "infrastructure"},
+		{condition: capi.ControlPlaneReadyCondition, name: "control plane"},
+	}
+	for _, c := range components {
+		if !conditionTrue(cluster, c.condition) {
+			return fmt.Sprintf("%s: %s not ready", phase, c.name)
+		}
+	}
+	return phase
+}
+
+func conditionTrue(cluster *capi.Cluster, conditionType capi.ConditionType) bool {
+	for _, condition := range cluster.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
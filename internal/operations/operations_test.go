@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const namespace = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+// createCluster creates a cluster of the given template in the given phase with the given true conditions
+func createCluster(t *testing.T, client *k8s.Client, name, template string, phase capi.ClusterPhase, conditions ...capi.ConditionType) {
+	cluster := capi.Cluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: core.ClusterResourceSchema.GroupVersion().String(), Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       capi.ClusterSpec{Topology: &capi.Topology{Class: template, Version: "v1.30.6+k3s1"}},
+		Status:     capi.ClusterStatus{Phase: string(phase)},
+	}
+	for _, condition := range conditions {
+		cluster.Status.Conditions = append(cluster.Status.Conditions, capi.Condition{Type: condition, Status: corev1.ConditionTrue})
+	}
+
+	obj, err := convert.ToUnstructured(cluster)
+	require.NoError(t, err)
+	_, err = client.Dyn.Resource(core.ClusterResourceSchema).Namespace(namespace).Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func TestTracker(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	t.Run("create operation succeeds once the cluster is ready", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		tracker := NewTracker(client, WithClock(clock))
+		createCluster(t, client, "cluster-1", "baseline-v1.0.0", capi.ClusterPhaseProvisioning)
+
+		op, err := tracker.Start(context.Background(), namespace, Create, "cluster-1", "baseline-v1.0.0")
+		require.NoError(t, err)
+		require.Equal(t, Running, op.State)
+
+		op, err = tracker.Get(context.Background(), namespace, op.ID)
+		require.NoError(t, err)
+		require.Equal(t, Running, op.State)
+		require.Equal(t, "Provisioning: infrastructure not ready", op.Progress)
+		require.Nil(t, op.CompletedAt)
+
+		require.NoError(t, client.Dyn.Resource(core.ClusterResourceSchema).Namespace(namespace).Delete(context.Background(), "cluster-1", metav1.DeleteOptions{}))
+		createCluster(t, client, "cluster-1", "baseline-v1.0.0", capi.ClusterPhaseProvisioned,
+			capi.InfrastructureReadyCondition, capi.ControlPlaneReadyCondition, capi.ReadyCondition)
+
+		op, err = tracker.Get(context.Background(), namespace, op.ID)
+		require.NoError(t, err)
+		require.Equal(t, Succeeded, op.State)
+		require.Equal(t, "Provisioned", op.Progress)
+		require.Equal(t, now, *op.CompletedAt)
+
+		// the completion is persisted
+		stored, err := tracker.store.Get(context.Background(), namespace, op.ID)
+		require.NoError(t, err)
+		require.Equal(t, op, stored)
+	})
+
+	t.Run("create operation fails with the cluster", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		tracker := NewTracker(client, WithClock(clock))
+		createCluster(t, client, "cluster-1", "baseline-v1.0.0", capi.ClusterPhaseFailed)
+
+		op, err := tracker.Start(context.Background(), namespace, Create, "cluster-1", "baseline-v1.0.0")
+		require.NoError(t, err)
+
+		op, err = tracker.Get(context.Background(), namespace, op.ID)
+		require.NoError(t, err)
+		require.Equal(t, Failed, op.State)
+		require.Equal(t, "cluster failed", op.Error)
+	})
+
+	t.Run("delete operation succeeds once the cluster is gone", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		tracker := NewTracker(client, WithClock(clock))
+
+		op, err := tracker.Start(context.Background(), namespace, Delete, "cluster-1", "")
+		require.NoError(t, err)
+
+		op, err = tracker.Get(context.Background(), namespace, op.ID)
+		require.NoError(t, err)
+		require.Equal(t, Succeeded, op.State)
+		require.Empty(t, op.Error)
+	})
+
+	t.Run("upgrade operation waits for the new template", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		tracker := NewTracker(client, WithClock(clock))
+		createCluster(t, client, "cluster-1", "baseline-v1.0.0", capi.ClusterPhaseProvisioned,
+			capi.InfrastructureReadyCondition, capi.ControlPlaneReadyCondition, capi.ReadyCondition, capi.TopologyReconciledCondition)
+
+		op, err := tracker.Start(context.Background(), namespace, Upgrade, "cluster-1", "baseline-v2.0.0")
+		require.NoError(t, err)
+
+		op, err = tracker.Get(context.Background(), namespace, op.ID)
+		require.NoError(t, err)
+		require.Equal(t, Running, op.State)
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		tracker := NewTracker(k8s.New().WithFakeClient(), WithClock(clock))
+		_, err := tracker.Get(context.Background(), namespace, "64e797f6-db22-445e-b606-4228d4f1c2bd")
+		require.ErrorIs(t, err, ErrOperationNotFound)
+	})
+}
+
+func TestTrackerList(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := k8s.New().WithFakeClient()
+	tracker := NewTracker(client, WithClock(func() time.Time { return now }), WithRetention(time.Hour))
+
+	deleted, err := tracker.Start(context.Background(), namespace, Delete, "cluster-1", "")
+	require.NoError(t, err)
+	_, err = tracker.Get(context.Background(), namespace, deleted.ID)
+	require.NoError(t, err)
+
+	now = now.Add(30 * time.Minute)
+	created, err := tracker.Start(context.Background(), namespace, Create, "cluster-2", "baseline-v1.0.0")
+	require.NoError(t, err)
+	createCluster(t, client, "cluster-2", "baseline-v1.0.0", capi.ClusterPhaseProvisioning)
+
+	ops, err := tracker.List(context.Background(), namespace, "")
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	require.Equal(t, created.ID, ops[0].ID, "most recent operation first")
+	require.Equal(t, deleted.ID, ops[1].ID)
+
+	ops, err = tracker.List(context.Background(), namespace, "cluster-2")
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	require.Equal(t, created.ID, ops[0].ID)
+
+	// completed operations are deleted once the retention period passed
+	now = now.Add(time.Hour)
+	ops, err = tracker.List(context.Background(), namespace, "")
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	require.Equal(t, created.ID, ops[0].ID)
+
+	_, err = tracker.Get(context.Background(), namespace, deleted.ID)
+	require.ErrorIs(t, err, ErrOperationNotFound)
+}
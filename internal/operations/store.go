@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const (
+	// OperationLabelKey marks the ConfigMaps holding operations, its value is the operation type
+	OperationLabelKey = core.ClusterOrchResourceGroup + "/operation"
+	// ClusterLabelKey holds the name of the cluster the operation acts upon
+	ClusterLabelKey = core.ClusterOrchResourceGroup + "/operation-cluster"
+
+	operationDataKey = "operation"
+)
+
+var ErrOperationNotFound = errors.New("operation not found")
+
+// Store keeps each operation as a ConfigMap in the namespace of its project, so operations
+// survive restarts of cluster manager and are removed together with the project
+type Store struct {
+	k8s *k8s.Client
+}
+
+// NewStore creates a new Store
+func NewStore(k8sClient *k8s.Client) *Store {
+	return &Store{k8s: k8sClient}
+}
+
+// Create stores a new operation
+func (s *Store) Create(ctx context.Context, namespace string, op Operation) error {
+	obj, err := configMap(namespace, op)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{})
+	return err
+}
+
+// Update replaces the stored operation
+func (s *Store) Update(ctx context.Context, namespace string, op Operation) error {
+	existing, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Get(ctx, configMapName(op.ID), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	obj, err := configMap(namespace, op)
+	if err != nil {
+		return err
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+
+	_, err = s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Update(ctx, obj, metav1.UpdateOptions{})
+	return err
+}
+
+// Get returns the operation with the given id
+func (s *Store) Get(ctx context.Context, namespace, id string) (Operation, error) {
+	obj, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Get(ctx, configMapName(id), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return Operation{}, ErrOperationNotFound
+	}
+	if err != nil {
+		return Operation{}, err
+	}
+	if _, ok := obj.GetLabels()[OperationLabelKey]; !ok {
+		return Operation{}, ErrOperationNotFound
+	}
+	return operation(*obj)
+}
+
+// List returns the operations of the namespace, optionally only those of the given cluster
+func (s *Store) List(ctx context.Context, namespace, cluster string) ([]Operation, error) {
+	selector := OperationLabelKey
+	if cluster != "" {
+		selector = fmt.Sprintf("%s,%s=%s", OperationLabelKey, ClusterLabelKey, cluster)
+	}
+
+	list, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]Operation, 0, len(list.Items))
+	for _, item := range list.Items {
+		op, err := operation(item)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// Delete removes the operation with the given id
+func (s *Store) Delete(ctx context.Context, namespace, id string) error {
+	err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Delete(ctx, configMapName(id), metav1.DeleteOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func configMapName(id string) string {
+	return "operation-" + id
+}
+
+// configMap returns the ConfigMap holding the operation
+func configMap(namespace string, op Operation) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal operation: %w", err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(configMapName(op.ID))
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{
+		OperationLabelKey: string(op.Type),
+		ClusterLabelKey:   op.Cluster,
+	})
+	if err := unstructured.SetNestedStringMap(obj.Object, map[string]string{operationDataKey: string(data)}, "data"); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// operation returns the operation held by the ConfigMap
+func operation(obj unstructured.Unstructured) (Operation, error) {
+	data, _, err := unstructured.NestedString(obj.Object, "data", operationDataKey)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	var op Operation
+	if err := json.Unmarshal([]byte(data), &op); err != nil {
+		return Operation{}, fmt.Errorf("failed to unmarshal operation %s: %w", obj.GetName(), err)
+	}
+	return op, nil
+}
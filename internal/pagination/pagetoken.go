@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	ErrInvalidPageToken  = errors.New("invalid pageToken")
+	ErrPageTokenMismatch = errors.New("pageToken does not match the filter and orderBy of the request")
+)
+
+// PageToken is the position of the next page of a list; clients only see its opaque encoding, see Encode
+type PageToken struct {
+	// Continue is the Kubernetes list continue token of the next page, empty if the list is paged by offset
+	Continue string `json:"c,omitempty"`
+	// Offset is the number of items before the next page
+	Offset int `json:"o"`
+	// Query identifies the filter and the order of the list the token belongs to, see PageQuery
+	Query string `json:"q,omitempty"`
+}
+
+// PageQuery returns the identifier of a list with the given filter and order, so that a token is not used to continue
+// a different list
+func PageQuery(filter, orderBy *string) string {
+	if filter == nil && orderBy == nil {
+		return ""
+	}
+
+	h := sha256.New()
+	for _, param := range []*string{filter, orderBy} {
+		if param != nil {
+			h.Write([]byte(*param))
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// Encode returns the opaque encoding of the token
+func (t PageToken) Encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken decodes the token and checks that it belongs to the list with the given query
+func DecodePageToken(token, query string) (PageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return PageToken{}, ErrInvalidPageToken
+	}
+
+	var t PageToken
+	if err := json.Unmarshal(data, &t); err != nil || t.Offset < 0 {
+		return PageToken{}, ErrInvalidPageToken
+	}
+	if t.Query != query {
+		return PageToken{}, ErrPageTokenMismatch
+	}
+	return t, nil
+}
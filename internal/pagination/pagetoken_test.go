@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToken(t *testing.T) {
+	filter, orderBy := "labels.env=prod", "name desc"
+
+	t.Run("token is decoded for the same query", func(t *testing.T) {
+		query := PageQuery(&filter, &orderBy)
+		token := PageToken{Continue: "eyJ2IjoibWV0YS5rOHMuaW8vdjEifQ", Offset: 20, Query: query}
+
+		decoded, err := DecodePageToken(token.Encode(), query)
+		require.NoError(t, err)
+		require.Equal(t, token, decoded)
+	})
+
+	t.Run("token is rejected for a different query", func(t *testing.T) {
+		token := PageToken{Offset: 20, Query: PageQuery(&filter, nil)}
+
+		_, err := DecodePageToken(token.Encode(), PageQuery(nil, &filter))
+		require.ErrorIs(t, err, ErrPageTokenMismatch)
+		_, err = DecodePageToken(token.Encode(), PageQuery(nil, nil))
+		require.ErrorIs(t, err, ErrPageTokenMismatch)
+	})
+
+	t.Run("invalid tokens are rejected", func(t *testing.T) {
+		for _, token := range []string{"not a token", "bm90IGpzb24", PageToken{Offset: -1}.Encode()} {
+			_, err := DecodePageToken(token, "")
+			require.ErrorIs(t, err, ErrInvalidPageToken, token)
+		}
+	})
+}
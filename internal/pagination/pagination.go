@@ -31,9 +31,14 @@ var (
 		"providerStatus":    true,
 		"lifecyclePhase":    true,
 		"version":           true,
+		"template":          true,
+		"phase":             true,
 	}
 )
 
+// LabelFilterPrefix prefixes the label key in filters on labels, e.g. "labels.env=prod"
+const LabelFilterPrefix = "labels."
+
 type Filter struct {
 	Name  string
 	Value string
@@ -50,7 +55,7 @@ var normalizeEqualsRe = regexp.MustCompile(`[ \t]*=[ \t]*`)
 
 // ParseFilter parses the given filter string and returns a list of Filter
 // If any error is encountered, an nil Filter slice and non-nil error is returned
-func parseFilter(filterParameter string) ([]*Filter, bool, error) {
+func ParseFilter(filterParameter string) ([]*Filter, bool, error) {
 	if filterParameter == "" {
 		return nil, false, nil
 	}
@@ -187,7 +192,7 @@ func applyPagination[T any](items []T, pageSize, offset int) ([]T, error) {
 }
 
 func FilterItems[T any](items []T, filter string, filterFunc func(T, *Filter) bool) ([]T, error) {
-	filters, useAnd, err := parseFilter(filter)
+	filters, useAnd, err := ParseFilter(filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse filter: %w", err)
 	}
@@ -291,7 +296,7 @@ func ValidateParams(params any) (pageSize, offset *int, orderBy, filter *string,
 		})
 		for _, part := range filterParts {
 			subParts := strings.Split(part, "=")
-			if len(subParts) != 2 || !validFilterFields[subParts[0]] {
+			if len(subParts) != 2 || !validFilterField(subParts[0]) {
 				return nil, nil, nil, nil, fmt.Errorf("invalid filter field")
 			}
 		}
@@ -300,6 +305,13 @@ func ValidateParams(params any) (pageSize, offset *int, orderBy, filter *string,
 	return pageSize, offset, orderBy, filter, nil
 }
 
+func validFilterField(name string) bool {
+	if key, ok := strings.CutPrefix(name, LabelFilterPrefix); ok {
+		return key != ""
+	}
+	return validFilterFields[name]
+}
+
 // MatchSubstring checks if the target string contains the substring.
 func MatchSubstring(target *string, substring string) bool {
 	if target == nil {
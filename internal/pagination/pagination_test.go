@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 func checkFilters(t *testing.T, filters []*Filter, wantedFieldList string, wantedValuesList string) {
@@ -66,7 +68,7 @@ func TestFiltersParsing(t *testing.T) {
 
 	for name, testCase := range tests {
 		t.Run(name, func(t *testing.T) {
-			resp, useAnd, err := parseFilter(testCase.filter)
+			resp, useAnd, err := ParseFilter(testCase.filter)
 			if testCase.expectedError != "" {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), testCase.expectedError)
@@ -141,3 +143,30 @@ func TestComputePageRange(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateParamsFilterFields(t *testing.T) {
+	tests := map[string]struct {
+		filter  string
+		isValid bool
+	}{
+		"name":              {filter: "name=foo", isValid: true},
+		"template":          {filter: "template=baseline-v1.0.0", isValid: true},
+		"phase":             {filter: "phase=Provisioned", isValid: true},
+		"label":             {filter: "labels.env=prod", isValid: true},
+		"prefixed label":    {filter: "labels.dns.sub.domain/key=value", isValid: true},
+		"label without key": {filter: "labels.=prod", isValid: false},
+		"unknown field":     {filter: "unknown=foo", isValid: false},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			pageSize, offset := 10, 0
+			_, _, _, _, err := ValidateParams(api.GetV2ClustersParams{PageSize: &pageSize, Offset: &offset, Filter: &testCase.filter})
+			if testCase.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package preflight pulls the control plane images of air-gapped clusters onto their hosts before the clusters are
+// provisioned, so that the nodes of a site whose registries are missing an image do not fail half-way through their
+// bootstrap. The images are pulled by the site-local infrastructure, which cluster manager instructs through a gateway.
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// PullState is the state of the pull of the images onto a host
+type PullState string
+
+const (
+	Pulling PullState = "pulling"
+	Pulled  PullState = "pulled"
+	Failed  PullState = "failed"
+)
+
+// requestTimeout bounds the requests to the gateway, so that an unreachable site does not block the scheduler
+const requestTimeout = 30 * time.Second
+
+// HostStatus is the status of the pull of the images onto a host
+type HostStatus struct {
+	HostID string    `json:"hostId"`
+	State  PullState `json:"state"`
+	// Pulled is the number of images pulled onto the host so far, out of Total
+	Pulled int    `json:"pulled"`
+	Total  int    `json:"total"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ImagePuller instructs the site-local infrastructure of the hosts of a project to pull images onto them
+type ImagePuller interface {
+	// PullImages starts pulling the images onto the host; pulling the same images again restarts a failed pull
+	PullImages(ctx context.Context, projectID, hostID string, images []string) error
+	// PullStatus returns the status of the last pull of images onto the host
+	PullStatus(ctx context.Context, projectID, hostID string) (HostStatus, error)
+}
+
+// GatewayPuller is an ImagePuller instructing the hosts through the image pull API of the site gateway:
+//
+//	PUT {url}/v1/projects/{projectID}/hosts/{hostID}/image-pull with {"images": [...]} starts a pull
+//	GET {url}/v1/projects/{projectID}/hosts/{hostID}/image-pull returns {"state", "pulled", "total", "error"}
+type GatewayPuller struct {
+	url    string
+	client *http.Client
+}
+
+// NewGatewayPuller creates a new GatewayPuller of the gateway with the given base URL
+func NewGatewayPuller(gatewayURL string, options ...func(*GatewayPuller)) *GatewayPuller {
+	p := &GatewayPuller{
+		url:    strings.TrimSuffix(gatewayURL, "/"),
+		client: &http.Client{Timeout: requestTimeout},
+	}
+	for _, o := range options {
+		o(p)
+	}
+	return p
+}
+
+// WithHTTPClient is a functional option for configuring the client the gateway is requested with
+func WithHTTPClient(client *http.Client) func(*GatewayPuller) {
+	return func(p *GatewayPuller) {
+		p.client = client
+	}
+}
+
+// PullImages requests the gateway to pull the images onto the host
+func (p *GatewayPuller) PullImages(ctx context.Context, projectID, hostID string, images []string) error {
+	body, err := json.Marshal(map[string][]string{"images": images})
+	if err != nil {
+		return fmt.Errorf("failed to marshal image pull: %w", err)
+	}
+	resp, err := p.do(ctx, http.MethodPut, projectID, hostID, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// PullStatus requests the status of the pull of the images onto the host from the gateway
+func (p *GatewayPuller) PullStatus(ctx context.Context, projectID, hostID string) (HostStatus, error) {
+	resp, err := p.do(ctx, http.MethodGet, projectID, hostID, nil)
+	if err != nil {
+		return HostStatus{}, err
+	}
+	defer resp.Body.Close()
+
+	status := HostStatus{}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return HostStatus{}, fmt.Errorf("failed to decode image pull status of host %s: %w", hostID, err)
+	}
+	status.HostID = hostID
+	switch status.State {
+	case Pulling, Pulled, Failed:
+	default:
+		return HostStatus{}, fmt.Errorf("unknown image pull state '%s' of host %s", status.State, hostID)
+	}
+	return status, nil
+}
+
+// do sends a request to the image pull of the host and fails on responses other than 2xx
+func (p *GatewayPuller) do(ctx context.Context, method, projectID, hostID string, body io.Reader) (*http.Response, error) {
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/hosts/%s/image-pull", p.url, url.PathEscape(projectID), url.PathEscape(hostID))
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image pull request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request image pull of host %s: %w", hostID, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("image pull request of host %s failed with status %d: %s", hostID, resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+func TestGatewayPuller(t *testing.T) {
+	images := []string{"registry.site.local:5000/rancher/mirrored-pause:3.6"}
+
+	t.Run("images are pulled through the gateway", func(t *testing.T) {
+		var pulled []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/projects/"+projectID+"/hosts/host-1/image-pull", r.URL.Path)
+			switch r.Method {
+			case http.MethodPut:
+				var body struct{ Images []string }
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				pulled = body.Images
+				w.WriteHeader(http.StatusAccepted)
+			case http.MethodGet:
+				_, _ = w.Write([]byte(`{"state": "pulling", "pulled": 0, "total": 1}`))
+			}
+		}))
+		defer server.Close()
+		puller := NewGatewayPuller(server.URL + "/")
+
+		require.NoError(t, puller.PullImages(context.Background(), projectID, "host-1", images))
+		require.Equal(t, images, pulled)
+
+		status, err := puller.PullStatus(context.Background(), projectID, "host-1")
+		require.NoError(t, err)
+		require.Equal(t, HostStatus{HostID: "host-1", State: Pulling, Total: 1}, status)
+	})
+
+	t.Run("gateway errors are returned", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "host not found", http.StatusNotFound)
+		}))
+		defer server.Close()
+		puller := NewGatewayPuller(server.URL)
+
+		err := puller.PullImages(context.Background(), projectID, "host-1", images)
+		require.ErrorContains(t, err, "status 404: host not found")
+		_, err = puller.PullStatus(context.Background(), projectID, "host-1")
+		require.ErrorContains(t, err, "status 404")
+	})
+
+	t.Run("unknown states are rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"state": "queued"}`))
+		}))
+		defer server.Close()
+
+		_, err := NewGatewayPuller(server.URL).PullStatus(context.Background(), projectID, "host-1")
+		require.ErrorContains(t, err, "unknown image pull state 'queued'")
+	})
+}
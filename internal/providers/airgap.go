@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+const (
+	// DefaultAirGapInstallScriptPath is where k3s expects the install script of air-gapped nodes by default
+	DefaultAirGapInstallScriptPath = "/opt/install.sh"
+
+	airGapBinaryPath = "/usr/local/bin/k3s"
+	airGapImagesDir  = "/var/lib/rancher/k3s/agent/images"
+	// containerdCertsDir is the directory containerd reads the hosts configuration of the registries from
+	containerdCertsDir = "/etc/containerd/certs.d"
+)
+
+// commandURLPattern matches the URLs referenced by the commands of the bootstrap configuration
+var commandURLPattern = regexp.MustCompile(`https?://[^\s"'|;)]+`)
+
+// RenderAirGap returns the k3s control plane template with the air-gap settings of the cluster template applied.
+// The nodes first check that every artifact is reachable from the site, then preload the k3s binary, install script
+// and image tarballs before k3s is installed. The worker templates are derived from the rendered control plane template,
+// so they get the same settings.
+func RenderAirGap(config string, airGap *v1alpha1.AirGapConfig) (string, error) {
+	if airGap == nil {
+		return config, nil
+	}
+
+	var cpt map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return "", fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+
+	installScriptPath := airGap.InstallScriptPath
+	if installScriptPath == "" {
+		installScriptPath = DefaultAirGapInstallScriptPath
+	}
+
+	fields := map[string]interface{}{
+		"agentConfig.airGapped":                  true,
+		"agentConfig.airGappedInstallScriptPath": installScriptPath,
+	}
+	if airGap.SystemDefaultRegistry != "" {
+		fields["serverConfig.systemDefaultRegistry"] = airGap.SystemDefaultRegistry
+	}
+	for field, value := range fields {
+		fieldPath := append([]string{"spec", "template", "spec", "kthreesConfigSpec"}, strings.Split(field, ".")...)
+		if err := unstructured.SetNestedField(cpt, value, fieldPath...); err != nil {
+			return "", fmt.Errorf("failed to set %s: %w", field, err)
+		}
+	}
+
+	preK3sCommands, _, err := unstructured.NestedStringSlice(cpt, "spec", "template", "spec", "kthreesConfigSpec", "preK3sCommands")
+	if err != nil {
+		return "", fmt.Errorf("failed to read preK3sCommands: %w", err)
+	}
+	commands := airGapCommands(airGap, installScriptPath)
+	if err := unstructured.SetNestedStringSlice(cpt, append(commands, preK3sCommands...), "spec", "template", "spec", "kthreesConfigSpec", "preK3sCommands"); err != nil {
+		return "", fmt.Errorf("failed to set preK3sCommands: %w", err)
+	}
+
+	rendered, err := json.Marshal(cpt)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal control plane template: %w", err)
+	}
+	return string(rendered), nil
+}
+
+// airGapCommands returns the commands checking the artifacts are reachable and downloading them onto the node
+func airGapCommands(airGap *v1alpha1.AirGapConfig, installScriptPath string) []string {
+	type artifact struct{ url, dest string }
+	artifacts := []artifact{
+		{url: artifactURL(airGap.ArtifactURL, "k3s"), dest: airGapBinaryPath},
+		{url: artifactURL(airGap.ArtifactURL, "install.sh"), dest: installScriptPath},
+	}
+	for _, tarball := range airGap.ImageTarballs {
+		url := artifactURL(airGap.ArtifactURL, tarball)
+		artifacts = append(artifacts, artifact{url: url, dest: path.Join(airGapImagesDir, path.Base(url))})
+	}
+
+	commands := []string{}
+	for _, a := range artifacts {
+		commands = append(commands, fmt.Sprintf(`curl -fsSI %[1]s > /dev/null || { echo "air-gap artifact %[1]s is not reachable from the site" >&2; exit 1; }`, a.url))
+	}
+	commands = append(commands, fmt.Sprintf("mkdir -p %s %s", path.Dir(installScriptPath), airGapImagesDir))
+	for _, a := range artifacts {
+		commands = append(commands, fmt.Sprintf("curl -fsSL %s -o %s", a.url, a.dest))
+	}
+	commands = append(commands, fmt.Sprintf("chmod +x %s %s", airGapBinaryPath, installScriptPath))
+	return commands
+}
+
+// artifactURL resolves an artifact path against the artifact server URL; absolute URLs are returned as is
+func artifactURL(baseURL, artifact string) string {
+	if strings.Contains(artifact, "://") {
+		return artifact
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(artifact, "/")
+}
+
+// RenderKubeadmAirGap returns the kubeadm control plane template with the air-gap settings of the cluster template
+// applied. kubeadm pulls the control plane, CoreDNS and etcd images from the site-local repositories, and containerd
+// pulls the images of the mirrored registries from their site-local mirrors. The pre and post kubeadm commands that
+// reach out to endpoints outside of the site, e.g. to apply a CNI manifest from the internet, are skipped. The mirror
+// configuration is copied to the worker templates, see copyKubeadmAirGap.
+func RenderKubeadmAirGap(config string, airGap *v1alpha1.AirGapConfig) (string, error) {
+	if airGap == nil {
+		return config, nil
+	}
+
+	var cpt map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return "", fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+	specPath := []string{"spec", "template", "spec", "kubeadmConfigSpec"}
+
+	fields := map[string]string{
+		"clusterConfiguration.imageRepository":            airGap.ImageRepository,
+		"clusterConfiguration.dns.imageRepository":        airGap.CoreDNSImageRepository,
+		"clusterConfiguration.etcd.local.imageRepository": airGap.EtcdImageRepository,
+	}
+	for field, value := range fields {
+		if value == "" {
+			continue
+		}
+		if err := unstructured.SetNestedField(cpt, value, append(specPath, strings.Split(field, ".")...)...); err != nil {
+			return "", fmt.Errorf("failed to set %s: %w", field, err)
+		}
+	}
+
+	files, _, err := unstructured.NestedSlice(cpt, append(specPath, "files")...)
+	if err != nil {
+		return "", fmt.Errorf("failed to read files: %w", err)
+	}
+	for _, mirror := range airGap.RegistryMirrors {
+		files = append(files, registryMirrorFile(mirror))
+	}
+	if err := unstructured.SetNestedSlice(cpt, files, append(specPath, "files")...); err != nil {
+		return "", fmt.Errorf("failed to set files: %w", err)
+	}
+
+	siteHosts := airGapSiteHosts(airGap)
+	for _, commandsField := range []string{"preKubeadmCommands", "postKubeadmCommands"} {
+		commands, ok, err := unstructured.NestedStringSlice(cpt, append(specPath, commandsField)...)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", commandsField, err)
+		}
+		if !ok {
+			continue
+		}
+		commands = slices.DeleteFunc(commands, func(command string) bool { return reachesOutOfSite(command, siteHosts) })
+		if err := unstructured.SetNestedStringSlice(cpt, commands, append(specPath, commandsField)...); err != nil {
+			return "", fmt.Errorf("failed to set %s: %w", commandsField, err)
+		}
+	}
+
+	rendered, err := json.Marshal(cpt)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal control plane template: %w", err)
+	}
+	return string(rendered), nil
+}
+
+// copyKubeadmAirGap copies the registry mirror configuration rendered by RenderKubeadmAirGap from the kubeadm control
+// plane template to the spec of the worker bootstrap template, so that the workers pull from the same mirrors
+func copyKubeadmAirGap(cpt map[string]interface{}, spec map[string]interface{}) error {
+	files, _, err := unstructured.NestedSlice(cpt, "spec", "template", "spec", "kubeadmConfigSpec", "files")
+	if err != nil {
+		return fmt.Errorf("failed to read files from control plane template: %w", err)
+	}
+	mirrorFiles := []interface{}{}
+	for _, f := range files {
+		if file, ok := f.(map[string]interface{}); ok {
+			if path, _ := file["path"].(string); strings.HasPrefix(path, containerdCertsDir+"/") {
+				mirrorFiles = append(mirrorFiles, f)
+			}
+		}
+	}
+	if len(mirrorFiles) == 0 {
+		return nil
+	}
+	existing, _ := spec["files"].([]interface{})
+	spec["files"] = append(existing, mirrorFiles...)
+	return nil
+}
+
+// registryMirrorFile returns the containerd hosts configuration of the mirrored registry; the mirror is the server of
+// the registry too, so that containerd never falls back to the registry outside of the site
+func registryMirrorFile(mirror v1alpha1.RegistryMirror) map[string]interface{} {
+	content := fmt.Sprintf("server = %q\n\n[host.%q]\n  capabilities = [\"pull\", \"resolve\"]\n", mirror.Endpoint, mirror.Endpoint)
+	return map[string]interface{}{
+		"path":        path.Join(containerdCertsDir, mirror.Registry, "hosts.toml"),
+		"owner":       "root:root",
+		"permissions": "0644",
+		"content":     content,
+	}
+}
+
+// airGapSiteHosts returns the hosts of the site-local repositories and mirrors of the air-gap settings
+func airGapSiteHosts(airGap *v1alpha1.AirGapConfig) []string {
+	hosts := []string{}
+	for _, repository := range []string{airGap.ImageRepository, airGap.CoreDNSImageRepository, airGap.EtcdImageRepository} {
+		if repository != "" {
+			hosts = append(hosts, strings.SplitN(repository, "/", 2)[0])
+		}
+	}
+	for _, mirror := range airGap.RegistryMirrors {
+		if u, err := url.Parse(mirror.Endpoint); err == nil {
+			hosts = append(hosts, u.Host)
+		}
+	}
+	return hosts
+}
+
+// reachesOutOfSite returns whether the command references a URL whose host is none of the site hosts
+func reachesOutOfSite(command string, siteHosts []string) bool {
+	for _, match := range commandURLPattern.FindAllString(command, -1) {
+		u, err := url.Parse(match)
+		if err != nil || !slices.Contains(siteHosts, u.Host) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+// flannelBackendConfig is the k3s configuration file of the control plane nodes setting the flannel backend of the
+// cluster; k3s merges the files of config.yaml.d into its configuration
+const flannelBackendConfig = "/etc/rancher/k3s/config.yaml.d/flannel-backend.yaml"
+
+var (
+	// FlannelBackend is set per cluster to override the backend of the flannel CNI of k3s, e.g. "wireguard-native"
+	FlannelBackend = "flannelBackend"
+
+	// FlannelBackends are the flannel backends supported by k3s
+	FlannelBackends = []string{"vxlan", "host-gw", "wireguard-native", "none"}
+
+	flannelBackendEnabledIf = "{{ if .flannelBackend }}true{{ end }}"
+)
+
+// cniVariables declares the variables overriding the CNI options of the template
+func cniVariables() []capiv1beta1.ClusterClassVariable {
+	enum := make([]apiextensionsv1.JSON, 0, len(FlannelBackends))
+	for _, backend := range FlannelBackends {
+		enum = append(enum, apiextensionsv1.JSON{Raw: []byte(`"` + backend + `"`)})
+	}
+	return []capiv1beta1.ClusterClassVariable{
+		{
+			Name: FlannelBackend,
+			Schema: capiv1beta1.VariableSchema{
+				OpenAPIV3Schema: capiv1beta1.JSONSchemaProps{
+					Type: "string",
+					Enum: enum,
+				},
+			},
+		},
+	}
+}
+
+// kthreesCNIPatches add the k3s configuration file setting the CNI options of the cluster to the control plane nodes;
+// the CNI is configured by the servers, so the agents of the worker nodes follow them
+func kthreesCNIPatches() []capiv1beta1.ClusterClassPatch {
+	value := `{"path": "` + flannelBackendConfig + `", "content": "flannel-backend: {{ .flannelBackend }}\n"}`
+	return []capiv1beta1.ClusterClassPatch{
+		{
+			Name:        FlannelBackend,
+			Description: "This patch will set the flannel backend of the cluster.",
+			EnabledIf:   &flannelBackendEnabledIf,
+			Definitions: []capiv1beta1.PatchDefinition{
+				{
+					Selector: capiv1beta1.PatchSelector{
+						APIVersion: "controlplane.cluster.x-k8s.io/v1beta2",
+						Kind:       KThreesControlPlaneTemplate,
+						MatchResources: capiv1beta1.PatchSelectorMatch{
+							ControlPlane: true,
+						},
+					},
+					JSONPatches: []capiv1beta1.JSONPatch{
+						{
+							Op:        "add",
+							Path:      "/spec/template/spec/kthreesConfigSpec/files/-",
+							ValueFrom: &capiv1beta1.JSONPatchValue{Template: &value},
+						},
+					},
+				},
+			},
+		},
+	}
+}
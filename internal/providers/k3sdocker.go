@@ -82,6 +82,31 @@ func (rd k3sdocker) CreateClusterTemplate(ctx context.Context, c client.Client,
 	return c.Create(ctx, &ct)
 }
 
+func (rd k3sdocker) CreateWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName, config string) error {
+	if err := createKThreesConfigTemplate(ctx, c, name, config); err != nil {
+		return err
+	}
+
+	worker := workerName(name)
+	wmt := dockerv1beta1.DockerMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      worker.Name,
+			Namespace: worker.Namespace,
+		},
+		Spec: dockerv1beta1.DockerMachineTemplateSpec{
+			Template: dockerv1beta1.DockerMachineTemplateResource{
+				Spec: dockerv1beta1.DockerMachineSpec{
+					CustomImage: "kindest/node:v1.30.3-custom",
+				},
+			},
+		},
+	}
+	if err := c.Create(ctx, &wmt); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 func (k3sdocker) DeletePrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error {
 	cm := &corev1.ConfigMap{}
 	err := c.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-k3s-class-lb-config", name.Name), Namespace: name.Namespace}, cm)
@@ -112,6 +137,13 @@ func (kd k3sdocker) GetClusterTemplate(ctx context.Context, c client.Client, nam
 	return c.Get(ctx, name, &dockerv1beta1.DockerClusterTemplate{})
 }
 
+func (kd k3sdocker) GetWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	if err := getWorkerBootstrapTemplate(ctx, c, name, kthreesBootstrapAPIVersion, KThreesConfigTemplate); err != nil {
+		return err
+	}
+	return c.Get(ctx, workerName(name), &dockerv1beta1.DockerMachineTemplate{})
+}
+
 func (rd k3sdocker) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 	cc.Spec.ControlPlane.LocalObjectTemplate.Ref.APIVersion = "controlplane.cluster.x-k8s.io/v1beta2"
 	cc.Spec.ControlPlane.LocalObjectTemplate.Ref.Kind = KThreesControlPlaneTemplate
@@ -119,6 +151,8 @@ func (rd k3sdocker) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 	cc.Spec.ControlPlane.MachineInfrastructure.Ref.Kind = DockerMachineTemplate
 	cc.Spec.Infrastructure.Ref.Kind = DockerClusterTemplate
 
+	alterWorkerClass(cc, kthreesBootstrapAPIVersion, KThreesConfigTemplate, "infrastructure.cluster.x-k8s.io/v1beta1", DockerMachineTemplate)
+
 	cc.Spec.Variables = []capiv1beta1.ClusterClassVariable{
 		{
 			Name: ReadOnly,
@@ -132,6 +166,9 @@ func (rd k3sdocker) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 			},
 		},
 	}
+	cc.Spec.Variables = append(cc.Spec.Variables, nodePoolVariables()...)
+	cc.Spec.Variables = append(cc.Spec.Variables, reservedResourcesVariables()...)
+	cc.Spec.Variables = append(cc.Spec.Variables, cniVariables()...)
 
 	cc.Spec.Patches = []capiv1beta1.ClusterClassPatch{
 		{
@@ -158,5 +195,9 @@ func (rd k3sdocker) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 				},
 			},
 		},
+
+		kthreesNodePoolPatch(cc),
 	}
+	cc.Spec.Patches = append(cc.Spec.Patches, kthreesReservedResourcesPatches(cc)...)
+	cc.Spec.Patches = append(cc.Spec.Patches, kthreesCNIPatches()...)
 }
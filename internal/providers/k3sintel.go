@@ -33,6 +33,8 @@ func (k3sintel) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 	cc.Spec.Infrastructure.Ref.APIVersion = "infrastructure.cluster.x-k8s.io/v1alpha1"
 	cc.Spec.Infrastructure.Ref.Kind = IntelClusterTemplate
 
+	alterWorkerClass(cc, kthreesBootstrapAPIVersion, KThreesConfigTemplate, "infrastructure.cluster.x-k8s.io/v1alpha1", IntelMachineTemplate)
+
 	cc.Spec.Variables = []capiv1beta1.ClusterClassVariable{
 		{
 			Name: connectAgentManifest,
@@ -65,6 +67,9 @@ func (k3sintel) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 			},
 		},
 	}
+	cc.Spec.Variables = append(cc.Spec.Variables, nodePoolVariables()...)
+	cc.Spec.Variables = append(cc.Spec.Variables, reservedResourcesVariables()...)
+	cc.Spec.Variables = append(cc.Spec.Variables, cniVariables()...)
 
 	cc.Spec.Patches = []capiv1beta1.ClusterClassPatch{
 		{
@@ -149,7 +154,11 @@ func (k3sintel) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 				},
 			},
 		},
+
+		kthreesNodePoolPatch(cc),
 	}
+	cc.Spec.Patches = append(cc.Spec.Patches, kthreesReservedResourcesPatches(cc)...)
+	cc.Spec.Patches = append(cc.Spec.Patches, kthreesCNIPatches()...)
 }
 
 func (k3sintel) CreatePrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error {
@@ -214,6 +223,27 @@ func (k3sintel) CreateClusterTemplate(ctx context.Context, c client.Client, name
 	return c.Create(ctx, &uct)
 }
 
+func (k3sintel) CreateWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName, config string) error {
+	if err := createKThreesConfigTemplate(ctx, c, name, config); err != nil {
+		return err
+	}
+
+	worker := workerName(name)
+	wmt := intelv1alpha1.IntelMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      worker.Name,
+			Namespace: worker.Namespace,
+		},
+		Spec: intelv1alpha1.IntelMachineTemplateSpec{
+			Template: intelv1alpha1.IntelMachineTemplateSpecTemplate{},
+		},
+	}
+	if err := c.Create(ctx, &wmt); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 func (k3sintel) DeletePrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error {
 	return nil
 }
@@ -233,3 +263,10 @@ func (k3sintel) GetControlPlaneMachineTemplate(ctx context.Context, c client.Cli
 func (k3sintel) GetClusterTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error {
 	return c.Get(ctx, name, &intelv1alpha1.IntelClusterTemplate{})
 }
+
+func (k3sintel) GetWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	if err := getWorkerBootstrapTemplate(ctx, c, name, kthreesBootstrapAPIVersion, KThreesConfigTemplate); err != nil {
+		return err
+	}
+	return c.Get(ctx, workerName(name), &intelv1alpha1.IntelMachineTemplate{})
+}
@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	kthreescpv1beta2 "github.com/k3s-io/cluster-api-k3s/controlplane/api/v1beta2"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	vspherev1beta1 "sigs.k8s.io/cluster-api-provider-vsphere/apis/v1beta1"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+const (
+	// the size of the virtual machines cloned for the control plane and worker nodes
+	vsphereNumCPUs   = 4
+	vsphereMemoryMiB = 8192
+	vsphereDiskGiB   = 40
+)
+
+type k3svsphere struct {
+}
+
+// vsphereConfig returns the vCenter placement of the ClusterTemplate the templates with the given name are rendered for
+func vsphereConfig(ctx context.Context, c client.Client, name types.NamespacedName) (*v1alpha1.VSphereConfig, error) {
+	var clusterTemplate v1alpha1.ClusterTemplate
+	if err := c.Get(ctx, name, &clusterTemplate); err != nil {
+		return nil, fmt.Errorf("failed to get ClusterTemplate: %w", err)
+	}
+	if clusterTemplate.Spec.VSphere == nil {
+		return nil, fmt.Errorf("ClusterTemplate %s has no vSphere settings", name.Name)
+	}
+	return clusterTemplate.Spec.VSphere, nil
+}
+
+// vsphereMachineTemplate returns the VSphereMachineTemplate cloning the virtual machines of the nodes from the template
+// of the given vCenter placement
+func vsphereMachineTemplate(name types.NamespacedName, config *v1alpha1.VSphereConfig) *vspherev1beta1.VSphereMachineTemplate {
+	return &vspherev1beta1.VSphereMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+		},
+		Spec: vspherev1beta1.VSphereMachineTemplateSpec{
+			Template: vspherev1beta1.VSphereMachineTemplateResource{
+				Spec: vspherev1beta1.VSphereMachineSpec{
+					VirtualMachineCloneSpec: vspherev1beta1.VirtualMachineCloneSpec{
+						Server:       config.Server,
+						Thumbprint:   config.Thumbprint,
+						Datacenter:   config.Datacenter,
+						Datastore:    config.Datastore,
+						Folder:       config.Folder,
+						ResourcePool: config.ResourcePool,
+						Template:     config.Template,
+						CloneMode:    vspherev1beta1.FullClone,
+						Network: vspherev1beta1.NetworkSpec{
+							Devices: []vspherev1beta1.NetworkDeviceSpec{
+								{NetworkName: config.Network, DHCP4: true},
+							},
+						},
+						NumCPUs:   vsphereNumCPUs,
+						MemoryMiB: vsphereMemoryMiB,
+						DiskGiB:   vsphereDiskGiB,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (k3svsphere) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
+	cc.Spec.ControlPlane.LocalObjectTemplate.Ref.APIVersion = "controlplane.cluster.x-k8s.io/v1beta2"
+	cc.Spec.ControlPlane.LocalObjectTemplate.Ref.Kind = KThreesControlPlaneTemplate
+
+	cc.Spec.ControlPlane.MachineInfrastructure.Ref.APIVersion = vspherev1beta1.GroupVersion.String()
+	cc.Spec.ControlPlane.MachineInfrastructure.Ref.Kind = VSphereMachineTemplate
+
+	cc.Spec.Infrastructure.Ref.APIVersion = vspherev1beta1.GroupVersion.String()
+	cc.Spec.Infrastructure.Ref.Kind = VSphereClusterTemplate
+
+	alterWorkerClass(cc, kthreesBootstrapAPIVersion, KThreesConfigTemplate, vspherev1beta1.GroupVersion.String(), VSphereMachineTemplate)
+
+	cc.Spec.Variables = []capiv1beta1.ClusterClassVariable{
+		{
+			Name: connectAgentManifest,
+			Schema: capiv1beta1.VariableSchema{
+				OpenAPIV3Schema: capiv1beta1.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]capiv1beta1.JSONSchemaProps{
+						"path": {
+							Type: "string",
+						},
+						"content": {
+							Type: "string",
+						},
+						"owner": {
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: ReadOnly,
+			Schema: capiv1beta1.VariableSchema{
+				OpenAPIV3Schema: capiv1beta1.JSONSchemaProps{
+					Type: "boolean",
+					Default: &apiextensionsv1.JSON{
+						Raw: []byte("false"),
+					},
+				},
+			},
+		},
+	}
+	cc.Spec.Variables = append(cc.Spec.Variables, nodePoolVariables()...)
+	cc.Spec.Variables = append(cc.Spec.Variables, reservedResourcesVariables()...)
+	cc.Spec.Variables = append(cc.Spec.Variables, cniVariables()...)
+
+	cc.Spec.Patches = []capiv1beta1.ClusterClassPatch{
+		{
+			Name: "connect-agent-manifest",
+			Description: "This patch will add connect-agent manifest " +
+				"injected by Cluster Connect Gateway.",
+			EnabledIf: &connectAgentEnabledIf,
+			Definitions: []capiv1beta1.PatchDefinition{
+				{
+					Selector: capiv1beta1.PatchSelector{
+						APIVersion: "controlplane.cluster.x-k8s.io/v1beta2",
+						Kind:       KThreesControlPlaneTemplate,
+						MatchResources: capiv1beta1.PatchSelectorMatch{
+							ControlPlane: true,
+						},
+					},
+					JSONPatches: []capiv1beta1.JSONPatch{
+						{
+							Op:   "add",
+							Path: "/spec/template/spec/kthreesConfigSpec/files/-",
+							ValueFrom: &capiv1beta1.JSONPatchValue{
+								Variable: &connectAgentManifest,
+							},
+						},
+					},
+				},
+			},
+		},
+
+		{
+			Name:        "airGapped",
+			Description: "This patch will disable air-gapped configuration ",
+			Definitions: []capiv1beta1.PatchDefinition{
+				{
+					Selector: capiv1beta1.PatchSelector{
+						APIVersion: "controlplane.cluster.x-k8s.io/v1beta2",
+						Kind:       KThreesControlPlaneTemplate,
+						MatchResources: capiv1beta1.PatchSelectorMatch{
+							ControlPlane: true,
+						},
+					},
+					JSONPatches: []capiv1beta1.JSONPatch{
+						{
+							Op:   "replace",
+							Path: "/spec/template/spec/kthreesConfigSpec/agentConfig/airGapped",
+							Value: &apiextensionsv1.JSON{
+								Raw: []byte("false"),
+							},
+						},
+					},
+				},
+			},
+		},
+
+		kthreesNodePoolPatch(cc),
+	}
+	cc.Spec.Patches = append(cc.Spec.Patches, kthreesReservedResourcesPatches(cc)...)
+	cc.Spec.Patches = append(cc.Spec.Patches, kthreesCNIPatches()...)
+}
+
+func (k3svsphere) CreatePrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	return nil
+}
+
+func (k3svsphere) CreateControlPlaneTemplate(ctx context.Context, c client.Client, name types.NamespacedName, config string) error {
+	var cpt kthreescpv1beta2.KThreesControlPlaneTemplate
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+
+	cpt.ObjectMeta = metav1.ObjectMeta{
+		Name:      name.Name,
+		Namespace: name.Namespace,
+	}
+
+	if err := c.Create(ctx, &cpt); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create KThreesControlPlaneTemplate: %w", err)
+	}
+	return nil
+}
+
+func (k3svsphere) CreateControlPlaneMachineTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	config, err := vsphereConfig(ctx, c, name)
+	if err != nil {
+		return err
+	}
+	return c.Create(ctx, vsphereMachineTemplate(types.NamespacedName{Name: name.Name + "-controlplane", Namespace: name.Namespace}, config))
+}
+
+func (k3svsphere) CreateClusterTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	config, err := vsphereConfig(ctx, c, name)
+	if err != nil {
+		return err
+	}
+
+	ct := vspherev1beta1.VSphereClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+		},
+		Spec: vspherev1beta1.VSphereClusterTemplateSpec{
+			Template: vspherev1beta1.VSphereClusterTemplateResource{
+				Spec: vspherev1beta1.VSphereClusterSpec{
+					Server:     config.Server,
+					Thumbprint: config.Thumbprint,
+				},
+			},
+		},
+	}
+	if config.CredentialsSecret != "" {
+		ct.Spec.Template.Spec.IdentityRef = &vspherev1beta1.VSphereIdentityReference{
+			Kind: vspherev1beta1.SecretKind,
+			Name: config.CredentialsSecret,
+		}
+	}
+	return c.Create(ctx, &ct)
+}
+
+func (k3svsphere) CreateWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName, config string) error {
+	if err := createKThreesConfigTemplate(ctx, c, name, config); err != nil {
+		return err
+	}
+
+	vsphere, err := vsphereConfig(ctx, c, name)
+	if err != nil {
+		return err
+	}
+	if err := c.Create(ctx, vsphereMachineTemplate(workerName(name), vsphere)); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (k3svsphere) DeletePrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	return nil
+}
+
+func (k3svsphere) GetPrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	return nil
+}
+
+func (k3svsphere) GetControlPlaneTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	return c.Get(ctx, name, &kthreescpv1beta2.KThreesControlPlaneTemplate{})
+}
+
+func (k3svsphere) GetControlPlaneMachineTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	return c.Get(ctx, types.NamespacedName{Name: name.Name + "-controlplane", Namespace: name.Namespace}, &vspherev1beta1.VSphereMachineTemplate{})
+}
+
+func (k3svsphere) GetClusterTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	return c.Get(ctx, name, &vspherev1beta1.VSphereClusterTemplate{})
+}
+
+func (k3svsphere) GetWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	if err := getWorkerBootstrapTemplate(ctx, c, name, kthreesBootstrapAPIVersion, KThreesConfigTemplate); err != nil {
+		return err
+	}
+	return c.Get(ctx, workerName(name), &vspherev1beta1.VSphereMachineTemplate{})
+}
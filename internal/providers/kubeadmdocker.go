@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kubeadmcpv1beta1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta1"
@@ -71,6 +72,29 @@ func (kd kubeadmdocker) CreateClusterTemplate(ctx context.Context, c client.Clie
 	return c.Create(ctx, &ct)
 }
 
+func (kd kubeadmdocker) CreateWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName, config string) error {
+	if err := createKubeadmConfigTemplate(ctx, c, name, config); err != nil {
+		return err
+	}
+
+	worker := workerName(name)
+	wmt := dockerv1beta1.DockerMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      worker.Name,
+			Namespace: worker.Namespace,
+		},
+		Spec: dockerv1beta1.DockerMachineTemplateSpec{
+			Template: dockerv1beta1.DockerMachineTemplateResource{
+				Spec: dockerv1beta1.DockerMachineSpec{},
+			},
+		},
+	}
+	if err := c.Create(ctx, &wmt); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 func (kubeadmdocker) DeletePrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error {
 	return nil
 }
@@ -92,11 +116,20 @@ func (kubeadmdocker) GetClusterTemplate(ctx context.Context, c client.Client, na
 	return c.Get(ctx, name, &dockerv1beta1.DockerClusterTemplate{})
 }
 
+func (kubeadmdocker) GetWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName) error {
+	if err := getWorkerBootstrapTemplate(ctx, c, name, kubeadmBootstrapAPIVersion, KubeadmConfigTemplate); err != nil {
+		return err
+	}
+	return c.Get(ctx, workerName(name), &dockerv1beta1.DockerMachineTemplate{})
+}
+
 func (kubeadmdocker) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 	cc.Spec.ControlPlane.LocalObjectTemplate.Ref.Kind = KubeadmControlPlaneTemplate
 	cc.Spec.ControlPlane.MachineInfrastructure.Ref.Kind = DockerMachineTemplate
 	cc.Spec.Infrastructure.Ref.Kind = DockerClusterTemplate
 
+	alterWorkerClass(cc, kubeadmBootstrapAPIVersion, KubeadmConfigTemplate, "infrastructure.cluster.x-k8s.io/v1beta1", DockerMachineTemplate)
+
 	imageVariable := "dockerKindImage"
 
 	cc.Spec.Variables = []capiv1beta1.ClusterClassVariable{
@@ -110,6 +143,8 @@ func (kubeadmdocker) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 			},
 		},
 	}
+	cc.Spec.Variables = append(cc.Spec.Variables, nodePoolVariables()...)
+	cc.Spec.Variables = append(cc.Spec.Variables, reservedResourcesVariables()...)
 
 	cc.Spec.Patches = []capiv1beta1.ClusterClassPatch{
 		{
@@ -136,5 +171,8 @@ func (kubeadmdocker) AlterClusterClass(cc *capiv1beta1.ClusterClass) {
 			Description: "Sets the container image that is used for running dockerMachines for the controlPlane.",
 			Name:        imageVariable,
 		},
+
+		kubeadmNodePoolPatch(cc),
 	}
+	cc.Spec.Patches = append(cc.Spec.Patches, kubeadmReservedResourcesPatches(cc)...)
 }
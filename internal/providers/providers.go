@@ -5,6 +5,9 @@ package providers
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -18,9 +21,15 @@ const (
 	IntelMachineTemplate = "IntelMachineTemplate"
 	IntelClusterTemplate = "IntelClusterTemplate"
 
+	VSphereMachineTemplate = "VSphereMachineTemplate"
+	VSphereClusterTemplate = "VSphereClusterTemplate"
+
 	KubeadmControlPlaneTemplate = "KubeadmControlPlaneTemplate"
 	KThreesControlPlaneTemplate = "KThreesControlPlaneTemplate"
 
+	KubeadmConfigTemplate = "KubeadmConfigTemplate"
+	KThreesConfigTemplate = "KThreesConfigTemplate"
+
 	DefaultProvider = "k3s"
 )
 
@@ -31,6 +40,7 @@ type Provider interface {
 	CreateControlPlaneTemplate(ctx context.Context, c client.Client, name types.NamespacedName, config string) error
 	CreateControlPlaneMachineTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error
 	CreateClusterTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error
+	CreateWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName, config string) error
 
 	DeletePrerequisites(ctx context.Context, c client.Client, name types.NamespacedName) error
 
@@ -38,6 +48,7 @@ type Provider interface {
 	GetControlPlaneTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error
 	GetControlPlaneMachineTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error
 	GetClusterTemplate(ctx context.Context, c client.Client, name types.NamespacedName) error
+	GetWorkerTemplates(ctx context.Context, c client.Client, name types.NamespacedName) error
 }
 
 var (
@@ -49,10 +60,16 @@ var (
 		"kubeadm:docker": kubeadmdocker{},
 		"k3s:intel":      k3sintel{},
 		"k3s:docker":     k3sdocker{},
+		"k3s:vsphere":    k3svsphere{},
 	}
 
 	ReadOnly = "readOnly"
 
+	// NodePoolLabels and NodePoolTaints are set per worker node pool as MachineDeployment variable overrides,
+	// in the k3s format of "key=value" for labels and "key=value:Effect" for taints
+	NodePoolLabels = "nodePoolLabels"
+	NodePoolTaints = "nodePoolTaints"
+
 	// List of officially supported control plane types
 	// TODO: make the provider list configurable
 	ControlPlaneProviders = []string{
@@ -62,9 +79,71 @@ var (
 	InfraProviders = []string{
 		"docker",
 		"intel",
+		"vsphere",
+	}
+
+	// controlPlaneReplicas lists the supported control plane sizes for each control plane provider;
+	// the etcd members on the control plane nodes need an odd count to keep quorum
+	controlPlaneReplicas = map[string][]int32{
+		"kubeadm": {1, 3, 5},
+		"k3s":     {1, 3, 5},
+		"rke2":    {1, 3, 5},
 	}
+
+	// dedicatedEtcdNodes lists the control plane providers that can run the etcd members on dedicated etcd nodes apart
+	// from the control plane nodes; k3s and kubeadm run them on the control plane nodes
+	dedicatedEtcdNodes = map[string]bool{
+		"rke2": true,
+	}
+)
+
+// Node roles of the clusters, see the roles of the NodeSpec of the API
+const (
+	NodeRoleAll          = "all"
+	NodeRoleControlPlane = "controlplane"
+	NodeRoleWorker       = "worker"
+	NodeRoleEtcd         = "etcd"
 )
 
+// ValidateNodeRole returns an error if the control plane provider does not support nodes of the given role
+func ValidateNodeRole(controlPlaneProvider, role string) error {
+	switch role {
+	// the role defaults to all
+	case "", NodeRoleAll, NodeRoleControlPlane, NodeRoleWorker:
+		return nil
+	case NodeRoleEtcd:
+		if !dedicatedEtcdNodes[controlPlaneProvider] {
+			return fmt.Errorf("dedicated etcd nodes are not supported by %s, its etcd members run on the control plane nodes", controlPlaneProvider)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown node role %q", role)
+}
+
+// ValidateControlPlaneReplicas returns an error if the control plane provider does not support the given number of control plane nodes
+func ValidateControlPlaneReplicas(controlPlaneProvider string, replicas int32) error {
+	supported, ok := controlPlaneReplicas[controlPlaneProvider]
+	if !ok {
+		return fmt.Errorf("unsupported control plane provider %q", controlPlaneProvider)
+	}
+	if !slices.Contains(supported, replicas) {
+		return fmt.Errorf("%d control plane nodes are not supported by %s, supported counts are %v", replicas, controlPlaneProvider, supported)
+	}
+	return nil
+}
+
+// InfraProvidersOf returns the infra providers the control plane provider can be combined with, sorted by name
+func InfraProvidersOf(controlPlaneProvider string) []string {
+	infraProviders := []string{}
+	for key := range providerRegistry {
+		if cp, infra, _ := strings.Cut(key, ":"); cp == controlPlaneProvider {
+			infraProviders = append(infraProviders, infra)
+		}
+	}
+	slices.Sort(infraProviders)
+	return infraProviders
+}
+
 func GetCapiProvider(controlPlaneProvider, infraProvider string) Provider {
 	key := controlPlaneProvider + ":" + infraProvider
 	return providerRegistry[key]
@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+const (
+	systemReservedArg = "system-reserved"
+	kubeReservedArg   = "kube-reserved"
+)
+
+var (
+	// SystemReserved and KubeReserved are set per cluster to override the resources reserved by the template, in the
+	// kubelet format of "cpu=500m,memory=1Gi"
+	SystemReserved = "systemReserved"
+	KubeReserved   = "kubeReserved"
+
+	systemReservedEnabledIf = "{{ if .systemReserved }}true{{ end }}"
+	kubeReservedEnabledIf   = "{{ if .kubeReserved }}true{{ end }}"
+)
+
+// ValidateReservedResources returns an error if an amount of reserved resources is not a non-negative quantity
+func ValidateReservedResources(reserved *v1alpha1.ReservedResources) error {
+	if reserved == nil {
+		return nil
+	}
+	for name, reservation := range map[string]*v1alpha1.ResourceReservation{"system": reserved.System, "kube": reserved.Kube} {
+		if reservation == nil {
+			continue
+		}
+		for resourceName, amount := range map[string]string{"cpu": reservation.CPU, "memory": reservation.Memory} {
+			if amount == "" {
+				continue
+			}
+			quantity, err := resource.ParseQuantity(amount)
+			if err != nil {
+				return fmt.Errorf("invalid %s reserved %s %q: %w", name, resourceName, amount, err)
+			}
+			if quantity.Sign() < 0 {
+				return fmt.Errorf("invalid %s reserved %s %q: must not be negative", name, resourceName, amount)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateMinNodeResources returns an error if an amount of the minimum resources of the hosts is not a positive
+// quantity
+func ValidateMinNodeResources(minimum *v1alpha1.ResourceReservation) error {
+	if minimum == nil {
+		return nil
+	}
+	for resourceName, amount := range map[string]string{"cpu": minimum.CPU, "memory": minimum.Memory} {
+		if amount == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(amount)
+		if err != nil {
+			return fmt.Errorf("invalid minimum node %s %q: %w", resourceName, amount, err)
+		}
+		if quantity.Sign() <= 0 {
+			return fmt.Errorf("invalid minimum node %s %q: must be positive", resourceName, amount)
+		}
+	}
+	return nil
+}
+
+// RenderReservedResources returns the control plane template with the reserved resources of the cluster template set
+// as kubelet arguments of the nodes: kubeletArgs of the k3s agent configuration and kubeletExtraArgs of the kubeadm
+// init and join configurations. The worker templates are derived from the rendered control plane template, so they
+// reserve the same resources.
+func RenderReservedResources(providerType, config string, reserved *v1alpha1.ReservedResources) (string, error) {
+	args := reservedResourcesArgs(reserved)
+	if len(args) == 0 {
+		return config, nil
+	}
+
+	var cpt map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return "", fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+
+	if providerType == "kubeadm" {
+		for _, configuration := range []string{"initConfiguration", "joinConfiguration"} {
+			path := []string{"spec", "template", "spec", "kubeadmConfigSpec", configuration, "nodeRegistration", "kubeletExtraArgs"}
+			kubeletArgs, _, err := unstructured.NestedStringMap(cpt, path...)
+			if err != nil {
+				return "", fmt.Errorf("failed to read kubelet arguments of %s: %w", configuration, err)
+			}
+			if kubeletArgs == nil {
+				kubeletArgs = map[string]string{}
+			}
+			for _, arg := range args {
+				kubeletArgs[arg[0]] = arg[1]
+			}
+			if err := unstructured.SetNestedStringMap(cpt, kubeletArgs, path...); err != nil {
+				return "", fmt.Errorf("failed to set kubelet arguments of %s: %w", configuration, err)
+			}
+		}
+	} else {
+		path := []string{"spec", "template", "spec", "kthreesConfigSpec", "agentConfig", "kubeletArgs"}
+		kubeletArgs, _, err := unstructured.NestedStringSlice(cpt, path...)
+		if err != nil {
+			return "", fmt.Errorf("failed to read kubelet arguments: %w", err)
+		}
+		for _, arg := range args {
+			kubeletArgs = append(kubeletArgs, arg[0]+"="+arg[1])
+		}
+		if err := unstructured.SetNestedStringSlice(cpt, kubeletArgs, path...); err != nil {
+			return "", fmt.Errorf("failed to set kubelet arguments: %w", err)
+		}
+	}
+
+	rendered, err := json.Marshal(cpt)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal control plane template: %w", err)
+	}
+	return string(rendered), nil
+}
+
+// reservedResourcesArgs returns the kubelet arguments and their values reserving the resources
+func reservedResourcesArgs(reserved *v1alpha1.ReservedResources) [][2]string {
+	if reserved == nil {
+		return nil
+	}
+	args := [][2]string{}
+	if value := reserved.System.KubeletValue(); value != "" {
+		args = append(args, [2]string{systemReservedArg, value})
+	}
+	if value := reserved.Kube.KubeletValue(); value != "" {
+		args = append(args, [2]string{kubeReservedArg, value})
+	}
+	return args
+}
+
+// reservedResourcesVariables declares the variables overriding the resources reserved by the template
+func reservedResourcesVariables() []capiv1beta1.ClusterClassVariable {
+	variables := []capiv1beta1.ClusterClassVariable{}
+	for _, name := range []string{SystemReserved, KubeReserved} {
+		variables = append(variables, capiv1beta1.ClusterClassVariable{
+			Name: name,
+			Schema: capiv1beta1.VariableSchema{
+				OpenAPIV3Schema: capiv1beta1.JSONSchemaProps{
+					Type: "string",
+				},
+			},
+		})
+	}
+	return variables
+}
+
+// kthreesReservedResourcesPatches append the reserved resources of the cluster to the kubelet arguments of the k3s
+// nodes; k3s passes the arguments in order, so they take precedence over the ones of the template. The patches assume
+// the template reserves resources, so that the kubelet arguments exist.
+func kthreesReservedResourcesPatches(cc *capiv1beta1.ClusterClass) []capiv1beta1.ClusterClassPatch {
+	patches := []capiv1beta1.ClusterClassPatch{}
+	for _, reservation := range []struct{ variable, enabledIf, arg string }{
+		{SystemReserved, systemReservedEnabledIf, systemReservedArg},
+		{KubeReserved, kubeReservedEnabledIf, kubeReservedArg},
+	} {
+		value := fmt.Sprintf(`"%s={{ .%s }}"`, reservation.arg, reservation.variable)
+		patches = append(patches, capiv1beta1.ClusterClassPatch{
+			Name:        reservation.variable,
+			Description: fmt.Sprintf("This patch will set the kubelet %s resources of the cluster.", reservation.arg),
+			EnabledIf:   &reservation.enabledIf,
+			Definitions: []capiv1beta1.PatchDefinition{
+				{
+					Selector: capiv1beta1.PatchSelector{
+						APIVersion: "controlplane.cluster.x-k8s.io/v1beta2",
+						Kind:       KThreesControlPlaneTemplate,
+						MatchResources: capiv1beta1.PatchSelectorMatch{
+							ControlPlane: true,
+						},
+					},
+					JSONPatches: []capiv1beta1.JSONPatch{
+						{
+							Op:        "add",
+							Path:      "/spec/template/spec/kthreesConfigSpec/agentConfig/kubeletArgs/-",
+							ValueFrom: &capiv1beta1.JSONPatchValue{Template: &value},
+						},
+					},
+				},
+				{
+					Selector: capiv1beta1.PatchSelector{
+						APIVersion: kthreesBootstrapAPIVersion,
+						Kind:       KThreesConfigTemplate,
+						MatchResources: capiv1beta1.PatchSelectorMatch{
+							MachineDeploymentClass: &capiv1beta1.PatchSelectorMatchMachineDeploymentClass{
+								Names: workerClassNames(cc),
+							},
+						},
+					},
+					JSONPatches: []capiv1beta1.JSONPatch{
+						{
+							Op:        "add",
+							Path:      "/spec/template/spec/agentConfig/kubeletArgs/-",
+							ValueFrom: &capiv1beta1.JSONPatchValue{Template: &value},
+						},
+					},
+				},
+			},
+		})
+	}
+	return patches
+}
+
+// kubeadmReservedResourcesPatches set the reserved resources of the cluster as kubelet arguments of the kubeadm nodes.
+// The patches assume the template reserves resources, so that the kubelet arguments of the control plane exist.
+func kubeadmReservedResourcesPatches(cc *capiv1beta1.ClusterClass) []capiv1beta1.ClusterClassPatch {
+	patches := []capiv1beta1.ClusterClassPatch{}
+	for _, reservation := range []struct{ variable, enabledIf, arg string }{
+		{SystemReserved, systemReservedEnabledIf, systemReservedArg},
+		{KubeReserved, kubeReservedEnabledIf, kubeReservedArg},
+	} {
+		patches = append(patches, capiv1beta1.ClusterClassPatch{
+			Name:        reservation.variable,
+			Description: fmt.Sprintf("This patch will set the kubelet %s resources of the cluster.", reservation.arg),
+			EnabledIf:   &reservation.enabledIf,
+			Definitions: []capiv1beta1.PatchDefinition{
+				{
+					Selector: capiv1beta1.PatchSelector{
+						APIVersion: "controlplane.cluster.x-k8s.io/v1beta1",
+						Kind:       KubeadmControlPlaneTemplate,
+						MatchResources: capiv1beta1.PatchSelectorMatch{
+							ControlPlane: true,
+						},
+					},
+					JSONPatches: []capiv1beta1.JSONPatch{
+						{
+							Op:        "add",
+							Path:      "/spec/template/spec/kubeadmConfigSpec/initConfiguration/nodeRegistration/kubeletExtraArgs/" + reservation.arg,
+							ValueFrom: &capiv1beta1.JSONPatchValue{Variable: &reservation.variable},
+						},
+						{
+							Op:        "add",
+							Path:      "/spec/template/spec/kubeadmConfigSpec/joinConfiguration/nodeRegistration/kubeletExtraArgs/" + reservation.arg,
+							ValueFrom: &capiv1beta1.JSONPatchValue{Variable: &reservation.variable},
+						},
+					},
+				},
+				{
+					Selector: capiv1beta1.PatchSelector{
+						APIVersion: kubeadmBootstrapAPIVersion,
+						Kind:       KubeadmConfigTemplate,
+						MatchResources: capiv1beta1.PatchSelectorMatch{
+							MachineDeploymentClass: &capiv1beta1.PatchSelectorMatchMachineDeploymentClass{
+								Names: workerClassNames(cc),
+							},
+						},
+					},
+					JSONPatches: []capiv1beta1.JSONPatch{
+						{
+							Op:        "add",
+							Path:      "/spec/template/spec/joinConfiguration/nodeRegistration/kubeletExtraArgs/" + reservation.arg,
+							ValueFrom: &capiv1beta1.JSONPatchValue{Variable: &reservation.variable},
+						},
+					},
+				},
+			},
+		})
+	}
+	return patches
+}
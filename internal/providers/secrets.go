@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the values of the resolved secret references in errors and logs
+const RedactedValue = "[REDACTED]"
+
+const secretReferencePrefix = "${secret:"
+
+// secretReferencePattern matches the references to a key of a Secret in the namespace of the template, e.g.
+// ${secret:registry-credentials/password}
+var secretReferencePattern = regexp.MustCompile(`\$\{secret:([a-z0-9]([-a-z0-9.]*[a-z0-9])?)/([-._a-zA-Z0-9]+)\}`)
+
+// SecretReference is a key of a Secret referenced by the cluster configuration of a template
+type SecretReference struct {
+	Name string
+	Key  string
+}
+
+func (ref SecretReference) String() string {
+	return ref.Name + "/" + ref.Key
+}
+
+// SecretLookup returns the value of the referenced key of a Secret in the namespace of the template
+type SecretLookup func(ref SecretReference) ([]byte, error)
+
+// ValidateSecretReferences checks every secret reference of the cluster configuration is well-formed, so that a
+// misspelled reference is not rendered verbatim into the bootstrap configuration of the nodes
+func ValidateSecretReferences(config string) error {
+	unmatched := secretReferencePattern.ReplaceAllString(config, "")
+	i := strings.Index(unmatched, secretReferencePrefix)
+	if i < 0 {
+		return nil
+	}
+
+	reference := unmatched[i:]
+	if end := strings.IndexAny(reference, `}"`); end >= 0 {
+		reference = reference[:end+1]
+	}
+	return fmt.Errorf("invalid secret reference %q: expected ${secret:<name>/<key>} with the name of a Secret in the namespace of the template", reference)
+}
+
+// ResolveSecretReferences returns the control plane template with its secret references replaced by the values of
+// the referenced keys, escaped for the JSON string they are part of. The resolved values are returned as well, so that
+// they can be redacted from the errors of the calls the rendered template is passed to, see RedactError; the errors of
+// the lookups only name the references.
+func ResolveSecretReferences(config string, lookup SecretLookup) (string, []string, error) {
+	var secrets []string
+	var errs []error
+	resolved := secretReferencePattern.ReplaceAllStringFunc(config, func(match string) string {
+		groups := secretReferencePattern.FindStringSubmatch(match)
+		ref := SecretReference{Name: groups[1], Key: groups[3]}
+		value, err := lookup(ref)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve secret reference %s: %w", ref, err))
+			return match
+		}
+
+		quoted, err := json.Marshal(string(value))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to escape secret reference %s: %w", ref, err))
+			return match
+		}
+		escaped := string(quoted[1 : len(quoted)-1])
+		secrets = append(secrets, string(value), escaped)
+		return escaped
+	})
+	if len(errs) > 0 {
+		return "", nil, errors.Join(errs...)
+	}
+	return resolved, secrets, nil
+}
+
+// Redact returns s with the given secret values replaced by RedactedValue
+func Redact(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, RedactedValue)
+		}
+	}
+	return s
+}
+
+// RedactError returns the error with the given secret values redacted from its message, e.g. an error of the API
+// server quoting the invalid value of a field of the rendered template
+func RedactError(err error, secrets []string) error {
+	if err == nil || len(secrets) == 0 {
+		return err
+	}
+	return redactedError{err: err, secrets: secrets}
+}
+
+type redactedError struct {
+	err     error
+	secrets []string
+}
+
+func (e redactedError) Error() string {
+	return Redact(e.err.Error(), e.secrets)
+}
+
+func (e redactedError) Unwrap() error {
+	return e.err
+}
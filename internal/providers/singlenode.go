@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+const (
+	// DefaultEtcdSnapshotDir is where k3s writes the etcd snapshots by default
+	DefaultEtcdSnapshotDir = "/var/lib/rancher/k3s/server/db/snapshots"
+	// DefaultEtcdSnapshotSchedule and DefaultEtcdSnapshotRetention are the schedule and retention of the etcd
+	// snapshots of single-node clusters whose template does not set them
+	DefaultEtcdSnapshotSchedule  = "0 */6 * * *"
+	DefaultEtcdSnapshotRetention = 5
+
+	// singleNodeConfigPath is a k3s config drop-in, which k3s merges into its configuration
+	singleNodeConfigPath = "/etc/rancher/k3s/config.yaml.d/60-single-node.yaml"
+	rejoinScriptPath     = "/usr/local/bin/k3s-rejoin.sh"
+	nodePasswordDir      = "/etc/rancher/node"
+	nodePasswordPath     = nodePasswordDir + "/password"
+	// savedNodePassword is the name of the copy of the node password in the etcd snapshot directory; the snapshots
+	// hold the node password secret of the node, which k3s compares with the node password when the node registers
+	savedNodePassword = "node-password"
+)
+
+var (
+	// controlPlaneTaints are the taints keeping the workloads off the control plane nodes
+	controlPlaneTaints = []string{"node-role.kubernetes.io/control-plane", "node-role.kubernetes.io/master", "CriticalAddonsOnly"}
+
+	snapshotDirPattern = regexp.MustCompile(`^/[A-Za-z0-9._/-]*$`)
+	cronFieldPattern   = regexp.MustCompile(`^[0-9*/,-]+$`)
+)
+
+// ValidateSingleNode returns an error if the single-node settings cannot be rendered into the k3s configuration of
+// the node
+func ValidateSingleNode(providerType string, singleNode *v1alpha1.SingleNodeConfig) error {
+	if singleNode == nil {
+		return nil
+	}
+	if providerType == "kubeadm" {
+		return fmt.Errorf("single-node mode is only supported by the k3s control plane provider")
+	}
+	// the directory is part of the commands of the node, so it is restricted to characters that need no quoting
+	if dir := singleNode.EtcdSnapshotDir; dir != "" && (!snapshotDirPattern.MatchString(dir) || strings.Contains(dir, "..")) {
+		return fmt.Errorf("invalid etcd snapshot directory %q: must be an absolute path of letters, digits and . _ / -", dir)
+	}
+	if schedule := singleNode.EtcdSnapshotSchedule; schedule != "" {
+		fields := strings.Fields(schedule)
+		if len(fields) != 5 {
+			return fmt.Errorf("invalid etcd snapshot schedule %q: must have 5 cron fields", schedule)
+		}
+		for _, field := range fields {
+			if !cronFieldPattern.MatchString(field) {
+				return fmt.Errorf("invalid etcd snapshot schedule %q: invalid cron field %q", schedule, field)
+			}
+		}
+	}
+	if singleNode.EtcdSnapshotRetention < 0 {
+		return fmt.Errorf("invalid etcd snapshot retention %d: must be positive", singleNode.EtcdSnapshotRetention)
+	}
+	switch singleNode.RejoinPolicy {
+	case "", v1alpha1.RejoinPolicyRestore, v1alpha1.RejoinPolicyNone:
+	default:
+		return fmt.Errorf("invalid rejoin policy %q: must be %s or %s", singleNode.RejoinPolicy, v1alpha1.RejoinPolicyRestore, v1alpha1.RejoinPolicyNone)
+	}
+	return nil
+}
+
+// RenderSingleNode returns the k3s control plane template with the single-node settings of the cluster template
+// applied. The control plane taints are removed, so that the workloads run on the node, and a k3s config drop-in
+// writes the etcd snapshots to the local directory. With the restore rejoin policy, the node password is kept next to
+// the snapshots and a reimaged host restores the cluster from its latest snapshot once k3s is installed, so that it
+// rejoins its cluster with its workloads. The worker templates are derived from the rendered control plane template
+// without the single-node settings, see stripSingleNode.
+func RenderSingleNode(providerType, config string, singleNode *v1alpha1.SingleNodeConfig) (string, error) {
+	if singleNode == nil {
+		return config, nil
+	}
+	if err := ValidateSingleNode(providerType, singleNode); err != nil {
+		return "", err
+	}
+
+	var cpt map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return "", fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+	specPath := []string{"spec", "template", "spec", "kthreesConfigSpec"}
+
+	taints, _, err := unstructured.NestedStringSlice(cpt, append(specPath, "agentConfig", "nodeTaints")...)
+	if err != nil {
+		return "", fmt.Errorf("failed to read node taints: %w", err)
+	}
+	if len(taints) > 0 {
+		kept := []string{}
+		for _, taint := range taints {
+			if !isControlPlaneTaint(taint) {
+				kept = append(kept, taint)
+			}
+		}
+		if err := unstructured.SetNestedStringSlice(cpt, kept, append(specPath, "agentConfig", "nodeTaints")...); err != nil {
+			return "", fmt.Errorf("failed to set node taints: %w", err)
+		}
+	}
+
+	dir := singleNodeSnapshotDir(singleNode)
+	schedule := singleNode.EtcdSnapshotSchedule
+	if schedule == "" {
+		schedule = DefaultEtcdSnapshotSchedule
+	}
+	retention := int(singleNode.EtcdSnapshotRetention)
+	if retention == 0 {
+		retention = DefaultEtcdSnapshotRetention
+	}
+	files := []interface{}{
+		map[string]interface{}{
+			"path":        singleNodeConfigPath,
+			"owner":       "root:root",
+			"permissions": "0600",
+			"content":     fmt.Sprintf("etcd-snapshot-dir: %s\netcd-snapshot-schedule-cron: %q\netcd-snapshot-retention: %d\n", dir, schedule, retention),
+		},
+	}
+	var preCommands, postCommands []string
+	if singleNode.RejoinPolicy != v1alpha1.RejoinPolicyNone {
+		files = append(files, map[string]interface{}{
+			"path":        rejoinScriptPath,
+			"owner":       "root:root",
+			"permissions": "0700",
+			"content":     rejoinScript(dir),
+		})
+		// k3s registers the node with the password of the node before the cluster is restored, so a reimaged host
+		// must register with the password of the node password secret of the snapshot
+		preCommands = append(preCommands, fmt.Sprintf("if [ -f %[1]s/%[2]s ]; then mkdir -p %[3]s && cp %[1]s/%[2]s %[4]s; fi",
+			dir, savedNodePassword, nodePasswordDir, nodePasswordPath))
+		postCommands = append(postCommands, rejoinScriptPath)
+	}
+
+	existingFiles, _, err := unstructured.NestedSlice(cpt, append(specPath, "files")...)
+	if err != nil {
+		return "", fmt.Errorf("failed to read files: %w", err)
+	}
+	if err := unstructured.SetNestedSlice(cpt, append(existingFiles, files...), append(specPath, "files")...); err != nil {
+		return "", fmt.Errorf("failed to set files: %w", err)
+	}
+	for field, commands := range map[string][]string{"preK3sCommands": preCommands, "postK3sCommands": postCommands} {
+		if len(commands) == 0 {
+			continue
+		}
+		existingCommands, _, err := unstructured.NestedStringSlice(cpt, append(specPath, field)...)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", field, err)
+		}
+		if field == "preK3sCommands" {
+			commands = append(commands, existingCommands...)
+		} else {
+			commands = append(existingCommands, commands...)
+		}
+		if err := unstructured.SetNestedStringSlice(cpt, commands, append(specPath, field)...); err != nil {
+			return "", fmt.Errorf("failed to set %s: %w", field, err)
+		}
+	}
+
+	rendered, err := json.Marshal(cpt)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal control plane template: %w", err)
+	}
+	return string(rendered), nil
+}
+
+// rejoinScript returns the script run once k3s is installed on the node: if the snapshot directory holds a snapshot,
+// the host was reimaged and the cluster is restored from the latest snapshot. The node password is saved next to the
+// snapshots for the next reimage once the node registered.
+func rejoinScript(dir string) string {
+	return fmt.Sprintf(`#!/bin/sh
+set -eu
+dir=%[1]s
+snapshot=$(ls -1t "$dir"/etcd-snapshot-* 2>/dev/null | head -n 1)
+if [ -n "$snapshot" ]; then
+  k3s=$(command -v k3s || echo /var/lib/rancher/k3s/bin/k3s)
+  systemctl stop k3s-server 2>/dev/null || systemctl stop k3s
+  "$k3s" server --cluster-reset --cluster-reset-restore-path="$snapshot"
+  systemctl start k3s-server 2>/dev/null || systemctl start k3s
+  echo "cluster restored from $snapshot"
+fi
+for i in $(seq 60); do
+  [ -f %[2]s ] && break
+  sleep 5
+done
+if [ -f %[2]s ]; then
+  mkdir -p "$dir"
+  install -m 0600 %[2]s "$dir"/%[3]s
+else
+  echo "node password not found, a reimaged host cannot rejoin the cluster" >&2
+fi
+`, dir, nodePasswordPath, savedNodePassword)
+}
+
+// stripSingleNode removes the single-node files and commands rendered by RenderSingleNode from the k3s configuration
+// of a worker bootstrap template, they only apply to the control plane node
+func stripSingleNode(spec map[string]interface{}) {
+	if files, ok := spec["files"].([]interface{}); ok {
+		kept := []interface{}{}
+		for _, f := range files {
+			if file, ok := f.(map[string]interface{}); ok && (file["path"] == singleNodeConfigPath || file["path"] == rejoinScriptPath) {
+				continue
+			}
+			kept = append(kept, f)
+		}
+		spec["files"] = kept
+	}
+	for _, field := range []string{"preK3sCommands", "postK3sCommands"} {
+		commands, ok := spec[field].([]interface{})
+		if !ok {
+			continue
+		}
+		kept := []interface{}{}
+		for _, c := range commands {
+			if command, ok := c.(string); ok && (command == rejoinScriptPath || strings.Contains(command, "/"+savedNodePassword)) {
+				continue
+			}
+			kept = append(kept, c)
+		}
+		spec[field] = kept
+	}
+}
+
+func singleNodeSnapshotDir(singleNode *v1alpha1.SingleNodeConfig) string {
+	if singleNode.EtcdSnapshotDir == "" {
+		return DefaultEtcdSnapshotDir
+	}
+	return strings.TrimSuffix(singleNode.EtcdSnapshotDir, "/")
+}
+
+// isControlPlaneTaint returns whether the k3s node taint, in the format key=value:effect, keeps the workloads off the
+// control plane nodes
+func isControlPlaneTaint(taint string) bool {
+	key, _, _ := strings.Cut(taint, ":")
+	key, _, _ = strings.Cut(key, "=")
+	for _, controlPlaneTaint := range controlPlaneTaints {
+		if key == controlPlaneTaint {
+			return true
+		}
+	}
+	return false
+}
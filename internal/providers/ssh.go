@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+)
+
+const (
+	// DefaultSSHUser is the user the authorized keys are installed for if the template does not name one
+	DefaultSSHUser = "breakglass"
+
+	sshTrustedUserCAKeysPath = "/etc/ssh/trusted-user-ca-keys.pub"
+	sshAuthorizedKeysDir     = "/etc/ssh/authorized_keys"
+	// sshdConfigPath is an sshd drop-in, which takes precedence over the main configuration since sshd uses the first
+	// value of an option and the drop-ins are included at the top of sshd_config
+	sshdConfigPath    = "/etc/ssh/sshd_config.d/60-cluster-manager.conf"
+	sshdReloadCommand = "systemctl reload ssh || systemctl reload sshd"
+)
+
+// RenderClusterConfiguration returns the control plane template of the cluster template with the node settings of the
+// template applied, see RenderAirGap, RenderKubeadmAirGap, RenderSSHAccess, RenderReservedResources and
+// RenderSingleNode
+func RenderClusterConfiguration(spec v1alpha1.ClusterTemplateSpec) (string, error) {
+	config := spec.ClusterConfiguration
+	var err error
+	switch {
+	case spec.ControlPlaneProviderType == "kubeadm" && spec.AirGapped:
+		config, err = RenderKubeadmAirGap(config, spec.AirGap)
+	case spec.ControlPlaneProviderType != "kubeadm":
+		config, err = RenderAirGap(config, spec.AirGap)
+	}
+	if err != nil {
+		return "", err
+	}
+	if config, err = RenderSSHAccess(spec.ControlPlaneProviderType, config, spec.SSHAccess); err != nil {
+		return "", err
+	}
+	if config, err = RenderReservedResources(spec.ControlPlaneProviderType, config, spec.ReservedResources); err != nil {
+		return "", err
+	}
+	return RenderSingleNode(spec.ControlPlaneProviderType, config, spec.SingleNode)
+}
+
+// RenderSSHAccess returns the control plane template with the SSH access settings of the cluster template applied to
+// the bootstrap configuration of the nodes. The trusted user CA keys and an sshd drop-in trusting them are added to the
+// files of the nodes. The user is added to the users of kubeadm nodes; k3s nodes have no users section, so the user is
+// created by a command and sshd reads its authorized keys from a root owned file instead of the home of the user.
+// The worker templates are derived from the rendered control plane template, so they get the same settings.
+func RenderSSHAccess(providerType, config string, sshAccess *v1alpha1.SSHAccessConfig) (string, error) {
+	if sshAccess == nil || (len(sshAccess.AuthorizedKeys) == 0 && len(sshAccess.TrustedUserCAKeys) == 0) {
+		return config, nil
+	}
+
+	var cpt map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return "", fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+
+	configSpec, commandsField := "kthreesConfigSpec", "preK3sCommands"
+	if providerType == "kubeadm" {
+		configSpec, commandsField = "kubeadmConfigSpec", "preKubeadmCommands"
+	}
+	specPath := []string{"spec", "template", "spec", configSpec}
+
+	user := sshAccess.User
+	if user == "" {
+		user = DefaultSSHUser
+	}
+
+	sshdConfig := []string{}
+	files := []interface{}{}
+	commands := []string{}
+	if len(sshAccess.TrustedUserCAKeys) > 0 {
+		files = append(files, sshFile(sshTrustedUserCAKeysPath, "0644", sshAccess.TrustedUserCAKeys))
+		sshdConfig = append(sshdConfig, "TrustedUserCAKeys "+sshTrustedUserCAKeysPath)
+	}
+	if len(sshAccess.AuthorizedKeys) > 0 {
+		if providerType == "kubeadm" {
+			users, _, err := unstructured.NestedSlice(cpt, append(specPath, "users")...)
+			if err != nil {
+				return "", fmt.Errorf("failed to read users: %w", err)
+			}
+			users = append(users, map[string]interface{}{
+				"name":              user,
+				"shell":             "/bin/bash",
+				"sudo":              "ALL=(ALL) NOPASSWD:ALL",
+				"lockPassword":      true,
+				"sshAuthorizedKeys": toInterfaces(sshAccess.AuthorizedKeys),
+			})
+			if err := unstructured.SetNestedSlice(cpt, users, append(specPath, "users")...); err != nil {
+				return "", fmt.Errorf("failed to set users: %w", err)
+			}
+		} else {
+			files = append(files,
+				sshFile(sshAuthorizedKeysDir+"/"+user, "0644", sshAccess.AuthorizedKeys),
+				sshFile("/etc/sudoers.d/"+user, "0440", []string{user + " ALL=(ALL) NOPASSWD:ALL"}))
+			sshdConfig = append(sshdConfig, "AuthorizedKeysFile .ssh/authorized_keys "+sshAuthorizedKeysDir+"/%u")
+			commands = append(commands, fmt.Sprintf("id -u %[1]s > /dev/null 2>&1 || useradd --create-home --shell /bin/bash %[1]s", user))
+		}
+	}
+	if len(sshdConfig) > 0 {
+		files = append(files, sshFile(sshdConfigPath, "0644", sshdConfig))
+		commands = append(commands, sshdReloadCommand)
+	}
+
+	existingFiles, _, err := unstructured.NestedSlice(cpt, append(specPath, "files")...)
+	if err != nil {
+		return "", fmt.Errorf("failed to read files: %w", err)
+	}
+	if err := unstructured.SetNestedSlice(cpt, append(existingFiles, files...), append(specPath, "files")...); err != nil {
+		return "", fmt.Errorf("failed to set files: %w", err)
+	}
+
+	if len(commands) > 0 {
+		existingCommands, _, err := unstructured.NestedStringSlice(cpt, append(specPath, commandsField)...)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", commandsField, err)
+		}
+		if err := unstructured.SetNestedStringSlice(cpt, append(commands, existingCommands...), append(specPath, commandsField)...); err != nil {
+			return "", fmt.Errorf("failed to set %s: %w", commandsField, err)
+		}
+	}
+
+	rendered, err := json.Marshal(cpt)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal control plane template: %w", err)
+	}
+	return string(rendered), nil
+}
+
+// copyKubeadmSSHAccess copies the users and the SSH files and commands rendered by RenderSSHAccess from the kubeadm
+// control plane template to the spec of the worker bootstrap template; the other files and commands of the control
+// plane template are specific to the control plane nodes
+func copyKubeadmSSHAccess(cpt map[string]interface{}, spec map[string]interface{}) error {
+	specPath := []string{"spec", "template", "spec", "kubeadmConfigSpec"}
+
+	users, ok, err := unstructured.NestedSlice(cpt, append(specPath, "users")...)
+	if err != nil {
+		return fmt.Errorf("failed to read users from control plane template: %w", err)
+	}
+	if ok {
+		spec["users"] = users
+	}
+
+	files, _, err := unstructured.NestedSlice(cpt, append(specPath, "files")...)
+	if err != nil {
+		return fmt.Errorf("failed to read files from control plane template: %w", err)
+	}
+	sshFiles := []interface{}{}
+	for _, f := range files {
+		if file, ok := f.(map[string]interface{}); ok && (file["path"] == sshTrustedUserCAKeysPath || file["path"] == sshdConfigPath) {
+			sshFiles = append(sshFiles, f)
+		}
+	}
+	if len(sshFiles) == 0 {
+		return nil
+	}
+	spec["files"] = sshFiles
+	spec["preKubeadmCommands"] = []interface{}{sshdReloadCommand}
+	return nil
+}
+
+// sshFile returns a root owned file of the bootstrap configuration with the given lines
+func sshFile(path, permissions string, lines []string) map[string]interface{} {
+	return map[string]interface{}{
+		"path":        path,
+		"owner":       "root:root",
+		"permissions": permissions,
+		"content":     strings.Join(lines, "\n") + "\n",
+	}
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kthreesBootstrapAPIVersion = "bootstrap.cluster.x-k8s.io/v1beta2"
+	kubeadmBootstrapAPIVersion = "bootstrap.cluster.x-k8s.io/v1beta1"
+)
+
+// workerName returns the name of the bootstrap and infrastructure templates of the worker MachineDeployment class
+func workerName(name types.NamespacedName) types.NamespacedName {
+	return types.NamespacedName{Name: fmt.Sprintf("%s-worker", name.Name), Namespace: name.Namespace}
+}
+
+// alterWorkerClass sets the bootstrap and infrastructure template kinds of the worker MachineDeployment classes
+func alterWorkerClass(cc *capiv1beta1.ClusterClass, bootstrapAPIVersion, bootstrapKind, infraAPIVersion, infraKind string) {
+	for i := range cc.Spec.Workers.MachineDeployments {
+		template := &cc.Spec.Workers.MachineDeployments[i].Template
+		template.Bootstrap.Ref.APIVersion = bootstrapAPIVersion
+		template.Bootstrap.Ref.Kind = bootstrapKind
+		template.Infrastructure.Ref.APIVersion = infraAPIVersion
+		template.Infrastructure.Ref.Kind = infraKind
+	}
+}
+
+// workerClassNames returns the names of the worker MachineDeployment classes of the ClusterClass
+func workerClassNames(cc *capiv1beta1.ClusterClass) []string {
+	names := []string{}
+	for _, md := range cc.Spec.Workers.MachineDeployments {
+		names = append(names, md.Class)
+	}
+	return names
+}
+
+// nodePoolVariables declares the variables holding the labels and taints of the nodes in a worker node pool
+func nodePoolVariables() []capiv1beta1.ClusterClassVariable {
+	variables := []capiv1beta1.ClusterClassVariable{}
+	for _, name := range []string{NodePoolLabels, NodePoolTaints} {
+		variables = append(variables, capiv1beta1.ClusterClassVariable{
+			Name: name,
+			Schema: capiv1beta1.VariableSchema{
+				OpenAPIV3Schema: capiv1beta1.JSONSchemaProps{
+					Type:  "array",
+					Items: &capiv1beta1.JSONSchemaProps{Type: "string"},
+					Default: &apiextensionsv1.JSON{
+						Raw: []byte("[]"),
+					},
+				},
+			},
+		})
+	}
+	return variables
+}
+
+// kthreesNodePoolPatch sets the node pool labels and taints on the k3s agent configuration of the worker nodes
+func kthreesNodePoolPatch(cc *capiv1beta1.ClusterClass) capiv1beta1.ClusterClassPatch {
+	return capiv1beta1.ClusterClassPatch{
+		Name:        "nodePool",
+		Description: "This patch will set the labels and taints of the worker node pool on its nodes.",
+		Definitions: []capiv1beta1.PatchDefinition{
+			{
+				Selector: capiv1beta1.PatchSelector{
+					APIVersion: kthreesBootstrapAPIVersion,
+					Kind:       KThreesConfigTemplate,
+					MatchResources: capiv1beta1.PatchSelectorMatch{
+						MachineDeploymentClass: &capiv1beta1.PatchSelectorMatchMachineDeploymentClass{
+							Names: workerClassNames(cc),
+						},
+					},
+				},
+				JSONPatches: []capiv1beta1.JSONPatch{
+					{
+						Op:   "add",
+						Path: "/spec/template/spec/agentConfig/nodeLabels",
+						ValueFrom: &capiv1beta1.JSONPatchValue{
+							Variable: &NodePoolLabels,
+						},
+					},
+					{
+						Op:   "add",
+						Path: "/spec/template/spec/agentConfig/nodeTaints",
+						ValueFrom: &capiv1beta1.JSONPatchValue{
+							Variable: &NodePoolTaints,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// kubeadmNodePoolPatch sets the node pool labels and taints as kubelet arguments of the worker nodes
+func kubeadmNodePoolPatch(cc *capiv1beta1.ClusterClass) capiv1beta1.ClusterClassPatch {
+	nodeLabels := fmt.Sprintf(`"{{ join "," .%s }}"`, NodePoolLabels)
+	nodeTaints := fmt.Sprintf(`"{{ join "," .%s }}"`, NodePoolTaints)
+	return capiv1beta1.ClusterClassPatch{
+		Name:        "nodePool",
+		Description: "This patch will set the labels and taints of the worker node pool on its nodes.",
+		Definitions: []capiv1beta1.PatchDefinition{
+			{
+				Selector: capiv1beta1.PatchSelector{
+					APIVersion: kubeadmBootstrapAPIVersion,
+					Kind:       KubeadmConfigTemplate,
+					MatchResources: capiv1beta1.PatchSelectorMatch{
+						MachineDeploymentClass: &capiv1beta1.PatchSelectorMatchMachineDeploymentClass{
+							Names: workerClassNames(cc),
+						},
+					},
+				},
+				JSONPatches: []capiv1beta1.JSONPatch{
+					{
+						Op:   "add",
+						Path: "/spec/template/spec/joinConfiguration/nodeRegistration/kubeletExtraArgs/node-labels",
+						ValueFrom: &capiv1beta1.JSONPatchValue{
+							Template: &nodeLabels,
+						},
+					},
+					{
+						Op:   "add",
+						Path: "/spec/template/spec/joinConfiguration/nodeRegistration/kubeletExtraArgs/register-with-taints",
+						ValueFrom: &capiv1beta1.JSONPatchValue{
+							Template: &nodeTaints,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// createKThreesConfigTemplate creates the worker bootstrap template from the k3s configuration of the control plane template,
+// so that worker nodes join the cluster with the same agent settings as the control plane nodes
+func createKThreesConfigTemplate(ctx context.Context, c client.Client, name types.NamespacedName, config string) error {
+	var cpt map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+
+	spec, _, err := unstructured.NestedMap(cpt, "spec", "template", "spec", "kthreesConfigSpec")
+	if err != nil {
+		return fmt.Errorf("failed to read k3s config from control plane template: %w", err)
+	}
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	// server settings only apply to control plane nodes
+	delete(spec, "serverConfig")
+	stripSingleNode(spec)
+	if _, ok := spec["agentConfig"]; !ok {
+		spec["agentConfig"] = map[string]interface{}{}
+	}
+
+	return createWorkerBootstrapTemplate(ctx, c, name, kthreesBootstrapAPIVersion, KThreesConfigTemplate, spec)
+}
+
+// createKubeadmConfigTemplate creates the worker bootstrap template from the join configuration of the control plane template
+func createKubeadmConfigTemplate(ctx context.Context, c client.Client, name types.NamespacedName, config string) error {
+	var cpt map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &cpt); err != nil {
+		return fmt.Errorf("failed to unmarshal control plane template: %w", err)
+	}
+
+	joinConfiguration, _, err := unstructured.NestedMap(cpt, "spec", "template", "spec", "kubeadmConfigSpec", "joinConfiguration")
+	if err != nil {
+		return fmt.Errorf("failed to read join configuration from control plane template: %w", err)
+	}
+	if joinConfiguration == nil {
+		joinConfiguration = map[string]interface{}{}
+	}
+	if _, ok, _ := unstructured.NestedMap(joinConfiguration, "nodeRegistration", "kubeletExtraArgs"); !ok {
+		if err := unstructured.SetNestedMap(joinConfiguration, map[string]interface{}{}, "nodeRegistration", "kubeletExtraArgs"); err != nil {
+			return fmt.Errorf("failed to set kubelet arguments of join configuration: %w", err)
+		}
+	}
+
+	spec := map[string]interface{}{
+		"joinConfiguration": joinConfiguration,
+	}
+	if err := copyKubeadmSSHAccess(cpt, spec); err != nil {
+		return err
+	}
+	if err := copyKubeadmAirGap(cpt, spec); err != nil {
+		return err
+	}
+
+	return createWorkerBootstrapTemplate(ctx, c, name, kubeadmBootstrapAPIVersion, KubeadmConfigTemplate, spec)
+}
+
+func createWorkerBootstrapTemplate(ctx context.Context, c client.Client, name types.NamespacedName, apiVersion, kind string, spec map[string]interface{}) error {
+	worker := workerName(name)
+	bt := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name":      worker.Name,
+				"namespace": worker.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": spec,
+				},
+			},
+		},
+	}
+	if err := c.Create(ctx, &bt); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create %s: %w", kind, err)
+	}
+	return nil
+}
+
+func getWorkerBootstrapTemplate(ctx context.Context, c client.Client, name types.NamespacedName, apiVersion, kind string) error {
+	bt := unstructured.Unstructured{}
+	bt.SetAPIVersion(apiVersion)
+	bt.SetKind(kind)
+	return c.Get(ctx, workerName(name), &bt)
+}
@@ -4,15 +4,26 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log/slog"
+	"strings"
+	"time"
 
-	"k8s.io/apimachinery/pkg/api/errors"
+	"github.com/google/uuid"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
@@ -20,44 +31,221 @@ import (
 func (s *Server) DeleteV2ClustersName(ctx context.Context, request api.DeleteV2ClustersNameRequestObject) (api.DeleteV2ClustersNameResponseObject, error) {
 	name := request.Name
 	if name == "" {
-		slog.Error("no cluster name provided")
-		return api.DeleteV2ClustersName400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("no cluster name provided"),
-			},
-		}, nil
+		message := messages.New(messages.ClusterNameMissing)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2ClustersName400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
 	activeProjectID := request.Params.Activeprojectid.String()
-	err := s.unpauseClusterIfPaused(ctx, activeProjectID, name)
-	if errors.IsNotFound(err) {
-		message := fmt.Sprintf("cluster '%s' not found in namespace '%s'", name, activeProjectID)
-		return api.DeleteV2ClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &message}}, nil
+
+	// clusters that other clusters depend on are deleted after their dependents
+	dependents, err := s.clusterDependents(ctx, activeProjectID, name)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to check cluster dependents", "namespace", activeProjectID, "name", name, "error", err)
+		message := messages.New(messages.ClusterDependentsCheckFailed)
+		return api.DeleteV2ClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	if len(dependents) > 0 {
+		message := messages.New(messages.ClusterHasDependents, name, strings.Join(dependents, ", "))
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersName409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the deletion is deferred for the grace period, during which it can be canceled with
+	// DELETE /v2/clusters/{name}/deletion; the deletion tracker deletes the cluster once the grace period passed. Clusters published to a GitOps repository are
+	// deleted right away, as their manifests are removed from the repository.
+	purge := request.Params.Purge != nil && *request.Params.Purge
+	if s.config.ClusterDeleteGracePeriod > 0 && !purge && s.gitops == nil {
+		forceFinalize := request.Params.ForceFinalize != nil && *request.Params.ForceFinalize
+		deleteAt, deferred, err := s.deferClusterDeletion(ctx, activeProjectID, name, forceFinalize)
+		if k8serrors.IsNotFound(err) {
+			message := messages.New(messages.ClusterNotFound, name)
+			return api.DeleteV2ClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+		}
+		if err != nil {
+			message := messages.New(messages.ClusterMarkDeleteFailed, name, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.DeleteV2ClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+		if deferred {
+			logger.FromContext(ctx).Info("cluster pending deletion", "namespace", activeProjectID, "name", name, "deleteAt", deleteAt)
+			return api.DeleteV2ClustersName202Response{}, nil
+		}
+	}
+
+	// the nodes are drained before the cluster is deleted, so that its workloads are shut down gracefully; the nodes of
+	// a cluster that is deleting already are not drained again
+	if (request.Params.Force == nil || !*request.Params.Force) && !s.clusterDeleting(ctx, activeProjectID, name) {
+		if err := s.drainClusterNodes(ctx, activeProjectID, name); err != nil {
+			message := messages.New(messages.ClusterDrainFailed, name, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+			return api.DeleteV2ClustersName409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// the manifests are removed from the GitOps repository of the project first, so that the GitOps controller does not
+	// create the cluster again
+	if s.gitops != nil {
+		if err := s.gitops.Remove(ctx, activeProjectID, name); err != nil {
+			message := messages.New(messages.ClusterUnpublishFailed, name, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.DeleteV2ClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	err = s.unpauseClusterIfPaused(ctx, activeProjectID, name)
+	if k8serrors.IsNotFound(err) {
+		message := messages.New(messages.ClusterNotFound, name)
+		return api.DeleteV2ClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
 	}
 	if err != nil {
-		slog.Error("failed to unpause cluster before deletion", "namespace", activeProjectID, "name", name, "error", err)
-		return api.DeleteV2ClustersName500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr("failed to unpause cluster before deletion"),
-			},
-		}, nil
+		logger.FromContext(ctx).Error("failed to unpause cluster before deletion", "namespace", activeProjectID, "name", name, "error", err)
+		message := messages.New(messages.ClusterUnpauseFailed)
+		return api.DeleteV2ClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
+
+	// the finalizers are stripped by the deletion tracker once the cluster is still deleting after the timeout
+	if request.Params.ForceFinalize != nil && *request.Params.ForceFinalize {
+		forceFinalizeAt := time.Now().Add(s.config.ForceDeleteTimeout).UTC().Truncate(time.Second)
+		err = s.annotateCluster(ctx, activeProjectID, name, deletion.ForceFinalizeAtAnnotationKey, forceFinalizeAt.Format(time.RFC3339))
+		if k8serrors.IsNotFound(err) {
+			message := messages.New(messages.ClusterNotFound, name)
+			return api.DeleteV2ClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+		}
+		if err != nil {
+			message := messages.New(messages.ClusterForceFinalizeFailed, name, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.DeleteV2ClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+		logger.FromContext(ctx).Info("force finalization of cluster requested", "namespace", activeProjectID, "name", name, "forceFinalizeAt", forceFinalizeAt)
+	}
+
 	err = s.k8sclient.Resource(core.ClusterResourceSchema).Namespace(activeProjectID).Delete(ctx, name, v1.DeleteOptions{})
-	if errors.IsNotFound(err) {
-		message := fmt.Sprintf("cluster '%s' not found in namespace '%s'", name, activeProjectID)
-		return api.DeleteV2ClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &message}}, nil
+	if k8serrors.IsNotFound(err) {
+		message := messages.New(messages.ClusterNotFound, name)
+		return api.DeleteV2ClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to delete cluster", "namespace", activeProjectID, "name", name, "error", err)
+		message := messages.New(messages.ClusterDeleteFailed)
+		return api.DeleteV2ClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	s.recordOperation(ctx, activeProjectID, operations.Delete, name, "")
+
+	logger.FromContext(ctx).Debug("cluster deletion requested", "namespace", activeProjectID, "name", name)
+	return api.DeleteV2ClustersName202Response{}, nil
+}
+
+// DeletePendingCluster deletes the cluster of the project whose grace period passed the way its deletion is requested
+// with DeleteV2ClustersName, see deletion.Deleter: the clusters depending on it keep it, its manifests are removed from
+// the GitOps repository and the deletion is recorded as an operation. Its nodes are drained on a best effort basis, as
+// the deletion was announced by the grace period; a cluster that is removed already is deleted.
+func (s *Server) DeletePendingCluster(ctx context.Context, projectID, name string) error {
+	id, err := uuid.Parse(projectID)
+	if err != nil {
+		return fmt.Errorf("invalid project id %s: %w", projectID, err)
+	}
+
+	if !s.clusterDeleting(ctx, projectID, name) {
+		if err := s.drainClusterNodes(ctx, projectID, name); err != nil {
+			logger.FromContext(ctx).Warn("failed to drain the nodes of cluster pending deletion", "namespace", projectID, "name", name, "error", err)
+		}
+	}
+
+	purge, force := true, true
+	response, err := s.DeleteV2ClustersName(ctx, api.DeleteV2ClustersNameRequestObject{
+		Name:   name,
+		Params: api.DeleteV2ClustersNameParams{Activeprojectid: id, Purge: &purge, Force: &force},
+	})
+	if err != nil {
+		return err
+	}
+
+	var message *string
+	switch r := response.(type) {
+	case api.DeleteV2ClustersName202Response, api.DeleteV2ClustersName404JSONResponse:
+		return nil
+	case api.DeleteV2ClustersName400JSONResponse:
+		message = r.Message
+	case api.DeleteV2ClustersName409JSONResponse:
+		message = r.Message
+	case api.DeleteV2ClustersName500JSONResponse:
+		message = r.Message
 	}
+	if message == nil {
+		return fmt.Errorf("failed to delete cluster: unexpected response %T", response)
+	}
+	return errors.New(*message)
+}
+
+// clusterDeleting returns whether the deletion of the cluster was requested already; the errors of getting the cluster
+// are reported by its deletion
+func (s *Server) clusterDeleting(ctx context.Context, namespace, name string) bool {
+	clusterObj, err := s.k8sclient.Resource(core.ClusterResourceSchema).Namespace(namespace).Get(ctx, name, v1.GetOptions{})
+	return err == nil && clusterObj.GetDeletionTimestamp() != nil
+}
+
+// deferClusterDeletion annotates the cluster with the end of its grace period, the grace period of a cluster pending
+// deletion already is kept; false is returned if the cluster is deleting already
+func (s *Server) deferClusterDeletion(ctx context.Context, namespace, name string, forceFinalize bool) (time.Time, bool, error) {
+	clusterObj, err := s.k8sclient.Resource(core.ClusterResourceSchema).Namespace(namespace).Get(ctx, name, v1.GetOptions{})
 	if err != nil {
-		slog.Error("failed to delete cluster", "namespace", activeProjectID, "name", name, "error", err)
-		return api.DeleteV2ClustersName500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr("failed to delete cluster"),
-			},
-		}, nil
+		return time.Time{}, false, err
+	}
+	if clusterObj.GetDeletionTimestamp() != nil {
+		return time.Time{}, false, nil
+	}
+	var c capi.Cluster
+	if err := convert.FromUnstructured(*clusterObj, &c); err != nil {
+		return time.Time{}, false, err
 	}
 
-	slog.Debug("cluster deleted", "namespace", activeProjectID, "name", name)
-	return api.DeleteV2ClustersName204Response{}, nil
+	deleteAt, ok := deletion.DeleteAt(&c)
+	if !ok {
+		deleteAt = time.Now().Add(s.config.ClusterDeleteGracePeriod).UTC().Truncate(time.Second)
+	}
+	annotations := map[string]any{deletion.DeleteAtAnnotationKey: deleteAt.Format(time.RFC3339)}
+	if forceFinalize {
+		annotations[deletion.ForceFinalizeAtAnnotationKey] = deleteAt.Add(s.config.ForceDeleteTimeout).Format(time.RFC3339)
+	}
+	if err := s.patchClusterAnnotations(ctx, namespace, name, annotations); err != nil {
+		return time.Time{}, false, err
+	}
+	return deleteAt, true, nil
+}
+
+// annotateCluster sets the annotation of the cluster to the given value
+func (s *Server) annotateCluster(ctx context.Context, namespace, name, key, value string) error {
+	return s.patchClusterAnnotations(ctx, namespace, name, map[string]any{key: value})
+}
+
+// patchClusterAnnotations merges the annotations into the annotations of the cluster, nil values remove the annotation
+func (s *Server) patchClusterAnnotations(ctx context.Context, namespace, name string, annotations map[string]any) error {
+	patchData, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": annotations}})
+	if err != nil {
+		return err
+	}
+	_, err = s.k8sclient.Resource(core.ClusterResourceSchema).Namespace(namespace).Patch(ctx, name, types.MergePatchType, patchData, v1.PatchOptions{})
+	return err
+}
+
+// clusterDependents returns the names of the clusters of the namespace that depend on the named cluster
+func (s *Server) clusterDependents(ctx context.Context, namespace, name string) ([]string, error) {
+	unstructuredClusters, err := fetchClustersList(ctx, s.k8sclient, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]capi.Cluster, 0, len(unstructuredClusters))
+	for _, item := range unstructuredClusters {
+		var c capi.Cluster
+		if err := convert.FromUnstructured(item, &c); err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, c)
+	}
+	return cluster.Dependents(clusters, name), nil
 }
 
 func (s *Server) unpauseClusterIfPaused(ctx context.Context, namespace, name string) error {
@@ -86,6 +274,6 @@ func (s *Server) unpauseClusterIfPaused(ctx context.Context, namespace, name str
 		return err
 	}
 
-	slog.Info("cluster unpaused before deletion", "namespace", namespace, "name", name)
+	logger.FromContext(ctx).Info("cluster unpaused before deletion", "namespace", namespace, "name", name)
 	return nil
 }
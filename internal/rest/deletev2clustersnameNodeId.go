@@ -6,16 +6,17 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
-	"log/slog"
 
 	intelProvider "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
+	controlplaneprovider "github.com/open-edge-platform/cluster-manager/v2/internal/providers"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	cutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -35,15 +36,15 @@ func (s *Server) DeleteV2ClustersNameNodesNodeId(ctx context.Context, request ap
 		*deleteOptions.GracePeriodSeconds = 0
 	}
 	if clusterName == "" {
-		errMsg := "no cluster name provided"
-		slog.Error(errMsg)
-		return api.DeleteV2ClustersNameNodesNodeId400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &errMsg}}, nil
+		message := messages.New(messages.ClusterNameMissing)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2ClustersNameNodesNodeId400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 	nodeID := request.NodeId
 	if nodeID == "" {
-		errMsg := "no node id provided"
-		slog.Error(errMsg)
-		return api.DeleteV2ClustersNameNodesNodeId400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &errMsg}}, nil
+		message := messages.New(messages.NodeIDMissing)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2ClustersNameNodesNodeId400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
 	if force {
@@ -53,34 +54,36 @@ func (s *Server) DeleteV2ClustersNameNodesNodeId(ctx context.Context, request ap
 		intelMachines, err := cli.IntelMachines(ctx, activeProjectID, clusterName)
 		if err != nil {
 			if errors.IsNotFound(err) {
-				errMsg := fmt.Sprintf("cluster or project not found: %s/%s", activeProjectID, clusterName)
-				slog.Error(errMsg, "error", err)
-				return api.DeleteV2ClustersNameNodesNodeId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &errMsg}}, nil
+				message := messages.New(messages.ClusterNotFound, clusterName)
+				logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "error", err)
+				return api.DeleteV2ClustersNameNodesNodeId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
 			}
-			errMsg := "failed to retrieve intel machines"
-			slog.Error(errMsg, "error", err)
-			return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &errMsg}}, nil
+			message := messages.New(messages.IntelMachinesGetFailed)
+			logger.FromContext(ctx).Error(message.String(), "error", err)
+			return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 		}
-		// once host-id is used instead of uuid we should check the machine's host-id and only remove the finalizer if it matches
-		// for now this works fine for single node clusters
+		// only the intel machine of the node is released, the other nodes of the cluster keep their finalizers
 		for _, intelMachine := range intelMachines {
+			if hostID, ok := intelMachine.Annotations[nodemetadata.HostIdAnnotationKey]; ok && hostID != nodeID {
+				continue
+			}
 			origIntelMachine := intelMachine.DeepCopy()
 			if !cutil.RemoveFinalizer(&intelMachine, intelProvider.HostCleanupFinalizer) {
 				// we don't error out just in case the finalizer was already removed but deletion still needs to be triggered
 				errMsg := "failed to remove finalizers"
-				slog.Error(errMsg)
+				logger.FromContext(ctx).Error(errMsg)
 				continue
 			}
 			intelMachineBytes, err := getPatchData(origIntelMachine, intelMachine)
 			if err != nil {
 				errMsg := "failed to get patch data"
-				slog.Error(errMsg, "error", err)
+				logger.FromContext(ctx).Error(errMsg, "error", err)
 				continue
 			}
 			_, err = s.k8sclient.Resource(core.IntelMachineResourceSchema).Namespace(activeProjectID).Patch(ctx, intelMachine.Name, types.MergePatchType, intelMachineBytes, v1.PatchOptions{})
 			if err != nil {
 				errMsg := "failed to remove finalizers"
-				slog.Error(errMsg, "error", err)
+				logger.FromContext(ctx).Error(errMsg, "error", err)
 				continue
 			}
 		}
@@ -90,30 +93,67 @@ func (s *Server) DeleteV2ClustersNameNodesNodeId(ctx context.Context, request ap
 	cluster, err := s.getCluster(ctx, activeProjectID, clusterName)
 	if err != nil {
 		if stderrors.Is(err, k8s.ErrClusterNotFound) {
-			errMsg := fmt.Sprintf("cluster not found: %s/%s", activeProjectID, clusterName)
-			slog.Warn(errMsg)
-			return api.DeleteV2ClustersNameNodesNodeId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &errMsg}}, nil
+			message := messages.New(messages.ClusterNotFound, clusterName)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+			return api.DeleteV2ClustersNameNodesNodeId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
 		}
-		errMsg := "failed to retrieve cluster"
-		slog.Error(errMsg, "error", err)
-		return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &errMsg}}, nil
+		message := messages.New(messages.ClusterGetFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
 	// check for single node
 	if len(*cluster.Nodes) == 1 {
+		if !force {
+			if err := s.drainClusterNodes(ctx, activeProjectID, clusterName); err != nil {
+				message := messages.New(messages.NodeDrainFailed, nodeID, clusterName, err)
+				logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+				return api.DeleteV2ClustersNameNodesNodeId409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+			}
+		}
+
 		// if we're dealing with a single node cluster, we can delete the capi cluster
 		err = deleteCluster(ctx, s, activeProjectID, clusterName, deleteOptions)
 		if err != nil {
-			errMsg := "failed to delete cluster"
-			slog.Error(errMsg, "error", err)
-			return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &errMsg}}, nil
+			message := messages.New(messages.ClusterDeleteFailed)
+			logger.FromContext(ctx).Error(message.String(), "error", err)
+			return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 		}
-		slog.Info("cluster deleted", "name", clusterName)
+		logger.FromContext(ctx).Info("cluster deleted", "name", clusterName)
 		return api.DeleteV2ClustersNameNodesNodeId200Response{}, nil
 	}
 
-	slog.Error("multi node clusters are not supported", "name", clusterName)
-	return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: ptr("multi node clusters are not supported")}}, nil
+	// multi node clusters are scaled down by removing the node's machine
+	// the node is drained once its removal is known to be allowed
+	var drain func(machine capi.Machine) error
+	if !force {
+		drain = func(machine capi.Machine) error {
+			return s.drainMachines(ctx, activeProjectID, clusterName, machine)
+		}
+	}
+	cli := k8s.New(s.k8sclient)
+	err = scaleDownCluster(ctx, cli, activeProjectID, clusterName, nodeID, drain)
+	switch {
+	case stderrors.Is(err, errNodeNotInCluster):
+		message := messages.New(messages.NodeNotInCluster, nodeID, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameNodesNodeId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case stderrors.Is(err, errNodeDrainFailed):
+		message := messages.New(messages.NodeDrainFailed, nodeID, clusterName, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameNodesNodeId409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case stderrors.Is(err, errNodeRemovalNotAllowed):
+		message := messages.New(messages.NodeRemovalNotAllowed, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameNodesNodeId400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.NodeRemoveFailed)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "name", clusterName, "node", nodeID, "error", err)
+		return api.DeleteV2ClustersNameNodesNodeId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("node removed from cluster", "name", clusterName, "node", nodeID)
+	return api.DeleteV2ClustersNameNodesNodeId200Response{}, nil
 }
 
 func deleteCluster(ctx context.Context, s *Server, activeProjectID, clusterName string, options v1.DeleteOptions) error {
@@ -129,36 +169,110 @@ func deleteCluster(ctx context.Context, s *Server, activeProjectID, clusterName
 	return err
 }
 
-// nolint: unused
-// for future use with multi-node clusters
-func scaleDownCluster(ctx context.Context, s *Server, capiCluster *capi.Cluster, nodeID string, options v1.DeleteOptions) error {
-	// get the machine associated with the node
-	machine, err := fetchMachineFromCluster(ctx, s, capiCluster.Namespace, capiCluster.Name, nodeID)
+// drainClusterNodes drains the nodes of all machines of the cluster, see drainMachines
+func (s *Server) drainClusterNodes(ctx context.Context, namespace, clusterName string) error {
+	if s.drainer == nil {
+		return nil
+	}
+	machines, err := k8s.New(s.k8sclient).GetMachines(ctx, namespace, clusterName)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get the machines of the cluster: %w", err)
 	}
-	// delete the machine
-	err = s.k8sclient.Resource(core.MachineResourceSchema).Namespace(capiCluster.Namespace).Delete(ctx, machine.Name, options)
-	if errors.IsNotFound(err) {
-		return fmt.Errorf("machine %s not found in namespace %s", machine.Name, capiCluster.Namespace)
+	return s.drainMachines(ctx, namespace, clusterName, machines...)
+}
+
+// drainMachines drains the workload cluster nodes of the machines if the server is configured with a NodeDrainer;
+// machines that have no node yet have nothing to drain
+func (s *Server) drainMachines(ctx context.Context, namespace, clusterName string, machines ...capi.Machine) error {
+	if s.drainer == nil {
+		return nil
+	}
+	var nodeNames []string
+	for _, machine := range machines {
+		if machine.Status.NodeRef != nil {
+			nodeNames = append(nodeNames, machine.Status.NodeRef.Name)
+		}
+	}
+	return s.drainer.Drain(ctx, namespace, clusterName, nodeNames...)
+}
+
+var (
+	errNodeNotInCluster      = stderrors.New("node is not part of the cluster")
+	errNodeRemovalNotAllowed = stderrors.New("node cannot be removed")
+	errNodeDrainFailed       = stderrors.New("node could not be drained")
+)
+
+// scaleDownCluster removes the node from a multi node cluster
+// The node's machine is marked for deletion and the control plane or node pool it belongs to is scaled down by one,
+// so the owner of the machine removes that machine rather than an arbitrary one. The node's machine binding is deleted too.
+// If drain is set, the machine is drained before it is marked for deletion.
+func scaleDownCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName, nodeID string, drain func(machine capi.Machine) error) error {
+	machine, err := cli.GetMachineByProviderHostID(ctx, namespace, nodeID)
+	if stderrors.Is(err, k8s.ErrMachineNotFound) || (err == nil && machine.Spec.ClusterName != clusterName) {
+		return errNodeNotInCluster
 	}
-	// delete the machine binding
-	intelMachine, err := fetchIntelMachineBindingFromCluster(ctx, s, capiCluster.Namespace, capiCluster.Name, nodeID)
 	if err != nil {
 		return err
 	}
-	err = s.k8sclient.Resource(core.BindingsResourceSchema).Namespace(capiCluster.Namespace).Delete(ctx, intelMachine.Name, options)
-	if errors.IsNotFound(err) {
-		return fmt.Errorf("could not delete machine binding %s in namespace %s", intelMachine.Name, capiCluster.Namespace)
+
+	capiCluster, err := cli.GetCluster(ctx, namespace, clusterName)
+	if err != nil {
+		return err
+	}
+
+	_, controlPlane := machine.Labels[capi.MachineControlPlaneLabel]
+	nodePool := machine.Labels[capi.ClusterTopologyMachineDeploymentNameLabel]
+	switch {
+	case controlPlane:
+		replicas := controlPlaneReplicas(capiCluster)
+		if replicas <= 1 {
+			return fmt.Errorf("%w: node %s is the last control plane node of cluster %s", errNodeRemovalNotAllowed, nodeID, clusterName)
+		}
+		templateName := capiCluster.Annotations[core.TemplateLabelKey]
+		template, err := cli.GetClusterTemplate(ctx, namespace, templateName)
+		if err != nil {
+			return err
+		}
+		if err := controlplaneprovider.ValidateControlPlaneReplicas(template.Spec.ControlPlaneProviderType, replicas-1); err != nil {
+			return fmt.Errorf("%w: %v", errNodeRemovalNotAllowed, err)
+		}
+	case nodePool == "":
+		return fmt.Errorf("machine %s of node %s belongs neither to the control plane nor to a node pool", machine.Name, nodeID)
+	}
+
+	if drain != nil {
+		if err := drain(machine); err != nil {
+			return fmt.Errorf("%w: %w", errNodeDrainFailed, err)
+		}
+	}
+
+	if err := cli.MarkMachineForDeletion(ctx, namespace, machine.Name); err != nil {
+		return err
+	}
+
+	if controlPlane {
+		err = cli.UpdateClusterTopology(ctx, namespace, clusterName, func(topology *capi.Topology) error {
+			replicas := controlPlaneReplicas(capiCluster) - 1
+			if topology.ControlPlane.Replicas != nil {
+				replicas = *topology.ControlPlane.Replicas - 1
+			}
+			topology.ControlPlane.Replicas = &replicas
+			return nil
+		})
+	} else {
+		err = cli.UpdateClusterWorkers(ctx, namespace, clusterName, func(workers *capi.WorkersTopology) error {
+			for _, md := range workers.MachineDeployments {
+				if md.Name == nodePool {
+					scaleNodePool(workers, nodePool, -1)
+					return nil
+				}
+			}
+			return errNodePoolNotFound
+		})
 	}
-	// scale down the cluster replicas
-	replicas := *capiCluster.Spec.Topology.ControlPlane.Replicas - 1
-	capiCluster.Spec.Topology.ControlPlane.Replicas = &replicas
-	unstructuredClusterInfo, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&capiCluster)
 	if err != nil {
 		return err
 	}
-	unstructuredCluster := &unstructured.Unstructured{Object: unstructuredClusterInfo}
-	_, err = s.k8sclient.Resource(core.ClusterResourceSchema).Namespace(capiCluster.Namespace).Update(ctx, unstructuredCluster, v1.UpdateOptions{})
-	return err
+
+	return cli.DeleteMachineBinding(ctx, namespace, fmt.Sprintf("%s-%s", clusterName, nodeID))
 }
@@ -3,21 +3,32 @@
 package rest
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
+	intelProvider "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/drain"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
 )
 
 // (DELETE /v2/clusters/{name}/nodes/{nodeId})
@@ -120,175 +131,145 @@ func TestDeleteClustersNameNodeId(t *testing.T) {
 		// Check the response
 		assert.Equal(t, http.StatusOK, rr.Code)
 	})
-	t.Run("Error on Multi Node Cluster", func(t *testing.T) {
-		// Prepare test data
-		name := "example-cluster"
-		activeProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
-		nodeID := "535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b"
-		// Mock the delete cluster to succeed
-		clusterResource := k8s.NewMockResourceInterface(t)
-		machineResource := k8s.NewMockResourceInterface(t)
-		intelMachineResource := k8s.NewMockResourceInterface(t)
-		intelMachineResource.EXPECT().Get(mock.Anything, mock.Anything, metav1.GetOptions{}).Return(&unstructured.Unstructured{Object: map[string]interface{}{}}, nil)
-		intelMachineResource.EXPECT().List(mock.Anything, metav1.ListOptions{LabelSelector: "cluster.x-k8s.io/cluster-name=" + name}).Return(&unstructured.UnstructuredList{
+	t.Run("Worker Node Removal on Multi Node Cluster", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(twoNodeCluster(t), nil)
+		clusters.EXPECT().Update(mock.Anything, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, obj *unstructured.Unstructured, _ metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				var cluster capi.Cluster
+				require.NoError(t, convert.FromUnstructured(*obj, &cluster))
+				require.Nil(t, cluster.Spec.Topology.ControlPlane.Replicas)
+				require.Equal(t, int32(0), *cluster.Spec.Topology.Workers.MachineDeployments[0].Replicas)
+				return obj, nil
+			})
+		machines := twoNodeMachines(t)
+		machines.EXPECT().Get(mock.Anything, "worker-machine", metav1.GetOptions{}).Return(twoNodeMachine(t, "worker-machine", false), nil)
+		machines.EXPECT().Update(mock.Anything, mock.Anything, metav1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, obj *unstructured.Unstructured, _ metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				require.Equal(t, "worker-machine", obj.GetName())
+				require.Contains(t, obj.GetAnnotations(), capi.DeleteMachineAnnotation)
+				return obj, nil
+			})
+		intelMachines := twoNodeIntelMachines(t)
+		intelMachines.EXPECT().List(mock.Anything, metav1.ListOptions{LabelSelector: "cluster.x-k8s.io/cluster-name=example-cluster"}).Return(&unstructured.UnstructuredList{
 			Items: []unstructured.Unstructured{
-				{
-					Object: map[string]interface{}{
-						"apiVersion": "cluster.k8s.io/v1alpha1",
-						"kind":       "IntelMachine",
-						"metadata": map[string]interface{}{
-							"name":      "machine1",
-							"namespace": activeProjectID,
-							"annotations": map[string]interface{}{
-								"intelmachine.infrastructure.cluster.x-k8s.io/host-id": nodeID,
-							},
-							"finalizers": []string{"intelmachine.infrastructure.cluster.x-k8s.io/host-cleanup"},
-						},
-						"status": map[string]interface{}{
-							"nodeRef": map[string]interface{}{
-								"uid": nodeID,
-							},
-						},
-						"spec": map[string]interface{}{},
-					},
-				},
-				{
-					Object: map[string]interface{}{
-						"apiVersion": "cluster.k8s.io/v1alpha1",
-						"kind":       "IntelMachine",
-						"metadata": map[string]interface{}{
-							"name":      "machine2",
-							"namespace": activeProjectID,
-							"annotations": map[string]interface{}{
-								"intelmachine.infrastructure.cluster.x-k8s.io/host-id": "different-id",
-							},
-						},
-						"status": map[string]interface{}{
-							"nodeRef": map[string]interface{}{
-								"uid": "different-node-id",
-							},
-						},
-						"spec": map[string]interface{}{},
-					},
-				},
-			},
-		}, nil)
-		intelMachineResource.EXPECT().Patch(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&unstructured.Unstructured{}, nil)
-		clusterResource.EXPECT().Get(mock.Anything, name, metav1.GetOptions{}).Return(&unstructured.Unstructured{
-			Object: map[string]interface{}{
-				"apiVersion": "v1",
-				"kind":       "Cluster",
-				"metadata": map[string]interface{}{
-					"name":      name,
-					"namespace": activeProjectID,
-				},
-				"spec": map[string]interface{}{
-					"nodeID": nodeID,
-					"topology": map[string]interface{}{
-						"version": "v1",
-						"class":   "topology.kubernetes.io/zone",
-						"controlPlane": map[string]interface{}{
-							"replicas": 2,
-						},
-					},
-					"providerStatus": map[string]interface{}{
-						"indicator": "Ready",
-					},
-					"lifecyclePhase": map[string]interface{}{
-						"indicator": "Active",
-					},
-					"nodeHealth": map[string]interface{}{
-						"indicator": "Healthy",
-					},
-					"template": "default-template",
-				},
-				"labels": map[string]interface{}{
-					"env": "production",
-				},
-			},
-		}, nil)
-		machineResource.EXPECT().List(mock.Anything, metav1.ListOptions{LabelSelector: "cluster.x-k8s.io/cluster-name=" + name}).Return(&unstructured.UnstructuredList{
-			Items: []unstructured.Unstructured{
-				{
-					Object: map[string]interface{}{
-						"apiVersion": "cluster.k8s.io/v1alpha1",
-						"kind":       "Machine",
-						"metadata": map[string]interface{}{
-							"name":      "machine1",
-							"namespace": activeProjectID,
-							"annotations": map[string]interface{}{
-								"intelmachine.infrastructure.cluster.x-k8s.io/host-id": nodeID,
-							},
-						},
-						"status": map[string]interface{}{
-							"nodeRef": map[string]interface{}{
-								"uid": nodeID,
-							},
-						},
-						"spec": map[string]interface{}{
-							"clusterName": name,
-							"infrastructureRef": map[string]interface{}{
-								"kind": "IntelMachine",
-							},
-						},
-					},
-				},
-				{
-					Object: map[string]interface{}{
-						"apiVersion": "cluster.k8s.io/v1alpha1",
-						"kind":       "Machine",
-						"metadata": map[string]interface{}{
-							"name":      "machine2",
-							"namespace": activeProjectID,
-							"annotations": map[string]interface{}{
-								"intelmachine.infrastructure.cluster.x-k8s.io/host-id": "different-id",
-							},
-						},
-						"status": map[string]interface{}{
-							"nodeRef": map[string]interface{}{
-								"uid": "different-node-id",
-							},
-						},
-						"spec": map[string]interface{}{
-							"clusterName": name,
-							"infrastructureRef": map[string]interface{}{
-								"kind": "IntelMachine",
-							},
-						},
-					},
-				},
+				*twoNodeIntelMachine("cp-intelmachine", controlPlaneNodeID),
+				*twoNodeIntelMachine("worker-intelmachine", workerNodeID),
 			},
 		}, nil)
-		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
-		namespacedMachine := k8s.NewMockNamespaceableResourceInterface(t)
-		namespacedIntelMachine := k8s.NewMockNamespaceableResourceInterface(t)
-		namespacedMachine.EXPECT().Namespace(activeProjectID).Return(machineResource)
-		nsResource.EXPECT().Namespace(activeProjectID).Return(clusterResource)
-		namespacedIntelMachine.EXPECT().Namespace(activeProjectID).Return(intelMachineResource)
-		mockedk8sclient := k8s.NewMockInterface(t)
-		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource)
-		mockedk8sclient.EXPECT().Resource(core.MachineResourceSchema).Return(namespacedMachine)
-		mockedk8sclient.EXPECT().Resource(k8s.IntelMachineResourceSchema).Return(namespacedIntelMachine)
-		server := NewServer(mockedk8sclient)
-		require.NotNil(t, server, "NewServer() returned nil, want not nil")
+		// only the intel machine of the removed node is released
+		intelMachines.EXPECT().Patch(mock.Anything, "worker-intelmachine", mock.Anything, mock.Anything, mock.Anything).Return(&unstructured.Unstructured{}, nil)
+		bindings := k8s.NewMockResourceInterface(t)
+		bindings.EXPECT().Delete(mock.Anything, "example-cluster-"+workerNodeID, metav1.DeleteOptions{}).Return(nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters,
+			core.MachineResourceSchema:     machines,
+			k8s.IntelMachineResourceSchema: intelMachines,
+			core.BindingsResourceSchema:    bindings,
+		}, http.MethodDelete, fmt.Sprintf("/v2/clusters/example-cluster/nodes/%s?force=true", workerNodeID), nil)
+		assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	})
+	t.Run("Last Control Plane Node Cannot Be Removed", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(twoNodeCluster(t), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters,
+			core.MachineResourceSchema:     twoNodeMachines(t),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodDelete, fmt.Sprintf("/v2/clusters/example-cluster/nodes/%s", controlPlaneNodeID), nil)
+		assert.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeRemovalNotAllowed, rr.Body.Bytes())
+	})
+	t.Run("Failed Drain Keeps The Node", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(twoNodeCluster(t), nil)
+
+		// the node is drained once its removal is allowed and neither marked for deletion nor scaled down
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters,
+			core.MachineResourceSchema:     twoNodeMachines(t),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodDelete, fmt.Sprintf("/v2/clusters/example-cluster/nodes/%s", workerNodeID), nil, WithNodeDrainer(&fakeDrainer{err: drain.ErrTimeout}))
+		assert.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeDrainFailed, rr.Body.Bytes())
+	})
+	t.Run("Node Not In Multi Node Cluster", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(twoNodeCluster(t), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters,
+			core.MachineResourceSchema:     twoNodeMachines(t),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodDelete, "/v2/clusters/example-cluster/nodes/535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b", nil)
+		assert.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
 
-		// Create a new request & response recorder
-		req := httptest.NewRequest("DELETE", fmt.Sprintf("/v2/clusters/%s/nodes/%s?force=true", name, nodeID), nil)
-		req.Header.Set("Activeprojectid", activeProjectID)
-		req.Header.Set("Content-Type", "application/json")
-		rr := httptest.NewRecorder()
+const (
+	controlPlaneNodeID = "6e6422c3-625e-507a-bc8a-bd2330e07e7e"
+	workerNodeID       = "64e797f6-db22-445e-b606-4228d4f1c2bd"
+)
 
-		// Create a handler with middleware to serve the request
-		handler, err := server.ConfigureHandler()
-		require.Nil(t, err)
-		handler.ServeHTTP(rr, req)
+// twoNodeCluster returns a cluster with a single control plane node and a single worker node
+func twoNodeCluster(t *testing.T) *unstructured.Unstructured {
+	replicas := int32(1)
+	return nodePoolCluster(t, capi.MachineDeploymentTopology{Class: common.WorkerClass, Name: "workers", Replicas: &replicas})
+}
 
-		// Check the response
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		expectedResponse := `{"message": "multi node clusters are not supported"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+// twoNodeMachine returns the control plane or worker machine of the two node cluster
+func twoNodeMachine(t *testing.T, name string, controlPlane bool) *unstructured.Unstructured {
+	machineLabels := map[string]string{capi.ClusterNameLabel: "example-cluster"}
+	if controlPlane {
+		machineLabels[capi.MachineControlPlaneLabel] = ""
+	} else {
+		machineLabels[capi.ClusterTopologyMachineDeploymentNameLabel] = "workers"
+	}
+	machine := capi.Machine{
+		TypeMeta:   metav1.TypeMeta{APIVersion: core.MachineResourceSchema.GroupVersion().String(), Kind: "Machine"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: activeProjectID, Labels: machineLabels},
+		Spec: capi.MachineSpec{
+			ClusterName:       "example-cluster",
+			InfrastructureRef: corev1.ObjectReference{Kind: "IntelMachine", Name: strings.Replace(name, "machine", "intelmachine", 1)},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&machine)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
 
-	})
+// twoNodeMachines mocks the machines of the two node cluster
+func twoNodeMachines(t *testing.T) *k8s.MockResourceInterface {
+	machines := k8s.NewMockResourceInterface(t)
+	machines.EXPECT().List(mock.Anything, mock.Anything).Return(&unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			*twoNodeMachine(t, "cp-machine", true),
+			*twoNodeMachine(t, "worker-machine", false),
+		},
+	}, nil)
+	return machines
+}
+
+// twoNodeIntelMachine returns an intel machine of the given host with the host cleanup finalizer
+func twoNodeIntelMachine(name, hostID string) *unstructured.Unstructured {
+	intelMachine := &unstructured.Unstructured{}
+	intelMachine.SetAPIVersion(k8s.IntelMachineResourceSchema.GroupVersion().String())
+	intelMachine.SetKind("IntelMachine")
+	intelMachine.SetName(name)
+	intelMachine.SetNamespace(activeProjectID)
+	intelMachine.SetAnnotations(map[string]string{nodemetadata.HostIdAnnotationKey: hostID})
+	intelMachine.SetFinalizers([]string{intelProvider.HostCleanupFinalizer})
+	return intelMachine
+}
+
+// twoNodeIntelMachines mocks the intel machines of the two node cluster
+func twoNodeIntelMachines(t *testing.T) *k8s.MockResourceInterface {
+	intelMachines := k8s.NewMockResourceInterface(t)
+	intelMachines.EXPECT().Get(mock.Anything, "cp-intelmachine", metav1.GetOptions{}).Return(twoNodeIntelMachine("cp-intelmachine", controlPlaneNodeID), nil)
+	intelMachines.EXPECT().Get(mock.Anything, "worker-intelmachine", metav1.GetOptions{}).Return(twoNodeIntelMachine("worker-intelmachine", workerNodeID), nil)
+	return intelMachines
 }
 
 func TestDeleteClustersNameNodeId400(t *testing.T) {
@@ -313,8 +294,7 @@ func TestDeleteClustersNameNodeId400(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
-		expectedResponse := `{"message": "no active project id provided"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ActiveProjectIDMissing, rr.Body.Bytes())
 	})
 }
 
@@ -346,8 +326,7 @@ func TestDeleteClustersNameNodeId500(t *testing.T) {
 		handler.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusNotFound, rr.Code)
-		expectedResponse := fmt.Sprintf(`{"message": "cluster or project not found: %s/%s"}`, activeProjectID, name)
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
 	})
 	t.Run("Force Delete - IntelMachines backend error returns 500", func(t *testing.T) {
 		name := "fuzzstring"
@@ -375,8 +354,7 @@ func TestDeleteClustersNameNodeId500(t *testing.T) {
 		handler.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		expectedResponse := `{"message": "failed to retrieve intel machines"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.IntelMachinesGetFailed, rr.Body.Bytes())
 	})
 	t.Run("Cluster Not Found", func(t *testing.T) {
 		// Prepare test data
@@ -407,8 +385,7 @@ func TestDeleteClustersNameNodeId500(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		expectedResponse := `{"message": "failed to retrieve cluster"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterGetFailed, rr.Body.Bytes())
 	})
 	t.Run("Cluster k8s NotFound returns 404", func(t *testing.T) {
 		// Prepare test data
@@ -439,7 +416,6 @@ func TestDeleteClustersNameNodeId500(t *testing.T) {
 
 		// A k8s NotFound error should map to 404 with a structured message
 		assert.Equal(t, http.StatusNotFound, rr.Code)
-		expectedResponse := fmt.Sprintf(`{"message": "cluster not found: %s/%s"}`, activeProjectID, name)
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
 	})
 }
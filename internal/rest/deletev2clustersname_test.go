@@ -4,26 +4,38 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/drain"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
-func TestDeleteV2ClustersName204(t *testing.T) {
+func TestDeleteV2ClustersName202(t *testing.T) {
 	t.Run("Successful Deletion", func(t *testing.T) {
 		// Prepare test data
 		name := "example-cluster"
@@ -31,6 +43,7 @@ func TestDeleteV2ClustersName204(t *testing.T) {
 
 		// Mock the delete cluster to succeed
 		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
 		resource.EXPECT().Get(mock.Anything, name, metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
 		resource.EXPECT().Delete(mock.Anything, name, metav1.DeleteOptions{}).Return(nil)
 		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
@@ -54,7 +67,37 @@ func TestDeleteV2ClustersName204(t *testing.T) {
 		handler.ServeHTTP(rr, req)
 
 		// Check the response
-		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+	})
+
+	t.Run("Deletion Operation Recorded", func(t *testing.T) {
+		name := "example-cluster"
+		activeProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		resource.EXPECT().Get(mock.Anything, name, metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		resource.EXPECT().Delete(mock.Anything, name, metav1.DeleteOptions{}).Return(nil)
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(activeProjectID).Return(resource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource)
+
+		ops := &fakeOperations{}
+		server := NewServer(mockedk8sclient, WithOperations(ops))
+
+		req := httptest.NewRequest("DELETE", fmt.Sprintf("/v2/clusters/%s", name), nil)
+		req.Header.Set("Activeprojectid", activeProjectID)
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		require.Len(t, ops.started, 1)
+		assert.Equal(t, operations.Delete, ops.started[0].Type)
+		assert.Equal(t, name, ops.started[0].Cluster)
 	})
 }
 
@@ -82,8 +125,7 @@ func TestDeleteV2ClustersName400(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
-		expectedResponse := `{"message": "no active project id provided"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ActiveProjectIDMissing, rr.Body.Bytes())
 	})
 }
 
@@ -95,6 +137,7 @@ func TestDeleteV2ClustersName404(t *testing.T) {
 
 		// Mock the get cluster to succeed and delete cluster to fail
 		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
 		resource.EXPECT().Get(mock.Anything, name, metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
 		resource.EXPECT().Delete(mock.Anything, name, metav1.DeleteOptions{}).Return(errors.NewNotFound(schema.GroupResource{Group: "core", Resource: "clusters"}, name))
 		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
@@ -119,8 +162,310 @@ func TestDeleteV2ClustersName404(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusNotFound, rr.Code)
-		expectedResponse := fmt.Sprintf(`{"message":"cluster '%s' not found in namespace '%s'"}`, name, activeProjectID)
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
+	})
+}
+
+func TestDeleteV2ClustersName409(t *testing.T) {
+	t.Run("Cluster Has Dependents", func(t *testing.T) {
+		name := "registry-cluster"
+		activeProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+		dependent := capi.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: core.ClusterResourceSchema.GroupVersion().String(), Kind: "Cluster"},
+			ObjectMeta: metav1.ObjectMeta{Name: "app-cluster", Namespace: activeProjectID},
+		}
+		cluster.SetDependencies(&dependent, []string{name})
+		obj, err := convert.ToUnstructured(dependent)
+		require.NoError(t, err)
+
+		// deletion is refused before the cluster is touched
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*obj}}, nil)
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(activeProjectID).Return(resource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource)
+
+		server := NewServer(mockedk8sclient)
+
+		req := httptest.NewRequest("DELETE", fmt.Sprintf("/v2/clusters/%s", name), nil)
+		req.Header.Set("Activeprojectid", activeProjectID)
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		requireCode(t, messages.ClusterHasDependents, rr.Body.Bytes())
+	})
+}
+
+type fakeDrainer struct {
+	err     error
+	drained []string
+}
+
+func (f *fakeDrainer) Drain(_ context.Context, projectID, clusterName string, nodeNames ...string) error {
+	if projectID != activeProjectID || clusterName != "example-cluster" {
+		return fmt.Errorf("unexpected cluster %s/%s", projectID, clusterName)
+	}
+	f.drained = append(f.drained, nodeNames...)
+	return f.err
+}
+
+// drainedMachines mocks the machines of a cluster whose nodes are drained, one of them has no node yet
+func drainedMachines(t *testing.T) *k8s.MockResourceInterface {
+	var items []unstructured.Unstructured
+	for name, nodeName := range map[string]string{"example-cluster-cp-1": "edge-node-1", "example-cluster-cp-2": ""} {
+		machine := capi.Machine{
+			TypeMeta:   metav1.TypeMeta{APIVersion: core.MachineResourceSchema.GroupVersion().String(), Kind: "Machine"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: activeProjectID},
+		}
+		if nodeName != "" {
+			machine.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: nodeName}
+		}
+		obj, err := convert.ToUnstructured(machine)
+		require.NoError(t, err)
+		items = append(items, *obj)
+	}
+
+	machines := k8s.NewMockResourceInterface(t)
+	machines.EXPECT().List(mock.Anything, metav1.ListOptions{LabelSelector: "cluster.x-k8s.io/cluster-name=example-cluster"}).Return(&unstructured.UnstructuredList{Items: items}, nil)
+	return machines
+}
+
+func TestDeleteV2ClustersNameDrain(t *testing.T) {
+	t.Run("Nodes Are Drained Before Deletion", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		clusters.EXPECT().Delete(mock.Anything, "example-cluster", metav1.DeleteOptions{}).Return(nil)
+
+		drainer := &fakeDrainer{}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: drainedMachines(t),
+		}, http.MethodDelete, "/v2/clusters/example-cluster", nil, WithNodeDrainer(drainer))
+		assert.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		assert.Equal(t, []string{"edge-node-1"}, drainer.drained)
+	})
+
+	t.Run("Failed Drain Keeps The Cluster", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: drainedMachines(t),
+		}, http.MethodDelete, "/v2/clusters/example-cluster", nil, WithNodeDrainer(&fakeDrainer{err: drain.ErrTimeout}))
+		assert.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterDrainFailed, rr.Body.Bytes())
+	})
+
+	t.Run("Forced Deletion Skips The Drain", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		clusters.EXPECT().Delete(mock.Anything, "example-cluster", metav1.DeleteOptions{}).Return(nil)
+
+		drainer := &fakeDrainer{err: drain.ErrTimeout}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster?force=true", nil, WithNodeDrainer(drainer))
+		assert.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		assert.Empty(t, drainer.drained)
+	})
+}
+
+func TestDeleteV2ClustersNameDeleting(t *testing.T) {
+	t.Run("Deleting Cluster Is Not Drained Again", func(t *testing.T) {
+		deleting := &unstructured.Unstructured{}
+		deleting.SetName("example-cluster")
+		deleting.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(deleting, nil)
+		clusters.EXPECT().Delete(mock.Anything, "example-cluster", metav1.DeleteOptions{}).Return(nil)
+
+		drainer := &fakeDrainer{err: drain.ErrTimeout}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster", nil, WithNodeDrainer(drainer))
+		assert.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		assert.Empty(t, drainer.drained)
+	})
+
+	t.Run("Force Finalization Is Requested", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		var patch map[string]map[string]map[string]string
+		clusters.EXPECT().Patch(mock.Anything, "example-cluster", types.MergePatchType, mock.Anything, metav1.PatchOptions{}).
+			RunAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+				require.NoError(t, json.Unmarshal(data, &patch))
+				return &unstructured.Unstructured{}, nil
+			})
+		clusters.EXPECT().Delete(mock.Anything, "example-cluster", metav1.DeleteOptions{}).Return(nil)
+
+		before := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster?force=true&forceFinalize=true", nil, WithConfig(&config.Config{ForceDeleteTimeout: 30 * time.Minute}))
+		assert.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		forceFinalizeAt, err := time.Parse(time.RFC3339, patch["metadata"]["annotations"][deletion.ForceFinalizeAtAnnotationKey])
+		require.NoError(t, err)
+		assert.False(t, forceFinalizeAt.Before(before))
+		assert.True(t, forceFinalizeAt.Before(before.Add(time.Minute)))
+	})
+}
+
+func TestDeleteV2ClustersNameGracePeriod(t *testing.T) {
+	gracePeriod := WithConfig(&config.Config{ClusterDeleteGracePeriod: time.Hour, ForceDeleteTimeout: 30 * time.Minute})
+
+	t.Run("Cluster Is Pending Deletion For The Grace Period", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		var patch map[string]map[string]map[string]string
+		clusters.EXPECT().Patch(mock.Anything, "example-cluster", types.MergePatchType, mock.Anything, metav1.PatchOptions{}).
+			RunAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+				require.NoError(t, json.Unmarshal(data, &patch))
+				return &unstructured.Unstructured{}, nil
+			})
+
+		drainer := &fakeDrainer{}
+		before := time.Now().Add(time.Hour).Truncate(time.Second)
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster?forceFinalize=true", nil, gracePeriod, WithNodeDrainer(drainer))
+		assert.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		assert.Empty(t, drainer.drained)
+
+		deleteAt, err := time.Parse(time.RFC3339, patch["metadata"]["annotations"][deletion.DeleteAtAnnotationKey])
+		require.NoError(t, err)
+		assert.False(t, deleteAt.Before(before))
+		assert.True(t, deleteAt.Before(before.Add(time.Minute)))
+		forceFinalizeAt, err := time.Parse(time.RFC3339, patch["metadata"]["annotations"][deletion.ForceFinalizeAtAnnotationKey])
+		require.NoError(t, err)
+		assert.Equal(t, deleteAt.Add(30*time.Minute), forceFinalizeAt)
+	})
+
+	t.Run("Grace Period Of A Cluster Pending Deletion Is Kept", func(t *testing.T) {
+		deleteAt := time.Now().Add(10 * time.Minute).UTC().Truncate(time.Second).Format(time.RFC3339)
+		pending := &unstructured.Unstructured{}
+		pending.SetName("example-cluster")
+		pending.SetAnnotations(map[string]string{deletion.DeleteAtAnnotationKey: deleteAt})
+
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(pending, nil)
+		var patch map[string]map[string]map[string]string
+		clusters.EXPECT().Patch(mock.Anything, "example-cluster", types.MergePatchType, mock.Anything, metav1.PatchOptions{}).
+			RunAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+				require.NoError(t, json.Unmarshal(data, &patch))
+				return pending, nil
+			})
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster", nil, gracePeriod)
+		assert.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		assert.Equal(t, deleteAt, patch["metadata"]["annotations"][deletion.DeleteAtAnnotationKey])
+	})
+
+	t.Run("Purged Cluster Is Deleted Right Away", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		clusters.EXPECT().Delete(mock.Anything, "example-cluster", metav1.DeleteOptions{}).Return(nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster?purge=true", nil, gracePeriod)
+		assert.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Missing Cluster Is Not Found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			Return(nil, errors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster", nil, gracePeriod)
+		assert.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
+
+func TestDeletePendingCluster(t *testing.T) {
+	mockedClient := func(t *testing.T, resources map[schema.GroupVersionResource]*k8s.MockResourceInterface) *k8s.MockInterface {
+		mockedk8sclient := k8s.NewMockInterface(t)
+		for resourceSchema, resource := range resources {
+			nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+			nsResource.EXPECT().Namespace(activeProjectID).Return(resource)
+			mockedk8sclient.EXPECT().Resource(resourceSchema).Return(nsResource)
+		}
+		return mockedk8sclient
+	}
+
+	t.Run("Pending Cluster Is Deleted After A Best Effort Drain", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		clusters.EXPECT().Delete(mock.Anything, "example-cluster", metav1.DeleteOptions{}).Return(nil)
+
+		drainer := &fakeDrainer{err: drain.ErrTimeout}
+		ops := &fakeOperations{}
+		server := NewServer(mockedClient(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: drainedMachines(t),
+		}), WithConfig(&config.Config{ClusterDeleteGracePeriod: time.Hour}), WithNodeDrainer(drainer), WithOperations(ops))
+
+		require.NoError(t, server.DeletePendingCluster(context.Background(), activeProjectID, "example-cluster"))
+		assert.Equal(t, []string{"edge-node-1"}, drainer.drained)
+		require.Len(t, ops.started, 1)
+		assert.Equal(t, operations.Delete, ops.started[0].Type)
+		assert.Equal(t, "example-cluster", ops.started[0].Cluster)
+	})
+
+	t.Run("Removed Cluster Is Deleted", func(t *testing.T) {
+		notFound := errors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster")
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(nil, notFound)
+
+		server := NewServer(mockedClient(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}))
+		require.NoError(t, server.DeletePendingCluster(context.Background(), activeProjectID, "example-cluster"))
+	})
+
+	t.Run("Cluster With Dependents Is Kept", func(t *testing.T) {
+		dependent := capi.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: core.ClusterResourceSchema.GroupVersion().String(), Kind: "Cluster"},
+			ObjectMeta: metav1.ObjectMeta{Name: "app-cluster", Namespace: activeProjectID},
+		}
+		cluster.SetDependencies(&dependent, []string{"example-cluster"})
+		obj, err := convert.ToUnstructured(dependent)
+		require.NoError(t, err)
+
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*obj}}, nil)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+
+		ops := &fakeOperations{}
+		server := NewServer(mockedClient(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}), WithOperations(ops))
+		err = server.DeletePendingCluster(context.Background(), activeProjectID, "example-cluster")
+		require.ErrorContains(t, err, "app-cluster")
+		assert.Empty(t, ops.started)
 	})
 }
 
@@ -132,6 +477,7 @@ func TestDeleteV2ClustersName500(t *testing.T) {
 
 		// Mock the get cluster to succeed and delete cluster to fail
 		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
 		resource.EXPECT().Get(mock.Anything, name, metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
 		resource.EXPECT().Delete(mock.Anything, name, metav1.DeleteOptions{}).Return(fmt.Errorf("delete error"))
 		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
@@ -155,22 +501,20 @@ func TestDeleteV2ClustersName500(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		expectedResponse := `{"message":"failed to delete cluster"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterDeleteFailed, rr.Body.Bytes())
 	})
 }
 
 func createDeleteV2ClustersNameStubServer(t *testing.T) *Server {
 	resource := k8s.NewMockResourceInterface(t)
+	resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil).Maybe()
 	resource.EXPECT().Get(mock.Anything, mock.Anything, metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil).Maybe()
 	resource.EXPECT().Delete(mock.Anything, mock.Anything, metav1.DeleteOptions{}).Return(nil).Maybe()
 	nsResource := k8s.NewMockNamespaceableResourceInterface(t)
 	nsResource.EXPECT().Namespace(mock.Anything).Return(resource).Maybe()
 	mockedk8sclient := k8s.NewMockInterface(t)
 	mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource).Maybe()
-	return &Server{
-		k8sclient: mockedk8sclient,
-	}
+	return NewServer(mockedk8sclient)
 }
 
 func FuzzDeleteV2ClustersName(f *testing.F) {
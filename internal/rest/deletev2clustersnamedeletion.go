@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (DELETE /v2/clusters/{name}/deletion)
+func (s *Server) DeleteV2ClustersNameDeletion(ctx context.Context, request api.DeleteV2ClustersNameDeletionRequestObject) (api.DeleteV2ClustersNameDeletionResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2ClustersNameDeletion500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cluster, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameDeletion404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameDeletion500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// only the deletion of a cluster pending deletion can be canceled, a deleting cluster is torn down already
+	if _, ok := deletion.DeleteAt(cluster); !ok || cluster.DeletionTimestamp != nil {
+		message := messages.New(messages.ClusterNotPendingDelete, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameDeletion409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+
+	err = s.patchClusterAnnotations(ctx, activeProjectID, request.Name, map[string]any{
+		deletion.DeleteAtAnnotationKey:        nil,
+		deletion.ForceFinalizeAtAnnotationKey: nil,
+	})
+	if k8serrors.IsNotFound(err) {
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		return api.DeleteV2ClustersNameDeletion404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	}
+	if err != nil {
+		message := messages.New(messages.ClusterDeleteCancelFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameDeletion500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster deletion canceled", "namespace", activeProjectID, "name", request.Name)
+	return api.DeleteV2ClustersNameDeletion204Response{}, nil
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+)
+
+func TestDeleteV2ClustersNameDeletion(t *testing.T) {
+	t.Run("deletion of a cluster pending deletion is canceled", func(t *testing.T) {
+		cluster := nodePoolCluster(t)
+		annotations := cluster.GetAnnotations()
+		annotations[deletion.DeleteAtAnnotationKey] = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		cluster.SetAnnotations(annotations)
+
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(cluster, nil)
+		clusters.EXPECT().Patch(mock.Anything, "example-cluster", types.MergePatchType, mock.MatchedBy(func(data []byte) bool {
+			var patch struct {
+				Metadata struct {
+					Annotations map[string]*string `json:"annotations"`
+				} `json:"metadata"`
+			}
+			if json.Unmarshal(data, &patch) != nil {
+				return false
+			}
+			deleteAt, ok := patch.Metadata.Annotations[deletion.DeleteAtAnnotationKey]
+			return ok && deleteAt == nil
+		}), v1.PatchOptions{}).Return(cluster, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster/deletion", nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+	})
+
+	t.Run("deletion of a cluster not pending deletion can not be canceled", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster/deletion", nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("deletion of a deleting cluster can not be canceled", func(t *testing.T) {
+		cluster := nodePoolCluster(t)
+		annotations := cluster.GetAnnotations()
+		annotations[deletion.DeleteAtAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+		cluster.SetAnnotations(annotations)
+		cluster.SetDeletionTimestamp(&v1.Time{Time: time.Now()})
+
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(cluster, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster/deletion", nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNotPendingDelete, rr.Body.Bytes())
+	})
+
+	t.Run("missing cluster is not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster/deletion", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
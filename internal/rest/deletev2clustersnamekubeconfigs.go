@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// revokeKubeconfigTokensFunc revokes the kubeconfig tokens issued before the given time, replaced in tests
+var revokeKubeconfigTokensFunc = revokeKubeconfigTokens
+
+// (DELETE /v2/clusters/{name}/kubeconfigs)
+func (s *Server) DeleteV2ClustersNameKubeconfigs(ctx context.Context, request api.DeleteV2ClustersNameKubeconfigsRequestObject) (api.DeleteV2ClustersNameKubeconfigsResponseObject, error) {
+	namespace := request.Params.Activeprojectid.String()
+
+	if !strings.HasPrefix(request.Params.Authorization, auth.BearerPrefix) {
+		message := messages.New(messages.InvalidAuthorizationHeader)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.DeleteV2ClustersNameKubeconfigs401JSONResponse{N401UnauthorizedJSONResponse: api.N401UnauthorizedJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// only the kubeconfigs of existing clusters are revoked
+	if _, err := s.getClusterKubeconfig(ctx, namespace, request.Name); err != nil {
+		message := messages.New(messages.KubeconfigNotFound)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "name", request.Name, "error", err)
+		return api.DeleteV2ClustersNameKubeconfigs404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// without authentication the kubeconfigs embed the tokens of the users, which are not issued by the cluster manager
+	if s.config.DisableAuth {
+		logger.FromContext(ctx).Debug("authentication disabled, skipping kubeconfig revocation", "namespace", namespace, "name", request.Name)
+		return api.DeleteV2ClustersNameKubeconfigs204Response{}, nil
+	}
+
+	err := revokeKubeconfigTokensFunc(ctx, time.Now())
+	if errors.Is(err, auth.ErrUnsupported) {
+		message := messages.New(messages.KubeconfigRevokeNotSupported, auth.GetOIDCProvider().Name())
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "name", request.Name)
+		return api.DeleteV2ClustersNameKubeconfigs501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, message))}, nil
+	}
+	if err != nil {
+		message := messages.New(messages.KubeconfigRevokeFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.DeleteV2ClustersNameKubeconfigs500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("kubeconfigs revoked", "namespace", namespace, "name", request.Name)
+	return api.DeleteV2ClustersNameKubeconfigs204Response{}, nil
+}
+
+// revokeKubeconfigTokens revokes the tokens issued before the given time for the M2M client the kubeconfig tokens are
+// issued for, and drops its cached credentials so that they are loaded from their backend again, e.g. once they are
+// rotated
+func revokeKubeconfigTokens(ctx context.Context, notBefore time.Time) error {
+	issuer := os.Getenv(auth.OidcUrlEnvVar)
+	if issuer == "" {
+		issuer = os.Getenv(auth.KeycloakUrlEnvVar)
+	}
+	if issuer == "" {
+		return fmt.Errorf("%s (or %s) environment variable not set", auth.OidcUrlEnvVar, auth.KeycloakUrlEnvVar)
+	}
+
+	if err := auth.EnsureM2MCredentials(false); err != nil {
+		return fmt.Errorf("failed to load M2M credentials: %w", err)
+	}
+	adminToken, err := JwtTokenWithM2MAdminFunc(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get M2M admin token: %w", err)
+	}
+
+	if err := auth.GetOIDCProvider().RevokeTokens(ctx, issuer, auth.GetM2MClientID(), notBefore, adminToken); err != nil {
+		return err
+	}
+	auth.InvalidateM2MCredentials()
+	return nil
+}
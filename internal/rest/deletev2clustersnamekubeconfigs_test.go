@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+)
+
+func TestDeleteV2ClustersNameKubeconfigs(t *testing.T) {
+	activeProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	encodedKubeconfig := base64.StdEncoding.EncodeToString([]byte(exampleKubeconfig))
+
+	mockRevocation := func(t *testing.T, err error) *[]time.Time {
+		var revoked []time.Time
+		original := revokeKubeconfigTokensFunc
+		revokeKubeconfigTokensFunc = func(_ context.Context, notBefore time.Time) error {
+			revoked = append(revoked, notBefore)
+			return err
+		}
+		t.Cleanup(func() { revokeKubeconfigTokensFunc = original })
+		return &revoked
+	}
+
+	t.Run("tokens issued before are revoked", func(t *testing.T) {
+		revoked := mockRevocation(t, nil)
+		mockedk8sclient, _, _ := mockK8sClient(t, "example-cluster", encodedKubeconfig, nil)
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+		req, rr := createRequestAndRecorder(t, http.MethodDelete, "/v2/clusters/example-cluster/kubeconfigs", activeProjectID, jwtToken)
+
+		start := time.Now()
+		configureHandlerAndServe(t, server, rr, req)
+
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		require.Len(t, *revoked, 1)
+		assert.False(t, (*revoked)[0].Before(start))
+	})
+
+	t.Run("nothing to revoke without authentication", func(t *testing.T) {
+		revoked := mockRevocation(t, nil)
+		mockedk8sclient, _, _ := mockK8sClient(t, "example-cluster", encodedKubeconfig, nil)
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal", DisableAuth: true}))
+		req, rr := createRequestAndRecorder(t, http.MethodDelete, "/v2/clusters/example-cluster/kubeconfigs", activeProjectID, jwtToken)
+
+		configureHandlerAndServe(t, server, rr, req)
+
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		assert.Empty(t, *revoked)
+	})
+
+	t.Run("cluster not found", func(t *testing.T) {
+		revoked := mockRevocation(t, nil)
+		mockedk8sclient, _, _ := mockK8sClient(t, "example-cluster", "", func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface) {
+			mockK8sClientSetup(resource, nsResource, mockedk8sclient, "example-cluster-kubeconfig", &unstructured.Unstructured{},
+				errors.NewNotFound(core.SecretResourceSchema.GroupResource(), "example-cluster-kubeconfig"))
+		})
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+		req, rr := createRequestAndRecorder(t, http.MethodDelete, "/v2/clusters/example-cluster/kubeconfigs", activeProjectID, jwtToken)
+
+		configureHandlerAndServe(t, server, rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.KubeconfigNotFound, rr.Body.Bytes())
+		assert.Empty(t, *revoked)
+	})
+
+	t.Run("revocation failed", func(t *testing.T) {
+		mockRevocation(t, assert.AnError)
+		mockedk8sclient, _, _ := mockK8sClient(t, "example-cluster", encodedKubeconfig, nil)
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+		req, rr := createRequestAndRecorder(t, http.MethodDelete, "/v2/clusters/example-cluster/kubeconfigs", activeProjectID, jwtToken)
+
+		configureHandlerAndServe(t, server, rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.KubeconfigRevokeFailed, rr.Body.Bytes())
+	})
+	t.Run("revocation not supported by the oidc provider", func(t *testing.T) {
+		mockRevocation(t, auth.ErrUnsupported)
+		mockedk8sclient, _, _ := mockK8sClient(t, "example-cluster", encodedKubeconfig, nil)
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+		req, rr := createRequestAndRecorder(t, http.MethodDelete, "/v2/clusters/example-cluster/kubeconfigs", activeProjectID, jwtToken)
+
+		configureHandlerAndServe(t, server, rr, req)
+
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+		requireCode(t, messages.KubeconfigRevokeNotSupported, rr.Body.Bytes())
+	})
+}
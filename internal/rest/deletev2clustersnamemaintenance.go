@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (DELETE /v2/clusters/{name}/maintenance)
+func (s *Server) DeleteV2ClustersNameMaintenance(ctx context.Context, request api.DeleteV2ClustersNameMaintenanceRequestObject) (api.DeleteV2ClustersNameMaintenanceResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cluster, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameMaintenance404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// clusters that are not in maintenance are left alone, they may be paused or have cordoned nodes for other reasons
+	if clusterMaintenance(cluster) == nil {
+		message := messages.New(messages.ClusterNotInMaintenance, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameMaintenance409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the nodes are uncordoned first, so that the maintenance is kept and can be ended again if that fails
+	if err := s.cordonClusterNodes(ctx, activeProjectID, request.Name, false); err != nil {
+		message := messages.New(messages.MaintenanceUncordonFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	err = cli.EndClusterMaintenance(ctx, activeProjectID, request.Name, cluster.ResourceVersion)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameMaintenance404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsConflict(err):
+		message := messages.New(messages.ClusterModified, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "error", err)
+		return api.DeleteV2ClustersNameMaintenance409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.MaintenanceEndFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster maintenance ended", "namespace", activeProjectID, "cluster", request.Name)
+	return api.DeleteV2ClustersNameMaintenance204Response{}, nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+)
+
+func TestDeleteV2ClustersNameMaintenance(t *testing.T) {
+	t.Run("cluster is uncordoned, resumed and its maintenance removed", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(maintenanceCluster(t, "2026-10-16T08:00:00Z"), nil)
+		clusters.EXPECT().Patch(mock.Anything, "example-cluster", types.MergePatchType, maintenancePatch(false), v1.PatchOptions{}).Return(maintenanceCluster(t, ""), nil)
+
+		cordoner := &fakeCordoner{}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: drainedMachines(t),
+		}, http.MethodDelete, "/v2/clusters/example-cluster/maintenance", nil, WithNodeCordoner(cordoner))
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		assert.Equal(t, []string{"edge-node-1"}, cordoner.cordoned)
+		require.NotNil(t, cordoner.unschedulable)
+		assert.False(t, *cordoner.unschedulable)
+	})
+
+	t.Run("clusters that are not in maintenance are left alone", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(maintenanceCluster(t, ""), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodDelete, "/v2/clusters/example-cluster/maintenance", nil, WithNodeCordoner(&fakeCordoner{}))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNotInMaintenance, rr.Body.Bytes())
+	})
+
+	t.Run("failed uncordon keeps the maintenance", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(maintenanceCluster(t, "2026-10-16T08:00:00Z"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: drainedMachines(t),
+		}, http.MethodDelete, "/v2/clusters/example-cluster/maintenance", nil, WithNodeCordoner(&fakeCordoner{err: errors.New("connection refused")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.MaintenanceUncordonFailed, rr.Body.Bytes())
+	})
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (DELETE /v2/pending-clusters/{name})
+func (s *Server) DeleteV2PendingClustersName(ctx context.Context, request api.DeleteV2PendingClustersNameRequestObject) (api.DeleteV2PendingClustersNameResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.pending == nil {
+		return api.DeleteV2PendingClustersName501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.DeferredProvisioningDisabled)))}, nil
+	}
+
+	err := s.pending.Cancel(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, scheduling.ErrPendingClusterNotFound):
+		message := messages.New(messages.PendingClusterNotFound, request.Name)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2PendingClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, scheduling.ErrProvisioning):
+		message := messages.New(messages.PendingClusterCancelFailed, request.Name, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2PendingClustersName409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.PendingClusterCancelFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2PendingClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("Pending cluster canceled", "namespace", activeProjectID, "name", request.Name)
+	return api.DeleteV2PendingClustersName204Response{}, nil
+}
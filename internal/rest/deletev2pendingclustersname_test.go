@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+)
+
+func TestDeleteV2PendingClustersName(t *testing.T) {
+	t.Run("pending cluster is canceled", func(t *testing.T) {
+		pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{scheduledCluster}}
+		rr := serveRequest(t, nil, http.MethodDelete, "/v2/pending-clusters/cluster-1", nil, WithPendingClusters(pending))
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		require.Equal(t, []string{"cluster-1"}, pending.canceled)
+	})
+
+	t.Run("pending cluster is being provisioned", func(t *testing.T) {
+		pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{provisioningCluster}}
+		rr := serveRequest(t, nil, http.MethodDelete, "/v2/pending-clusters/cluster-3", nil, WithPendingClusters(pending))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClusterCancelFailed, rr.Body.Bytes())
+		require.Empty(t, pending.canceled)
+	})
+
+	t.Run("pending cluster not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodDelete, "/v2/pending-clusters/cluster-1", nil, WithPendingClusters(&fakePendingClusters{}))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClusterNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("failed to cancel pending cluster", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodDelete, "/v2/pending-clusters/cluster-1", nil, WithPendingClusters(&fakePendingClusters{err: errors.New("forbidden")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClusterCancelFailed, rr.Body.Bytes())
+	})
+
+	t.Run("deferred provisioning not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodDelete, "/v2/pending-clusters/cluster-1", nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+	})
+}
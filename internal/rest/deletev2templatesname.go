@@ -4,19 +4,19 @@ package rest
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 // (DELETE /v2/templates/{name}/{version})
 func (s *Server) DeleteV2TemplatesNameVersion(ctx context.Context, request api.DeleteV2TemplatesNameVersionRequestObject) (api.DeleteV2TemplatesNameVersionResponseObject, error) {
-	slog.Debug("DeleteV2TemplatesNameVersion", "request", request)
+	logger.FromContext(ctx).Debug("DeleteV2TemplatesNameVersion", "request", request)
 
 	activeProjectID := request.Params.Activeprojectid.String()
 
@@ -25,23 +25,23 @@ func (s *Server) DeleteV2TemplatesNameVersion(ctx context.Context, request api.D
 	err := s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Delete(ctx, templateName, v1.DeleteOptions{})
 	switch {
 	case errors.IsBadRequest(err):
-		message := fmt.Sprintf("Template '%s' is invalid: %v", templateName, err)
-		slog.Error(message)
-		return api.DeleteV2TemplatesNameVersion400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.TemplateInvalid, templateName, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2TemplatesNameVersion400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	case errors.IsNotFound(err):
-		message := fmt.Sprintf("Template '%s' not found: %v", templateName, err)
-		slog.Error(message)
-		return api.DeleteV2TemplatesNameVersion404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.TemplateNotFound, templateName)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2TemplatesNameVersion404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
 	case errors.IsConflict(err):
-		message := fmt.Sprintf("Template '%s' is in use: %v", templateName, err)
-		slog.Error(message)
-		return api.DeleteV2TemplatesNameVersion409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.TemplateInUse, templateName, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2TemplatesNameVersion409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
 	case err != nil:
-		message := fmt.Sprintf("Failed to delete template '%s': %v", templateName, err)
-		slog.Error(message)
-		return api.DeleteV2TemplatesNameVersion500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.TemplateDeleteFailed, templateName, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.DeleteV2TemplatesNameVersion500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
-	slog.Info("deleted clusterTemplate", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "name", templateName)
+	logger.FromContext(ctx).Info("deleted clusterTemplate", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "name", templateName)
 	return api.DeleteV2TemplatesNameVersion204Response{}, nil
 }
@@ -16,16 +16,17 @@ import (
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 func TestDeleteV2TemplatesNameVersion(t *testing.T) {
 	expectedActiveProjectID := "28f35814-dd5d-11ef-93a6-17b771bdd27a"
 	tests := []struct {
-		name                 string
-		expectedStatusCode   int
-		expectedErrorMessage string
-		mockDeleteReturn     error
+		name               string
+		expectedStatusCode int
+		expectedCode       messages.Code
+		mockDeleteReturn   error
 	}{
 		{
 			name:               "204 No Content",
@@ -33,9 +34,9 @@ func TestDeleteV2TemplatesNameVersion(t *testing.T) {
 			mockDeleteReturn:   nil,
 		},
 		{
-			name:                 "400 Bad Request",
-			expectedStatusCode:   http.StatusBadRequest,
-			expectedErrorMessage: "Bad request",
+			name:               "400 Bad Request",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedCode:       messages.TemplateInvalid,
 			mockDeleteReturn: &errors.StatusError{
 				ErrStatus: metav1.Status{
 					Status:  metav1.StatusFailure,
@@ -46,9 +47,9 @@ func TestDeleteV2TemplatesNameVersion(t *testing.T) {
 			},
 		},
 		{
-			name:                 "404 Not Found",
-			expectedStatusCode:   http.StatusNotFound,
-			expectedErrorMessage: "Is not found",
+			name:               "404 Not Found",
+			expectedStatusCode: http.StatusNotFound,
+			expectedCode:       messages.TemplateNotFound,
 			mockDeleteReturn: &errors.StatusError{
 				ErrStatus: metav1.Status{
 					Status:  metav1.StatusFailure,
@@ -59,9 +60,9 @@ func TestDeleteV2TemplatesNameVersion(t *testing.T) {
 			},
 		},
 		{
-			name:                 "409 Conflict - template in use",
-			expectedStatusCode:   http.StatusConflict,
-			expectedErrorMessage: "Is in use",
+			name:               "409 Conflict - template in use",
+			expectedStatusCode: http.StatusConflict,
+			expectedCode:       messages.TemplateInUse,
 			mockDeleteReturn: &errors.StatusError{
 				ErrStatus: metav1.Status{
 					Status:  metav1.StatusFailure,
@@ -72,9 +73,9 @@ func TestDeleteV2TemplatesNameVersion(t *testing.T) {
 			},
 		},
 		{
-			name:                 "500 Internal Server Error",
-			expectedStatusCode:   http.StatusInternalServerError,
-			expectedErrorMessage: "Internal server error",
+			name:               "500 Internal Server Error",
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedCode:       messages.TemplateDeleteFailed,
 			mockDeleteReturn: &errors.StatusError{
 				ErrStatus: metav1.Status{
 					Status:  metav1.StatusFailure,
@@ -116,23 +117,24 @@ func TestDeleteV2TemplatesNameVersion(t *testing.T) {
 			require.Equal(t, tt.expectedStatusCode, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, tt.expectedStatusCode)
 
 			// check the response body
-			if tt.expectedErrorMessage != "" {
+			if tt.expectedCode != "" {
 				resp, err := api.ParseDeleteV2TemplatesNameVersionResponse(rr.Result())
 				require.NoError(t, err, "json.ParseDeleteV2TemplatesNameVersionResponse() error = %v, want nil", err)
 
-				var actualMessage *string
+				var problem *api.ProblemDetails
 				switch rr.Code {
 				case http.StatusBadRequest:
-					actualMessage = resp.JSON400.Message
+					problem = resp.JSON400
 				case http.StatusNotFound:
-					actualMessage = resp.JSON404.Message
+					problem = resp.JSON404
 				case http.StatusConflict:
-					actualMessage = resp.JSON409.Message
+					problem = resp.JSON409
 				case http.StatusInternalServerError:
-					actualMessage = resp.JSON500.Message
+					problem = resp.JSON500
 				}
 
-				require.Contains(t, *actualMessage, tt.expectedErrorMessage, "ServeHTTP() body = %v, want %v", *actualMessage, tt.expectedErrorMessage)
+				require.NotNil(t, problem, "ServeHTTP() body = %v", rr.Body.String())
+				requireProblemCode(t, tt.expectedCode, *problem)
 			}
 		})
 	}
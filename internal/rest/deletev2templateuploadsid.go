@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (DELETE /v2/template-uploads/{id})
+func (s *Server) DeleteV2TemplateUploadsId(ctx context.Context, request api.DeleteV2TemplateUploadsIdRequestObject) (api.DeleteV2TemplateUploadsIdResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.uploads == nil {
+		return api.DeleteV2TemplateUploadsId501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.TemplateUploadsDisabled)))}, nil
+	}
+
+	err := s.uploads.Delete(ctx, activeProjectID, request.Id.String())
+	switch {
+	case errors.Is(err, uploads.ErrSessionNotFound):
+		message := messages.New(messages.TemplateUploadNotFound, request.Id)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2TemplateUploadsId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateUploadDeleteFailed, request.Id, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.DeleteV2TemplateUploadsId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("template upload aborted", "namespace", activeProjectID, "id", request.Id)
+	return api.DeleteV2TemplateUploadsId204Response{}, nil
+}
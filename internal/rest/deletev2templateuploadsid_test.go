@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+)
+
+func TestDeleteV2TemplateUploadsId(t *testing.T) {
+	t.Run("upload aborted", func(t *testing.T) {
+		store, session := startTemplateUpload(t, "name: test")
+		rr := serveRequest(t, nil, http.MethodDelete, "/v2/template-uploads/"+session.ID, nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		_, err := store.Get(context.Background(), activeProjectID, session.ID)
+		require.ErrorIs(t, err, uploads.ErrSessionNotFound)
+	})
+
+	t.Run("upload not found", func(t *testing.T) {
+		store, _ := startTemplateUpload(t, "name: test")
+		rr := serveRequest(t, nil, http.MethodDelete, "/v2/template-uploads/"+unknownTemplateUploadID, nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateUploadNotFound, rr.Body.Bytes())
+	})
+}
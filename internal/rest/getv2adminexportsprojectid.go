@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/offboarding"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/admin/exports/{projectId})
+func (s *Server) GetV2AdminExportsProjectId(ctx context.Context, request api.GetV2AdminExportsProjectIdRequestObject) (api.GetV2AdminExportsProjectIdResponseObject, error) {
+	projectID := request.ProjectId.String()
+
+	if s.exports == nil {
+		return api.GetV2AdminExportsProjectId501JSONResponse{
+			N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.ProjectExportDisabled))),
+		}, nil
+	}
+
+	bundle, err := s.exports.Open(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, offboarding.ErrBundleNotFound) {
+			return api.GetV2AdminExportsProjectId404JSONResponse{
+				N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.ExportBundleNotFound))),
+			}, nil
+		}
+		logger.FromContext(ctx).Error("failed to open project export bundle", "project_id", projectID, "error", err)
+		return api.GetV2AdminExportsProjectId500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ExportBundleFailed, err))),
+		}, nil
+	}
+
+	return api.GetV2AdminExportsProjectId200ApplicationgzipResponse{
+		Body: bundle,
+	}, nil
+}
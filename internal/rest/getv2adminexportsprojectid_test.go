@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/offboarding"
+)
+
+type fakeExportStore struct {
+	bundles map[string]string
+	err     error
+}
+
+func (f fakeExportStore) Open(_ context.Context, projectID string) (io.ReadCloser, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	bundle, ok := f.bundles[projectID]
+	if !ok {
+		return nil, offboarding.ErrBundleNotFound
+	}
+	return io.NopCloser(strings.NewReader(bundle)), nil
+}
+
+func TestGetV2AdminExportsProjectId200(t *testing.T) {
+	store := fakeExportStore{bundles: map[string]string{activeProjectID: "bundle"}}
+	server := NewServer(k8s.NewMockInterface(t), WithExportStore(store))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	// admin endpoints do not require an active project id
+	req := httptest.NewRequest("GET", "/v2/admin/exports/"+activeProjectID, nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.Equal(t, "application/gzip", rr.Header().Get("Content-Type"))
+	require.Equal(t, "bundle", rr.Body.String())
+}
+
+func TestGetV2AdminExportsProjectId400(t *testing.T) {
+	server := NewServer(k8s.NewMockInterface(t), WithExportStore(fakeExportStore{}))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/admin/exports/not-a-uuid", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetV2AdminExportsProjectId404(t *testing.T) {
+	server := NewServer(k8s.NewMockInterface(t), WithExportStore(fakeExportStore{}))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/admin/exports/"+activeProjectID, nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	requireCode(t, messages.ExportBundleNotFound, rr.Body.Bytes())
+}
+
+func TestGetV2AdminExportsProjectId500(t *testing.T) {
+	server := NewServer(k8s.NewMockInterface(t), WithExportStore(fakeExportStore{err: errors.New("permission denied")}))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/admin/exports/"+activeProjectID, nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestGetV2AdminExportsProjectId501(t *testing.T) {
+	server := NewServer(k8s.NewMockInterface(t))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/admin/exports/"+activeProjectID, nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusNotImplemented, rr.Code)
+}
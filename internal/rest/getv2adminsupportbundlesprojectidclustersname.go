@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/admin/support-bundles/{projectId}/clusters/{name})
+func (s *Server) GetV2AdminSupportBundlesProjectIdClustersName(ctx context.Context, request api.GetV2AdminSupportBundlesProjectIdClustersNameRequestObject) (api.GetV2AdminSupportBundlesProjectIdClustersNameResponseObject, error) {
+	projectID := request.ProjectId.String()
+
+	if s.bundles == nil {
+		return api.GetV2AdminSupportBundlesProjectIdClustersName501JSONResponse{
+			N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.SupportBundlesDisabled))),
+		}, nil
+	}
+
+	// the bundle is buffered so that a failure does not leave the client with a truncated tarball
+	var bundle bytes.Buffer
+	if _, err := s.bundles.Collect(ctx, projectID, request.Name, &bundle); err != nil {
+		if errors.Is(err, k8s.ErrClusterNotFound) {
+			return api.GetV2AdminSupportBundlesProjectIdClustersName404JSONResponse{
+				N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.ClusterNotFound, request.Name))),
+			}, nil
+		}
+		logger.FromContext(ctx).Error("failed to collect support bundle", "project_id", projectID, "cluster", request.Name, "error", err)
+		return api.GetV2AdminSupportBundlesProjectIdClustersName500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.SupportBundleFailed, err))),
+		}, nil
+	}
+
+	return api.GetV2AdminSupportBundlesProjectIdClustersName200ApplicationgzipResponse{
+		Body:          &bundle,
+		ContentLength: int64(bundle.Len()),
+	}, nil
+}
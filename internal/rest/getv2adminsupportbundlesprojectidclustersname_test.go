@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportbundle"
+)
+
+type fakeSupportBundles struct {
+	err error
+}
+
+func (f fakeSupportBundles) Collect(_ context.Context, projectID, clusterName string, w io.Writer) (supportbundle.Manifest, error) {
+	if f.err != nil {
+		return supportbundle.Manifest{}, f.err
+	}
+	_, err := io.WriteString(w, "bundle of "+clusterName+" in "+projectID)
+	return supportbundle.Manifest{ProjectID: projectID, Cluster: clusterName}, err
+}
+
+func TestGetV2AdminSupportBundlesProjectIdClustersName(t *testing.T) {
+	path := "/v2/admin/support-bundles/" + activeProjectID + "/clusters/example-cluster"
+
+	t.Run("bundle is downloaded", func(t *testing.T) {
+		// admin endpoints do not require an active project id
+		req := newRequest(t, http.MethodGet, path, nil)
+		req.Header.Del("Activeprojectid")
+		rr := serveHTTPRequest(t, k8s.NewMockInterface(t), req, WithSupportBundles(fakeSupportBundles{}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, "application/gzip", rr.Header().Get("Content-Type"))
+		require.Equal(t, "bundle of example-cluster in "+activeProjectID, rr.Body.String())
+		require.NotEmpty(t, rr.Header().Get("X-Correlation-ID"))
+	})
+
+	t.Run("missing clusters are not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path, nil, WithSupportBundles(fakeSupportBundles{err: k8s.ErrClusterNotFound}))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("collection failures are internal errors", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path, nil, WithSupportBundles(fakeSupportBundles{err: errors.New("connection refused")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("bundles are not implemented without collector", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path, nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+	})
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/apidocs"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/apichangelog)
+func (s *Server) GetV2Apichangelog(ctx context.Context, request api.GetV2ApichangelogRequestObject) (api.GetV2ApichangelogResponseObject, error) {
+	changelog, err := apidocs.Changelog()
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get api changelog", "error", err)
+		return api.GetV2Apichangelog500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.APIChangelogFailed, err))),
+		}, nil
+	}
+
+	return api.GetV2Apichangelog200JSONResponse(changelog), nil
+}
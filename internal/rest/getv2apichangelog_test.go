@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2Apichangelog200(t *testing.T) {
+	// the changelog is served whether or not the api docs are enabled
+	rr := serveRequest(t, nil, http.MethodGet, "/v2/apichangelog", nil)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	resp, err := api.ParseGetV2ApichangelogResponse(rr.Result())
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.JSON200.Releases)
+
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+	require.Equal(t, swagger.Info.Version, resp.JSON200.Releases[0].Version)
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// permissionChecks are the operations evaluated for the caller, each by a request representative of the operation
+var permissionChecks = []struct {
+	method     string
+	path       string
+	permission func(*api.EffectivePermissions) *bool
+}{
+	{http.MethodGet, "/v2/clusters", func(p *api.EffectivePermissions) *bool { return &p.ListClusters }},
+	{http.MethodPost, "/v2/clusters", func(p *api.EffectivePermissions) *bool { return &p.CreateClusters }},
+	{http.MethodPut, "/v2/clusters/{name}/labels", func(p *api.EffectivePermissions) *bool { return &p.UpdateClusters }},
+	{http.MethodDelete, "/v2/clusters/{name}", func(p *api.EffectivePermissions) *bool { return &p.DeleteClusters }},
+	{http.MethodGet, "/v2/clusters/{name}/kubeconfigs", func(p *api.EffectivePermissions) *bool { return &p.DownloadKubeconfigs }},
+	{http.MethodGet, "/v2/templates", func(p *api.EffectivePermissions) *bool { return &p.ListTemplates }},
+	{http.MethodPost, "/v2/templates", func(p *api.EffectivePermissions) *bool { return &p.ManageTemplates }},
+	{http.MethodPost, "/v2/templates/{name}/{version}/publish", func(p *api.EffectivePermissions) *bool { return &p.PublishTemplates }},
+}
+
+// (GET /v2/authz/self)
+func (s *Server) GetV2AuthzSelf(ctx context.Context, request api.GetV2AuthzSelfRequestObject) (api.GetV2AuthzSelfResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	authHeader := ""
+	if request.Params.Authorization != nil {
+		authHeader = *request.Params.Authorization
+	}
+
+	// authenticators that do not authorize the requests allow every operation
+	authorizer, _ := s.auth.(Authorizer)
+
+	permissions := api.EffectivePermissions{}
+	for _, check := range permissionChecks {
+		allowed := true
+		if authorizer != nil {
+			err := authorizer.Authorize(ctx, authHeader, activeProjectID, check.method, check.path)
+			switch {
+			case errors.Is(err, auth.ErrAuthorizationDenied):
+				allowed = false
+			case err != nil:
+				message := messages.New(messages.PermissionsFailed, err)
+				logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "method", check.method, "path", check.path)
+				return api.GetV2AuthzSelf500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+			}
+		}
+		*check.permission(&permissions) = allowed
+	}
+
+	return api.GetV2AuthzSelf200JSONResponse(permissions), nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// fakeAuthorizer authenticates every request and allows the requests of its allowed methods and paths
+type fakeAuthorizer struct {
+	allowed map[string]bool
+	err     error
+}
+
+func (a fakeAuthorizer) Authenticate(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	return openapi3filter.NoopAuthenticationFunc(ctx, input)
+}
+
+func (a fakeAuthorizer) Authorize(_ context.Context, authHeader, projectId, method, path string) error {
+	if a.err != nil {
+		return a.err
+	}
+	if authHeader != "Bearer token" || projectId != activeProjectID {
+		return errors.New("unexpected authorization input")
+	}
+	if !a.allowed[method+" "+path] {
+		return auth.ErrAuthorizationDenied
+	}
+	return nil
+}
+
+func TestGetV2AuthzSelf(t *testing.T) {
+	serve := func(t *testing.T, server *Server) *httptest.ResponseRecorder {
+		handler, err := server.ConfigureHandler()
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/v2/authz/self", nil)
+		req.Header.Set("Activeprojectid", activeProjectID)
+		req.Header.Set("Authorization", "Bearer token")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("read only", func(t *testing.T) {
+		authorizer := fakeAuthorizer{allowed: map[string]bool{
+			"GET /v2/clusters":                    true,
+			"GET /v2/clusters/{name}/kubeconfigs": true,
+			"GET /v2/templates":                   true,
+		}}
+		rr := serve(t, NewServer(nil, WithAuth(authorizer)))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp, err := api.ParseGetV2AuthzSelfResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, api.EffectivePermissions{
+			ListClusters:        true,
+			DownloadKubeconfigs: true,
+			ListTemplates:       true,
+		}, *resp.JSON200)
+	})
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		rr := serve(t, NewServer(nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp, err := api.ParseGetV2AuthzSelfResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, api.EffectivePermissions{
+			ListClusters:        true,
+			CreateClusters:      true,
+			UpdateClusters:      true,
+			DeleteClusters:      true,
+			DownloadKubeconfigs: true,
+			ListTemplates:       true,
+			ManageTemplates:     true,
+			PublishTemplates:    true,
+		}, *resp.JSON200)
+	})
+
+	t.Run("policy evaluation failure", func(t *testing.T) {
+		rr := serve(t, NewServer(nil, WithAuth(fakeAuthorizer{err: errors.New("opa unavailable")})))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		requireCode(t, messages.PermissionsFailed, rr.Body.Bytes())
+	})
+}
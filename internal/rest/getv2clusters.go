@@ -4,16 +4,24 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log/slog"
 	"math"
+	"slices"
+	"strings"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	. "github.com/open-edge-platform/cluster-manager/v2/internal/pagination"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
@@ -24,56 +32,147 @@ const MaxClusters = math.MaxInt32 // Maximum value for int32
 func (s *Server) GetV2Clusters(ctx context.Context, request api.GetV2ClustersRequestObject) (api.GetV2ClustersResponseObject, error) {
 	pageSize, offset, orderBy, filter, err := ValidateParams(request.Params)
 	if err != nil {
-		slog.Error("failed to validate parameters", "pageSize", pageSize, "offset", offset, "orderBy", orderBy, "filter", filter, "error", err)
-		return badRequestGetClustersResponse(err.Error()), nil
+		logger.FromContext(ctx).Error("failed to validate parameters", "pageSize", pageSize, "offset", offset, "orderBy", orderBy, "filter", filter, "error", err)
+		return badRequestGetClustersResponse(ctx, messages.New(messages.InvalidParameters, err)), nil
 	}
 
 	namespace := request.Params.Activeprojectid.String()
+	query := PageQuery(filter, orderBy)
+
+	var token *PageToken
+	if request.Params.PageToken != nil {
+		if *offset > 0 {
+			return badRequestGetClustersResponse(ctx, messages.New(messages.PageTokenWithOffset)), nil
+		}
+		decoded, err := DecodePageToken(*request.Params.PageToken, query)
+		if err != nil {
+			logger.FromContext(ctx).Debug("failed to decode page token", "namespace", namespace, "error", err)
+			if errors.Is(err, ErrPageTokenMismatch) {
+				return badRequestGetClustersResponse(ctx, messages.New(messages.PageTokenMismatch)), nil
+			}
+			return badRequestGetClustersResponse(ctx, messages.New(messages.InvalidPageToken)), nil
+		}
+		token = &decoded
+	}
+
+	// listings that kubernetes can page are paged with list continue tokens instead of loading all clusters; tokens
+	// without continue token were issued for listings paged by offset
+	if selector, ok := s.pageableClusters(filter, orderBy); ok && *offset == 0 && (token == nil || token.Continue != "") {
+		return s.getClustersPage(ctx, namespace, selector, *pageSize, token, query)
+	}
+	if token != nil {
+		offset = &token.Offset
+	}
+
 	clusters, err := s.getClusters(ctx, namespace, orderBy, filter)
 	if err != nil {
-		slog.Error("failed to get clusters", "namespace", namespace, "filter", filter, "order", orderBy, "error", err)
-		return internalServerErrorGetClustersResponse("failed to retrieve clusters"), nil
+		logger.FromContext(ctx).Error("failed to get clusters", "namespace", namespace, "filter", filter, "order", orderBy, "error", err)
+		return internalServerErrorGetClustersResponse(ctx, messages.New(messages.ClustersListFailed)), nil
 	}
 
 	if len(*clusters) == 0 {
 		return api.GetV2Clusters200JSONResponse{
-			Clusters:      clusters,
+			Clusters:      &[]api.ClusterInfo{},
 			TotalElements: 0,
 		}, nil
 	}
 
 	paginatedClusters, err := PaginateItems(*clusters, *pageSize, *offset)
 	if err != nil {
-		slog.Error("failed to paginate clusters", "namespace", namespace, "pageSize", pageSize, "offset", offset, "error", err)
-		return internalServerErrorGetClustersResponse(err.Error()), nil
+		logger.FromContext(ctx).Error("failed to paginate clusters", "namespace", namespace, "pageSize", pageSize, "offset", offset, "error", err)
+		return internalServerErrorGetClustersResponse(ctx, messages.New(messages.PaginationFailed, err)), nil
 	}
 
 	if len(*paginatedClusters) > MaxClusters {
-		slog.Error("number of clusters exceeds the maximum allowed", "namespace", namespace, "count", len(*paginatedClusters))
-		return badRequestGetClustersResponse("number of clusters exceeds the maximum allowed"), nil
+		logger.FromContext(ctx).Error("number of clusters exceeds the maximum allowed", "namespace", namespace, "count", len(*paginatedClusters))
+		return badRequestGetClustersResponse(ctx, messages.New(messages.TooManyClusters)), nil
 	}
 
-	slog.Info("Clusters state read", "namespace", namespace, "count", len(*clusters))
+	logger.FromContext(ctx).Info("Clusters state read", "namespace", namespace, "count", len(*clusters))
 
-	return api.GetV2Clusters200JSONResponse{
+	response := api.GetV2Clusters200JSONResponse{
 		Clusters:      paginatedClusters,
 		TotalElements: int32(len(*clusters)),
-	}, nil
+	}
+	if next := *offset + *pageSize; next < len(*clusters) {
+		response.NextPageToken = ptr(PageToken{Offset: next, Query: query}.Encode())
+	}
+	return response, nil
 }
 
-func badRequestGetClustersResponse(message string) api.GetV2Clusters400JSONResponse {
+// getClustersPage returns the page of the clusters starting at the continue token of the given page token, the first
+// page if there is none; only the clusters of the page are listed
+func (s *Server) getClustersPage(ctx context.Context, namespace string, selector k8slabels.Selector, pageSize int, token *PageToken, query string) (api.GetV2ClustersResponseObject, error) {
+	var continueToken string
+	listed := 0
+	if token != nil {
+		continueToken, listed = token.Continue, token.Offset
+	}
+
+	list, err := k8s.ListClustersPage(ctx, s.reader(), namespace, selector, int64(pageSize), continueToken)
+	switch {
+	case k8serrors.IsResourceExpired(err):
+		logger.FromContext(ctx).Debug("cluster list continue token expired", "namespace", namespace, "error", err)
+		return badRequestGetClustersResponse(ctx, messages.New(messages.PageTokenExpired)), nil
+	case err != nil:
+		logger.FromContext(ctx).Error("failed to get clusters", "namespace", namespace, "selector", selector.String(), "error", err)
+		return internalServerErrorGetClustersResponse(ctx, messages.New(messages.ClustersListFailed)), nil
+	}
+
+	// the clusters skipped for a missing name or version are not counted
+	clusters := s.convertClusters(ctx, namespace, list.Items)
+	listed += len(clusters)
+	total := listed
+
+	response := api.GetV2Clusters200JSONResponse{Clusters: &clusters}
+	if list.GetContinue() != "" {
+		// the remaining count is not known for listings filtered by labels
+		if remaining := list.GetRemainingItemCount(); remaining != nil {
+			total += int(*remaining)
+		}
+		response.NextPageToken = ptr(PageToken{Continue: list.GetContinue(), Offset: listed, Query: query}.Encode())
+	}
+	response.TotalElements = int32(total)
+
+	logger.FromContext(ctx).Info("Clusters state read", "namespace", namespace, "count", len(clusters))
+	return response, nil
+}
+
+// pageableClusters returns the label selector of the filter if the clusters can be paged by kubernetes, that is if they
+// are not ordered and only filtered by labels; filters on labels are looked up in the cluster index instead, if any.
+// The clusters are not paged by kubernetes if they are read from the read cache, which cannot serve continue tokens.
+func (s *Server) pageableClusters(filter, orderBy *string) (k8slabels.Selector, bool) {
+	if orderBy != nil || s.readCache != nil {
+		return nil, false
+	}
+	if filter == nil {
+		return k8slabels.NewSelector(), true
+	}
+	if s.clusterIndex != nil {
+		return nil, false
+	}
+
+	filters, useAnd, err := ParseFilter(*filter)
+	if err != nil || (!useAnd && len(filters) > 1) {
+		return nil, false
+	}
+	for _, filter := range filters {
+		if !strings.HasPrefix(filter.Name, LabelFilterPrefix) {
+			return nil, false
+		}
+	}
+	return clusterLabelSelector(filters, useAnd)
+}
+
+func badRequestGetClustersResponse(ctx context.Context, message messages.Message) api.GetV2Clusters400JSONResponse {
 	return api.GetV2Clusters400JSONResponse{
-		N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-			Message: ptr(message),
-		},
+		N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message)),
 	}
 }
 
-func internalServerErrorGetClustersResponse(message string) api.GetV2Clusters500JSONResponse {
+func internalServerErrorGetClustersResponse(ctx context.Context, message messages.Message) api.GetV2Clusters500JSONResponse {
 	return api.GetV2Clusters500JSONResponse{
-		N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-			Message: ptr(message),
-		},
+		N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message)),
 	}
 }
 
@@ -84,15 +183,29 @@ func (s *Server) getClusters(ctx context.Context, namespace string, orderBy, fil
 		return nil, fmt.Errorf("no namespace provided")
 	}
 
-	clusters, err := fetchClustersList(ctx, s, namespace)
+	var filters []*Filter
+	useAnd := false
+	if filter != nil {
+		var err error
+		if filters, useAnd, err = ParseFilter(*filter); err != nil {
+			return nil, fmt.Errorf("failed to apply filters: %w", err)
+		}
+	}
+
+	clusters, indexMatches, err := s.lookupClusters(ctx, namespace, filters, useAnd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch clusters: %w", err)
+		return nil, err
 	}
 
 	convertedClusters := s.convertClusters(ctx, namespace, clusters)
 
 	if filter != nil {
-		convertedClusters, err = FilterItems(convertedClusters, *filter, filterClusters)
+		convertedClusters, err = FilterItems(convertedClusters, *filter, func(cluster api.ClusterInfo, filter *Filter) bool {
+			if matches, ok := indexMatches[*filter]; ok {
+				return cluster.Name != nil && matches[*cluster.Name]
+			}
+			return filterClusters(cluster, filter)
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to apply filters: %w", err)
 		}
@@ -108,17 +221,122 @@ func (s *Server) getClusters(ctx context.Context, namespace string, orderBy, fil
 	return &convertedClusters, nil
 }
 
+// lookupClusters returns the clusters to be filtered and, for each filter on an indexed field, the names of the matching clusters
+// When all filters have to match, the clusters are looked up in the cluster index instead of listing all clusters of the namespace,
+// without an index only the clusters having the filtered labels are listed
+func (s *Server) lookupClusters(ctx context.Context, namespace string, filters []*Filter, useAnd bool) ([]unstructured.Unstructured, map[Filter]map[string]bool, error) {
+	var indexFilters []*Filter
+	for _, filter := range filters {
+		if _, _, ok := clusterIndexQuery(namespace, filter); ok {
+			indexFilters = append(indexFilters, filter)
+		}
+	}
+
+	var index ClusterIndex = s.clusterIndex
+	if check, ok := index.(HealthCheck); ok && check.Health() != nil {
+		// the index is not used while it is not synced or may be stale
+		index = nil
+	}
+	indexed := index != nil && len(indexFilters) > 0 && (useAnd || len(filters) == 1)
+
+	var clusters []unstructured.Unstructured
+	if !indexed {
+		selector, ok := clusterLabelSelector(filters, useAnd)
+		if !ok {
+			return nil, nil, nil
+		}
+		var err error
+		if clusters, err = k8s.ListClusters(ctx, s.reader(), namespace, selector); err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch clusters: %w", err)
+		}
+		if len(indexFilters) == 0 {
+			return clusters, nil, nil
+		}
+		if index == nil {
+			if index, err = k8s.NewClusterIndexer(clusters); err != nil {
+				return nil, nil, fmt.Errorf("failed to index clusters: %w", err)
+			}
+		}
+	}
+
+	matches := map[Filter]map[string]bool{}
+	for i, filter := range indexFilters {
+		indexName, indexedValue, _ := clusterIndexQuery(namespace, filter)
+		found, err := index.ByIndex(indexName, indexedValue)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to look up clusters by %s: %w", filter.Name, err)
+		}
+
+		names := map[string]bool{}
+		for _, cluster := range found {
+			names[cluster.GetName()] = true
+		}
+		matches[*filter] = names
+
+		if !indexed {
+			continue
+		}
+		// only clusters matching every index filter can match all filters
+		if i == 0 {
+			clusters = found
+			continue
+		}
+		clusters = slices.DeleteFunc(clusters, func(cluster unstructured.Unstructured) bool {
+			return !names[cluster.GetName()]
+		})
+	}
+
+	return clusters, matches, nil
+}
+
+// clusterIndexQuery returns the index and indexed value to look up the clusters matching the filter, if the filtered field is indexed
+func clusterIndexQuery(namespace string, filter *Filter) (string, string, bool) {
+	switch filter.Name {
+	case "template":
+		return k8s.ClusterTemplateIndex, k8s.ClusterIndexValue(namespace, filter.Value), true
+	case "phase":
+		return k8s.ClusterPhaseIndex, k8s.ClusterIndexValue(namespace, filter.Value), true
+	}
+	if key, ok := strings.CutPrefix(filter.Name, LabelFilterPrefix); ok {
+		return k8s.ClusterLabelIndex, k8s.ClusterLabelIndexValue(namespace, key, filter.Value), true
+	}
+	return "", "", false
+}
+
+// clusterLabelSelector translates the filters on labels into a label selector, so that only the clusters having the
+// filtered labels are listed; this is only possible when all filters have to match
+// It returns false if no cluster can match the filters because a filtered label is not a valid label
+func clusterLabelSelector(filters []*Filter, useAnd bool) (k8slabels.Selector, bool) {
+	selector := k8slabels.NewSelector()
+	if !useAnd && len(filters) > 1 {
+		return selector, true
+	}
+
+	for _, filter := range filters {
+		key, ok := strings.CutPrefix(filter.Name, LabelFilterPrefix)
+		if !ok {
+			continue
+		}
+		requirement, err := k8slabels.NewRequirement(key, selection.Equals, []string{filter.Value})
+		if err != nil {
+			return nil, false
+		}
+		selector = selector.Add(*requirement)
+	}
+	return selector, true
+}
+
 func (s *Server) convertClusters(ctx context.Context, namespace string, unstructuredClusters []unstructured.Unstructured) []api.ClusterInfo {
 	clusters := make([]api.ClusterInfo, 0, len(unstructuredClusters))
-	allMachines, err := fetchAllMachinesList(ctx, s, namespace)
+	allMachines, err := fetchAllMachinesList(ctx, s.reader(), namespace)
 	if err != nil {
-		slog.Error("failed to fetch machines", "namespace", namespace, "error", err)
+		logger.FromContext(ctx).Error("failed to fetch machines", "namespace", namespace, "error", err)
 		return nil
 	}
 	for _, item := range unstructuredClusters {
 		capiCluster := capi.Cluster{}
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &capiCluster); err != nil {
-			slog.Error("failed to convert unstructured to cluster, skipping...", "unstructured", item, "error", err)
+			logger.FromContext(ctx).Error("failed to convert unstructured to cluster, skipping...", "unstructured", item, "error", err)
 			continue
 		}
 
@@ -130,7 +348,7 @@ func (s *Server) convertClusters(ctx context.Context, namespace string, unstruct
 
 		lp, errs := getClusterLifecyclePhase(&capiCluster)
 		if len(errs) > 0 {
-			slog.Debug("errors while building cluster lifecycle phase", "cluster", capiCluster.Name, "errors", errs)
+			logger.FromContext(ctx).Debug("errors while building cluster lifecycle phase", "cluster", capiCluster.Name, "errors", errs)
 		}
 
 		clusterInfo := api.ClusterInfo{
@@ -150,7 +368,7 @@ func (s *Server) convertClusters(ctx context.Context, namespace string, unstruct
 		}
 
 		if clusterInfo.Name == ptr("") || clusterInfo.Name == nil || clusterInfo.KubernetesVersion == nil {
-			slog.Warn("skipping cluster with missing name or version", "name", clusterInfo.Name, "version", clusterInfo.KubernetesVersion)
+			logger.FromContext(ctx).Warn("skipping cluster with missing name or version", "name", clusterInfo.Name, "version", clusterInfo.KubernetesVersion)
 			continue
 		}
 
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -15,6 +17,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -22,6 +25,8 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/pagination"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
@@ -104,7 +109,9 @@ func createMockServer(t *testing.T, clusters []capi.Cluster, projectID string, o
 	mockedk8sclient = k8s.NewMockInterface(t)
 	if setupK8sMocks {
 		resource := k8s.NewMockResourceInterface(t)
-		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(unstructuredClusterList, nil)
+		resource.EXPECT().List(mock.Anything, mock.MatchedBy(func(opts metav1.ListOptions) bool {
+			return opts.LabelSelector == ""
+		})).RunAndReturn(pagedClusterList(unstructuredClusterList.Items))
 		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
 		nsResource.EXPECT().Namespace(projectID).Return(resource)
 		mockedk8sclient = k8s.NewMockInterface(t)
@@ -125,6 +132,30 @@ func createMockServer(t *testing.T, clusters []capi.Cluster, projectID string, o
 	return NewServer(mockedk8sclient)
 }
 
+// pagedClusterList returns a List implementation that pages the given clusters like the API server does, with the index
+// of the next cluster as continue token
+func pagedClusterList(clusters []unstructured.Unstructured) func(context.Context, metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return func(_ context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		start := 0
+		if opts.Continue != "" {
+			var err error
+			if start, err = strconv.Atoi(opts.Continue); err != nil {
+				return nil, k8serrors.NewResourceExpired("continue token expired")
+			}
+		}
+
+		list := &unstructured.UnstructuredList{Items: clusters[start:]}
+		if opts.Limit > 0 && int(opts.Limit) < len(list.Items) {
+			next := start + int(opts.Limit)
+			remaining := int64(len(clusters) - next)
+			list.Items = list.Items[:opts.Limit]
+			list.SetContinue(strconv.Itoa(next))
+			list.SetRemainingItemCount(&remaining)
+		}
+		return list, nil
+	}
+}
+
 func generateCluster(name *string, version *string) capi.Cluster {
 	clusterName := ""
 	if name != nil {
@@ -639,7 +670,7 @@ func TestGetV2Clusters500(t *testing.T) {
 	t.Run("Failed to Retrieve Clusters", func(t *testing.T) {
 		// Simulate an error in listing clusters
 		resource := k8s.NewMockResourceInterface(t)
-		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(nil, errors.New("failed to list clusters"))
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{Limit: 20}).Return(nil, errors.New("failed to list clusters"))
 		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
 		expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
 		nsResource.EXPECT().Namespace(expectedActiveProjectID).Return(resource)
@@ -659,23 +690,17 @@ func TestGetV2Clusters500(t *testing.T) {
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
 
-		// Parse the response body
-		var actualResponse api.GetV2Clusters500JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
-
 		// Check the response status
 		require.Equal(t, http.StatusInternalServerError, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, 500)
 
-		// Check the error message
-		require.Equal(t, "failed to retrieve clusters", *actualResponse.Message, "Error message = %v, want %v",
-			*actualResponse.Message, "failed to retrieve clusters")
+		// Check the error code
+		requireCode(t, messages.ClustersListFailed, rr.Body.Bytes())
 	})
 
 	t.Run("Missing Project ID", func(t *testing.T) {
 		// Simulate a missing project ID in the context
 		resource := k8s.NewMockResourceInterface(t)
-		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(nil, errors.New("failed to list clusters")).Maybe()
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{Limit: 20}).Return(nil, errors.New("failed to list clusters")).Maybe()
 		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
 		nsResource.EXPECT().Namespace(mock.Anything).Return(resource).Maybe()
 		mockedk8sclient := k8s.NewMockInterface(t)
@@ -694,17 +719,11 @@ func TestGetV2Clusters500(t *testing.T) {
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
 
-		// Parse the response body
-		var actualResponse api.GetV2Clusters500JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
-
 		// Check the response status
 		require.Equal(t, http.StatusInternalServerError, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, 500)
 
-		// Check the error message
-		require.Equal(t, "failed to retrieve clusters", *actualResponse.Message, "Error message = %v, want %v",
-			*actualResponse.Message, "failed to retrieve clusters")
+		// Check the error code
+		requireCode(t, messages.ClustersListFailed, rr.Body.Bytes())
 	})
 }
 
@@ -729,13 +748,8 @@ func TestGetV2Clusters400(t *testing.T) {
 		// Check the response status
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, 400)
 
-		// Check the error message in the response body
-		var respbody api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &respbody)
-		require.NoError(t, err, "json.Unmarshal() error = %v, want nil", err)
-		expectedErrorMessage := "no active project id provided"
-		require.Contains(t, *respbody.Message, expectedErrorMessage, "Error message = %v, want %v",
-			*respbody.Message, expectedErrorMessage)
+		// Check the error code in the response body
+		requireCode(t, messages.ActiveProjectIDMissing, rr.Body.Bytes())
 	})
 
 	t.Run("No Project ID", func(t *testing.T) {
@@ -762,13 +776,8 @@ func TestGetV2Clusters400(t *testing.T) {
 		// Check the response status
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, 400)
 
-		// Check the error message in the response body
-		var respbody api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &respbody)
-		require.NoError(t, err, "json.Unmarshal() error = %v, want nil", err)
-		expectedErrorMessage := "no active project id provided"
-		require.Contains(t, *respbody.Message, expectedErrorMessage, "Error message = %v, want %v",
-			*respbody.Message, expectedErrorMessage)
+		// Check the error code in the response body
+		requireCode(t, messages.ActiveProjectIDMissing, rr.Body.Bytes())
 	})
 	t.Run("invalid pageSize", func(t *testing.T) {
 		server := createMockServer(t, []capi.Cluster{}, expectedActiveProjectID, false, true)
@@ -781,16 +790,9 @@ func TestGetV2Clusters400(t *testing.T) {
 		handler, err := server.ConfigureHandler()
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
-		var actualResponse api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
-		expectedResponse := api.GetV2Clusters400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("parameter \"pageSize\" in query has an error: number must be at least 0"),
-			},
-		}
-		require.Equal(t, expectedResponse, actualResponse, "GetV2Clusters() response = %v, want %v", actualResponse, expectedResponse)
+		requireCode(t, messages.InvalidRequest, rr.Body.Bytes())
+		require.Contains(t, rr.Body.String(), `parameter \"pageSize\" in query has an error: number must be at least 0`)
 	})
 	t.Run("invalid combination of pageSize and offset", func(t *testing.T) {
 		clusters := []capi.Cluster{}
@@ -804,16 +806,8 @@ func TestGetV2Clusters400(t *testing.T) {
 		handler, err := server.ConfigureHandler()
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
-		var actualResponse api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
-		expectedResponse := api.GetV2Clusters400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("invalid pageSize: must be greater than 0"),
-			},
-		}
-		require.Equal(t, expectedResponse, actualResponse, "GetV2Clusters() response = %v, want %v", actualResponse, expectedResponse)
+		requireCode(t, messages.InvalidParameters, rr.Body.Bytes())
 	})
 	t.Run("invalid orderBy field", func(t *testing.T) {
 		clusters := []capi.Cluster{}
@@ -827,16 +821,8 @@ func TestGetV2Clusters400(t *testing.T) {
 		handler, err := server.ConfigureHandler()
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
-		var actualResponse api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
-		expectedResponse := api.GetV2Clusters400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("invalid orderBy field"),
-			},
-		}
-		require.Equal(t, expectedResponse, actualResponse, "GetV2Clusters() response = %v, want %v", actualResponse, expectedResponse)
+		requireCode(t, messages.InvalidParameters, rr.Body.Bytes())
 	})
 
 	t.Run("invalid orderBy field", func(t *testing.T) {
@@ -850,16 +836,8 @@ func TestGetV2Clusters400(t *testing.T) {
 		handler, err := server.ConfigureHandler()
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
-		var actualResponse api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
-		expectedResponse := api.GetV2Clusters400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("invalid orderBy field"),
-			},
-		}
-		require.Equal(t, expectedResponse, actualResponse, "GetV2Clusters() response = %v, want %v", actualResponse, expectedResponse)
+		requireCode(t, messages.InvalidParameters, rr.Body.Bytes())
 	})
 	t.Run("Invalid OrderBy Parameter", func(t *testing.T) {
 		server := createMockServer(t, []capi.Cluster{}, expectedActiveProjectID, false, true)
@@ -871,16 +849,8 @@ func TestGetV2Clusters400(t *testing.T) {
 		handler, err := server.ConfigureHandler()
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
-		var actualResponse api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, 400)
-		expectedResponse := api.GetV2Clusters400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("invalid orderBy field"),
-			},
-		}
-		require.Equal(t, expectedResponse, actualResponse, "GetV2Clusters() response = %v, want %v", actualResponse, expectedResponse)
+		requireCode(t, messages.InvalidParameters, rr.Body.Bytes())
 	})
 	t.Run("invalid filter", func(t *testing.T) {
 		clusters := []capi.Cluster{}
@@ -893,17 +863,234 @@ func TestGetV2Clusters400(t *testing.T) {
 		handler, err := server.ConfigureHandler()
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
-		var actualResponse api.GetV2Clusters400JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
-		expectedResponse := api.GetV2Clusters400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("invalid filter field"),
-			},
+		requireCode(t, messages.InvalidParameters, rr.Body.Bytes())
+	})
+}
+
+func TestGetV2ClustersIndexedFilters(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+	indexedCluster := func(name, template, env string, status capi.ClusterStatus) capi.Cluster {
+		cluster := generateClusterWithStatus(ptr(name), ptr("v1.30.6+k3s1"), status)
+		cluster.Namespace = expectedActiveProjectID
+		cluster.Annotations = map[string]string{core.TemplateLabelKey: template}
+		cluster.Labels = map[string]string{"env": env}
+		return cluster
+	}
+	clusters := []capi.Cluster{
+		indexedCluster("cluster-1", "baseline-v1.0.0", "prod", clusterStatusReady),
+		indexedCluster("cluster-2", "baseline-v2.0.0", "prod", clusterStatusInProgressControlPlane),
+		indexedCluster("cluster-3", "baseline-v1.0.0", "dev", clusterStatusReady),
+	}
+
+	getClusterNames := func(t *testing.T, server *Server, filter string) []string {
+		req := httptest.NewRequest("GET", "/v2/clusters?filter="+url.QueryEscape(filter), nil)
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var resp api.GetV2Clusters200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		names := []string{}
+		for _, cluster := range *resp.Clusters {
+			names = append(names, *cluster.Name)
 		}
-		require.Equal(t, expectedResponse, actualResponse, "GetV2Clusters() response = %v, want %v", actualResponse, expectedResponse)
+		return names
+	}
+
+	t.Run("clusters are listed when no index is configured", func(t *testing.T) {
+		server := createMockServer(t, clusters, expectedActiveProjectID)
+		names := getClusterNames(t, server, "template=baseline-v2.0.0 OR labels.env=dev")
+		require.ElementsMatch(t, []string{"cluster-2", "cluster-3"}, names)
+	})
+
+	t.Run("clusters are looked up in the index when all filters have to match", func(t *testing.T) {
+		unstructuredClusters := make([]unstructured.Unstructured, 0, len(clusters))
+		for _, cluster := range clusters {
+			u, err := convert.ToUnstructured(cluster)
+			require.NoError(t, err)
+			unstructuredClusters = append(unstructuredClusters, *u)
+		}
+		index, err := k8s.NewClusterIndexer(unstructuredClusters)
+		require.NoError(t, err)
+
+		// only machines are listed, clusters come from the index
+		machineResource := k8s.NewMockResourceInterface(t)
+		machineResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		nsMachineResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsMachineResource.EXPECT().Namespace(expectedActiveProjectID).Return(machineResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.MachineResourceSchema).Return(nsMachineResource)
+
+		server := NewServer(mockedk8sclient, WithClusterIndex(index))
+		names := getClusterNames(t, server, "phase=Provisioned AND labels.env=prod AND name=cluster")
+		require.Equal(t, []string{"cluster-1"}, names)
+	})
+
+	mockMachines := func(t *testing.T, mockedk8sclient *k8s.MockInterface) {
+		machineResource := k8s.NewMockResourceInterface(t)
+		machineResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		nsMachineResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsMachineResource.EXPECT().Namespace(expectedActiveProjectID).Return(machineResource)
+		mockedk8sclient.EXPECT().Resource(core.MachineResourceSchema).Return(nsMachineResource)
+	}
+
+	t.Run("label filters are passed as label selector when all filters have to match", func(t *testing.T) {
+		prodClusters := []unstructured.Unstructured{}
+		for _, cluster := range clusters[:2] {
+			u, err := convert.ToUnstructured(cluster)
+			require.NoError(t, err)
+			prodClusters = append(prodClusters, *u)
+		}
+
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{LabelSelector: "env=prod"}).Return(&unstructured.UnstructuredList{Items: prodClusters}, nil)
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(expectedActiveProjectID).Return(resource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource)
+		mockMachines(t, mockedk8sclient)
+
+		server := NewServer(mockedk8sclient)
+		names := getClusterNames(t, server, "labels.env=prod AND template=baseline-v1.0.0")
+		require.Equal(t, []string{"cluster-1"}, names)
 	})
+
+	t.Run("no clusters are listed for invalid labels", func(t *testing.T) {
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockMachines(t, mockedk8sclient)
+
+		server := NewServer(mockedk8sclient)
+		names := getClusterNames(t, server, "labels.-env=prod")
+		require.Empty(t, names)
+	})
+}
+
+func TestGetV2ClustersPageToken(t *testing.T) {
+	clusters := []capi.Cluster{
+		generateCluster(ptr("example-cluster-1"), ptr("v1.33.5+k3s1")),
+		generateCluster(ptr("example-cluster-2"), ptr("v1.30.6+k3s1")),
+		generateCluster(ptr("example-cluster-3"), ptr("v1.18.0")),
+	}
+
+	getClusters := func(t *testing.T, server *Server, query string) (int, api.GetV2Clusters200JSONResponse, string) {
+		req := httptest.NewRequest("GET", "/v2/clusters?"+query, nil)
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+
+		var resp api.GetV2Clusters200JSONResponse
+		if rr.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		}
+		return rr.Code, resp, rr.Body.String()
+	}
+	names := func(resp api.GetV2Clusters200JSONResponse) []string {
+		names := []string{}
+		for _, cluster := range *resp.Clusters {
+			names = append(names, *cluster.Name)
+		}
+		return names
+	}
+
+	t.Run("clusters are paged by kubernetes", func(t *testing.T) {
+		server := createMockServer(t, clusters, expectedActiveProjectID)
+
+		code, resp, body := getClusters(t, server, "pageSize=2")
+		require.Equal(t, http.StatusOK, code, body)
+		require.Equal(t, []string{"example-cluster-1", "example-cluster-2"}, names(resp))
+		require.Equal(t, int32(3), resp.TotalElements)
+		require.NotNil(t, resp.NextPageToken)
+
+		code, resp, body = getClusters(t, server, "pageSize=2&pageToken="+*resp.NextPageToken)
+		require.Equal(t, http.StatusOK, code, body)
+		require.Equal(t, []string{"example-cluster-3"}, names(resp))
+		require.Equal(t, int32(3), resp.TotalElements)
+		require.Nil(t, resp.NextPageToken)
+	})
+
+	t.Run("ordered clusters are paged by offset", func(t *testing.T) {
+		server := createMockServer(t, clusters, expectedActiveProjectID)
+
+		code, resp, body := getClusters(t, server, "pageSize=2&orderBy="+url.QueryEscape("name desc"))
+		require.Equal(t, http.StatusOK, code, body)
+		require.Equal(t, []string{"example-cluster-3", "example-cluster-2"}, names(resp))
+		require.NotNil(t, resp.NextPageToken)
+
+		code, resp, body = getClusters(t, server, "pageSize=2&orderBy="+url.QueryEscape("name desc")+"&pageToken="+*resp.NextPageToken)
+		require.Equal(t, http.StatusOK, code, body)
+		require.Equal(t, []string{"example-cluster-1"}, names(resp))
+		require.Equal(t, int32(3), resp.TotalElements)
+		require.Nil(t, resp.NextPageToken)
+	})
+
+	t.Run("page token of a different listing is rejected", func(t *testing.T) {
+		server := createMockServer(t, clusters, expectedActiveProjectID)
+
+		code, resp, body := getClusters(t, server, "pageSize=2&orderBy=name")
+		require.Equal(t, http.StatusOK, code, body)
+		require.NotNil(t, resp.NextPageToken)
+
+		code, _, body = getClusters(t, server, "pageSize=2&pageToken="+*resp.NextPageToken)
+		require.Equal(t, http.StatusBadRequest, code)
+		requireCode(t, messages.PageTokenMismatch, []byte(body))
+	})
+
+	t.Run("page token cannot be combined with offset", func(t *testing.T) {
+		server := NewServer(k8s.NewMockInterface(t))
+
+		code, _, body := getClusters(t, server, "offset=1&pageToken="+pagination.PageToken{Offset: 2}.Encode())
+		require.Equal(t, http.StatusBadRequest, code)
+		requireCode(t, messages.PageTokenWithOffset, []byte(body))
+	})
+
+	t.Run("expired page token is rejected", func(t *testing.T) {
+		server := createMockServer(t, clusters, expectedActiveProjectID, true, false)
+
+		code, _, body := getClusters(t, server, "pageToken="+pagination.PageToken{Continue: "expired", Offset: 2}.Encode())
+		require.Equal(t, http.StatusBadRequest, code)
+		requireCode(t, messages.PageTokenExpired, []byte(body))
+	})
+}
+
+func TestClusterLabelSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		filters  []*pagination.Filter
+		useAnd   bool
+		selector string
+		ok       bool
+	}{
+		{name: "single label filter", filters: []*pagination.Filter{{Name: "labels.env", Value: "prod"}}, selector: "env=prod", ok: true},
+		{
+			name:     "label filters combined with AND",
+			filters:  []*pagination.Filter{{Name: "labels.env", Value: "prod"}, {Name: "name", Value: "cluster"}, {Name: "labels.app", Value: "wordpress"}},
+			useAnd:   true,
+			selector: "app=wordpress,env=prod",
+			ok:       true,
+		},
+		{name: "label filters combined with OR", filters: []*pagination.Filter{{Name: "labels.env", Value: "prod"}, {Name: "labels.env", Value: "dev"}}, ok: true},
+		{name: "no label filters", filters: []*pagination.Filter{{Name: "name", Value: "cluster"}}, ok: true},
+		{name: "invalid label key", filters: []*pagination.Filter{{Name: "labels.-env", Value: "prod"}}},
+		{name: "invalid label value", filters: []*pagination.Filter{{Name: "labels.env", Value: "prod*"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, ok := clusterLabelSelector(tt.filters, tt.useAnd)
+			require.Equal(t, tt.ok, ok)
+			if ok {
+				require.Equal(t, tt.selector, selector.String())
+			}
+		})
+	}
 }
 
 func createGetV2ClustersStubServer(t *testing.T) *Server {
@@ -912,7 +1099,7 @@ func createGetV2ClustersStubServer(t *testing.T) *Server {
 		Items: unstructuredClusters,
 	}
 	resource := k8s.NewMockResourceInterface(t)
-	resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(unstructuredClusterList, nil).Maybe()
+	resource.EXPECT().List(mock.Anything, mock.Anything).Return(unstructuredClusterList, nil).Maybe()
 	nsResource := k8s.NewMockNamespaceableResourceInterface(t)
 	nsResource.EXPECT().Namespace(mock.Anything).Return(resource).Maybe()
 
@@ -6,11 +6,11 @@ import (
 	"context"
 	"fmt"
 
-	"log/slog"
-
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -25,9 +25,7 @@ func (s *Server) GetV2ClustersNodeIdClusterdetail(ctx context.Context, request a
 	clusterDetails, err := s.getClusterDetails(ctx, activeProjectID, request.NodeId)
 	if err != nil {
 		return api.GetV2ClustersNodeIdClusterdetail404JSONResponse{
-			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.ClusterOfNodeNotFound, request.NodeId, err))),
 		}, nil
 	}
 	labels := labels.UserLabels(convert.MapAnyToString(*clusterDetails.Labels))
@@ -39,19 +37,19 @@ func (s *Server) GetV2ClustersNodeIdClusterdetail(ctx context.Context, request a
 func (s *Server) getClusterDetails(ctx context.Context, activeProjectID, nodeId string) (api.ClusterDetailInfo, error) {
 	// retrieve cluster using node id and populate detail info with it
 	// get capi machine object using ID and link it to cluster
-	unstructuredMachines, err := s.k8sclient.Resource(core.MachineResourceSchema).Namespace(activeProjectID).List(ctx, v1.ListOptions{})
+	unstructuredMachines, err := s.reader().Resource(core.MachineResourceSchema).Namespace(activeProjectID).List(ctx, v1.ListOptions{})
 	if unstructuredMachines == nil || len(unstructuredMachines.Items) == 0 {
-		slog.Error("failed to get machine", "namespace", activeProjectID, "ID", nodeId, "error", err)
+		logger.FromContext(ctx).Error("failed to get machine", "namespace", activeProjectID, "ID", nodeId, "error", err)
 		return api.ClusterDetailInfo{}, fmt.Errorf("machine not found")
 	}
 	if err != nil {
-		slog.Error("failed to get machine", "namespace", activeProjectID, "ID", nodeId, "error", err)
+		logger.FromContext(ctx).Error("failed to get machine", "namespace", activeProjectID, "ID", nodeId, "error", err)
 		return api.ClusterDetailInfo{}, err
 	}
 
 	machines, err := convertUnsructuredtoMachine(unstructuredMachines)
 	if err != nil {
-		slog.Error("failed to convert machine to structured object", "namespace", activeProjectID, "ID", nodeId, "error", err)
+		logger.FromContext(ctx).Error("failed to convert machine to structured object", "namespace", activeProjectID, "ID", nodeId, "error", err)
 		return api.ClusterDetailInfo{}, err
 	}
 	for _, machine := range machines {
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	intelProvider "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -165,12 +166,12 @@ func TestGetV2ClustersClusterDetail200(t *testing.T) {
 
 func TestGetV2ClustersClusterDetail404(t *testing.T) {
 	tests := []struct {
-		name             string
-		nodeId           string
-		activeProjectID  string
-		mockSetup        func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface)
-		expectedCode     int
-		expectedResponse string
+		name            string
+		nodeId          string
+		activeProjectID string
+		mockSetup       func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface)
+		expectedCode    int
+		expectedProblem messages.Code
 	}{
 		{
 			name:            "no machine",
@@ -183,8 +184,8 @@ func TestGetV2ClustersClusterDetail404(t *testing.T) {
 
 				mockedk8sclient.EXPECT().Resource(core.MachineResourceSchema).Return(nsResource)
 			},
-			expectedCode:     http.StatusNotFound,
-			expectedResponse: "{\"message\":\"machine not found\"}\n",
+			expectedCode:    http.StatusNotFound,
+			expectedProblem: messages.ClusterOfNodeNotFound,
 		},
 		{
 			name:            "wrong machine",
@@ -200,8 +201,8 @@ func TestGetV2ClustersClusterDetail404(t *testing.T) {
 
 				mockedk8sclient.EXPECT().Resource(core.MachineResourceSchema).Return(nsResource)
 			},
-			expectedCode:     http.StatusNotFound,
-			expectedResponse: "{\"message\":\"machine not found\"}\n",
+			expectedCode:    http.StatusNotFound,
+			expectedProblem: messages.ClusterOfNodeNotFound,
 		},
 	}
 
@@ -224,29 +225,29 @@ func TestGetV2ClustersClusterDetail404(t *testing.T) {
 			// serve the request and check response
 			handler.ServeHTTP(rr, req)
 			assert.Equal(t, tt.expectedCode, rr.Code)
-			assert.JSONEq(t, tt.expectedResponse, rr.Body.String())
+			requireCode(t, tt.expectedProblem, rr.Body.Bytes())
 
 		})
 	}
 }
 func TestGetV2ClustersClusterDetail400(t *testing.T) {
 	tests := []struct {
-		name             string
-		activeProjectID  string
-		expectedCode     int
-		expectedResponse string
+		name            string
+		activeProjectID string
+		expectedCode    int
+		expectedProblem messages.Code
 	}{
 		{
-			name:             "no active projectId",
-			activeProjectID:  "",
-			expectedCode:     http.StatusBadRequest,
-			expectedResponse: `{"message": "no active project id provided"}`,
+			name:            "no active projectId",
+			activeProjectID: "",
+			expectedCode:    http.StatusBadRequest,
+			expectedProblem: messages.ActiveProjectIDMissing,
 		},
 		{
-			name:             "zero values for projectId",
-			activeProjectID:  "00000000-0000-0000-0000-000000000000",
-			expectedCode:     http.StatusBadRequest,
-			expectedResponse: `{"message":"no active project id provided"}`,
+			name:            "zero values for projectId",
+			activeProjectID: "00000000-0000-0000-0000-000000000000",
+			expectedCode:    http.StatusBadRequest,
+			expectedProblem: messages.ActiveProjectIDMissing,
 		},
 	}
 
@@ -265,7 +266,7 @@ func TestGetV2ClustersClusterDetail400(t *testing.T) {
 
 			configureHandlerAndServe(t, server, rr, req)
 			assert.Equal(t, tt.expectedCode, rr.Code)
-			assert.JSONEq(t, tt.expectedResponse, rr.Body.String())
+			requireCode(t, tt.expectedProblem, rr.Body.Bytes())
 		})
 	}
 }
@@ -6,14 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
 	"regexp"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	templates "github.com/open-edge-platform/cluster-manager/v2/internal/template"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
 // (GET /v2/clusters/{name})
@@ -24,18 +28,14 @@ func (s *Server) GetV2ClustersName(ctx context.Context, request api.GetV2Cluster
 	// we need to refactor the tests to invoke this function indirectly via handler.ServeHTTP(rr, req)
 	if activeProjectID == "" || activeProjectID == "00000000-0000-0000-0000-000000000000" {
 		return api.GetV2ClustersName400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("no active project id provided"),
-			},
+			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, messages.New(messages.ActiveProjectIDMissing))),
 		}, nil
 	}
 
 	name := request.Name
 	if name == "" {
 		return api.GetV2ClustersName400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("cluster name is required"),
-			},
+			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, messages.New(messages.ClusterNameMissing))),
 		}, nil
 	}
 
@@ -45,44 +45,44 @@ func (s *Server) GetV2ClustersName(ctx context.Context, request api.GetV2Cluster
 	if err != nil || !matched {
 		// nolint: nilerr
 		return api.GetV2ClustersName400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr("invalid cluster name format"),
-			},
+			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, messages.New(messages.InvalidClusterName))),
 		}, nil
 	}
 
-	cluster, err := s.getCluster(ctx, activeProjectID, name)
+	// the cluster detail includes the machines backing the nodes, to troubleshoot nodes that fail to provision
+	detail, err := s.getClusterDetail(ctx, activeProjectID, name, cluster.NodesWithMachines)
 	if err != nil {
 		if errors.Unwrap(err) == k8s.ErrClusterNotFound {
 			return api.GetV2ClustersName404JSONResponse{
-				N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{
-					Message: ptr(err.Error()),
-				},
+				N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.ClusterNotFound, name))),
 			}, nil
 		}
-		slog.Error("failed to get cluster", "name", name, "error", err)
+		logger.FromContext(ctx).Error("failed to get cluster", "name", name, "error", err)
 		return api.GetV2ClustersName500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ClusterGetFailed, name, err))),
 		}, nil
 	}
 
-	return api.GetV2ClustersName200JSONResponse(cluster), nil
+	return api.GetV2ClustersName200JSONResponse(detail), nil
 }
 
 // getCluster retrieves a cluster from the k8s client
 func (s *Server) getCluster(ctx context.Context, activeProjectID, name string) (api.ClusterDetailInfo, error) {
+	return s.getClusterDetail(ctx, activeProjectID, name, cluster.Nodes)
+}
+
+// getClusterDetail retrieves a cluster from the k8s client with its nodes listed by the given function
+func (s *Server) getClusterDetail(ctx context.Context, activeProjectID, name string, listNodes func(context.Context, *k8s.Client, *capi.Cluster) ([]api.NodeInfo, error)) (api.ClusterDetailInfo, error) {
 	namespace := activeProjectID
-	cli := k8s.New(s.k8sclient)
+	cli := k8s.New(s.reader())
 	if cli == nil {
-		slog.Error("failed to create k8s client")
+		logger.FromContext(ctx).Error("failed to create k8s client")
 		return api.ClusterDetailInfo{}, fmt.Errorf("failed to create k8s client")
 	}
 
 	capiCluster, err := cli.GetCluster(ctx, namespace, name)
 	if err != nil {
-		slog.Error("failed to get cluster", "name", name, "error", err)
+		logger.FromContext(ctx).Error("failed to get cluster", "name", name, "error", err)
 		return api.ClusterDetailInfo{}, fmt.Errorf("failed to get cluster, err: %w", err)
 	}
 	if capiCluster.Name == "" {
@@ -90,22 +90,22 @@ func (s *Server) getCluster(ctx context.Context, activeProjectID, name string) (
 	}
 
 	// get machines associated with the cluster
-	machines, err := fetchMachinesList(ctx, s, namespace, capiCluster.Name)
+	machines, err := fetchMachinesList(ctx, s.reader(), namespace, capiCluster.Name)
 	if err != nil {
 		// do we need to return error here?
-		slog.Error("failed to fetch machines for cluster", "cluster", capiCluster.Name, "error", err)
+		logger.FromContext(ctx).Error("failed to fetch machines for cluster", "cluster", capiCluster.Name, "error", err)
 	}
 
 	labels := labels.UserLabels(capiCluster.Labels)
 	unstrucutreLabels := convert.MapStringToAny(labels)
 
-	nodes, err := cluster.Nodes(ctx, cli, capiCluster)
+	nodes, err := listNodes(ctx, cli, capiCluster)
 	if err != nil {
-		slog.Error("failed to get nodes", "cluster", capiCluster.Name, "error", err)
+		logger.FromContext(ctx).Error("failed to get nodes", "cluster", capiCluster.Name, "error", err)
 		return api.ClusterDetailInfo{}, fmt.Errorf("failed to get nodes, err: %w", err)
 	}
 	if len(nodes) == 0 {
-		slog.Warn("no nodes found for cluster", "cluster", capiCluster.Name)
+		logger.FromContext(ctx).Warn("no nodes found for cluster", "cluster", capiCluster.Name)
 		nodes = []api.NodeInfo{
 			{
 				Id:   nil,
@@ -120,7 +120,7 @@ func (s *Server) getCluster(ctx context.Context, activeProjectID, name string) (
 	template := cluster.Template(capiCluster)
 	lp, errs := getClusterLifecyclePhase(capiCluster)
 	if len(errs) > 0 {
-		slog.Debug("errors while building cluster lifecycle phase", "cluster", capiCluster.Name, "errors", errs)
+		logger.FromContext(ctx).Debug("errors while building cluster lifecycle phase", "cluster", capiCluster.Name, "errors", errs)
 	}
 
 	clusterDetailInfo := api.ClusterDetailInfo{
@@ -134,12 +134,49 @@ func (s *Server) getCluster(ctx context.Context, activeProjectID, name string) (
 		NodeHealth:          getNodeHealth(capiCluster, machines),
 		Nodes:               &nodes,
 		Template:            &template,
+		Maintenance:         clusterMaintenance(capiCluster),
+	}
+	if capiCluster.DeletionTimestamp != nil {
+		progress, err := deletion.GetProgress(ctx, cli, capiCluster)
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to get the progress of the deletion of cluster", "cluster", capiCluster.Name, "error", err)
+		} else {
+			clusterDetailInfo.Deletion = toAPIClusterDeletion(progress)
+		}
+	} else if deleteAt, ok := deletion.DeleteAt(capiCluster); ok {
+		clusterDetailInfo.DeleteAt = &deleteAt
 	}
+	if capiCluster.ResourceVersion != "" {
+		clusterDetailInfo.ResourceVersion = &capiCluster.ResourceVersion
+	}
+	if dependencies := cluster.Dependencies(capiCluster); len(dependencies) > 0 {
+		clusterDetailInfo.DependsOn = &dependencies
+	}
+	clusterDetailInfo.ReservedResources = templates.ToAPIReservedResources(cluster.ReservedResources(capiCluster))
 
 	if err := validateClusterDetail(clusterDetailInfo); err != nil {
-		slog.Error("failed to validate cluster detail", "cluster", capiCluster.Name, "error", err)
+		logger.FromContext(ctx).Error("failed to validate cluster detail", "cluster", capiCluster.Name, "error", err)
 		return api.ClusterDetailInfo{}, fmt.Errorf("failed to validate cluster detail, err: %w", err)
 	}
 
 	return clusterDetailInfo, nil
 }
+
+// toAPIClusterDeletion converts the progress of the deletion of a cluster to its API representation
+func toAPIClusterDeletion(progress deletion.Progress) *api.ClusterDeletion {
+	clusterDeletion := &api.ClusterDeletion{
+		RequestedAt:       progress.RequestedAt,
+		MachinesRemaining: progress.MachinesRemaining,
+		ForceFinalizeAt:   progress.ForceFinalizeAt,
+	}
+	if progress.ControlPlane != "" {
+		clusterDeletion.ControlPlane = ptr(api.TeardownState(progress.ControlPlane))
+	}
+	if progress.Infrastructure != "" {
+		clusterDeletion.Infrastructure = ptr(api.TeardownState(progress.Infrastructure))
+	}
+	if len(progress.Finalizers) > 0 {
+		clusterDeletion.Finalizers = &progress.Finalizers
+	}
+	return clusterDeletion
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -25,7 +26,9 @@ import (
 	intelProvider "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
@@ -63,6 +66,18 @@ func setupMockServer(t *testing.T, expectedCluster capi.Cluster, expectedActiveP
 	nsIntelMachineResource.EXPECT().Namespace(activeProjectID).Return(intelMachineResource).Maybe()
 	mockedk8sclient.EXPECT().Resource(k8s.IntelMachineResourceSchema).Return(nsIntelMachineResource).Maybe()
 
+	// create a new mocked binding resource
+	bindings, err := convert.ToUnstructuredList([]intelProvider.IntelMachineBinding{{
+		ObjectMeta: metav1.ObjectMeta{Name: expectedCluster.Name + "-" + hostId, Namespace: activeProjectID},
+		Spec:       intelProvider.IntelMachineBindingSpec{NodeGUID: hostId, ClusterName: expectedCluster.Name},
+	}})
+	require.Nil(t, err)
+	bindingResource := k8s.NewMockResourceInterface(t)
+	bindingResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(bindings, nil).Maybe()
+	nsBindingResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsBindingResource.EXPECT().Namespace(activeProjectID).Return(bindingResource).Maybe()
+	mockedk8sclient.EXPECT().Resource(core.BindingsResourceSchema).Return(nsBindingResource).Maybe()
+
 	// create a new mocked cluster resource
 	resource := k8s.NewMockResourceInterface(t)
 	resource.EXPECT().Get(mock.Anything, expectedCluster.Name, metav1.GetOptions{}).Return(getReturn, getError)
@@ -148,6 +163,16 @@ func TestGetV2Cluster200(t *testing.T) {
 				Condition: statusStatusInfoConditionPtr("STATUS_CONDITION_UNKNOWN"),
 				Reason:    ptr("Unknown"),
 			},
+			Machine: &api.MachineInfo{
+				Name:                ptr("example-machine"),
+				Phase:               ptr("Unknown"),
+				BootstrapReady:      ptr(false),
+				InfrastructureReady: ptr(false),
+				ProviderKind:        ptr("IntelMachine"),
+				ProviderMachineName: ptr("example-infrastructure"),
+				HostId:              &hostId,
+				BindingName:         ptr("example-cluster-" + hostId),
+			},
 		}},
 		ProviderStatus: &api.GenericStatus{
 			Indicator: (*api.StatusIndicator)(ptr("STATUS_INDICATION_IDLE")),
@@ -195,6 +220,48 @@ func TestGetV2Cluster200(t *testing.T) {
 	require.Equal(t, expectedClusterDetailInfo, actualClusterDetailInfo, "Response body = %v, want %v", actualClusterDetailInfo, expectedClusterDetailInfo)
 }
 
+func TestGetV2ClusterDeleting(t *testing.T) {
+	requestedAt := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	forceFinalizeAt := requestedAt.Add(30 * time.Minute)
+	deletingCluster := capi.Cluster{
+		TypeMeta: metav1.TypeMeta{APIVersion: "cluster.x-k8s.io/v1beta1", Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "example-cluster",
+			Namespace:         activeProjectID,
+			DeletionTimestamp: &metav1.Time{Time: requestedAt},
+			Finalizers:        []string{capi.ClusterFinalizer},
+			Annotations:       map[string]string{deletion.ForceFinalizeAtAnnotationKey: forceFinalizeAt.Format(time.RFC3339)},
+		},
+		Spec: capi.ClusterSpec{Topology: &capi.Topology{Version: "v1.30.6+k3s1", Class: "baseline"}},
+		Status: capi.ClusterStatus{
+			Phase:      string(capi.ClusterPhaseProvisioned),
+			Conditions: []capi.Condition{{Type: capi.ReadyCondition, Status: v1.ConditionTrue, LastTransitionTime: metav1.Now()}},
+		},
+	}
+	unstructuredCluster, err := convert.ToUnstructured(deletingCluster)
+	require.NoError(t, err)
+
+	server := setupMockServer(t, deletingCluster, activeProjectID, unstructuredCluster, nil)
+	req := httptest.NewRequest("GET", "/v2/clusters/example-cluster", nil)
+	req.Header.Set("Activeprojectid", activeProjectID)
+	rr := httptest.NewRecorder()
+	handler, err := server.ConfigureHandler()
+	require.Nil(t, err)
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var detail api.ClusterDetailInfo
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &detail))
+	require.Equal(t, api.STATUSINDICATIONINPROGRESS, *detail.LifecyclePhase.Indicator)
+	require.Equal(t, "deleting", *detail.LifecyclePhase.Message)
+	require.Equal(t, &api.ClusterDeletion{
+		RequestedAt:       requestedAt,
+		MachinesRemaining: 1,
+		Finalizers:        &[]string{capi.ClusterFinalizer},
+		ForceFinalizeAt:   &forceFinalizeAt,
+	}, detail.Deletion)
+}
+
 func TestGetV2Cluster500(t *testing.T) {
 	// prepare test data
 	expectedCluster := capi.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "example-cluster"}}
@@ -258,9 +325,9 @@ func TestGetV2Cluster500(t *testing.T) {
 		require.NoError(t, err, "GetV2ClustersName() error = %v, want nil", err)
 		require.IsType(t, api.GetV2ClustersName500JSONResponse{}, response, "GetV2ClustersName() response = %v, want %v", response, api.GetV2ClustersName500JSONResponse{})
 
-		// Check the response message
+		// Check the response code
 		resp := response.(api.GetV2ClustersName500JSONResponse)
-		require.Equal(t, "missing cluster name", *resp.N500InternalServerErrorJSONResponse.Message, "GetV2ClustersName() message = %v, want %v", *resp.N500InternalServerErrorJSONResponse.Message, "missing cluster name")
+		requireProblemCode(t, messages.ClusterGetFailed, api.ProblemDetails(resp.N500InternalServerErrorJSONResponse))
 	})
 }
 
@@ -282,9 +349,9 @@ func TestGetV2ClustersName400(t *testing.T) {
 		response, err := server.GetV2ClustersName(context.Background(), request)
 		require.NoError(t, err, "GetV2ClustersName() error = %v, want nil", err)
 
-		// check the response message
+		// check the response code
 		resp := response.(api.GetV2ClustersName400JSONResponse)
-		require.Equal(t, "no active project id provided", *resp.N400BadRequestJSONResponse.Message, "GetV2ClustersName() message = %v, want %v", *resp.N400BadRequestJSONResponse.Message, "no active project id provided")
+		requireProblemCode(t, messages.ActiveProjectIDMissing, api.ProblemDetails(resp.N400BadRequestJSONResponse))
 	})
 
 	t.Run("InvalidActiveProjectID", func(t *testing.T) {
@@ -304,9 +371,9 @@ func TestGetV2ClustersName400(t *testing.T) {
 		response, err := server.GetV2ClustersName(context.Background(), request)
 		require.NoError(t, err, "GetV2ClustersName() error = %v, want nil", err)
 
-		// check the response message
+		// check the response code
 		resp := response.(api.GetV2ClustersName400JSONResponse)
-		require.Equal(t, "no active project id provided", *resp.N400BadRequestJSONResponse.Message, "GetV2ClustersName() message = %v, want %v", *resp.N400BadRequestJSONResponse.Message, "no active project id provided")
+		requireProblemCode(t, messages.ActiveProjectIDMissing, api.ProblemDetails(resp.N400BadRequestJSONResponse))
 	})
 
 	t.Run("MissingClusterName", func(t *testing.T) {
@@ -326,9 +393,9 @@ func TestGetV2ClustersName400(t *testing.T) {
 		response, err := server.GetV2ClustersName(context.Background(), request)
 		require.NoError(t, err, "GetV2ClustersName() error = %v, want nil", err)
 
-		// check the response message
+		// check the response code
 		resp := response.(api.GetV2ClustersName400JSONResponse)
-		require.Equal(t, "cluster name is required", *resp.N400BadRequestJSONResponse.Message, "GetV2ClustersName() message = %v, want %v", *resp.N400BadRequestJSONResponse.Message, "cluster name is required")
+		requireProblemCode(t, messages.ClusterNameMissing, api.ProblemDetails(resp.N400BadRequestJSONResponse))
 	})
 
 	t.Run("InvalidClusterNameFormat", func(t *testing.T) {
@@ -345,9 +412,9 @@ func TestGetV2ClustersName400(t *testing.T) {
 		response, err := server.GetV2ClustersName(context.Background(), request)
 		require.NoError(t, err, "GetV2ClustersName() error = %v, want nil", err)
 
-		// check the response message
+		// check the response code
 		resp := response.(api.GetV2ClustersName400JSONResponse)
-		require.Equal(t, "invalid cluster name format", *resp.N400BadRequestJSONResponse.Message, "GetV2ClustersName() message = %v, want %v", *resp.N400BadRequestJSONResponse.Message, "invalid cluster name format")
+		requireProblemCode(t, messages.InvalidClusterName, api.ProblemDetails(resp.N400BadRequestJSONResponse))
 	})
 }
 func TestGetV2ClustersName404(t *testing.T) {
@@ -375,10 +442,10 @@ func TestGetV2ClustersName404(t *testing.T) {
 		response, err := server.GetV2ClustersName(context.Background(), request)
 		require.NoError(t, err, "GetV2ClustersName() error = %v, want nil", err)
 
-		// check the response type and message
+		// check the response type and code
 		resp, ok := response.(api.GetV2ClustersName404JSONResponse)
 		require.True(t, ok, "GetV2ClustersName() response type = %T, want api.GetV2ClustersName404JSONResponse", response)
-		require.Equal(t, "failed to get cluster, err: cluster not found", *resp.N404NotFoundJSONResponse.Message, "GetV2ClustersName() message = %v, want %v", *resp.N404NotFoundJSONResponse.Message, "cluster not found")
+		requireProblemCode(t, messages.ClusterNotFound, api.ProblemDetails(resp.N404NotFoundJSONResponse))
 	})
 }
 func TestGetV2ClusterNoNodesPopulatesDefault(t *testing.T) {
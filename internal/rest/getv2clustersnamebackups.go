@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/clusters/{name}/backups)
+func (s *Server) GetV2ClustersNameBackups(ctx context.Context, request api.GetV2ClustersNameBackupsRequestObject) (api.GetV2ClustersNameBackupsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	cli := k8s.New(s.reader())
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2ClustersNameBackups500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	_, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameBackups404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameBackups500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	backups, err := cli.Backups(ctx, activeProjectID, request.Name)
+	if err != nil {
+		message := messages.New(messages.BackupsListFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameBackups500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	response := api.GetV2ClustersNameBackups200JSONResponse{Backups: []api.ClusterBackup{}}
+	for _, backup := range backups {
+		response.Backups = append(response.Backups, clusterBackup(backup))
+	}
+	return response, nil
+}
+
+// clusterBackup converts the backup resource to its API representation
+func clusterBackup(backup v1alpha1.Backup) api.ClusterBackup {
+	phase := backup.Status.Phase
+	if phase == "" {
+		phase = v1alpha1.BackupPending
+	}
+
+	result := api.ClusterBackup{
+		Name:        backup.Name,
+		ClusterName: backup.Spec.ClusterName,
+		Phase:       api.ClusterBackupPhase(phase),
+	}
+	if !backup.CreationTimestamp.IsZero() {
+		result.CreatedAt = ptr(backup.CreationTimestamp.Time)
+	}
+	if backup.Status.SnapshotName != "" {
+		result.SnapshotName = ptr(backup.Status.SnapshotName)
+	}
+	if backup.Status.Location != "" {
+		result.Location = ptr(backup.Status.Location)
+	}
+	if backup.Status.Size != nil {
+		result.Size = ptr(backup.Status.Size.String())
+	}
+	if backup.Status.CompletionTime != nil {
+		result.CompletedAt = ptr(backup.Status.CompletionTime.Time)
+	}
+	if backup.Status.Message != "" {
+		result.Message = ptr(backup.Status.Message)
+	}
+
+	if restore := backup.Status.Restore; restore != nil {
+		result.Restore = &api.ClusterRestore{Phase: api.ClusterRestorePhase(restore.Phase)}
+		if restore.StartTime != nil {
+			result.Restore.StartedAt = ptr(restore.StartTime.Time)
+		}
+		if restore.CompletionTime != nil {
+			result.Restore.CompletedAt = ptr(restore.CompletionTime.Time)
+		}
+		if restore.Message != "" {
+			result.Restore.Message = ptr(restore.Message)
+		}
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func clusterBackupResource(t *testing.T, name, clusterName string, created time.Time, status v1alpha1.BackupStatus) unstructured.Unstructured {
+	return toUnstructured(t, &v1alpha1.Backup{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: activeProjectID, CreationTimestamp: v1.NewTime(created)},
+		Spec:       v1alpha1.BackupSpec{ClusterName: clusterName},
+		Status:     status,
+	})
+}
+
+func TestGetV2ClustersNameBackups(t *testing.T) {
+	t.Run("backups of the cluster are listed newest first", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		now := time.Now()
+		size := resource.MustParse("12Mi")
+		backups := k8s.NewMockResourceInterface(t)
+		backups.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			clusterBackupResource(t, "example-cluster-old", "example-cluster", now.Add(-time.Hour), v1alpha1.BackupStatus{
+				Phase:        v1alpha1.BackupCompleted,
+				SnapshotName: "example-cluster-old-node-1",
+				Size:         &size,
+				Restore:      &v1alpha1.RestoreStatus{Phase: v1alpha1.RestoreFailed, Message: "job failed"},
+			}),
+			clusterBackupResource(t, "other-cluster-backup", "other-cluster", now, v1alpha1.BackupStatus{Phase: v1alpha1.BackupCompleted}),
+			clusterBackupResource(t, "example-cluster-new", "example-cluster", now, v1alpha1.BackupStatus{}),
+		}}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.BackupResourceSchema:  backups,
+		}, http.MethodGet, "/v2/clusters/example-cluster/backups", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list api.ClusterBackupList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Backups, 2)
+
+		require.Equal(t, "example-cluster-new", list.Backups[0].Name)
+		require.Equal(t, api.ClusterBackupPhasePending, list.Backups[0].Phase)
+		require.Nil(t, list.Backups[0].Restore)
+
+		require.Equal(t, "example-cluster-old", list.Backups[1].Name)
+		require.Equal(t, api.ClusterBackupPhaseCompleted, list.Backups[1].Phase)
+		require.Equal(t, "example-cluster-old-node-1", *list.Backups[1].SnapshotName)
+		require.Equal(t, "12Mi", *list.Backups[1].Size)
+		require.Equal(t, api.ClusterRestorePhaseFailed, list.Backups[1].Restore.Phase)
+		require.Equal(t, "job failed", *list.Backups[1].Restore.Message)
+	})
+
+	t.Run("missing clusters are not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodGet, "/v2/clusters/example-cluster/backups", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/watch"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// keepAliveInterval is the interval at which a comment is written to idle event streams
+// so that proxies and load balancers do not close the connection
+var keepAliveInterval = 30 * time.Second
+
+// (GET /v2/clusters/{name}/events)
+func (s *Server) GetV2ClustersNameEvents(ctx context.Context, request api.GetV2ClustersNameEventsRequestObject) (api.GetV2ClustersNameEventsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if request.Params.Source != nil && *request.Params.Source == api.GetV2ClustersNameEventsParamsSourceKubernetes {
+		return s.listClusterEvents(ctx, activeProjectID, request.Name)
+	}
+
+	if s.clusterEvents == nil {
+		return api.GetV2ClustersNameEvents501JSONResponse{
+			N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.ClusterEventsDisabled))),
+		}, nil
+	}
+
+	events, unsubscribe, err := s.clusterEvents.Subscribe(activeProjectID, request.Name)
+	if err != nil {
+		if errors.Is(err, k8s.ErrClusterNotFound) {
+			return api.GetV2ClustersNameEvents404JSONResponse{
+				N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.ClusterNotFound, request.Name))),
+			}, nil
+		}
+		logger.FromContext(ctx).Error("failed to subscribe to cluster events", "name", request.Name, "error", err)
+		return api.GetV2ClustersNameEvents500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ClusterEventsFailed, request.Name, err))),
+		}, nil
+	}
+
+	return clusterEventStream{ctx: ctx, shutdown: s.shutdown, name: request.Name, events: events, unsubscribe: unsubscribe}, nil
+}
+
+// listClusterEvents lists the Kubernetes events of the cluster, e.g. the failures of the provider to create its
+// machines, that are otherwise only visible with "kubectl describe"
+func (s *Server) listClusterEvents(ctx context.Context, activeProjectID, name string) (api.GetV2ClustersNameEventsResponseObject, error) {
+	events, err := k8s.New(s.reader()).ClusterEvents(ctx, activeProjectID, name)
+	if errors.Is(err, k8s.ErrClusterNotFound) {
+		return api.GetV2ClustersNameEvents404JSONResponse{
+			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.ClusterNotFound, name))),
+		}, nil
+	}
+	if err != nil {
+		message := messages.New(messages.ClusterEventsListFailed, name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameEvents500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message)),
+		}, nil
+	}
+
+	list := make([]api.ClusterEvent, 0, len(events))
+	for _, event := range events {
+		clusterEvent := api.ClusterEvent{
+			Time:    k8s.EventTime(event),
+			Type:    event.Type,
+			Reason:  event.Reason,
+			Message: event.Message,
+			InvolvedObject: api.ClusterEventObject{
+				Kind: event.InvolvedObject.Kind,
+				Name: event.InvolvedObject.Name,
+			},
+		}
+		if event.Count > 0 {
+			clusterEvent.Count = ptr(event.Count)
+		}
+		list = append(list, clusterEvent)
+	}
+	return api.GetV2ClustersNameEvents200JSONResponse{Events: &list}, nil
+}
+
+// clusterEventStream writes the cluster status changes to the client as server-sent events
+type clusterEventStream struct {
+	ctx context.Context
+	// shutdown ends the stream once the server starts shutting down, the client reconnects to another replica
+	shutdown    <-chan struct{}
+	name        string
+	events      <-chan k8s.ClusterEvent
+	unsubscribe func()
+}
+
+func (stream clusterEventStream) VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error {
+	defer stream.unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	flush := func() {
+		if err := rc.Flush(); err != nil {
+			logger.FromContext(stream.ctx).Debug("failed to flush cluster event stream", "name", stream.name, "error", err)
+		}
+	}
+	flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	var last []byte
+	for {
+		select {
+		case <-stream.ctx.Done():
+			return nil
+		case <-stream.shutdown:
+			return nil
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+			flush()
+		case event, ok := <-stream.events:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(clusterStatusEvent(stream.ctx, stream.name, event.Cluster))
+			if err != nil {
+				return err
+			}
+
+			if event.Type == watch.Deleted {
+				if _, err := fmt.Fprintf(w, "event: deleted\ndata: %s\n\n", data); err != nil {
+					return err
+				}
+				flush()
+				return nil
+			}
+
+			// only push changes of the statuses exposed in the event
+			if bytes.Equal(data, last) {
+				continue
+			}
+			last = data
+
+			if _, err := fmt.Fprintf(w, "event: status\ndata: %s\n\n", data); err != nil {
+				return err
+			}
+			flush()
+		}
+	}
+}
+
+func clusterStatusEvent(ctx context.Context, name string, cluster *capi.Cluster) api.ClusterStatusEvent {
+	lp, errs := getClusterLifecyclePhase(cluster)
+	if len(errs) > 0 {
+		logger.FromContext(ctx).Debug("errors while building cluster lifecycle phase", "cluster", name, "errors", errs)
+	}
+
+	return api.ClusterStatusEvent{
+		Name:                &name,
+		LifecyclePhase:      lp,
+		ControlPlaneReady:   getControlPlaneReady(cluster),
+		InfrastructureReady: getInfrastructureReady(cluster),
+	}
+}
@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+type fakeClusterEvents struct {
+	namespace    string
+	name         string
+	events       []k8s.ClusterEvent
+	err          error
+	unsubscribed bool
+}
+
+func (f *fakeClusterEvents) Subscribe(namespace, name string) (<-chan k8s.ClusterEvent, func(), error) {
+	f.namespace, f.name = namespace, name
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	ch := make(chan k8s.ClusterEvent, len(f.events))
+	for _, e := range f.events {
+		ch <- e
+	}
+	close(ch)
+
+	return ch, func() { f.unsubscribed = true }, nil
+}
+
+func eventsTestCluster(phase capi.ClusterPhase, controlPlaneReady, infrastructureReady v1.ConditionStatus) *capi.Cluster {
+	transitionTime := metav1.Unix(1700000000, 0)
+	return &capi.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-cluster", Namespace: activeProjectID},
+		Status: capi.ClusterStatus{
+			Phase: string(phase),
+			Conditions: []capi.Condition{
+				{Type: capi.ReadyCondition, Status: controlPlaneReady, LastTransitionTime: transitionTime},
+				{Type: capi.ControlPlaneReadyCondition, Status: controlPlaneReady, LastTransitionTime: transitionTime},
+				{Type: capi.InfrastructureReadyCondition, Status: infrastructureReady, LastTransitionTime: transitionTime},
+			},
+		},
+	}
+}
+
+// parseClusterEvents splits a server-sent events stream into event names and payloads
+func parseClusterEvents(t *testing.T, body string) ([]string, []api.ClusterStatusEvent) {
+	var names []string
+	var payloads []api.ClusterStatusEvent
+	for _, block := range strings.Split(strings.TrimSpace(body), "\n\n") {
+		var name, data string
+		for _, line := range strings.Split(block, "\n") {
+			if v, ok := strings.CutPrefix(line, "event: "); ok {
+				name = v
+			}
+			if v, ok := strings.CutPrefix(line, "data: "); ok {
+				data = v
+			}
+		}
+		if name == "" {
+			continue
+		}
+
+		var payload api.ClusterStatusEvent
+		require.NoError(t, json.Unmarshal([]byte(data), &payload))
+		names = append(names, name)
+		payloads = append(payloads, payload)
+	}
+	return names, payloads
+}
+
+func TestGetV2ClustersNameEvents200(t *testing.T) {
+	provisioning := eventsTestCluster(capi.ClusterPhaseProvisioning, v1.ConditionFalse, v1.ConditionTrue)
+	provisioned := eventsTestCluster(capi.ClusterPhaseProvisioned, v1.ConditionTrue, v1.ConditionTrue)
+
+	events := &fakeClusterEvents{events: []k8s.ClusterEvent{
+		{Type: watch.Added, Cluster: provisioning},
+		// unrelated change of the cluster object, statuses are the same
+		{Type: watch.Modified, Cluster: provisioning},
+		{Type: watch.Modified, Cluster: provisioned},
+		{Type: watch.Deleted, Cluster: provisioned},
+	}}
+
+	server := NewServer(k8s.NewMockInterface(t), WithClusterEvents(events))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/clusters/example-cluster/events", nil)
+	req.Header.Set("Activeprojectid", activeProjectID)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+	require.Equal(t, activeProjectID, events.namespace)
+	require.Equal(t, "example-cluster", events.name)
+	require.True(t, events.unsubscribed)
+
+	names, payloads := parseClusterEvents(t, rr.Body.String())
+	require.Equal(t, []string{"status", "status", "deleted"}, names)
+
+	require.Equal(t, "example-cluster", *payloads[0].Name)
+	require.Equal(t, "provisioning", *payloads[0].LifecyclePhase.Message)
+	require.Equal(t, api.STATUSINDICATIONINPROGRESS, *payloads[0].ControlPlaneReady.Indicator)
+	require.Equal(t, api.STATUSINDICATIONIDLE, *payloads[0].InfrastructureReady.Indicator)
+
+	require.Equal(t, "active", *payloads[1].LifecyclePhase.Message)
+	require.Equal(t, api.STATUSINDICATIONIDLE, *payloads[1].ControlPlaneReady.Indicator)
+}
+
+func TestGetV2ClustersNameEvents404(t *testing.T) {
+	events := &fakeClusterEvents{err: k8s.ErrClusterNotFound}
+	server := NewServer(k8s.NewMockInterface(t), WithClusterEvents(events))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/clusters/example-cluster/events", nil)
+	req.Header.Set("Activeprojectid", activeProjectID)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
+}
+
+func TestGetV2ClustersNameEvents500(t *testing.T) {
+	events := &fakeClusterEvents{err: errors.New("cache not synced")}
+	server := NewServer(k8s.NewMockInterface(t), WithClusterEvents(events))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/clusters/example-cluster/events", nil)
+	req.Header.Set("Activeprojectid", activeProjectID)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestGetV2ClustersNameEvents501(t *testing.T) {
+	server := NewServer(k8s.NewMockInterface(t))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/v2/clusters/example-cluster/events", nil)
+	req.Header.Set("Activeprojectid", activeProjectID)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusNotImplemented, rr.Code)
+}
+
+func TestGetV2ClustersNameEventsKubernetes(t *testing.T) {
+	toUnstructured := func(obj any) unstructured.Unstructured {
+		u, err := convert.ToUnstructured(obj)
+		require.NoError(t, err)
+		return *u
+	}
+	event := func(name, kind, objectName, reason string, last int64) unstructured.Unstructured {
+		return toUnstructured(v1.Event{
+			TypeMeta:       metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: activeProjectID},
+			InvolvedObject: v1.ObjectReference{Kind: kind, Name: objectName},
+			Type:           v1.EventTypeWarning,
+			Reason:         reason,
+			Message:        reason + " " + objectName,
+			Count:          2,
+			LastTimestamp:  metav1.Unix(last, 0),
+		})
+	}
+
+	t.Run("events of the cluster objects", func(t *testing.T) {
+		cluster := capi.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: core.ClusterResourceSchema.GroupVersion().String(), Kind: "Cluster"},
+			ObjectMeta: metav1.ObjectMeta{Name: "example-cluster", Namespace: activeProjectID},
+			Spec: capi.ClusterSpec{
+				ControlPlaneRef: &v1.ObjectReference{Kind: "RKE2ControlPlane", Name: "example-cluster"},
+			},
+		}
+		machine := capi.Machine{
+			TypeMeta:   metav1.TypeMeta{APIVersion: core.MachineResourceSchema.GroupVersion().String(), Kind: "Machine"},
+			ObjectMeta: metav1.ObjectMeta{Name: "example-cluster-cp-1", Namespace: activeProjectID},
+			Spec: capi.MachineSpec{
+				ClusterName:       "example-cluster",
+				InfrastructureRef: v1.ObjectReference{Kind: "IntelMachine", Name: "example-cluster-cp-1"},
+			},
+		}
+
+		clusters := k8s.NewMockResourceInterface(t)
+		clusterObject := toUnstructured(cluster)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&clusterObject, nil)
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, metav1.ListOptions{LabelSelector: "cluster.x-k8s.io/cluster-name=example-cluster"}).
+			Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{toUnstructured(machine)}}, nil)
+		events := k8s.NewMockResourceInterface(t)
+		events.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			event("e1", "IntelMachine", "example-cluster-cp-1", "HostNotFound", 1700000300),
+			event("e2", "Cluster", "example-cluster", "TopologyCreate", 1700000100),
+			event("e3", "Machine", "other-cluster-cp-1", "Unrelated", 1700000000),
+			event("e4", "RKE2ControlPlane", "example-cluster", "ScalingUp", 1700000200),
+			event("e5", "Machine", "example-cluster-cp-1", "DetectedUnhealthy", 1700000400),
+		}}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: machines,
+			core.EventResourceSchema:   events,
+		}, http.MethodGet, "/v2/clusters/example-cluster/events?source=kubernetes", nil)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		var list api.ClusterEventList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.NotNil(t, list.Events)
+		var reasons []string
+		for _, e := range *list.Events {
+			reasons = append(reasons, e.Reason)
+		}
+		require.Equal(t, []string{"TopologyCreate", "ScalingUp", "HostNotFound", "DetectedUnhealthy"}, reasons)
+
+		first := (*list.Events)[0]
+		require.Equal(t, api.ClusterEventObject{Kind: "Cluster", Name: "example-cluster"}, first.InvolvedObject)
+		require.Equal(t, "TopologyCreate example-cluster", first.Message)
+		require.Equal(t, v1.EventTypeWarning, first.Type)
+		require.Equal(t, int32(2), *first.Count)
+		require.True(t, first.Time.Equal(metav1.Unix(1700000100, 0).Time))
+	})
+
+	t.Run("cluster not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodGet, "/v2/clusters/example-cluster/events?source=kubernetes", nil)
+
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("events not listed", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusterObject := toUnstructured(capi.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: core.ClusterResourceSchema.GroupVersion().String(), Kind: "Cluster"},
+			ObjectMeta: metav1.ObjectMeta{Name: "example-cluster", Namespace: activeProjectID},
+		})
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&clusterObject, nil)
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, mock.Anything).Return(&unstructured.UnstructuredList{}, nil)
+		events := k8s.NewMockResourceInterface(t)
+		events.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(nil, errors.New("forbidden"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: machines,
+			core.EventResourceSchema:   events,
+		}, http.MethodGet, "/v2/clusters/example-cluster/events?source=kubernetes", nil)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterEventsListFailed, rr.Body.Bytes())
+	})
+}
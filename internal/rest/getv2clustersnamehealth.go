@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/health"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/clusters/{name}/health)
+func (s *Server) GetV2ClustersNameHealth(ctx context.Context, request api.GetV2ClustersNameHealthRequestObject) (api.GetV2ClustersNameHealthResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.health == nil {
+		message := messages.New(messages.ClusterHealthDisabled)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameHealth501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, message))}, nil
+	}
+
+	report, err := s.health.Report(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameHealth404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterHealthFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameHealth500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	return api.GetV2ClustersNameHealth200JSONResponse(clusterHealth(report)), nil
+}
+
+func clusterHealth(report health.Report) api.ClusterHealth {
+	response := api.ClusterHealth{
+		Status:          api.ClusterHealthStatus(report.Status),
+		ProbedAt:        report.ProbedAt,
+		Nodes:           []api.NodeHealthReport{},
+		SystemPods:      report.SystemPods,
+		ReadySystemPods: report.ReadySystemPods,
+		UnhealthyPods:   []api.PodHealthReport{},
+	}
+	if report.Message != "" {
+		response.Message = ptr(report.Message)
+	}
+
+	for _, node := range report.Nodes {
+		conditions := []api.NodeHealthCondition{}
+		for _, condition := range node.Conditions {
+			conditions = append(conditions, api.NodeHealthCondition{
+				Type:    condition.Type,
+				Status:  condition.Status,
+				Reason:  optional(condition.Reason),
+				Message: optional(condition.Message),
+			})
+		}
+		response.Nodes = append(response.Nodes, api.NodeHealthReport{Name: node.Name, Ready: node.Ready, Conditions: conditions})
+	}
+
+	for _, pod := range report.UnhealthyPods {
+		response.UnhealthyPods = append(response.UnhealthyPods, api.PodHealthReport{
+			Name:     pod.Name,
+			Phase:    pod.Phase,
+			Reason:   optional(pod.Reason),
+			Restarts: pod.Restarts,
+		})
+	}
+	return response
+}
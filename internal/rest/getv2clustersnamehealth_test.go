@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/health"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+type fakeClusterHealth struct {
+	report health.Report
+	err    error
+}
+
+func (f fakeClusterHealth) Report(_ context.Context, projectID, clusterName string) (health.Report, error) {
+	if projectID != activeProjectID || clusterName != "example-cluster" {
+		return health.Report{}, k8s.ErrClusterNotFound
+	}
+	return f.report, f.err
+}
+
+func TestGetV2ClustersNameHealth(t *testing.T) {
+	t.Run("report is returned", func(t *testing.T) {
+		probedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		report := health.Report{
+			Status:   health.Degraded,
+			ProbedAt: probedAt,
+			Nodes: []health.NodeReport{{Name: "node-1", Ready: true, Conditions: []health.NodeCondition{
+				{Type: "MemoryPressure", Status: "True", Reason: "KubeletHasInsufficientMemory"},
+			}}},
+			SystemPods:      3,
+			ReadySystemPods: 2,
+			UnhealthyPods:   []health.PodReport{{Name: "coredns", Phase: "Running", Reason: "CrashLoopBackOff", Restarts: 7}},
+		}
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/clusters/example-cluster/health", nil, WithClusterHealth(fakeClusterHealth{report: report}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var resp api.ClusterHealth
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Equal(t, api.ClusterHealth{
+			Status:   api.ClusterHealthStatus("degraded"),
+			ProbedAt: probedAt,
+			Nodes: []api.NodeHealthReport{{Name: "node-1", Ready: true, Conditions: []api.NodeHealthCondition{
+				{Type: "MemoryPressure", Status: "True", Reason: ptr("KubeletHasInsufficientMemory")},
+			}}},
+			SystemPods:      3,
+			ReadySystemPods: 2,
+			UnhealthyPods:   []api.PodHealthReport{{Name: "coredns", Phase: "Running", Reason: ptr("CrashLoopBackOff"), Restarts: 7}},
+		}, resp)
+	})
+
+	t.Run("unreachable cluster is reported with the reason", func(t *testing.T) {
+		report := health.Report{Status: health.Unreachable, Message: "the kubeconfig of the cluster is not available yet"}
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/clusters/example-cluster/health", nil, WithClusterHealth(fakeClusterHealth{report: report}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var resp api.ClusterHealth
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Equal(t, api.ClusterHealthStatus("unreachable"), resp.Status)
+		require.Equal(t, "the kubeconfig of the cluster is not available yet", *resp.Message)
+		require.Empty(t, resp.Nodes)
+	})
+
+	t.Run("missing clusters are not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/clusters/example-cluster/health", nil, WithClusterHealth(fakeClusterHealth{err: k8s.ErrClusterNotFound}))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("probe failures are internal errors", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/clusters/example-cluster/health", nil, WithClusterHealth(fakeClusterHealth{err: errors.New("connection refused")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("health is not implemented without prober", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/clusters/example-cluster/health", nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+	})
+}
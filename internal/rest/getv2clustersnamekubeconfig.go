@@ -5,17 +5,19 @@ package rest
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"log/slog"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/kubeconfigs"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 
-	"gopkg.in/yaml.v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -35,36 +37,41 @@ func (s *Server) GetV2ClustersNameKubeconfigs(ctx context.Context, request api.G
 
 	authHeader := request.Params.Authorization
 	if !strings.HasPrefix(authHeader, auth.BearerPrefix) {
-		slog.Error("invalid Authorization header", "authHeader", authHeader)
+		logger.FromContext(ctx).Error("invalid Authorization header", "authHeader", authHeader)
 		return api.GetV2ClustersNameKubeconfigs401JSONResponse{
-			N401UnauthorizedJSONResponse: api.N401UnauthorizedJSONResponse{
-				Message: ptr("Unauthorized: invalid Authorization header"),
-			},
+			N401UnauthorizedJSONResponse: api.N401UnauthorizedJSONResponse(problem(ctx, messages.New(messages.InvalidAuthorizationHeader))),
 		}, nil
 	}
 
 	clusterKubeconfig, err := s.getClusterKubeconfig(ctx, namespace, request.Name)
 	if err != nil {
-		slog.Error("failed to get kubeconfig", "error", err)
+		logger.FromContext(ctx).Error("failed to get kubeconfig", "error", err)
 		return api.GetV2ClustersNameKubeconfigs404JSONResponse{
-			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{
-				Message: ptr("404 Not Found: kubeconfig not found"),
-			},
+			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.KubeconfigNotFound))),
 		}, nil
 	}
 
+	if request.Params.AuthType != nil && *request.Params.AuthType == api.GetV2ClustersNameKubeconfigsParamsAuthTypeOidcExec {
+		clusterKubeconfigUpdated, err := updateKubeconfigWithExec(ctx, clusterKubeconfig, namespace, request.Name, s.kubeconfigOidcIssuer(), s.config.KubeconfigOidcClientID)
+		if err != nil {
+			logger.FromContext(ctx).Error("failed to update kubeconfig with oidc exec plugin", "error", err)
+			return api.GetV2ClustersNameKubeconfigs500JSONResponse{
+				N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.KubeconfigFailed))),
+			}, nil
+		}
+		return api.GetV2ClustersNameKubeconfigs200JSONResponse{Kubeconfig: ptr(clusterKubeconfigUpdated)}, nil
+	}
+
 	var kubeconfigTTL *time.Duration
 	if s.config != nil {
 		kubeconfigTTL = &s.config.KubeconfigTTL
 	}
 
-	clusterKubeconfigUpdated, err := updateKubeconfigWithTokenFunc(clusterKubeconfig, namespace, request.Name, request.Params.Authorization, s.config.DisableAuth, kubeconfigTTL)
+	clusterKubeconfigUpdated, err := updateKubeconfigWithTokenFunc(ctx, clusterKubeconfig, namespace, request.Name, request.Params.Authorization, s.config.DisableAuth, kubeconfigTTL)
 	if err != nil {
-		slog.Error("failed to update kubeconfig with token", "error", err)
+		logger.FromContext(ctx).Error("failed to update kubeconfig with token", "error", err)
 		return api.GetV2ClustersNameKubeconfigs500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr("500 Internal Server Error: failed to process kubeconfig"),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.KubeconfigFailed))),
 		}, nil
 	}
 
@@ -76,7 +83,7 @@ func (s *Server) getClusterKubeconfig(ctx context.Context, namespace, clusterNam
 		return kubeconfigParameters{}, fmt.Errorf("config is nil")
 	}
 
-	unstructuredClusterSecret, err := s.k8sclient.Resource(core.SecretResourceSchema).
+	unstructuredClusterSecret, err := s.reader().Resource(core.SecretResourceSchema).
 		Namespace(namespace).Get(ctx, fmt.Sprintf("%s-kubeconfig", clusterName), metav1.GetOptions{})
 	if err != nil || unstructuredClusterSecret == nil {
 		return kubeconfigParameters{}, fmt.Errorf("failed to get kubeconfig secret: %w", err)
@@ -95,166 +102,80 @@ func (s *Server) getClusterKubeconfig(ctx context.Context, namespace, clusterNam
 		return kubeconfigParameters{}, fmt.Errorf("failed to decode kubeconfig data: %w", err)
 	}
 
-	var caDataInSecretValue string
 	apiServerCA, found, err := unstructured.NestedString(unstructuredClusterSecret.Object, "data", "apiServerCA")
 	if err != nil || !found {
-		slog.Warn("failed to get apiServerCA from secret", "namespace", namespace, "name", clusterName, "error", err)
+		logger.FromContext(ctx).Warn("failed to get apiServerCA from secret", "namespace", namespace, "name", clusterName, "error", err)
 
-		caData, err := unmarshalKubeconfig(string(kubeconfigBytes))
+		kubeconfig, err := kubeconfigs.Parse(string(kubeconfigBytes), namespace, clusterName)
 		if err != nil {
 			return kubeconfigParameters{}, err
 		}
-
-		caDataInSecretValue, err = getCertificateAuthorityData(caData)
-		if err != nil {
-			return kubeconfigParameters{}, err
+		if kubeconfig.CAData == "" {
+			return kubeconfigParameters{}, fmt.Errorf("failed to get certificate-authority-data from kubeconfig")
 		}
-
-		return kubeconfigParameters{serverCA: caDataInSecretValue, clusterDomain: s.config.ClusterDomain, userName: s.config.Username, kubeConfigDecode: string(kubeconfigBytes)}, nil
-
+		apiServerCA = kubeconfig.CAData
 	}
 
-	return kubeconfigParameters{serverCA: apiServerCA, clusterDomain: s.config.ClusterDomain, userName: s.config.Username, kubeConfigDecode: string(kubeconfigBytes)}, nil
+	return kubeconfigParameters{
+		serverCA:         apiServerCA,
+		clusterDomain:    s.config.ClusterDomain,
+		userName:         s.config.Username,
+		kubeConfigDecode: string(kubeconfigBytes),
+		pipeline:         s.kubeconfigs,
+	}, nil
 }
 
-// server internal kubeconfig from secrets:
-// server: http://edge-connect-gateway-cluster-connect-gateway.orch-cluster.svc:8080/kubernetes/<project-id>-<cluster-name>
-// server external:
-// server: https://connect-gateway.<domain>:443/kubernetes/<project-id>-<cluster-name>
-
-func updateKubeconfigWithToken(kubeconfig kubeconfigParameters, namespace, clusterName, authHeader string, disableAuth bool, ttl *time.Duration) (string, error) {
-	token := auth.GetAccessToken(authHeader)
-	newAccessToken, err := tokenRenewalFunc(token, disableAuth, ttl)
-	if err != nil {
-		return "", err
-	}
-	caData, domain, userName := kubeconfig.serverCA, kubeconfig.clusterDomain, kubeconfig.userName
-
-	config, err := unmarshalKubeconfig(kubeconfig.kubeConfigDecode)
-	if err != nil {
-		return "", err
+// kubeconfigOidcIssuer returns the issuer URL of the OIDC provider configured in the kubeconfigs with the OIDC exec
+// credential plugin, by default the keycloak of the cluster domain
+func (s *Server) kubeconfigOidcIssuer() string {
+	if s.config.KubeconfigOidcIssuer != "" {
+		return s.config.KubeconfigOidcIssuer
 	}
+	return fmt.Sprintf("https://keycloak.%s/realms/master", s.config.ClusterDomain)
+}
 
-	serverUrl, err := extractServerURL(kubeconfig.kubeConfigDecode)
-	if err != nil {
-		return "", err
-	}
-
-	middleUrl := fmt.Sprintf("/kubernetes/%s-%s", namespace, clusterName)
-
-	endSegment, err := extractEndSegment(serverUrl, middleUrl)
+func updateKubeconfigWithToken(ctx context.Context, kubeconfig kubeconfigParameters, namespace, clusterName, authHeader string, disableAuth bool, ttl *time.Duration) (string, error) {
+	token := auth.GetAccessToken(authHeader)
+	newAccessToken, err := tokenRenewalFunc(ctx, token, disableAuth, ttl)
 	if err != nil {
 		return "", err
 	}
 
-	serverAddress := fmt.Sprintf("https://connect-gateway.%s:443%s%s", domain, middleUrl, endSegment)
-	slog.Debug("serverAddress", "decoded", serverAddress)
-
-	updateKubeconfigFields(config, clusterName+"-"+userName, clusterName, serverAddress, caData, newAccessToken)
-
-	updatedKubeconfig, err := yaml.Marshal(config)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal updated kubeconfig: %w", err)
-	}
-
-	return string(updatedKubeconfig), nil
-}
-
-func unmarshalKubeconfig(kubeconfig string) (map[string]interface{}, error) {
-	var config map[string]interface{}
-	if err := yaml.Unmarshal([]byte(kubeconfig), &config); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal kubeconfig: %w", err)
-	}
-
-	return config, nil
+	return updateKubeconfigWithUser(ctx, kubeconfig, namespace, clusterName, kubeconfigs.InjectToken(newAccessToken))
 }
 
-func extractServerURL(kubeconfigData string) (string, error) {
-	var config kubeConfigData
-	if err := yaml.Unmarshal([]byte(kubeconfigData), &config); err != nil {
-		return "", fmt.Errorf("failed to parse kubeconfig data: %v", err)
-	}
-
-	if len(config.Clusters) == 0 {
-		return "", fmt.Errorf("no clusters found in kubeconfig")
-	}
-
-	serverURL := config.Clusters[0].Cluster.Server
-
-	return serverURL, nil
-}
-
-// getCertificateAuthorityData extracts the certificate-authority-data from the data values in kubeconfig secret
-func getCertificateAuthorityData(config map[string]interface{}) (string, error) {
-	clusters, ok := config["clusters"].([]interface{})
-	if !ok || len(clusters) == 0 {
-		return "", fmt.Errorf("invalid kubeconfig: missing clusters")
-	}
-
-	cluster, ok := clusters[0].(map[interface{}]interface{})
-	if !ok {
-		return "", fmt.Errorf("invalid kubeconfig: cluster is not a map")
-	}
-
-	clusterData, ok := cluster["cluster"].(map[interface{}]interface{})
-	if !ok {
-		return "", fmt.Errorf("invalid kubeconfig: cluster data is not a map")
-	}
-
-	caData, ok := clusterData["certificate-authority-data"]
-	if !ok {
-		return "", fmt.Errorf("failed to get certificate-authority-data from kubeconfig")
-	}
-
-	caDataInSecretValue, ok := caData.(string)
-	if !ok {
-		return "", fmt.Errorf("failed to assert caData to string")
-	}
-
-	return caDataInSecretValue, nil
+// updateKubeconfigWithExec configures the user of the kubeconfig with the kubectl oidc-login exec credential plugin
+// instead of a token, so that kubectl logs in to the OIDC provider and refreshes the tokens itself
+func updateKubeconfigWithExec(ctx context.Context, kubeconfig kubeconfigParameters, namespace, clusterName, issuer, clientID string) (string, error) {
+	return updateKubeconfigWithUser(ctx, kubeconfig, namespace, clusterName, kubeconfigs.OIDCExec(issuer, clientID))
 }
 
-func updateKubeconfigFields(config map[string]interface{}, user, clusterName, serverAddress string, caData, token interface{}) {
-	config["apiVersion"] = "v1"
-	config["kind"] = "Config"
-	config["clusters"] = []map[string]interface{}{
-		{
-			"name": clusterName,
-			"cluster": map[string]interface{}{
-				"server":                     serverAddress,
-				"certificate-authority-data": caData,
-			},
-		},
-	}
-
-	config["users"] = []map[string]interface{}{
-		{
-			"name": user,
-			"user": map[string]interface{}{
-				"token": token,
-			},
-		},
+// updateKubeconfigWithUser processes the kubeconfig of the cluster with the kubeconfig pipeline of the deployment
+// followed by the step setting the credentials of the user; without pipeline the server URL is rewritten to the
+// connect gateway of the cluster domain
+func updateKubeconfigWithUser(ctx context.Context, kubeconfig kubeconfigParameters, namespace, clusterName string, credentials kubeconfigs.Step) (string, error) {
+	config, err := kubeconfigs.Parse(kubeconfig.kubeConfigDecode, namespace, clusterName)
+	if err != nil {
+		return "", err
 	}
+	config.CAData = kubeconfig.serverCA
+	config.UserName = clusterName + "-" + kubeconfig.userName
 
-	config["contexts"] = []map[string]interface{}{
-		{
-			"name": user + "@" + clusterName,
-			"context": map[string]interface{}{
-				"user":    user,
-				"cluster": clusterName,
-			},
-		},
+	pipeline := kubeconfig.pipeline
+	if pipeline == nil {
+		pipeline = kubeconfigs.NewPipeline(kubeconfigs.RewriteServerURL(kubeconfigs.DefaultGatewayURL(kubeconfig.clusterDomain)))
 	}
+	return pipeline.Process(ctx, config, credentials)
 }
 
 var (
 	lastAppliedTTLSeconds int64 = -1
 )
 
-func tokenRenewal(accessToken string, disableAuth bool, ttl *time.Duration) (string, error) {
+func tokenRenewal(ctx context.Context, accessToken string, disableAuth bool, ttl *time.Duration) (string, error) {
 	// skip renewal outright if auth disabled
 	if disableAuth {
-		slog.Debug("authentication disabled, skipping token renewal")
+		logger.FromContext(ctx).Debug("authentication disabled, skipping token renewal")
 		return accessToken, nil
 	}
 
@@ -262,12 +183,10 @@ func tokenRenewal(accessToken string, disableAuth bool, ttl *time.Duration) (str
 	if ttl != nil {
 		desiredSeconds := int64(ttl.Seconds())
 		if desiredSeconds != lastAppliedTTLSeconds {
-			enforceClientAccessTokenTTL(desiredSeconds)
+			enforceClientAccessTokenTTL(ctx, desiredSeconds)
 		}
 	}
 
-	ctx := context.Background()
-
 	newToken, err := JwtTokenWithM2MFunc(ctx, ttl)
 	if err != nil {
 		return "", fmt.Errorf("failed to get new M2M token: %w", err)
@@ -275,7 +194,7 @@ func tokenRenewal(accessToken string, disableAuth bool, ttl *time.Duration) (str
 
 	newAzp, newUser, newExp, claimErr := auth.ExtractClaims(newToken)
 	if claimErr != nil {
-		slog.Error("failed to parse renewed token claims", "error", claimErr)
+		logger.FromContext(ctx).Error("failed to parse renewed token claims", "error", claimErr)
 
 		return accessToken, nil
 	}
@@ -286,20 +205,20 @@ func tokenRenewal(accessToken string, disableAuth bool, ttl *time.Duration) (str
 	}
 
 	renewedLifetime := time.Until(newExp)
-	slog.Debug("kubeconfig token renewed", "requested_ttl", requestedTTL, "renewed_lifetime", renewedLifetime, "user", newUser, "azp", newAzp)
+	logger.FromContext(ctx).Debug("kubeconfig token renewed", "requested_ttl", requestedTTL, "renewed_lifetime", renewedLifetime, "user", newUser, "azp", newAzp)
 
 	return newToken, nil
 }
 
 // enforceClientAccessTokenTTL enforces client token TTL and updates lastAppliedTTLSeconds
-func enforceClientAccessTokenTTL(desiredSeconds int64) {
+func enforceClientAccessTokenTTL(ctx context.Context, desiredSeconds int64) {
 	issuer := os.Getenv(auth.OidcUrlEnvVar)
 	if issuer == "" {
 		issuer = os.Getenv(auth.KeycloakUrlEnvVar)
 	}
 	// check M2M credentials exist and create if missing
 	if err := auth.EnsureM2MCredentials(false); err != nil {
-		slog.Warn("cannot ensure M2M credentials for TTL enforcement", "error", err)
+		logger.FromContext(ctx).Warn("cannot ensure M2M credentials for TTL enforcement", "error", err)
 		return
 	}
 
@@ -310,28 +229,26 @@ func enforceClientAccessTokenTTL(desiredSeconds int64) {
 
 	adminToken, errTok := JwtTokenWithM2MAdminFunc(context.Background(), nil)
 	if errTok != nil {
-		slog.Warn("failed to get M2M admin token for TTL enforcement", "error", errTok)
+		logger.FromContext(ctx).Warn("failed to get M2M admin token for TTL enforcement", "error", errTok)
 		return
 	}
 
-	ok := auth.EnforceClientAccessTokenTTL(context.Background(), issuer, "", clientID, time.Duration(desiredSeconds)*time.Second, adminToken)
-
-	if !ok {
-		slog.Warn("keycloak client TTL state NOT applied", "attempted_seconds", desiredSeconds)
+	provider := auth.GetOIDCProvider()
+	err := provider.EnforceAccessTokenTTL(context.Background(), issuer, clientID, time.Duration(desiredSeconds)*time.Second, adminToken)
+	if errors.Is(err, auth.ErrUnsupported) {
+		// the tokens keep the lifespan of the provider, the TTL is not attempted again until it changes
+		lastAppliedTTLSeconds = desiredSeconds
+		logger.FromContext(ctx).Info("oidc provider does not support the client TTL, kubeconfig tokens keep its lifespan", "provider", provider.Name(), "requested_seconds", desiredSeconds)
+		return
+	}
+	if err != nil {
+		logger.FromContext(ctx).Warn("oidc client TTL state NOT applied", "provider", provider.Name(), "attempted_seconds", desiredSeconds, "error", err)
 		return
 	}
 
 	lastAppliedTTLSeconds = desiredSeconds
-	slog.Debug("keycloak client TTL state applied", "applied_seconds", desiredSeconds)
-
-}
+	logger.FromContext(ctx).Debug("oidc client TTL state applied", "provider", provider.Name(), "applied_seconds", desiredSeconds)
 
-type kubeConfigData struct {
-	Clusters []struct {
-		Cluster struct {
-			Server string `yaml:"server"`
-		} `yaml:"cluster"`
-	} `yaml:"clusters"`
 }
 
 type kubeconfigParameters struct {
@@ -339,17 +256,6 @@ type kubeconfigParameters struct {
 	clusterDomain    string
 	userName         string
 	kubeConfigDecode string
-}
-
-// url - intersection = endSegment
-func extractEndSegment(url, intersection string) (string, error) {
-	index := strings.Index(url, intersection)
-	if index == -1 {
-		return "", fmt.Errorf("known part not found in URL")
-	}
-
-	startIndex := index + len(intersection)
-	endSegment := url[startIndex:]
-
-	return endSegment, nil
+	// pipeline processes the kubeconfig, the default pipeline of the cluster domain if nil
+	pipeline *kubeconfigs.Pipeline
 }
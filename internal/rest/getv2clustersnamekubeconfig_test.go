@@ -27,6 +27,8 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/kubeconfigs"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	"github.com/open-edge-platform/cluster-manager/v2/test/helpers"
 )
@@ -69,6 +71,33 @@ users:
   user:
     token: ` + jwtToken
 
+var exampleKubeconfigWithExec = `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: data
+    server: https://connect-gateway.kind.internal:443/kubernetes/655a6892-4280-4c37-97b1-31161ac0b99e-example-cluster
+  name: example-cluster
+contexts:
+- context:
+    cluster: example-cluster
+    user: example-cluster-admin
+  name: example-cluster-admin@example-cluster
+current-context: example-cluster-admin@example-cluster
+kind: Config
+preferences: {}
+users:
+- name: example-cluster-admin
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubectl
+      args:
+      - oidc-login
+      - get-token
+      - --oidc-issuer-url=https://keycloak.kind.internal/realms/master
+      - --oidc-client-id=system-client
+      interactiveMode: IfAvailable`
+
 var jwtToken = mustGenerateTestJWT()
 
 func mustGenerateTestJWT() string {
@@ -144,7 +173,7 @@ func configureHandlerAndServe(t *testing.T, server *Server, rr *httptest.Respons
 
 func mockTokenRenewal(jwtToken string) func() {
 	originalTokenRenewalFunc := tokenRenewalFunc
-	tokenRenewalFunc = func(authHeader string, disableAuth bool, ttl *time.Duration) (string, error) {
+	tokenRenewalFunc = func(_ context.Context, authHeader string, disableAuth bool, ttl *time.Duration) (string, error) {
 		return jwtToken, nil
 	}
 	return func() { tokenRenewalFunc = originalTokenRenewalFunc }
@@ -173,20 +202,70 @@ func TestGetV2ClustersNameKubeconfigs200(t *testing.T) {
 		expectedResponse := fmt.Sprintf(`{"kubeconfig":"%s\n"}`, escapeNewlines(exampleKubeconfigWithToken))
 		assert.JSONEq(t, expectedResponse, rr.Body.String())
 	})
+
+	t.Run("kubeconfig processed with the pipeline of the deployment", func(t *testing.T) {
+		name := "example-cluster"
+		activeProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+		encodedKubeconfig := base64.StdEncoding.EncodeToString([]byte(exampleKubeconfig))
+		restoreTokenRenewal := mockTokenRenewal(jwtToken)
+		defer restoreTokenRenewal()
+		mockedk8sclient, _, _ := mockK8sClient(t, name, encodedKubeconfig, nil)
+		pipeline := kubeconfigs.NewPipeline(kubeconfigs.RewriteServerURL("https://clusters.example.com"), kubeconfigs.RenameContext("{cluster}"))
+		server := NewServer(mockedk8sclient, WithKubeconfigPipeline(pipeline))
+		server.config = &config.Config{ClusterDomain: "kind.internal", Username: "admin", DisableAuth: true}
+		req, rr := createRequestAndRecorder(t, "GET", fmt.Sprintf("/v2/clusters/%s/kubeconfigs", name), activeProjectID, jwtToken)
+
+		configureHandlerAndServe(t, server, rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response api.KubeconfigInfo
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Kubeconfig)
+		expected := strings.NewReplacer(
+			"https://connect-gateway.kind.internal:443", "https://clusters.example.com",
+			"name: example-cluster-admin@example-cluster", "name: example-cluster",
+			"current-context: example-cluster-admin@example-cluster", "current-context: example-cluster",
+		).Replace(exampleKubeconfigWithToken)
+		assert.YAMLEq(t, expected, *response.Kubeconfig)
+	})
+
+	t.Run("kubeconfig with oidc exec plugin", func(t *testing.T) {
+		name := "example-cluster"
+		activeProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+		encodedKubeconfig := base64.StdEncoding.EncodeToString([]byte(exampleKubeconfig))
+		originalTokenRenewalFunc := tokenRenewalFunc
+		defer func() { tokenRenewalFunc = originalTokenRenewalFunc }()
+		tokenRenewalFunc = func(context.Context, string, bool, *time.Duration) (string, error) {
+			t.Fatal("the token must not be renewed for the oidc exec plugin")
+			return "", nil
+		}
+		mockedk8sclient, _, _ := mockK8sClient(t, name, encodedKubeconfig, nil)
+		serverConfig := config.Config{ClusterDomain: "kind.internal", Username: "admin", DisableAuth: true, KubeconfigOidcClientID: "system-client"}
+		server := NewServer(mockedk8sclient)
+		server.config = &serverConfig
+		req, rr := createRequestAndRecorder(t, "GET", fmt.Sprintf("/v2/clusters/%s/kubeconfigs?authType=oidc-exec", name), activeProjectID, jwtToken)
+
+		configureHandlerAndServe(t, server, rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response api.KubeconfigInfo
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Kubeconfig)
+		assert.YAMLEq(t, exampleKubeconfigWithExec, *response.Kubeconfig)
+	})
 }
 
 func TestGetV2ClustersNameKubeconfigs404(t *testing.T) {
-	expected404Response := `{"message":"404 Not Found: kubeconfig not found"}`
 	restoreTokenRenewal := mockTokenRenewal(jwtToken)
 	defer restoreTokenRenewal()
 	tests := []struct {
-		name             string
-		clusterName      string
-		activeProjectID  string
-		authHeader       string
-		mockSetup        func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface)
-		expectedCode     int
-		expectedResponse string
+		name            string
+		clusterName     string
+		activeProjectID string
+		authHeader      string
+		mockSetup       func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface)
+		expectedCode    int
+		expectedProblem messages.Code
 	}{
 		{
 			name:            "no cluster name",
@@ -194,8 +273,8 @@ func TestGetV2ClustersNameKubeconfigs404(t *testing.T) {
 			activeProjectID: "655a6892-4280-4c37-97b1-31161ac0b99e",
 			mockSetup: func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface) {
 			},
-			expectedCode:     http.StatusNotFound,
-			expectedResponse: "no matching operation was found\n",
+			expectedCode:    http.StatusNotFound,
+			expectedProblem: messages.RouteNotFound,
 		},
 		{
 			name:            "cluster Not Found",
@@ -207,8 +286,8 @@ func TestGetV2ClustersNameKubeconfigs404(t *testing.T) {
 				}
 				mockK8sClientSetup(resource, nsResource, mockedk8sclient, "example-cluster-kubeconfig", clusterSecret, errors.NewNotFound(core.SecretResourceSchema.GroupResource(), "example-cluster-kubeconfig"))
 			},
-			expectedCode:     http.StatusNotFound,
-			expectedResponse: expected404Response,
+			expectedCode:    http.StatusNotFound,
+			expectedProblem: messages.KubeconfigNotFound,
 		},
 		{
 			name:            "no kubeconfig in secret",
@@ -220,8 +299,8 @@ func TestGetV2ClustersNameKubeconfigs404(t *testing.T) {
 				}
 				mockK8sClientSetup(resource, nsResource, mockedk8sclient, "example-cluster-kubeconfig", clusterSecret, nil)
 			},
-			expectedCode:     http.StatusNotFound,
-			expectedResponse: expected404Response,
+			expectedCode:    http.StatusNotFound,
+			expectedProblem: messages.KubeconfigNotFound,
 		},
 		{
 			name:            "not able to decode kubeconfig",
@@ -237,8 +316,8 @@ func TestGetV2ClustersNameKubeconfigs404(t *testing.T) {
 				}
 				mockK8sClientSetup(resource, nsResource, mockedk8sclient, "example-cluster-kubeconfig", clusterSecret, nil)
 			},
-			expectedCode:     http.StatusNotFound,
-			expectedResponse: expected404Response,
+			expectedCode:    http.StatusNotFound,
+			expectedProblem: messages.KubeconfigNotFound,
 		},
 	}
 	serverConfig := config.Config{ClusterDomain: "kind.internal", Username: "admin"}
@@ -252,34 +331,30 @@ func TestGetV2ClustersNameKubeconfigs404(t *testing.T) {
 
 			configureHandlerAndServe(t, server, rr, req)
 			assert.Equal(t, tt.expectedCode, rr.Code)
-			if tt.name == "no cluster name" {
-				assert.Equal(t, tt.expectedResponse, rr.Body.String())
-			} else {
-				assert.JSONEq(t, tt.expectedResponse, rr.Body.String())
-			}
+			requireCode(t, tt.expectedProblem, rr.Body.Bytes())
 		})
 	}
 }
 func TestGetV2ClustersNameKubeconfig400(t *testing.T) {
 	tests := []struct {
-		name             string
-		activeProjectID  string
-		authHeader       string
-		expectedCode     int
-		expectedResponse string
+		name            string
+		activeProjectID string
+		authHeader      string
+		expectedCode    int
+		expectedProblem messages.Code
 	}{
 		{
-			name:             "no active projectId",
-			activeProjectID:  "",
-			expectedCode:     http.StatusBadRequest,
-			expectedResponse: `{"message": "no active project id provided"}`,
+			name:            "no active projectId",
+			activeProjectID: "",
+			expectedCode:    http.StatusBadRequest,
+			expectedProblem: messages.ActiveProjectIDMissing,
 		},
 		{
-			name:             "zero values for projectId",
-			activeProjectID:  "00000000-0000-0000-0000-000000000000",
-			authHeader:       jwtToken,
-			expectedCode:     http.StatusBadRequest,
-			expectedResponse: `{"message":"no active project id provided"}`,
+			name:            "zero values for projectId",
+			activeProjectID: "00000000-0000-0000-0000-000000000000",
+			authHeader:      jwtToken,
+			expectedCode:    http.StatusBadRequest,
+			expectedProblem: messages.ActiveProjectIDMissing,
 		},
 	}
 
@@ -299,29 +374,29 @@ func TestGetV2ClustersNameKubeconfig400(t *testing.T) {
 
 			configureHandlerAndServe(t, server, rr, req)
 			assert.Equal(t, tt.expectedCode, rr.Code)
-			assert.JSONEq(t, tt.expectedResponse, rr.Body.String())
+			requireCode(t, tt.expectedProblem, rr.Body.Bytes())
 		})
 	}
 }
 
 func TestGetV2ClustersNameKubeconfigs401(t *testing.T) {
 	tests := []struct {
-		name             string
-		authHeader       string
-		expectedCode     int
-		expectedResponse string
+		name            string
+		authHeader      string
+		expectedCode    int
+		expectedProblem messages.Code
 	}{
 		{
-			name:             "missing authorization header", // this is captured in the middleware
-			authHeader:       "",
-			expectedCode:     http.StatusBadRequest,
-			expectedResponse: `{"message":"Invalid format for parameter Authorization: parameter 'Authorization' is empty, can't bind its value"}`,
+			name:            "missing authorization header", // this is captured in the middleware
+			authHeader:      "",
+			expectedCode:    http.StatusBadRequest,
+			expectedProblem: messages.InvalidRequest,
 		},
 		{
-			name:             "invalid authorization header",
-			authHeader:       "InvalidToken",
-			expectedCode:     http.StatusUnauthorized,
-			expectedResponse: `{"message":"Unauthorized: invalid Authorization header"}`,
+			name:            "invalid authorization header",
+			authHeader:      "InvalidToken",
+			expectedCode:    http.StatusUnauthorized,
+			expectedProblem: messages.InvalidAuthorizationHeader,
 		},
 	}
 	for _, tt := range tests {
@@ -337,7 +412,7 @@ func TestGetV2ClustersNameKubeconfigs401(t *testing.T) {
 			rr := httptest.NewRecorder()
 			configureHandlerAndServe(t, server, rr, req)
 			assert.Equal(t, tt.expectedCode, rr.Code)
-			assert.JSONEq(t, tt.expectedResponse, rr.Body.String())
+			requireCode(t, tt.expectedProblem, rr.Body.Bytes())
 		})
 	}
 }
@@ -346,13 +421,13 @@ func TestGetV2ClustersNameKubeconfigs500(t *testing.T) {
 	restoreTokenRenewal := mockTokenRenewal(jwtToken)
 	defer restoreTokenRenewal()
 	tests := []struct {
-		name             string
-		clusterName      string
-		activeProjectID  string
-		authHeader       string
-		mockSetup        func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface)
-		expectedCode     int
-		expectedResponse string
+		name            string
+		clusterName     string
+		activeProjectID string
+		authHeader      string
+		mockSetup       func(resource *k8s.MockResourceInterface, nsResource *k8s.MockNamespaceableResourceInterface, mockedk8sclient *k8s.MockInterface)
+		expectedCode    int
+		expectedProblem messages.Code
 	}{
 		{
 			name:            "error updating kubeconfig with token",
@@ -370,8 +445,8 @@ func TestGetV2ClustersNameKubeconfigs500(t *testing.T) {
 				}
 				mockK8sClientSetup(resource, nsResource, mockedk8sclient, "demo-example-cluster-kubeconfig", clusterSecret, nil)
 			},
-			expectedCode:     http.StatusInternalServerError,
-			expectedResponse: `{"message":"500 Internal Server Error: failed to process kubeconfig"}`,
+			expectedCode:    http.StatusInternalServerError,
+			expectedProblem: messages.KubeconfigFailed,
 		},
 	}
 	serverConfig := config.Config{ClusterDomain: "kind.internal", Username: "admin"}
@@ -379,7 +454,7 @@ func TestGetV2ClustersNameKubeconfigs500(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// function variable with a mock implementation
 			originalFunc := updateKubeconfigWithTokenFunc
-			updateKubeconfigWithTokenFunc = func(kubeconfig kubeconfigParameters, activeProjectID, clusterName, token string, disableAuth bool, ttl *time.Duration) (string, error) {
+			updateKubeconfigWithTokenFunc = func(_ context.Context, kubeconfig kubeconfigParameters, activeProjectID, clusterName, token string, disableAuth bool, ttl *time.Duration) (string, error) {
 				return "", fmt.Errorf("failed to update kubeconfig with token")
 			}
 			defer func() {
@@ -392,7 +467,7 @@ func TestGetV2ClustersNameKubeconfigs500(t *testing.T) {
 			req, rr := createRequestAndRecorder(t, "GET", fmt.Sprintf("/v2/clusters/%s/kubeconfigs", tt.clusterName), tt.activeProjectID, tt.authHeader)
 			configureHandlerAndServe(t, server, rr, req)
 			assert.Equal(t, tt.expectedCode, rr.Code)
-			assert.JSONEq(t, tt.expectedResponse, rr.Body.String())
+			requireCode(t, tt.expectedProblem, rr.Body.Bytes())
 		})
 	}
 }
@@ -480,7 +555,7 @@ func TestUpdateKubeconfigWithToken(t *testing.T) {
 				defer restoreTokenRenewal()
 			}
 
-			updatedConfig, err := updateKubeconfigWithToken(tt.kubeconfig, tt.activeProjectID, tt.clusterName, tt.token, true, nil)
+			updatedConfig, err := updateKubeconfigWithToken(context.Background(), tt.kubeconfig, tt.activeProjectID, tt.clusterName, tt.token, true, nil)
 
 			if tt.expectedError != "" {
 				require.Error(t, err)
@@ -568,7 +643,7 @@ func TestTokenRenewal(t *testing.T) {
 				return helpers.CreateTestJWT(exp, []string{"test-role"}), nil
 			}
 
-			result, err := tokenRenewal(originalToken, c.disableAuth, c.ttl)
+			result, err := tokenRenewal(context.Background(), originalToken, c.disableAuth, c.ttl)
 
 			assert.Equal(t, c.expectCalled, called, "JwtTokenWithM2MFunc call expectation mismatch")
 
@@ -665,7 +740,7 @@ func TestTokenRenewalWithVaultAndKeycloak(t *testing.T) {
 			defer func() { JwtTokenWithM2MFunc = originalJwtTokenWithM2MFunc }()
 
 			// Execute renewal
-			newToken, err := tokenRenewal(originalToken, false, tt.requestedTTL)
+			newToken, err := tokenRenewal(context.Background(), originalToken, false, tt.requestedTTL)
 			if tt.expectedError {
 				require.Error(t, err, "expected an error but got none")
 				return
@@ -817,9 +892,7 @@ func TestKubeconfigEndToEndWithTTLM2MFailure(t *testing.T) {
 	if rr.Code != http.StatusInternalServerError {
 		t.Fatalf("expected 500 status when m2m fails, got %d body=%s", rr.Code, rr.Body.String())
 	}
-	if !strings.Contains(rr.Body.String(), "failed to process kubeconfig") {
-		t.Fatalf("expected failure message in body, got %s", rr.Body.String())
-	}
+	requireCode(t, messages.KubeconfigFailed, rr.Body.Bytes())
 }
 
 // TestKubeconfigEndToEndRenewalCallExpectations verifies whether renewal is called or skipped under different flags
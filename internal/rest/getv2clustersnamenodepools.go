@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	controlplaneprovider "github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+var (
+	errNodePoolExists   = errors.New("node pool already exists")
+	errNodePoolNotFound = errors.New("node pool not found")
+)
+
+// (GET /v2/clusters/{name}/nodepools)
+func (s *Server) GetV2ClustersNameNodepools(ctx context.Context, request api.GetV2ClustersNameNodepoolsRequestObject) (api.GetV2ClustersNameNodepoolsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	cli := k8s.New(s.reader())
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cluster, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodepools404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	nodePools := []api.NodePool{}
+	if cluster.Spec.Topology != nil && cluster.Spec.Topology.Workers != nil {
+		for _, md := range cluster.Spec.Topology.Workers.MachineDeployments {
+			if md.Class != common.WorkerClass {
+				continue
+			}
+			nodePool, err := nodePoolFromTopology(md)
+			if err != nil {
+				message := messages.New(messages.NodePoolReadFailed, md.Name, request.Name, err)
+				logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+				return api.GetV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+			}
+			nodePools = append(nodePools, nodePool)
+		}
+	}
+
+	return api.GetV2ClustersNameNodepools200JSONResponse{NodePools: &nodePools}, nil
+}
+
+// validateNodePool checks the label keys and taint keys of a node pool against the Kubernetes label syntax
+func validateNodePool(nodeLabels *map[string]string, taints *[]api.NodeTaint) *messages.Message {
+	if nodeLabels != nil && !labels.Valid(*nodeLabels) {
+		message := messages.New(messages.InvalidNodePoolLabelKeys)
+		return &message
+	}
+	if taints != nil {
+		taintKeys := map[string]string{}
+		for _, taint := range *taints {
+			taintKeys[taint.Key] = ""
+		}
+		if !labels.Valid(taintKeys) {
+			message := messages.New(messages.InvalidNodePoolTaintKeys)
+			return &message
+		}
+	}
+	return nil
+}
+
+// nodePoolFromTopology converts a worker MachineDeployment of the cluster topology to a node pool
+func nodePoolFromTopology(md capi.MachineDeploymentTopology) (api.NodePool, error) {
+	nodePool := api.NodePool{Name: md.Name}
+	if md.Replicas != nil {
+		nodePool.Replicas = *md.Replicas
+	}
+	if md.Variables == nil {
+		return nodePool, nil
+	}
+
+	for _, variable := range md.Variables.Overrides {
+		var values []string
+		switch variable.Name {
+		case controlplaneprovider.NodePoolLabels:
+			if err := json.Unmarshal(variable.Value.Raw, &values); err != nil {
+				return nodePool, err
+			}
+			nodeLabels := map[string]string{}
+			for _, value := range values {
+				key, val, _ := strings.Cut(value, "=")
+				nodeLabels[key] = val
+			}
+			nodePool.Labels = &nodeLabels
+		case controlplaneprovider.NodePoolTaints:
+			if err := json.Unmarshal(variable.Value.Raw, &values); err != nil {
+				return nodePool, err
+			}
+			taints := []api.NodeTaint{}
+			for _, value := range values {
+				taints = append(taints, parseTaint(value))
+			}
+			nodePool.Taints = &taints
+		}
+	}
+	return nodePool, nil
+}
+
+// setNodePoolLabels stores the node pool labels as a MachineDeployment variable override in the "key=value" format
+func setNodePoolLabels(md *capi.MachineDeploymentTopology, nodeLabels map[string]string) error {
+	values := []string{}
+	for key, value := range nodeLabels {
+		values = append(values, fmt.Sprintf("%s=%s", key, value))
+	}
+	slices.Sort(values)
+	return setNodePoolOverride(md, controlplaneprovider.NodePoolLabels, values)
+}
+
+// setNodePoolTaints stores the node pool taints as a MachineDeployment variable override in the "key=value:Effect" format
+func setNodePoolTaints(md *capi.MachineDeploymentTopology, taints []api.NodeTaint) error {
+	values := []string{}
+	for _, taint := range taints {
+		values = append(values, formatTaint(taint))
+	}
+	return setNodePoolOverride(md, controlplaneprovider.NodePoolTaints, values)
+}
+
+func setNodePoolOverride(md *capi.MachineDeploymentTopology, name string, values []string) error {
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if md.Variables == nil {
+		md.Variables = &capi.MachineDeploymentVariables{}
+	}
+
+	override := capi.ClusterVariable{Name: name, Value: apiextensionsv1.JSON{Raw: raw}}
+	for i := range md.Variables.Overrides {
+		if md.Variables.Overrides[i].Name == name {
+			md.Variables.Overrides[i] = override
+			return nil
+		}
+	}
+	md.Variables.Overrides = append(md.Variables.Overrides, override)
+	return nil
+}
+
+func formatTaint(taint api.NodeTaint) string {
+	if taint.Value == nil || *taint.Value == "" {
+		return fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+	}
+	return fmt.Sprintf("%s=%s:%s", taint.Key, *taint.Value, taint.Effect)
+}
+
+func parseTaint(value string) api.NodeTaint {
+	keyValue, effect, _ := strings.Cut(value, ":")
+	key, val, found := strings.Cut(keyValue, "=")
+	taint := api.NodeTaint{Key: key, Effect: api.NodeTaintEffect(effect)}
+	if found {
+		taint.Value = &val
+	}
+	return taint
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// nodePoolCluster returns a cluster with a managed topology holding the given worker MachineDeployments
+func nodePoolCluster(t *testing.T, machineDeployments ...capi.MachineDeploymentTopology) *unstructured.Unstructured {
+	cluster := capi.Cluster{
+		TypeMeta: v1.TypeMeta{APIVersion: core.ClusterResourceSchema.GroupVersion().String(), Kind: "Cluster"},
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "example-cluster",
+			Namespace:   activeProjectID,
+			Annotations: map[string]string{core.TemplateLabelKey: "baseline-v1.0.0"},
+		},
+		Spec: capi.ClusterSpec{
+			Topology: &capi.Topology{
+				Class:   "baseline-v1.0.0",
+				Version: "v1.30.6+k3s1",
+				Workers: &capi.WorkersTopology{MachineDeployments: machineDeployments},
+			},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&cluster)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func gpuNodePool() capi.MachineDeploymentTopology {
+	replicas := int32(2)
+	return capi.MachineDeploymentTopology{
+		Class:    common.WorkerClass,
+		Name:     "gpu-workers",
+		Replicas: &replicas,
+		Variables: &capi.MachineDeploymentVariables{
+			Overrides: []capi.ClusterVariable{
+				{Name: "nodePoolLabels", Value: apiextensionsv1.JSON{Raw: []byte(`["accelerator=gpu"]`)}},
+				{Name: "nodePoolTaints", Value: apiextensionsv1.JSON{Raw: []byte(`["nvidia.com/gpu=present:NoSchedule","dedicated:NoExecute"]`)}},
+			},
+		},
+	}
+}
+
+func TestGetV2ClustersNameNodepools(t *testing.T) {
+	t.Run("node pools are listed", func(t *testing.T) {
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t, gpuNodePool()), nil)
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: resource},
+			http.MethodGet, "/v2/clusters/example-cluster/nodepools", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var nodePools api.NodePoolList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &nodePools))
+		require.Len(t, *nodePools.NodePools, 1)
+
+		nodePool := (*nodePools.NodePools)[0]
+		require.Equal(t, "gpu-workers", nodePool.Name)
+		require.Equal(t, int32(2), nodePool.Replicas)
+		require.Equal(t, map[string]string{"accelerator": "gpu"}, *nodePool.Labels)
+		require.Equal(t, []api.NodeTaint{
+			{Key: "nvidia.com/gpu", Value: ptr("present"), Effect: api.NoSchedule},
+			{Key: "dedicated", Effect: api.NoExecute},
+		}, *nodePool.Taints)
+	})
+
+	t.Run("cluster without node pools", func(t *testing.T) {
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: resource},
+			http.MethodGet, "/v2/clusters/example-cluster/nodepools", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, `{"nodePools":[]}`, rr.Body.String())
+	})
+
+	t.Run("cluster not found", func(t *testing.T) {
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nil,
+			k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: resource},
+			http.MethodGet, "/v2/clusters/example-cluster/nodepools", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/machinelogs"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/clusters/{name}/nodes/{nodeId}/logs)
+func (s *Server) GetV2ClustersNameNodesNodeIdLogs(ctx context.Context, request api.GetV2ClustersNameNodesNodeIdLogsRequestObject) (api.GetV2ClustersNameNodesNodeIdLogsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.machineLogs == nil {
+		message := messages.New(messages.NodeLogsDisabled)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodesNodeIdLogs501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, message))}, nil
+	}
+
+	tailLines := machinelogs.DefaultTailLines
+	if request.Params.TailLines != nil {
+		tailLines = *request.Params.TailLines
+	}
+
+	logs, err := s.machineLogs.Logs(ctx, activeProjectID, request.Name, request.NodeId, tailLines)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodesNodeIdLogs404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, machinelogs.ErrNodeNotFound):
+		message := messages.New(messages.NodeNotInCluster, request.NodeId, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodesNodeIdLogs404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, machinelogs.ErrLogsNotAvailable):
+		message := messages.New(messages.NodeLogsNotAvailable, request.NodeId, request.Name)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodesNodeIdLogs404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, machinelogs.ErrUnsupported):
+		message := messages.New(messages.NodeLogsNotSupported, request.NodeId, request.Name)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodesNodeIdLogs501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.NodeLogsFailed, request.NodeId, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameNodesNodeIdLogs500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	return api.GetV2ClustersNameNodesNodeIdLogs200TextResponse(logs), nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/machinelogs"
+)
+
+type fakeMachineLogs struct {
+	logs      string
+	err       error
+	tailLines int
+}
+
+func (f *fakeMachineLogs) Logs(_ context.Context, projectID, clusterName, nodeID string, tailLines int) ([]byte, error) {
+	if projectID != activeProjectID || clusterName != "example-cluster" {
+		return nil, k8s.ErrClusterNotFound
+	}
+	if nodeID != "64e797f6-db22-445e-b606-4228d4f1c2bd" {
+		return nil, machinelogs.ErrNodeNotFound
+	}
+	f.tailLines = tailLines
+	return []byte(f.logs), f.err
+}
+
+func TestGetV2ClustersNameNodesNodeIdLogs(t *testing.T) {
+	const path = "/v2/clusters/example-cluster/nodes/64e797f6-db22-445e-b606-4228d4f1c2bd/logs"
+
+	t.Run("logs of the node", func(t *testing.T) {
+		logs := &fakeMachineLogs{logs: "cloud-init finished\n"}
+		rr := serveRequest(t, nil, http.MethodGet, path+"?tailLines=100", nil, WithMachineLogs(logs))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "text/plain", rr.Header().Get("Content-Type"))
+		require.Equal(t, "cloud-init finished\n", rr.Body.String())
+		require.Equal(t, 100, logs.tailLines)
+
+		rr = serveRequest(t, nil, http.MethodGet, path, nil, WithMachineLogs(logs))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, machinelogs.DefaultTailLines, logs.tailLines)
+	})
+
+	t.Run("invalid tail lines", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path+"?tailLines=0", nil, WithMachineLogs(&fakeMachineLogs{}))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("missing clusters and nodes are not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/clusters/other-cluster/nodes/64e797f6-db22-445e-b606-4228d4f1c2bd/logs", nil, WithMachineLogs(&fakeMachineLogs{}))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		rr = serveRequest(t, nil, http.MethodGet, "/v2/clusters/example-cluster/nodes/example-cluster-md-abcde/logs", nil, WithMachineLogs(&fakeMachineLogs{}))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("logs that are not available yet are not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path, nil, WithMachineLogs(&fakeMachineLogs{err: machinelogs.ErrLogsNotAvailable}))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("logs of unsupported providers are not implemented", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path, nil, WithMachineLogs(&fakeMachineLogs{err: machinelogs.ErrUnsupported}))
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+	})
+
+	t.Run("fetch failures are internal errors", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path, nil, WithMachineLogs(&fakeMachineLogs{err: errors.New("connection refused")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("logs are not implemented without fetcher", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, path, nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+	})
+}
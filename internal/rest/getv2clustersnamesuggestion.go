@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/clusters/name-suggestion)
+func (s *Server) GetV2ClustersNameSuggestion(ctx context.Context, request api.GetV2ClustersNameSuggestionRequestObject) (api.GetV2ClustersNameSuggestionResponseObject, error) {
+	namespace := request.Params.Activeprojectid.String()
+	base := ""
+	if request.Params.Base != nil {
+		base = *request.Params.Base
+	}
+
+	// the suggested name must not be taken by a cluster nor by a pending cluster, which is created under its name later
+	taken, message := s.takenClusterNames(ctx, namespace)
+	if message != nil {
+		return api.GetV2ClustersNameSuggestion500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, *message))}, nil
+	}
+
+	policy := s.namingPolicy(namespace)
+	name, err := policy.Suggest(base, func(name string) bool { return taken[name] })
+	if err != nil {
+		message := messages.New(messages.ClusterNameNotGenerated, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace, "base", base)
+		return api.GetV2ClustersNameSuggestion400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	return api.GetV2ClustersNameSuggestion200JSONResponse{Name: name, Policy: toAPINamingPolicy(policy)}, nil
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/naming"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2ClustersNameSuggestion(t *testing.T) {
+	policy := naming.Policy{Prefix: "fra1-", MaxLength: 20, ForbiddenWords: []string{"prod"}}
+
+	getSuggestion := func(t *testing.T, listErr error, path string, options ...func(*Server)) *httptest.ResponseRecorder {
+		clusters := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "fra1-edge", "namespace": activeProjectID},
+		}}}}
+		resource := k8s.NewMockResourceInterface(t)
+		if listErr != nil {
+			resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(nil, listErr)
+		} else {
+			resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(clusters, nil)
+		}
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(activeProjectID).Return(resource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource)
+
+		server := NewServer(mockedk8sclient, options...)
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, path, nil)
+		req.Header.Set("Activeprojectid", activeProjectID)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("suggested name follows the policy and is not taken", func(t *testing.T) {
+		pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{{Name: "fra1-edge-2", ProjectID: activeProjectID}}}
+		rr := getSuggestion(t, nil, "/v2/clusters/name-suggestion?base=prod-edge",
+			WithNamingPolicies(naming.NewPolicies(naming.Config{Default: policy})), WithPendingClusters(pending))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var suggestion api.ClusterNameSuggestion
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &suggestion))
+		require.Equal(t, api.ClusterNameSuggestion{
+			Name:   "fra1-edge-3",
+			Policy: api.NamingPolicy{Prefix: ptr("fra1-"), MaxLength: ptr(20), ForbiddenWords: &[]string{"prod"}},
+		}, suggestion)
+	})
+
+	t.Run("default base without policy", func(t *testing.T) {
+		rr := getSuggestion(t, nil, "/v2/clusters/name-suggestion")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var suggestion api.ClusterNameSuggestion
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &suggestion))
+		require.Equal(t, api.ClusterNameSuggestion{Name: "cluster"}, suggestion)
+	})
+
+	t.Run("clusters cannot be listed", func(t *testing.T) {
+		rr := getSuggestion(t, errors.New("failed to list clusters"), "/v2/clusters/name-suggestion")
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClustersListFailed, rr.Body.Bytes())
+	})
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/clusters/{name}/upgrades)
+func (s *Server) GetV2ClustersNameUpgrades(ctx context.Context, request api.GetV2ClustersNameUpgradesRequestObject) (api.GetV2ClustersNameUpgradesResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	cli := k8s.New(s.reader())
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2ClustersNameUpgrades500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	capiCluster, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameUpgrades404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameUpgrades500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	templateName := cluster.Template(capiCluster)
+	current, err := cli.Template(ctx, activeProjectID, templateName)
+	switch {
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.TemplateOfClusterNotFound, templateName, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameUpgrades404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateGetFailed, templateName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameUpgrades500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	templates, err := cli.Templates(ctx, activeProjectID)
+	if err != nil {
+		message := messages.New(messages.TemplatesListFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameUpgrades500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	machines, err := cli.GetMachines(ctx, activeProjectID, request.Name)
+	if err != nil {
+		message := messages.New(messages.MachinesGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameUpgrades500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	upgrades, err := cluster.Upgrades(current, templates, cluster.KubeletVersions(machines))
+	if err != nil {
+		message := messages.New(messages.UpgradesFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2ClustersNameUpgrades500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	response := api.GetV2ClustersNameUpgrades200JSONResponse{
+		CurrentTemplate:          templateName,
+		CurrentKubernetesVersion: ptr(current.Spec.KubernetesVersion),
+		Upgrades:                 []api.ClusterUpgrade{},
+	}
+	for _, upgrade := range upgrades {
+		warnings := []api.UpgradeWarning{}
+		for _, warning := range upgrade.Warnings {
+			warnings = append(warnings, api.UpgradeWarning{Type: api.UpgradeWarningType(warning.Type), Message: warning.Message})
+		}
+		response.Upgrades = append(response.Upgrades, api.ClusterUpgrade{
+			Template:          upgrade.Template,
+			KubernetesVersion: upgrade.KubernetesVersion,
+			Warnings:          warnings,
+			Path:              upgrade.Path,
+		})
+	}
+	return response, nil
+}
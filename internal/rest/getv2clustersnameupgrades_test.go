@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func toUnstructured(t *testing.T, obj any) unstructured.Unstructured {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return unstructured.Unstructured{Object: u}
+}
+
+func upgradeTemplate(t *testing.T, name, kubernetesVersion string) unstructured.Unstructured {
+	return toUnstructured(t, &v1alpha1.ClusterTemplate{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: activeProjectID},
+		Spec: v1alpha1.ClusterTemplateSpec{
+			ControlPlaneProviderType: "k3s",
+			InfraProviderType:        "intel",
+			KubernetesVersion:        kubernetesVersion,
+		},
+	})
+}
+
+func TestGetV2ClustersNameUpgrades(t *testing.T) {
+	t.Run("upgrades are listed with skew warnings and paths", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		current := upgradeTemplate(t, "baseline-v1.0.0", "v1.28.4+k3s1")
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(&current, nil)
+		templates.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			current,
+			upgradeTemplate(t, "baseline-v2.0.0", "v1.29.2+k3s1"),
+			upgradeTemplate(t, "baseline-v3.0.0", "v1.30.6+k3s1"),
+		}}, nil)
+
+		kubelet := "v1.28.4+k3s1"
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, mock.Anything).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			toUnstructured(t, &capi.Machine{ObjectMeta: v1.ObjectMeta{Name: "machine"}, Spec: capi.MachineSpec{ClusterName: "example-cluster", Version: &kubelet}}),
+		}}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+			core.MachineResourceSchema:  machines,
+		}, http.MethodGet, "/v2/clusters/example-cluster/upgrades", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var upgrades api.ClusterUpgrades
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &upgrades))
+		require.Equal(t, "baseline-v1.0.0", upgrades.CurrentTemplate)
+		require.Equal(t, "v1.28.4+k3s1", *upgrades.CurrentKubernetesVersion)
+		require.Len(t, upgrades.Upgrades, 2)
+
+		require.Equal(t, "baseline-v2.0.0", upgrades.Upgrades[0].Template)
+		require.Empty(t, upgrades.Upgrades[0].Warnings)
+		require.Equal(t, []string{"baseline-v2.0.0"}, upgrades.Upgrades[0].Path)
+
+		require.Equal(t, "baseline-v3.0.0", upgrades.Upgrades[1].Template)
+		require.Len(t, upgrades.Upgrades[1].Warnings, 1)
+		require.Equal(t, api.ControlPlaneMinorSkip, upgrades.Upgrades[1].Warnings[0].Type)
+		require.Equal(t, []string{"baseline-v2.0.0", "baseline-v3.0.0"}, upgrades.Upgrades[1].Path)
+	})
+
+	t.Run("missing cluster", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: clusters},
+			http.MethodGet, "/v2/clusters/example-cluster/upgrades", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("missing template", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.TemplateResourceSchema.GroupResource(), "baseline-v1.0.0"))
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodGet, "/v2/clusters/example-cluster/upgrades", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateOfClusterNotFound, rr.Body.Bytes())
+	})
+}
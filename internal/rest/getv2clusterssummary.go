@@ -4,24 +4,23 @@ package rest
 
 import (
 	"context"
-	"log/slog"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 // (GET /v2/clusters/summary)
 func (s *Server) GetV2ClustersSummary(ctx context.Context, request api.GetV2ClustersSummaryRequestObject) (api.GetV2ClustersSummaryResponseObject, error) {
-	slog.Debug("GetV2ClustersSummary")
+	logger.FromContext(ctx).Debug("GetV2ClustersSummary")
 	namespace := request.Params.Activeprojectid.String()
-	unstructuredClusters, err := fetchClustersList(ctx, s, namespace)
+	unstructuredClusters, err := fetchClustersList(ctx, s.reader(), namespace)
 
 	if k8serrors.IsInternalError(err) || err != nil {
 		return api.GetV2ClustersSummary500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ClustersListFailed))),
 		}, nil
 	}
 
@@ -57,9 +56,7 @@ func (s *Server) GetV2ClustersSummary(ctx context.Context, request api.GetV2Clus
 
 	if sum != total {
 		return api.GetV2ClustersSummary500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr("Cluster summary count mismatch"),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ClusterSummaryMismatch))),
 		}, nil
 	}
 
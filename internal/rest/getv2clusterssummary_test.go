@@ -19,6 +19,7 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/rest"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
@@ -261,11 +262,11 @@ func TestGetV2ClustersSummary500(t *testing.T) {
 		require.Nil(t, err)
 		handler.ServeHTTP(rr, req)
 
-		var actualResponse api.GetV2ClustersSummary500JSONResponse
-		err = json.Unmarshal(rr.Body.Bytes(), &actualResponse)
-		require.NoError(t, err, "Failed to unmarshal response body")
+		var problem api.ProblemDetails
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem), "Failed to unmarshal response body")
 
 		require.Equal(t, http.StatusInternalServerError, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, 500)
-		require.Equal(t, "failed to list clusters", *actualResponse.Message, "Error message = %v, want %v", *actualResponse.Message, "failed to list clusters")
+		require.NotNil(t, problem.Code)
+		require.Equal(t, string(messages.ClustersListFailed), *problem.Code)
 	})
 }
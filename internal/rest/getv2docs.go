@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/apidocs"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/docs)
+func (s *Server) GetV2Docs(ctx context.Context, request api.GetV2DocsRequestObject) (api.GetV2DocsResponseObject, error) {
+	if !s.config.EnableAPIDocs {
+		return api.GetV2Docs501JSONResponse{
+			N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.APIDocsDisabled))),
+		}, nil
+	}
+
+	page, err := apidocs.SwaggerUI()
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to render swagger ui", "error", err)
+		return api.GetV2Docs500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.APIDocsFailed, err))),
+		}, nil
+	}
+
+	return api.GetV2Docs200TexthtmlResponse{Body: bytes.NewReader(page), ContentLength: int64(len(page))}, nil
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2Docs200(t *testing.T) {
+	rr := serveRequest(t, nil, http.MethodGet, "/v2/docs", nil, WithConfig(&config.Config{EnableAPIDocs: true}))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "text/html", rr.Header().Get("Content-Type"))
+	require.Contains(t, rr.Body.String(), "SwaggerUIBundle")
+	require.Contains(t, rr.Body.String(), `"operationId":"GetV2Docs"`)
+}
+
+func TestGetV2Docs501(t *testing.T) {
+	rr := serveRequest(t, nil, http.MethodGet, "/v2/docs", nil)
+
+	require.Equal(t, http.StatusNotImplemented, rr.Code)
+	resp, err := api.ParseGetV2DocsResponse(rr.Result())
+	require.NoError(t, err)
+	requireProblemCode(t, messages.APIDocsDisabled, *resp.JSON501)
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/extensions"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/extensions)
+func (s *Server) GetV2Extensions(ctx context.Context, request api.GetV2ExtensionsRequestObject) (api.GetV2ExtensionsResponseObject, error) {
+	catalog, err := s.extensionCatalog()
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get extension catalog", "error", err)
+		return api.GetV2Extensions500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ExtensionCatalogFailed, err))),
+		}, nil
+	}
+
+	provider := ""
+	if request.Params.Provider != nil {
+		provider = *request.Params.Provider
+	}
+
+	available := catalog.Available(provider)
+	items := make([]api.Extension, 0, len(available))
+	for _, extension := range available {
+		item := api.Extension{Name: extension.Name, Providers: extension.Providers}
+		if extension.Description != "" {
+			item.Description = &extension.Description
+		}
+		items = append(items, item)
+	}
+	return api.GetV2Extensions200JSONResponse{Extensions: items}, nil
+}
+
+// extensionCatalog returns the extension catalog of the server, the embedded one unless it is overridden
+func (s *Server) extensionCatalog() (*extensions.Catalog, error) {
+	if s.extensions != nil {
+		return s.extensions, nil
+	}
+	return extensions.Default()
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/extensions"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2Extensions200(t *testing.T) {
+	t.Run("default catalog", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/extensions", nil)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		resp, err := api.ParseGetV2ExtensionsResponse(rr.Result())
+		require.NoError(t, err)
+
+		catalog, err := extensions.Default()
+		require.NoError(t, err)
+		require.Len(t, resp.JSON200.Extensions, len(catalog.Extensions))
+	})
+
+	t.Run("extensions of a provider", func(t *testing.T) {
+		catalog, err := extensions.Parse([]byte(`{"extensions":[{"name":"baseline","description":"Baseline add-ons","providers":["kubeadm","k3s"]},{"name":"edge-ai","providers":["kubeadm"]}]}`))
+		require.NoError(t, err)
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/extensions?provider=k3s", nil, WithExtensionCatalog(catalog))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		resp, err := api.ParseGetV2ExtensionsResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, []api.Extension{
+			{Name: "baseline", Description: ptr("Baseline add-ons"), Providers: []string{"kubeadm", "k3s"}},
+		}, resp.JSON200.Extensions)
+	})
+}
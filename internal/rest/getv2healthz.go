@@ -5,10 +5,19 @@ package rest
 import (
 	"context"
 
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 // (GET /v2/healthz) TODO: remove healthz from oapi code generation and implement separately to the generated server handler
 func (s *Server) GetV2Healthz(ctx context.Context, request api.GetV2HealthzRequestObject) (api.GetV2HealthzResponseObject, error) {
+	for _, check := range s.healthChecks {
+		if err := check.Health(); err != nil {
+			message := messages.New(messages.ServiceNotReady, err)
+			logger.FromContext(ctx).Warn(message.String())
+			return api.GetV2Healthz503JSONResponse(problem(ctx, message)), nil
+		}
+	}
 	return api.GetV2Healthz200JSONResponse("cm rest server is healthy"), nil
 }
@@ -5,12 +5,15 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 )
 
 func TestGetV2Healthz(t *testing.T) {
@@ -34,3 +37,22 @@ func TestGetV2Healthz(t *testing.T) {
 	expectedResponse := "\"cm rest server is healthy\"\n"
 	assert.Equal(t, expectedResponse, rr.Body.String())
 }
+
+type healthCheckFunc func() error
+
+func (f healthCheckFunc) Health() error { return f() }
+
+func TestGetV2HealthzNotReady(t *testing.T) {
+	healthy := healthCheckFunc(func() error { return nil })
+	notSynced := healthCheckFunc(func() error { return errors.New("cluster informer cache is not synced") })
+	server := NewServer(nil, WithHealthChecks(healthy, notSynced))
+
+	req := httptest.NewRequest("GET", "/v2/healthz", nil)
+	rr := httptest.NewRecorder()
+	handler, err := server.ConfigureHandler()
+	require.Nil(t, err)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	requireCode(t, messages.ServiceNotReady, rr.Body.Bytes())
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/apidocs"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/openapi.json)
+func (s *Server) GetV2OpenapiJson(ctx context.Context, request api.GetV2OpenapiJsonRequestObject) (api.GetV2OpenapiJsonResponseObject, error) {
+	document, err := apidocs.OpenAPIDocument()
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get openapi document", "error", err)
+		return api.GetV2OpenapiJson500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.OpenAPIDocumentFailed, err))),
+		}, nil
+	}
+
+	return api.GetV2OpenapiJson200JSONResponse(document), nil
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2OpenapiJson200(t *testing.T) {
+	// the document is served whether or not the api docs are enabled
+	rr := serveRequest(t, nil, http.MethodGet, "/v2/openapi.json", nil)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	resp, err := api.ParseGetV2OpenapiJsonResponse(rr.Result())
+	require.NoError(t, err)
+	require.Equal(t, "3.1.0", (*resp.JSON200)["openapi"])
+	require.Contains(t, (*resp.JSON200)["paths"], "/v2/openapi.json")
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/operations)
+func (s *Server) GetV2Operations(ctx context.Context, request api.GetV2OperationsRequestObject) (api.GetV2OperationsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.operations == nil {
+		return api.GetV2Operations501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.OperationTrackingDisabled)))}, nil
+	}
+
+	cluster := ""
+	if request.Params.Cluster != nil {
+		cluster = *request.Params.Cluster
+	}
+
+	ops, err := s.operations.List(ctx, activeProjectID, cluster)
+	if err != nil {
+		message := messages.New(messages.OperationsListFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2Operations500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	operationList := make([]api.Operation, 0, len(ops))
+	for _, op := range ops {
+		operationList = append(operationList, toAPIOperation(op))
+	}
+	return api.GetV2Operations200JSONResponse{Operations: &operationList}, nil
+}
+
+// recordOperation records a long-running operation when operation tracking is enabled
+// The request the operation belongs to already succeeded, so failures are only logged
+func (s *Server) recordOperation(ctx context.Context, namespace string, opType operations.Type, cluster, template string) {
+	if s.operations == nil {
+		return
+	}
+	if _, err := s.operations.Start(ctx, namespace, opType, cluster, template); err != nil {
+		logger.FromContext(ctx).Warn("failed to record operation", "namespace", namespace, "type", opType, "cluster", cluster, "error", err)
+	}
+}
+
+// toAPIOperation converts a tracked operation to its API representation
+func toAPIOperation(op operations.Operation) api.Operation {
+	// operation ids are generated by the tracker and always valid uuids
+	id, _ := uuid.Parse(op.ID)
+	operation := api.Operation{
+		Id:          id,
+		Type:        api.OperationType(op.Type),
+		Cluster:     op.Cluster,
+		State:       api.OperationState(op.State),
+		CreatedAt:   op.CreatedAt,
+		UpdatedAt:   op.UpdatedAt,
+		CompletedAt: op.CompletedAt,
+	}
+	if op.Template != "" {
+		operation.Template = ptr(op.Template)
+	}
+	if op.Progress != "" {
+		operation.Progress = ptr(op.Progress)
+	}
+	if op.Error != "" {
+		operation.Error = ptr(op.Error)
+	}
+	return operation
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+type fakeOperations struct {
+	ops     []operations.Operation
+	started []operations.Operation
+	err     error
+}
+
+func (f *fakeOperations) Start(_ context.Context, _ string, opType operations.Type, cluster, template string) (operations.Operation, error) {
+	if f.err != nil {
+		return operations.Operation{}, f.err
+	}
+	op := operations.Operation{Type: opType, Cluster: cluster, Template: template, State: operations.Running}
+	f.started = append(f.started, op)
+	return op, nil
+}
+
+func (f *fakeOperations) Get(_ context.Context, _, id string) (operations.Operation, error) {
+	if f.err != nil {
+		return operations.Operation{}, f.err
+	}
+	for _, op := range f.ops {
+		if op.ID == id {
+			return op, nil
+		}
+	}
+	return operations.Operation{}, operations.ErrOperationNotFound
+}
+
+func (f *fakeOperations) List(_ context.Context, _, cluster string) ([]operations.Operation, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var ops []operations.Operation
+	for _, op := range f.ops {
+		if cluster == "" || op.Cluster == cluster {
+			ops = append(ops, op)
+		}
+	}
+	return ops, nil
+}
+
+var (
+	operationCreatedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	createOperation    = operations.Operation{
+		ID:        "64e797f6-db22-445e-b606-4228d4f1c2bd",
+		Type:      operations.Create,
+		Cluster:   "cluster-1",
+		Template:  "baseline-v1.0.0",
+		State:     operations.Running,
+		Progress:  "Provisioning: infrastructure not ready",
+		CreatedAt: operationCreatedAt,
+		UpdatedAt: operationCreatedAt,
+	}
+	deleteOperation = operations.Operation{
+		ID:          "6e6422c3-625e-507a-bc8a-bd2330e07e7e",
+		Type:        operations.Delete,
+		Cluster:     "cluster-2",
+		State:       operations.Succeeded,
+		Progress:    "cluster deleted",
+		CreatedAt:   operationCreatedAt,
+		UpdatedAt:   operationCreatedAt,
+		CompletedAt: &operationCreatedAt,
+	}
+)
+
+func TestGetV2Operations(t *testing.T) {
+	t.Run("all operations", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations", nil, WithOperations(&fakeOperations{ops: []operations.Operation{createOperation, deleteOperation}}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var resp api.OperationList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Len(t, *resp.Operations, 2)
+
+		op := (*resp.Operations)[0]
+		require.Equal(t, createOperation.ID, op.Id.String())
+		require.Equal(t, api.Create, op.Type)
+		require.Equal(t, api.OperationStateRunning, op.State)
+		require.Equal(t, "baseline-v1.0.0", *op.Template)
+		require.Equal(t, "Provisioning: infrastructure not ready", *op.Progress)
+		require.Nil(t, op.Error)
+		require.Nil(t, op.CompletedAt)
+
+		op = (*resp.Operations)[1]
+		require.Equal(t, api.Delete, op.Type)
+		require.Equal(t, api.OperationStateSucceeded, op.State)
+		require.Nil(t, op.Template)
+		require.True(t, operationCreatedAt.Equal(*op.CompletedAt))
+	})
+
+	t.Run("operations of a cluster", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations?cluster=cluster-2", nil, WithOperations(&fakeOperations{ops: []operations.Operation{createOperation, deleteOperation}}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var resp api.OperationList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Len(t, *resp.Operations, 1)
+		require.Equal(t, deleteOperation.ID, (*resp.Operations)[0].Id.String())
+	})
+
+	t.Run("no operations", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations", nil, WithOperations(&fakeOperations{}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, `{"operations":[]}`, rr.Body.String())
+	})
+
+	t.Run("failed to list operations", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations", nil, WithOperations(&fakeOperations{err: errors.New("forbidden")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.OperationsListFailed, rr.Body.Bytes())
+	})
+
+	t.Run("operation tracking not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations", nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+		requireCode(t, messages.OperationTrackingDisabled, rr.Body.Bytes())
+	})
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/operations/{id})
+func (s *Server) GetV2OperationsId(ctx context.Context, request api.GetV2OperationsIdRequestObject) (api.GetV2OperationsIdResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.operations == nil {
+		return api.GetV2OperationsId501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.OperationTrackingDisabled)))}, nil
+	}
+
+	op, err := s.operations.Get(ctx, activeProjectID, request.Id.String())
+	switch {
+	case errors.Is(err, operations.ErrOperationNotFound):
+		message := messages.New(messages.OperationNotFound, request.Id)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.GetV2OperationsId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.OperationGetFailed, request.Id, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2OperationsId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	return api.GetV2OperationsId200JSONResponse(toAPIOperation(op)), nil
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2OperationsId(t *testing.T) {
+	t.Run("operation found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations/"+deleteOperation.ID, nil, WithOperations(&fakeOperations{ops: []operations.Operation{createOperation, deleteOperation}}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var op api.Operation
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &op))
+		require.Equal(t, deleteOperation.ID, op.Id.String())
+		require.Equal(t, "cluster-2", op.Cluster)
+		require.Equal(t, api.OperationStateSucceeded, op.State)
+		require.Equal(t, "cluster deleted", *op.Progress)
+	})
+
+	t.Run("operation not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations/"+createOperation.ID, nil, WithOperations(&fakeOperations{}))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.OperationNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("invalid operation id", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations/not-a-uuid", nil, WithOperations(&fakeOperations{}))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("failed to get operation", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations/"+createOperation.ID, nil, WithOperations(&fakeOperations{err: errors.New("forbidden")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+	})
+
+	t.Run("operation tracking not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/operations/"+createOperation.ID, nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+	})
+}
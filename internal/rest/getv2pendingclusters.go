@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/pending-clusters)
+func (s *Server) GetV2PendingClusters(ctx context.Context, request api.GetV2PendingClustersRequestObject) (api.GetV2PendingClustersResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.pending == nil {
+		return api.GetV2PendingClusters501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.DeferredProvisioningDisabled)))}, nil
+	}
+
+	pcs, err := s.pending.List(ctx, activeProjectID)
+	if err != nil {
+		message := messages.New(messages.PendingClustersListFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2PendingClusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	pendingClusters := make([]api.PendingCluster, 0, len(pcs))
+	for _, pc := range pcs {
+		pendingClusters = append(pendingClusters, toAPIPendingCluster(pc))
+	}
+	return api.GetV2PendingClusters200JSONResponse{PendingClusters: &pendingClusters}, nil
+}
+
+// toAPIPendingCluster converts a pending cluster to its API representation
+func toAPIPendingCluster(pc scheduling.PendingCluster) api.PendingCluster {
+	pendingCluster := api.PendingCluster{
+		Name:        pc.Name,
+		ProvisionAt: pc.ProvisionAt,
+		Spec:        pc.Spec,
+		State:       api.PendingClusterState(pc.State),
+		CreatedAt:   pc.CreatedAt,
+		UpdatedAt:   pc.UpdatedAt,
+	}
+	if pc.Error != "" {
+		pendingCluster.Error = ptr(pc.Error)
+	}
+	if pc.Preflight != nil {
+		hosts := make([]api.HostImagePullStatus, 0, len(pc.Preflight))
+		for _, host := range pc.Preflight {
+			status := api.HostImagePullStatus{HostId: host.HostID, State: string(host.State), Pulled: host.Pulled, Total: host.Total}
+			if host.Error != "" {
+				status.Error = ptr(host.Error)
+			}
+			hosts = append(hosts, status)
+		}
+		pendingCluster.Preflight = &hosts
+	}
+	return pendingCluster
+}
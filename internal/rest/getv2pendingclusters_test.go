@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/preflight"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+type fakePendingClusters struct {
+	pcs       []scheduling.PendingCluster
+	scheduled []scheduling.PendingCluster
+	canceled  []string
+	preflight bool
+	err       error
+}
+
+func (f *fakePendingClusters) Schedule(_ context.Context, projectID string, spec api.ClusterSpec, provisionAt time.Time, options ...func(*scheduling.PendingCluster)) (scheduling.PendingCluster, error) {
+	if f.err != nil {
+		return scheduling.PendingCluster{}, f.err
+	}
+	spec.ProvisionAt = nil
+	pc := scheduling.PendingCluster{Name: *spec.Name, ProjectID: projectID, ProvisionAt: provisionAt, Spec: spec, State: scheduling.Scheduled}
+	for _, o := range options {
+		o(&pc)
+	}
+	f.scheduled = append(f.scheduled, pc)
+	return pc, nil
+}
+
+func (f *fakePendingClusters) SchedulePreflight(ctx context.Context, projectID string, spec api.ClusterSpec, provisionAt time.Time, images []string, options ...func(*scheduling.PendingCluster)) (scheduling.PendingCluster, error) {
+	pc, err := f.Schedule(ctx, projectID, spec, provisionAt, options...)
+	if err != nil {
+		return scheduling.PendingCluster{}, err
+	}
+	pc.State, pc.Images = scheduling.Preflight, images
+	f.scheduled[len(f.scheduled)-1] = pc
+	return pc, nil
+}
+
+func (f *fakePendingClusters) PreflightEnabled() bool {
+	return f.preflight
+}
+
+func (f *fakePendingClusters) Get(_ context.Context, _, name string) (scheduling.PendingCluster, error) {
+	if f.err != nil {
+		return scheduling.PendingCluster{}, f.err
+	}
+	for _, pc := range f.pcs {
+		if pc.Name == name {
+			return pc, nil
+		}
+	}
+	return scheduling.PendingCluster{}, scheduling.ErrPendingClusterNotFound
+}
+
+func (f *fakePendingClusters) List(_ context.Context, _ string) ([]scheduling.PendingCluster, error) {
+	return f.pcs, f.err
+}
+
+func (f *fakePendingClusters) Reschedule(ctx context.Context, projectID, name string, spec api.ClusterSpec, provisionAt time.Time) (scheduling.PendingCluster, error) {
+	pc, err := f.Get(ctx, projectID, name)
+	if err != nil {
+		return scheduling.PendingCluster{}, err
+	}
+	if pc.State == scheduling.Provisioning {
+		return scheduling.PendingCluster{}, scheduling.ErrProvisioning
+	}
+	spec.Name, spec.ProvisionAt = &pc.Name, nil
+	pc.Spec, pc.ProvisionAt, pc.State, pc.Error = spec, provisionAt, scheduling.Scheduled, ""
+	f.scheduled = append(f.scheduled, pc)
+	return pc, nil
+}
+
+func (f *fakePendingClusters) Cancel(ctx context.Context, projectID, name string) error {
+	pc, err := f.Get(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	if pc.State == scheduling.Provisioning {
+		return scheduling.ErrProvisioning
+	}
+	f.canceled = append(f.canceled, name)
+	return nil
+}
+
+var (
+	pendingClusterCreatedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	scheduledCluster        = scheduling.PendingCluster{
+		Name:        "cluster-1",
+		ProjectID:   activeProjectID,
+		ProvisionAt: pendingClusterCreatedAt.Add(24 * time.Hour),
+		Spec:        api.ClusterSpec{Name: ptr("cluster-1"), Template: ptr("baseline-v1.0.0"), Nodes: []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}}},
+		State:       scheduling.Scheduled,
+		CreatedAt:   pendingClusterCreatedAt,
+		UpdatedAt:   pendingClusterCreatedAt,
+	}
+	failedCluster = scheduling.PendingCluster{
+		Name:        "cluster-2",
+		ProjectID:   activeProjectID,
+		ProvisionAt: pendingClusterCreatedAt,
+		Spec:        api.ClusterSpec{Name: ptr("cluster-2"), Nodes: []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc02", Role: api.All}}},
+		State:       scheduling.Failed,
+		Error:       "quota exceeded: the project is limited to 2 clusters and already has 2",
+		CreatedAt:   pendingClusterCreatedAt,
+		UpdatedAt:   pendingClusterCreatedAt,
+	}
+	provisioningCluster = scheduling.PendingCluster{
+		Name:        "cluster-3",
+		ProjectID:   activeProjectID,
+		ProvisionAt: pendingClusterCreatedAt,
+		Spec:        api.ClusterSpec{Name: ptr("cluster-3"), Nodes: []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc03", Role: api.All}}},
+		State:       scheduling.Provisioning,
+		CreatedAt:   pendingClusterCreatedAt,
+		UpdatedAt:   pendingClusterCreatedAt,
+	}
+)
+
+func TestGetV2PendingClusters(t *testing.T) {
+	t.Run("all pending clusters", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters", nil, WithPendingClusters(&fakePendingClusters{pcs: []scheduling.PendingCluster{scheduledCluster, failedCluster}}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var resp api.PendingClusterList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Len(t, *resp.PendingClusters, 2)
+
+		pc := (*resp.PendingClusters)[0]
+		require.Equal(t, "cluster-1", pc.Name)
+		require.Equal(t, api.PendingClusterStateScheduled, pc.State)
+		require.True(t, scheduledCluster.ProvisionAt.Equal(pc.ProvisionAt))
+		require.Equal(t, "baseline-v1.0.0", *pc.Spec.Template)
+		require.Nil(t, pc.Error)
+
+		pc = (*resp.PendingClusters)[1]
+		require.Equal(t, api.PendingClusterStateFailed, pc.State)
+		require.Equal(t, failedCluster.Error, *pc.Error)
+	})
+
+	t.Run("image pull status of the hosts", func(t *testing.T) {
+		preflightCluster := scheduledCluster
+		preflightCluster.State = scheduling.Preflight
+		preflightCluster.Preflight = []preflight.HostStatus{
+			{HostID: "27b4e138-ea0b-11ef-8552-8b663d95bc01", State: preflight.Failed, Pulled: 1, Total: 2, Error: "manifest unknown"},
+		}
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters", nil, WithPendingClusters(&fakePendingClusters{pcs: []scheduling.PendingCluster{preflightCluster}}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var resp api.PendingClusterList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		pc := (*resp.PendingClusters)[0]
+		require.Equal(t, api.PendingClusterStatePreflight, pc.State)
+		require.Equal(t, []api.HostImagePullStatus{
+			{HostId: "27b4e138-ea0b-11ef-8552-8b663d95bc01", State: "failed", Pulled: 1, Total: 2, Error: ptr("manifest unknown")},
+		}, *pc.Preflight)
+	})
+
+	t.Run("no pending clusters", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters", nil, WithPendingClusters(&fakePendingClusters{}))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, `{"pendingClusters":[]}`, rr.Body.String())
+	})
+
+	t.Run("failed to list pending clusters", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters", nil, WithPendingClusters(&fakePendingClusters{err: errors.New("forbidden")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClustersListFailed, rr.Body.Bytes())
+	})
+
+	t.Run("deferred provisioning not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters", nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+		requireCode(t, messages.DeferredProvisioningDisabled, rr.Body.Bytes())
+	})
+}
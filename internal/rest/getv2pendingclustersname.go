@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/pending-clusters/{name})
+func (s *Server) GetV2PendingClustersName(ctx context.Context, request api.GetV2PendingClustersNameRequestObject) (api.GetV2PendingClustersNameResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.pending == nil {
+		return api.GetV2PendingClustersName501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.DeferredProvisioningDisabled)))}, nil
+	}
+
+	pc, err := s.pending.Get(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, scheduling.ErrPendingClusterNotFound):
+		message := messages.New(messages.PendingClusterNotFound, request.Name)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.GetV2PendingClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.PendingClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2PendingClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	return api.GetV2PendingClustersName200JSONResponse(toAPIPendingCluster(pc)), nil
+}
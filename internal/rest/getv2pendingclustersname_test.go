@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2PendingClustersName(t *testing.T) {
+	pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{scheduledCluster}}
+
+	t.Run("pending cluster", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters/cluster-1", nil, WithPendingClusters(pending))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var pc api.PendingCluster
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pc))
+		require.Equal(t, "cluster-1", pc.Name)
+		require.Equal(t, api.PendingClusterStateScheduled, pc.State)
+		require.Len(t, pc.Spec.Nodes, 1)
+	})
+
+	t.Run("pending cluster not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters/cluster-2", nil, WithPendingClusters(pending))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClusterNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("failed to get pending cluster", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters/cluster-1", nil, WithPendingClusters(&fakePendingClusters{err: errors.New("forbidden")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClusterGetFailed, rr.Body.Bytes())
+	})
+
+	t.Run("deferred provisioning not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/pending-clusters/cluster-1", nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/readyz)
+func (s *Server) GetV2Readyz(ctx context.Context, request api.GetV2ReadyzRequestObject) (api.GetV2ReadyzResponseObject, error) {
+	readiness := api.Readiness{Ready: true}
+
+	warming := false
+	if s.warmup != nil {
+		progress := s.warmup.WarmupProgress()
+		readiness.CacheWarmup = &api.CacheWarmup{
+			Projects:       int32(progress.Projects),
+			WarmedProjects: int32(progress.Warmed),
+			Synced:         progress.Synced,
+		}
+		warming = !progress.Synced
+	}
+
+	failures := []string{}
+	// the pod is removed from the endpoints of the Service before the server stops accepting connections
+	if s.shuttingDown() {
+		failures = append(failures, "server is shutting down")
+	}
+	// the standby replicas take over once the leader fails, see WithLeadership
+	if s.leadership != nil && !s.leadership.IsLeader() {
+		failures = append(failures, "replica is a standby of the leader")
+	}
+	for _, check := range s.healthChecks {
+		err := check.Health()
+		// the reads are sent to the API server while the cache is warmed up
+		if err == nil || (warming && errors.Is(err, k8s.ErrCacheNotSynced)) {
+			continue
+		}
+		failures = append(failures, err.Error())
+	}
+	if len(failures) > 0 {
+		logger.FromContext(ctx).Warn("server not ready", "failures", failures)
+		readiness.Ready = false
+		readiness.Failures = &failures
+		return api.GetV2Readyz503JSONResponse(readiness), nil
+	}
+	return api.GetV2Readyz200JSONResponse(readiness), nil
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+type cacheWarmupFunc func() k8s.WarmupProgress
+
+func (f cacheWarmupFunc) WarmupProgress() k8s.WarmupProgress { return f() }
+
+type leadershipFunc func() bool
+
+func (f leadershipFunc) IsLeader() bool { return f() }
+
+func TestGetV2Readyz(t *testing.T) {
+	notSynced := healthCheckFunc(func() error { return fmt.Errorf("cluster informer %w", k8s.ErrCacheNotSynced) })
+	unreachable := healthCheckFunc(func() error { return errors.New("kubernetes api server unreachable") })
+	warming := cacheWarmupFunc(func() k8s.WarmupProgress { return k8s.WarmupProgress{Projects: 3, Warmed: 1} })
+	synced := cacheWarmupFunc(func() k8s.WarmupProgress { return k8s.WarmupProgress{Projects: 3, Warmed: 3, Synced: true} })
+
+	serve := func(t *testing.T, options ...func(*Server)) (int, api.Readiness) {
+		handler, err := NewServer(nil, options...).ConfigureHandler()
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest("GET", "/v2/readyz", nil))
+
+		var readiness api.Readiness
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &readiness), rr.Body.String())
+		return rr.Code, readiness
+	}
+
+	t.Run("ready", func(t *testing.T) {
+		code, readiness := serve(t)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, api.Readiness{Ready: true}, readiness)
+	})
+
+	t.Run("ready while the cache is warmed up", func(t *testing.T) {
+		code, readiness := serve(t, WithHealthChecks(notSynced), WithCacheWarmup(warming))
+		require.Equal(t, http.StatusOK, code)
+		require.True(t, readiness.Ready)
+		require.Equal(t, &api.CacheWarmup{Projects: 3, WarmedProjects: 1}, readiness.CacheWarmup)
+	})
+
+	t.Run("other failures while the cache is warmed up", func(t *testing.T) {
+		code, readiness := serve(t, WithHealthChecks(notSynced, unreachable), WithCacheWarmup(warming))
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.False(t, readiness.Ready)
+		require.Equal(t, &[]string{"kubernetes api server unreachable"}, readiness.Failures)
+	})
+
+	t.Run("cache not synced without warmup", func(t *testing.T) {
+		code, readiness := serve(t, WithHealthChecks(notSynced), WithCacheWarmup(synced))
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Equal(t, &[]string{"cluster informer cache is not synced"}, readiness.Failures)
+		require.True(t, readiness.CacheWarmup.Synced)
+	})
+
+	t.Run("standby replica is not ready", func(t *testing.T) {
+		code, readiness := serve(t, WithLeadership(leadershipFunc(func() bool { return false })))
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Equal(t, &[]string{"replica is a standby of the leader"}, readiness.Failures)
+
+		code, _ = serve(t, WithLeadership(leadershipFunc(func() bool { return true })))
+		require.Equal(t, http.StatusOK, code)
+	})
+}
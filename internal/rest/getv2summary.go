@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/clustermetrics"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/summary)
+func (s *Server) GetV2Summary(ctx context.Context, request api.GetV2SummaryRequestObject) (api.GetV2SummaryResponseObject, error) {
+	namespace := request.Params.Activeprojectid.String()
+
+	cli := k8s.New(s.reader())
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2Summary500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	clusters, err := fetchClustersList(ctx, s.reader(), namespace)
+	if err != nil {
+		message := messages.New(messages.ClustersListFailed)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "error", err)
+		return api.GetV2Summary500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	machines, err := fetchAllMachinesList(ctx, s.reader(), namespace)
+	if err != nil {
+		message := messages.New(messages.MachinesListFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.GetV2Summary500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	templates, err := cli.Templates(ctx, namespace)
+	if err != nil {
+		message := messages.New(messages.TemplatesListFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.GetV2Summary500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	summary := api.ProjectSummary{ClustersByPhase: map[string]int32{}, Templates: []api.TemplateUsage{}}
+	usage := map[string]int32{}
+	for _, item := range clusters {
+		var cluster capi.Cluster
+		if err := convert.FromUnstructured(item, &cluster); err != nil {
+			logger.FromContext(ctx).Warn("failed to convert cluster, skipping it", "namespace", namespace, "name", item.GetName(), "error", err)
+			continue
+		}
+		summary.Clusters++
+		summary.ClustersByPhase[clustermetrics.Phase(&cluster)]++
+		usage[cluster.Annotations[core.TemplateLabelKey]]++
+	}
+	for _, item := range machines {
+		var machine capi.Machine
+		if err := convert.FromUnstructured(item, &machine); err != nil {
+			logger.FromContext(ctx).Warn("failed to convert machine, skipping it", "namespace", namespace, "name", item.GetName(), "error", err)
+			continue
+		}
+		summary.Nodes++
+		switch nodeHealth(machine) {
+		case corev1.ConditionTrue:
+			summary.NodesByHealth.Healthy++
+		case corev1.ConditionFalse:
+			summary.NodesByHealth.Unhealthy++
+		default:
+			summary.NodesByHealth.Unknown++
+		}
+	}
+
+	slices.SortFunc(templates, func(a, b v1alpha1.ClusterTemplate) int { return strings.Compare(a.Name, b.Name) })
+	for _, template := range templates {
+		summary.Templates = append(summary.Templates, api.TemplateUsage{Template: template.Name, Clusters: usage[template.Name]})
+	}
+	return api.GetV2Summary200JSONResponse(summary), nil
+}
+
+// nodeHealth returns the status of the node healthy condition of the machine, unknown if the machine has no node yet
+func nodeHealth(machine capi.Machine) corev1.ConditionStatus {
+	for _, condition := range machine.Status.Conditions {
+		if condition.Type == capi.MachineNodeHealthyCondition {
+			return condition.Status
+		}
+	}
+	return corev1.ConditionUnknown
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func summaryCluster(t *testing.T, name, template string, phase capi.ClusterPhase, annotations map[string]string) unstructured.Unstructured {
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[core.TemplateLabelKey] = template
+	return toUnstructured(t, &capi.Cluster{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: activeProjectID, Annotations: annotations},
+		Status:     capi.ClusterStatus{Phase: string(phase)},
+	})
+}
+
+func summaryMachine(t *testing.T, name string, health ...corev1.ConditionStatus) unstructured.Unstructured {
+	machine := &capi.Machine{ObjectMeta: v1.ObjectMeta{Name: name, Namespace: activeProjectID}}
+	for _, status := range health {
+		machine.Status.Conditions = append(machine.Status.Conditions, capi.Condition{Type: capi.MachineNodeHealthyCondition, Status: status})
+	}
+	return toUnstructured(t, machine)
+}
+
+func TestGetV2Summary(t *testing.T) {
+	t.Run("clusters, nodes and templates are counted", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			summaryCluster(t, "edge-1", "baseline-v1.0.0", capi.ClusterPhaseProvisioned, nil),
+			summaryCluster(t, "edge-2", "baseline-v1.0.0", capi.ClusterPhaseProvisioning, nil),
+			summaryCluster(t, "edge-3", "baseline-v2.0.0", capi.ClusterPhaseProvisioned, map[string]string{core.MaintenanceSinceAnnotationKey: "2026-06-01T12:00:00Z"}),
+		}}, nil)
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			summaryMachine(t, "edge-1-a", corev1.ConditionTrue),
+			summaryMachine(t, "edge-1-b", corev1.ConditionFalse),
+			summaryMachine(t, "edge-2-a"),
+		}}, nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			upgradeTemplate(t, "baseline-v3.0.0", "v1.30.6+k3s1"),
+			upgradeTemplate(t, "baseline-v1.0.0", "v1.28.4+k3s1"),
+			upgradeTemplate(t, "baseline-v2.0.0", "v1.29.2+k3s1"),
+		}}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.MachineResourceSchema:  machines,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodGet, "/v2/summary", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var summary api.ProjectSummary
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &summary))
+		require.Equal(t, api.ProjectSummary{
+			Clusters:        3,
+			ClustersByPhase: map[string]int32{"Provisioned": 1, "Provisioning": 1, "Maintenance": 1},
+			Nodes:           3,
+			NodesByHealth:   api.NodeHealthSummary{Healthy: 1, Unhealthy: 1, Unknown: 1},
+			Templates: []api.TemplateUsage{
+				{Template: "baseline-v1.0.0", Clusters: 2},
+				{Template: "baseline-v2.0.0", Clusters: 1},
+				{Template: "baseline-v3.0.0", Clusters: 0},
+			},
+		}, summary)
+	})
+
+	t.Run("failure to list the machines", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(nil, errors.New("boom"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: machines,
+		}, http.MethodGet, "/v2/summary", nil)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		requireCode(t, messages.MachinesListFailed, rr.Body.Bytes())
+	})
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/supportmatrix)
+func (s *Server) GetV2Supportmatrix(ctx context.Context, request api.GetV2SupportmatrixRequestObject) (api.GetV2SupportmatrixResponseObject, error) {
+	matrix := s.supportMatrix
+	if matrix == nil {
+		var err error
+		if matrix, err = supportmatrix.Default(); err != nil {
+			logger.FromContext(ctx).Error("failed to get support matrix", "error", err)
+			return api.GetV2Supportmatrix500JSONResponse{
+				N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.SupportMatrixFailed, err))),
+			}, nil
+		}
+	}
+
+	providers := make([]api.ControlPlaneProviderSupport, 0, len(matrix.ControlPlaneProviders))
+	for _, support := range matrix.ControlPlaneProviders {
+		providers = append(providers, api.ControlPlaneProviderSupport{
+			Provider:             support.Provider,
+			Release:              support.Release,
+			MinKubernetesVersion: support.MinKubernetesVersion,
+			MaxKubernetesVersion: support.MaxKubernetesVersion,
+		})
+	}
+	return api.GetV2Supportmatrix200JSONResponse{ControlPlaneProviders: providers}, nil
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2Supportmatrix200(t *testing.T) {
+	t.Run("default matrix", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/supportmatrix", nil)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		resp, err := api.ParseGetV2SupportmatrixResponse(rr.Result())
+		require.NoError(t, err)
+
+		matrix, err := supportmatrix.Default()
+		require.NoError(t, err)
+		require.Len(t, resp.JSON200.ControlPlaneProviders, len(matrix.ControlPlaneProviders))
+	})
+
+	t.Run("overridden matrix", func(t *testing.T) {
+		matrix, err := supportmatrix.Parse([]byte(`{"controlPlaneProviders":[{"provider":"k3s","release":"v0.5.0","minKubernetesVersion":"v1.31","maxKubernetesVersion":"v1.35"}]}`))
+		require.NoError(t, err)
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/supportmatrix", nil, WithSupportMatrix(matrix))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		resp, err := api.ParseGetV2SupportmatrixResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, []api.ControlPlaneProviderSupport{
+			{Provider: "k3s", Release: "v0.5.0", MinKubernetesVersion: "v1.31", MaxKubernetesVersion: "v1.35"},
+		}, resp.JSON200.ControlPlaneProviders)
+	})
+}
@@ -4,39 +4,41 @@ package rest
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
-	"strings"
+	"errors"
 
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	. "github.com/open-edge-platform/cluster-manager/v2/internal/pagination"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 // (GET /v2/templates)
 func (s *Server) GetV2Templates(ctx context.Context, request api.GetV2TemplatesRequestObject) (api.GetV2TemplatesResponseObject, error) {
-	slog.Debug("GetV2Templates", "params", request.Params)
+	logger.FromContext(ctx).Debug("GetV2Templates", "params", request.Params)
 	activeProjectID := request.Params.Activeprojectid.String()
 
-	cli := k8s.New(s.k8sclient)
+	cli := k8s.New(s.reader())
 	if cli == nil {
-		message := "failed to create k8s client"
-		slog.Error(message)
-		return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
 	defaultTemplate, err := getV2TemplateDefault(ctx, cli, activeProjectID, request.Params.Default)
 	if err != nil {
-		response, is200OK := handleV2TemplateDefaultResponse(err, defaultTemplate)
+		response, is200OK := handleV2TemplateDefaultResponse(ctx, err)
 		if !is200OK {
 			return response, nil
 		}
 	}
 
-	return getV2TemplatesAll(ctx, cli, activeProjectID, request.Params, defaultTemplate)
+	return s.getV2TemplatesAll(ctx, cli, activeProjectID, request.Params, defaultTemplate)
 }
 
 func getV2TemplateDefault(ctx context.Context, cli *k8s.Client, activeProjectID string, defaultParam *bool) (*api.DefaultTemplateInfo, error) {
@@ -45,56 +47,62 @@ func getV2TemplateDefault(ctx context.Context, cli *k8s.Client, activeProjectID
 	}
 
 	defaultTemplate, err := cli.DefaultTemplate(ctx, activeProjectID)
-	if err == k8s.ErrDefaultTemplateNotFound {
-		message := fmt.Sprintf("default template not found: %v", err)
-		slog.Warn(message)
-		return nil, fmt.Errorf("%s", message)
-	} else if err != nil {
-		message := fmt.Sprintf("failed to get default template: %v", err)
-		slog.Error(message)
-		return nil, fmt.Errorf("%s", message)
+	switch {
+	case errors.Is(err, k8s.ErrDefaultTemplateNotFound):
+		message := messages.New(messages.DefaultTemplateNotFound)
+		logger.FromContext(ctx).Warn(message.String())
+		return nil, message
+	case errors.Is(err, k8s.ErrMultipleDefaultTemplates):
+		message := messages.New(messages.MultipleDefaultTemplates)
+		logger.FromContext(ctx).Error(message.String())
+		return nil, message
+	case err != nil:
+		message := messages.New(messages.DefaultTemplateGetFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return nil, message
 	}
 
 	defaultTemplateInfo, err := template.FromClusterTemplateToDefaultTemplateInfo(defaultTemplate)
 	if err != nil {
-		message := fmt.Sprintf("failed to convert default template to response object: %v", err)
-		slog.Error(message)
-		return nil, fmt.Errorf("%s", message)
+		message := messages.New(messages.TemplateConvertFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return nil, message
 	}
-	slog.Info("returned default clusterTemplate", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "name", defaultTemplate.Name)
+	logger.FromContext(ctx).Info("returned default clusterTemplate", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "name", defaultTemplate.Name)
 	return defaultTemplateInfo, nil
 }
 
-func getV2TemplatesAll(ctx context.Context, cli *k8s.Client, activeProjectID string, params any, defaultTemplate *api.DefaultTemplateInfo) (api.GetV2TemplatesResponseObject, error) {
+func (s *Server) getV2TemplatesAll(ctx context.Context, cli *k8s.Client, activeProjectID string, params any, defaultTemplate *api.DefaultTemplateInfo) (api.GetV2TemplatesResponseObject, error) {
 	// get all templates from k8s
 	templates, err := cli.Templates(ctx, activeProjectID)
 	if err != nil {
-		message := fmt.Sprintf("failed to get templates: %v", err)
-		slog.Error(message)
-		return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.TemplatesListFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
 	pageSize, offset, orderBy, filter, err := ValidateParams(params)
 	if err != nil {
-		message := fmt.Sprintf("invalid parameters: %v", err)
-		slog.Error(message)
-		return api.GetV2Templates404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.InvalidParameters, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2Templates404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
 	}
 
 	// convert to the response object
 	var templateInfo []api.TemplateInfo
-	for _, t := range templates {
-		t, err := template.FromClusterTemplateToTemplateInfo(t)
+	for _, clusterTemplate := range templates {
+		t, err := template.FromClusterTemplateToTemplateInfo(clusterTemplate)
 		if err != nil {
-			message := fmt.Sprintf("failed to convert template to response object: %v", err)
-			slog.Error(message)
-			return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message}}, nil
+			message := messages.New(messages.TemplateConvertFailed, err)
+			logger.FromContext(ctx).Error(message.String())
+			return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 		}
+		t.SignatureVerification = s.signatureVerification(ctx, clusterTemplate)
 		templateInfo = append(templateInfo, *t)
 	}
 
 	if len(templateInfo) == 0 && defaultTemplate == nil {
-		slog.Warn("no templates found in namespace", "namespace", activeProjectID)
+		logger.FromContext(ctx).Warn("no templates found in namespace", "namespace", activeProjectID)
 		return api.GetV2Templates200JSONResponse{
 			DefaultTemplateInfo: nil,
 			TemplateInfoList:    &[]api.TemplateInfo{},
@@ -105,12 +113,12 @@ func getV2TemplatesAll(ctx context.Context, cli *k8s.Client, activeProjectID str
 	if filter != nil {
 		templateInfo, err = FilterItems(templateInfo, *filter, filterTemplates)
 		if err != nil {
-			slog.Error("failed to apply filters", "error", err)
+			logger.FromContext(ctx).Error("failed to apply filters", "error", err)
 			return nil, err
 		}
 
 		if len(templateInfo) == 0 {
-			slog.Warn("no templates found in namespace with filter", "namespace", activeProjectID, "filter", *filter)
+			logger.FromContext(ctx).Warn("no templates found in namespace with filter", "namespace", activeProjectID, "filter", *filter)
 			return api.GetV2Templates200JSONResponse{
 				DefaultTemplateInfo: nil,
 				TemplateInfoList:    &[]api.TemplateInfo{},
@@ -122,19 +130,19 @@ func getV2TemplatesAll(ctx context.Context, cli *k8s.Client, activeProjectID str
 	if orderBy != nil {
 		templateInfo, err = OrderItems(templateInfo, *orderBy, orderByTemplates)
 		if err != nil {
-			slog.Error("failed to apply filters", "error", err)
+			logger.FromContext(ctx).Error("failed to apply filters", "error", err)
 			return nil, err
 		}
 	}
 
 	paginatedTemplatesList, err := PaginateItems(templateInfo, *pageSize, *offset)
 	if err != nil {
-		message := fmt.Sprintf("failed to get paginated templates: %v", err)
-		slog.Error(message)
-		return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.TemplatePageFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
-	slog.Info("returned clusterTemplates", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "count", len(templateInfo))
+	logger.FromContext(ctx).Info("returned clusterTemplates", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "count", len(templateInfo))
 	return api.GetV2Templates200JSONResponse{
 		DefaultTemplateInfo: defaultTemplate,
 		TemplateInfoList:    paginatedTemplatesList,
@@ -142,18 +150,16 @@ func getV2TemplatesAll(ctx context.Context, cli *k8s.Client, activeProjectID str
 	}, nil
 }
 
-func handleV2TemplateDefaultResponse(err error, defaultTemplate *api.DefaultTemplateInfo) (api.GetV2TemplatesResponseObject, bool) {
-	message := err.Error()
-	if defaultTemplate != nil || message == "failed to get default template: multiple default templates found" {
+// handleV2TemplateDefaultResponse returns the error response of a failure to get the default template; only multiple
+// default templates fail the request, otherwise the templates are returned without default template
+func handleV2TemplateDefaultResponse(ctx context.Context, err error) (api.GetV2TemplatesResponseObject, bool) {
+	var message messages.Message
+	if errors.As(err, &message) && message.Code == messages.MultipleDefaultTemplates {
 		return api.GetV2Templates500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message)),
 		}, false
-	} else if defaultTemplate == nil || strings.Contains(message, "default template not found") {
-		return api.GetV2Templates200JSONResponse{}, true
 	}
-	return api.GetV2Templates404JSONResponse{
-		N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &message},
-	}, false
+	return api.GetV2Templates200JSONResponse{}, true
 }
 
 func filterTemplates(template api.TemplateInfo, filter *Filter) bool {
@@ -190,3 +196,20 @@ func orderByTemplates(template1, template2 api.TemplateInfo, orderBy *OrderBy) b
 		return false
 	}
 }
+
+// signatureVerification returns whether the signature of the template was verified with the trusted keys, nil if no
+// trusted keys are configured
+func (s *Server) signatureVerification(ctx context.Context, clusterTemplate v1alpha1.ClusterTemplate) *api.TemplateInfoSignatureVerification {
+	if len(s.trustedKeys) == 0 {
+		return nil
+	}
+
+	verification := api.Verified
+	if err := template.VerifySignature(clusterTemplate, s.trustedKeys); errors.Is(err, signature.ErrUnsigned) {
+		verification = api.Unsigned
+	} else if err != nil {
+		logger.FromContext(ctx).Warn("template signature is not valid", "namespace", clusterTemplate.Namespace, "name", clusterTemplate.Name, "error", err)
+		verification = api.Invalid
+	}
+	return &verification
+}
@@ -46,6 +46,7 @@ var templateInfo1 = api.TemplateInfo{
 	Clusterconfiguration: &map[string]interface{}{
 		"fake": "config",
 	},
+	LifecycleState: ptr(api.TemplateInfoLifecycleStatePublished),
 }
 
 var template2 = v1alpha1.ClusterTemplate{
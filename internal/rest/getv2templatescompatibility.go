@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/templates/compatibility)
+func (s *Server) GetV2TemplatesCompatibility(ctx context.Context, request api.GetV2TemplatesCompatibilityRequestObject) (api.GetV2TemplatesCompatibilityResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	matrix := s.supportMatrix
+	if matrix == nil {
+		var err error
+		if matrix, err = supportmatrix.Default(); err != nil {
+			message := messages.New(messages.SupportMatrixFailed, err)
+			logger.FromContext(ctx).Error(message.String())
+			return api.GetV2TemplatesCompatibility500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	cli := k8s.New(s.reader())
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.GetV2TemplatesCompatibility500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	templates, err := cli.Templates(ctx, activeProjectID)
+	if err != nil {
+		message := messages.New(messages.TemplatesListFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2TemplatesCompatibility500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	slices.SortFunc(templates, func(a, b v1alpha1.ClusterTemplate) int { return strings.Compare(a.Name, b.Name) })
+
+	response := api.GetV2TemplatesCompatibility200JSONResponse{Templates: []api.TemplateCompatibility{}}
+	for _, template := range templates {
+		response.Templates = append(response.Templates, templateCompatibility(ctx, template, templates, matrix))
+	}
+	return response, nil
+}
+
+// templateCompatibility returns the compatibility of the template with the Kubernetes versions and infra providers of
+// its control plane provider and the templates its clusters can be upgraded to
+func templateCompatibility(ctx context.Context, template v1alpha1.ClusterTemplate, templates []v1alpha1.ClusterTemplate, matrix *supportmatrix.Matrix) api.TemplateCompatibility {
+	controlPlaneProvider := template.Spec.ControlPlaneProviderType
+	compatibility := api.TemplateCompatibility{
+		Template:                   template.Name,
+		KubernetesVersion:          template.Spec.KubernetesVersion,
+		Controlplaneprovidertype:   controlPlaneProvider,
+		Infraprovidertype:          template.Spec.InfraProviderType,
+		KubernetesVersionSupported: matrix.Check(controlPlaneProvider, template.Spec.KubernetesVersion) == nil,
+		InfraProviders:             providers.InfraProvidersOf(controlPlaneProvider),
+		Upgrades:                   []api.ClusterUpgrade{},
+	}
+	if minVersion, maxVersion, ok := matrix.Window(controlPlaneProvider); ok {
+		compatibility.MinKubernetesVersion = &minVersion
+		compatibility.MaxKubernetesVersion = &maxVersion
+	}
+
+	// the kubelets of a cluster run the version of its template, templates with invalid versions have no upgrades
+	upgrades, err := cluster.Upgrades(template, templates, nil)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to get upgrades of template", "namespace", template.Namespace, "name", template.Name, "error", err)
+		return compatibility
+	}
+	for _, upgrade := range upgrades {
+		warnings := []api.UpgradeWarning{}
+		for _, warning := range upgrade.Warnings {
+			warnings = append(warnings, api.UpgradeWarning{Type: api.UpgradeWarningType(warning.Type), Message: warning.Message})
+		}
+		if err := matrix.Check(controlPlaneProvider, upgrade.KubernetesVersion); err != nil {
+			warnings = append(warnings, api.UpgradeWarning{Type: api.UpgradeWarningType(cluster.UnsupportedKubernetesVersion), Message: err.Error()})
+		}
+		compatibility.Upgrades = append(compatibility.Upgrades, api.ClusterUpgrade{
+			Template:          upgrade.Template,
+			KubernetesVersion: upgrade.KubernetesVersion,
+			Warnings:          warnings,
+			Path:              upgrade.Path,
+		})
+	}
+	return compatibility
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2TemplatesCompatibility(t *testing.T) {
+	matrix, err := supportmatrix.Parse([]byte(`{"controlPlaneProviders":[{"provider":"k3s","release":"v0.4.0","minKubernetesVersion":"v1.28","maxKubernetesVersion":"v1.29"}]}`))
+	require.NoError(t, err)
+
+	t.Run("templates are listed with their support and upgrades", func(t *testing.T) {
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			upgradeTemplate(t, "baseline-v3.0.0", "v1.30.6+k3s1"),
+			upgradeTemplate(t, "baseline-v1.0.0", "v1.28.4+k3s1"),
+			upgradeTemplate(t, "baseline-v2.0.0", "v1.29.2+k3s1"),
+		}}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.TemplateResourceSchema: templates,
+		}, http.MethodGet, "/v2/templates/compatibility", nil, WithSupportMatrix(matrix))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var compatibility api.TemplateCompatibilityList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &compatibility))
+		require.Len(t, compatibility.Templates, 3)
+
+		baseline := compatibility.Templates[0]
+		require.Equal(t, "baseline-v1.0.0", baseline.Template)
+		require.True(t, baseline.KubernetesVersionSupported)
+		require.Equal(t, "v1.28", *baseline.MinKubernetesVersion)
+		require.Equal(t, "v1.29", *baseline.MaxKubernetesVersion)
+		require.Equal(t, []string{"docker", "intel", "vsphere"}, baseline.InfraProviders)
+		require.Len(t, baseline.Upgrades, 2)
+		require.Equal(t, "baseline-v2.0.0", baseline.Upgrades[0].Template)
+		require.Empty(t, baseline.Upgrades[0].Warnings)
+		require.Equal(t, "baseline-v3.0.0", baseline.Upgrades[1].Template)
+		require.Equal(t, []string{"baseline-v2.0.0", "baseline-v3.0.0"}, baseline.Upgrades[1].Path)
+		require.Equal(t, []api.UpgradeWarningType{api.ControlPlaneMinorSkip, api.UnsupportedKubernetesVersion},
+			[]api.UpgradeWarningType{baseline.Upgrades[1].Warnings[0].Type, baseline.Upgrades[1].Warnings[1].Type})
+
+		latest := compatibility.Templates[2]
+		require.Equal(t, "baseline-v3.0.0", latest.Template)
+		require.False(t, latest.KubernetesVersionSupported)
+		require.Empty(t, latest.Upgrades)
+	})
+
+	t.Run("failure to list the templates", func(t *testing.T) {
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(nil, errors.New("boom"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.TemplateResourceSchema: templates,
+		}, http.MethodGet, "/v2/templates/compatibility", nil, WithSupportMatrix(matrix))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		requireCode(t, messages.TemplatesListFailed, rr.Body.Bytes())
+	})
+}
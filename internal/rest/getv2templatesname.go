@@ -4,10 +4,10 @@ package rest
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 
@@ -20,50 +20,49 @@ import (
 
 // (GET /v2/templates/{name}/{version})
 func (s *Server) GetV2TemplatesNameVersion(ctx context.Context, request api.GetV2TemplatesNameVersionRequestObject) (api.GetV2TemplatesNameVersionResponseObject, error) {
-	slog.Debug("GetV2TemplatesNameVersion", "params", request.Params)
+	logger.FromContext(ctx).Debug("GetV2TemplatesNameVersion", "params", request.Params)
 	activeProjectID := request.Params.Activeprojectid.String()
 
 	templateName := request.Name + "-" + request.Version
-	slog.Debug("getting clusterTemplate", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "name", templateName)
+	logger.FromContext(ctx).Debug("getting clusterTemplate", "schema", core.TemplateResourceSchema, "namespace", activeProjectID, "name", templateName)
 
-	unstructuredClusterTemplate, err := s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Get(ctx, templateName, v1.GetOptions{})
+	unstructuredClusterTemplate, err := s.reader().Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Get(ctx, templateName, v1.GetOptions{})
 	switch {
 	case errors.IsNotFound(err):
-		slog.Error("clusterTemplate not found", "namespace", activeProjectID, "name", templateName)
+		logger.FromContext(ctx).Error("clusterTemplate not found", "namespace", activeProjectID, "name", templateName)
 		return api.GetV2TemplatesNameVersion404JSONResponse{
-			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{
-				Message: ptr(fmt.Sprintf("clusterTemplate '%s-%s' not found", request.Name, request.Version)),
-			},
+			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.TemplateNotFound, templateName))),
 		}, nil
 	case err != nil:
-		slog.Error("failed to get clusterTemplate", "namespace", activeProjectID, "name", templateName, "error", err)
+		logger.FromContext(ctx).Error("failed to get clusterTemplate", "namespace", activeProjectID, "name", templateName, "error", err)
 		return api.GetV2TemplatesNameVersion500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.TemplateGetFailed, templateName, err))),
 		}, nil
 	}
 
-	template, err := s.getTemplate(*unstructuredClusterTemplate)
+	template, err := s.getTemplate(ctx, *unstructuredClusterTemplate)
 	if err != nil {
-		slog.Error("failed to get clusterTemplate from unstructuredClusterTemplate", "namespace", activeProjectID, "name", templateName, "error", err)
+		logger.FromContext(ctx).Error("failed to get clusterTemplate from unstructuredClusterTemplate", "namespace", activeProjectID, "name", templateName, "error", err)
 		return api.GetV2TemplatesNameVersion500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.TemplateConvertFailed, err))),
 		}, nil
 	}
 
 	return api.GetV2TemplatesNameVersion200JSONResponse(*template), nil
 }
 
-func (s *Server) getTemplate(item unstructured.Unstructured) (*api.TemplateInfo, error) {
-	slog.Debug("getTemplate", "item", item)
+func (s *Server) getTemplate(ctx context.Context, item unstructured.Unstructured) (*api.TemplateInfo, error) {
+	logger.FromContext(ctx).Debug("getTemplate", "item", item)
 	clusterTemplate := ct.ClusterTemplate{}
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &clusterTemplate); err != nil {
-		slog.Error("failed to convert unstructured to clusterTemplate", "unstructured", item, "error", err)
+		logger.FromContext(ctx).Error("failed to convert unstructured to clusterTemplate", "unstructured", item, "error", err)
 		return nil, err
 	}
 
-	return template.FromClusterTemplateToTemplateInfo(clusterTemplate)
+	templateInfo, err := template.FromClusterTemplateToTemplateInfo(clusterTemplate)
+	if err != nil {
+		return nil, err
+	}
+	templateInfo.SignatureVerification = s.signatureVerification(ctx, clusterTemplate)
+	return templateInfo, nil
 }
@@ -4,6 +4,13 @@ package rest
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -14,11 +21,17 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic/fake"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
 func createMockServerTemplateNameVersion(t *testing.T, template v1alpha1.ClusterTemplate, activeProjectId string, getError error) *Server {
@@ -45,7 +58,7 @@ func TestGetV2TemplatesNameVersion(t *testing.T) {
 		expectedTemplateInfo api.TemplateInfo
 		expectedError        error
 		expectedStatusCode   int
-		expectedErrMessage   string
+		expectedErrCode      messages.Code
 	}{
 		{
 			name:                 "200 OK",
@@ -69,7 +82,7 @@ func TestGetV2TemplatesNameVersion(t *testing.T) {
 				},
 			},
 			expectedStatusCode: http.StatusNotFound,
-			expectedErrMessage: "not found",
+			expectedErrCode:    messages.TemplateNotFound,
 		},
 		{
 			name:            "500 Internal Server Error",
@@ -85,7 +98,7 @@ func TestGetV2TemplatesNameVersion(t *testing.T) {
 				},
 			},
 			expectedStatusCode: http.StatusInternalServerError,
-			expectedErrMessage: "Internal Server Error",
+			expectedErrCode:    messages.TemplateGetFailed,
 		},
 		{
 			name: "500 Invalid ClusterConfiguration in ClusterTemplate",
@@ -105,7 +118,7 @@ func TestGetV2TemplatesNameVersion(t *testing.T) {
 			templateVersion:    "v0.0.1",
 			expectedError:      nil,
 			expectedStatusCode: http.StatusInternalServerError,
-			expectedErrMessage: "invalid",
+			expectedErrCode:    messages.TemplateConvertFailed,
 		},
 	}
 
@@ -129,21 +142,13 @@ func TestGetV2TemplatesNameVersion(t *testing.T) {
 		resp, err := api.ParseGetV2TemplatesNameVersionResponse(rr.Result())
 		require.NoError(t, err, "api.ParseGetV2TemplatesNameVersionResponse() error = %v, want nil", err)
 
-		var actualMessage *string
-		var actualTemplateInfo *api.TemplateInfo
 		switch rr.Code {
 		case http.StatusOK:
-			actualTemplateInfo = resp.JSON200
+			require.Equal(t, tt.expectedTemplateInfo, *resp.JSON200, "TemplateInfo = %v, want %v", *resp.JSON200, tt.expectedTemplateInfo)
 		case http.StatusNotFound:
-			actualMessage = resp.JSON404.Message
+			requireProblemCode(t, tt.expectedErrCode, *resp.JSON404)
 		case http.StatusInternalServerError:
-			actualMessage = resp.JSON500.Message
-		}
-
-		if tt.expectedErrMessage == "" {
-			require.Equal(t, tt.expectedTemplateInfo, *actualTemplateInfo, "TemplateInfo = %v, want %v", *actualTemplateInfo, tt.expectedTemplateInfo)
-		} else {
-			require.Contains(t, *actualMessage, tt.expectedErrMessage, "ServeHTTP() body = %v, want %v", *actualMessage, tt.expectedErrMessage)
+			requireProblemCode(t, tt.expectedErrCode, *resp.JSON500)
 		}
 	}
 }
@@ -182,3 +187,137 @@ func FuzzGetV2TemplatesName(f *testing.F) {
 		_, _ = server.GetV2TemplatesNameVersion(context.Background(), req)
 	})
 }
+
+func TestGetV2TemplatesNameVersionReadCache(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	client := k8s.New().WithFakeClient()
+	template, err := convert.ToUnstructured(template1)
+	require.NoError(t, err)
+	template.SetAPIVersion("edge-orchestrator.intel.com/v1alpha1")
+	template.SetKind("ClusterTemplate")
+	_, err = client.Dyn.Resource(core.TemplateResourceSchema).Namespace(expectedActiveProjectID).Create(context.Background(), template, v1.CreateOptions{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cache := k8s.NewReadCache(client.Dyn, nil)
+	cache.Start(ctx)
+	require.True(t, cache.WaitForSync(ctx))
+
+	handler, err := NewServer(client.Dyn, WithReadCache(cache)).ConfigureHandler()
+	require.NoError(t, err)
+	serve := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	gets := func() int {
+		count := 0
+		for _, action := range client.Dyn.(*fake.FakeDynamicClient).Actions() {
+			if action.GetVerb() == "get" {
+				count++
+			}
+		}
+		return count
+	}
+
+	t.Run("served from the cache", func(t *testing.T) {
+		rr := serve("/v2/templates/test-template/v0.0.1")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Zero(t, gets(), "the template is not read from the API server")
+	})
+
+	t.Run("not cached", func(t *testing.T) {
+		rr := serve("/v2/templates/test-template/v0.0.2")
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		require.Equal(t, 1, gets(), "reads of objects that are not cached fall back to the API server")
+	})
+}
+
+func TestGetV2TemplatesNameVersionSignatureVerification(t *testing.T) {
+	const activeProjectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	keys, err := signature.ParseTrustedKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	info := api.TemplateInfo{Name: "foo", Version: "v1.0.0", KubernetesVersion: "v1.33.5+k3s1"}
+	payload, err := template.SignaturePayload(info)
+	require.NoError(t, err)
+	digest := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	info.Signature = ptr(base64.StdEncoding.EncodeToString(sig))
+
+	signed, err := template.FromTemplateInfoToClusterTemplate(info)
+	require.NoError(t, err)
+	modified := signed.DeepCopy()
+	modified.Spec.KubernetesVersion = "v1.34.1+k3s1"
+	unsigned := signed.DeepCopy()
+	unsigned.Annotations = nil
+
+	for name, tc := range map[string]struct {
+		template     *v1alpha1.ClusterTemplate
+		verification api.TemplateInfoSignatureVerification
+	}{
+		"signed template is verified":       {template: signed, verification: api.Verified},
+		"modified template is not verified": {template: modified, verification: api.Invalid},
+		"unsigned template is reported":     {template: unsigned, verification: api.Unsigned},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := createMockServerTemplateNameVersion(t, *tc.template, activeProjectID, nil)
+			server.trustedKeys = keys
+
+			req := httptest.NewRequest(http.MethodGet, "/v2/templates/foo/v1.0.0", nil)
+			req.Header.Set("Activeprojectid", activeProjectID)
+			rr := httptest.NewRecorder()
+			handler, err := server.ConfigureHandler()
+			require.NoError(t, err)
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			resp, err := api.ParseGetV2TemplatesNameVersionResponse(rr.Result())
+			require.NoError(t, err)
+			require.NotNil(t, resp.JSON200.SignatureVerification)
+			require.Equal(t, tc.verification, *resp.JSON200.SignatureVerification)
+			require.Nil(t, resp.JSON200.Signature)
+		})
+	}
+}
+
+func TestGetV2TemplatesNameVersionStatus(t *testing.T) {
+	const activeProjectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+	clusterTemplate := template1.DeepCopy()
+	clusterTemplate.Spec.InfraProviderType = "intel"
+	clusterTemplate.Status = v1alpha1.ClusterTemplateStatus{
+		Conditions: capi.Conditions{
+			{Type: capi.ReadyCondition, Status: corev1.ConditionFalse, Severity: capi.ConditionSeverityError, Reason: v1alpha1.UnsupportedProvidersReason, Message: "control plane provider \"kubeadm\" is not supported with infrastructure provider \"intel\""},
+			{Type: v1alpha1.ProvidersValidatedCondition, Status: corev1.ConditionFalse, Severity: capi.ConditionSeverityError, Reason: v1alpha1.UnsupportedProvidersReason, Message: "control plane provider \"kubeadm\" is not supported with infrastructure provider \"intel\""},
+		},
+	}
+	server := createMockServerTemplateNameVersion(t, *clusterTemplate, activeProjectID, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/templates/foo/v1.0.0", nil)
+	req.Header.Set("Activeprojectid", activeProjectID)
+	rr := httptest.NewRecorder()
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	resp, err := api.ParseGetV2TemplatesNameVersionResponse(rr.Result())
+	require.NoError(t, err)
+	require.NotNil(t, resp.JSON200.Status)
+	require.False(t, resp.JSON200.Status.Ready)
+	require.Len(t, resp.JSON200.Status.Conditions, 2)
+	condition := resp.JSON200.Status.Conditions[1]
+	require.Equal(t, "ProvidersValidated", condition.Type)
+	require.Equal(t, api.TemplateConditionStatusFalse, condition.Status)
+	require.Equal(t, api.Error, *condition.Severity)
+	require.Equal(t, v1alpha1.UnsupportedProvidersReason, *condition.Reason)
+	require.Contains(t, *condition.Message, "is not supported with infrastructure provider")
+}
@@ -4,41 +4,37 @@ package rest
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // (GET /v2/templates/{name}/versions)
 func (s *Server) GetV2TemplatesNameVersions(ctx context.Context, request api.GetV2TemplatesNameVersionsRequestObject) (api.GetV2TemplatesNameVersionsResponseObject, error) {
-	slog.Debug("GetV2TemplatesNameVersions", "params", request.Params)
+	logger.FromContext(ctx).Debug("GetV2TemplatesNameVersions", "params", request.Params)
 	activeProjectID := request.Params.Activeprojectid.String()
 
 	// TODO: Revisit this. Field selector does not handle regex/wildcards.
 	// Gathering all templates and filtering them manually is too much time and memory consuming.
-	unstructuredClusterTemplatesList, err := s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(activeProjectID).List(ctx, v1.ListOptions{})
+	unstructuredClusterTemplatesList, err := s.reader().Resource(core.TemplateResourceSchema).Namespace(activeProjectID).List(ctx, v1.ListOptions{})
 	if err != nil {
-		slog.Error("failed to list clusterTemplates", "namespace", activeProjectID, "error", err)
+		logger.FromContext(ctx).Error("failed to list clusterTemplates", "namespace", activeProjectID, "error", err)
 		return api.GetV2TemplatesNameVersions500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.TemplatesListFailed, err))),
 		}, nil
 	}
 
 	versions := []string{}
 
 	for _, item := range unstructuredClusterTemplatesList.Items {
-		template, err := s.getTemplate(item)
+		template, err := s.getTemplate(ctx, item)
 		if err != nil {
-			slog.Error("failed to get template", "error", err)
+			logger.FromContext(ctx).Error("failed to get template", "error", err)
 			return api.GetV2TemplatesNameVersions500JSONResponse{
-				N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-					Message: ptr(err.Error()),
-				},
+				N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.TemplateConvertFailed, err))),
 			}, nil
 		}
 		if request.Name == template.Name {
@@ -47,11 +43,9 @@ func (s *Server) GetV2TemplatesNameVersions(ctx context.Context, request api.Get
 	}
 
 	if len(versions) == 0 {
-		slog.Error("clusterTemplate not found", "namespace", activeProjectID, "name", request.Name)
+		logger.FromContext(ctx).Error("clusterTemplate not found", "namespace", activeProjectID, "name", request.Name)
 		return api.GetV2TemplatesNameVersions404JSONResponse{
-			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{
-				Message: ptr(fmt.Sprintf("clusterTemplate with name '%s' not found", request.Name)),
-			},
+			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.TemplateVersionsNotFound, request.Name))),
 		}, nil
 	}
 
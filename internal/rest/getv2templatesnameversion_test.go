@@ -11,6 +11,7 @@ import (
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +26,7 @@ func TestGetV2TemplatesNameVersions(t *testing.T) {
 		expectedError      error
 		expectedStatusCode int
 		expectedResponse   api.VersionList
-		expectedErrMessage string
+		expectedErrCode    messages.Code
 	}{
 		{
 			name:               "200 OK",
@@ -39,7 +40,7 @@ func TestGetV2TemplatesNameVersions(t *testing.T) {
 			templates:          []v1alpha1.ClusterTemplate{},
 			expectedError:      nil,
 			expectedStatusCode: http.StatusNotFound,
-			expectedErrMessage: "not found",
+			expectedErrCode:    messages.TemplateVersionsNotFound,
 		},
 		{
 			name:      "500 Internal Server Error",
@@ -53,7 +54,7 @@ func TestGetV2TemplatesNameVersions(t *testing.T) {
 				},
 			},
 			expectedStatusCode: http.StatusInternalServerError,
-			expectedErrMessage: "Internal server error",
+			expectedErrCode:    messages.TemplatesListFailed,
 		},
 		{
 			name: "500 Invalid name in ClusterTemplate",
@@ -76,7 +77,7 @@ func TestGetV2TemplatesNameVersions(t *testing.T) {
 				},
 			},
 			expectedStatusCode: http.StatusInternalServerError,
-			expectedErrMessage: "invalid clusterTemplate name format",
+			expectedErrCode:    messages.TemplateConvertFailed,
 		},
 	}
 
@@ -97,21 +98,18 @@ func TestGetV2TemplatesNameVersions(t *testing.T) {
 			require.Equal(t, tt.expectedStatusCode, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, tt.expectedStatusCode)
 
 			// check the response body
-			if tt.expectedErrMessage != "" {
+			if tt.expectedErrCode != "" {
 				resp, err := api.ParseGetV2TemplatesNameVersionsResponse(rr.Result())
 				require.NoError(t, err, "api.ParseGetV2TemplatesNameVersionsResponse() error = %v, want nil", err)
 
-				var actualMessage *string
 				switch rr.Code {
 				case http.StatusBadRequest:
-					actualMessage = resp.JSON400.Message
+					requireProblemCode(t, tt.expectedErrCode, *resp.JSON400)
 				case http.StatusNotFound:
-					actualMessage = resp.JSON404.Message
+					requireProblemCode(t, tt.expectedErrCode, *resp.JSON404)
 				case http.StatusInternalServerError:
-					actualMessage = resp.JSON500.Message
+					requireProblemCode(t, tt.expectedErrCode, *resp.JSON500)
 				}
-
-				require.Contains(t, *actualMessage, tt.expectedErrMessage, "ServeHTTP() body = %v, want %v", *actualMessage, tt.expectedErrMessage)
 			}
 		})
 	}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"slices"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// adminPermissionPath is the path of a request representative of the administration of the platform
+const adminPermissionPath = "/v2/admin/"
+
+// (GET /v2/templates/{name}/{version}/clusters)
+func (s *Server) GetV2TemplatesNameVersionClusters(ctx context.Context, request api.GetV2TemplatesNameVersionClustersRequestObject) (api.GetV2TemplatesNameVersionClustersResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	templateName := request.Name + "-" + request.Version
+	logger.FromContext(ctx).Debug("listing clusters of clusterTemplate", "namespace", activeProjectID, "name", templateName)
+
+	_, err := s.reader().Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Get(ctx, templateName, v1.GetOptions{})
+	switch {
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.TemplateNotFound, templateName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.GetV2TemplatesNameVersionClusters404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateGetFailed, templateName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2TemplatesNameVersionClusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	admin, err := s.administrates(ctx, activeProjectID, request.Params.Authorization)
+	if err != nil {
+		message := messages.New(messages.PermissionsFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "method", http.MethodGet, "path", adminPermissionPath)
+		return api.GetV2TemplatesNameVersionClusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// administrators get the counts of all projects, so the clusters of all namespaces are indexed
+	namespace := activeProjectID
+	if admin {
+		namespace = ""
+	}
+	index, err := s.templateClusterIndex(ctx, namespace)
+	if err != nil {
+		message := messages.New(messages.ClustersListFailed)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "error", err)
+		return api.GetV2TemplatesNameVersionClusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	clusters, err := index.ByIndex(k8s.ClusterTemplateIndex, k8s.ClusterIndexValue(activeProjectID, templateName))
+	if err != nil {
+		message := messages.New(messages.ClustersListFailed)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "error", err)
+		return api.GetV2TemplatesNameVersionClusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	usage := api.TemplateClusters{Clusters: make([]string, 0, len(clusters))}
+	for _, cluster := range clusters {
+		usage.Clusters = append(usage.Clusters, cluster.GetName())
+	}
+	slices.Sort(usage.Clusters)
+
+	if !admin {
+		return api.GetV2TemplatesNameVersionClusters200JSONResponse(usage), nil
+	}
+
+	all, err := index.ByIndex(k8s.ClusterTemplateNameIndex, templateName)
+	if err != nil {
+		message := messages.New(messages.ClustersListFailed)
+		logger.FromContext(ctx).Error(message.String(), "name", templateName, "error", err)
+		return api.GetV2TemplatesNameVersionClusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	counts := map[string]int{}
+	for _, cluster := range all {
+		counts[cluster.GetNamespace()]++
+	}
+	projects := make([]api.ProjectTemplateClusters, 0, len(counts))
+	for _, projectID := range slices.Sorted(maps.Keys(counts)) {
+		projects = append(projects, api.ProjectTemplateClusters{ProjectId: projectID, Clusters: counts[projectID]})
+	}
+	total := len(all)
+	usage.Projects = &projects
+	usage.TotalClusters = &total
+
+	return api.GetV2TemplatesNameVersionClusters200JSONResponse(usage), nil
+}
+
+// administrates returns whether the caller is allowed to administrate the platform; authenticators that do not
+// authorize the requests allow every operation, see GetV2AuthzSelf
+func (s *Server) administrates(ctx context.Context, projectID string, authHeader *string) (bool, error) {
+	authorizer, ok := s.auth.(Authorizer)
+	if !ok {
+		return true, nil
+	}
+
+	header := ""
+	if authHeader != nil {
+		header = *authHeader
+	}
+	err := authorizer.Authorize(ctx, header, projectID, http.MethodGet, adminPermissionPath)
+	if errors.Is(err, auth.ErrAuthorizationDenied) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// templateClusterIndex returns the index to look the clusters of a template up in: the cluster index if it is synced,
+// otherwise an index of the clusters listed in the given namespace, or in all namespaces if it is empty
+func (s *Server) templateClusterIndex(ctx context.Context, namespace string) (ClusterIndex, error) {
+	if s.clusterIndex != nil {
+		if check, ok := s.clusterIndex.(HealthCheck); !ok || check.Health() == nil {
+			return s.clusterIndex, nil
+		}
+	}
+
+	clusters, err := k8s.ListClusters(ctx, s.reader(), namespace, k8slabels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+	indexer, err := k8s.NewClusterIndexer(clusters)
+	if err != nil {
+		return nil, err
+	}
+	return indexer, nil
+}
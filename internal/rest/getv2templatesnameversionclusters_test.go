@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2TemplatesNameVersionClusters(t *testing.T) {
+	const otherProjectID = "7b3a1c2d-0e4f-4a5b-8c6d-9e0f1a2b3c4d"
+
+	templateCluster := func(t *testing.T, namespace, name, template string) unstructured.Unstructured {
+		cluster := nodePoolCluster(t)
+		cluster.SetNamespace(namespace)
+		cluster.SetName(name)
+		cluster.SetAnnotations(map[string]string{core.TemplateLabelKey: template})
+		return *cluster
+	}
+	clusters := func(t *testing.T) []unstructured.Unstructured {
+		return []unstructured.Unstructured{
+			templateCluster(t, activeProjectID, "edge-2", "baseline-v1.0.0"),
+			templateCluster(t, activeProjectID, "edge-1", "baseline-v1.0.0"),
+			templateCluster(t, activeProjectID, "edge-3", "baseline-v2.0.0"),
+			templateCluster(t, otherProjectID, "store-1", "baseline-v1.0.0"),
+		}
+	}
+
+	// mockTemplate returns a client that gets the template of the active project
+	mockTemplate := func(t *testing.T, err error) *k8s.MockInterface {
+		templates := k8s.NewMockResourceInterface(t)
+		if err != nil {
+			templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nil, err)
+		} else {
+			templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+		}
+		nsTemplates := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplates.EXPECT().Namespace(activeProjectID).Return(templates)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplates)
+		return mockedk8sclient
+	}
+
+	serve := func(t *testing.T, server *Server) *httptest.ResponseRecorder {
+		handler, err := server.ConfigureHandler()
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/v2/templates/baseline/v1.0.0/clusters", nil)
+		req.Header.Set("Activeprojectid", activeProjectID)
+		req.Header.Set("Authorization", "Bearer token")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("clusters of the project", func(t *testing.T) {
+		index, err := k8s.NewClusterIndexer(clusters(t))
+		require.NoError(t, err)
+
+		rr := serve(t, NewServer(mockTemplate(t, nil), WithClusterIndex(index), WithAuth(fakeAuthorizer{})))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		resp, err := api.ParseGetV2TemplatesNameVersionClustersResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, api.TemplateClusters{Clusters: []string{"edge-1", "edge-2"}}, *resp.JSON200)
+	})
+
+	t.Run("counts of all projects for administrators", func(t *testing.T) {
+		index, err := k8s.NewClusterIndexer(clusters(t))
+		require.NoError(t, err)
+		authorizer := fakeAuthorizer{allowed: map[string]bool{"GET /v2/admin/": true}}
+
+		rr := serve(t, NewServer(mockTemplate(t, nil), WithClusterIndex(index), WithAuth(authorizer)))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		resp, err := api.ParseGetV2TemplatesNameVersionClustersResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, api.TemplateClusters{
+			Clusters:      []string{"edge-1", "edge-2"},
+			TotalClusters: ptr(3),
+			Projects: &[]api.ProjectTemplateClusters{
+				{ProjectId: activeProjectID, Clusters: 2},
+				{ProjectId: otherProjectID, Clusters: 1},
+			},
+		}, *resp.JSON200)
+	})
+
+	t.Run("clusters of all namespaces are listed without an index", func(t *testing.T) {
+		mockedk8sclient := mockTemplate(t, nil)
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: clusters(t)}, nil)
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace("").Return(clusterResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		rr := serve(t, NewServer(mockedk8sclient))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		resp, err := api.ParseGetV2TemplatesNameVersionClustersResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, []string{"edge-1", "edge-2"}, resp.JSON200.Clusters)
+		require.Equal(t, ptr(3), resp.JSON200.TotalClusters)
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		notFound := k8serrors.NewNotFound(schema.GroupResource{Group: "edge-orchestrator.intel.com", Resource: "clustertemplates"}, "baseline-v1.0.0")
+
+		rr := serve(t, NewServer(mockTemplate(t, notFound)))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateNotFound, rr.Body.Bytes())
+	})
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// instance specific metadata that is dropped from exported objects
+var exportedMetadataExcludes = []string{"namespace", "uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "ownerReferences"}
+
+// (GET /v2/templates/{name}/{version}/export)
+func (s *Server) GetV2TemplatesNameVersionExport(ctx context.Context, request api.GetV2TemplatesNameVersionExportRequestObject) (api.GetV2TemplatesNameVersionExportResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	templateName := request.Name + "-" + request.Version
+	logger.FromContext(ctx).Debug("exporting clusterTemplate", "namespace", activeProjectID, "name", templateName)
+
+	unstructuredClusterTemplate, err := s.reader().Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Get(ctx, templateName, v1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		logger.FromContext(ctx).Error("clusterTemplate not found", "namespace", activeProjectID, "name", templateName)
+		return api.GetV2TemplatesNameVersionExport404JSONResponse{
+			N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, messages.New(messages.TemplateNotFound, templateName))),
+		}, nil
+	case err != nil:
+		logger.FromContext(ctx).Error("failed to get clusterTemplate", "namespace", activeProjectID, "name", templateName, "error", err)
+		return api.GetV2TemplatesNameVersionExport500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.TemplateGetFailed, templateName, err))),
+		}, nil
+	}
+
+	templateInfo, err := s.getTemplate(ctx, *unstructuredClusterTemplate)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get clusterTemplate from unstructuredClusterTemplate", "namespace", activeProjectID, "name", templateName, "error", err)
+		return api.GetV2TemplatesNameVersionExport500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.TemplateConvertFailed, err))),
+		}, nil
+	}
+
+	bundle := api.TemplateBundle{Template: *templateInfo}
+
+	clusterClass, err := s.exportClusterClass(ctx, activeProjectID, *unstructuredClusterTemplate)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get clusterClass of clusterTemplate", "namespace", activeProjectID, "name", templateName, "error", err)
+		return api.GetV2TemplatesNameVersionExport500JSONResponse{
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ClusterClassGetFailed, templateName))),
+		}, nil
+	}
+	if clusterClass != nil {
+		bundle.ClusterClass = &clusterClass
+	}
+
+	return api.GetV2TemplatesNameVersionExport200JSONResponse(bundle), nil
+}
+
+// exportClusterClass returns the ClusterClass referenced by the template without its instance specific metadata and
+// status, or nil if the template controller has not generated it yet
+func (s *Server) exportClusterClass(ctx context.Context, namespace string, item unstructured.Unstructured) (map[string]any, error) {
+	var clusterTemplate ct.ClusterTemplate
+	if err := convert.FromUnstructured(item, &clusterTemplate); err != nil {
+		return nil, err
+	}
+	ref := clusterTemplate.Status.ClusterClassRef
+	if ref == nil || ref.Name == "" {
+		return nil, nil
+	}
+
+	clusterClass, err := s.reader().Resource(core.ClusterClassResourceSchema).Namespace(namespace).Get(ctx, ref.Name, v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range exportedMetadataExcludes {
+		unstructured.RemoveNestedField(clusterClass.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(clusterClass.Object, "status")
+	return clusterClass.Object, nil
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2TemplatesNameVersionExport(t *testing.T) {
+	exportedTemplate := template1.DeepCopy()
+	exportedTemplate.Status.ClusterClassRef = &corev1.ObjectReference{Name: "test-template-v0.0.1"}
+
+	clusterClass := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "ClusterClass",
+		"metadata": map[string]any{
+			"name":            "test-template-v0.0.1",
+			"namespace":       expectedActiveProjectID,
+			"uid":             "2f1fb1bd-2a1b-4d3c-9d4c-6e1e0f0b1a2b",
+			"resourceVersion": "42",
+		},
+		"spec":   map[string]any{"controlPlane": map[string]any{"ref": map[string]any{"name": "test-template-v0.0.1"}}},
+		"status": map[string]any{"observedGeneration": int64(1)},
+	}}
+
+	newServer := func(t *testing.T, clusterClass *unstructured.Unstructured, clusterClassErr error) *Server {
+		unstructuredTemplate, err := convert.ToUnstructured(*exportedTemplate)
+		require.NoError(t, err)
+
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, "test-template-v0.0.1", v1.GetOptions{}).Return(unstructuredTemplate, nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+
+		classResource := k8s.NewMockResourceInterface(t)
+		classResource.EXPECT().Get(mock.Anything, "test-template-v0.0.1", v1.GetOptions{}).Return(clusterClass, clusterClassErr)
+		nsClassResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClassResource.EXPECT().Namespace(expectedActiveProjectID).Return(classResource)
+
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterClassResourceSchema).Return(nsClassResource)
+		return NewServer(mockedk8sclient)
+	}
+
+	serve := func(t *testing.T, server *Server, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/v2/templates/test-template/v0.0.1/export", nil)
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("exports the template and its cluster class", func(t *testing.T) {
+		rr := serve(t, newServer(t, clusterClass.DeepCopy(), nil), "")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp, err := api.ParseGetV2TemplatesNameVersionExportResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, templateInfo1, resp.JSON200.Template)
+		require.NotNil(t, resp.JSON200.ClusterClass)
+		require.Equal(t, map[string]any{
+			"apiVersion": "cluster.x-k8s.io/v1beta1",
+			"kind":       "ClusterClass",
+			"metadata":   map[string]any{"name": "test-template-v0.0.1"},
+			"spec":       map[string]any{"controlPlane": map[string]any{"ref": map[string]any{"name": "test-template-v0.0.1"}}},
+		}, *resp.JSON200.ClusterClass)
+	})
+
+	t.Run("exports as yaml", func(t *testing.T) {
+		rr := serve(t, newServer(t, clusterClass.DeepCopy(), nil), "application/yaml")
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+
+		var bundle api.TemplateBundle
+		require.NoError(t, yaml.Unmarshal(rr.Body.Bytes(), &bundle))
+		require.Equal(t, templateInfo1, bundle.Template)
+		require.NotNil(t, bundle.ClusterClass)
+	})
+
+	t.Run("cluster class not generated yet", func(t *testing.T) {
+		notFound := errors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusterclasses"}, "test-template-v0.0.1")
+		rr := serve(t, newServer(t, nil, notFound), "")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp, err := api.ParseGetV2TemplatesNameVersionExportResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, templateInfo1, resp.JSON200.Template)
+		require.Nil(t, resp.JSON200.ClusterClass)
+	})
+
+	t.Run("failed to get cluster class", func(t *testing.T) {
+		rr := serve(t, newServer(t, nil, errors.NewInternalError(fmt.Errorf("connection refused"))), "")
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		resp, err := api.ParseGetV2TemplatesNameVersionExportResponse(rr.Result())
+		require.NoError(t, err)
+		requireProblemCode(t, messages.ClusterClassGetFailed, *resp.JSON500)
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().Get(mock.Anything, "test-template-v0.0.1", v1.GetOptions{}).
+			Return(nil, errors.NewNotFound(schema.GroupResource{Group: core.TemplateResourceGroup, Resource: core.TemplateResourceKind}, "test-template-v0.0.1"))
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(expectedActiveProjectID).Return(resource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsResource)
+
+		rr := serve(t, NewServer(mockedk8sclient), "")
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		resp, err := api.ParseGetV2TemplatesNameVersionExportResponse(rr.Result())
+		require.NoError(t, err)
+		requireProblemCode(t, messages.TemplateNotFound, *resp.JSON404)
+	})
+}
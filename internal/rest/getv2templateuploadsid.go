@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/template-uploads/{id})
+func (s *Server) GetV2TemplateUploadsId(ctx context.Context, request api.GetV2TemplateUploadsIdRequestObject) (api.GetV2TemplateUploadsIdResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.uploads == nil {
+		return api.GetV2TemplateUploadsId501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.TemplateUploadsDisabled)))}, nil
+	}
+
+	session, err := s.uploads.Get(ctx, activeProjectID, request.Id.String())
+	switch {
+	case errors.Is(err, uploads.ErrSessionNotFound):
+		message := messages.New(messages.TemplateUploadNotFound, request.Id)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.GetV2TemplateUploadsId404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateUploadGetFailed, request.Id, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.GetV2TemplateUploadsId500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	return api.GetV2TemplateUploadsId200JSONResponse(toAPITemplateUpload(session)), nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2TemplateUploadsId(t *testing.T) {
+	t.Run("upload found", func(t *testing.T) {
+		store, session := startTemplateUpload(t, "name: test")
+		_, err := store.Append(context.Background(), activeProjectID, session.ID, 0, []byte("name"))
+		require.NoError(t, err)
+
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/template-uploads/"+session.ID, nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var upload api.TemplateUpload
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &upload))
+		require.Equal(t, session.ID, upload.Id.String())
+		require.EqualValues(t, 4, upload.Received)
+	})
+
+	t.Run("upload not found", func(t *testing.T) {
+		store, _ := startTemplateUpload(t, "name: test")
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/template-uploads/"+unknownTemplateUploadID, nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateUploadNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("uploads not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodGet, "/v2/template-uploads/"+unknownTemplateUploadID, nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (GET /v2/webhooks/destinations)
+func (s *Server) GetV2WebhooksDestinations(ctx context.Context, request api.GetV2WebhooksDestinationsRequestObject) (api.GetV2WebhooksDestinationsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.destinations == nil {
+		return api.GetV2WebhooksDestinations501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.WebhookDestinationsNotConfigured)))}, nil
+	}
+
+	destinations := []api.WebhookDestination{}
+	for _, d := range s.destinations.Destinations(activeProjectID) {
+		destination := api.WebhookDestination{
+			Host:                d.Host,
+			Port:                d.Port,
+			AllowPrivateNetwork: d.AllowPrivateNetwork,
+		}
+		if len(d.PinnedPublicKeys) > 0 {
+			destination.PinnedPublicKeys = ptr(slices.Clone(d.PinnedPublicKeys))
+		}
+		destinations = append(destinations, destination)
+	}
+	return api.GetV2WebhooksDestinations200JSONResponse{Destinations: &destinations}, nil
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/notification"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestGetV2WebhooksDestinations(t *testing.T) {
+	serve := func(t *testing.T, server *Server) *httptest.ResponseRecorder {
+		handler, err := server.ConfigureHandler()
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/v2/webhooks/destinations", nil)
+		req.Header.Set("Activeprojectid", activeProjectID)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("project destinations", func(t *testing.T) {
+		policy := notification.NewDestinationPolicy(notification.DestinationConfig{
+			Default: []notification.Destination{{Host: "hooks.example.com", Port: 443}},
+			Projects: map[string][]notification.Destination{
+				activeProjectID: {{Host: "*.example.org", Port: 8443, PinnedPublicKeys: []string{"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="}}},
+			},
+		})
+
+		rr := serve(t, NewServer(nil, WithWebhookDestinations(policy)))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp, err := api.ParseGetV2WebhooksDestinationsResponse(rr.Result())
+		require.NoError(t, err)
+		require.Equal(t, []api.WebhookDestination{{
+			Host:             "*.example.org",
+			Port:             8443,
+			PinnedPublicKeys: &[]string{"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		}}, *resp.JSON200.Destinations)
+	})
+
+	t.Run("no destinations", func(t *testing.T) {
+		rr := serve(t, NewServer(nil, WithWebhookDestinations(notification.NewDestinationPolicy(notification.DestinationConfig{}))))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp, err := api.ParseGetV2WebhooksDestinationsResponse(rr.Result())
+		require.NoError(t, err)
+		require.Empty(t, *resp.JSON200.Destinations)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		rr := serve(t, NewServer(nil))
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+
+		resp, err := api.ParseGetV2WebhooksDestinationsResponse(rr.Result())
+		require.NoError(t, err)
+		requireProblemCode(t, messages.WebhookDestinationsNotConfigured, *resp.JSON501)
+	})
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// publishCluster commits the manifests of the cluster and of the bindings of its hosts, if a control plane machine
+// template is given, to the GitOps repository of the project; it returns the error response if the cluster exists or
+// cannot be published and nil once it is published
+func (s *Server) publishCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, nodes []api.NodeSpec, labels map[string]string, dependsOn []string, reservedResources *ct.ReservedResources, cni *api.CNIOptions, variables map[string]interface{}, machineTemplateName string, reservedHosts []string, idempotent *idempotentRequest) api.PostV2ClustersResponseObject {
+	_, err := cli.GetCluster(ctx, namespace, clusterName)
+	switch {
+	case err == nil:
+		message := messages.New(messages.ClusterExists, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}
+	case !errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterGetFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}
+	}
+
+	objects, err := s.clusterManifests(ctx, cli, namespace, clusterName, template, nodes, labels, dependsOn, reservedResources, cni, variables, machineTemplateName, reservedHosts, idempotent)
+	if err == nil {
+		err = s.gitops.Publish(ctx, namespace, clusterName, objects...)
+	}
+	if err != nil {
+		message := messages.New(messages.ClusterPublishFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}
+	}
+
+	logger.FromContext(ctx).Info("Cluster published", "namespace", namespace, "name", clusterName, "objects", len(objects))
+	return nil
+}
+
+// clusterManifests returns the manifests of the cluster and of the bindings of its hosts as committed to the GitOps
+// repositories, without status and server-set metadata
+func (s *Server) clusterManifests(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, nodes []api.NodeSpec, labels map[string]string, dependsOn []string, reservedResources *ct.ReservedResources, cni *api.CNIOptions, variables map[string]interface{}, machineTemplateName string, reservedHosts []string, idempotent *idempotentRequest) ([]any, error) {
+	capiCluster, err := s.renderCluster(ctx, cli, namespace, clusterName, template, nodes, labels, dependsOn, reservedResources, cni, variables)
+	if err != nil {
+		return nil, err
+	}
+	cluster.SetReservedHosts(&capiCluster, reservedHosts)
+	idempotent.annotate(&capiCluster)
+	clusterObject, err := convert.ToUnstructured(capiCluster)
+	if err != nil {
+		return nil, err
+	}
+	objects := []any{manifest(clusterObject)}
+
+	if machineTemplateName == "" {
+		return objects, nil
+	}
+	bindings, err := renderBindings(cli, &capiCluster, machineTemplateName, nodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, binding := range bindings {
+		// the bindings cannot be owned by a cluster that does not exist yet, the GitOps controller prunes them with it
+		binding.OwnerReferences = nil
+		bindingObject, err := convert.ToUnstructured(binding)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, manifest(bindingObject))
+	}
+	return objects, nil
+}
+
+// manifest returns the object without its status and creation timestamp, which are set by the API server
+func manifest(obj *unstructured.Unstructured) map[string]interface{} {
+	unstructured.RemoveNestedField(obj.Object, "status")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	return obj.Object
+}
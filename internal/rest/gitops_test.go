@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/gitops"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+type fakeGitOps struct {
+	err       error
+	published map[string][]any
+	removed   []string
+}
+
+func (f *fakeGitOps) Publish(_ context.Context, projectID, clusterName string, objects ...any) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.published == nil {
+		f.published = map[string][]any{}
+	}
+	f.published[projectID+"/"+clusterName] = objects
+	return nil
+}
+
+func (f *fakeGitOps) Remove(_ context.Context, projectID, clusterName string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.removed = append(f.removed, projectID+"/"+clusterName)
+	return nil
+}
+
+func TestPostV2ClustersGitOpsExport(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	nodeID := "27b4e138-ea0b-11ef-8552-8b663d95bc01"
+	clustersResource := schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}
+
+	postCluster := func(t *testing.T, clusterResource *k8s.MockResourceInterface, publisher *fakeGitOps) *httptest.ResponseRecorder {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		// the cluster and its bindings are published instead of created
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal", GitopsMode: gitops.ModeExport}), WithGitOps(publisher))
+
+		clusterSpec := api.ClusterSpec{
+			Name:     ptr("example-cluster"),
+			Template: ptr(expectedTemplateName),
+			Nodes:    []api.NodeSpec{{Id: nodeID, Role: api.All}},
+		}
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("cluster is published instead of created", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(clustersResource, "example-cluster"))
+		publisher := &fakeGitOps{}
+
+		rr := postCluster(t, clusterResource, publisher)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		objects := publisher.published[expectedActiveProjectID+"/example-cluster"]
+		require.Len(t, objects, 2)
+		cluster := unstructured.Unstructured{Object: objects[0].(map[string]interface{})}
+		assert.Equal(t, "Cluster", cluster.GetKind())
+		assert.Equal(t, expectedActiveProjectID, cluster.GetNamespace())
+		assert.NotContains(t, cluster.Object, "status")
+		binding := unstructured.Unstructured{Object: objects[1].(map[string]interface{})}
+		assert.Equal(t, "IntelMachineBinding", binding.GetKind())
+		assert.Equal(t, "example-cluster-"+nodeID, binding.GetName())
+		assert.Empty(t, binding.GetOwnerReferences())
+	})
+
+	t.Run("cluster exists", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "cluster.x-k8s.io/v1beta1",
+			"kind":       "Cluster",
+			"metadata":   map[string]interface{}{"name": "example-cluster", "namespace": expectedActiveProjectID},
+		}}, nil)
+		publisher := &fakeGitOps{}
+
+		rr := postCluster(t, clusterResource, publisher)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterExists, rr.Body.Bytes())
+		assert.Empty(t, publisher.published)
+	})
+
+	t.Run("publishing fails", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(clustersResource, "example-cluster"))
+
+		rr := postCluster(t, clusterResource, &fakeGitOps{err: errors.New("push rejected")})
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterPublishFailed, rr.Body.Bytes())
+	})
+}
+
+func TestDeleteV2ClustersNameGitOps(t *testing.T) {
+	name := "example-cluster"
+	activeProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+	deleteCluster := func(t *testing.T, resource *k8s.MockResourceInterface, publisher *fakeGitOps) *httptest.ResponseRecorder {
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(activeProjectID).Return(resource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource)
+		server := NewServer(mockedk8sclient, WithGitOps(publisher))
+
+		req := httptest.NewRequest("DELETE", "/v2/clusters/"+name, nil)
+		req.Header.Set("Activeprojectid", activeProjectID)
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("manifests are removed before the cluster is deleted", func(t *testing.T) {
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		resource.EXPECT().Get(mock.Anything, name, metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+		resource.EXPECT().Delete(mock.Anything, name, metav1.DeleteOptions{}).Return(nil)
+		publisher := &fakeGitOps{}
+
+		rr := deleteCluster(t, resource, publisher)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		assert.Equal(t, []string{activeProjectID + "/" + name}, publisher.removed)
+	})
+
+	t.Run("failed removal keeps the cluster", func(t *testing.T) {
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
+		resource.EXPECT().Get(mock.Anything, name, metav1.GetOptions{}).Return(&unstructured.Unstructured{}, nil)
+
+		rr := deleteCluster(t, resource, &fakeGitOps{err: errors.New("push rejected")})
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterUnpublishFailed, rr.Body.Bytes())
+	})
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+)
+
+// idempotentRequest is a create request with an Idempotency-Key; the object it creates is annotated with the key and the
+// digest of the request body, so that a retry of the request returns the object created by the original request
+type idempotentRequest struct {
+	key    string
+	digest string
+}
+
+// newIdempotentRequest returns the idempotent request with the given key and body, nil if the request has no key
+func newIdempotentRequest(key *string, body any) *idempotentRequest {
+	if key == nil || *key == "" {
+		return nil
+	}
+	// the body was decoded from JSON, so it can be encoded again
+	data, _ := json.Marshal(body)
+	sum := sha256.Sum256(data)
+	return &idempotentRequest{key: *key, digest: hex.EncodeToString(sum[:])}
+}
+
+// annotate annotates the object the request creates with its key and digest; objects of requests without a key are
+// left alone
+func (r *idempotentRequest) annotate(obj metav1.Object) {
+	if r == nil {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[core.IdempotencyKeyAnnotationKey] = r.key
+	annotations[core.IdempotencyDigestAnnotationKey] = r.digest
+	obj.SetAnnotations(annotations)
+}
+
+// find returns the object of the given resource in the namespace matching the label selector that was created by the
+// original request, nil if the request was not processed yet; the error is an IdempotencyKeyReused message if the key was used for a different
+// request. The objects are listed from the API server rather than the read cache, which may not have observed an object
+// created by a request that is retried right away.
+func (r *idempotentRequest) find(ctx context.Context, cli dynamic.Interface, resource schema.GroupVersionResource, namespace, selector string) (*unstructured.Unstructured, error) {
+	list, err := cli.Resource(resource).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", resource.Resource, err)
+	}
+	for i := range list.Items {
+		annotations := list.Items[i].GetAnnotations()
+		if annotations[core.IdempotencyKeyAnnotationKey] != r.key {
+			continue
+		}
+		if annotations[core.IdempotencyDigestAnnotationKey] != r.digest {
+			return nil, messages.New(messages.IdempotencyKeyReused, r.key)
+		}
+		return &list.Items[i], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/naming"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// namingPolicy returns the naming policy of the cluster names of the project
+// Any name is allowed if no naming policies are configured
+func (s *Server) namingPolicy(namespace string) naming.Policy {
+	if s.naming == nil {
+		return naming.Policy{}
+	}
+	return s.naming.Policy(namespace)
+}
+
+// takenClusterNames returns the names taken in the project, by a cluster or by a pending cluster, which is created under
+// its name later; returns a message if they cannot be listed
+func (s *Server) takenClusterNames(ctx context.Context, namespace string) (map[string]bool, *messages.Message) {
+	taken := map[string]bool{}
+	clusters, err := fetchClustersList(ctx, s.reader(), namespace)
+	if err != nil {
+		message := messages.New(messages.ClustersListFailed)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "error", err)
+		return nil, &message
+	}
+	for _, cluster := range clusters {
+		taken[cluster.GetName()] = true
+	}
+	if s.pending != nil {
+		pcs, err := s.pending.List(ctx, namespace)
+		if err != nil {
+			message := messages.New(messages.PendingClustersListFailed, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+			return nil, &message
+		}
+		for _, pc := range pcs {
+			taken[pc.Name] = true
+		}
+	}
+	return taken, nil
+}
+
+// generateClusterName returns a name for a cluster created without a name that is not taken yet, generated with the
+// configured strategy and following the naming policy of the project
+func (s *Server) generateClusterName(ctx context.Context, namespace string, policy naming.Policy, nodes []api.NodeSpec, taken map[string]bool) (string, error) {
+	strategy := naming.Strategy(s.config.ClusterNameStrategy)
+	request := naming.Request{Now: time.Now(), Taken: func(name string) bool { return taken[name] }}
+	if strategy == naming.StrategySite && len(nodes) > 0 {
+		// clusters whose hosts have no site, or whose site cannot be looked up, are numbered like sequential names
+		site, err := s.inventory.HostSite(ctx, namespace, nodes[0].Id)
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to get host site", "node", nodes[0].Id, "error", err)
+		}
+		request.Site = site
+	}
+	return policy.GenerateName(strategy, request)
+}
+
+// toAPINamingPolicy converts a naming policy to its API representation
+func toAPINamingPolicy(policy naming.Policy) api.NamingPolicy {
+	apiPolicy := api.NamingPolicy{}
+	if policy.Prefix != "" {
+		apiPolicy.Prefix = ptr(policy.Prefix)
+	}
+	if policy.MaxLength > 0 {
+		apiPolicy.MaxLength = ptr(policy.MaxLength)
+	}
+	if len(policy.ForbiddenWords) > 0 {
+		apiPolicy.ForbiddenWords = ptr(policy.ForbiddenWords)
+	}
+	return apiPolicy
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// validateNodes returns the nodes of the cluster whose hosts cannot be used for it, with the reasons why: the hosts must
+// exist in the inventory of the project, must not be bound to other clusters and must have the minimum resources of the
+// template. Resources the hosts did not report (yet) are not checked.
+func (s *Server) validateNodes(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, nodes []api.NodeSpec) ([]api.NodeValidationFailure, error) {
+	// hosts are bound to the machines of the clusters by the Intel infra provider only
+	var hostClusters map[string]string
+	if api.TemplateInfoInfraprovidertype(template.Spec.InfraProviderType) == api.Intel {
+		var err error
+		if hostClusters, err = cli.HostClusters(ctx, namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	failures := []api.NodeValidationFailure{}
+	for _, node := range nodes {
+		var reasons []api.NodeValidationReason
+		resources, err := s.inventory.HostResources(ctx, namespace, node.Id)
+		switch {
+		case errors.Is(err, inventory.ErrHostNotFound):
+			reasons = append(reasons, api.NodeValidationReason{
+				Type:    api.HostNotFound,
+				Message: fmt.Sprintf("host %s does not exist in the inventory of the project", node.Id),
+			})
+		case err != nil:
+			return nil, fmt.Errorf("failed to get resources of host %s: %w", node.Id, err)
+		default:
+			reasons = append(reasons, insufficientResources(resources, template.Spec.MinNodeResources)...)
+		}
+		if other, ok := hostClusters[node.Id]; ok && other != clusterName {
+			reasons = append(reasons, api.NodeValidationReason{
+				Type:    api.HostBound,
+				Message: fmt.Sprintf("host %s is bound to cluster %s", node.Id, other),
+			})
+		}
+		if len(reasons) > 0 {
+			failures = append(failures, api.NodeValidationFailure{Id: node.Id, Reasons: reasons})
+		}
+	}
+	return failures, nil
+}
+
+// insufficientResources returns the reasons the given resources of a host fall short of the minimum resources of a
+// template; the minimum quantities are validated by the template webhook
+func insufficientResources(resources inventory.HostResources, minimum *ct.ResourceReservation) []api.NodeValidationReason {
+	if minimum == nil {
+		return nil
+	}
+
+	var reasons []api.NodeValidationReason
+	if q, err := resource.ParseQuantity(minimum.CPU); err == nil && resources.CPUCores > 0 && int64(resources.CPUCores)*1000 < q.MilliValue() {
+		reasons = append(reasons, api.NodeValidationReason{
+			Type:    api.InsufficientCpu,
+			Message: fmt.Sprintf("host has %d CPU cores, the template requires %s", resources.CPUCores, minimum.CPU),
+		})
+	}
+	if q, err := resource.ParseQuantity(minimum.Memory); err == nil && resources.MemoryBytes > 0 && resources.MemoryBytes < uint64(q.Value()) {
+		reasons = append(reasons, api.NodeValidationReason{
+			Type:    api.InsufficientMemory,
+			Message: fmt.Sprintf("host has %s of memory, the template requires %s", resource.NewQuantity(int64(resources.MemoryBytes), resource.BinarySI), minimum.Memory),
+		})
+	}
+	return reasons
+}
+
+// nodesUnusable returns the message of the nodes whose hosts cannot be used for the cluster
+func nodesUnusable(clusterName string, failures []api.NodeValidationFailure) messages.Message {
+	ids := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		ids = append(ids, failure.Id)
+	}
+	return messages.New(messages.NodesUnusable, len(failures), clusterName, strings.Join(ids, ", "))
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func init() {
+	// the request validator only decodes the bodies of registered media types
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
+}
+
+// (PATCH /v2/clusters/{name}/labels)
+func (s *Server) PatchV2ClustersNameLabels(ctx context.Context, request api.PatchV2ClustersNameLabelsRequestObject) (api.PatchV2ClustersNameLabelsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	clusterName := request.Name
+
+	if request.Body == nil || request.Body.Labels == nil {
+		message := messages.New(messages.ClusterLabelsMissing)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PatchV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// removed labels are validated with an empty value
+	patch := request.Body.Labels
+	values := make(map[string]string, len(patch))
+	for key, value := range patch {
+		values[key] = ""
+		if value != nil {
+			values[key] = *value
+		}
+	}
+	if !labels.Valid(values) {
+		message := messages.New(messages.InvalidClusterLabelKeys)
+		logger.FromContext(ctx).Warn(message.String(), "labels", values)
+		return api.PatchV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	if system := labels.SystemLabels(values); len(system) > 0 {
+		keys := make([]string, 0, len(system))
+		for key := range system {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		message := messages.New(messages.SystemClusterLabelKeys, strings.Join(keys, ", "))
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "name", clusterName)
+		return api.PatchV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the version may be quoted as an entity tag, "*" matches any version
+	resourceVersion := ""
+	if ifMatch := request.Params.IfMatch; ifMatch != nil && *ifMatch != "*" {
+		resourceVersion = strings.Trim(strings.TrimPrefix(*ifMatch, "W/"), `"`)
+	}
+
+	updated, err := k8s.New(s.k8sclient).PatchClusterLabels(ctx, activeProjectID, clusterName, patch, resourceVersion)
+	switch {
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.ClusterNotFound, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PatchV2ClustersNameLabels404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsConflict(err):
+		message := messages.New(messages.ClusterModified, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "error", err)
+		return api.PatchV2ClustersNameLabels409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsBadRequest(err), k8serrors.IsInvalid(err):
+		message := messages.New(messages.ClusterInvalid, clusterName, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PatchV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterUpdateFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PatchV2ClustersNameLabels500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("Cluster labels patched", "namespace", activeProjectID, "name", clusterName, "labels", patch, "resourceVersion", updated.GetResourceVersion())
+	return api.PatchV2ClustersNameLabels200JSONResponse{
+		Labels:          labels.UserLabels(updated.GetLabels()),
+		ResourceVersion: updated.GetResourceVersion(),
+	}, nil
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPatchV2ClustersNameLabels(t *testing.T) {
+	clustersResource := schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}
+	labeledCluster := func() *unstructured.Unstructured {
+		cluster := &unstructured.Unstructured{}
+		cluster.SetName("example-cluster")
+		cluster.SetNamespace(activeProjectID)
+		cluster.SetResourceVersion("5")
+		cluster.SetLabels(map[string]string{
+			"team":                                   "edge",
+			"stage":                                  "beta",
+			"edge-orchestrator.intel.com/project-id": activeProjectID,
+		})
+		return cluster
+	}
+	patch := map[string]*string{"team": ptr("core"), "stage": nil, "site": ptr("fra1")}
+
+	tests := []struct {
+		name             string
+		patch            map[string]*string
+		ifMatch          string
+		getErr           error
+		updateErrs       []error
+		expectedStatus   int
+		expectedCode     messages.Code
+		expectedLabels   map[string]string
+		expectedUpdates  int
+		expectedResponse *api.VersionedClusterLabels
+	}{
+		{
+			name:            "labels are merged",
+			patch:           patch,
+			expectedStatus:  http.StatusOK,
+			expectedUpdates: 1,
+			expectedLabels:  map[string]string{"team": "core", "site": "fra1", "edge-orchestrator.intel.com/project-id": activeProjectID},
+			expectedResponse: &api.VersionedClusterLabels{
+				Labels:          map[string]string{"team": "core", "site": "fra1"},
+				ResourceVersion: "6",
+			},
+		},
+		{
+			name:            "labels are merged into the version of If-Match",
+			patch:           patch,
+			ifMatch:         `"5"`,
+			expectedStatus:  http.StatusOK,
+			expectedUpdates: 1,
+			expectedLabels:  map[string]string{"team": "core", "site": "fra1", "edge-orchestrator.intel.com/project-id": activeProjectID},
+		},
+		{
+			name:            "concurrent modification is retried without If-Match",
+			patch:           patch,
+			updateErrs:      []error{k8serrors.NewConflict(clustersResource, "example-cluster", nil)},
+			expectedStatus:  http.StatusOK,
+			expectedUpdates: 2,
+			expectedLabels:  map[string]string{"team": "core", "site": "fra1", "edge-orchestrator.intel.com/project-id": activeProjectID},
+		},
+		{
+			name:           "cluster modified since the version of If-Match",
+			patch:          patch,
+			ifMatch:        `"4"`,
+			expectedStatus: http.StatusConflict,
+			expectedCode:   messages.ClusterModified,
+		},
+		{
+			name:            "concurrent modification with If-Match",
+			patch:           patch,
+			ifMatch:         "5",
+			updateErrs:      []error{k8serrors.NewConflict(clustersResource, "example-cluster", nil)},
+			expectedStatus:  http.StatusConflict,
+			expectedCode:    messages.ClusterModified,
+			expectedUpdates: 1,
+		},
+		{
+			name:           "system labels cannot be patched",
+			patch:          map[string]*string{"edge-orchestrator.intel.com/project-id": nil},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   messages.SystemClusterLabelKeys,
+		},
+		{
+			name:           "invalid label key",
+			patch:          map[string]*string{"-team": ptr("core")},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   messages.InvalidClusterLabelKeys,
+		},
+		{
+			name:           "cluster not found",
+			patch:          patch,
+			getErr:         k8serrors.NewNotFound(clustersResource, "example-cluster"),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   messages.ClusterNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *unstructured.Unstructured
+			updates := 0
+			resource := k8s.NewMockResourceInterface(t)
+			resource.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).RunAndReturn(
+				func(context.Context, string, v1.GetOptions, ...string) (*unstructured.Unstructured, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return labeledCluster(), nil
+				}).Maybe()
+			resource.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+				func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+					updates++
+					if updates <= len(tt.updateErrs) {
+						return nil, tt.updateErrs[updates-1]
+					}
+					updated = obj.DeepCopy()
+					updated.SetResourceVersion("6")
+					return updated, nil
+				}).Maybe()
+			nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+			nsResource.EXPECT().Namespace(activeProjectID).Return(resource).Maybe()
+			mockedk8sclient := k8s.NewMockInterface(t)
+			mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsResource).Maybe()
+
+			handler, err := NewServer(mockedk8sclient).ConfigureHandler()
+			require.Nil(t, err)
+
+			body, err := json.Marshal(api.ClusterLabelsPatch{Labels: tt.patch})
+			require.NoError(t, err)
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPatch, "/v2/clusters/example-cluster/labels", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			req.Header.Set("Activeprojectid", activeProjectID)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+			require.Equal(t, tt.expectedUpdates, updates)
+			if tt.expectedStatus != http.StatusOK {
+				requireCode(t, tt.expectedCode, rr.Body.Bytes())
+				return
+			}
+
+			require.Equal(t, tt.expectedLabels, updated.GetLabels())
+			if tt.expectedResponse != nil {
+				var response api.VersionedClusterLabels
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				require.Equal(t, *tt.expectedResponse, response)
+			}
+		})
+	}
+}
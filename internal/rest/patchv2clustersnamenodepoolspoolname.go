@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (PATCH /v2/clusters/{name}/nodepools/{poolName})
+func (s *Server) PatchV2ClustersNameNodepoolsPoolName(ctx context.Context, request api.PatchV2ClustersNameNodepoolsPoolNameRequestObject) (api.PatchV2ClustersNameNodepoolsPoolNameResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if request.Body == nil {
+		message := messages.New(messages.NodePoolUpdateMissing)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PatchV2ClustersNameNodepoolsPoolName400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	update := *request.Body
+
+	if message := validateNodePool(update.Labels, update.Taints); message != nil {
+		logger.FromContext(ctx).Warn(message.String(), "labels", update.Labels, "taints", update.Taints)
+		return api.PatchV2ClustersNameNodepoolsPoolName400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, *message))}, nil
+	}
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PatchV2ClustersNameNodepoolsPoolName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	var nodePool api.NodePool
+	err := cli.UpdateClusterWorkers(ctx, activeProjectID, request.Name, func(workers *capi.WorkersTopology) error {
+		for i := range workers.MachineDeployments {
+			md := &workers.MachineDeployments[i]
+			if md.Class != common.WorkerClass || md.Name != request.PoolName {
+				continue
+			}
+
+			if update.Replicas != nil {
+				// added nodes must fit into the quota of the project
+				current := int32(0)
+				if md.Replicas != nil {
+					current = *md.Replicas
+				}
+				if added := *update.Replicas - current; added > 0 {
+					if err := s.checkQuota(ctx, activeProjectID, multitenancy.QuotaRequest{Nodes: int(added)}); err != nil {
+						return err
+					}
+				}
+
+				replicas := *update.Replicas
+				md.Replicas = &replicas
+			}
+			if update.Labels != nil {
+				if err := setNodePoolLabels(md, *update.Labels); err != nil {
+					return err
+				}
+			}
+			if update.Taints != nil {
+				if err := setNodePoolTaints(md, *update.Taints); err != nil {
+					return err
+				}
+			}
+
+			var err error
+			nodePool, err = nodePoolFromTopology(*md)
+			return err
+		}
+		return errNodePoolNotFound
+	})
+	switch {
+	case errors.Is(err, multitenancy.ErrQuotaExceeded):
+		message := messages.New(messages.QuotaExceeded, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "cluster", request.Name)
+		return api.PatchV2ClustersNameNodepoolsPoolName403JSONResponse{N403ForbiddenJSONResponse: api.N403ForbiddenJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, errNodePoolNotFound):
+		message := messages.New(messages.NodePoolNotFound, request.PoolName, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PatchV2ClustersNameNodepoolsPoolName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PatchV2ClustersNameNodepoolsPoolName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsBadRequest(err), k8serrors.IsInvalid(err):
+		message := messages.New(messages.NodePoolUpdateInvalid, request.PoolName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PatchV2ClustersNameNodepoolsPoolName400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.NodePoolUpdateFailed, request.PoolName, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PatchV2ClustersNameNodepoolsPoolName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("Node pool updated", "namespace", activeProjectID, "cluster", request.Name, "name", request.PoolName)
+	return api.PatchV2ClustersNameNodepoolsPoolName200JSONResponse(nodePool), nil
+}
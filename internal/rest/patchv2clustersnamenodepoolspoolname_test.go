@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPatchV2ClustersNameNodepoolsPoolName(t *testing.T) {
+	t.Run("replicas and taints are updated, labels are kept", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t, gpuNodePool()), nil)
+		clusters.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				var cluster capi.Cluster
+				require.NoError(t, convert.FromUnstructured(*obj, &cluster))
+				md := cluster.Spec.Topology.Workers.MachineDeployments[0]
+				require.Equal(t, int32(4), *md.Replicas)
+				require.JSONEq(t, `["accelerator=gpu"]`, string(md.Variables.Overrides[0].Value.Raw))
+				require.JSONEq(t, `[]`, string(md.Variables.Overrides[1].Value.Raw))
+				return obj, nil
+			})
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: clusters},
+			http.MethodPatch, "/v2/clusters/example-cluster/nodepools/gpu-workers", api.NodePoolUpdate{
+				Replicas: ptr(int32(4)),
+				Taints:   &[]api.NodeTaint{},
+			})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var nodePool api.NodePool
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &nodePool))
+		require.Equal(t, "gpu-workers", nodePool.Name)
+		require.Equal(t, int32(4), nodePool.Replicas)
+		require.Equal(t, map[string]string{"accelerator": "gpu"}, *nodePool.Labels)
+		require.Empty(t, *nodePool.Taints)
+	})
+
+	t.Run("added replicas exceed the project quota", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t, gpuNodePool()), nil)
+		quotas := &fakeQuotas{err: fmt.Errorf("%w: the project is limited to 4 nodes and already has 3, 2 requested", multitenancy.ErrQuotaExceeded)}
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: clusters},
+			http.MethodPatch, "/v2/clusters/example-cluster/nodepools/gpu-workers", api.NodePoolUpdate{Replicas: ptr(int32(4))}, WithQuotas(quotas))
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		requireCode(t, messages.QuotaExceeded, rr.Body.Bytes())
+		require.Equal(t, []multitenancy.QuotaRequest{{Nodes: 2}}, quotas.requests)
+	})
+
+	t.Run("node pool not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t, gpuNodePool()), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: clusters},
+			http.MethodPatch, "/v2/clusters/example-cluster/nodepools/cpu-workers", api.NodePoolUpdate{Replicas: ptr(int32(1))})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("invalid taint keys", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPatch, "/v2/clusters/example-cluster/nodepools/gpu-workers", api.NodePoolUpdate{
+			Taints: &[]api.NodeTaint{{Key: "invalid key!", Effect: api.NoSchedule}},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.InvalidNodePoolTaintKeys, rr.Body.Bytes())
+	})
+}
@@ -4,43 +4,109 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"log/slog"
+	"maps"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
 	controlplaneprovider "github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
 	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/gitops"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	templates "github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 // (POST /v2/clusters)
 func (s *Server) PostV2Clusters(ctx context.Context, request api.PostV2ClustersRequestObject) (api.PostV2ClustersResponseObject, error) {
 	namespace := request.Params.Activeprojectid.String()
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
 
-	// validate nodes (only single node clusters are supported)
+	// a retry of a request with an Idempotency-Key returns the pending cluster scheduled by the original request until
+	// it is provisioned, its cluster is created with the same key
+	idempotent := newIdempotentRequest(request.Params.IdempotencyKey, request.Body)
+	if idempotent != nil && !dryRun && s.pending != nil {
+		existing, response := s.findIdempotent(ctx, idempotent, core.ConfigMapResourceSchema, namespace, scheduling.PendingClusterLabelKey)
+		if response != nil {
+			return response, nil
+		}
+		if existing != nil {
+			pc, err := s.pending.Get(ctx, namespace, existing.GetLabels()[scheduling.PendingClusterLabelKey])
+			switch {
+			case err == nil:
+				logger.FromContext(ctx).Info("cluster already scheduled with the idempotency key", "namespace", namespace, "name", pc.Name, "idempotencyKey", idempotent.key)
+				return api.PostV2Clusters202JSONResponse(toAPIPendingCluster(pc)), nil
+			case !errors.Is(err, scheduling.ErrPendingClusterNotFound):
+				message := messages.New(messages.IdempotencyCheckFailed, idempotent.key, err)
+				logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+				return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+			}
+			// the pending cluster was provisioned since, its cluster is found below
+		}
+	}
+
+	return s.postCluster(ctx, request, idempotent)
+}
+
+// postCluster creates the cluster requested with the given idempotent request, nil if the request has no
+// Idempotency-Key
+func (s *Server) postCluster(ctx context.Context, request api.PostV2ClustersRequestObject, idempotent *idempotentRequest) (api.PostV2ClustersResponseObject, error) {
+	namespace := request.Params.Activeprojectid.String()
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	// a retry of a request with an Idempotency-Key returns the cluster created by the original request, the cluster
+	// would not pass the quota check again and its generated name would differ
+	if idempotent != nil && !dryRun {
+		existing, response := s.findIdempotent(ctx, idempotent, core.ClusterResourceSchema, namespace, "")
+		if response != nil {
+			return response, nil
+		}
+		if existing != nil {
+			logger.FromContext(ctx).Info("cluster already created with the idempotency key", "namespace", namespace, "name", existing.GetName(), "idempotencyKey", idempotent.key)
+			return api.PostV2Clusters201JSONResponse(fmt.Sprintf("successfully created cluster %s", existing.GetName())), nil
+		}
+	}
+
+	// validate nodes (the nodes of a new cluster form its control plane, worker nodes are added through node pools once
+	// the cluster exists)
 	nodes := request.Body.Nodes
-	if nodes == nil {
-		msg := "nodes are required"
-		slog.Error(msg)
-		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &msg}}, nil
+	if len(nodes) == 0 {
+		message := messages.New(messages.NodesRequired)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
-	if len(nodes) != 1 {
-		msg := fmt.Sprintf("only single node clusters are supported, got %d nodes", len(nodes))
-		slog.Warn(msg)
-		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &msg}}, nil
+	if message := validateControlPlaneNodes(nodes, request.Body.ControlPlaneReplicas); message != nil {
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, *message))}, nil
 	}
 
 	var userLabels map[string]string
@@ -50,90 +116,556 @@ func (s *Server) PostV2Clusters(ctx context.Context, request api.PostV2ClustersR
 		userLabels = *request.Body.Labels
 	}
 
-	// cluster name is optional, if not provided we generate one
+	// cluster name is optional, if not provided we generate one that is not taken yet following the naming policy of the
+	// project
 	var clusterName string
+	policy := s.namingPolicy(namespace)
 	if request.Body.Name == nil || *request.Body.Name == "" {
-		clusterName = fmt.Sprintf("cluster-%v", time.Now().Unix())
-		slog.Info("cluster name not provided, generating one", "name", clusterName)
+		taken, message := s.takenClusterNames(ctx, namespace)
+		if message != nil {
+			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, *message))}, nil
+		}
+		name, err := s.generateClusterName(ctx, namespace, policy, nodes, taken)
+		if err != nil {
+			message := messages.New(messages.ClusterNameNotGenerated, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+		clusterName = name
+		logger.FromContext(ctx).Info("cluster name not provided, generating one", "name", clusterName)
 	} else {
 		clusterName = *request.Body.Name
+		if violations := policy.Violations(clusterName); len(violations) > 0 {
+			message := messages.New(messages.ClusterNamePolicyViolated, clusterName, strings.Join(violations, "; "))
+			logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
 	}
 
 	// create k8s client
 	cli := k8s.New(s.k8sclient)
 	if cli == nil {
-		msg := "failed to create k8s client"
-		slog.Error(msg)
-		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &msg}}, nil
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
 	// fetch cluster template
 	template, err := fetchTemplate(ctx, cli, namespace, request.Body.Template)
-	if err != nil {
-		// Check if it's a template not found error - return 400 Bad Request.
-		if k8serrors.IsNotFound(err) || errors.Is(err, k8s.ErrDefaultTemplateNotFound) {
-			msg := fmt.Sprintf("template not found: %v", err)
-			slog.Error(msg)
-			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &msg}}, nil
+	var notPublished messages.Message
+	switch {
+	// a missing template is a bad request
+	case k8serrors.IsNotFound(err), errors.Is(err, k8s.ErrDefaultTemplateNotFound):
+		message := messages.New(messages.DefaultTemplateNotFound)
+		if request.Body.Template != nil && *request.Body.Template != "" {
+			message = messages.New(messages.TemplateNotFound, *request.Body.Template)
+		}
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	// deprecated templates are kept for existing clusters only, which conflicts with creating new ones
+	case errors.As(err, &notPublished) && notPublished.Code == messages.TemplateDeprecated:
+		logger.FromContext(ctx).Warn(notPublished.String())
+		return api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, notPublished))}, nil
+	// draft templates cannot be used to create clusters
+	case errors.As(err, &notPublished):
+		logger.FromContext(ctx).Warn(notPublished.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, notPublished))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterCreateFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the control plane provider of the template must support the roles of the nodes
+	for _, node := range nodes {
+		if err := controlplaneprovider.ValidateNodeRole(template.Spec.ControlPlaneProviderType, string(node.Role)); err != nil {
+			message := messages.New(messages.NodeRoleNotSupported, node.Id, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// the clusters of single-node templates run their workloads on their only node, a control plane node
+	if template.Spec.SingleNode != nil && (len(nodes) != 1 || nodes[0].Role == api.Worker) {
+		message := messages.New(messages.SingleNodeOnly, template.Name, len(nodes))
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// validate the control plane size against the template's control plane provider
+	if err := controlplaneprovider.ValidateControlPlaneReplicas(template.Spec.ControlPlaneProviderType, int32(len(nodes))); err != nil {
+		message := messages.New(messages.ControlPlaneSizeUnsupported, err)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// clusters can only override the resources reserved by the template, the kubelet arguments of templates without
+	// reservations cannot be patched
+	reservedResources := templates.FromAPIReservedResources(request.Body.ReservedResources)
+	if reservedResources != nil {
+		if template.Spec.ReservedResources == nil {
+			message := messages.New(messages.ReservedResourcesNotSupported, template.Name)
+			logger.FromContext(ctx).Warn(message.String())
+			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+		violation := controlplaneprovider.ValidateReservedResources(reservedResources)
+		if err := s.rules.Check(validation.ReservedResources, violation, "namespace", namespace, "name", clusterName); err != nil {
+			message := messages.New(messages.InvalidReservedResources, err)
+			logger.FromContext(ctx).Warn(message.String())
+			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// clusters may override the pod and service CIDR blocks of the template; templates created before the networks were
+	// reserved may still overlap them, as may the overrides
+	template.Spec.ClusterNetwork = overrideClusterNetwork(template.Spec.ClusterNetwork, request.Body.ClusterNetwork)
+	violation := s.reserved.Check(&template.Spec.ClusterNetwork)
+	if err := s.rules.Check(validation.ClusterNetwork, violation, "namespace", namespace, "name", clusterName); err != nil {
+		message := messages.New(messages.ClusterNetworkReserved, template.Name, err)
+		if request.Body.ClusterNetwork != nil {
+			message = messages.New(messages.InvalidClusterNetwork, err)
 		}
-		msg := fmt.Sprintf("failed to create cluster: %v", err)
-		slog.Error(msg)
-		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &msg}}, nil
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the CNI options are set in the k3s configuration of the control plane nodes
+	if request.Body.Cni != nil && template.Spec.ControlPlaneProviderType != "k3s" {
+		message := messages.New(messages.CNIOptionsNotSupported, template.Name)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
-	// fetch host from inventory to check for trusted compute
-	trustedCompute, err := s.inventory.GetHostTrustedCompute(ctx, namespace, nodes[0].Id)
+	// the values of the variables of the template are validated before the cluster is created, the topology of a cluster
+	// with invalid values would not be reconciled; the variables the cluster does not set take their default
+	var variableValues map[string]interface{}
+	if request.Body.Variables != nil {
+		variableValues = *request.Body.Variables
+	}
+	variables, err := common.ResolveVariableValues(template.Spec.Variables, variableValues)
 	if err != nil {
-		slog.Warn("failed to get host trusted compute", "error", err)
+		message := messages.New(messages.InvalidClusterVariables, template.Name, err)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the hosts of clusters requesting image preflight pull the images of the template first, the cluster is kept as a
+	// pending cluster until then; dry runs render the cluster as if it was created now
+	if !dryRun && request.Body.ImagePreflight != nil && *request.Body.ImagePreflight {
+		return s.preflightCluster(ctx, cli, namespace, clusterName, template, *request.Body, idempotent)
+	}
+
+	// clusters requested for a later time are kept as pending clusters until then, the quota of the project and the
+	// dependencies of the cluster are checked when it is provisioned
+	if !dryRun && request.Body.ProvisionAt != nil && request.Body.ProvisionAt.After(time.Now()) {
+		return s.scheduleCluster(ctx, cli, namespace, clusterName, *request.Body, nil, idempotent)
+	}
+
+	// the cluster must fit into the quota of the project
+	err = s.checkQuota(ctx, namespace, multitenancy.QuotaRequest{Clusters: 1, Nodes: len(nodes), ControlPlaneReplicas: len(nodes)})
+	switch {
+	case errors.Is(err, multitenancy.ErrQuotaExceeded):
+		message := messages.New(messages.QuotaExceeded, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters403JSONResponse{N403ForbiddenJSONResponse: api.N403ForbiddenJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.QuotaCheckFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the clusters this cluster depends on must exist
+	var dependsOn []string
+	if request.Body.DependsOn != nil {
+		dependsOn = *request.Body.DependsOn
+	}
+	if err := validateDependencies(ctx, cli, namespace, clusterName, dependsOn); err != nil {
+		var invalid messages.Message
+		if errors.As(err, &invalid) {
+			logger.FromContext(ctx).Warn(invalid.String(), "namespace", namespace)
+			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, invalid))}, nil
+		}
+		message := messages.New(messages.DependencyCheckFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the hosts of the nodes must exist in the inventory of the project, must not be bound to other clusters and must
+	// have the minimum resources of the template
+	if s.config.ValidateNodes && inventoryHosts(template) {
+		failures, err := s.validateNodes(ctx, cli, namespace, clusterName, template, nodes)
+		if err != nil {
+			message := messages.New(messages.NodesCheckFailed, clusterName, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+		if len(failures) > 0 {
+			message := nodesUnusable(clusterName, failures)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+			details := problem(ctx, message)
+			return api.PostV2Clusters422JSONResponse{Code: details.Code, Message: details.Message, Nodes: failures}, nil
+		}
+	}
+
+	// the pod and service CIDR blocks overriding the ones of the template must not overlap the networks of the clusters
+	// with hosts on the same sites, the sites route between the hosts of the clusters
+	if request.Body.ClusterNetwork != nil && inventoryHosts(template) {
+		if err := s.checkSiteNetworks(ctx, cli, namespace, clusterName, nodes, &template.Spec.ClusterNetwork); err != nil {
+			var conflict messages.Message
+			if errors.As(err, &conflict) {
+				logger.FromContext(ctx).Warn(conflict.String(), "namespace", namespace)
+				return api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, conflict))}, nil
+			}
+			message := messages.New(messages.ClusterNetworkCheckFailed, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// fetch hosts from inventory to check for trusted compute, the cluster is trusted compute compatible only if all hosts
+	// have secure boot and full disk encryption enabled and passed the attestation of their measured boot
+	trustedCompute := true
+	var unattested []string
+	hosts := nodes
+	if !inventoryHosts(template) {
+		// virtual machines are neither measured nor attested
+		trustedCompute, hosts = false, nil
+	}
+	for _, node := range hosts {
+		trusted, err := s.inventory.GetHostTrustedCompute(ctx, namespace, node.Id)
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to get host trusted compute", "node", node.Id, "error", err)
+		}
+		attested, err := s.inventory.IsAttested(ctx, namespace, node.Id)
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to get host attestation status", "node", node.Id, "error", err)
+		}
+		if !attested {
+			unattested = append(unattested, node.Id)
+		}
+		trustedCompute = trustedCompute && trusted && attested
+	}
+
+	// trusted compute workloads must not be placed onto unattested hosts
+	violation = nil
+	if template.Spec.RequireTrustedCompute && len(unattested) > 0 {
+		violation = fmt.Errorf("hosts %s are not attested", strings.Join(unattested, ", "))
+	}
+	if err := s.rules.Check(validation.TrustedCompute, violation, "namespace", namespace, "name", clusterName); err != nil {
+		message := messages.New(messages.HostsNotAttested, template.Name, strings.Join(unattested, ", "))
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
 	// merge user labels with template and system labels
-	clusterLabels := labels.Merge(userLabels, template.Spec.ClusterLabels, map[string]string{
-		fmt.Sprintf("%s/clustername", labels.PlatformPrefix): clusterName,
-		fmt.Sprintf("%s/project-id", labels.PlatformPrefix):  namespace,
-		labels.PrometheusMetricsUrlLabelKey:                  fmt.Sprintf("%s.%s", labels.PrometheusMetricsSubdomain, s.config.ClusterDomain),
-		labels.TrustedComputeLabelKey:                        strconv.FormatBool(trustedCompute),
-	})
+	clusterLabels := labels.Merge(userLabels, template.Spec.ClusterLabels, s.systemClusterLabels(namespace, clusterName, trustedCompute))
 
 	// validate cluster labels against k8s label format
 	if !labels.Valid(clusterLabels) {
-		msg := "invalid cluster labels"
-		slog.Error(msg, "labels", clusterLabels)
-		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &msg}}, nil
+		message := messages.New(messages.InvalidClusterLabels)
+		logger.FromContext(ctx).Error(message.String(), "labels", clusterLabels)
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// hosts are bound to the control plane machines when the Intel infra provider is used
+	bindNodes := api.TemplateInfoInfraprovidertype(template.Spec.InfraProviderType) == api.Intel
+	var controlPlaneMachineTemplate string
+	if bindNodes {
+		controlPlaneMachineTemplate, err = machineTemplateName(template.Name, template.Status.ControlPlaneMachineTemplateRef)
+		if err != nil {
+			message := messages.New(messages.MachineBindingsFailed, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	reservedResources = cluster.MergeReservedResources(template.Spec.ReservedResources, reservedResources)
+	if dryRun {
+		return s.dryRunCluster(ctx, cli, namespace, clusterName, template, nodes, clusterLabels, dependsOn, reservedResources, request.Body.Cni, variables, controlPlaneMachineTemplate)
+	}
+
+	// the hosts are reserved in the inventory before they are bound, so that concurrent creates cannot bind the same
+	// hosts; the hosts reserved by this request are released again if the cluster cannot be created, all hosts of the
+	// cluster once it is removed
+	var hostIds, reserved []string
+	if bindNodes {
+		for _, node := range nodes {
+			hostIds = append(hostIds, node.Id)
+		}
+		reserved, err = s.inventory.ReserveHosts(ctx, namespace, clusterName, hostIds)
+		switch {
+		case errors.Is(err, inventory.ErrHostReserved):
+			message := messages.New(messages.HostsNotReservable, clusterName, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+			return api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+		case errors.Is(err, inventory.ErrHostNotFound):
+			message := messages.New(messages.HostsNotReservable, clusterName, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+			return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		case err != nil:
+			message := messages.New(messages.HostsReservationFailed, clusterName, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// the manifests are committed to the GitOps repository of the project before the cluster is created, the repository
+	// is the source of truth of the GitOps controller applying them
+	if s.gitops != nil {
+		if response := s.publishCluster(ctx, cli, namespace, clusterName, template, nodes, clusterLabels, dependsOn, reservedResources, request.Body.Cni, variables, controlPlaneMachineTemplate, hostIds, idempotent); response != nil {
+			s.releaseHosts(ctx, namespace, clusterName, reserved)
+			return response, nil
+		}
+		if s.config.GitopsMode == gitops.ModeExport {
+			s.recordOperation(ctx, namespace, operations.Create, clusterName, template.Name)
+			return api.PostV2Clusters201JSONResponse(fmt.Sprintf("successfully published cluster %s", clusterName)), nil
+		}
 	}
 
 	// create cluster
-	slog.Debug("creating cluster", "namespace", namespace)
-	createdClusterName, err := s.createCluster(ctx, cli, namespace, clusterName, template, nodes, clusterLabels)
+	logger.FromContext(ctx).Debug("creating cluster", "namespace", namespace)
+	createdClusterName, err := s.createCluster(ctx, cli, namespace, clusterName, template, nodes, clusterLabels, dependsOn, reservedResources, request.Body.Cni, variables, hostIds, idempotent)
 	if err != nil {
-		slog.Error("failed to create cluster", "namespace", namespace, "name", clusterName, "error", err)
+		logger.FromContext(ctx).Error("failed to create cluster", "namespace", namespace, "name", clusterName, "error", err)
+		s.releaseHosts(ctx, namespace, clusterName, reserved)
 		return api.PostV2Clusters500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(fmt.Sprintf("failed to create cluster: %v", err)),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.ClusterCreateFailed, err))),
 		}, nil
 	}
 
-	// create machine binding for Intel infra provider
-	if api.TemplateInfoInfraprovidertype(template.Spec.InfraProviderType) == api.Intel {
-		err := createBindings(ctx, cli, namespace, clusterName, template.Name, nodes)
+	// create machine binding for Intel infra provider; a cluster whose hosts cannot be bound is deleted again, its hosts
+	// stay reserved until it is removed and are then released with the other reserved hosts of removed clusters
+	if bindNodes {
+		err := createBindings(ctx, cli, namespace, clusterName, controlPlaneMachineTemplate, nodes)
 		if err != nil {
-			msg := fmt.Sprintf("failed to create machine bindings: %v", err)
-			slog.Error(msg)
-			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &msg}}, nil
+			message := messages.New(messages.MachineBindingsFailed, err)
+			logger.FromContext(ctx).Error(message.String())
+			if err := cli.DeleteCluster(ctx, namespace, createdClusterName); err != nil && !k8serrors.IsNotFound(err) {
+				logger.FromContext(ctx).Warn("failed to delete the cluster whose hosts could not be bound", "namespace", namespace, "name", createdClusterName, "error", err)
+			}
+			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 		}
 	}
 
-	slog.Info("Cluster created", "namespace", namespace, "name", createdClusterName)
+	s.recordOperation(ctx, namespace, operations.Create, createdClusterName, template.Name)
+
+	logger.FromContext(ctx).Info("Cluster created", "namespace", namespace, "name", createdClusterName)
 	return api.PostV2Clusters201JSONResponse(fmt.Sprintf("successfully created cluster %s", createdClusterName)), nil
 }
 
+// releaseHosts releases the given hosts reserved for a cluster that could not be created; the hosts that cannot be
+// released stay reserved for the name of the cluster
+func (s *Server) releaseHosts(ctx context.Context, namespace, clusterName string, hosts []string) {
+	if len(hosts) == 0 {
+		return
+	}
+	if err := s.inventory.ReleaseHosts(ctx, namespace, clusterName, hosts); err != nil {
+		logger.FromContext(ctx).Warn("failed to release the hosts of cluster", "namespace", namespace, "name", clusterName, "hosts", hosts, "error", err)
+	}
+}
+
+// dryRunCluster renders the cluster and the bindings of its hosts, if a control plane machine template is given, and
+// returns them instead of creating them
+func (s *Server) dryRunCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, nodes []api.NodeSpec, labels map[string]string, dependsOn []string, reservedResources *ct.ReservedResources, cni *api.CNIOptions, variables map[string]interface{}, machineTemplateName string) (api.PostV2ClustersResponseObject, error) {
+	_, err := cli.GetCluster(ctx, namespace, clusterName)
+	switch {
+	case err == nil:
+		message := messages.New(messages.ClusterExists, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case !errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterGetFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	capiCluster, err := s.renderCluster(ctx, cli, namespace, clusterName, template, nodes, labels, dependsOn, reservedResources, cni, variables)
+	if err != nil {
+		message := messages.New(messages.ClusterCreateFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "name", clusterName)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	clusterObject, err := convert.ToUnstructured(capiCluster)
+	if err != nil {
+		message := messages.New(messages.ClusterCreateFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "name", clusterName)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	response := api.ClusterDryRun{Cluster: clusterObject.Object, Bindings: []map[string]interface{}{}}
+	if machineTemplateName != "" {
+		bindings, err := renderBindings(cli, &capiCluster, machineTemplateName, nodes)
+		if err != nil {
+			message := messages.New(messages.MachineBindingsFailed, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "name", clusterName)
+			return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+		for _, binding := range bindings {
+			bindingObject, err := convert.ToUnstructured(binding)
+			if err != nil {
+				message := messages.New(messages.MachineBindingsFailed, err)
+				logger.FromContext(ctx).Error(message.String(), "namespace", namespace, "name", clusterName)
+				return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+			}
+			response.Bindings = append(response.Bindings, bindingObject.Object)
+		}
+	}
+
+	logger.FromContext(ctx).Info("Cluster dry run", "namespace", namespace, "name", clusterName, "bindings", len(response.Bindings))
+	return api.PostV2Clusters200JSONResponse(response), nil
+}
+
+// preflightCluster stores the spec as a pending cluster whose hosts pull the images of the air-gapped template before
+// it is provisioned
+func (s *Server) preflightCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, spec api.ClusterSpec, idempotent *idempotentRequest) (api.PostV2ClustersResponseObject, error) {
+	if s.pending == nil || !s.pending.PreflightEnabled() {
+		message := messages.New(messages.ImagePreflightDisabled)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	if template.Spec.AirGap == nil || len(template.Spec.AirGap.Images) == 0 {
+		message := messages.New(messages.ImagePreflightNoImages, template.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	return s.scheduleCluster(ctx, cli, namespace, clusterName, spec, template.Spec.AirGap.Images, idempotent)
+}
+
+// scheduleCluster stores the spec as a pending cluster to be provisioned at its provisionAt time, or as soon as its
+// hosts pulled the given images if there are any; the pending cluster is annotated with the Idempotency-Key of the
+// request if it has one
+func (s *Server) scheduleCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName string, spec api.ClusterSpec, images []string, idempotent *idempotentRequest) (api.PostV2ClustersResponseObject, error) {
+	if s.pending == nil {
+		message := messages.New(messages.ProvisionAtRequiresDeferred)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	_, err := cli.GetCluster(ctx, namespace, clusterName)
+	switch {
+	case err == nil:
+		message := messages.New(messages.ClusterExists, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case !errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterGetFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	spec.Name = &clusterName
+	var options []func(*scheduling.PendingCluster)
+	if idempotent != nil {
+		options = append(options, scheduling.WithIdempotency(idempotent.key, idempotent.digest))
+	}
+	var pc scheduling.PendingCluster
+	if len(images) > 0 {
+		provisionAt := time.Now()
+		if spec.ProvisionAt != nil {
+			provisionAt = *spec.ProvisionAt
+		}
+		pc, err = s.pending.SchedulePreflight(ctx, namespace, spec, provisionAt, images, options...)
+	} else {
+		pc, err = s.pending.Schedule(ctx, namespace, spec, *spec.ProvisionAt, options...)
+	}
+	switch {
+	case errors.Is(err, scheduling.ErrPendingClusterExists):
+		message := messages.New(messages.PendingClusterExists, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.PendingClusterScheduleFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("Cluster scheduled", "namespace", namespace, "name", clusterName, "provisionAt", pc.ProvisionAt, "state", pc.State)
+	return api.PostV2Clusters202JSONResponse(toAPIPendingCluster(pc)), nil
+}
+
+// ProvisionCluster creates the cluster of a pending cluster of the project, see scheduling.Provisioner; the cluster of a
+// pending cluster created by a request with an Idempotency-Key is annotated with the key and digest of that request
+func (s *Server) ProvisionCluster(ctx context.Context, projectID string, spec api.ClusterSpec, idempotency *scheduling.Idempotency) error {
+	id, err := uuid.Parse(projectID)
+	if err != nil {
+		return fmt.Errorf("invalid project id %s: %w", projectID, err)
+	}
+
+	var idempotent *idempotentRequest
+	if idempotency != nil {
+		idempotent = &idempotentRequest{key: idempotency.Key, digest: idempotency.Digest}
+	}
+
+	spec.ProvisionAt, spec.ImagePreflight = nil, nil
+	response, err := s.postCluster(ctx, api.PostV2ClustersRequestObject{Params: api.PostV2ClustersParams{Activeprojectid: id}, Body: &spec}, idempotent)
+	if err != nil {
+		return err
+	}
+
+	var message *string
+	switch r := response.(type) {
+	case api.PostV2Clusters201JSONResponse:
+		return nil
+	case api.PostV2Clusters400JSONResponse:
+		message = r.Message
+	case api.PostV2Clusters403JSONResponse:
+		message = r.Message
+	case api.PostV2Clusters409JSONResponse:
+		message = r.Message
+	case api.PostV2Clusters500JSONResponse:
+		message = r.Message
+	}
+	if message == nil {
+		return fmt.Errorf("failed to create cluster: unexpected response %T", response)
+	}
+	return errors.New(*message)
+}
+
+// findIdempotent returns the object of the given resource created by the original request of the idempotent request,
+// or the response to the request if the key was used for a different request or the objects can not be listed
+func (s *Server) findIdempotent(ctx context.Context, idempotent *idempotentRequest, resource schema.GroupVersionResource, namespace, selector string) (*unstructured.Unstructured, api.PostV2ClustersResponseObject) {
+	existing, err := idempotent.find(ctx, s.k8sclient, resource, namespace, selector)
+	var reused messages.Message
+	switch {
+	case errors.As(err, &reused):
+		logger.FromContext(ctx).Warn(reused.String(), "namespace", namespace)
+		return nil, api.PostV2Clusters409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, reused))}
+	case err != nil:
+		message := messages.New(messages.IdempotencyCheckFailed, idempotent.key, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return nil, api.PostV2Clusters500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}
+	}
+	return existing, nil
+}
+
+// validateControlPlaneNodes checks that the nodes form a control plane of the requested size
+func validateControlPlaneNodes(nodes []api.NodeSpec, controlPlaneReplicas *int32) *messages.Message {
+	ids := map[string]bool{}
+	for _, node := range nodes {
+		if node.Role == api.Worker {
+			message := messages.New(messages.WorkerNodesRequireNodePool, node.Id)
+			return &message
+		}
+		if ids[node.Id] {
+			message := messages.New(messages.DuplicateNode, node.Id)
+			return &message
+		}
+		ids[node.Id] = true
+	}
+
+	if controlPlaneReplicas != nil && int(*controlPlaneReplicas) != len(nodes) {
+		message := messages.New(messages.ControlPlaneReplicasMismatch, *controlPlaneReplicas, len(nodes))
+		return &message
+	}
+	return nil
+}
+
 func fetchTemplate(ctx context.Context, cli *k8s.Client, namespace string, templateName *string) (ct.ClusterTemplate, error) {
 	// template name is optional, if not provided we use default
 	var template ct.ClusterTemplate
 	var err error
 	if templateName == nil || *templateName == "" {
-		slog.Info("template name not provided, using default template")
+		logger.FromContext(ctx).Info("template name not provided, using default template")
 		if template, err = cli.DefaultTemplate(ctx, namespace); err != nil {
 			return ct.ClusterTemplate{}, err
 		}
@@ -143,21 +675,58 @@ func fetchTemplate(ctx context.Context, cli *k8s.Client, namespace string, templ
 		}
 	}
 
+	switch state := template.LifecycleState(); state {
+	case ct.TemplatePublished:
+	case ct.TemplateDeprecated:
+		return ct.ClusterTemplate{}, messages.New(messages.TemplateDeprecated, template.Name)
+	default:
+		return ct.ClusterTemplate{}, messages.New(messages.TemplateNotPublished, template.Name, state)
+	}
+
 	if !template.Status.Ready || template.Status.ClusterClassRef == nil {
 		return ct.ClusterTemplate{}, fmt.Errorf("template %s is not ready", template.Name)
 	}
 	return template, nil
 }
 
-func (s *Server) createCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, nodes []api.NodeSpec, labels map[string]string) (string, error) {
-	slog.Debug("creating cluster", "namespace", namespace, "name", clusterName, "nodes", nodes, "labels", labels)
+// createCluster creates the Cluster API cluster of the template, see renderCluster; the given hosts reserved for the
+// cluster are recorded on it, so that they are released once it is removed
+func (s *Server) createCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, nodes []api.NodeSpec, labels map[string]string, dependsOn []string, reservedResources *ct.ReservedResources, cni *api.CNIOptions, variables map[string]interface{}, reservedHosts []string, idempotent *idempotentRequest) (string, error) {
+	logger.FromContext(ctx).Debug("creating cluster", "namespace", namespace, "name", clusterName, "nodes", nodes, "labels", labels)
 
-	// Assumes single node cluster for now, so we can use the first node's ID for air-gap installation check
-	// TODO: This will need to change when we support multi-node clusters
-	enableReadOnly, err := s.enableReadOnlyInstall(ctx, cli, namespace, clusterName, nodes[0].Id, template)
+	capiCluster, err := s.renderCluster(ctx, cli, namespace, clusterName, template, nodes, labels, dependsOn, reservedResources, cni, variables)
 	if err != nil {
 		return "", err
 	}
+	cluster.SetReservedHosts(&capiCluster, reservedHosts)
+	idempotent.annotate(&capiCluster)
+	newClusterName, err := cli.CreateCluster(ctx, namespace, capiCluster)
+	if err != nil {
+		return "", err
+	}
+	return newClusterName, nil
+}
+
+// renderCluster returns the Cluster API cluster of the template with the given nodes, labels, dependencies, reserved
+// resources, CNI options and values of the template variables
+func (s *Server) renderCluster(ctx context.Context, cli *k8s.Client, namespace, clusterName string, template ct.ClusterTemplate, nodes []api.NodeSpec, labels map[string]string, dependsOn []string, reservedResources *ct.ReservedResources, cni *api.CNIOptions, templateVariables map[string]interface{}) (capi.Cluster, error) {
+	// read-only install is a cluster wide setting, so all control plane nodes must agree on it; the virtual machines
+	// cloned for the nodes are not immutable
+	var enableReadOnly bool
+	hosts := nodes
+	if !inventoryHosts(template) {
+		hosts = nil
+	}
+	for i, node := range hosts {
+		readOnly, err := s.enableReadOnlyInstall(ctx, cli, namespace, clusterName, node.Id, template)
+		if err != nil {
+			return capi.Cluster{}, err
+		}
+		if i > 0 && readOnly != enableReadOnly {
+			return capi.Cluster{}, fmt.Errorf("cluster %s cannot mix hosts with and without read-only install", clusterName)
+		}
+		enableReadOnly = readOnly
+	}
 
 	var variables []capi.ClusterVariable
 	if enableReadOnly {
@@ -168,10 +737,24 @@ func (s *Server) createCluster(ctx context.Context, cli *k8s.Client, namespace,
 			},
 		})
 	}
+	if cni != nil && cni.FlannelBackend != nil {
+		variables = append(variables, capi.ClusterVariable{
+			Name: controlplaneprovider.FlannelBackend,
+			Value: apiextensionsv1.JSON{
+				Raw: []byte(strconv.Quote(string(*cni.FlannelBackend))),
+			},
+		})
+	}
+	for _, name := range slices.Sorted(maps.Keys(templateVariables)) {
+		raw, err := json.Marshal(templateVariables[name])
+		if err != nil {
+			return capi.Cluster{}, fmt.Errorf("failed to marshal variable %s: %w", name, err)
+		}
+		variables = append(variables, capi.ClusterVariable{Name: name, Value: apiextensionsv1.JSON{Raw: raw}})
+	}
 
-	// create cluster
 	replicas := int32(len(nodes))
-	cluster := capi.Cluster{
+	capiCluster := capi.Cluster{
 		TypeMeta: v1.TypeMeta{
 			APIVersion: core.ClusterResourceSchema.GroupVersion().String(),
 			Kind:       "Cluster",
@@ -197,20 +780,70 @@ func (s *Server) createCluster(ctx context.Context, cli *k8s.Client, namespace,
 			Paused: true,
 		},
 	}
+	cluster.SetDependencies(&capiCluster, dependsOn)
+	cluster.SetReservedResources(&capiCluster, reservedResources)
+	return capiCluster, nil
+}
 
-	newClusterName, err := cli.CreateCluster(ctx, namespace, cluster)
-	if err != nil {
-		return "", err
+// systemClusterLabels returns the system labels of the cluster with the given name in the given namespace
+func (s *Server) systemClusterLabels(namespace, clusterName string, trustedCompute bool) map[string]string {
+	return map[string]string{
+		fmt.Sprintf("%s/clustername", labels.PlatformPrefix): clusterName,
+		fmt.Sprintf("%s/project-id", labels.PlatformPrefix):  namespace,
+		labels.PrometheusMetricsUrlLabelKey:                  fmt.Sprintf("%s.%s", labels.PrometheusMetricsSubdomain, s.config.ClusterDomain),
+		labels.TrustedComputeLabelKey:                        strconv.FormatBool(trustedCompute),
 	}
-	return newClusterName, nil
 }
 
-func createBindings(ctx context.Context, cli *k8s.Client, namespace, clusterName, templateName string, nodes []api.NodeSpec) error {
+// validateDependencies checks that the clusters the new cluster depends on exist and are not being deleted
+func validateDependencies(ctx context.Context, cli *k8s.Client, namespace, clusterName string, dependsOn []string) error {
+	seen := map[string]bool{}
+	for _, dependency := range dependsOn {
+		switch {
+		case dependency == clusterName:
+			return messages.New(messages.DependencyOnItself, clusterName)
+		case seen[dependency]:
+			return messages.New(messages.DuplicateDependency, dependency)
+		}
+		seen[dependency] = true
+
+		dependencyCluster, err := cli.GetCluster(ctx, namespace, dependency)
+		switch {
+		case errors.Is(err, k8s.ErrClusterNotFound):
+			return messages.New(messages.DependencyNotFound, dependency)
+		case err != nil:
+			return fmt.Errorf("failed to get cluster '%s': %w", dependency, err)
+		case dependencyCluster.DeletionTimestamp != nil:
+			return messages.New(messages.DependencyDeleting, dependency)
+		}
+	}
+	return nil
+}
+
+// createBindings binds the given hosts to the machines created from the given IntelMachineTemplate
+func createBindings(ctx context.Context, cli *k8s.Client, namespace, clusterName, machineTemplateName string, nodes []api.NodeSpec) error {
 	cluster, err := cli.GetCluster(ctx, namespace, clusterName)
 	if err != nil {
 		return err
 	}
 
+	bindings, err := renderBindings(cli, cluster, machineTemplateName, nodes)
+	if err != nil {
+		return err
+	}
+	for _, binding := range bindings {
+		if err := cli.CreateMachineBinding(ctx, namespace, binding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderBindings returns the bindings of the given hosts to the machines of the cluster created from the given
+// IntelMachineTemplate, owned by the cluster
+func renderBindings(cli *k8s.Client, cluster *capi.Cluster, machineTemplateName string, nodes []api.NodeSpec) ([]intelv1alpha1.IntelMachineBinding, error) {
+	namespace, clusterName := cluster.Namespace, cluster.Name
+	bindings := make([]intelv1alpha1.IntelMachineBinding, 0, len(nodes))
 	for _, nodes := range nodes {
 		binding := intelv1alpha1.IntelMachineBinding{
 			TypeMeta: v1.TypeMeta{
@@ -224,22 +857,34 @@ func createBindings(ctx context.Context, cli *k8s.Client, namespace, clusterName
 			Spec: intelv1alpha1.IntelMachineBindingSpec{
 				NodeGUID:                 nodes.Id,
 				ClusterName:              clusterName,
-				IntelMachineTemplateName: fmt.Sprintf("%s-controlplane", templateName),
+				IntelMachineTemplateName: machineTemplateName,
 			},
 		}
 
 		// Set owner reference to the cluster for garbage collection
-		err = controllerutil.SetOwnerReference(cluster, &binding, cli.Scheme)
-		if err != nil {
-			return err
+		if err := controllerutil.SetOwnerReference(cluster, &binding, cli.Scheme); err != nil {
+			return nil, err
 		}
+		bindings = append(bindings, binding)
+	}
+	return bindings, nil
+}
 
-		err := cli.CreateMachineBinding(ctx, namespace, binding)
-		if err != nil {
-			return err
-		}
+// inventoryHosts reports whether the nodes of the clusters of the given template are hosts of the inventory; the virtual
+// machines of the vSphere infra provider are cloned from a template when the cluster is provisioned instead
+func inventoryHosts(template ct.ClusterTemplate) bool {
+	return api.TemplateInfoInfraprovidertype(template.Spec.InfraProviderType) != api.Vsphere
+}
+
+var errMachineTemplateNotRendered = errors.New("machine template is not rendered")
+
+// machineTemplateName returns the name of the machine template the cluster template references in its status; the
+// template controller sets the references from the rendered ClusterClass, so the name does not follow any convention
+func machineTemplateName(templateName string, ref *corev1.ObjectReference) (string, error) {
+	if ref == nil || ref.Name == "" {
+		return "", fmt.Errorf("%w for template %s", errMachineTemplateNotRendered, templateName)
 	}
-	return nil
+	return ref.Name, nil
 }
 
 func (s *Server) enableReadOnlyInstall(ctx context.Context, cli *k8s.Client, namespace, clusterName, nodeUuid string, template ct.ClusterTemplate) (bool, error) {
@@ -263,22 +908,112 @@ func (s *Server) enableReadOnlyInstall(ctx context.Context, cli *k8s.Client, nam
 		if s.config.DisableInventory {
 			// This is specifically used in integration tests. However, when the inventory is enabled, the decision to
 			// airgap mode or not is made based on the OS type of the host, as determined by the inventory service.
-			slog.Debug("enable air gap by default for k3s, when inventory is disabled", "namespace", namespace, "name", clusterName, "node", nodeUuid)
+			logger.FromContext(ctx).Debug("enable air gap by default for k3s, when inventory is disabled", "namespace", namespace, "name", clusterName, "node", nodeUuid)
 			return true, nil
 		}
 		enableReadOnly, err := s.inventory.IsImmutable(ctx, namespace, nodeUuid)
 		if err != nil {
 			return false, fmt.Errorf("failed to determine read-only install for cluster %s, node: %s: %w", clusterName, nodeUuid, err)
 		}
-		slog.Debug("enable read-only install", "namespace", namespace, "name", clusterName, "node", nodeUuid, "immutable", enableReadOnly, "controlPlaneProviderType", clusterTemplate.Spec.ControlPlaneProviderType)
+		logger.FromContext(ctx).Debug("enable read-only install", "namespace", namespace, "name", clusterName, "node", nodeUuid, "immutable", enableReadOnly, "controlPlaneProviderType", clusterTemplate.Spec.ControlPlaneProviderType)
 		return enableReadOnly, nil
 	}
 
 	// Default case: air-gap installation not required
-	slog.Debug("read-only install is not required", "namespace", namespace, "name", clusterName, "node", nodeUuid, "controlPlaneProviderType", clusterTemplate.Spec.ControlPlaneProviderType)
+	logger.FromContext(ctx).Debug("read-only install is not required", "namespace", namespace, "name", clusterName, "node", nodeUuid, "controlPlaneProviderType", clusterTemplate.Spec.ControlPlaneProviderType)
 	return false, nil
 }
 
+// overrideClusterNetwork returns the cluster network of the template with the pod and service CIDR blocks of the
+// cluster, if given
+func overrideClusterNetwork(clusterNetwork ct.ClusterNetwork, override *api.ClusterNetwork) ct.ClusterNetwork {
+	if override == nil {
+		return clusterNetwork
+	}
+	if override.Pods != nil {
+		clusterNetwork.Pods = &ct.NetworkRanges{CIDRBlocks: override.Pods.CidrBlocks}
+	}
+	if override.Services != nil {
+		clusterNetwork.Services = &ct.NetworkRanges{CIDRBlocks: override.Services.CidrBlocks}
+	}
+	return clusterNetwork
+}
+
+// checkSiteNetworks checks that the cluster network does not overlap the networks of the other clusters of the
+// namespace with hosts on the sites of the given nodes; returns a message if it does. Only the hosts bound to the
+// clusters by the Intel infra provider are known.
+func (s *Server) checkSiteNetworks(ctx context.Context, cli *k8s.Client, namespace, clusterName string, nodes []api.NodeSpec, clusterNetwork *ct.ClusterNetwork) error {
+	sites := map[string]bool{}
+	requested := map[string]bool{}
+	for _, node := range nodes {
+		requested[node.Id] = true
+		site, err := s.inventory.HostSite(ctx, namespace, node.Id)
+		if err != nil {
+			return fmt.Errorf("failed to get site of host %s: %w", node.Id, err)
+		}
+		if site != "" {
+			sites[site] = true
+		}
+	}
+	if len(sites) == 0 {
+		return nil
+	}
+
+	hostClusters, err := cli.HostClusters(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	hostIds := slices.Sorted(maps.Keys(hostClusters))
+	checked := map[string]bool{clusterName: true}
+	var conflicts []error
+	for _, hostId := range hostIds {
+		otherName := hostClusters[hostId]
+		if requested[hostId] || checked[otherName] {
+			continue
+		}
+		// hosts removed from the inventory are not on any site anymore
+		site, err := s.inventory.HostSite(ctx, namespace, hostId)
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to get host site", "node", hostId, "cluster", otherName, "error", err)
+			continue
+		}
+		if !sites[site] {
+			continue
+		}
+		checked[otherName] = true
+
+		other, err := cli.GetCluster(ctx, namespace, otherName)
+		switch {
+		case errors.Is(err, k8s.ErrClusterNotFound):
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to get cluster '%s': %w", otherName, err)
+		}
+		if err := network.Overlaps(clusterNetwork, fromCAPIClusterNetwork(other.Spec.ClusterNetwork), otherName); err != nil {
+			conflicts = append(conflicts, err)
+		}
+	}
+	if len(conflicts) > 0 {
+		return messages.New(messages.ClusterNetworkConflict, errors.Join(conflicts...))
+	}
+	return nil
+}
+
+// fromCAPIClusterNetwork returns the pod and service CIDR blocks of the network of a Cluster API cluster
+func fromCAPIClusterNetwork(capiNetwork *capi.ClusterNetwork) *ct.ClusterNetwork {
+	if capiNetwork == nil {
+		return nil
+	}
+	clusterNetwork := &ct.ClusterNetwork{}
+	if capiNetwork.Pods != nil {
+		clusterNetwork.Pods = &ct.NetworkRanges{CIDRBlocks: capiNetwork.Pods.CIDRBlocks}
+	}
+	if capiNetwork.Services != nil {
+		clusterNetwork.Services = &ct.NetworkRanges{CIDRBlocks: capiNetwork.Services.CIDRBlocks}
+	}
+	return clusterNetwork
+}
+
 func convertClusterNetwork(network *ct.ClusterNetwork) *capi.ClusterNetwork {
 	pods := &capi.NetworkRanges{}
 	services := &capi.NetworkRanges{}
@@ -6,20 +6,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
 	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
@@ -27,7 +31,15 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/naming"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
@@ -193,7 +205,8 @@ func TestPostV2Clusters201(t *testing.T) {
 					"test2":                                   "true",
 				},
 				Annotations: map[string]string{
-					"edge-orchestrator.intel.com/template": "baseline-k3s",
+					"edge-orchestrator.intel.com/template":       "baseline-k3s",
+					"edge-orchestrator.intel.com/reserved-hosts": expectedNodeid,
 				},
 			},
 			Spec: capi.ClusterSpec{
@@ -275,6 +288,9 @@ func TestPostV2Clusters201(t *testing.T) {
 				ClusterClassRef: &corev1.ObjectReference{
 					Name: "example-cluster-class",
 				},
+				ControlPlaneMachineTemplateRef: &corev1.ObjectReference{
+					Name: expectedIntelMachineTemplateName,
+				},
 			},
 		}
 		unstructuredTemplate, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&expectedTemplate)
@@ -569,6 +585,8 @@ func TestPostV2Clusters201NoNameNoTemplate(t *testing.T) {
 	nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
 	nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
 	clusterResource := k8s.NewMockResourceInterface(t)
+	// the clusters are listed for the names taken in the project when the name of the cluster is generated
+	clusterResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil)
 	clusterResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).Return(unstructuredCluster, nil)
 	nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
 	nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
@@ -688,8 +706,7 @@ func TestPostV2Clusters500(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		expectedResponse := `{"message":"failed to create cluster: template baseline-kubeadm is not ready"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterCreateFailed, rr.Body.Bytes())
 	})
 
 	t.Run("ClusterClassRefNil", func(t *testing.T) {
@@ -768,8 +785,7 @@ func TestPostV2Clusters500(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		expectedResponse := `{"message":"failed to create cluster: template baseline-kubeadm is not ready"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterCreateFailed, rr.Body.Bytes())
 	})
 
 	t.Run("Failed to Get Cluster Template", func(t *testing.T) {
@@ -822,8 +838,7 @@ func TestPostV2Clusters500(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		expectedResponse := `{"message":"failed to create cluster: failed to get cluster template"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ClusterCreateFailed, rr.Body.Bytes())
 	})
 
 	t.Run("Failed to create Binding", func(t *testing.T) {
@@ -859,7 +874,8 @@ func TestPostV2Clusters500(t *testing.T) {
 					"default-extension":                       "restricted",
 				},
 				Annotations: map[string]string{
-					"edge-orchestrator.intel.com/template": "baseline-k3s",
+					"edge-orchestrator.intel.com/template":       "baseline-k3s",
+					"edge-orchestrator.intel.com/reserved-hosts": expectedNodeid,
 				},
 			},
 			Spec: capi.ClusterSpec{
@@ -941,6 +957,9 @@ func TestPostV2Clusters500(t *testing.T) {
 				ClusterClassRef: &corev1.ObjectReference{
 					Name: "example-cluster-class",
 				},
+				ControlPlaneMachineTemplateRef: &corev1.ObjectReference{
+					Name: expectedIntelMachineTemplateName,
+				},
 			},
 		}
 		unstructuredTemplate, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&expectedTemplate)
@@ -963,6 +982,9 @@ func TestPostV2Clusters500(t *testing.T) {
 		bindingResource := k8s.NewMockResourceInterface(t)
 		bindingResource.EXPECT().Create(mock.Anything, &unstructured.Unstructured{Object: unstructuredBinding}, metav1.CreateOptions{}).Return(nil, &expectedError)
 
+		// the cluster whose host cannot be bound is deleted again, its host is released once it is removed
+		clusterResource.EXPECT().Delete(mock.Anything, expectedClusterName, mock.Anything).Return(nil)
+
 		// Create a mock namespaceable resource interface for clusters
 		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
 		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
@@ -1044,8 +1066,7 @@ func TestPostV2Clusters400(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
-		expectedResponse := `{"message":"no active project id provided"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.ActiveProjectIDMissing, rr.Body.Bytes())
 	})
 
 	t.Run("Missing Fields", func(t *testing.T) {
@@ -1071,8 +1092,8 @@ func TestPostV2Clusters400(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
-		expectedResponse := `{"message":"request body has an error: doesn't match schema #/components/schemas/ClusterSpec: Error at \"/nodes\": property \"nodes\" is missing"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.InvalidRequest, rr.Body.Bytes())
+		assert.Contains(t, rr.Body.String(), "doesn't match schema #/components/schemas/ClusterSpec")
 	})
 	t.Run("Create Cluster with Invalid JSON", func(t *testing.T) {
 		// Prepare test data
@@ -1097,8 +1118,8 @@ func TestPostV2Clusters400(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
-		expectedResponse := `{"message":"request body has an error: failed to decode request body: unexpected EOF"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.InvalidRequest, rr.Body.Bytes())
+		assert.Contains(t, rr.Body.String(), "request body has an error: failed to decode request body: unexpected EOF")
 	})
 	t.Run("Create Cluster with Invalid Data Types", func(t *testing.T) {
 		// Prepare test data
@@ -1123,8 +1144,448 @@ func TestPostV2Clusters400(t *testing.T) {
 
 		// Check the response
 		assert.Equal(t, http.StatusBadRequest, rr.Code)
-		expectedResponse := `{"message":"request body has an error: doesn't match schema #/components/schemas/ClusterSpec: Error at \"/name\": value must be a string"}`
-		assert.JSONEq(t, expectedResponse, rr.Body.String())
+		requireCode(t, messages.InvalidRequest, rr.Body.Bytes())
+		assert.Contains(t, rr.Body.String(), "value must be a string")
+	})
+}
+
+// haControlPlaneTemplate returns a ready k3s template for the Intel infra provider
+func haControlPlaneTemplate(t *testing.T, name string) *unstructured.Unstructured {
+	template := clusterv1alpha1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: clusterv1alpha1.ClusterTemplateSpec{
+			ControlPlaneProviderType: "k3s",
+			InfraProviderType:        "intel",
+			KubernetesVersion:        "v1.33.5+k3s1",
+		},
+		Status: clusterv1alpha1.ClusterTemplateStatus{
+			Ready:                          true,
+			ClusterClassRef:                &corev1.ObjectReference{Name: "example-cluster-class"},
+			ControlPlaneMachineTemplateRef: &corev1.ObjectReference{Name: name + "-controlplane"},
+		},
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: u}
+}
+
+func TestPostV2ClustersTemplateLifecycle(t *testing.T) {
+	tests := []struct {
+		name           string
+		state          clusterv1alpha1.TemplateLifecycleState
+		expectedStatus int
+		expectedCode   messages.Code
+	}{
+		{name: "draft template is rejected", state: clusterv1alpha1.TemplateDraft, expectedStatus: http.StatusBadRequest, expectedCode: messages.TemplateNotPublished},
+		{name: "deprecated template conflicts", state: clusterv1alpha1.TemplateDeprecated, expectedStatus: http.StatusConflict, expectedCode: messages.TemplateDeprecated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+			expectedTemplateName := "baseline-kubeadm-v0.1.0"
+
+			sunsetDate := metav1.NewTime(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+			template := clusterv1alpha1.ClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: expectedTemplateName},
+				Spec: clusterv1alpha1.ClusterTemplateSpec{
+					ControlPlaneProviderType: "kubeadm",
+					InfraProviderType:        "docker",
+					KubernetesVersion:        "v1.30.0",
+					LifecycleState:           tt.state,
+					SunsetDate:               &sunsetDate,
+				},
+				Status: clusterv1alpha1.ClusterTemplateStatus{
+					Ready:           true,
+					ClusterClassRef: &corev1.ObjectReference{Name: expectedTemplateName},
+				},
+			}
+			unstructuredTemplate, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+			require.NoError(t, err, "failed to convert template to unstructured")
+
+			templateResource := k8s.NewMockResourceInterface(t)
+			templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(&unstructured.Unstructured{Object: unstructuredTemplate}, nil)
+			nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+			nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+			mockedk8sclient := k8s.NewMockInterface(t)
+			mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+
+			server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+			require.NotNil(t, server, "NewServer() returned nil, want not nil")
+
+			requestBody, err := json.Marshal(api.ClusterSpec{
+				Name:     ptr("example-cluster"),
+				Template: ptr(expectedTemplateName),
+				Nodes:    []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.NodeSpecRole(api.All)}},
+				Labels:   &map[string]string{},
+			})
+			require.NoError(t, err, "Failed to marshal request body")
+			req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+			req.Header.Set("Activeprojectid", expectedActiveProjectID)
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler, err := server.ConfigureHandler()
+			require.Nil(t, err)
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			requireCode(t, tt.expectedCode, rr.Body.Bytes())
+		})
+	}
+}
+
+func TestPostV2Clusters201MultiNodeControlPlane(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	nodeIds := []string{
+		"27b4e138-ea0b-11ef-8552-8b663d95bc01",
+		"27b4e138-ea0b-11ef-8552-8b663d95bc02",
+		"27b4e138-ea0b-11ef-8552-8b663d95bc03",
+	}
+
+	templateResource := k8s.NewMockResourceInterface(t)
+	templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+	nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+
+	// the cluster is created with one control plane replica per node
+	var createdCluster *unstructured.Unstructured
+	clusterResource := k8s.NewMockResourceInterface(t)
+	clusterResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).
+		RunAndReturn(func(_ context.Context, u *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			createdCluster = u
+			return u, nil
+		})
+	clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+		RunAndReturn(func(_ context.Context, _ string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+			return createdCluster, nil
+		})
+	nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+
+	// one binding is created for each control plane node
+	var bindings []intelv1alpha1.IntelMachineBinding
+	bindingResource := k8s.NewMockResourceInterface(t)
+	bindingResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).
+		RunAndReturn(func(_ context.Context, u *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			var binding intelv1alpha1.IntelMachineBinding
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &binding))
+			bindings = append(bindings, binding)
+			return u, nil
+		})
+	nsBindingResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsBindingResource.EXPECT().Namespace(expectedActiveProjectID).Return(bindingResource)
+
+	mockedk8sclient := k8s.NewMockInterface(t)
+	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+	mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+	mockedk8sclient.EXPECT().Resource(core.BindingsResourceSchema).Return(nsBindingResource)
+
+	server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+
+	clusterSpec := api.ClusterSpec{
+		Name:                 ptr("example-cluster"),
+		Template:             ptr(expectedTemplateName),
+		ControlPlaneReplicas: ptr(int32(3)),
+	}
+	for i, id := range nodeIds {
+		role := api.All
+		if i > 0 {
+			role = api.Controlplane
+		}
+		clusterSpec.Nodes = append(clusterSpec.Nodes, api.NodeSpec{Id: id, Role: role})
+	}
+	requestBody, err := json.Marshal(clusterSpec)
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+	req.Header.Set("Activeprojectid", expectedActiveProjectID)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler, err := server.ConfigureHandler()
+	require.Nil(t, err)
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	var cluster capi.Cluster
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(createdCluster.Object, &cluster))
+	require.Equal(t, int32(3), *cluster.Spec.Topology.ControlPlane.Replicas)
+
+	require.Len(t, bindings, 3)
+	for i, binding := range bindings {
+		require.Equal(t, fmt.Sprintf("example-cluster-%s", nodeIds[i]), binding.Name)
+		require.Equal(t, nodeIds[i], binding.Spec.NodeGUID)
+		require.Equal(t, "baseline-k3s-controlplane", binding.Spec.IntelMachineTemplateName)
+	}
+}
+
+func TestPostV2ClustersDryRun(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	nodeID := "27b4e138-ea0b-11ef-8552-8b663d95bc01"
+	clustersResource := schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}
+
+	postCluster := func(t *testing.T, clusterResource *k8s.MockResourceInterface) *httptest.ResponseRecorder {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		// nothing is created, so no binding resource is expected
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+
+		clusterSpec := api.ClusterSpec{
+			Name:     ptr("example-cluster"),
+			Template: ptr(expectedTemplateName),
+			Nodes:    []api.NodeSpec{{Id: nodeID, Role: api.All}},
+			Labels:   &map[string]string{"team": "edge"},
+		}
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters?dryRun=true", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("objects are returned instead of created", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(clustersResource, "example-cluster"))
+
+		rr := postCluster(t, clusterResource)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var dryRun api.ClusterDryRun
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &dryRun))
+
+		var cluster capi.Cluster
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(dryRun.Cluster, &cluster))
+		require.Equal(t, "example-cluster", cluster.Name)
+		require.Equal(t, expectedTemplateName, cluster.Annotations[core.TemplateLabelKey])
+		require.Equal(t, "edge", cluster.Labels["team"])
+		require.Equal(t, "example-cluster-class", cluster.Spec.Topology.Class)
+		require.True(t, cluster.Spec.Paused)
+
+		require.Len(t, dryRun.Bindings, 1)
+		var binding intelv1alpha1.IntelMachineBinding
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(dryRun.Bindings[0], &binding))
+		require.Equal(t, "example-cluster-"+nodeID, binding.Name)
+		require.Equal(t, nodeID, binding.Spec.NodeGUID)
+		require.Equal(t, "baseline-k3s-controlplane", binding.Spec.IntelMachineTemplateName)
+		require.Len(t, binding.OwnerReferences, 1)
+		require.Equal(t, "example-cluster", binding.OwnerReferences[0].Name)
+	})
+
+	t.Run("cluster exists", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "cluster.x-k8s.io/v1beta1",
+			"kind":       "Cluster",
+			"metadata":   map[string]interface{}{"name": "example-cluster", "namespace": expectedActiveProjectID},
+		}}, nil)
+
+		rr := postCluster(t, clusterResource)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterExists, rr.Body.Bytes())
+	})
+}
+
+func TestPostV2Clusters400ControlPlane(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+
+	nodes := func(n int, role api.NodeSpecRole) []api.NodeSpec {
+		var nodes []api.NodeSpec
+		for i := range n {
+			nodes = append(nodes, api.NodeSpec{Id: fmt.Sprintf("27b4e138-ea0b-11ef-8552-8b663d95bc0%d", i), Role: role})
+		}
+		return nodes
+	}
+
+	tests := []struct {
+		name            string
+		nodes           []api.NodeSpec
+		replicas        *int32
+		fetchesTemplate bool
+		singleNode      bool
+		expectedCode    messages.Code
+	}{
+		{
+			name:            "even number of control plane nodes",
+			nodes:           nodes(2, api.All),
+			fetchesTemplate: true,
+			expectedCode:    messages.ControlPlaneSizeUnsupported,
+		},
+		{
+			name:         "replicas do not match nodes",
+			nodes:        nodes(3, api.Controlplane),
+			replicas:     ptr(int32(5)),
+			expectedCode: messages.ControlPlaneReplicasMismatch,
+		},
+		{
+			name:         "worker nodes",
+			nodes:        []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.Worker}},
+			expectedCode: messages.WorkerNodesRequireNodePool,
+		},
+		{
+			name:            "dedicated etcd nodes of k3s",
+			nodes:           append(nodes(1, api.All), api.NodeSpec{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc09", Role: api.Etcd}),
+			fetchesTemplate: true,
+			expectedCode:    messages.NodeRoleNotSupported,
+		},
+		{
+			name:         "duplicate nodes",
+			nodes:        append(nodes(1, api.All), nodes(1, api.All)...),
+			expectedCode: messages.DuplicateNode,
+		},
+		{
+			name:            "more than one node of a single-node template",
+			nodes:           nodes(3, api.All),
+			fetchesTemplate: true,
+			singleNode:      true,
+			expectedCode:    messages.SingleNodeOnly,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedk8sclient := k8s.NewMockInterface(t)
+			if tc.fetchesTemplate {
+				templateResource := k8s.NewMockResourceInterface(t)
+				template := haControlPlaneTemplate(t, expectedTemplateName)
+				if tc.singleNode {
+					require.NoError(t, unstructured.SetNestedMap(template.Object, map[string]interface{}{"rejoinPolicy": "restore"}, "spec", "singleNode"))
+				}
+				templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+				nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+				nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+				mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+			}
+			server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+
+			clusterSpec := api.ClusterSpec{
+				Name:                 ptr("example-cluster"),
+				Template:             ptr(expectedTemplateName),
+				Nodes:                tc.nodes,
+				ControlPlaneReplicas: tc.replicas,
+			}
+			requestBody, err := json.Marshal(clusterSpec)
+			require.NoError(t, err)
+			req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+			req.Header.Set("Activeprojectid", expectedActiveProjectID)
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler, err := server.ConfigureHandler()
+			require.Nil(t, err)
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			requireCode(t, tc.expectedCode, rr.Body.Bytes())
+		})
+	}
+}
+
+func TestPostV2ClustersDependencies(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	registryCluster := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "Cluster",
+		"metadata":   map[string]interface{}{"name": "registry-cluster", "namespace": expectedActiveProjectID},
+	}}
+
+	postCluster := func(t *testing.T, mockedk8sclient *k8s.MockInterface, dependsOn []string) *httptest.ResponseRecorder {
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+
+		clusterSpec := api.ClusterSpec{
+			Name:      ptr("example-cluster"),
+			Template:  ptr(expectedTemplateName),
+			Nodes:     []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}},
+			DependsOn: &dependsOn,
+		}
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	templateClient := func(t *testing.T) *k8s.MockInterface {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		return mockedk8sclient
+	}
+
+	t.Run("dependencies are recorded", func(t *testing.T) {
+		var createdCluster *unstructured.Unstructured
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "registry-cluster", metav1.GetOptions{}).Return(registryCluster, nil)
+		clusterResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).
+			RunAndReturn(func(_ context.Context, u *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				createdCluster = u
+				return u, nil
+			})
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			RunAndReturn(func(_ context.Context, _ string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+				return createdCluster, nil
+			})
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		bindingResource := k8s.NewMockResourceInterface(t)
+		bindingResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).Return(&unstructured.Unstructured{}, nil)
+		nsBindingResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsBindingResource.EXPECT().Namespace(expectedActiveProjectID).Return(bindingResource)
+
+		mockedk8sclient := templateClient(t)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+		mockedk8sclient.EXPECT().Resource(core.BindingsResourceSchema).Return(nsBindingResource)
+
+		rr := postCluster(t, mockedk8sclient, []string{"registry-cluster"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.Equal(t, "registry-cluster", createdCluster.GetAnnotations()[core.DependsOnAnnotationKey])
+	})
+
+	t.Run("dependency does not exist", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "registry-cluster", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "registry-cluster"))
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+
+		mockedk8sclient := templateClient(t)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		rr := postCluster(t, mockedk8sclient, []string{"registry-cluster"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		requireCode(t, messages.DependencyNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("dependency on itself", func(t *testing.T) {
+		rr := postCluster(t, templateClient(t), []string{"example-cluster"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		requireCode(t, messages.DependencyOnItself, rr.Body.Bytes())
 	})
 }
 
@@ -1140,6 +1601,8 @@ func createPostV2ClustersStubServer(t *testing.T) *Server {
 	// Create a mock resource interface for clusters
 	clusterResource := k8s.NewMockResourceInterface(t)
 	clusterResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).Return(&unstructured.Unstructured{Object: unstructuredCluster}, nil).Maybe()
+	// Add support for List() calls when checking whether generated names are taken
+	clusterResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, nil).Maybe()
 
 	// Create a mock namespaceable resource interface for clusters
 	nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
@@ -1161,18 +1624,24 @@ func createPostV2ClustersStubServer(t *testing.T) *Server {
 	mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource).Maybe()
 	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource).Maybe()
 
-	return &Server{
-		k8sclient: mockedk8sclient,
-	}
+	return NewServer(mockedk8sclient, WithInventory(siteInventory{}))
 }
 
 func FuzzPostV2Clusters(f *testing.F) {
-	f.Add("abc", "def", "ghi", "jkl", "mno", "pqr",
+	f.Add("abc", "def", "ghi", "jkl", "mno", "pqr", "timestamp",
 		byte(0), byte(1), byte(2), byte(3), byte(4), byte(5), byte(6), byte(7),
 		byte(8), byte(9), byte(10), byte(11), byte(12), byte(13), byte(14), byte(15))
-	f.Fuzz(func(t *testing.T, labelKey, labelVal, id, role, name, template string,
+	// the names of clusters created without a name are generated with the strategies
+	for _, strategy := range naming.Strategies {
+		f.Add("abc", "def", "27b4e138-ea0b-11ef-8552-8b663d95bc01", "all", "", "pqr", strategy,
+			byte(0), byte(1), byte(2), byte(3), byte(4), byte(5), byte(6), byte(7),
+			byte(8), byte(9), byte(10), byte(11), byte(12), byte(13), byte(14), byte(15))
+	}
+	f.Fuzz(func(t *testing.T, labelKey, labelVal, id, role, name, template, strategy string,
 		u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15 byte) {
 		server := createPostV2ClustersStubServer(t)
+		server.config.ClusterNameStrategy = strategy
+		server.naming = naming.NewPolicies(naming.Config{Default: naming.Policy{Prefix: "fra1-", MaxLength: 30}})
 		uuid := [16]byte{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 		activeprojectid := api.ActiveProjectIdHeader(openapi_types.UUID(uuid))
 		params := api.PostV2ClustersParams{
@@ -1197,3 +1666,1115 @@ func FuzzPostV2Clusters(f *testing.F) {
 		_, _ = server.PostV2Clusters(context.Background(), req)
 	})
 }
+
+func TestPostV2ClustersQuota(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+
+	templateResource := k8s.NewMockResourceInterface(t)
+	templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+	nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+	mockedk8sclient := k8s.NewMockInterface(t)
+	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+
+	quotas := &fakeQuotas{err: fmt.Errorf("%w: the project is limited to 2 clusters and already has 2", multitenancy.ErrQuotaExceeded)}
+	server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithQuotas(quotas))
+
+	clusterSpec := api.ClusterSpec{
+		Name:     ptr("example-cluster"),
+		Template: ptr(expectedTemplateName),
+		Nodes: []api.NodeSpec{
+			{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All},
+			{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc02", Role: api.All},
+			{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc03", Role: api.All},
+		},
+	}
+	requestBody, err := json.Marshal(clusterSpec)
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+	req.Header.Set("Activeprojectid", expectedActiveProjectID)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler, err := server.ConfigureHandler()
+	require.Nil(t, err)
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+	requireCode(t, messages.QuotaExceeded, rr.Body.Bytes())
+	require.Equal(t, []multitenancy.QuotaRequest{{Clusters: 1, Nodes: 3, ControlPlaneReplicas: 3}}, quotas.requests)
+}
+
+func TestPostV2ClustersScheduled(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	provisionAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	postCluster := func(t *testing.T, clusterResource *k8s.MockResourceInterface, options ...func(*Server)) *httptest.ResponseRecorder {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		if clusterResource != nil {
+			nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+			nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+			mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+		}
+
+		options = append(options, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+		server := NewServer(mockedk8sclient, options...)
+
+		clusterSpec := api.ClusterSpec{
+			Name:        ptr("example-cluster"),
+			Template:    ptr(expectedTemplateName),
+			Nodes:       []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}},
+			ProvisionAt: &provisionAt,
+		}
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	notFound := func(t *testing.T) *k8s.MockResourceInterface {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+		return clusterResource
+	}
+
+	t.Run("cluster is scheduled", func(t *testing.T) {
+		pending := &fakePendingClusters{}
+		// the quota is checked when the cluster is provisioned
+		quotas := &fakeQuotas{err: fmt.Errorf("%w: the project is limited to 2 clusters and already has 2", multitenancy.ErrQuotaExceeded)}
+		rr := postCluster(t, notFound(t), WithPendingClusters(pending), WithQuotas(quotas))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var pc api.PendingCluster
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pc))
+		require.Equal(t, "example-cluster", pc.Name)
+		require.Equal(t, api.PendingClusterStateScheduled, pc.State)
+		require.True(t, provisionAt.Equal(pc.ProvisionAt))
+		require.Nil(t, pc.Spec.ProvisionAt)
+
+		require.Len(t, pending.scheduled, 1)
+		require.Equal(t, expectedActiveProjectID, pending.scheduled[0].ProjectID)
+		require.Equal(t, expectedTemplateName, *pending.scheduled[0].Spec.Template)
+		require.Empty(t, quotas.requests)
+	})
+
+	t.Run("pending cluster exists", func(t *testing.T) {
+		rr := postCluster(t, notFound(t), WithPendingClusters(&fakePendingClusters{err: scheduling.ErrPendingClusterExists}))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClusterExists, rr.Body.Bytes())
+	})
+
+	t.Run("cluster exists", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "cluster.x-k8s.io/v1beta1",
+			"kind":       "Cluster",
+			"metadata":   map[string]interface{}{"name": "example-cluster", "namespace": expectedActiveProjectID},
+		}}, nil)
+		pending := &fakePendingClusters{}
+		rr := postCluster(t, clusterResource, WithPendingClusters(pending))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterExists, rr.Body.Bytes())
+		require.Empty(t, pending.scheduled)
+	})
+
+	t.Run("deferred provisioning not enabled", func(t *testing.T) {
+		rr := postCluster(t, nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.ProvisionAtRequiresDeferred, rr.Body.Bytes())
+	})
+}
+
+func TestPostV2ClustersImagePreflight(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "airgap-k3s"
+	images := []string{"registry.site.local:5000/rancher/mirrored-pause:3.6", "registry.site.local:5000/rancher/mirrored-coredns-coredns:1.12.0"}
+
+	postCluster := func(t *testing.T, templateImages []string, clusterResource *k8s.MockResourceInterface, options ...func(*Server)) *httptest.ResponseRecorder {
+		template := haControlPlaneTemplate(t, expectedTemplateName)
+		if templateImages != nil {
+			require.NoError(t, unstructured.SetNestedStringSlice(template.Object, templateImages, "spec", "airGap", "images"))
+		}
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		if clusterResource != nil {
+			nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+			nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+			mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+		}
+
+		options = append(options, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+		server := NewServer(mockedk8sclient, options...)
+
+		clusterSpec := api.ClusterSpec{
+			Name:           ptr("example-cluster"),
+			Template:       ptr(expectedTemplateName),
+			Nodes:          []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}},
+			ImagePreflight: ptr(true),
+		}
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("cluster is kept pending until the hosts pulled the images", func(t *testing.T) {
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+		pending := &fakePendingClusters{preflight: true}
+		rr := postCluster(t, images, clusterResource, WithPendingClusters(pending))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var pc api.PendingCluster
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pc))
+		require.Equal(t, api.PendingClusterStatePreflight, pc.State)
+
+		require.Len(t, pending.scheduled, 1)
+		require.Equal(t, images, pending.scheduled[0].Images)
+	})
+
+	t.Run("template without images", func(t *testing.T) {
+		rr := postCluster(t, nil, nil, WithPendingClusters(&fakePendingClusters{preflight: true}))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.ImagePreflightNoImages, rr.Body.Bytes())
+	})
+
+	t.Run("image preflight not enabled", func(t *testing.T) {
+		rr := postCluster(t, images, nil, WithPendingClusters(&fakePendingClusters{}))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.ImagePreflightDisabled, rr.Body.Bytes())
+	})
+}
+
+func TestPostV2ClustersNamingPolicy(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	policies := naming.NewPolicies(naming.Config{Default: naming.Policy{Prefix: "fra1-", MaxLength: 30, ForbiddenWords: []string{"example"}}})
+
+	postCluster := func(t *testing.T, mockedk8sclient *k8s.MockInterface, name *string, options ...func(*Server)) *httptest.ResponseRecorder {
+		options = append([]func(*Server){WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithNamingPolicies(policies)}, options...)
+		server := NewServer(mockedk8sclient, options...)
+
+		provisionAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		clusterSpec := api.ClusterSpec{
+			Name:        name,
+			Template:    ptr(expectedTemplateName),
+			Nodes:       []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}},
+			ProvisionAt: &provisionAt,
+		}
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("name violating the policy", func(t *testing.T) {
+		rr := postCluster(t, k8s.NewMockInterface(t), ptr("example-cluster"))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNamePolicyViolated, rr.Body.Bytes())
+		require.Contains(t, rr.Body.String(), "it must start with 'fra1-'; it must not contain 'example'")
+	})
+
+	// generatingClient returns a client of a project with the given clusters, in which a cluster with a generated name
+	// is created
+	generatingClient := func(t *testing.T, names ...string) *k8s.MockInterface {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		clusters := &unstructured.UnstructuredList{}
+		for _, name := range names {
+			cluster := unstructured.Unstructured{}
+			cluster.SetName(name)
+			clusters.Items = append(clusters.Items, cluster)
+		}
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(clusters, nil)
+		clusterResource.EXPECT().Get(mock.Anything, mock.Anything, metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "cluster"))
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+		return mockedk8sclient
+	}
+
+	t.Run("generated name follows the policy", func(t *testing.T) {
+		pending := &fakePendingClusters{}
+		rr := postCluster(t, generatingClient(t), nil, WithPendingClusters(pending))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		require.Len(t, pending.scheduled, 1)
+		require.Regexp(t, `^fra1-cluster-[0-9]+$`, pending.scheduled[0].Name)
+	})
+
+	t.Run("generated name is not taken", func(t *testing.T) {
+		pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{{Name: "fra1-cluster-2"}}}
+		rr := postCluster(t, generatingClient(t, "fra1-cluster-1"), nil, WithPendingClusters(pending),
+			WithConfig(&config.Config{ClusterDomain: "kind.internal", ClusterNameStrategy: string(naming.StrategySequential)}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		require.Len(t, pending.scheduled, 1)
+		require.Equal(t, "fra1-cluster-3", pending.scheduled[0].Name)
+	})
+
+	t.Run("generated name of the site of the hosts", func(t *testing.T) {
+		pending := &fakePendingClusters{}
+		sites := siteInventory{"27b4e138-ea0b-11ef-8552-8b663d95bc01": "site-0a1b2c3d"}
+		rr := postCluster(t, generatingClient(t, "fra1-site-0a1b2c3d-1"), nil, WithPendingClusters(pending), WithInventory(sites),
+			WithConfig(&config.Config{ClusterDomain: "kind.internal", ClusterNameStrategy: string(naming.StrategySite)}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		require.Len(t, pending.scheduled, 1)
+		require.Equal(t, "fra1-site-0a1b2c3d-2", pending.scheduled[0].Name)
+	})
+}
+
+func TestPostV2ClustersReservedResources(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+
+	postCluster := func(t *testing.T, mockedk8sclient *k8s.MockInterface, reserved *api.ReservedResources) *httptest.ResponseRecorder {
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+
+		clusterSpec := api.ClusterSpec{
+			Name:              ptr("example-cluster"),
+			Template:          ptr(expectedTemplateName),
+			Nodes:             []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}},
+			ReservedResources: reserved,
+		}
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	templateClient := func(t *testing.T, reserved bool) *k8s.MockInterface {
+		template := haControlPlaneTemplate(t, expectedTemplateName)
+		if reserved {
+			require.NoError(t, unstructured.SetNestedField(template.Object, map[string]interface{}{
+				"system": map[string]interface{}{"cpu": "500m", "memory": "1Gi"},
+			}, "spec", "reservedResources"))
+		}
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		return mockedk8sclient
+	}
+
+	t.Run("overrides are merged with the reservations of the template", func(t *testing.T) {
+		var createdCluster *unstructured.Unstructured
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).
+			RunAndReturn(func(_ context.Context, u *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				createdCluster = u
+				return u, nil
+			})
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			RunAndReturn(func(_ context.Context, _ string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+				return createdCluster, nil
+			})
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		bindingResource := k8s.NewMockResourceInterface(t)
+		bindingResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).Return(&unstructured.Unstructured{}, nil)
+		nsBindingResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsBindingResource.EXPECT().Namespace(expectedActiveProjectID).Return(bindingResource)
+
+		mockedk8sclient := templateClient(t, true)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+		mockedk8sclient.EXPECT().Resource(core.BindingsResourceSchema).Return(nsBindingResource)
+
+		rr := postCluster(t, mockedk8sclient, &api.ReservedResources{
+			System: &api.ResourceReservation{Memory: ptr("2Gi")},
+			Kube:   &api.ResourceReservation{Cpu: ptr("250m")},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		variables, _, err := unstructured.NestedSlice(createdCluster.Object, "spec", "topology", "variables")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []interface{}{
+			map[string]interface{}{"name": "systemReserved", "value": "cpu=500m,memory=2Gi"},
+			map[string]interface{}{"name": "kubeReserved", "value": "cpu=250m"},
+		}, variables)
+	})
+
+	t.Run("template without reservations", func(t *testing.T) {
+		rr := postCluster(t, templateClient(t, false), &api.ReservedResources{System: &api.ResourceReservation{Cpu: ptr("1")}})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		requireCode(t, messages.ReservedResourcesNotSupported, rr.Body.Bytes())
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		rr := postCluster(t, templateClient(t, true), &api.ReservedResources{System: &api.ResourceReservation{Memory: ptr("lots")}})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		requireCode(t, messages.InvalidReservedResources, rr.Body.Bytes())
+	})
+}
+
+func TestPostV2ClustersReservedNetworks(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	reserved, err := network.ParseReserved([]string{"10.42.0.0/24"})
+	require.NoError(t, err)
+
+	template := haControlPlaneTemplate(t, expectedTemplateName)
+	require.NoError(t, unstructured.SetNestedField(template.Object, map[string]interface{}{
+		"pods":     map[string]interface{}{"cidrBlocks": []interface{}{"10.42.0.0/16"}},
+		"services": map[string]interface{}{"cidrBlocks": []interface{}{"10.43.0.0/16"}},
+	}, "spec", "clusterNetwork"))
+	templateResource := k8s.NewMockResourceInterface(t)
+	templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+	nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+	mockedk8sclient := k8s.NewMockInterface(t)
+	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+
+	server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithReservedNetworks(reserved))
+	clusterSpec := api.ClusterSpec{
+		Name:     ptr("example-cluster"),
+		Template: ptr(expectedTemplateName),
+		Nodes:    []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}},
+	}
+	requestBody, err := json.Marshal(clusterSpec)
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+	req.Header.Set("Activeprojectid", expectedActiveProjectID)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler, err := server.ConfigureHandler()
+	require.Nil(t, err)
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	requireCode(t, messages.ClusterNetworkReserved, rr.Body.Bytes())
+	require.Contains(t, rr.Body.String(), "pod CIDR block 10.42.0.0/16 overlaps reserved infrastructure network 10.42.0.0/24")
+}
+
+// siteInventory is an inventory whose hosts are on the listed sites
+type siteInventory map[string]string
+
+func (s siteInventory) GetHostTrustedCompute(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (s siteInventory) IsAttested(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (s siteInventory) IsImmutable(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (s siteInventory) HostSite(_ context.Context, _, hostUuid string) (string, error) {
+	return s[hostUuid], nil
+}
+
+func (s siteInventory) HostResources(context.Context, string, string) (inventory.HostResources, error) {
+	return inventory.HostResources{}, nil
+}
+
+func (s siteInventory) ReserveHosts(context.Context, string, string, []string) ([]string, error) {
+	return nil, nil
+}
+
+func (s siteInventory) ReleaseHosts(context.Context, string, string, []string) error {
+	return nil
+}
+
+func TestPostV2ClustersClusterNetwork(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	nodeID := "27b4e138-ea0b-11ef-8552-8b663d95bc01"
+	otherNodeID := "3c1e2f62-ea0b-11ef-8552-8b663d95bc01"
+	reserved, err := network.ParseReserved([]string{"10.0.0.0/16"})
+	require.NoError(t, err)
+
+	binding := func(nodeID, clusterName string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "infrastructure.cluster.x-k8s.io/v1alpha1",
+			"kind":       "IntelMachineBinding",
+			"metadata":   map[string]interface{}{"name": clusterName + "-" + nodeID, "namespace": expectedActiveProjectID},
+			"spec":       map[string]interface{}{"nodeGUID": nodeID, "clusterName": clusterName, "intelMachineTemplateName": "baseline-k3s-controlplane"},
+		}}
+	}
+	otherCluster := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cluster.x-k8s.io/v1beta1",
+		"kind":       "Cluster",
+		"metadata":   map[string]interface{}{"name": "other-cluster", "namespace": expectedActiveProjectID},
+		"spec": map[string]interface{}{"clusterNetwork": map[string]interface{}{
+			"pods":     map[string]interface{}{"cidrBlocks": []interface{}{"10.42.0.0/16"}},
+			"services": map[string]interface{}{"cidrBlocks": []interface{}{"10.43.0.0/16"}},
+		}},
+	}}
+
+	postCluster := func(t *testing.T, mockedk8sclient *k8s.MockInterface, inventory Inventory, clusterSpec api.ClusterSpec) *httptest.ResponseRecorder {
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithReservedNetworks(reserved), WithInventory(inventory))
+		requestBody, err := json.Marshal(clusterSpec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters?dryRun=true", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	mockTemplate := func(t *testing.T, mockedk8sclient *k8s.MockInterface, template *unstructured.Unstructured) {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+	}
+	mockBindings := func(t *testing.T, mockedk8sclient *k8s.MockInterface, bindings ...unstructured.Unstructured) {
+		bindingResource := k8s.NewMockResourceInterface(t)
+		bindingResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: bindings}, nil)
+		nsBindingResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsBindingResource.EXPECT().Namespace(expectedActiveProjectID).Return(bindingResource)
+		mockedk8sclient.EXPECT().Resource(core.BindingsResourceSchema).Return(nsBindingResource)
+	}
+	overrides := api.ClusterSpec{
+		Name:     ptr("example-cluster"),
+		Template: ptr(expectedTemplateName),
+		Nodes:    []api.NodeSpec{{Id: nodeID, Role: api.All}},
+		ClusterNetwork: &api.ClusterNetwork{
+			Pods: &api.NetworkRanges{CidrBlocks: []string{"10.42.0.0/16"}},
+		},
+		Cni: &api.CNIOptions{FlannelBackend: ptr(api.CNIOptionsFlannelBackendWireguardNative)},
+	}
+
+	t.Run("overrides are rendered", func(t *testing.T) {
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockTemplate(t, mockedk8sclient, haControlPlaneTemplate(t, expectedTemplateName))
+		mockBindings(t, mockedk8sclient, binding(otherNodeID, "other-cluster"))
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		// the other cluster has hosts on another site only
+		rr := postCluster(t, mockedk8sclient, siteInventory{nodeID: "site-0a1b2c3d", otherNodeID: "site-4e5f6a7b"}, overrides)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var dryRun api.ClusterDryRun
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &dryRun))
+		var cluster capi.Cluster
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(dryRun.Cluster, &cluster))
+		require.Equal(t, []string{"10.42.0.0/16"}, cluster.Spec.ClusterNetwork.Pods.CIDRBlocks)
+		require.Contains(t, cluster.Spec.Topology.Variables, capi.ClusterVariable{
+			Name:  providers.FlannelBackend,
+			Value: apiextensionsv1.JSON{Raw: []byte(`"wireguard-native"`)},
+		})
+	})
+
+	t.Run("overrides overlap a cluster on the same site", func(t *testing.T) {
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockTemplate(t, mockedk8sclient, haControlPlaneTemplate(t, expectedTemplateName))
+		mockBindings(t, mockedk8sclient, binding(otherNodeID, "other-cluster"))
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "other-cluster", metav1.GetOptions{}).Return(otherCluster, nil)
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		rr := postCluster(t, mockedk8sclient, siteInventory{nodeID: "site-0a1b2c3d", otherNodeID: "site-0a1b2c3d"}, overrides)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNetworkConflict, rr.Body.Bytes())
+		require.Contains(t, rr.Body.String(), "pod CIDR block 10.42.0.0/16 overlaps pod CIDR block 10.42.0.0/16 of cluster other-cluster")
+	})
+
+	t.Run("overrides overlap reserved networks", func(t *testing.T) {
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockTemplate(t, mockedk8sclient, haControlPlaneTemplate(t, expectedTemplateName))
+		clusterSpec := overrides
+		clusterSpec.ClusterNetwork = &api.ClusterNetwork{Services: &api.NetworkRanges{CidrBlocks: []string{"10.0.0.0/12"}}}
+
+		rr := postCluster(t, mockedk8sclient, failingInventory{t: t}, clusterSpec)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.InvalidClusterNetwork, rr.Body.Bytes())
+		require.Contains(t, rr.Body.String(), "service CIDR block 10.0.0.0/12 overlaps reserved infrastructure network 10.0.0.0/16")
+	})
+
+	t.Run("CNI options of kubeadm templates", func(t *testing.T) {
+		template := haControlPlaneTemplate(t, expectedTemplateName)
+		require.NoError(t, unstructured.SetNestedField(template.Object, "kubeadm", "spec", "controlPlaneProviderType"))
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockTemplate(t, mockedk8sclient, template)
+
+		rr := postCluster(t, mockedk8sclient, failingInventory{t: t}, overrides)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.CNIOptionsNotSupported, rr.Body.Bytes())
+	})
+
+	variablesTemplate := func(t *testing.T) *unstructured.Unstructured {
+		template := haControlPlaneTemplate(t, expectedTemplateName)
+		require.NoError(t, unstructured.SetNestedSlice(template.Object, []interface{}{
+			map[string]interface{}{"name": "maxPods", "type": "integer", "default": "110", "maximum": int64(250)},
+			map[string]interface{}{"name": "profile", "type": "enum", "enum": []interface{}{"small", "large"}},
+		}, "spec", "variables"))
+		return template
+	}
+
+	t.Run("variables are rendered", func(t *testing.T) {
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockTemplate(t, mockedk8sclient, variablesTemplate(t))
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		rr := postCluster(t, mockedk8sclient, siteInventory{nodeID: "site-0a1b2c3d"}, api.ClusterSpec{
+			Name:      ptr("example-cluster"),
+			Template:  ptr(expectedTemplateName),
+			Nodes:     []api.NodeSpec{{Id: nodeID, Role: api.All}},
+			Variables: &map[string]interface{}{"profile": "large"},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var dryRun api.ClusterDryRun
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &dryRun))
+		var cluster capi.Cluster
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(dryRun.Cluster, &cluster))
+		require.Contains(t, cluster.Spec.Topology.Variables, capi.ClusterVariable{
+			Name:  "maxPods",
+			Value: apiextensionsv1.JSON{Raw: []byte(`110`)},
+		}, "the variables the cluster does not set take their default")
+		require.Contains(t, cluster.Spec.Topology.Variables, capi.ClusterVariable{
+			Name:  "profile",
+			Value: apiextensionsv1.JSON{Raw: []byte(`"large"`)},
+		})
+	})
+
+	for name, values := range map[string]map[string]interface{}{
+		"variables out of bounds": {"maxPods": 500},
+		"variables not in enum":   {"profile": "medium"},
+		"variables not declared":  {"maxNodes": 3},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockedk8sclient := k8s.NewMockInterface(t)
+			mockTemplate(t, mockedk8sclient, variablesTemplate(t))
+
+			rr := postCluster(t, mockedk8sclient, failingInventory{t: t}, api.ClusterSpec{
+				Name:      ptr("example-cluster"),
+				Template:  ptr(expectedTemplateName),
+				Nodes:     []api.NodeSpec{{Id: nodeID, Role: api.All}},
+				Variables: &values,
+			})
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+			requireCode(t, messages.InvalidClusterVariables, rr.Body.Bytes())
+		})
+	}
+}
+
+// attestedInventory is an inventory whose hosts are trusted compute compatible, the listed hosts are attested
+type attestedInventory map[string]bool
+
+func (a attestedInventory) GetHostTrustedCompute(context.Context, string, string) (bool, error) {
+	return true, nil
+}
+
+func (a attestedInventory) IsAttested(_ context.Context, _, hostUuid string) (bool, error) {
+	return a[hostUuid], nil
+}
+
+func (a attestedInventory) IsImmutable(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (a attestedInventory) HostSite(context.Context, string, string) (string, error) {
+	return "", nil
+}
+
+func (a attestedInventory) HostResources(context.Context, string, string) (inventory.HostResources, error) {
+	return inventory.HostResources{}, nil
+}
+
+func (a attestedInventory) ReserveHosts(context.Context, string, string, []string) ([]string, error) {
+	return nil, nil
+}
+
+func (a attestedInventory) ReleaseHosts(context.Context, string, string, []string) error {
+	return nil
+}
+
+func TestPostV2ClustersTrustedCompute(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+
+	template := haControlPlaneTemplate(t, expectedTemplateName)
+	require.NoError(t, unstructured.SetNestedField(template.Object, true, "spec", "requireTrustedCompute"))
+	templateResource := k8s.NewMockResourceInterface(t)
+	templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+	nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+	mockedk8sclient := k8s.NewMockInterface(t)
+	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+
+	inventory := attestedInventory{"27b4e138-ea0b-11ef-8552-8b663d95bc01": true}
+	server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithInventory(inventory))
+	clusterSpec := api.ClusterSpec{
+		Name:     ptr("example-cluster"),
+		Template: ptr(expectedTemplateName),
+		Nodes: []api.NodeSpec{
+			{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All},
+			{Id: "3c1e2f62-ea0b-11ef-8552-8b663d95bc01", Role: api.All},
+			{Id: "4a7d9b10-ea0b-11ef-8552-8b663d95bc01", Role: api.All},
+		},
+	}
+	requestBody, err := json.Marshal(clusterSpec)
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+	req.Header.Set("Activeprojectid", expectedActiveProjectID)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler, err := server.ConfigureHandler()
+	require.Nil(t, err)
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	requireCode(t, messages.HostsNotAttested, rr.Body.Bytes())
+	require.Contains(t, rr.Body.String(), "hosts 3c1e2f62-ea0b-11ef-8552-8b663d95bc01, 4a7d9b10-ea0b-11ef-8552-8b663d95bc01 are not attested")
+}
+
+// failingInventory fails the test when hosts are looked up
+type failingInventory struct {
+	t *testing.T
+}
+
+func (f failingInventory) GetHostTrustedCompute(_ context.Context, _, hostUuid string) (bool, error) {
+	f.t.Errorf("unexpected inventory lookup of host %s", hostUuid)
+	return false, nil
+}
+
+func (f failingInventory) IsAttested(_ context.Context, _, hostUuid string) (bool, error) {
+	f.t.Errorf("unexpected inventory lookup of host %s", hostUuid)
+	return false, nil
+}
+
+func (f failingInventory) IsImmutable(_ context.Context, _, hostUuid string) (bool, error) {
+	f.t.Errorf("unexpected inventory lookup of host %s", hostUuid)
+	return false, nil
+}
+
+func (f failingInventory) HostSite(_ context.Context, _, hostUuid string) (string, error) {
+	f.t.Errorf("unexpected inventory lookup of host %s", hostUuid)
+	return "", nil
+}
+
+func (f failingInventory) HostResources(_ context.Context, _, hostUuid string) (inventory.HostResources, error) {
+	f.t.Errorf("unexpected inventory lookup of host %s", hostUuid)
+	return inventory.HostResources{}, nil
+}
+
+func (f failingInventory) ReserveHosts(_ context.Context, _, _ string, hostUuids []string) ([]string, error) {
+	f.t.Errorf("unexpected reservation of hosts %v", hostUuids)
+	return nil, nil
+}
+
+func (f failingInventory) ReleaseHosts(_ context.Context, _, _ string, hostUuids []string) error {
+	f.t.Errorf("unexpected release of hosts %v", hostUuids)
+	return nil
+}
+
+func TestPostV2ClustersVSphere(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s-vsphere"
+
+	template := haControlPlaneTemplate(t, expectedTemplateName)
+	require.NoError(t, unstructured.SetNestedField(template.Object, "vsphere", "spec", "infraProviderType"))
+	unstructured.RemoveNestedField(template.Object, "status", "controlPlaneMachineTemplateRef")
+	templateResource := k8s.NewMockResourceInterface(t)
+	templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+	nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+	clusterResource := k8s.NewMockResourceInterface(t)
+	clusterResource.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).
+		Return(nil, k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+	nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+	mockedk8sclient := k8s.NewMockInterface(t)
+	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+	mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+	// the virtual machines are cloned by the vSphere provider, so they are neither looked up in the inventory nor bound
+	server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithInventory(failingInventory{t: t}))
+	clusterSpec := api.ClusterSpec{
+		Name:     ptr("example-cluster"),
+		Template: ptr(expectedTemplateName),
+		Nodes: []api.NodeSpec{
+			{Id: "example-cluster-vm-1", Role: api.All},
+			{Id: "example-cluster-vm-2", Role: api.All},
+			{Id: "example-cluster-vm-3", Role: api.All},
+		},
+	}
+	requestBody, err := json.Marshal(clusterSpec)
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "/v2/clusters?dryRun=true", bytes.NewReader(requestBody))
+	req.Header.Set("Activeprojectid", expectedActiveProjectID)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler, err := server.ConfigureHandler()
+	require.Nil(t, err)
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var dryRun api.ClusterDryRun
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &dryRun))
+	require.Empty(t, dryRun.Bindings)
+
+	var cluster capi.Cluster
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(dryRun.Cluster, &cluster))
+	require.Equal(t, int32(3), *cluster.Spec.Topology.ControlPlane.Replicas)
+	require.Equal(t, "false", cluster.Labels[labels.TrustedComputeLabelKey])
+	require.Empty(t, cluster.Spec.Topology.Variables)
+}
+
+func TestPostV2ClustersIdempotencyKey(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	idempotencyKey := "0b6a2fbb-5a4c-4b8e-9a6f-1d7c2f5d3e21"
+	// the request validator sets the defaults of the body before it is digested
+	clusterSpec := api.ClusterSpec{
+		Template:       ptr("baseline-k3s"),
+		Nodes:          []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc01", Role: api.All}},
+		ImagePreflight: ptr(false),
+	}
+
+	// the cluster the original request created, with the name generated for it
+	client := k8s.New().WithFakeClient()
+	cluster := &capi.Cluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: capi.GroupVersion.String(), Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-1767225600", Namespace: expectedActiveProjectID},
+	}
+	newIdempotentRequest(&idempotencyKey, &clusterSpec).annotate(cluster)
+	_, err := client.CreateCluster(context.Background(), expectedActiveProjectID, *cluster)
+	require.NoError(t, err)
+
+	pending := scheduling.NewScheduler(client)
+	server := NewServer(client.Dyn, WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithPendingClusters(pending))
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+	postWithKey := func(spec api.ClusterSpec, key string) *httptest.ResponseRecorder {
+		requestBody, err := json.Marshal(spec)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", key)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	post := func(spec api.ClusterSpec) *httptest.ResponseRecorder {
+		return postWithKey(spec, idempotencyKey)
+	}
+
+	t.Run("retried request returns the cluster of the original request", func(t *testing.T) {
+		rr := post(clusterSpec)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "successfully created cluster cluster-1767225600")
+	})
+
+	t.Run("key reused for a different request", func(t *testing.T) {
+		spec := clusterSpec
+		spec.Labels = &map[string]string{"test": "true"}
+		rr := post(spec)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.IdempotencyKeyReused, rr.Body.Bytes())
+	})
+
+	t.Run("retried scheduled request returns the pending cluster of the original request", func(t *testing.T) {
+		scheduledKey := "7f3c1f0e-8d2a-4b5e-9c61-2e4d5a6b7c80"
+		provisionAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		spec := clusterSpec
+		spec.Name, spec.ProvisionAt = ptr("scheduled-cluster"), &provisionAt
+		original := newIdempotentRequest(&scheduledKey, &spec)
+		_, err := pending.Schedule(context.Background(), expectedActiveProjectID, spec, provisionAt, scheduling.WithIdempotency(original.key, original.digest))
+		require.NoError(t, err)
+
+		rr := postWithKey(spec, scheduledKey)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		var pc api.PendingCluster
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pc))
+		require.Equal(t, "scheduled-cluster", pc.Name)
+
+		spec.Labels = &map[string]string{"test": "true"}
+		rr = postWithKey(spec, scheduledKey)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.IdempotencyKeyReused, rr.Body.Bytes())
+	})
+
+	t.Run("pending cluster is provisioned with the key of the original request", func(t *testing.T) {
+		// the spec of the pending cluster differs from the body of the original request, its digest is kept
+		original := newIdempotentRequest(&idempotencyKey, &clusterSpec)
+		spec := clusterSpec
+		spec.Name = ptr("cluster-1767225600")
+		require.NoError(t, server.ProvisionCluster(context.Background(), expectedActiveProjectID, spec,
+			&scheduling.Idempotency{Key: original.key, Digest: original.digest}))
+
+		err := server.ProvisionCluster(context.Background(), expectedActiveProjectID, spec, &scheduling.Idempotency{Key: original.key, Digest: "other"})
+		require.ErrorContains(t, err, idempotencyKey)
+	})
+}
+
+// resourcesInventory returns the resources of its hosts, the other hosts do not exist
+type resourcesInventory map[string]inventory.HostResources
+
+func (r resourcesInventory) GetHostTrustedCompute(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (r resourcesInventory) IsAttested(context.Context, string, string) (bool, error) {
+	return true, nil
+}
+
+func (r resourcesInventory) IsImmutable(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (r resourcesInventory) HostSite(context.Context, string, string) (string, error) {
+	return "", nil
+}
+
+func (r resourcesInventory) HostResources(_ context.Context, _, hostUuid string) (inventory.HostResources, error) {
+	resources, ok := r[hostUuid]
+	if !ok {
+		return inventory.HostResources{}, fmt.Errorf("%w: %s", inventory.ErrHostNotFound, hostUuid)
+	}
+	return resources, nil
+}
+
+func (r resourcesInventory) ReserveHosts(context.Context, string, string, []string) ([]string, error) {
+	return nil, nil
+}
+
+func (r resourcesInventory) ReleaseHosts(context.Context, string, string, []string) error {
+	return nil
+}
+
+func TestPostV2ClustersNodeValidation(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	smallNodeID := "27b4e138-ea0b-11ef-8552-8b663d95bc01"
+	boundNodeID := "3c1e2f62-ea0b-11ef-8552-8b663d95bc01"
+	missingNodeID := "4a7d9b10-ea0b-11ef-8552-8b663d95bc01"
+
+	template := haControlPlaneTemplate(t, expectedTemplateName)
+	require.NoError(t, unstructured.SetNestedStringMap(template.Object, map[string]string{"cpu": "4", "memory": "8Gi"}, "spec", "minNodeResources"))
+	postCluster := func(t *testing.T, inventory Inventory, nodes []api.NodeSpec, bindings *unstructured.UnstructuredList, listErr error) *httptest.ResponseRecorder {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(template, nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		bindingResource := k8s.NewMockResourceInterface(t)
+		bindingResource.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(bindings, listErr)
+		nsBindingResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsBindingResource.EXPECT().Namespace(expectedActiveProjectID).Return(bindingResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		mockedk8sclient.EXPECT().Resource(core.BindingsResourceSchema).Return(nsBindingResource)
+
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal", ValidateNodes: true}), WithInventory(inventory))
+		requestBody, err := json.Marshal(api.ClusterSpec{
+			Name:     ptr("example-cluster"),
+			Template: ptr(expectedTemplateName),
+			Nodes:    nodes,
+		})
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("unusable hosts are reported per node", func(t *testing.T) {
+		binding := unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "infrastructure.cluster.x-k8s.io/v1alpha1",
+			"kind":       "IntelMachineBinding",
+			"metadata":   map[string]interface{}{"name": "other-cluster-" + boundNodeID, "namespace": expectedActiveProjectID},
+			"spec":       map[string]interface{}{"nodeGUID": boundNodeID, "clusterName": "other-cluster", "intelMachineTemplateName": "baseline-k3s-controlplane"},
+		}}
+		inventory := resourcesInventory{
+			smallNodeID: {CPUCores: 2, MemoryBytes: 4 << 30},
+			boundNodeID: {CPUCores: 8, MemoryBytes: 16 << 30},
+		}
+		nodes := []api.NodeSpec{
+			{Id: smallNodeID, Role: api.All},
+			{Id: boundNodeID, Role: api.All},
+			{Id: missingNodeID, Role: api.All},
+		}
+		rr := postCluster(t, inventory, nodes, &unstructured.UnstructuredList{Items: []unstructured.Unstructured{binding}}, nil)
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodesUnusable, rr.Body.Bytes())
+
+		var problem api.NodeValidationProblem
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+		require.Len(t, problem.Nodes, 3)
+		reasons := map[string][]api.NodeValidationReasonType{}
+		for _, node := range problem.Nodes {
+			for _, reason := range node.Reasons {
+				reasons[node.Id] = append(reasons[node.Id], reason.Type)
+			}
+		}
+		require.Equal(t, map[string][]api.NodeValidationReasonType{
+			smallNodeID:   {api.InsufficientCpu, api.InsufficientMemory},
+			boundNodeID:   {api.HostBound},
+			missingNodeID: {api.HostNotFound},
+		}, reasons)
+	})
+
+	t.Run("failure to list the bindings of the hosts", func(t *testing.T) {
+		rr := postCluster(t, failingInventory{t}, []api.NodeSpec{{Id: smallNodeID, Role: api.All}}, nil, errors.New("boom"))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodesCheckFailed, rr.Body.Bytes())
+	})
+}
+
+// reservingInventory is an inventory whose hosts are reserved for the clusters they are mapped to
+type reservingInventory struct {
+	siteInventory
+	reservations map[string]string
+}
+
+func (r *reservingInventory) ReserveHosts(_ context.Context, _, clusterName string, hostUuids []string) ([]string, error) {
+	var reserved []string
+	for _, hostUuid := range hostUuids {
+		switch owner := r.reservations[hostUuid]; owner {
+		case clusterName:
+		case "":
+			r.reservations[hostUuid] = clusterName
+			reserved = append(reserved, hostUuid)
+		default:
+			return nil, fmt.Errorf("%w: host %s is reserved for cluster %s", inventory.ErrHostReserved, hostUuid, owner)
+		}
+	}
+	return reserved, nil
+}
+
+func (r *reservingInventory) ReleaseHosts(_ context.Context, _, clusterName string, hostUuids []string) error {
+	for _, hostUuid := range hostUuids {
+		if r.reservations[hostUuid] == clusterName {
+			delete(r.reservations, hostUuid)
+		}
+	}
+	return nil
+}
+
+func TestPostV2ClustersHostReservations(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	expectedTemplateName := "baseline-k3s"
+	nodeID := "27b4e138-ea0b-11ef-8552-8b663d95bc01"
+
+	postCluster := func(t *testing.T, inv *reservingInventory, clusterResource *k8s.MockResourceInterface) *httptest.ResponseRecorder {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, expectedTemplateName, metav1.GetOptions{}).Return(haControlPlaneTemplate(t, expectedTemplateName), nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		if clusterResource != nil {
+			nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+			nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+			mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+		}
+
+		server := NewServer(mockedk8sclient, WithConfig(&config.Config{ClusterDomain: "kind.internal"}), WithInventory(inv))
+		requestBody, err := json.Marshal(api.ClusterSpec{
+			Name:     ptr("example-cluster"),
+			Template: ptr(expectedTemplateName),
+			Nodes:    []api.NodeSpec{{Id: nodeID, Role: api.All}},
+		})
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/clusters", bytes.NewReader(requestBody))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.Nil(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("host reserved for another cluster", func(t *testing.T) {
+		inv := &reservingInventory{reservations: map[string]string{nodeID: "other-cluster"}}
+
+		rr := postCluster(t, inv, nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.HostsNotReservable, rr.Body.Bytes())
+		require.Equal(t, "other-cluster", inv.reservations[nodeID])
+	})
+
+	t.Run("reservation released when the cluster cannot be created", func(t *testing.T) {
+		inv := &reservingInventory{reservations: map[string]string{}}
+		var createdCluster *unstructured.Unstructured
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().Create(mock.Anything, mock.Anything, metav1.CreateOptions{}).
+			RunAndReturn(func(_ context.Context, u *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				createdCluster = u
+				return nil, errors.New("boom")
+			})
+
+		rr := postCluster(t, inv, clusterResource)
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterCreateFailed, rr.Body.Bytes())
+		require.Equal(t, nodeID, createdCluster.GetAnnotations()[core.ReservedHostsAnnotationKey])
+		require.Empty(t, inv.reservations)
+	})
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/clusters/import)
+func (s *Server) PostV2ClustersImport(ctx context.Context, request api.PostV2ClustersImportRequestObject) (api.PostV2ClustersImportResponseObject, error) {
+	namespace := request.Params.Activeprojectid.String()
+	clusterName := request.Body.Name
+	logger.FromContext(ctx).Debug("handling request to import cluster", "namespace", namespace, "name", clusterName)
+
+	userLabels := map[string]string{}
+	if request.Body.Labels != nil {
+		userLabels = *request.Body.Labels
+	}
+	if !labels.Valid(userLabels) {
+		message := messages.New(messages.InvalidClusterLabelKeys)
+		logger.FromContext(ctx).Warn(message.String(), "labels", userLabels)
+		return api.PostV2ClustersImport400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cli := k8s.New(s.k8sclient)
+	capiCluster, err := cli.GetCluster(ctx, namespace, clusterName)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, clusterName)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	switch {
+	case capiCluster.Annotations[core.TemplateLabelKey] != "":
+		message := messages.New(messages.ClusterAlreadyManaged, clusterName, capiCluster.Annotations[core.TemplateLabelKey])
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case capiCluster.DeletionTimestamp != nil:
+		message := messages.New(messages.ClusterNotImportable, clusterName, "it is being deleted")
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	// the template operations of the API, e.g. upgrades, change the managed topology of the cluster
+	case capiCluster.Spec.Topology == nil || capiCluster.Spec.Topology.Class == "":
+		message := messages.New(messages.ClusterNotImportable, clusterName, "it does not use a ClusterClass")
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	template, err := importTemplate(ctx, cli, namespace, capiCluster, request.Body.Template)
+	var invalid messages.Message
+	switch {
+	case errors.As(err, &invalid):
+		logger.FromContext(ctx).Warn(invalid.String(), "namespace", namespace)
+		return api.PostV2ClustersImport400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, invalid))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterImportFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the hosts of imported clusters were not checked for trusted compute
+	clusterLabels := labels.Merge(capiCluster.Labels, userLabels, template.Spec.ClusterLabels, s.systemClusterLabels(namespace, clusterName, false))
+	if !labels.Valid(clusterLabels) {
+		message := messages.New(messages.InvalidClusterLabels)
+		logger.FromContext(ctx).Error(message.String(), "labels", clusterLabels)
+		return api.PostV2ClustersImport400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	capiCluster.Labels = clusterLabels
+	if capiCluster.Annotations == nil {
+		capiCluster.Annotations = map[string]string{}
+	}
+	capiCluster.Annotations[core.TemplateLabelKey] = template.Name
+	err = s.updateImportedCluster(ctx, namespace, capiCluster)
+	switch {
+	case k8serrors.IsConflict(err):
+		message := messages.New(messages.ClusterImportFailed, clusterName, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterImportFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", namespace)
+		return api.PostV2ClustersImport500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster imported", "namespace", namespace, "name", clusterName, "template", template.Name)
+	return api.PostV2ClustersImport200JSONResponse(fmt.Sprintf("successfully imported cluster %s", clusterName)), nil
+}
+
+// importTemplate returns the template of the imported cluster: the requested template, which must use the ClusterClass
+// of the cluster, or else the template of the ClusterClass. Clusters whose ClusterClass has no template, e.g. because
+// it was created by GitOps as well, reference a synthetic template named after the ClusterClass.
+func importTemplate(ctx context.Context, cli *k8s.Client, namespace string, capiCluster *capi.Cluster, requested *string) (*ct.ClusterTemplate, error) {
+	class := capiCluster.Spec.Topology.Class
+	templateName := class
+	if requested != nil && *requested != "" {
+		templateName = *requested
+	}
+
+	template, err := cli.GetClusterTemplate(ctx, namespace, templateName)
+	switch {
+	case k8serrors.IsNotFound(err) && templateName != class:
+		return nil, messages.New(messages.TemplateNotFound, templateName)
+	case k8serrors.IsNotFound(err):
+		logger.FromContext(ctx).Info("no template of the ClusterClass of the imported cluster, referencing a synthetic template", "namespace", namespace, "name", capiCluster.Name, "template", templateName)
+		return &ct.ClusterTemplate{ObjectMeta: v1.ObjectMeta{Namespace: namespace, Name: templateName}}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get template '%s': %w", templateName, err)
+	}
+
+	if ref := template.Status.ClusterClassRef; ref != nil && ref.Name != class {
+		return nil, messages.New(messages.ClusterNotImportable, capiCluster.Name,
+			fmt.Sprintf("it uses ClusterClass '%s' instead of ClusterClass '%s' of template '%s'", class, ref.Name, templateName))
+	}
+	return template, nil
+}
+
+// updateImportedCluster writes the labels and annotations of the imported cluster; the update fails with a conflict
+// if the cluster was modified since it was read
+func (s *Server) updateImportedCluster(ctx context.Context, namespace string, capiCluster *capi.Cluster) error {
+	obj, err := convert.ToUnstructured(*capiCluster)
+	if err != nil {
+		return fmt.Errorf("failed to convert cluster to unstructured: %w", err)
+	}
+	_, err = s.k8sclient.Resource(core.ClusterResourceSchema).Namespace(namespace).Update(ctx, obj, v1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func importedCluster(t *testing.T, modify func(*capi.Cluster)) *unstructured.Unstructured {
+	cluster := capi.Cluster{
+		TypeMeta: v1.TypeMeta{APIVersion: "cluster.x-k8s.io/v1beta1", Kind: "Cluster"},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "gitops-cluster",
+			Namespace: activeProjectID,
+			Labels:    map[string]string{"owner": "gitops"},
+		},
+		Spec: capi.ClusterSpec{Topology: &capi.Topology{Class: "baseline-v1.0.0", Version: "v1.30.6+k3s1"}},
+	}
+	if modify != nil {
+		modify(&cluster)
+	}
+	obj, err := convert.ToUnstructured(cluster)
+	require.NoError(t, err)
+	return obj
+}
+
+func importTemplateObject(t *testing.T, class string) *unstructured.Unstructured {
+	template := v1alpha1.ClusterTemplate{
+		TypeMeta:   v1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: "ClusterTemplate"},
+		ObjectMeta: v1.ObjectMeta{Name: "baseline-v1.0.0", Namespace: activeProjectID},
+		Spec:       v1alpha1.ClusterTemplateSpec{ClusterLabels: map[string]string{"default-extension": "baseline"}},
+		Status:     v1alpha1.ClusterTemplateStatus{ClusterClassRef: &corev1.ObjectReference{Name: class}},
+	}
+	obj, err := convert.ToUnstructured(template)
+	require.NoError(t, err)
+	return obj
+}
+
+func TestPostV2ClustersImport(t *testing.T) {
+	clustersResource := schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}
+	templatesResource := schema.GroupResource{Group: "edge-orchestrator.intel.com", Resource: "clustertemplates"}
+	other := "other-v1.0.0"
+
+	tests := []struct {
+		name             string
+		template         *string
+		cluster          func(*capi.Cluster)
+		getErr           error
+		templateObject   *unstructured.Unstructured
+		templateErr      error
+		updateErr        error
+		expectedStatus   int
+		expectedCode     messages.Code
+		expectedTemplate string
+		expectedLabels   map[string]string
+	}{
+		{
+			name:             "cluster of a template is imported",
+			templateObject:   importTemplateObject(t, "baseline-v1.0.0"),
+			expectedStatus:   http.StatusOK,
+			expectedTemplate: "baseline-v1.0.0",
+			expectedLabels: map[string]string{
+				"owner":             "gitops",
+				"team":              "edge",
+				"default-extension": "baseline",
+				"edge-orchestrator.intel.com/clustername": "gitops-cluster",
+				"edge-orchestrator.intel.com/project-id":  activeProjectID,
+				"prometheusMetricsURL":                    "metrics-node.kind.internal",
+				"trusted-compute-compatible":              "false",
+			},
+		},
+		{
+			name:             "cluster of a ClusterClass without template references a synthetic template",
+			templateErr:      k8serrors.NewNotFound(templatesResource, "baseline-v1.0.0"),
+			expectedStatus:   http.StatusOK,
+			expectedTemplate: "baseline-v1.0.0",
+		},
+		{name: "cluster not found", getErr: k8serrors.NewNotFound(clustersResource, "gitops-cluster"), expectedStatus: http.StatusNotFound, expectedCode: messages.ClusterNotFound},
+		{
+			name: "managed cluster",
+			cluster: func(c *capi.Cluster) {
+				c.Annotations = map[string]string{core.TemplateLabelKey: "baseline-v1.0.0"}
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   messages.ClusterAlreadyManaged,
+		},
+		{
+			name:           "cluster without ClusterClass",
+			cluster:        func(c *capi.Cluster) { c.Spec.Topology = nil },
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   messages.ClusterNotImportable,
+		},
+		{
+			name:           "requested template not found",
+			template:       &other,
+			templateErr:    k8serrors.NewNotFound(templatesResource, other),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   messages.TemplateNotFound,
+		},
+		{
+			name:           "template of another ClusterClass",
+			template:       &other,
+			templateObject: importTemplateObject(t, other),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   messages.ClusterNotImportable,
+		},
+		{
+			name:           "cluster modified while imported",
+			templateObject: importTemplateObject(t, "baseline-v1.0.0"),
+			updateErr:      k8serrors.NewConflict(clustersResource, "gitops-cluster", nil),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   messages.ClusterImportFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters := k8s.NewMockResourceInterface(t)
+			templates := k8s.NewMockResourceInterface(t)
+			resources := map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: clusters}
+			if tt.getErr != nil {
+				clusters.EXPECT().Get(mock.Anything, "gitops-cluster", v1.GetOptions{}).Return(nil, tt.getErr)
+			} else {
+				clusters.EXPECT().Get(mock.Anything, "gitops-cluster", v1.GetOptions{}).Return(importedCluster(t, tt.cluster), nil)
+			}
+			if tt.templateObject != nil || tt.templateErr != nil {
+				templateName := "baseline-v1.0.0"
+				if tt.template != nil {
+					templateName = *tt.template
+				}
+				templates.EXPECT().Get(mock.Anything, templateName, v1.GetOptions{}).Return(tt.templateObject, tt.templateErr)
+				resources[core.TemplateResourceSchema] = templates
+			}
+
+			var updated *unstructured.Unstructured
+			if tt.expectedStatus == http.StatusOK || tt.updateErr != nil {
+				clusters.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+					func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+						updated = obj
+						return obj, tt.updateErr
+					})
+			}
+
+			rr := serveRequest(t, resources, http.MethodPost, "/v2/clusters/import", api.ClusterImport{
+				Name:     "gitops-cluster",
+				Template: tt.template,
+				Labels:   &map[string]string{"team": "edge"},
+			}, WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+			if tt.expectedStatus != http.StatusOK {
+				requireCode(t, tt.expectedCode, rr.Body.Bytes())
+				return
+			}
+
+			require.NotNil(t, updated)
+			require.Equal(t, tt.expectedTemplate, updated.GetAnnotations()[core.TemplateLabelKey])
+			if tt.expectedLabels != nil {
+				require.Equal(t, tt.expectedLabels, updated.GetLabels())
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPostV2ClustersNameBackups(t *testing.T) {
+	t.Run("backup is created for the cluster", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		backups := k8s.NewMockResourceInterface(t)
+		backups.EXPECT().Create(mock.Anything, mock.Anything, v1.CreateOptions{}).
+			RunAndReturn(func(_ context.Context, obj *unstructured.Unstructured, _ v1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				var backup v1alpha1.Backup
+				require.NoError(t, json.Unmarshal(mustMarshal(t, obj), &backup))
+				require.Equal(t, "Backup", backup.Kind)
+				require.Equal(t, "example-cluster-", backup.GenerateName)
+				require.Equal(t, "example-cluster", backup.Spec.ClusterName)
+
+				created := obj.DeepCopy()
+				created.SetName("example-cluster-x7k2p")
+				return created, nil
+			})
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.BackupResourceSchema:  backups,
+		}, http.MethodPost, "/v2/clusters/example-cluster/backups", nil)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var backup api.ClusterBackup
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &backup))
+		require.Equal(t, "example-cluster-x7k2p", backup.Name)
+		require.Equal(t, "example-cluster", backup.ClusterName)
+		require.Equal(t, api.ClusterBackupPhasePending, backup.Phase)
+	})
+
+	t.Run("missing clusters are not backed up", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodPost, "/v2/clusters/example-cluster/backups", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
+
+func mustMarshal(t *testing.T, obj *unstructured.Unstructured) []byte {
+	data, err := obj.MarshalJSON()
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	controlplaneprovider "github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+	templates "github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/clusters/{name}/clone)
+func (s *Server) PostV2ClustersNameClone(ctx context.Context, request api.PostV2ClustersNameCloneRequestObject) (api.PostV2ClustersNameCloneResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if request.Body == nil || len(request.Body.Nodes) == 0 {
+		message := messages.New(messages.NodesRequired)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2ClustersNameClone400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2ClustersNameClone500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	source, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameClone404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameClone500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	templateName := source.Annotations[core.TemplateLabelKey]
+	if templateName == "" {
+		message := messages.New(messages.ClusterNotClonable, request.Name, "it has no template")
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameClone409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+	template, err := cli.GetClusterTemplate(ctx, activeProjectID, templateName)
+	switch {
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.ClusterNotClonable, request.Name, fmt.Sprintf("its template %s does not exist", templateName))
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameClone409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateOfClusterGetFailed, templateName, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameClone500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	spec, err := cloneClusterSpec(source, *template, request.Body)
+	if err != nil {
+		message := messages.New(messages.ClusterNotClonable, request.Name, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameClone409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the clone replicates the cluster to another location, so it cannot share the hosts of the cluster
+	sourceNodes, err := cluster.Nodes(ctx, cli, source)
+	if err != nil {
+		message := messages.New(messages.NodesGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameClone500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	for _, node := range spec.Nodes {
+		for _, sourceNode := range sourceNodes {
+			if sourceNode.Id != nil && *sourceNode.Id == node.Id {
+				message := messages.New(messages.ClusterCloneNodeInUse, node.Id, request.Name)
+				logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+				return api.PostV2ClustersNameClone400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+			}
+		}
+	}
+
+	// the clone is created like any other cluster, so it is validated against the template and the quota and naming
+	// policy of the project
+	response, err := s.PostV2Clusters(ctx, api.PostV2ClustersRequestObject{Params: api.PostV2ClustersParams{Activeprojectid: request.Params.Activeprojectid}, Body: &spec})
+	if err != nil {
+		return nil, err
+	}
+	switch r := response.(type) {
+	case api.PostV2Clusters201JSONResponse:
+		logger.FromContext(ctx).Info("cluster cloned", "namespace", activeProjectID, "cluster", request.Name, "clone", string(r))
+		return api.PostV2ClustersNameClone201JSONResponse(r), nil
+	case api.PostV2Clusters400JSONResponse:
+		return api.PostV2ClustersNameClone400JSONResponse(r), nil
+	case api.PostV2Clusters403JSONResponse:
+		return api.PostV2ClustersNameClone403JSONResponse(r), nil
+	case api.PostV2Clusters409JSONResponse:
+		return api.PostV2ClustersNameClone409JSONResponse(r), nil
+	case api.PostV2Clusters422JSONResponse:
+		return api.PostV2ClustersNameClone422JSONResponse(r), nil
+	case api.PostV2Clusters500JSONResponse:
+		return api.PostV2ClustersNameClone500JSONResponse(r), nil
+	}
+	message := messages.New(messages.InternalError, fmt.Sprintf("unexpected response %T", response))
+	logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+	return api.PostV2ClustersNameClone500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+}
+
+// cloneClusterSpec returns the spec of a new cluster on the nodes of the request with the template, the user labels,
+// the values of the template variables, the reserved resources, the network overrides and the CNI options of the
+// source cluster; the labels of the request are merged into the labels of the source cluster
+func cloneClusterSpec(source *capi.Cluster, template ct.ClusterTemplate, request *api.ClusterCloneRequest) (api.ClusterSpec, error) {
+	clusterLabels := labels.UserLabels(source.Labels)
+	if request.Labels != nil {
+		maps.Copy(clusterLabels, *request.Labels)
+	}
+
+	spec := api.ClusterSpec{
+		Name:              request.Name,
+		Template:          &template.Name,
+		Nodes:             request.Nodes,
+		Labels:            &clusterLabels,
+		ReservedResources: templates.ToAPIReservedResources(cluster.ReservedResources(source)),
+		ClusterNetwork:    clusterNetworkOverride(source.Spec.ClusterNetwork, template.Spec.ClusterNetwork),
+	}
+	if source.Spec.Topology == nil {
+		return spec, nil
+	}
+
+	// only the CNI options and the variables of the template are cloned, the other variables of the topology are set
+	// from the hosts of the nodes, the reserved resources or by the providers
+	templateVariables := map[string]bool{}
+	for _, variable := range template.Spec.Variables {
+		templateVariables[variable.Name] = true
+	}
+	variables := map[string]interface{}{}
+	for _, variable := range source.Spec.Topology.Variables {
+		switch {
+		case variable.Name == controlplaneprovider.FlannelBackend:
+			var backend api.CNIOptionsFlannelBackend
+			if err := json.Unmarshal(variable.Value.Raw, &backend); err != nil {
+				return api.ClusterSpec{}, fmt.Errorf("invalid value of variable %s: %w", variable.Name, err)
+			}
+			spec.Cni = &api.CNIOptions{FlannelBackend: &backend}
+		case templateVariables[variable.Name]:
+			var value interface{}
+			if err := json.Unmarshal(variable.Value.Raw, &value); err != nil {
+				return api.ClusterSpec{}, fmt.Errorf("invalid value of variable %s: %w", variable.Name, err)
+			}
+			variables[variable.Name] = value
+		}
+	}
+	if len(variables) > 0 {
+		spec.Variables = &variables
+	}
+	return spec, nil
+}
+
+// clusterNetworkOverride returns the pod and service CIDR blocks of the network of the cluster that differ from those
+// of the network of its template, nil if the cluster was created with the network of the template
+func clusterNetworkOverride(clusterNetwork *capi.ClusterNetwork, templateNetwork ct.ClusterNetwork) *api.ClusterNetwork {
+	if clusterNetwork == nil {
+		return nil
+	}
+	override := api.ClusterNetwork{}
+	if clusterNetwork.Pods != nil && len(clusterNetwork.Pods.CIDRBlocks) > 0 && !slices.Equal(clusterNetwork.Pods.CIDRBlocks, cidrBlocks(templateNetwork.Pods)) {
+		override.Pods = &api.NetworkRanges{CidrBlocks: clusterNetwork.Pods.CIDRBlocks}
+	}
+	if clusterNetwork.Services != nil && len(clusterNetwork.Services.CIDRBlocks) > 0 && !slices.Equal(clusterNetwork.Services.CIDRBlocks, cidrBlocks(templateNetwork.Services)) {
+		override.Services = &api.NetworkRanges{CidrBlocks: clusterNetwork.Services.CIDRBlocks}
+	}
+	if override.Pods == nil && override.Services == nil {
+		return nil
+	}
+	return &override
+}
+
+// cidrBlocks returns the CIDR blocks of the network ranges of a template, nil if it has none
+func cidrBlocks(ranges *ct.NetworkRanges) []string {
+	if ranges == nil {
+		return nil
+	}
+	return ranges.CIDRBlocks
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestCloneClusterSpec(t *testing.T) {
+	source := &capi.Cluster{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "store-001",
+			Labels:      map[string]string{"edge-orchestrator.intel.com/clustername": "store-001", "env": "prod", "site": "store-001"},
+			Annotations: map[string]string{core.TemplateLabelKey: "baseline-v1.0.0"},
+		},
+		Spec: capi.ClusterSpec{
+			Topology: &capi.Topology{
+				Variables: []capi.ClusterVariable{
+					{Name: "readOnly", Value: apiextensionsv1.JSON{Raw: []byte(`true`)}},
+					{Name: "flannelBackend", Value: apiextensionsv1.JSON{Raw: []byte(`"wireguard-native"`)}},
+					{Name: "maxPods", Value: apiextensionsv1.JSON{Raw: []byte(`200`)}},
+				},
+			},
+		},
+	}
+	cluster.SetReservedResources(source, &ct.ReservedResources{System: &ct.ResourceReservation{CPU: "500m"}})
+	template := ct.ClusterTemplate{
+		ObjectMeta: v1.ObjectMeta{Name: "baseline-v1.0.0"},
+		Spec:       ct.ClusterTemplateSpec{Variables: []ct.TemplateVariable{{Name: "maxPods"}}},
+	}
+	nodes := []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc02", Role: api.All}}
+
+	spec, err := cloneClusterSpec(source, template, &api.ClusterCloneRequest{
+		Name:   convert.Ptr("store-042"),
+		Nodes:  nodes,
+		Labels: &map[string]string{"site": "store-042"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "store-042", *spec.Name)
+	require.Equal(t, "baseline-v1.0.0", *spec.Template)
+	require.Equal(t, nodes, spec.Nodes)
+	require.Equal(t, map[string]string{"env": "prod", "site": "store-042"}, *spec.Labels)
+	require.Equal(t, map[string]interface{}{"maxPods": float64(200)}, *spec.Variables)
+	require.Equal(t, api.CNIOptionsFlannelBackend("wireguard-native"), *spec.Cni.FlannelBackend)
+	require.Equal(t, "500m", *spec.ReservedResources.System.Cpu)
+	require.Nil(t, spec.DependsOn)
+	require.Nil(t, spec.ClusterNetwork)
+
+	t.Run("network overrides are cloned", func(t *testing.T) {
+		template := template
+		template.Spec.ClusterNetwork = ct.ClusterNetwork{
+			Pods:     &ct.NetworkRanges{CIDRBlocks: []string{"10.42.0.0/16"}},
+			Services: &ct.NetworkRanges{CIDRBlocks: []string{"10.43.0.0/16"}},
+		}
+		source := source.DeepCopy()
+		source.Spec.ClusterNetwork = &capi.ClusterNetwork{
+			Pods:     &capi.NetworkRanges{CIDRBlocks: []string{"10.52.0.0/16"}},
+			Services: &capi.NetworkRanges{CIDRBlocks: []string{"10.43.0.0/16"}},
+		}
+
+		spec, err := cloneClusterSpec(source, template, &api.ClusterCloneRequest{Nodes: nodes})
+		require.NoError(t, err)
+		require.Equal(t, &api.ClusterNetwork{Pods: &api.NetworkRanges{CidrBlocks: []string{"10.52.0.0/16"}}}, spec.ClusterNetwork)
+
+		// the network of the template is not an override
+		source.Spec.ClusterNetwork.Pods.CIDRBlocks = []string{"10.42.0.0/16"}
+		spec, err = cloneClusterSpec(source, template, &api.ClusterCloneRequest{Nodes: nodes})
+		require.NoError(t, err)
+		require.Nil(t, spec.ClusterNetwork)
+	})
+}
+
+func TestPostV2ClustersNameClone(t *testing.T) {
+	cloneRequest := api.ClusterCloneRequest{Nodes: []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc02", Role: api.All}}}
+
+	t.Run("cluster is cloned", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		template := haControlPlaneTemplate(t, "baseline-v1.0.0")
+		require.NoError(t, unstructured.SetNestedStringSlice(template.Object, []string{"10.42.0.0/16"}, "spec", "clusterNetwork", "pods", "cidrBlocks"))
+		_, err := client.Dyn.Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Create(context.Background(), template, v1.CreateOptions{})
+		require.NoError(t, err)
+
+		source := nodePoolCluster(t)
+		source.SetLabels(map[string]string{"env": "prod", "site": "store-001"})
+		require.NoError(t, unstructured.SetNestedStringSlice(source.Object, []string{"10.52.0.0/16"}, "spec", "clusterNetwork", "pods", "cidrBlocks"))
+		_, err = client.Dyn.Resource(core.ClusterResourceSchema).Namespace(activeProjectID).Create(context.Background(), source, v1.CreateOptions{})
+		require.NoError(t, err)
+
+		request := cloneRequest
+		request.Name = convert.Ptr("store-042")
+		request.Labels = &map[string]string{"site": "store-042"}
+		rr := serveHTTPRequest(t, client.Dyn, newRequest(t, http.MethodPost, "/v2/clusters/example-cluster/clone", request),
+			WithConfig(&config.Config{ClusterDomain: "kind.internal"}))
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		clone, err := client.GetCluster(context.Background(), activeProjectID, "store-042")
+		require.NoError(t, err)
+		require.Equal(t, "baseline-v1.0.0", clone.Annotations[core.TemplateLabelKey])
+		require.Equal(t, "prod", clone.Labels["env"])
+		require.Equal(t, "store-042", clone.Labels["site"])
+		require.NotNil(t, clone.Spec.ClusterNetwork)
+		require.Equal(t, []string{"10.52.0.0/16"}, clone.Spec.ClusterNetwork.Pods.CIDRBlocks)
+	})
+
+	t.Run("missing cluster", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodPost, "/v2/clusters/example-cluster/clone", cloneRequest)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
+	})
+
+	t.Run("cluster without template", func(t *testing.T) {
+		source := nodePoolCluster(t)
+		source.SetAnnotations(nil)
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(source, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodPost, "/v2/clusters/example-cluster/clone", cloneRequest)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNotClonable, rr.Body.Bytes())
+	})
+
+	t.Run("clone without nodes", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/clusters/example-cluster/clone", api.ClusterCloneRequest{Nodes: []api.NodeSpec{}})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/clusters/{name}/nodepools)
+func (s *Server) PostV2ClustersNameNodepools(ctx context.Context, request api.PostV2ClustersNameNodepoolsRequestObject) (api.PostV2ClustersNameNodepoolsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if request.Body == nil {
+		message := messages.New(messages.NodePoolMissing)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2ClustersNameNodepools400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	nodePool := *request.Body
+
+	if message := validateNodePool(nodePool.Labels, nodePool.Taints); message != nil {
+		logger.FromContext(ctx).Warn(message.String(), "labels", nodePool.Labels, "taints", nodePool.Taints)
+		return api.PostV2ClustersNameNodepools400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, *message))}, nil
+	}
+
+	var nodes []api.NodeSpec
+	if nodePool.Nodes != nil {
+		if len(*nodePool.Nodes) != int(nodePool.Replicas) {
+			message := messages.New(messages.NodePoolReplicasMismatch, nodePool.Name, nodePool.Replicas, len(*nodePool.Nodes))
+			logger.FromContext(ctx).Warn(message.String())
+			return api.PostV2ClustersNameNodepools400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+		for _, id := range *nodePool.Nodes {
+			nodes = append(nodes, api.NodeSpec{Id: id, Role: api.Worker})
+		}
+	}
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cluster, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// hosts must be bound to the worker machines when the Intel infra provider is used
+	templateName := cluster.Annotations[core.TemplateLabelKey]
+	template, err := cli.GetClusterTemplate(ctx, activeProjectID, templateName)
+	if err != nil {
+		message := messages.New(messages.TemplateOfClusterGetFailed, templateName, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	bindNodes := api.TemplateInfoInfraprovidertype(template.Spec.InfraProviderType) == api.Intel
+	if bindNodes && len(nodes) != int(nodePool.Replicas) {
+		message := messages.New(messages.NodePoolNodesRequired, nodePool.Name)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2ClustersNameNodepools400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	var workerMachineTemplate string
+	if bindNodes {
+		workerMachineTemplate, err = machineTemplateName(templateName, template.Status.WorkerMachineTemplateRef)
+		if err != nil {
+			message := messages.New(messages.MachineBindingsFailed, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.PostV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// the nodes of the pool must fit into the quota of the project
+	err = s.checkQuota(ctx, activeProjectID, multitenancy.QuotaRequest{Nodes: int(nodePool.Replicas)})
+	switch {
+	case errors.Is(err, multitenancy.ErrQuotaExceeded):
+		message := messages.New(messages.QuotaExceeded, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "cluster", request.Name)
+		return api.PostV2ClustersNameNodepools403JSONResponse{N403ForbiddenJSONResponse: api.N403ForbiddenJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.QuotaCheckFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	err = cli.UpdateClusterWorkers(ctx, activeProjectID, request.Name, func(workers *capi.WorkersTopology) error {
+		for _, md := range workers.MachineDeployments {
+			if md.Name == nodePool.Name {
+				return errNodePoolExists
+			}
+		}
+
+		replicas := nodePool.Replicas
+		md := capi.MachineDeploymentTopology{
+			Class:    common.WorkerClass,
+			Name:     nodePool.Name,
+			Replicas: &replicas,
+		}
+		if nodePool.Labels != nil {
+			if err := setNodePoolLabels(&md, *nodePool.Labels); err != nil {
+				return err
+			}
+		}
+		if nodePool.Taints != nil {
+			if err := setNodePoolTaints(&md, *nodePool.Taints); err != nil {
+				return err
+			}
+		}
+		workers.MachineDeployments = append(workers.MachineDeployments, md)
+		return nil
+	})
+	switch {
+	case errors.Is(err, errNodePoolExists):
+		message := messages.New(messages.NodePoolExists, nodePool.Name, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsBadRequest(err), k8serrors.IsInvalid(err):
+		message := messages.New(messages.NodePoolInvalid, nodePool.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.NodePoolAddFailed, nodePool.Name, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	if bindNodes {
+		if err := createBindings(ctx, cli, activeProjectID, request.Name, workerMachineTemplate, nodes); err != nil {
+			message := messages.New(messages.MachineBindingsFailed, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.PostV2ClustersNameNodepools500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	logger.FromContext(ctx).Info("Node pool created", "namespace", activeProjectID, "cluster", request.Name, "name", nodePool.Name, "replicas", nodePool.Replicas)
+	nodePool.Nodes = nil
+	return api.PostV2ClustersNameNodepools201JSONResponse(nodePool), nil
+}
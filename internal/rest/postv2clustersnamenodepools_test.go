@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func nodePoolTemplate(t *testing.T, infraProviderType string) *unstructured.Unstructured {
+	template := v1alpha1.ClusterTemplate{
+		TypeMeta:   v1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: "ClusterTemplate"},
+		ObjectMeta: v1.ObjectMeta{Name: "baseline-v1.0.0", Namespace: activeProjectID},
+		Spec: v1alpha1.ClusterTemplateSpec{
+			ControlPlaneProviderType: "k3s",
+			InfraProviderType:        infraProviderType,
+			KubernetesVersion:        "v1.30.6+k3s1",
+		},
+		Status: v1alpha1.ClusterTemplateStatus{
+			Ready:                          true,
+			ClusterClassRef:                &corev1.ObjectReference{Name: "baseline-v1.0.0"},
+			ControlPlaneMachineTemplateRef: &corev1.ObjectReference{Name: "baseline-v1.0.0-controlplane"},
+			WorkerMachineTemplateRef:       &corev1.ObjectReference{Name: "baseline-v1.0.0-worker"},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestPostV2ClustersNameNodepools(t *testing.T) {
+	nodePool := api.NodePool{
+		Name:     "edge-workers",
+		Replicas: 1,
+		Labels:   &map[string]string{"zone": "store-1"},
+		Taints:   &[]api.NodeTaint{{Key: "dedicated", Value: ptr("edge"), Effect: api.PreferNoSchedule}},
+	}
+
+	t.Run("node pool is added to the cluster topology", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t, gpuNodePool()), nil)
+		clusters.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				var cluster capi.Cluster
+				require.NoError(t, convert.FromUnstructured(*obj, &cluster))
+				require.Len(t, cluster.Spec.Topology.Workers.MachineDeployments, 2)
+
+				md := cluster.Spec.Topology.Workers.MachineDeployments[1]
+				require.Equal(t, common.WorkerClass, md.Class)
+				require.Equal(t, "edge-workers", md.Name)
+				require.Equal(t, int32(1), *md.Replicas)
+				require.Len(t, md.Variables.Overrides, 2)
+				require.JSONEq(t, `["zone=store-1"]`, string(md.Variables.Overrides[0].Value.Raw))
+				require.JSONEq(t, `["dedicated=edge:PreferNoSchedule"]`, string(md.Variables.Overrides[1].Value.Raw))
+				return obj, nil
+			})
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "docker"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodPost, "/v2/clusters/example-cluster/nodepools", nodePool)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var created api.NodePool
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+		require.Equal(t, nodePool, created)
+	})
+
+	t.Run("node pool already exists", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t, gpuNodePool()), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "docker"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodPost, "/v2/clusters/example-cluster/nodepools", api.NodePool{Name: "gpu-workers", Replicas: 1})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("intel node pool requires one node per replica", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodPost, "/v2/clusters/example-cluster/nodepools", nodePool)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("nodes do not match replicas", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/clusters/example-cluster/nodepools", api.NodePool{
+			Name:     "edge-workers",
+			Replicas: 2,
+			Nodes:    &[]string{"6e6422c3-625e-507a-bc8a-bd2330e07e7e"},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("invalid label keys", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/clusters/example-cluster/nodepools", api.NodePool{
+			Name:     "edge-workers",
+			Replicas: 1,
+			Labels:   &map[string]string{"invalid key!": "value"},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.InvalidNodePoolLabelKeys, rr.Body.Bytes())
+	})
+}
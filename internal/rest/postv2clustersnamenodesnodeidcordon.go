@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// maxMaintenanceWindow bounds the maintenance window of a host, so that a host that never comes back from its
+// maintenance is remediated again eventually
+const maxMaintenanceWindow = 24 * time.Hour
+
+var errNodeNotJoined = errors.New("node has not joined the cluster")
+
+// (POST /v2/clusters/{name}/nodes/{nodeId}/cordon)
+func (s *Server) PostV2ClustersNameNodesNodeIdCordon(ctx context.Context, request api.PostV2ClustersNameNodesNodeIdCordonRequestObject) (api.PostV2ClustersNameNodesNodeIdCordonResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.cordoner == nil {
+		message := messages.New(messages.NodeCordonDisabled)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdCordon501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, message))}, nil
+	}
+
+	var drain bool
+	var window time.Duration
+	if request.Body != nil {
+		drain = request.Body.Drain != nil && *request.Body.Drain
+		if request.Body.MaintenanceWindow != nil {
+			var err error
+			window, err = time.ParseDuration(*request.Body.MaintenanceWindow)
+			if err != nil || window <= 0 || window > maxMaintenanceWindow {
+				message := messages.New(messages.MaintenanceWindowInvalid, *request.Body.MaintenanceWindow, maxMaintenanceWindow)
+				logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+				return api.PostV2ClustersNameNodesNodeIdCordon400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+			}
+		}
+	}
+
+	cli := k8s.New(s.k8sclient)
+	if _, err := cli.GetCluster(ctx, activeProjectID, request.Name); err != nil {
+		if errors.Is(err, k8s.ErrClusterNotFound) {
+			message := messages.New(messages.ClusterNotFound, request.Name)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+			return api.PostV2ClustersNameNodesNodeIdCordon404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+		}
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdCordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	machine, err := nodeMachine(ctx, cli, activeProjectID, request.Name, request.NodeId)
+	switch {
+	case errors.Is(err, errNodeNotInCluster):
+		message := messages.New(messages.NodeNotInCluster, request.NodeId, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdCordon404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, errNodeNotJoined):
+		message := messages.New(messages.NodeNotJoined, request.NodeId, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdCordon409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.MachinesGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdCordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	nodeName := machine.Status.NodeRef.Name
+
+	// the window is recorded before the node is cordoned and drained, so that the machine is not remediated while its
+	// pods are evicted
+	response := api.NodeMaintenance{NodeId: request.NodeId, NodeName: nodeName, Unschedulable: true}
+	if window > 0 {
+		end := time.Now().Add(window).UTC().Truncate(time.Second)
+		if err := cli.SetMachineMaintenanceWindow(ctx, activeProjectID, machine, &end); err != nil {
+			message := messages.New(messages.MaintenanceWindowFailed, request.NodeId, request.Name, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.PostV2ClustersNameNodesNodeIdCordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+		response.MaintenanceWindowEnd = &end
+	}
+
+	if err := s.cordoner.Cordon(ctx, activeProjectID, request.Name, true, nodeName); err != nil {
+		message := messages.New(messages.NodeCordonFailed, request.NodeId, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdCordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	if drain {
+		if err := s.drainMachines(ctx, activeProjectID, request.Name, machine); err != nil {
+			message := messages.New(messages.NodeCordonDrainFailed, request.NodeId, request.Name, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+			return api.PostV2ClustersNameNodesNodeIdCordon409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	logger.FromContext(ctx).Info("node cordoned", "namespace", activeProjectID, "cluster", request.Name, "node", request.NodeId, "drain", drain, "maintenanceWindow", window)
+	return api.PostV2ClustersNameNodesNodeIdCordon200JSONResponse(response), nil
+}
+
+// nodeMachine returns the machine of the cluster whose provider machine runs on the host with the given id; the node
+// of the machine must have joined the workload cluster to be cordoned
+func nodeMachine(ctx context.Context, cli *k8s.Client, namespace, clusterName, nodeID string) (capi.Machine, error) {
+	machine, err := cli.GetMachineByProviderHostID(ctx, namespace, nodeID)
+	if errors.Is(err, k8s.ErrMachineNotFound) || (err == nil && machine.Spec.ClusterName != clusterName) {
+		return capi.Machine{}, errNodeNotInCluster
+	}
+	if err != nil {
+		return capi.Machine{}, err
+	}
+	if machine.Status.NodeRef == nil {
+		return capi.Machine{}, errNodeNotJoined
+	}
+	return machine, nil
+}
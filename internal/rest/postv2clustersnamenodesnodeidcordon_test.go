@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// cordonMachines mocks the machines of the two node cluster, the worker machine has joined the cluster as node
+// edge-node-2 if joined is set
+func cordonMachines(t *testing.T, joined bool) *k8s.MockResourceInterface {
+	worker := twoNodeMachine(t, "worker-machine", false)
+	if joined {
+		require.NoError(t, unstructured.SetNestedField(worker.Object, "edge-node-2", "status", "nodeRef", "name"))
+	}
+	machines := k8s.NewMockResourceInterface(t)
+	machines.EXPECT().List(mock.Anything, mock.Anything).Return(&unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{*twoNodeMachine(t, "cp-machine", true), *worker},
+	}, nil)
+	return machines
+}
+
+// annotationPatch matches the merge patch of an annotation, set if set is true and removed otherwise
+func annotationPatch(key string, set bool) any {
+	return mock.MatchedBy(func(data []byte) bool {
+		var patch struct {
+			Metadata struct {
+				Annotations map[string]*string `json:"annotations"`
+			} `json:"metadata"`
+		}
+		if json.Unmarshal(data, &patch) != nil {
+			return false
+		}
+		value, ok := patch.Metadata.Annotations[key]
+		return ok && (value != nil) == set
+	})
+}
+
+func TestPostV2ClustersNameNodesNodeIdCordon(t *testing.T) {
+	const path = "/v2/clusters/example-cluster/nodes/" + workerNodeID + "/cordon"
+
+	clusters := func(t *testing.T) *k8s.MockResourceInterface {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(twoNodeCluster(t), nil)
+		return clusters
+	}
+
+	t.Run("node is cordoned and drained within its maintenance window", func(t *testing.T) {
+		machines := cordonMachines(t, true)
+		machines.EXPECT().Patch(mock.Anything, "worker-machine", types.MergePatchType, annotationPatch(capi.MachineSkipRemediationAnnotation, true), metav1.PatchOptions{}).Return(nil, nil)
+		intelMachines := twoNodeIntelMachines(t)
+		intelMachines.EXPECT().Patch(mock.Anything, "worker-intelmachine", types.MergePatchType, annotationPatch(core.MaintenanceWindowEndAnnotationKey, true), metav1.PatchOptions{}).Return(nil, nil)
+
+		cordoner := &fakeCordoner{}
+		drainer := &fakeDrainer{}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters(t),
+			core.MachineResourceSchema:     machines,
+			k8s.IntelMachineResourceSchema: intelMachines,
+		}, http.MethodPost, path, api.NodeCordonRequest{Drain: ptr(true), MaintenanceWindow: ptr("2h")}, WithNodeCordoner(cordoner), WithNodeDrainer(drainer))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, []string{"edge-node-2"}, cordoner.cordoned)
+		require.NotNil(t, cordoner.unschedulable)
+		assert.True(t, *cordoner.unschedulable)
+		assert.Equal(t, []string{"edge-node-2"}, drainer.drained)
+
+		var response api.NodeMaintenance
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "edge-node-2", response.NodeName)
+		assert.True(t, response.Unschedulable)
+		require.NotNil(t, response.MaintenanceWindowEnd)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), *response.MaintenanceWindowEnd, time.Minute)
+	})
+
+	t.Run("failed drain keeps the node cordoned", func(t *testing.T) {
+		cordoner := &fakeCordoner{}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters(t),
+			core.MachineResourceSchema:     cordonMachines(t, true),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodPost, path, api.NodeCordonRequest{Drain: ptr(true)}, WithNodeCordoner(cordoner), WithNodeDrainer(&fakeDrainer{err: errors.New("pod disruption budget")}))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeCordonDrainFailed, rr.Body.Bytes())
+		assert.Equal(t, []string{"edge-node-2"}, cordoner.cordoned)
+	})
+
+	t.Run("nodes that have not joined the cluster are not cordoned", func(t *testing.T) {
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters(t),
+			core.MachineResourceSchema:     cordonMachines(t, false),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodPost, path, api.NodeCordonRequest{}, WithNodeCordoner(&fakeCordoner{}))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeNotJoined, rr.Body.Bytes())
+	})
+
+	t.Run("maintenance window is bounded", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPost, path, api.NodeCordonRequest{MaintenanceWindow: ptr("48h")}, WithNodeCordoner(&fakeCordoner{}))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.MaintenanceWindowInvalid, rr.Body.Bytes())
+	})
+
+	t.Run("cordoning is not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPost, path, api.NodeCordonRequest{})
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeCordonDisabled, rr.Body.Bytes())
+	})
+}
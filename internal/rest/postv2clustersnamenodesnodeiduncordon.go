@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/clusters/{name}/nodes/{nodeId}/uncordon)
+func (s *Server) PostV2ClustersNameNodesNodeIdUncordon(ctx context.Context, request api.PostV2ClustersNameNodesNodeIdUncordonRequestObject) (api.PostV2ClustersNameNodesNodeIdUncordonResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.cordoner == nil {
+		message := messages.New(messages.NodeCordonDisabled)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdUncordon501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cli := k8s.New(s.k8sclient)
+	if _, err := cli.GetCluster(ctx, activeProjectID, request.Name); err != nil {
+		if errors.Is(err, k8s.ErrClusterNotFound) {
+			message := messages.New(messages.ClusterNotFound, request.Name)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+			return api.PostV2ClustersNameNodesNodeIdUncordon404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+		}
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdUncordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	machine, err := nodeMachine(ctx, cli, activeProjectID, request.Name, request.NodeId)
+	switch {
+	case errors.Is(err, errNodeNotInCluster):
+		message := messages.New(messages.NodeNotInCluster, request.NodeId, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdUncordon404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, errNodeNotJoined):
+		message := messages.New(messages.NodeNotJoined, request.NodeId, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdUncordon409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.MachinesGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdUncordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	nodeName := machine.Status.NodeRef.Name
+
+	// the node is uncordoned before the maintenance window is removed, so that the machine is not remediated while it
+	// is still cordoned
+	if err := s.cordoner.Cordon(ctx, activeProjectID, request.Name, false, nodeName); err != nil {
+		message := messages.New(messages.NodeUncordonFailed, request.NodeId, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdUncordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	if err := cli.SetMachineMaintenanceWindow(ctx, activeProjectID, machine, nil); err != nil {
+		message := messages.New(messages.MaintenanceWindowFailed, request.NodeId, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameNodesNodeIdUncordon500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("node uncordoned", "namespace", activeProjectID, "cluster", request.Name, "node", request.NodeId)
+	return api.PostV2ClustersNameNodesNodeIdUncordon200JSONResponse(api.NodeMaintenance{NodeId: request.NodeId, NodeName: nodeName}), nil
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPostV2ClustersNameNodesNodeIdUncordon(t *testing.T) {
+	const path = "/v2/clusters/example-cluster/nodes/" + workerNodeID + "/uncordon"
+
+	clusters := func(t *testing.T) *k8s.MockResourceInterface {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", metav1.GetOptions{}).Return(twoNodeCluster(t), nil)
+		return clusters
+	}
+
+	t.Run("node is uncordoned and its maintenance window removed", func(t *testing.T) {
+		machines := cordonMachines(t, true)
+		machines.EXPECT().Patch(mock.Anything, "worker-machine", types.MergePatchType, annotationPatch(capi.MachineSkipRemediationAnnotation, false), metav1.PatchOptions{}).Return(nil, nil)
+		intelMachines := twoNodeIntelMachines(t)
+		intelMachines.EXPECT().Patch(mock.Anything, "worker-intelmachine", types.MergePatchType, annotationPatch(core.MaintenanceWindowEndAnnotationKey, false), metav1.PatchOptions{}).Return(nil, nil)
+
+		cordoner := &fakeCordoner{}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters(t),
+			core.MachineResourceSchema:     machines,
+			k8s.IntelMachineResourceSchema: intelMachines,
+		}, http.MethodPost, path, nil, WithNodeCordoner(cordoner))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, []string{"edge-node-2"}, cordoner.cordoned)
+		require.NotNil(t, cordoner.unschedulable)
+		assert.False(t, *cordoner.unschedulable)
+
+		var response api.NodeMaintenance
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, api.NodeMaintenance{NodeId: workerNodeID, NodeName: "edge-node-2"}, response)
+	})
+
+	t.Run("failed uncordon keeps the maintenance window", func(t *testing.T) {
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters(t),
+			core.MachineResourceSchema:     cordonMachines(t, true),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodPost, path, nil, WithNodeCordoner(&fakeCordoner{err: errors.New("connection refused")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeUncordonFailed, rr.Body.Bytes())
+	})
+
+	t.Run("nodes of other clusters are not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "other-cluster", metav1.GetOptions{}).Return(twoNodeCluster(t), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters,
+			core.MachineResourceSchema:     cordonMachines(t, true),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodPost, "/v2/clusters/other-cluster/nodes/"+workerNodeID+"/uncordon", nil, WithNodeCordoner(&fakeCordoner{}))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeNotInCluster, rr.Body.Bytes())
+	})
+}
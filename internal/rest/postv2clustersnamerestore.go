@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/clusters/{name}/restore)
+func (s *Server) PostV2ClustersNameRestore(ctx context.Context, request api.PostV2ClustersNameRestoreRequestObject) (api.PostV2ClustersNameRestoreResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if request.Body == nil || request.Body.Backup == "" {
+		message := messages.New(messages.BackupMissing)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2ClustersNameRestore400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	backupName := request.Body.Backup
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2ClustersNameRestore500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	_, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	backup, err := cli.GetBackup(ctx, activeProjectID, backupName)
+	switch {
+	case errors.Is(err, k8s.ErrBackupNotFound) || (err == nil && backup.Spec.ClusterName != request.Name):
+		message := messages.New(messages.BackupNotFound, backupName, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.BackupGetFailed, backupName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	if backup.Status.Phase != v1alpha1.BackupCompleted {
+		message := messages.New(messages.BackupNotCompleted, backupName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+	if restoreInProgress(backup) {
+		message := messages.New(messages.RestoreInProgress, request.Name, backupName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+
+	backups, err := cli.Backups(ctx, activeProjectID, request.Name)
+	if err != nil {
+		message := messages.New(messages.BackupsListFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	for _, other := range backups {
+		if restoreInProgress(other) {
+			message := messages.New(messages.RestoreInProgress, request.Name, other.Name)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+			return api.PostV2ClustersNameRestore409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	if err := cli.RequestRestore(ctx, activeProjectID, backupName, time.Now().UTC().Format(time.RFC3339Nano)); err != nil {
+		message := messages.New(messages.RestoreRequestFailed, request.Name, backupName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2ClustersNameRestore500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster restore requested", "namespace", activeProjectID, "cluster", request.Name, "backup", backupName)
+	response := clusterBackup(backup)
+	response.Restore = &api.ClusterRestore{Phase: api.ClusterRestorePhaseRunning}
+	return api.PostV2ClustersNameRestore202JSONResponse(response), nil
+}
+
+// restoreInProgress returns true if a restore from the backup was requested and has not finished yet
+func restoreInProgress(backup v1alpha1.Backup) bool {
+	requestedAt := backup.Annotations[v1alpha1.BackupRestoreAnnotation]
+	if requestedAt == "" {
+		return false
+	}
+	restore := backup.Status.Restore
+	return restore == nil || restore.RequestedAt != requestedAt || restore.Phase == v1alpha1.RestoreRunning
+}
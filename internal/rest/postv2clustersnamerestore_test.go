@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPostV2ClustersNameRestore(t *testing.T) {
+	completed := v1alpha1.BackupStatus{Phase: v1alpha1.BackupCompleted, SnapshotName: "example-cluster-x7k2p-node-1"}
+	restoreRequest := api.ClusterRestoreRequest{Backup: "example-cluster-x7k2p"}
+
+	t.Run("restore is requested from a completed backup", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		backup := clusterBackupResource(t, "example-cluster-x7k2p", "example-cluster", time.Now(), completed)
+		backups := k8s.NewMockResourceInterface(t)
+		backups.EXPECT().Get(mock.Anything, "example-cluster-x7k2p", v1.GetOptions{}).Return(&backup, nil)
+		backups.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{backup}}, nil)
+		backups.EXPECT().Patch(mock.Anything, "example-cluster-x7k2p", types.MergePatchType, mock.MatchedBy(func(data []byte) bool {
+			var patch struct {
+				Metadata struct {
+					Annotations map[string]string `json:"annotations"`
+				} `json:"metadata"`
+			}
+			return json.Unmarshal(data, &patch) == nil && patch.Metadata.Annotations[v1alpha1.BackupRestoreAnnotation] != ""
+		}), v1.PatchOptions{}).Return(&backup, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.BackupResourceSchema:  backups,
+		}, http.MethodPost, "/v2/clusters/example-cluster/restore", restoreRequest)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var response api.ClusterBackup
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, "example-cluster-x7k2p", response.Name)
+		require.Equal(t, api.ClusterRestorePhaseRunning, response.Restore.Phase)
+	})
+
+	t.Run("incomplete backups can not be restored", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		backup := clusterBackupResource(t, "example-cluster-x7k2p", "example-cluster", time.Now(), v1alpha1.BackupStatus{Phase: v1alpha1.BackupRunning})
+		backups := k8s.NewMockResourceInterface(t)
+		backups.EXPECT().Get(mock.Anything, "example-cluster-x7k2p", v1.GetOptions{}).Return(&backup, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.BackupResourceSchema:  backups,
+		}, http.MethodPost, "/v2/clusters/example-cluster/restore", restoreRequest)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("restores in progress are not repeated", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		restoring := completed
+		restoring.Restore = &v1alpha1.RestoreStatus{Phase: v1alpha1.RestoreRunning, RequestedAt: "2026-10-16T10:00:00Z"}
+		backup := clusterBackupResource(t, "example-cluster-x7k2p", "example-cluster", time.Now(), restoring)
+		backup.SetAnnotations(map[string]string{v1alpha1.BackupRestoreAnnotation: "2026-10-16T10:00:00Z"})
+		backups := k8s.NewMockResourceInterface(t)
+		backups.EXPECT().Get(mock.Anything, "example-cluster-x7k2p", v1.GetOptions{}).Return(&backup, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.BackupResourceSchema:  backups,
+		}, http.MethodPost, "/v2/clusters/example-cluster/restore", restoreRequest)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("backups of other clusters are not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		backup := clusterBackupResource(t, "example-cluster-x7k2p", "other-cluster", time.Now(), completed)
+		backups := k8s.NewMockResourceInterface(t)
+		backups.EXPECT().Get(mock.Anything, "example-cluster-x7k2p", v1.GetOptions{}).Return(&backup, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.BackupResourceSchema:  backups,
+		}, http.MethodPost, "/v2/clusters/example-cluster/restore", restoreRequest)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("missing backups are not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+
+		backups := k8s.NewMockResourceInterface(t)
+		backups.EXPECT().Get(mock.Anything, "example-cluster-x7k2p", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.BackupResourceSchema.GroupResource(), "example-cluster-x7k2p"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.BackupResourceSchema:  backups,
+		}, http.MethodPost, "/v2/clusters/example-cluster/restore", restoreRequest)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("backup is required", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/clusters/example-cluster/restore", api.ClusterRestoreRequest{})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+}
@@ -4,73 +4,96 @@ package rest
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
-	"log/slog"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 // (POST /v2/templates)
 func (s *Server) PostV2Templates(ctx context.Context, request api.PostV2TemplatesRequestObject) (api.PostV2TemplatesResponseObject, error) {
 	activeProjectID := request.Params.Activeprojectid.String()
-	slog.Debug("creating clusterTemplate", "namespace", activeProjectID)
+	logger.FromContext(ctx).Debug("creating clusterTemplate", "namespace", activeProjectID)
 
 	name := request.Body.Name
 
-	ct, err := template.FromTemplateInfoToClusterTemplate(*request.Body)
+	clusterTemplate, err := template.FromTemplateInfoToClusterTemplate(*request.Body)
 	if err != nil {
-		slog.Error("failed to convert templateInfo to clusterTemplate", "templateInfo", request.Body, "error", err)
+		logger.FromContext(ctx).Error("failed to convert templateInfo to clusterTemplate", "templateInfo", request.Body, "error", err)
 		return api.PostV2Templates400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr(fmt.Sprintf("invalid clusterconfiguration: %s", err.Error())),
-			},
+			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, messages.New(messages.InvalidClusterConfiguration, err))),
 		}, nil
 	}
 
-	templateObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&ct)
-	if err != nil {
-		slog.Error("failed to convert clusterTemplate to unstructured", "clusterTemplate", ct, "error", err)
-		return api.PostV2Templates500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(err.Error()),
-			},
-		}, nil
+	// the webhook checks the cluster network as well, but only if it is configured with the reserved networks
+	violation := s.reserved.Check(&clusterTemplate.Spec.ClusterNetwork)
+	if err := s.rules.Check(validation.ClusterNetwork, violation, "namespace", activeProjectID, "name", name); err != nil {
+		message := messages.New(messages.ClusterNetworkReserved, name, err)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2Templates400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
-	unstructuredClusterTemplate := &unstructured.Unstructured{Object: templateObject}
-	_, err = s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Create(ctx, unstructuredClusterTemplate, v1.CreateOptions{})
+	// a retry of a request with an Idempotency-Key returns the result of the original request instead of a conflict
+	idempotent := newIdempotentRequest(request.Params.IdempotencyKey, request.Body)
+	if idempotent != nil {
+		existing, err := idempotent.find(ctx, s.k8sclient, core.TemplateResourceSchema, activeProjectID, "")
+		var reused messages.Message
+		switch {
+		case stderrors.As(err, &reused):
+			logger.FromContext(ctx).Warn(reused.String(), "namespace", activeProjectID)
+			return api.PostV2Templates409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, reused))}, nil
+		case err != nil:
+			message := messages.New(messages.IdempotencyCheckFailed, idempotent.key, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.PostV2Templates500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		case existing != nil:
+			logger.FromContext(ctx).Info("cluster template already created with the idempotency key", "namespace", activeProjectID, "name", existing.GetName(), "idempotencyKey", idempotent.key)
+			return api.PostV2Templates201JSONResponse(fmt.Sprintf("successfully imported template %s", name)), nil
+		}
+		idempotent.annotate(clusterTemplate)
+	}
 
+	err = s.createTemplate(ctx, activeProjectID, clusterTemplate)
 	if err != nil && errors.IsBadRequest(err) {
-		slog.Error("failed to create clusterTemplate - invalid clusterTemplate", "namespace", activeProjectID, "name", name, "error", err)
+		logger.FromContext(ctx).Error("failed to create clusterTemplate - invalid clusterTemplate", "namespace", activeProjectID, "name", name, "error", err)
 		return api.PostV2Templates400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, messages.New(messages.TemplateInvalid, name, err))),
 		}, nil
 	} else if err != nil && errors.IsAlreadyExists(err) {
-		slog.Error("failed to create clusterTemplate - clusterTemplate already exists", "namespace", activeProjectID, "name", name, "error", err)
+		logger.FromContext(ctx).Error("failed to create clusterTemplate - clusterTemplate already exists", "namespace", activeProjectID, "name", name, "error", err)
 		return api.PostV2Templates409JSONResponse{
-			N409ConflictJSONResponse: api.N409ConflictJSONResponse{
-				Message: ptr(fmt.Sprintf("template %s already exists", name)),
-			},
+			N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, messages.New(messages.TemplateExists, name))),
 		}, nil
 	} else if err != nil {
-		slog.Error("failed to create clusterTemplate", "namespace", activeProjectID, "name", name, "error", err)
+		logger.FromContext(ctx).Error("failed to create clusterTemplate", "namespace", activeProjectID, "name", name, "error", err)
 		return api.PostV2Templates500JSONResponse{
-			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{
-				Message: ptr(err.Error()),
-			},
+			N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, messages.New(messages.TemplateImportFailed, name, err))),
 		}, nil
 	}
 
-	slog.Info("Cluster Template created", "namespace", activeProjectID, "name", name)
+	logger.FromContext(ctx).Info("Cluster Template created", "namespace", activeProjectID, "name", name)
 
 	return api.PostV2Templates201JSONResponse(fmt.Sprintf("successfully imported template %s", name)), nil
 }
+
+// createTemplate creates the cluster template in the namespace
+func (s *Server) createTemplate(ctx context.Context, namespace string, clusterTemplate *ct.ClusterTemplate) error {
+	templateObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(clusterTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to convert clusterTemplate to unstructured: %w", err)
+	}
+
+	_, err = s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(namespace).Create(ctx, &unstructured.Unstructured{Object: templateObject}, v1.CreateOptions{})
+	return err
+}
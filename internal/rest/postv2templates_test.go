@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -19,6 +20,8 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
@@ -30,12 +33,14 @@ func TestPostV2Templates200(t *testing.T) {
 	cptype := api.Kubeadm
 	infratype := api.Docker
 
+	// the request validator sets the default label propagation policy of the template
 	templateInfo := api.TemplateInfo{
 		Name:                     "test",
 		Version:                  "v1.0.0",
 		Controlplaneprovidertype: &cptype,
 		Infraprovidertype:        &infratype,
 		KubernetesVersion:        "v1.21.0",
+		LabelPropagationPolicy:   ptr(api.TemplateInfoLabelPropagationPolicyNone),
 	}
 
 	clusterTemplate, err := template.FromTemplateInfoToClusterTemplate(templateInfo)
@@ -76,6 +81,58 @@ func TestPostV2Templates200(t *testing.T) {
 
 }
 
+// TestPostV2TemplatesYAML tests that templates can be imported as YAML
+func TestPostV2TemplatesYAML(t *testing.T) {
+	var expectedActiveProjectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+	cptype := api.K3s
+	infratype := api.Intel
+
+	// the request validator sets the default label propagation policy of the template
+	templateInfo := api.TemplateInfo{
+		Name:                     "test",
+		Version:                  "v1.0.0",
+		Controlplaneprovidertype: &cptype,
+		Infraprovidertype:        &infratype,
+		KubernetesVersion:        "v1.30.6+k3s1",
+		LabelPropagationPolicy:   ptr(api.TemplateInfoLabelPropagationPolicyNone),
+	}
+
+	clusterTemplate, err := template.FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err, "FromTemplateInfoToClusterTemplate() error = %v, want nil")
+
+	unstructuredClusterTemplate, err := convert.ToUnstructured(&clusterTemplate)
+	require.NoError(t, err, "convertClusterToUnstructured() error = %v, want nil")
+
+	resource := k8s.NewMockResourceInterface(t)
+	resource.EXPECT().Create(mock.Anything, unstructuredClusterTemplate, v1.CreateOptions{}).Return(nil, nil)
+	nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+	nsResource.EXPECT().Namespace(expectedActiveProjectID).Return(resource)
+	mockedk8sclient := k8s.NewMockInterface(t)
+	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsResource)
+
+	handler, err := NewServer(mockedk8sclient).ConfigureHandler()
+	require.Nil(t, err)
+
+	body := `name: test
+version: v1.0.0
+kubernetesVersion: v1.30.6+k3s1
+controlplaneprovidertype: k3s
+infraprovidertype: intel
+`
+	req := httptest.NewRequest("POST", "/v2/templates", strings.NewReader(body))
+	req.Header.Set("Activeprojectid", expectedActiveProjectID)
+	req.Header.Set("Content-Type", "application/yaml")
+	req.Header.Set("Accept", "application/yaml")
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, 201)
+	require.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+	require.Equal(t, "successfully imported template test\n", rr.Body.String())
+}
+
 func TestPostV2Templates400(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -151,6 +208,22 @@ func TestPostV2Templates400(t *testing.T) {
 			},
 			expectedError: " Error at \"/kubernetesVersion\"",
 		},
+		{
+			name:      "cluster network overlapping reserved networks",
+			projectId: expectedActiveProjectID,
+			templateInfo: api.TemplateInfo{
+				Name:                     "test",
+				Version:                  "v1.0.0",
+				Controlplaneprovidertype: ptr(api.K3s),
+				Infraprovidertype:        ptr(api.Docker),
+				KubernetesVersion:        "v1.32.4+k3s1",
+				ClusterNetwork: &api.ClusterNetwork{
+					Pods:     &api.NetworkRanges{CidrBlocks: []string{"10.42.0.0/16"}},
+					Services: &api.NetworkRanges{CidrBlocks: []string{"192.168.0.0/16"}},
+				},
+			},
+			expectedError: "service CIDR block 192.168.0.0/16 overlaps reserved infrastructure network 192.168.1.0/24",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -163,7 +236,9 @@ func TestPostV2Templates400(t *testing.T) {
 			rr := httptest.NewRecorder()
 
 			mockedk8sclient := k8s.NewMockInterface(t)
-			server := NewServer(mockedk8sclient)
+			reserved, err := network.ParseReserved([]string{"192.168.1.0/24"})
+			require.NoError(t, err)
+			server := NewServer(mockedk8sclient, WithReservedNetworks(reserved))
 			require.NotNil(t, server, "NewServer() returned nil, want not nil")
 
 			// create a handler with middleware
@@ -186,12 +261,14 @@ func TestPostV2Templates409(t *testing.T) {
 	cptype := api.Kubeadm
 	infratype := api.Docker
 
+	// the request validator sets the default label propagation policy of the template
 	templateInfo := api.TemplateInfo{
 		Name:                     "test",
 		Version:                  "v1.0.0",
 		Controlplaneprovidertype: &cptype,
 		Infraprovidertype:        &infratype,
 		KubernetesVersion:        "v1.21.0",
+		LabelPropagationPolicy:   ptr(api.TemplateInfoLabelPropagationPolicyNone),
 	}
 
 	// configure mockery to return a conflict error on a Create() call
@@ -244,12 +321,14 @@ func TestPostV2Templates500(t *testing.T) {
 	cptype := api.Kubeadm
 	infratype := api.Docker
 
+	// the request validator sets the default label propagation policy of the template
 	templateInfo := api.TemplateInfo{
 		Name:                     "test",
 		Version:                  "v1.0.0",
 		Controlplaneprovidertype: &cptype,
 		Infraprovidertype:        &infratype,
 		KubernetesVersion:        "v1.21.0",
+		LabelPropagationPolicy:   ptr(api.TemplateInfoLabelPropagationPolicyNone),
 	}
 
 	// configure mockery to return an internal server error on a Create() call
@@ -355,3 +434,56 @@ func FuzzPostV2Templates(f *testing.F) {
 		_, _ = server.PostV2Templates(context.Background(), req)
 	})
 }
+
+func TestPostV2TemplatesIdempotencyKey(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+	cptype := api.K3s
+	infratype := api.Intel
+	// the request validator sets the default label propagation policy of the template
+	templateInfo := api.TemplateInfo{
+		Name:                     "test",
+		Version:                  "v1.0.0",
+		Controlplaneprovidertype: &cptype,
+		Infraprovidertype:        &infratype,
+		KubernetesVersion:        "v1.30.6+k3s1",
+		LabelPropagationPolicy:   ptr(api.TemplateInfoLabelPropagationPolicyNone),
+	}
+
+	client := k8s.New().WithFakeClient()
+	handler, err := NewServer(client.Dyn).ConfigureHandler()
+	require.NoError(t, err)
+	post := func(info api.TemplateInfo, idempotencyKey string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(info)
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/v2/templates", bytes.NewReader(body))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		if idempotencyKey != "" {
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := post(templateInfo, "import-1")
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	templates, err := client.Dyn.Resource(core.TemplateResourceSchema).Namespace(expectedActiveProjectID).List(context.Background(), v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, templates.Items, 1)
+	require.Equal(t, "import-1", templates.Items[0].GetAnnotations()[core.IdempotencyKeyAnnotationKey])
+
+	// the retry returns the result of the original request instead of a conflict
+	rr = post(templateInfo, "import-1")
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	// the key cannot be reused for a different template, nor can the template be imported again without the key
+	other := templateInfo
+	other.Version = "v1.0.1"
+	rr = post(other, "import-1")
+	require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	requireCode(t, messages.IdempotencyKeyReused, rr.Body.Bytes())
+	rr = post(templateInfo, "")
+	require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	requireCode(t, messages.TemplateExists, rr.Body.Bytes())
+}
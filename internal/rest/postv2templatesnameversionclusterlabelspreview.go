@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/templates/{name}/{version}/clusterlabels/preview)
+func (s *Server) PostV2TemplatesNameVersionClusterlabelsPreview(ctx context.Context, request api.PostV2TemplatesNameVersionClusterlabelsPreviewRequestObject) (api.PostV2TemplatesNameVersionClusterlabelsPreviewResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	templateName := request.Name + "-" + request.Version
+	logger.FromContext(ctx).Debug("previewing propagation of template cluster labels", "namespace", activeProjectID, "name", templateName)
+
+	if request.Body == nil || !labels.Valid(request.Body.ClusterLabels) {
+		message := messages.New(messages.InvalidClusterLabels)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "name", templateName)
+		return api.PostV2TemplatesNameVersionClusterlabelsPreview400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	item, err := s.reader().Resource(core.TemplateResourceSchema).Namespace(activeProjectID).Get(ctx, templateName, v1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		message := messages.New(messages.TemplateNotFound, templateName)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplatesNameVersionClusterlabelsPreview404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateGetFailed, templateName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplatesNameVersionClusterlabelsPreview500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	var clusterTemplate ct.ClusterTemplate
+	if err := convert.FromUnstructured(*item, &clusterTemplate); err != nil {
+		message := messages.New(messages.TemplateConvertFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "name", templateName)
+		return api.PostV2TemplatesNameVersionClusterlabelsPreview500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	clusters, err := fetchClustersList(ctx, s.reader(), activeProjectID)
+	if err != nil {
+		message := messages.New(messages.ClustersListFailed)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "error", err)
+		return api.PostV2TemplatesNameVersionClusterlabelsPreview500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	preview, err := labelPropagationPreview(clusterTemplate, clusters, request.Body.ClusterLabels)
+	if err != nil {
+		message := messages.New(messages.ClustersListFailed)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID, "error", err)
+		return api.PostV2TemplatesNameVersionClusterlabelsPreview500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	return api.PostV2TemplatesNameVersionClusterlabelsPreview200JSONResponse(preview), nil
+}
+
+// labelPropagationPreview returns the labels the template controller would propagate to the clusters of the template
+// if its cluster labels changed to the given labels, and the labels it would keep because they were overridden on the
+// clusters
+func labelPropagationPreview(clusterTemplate ct.ClusterTemplate, clusters []unstructured.Unstructured, clusterLabels map[string]string) (api.LabelPropagationPreview, error) {
+	policy := api.LabelPropagationPreviewLabelPropagationPolicyNone
+	if clusterTemplate.PropagatesLabels() {
+		policy = api.LabelPropagationPreviewLabelPropagationPolicyPropagate
+	}
+	preview := api.LabelPropagationPreview{LabelPropagationPolicy: policy, Clusters: []api.ClusterLabelPropagation{}}
+
+	clusterClassName := clusterTemplate.Name
+	if clusterTemplate.Status.ClusterClassRef != nil {
+		clusterClassName = clusterTemplate.Status.ClusterClassRef.Name
+	}
+	for _, item := range clusters {
+		var cluster capi.Cluster
+		if err := convert.FromUnstructured(item, &cluster); err != nil {
+			return api.LabelPropagationPreview{}, err
+		}
+		if cluster.Spec.Topology == nil || cluster.Spec.Topology.Class != clusterClassName {
+			continue
+		}
+
+		changes := labels.Propagation(cluster.Labels, clusterTemplate.Status.PropagatedClusterLabels, clusterLabels)
+		overridden := []string{}
+		for key, value := range clusterLabels {
+			clusterValue, onCluster := cluster.Labels[key]
+			if _, changed := changes[key]; !changed && (!onCluster || clusterValue != value) {
+				overridden = append(overridden, key)
+			}
+		}
+		if len(changes) == 0 && len(overridden) == 0 {
+			continue
+		}
+		slices.Sort(overridden)
+		preview.Clusters = append(preview.Clusters, api.ClusterLabelPropagation{Name: cluster.Name, Labels: changes, OverriddenLabels: overridden})
+	}
+	return preview, nil
+}
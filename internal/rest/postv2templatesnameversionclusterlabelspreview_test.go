@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func previewCluster(t *testing.T, name, class string, labels map[string]string) unstructured.Unstructured {
+	cluster := capi.Cluster{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: expectedActiveProjectID, Labels: labels},
+		Spec:       capi.ClusterSpec{Topology: &capi.Topology{Class: class, Version: "v1.30.6"}},
+	}
+	u, err := convert.ToUnstructured(cluster)
+	require.NoError(t, err)
+	return *u
+}
+
+func TestPostV2TemplatesNameVersionClusterlabelsPreview(t *testing.T) {
+	const path = "/v2/templates/test-template/v0.0.1/clusterlabels/preview"
+
+	previewTemplate := template1.DeepCopy()
+	previewTemplate.Spec.ClusterLabels = map[string]string{"region": "eu", "tier": "gold"}
+	previewTemplate.Spec.LabelPropagationPolicy = v1alpha1.LabelPropagationPropagate
+	previewTemplate.Status.ClusterClassRef = &corev1.ObjectReference{Name: "test-template-v0.0.1"}
+	previewTemplate.Status.PropagatedClusterLabels = map[string]string{"region": "eu", "tier": "gold"}
+
+	serve := func(t *testing.T, server *Server, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		handler, err := server.ConfigureHandler()
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("previews the labels propagated to the clusters of the template", func(t *testing.T) {
+		unstructuredTemplate, err := convert.ToUnstructured(*previewTemplate)
+		require.NoError(t, err)
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, "test-template-v0.0.1", v1.GetOptions{}).Return(unstructuredTemplate, nil)
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+
+		clusterResource := k8s.NewMockResourceInterface(t)
+		clusterResource.EXPECT().List(mock.Anything, v1.ListOptions{}).Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			previewCluster(t, "unchanged", "test-template-v0.0.1", map[string]string{"region": "eu", "tier": "gold"}),
+			previewCluster(t, "overridden", "test-template-v0.0.1", map[string]string{"region": "eu", "tier": "silver"}),
+			previewCluster(t, "other", "other-template-v0.0.1", map[string]string{"region": "eu", "tier": "gold"}),
+		}}, nil)
+		nsClusterResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsClusterResource.EXPECT().Namespace(expectedActiveProjectID).Return(clusterResource)
+
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+		mockedk8sclient.EXPECT().Resource(core.ClusterResourceSchema).Return(nsClusterResource)
+
+		rr := serve(t, NewServer(mockedk8sclient), `{"cluster-labels": {"region": "us", "tier": "platinum"}}`)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var preview api.LabelPropagationPreview
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &preview))
+		require.Equal(t, api.LabelPropagationPreviewLabelPropagationPolicyPropagate, preview.LabelPropagationPolicy)
+		require.Equal(t, []api.ClusterLabelPropagation{
+			{Name: "unchanged", Labels: map[string]string{"region": "us", "tier": "platinum"}, OverriddenLabels: []string{}},
+			{Name: "overridden", Labels: map[string]string{"region": "us"}, OverriddenLabels: []string{"tier"}},
+		}, preview.Clusters)
+	})
+
+	t.Run("invalid labels", func(t *testing.T) {
+		rr := serve(t, NewServer(k8s.NewMockInterface(t)), `{"cluster-labels": {"region": "not a value"}}`)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		templateResource := k8s.NewMockResourceInterface(t)
+		templateResource.EXPECT().Get(mock.Anything, "test-template-v0.0.1", v1.GetOptions{}).
+			Return(nil, errors.NewNotFound(schema.GroupResource{Group: "edge-orchestrator.intel.com", Resource: "clustertemplates"}, "test-template-v0.0.1"))
+		nsTemplateResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsTemplateResource.EXPECT().Namespace(expectedActiveProjectID).Return(templateResource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsTemplateResource)
+
+		rr := serve(t, NewServer(mockedk8sclient), `{"cluster-labels": {"region": "us"}}`)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
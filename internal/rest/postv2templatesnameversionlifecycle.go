@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/templates/{name}/{version}/publish)
+func (s *Server) PostV2TemplatesNameVersionPublish(ctx context.Context, request api.PostV2TemplatesNameVersionPublishRequestObject) (api.PostV2TemplatesNameVersionPublishResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	logger.FromContext(ctx).Debug("handling request to publish template", "namespace", activeProjectID, "name", request.Name, "version", request.Version)
+
+	templateInfo, err := s.transitionTemplate(ctx, activeProjectID, request.Name, request.Version, ct.TemplatePublished, request.Params.IfMatch)
+	switch {
+	case k8serrors.IsBadRequest(err):
+		message := messages.New(messages.TemplatePublishFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2TemplatesNameVersionPublish400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.TemplateNotFound, request.Name+"-"+request.Version)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2TemplatesNameVersionPublish404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, errTemplateModified):
+		message := messages.New(messages.TemplateModified, request.Name+"-"+request.Version)
+		logger.FromContext(ctx).Warn(message.String(), "error", err)
+		return api.PostV2TemplatesNameVersionPublish409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsConflict(err):
+		message := messages.New(messages.TemplateCannotBePublished, err)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2TemplatesNameVersionPublish409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplatePublishFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2TemplatesNameVersionPublish500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster template published", "namespace", activeProjectID, "name", request.Name, "version", request.Version)
+	return api.PostV2TemplatesNameVersionPublish200JSONResponse(*templateInfo), nil
+}
+
+// (POST /v2/templates/{name}/{version}/deprecate)
+func (s *Server) PostV2TemplatesNameVersionDeprecate(ctx context.Context, request api.PostV2TemplatesNameVersionDeprecateRequestObject) (api.PostV2TemplatesNameVersionDeprecateResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	logger.FromContext(ctx).Debug("handling request to deprecate template", "namespace", activeProjectID, "name", request.Name, "version", request.Version)
+
+	templateInfo, err := s.transitionTemplate(ctx, activeProjectID, request.Name, request.Version, ct.TemplateDeprecated, request.Params.IfMatch)
+	switch {
+	case k8serrors.IsBadRequest(err):
+		message := messages.New(messages.TemplateDeprecateFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2TemplatesNameVersionDeprecate400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.TemplateNotFound, request.Name+"-"+request.Version)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2TemplatesNameVersionDeprecate404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, errTemplateModified):
+		message := messages.New(messages.TemplateModified, request.Name+"-"+request.Version)
+		logger.FromContext(ctx).Warn(message.String(), "error", err)
+		return api.PostV2TemplatesNameVersionDeprecate409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsConflict(err):
+		message := messages.New(messages.TemplateCannotBeDeprecated, err)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PostV2TemplatesNameVersionDeprecate409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateDeprecateFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PostV2TemplatesNameVersionDeprecate500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster template deprecated", "namespace", activeProjectID, "name", request.Name, "version", request.Version)
+	return api.PostV2TemplatesNameVersionDeprecate200JSONResponse(*templateInfo), nil
+}
+
+// transitionTemplate moves the given cluster template to the requested lifecycle state. A conflict error is
+// returned if the template's current state does not allow the transition, errTemplateModified if the template was
+// modified since the client read the version of ifMatch.
+func (s *Server) transitionTemplate(ctx context.Context, namespace, name, version string, state ct.TemplateLifecycleState, ifMatch *api.IfMatchHeader) (*api.TemplateInfo, error) {
+	templateName := name + "-" + version
+	item, err := s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(namespace).Get(ctx, templateName, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIfMatch(ifMatch, item); err != nil {
+		return nil, err
+	}
+
+	clusterTemplate := ct.ClusterTemplate{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &clusterTemplate); err != nil {
+		return nil, err
+	}
+
+	current := clusterTemplate.LifecycleState()
+	if !current.CanTransitionTo(state) {
+		return nil, k8serrors.NewConflict(
+			schema.GroupResource{Group: ct.GroupVersion.Group, Resource: "clustertemplates"},
+			templateName,
+			fmt.Errorf("lifecycle state cannot change from %s to %s", current, state),
+		)
+	}
+
+	if err := unstructured.SetNestedField(item.Object, string(state), "spec", "lifecycleState"); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(namespace).Update(ctx, item, v1.UpdateOptions{})
+	if k8serrors.IsConflict(err) {
+		return nil, fmt.Errorf("%w: %w", errTemplateModified, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getTemplate(ctx, *updated)
+}
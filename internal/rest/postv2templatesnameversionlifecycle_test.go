@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func lifecycleTemplate(t *testing.T, state v1alpha1.TemplateLifecycleState) *unstructured.Unstructured {
+	template := v1alpha1.ClusterTemplate{
+		TypeMeta:   v1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: "ClusterTemplate"},
+		ObjectMeta: v1.ObjectMeta{Name: "baseline-v1.0.0", Namespace: activeProjectID, ResourceVersion: "42"},
+		Spec: v1alpha1.ClusterTemplateSpec{
+			ControlPlaneProviderType: "k3s",
+			KubernetesVersion:        "v1.30.6+k3s1",
+			LifecycleState:           state,
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestPostV2TemplatesNameVersionPublish(t *testing.T) {
+	tests := []struct {
+		name           string
+		state          v1alpha1.TemplateLifecycleState
+		ifMatch        string
+		getErr         error
+		updateErr      error
+		expectedStatus int
+	}{
+		{name: "draft template is published", state: v1alpha1.TemplateDraft, expectedStatus: http.StatusOK},
+		{name: "draft template is published if not modified", state: v1alpha1.TemplateDraft, ifMatch: `"42"`, expectedStatus: http.StatusOK},
+		{name: "modified template is not published", state: v1alpha1.TemplateDraft, ifMatch: "41", expectedStatus: http.StatusConflict},
+		{name: "published template cannot be published again", state: v1alpha1.TemplatePublished, expectedStatus: http.StatusConflict},
+		{name: "deprecated template cannot be published", state: v1alpha1.TemplateDeprecated, expectedStatus: http.StatusConflict},
+		{
+			name:           "template not found",
+			getErr:         k8serrors.NewNotFound(schema.GroupResource{Group: "edge-orchestrator.intel.com", Resource: "clustertemplates"}, "baseline-v1.0.0"),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "concurrent update",
+			state:          v1alpha1.TemplateDraft,
+			updateErr:      k8serrors.NewConflict(schema.GroupResource{Group: "edge-orchestrator.intel.com", Resource: "clustertemplates"}, "baseline-v1.0.0", errors.New("object has been modified")),
+			expectedStatus: http.StatusConflict,
+		},
+		{name: "update fails", state: v1alpha1.TemplateDraft, updateErr: errors.New("boom"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := k8s.NewMockResourceInterface(t)
+			if tt.getErr != nil {
+				resource.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nil, tt.getErr)
+			} else {
+				resource.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(lifecycleTemplate(t, tt.state), nil)
+			}
+			if tt.state == v1alpha1.TemplateDraft && tt.ifMatch != "41" {
+				resource.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+					func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+						state, _, _ := unstructured.NestedString(obj.Object, "spec", "lifecycleState")
+						require.Equal(t, "published", state)
+						if tt.updateErr != nil {
+							return nil, tt.updateErr
+						}
+						return obj, nil
+					})
+			}
+
+			req := newRequest(t, http.MethodPost, "/v2/templates/baseline/v1.0.0/publish", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rr := serveHTTPRequest(t, mockResources(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.TemplateResourceSchema: resource}), req)
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+
+			if tt.expectedStatus == http.StatusOK {
+				var templateInfo api.TemplateInfo
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &templateInfo))
+				require.Equal(t, "baseline", templateInfo.Name)
+				require.Equal(t, api.TemplateInfoLifecycleStatePublished, *templateInfo.LifecycleState)
+				require.Equal(t, "42", *templateInfo.ResourceVersion)
+			}
+		})
+	}
+}
+
+func TestPostV2TemplatesNameVersionDeprecate(t *testing.T) {
+	tests := []struct {
+		name           string
+		state          v1alpha1.TemplateLifecycleState
+		expectedStatus int
+	}{
+		{name: "published template is deprecated", state: v1alpha1.TemplatePublished, expectedStatus: http.StatusOK},
+		{name: "template without a state is treated as published", state: "", expectedStatus: http.StatusOK},
+		{name: "draft template cannot be deprecated", state: v1alpha1.TemplateDraft, expectedStatus: http.StatusConflict},
+		{name: "deprecated template cannot be deprecated again", state: v1alpha1.TemplateDeprecated, expectedStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := k8s.NewMockResourceInterface(t)
+			resource.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(lifecycleTemplate(t, tt.state), nil)
+			if tt.expectedStatus == http.StatusOK {
+				resource.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+					func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+						return obj, nil
+					})
+			}
+
+			rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.TemplateResourceSchema: resource},
+				http.MethodPost, "/v2/templates/baseline/v1.0.0/deprecate", nil)
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+
+			if tt.expectedStatus == http.StatusOK {
+				var templateInfo api.TemplateInfo
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &templateInfo))
+				require.Equal(t, api.TemplateInfoLifecycleStateDeprecated, *templateInfo.LifecycleState)
+			}
+		})
+	}
+}
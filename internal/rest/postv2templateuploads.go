@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/template-uploads)
+func (s *Server) PostV2TemplateUploads(ctx context.Context, request api.PostV2TemplateUploadsRequestObject) (api.PostV2TemplateUploadsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.uploads == nil {
+		return api.PostV2TemplateUploads501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.TemplateUploadsDisabled)))}, nil
+	}
+
+	// the template is stored as a single object once it is assembled, larger templates are rejected before any chunk
+	// is uploaded
+	session, err := s.uploads.Create(ctx, activeProjectID, request.Body.Size, request.Body.Sha256)
+	if errors.Is(err, uploads.ErrSizeExceeded) {
+		message := messages.New(messages.TemplateUploadSizeExceeded, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploads400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	if err != nil {
+		message := messages.New(messages.TemplateUploadCreateFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploads500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("template upload started", "namespace", activeProjectID, "id", session.ID, "size", session.Size)
+	return api.PostV2TemplateUploads201JSONResponse(toAPITemplateUpload(session)), nil
+}
+
+// toAPITemplateUpload converts an upload session to its API representation
+func toAPITemplateUpload(session uploads.Session) api.TemplateUpload {
+	// session ids are generated by the store and always valid uuids
+	id, _ := uuid.Parse(session.ID)
+	return api.TemplateUpload{
+		Id:        id,
+		Size:      session.Size,
+		Sha256:    session.SHA256,
+		Received:  session.Received,
+		ExpiresAt: session.ExpiresAt,
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+const unknownTemplateUploadID = "64e797f6-db22-445e-b606-4228d4f1c2bd"
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+// startTemplateUpload starts an upload of the data in a store backed by a fake cluster
+func startTemplateUpload(t *testing.T, data string) (*uploads.Store, uploads.Session) {
+	store := uploads.NewStore(k8s.New().WithFakeClient())
+	session, err := store.Create(context.Background(), activeProjectID, int64(len(data)), sha256Hex(data))
+	require.NoError(t, err)
+	return store, session
+}
+
+func TestPostV2TemplateUploads(t *testing.T) {
+	t.Run("upload started", func(t *testing.T) {
+		store := uploads.NewStore(k8s.New().WithFakeClient())
+		body := api.TemplateUploadRequest{Size: 1024, Sha256: sha256Hex("template")}
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads", body, WithTemplateUploads(store))
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var upload api.TemplateUpload
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &upload))
+		require.EqualValues(t, 1024, upload.Size)
+		require.Zero(t, upload.Received)
+
+		_, err := store.Get(context.Background(), activeProjectID, upload.Id.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		store := uploads.NewStore(k8s.New().WithFakeClient())
+		body := api.TemplateUploadRequest{Size: 1024, Sha256: "not-a-checksum"}
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads", body, WithTemplateUploads(store))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		store := uploads.NewStore(k8s.New().WithFakeClient())
+		body := api.TemplateUploadRequest{Size: uploads.MaxSize + 1, Sha256: sha256Hex("template")}
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads", body, WithTemplateUploads(store))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+
+		// the store rejects the size as well if the request is not validated against the spec
+		server := NewServer(nil, WithTemplateUploads(store))
+		response, err := server.PostV2TemplateUploads(context.Background(), api.PostV2TemplateUploadsRequestObject{
+			Params: api.PostV2TemplateUploadsParams{Activeprojectid: uuid.MustParse(expectedActiveProjectID)},
+			Body:   &body,
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.PostV2TemplateUploads400JSONResponse{}, response)
+		require.Equal(t, string(messages.TemplateUploadSizeExceeded), *response.(api.PostV2TemplateUploads400JSONResponse).Code)
+	})
+
+	t.Run("uploads not enabled", func(t *testing.T) {
+		body := api.TemplateUploadRequest{Size: 1024, Sha256: sha256Hex("template")}
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads", body)
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/template-uploads/{id}/chunks)
+func (s *Server) PostV2TemplateUploadsIdChunks(ctx context.Context, request api.PostV2TemplateUploadsIdChunksRequestObject) (api.PostV2TemplateUploadsIdChunksResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.uploads == nil {
+		return api.PostV2TemplateUploadsIdChunks501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.TemplateUploadsDisabled)))}, nil
+	}
+
+	// the length of the base64 encoded data is validated against the spec, the decoded size is checked here
+	if len(request.Body.Data) > uploads.MaxChunkSize {
+		message := messages.New(messages.TemplateUploadTooLarge, request.Id, fmt.Errorf("%d bytes exceed the maximum chunk size of %d", len(request.Body.Data), uploads.MaxChunkSize))
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdChunks400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	session, err := s.uploads.Append(ctx, activeProjectID, request.Id.String(), request.Body.Offset, request.Body.Data)
+	switch {
+	case errors.Is(err, uploads.ErrSessionNotFound):
+		message := messages.New(messages.TemplateUploadNotFound, request.Id)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdChunks404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, uploads.ErrTooLarge):
+		message := messages.New(messages.TemplateUploadTooLarge, request.Id, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdChunks400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, uploads.ErrOffsetMismatch):
+		message := messages.New(messages.TemplateUploadOffsetMismatch, request.Id, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdChunks409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateUploadAppendFailed, request.Id, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdChunks500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Debug("chunk appended to template upload", "namespace", activeProjectID, "id", session.ID, "received", session.Received, "size", session.Size)
+	return api.PostV2TemplateUploadsIdChunks200JSONResponse(toAPITemplateUpload(session)), nil
+}
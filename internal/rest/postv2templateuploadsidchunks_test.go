@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPostV2TemplateUploadsIdChunks(t *testing.T) {
+	store, session := startTemplateUpload(t, "name: test")
+	path := "/v2/template-uploads/" + session.ID + "/chunks"
+
+	rr := serveRequest(t, nil, http.MethodPost, path, api.TemplateUploadChunk{Offset: 0, Data: []byte("name")}, WithTemplateUploads(store))
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var upload api.TemplateUpload
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &upload))
+	require.EqualValues(t, 4, upload.Received)
+
+	// a retried chunk does not continue the upload
+	rr = serveRequest(t, nil, http.MethodPost, path, api.TemplateUploadChunk{Offset: 0, Data: []byte("name")}, WithTemplateUploads(store))
+	require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	requireCode(t, messages.TemplateUploadOffsetMismatch, rr.Body.Bytes())
+
+	rr = serveRequest(t, nil, http.MethodPost, path, api.TemplateUploadChunk{Offset: 4, Data: []byte(": test, too long")}, WithTemplateUploads(store))
+	require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	requireCode(t, messages.TemplateUploadTooLarge, rr.Body.Bytes())
+
+	rr = serveRequest(t, nil, http.MethodPost, "/v2/template-uploads/"+unknownTemplateUploadID+"/chunks",
+		api.TemplateUploadChunk{Offset: 0, Data: []byte("name")}, WithTemplateUploads(store))
+	require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (POST /v2/template-uploads/{id}/commit)
+func (s *Server) PostV2TemplateUploadsIdCommit(ctx context.Context, request api.PostV2TemplateUploadsIdCommitRequestObject) (api.PostV2TemplateUploadsIdCommitResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.uploads == nil {
+		return api.PostV2TemplateUploadsIdCommit501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.TemplateUploadsDisabled)))}, nil
+	}
+
+	data, err := s.uploads.Assemble(ctx, activeProjectID, request.Id.String())
+	switch {
+	case errors.Is(err, uploads.ErrSessionNotFound):
+		message := messages.New(messages.TemplateUploadNotFound, request.Id)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, uploads.ErrIncomplete):
+		message := messages.New(messages.TemplateUploadIncomplete, request.Id, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, uploads.ErrChecksumMismatch):
+		message := messages.New(messages.TemplateUploadChecksumMismatch, request.Id, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateUploadAssembleFailed, request.Id, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	templateInfo, err := parseTemplateInfo(data)
+	if err != nil {
+		message := messages.New(messages.TemplateUploadInvalid, request.Id, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	name := templateInfo.Name
+
+	clusterTemplate, err := template.FromTemplateInfoToClusterTemplate(templateInfo)
+	if err != nil {
+		message := messages.New(messages.InvalidClusterConfiguration, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "id", request.Id)
+		return api.PostV2TemplateUploadsIdCommit400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the session is kept when the import fails, so the template can be committed again once e.g. a conflicting
+	// template was deleted
+	err = s.createTemplate(ctx, activeProjectID, clusterTemplate)
+	switch {
+	case k8serrors.IsBadRequest(err):
+		message := messages.New(messages.TemplateInvalid, name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsAlreadyExists(err):
+		message := messages.New(messages.TemplateExists, name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateImportFailed, name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PostV2TemplateUploadsIdCommit500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	if err := s.uploads.Delete(ctx, activeProjectID, request.Id.String()); err != nil {
+		logger.FromContext(ctx).Warn("failed to delete committed template upload, it is deleted once expired", "namespace", activeProjectID, "id", request.Id, "error", err)
+	}
+
+	logger.FromContext(ctx).Info("Cluster Template created from upload", "namespace", activeProjectID, "name", name, "id", request.Id)
+	return api.PostV2TemplateUploadsIdCommit201JSONResponse(fmt.Sprintf("successfully imported template %s", name)), nil
+}
+
+// parseTemplateInfo parses the uploaded template, sent as JSON or YAML, and validates it against the TemplateInfo
+// schema of the spec like the body of POST /v2/templates
+func parseTemplateInfo(data []byte) (api.TemplateInfo, error) {
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return api.TemplateInfo{}, err
+	}
+
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		return api.TemplateInfo{}, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return api.TemplateInfo{}, err
+	}
+	if err := swagger.Components.Schemas["TemplateInfo"].Value.VisitJSON(value); err != nil {
+		// the schema error includes the whole schema and value otherwise
+		var schemaErr *openapi3.SchemaError
+		if errors.As(err, &schemaErr) {
+			return api.TemplateInfo{}, fmt.Errorf("%s: %s", strings.Join(schemaErr.JSONPointer(), "/"), schemaErr.Reason)
+		}
+		return api.TemplateInfo{}, err
+	}
+
+	var templateInfo api.TemplateInfo
+	if err := json.Unmarshal(data, &templateInfo); err != nil {
+		return api.TemplateInfo{}, err
+	}
+	return templateInfo, nil
+}
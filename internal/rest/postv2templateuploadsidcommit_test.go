@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+const uploadedTemplate = `name: test
+version: v1.0.0
+kubernetesVersion: v1.30.6+k3s1
+controlplaneprovidertype: k3s
+infraprovidertype: intel
+`
+
+// uploadTemplate uploads the data in two chunks
+func uploadTemplate(t *testing.T, data string) (*uploads.Store, uploads.Session) {
+	store, session := startTemplateUpload(t, data)
+	half := len(data) / 2
+	session, err := store.Append(context.Background(), activeProjectID, session.ID, 0, []byte(data[:half]))
+	require.NoError(t, err)
+	session, err = store.Append(context.Background(), activeProjectID, session.ID, int64(half), []byte(data[half:]))
+	require.NoError(t, err)
+	return store, session
+}
+
+// templateCreate expects the creation of the uploaded template, which fails with the given error
+func templateCreate(t *testing.T, err error) map[schema.GroupVersionResource]*k8s.MockResourceInterface {
+	cptype, infratype := api.K3s, api.Intel
+	clusterTemplate, convertErr := template.FromTemplateInfoToClusterTemplate(api.TemplateInfo{
+		Name:                     "test",
+		Version:                  "v1.0.0",
+		Controlplaneprovidertype: &cptype,
+		Infraprovidertype:        &infratype,
+		KubernetesVersion:        "v1.30.6+k3s1",
+	})
+	require.NoError(t, convertErr)
+	unstructuredClusterTemplate, convertErr := convert.ToUnstructured(&clusterTemplate)
+	require.NoError(t, convertErr)
+
+	resource := k8s.NewMockResourceInterface(t)
+	resource.EXPECT().Create(mock.Anything, unstructuredClusterTemplate, v1.CreateOptions{}).Return(nil, err)
+	return map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.TemplateResourceSchema: resource}
+}
+
+func TestPostV2TemplateUploadsIdCommit(t *testing.T) {
+	t.Run("template imported", func(t *testing.T) {
+		store, session := uploadTemplate(t, uploadedTemplate)
+		rr := serveRequest(t, templateCreate(t, nil), http.MethodPost, "/v2/template-uploads/"+session.ID+"/commit", nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		_, err := store.Get(context.Background(), activeProjectID, session.ID)
+		require.ErrorIs(t, err, uploads.ErrSessionNotFound, "the session is deleted once committed")
+	})
+
+	t.Run("template exists", func(t *testing.T) {
+		store, session := uploadTemplate(t, uploadedTemplate)
+		exists := k8serrors.NewAlreadyExists(core.TemplateResourceSchema.GroupResource(), "test-v1.0.0")
+		rr := serveRequest(t, templateCreate(t, exists), http.MethodPost, "/v2/template-uploads/"+session.ID+"/commit", nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateExists, rr.Body.Bytes())
+
+		_, err := store.Get(context.Background(), activeProjectID, session.ID)
+		require.NoError(t, err, "the session is kept to commit it again")
+	})
+
+	t.Run("upload incomplete", func(t *testing.T) {
+		store, session := startTemplateUpload(t, uploadedTemplate)
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads/"+session.ID+"/commit", nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateUploadIncomplete, rr.Body.Bytes())
+	})
+
+	t.Run("upload corrupted", func(t *testing.T) {
+		store, session := startTemplateUpload(t, uploadedTemplate)
+		corrupted := []byte(uploadedTemplate)
+		corrupted[0] = 'N'
+		_, err := store.Append(context.Background(), activeProjectID, session.ID, 0, corrupted)
+		require.NoError(t, err)
+
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads/"+session.ID+"/commit", nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateUploadChecksumMismatch, rr.Body.Bytes())
+	})
+
+	t.Run("not a template", func(t *testing.T) {
+		store, session := uploadTemplate(t, "name: test\nkubernetesVersion: 1\n")
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads/"+session.ID+"/commit", nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateUploadInvalid, rr.Body.Bytes())
+	})
+
+	t.Run("upload not found", func(t *testing.T) {
+		store, _ := startTemplateUpload(t, uploadedTemplate)
+		rr := serveRequest(t, nil, http.MethodPost, "/v2/template-uploads/"+unknownTemplateUploadID+"/commit", nil, WithTemplateUploads(store))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
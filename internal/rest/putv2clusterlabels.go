@@ -4,11 +4,11 @@ package rest
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	"k8s.io/apimachinery/pkg/api/errors"
 )
@@ -19,60 +19,48 @@ func (s *Server) PutV2ClustersNameLabels(ctx context.Context, request api.PutV2C
 
 	clusterName := request.Name
 	if clusterName == "" {
-		errMsg := "no cluster name provided"
-		slog.Warn(errMsg, "name", clusterName)
-		return api.PutV2ClustersNameLabels400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: &errMsg,
-			},
-		}, nil
+		message := messages.New(messages.ClusterNameMissing)
+		logger.FromContext(ctx).Warn(message.String(), "name", clusterName)
+		return api.PutV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
 	if request.Body == nil || request.Body.Labels == nil {
-		errMsg := "no labels provided"
-		slog.Warn(errMsg)
-		return api.PutV2ClustersNameLabels400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: &errMsg,
-			},
-		}, nil
+		message := messages.New(messages.ClusterLabelsMissing)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PutV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
 	newUserLabels := *request.Body.Labels
 	if !labels.Valid(newUserLabels) {
-		errMsg := "invalid cluster label keys"
-		slog.Warn(errMsg, "labels", newUserLabels)
-		return api.PutV2ClustersNameLabels400JSONResponse{
-			N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{
-				Message: &errMsg,
-			},
-		}, nil
+		message := messages.New(messages.InvalidClusterLabelKeys)
+		logger.FromContext(ctx).Warn(message.String(), "labels", newUserLabels)
+		return api.PutV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	}
 
 	cli := k8s.New(s.k8sclient)
 	if cli == nil {
-		message := "failed to create k8s client"
-		slog.Error(message)
-		return api.PutV2ClustersNameLabels500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameLabels500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
 	err := cli.SetClusterLabels(ctx, activeProjectID, clusterName, newUserLabels)
 
 	switch {
 	case errors.IsBadRequest(err):
-		message := fmt.Sprintf("cluster '%s' is invalid: %v", clusterName, err)
-		slog.Error(message)
-		return api.PutV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.ClusterInvalid, clusterName, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameLabels400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
 	case errors.IsNotFound(err):
-		message := fmt.Sprintf("cluster '%s' not found: %v", clusterName, err)
-		slog.Error(message)
-		return api.PutV2ClustersNameLabels404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.ClusterNotFound, clusterName)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameLabels404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
 	case err != nil:
-		message := fmt.Sprintf("failed to update Cluster '%s': %v", clusterName, err)
-		slog.Error(message)
-		return api.PutV2ClustersNameLabels500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse{Message: &message}}, nil
+		message := messages.New(messages.ClusterUpdateFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameLabels500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
 	}
 
-	slog.Info("Cluster labels updated", "namespace", activeProjectID, "name", request.Name, "labels", newUserLabels)
+	logger.FromContext(ctx).Info("Cluster labels updated", "namespace", activeProjectID, "name", request.Name, "labels", newUserLabels)
 	return api.PutV2ClustersNameLabels200Response{}, nil
 }
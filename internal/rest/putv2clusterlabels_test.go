@@ -21,6 +21,7 @@ import (
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
@@ -110,8 +111,7 @@ func TestPutV2ClusterLabels400(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
 
 		// Validate the error message in the response body
-		expectedErrorMessage := `{"message":"invalid cluster label keys"}`
-		require.JSONEq(t, expectedErrorMessage, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), expectedErrorMessage)
+		requireCode(t, messages.InvalidClusterLabelKeys, rr.Body.Bytes())
 	})
 
 	t.Run("InvalidCluster", func(t *testing.T) {
@@ -144,8 +144,7 @@ func TestPutV2ClusterLabels400(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
 
 		// Validate the error message in the response body
-		expectedErrorMessage := `{"message":"cluster 'example-cluster' is invalid: invalid cluster"}`
-		require.JSONEq(t, expectedErrorMessage, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), expectedErrorMessage)
+		requireCode(t, messages.ClusterInvalid, rr.Body.Bytes())
 	})
 
 	t.Run("InvalidActiveProjectID", func(t *testing.T) {
@@ -171,8 +170,7 @@ func TestPutV2ClusterLabels400(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
 
 		// Validate the error message in the response body
-		expectedErrorMessage := `{"message": "no active project id provided"}`
-		require.JSONEq(t, expectedErrorMessage, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), expectedErrorMessage)
+		requireCode(t, messages.ActiveProjectIDMissing, rr.Body.Bytes())
 	})
 }
 func TestPutV2ClusterLabel404(t *testing.T) {
@@ -204,8 +202,7 @@ func TestPutV2ClusterLabel404(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusNotFound)
 
 	// Validate the error message in the response body
-	expectedErrorMessage := `{"message":"cluster 'example-cluster' not found: example-cluster.clusters \"example-cluster\" not found"}`
-	require.JSONEq(t, expectedErrorMessage, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), expectedErrorMessage)
+	requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
 }
 
 func TestPutV2ClusterLabels500(t *testing.T) {
@@ -241,6 +238,5 @@ func TestPutV2ClusterLabels500(t *testing.T) {
 	require.Equal(t, http.StatusInternalServerError, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusInternalServerError)
 
 	// Validate the error message in the response body
-	expectedErrorMessage := `{"message":"failed to update Cluster 'example-cluster': internal server error"}`
-	require.JSONEq(t, expectedErrorMessage, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), expectedErrorMessage)
+	requireCode(t, messages.ClusterUpdateFailed, rr.Body.Bytes())
 }
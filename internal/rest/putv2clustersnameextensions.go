@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/extensions"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (PUT /v2/clusters/{name}/extensions)
+func (s *Server) PutV2ClustersNameExtensions(ctx context.Context, request api.PutV2ClustersNameExtensionsRequestObject) (api.PutV2ClustersNameExtensionsResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	clusterName := request.Name
+	extension := request.Body.DefaultExtension
+
+	catalog, err := s.extensionCatalog()
+	if err != nil {
+		message := messages.New(messages.ExtensionCatalogFailed, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameExtensions500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameExtensions500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	capiCluster, err := cli.GetCluster(ctx, activeProjectID, clusterName)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameExtensions404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameExtensions500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the extension must be available for the control plane provider of the template of the cluster
+	templateName := capiCluster.Annotations[core.TemplateLabelKey]
+	template, err := cli.GetClusterTemplate(ctx, activeProjectID, templateName)
+	if err != nil {
+		message := messages.New(messages.TemplateOfClusterGetFailed, templateName, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameExtensions500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	if err := catalog.Check(extension, template.Spec.ControlPlaneProviderType); err != nil {
+		message := messages.New(messages.ExtensionNotAvailable, clusterName, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameExtensions400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the app deployment manager deploys the extension to the cluster by its label
+	_, err = cli.PatchClusterLabels(ctx, activeProjectID, clusterName, map[string]*string{extensions.LabelKey: &extension}, "")
+	switch {
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.ClusterNotFound, clusterName)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameExtensions404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsBadRequest(err), k8serrors.IsInvalid(err):
+		message := messages.New(messages.ClusterInvalid, clusterName, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameExtensions400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterUpdateFailed, clusterName, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameExtensions500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster extension changed", "namespace", activeProjectID, "name", clusterName, "extension", extension)
+	return api.PutV2ClustersNameExtensions200JSONResponse{DefaultExtension: extension}, nil
+}
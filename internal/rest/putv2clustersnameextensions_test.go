@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/extensions"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPutV2ClustersNameExtensions(t *testing.T) {
+	catalog, err := extensions.Parse([]byte(`
+extensions:
+  - name: baseline
+    providers: [kubeadm, k3s]
+  - name: edge-ai
+    providers: [kubeadm]
+`))
+	require.NoError(t, err)
+
+	t.Run("extension label is changed", func(t *testing.T) {
+		cluster := nodePoolCluster(t)
+		cluster.SetLabels(map[string]string{extensions.LabelKey: "edge-ai", "app": "demo"})
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(cluster, nil)
+		clusters.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				require.Equal(t, map[string]string{extensions.LabelKey: "baseline", "app": "demo"}, obj.GetLabels())
+				return obj, nil
+			})
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodPut, "/v2/clusters/example-cluster/extensions", api.ClusterExtensions{DefaultExtension: "baseline"},
+			WithExtensionCatalog(catalog))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, `{"defaultExtension":"baseline"}`, rr.Body.String())
+	})
+
+	t.Run("extension not available for the provider", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodPut, "/v2/clusters/example-cluster/extensions", api.ClusterExtensions{DefaultExtension: "edge-ai"},
+			WithExtensionCatalog(catalog))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.ExtensionNotAvailable, rr.Body.Bytes())
+	})
+
+	t.Run("unknown extension", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodPut, "/v2/clusters/example-cluster/extensions", api.ClusterExtensions{DefaultExtension: "demo"})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.ExtensionNotAvailable, rr.Body.Bytes())
+	})
+
+	t.Run("cluster not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nil,
+			k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodPut, "/v2/clusters/example-cluster/extensions", api.ClusterExtensions{DefaultExtension: "baseline"})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterNotFound, rr.Body.Bytes())
+	})
+}
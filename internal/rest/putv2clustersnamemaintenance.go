@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (PUT /v2/clusters/{name}/maintenance)
+func (s *Server) PutV2ClustersNameMaintenance(ctx context.Context, request api.PutV2ClustersNameMaintenanceRequestObject) (api.PutV2ClustersNameMaintenanceResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if request.Body == nil || strings.TrimSpace(request.Body.Reason) == "" {
+		message := messages.New(messages.MaintenanceReasonMissing)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PutV2ClustersNameMaintenance400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	cluster, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameMaintenance404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// a maintenance is not taken over, so that its record is not overwritten by somebody else
+	if maintenance := clusterMaintenance(cluster); maintenance != nil {
+		message := messages.New(messages.ClusterInMaintenance, request.Name, maintenance.Since.Format(time.RFC3339), maintenance.User)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameMaintenance409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the nodes are cordoned first, so that a cluster whose nodes could not be cordoned is not in maintenance and the
+	// request can be retried
+	if err := s.cordonClusterNodes(ctx, activeProjectID, request.Name, true); err != nil {
+		message := messages.New(messages.MaintenanceCordonFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	user := "anonymous"
+	if request.Params.Authorization != nil {
+		if name := auth.GetUsername(*request.Params.Authorization); name != "" {
+			user = name
+		}
+	}
+	maintenance := api.ClusterMaintenance{
+		User:   user,
+		Since:  time.Now().UTC().Truncate(time.Second),
+		Reason: strings.TrimSpace(request.Body.Reason),
+	}
+
+	err = cli.StartClusterMaintenance(ctx, activeProjectID, request.Name, cluster.ResourceVersion, maintenance.User, maintenance.Reason, maintenance.Since)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameMaintenance404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsConflict(err):
+		message := messages.New(messages.ClusterModified, request.Name)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "error", err)
+		return api.PutV2ClustersNameMaintenance409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.MaintenanceStartFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameMaintenance500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster put in maintenance", "namespace", activeProjectID, "cluster", request.Name, "user", maintenance.User, "reason", maintenance.Reason)
+	return api.PutV2ClustersNameMaintenance200JSONResponse(maintenance), nil
+}
+
+// clusterMaintenance returns the maintenance recorded in the annotations of the cluster, nil if it is not in maintenance
+func clusterMaintenance(cluster *capi.Cluster) *api.ClusterMaintenance {
+	value, ok := cluster.Annotations[core.MaintenanceSinceAnnotationKey]
+	if !ok {
+		return nil
+	}
+	// the time of a maintenance whose annotation was modified by hand is unknown, the cluster is still in maintenance
+	since, _ := time.Parse(time.RFC3339, value)
+	return &api.ClusterMaintenance{
+		User:   cluster.Annotations[core.MaintenanceByAnnotationKey],
+		Since:  since,
+		Reason: cluster.Annotations[core.MaintenanceReasonAnnotationKey],
+	}
+}
+
+// cordonClusterNodes cordons or uncordons the workload cluster nodes of all machines of the cluster if the server is
+// configured with a NodeCordoner; machines that have no node yet have nothing to cordon
+func (s *Server) cordonClusterNodes(ctx context.Context, namespace, clusterName string, unschedulable bool) error {
+	if s.cordoner == nil {
+		return nil
+	}
+	machines, err := k8s.New(s.k8sclient).GetMachines(ctx, namespace, clusterName)
+	if err != nil {
+		return fmt.Errorf("failed to get the machines of the cluster: %w", err)
+	}
+	var nodeNames []string
+	for _, machine := range machines {
+		if machine.Status.NodeRef != nil {
+			nodeNames = append(nodeNames, machine.Status.NodeRef.Name)
+		}
+	}
+	return s.cordoner.Cordon(ctx, namespace, clusterName, unschedulable, nodeNames...)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+type fakeCordoner struct {
+	err           error
+	unschedulable *bool
+	cordoned      []string
+}
+
+func (f *fakeCordoner) Cordon(_ context.Context, projectID, clusterName string, unschedulable bool, nodeNames ...string) error {
+	if projectID != activeProjectID || clusterName != "example-cluster" {
+		return fmt.Errorf("unexpected cluster %s/%s", projectID, clusterName)
+	}
+	f.unschedulable = &unschedulable
+	f.cordoned = append(f.cordoned, nodeNames...)
+	return f.err
+}
+
+// maintenanceCluster returns the example cluster, in maintenance if since is set
+func maintenanceCluster(t *testing.T, since string) *unstructured.Unstructured {
+	cluster := nodePoolCluster(t)
+	cluster.SetResourceVersion("42")
+	if since != "" {
+		annotations := cluster.GetAnnotations()
+		annotations[core.MaintenanceSinceAnnotationKey] = since
+		annotations[core.MaintenanceByAnnotationKey] = "site-admin"
+		annotations[core.MaintenanceReasonAnnotationKey] = "power work"
+		cluster.SetAnnotations(annotations)
+	}
+	return cluster
+}
+
+// maintenancePatch matches the merge patch of the maintenance of a cluster that pauses or resumes it
+func maintenancePatch(paused bool) any {
+	return mock.MatchedBy(func(data []byte) bool {
+		var patch struct {
+			Metadata struct {
+				ResourceVersion string             `json:"resourceVersion"`
+				Annotations     map[string]*string `json:"annotations"`
+			} `json:"metadata"`
+			Spec struct {
+				Paused bool `json:"paused"`
+			} `json:"spec"`
+		}
+		if json.Unmarshal(data, &patch) != nil || patch.Metadata.ResourceVersion != "42" || patch.Spec.Paused != paused {
+			return false
+		}
+		since, ok := patch.Metadata.Annotations[core.MaintenanceSinceAnnotationKey]
+		return ok && (since != nil) == paused
+	})
+}
+
+func TestPutV2ClustersNameMaintenance(t *testing.T) {
+	request := api.ClusterMaintenanceRequest{Reason: "planned power work"}
+
+	t.Run("cluster is cordoned, paused and its maintenance recorded", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(maintenanceCluster(t, ""), nil)
+		clusters.EXPECT().Patch(mock.Anything, "example-cluster", types.MergePatchType, maintenancePatch(true), v1.PatchOptions{}).Return(maintenanceCluster(t, ""), nil)
+
+		cordoner := &fakeCordoner{}
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: drainedMachines(t),
+		}, http.MethodPut, "/v2/clusters/example-cluster/maintenance", request, WithNodeCordoner(cordoner))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, []string{"edge-node-1"}, cordoner.cordoned)
+		require.NotNil(t, cordoner.unschedulable)
+		assert.True(t, *cordoner.unschedulable)
+
+		var response api.ClusterMaintenance
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "planned power work", response.Reason)
+		assert.Equal(t, "anonymous", response.User)
+		assert.False(t, response.Since.IsZero())
+	})
+
+	t.Run("reason is required", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPut, "/v2/clusters/example-cluster/maintenance", api.ClusterMaintenanceRequest{Reason: " "})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.MaintenanceReasonMissing, rr.Body.Bytes())
+	})
+
+	t.Run("clusters in maintenance are not taken over", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(maintenanceCluster(t, "2026-10-16T08:00:00Z"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodPut, "/v2/clusters/example-cluster/maintenance", request, WithNodeCordoner(&fakeCordoner{}))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterInMaintenance, rr.Body.Bytes())
+	})
+
+	t.Run("failed cordon does not put the cluster in maintenance", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(maintenanceCluster(t, ""), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+			core.MachineResourceSchema: drainedMachines(t),
+		}, http.MethodPut, "/v2/clusters/example-cluster/maintenance", request, WithNodeCordoner(&fakeCordoner{err: errors.New("connection refused")}))
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.MaintenanceCordonFailed, rr.Body.Bytes())
+	})
+
+	t.Run("concurrently modified clusters are not put in maintenance", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(maintenanceCluster(t, ""), nil)
+		clusters.EXPECT().Patch(mock.Anything, "example-cluster", types.MergePatchType, maintenancePatch(true), v1.PatchOptions{}).
+			Return(nil, k8serrors.NewConflict(core.ClusterResourceSchema.GroupResource(), "example-cluster", errors.New("modified")))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodPut, "/v2/clusters/example-cluster/maintenance", request)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.ClusterModified, rr.Body.Bytes())
+	})
+
+	t.Run("cluster not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(core.ClusterResourceSchema.GroupResource(), "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema: clusters,
+		}, http.MethodPut, "/v2/clusters/example-cluster/maintenance", request)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	controlplaneprovider "github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// defaultNodePool is the node pool worker nodes join when they are added to a cluster without naming a pool
+const defaultNodePool = "workers"
+
+// (PUT /v2/clusters/{name}/nodes)
+func (s *Server) PutV2ClustersNameNodes(ctx context.Context, request api.PutV2ClustersNameNodesRequestObject) (api.PutV2ClustersNameNodesResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if request.Body == nil || len(*request.Body) == 0 {
+		message := messages.New(messages.NodesRequired)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PutV2ClustersNameNodes400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	nodes := *request.Body
+
+	ids := map[string]bool{}
+	for _, node := range nodes {
+		if ids[node.Id] {
+			message := messages.New(messages.DuplicateNode, node.Id)
+			logger.FromContext(ctx).Warn(message.String())
+			return api.PutV2ClustersNameNodes400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+		ids[node.Id] = true
+	}
+
+	cli := k8s.New(s.k8sclient)
+	if cli == nil {
+		message := messages.New(messages.K8sClientFailed)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	capiCluster, err := cli.GetCluster(ctx, activeProjectID, request.Name)
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.ClusterGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	templateName := capiCluster.Annotations[core.TemplateLabelKey]
+	template, err := cli.GetClusterTemplate(ctx, activeProjectID, templateName)
+	if err != nil {
+		message := messages.New(messages.TemplateOfClusterGetFailed, templateName, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// the control plane provider of the template must support the roles of the nodes
+	for _, node := range nodes {
+		if err := controlplaneprovider.ValidateNodeRole(template.Spec.ControlPlaneProviderType, string(node.Role)); err != nil {
+			message := messages.New(messages.NodeRoleNotSupported, node.Id, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "cluster", request.Name)
+			return api.PutV2ClustersNameNodes400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// nodes already in the cluster are left as they are
+	existingNodes, err := cluster.Nodes(ctx, cli, capiCluster)
+	if err != nil {
+		message := messages.New(messages.NodesGetFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+	for _, node := range existingNodes {
+		if node.Id != nil {
+			delete(ids, *node.Id)
+		}
+	}
+
+	var controlPlaneNodes, workerNodes []api.NodeSpec
+	for _, node := range nodes {
+		switch {
+		case !ids[node.Id]:
+			continue
+		case node.Role == api.Worker:
+			workerNodes = append(workerNodes, node)
+		default:
+			controlPlaneNodes = append(controlPlaneNodes, node)
+		}
+	}
+	if len(controlPlaneNodes) == 0 && len(workerNodes) == 0 {
+		logger.FromContext(ctx).Info("All nodes are already part of the cluster", "namespace", activeProjectID, "cluster", request.Name)
+		return api.PutV2ClustersNameNodes200Response{}, nil
+	}
+
+	if template.Spec.SingleNode != nil {
+		message := messages.New(messages.SingleNodeOnly, template.Name, len(existingNodes)+len(controlPlaneNodes)+len(workerNodes))
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "cluster", request.Name)
+		return api.PutV2ClustersNameNodes400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	if len(controlPlaneNodes) > 0 {
+		replicas := controlPlaneReplicas(capiCluster) + int32(len(controlPlaneNodes))
+		if err := controlplaneprovider.ValidateControlPlaneReplicas(template.Spec.ControlPlaneProviderType, replicas); err != nil {
+			message := messages.New(messages.ControlPlaneSizeUnsupported, err)
+			logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "cluster", request.Name)
+			return api.PutV2ClustersNameNodes400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	// the nodes must fit into the quota of the project
+	quotaRequest := multitenancy.QuotaRequest{Nodes: len(controlPlaneNodes) + len(workerNodes)}
+	if len(controlPlaneNodes) > 0 {
+		quotaRequest.ControlPlaneReplicas = int(controlPlaneReplicas(capiCluster)) + len(controlPlaneNodes)
+	}
+	err = s.checkQuota(ctx, activeProjectID, quotaRequest)
+	switch {
+	case errors.Is(err, multitenancy.ErrQuotaExceeded):
+		message := messages.New(messages.QuotaExceeded, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID, "cluster", request.Name)
+		return api.PutV2ClustersNameNodes403JSONResponse{N403ForbiddenJSONResponse: api.N403ForbiddenJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.QuotaCheckFailed, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	// hosts must be bound to the new machines when the Intel infra provider is used, the machine templates are resolved
+	// before the cluster is scaled
+	bindNodes := api.TemplateInfoInfraprovidertype(template.Spec.InfraProviderType) == api.Intel
+	var controlPlaneMachineTemplate, workerMachineTemplate string
+	if bindNodes {
+		if len(controlPlaneNodes) > 0 {
+			controlPlaneMachineTemplate, err = machineTemplateName(templateName, template.Status.ControlPlaneMachineTemplateRef)
+		}
+		if err == nil && len(workerNodes) > 0 {
+			workerMachineTemplate, err = machineTemplateName(templateName, template.Status.WorkerMachineTemplateRef)
+		}
+		if err != nil {
+			message := messages.New(messages.MachineBindingsFailed, err)
+			logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+			return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+		}
+	}
+
+	err = cli.UpdateClusterTopology(ctx, activeProjectID, request.Name, func(topology *capi.Topology) error {
+		if len(controlPlaneNodes) > 0 {
+			replicas := int32(1)
+			if topology.ControlPlane.Replicas != nil {
+				replicas = *topology.ControlPlane.Replicas
+			}
+			replicas += int32(len(controlPlaneNodes))
+			topology.ControlPlane.Replicas = &replicas
+		}
+		if len(workerNodes) > 0 {
+			if topology.Workers == nil {
+				topology.Workers = &capi.WorkersTopology{}
+			}
+			scaleNodePool(topology.Workers, defaultNodePool, int32(len(workerNodes)))
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, k8s.ErrClusterNotFound):
+		message := messages.New(messages.ClusterNotFound, request.Name)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsBadRequest(err), k8serrors.IsInvalid(err):
+		message := messages.New(messages.NodesInvalid, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.NodesAddFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	if bindNodes {
+		bindings := []struct {
+			machineTemplateName string
+			nodes               []api.NodeSpec
+		}{
+			{machineTemplateName: controlPlaneMachineTemplate, nodes: controlPlaneNodes},
+			{machineTemplateName: workerMachineTemplate, nodes: workerNodes},
+		}
+		for _, b := range bindings {
+			if len(b.nodes) == 0 {
+				continue
+			}
+			if err := createBindings(ctx, cli, activeProjectID, request.Name, b.machineTemplateName, b.nodes); err != nil {
+				message := messages.New(messages.MachineBindingsFailed, err)
+				logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+				return api.PutV2ClustersNameNodes500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+			}
+		}
+	}
+
+	logger.FromContext(ctx).Info("Nodes added to cluster", "namespace", activeProjectID, "cluster", request.Name, "controlPlaneNodes", len(controlPlaneNodes), "workerNodes", len(workerNodes))
+	return api.PutV2ClustersNameNodes200Response{}, nil
+}
+
+// controlPlaneReplicas returns the number of control plane nodes of the cluster, a topology without replicas has a single one
+func controlPlaneReplicas(c *capi.Cluster) int32 {
+	if c.Spec.Topology == nil || c.Spec.Topology.ControlPlane.Replicas == nil {
+		return 1
+	}
+	return *c.Spec.Topology.ControlPlane.Replicas
+}
+
+// scaleNodePool changes the replicas of the named node pool by delta, the pool is created when it does not exist yet
+func scaleNodePool(workers *capi.WorkersTopology, name string, delta int32) {
+	for i := range workers.MachineDeployments {
+		md := &workers.MachineDeployments[i]
+		if md.Name != name {
+			continue
+		}
+		replicas := delta
+		if md.Replicas != nil {
+			replicas += *md.Replicas
+		}
+		md.Replicas = &replicas
+		return
+	}
+
+	workers.MachineDeployments = append(workers.MachineDeployments, capi.MachineDeploymentTopology{
+		Class:    common.WorkerClass,
+		Name:     name,
+		Replicas: &delta,
+	})
+}
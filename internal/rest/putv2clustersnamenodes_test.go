@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPutV2ClustersNameNodes(t *testing.T) {
+	t.Run("control plane and worker nodes are added", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		clusters.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+			func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				var cluster capi.Cluster
+				require.NoError(t, convert.FromUnstructured(*obj, &cluster))
+				require.Equal(t, int32(3), *cluster.Spec.Topology.ControlPlane.Replicas)
+				require.Len(t, cluster.Spec.Topology.Workers.MachineDeployments, 1)
+
+				md := cluster.Spec.Topology.Workers.MachineDeployments[0]
+				require.Equal(t, common.WorkerClass, md.Class)
+				require.Equal(t, "workers", md.Name)
+				require.Equal(t, int32(1), *md.Replicas)
+				return obj, nil
+			})
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, v1.ListOptions{LabelSelector: "cluster.x-k8s.io/cluster-name=example-cluster"}).Return(&unstructured.UnstructuredList{}, nil)
+
+		machineTemplates := map[string]string{}
+		bindings := k8s.NewMockResourceInterface(t)
+		bindings.EXPECT().Create(mock.Anything, mock.Anything, v1.CreateOptions{}).RunAndReturn(
+			func(_ context.Context, obj *unstructured.Unstructured, _ v1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				var binding intelv1alpha1.IntelMachineBinding
+				require.NoError(t, convert.FromUnstructured(*obj, &binding))
+				machineTemplates[binding.Spec.NodeGUID] = binding.Spec.IntelMachineTemplateName
+				return obj, nil
+			}).Times(3)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+			core.MachineResourceSchema:  machines,
+			core.BindingsResourceSchema: bindings,
+		}, http.MethodPut, "/v2/clusters/example-cluster/nodes", []api.NodeSpec{
+			{Id: "6e6422c3-625e-507a-bc8a-bd2330e07e7e", Role: api.All},
+			{Id: "64e797f6-db22-445e-b606-4228d4f1c2bd", Role: api.Controlplane},
+			{Id: "535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b", Role: api.Worker},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, map[string]string{
+			"6e6422c3-625e-507a-bc8a-bd2330e07e7e": "baseline-v1.0.0-controlplane",
+			"64e797f6-db22-445e-b606-4228d4f1c2bd": "baseline-v1.0.0-controlplane",
+			"535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b": "baseline-v1.0.0-worker",
+		}, machineTemplates)
+	})
+
+	t.Run("nodes already in the cluster are left as they are", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(twoNodeCluster(t), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:     clusters,
+			core.TemplateResourceSchema:    templates,
+			core.MachineResourceSchema:     twoNodeMachines(t),
+			k8s.IntelMachineResourceSchema: twoNodeIntelMachines(t),
+		}, http.MethodPut, "/v2/clusters/example-cluster/nodes", []api.NodeSpec{
+			{Id: controlPlaneNodeID, Role: api.All},
+			{Id: workerNodeID, Role: api.Worker},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	})
+
+	t.Run("machine template not rendered", func(t *testing.T) {
+		// the cluster is not scaled when the hosts can not be bound to the new machines
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		template := nodePoolTemplate(t, "intel")
+		unstructured.RemoveNestedField(template.Object, "status", "workerMachineTemplateRef")
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(template, nil)
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, mock.Anything).Return(&unstructured.UnstructuredList{}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+			core.MachineResourceSchema:  machines,
+		}, http.MethodPut, "/v2/clusters/example-cluster/nodes", []api.NodeSpec{
+			{Id: "535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b", Role: api.Worker},
+		})
+		require.Equal(t, http.StatusInternalServerError, rr.Code, rr.Body.String())
+		requireCode(t, messages.MachineBindingsFailed, rr.Body.Bytes())
+	})
+
+	t.Run("unsupported control plane size", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+		machines := k8s.NewMockResourceInterface(t)
+		machines.EXPECT().List(mock.Anything, mock.Anything).Return(&unstructured.UnstructuredList{}, nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+			core.MachineResourceSchema:  machines,
+		}, http.MethodPut, "/v2/clusters/example-cluster/nodes", []api.NodeSpec{
+			{Id: "6e6422c3-625e-507a-bc8a-bd2330e07e7e", Role: api.Controlplane},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.ControlPlaneSizeUnsupported, rr.Body.Bytes())
+	})
+
+	t.Run("dedicated etcd node of k3s", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nodePoolCluster(t), nil)
+		templates := k8s.NewMockResourceInterface(t)
+		templates.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nodePoolTemplate(t, "intel"), nil)
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{
+			core.ClusterResourceSchema:  clusters,
+			core.TemplateResourceSchema: templates,
+		}, http.MethodPut, "/v2/clusters/example-cluster/nodes", []api.NodeSpec{
+			{Id: "535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b", Role: api.Etcd},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.NodeRoleNotSupported, rr.Body.Bytes())
+	})
+
+	t.Run("node listed more than once", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPut, "/v2/clusters/example-cluster/nodes", []api.NodeSpec{
+			{Id: "535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b", Role: api.Worker},
+			{Id: "535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b", Role: api.Worker},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		requireCode(t, messages.DuplicateNode, rr.Body.Bytes())
+	})
+
+	t.Run("cluster not found", func(t *testing.T) {
+		clusters := k8s.NewMockResourceInterface(t)
+		clusters.EXPECT().Get(mock.Anything, "example-cluster", v1.GetOptions{}).Return(nil,
+			k8serrors.NewNotFound(schema.GroupResource{Group: "cluster.x-k8s.io", Resource: "clusters"}, "example-cluster"))
+
+		rr := serveRequest(t, map[schema.GroupVersionResource]*k8s.MockResourceInterface{core.ClusterResourceSchema: clusters},
+			http.MethodPut, "/v2/clusters/example-cluster/nodes", []api.NodeSpec{{Id: "535436e4-4b0b-4b3b-8b3b-3b3b3b3b3b3b", Role: api.Worker}})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
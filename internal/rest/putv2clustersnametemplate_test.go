@@ -33,5 +33,5 @@ func TestPutV2ClustersNameTemplate(t *testing.T) {
 
 	// check the response
 	require.Equal(t, http.StatusNotImplemented, rr.Code)
-	require.JSONEq(t, `{"message":"In-place cluster updates are not supported. Please delete the cluster and create a new one with updated cluster template."}`, rr.Body.String())
+	require.JSONEq(t, `{"code":"IN_PLACE_UPDATES_NOT_SUPPORTED","message":"in-place cluster updates are not supported, delete the cluster and create a new one with the updated cluster template"}`, rr.Body.String())
 }
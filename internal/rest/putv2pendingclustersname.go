@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (PUT /v2/pending-clusters/{name})
+func (s *Server) PutV2PendingClustersName(ctx context.Context, request api.PutV2PendingClustersNameRequestObject) (api.PutV2PendingClustersNameResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+
+	if s.pending == nil {
+		return api.PutV2PendingClustersName501JSONResponse{N501NotImplementedJSONResponse: api.N501NotImplementedJSONResponse(problem(ctx, messages.New(messages.DeferredProvisioningDisabled)))}, nil
+	}
+
+	if request.Body == nil {
+		message := messages.New(messages.RequestBodyMissing)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2PendingClustersName400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+	spec := *request.Body
+
+	var invalid *messages.Message
+	switch {
+	case spec.Name != nil && *spec.Name != "" && *spec.Name != request.Name:
+		message := messages.New(messages.PendingClusterRenamed, request.Name, *spec.Name)
+		invalid = &message
+	case spec.ProvisionAt == nil || !spec.ProvisionAt.After(time.Now()):
+		message := messages.New(messages.ProvisionAtNotInFuture)
+		invalid = &message
+	case len(spec.Nodes) == 0:
+		message := messages.New(messages.NodesRequired)
+		invalid = &message
+	default:
+		invalid = validateControlPlaneNodes(spec.Nodes, spec.ControlPlaneReplicas)
+	}
+	if invalid != nil {
+		logger.FromContext(ctx).Warn(invalid.String(), "namespace", activeProjectID)
+		return api.PutV2PendingClustersName400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, *invalid))}, nil
+	}
+
+	pc, err := s.pending.Reschedule(ctx, activeProjectID, request.Name, spec, *spec.ProvisionAt)
+	switch {
+	case errors.Is(err, scheduling.ErrPendingClusterNotFound):
+		message := messages.New(messages.PendingClusterNotFound, request.Name)
+		logger.FromContext(ctx).Debug(message.String(), "namespace", activeProjectID)
+		return api.PutV2PendingClustersName404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, scheduling.ErrProvisioning), errors.Is(err, scheduling.ErrPreflight), errors.Is(err, scheduling.ErrPendingClusterChanged):
+		message := messages.New(messages.PendingClusterModifyFailed, request.Name, err)
+		logger.FromContext(ctx).Warn(message.String(), "namespace", activeProjectID)
+		return api.PutV2PendingClustersName409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.PendingClusterModifyFailed, request.Name, err)
+		logger.FromContext(ctx).Error(message.String(), "namespace", activeProjectID)
+		return api.PutV2PendingClustersName500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("Cluster rescheduled", "namespace", activeProjectID, "name", request.Name, "provisionAt", pc.ProvisionAt)
+	return api.PutV2PendingClustersName200JSONResponse(toAPIPendingCluster(pc)), nil
+}
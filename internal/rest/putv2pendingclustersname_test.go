@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPutV2PendingClustersName(t *testing.T) {
+	provisionAt := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	nodes := []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc05", Role: api.All}}
+
+	t.Run("failed pending cluster is rescheduled", func(t *testing.T) {
+		pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{failedCluster}}
+		rr := serveRequest(t, nil, http.MethodPut, "/v2/pending-clusters/cluster-2",
+			api.ClusterSpec{Nodes: nodes, Template: ptr("baseline-v1.1.0"), ProvisionAt: &provisionAt}, WithPendingClusters(pending))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var pc api.PendingCluster
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pc))
+		require.Equal(t, api.PendingClusterStateScheduled, pc.State)
+		require.Nil(t, pc.Error)
+		require.True(t, provisionAt.Equal(pc.ProvisionAt))
+
+		require.Len(t, pending.scheduled, 1)
+		require.Equal(t, "cluster-2", *pending.scheduled[0].Spec.Name)
+		require.Equal(t, "baseline-v1.1.0", *pending.scheduled[0].Spec.Template)
+		require.Equal(t, nodes, pending.scheduled[0].Spec.Nodes)
+	})
+
+	for _, tc := range []struct {
+		name         string
+		spec         api.ClusterSpec
+		expectedCode messages.Code
+	}{
+		{
+			name:         "renamed",
+			spec:         api.ClusterSpec{Name: ptr("cluster-9"), Nodes: nodes, ProvisionAt: &provisionAt},
+			expectedCode: messages.PendingClusterRenamed,
+		},
+		{
+			name:         "no provisioning time",
+			spec:         api.ClusterSpec{Nodes: nodes},
+			expectedCode: messages.ProvisionAtNotInFuture,
+		},
+		{
+			name:         "provisioning time in the past",
+			spec:         api.ClusterSpec{Nodes: nodes, ProvisionAt: ptr(time.Now().Add(-time.Hour))},
+			expectedCode: messages.ProvisionAtNotInFuture,
+		},
+		{
+			name:         "worker nodes",
+			spec:         api.ClusterSpec{Nodes: []api.NodeSpec{{Id: "27b4e138-ea0b-11ef-8552-8b663d95bc05", Role: api.Worker}}, ProvisionAt: &provisionAt},
+			expectedCode: messages.WorkerNodesRequireNodePool,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{scheduledCluster}}
+			rr := serveRequest(t, nil, http.MethodPut, "/v2/pending-clusters/cluster-1", tc.spec, WithPendingClusters(pending))
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+			requireCode(t, tc.expectedCode, rr.Body.Bytes())
+			require.Empty(t, pending.scheduled)
+		})
+	}
+
+	t.Run("pending cluster is being provisioned", func(t *testing.T) {
+		pending := &fakePendingClusters{pcs: []scheduling.PendingCluster{provisioningCluster}}
+		rr := serveRequest(t, nil, http.MethodPut, "/v2/pending-clusters/cluster-3",
+			api.ClusterSpec{Nodes: nodes, ProvisionAt: &provisionAt}, WithPendingClusters(pending))
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.PendingClusterModifyFailed, rr.Body.Bytes())
+	})
+
+	t.Run("pending cluster not found", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPut, "/v2/pending-clusters/cluster-1",
+			api.ClusterSpec{Nodes: nodes, ProvisionAt: &provisionAt}, WithPendingClusters(&fakePendingClusters{}))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("deferred provisioning not enabled", func(t *testing.T) {
+		rr := serveRequest(t, nil, http.MethodPut, "/v2/pending-clusters/cluster-1",
+			api.ClusterSpec{Nodes: nodes, ProvisionAt: &provisionAt})
+		require.Equal(t, http.StatusNotImplemented, rr.Code, rr.Body.String())
+	})
+}
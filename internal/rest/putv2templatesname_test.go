@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/mock"
@@ -23,9 +24,26 @@ import (
 	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
+// mockDefaultTemplateLease expects the lease on the ConfigMap pinning the default template of the namespace to be taken
+// and released
+func mockDefaultTemplateLease(t *testing.T, client *k8s.MockInterface, namespace string) {
+	configMaps := k8s.NewMockResourceInterface(t)
+	configMaps.EXPECT().Get(mock.Anything, k8s.DefaultTemplateConfigMapName, v1.GetOptions{}).
+		Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, k8s.DefaultTemplateConfigMapName))
+	configMaps.EXPECT().Create(mock.Anything, mock.Anything, v1.CreateOptions{}).
+		RunAndReturn(func(_ context.Context, pin *unstructured.Unstructured, _ v1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			return pin, nil
+		})
+	configMaps.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).Return(nil, nil)
+	nsConfigMaps := k8s.NewMockNamespaceableResourceInterface(t)
+	nsConfigMaps.EXPECT().Namespace(namespace).Return(configMaps)
+	client.EXPECT().Resource(core.ConfigMapResourceSchema).Return(nsConfigMaps)
+}
+
 func TestPutV2Templates200(t *testing.T) {
 	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
 	testCtx := context.WithValue(context.Background(), core.ActiveProjectIdContextKey, expectedActiveProjectID)
@@ -129,8 +147,7 @@ func TestPutV2Templates400(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusBadRequest)
 
 	// Validate the error message in the response body
-	expectedErrorMessage := `{"message":"failed to set default template"}`
-	require.JSONEq(t, expectedErrorMessage, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), expectedErrorMessage)
+	requireCode(t, messages.DefaultTemplateInvalid, rr.Body.Bytes())
 }
 
 func TestPutV2TemplatesInvalidProjectID(t *testing.T) {
@@ -204,6 +221,127 @@ func TestPutV2TemplatesAlreadyDefault(t *testing.T) {
 	require.Equal(t, http.StatusOK, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusOK)
 }
 
+func TestPutV2TemplatesModified(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+	serve := func(t *testing.T, resource *k8s.MockResourceInterface, ifMatch string, leased bool) *httptest.ResponseRecorder {
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(expectedActiveProjectID).Return(resource)
+		mockedk8sclient := k8s.NewMockInterface(t)
+		mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsResource)
+		if leased {
+			mockDefaultTemplateLease(t, mockedk8sclient, expectedActiveProjectID)
+		}
+
+		handler, err := NewServer(mockedk8sclient).ConfigureHandler()
+		require.Nil(t, err)
+
+		req := httptest.NewRequestWithContext(context.Background(), "PUT", "/v2/templates/restricted/default", strings.NewReader(`{"version": "v1.0.0"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("template modified since it was read", func(t *testing.T) {
+		template := &unstructured.Unstructured{}
+		template.SetResourceVersion("7")
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().Get(mock.Anything, "restricted-v1.0.0", v1.GetOptions{}).Return(template, nil)
+
+		rr := serve(t, resource, `"6"`, false)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateModified, rr.Body.Bytes())
+	})
+
+	t.Run("template modified concurrently", func(t *testing.T) {
+		template := &unstructured.Unstructured{}
+		template.SetResourceVersion("7")
+		resource := k8s.NewMockResourceInterface(t)
+		resource.EXPECT().Get(mock.Anything, "restricted-v1.0.0", v1.GetOptions{}).Return(template, nil)
+		resource.EXPECT().List(mock.Anything, v1.ListOptions{LabelSelector: "default=true"}).Return(&unstructured.UnstructuredList{}, nil)
+		resource.EXPECT().Update(mock.Anything, template, v1.UpdateOptions{}).Return(nil,
+			k8serrors.NewConflict(schema.GroupResource{Group: "edge-orchestrator.intel.com", Resource: "clustertemplates"}, "restricted-v1.0.0", errors.New("object has been modified")))
+
+		rr := serve(t, resource, `"7"`, true)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.TemplateModified, rr.Body.Bytes())
+	})
+}
+
+func TestPutV2TemplatesDefaultPinned(t *testing.T) {
+	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+	setup := func(t *testing.T) *k8s.Client {
+		client := k8s.New().WithFakeClient()
+		for name, labels := range map[string]map[string]string{
+			"baseline-v1.0.0":   {"default": "true"},
+			"restricted-v1.0.0": nil,
+		} {
+			template := &unstructured.Unstructured{}
+			template.SetAPIVersion("edge-orchestrator.intel.com/v1alpha1")
+			template.SetKind("ClusterTemplate")
+			template.SetName(name)
+			template.SetLabels(labels)
+			_, err := client.Dyn.Resource(core.TemplateResourceSchema).Namespace(expectedActiveProjectID).Create(context.Background(), template, v1.CreateOptions{})
+			require.NoError(t, err)
+		}
+		return client
+	}
+
+	serve := func(t *testing.T, client *k8s.Client) *httptest.ResponseRecorder {
+		handler, err := NewServer(client.Dyn).ConfigureHandler()
+		require.Nil(t, err)
+
+		req := httptest.NewRequestWithContext(context.Background(), "PUT", "/v2/templates/restricted/default", strings.NewReader(`{"version": "v1.0.0"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Activeprojectid", expectedActiveProjectID)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("default template pinned", func(t *testing.T) {
+		client := setup(t)
+		rr := serve(t, client)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		defaults, err := client.Dyn.Resource(core.TemplateResourceSchema).Namespace(expectedActiveProjectID).List(context.Background(), v1.ListOptions{LabelSelector: "default=true"})
+		require.NoError(t, err)
+		require.Len(t, defaults.Items, 1)
+		require.Equal(t, "restricted-v1.0.0", defaults.Items[0].GetName())
+
+		pinned, err := client.PinnedDefaultTemplate(context.Background(), expectedActiveProjectID)
+		require.NoError(t, err)
+		require.Equal(t, "restricted-v1.0.0", pinned)
+	})
+
+	t.Run("default template being changed", func(t *testing.T) {
+		client := setup(t)
+		pin := &unstructured.Unstructured{}
+		pin.SetAPIVersion("v1")
+		pin.SetKind("ConfigMap")
+		pin.SetName(k8s.DefaultTemplateConfigMapName)
+		pin.SetAnnotations(map[string]string{
+			"edge-orchestrator.intel.com/default-template-lease": time.Now().Add(time.Minute).UTC().Format(time.RFC3339),
+		})
+		_, err := client.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(expectedActiveProjectID).Create(context.Background(), pin, v1.CreateOptions{})
+		require.NoError(t, err)
+
+		rr := serve(t, client)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		requireCode(t, messages.DefaultTemplateBusy, rr.Body.Bytes())
+
+		pinned, err := client.PinnedDefaultTemplate(context.Background(), expectedActiveProjectID)
+		require.NoError(t, err)
+		require.Empty(t, pinned)
+	})
+}
+
 func TestPutV2TemplatesListWithLabelSelector(t *testing.T) {
 	expectedActiveProjectID := "655a6892-4280-4c37-97b1-31161ac0b99e"
 	testCtx := context.WithValue(context.Background(), core.ActiveProjectIdContextKey, expectedActiveProjectID)
@@ -224,6 +362,7 @@ func TestPutV2TemplatesListWithLabelSelector(t *testing.T) {
 
 	// Mock the update call to unlabel the existing default template
 	resource.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).Return(nil, nil).Times(2)
+	mockDefaultTemplateLease(t, mockedk8sclient, expectedActiveProjectID)
 
 	server := NewServer(mockedk8sclient)
 	require.NotNil(t, server, "NewServer() returned nil, want not nil")
@@ -324,8 +463,7 @@ func TestPutV2TemplatesFetchTemplateVersionsError(t *testing.T) {
 	require.Equal(t, http.StatusInternalServerError, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, http.StatusInternalServerError)
 
 	// Validate the error message in the response body
-	expectedErrorMessage := `{"message":"failed to fetch and select latest version"}`
-	require.JSONEq(t, expectedErrorMessage, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), expectedErrorMessage)
+	requireCode(t, messages.LatestTemplateVersionFailed, rr.Body.Bytes())
 }
 
 func TestPutV2TemplatesInternalServerError(t *testing.T) {
@@ -336,14 +474,14 @@ func TestPutV2TemplatesInternalServerError(t *testing.T) {
 		name           string
 		requestBody    string
 		expectedStatus int
-		expectedError  string
+		expectedCode   messages.Code
 		mockSetup      func(resource *k8s.MockResourceInterface)
 	}{
 		{
 			name:           "500 Internal Server Error",
 			requestBody:    `{"version": "v1.0.0"}`,
 			expectedStatus: http.StatusInternalServerError,
-			expectedError:  `{"message":"unexpected error occurred"}`,
+			expectedCode:   messages.DefaultTemplateSetFailed,
 			mockSetup: func(resource *k8s.MockResourceInterface) {
 				resource.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nil, errors.New("internal server error"))
 			},
@@ -352,7 +490,7 @@ func TestPutV2TemplatesInternalServerError(t *testing.T) {
 			name:           "Fetch Template Versions Error",
 			requestBody:    `{"version": ""}`,
 			expectedStatus: http.StatusInternalServerError,
-			expectedError:  `{"message":"failed to fetch and select latest version"}`,
+			expectedCode:   messages.LatestTemplateVersionFailed,
 			mockSetup: func(resource *k8s.MockResourceInterface) {
 				resource.EXPECT().List(mock.Anything, mock.Anything).Return(nil, errors.New("internal server error"))
 			},
@@ -384,7 +522,7 @@ func TestPutV2TemplatesInternalServerError(t *testing.T) {
 			handler.ServeHTTP(rr, req)
 			fmt.Println(rr.Body.String())
 			require.Equal(t, tt.expectedStatus, rr.Code, "ServeHTTP() status = %v, want %v", rr.Code, tt.expectedStatus)
-			require.JSONEq(t, tt.expectedError, rr.Body.String(), "Response body = %v, want %v", rr.Body.String(), tt.expectedError)
+			requireCode(t, tt.expectedCode, rr.Body.Bytes())
 		})
 	}
 }
@@ -603,6 +741,7 @@ func TestPutV2TemplatesSetMostRecentVersionAsDefault2(t *testing.T) {
 
 	// Mock the Update call to unlabel the existing default template
 	mockResource.On("Update", mock.Anything, mock.Anything, v1.UpdateOptions{}).Return(nil, nil).Times(2)
+	mockDefaultTemplateLease(t, mockClient, expectedActiveProjectID)
 
 	server := NewServer(mockClient)
 	require.NotNil(t, server, "NewServer() returned nil, want not nil")
@@ -648,6 +787,17 @@ func createPutV2TemplatesNameStubServer(t *testing.T) *Server {
 	nsResource.EXPECT().Namespace(mock.Anything).Return(resource).Maybe()
 	mockedk8sclient := k8s.NewMockInterface(t)
 	mockedk8sclient.EXPECT().Resource(core.TemplateResourceSchema).Return(nsResource).Maybe()
+	configMaps := k8s.NewMockResourceInterface(t)
+	configMaps.EXPECT().Get(mock.Anything, mock.Anything, v1.GetOptions{}).
+		Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, k8s.DefaultTemplateConfigMapName)).Maybe()
+	configMaps.EXPECT().Create(mock.Anything, mock.Anything, v1.CreateOptions{}).
+		RunAndReturn(func(_ context.Context, pin *unstructured.Unstructured, _ v1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			return pin, nil
+		}).Maybe()
+	configMaps.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).Return(nil, nil).Maybe()
+	nsConfigMaps := k8s.NewMockNamespaceableResourceInterface(t)
+	nsConfigMaps.EXPECT().Namespace(mock.Anything).Return(configMaps).Maybe()
+	mockedk8sclient.EXPECT().Resource(core.ConfigMapResourceSchema).Return(nsConfigMaps).Maybe()
 	return &Server{
 		k8sclient: mockedk8sclient,
 	}
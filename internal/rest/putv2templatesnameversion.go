@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ct "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// (PUT /v2/templates/{name}/{version})
+func (s *Server) PutV2TemplatesNameVersion(ctx context.Context, request api.PutV2TemplatesNameVersionRequestObject) (api.PutV2TemplatesNameVersionResponseObject, error) {
+	activeProjectID := request.Params.Activeprojectid.String()
+	templateName := request.Name + "-" + request.Version
+	logger.FromContext(ctx).Debug("handling request to update template", "namespace", activeProjectID, "name", templateName)
+
+	if request.Body.Name != request.Name || request.Body.Version != request.Version {
+		message := messages.New(messages.TemplatePathMismatch, request.Body.Name+"-"+request.Body.Version, templateName)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2TemplatesNameVersion400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	clusterTemplate, err := template.FromTemplateInfoToClusterTemplate(*request.Body)
+	if err != nil {
+		message := messages.New(messages.InvalidClusterConfiguration, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2TemplatesNameVersion400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	}
+
+	templateInfo, err := s.updateTemplate(ctx, activeProjectID, clusterTemplate, request.Params.IfMatch)
+	switch {
+	case k8serrors.IsBadRequest(err):
+		message := messages.New(messages.TemplateInvalid, templateName, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2TemplatesNameVersion400JSONResponse{N400BadRequestJSONResponse: api.N400BadRequestJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsNotFound(err):
+		message := messages.New(messages.TemplateNotFound, templateName)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2TemplatesNameVersion404JSONResponse{N404NotFoundJSONResponse: api.N404NotFoundJSONResponse(problem(ctx, message))}, nil
+	case errors.Is(err, errTemplateModified):
+		message := messages.New(messages.TemplateModified, templateName)
+		logger.FromContext(ctx).Warn(message.String(), "error", err)
+		return api.PutV2TemplatesNameVersion409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case k8serrors.IsConflict(err):
+		message := messages.New(messages.TemplateInUse, templateName, err)
+		logger.FromContext(ctx).Warn(message.String())
+		return api.PutV2TemplatesNameVersion409JSONResponse{N409ConflictJSONResponse: api.N409ConflictJSONResponse(problem(ctx, message))}, nil
+	case err != nil:
+		message := messages.New(messages.TemplateUpdateFailed, templateName, err)
+		logger.FromContext(ctx).Error(message.String())
+		return api.PutV2TemplatesNameVersion500JSONResponse{N500InternalServerErrorJSONResponse: api.N500InternalServerErrorJSONResponse(problem(ctx, message))}, nil
+	}
+
+	logger.FromContext(ctx).Info("cluster template updated", "namespace", activeProjectID, "name", templateName)
+	return api.PutV2TemplatesNameVersion200JSONResponse(*templateInfo), nil
+}
+
+// updateTemplate replaces the spec and the description of the existing cluster template with those of the imported
+// one, keeping the lifecycle state of the existing template. The webhook decides which fields may change and returns
+// a conflict error if the spec of a template in use changes; errTemplateModified is returned if the template was
+// modified since the client read the version of ifMatch or since it was read here.
+func (s *Server) updateTemplate(ctx context.Context, namespace string, clusterTemplate *ct.ClusterTemplate, ifMatch *api.IfMatchHeader) (*api.TemplateInfo, error) {
+	templates := s.k8sclient.Resource(core.TemplateResourceSchema).Namespace(namespace)
+	item, err := templates.Get(ctx, clusterTemplate.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIfMatch(ifMatch, item); err != nil {
+		return nil, err
+	}
+
+	state, _, err := unstructured.NestedString(item.Object, "spec", "lifecycleState")
+	if err != nil {
+		return nil, err
+	}
+	spec := clusterTemplate.Spec
+	spec.LifecycleState = ct.TemplateLifecycleState(state)
+	specObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert clusterTemplate spec to unstructured: %w", err)
+	}
+	if err := unstructured.SetNestedField(item.Object, specObject, "spec"); err != nil {
+		return nil, err
+	}
+
+	// the signature is replaced as well, the signature of the existing template does not sign the imported one
+	annotations := item.GetAnnotations()
+	for _, key := range []string{"description", core.SignatureAnnotationKey, core.SignedPayloadAnnotationKey} {
+		if value, ok := clusterTemplate.Annotations[key]; ok {
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[key] = value
+		} else {
+			delete(annotations, key)
+		}
+	}
+	item.SetAnnotations(annotations)
+
+	updated, err := templates.Update(ctx, item, v1.UpdateOptions{})
+	if k8serrors.IsConflict(err) {
+		// the webhook denies spec changes of templates in use with a conflict as well, which the resource version
+		// of the template tells apart from a concurrent modification
+		current, getErr := templates.Get(ctx, clusterTemplate.Name, v1.GetOptions{})
+		if getErr == nil && current.GetResourceVersion() == item.GetResourceVersion() {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", errTemplateModified, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getTemplate(ctx, *updated)
+}
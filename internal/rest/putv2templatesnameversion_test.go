@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestPutV2TemplatesNameVersion(t *testing.T) {
+	templatesResource := schema.GroupResource{Group: "edge-orchestrator.intel.com", Resource: "clustertemplates"}
+	k3s := api.TemplateInfoControlplaneprovidertype("k3s")
+	description := "updated baseline"
+	templateInfo := api.TemplateInfo{
+		Name:                     "baseline",
+		Version:                  "v1.0.0",
+		KubernetesVersion:        "v1.32.4+k3s1",
+		Controlplaneprovidertype: &k3s,
+		Description:              &description,
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		ifMatch        string
+		getErr         error
+		updateErr      error
+		currentVersion string
+		expectedStatus int
+		expectedCode   messages.Code
+	}{
+		{name: "spec of the template is updated", expectedStatus: http.StatusOK},
+		{name: "spec of the template is updated if not modified", ifMatch: `"42"`, expectedStatus: http.StatusOK},
+		{name: "template of another path", path: "/v2/templates/baseline/v2.0.0", expectedStatus: http.StatusBadRequest, expectedCode: messages.TemplatePathMismatch},
+		{name: "template not found", getErr: k8serrors.NewNotFound(templatesResource, "baseline-v1.0.0"), expectedStatus: http.StatusNotFound, expectedCode: messages.TemplateNotFound},
+		{name: "modified template is not updated", ifMatch: "41", expectedStatus: http.StatusConflict, expectedCode: messages.TemplateModified},
+		{
+			name:           "spec of a template in use cannot change",
+			updateErr:      k8serrors.NewConflict(templatesResource, "baseline-v1.0.0", errors.New("clusterTemplate spec immutable while the template is in use")),
+			currentVersion: "42",
+			expectedStatus: http.StatusConflict,
+			expectedCode:   messages.TemplateInUse,
+		},
+		{
+			name:           "concurrent update",
+			updateErr:      k8serrors.NewConflict(templatesResource, "baseline-v1.0.0", errors.New("object has been modified")),
+			currentVersion: "43",
+			expectedStatus: http.StatusConflict,
+			expectedCode:   messages.TemplateModified,
+		},
+		{
+			name:           "invalid spec",
+			updateErr:      k8serrors.NewBadRequest("clusterTemplate provider types are immutable"),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   messages.TemplateInvalid,
+		},
+		{name: "update fails", updateErr: errors.New("boom"), expectedStatus: http.StatusInternalServerError, expectedCode: messages.TemplateUpdateFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path
+			if path == "" {
+				path = "/v2/templates/baseline/v1.0.0"
+			}
+
+			resource := k8s.NewMockResourceInterface(t)
+			switch {
+			case tt.expectedCode == messages.TemplatePathMismatch:
+			case tt.getErr != nil:
+				resource.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(nil, tt.getErr)
+			default:
+				existing := lifecycleTemplate(t, v1alpha1.TemplatePublished)
+				existing.SetAnnotations(map[string]string{"description": "baseline", "owner": "platform"})
+				resource.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(existing, nil).Once()
+			}
+			if tt.currentVersion != "" {
+				current := lifecycleTemplate(t, v1alpha1.TemplatePublished)
+				current.SetResourceVersion(tt.currentVersion)
+				resource.EXPECT().Get(mock.Anything, "baseline-v1.0.0", v1.GetOptions{}).Return(current, nil).Once()
+			}
+			if tt.getErr == nil && tt.expectedCode != messages.TemplatePathMismatch && tt.ifMatch != "41" {
+				resource.EXPECT().Update(mock.Anything, mock.Anything, v1.UpdateOptions{}).RunAndReturn(
+					func(_ context.Context, obj *unstructured.Unstructured, _ v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+						if tt.updateErr != nil {
+							return nil, tt.updateErr
+						}
+						return obj, nil
+					})
+			}
+
+			resources := map[schema.GroupVersionResource]*k8s.MockResourceInterface{}
+			if tt.expectedCode != messages.TemplatePathMismatch {
+				resources[core.TemplateResourceSchema] = resource
+			}
+			req := newRequest(t, http.MethodPut, path, templateInfo)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rr := serveHTTPRequest(t, mockResources(t, resources), req)
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+			if tt.expectedStatus != http.StatusOK {
+				requireCode(t, tt.expectedCode, rr.Body.Bytes())
+				return
+			}
+
+			var updated api.TemplateInfo
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &updated))
+			require.Equal(t, "v1.32.4+k3s1", updated.KubernetesVersion)
+			require.Equal(t, "updated baseline", *updated.Description)
+			// the lifecycle state of the template is kept
+			require.Equal(t, api.TemplateInfoLifecycleStatePublished, *updated.LifecycleState)
+		})
+	}
+}
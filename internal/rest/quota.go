@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+)
+
+// checkQuota checks the request against the quota of the project, the returned error wraps
+// multitenancy.ErrQuotaExceeded if the quota would be exceeded
+// Requests are not limited if no quotas are configured
+func (s *Server) checkQuota(ctx context.Context, namespace string, request multitenancy.QuotaRequest) error {
+	if s.quotas == nil {
+		return nil
+	}
+	return s.quotas.Check(ctx, namespace, request)
+}
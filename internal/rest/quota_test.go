@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+)
+
+// fakeQuotas records the checked requests and fails them with err
+type fakeQuotas struct {
+	err      error
+	requests []multitenancy.QuotaRequest
+}
+
+func (f *fakeQuotas) Check(_ context.Context, _ string, request multitenancy.QuotaRequest) error {
+	f.requests = append(f.requests, request)
+	return f.err
+}
+
+func TestCheckQuota(t *testing.T) {
+	request := multitenancy.QuotaRequest{Clusters: 1, Nodes: 3, ControlPlaneReplicas: 3}
+
+	// requests are not limited without quotas
+	require.NoError(t, NewServer(nil).checkQuota(context.Background(), activeProjectID, request))
+
+	quotas := &fakeQuotas{err: multitenancy.ErrQuotaExceeded}
+	err := NewServer(nil, WithQuotas(quotas)).checkQuota(context.Background(), activeProjectID, request)
+	require.ErrorIs(t, err, multitenancy.ErrQuotaExceeded)
+	require.Equal(t, []multitenancy.QuotaRequest{request}, quotas.requests)
+}
@@ -4,9 +4,22 @@
 package rest
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 type noopAuthenticator struct{}
@@ -14,3 +27,68 @@ type noopAuthenticator struct{}
 func (auth noopAuthenticator) Authenticate(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 	return openapi3filter.NoopAuthenticationFunc(ctx, input)
 }
+
+// serveRequest serves a request of the active project with the method, path and JSON body, if not nil, by a server with
+// the options on a k8s client mocking the given resources of the active project
+func serveRequest(t *testing.T, resources map[schema.GroupVersionResource]*k8s.MockResourceInterface, method, path string, body any, options ...func(*Server)) *httptest.ResponseRecorder {
+	return serveHTTPRequest(t, mockResources(t, resources), newRequest(t, method, path, body), options...)
+}
+
+// newRequest returns a request of the active project with the method, path and JSON body, if not nil
+func newRequest(t *testing.T, method, path string, body any) *http.Request {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(data)
+	}
+	req := httptest.NewRequestWithContext(context.Background(), method, path, reader)
+	req.Header.Set("Activeprojectid", activeProjectID)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req
+}
+
+// mockResources returns a k8s client mocking the given resources of the active project
+func mockResources(t *testing.T, resources map[schema.GroupVersionResource]*k8s.MockResourceInterface) *k8s.MockInterface {
+	mockedk8sclient := k8s.NewMockInterface(t)
+	for resourceSchema, resource := range resources {
+		nsResource := k8s.NewMockNamespaceableResourceInterface(t)
+		nsResource.EXPECT().Namespace(activeProjectID).Return(resource)
+		mockedk8sclient.EXPECT().Resource(resourceSchema).Return(nsResource)
+	}
+	return mockedk8sclient
+}
+
+// serveHTTPRequest serves the request by a server with the options on the k8s client
+func serveHTTPRequest(t *testing.T, k8sclient dynamic.Interface, req *http.Request, options ...func(*Server)) *httptest.ResponseRecorder {
+	server := NewServer(k8sclient, options...)
+	require.NotNil(t, server, "NewServer() returned nil, want not nil")
+
+	handler, err := server.ConfigureHandler()
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+// requireCode asserts that body holds the problem details of a message with the given code
+func requireCode(t *testing.T, code messages.Code, body []byte) {
+	t.Helper()
+	var problem api.ProblemDetails
+	require.NoError(t, json.Unmarshal(body, &problem), "body is not a problem details: %s", body)
+	requireProblemCode(t, code, problem)
+}
+
+// requireProblemCode asserts that the problem details are of a message with the given code
+func requireProblemCode(t *testing.T, code messages.Code, problem api.ProblemDetails) {
+	t.Helper()
+	message := ""
+	if problem.Message != nil {
+		message = *problem.Message
+	}
+	require.NotNil(t, problem.Code, "problem details have no code, message: %s", message)
+	require.Equal(t, string(code), *problem.Code, "message: %s", message)
+}
@@ -6,23 +6,46 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
 	oapi_middleware "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/open-edge-platform/orch-library/go/pkg/middleware/projectcontext"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic"
 
 	"github.com/open-edge-platform/cluster-manager/v2/internal/auth"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/events"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/extensions"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/health"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/inventory"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/kubeconfigs"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
 	cm_middleware "github.com/open-edge-platform/cluster-manager/v2/internal/middleware"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/multitenancy"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/naming"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/notification"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/scheduling"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportbundle"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/uploads"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
@@ -31,11 +54,26 @@ var (
 	_ api.StrictServerInterface = (*Server)(nil)
 )
 
+// readHeaderTimeout bounds the time the clients have to send the headers of their requests
+const readHeaderTimeout = 10 * time.Second
+
 // Authenticator is an interface that can be used to authenticate requests
 type Authenticator interface {
 	Authenticate(ctx context.Context, input *openapi3filter.AuthenticationInput) error
 }
 
+// Authorizer is implemented by the Authenticators that can evaluate the authorization policy for any request of the
+// bearer token, not only for the request being authenticated
+type Authorizer interface {
+	Authorize(ctx context.Context, authHeader, projectId, method, path string) error
+}
+
+// SubjectVerifier is implemented by the Authenticators that can verify a bearer token before the request is
+// authenticated, so that the middleware running ahead of the authentication can identify the client of the request
+type SubjectVerifier interface {
+	Subject(authHeader string) (string, error)
+}
+
 // Provider is an interface that can be used with an Authenticator to verify tokens
 type Provider interface {
 	GetSigningKey(kid string) (interface{}, error)
@@ -43,14 +81,154 @@ type Provider interface {
 
 type Inventory interface {
 	GetHostTrustedCompute(ctx context.Context, tenantId, hostUuid string) (bool, error)
+	IsAttested(ctx context.Context, tenantId, hostUuid string) (bool, error)
 	IsImmutable(ctx context.Context, tenantId, hostUuid string) (bool, error)
+	HostSite(ctx context.Context, tenantId, hostUuid string) (string, error)
+	HostResources(ctx context.Context, tenantId, hostUuid string) (inventory.HostResources, error)
+	ReserveHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) ([]string, error)
+	ReleaseHosts(ctx context.Context, tenantId, clusterName string, hostUuids []string) error
+}
+
+// ClusterEvents is an interface that can be used to subscribe to cluster changes
+type ClusterEvents interface {
+	Subscribe(namespace, name string) (<-chan k8s.ClusterEvent, func(), error)
+}
+
+// ClusterIndex is an interface that can be used to look clusters up by an index instead of listing all of them
+type ClusterIndex interface {
+	ByIndex(indexName, indexedValue string) ([]unstructured.Unstructured, error)
+}
+
+// HealthCheck is an interface that can be used to report whether a dependency of the server is ready, see GetV2Healthz
+type HealthCheck interface {
+	Health() error
+}
+
+// Leadership is an interface that can be used to report whether the replica is the leader of the replicas of the
+// cluster manager, see GetV2Readyz
+type Leadership interface {
+	IsLeader() bool
+}
+
+// CacheWarmup is an interface that can be used to report the progress of the warmup of the read cache, see GetV2Readyz
+type CacheWarmup interface {
+	WarmupProgress() k8s.WarmupProgress
+}
+
+// ClusterHealth is an interface that can be used to probe the health of the workload clusters
+type ClusterHealth interface {
+	Report(ctx context.Context, projectID, clusterName string) (health.Report, error)
+}
+
+// MachineLogs is an interface that can be used to retrieve the bootstrap logs of the nodes of a cluster from their provider
+type MachineLogs interface {
+	Logs(ctx context.Context, projectID, clusterName, nodeID string, tailLines int) ([]byte, error)
+}
+
+// NodeDrainer is an interface that can be used to drain the nodes of a cluster before they are removed
+type NodeDrainer interface {
+	Drain(ctx context.Context, projectID, clusterName string, nodeNames ...string) error
+}
+
+// NodeCordoner is an interface that can be used to cordon and uncordon the nodes of a cluster in maintenance
+type NodeCordoner interface {
+	Cordon(ctx context.Context, projectID, clusterName string, unschedulable bool, nodeNames ...string) error
+}
+
+// ExportStore is an interface that can be used to read the export bundles of deleted projects
+type ExportStore interface {
+	Open(ctx context.Context, projectID string) (io.ReadCloser, error)
+}
+
+// SupportBundles is an interface that can be used to gather the support bundle of a cluster
+type SupportBundles interface {
+	Collect(ctx context.Context, projectID, clusterName string, w io.Writer) (supportbundle.Manifest, error)
+}
+
+// Operations is an interface that can be used to record long-running cluster operations and poll their progress
+type Operations interface {
+	Start(ctx context.Context, namespace string, opType operations.Type, cluster, template string) (operations.Operation, error)
+	Get(ctx context.Context, namespace, id string) (operations.Operation, error)
+	List(ctx context.Context, namespace, cluster string) ([]operations.Operation, error)
+}
+
+// PendingClusters is an interface that can be used to defer the provisioning of clusters
+type PendingClusters interface {
+	Schedule(ctx context.Context, projectID string, spec api.ClusterSpec, provisionAt time.Time, options ...func(*scheduling.PendingCluster)) (scheduling.PendingCluster, error)
+	SchedulePreflight(ctx context.Context, projectID string, spec api.ClusterSpec, provisionAt time.Time, images []string, options ...func(*scheduling.PendingCluster)) (scheduling.PendingCluster, error)
+	PreflightEnabled() bool
+	Get(ctx context.Context, projectID, name string) (scheduling.PendingCluster, error)
+	List(ctx context.Context, projectID string) ([]scheduling.PendingCluster, error)
+	Reschedule(ctx context.Context, projectID, name string, spec api.ClusterSpec, provisionAt time.Time) (scheduling.PendingCluster, error)
+	Cancel(ctx context.Context, projectID, name string) error
+}
+
+// TemplateUploads is an interface that can be used to upload templates in chunks that are too large for a single request
+type TemplateUploads interface {
+	Create(ctx context.Context, namespace string, size int64, checksum string) (uploads.Session, error)
+	Get(ctx context.Context, namespace, id string) (uploads.Session, error)
+	Append(ctx context.Context, namespace, id string, offset int64, data []byte) (uploads.Session, error)
+	Assemble(ctx context.Context, namespace, id string) ([]byte, error)
+	Delete(ctx context.Context, namespace, id string) error
+}
+
+// Quotas is an interface that can be used to enforce per-project resource limits
+type Quotas interface {
+	Check(ctx context.Context, namespace string, request multitenancy.QuotaRequest) error
+}
+
+// NamingPolicies is an interface that can be used to get the naming policy the cluster names of a project have to follow
+type NamingPolicies interface {
+	Policy(projectID string) naming.Policy
+}
+
+// WebhookDestinations is an interface that can be used to get the destinations the webhook calls of a project may be sent to
+type WebhookDestinations interface {
+	Destinations(projectID string) []notification.Destination
+}
+
+// GitOps is an interface that can be used to commit the manifests of the clusters to the Git repositories of their
+// projects, which a GitOps controller applies
+type GitOps interface {
+	Publish(ctx context.Context, projectID, clusterName string, objects ...any) error
+	Remove(ctx context.Context, projectID, clusterName string) error
 }
 
 type Server struct {
-	config    *config.Config
-	auth      Authenticator
-	k8sclient dynamic.Interface
-	inventory Inventory
+	config        *config.Config
+	auth          Authenticator
+	k8sclient     dynamic.Interface
+	readCache     dynamic.Interface
+	warmup        CacheWarmup
+	inventory     Inventory
+	clusterEvents ClusterEvents
+	clusterIndex  ClusterIndex
+	exports       ExportStore
+	bundles       SupportBundles
+	health        ClusterHealth
+	machineLogs   MachineLogs
+	drainer       NodeDrainer
+	cordoner      NodeCordoner
+	operations    Operations
+	pending       PendingClusters
+	uploads       TemplateUploads
+	quotas        Quotas
+	naming        NamingPolicies
+	destinations  WebhookDestinations
+	gitops        GitOps
+	supportMatrix *supportmatrix.Matrix
+	extensions    *extensions.Catalog
+	rules         *validation.Rules
+	reserved      network.Reserved
+	trustedKeys   signature.TrustedKeys
+	kubeconfigs   *kubeconfigs.Pipeline
+	audit         cm_middleware.AuditLogger
+	healthChecks  []HealthCheck
+	leadership    Leadership
+	// k8sRetryAfter returns how long the circuit breaker of the Kubernetes client stays open, see WithK8sAvailability
+	k8sRetryAfter func() time.Duration
+	// shutdown is closed once the server starts shutting down, see Serve
+	shutdown chan struct{}
 }
 
 // NewServer creates a new Server instance
@@ -60,6 +238,7 @@ func NewServer(k8sclient dynamic.Interface, options ...func(*Server)) *Server {
 		auth:      auth.NewNoopAuthenticator(),
 		k8sclient: k8sclient,
 		inventory: inventory.NewNoopInventoryClient(),
+		shutdown:  make(chan struct{}),
 	}
 
 	for _, o := range options {
@@ -90,8 +269,217 @@ func WithInventory(inv Inventory) func(*Server) {
 	}
 }
 
-// Serve starts the server
-func (s *Server) Serve() error {
+// WithClusterEvents is a functional option for configuring a Server with a ClusterEvents source
+func WithClusterEvents(events ClusterEvents) func(*Server) {
+	return func(s *Server) {
+		s.clusterEvents = events
+	}
+}
+
+// WithClusterIndex is a functional option for configuring a Server with a ClusterIndex
+func WithClusterIndex(index ClusterIndex) func(*Server) {
+	return func(s *Server) {
+		s.clusterIndex = index
+	}
+}
+
+// WithReadCache is a functional option for configuring a Server with a client that serves the reads of the GET
+// handlers from a cache, see k8s.ReadCache
+func WithReadCache(cache dynamic.Interface) func(*Server) {
+	return func(s *Server) {
+		s.readCache = cache
+	}
+}
+
+// WithCacheWarmup is a functional option for configuring a Server with the warmup of its read cache, the server is
+// ready while the cache is warmed up
+func WithCacheWarmup(warmup CacheWarmup) func(*Server) {
+	return func(s *Server) {
+		s.warmup = warmup
+	}
+}
+
+// reader returns the client the GET handlers read with: the read cache if any, else the API server client. Handlers
+// that modify the objects they read must use k8sclient, as the cache may be stale.
+func (s *Server) reader() dynamic.Interface {
+	if s.readCache != nil {
+		return s.readCache
+	}
+	return s.k8sclient
+}
+
+// WithHealthChecks is a functional option for configuring a Server with the HealthChecks its readiness depends on
+func WithHealthChecks(checks ...HealthCheck) func(*Server) {
+	return func(s *Server) {
+		s.healthChecks = append(s.healthChecks, checks...)
+	}
+}
+
+// WithLeadership is a functional option for configuring a Server that is only ready while its replica is the leader,
+// so that the Service sends all requests to the leader
+func WithLeadership(leadership Leadership) func(*Server) {
+	return func(s *Server) {
+		s.leadership = leadership
+	}
+}
+
+// WithExportStore is a functional option for configuring a Server with an ExportStore
+func WithExportStore(store ExportStore) func(*Server) {
+	return func(s *Server) {
+		s.exports = store
+	}
+}
+
+// WithClusterHealth is a functional option for configuring a Server with a ClusterHealth prober
+func WithClusterHealth(health ClusterHealth) func(*Server) {
+	return func(s *Server) {
+		s.health = health
+	}
+}
+
+// WithMachineLogs is a functional option for configuring a Server with a MachineLogs fetcher
+func WithMachineLogs(logs MachineLogs) func(*Server) {
+	return func(s *Server) {
+		s.machineLogs = logs
+	}
+}
+
+// WithNodeDrainer is a functional option for configuring a Server to drain the nodes it removes
+func WithNodeDrainer(drainer NodeDrainer) func(*Server) {
+	return func(s *Server) {
+		s.drainer = drainer
+	}
+}
+
+// WithNodeCordoner is a functional option for configuring a Server to cordon the nodes of the clusters in maintenance
+func WithNodeCordoner(cordoner NodeCordoner) func(*Server) {
+	return func(s *Server) {
+		s.cordoner = cordoner
+	}
+}
+
+// WithSupportBundles is a functional option for configuring a Server with a SupportBundles collector
+func WithSupportBundles(bundles SupportBundles) func(*Server) {
+	return func(s *Server) {
+		s.bundles = bundles
+	}
+}
+
+// WithOperations is a functional option for configuring a Server with an Operations tracker
+func WithOperations(ops Operations) func(*Server) {
+	return func(s *Server) {
+		s.operations = ops
+	}
+}
+
+// WithPendingClusters is a functional option for configuring a Server to defer the provisioning of clusters
+func WithPendingClusters(pending PendingClusters) func(*Server) {
+	return func(s *Server) {
+		s.pending = pending
+	}
+}
+
+// WithTemplateUploads is a functional option for configuring a Server to accept templates uploaded in chunks
+func WithTemplateUploads(uploads TemplateUploads) func(*Server) {
+	return func(s *Server) {
+		s.uploads = uploads
+	}
+}
+
+// WithQuotas is a functional option for configuring a Server with per-project Quotas
+func WithQuotas(quotas Quotas) func(*Server) {
+	return func(s *Server) {
+		s.quotas = quotas
+	}
+}
+
+// WithNamingPolicies is a functional option for configuring a Server with the NamingPolicies of the cluster names
+func WithNamingPolicies(policies NamingPolicies) func(*Server) {
+	return func(s *Server) {
+		s.naming = policies
+	}
+}
+
+// WithWebhookDestinations is a functional option for configuring a Server with the allowed WebhookDestinations
+func WithWebhookDestinations(destinations WebhookDestinations) func(*Server) {
+	return func(s *Server) {
+		s.destinations = destinations
+	}
+}
+
+// WithGitOps is a functional option for configuring a Server to commit the manifests of the clusters to the Git
+// repositories of their projects, instead of or in addition to creating the clusters depending on the GitopsMode of
+// its Config
+func WithGitOps(gitops GitOps) func(*Server) {
+	return func(s *Server) {
+		s.gitops = gitops
+	}
+}
+
+// WithValidationRules is a functional option for configuring a Server with the modes of the validation rules, all rules
+// are enforced without it
+func WithValidationRules(rules *validation.Rules) func(*Server) {
+	return func(s *Server) {
+		s.rules = rules
+	}
+}
+
+// WithKubeconfigPipeline is a functional option for configuring a Server with the pipeline the kubeconfigs of the
+// clusters are processed with, the server URL is rewritten to the connect gateway of the cluster domain without it
+func WithKubeconfigPipeline(pipeline *kubeconfigs.Pipeline) func(*Server) {
+	return func(s *Server) {
+		s.kubeconfigs = pipeline
+	}
+}
+
+// WithReservedNetworks is a functional option for configuring a Server with the networks of the orchestrator
+// infrastructure the cluster networks of templates must not overlap
+func WithReservedNetworks(reserved network.Reserved) func(*Server) {
+	return func(s *Server) {
+		s.reserved = reserved
+	}
+}
+
+// WithTrustedKeys is a functional option for configuring a Server with the public keys the signatures of the
+// templates are verified with, the templates are served with their verification status
+func WithTrustedKeys(keys signature.TrustedKeys) func(*Server) {
+	return func(s *Server) {
+		s.trustedKeys = keys
+	}
+}
+
+// WithSupportMatrix is a functional option for configuring a Server with a support matrix overriding the default one
+func WithSupportMatrix(matrix *supportmatrix.Matrix) func(*Server) {
+	return func(s *Server) {
+		s.supportMatrix = matrix
+	}
+}
+
+// WithExtensionCatalog is a functional option for configuring a Server with an extension catalog overriding the default
+// one
+func WithExtensionCatalog(catalog *extensions.Catalog) func(*Server) {
+	return func(s *Server) {
+		s.extensions = catalog
+	}
+}
+
+// WithK8sAvailability is a functional option for configuring a Server to reject the requests with 503 Service
+// Unavailable while the circuit breaker of its Kubernetes client is open, see k8s.ResilientClient.RetryAfter
+func WithK8sAvailability(retryAfter func() time.Duration) func(*Server) {
+	return func(s *Server) {
+		s.k8sRetryAfter = retryAfter
+	}
+}
+
+// WithAuditLogger is a functional option for configuring a Server to audit its mutating requests
+func WithAuditLogger(logger cm_middleware.AuditLogger) func(*Server) {
+	return func(s *Server) {
+		s.audit = logger
+	}
+}
+
+// Serve serves the REST API until the context is canceled and then shuts the server down gracefully, see serve
+func (s *Server) Serve(ctx context.Context) error {
 	handler, err := s.ConfigureHandler()
 	if err != nil {
 		slog.Error("failed to initialize handler middleware", "error", err)
@@ -99,14 +487,62 @@ func (s *Server) Serve() error {
 	}
 
 	addr := "0.0.0.0:8080"
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
 	slog.Info("starting server", "addr", addr)
-	if err := http.ListenAndServe(addr, handler); err != nil {
+	return s.serve(ctx, listener, handler)
+}
+
+// serve serves the handler on the listener until the context is canceled. The server then reports it is shutting down
+// on /v2/readyz for the shutdown delay while it keeps serving, so that the pod is removed from the endpoints of the
+// Service and the metrics are scraped a last time, stops accepting connections and drains the in-flight requests for
+// the shutdown timeout; the connections of the requests that are not drained by then are closed
+func (s *Server) serve(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: readHeaderTimeout}
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-served:
 		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down server", "delay", s.config.ShutdownDelay, "timeout", s.config.ShutdownTimeout)
+	close(s.shutdown)
+	time.Sleep(s.config.ShutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.config.ShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("in-flight requests not drained before the shutdown timeout, closing their connections", "error", err)
+		if err := server.Close(); err != nil {
+			return err
+		}
 	}
 
+	if err := <-served; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	slog.Info("server stopped")
 	return nil
 }
 
+// shuttingDown returns true once the server starts shutting down
+func (s *Server) shuttingDown() bool {
+	select {
+	case <-s.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
 // ConfigureHandler configures the server with necessary middleware and handlers
 func (s *Server) ConfigureHandler() (http.Handler, error) {
 	// handler already implements request validation via oapi request validator
@@ -116,6 +552,11 @@ func (s *Server) ConfigureHandler() (http.Handler, error) {
 		return nil, err
 	}
 
+	// mutating requests are audited once they are scoped to their project and converted to JSON
+	if s.audit != nil {
+		handler = cm_middleware.Audit(s.audit)(handler)
+	}
+
 	return cm_middleware.Append(
 		func(handler http.Handler) http.Handler {
 			return cm_middleware.RequestDurationMetrics(metrics.ResponseTime, handler)
@@ -123,12 +564,45 @@ func (s *Server) ConfigureHandler() (http.Handler, error) {
 		func(handler http.Handler) http.Handler {
 			return cm_middleware.ResponseCounterMetrics(metrics.HttpResponseCounter, handler)
 		},
+		cm_middleware.CorrelationID,
 		cm_middleware.Logger,
+		func(handler http.Handler) http.Handler {
+			if !s.config.K8sCallDiagnostics {
+				return handler
+			}
+			return cm_middleware.K8sCalls(handler)
+		},
+		cm_middleware.Language,
+		cm_middleware.K8sAvailability(s.k8sRetryAfter),
+		cm_middleware.RateLimit(cm_middleware.RateLimits{
+			ClientRate:  s.config.ClientRateLimit,
+			ClientBurst: s.config.ClientBurst,
+			GlobalRate:  s.config.GlobalRateLimit,
+			GlobalBurst: s.config.GlobalBurst,
+			MaxInFlight: s.config.MaxInFlightRequests,
+			Subject:     s.verifiedSubject,
+		}),
 		func(handler http.Handler) http.Handler {
 			return projectcontext.InjectActiveProjectID(s.config.ProjectServiceURL, false)(handler)
 		},
 		cm_middleware.RewriteProjectScopedPath,
-		cm_middleware.ProjectIDValidator)(handler), nil
+		cm_middleware.ProjectIDValidator,
+		cm_middleware.RequestLogger,
+		cm_middleware.YAMLContentNegotiation)(handler), nil
+}
+
+// verifiedSubject returns the subject of the bearer token of the request if the authenticator verifies the token,
+// or an empty string otherwise
+func (s *Server) verifiedSubject(r *http.Request) string {
+	verifier, ok := s.auth.(SubjectVerifier)
+	if !ok {
+		return ""
+	}
+	subject, err := verifier.Subject(r.Header.Get("Authorization"))
+	if err != nil {
+		return ""
+	}
+	return subject
 }
 
 // getServerHandler returns the base http handler with strict validation against the OpenAPI spec
@@ -140,18 +614,23 @@ func (s *Server) getServerHandler() (http.Handler, error) {
 		router.Handle("/metrics", promhttp.HandlerFor(metrics.GetRegistry(), promhttp.HandlerOpts{}))
 	}
 
-	// create the openapi handler with existing router
-	handler := api.HandlerWithOptions(api.NewStrictHandler(s, nil), api.StdHTTPServerOptions{
+	// create the openapi handler with existing router; the errors raised before the requests reach the handlers are
+	// returned as problem details as well, so that every error response has a code
+	strictHandler := api.NewStrictHandlerWithOptions(s, nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			logger.FromContext(r.Context()).Error(err.Error(), "path", r.URL.Path, "method", r.Method)
+			writeProblem(r.Context(), w, http.StatusBadRequest, messages.New(messages.InvalidRequest, err))
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			logger.FromContext(r.Context()).Error(err.Error(), "path", r.URL.Path, "method", r.Method)
+			writeProblem(r.Context(), w, http.StatusInternalServerError, messages.New(messages.InternalError, err))
+		},
+	})
+	handler := api.HandlerWithOptions(strictHandler, api.StdHTTPServerOptions{
 		BaseRouter: router,
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			slog.Error(err.Error(), "path", r.URL.Path, "method", r.Method)
-
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusBadRequest)
-
-			if err := json.NewEncoder(w).Encode(api.N400BadRequest{Message: ptr(err.Error())}); err != nil {
-				slog.Error("failed to encode 400 response", "error", err)
-			}
+			logger.FromContext(r.Context()).Error(err.Error(), "path", r.URL.Path, "method", r.Method)
+			writeProblem(r.Context(), w, http.StatusBadRequest, messages.New(messages.InvalidRequest, err))
 		},
 	})
 
@@ -168,24 +647,20 @@ func (s *Server) getServerHandler() (http.Handler, error) {
 		Options: openapi3filter.Options{AuthenticationFunc: s.auth.Authenticate},
 		ErrorHandler: func(w http.ResponseWriter, message string, code int) {
 			slog.Error(message, "status", code)
-			if http.StatusBadRequest == code {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusBadRequest)
-
-				response := api.N400BadRequest{Message: &message}
-				if err := json.NewEncoder(w).Encode(response); err != nil {
-					slog.Error("failed to encode 400 response", "error", err)
-				}
-			} else if http.StatusInternalServerError == code {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusInternalServerError)
-
-				response := api.N500InternalServerError{Message: &message}
-				if err := json.NewEncoder(w).Encode(response); err != nil {
-					slog.Error("failed to write 500 response", "error", err)
-				}
-			} else {
-				http.Error(w, message, code)
+			// the request is not passed to the error handler, so the messages are not localized
+			ctx := context.Background()
+			switch {
+			case code == http.StatusBadRequest:
+				writeProblem(ctx, w, code, messages.New(messages.InvalidRequest, message))
+			case code == http.StatusUnauthorized && strings.Contains(message, auth.ErrAuthorizationDenied.Error()):
+				// the user is authenticated, but the roles of the user do not allow the request
+				writeProblem(ctx, w, http.StatusForbidden, messages.New(messages.AuthorizationDenied))
+			case code == http.StatusUnauthorized:
+				writeProblem(ctx, w, code, messages.New(messages.AuthenticationFailed, message))
+			case code == http.StatusNotFound:
+				writeProblem(ctx, w, code, messages.New(messages.RouteNotFound, message))
+			default:
+				writeProblem(ctx, w, code, messages.New(messages.InternalError, message))
 			}
 		},
 	})
@@ -193,21 +668,36 @@ func (s *Server) getServerHandler() (http.Handler, error) {
 	return validator(handler), nil
 }
 
-func GetAuthenticator(cfg *config.Config) (Authenticator, error) {
+// writeProblem writes the problem details of the message with the given status code
+func writeProblem(ctx context.Context, w http.ResponseWriter, status int, message messages.Message) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(problem(ctx, message)); err != nil {
+		logger.FromContext(ctx).Error("failed to encode problem details", "status", status, "error", err)
+	}
+}
+
+func GetAuthenticator(ctx context.Context, cfg *config.Config) (Authenticator, error) {
 	if cfg.DisableAuth {
 		slog.Warn("authentication/authorization is disabled")
 		return auth.NewNoopAuthenticator(), nil
 	}
 
-	provider, err := auth.NewOidcProvider(cfg.OidcUrl)
+	provider, err := auth.NewOidcProvider(ctx, cfg.OidcUrl)
 	if err != nil {
 		slog.Error("failed to initialize oidc authenticator", "error", err)
 		return nil, err
 	}
 
+	// keycloak signs its tokens with PS512, generic providers with the algorithms they advertise
+	options := []auth.AuthenticatorOption{auth.WithRolesClaim(cfg.OidcRolesClaim)}
+	if cfg.OidcProvider == auth.OIDCProviderGeneric {
+		options = append(options, auth.WithSigningMethods(provider.SigningAlgorithms()...))
+	}
+
 	if !cfg.OpaEnabled {
-		slog.Warn("opa is not enabled")
-		return auth.NewOidcAuthenticator(provider, nil)
+		slog.Warn("opa is not enabled, authorizing with the built-in rbac policy")
+		return auth.NewOidcAuthenticator(provider, nil, options...)
 	}
 
 	opa, err := auth.NewOpaClient(cfg.OpaPort)
@@ -215,10 +705,17 @@ func GetAuthenticator(cfg *config.Config) (Authenticator, error) {
 		return nil, fmt.Errorf("failed to create opa client: %w", err)
 	}
 
-	return auth.NewOidcAuthenticator(provider, opa)
+	return auth.NewOidcAuthenticator(provider, opa, options...)
 }
 
-func GetInventory(cfg *config.Config, k8sClient *k8s.Client) (Inventory, error) {
+func GetInventory(cfg *config.Config, k8sClient *k8s.Client, isLeader func() bool) (Inventory, error) {
+	if cfg.InventoryStub {
+		slog.Warn("inventory is replaced by a stub returning fake host data")
+		stub := inventory.NewStubInventoryClient(k8sClient)
+		stub.WatchHosts(context.TODO(), events.NewSink(context.TODO()))
+		return stub, nil
+	}
+
 	if cfg.DisableInventory {
 		slog.Warn("inventory integration is disabled")
 		return inventory.NewNoopInventoryClient(), nil
@@ -230,5 +727,6 @@ func GetInventory(cfg *config.Config, k8sClient *k8s.Client) (Inventory, error)
 		WithTracing(false).
 		WithMetrics(false).
 		WithK8sClient(k8sClient).
+		WithLeaderCheck(isLeader).
 		Build())
 }
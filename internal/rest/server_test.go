@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/config"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestServeShutdown(t *testing.T) {
+	s := NewServer(nil, WithConfig(&config.Config{ShutdownDelay: 500 * time.Millisecond, ShutdownTimeout: 5 * time.Second}))
+	handler, err := s.ConfigureHandler()
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	served := make(chan error, 1)
+	go func() {
+		served <- s.serve(ctx, listener, handler)
+	}()
+
+	url := "http://" + listener.Addr().String() + "/v2/readyz"
+	readyz := func() (int, api.Readiness, error) {
+		resp, err := http.Get(url) //nolint:noctx // local test server
+		if err != nil {
+			return 0, api.Readiness{}, err
+		}
+		defer resp.Body.Close()
+		var readiness api.Readiness
+		err = json.NewDecoder(resp.Body).Decode(&readiness)
+		return resp.StatusCode, readiness, err
+	}
+
+	code, readiness, err := readyz()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, readiness.Ready)
+
+	// the server keeps serving for the shutdown delay, reporting it is not ready
+	cancel()
+	require.Eventually(t, func() bool {
+		code, readiness, err = readyz()
+		return err == nil && code == http.StatusServiceUnavailable
+	}, 400*time.Millisecond, 20*time.Millisecond)
+	require.Equal(t, &[]string{"server is shutting down"}, readiness.Failures)
+
+	select {
+	case err := <-served:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not shut down")
+	}
+	_, _, err = readyz()
+	require.Error(t, err)
+}
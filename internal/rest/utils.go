@@ -8,17 +8,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/messages"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
@@ -30,6 +34,13 @@ const (
 	ClusterNameSelectorKey               = "cluster.x-k8s.io/cluster-name"
 )
 
+// problem returns the problem details of a message in the language of the request
+func problem(ctx context.Context, message messages.Message) api.ProblemDetails {
+	code := string(message.Code)
+	text := message.Localize(ctx)
+	return api.ProblemDetails{Code: &code, Message: &text}
+}
+
 func validateClusterDetail(clusterDetail api.ClusterDetailInfo) error {
 	if clusterDetail.Name == nil || *clusterDetail.Name == "" {
 		return errors.New("missing or invalid cluster name")
@@ -164,6 +175,19 @@ func getClusterLifecyclePhase(cluster *capi.Cluster) (*api.GenericStatus, []erro
 	}
 
 	var errorReasons []error
+	// the phase of a cluster is updated by Cluster API only after its deletion was requested
+	if cluster.DeletionTimestamp != nil {
+		*status.Indicator = api.STATUSINDICATIONINPROGRESS
+		*status.Message = "deleting"
+		*status.Timestamp = uint64(cluster.DeletionTimestamp.UTC().Unix())
+		return &status, errorReasons
+	}
+	if deleteAt, ok := deletion.DeleteAt(cluster); ok {
+		*status.Indicator = api.STATUSINDICATIONINPROGRESS
+		*status.Message = "pending-delete"
+		*status.Timestamp = uint64(deleteAt.Unix())
+		return &status, errorReasons
+	}
 	if len(cluster.Status.Conditions) == 0 {
 		*status.Indicator = api.STATUSINDICATIONUNSPECIFIED
 		*status.Message = "Condition not found"
@@ -326,8 +350,16 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
-func fetchClustersList(ctx context.Context, s *Server, namespace string) ([]unstructured.Unstructured, error) {
-	unstructuredClusterList, err := s.k8sclient.Resource(core.ClusterResourceSchema).Namespace(namespace).List(ctx, v1.ListOptions{})
+// optional returns a pointer to s, or nil if s is empty
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func fetchClustersList(ctx context.Context, dyn dynamic.Interface, namespace string) ([]unstructured.Unstructured, error) {
+	unstructuredClusterList, err := dyn.Resource(core.ClusterResourceSchema).Namespace(namespace).List(ctx, v1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +382,7 @@ func fetchClusterObject(ctx context.Context, s *Server, namespace string, name s
 
 // nolint: unused
 func fetchMachineFromCluster(ctx context.Context, s *Server, namespace string, clusterName string, nodeID string) (*capi.Machine, error) {
-	unstructuredMachines, err := fetchMachinesList(ctx, s, namespace, clusterName)
+	unstructuredMachines, err := fetchMachinesList(ctx, s.k8sclient, namespace, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -415,8 +447,8 @@ func fetchIntelMachineBindingFromCluster(ctx context.Context, s *Server, namespa
 	return nil, fmt.Errorf("machine not found for node ID %s", nodeID)
 }
 
-func fetchMachinesList(ctx context.Context, s *Server, namespace string, clusterName string) ([]unstructured.Unstructured, error) {
-	unstructuredMachineList, err := s.k8sclient.Resource(core.MachineResourceSchema).Namespace(namespace).List(ctx, v1.ListOptions{
+func fetchMachinesList(ctx context.Context, dyn dynamic.Interface, namespace string, clusterName string) ([]unstructured.Unstructured, error) {
+	unstructuredMachineList, err := dyn.Resource(core.MachineResourceSchema).Namespace(namespace).List(ctx, v1.ListOptions{
 		LabelSelector: ClusterNameSelectorKey + "=" + clusterName,
 	})
 	if err != nil {
@@ -425,8 +457,8 @@ func fetchMachinesList(ctx context.Context, s *Server, namespace string, cluster
 	return unstructuredMachineList.Items, nil
 }
 
-func fetchAllMachinesList(ctx context.Context, s *Server, namespace string) ([]unstructured.Unstructured, error) {
-	unstructuredMachineList, err := s.k8sclient.Resource(core.MachineResourceSchema).Namespace(namespace).List(ctx, v1.ListOptions{})
+func fetchAllMachinesList(ctx context.Context, dyn dynamic.Interface, namespace string) ([]unstructured.Unstructured, error) {
+	unstructuredMachineList, err := dyn.Resource(core.MachineResourceSchema).Namespace(namespace).List(ctx, v1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -450,3 +482,19 @@ func getPatchData(originalObj, modifiedObj interface{}) ([]byte, error) {
 	}
 	return patchBytes, nil
 }
+
+// errTemplateModified is returned when a template was modified since the client read it
+var errTemplateModified = errors.New("template was modified since it was read")
+
+// checkIfMatch returns errTemplateModified unless the If-Match header is unset, "*" or the resource version of the
+// template; the version may be quoted as an entity tag
+func checkIfMatch(ifMatch *api.IfMatchHeader, template *unstructured.Unstructured) error {
+	if ifMatch == nil || *ifMatch == "" || *ifMatch == "*" {
+		return nil
+	}
+	version := strings.Trim(strings.TrimPrefix(*ifMatch, "W/"), `"`)
+	if version != template.GetResourceVersion() {
+		return fmt.Errorf("%w: resource version is %s, not %s", errTemplateModified, template.GetResourceVersion(), version)
+	}
+	return nil
+}
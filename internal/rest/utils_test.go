@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/open-edge-platform/cluster-manager/v2/internal/deletion"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 
 	intelv1alpha1 "github.com/open-edge-platform/cluster-api-provider-intel/api/v1alpha1"
@@ -57,6 +58,22 @@ func TestGetClusterLifecyclePhase(t *testing.T) {
 				Timestamp: ptr(uint64(fixedTime.Unix())),
 			},
 		},
+		"pending deletion": {
+			cluster: &capi.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					deletion.DeleteAtAnnotationKey: fixedTime.UTC().Format(time.RFC3339),
+				}},
+				Status: capi.ClusterStatus{
+					Conditions: []capi.Condition{{LastTransitionTime: metav1.Time{Time: fixedTime}}},
+					Phase:      string(capi.ClusterPhaseProvisioned),
+				},
+			},
+			expectedStatus: &api.GenericStatus{
+				Indicator: ptr(api.STATUSINDICATIONINPROGRESS),
+				Message:   ptr("pending-delete"),
+				Timestamp: ptr(uint64(fixedTime.Unix())),
+			},
+		},
 		"provisioned": {
 			cluster: &capi.Cluster{
 				Status: capi.ClusterStatus{
@@ -0,0 +1,390 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scheduling defers the provisioning of clusters: the spec of a cluster requested for a later time, or whose
+// hosts pull the images of its template first, is kept as a pending cluster until the Scheduler provisions it
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/preflight"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// State is the state of a pending cluster
+type State string
+
+const (
+	Preflight    State = "preflight"
+	Scheduled    State = "scheduled"
+	Provisioning State = "provisioning"
+	Failed       State = "failed"
+)
+
+const (
+	// DefaultInterval is how often the Scheduler checks for pending clusters that are due
+	DefaultInterval = 30 * time.Second
+	// DefaultProvisioningTimeout is how long a pending cluster may be provisioning before the attempt is considered
+	// interrupted, e.g. by a restart of cluster manager
+	DefaultProvisioningTimeout = 10 * time.Minute
+	// DefaultPreflightTimeout is how long the hosts of a pending cluster may take to pull the images of its template
+	DefaultPreflightTimeout = time.Hour
+)
+
+var (
+	// ErrProvisioning is returned when a pending cluster can not be changed because it is being provisioned
+	ErrProvisioning = errors.New("pending cluster is being provisioned")
+	// ErrPreflight is returned when a pending cluster can not be rescheduled because its hosts are pulling images
+	ErrPreflight = errors.New("hosts of pending cluster are pulling images")
+	// ErrPreflightDisabled is returned when image preflight is requested but no image puller is configured
+	ErrPreflightDisabled = errors.New("image preflight is not enabled")
+)
+
+// PendingCluster is a cluster whose provisioning is deferred until ProvisionAt
+type PendingCluster struct {
+	Name        string          `json:"name"`
+	ProjectID   string          `json:"projectId"`
+	ProvisionAt time.Time       `json:"provisionAt"`
+	Spec        api.ClusterSpec `json:"spec"`
+	State       State           `json:"state"`
+	Error       string          `json:"error,omitempty"`
+	// Images are the images the hosts pull before the cluster is provisioned, and Preflight the status of the hosts
+	Images    []string               `json:"images,omitempty"`
+	Preflight []preflight.HostStatus `json:"preflight,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+	UpdatedAt time.Time              `json:"updatedAt"`
+	// Idempotency identifies the request the pending cluster was created by if it had an Idempotency-Key; its cluster is
+	// created with the same key, so that a retry of the request finds the pending cluster and then its cluster
+	Idempotency *Idempotency `json:"idempotency,omitempty"`
+
+	// resourceVersion is the version of the stored pending cluster, see Store.Update
+	resourceVersion string
+}
+
+// Idempotency is the Idempotency-Key of a create request and the digest of its body
+type Idempotency struct {
+	Key    string `json:"key"`
+	Digest string `json:"digest"`
+}
+
+// WithIdempotency is a functional option for scheduling a pending cluster created by a request with the given
+// Idempotency-Key and body digest
+func WithIdempotency(key, digest string) func(*PendingCluster) {
+	return func(pc *PendingCluster) {
+		pc.Idempotency = &Idempotency{Key: key, Digest: digest}
+	}
+}
+
+// Provisioner creates the cluster of a pending cluster, with the idempotency of the request the pending cluster was
+// created by if it had any
+type Provisioner interface {
+	ProvisionCluster(ctx context.Context, projectID string, spec api.ClusterSpec, idempotency *Idempotency) error
+}
+
+// Scheduler keeps the pending clusters and provisions them when they are due
+type Scheduler struct {
+	store               *Store
+	interval            time.Duration
+	provisioningTimeout time.Duration
+	preflightTimeout    time.Duration
+	puller              preflight.ImagePuller
+	now                 func() time.Time
+}
+
+// NewScheduler creates a new Scheduler that keeps the pending clusters in ConfigMaps of the project namespaces
+func NewScheduler(k8sClient *k8s.Client, options ...func(*Scheduler)) *Scheduler {
+	s := &Scheduler{
+		store:               NewStore(k8sClient),
+		interval:            DefaultInterval,
+		provisioningTimeout: DefaultProvisioningTimeout,
+		preflightTimeout:    DefaultPreflightTimeout,
+		now:                 time.Now,
+	}
+
+	for _, o := range options {
+		o(s)
+	}
+
+	return s
+}
+
+// WithInterval is a functional option for configuring how often a Scheduler checks for due pending clusters
+func WithInterval(interval time.Duration) func(*Scheduler) {
+	return func(s *Scheduler) {
+		s.interval = interval
+	}
+}
+
+// WithClock is a functional option for configuring a Scheduler with the given clock
+func WithClock(now func() time.Time) func(*Scheduler) {
+	return func(s *Scheduler) {
+		s.now = now
+	}
+}
+
+// WithImagePuller is a functional option for enabling the image preflight of pending clusters with the given puller
+func WithImagePuller(puller preflight.ImagePuller) func(*Scheduler) {
+	return func(s *Scheduler) {
+		s.puller = puller
+	}
+}
+
+// WithPreflightTimeout is a functional option for configuring how long the hosts may take to pull the images
+func WithPreflightTimeout(timeout time.Duration) func(*Scheduler) {
+	return func(s *Scheduler) {
+		s.preflightTimeout = timeout
+	}
+}
+
+// PreflightEnabled returns whether pending clusters can pull images onto their hosts, see SchedulePreflight
+func (s *Scheduler) PreflightEnabled() bool {
+	return s.puller != nil
+}
+
+// Schedule stores the spec as a pending cluster of the project to be provisioned at the given time
+// The spec must be named, see PendingCluster.Name
+func (s *Scheduler) Schedule(ctx context.Context, projectID string, spec api.ClusterSpec, provisionAt time.Time, options ...func(*PendingCluster)) (PendingCluster, error) {
+	if spec.Name == nil || *spec.Name == "" {
+		return PendingCluster{}, fmt.Errorf("pending cluster must be named")
+	}
+
+	now := s.now().UTC()
+	spec.ProvisionAt = nil
+	pc := PendingCluster{
+		Name:        *spec.Name,
+		ProjectID:   projectID,
+		ProvisionAt: provisionAt.UTC(),
+		Spec:        spec,
+		State:       Scheduled,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for _, o := range options {
+		o(&pc)
+	}
+
+	if err := s.store.Create(ctx, pc); err != nil {
+		return PendingCluster{}, err
+	}
+	return pc, nil
+}
+
+// SchedulePreflight stores the spec as a pending cluster of the project whose hosts pull the given images first; it is
+// scheduled to be provisioned at the given time once all hosts pulled them. The pending cluster is not stored if a host
+// can not be instructed to pull the images.
+func (s *Scheduler) SchedulePreflight(ctx context.Context, projectID string, spec api.ClusterSpec, provisionAt time.Time, images []string, options ...func(*PendingCluster)) (PendingCluster, error) {
+	if s.puller == nil {
+		return PendingCluster{}, ErrPreflightDisabled
+	}
+	if spec.Name == nil || *spec.Name == "" {
+		return PendingCluster{}, fmt.Errorf("pending cluster must be named")
+	}
+
+	now := s.now().UTC()
+	spec.ProvisionAt, spec.ImagePreflight = nil, nil
+	pc := PendingCluster{
+		Name:        *spec.Name,
+		ProjectID:   projectID,
+		ProvisionAt: provisionAt.UTC(),
+		Spec:        spec,
+		State:       Preflight,
+		Images:      images,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for _, node := range spec.Nodes {
+		pc.Preflight = append(pc.Preflight, preflight.HostStatus{HostID: node.Id, State: preflight.Pulling, Total: len(images)})
+	}
+	for _, o := range options {
+		o(&pc)
+	}
+
+	if err := s.store.Create(ctx, pc); err != nil {
+		return PendingCluster{}, err
+	}
+	for _, host := range pc.Preflight {
+		if err := s.puller.PullImages(ctx, projectID, host.HostID, images); err != nil {
+			if err := s.store.Delete(ctx, projectID, pc.Name); err != nil {
+				slog.Error("failed to delete pending cluster", "namespace", projectID, "name", pc.Name, "error", err)
+			}
+			return PendingCluster{}, err
+		}
+	}
+	return pc, nil
+}
+
+// Get returns the pending cluster of the project with the given name
+func (s *Scheduler) Get(ctx context.Context, projectID, name string) (PendingCluster, error) {
+	return s.store.Get(ctx, projectID, name)
+}
+
+// List returns the pending clusters of the project, the earliest due first
+func (s *Scheduler) List(ctx context.Context, projectID string) ([]PendingCluster, error) {
+	pcs, err := s.store.List(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(pcs, func(i, j int) bool {
+		return pcs[i].ProvisionAt.Before(pcs[j].ProvisionAt)
+	})
+	return pcs, nil
+}
+
+// Reschedule replaces the spec and the provisioning time of the pending cluster of the project with the given name
+// A failed pending cluster is scheduled again
+func (s *Scheduler) Reschedule(ctx context.Context, projectID, name string, spec api.ClusterSpec, provisionAt time.Time) (PendingCluster, error) {
+	pc, err := s.store.Get(ctx, projectID, name)
+	if err != nil {
+		return PendingCluster{}, err
+	}
+	switch pc.State {
+	case Provisioning:
+		return PendingCluster{}, ErrProvisioning
+	case Preflight:
+		return PendingCluster{}, ErrPreflight
+	}
+
+	spec.Name = &pc.Name
+	spec.ProvisionAt, spec.ImagePreflight = nil, nil
+	pc.Spec, pc.ProvisionAt = spec, provisionAt.UTC()
+	pc.State, pc.Error, pc.UpdatedAt = Scheduled, "", s.now().UTC()
+	pc.Images, pc.Preflight = nil, nil
+
+	// fails with ErrPendingClusterChanged if the scheduler claimed the pending cluster concurrently
+	return s.store.Update(ctx, pc)
+}
+
+// Cancel deletes the pending cluster of the project with the given name
+func (s *Scheduler) Cancel(ctx context.Context, projectID, name string) error {
+	pc, err := s.store.Get(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	if pc.State == Provisioning {
+		return ErrProvisioning
+	}
+	return s.store.Delete(ctx, projectID, name)
+}
+
+// Run provisions the due pending clusters of all projects with the given provisioner until the context is canceled
+func (s *Scheduler) Run(ctx context.Context, provisioner Provisioner) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.provisionDue(ctx, provisioner)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// provisionDue provisions the pending clusters that are due
+// A pending cluster is claimed by setting its state to provisioning first, so that it is provisioned once even if
+// several replicas of cluster manager are running; it is deleted once its cluster was created and marked failed
+// otherwise, so that the project can correct and reschedule it
+func (s *Scheduler) provisionDue(ctx context.Context, provisioner Provisioner) {
+	pcs, err := s.store.List(ctx, "")
+	if err != nil {
+		slog.Error("failed to list pending clusters", "error", err)
+		return
+	}
+
+	now := s.now().UTC()
+	for _, pc := range pcs {
+		switch {
+		case pc.State == Preflight:
+			s.checkPreflight(ctx, pc, now)
+			continue
+		case pc.State == Provisioning && now.Sub(pc.UpdatedAt) > s.provisioningTimeout:
+			s.fail(ctx, pc, "provisioning was interrupted, check whether the cluster exists before rescheduling it")
+			continue
+		case pc.State != Scheduled || pc.ProvisionAt.After(now):
+			continue
+		}
+
+		pc.State, pc.UpdatedAt = Provisioning, now
+		claimed, err := s.store.Update(ctx, pc)
+		if errors.Is(err, ErrPendingClusterChanged) || errors.Is(err, ErrPendingClusterNotFound) {
+			// the pending cluster was claimed, rescheduled or canceled concurrently
+			continue
+		}
+		if err != nil {
+			slog.Error("failed to claim pending cluster", "namespace", pc.ProjectID, "name", pc.Name, "error", err)
+			continue
+		}
+		pc = claimed
+
+		slog.Info("provisioning pending cluster", "namespace", pc.ProjectID, "name", pc.Name, "provisionAt", pc.ProvisionAt)
+		if err := provisioner.ProvisionCluster(ctx, pc.ProjectID, pc.Spec, pc.Idempotency); err != nil {
+			s.fail(ctx, pc, err.Error())
+			continue
+		}
+		if err := s.store.Delete(ctx, pc.ProjectID, pc.Name); err != nil && !errors.Is(err, ErrPendingClusterNotFound) {
+			slog.Error("failed to delete provisioned pending cluster", "namespace", pc.ProjectID, "name", pc.Name, "error", err)
+		}
+	}
+}
+
+// checkPreflight updates the image pull status of the hosts of the pending cluster; it is scheduled once all hosts
+// pulled the images, and marked failed if a host failed to pull them or they were not pulled in time
+func (s *Scheduler) checkPreflight(ctx context.Context, pc PendingCluster, now time.Time) {
+	if s.puller == nil {
+		s.fail(ctx, pc, ErrPreflightDisabled.Error())
+		return
+	}
+
+	pulled := true
+	for i, host := range pc.Preflight {
+		status, err := s.puller.PullStatus(ctx, pc.ProjectID, host.HostID)
+		if err != nil {
+			// the pull goes on on the host, its status is checked again with the next check
+			slog.Warn("failed to get image pull status of host", "namespace", pc.ProjectID, "name", pc.Name, "host", host.HostID, "error", err)
+			pulled = false
+			continue
+		}
+		pc.Preflight[i] = status
+		switch status.State {
+		case preflight.Failed:
+			s.fail(ctx, pc, fmt.Sprintf("failed to pull images onto host %s: %s", host.HostID, status.Error))
+			return
+		case preflight.Pulling:
+			pulled = false
+		}
+	}
+
+	switch {
+	case pulled:
+		slog.Info("hosts of pending cluster pulled the images", "namespace", pc.ProjectID, "name", pc.Name, "images", len(pc.Images))
+		pc.State = Scheduled
+	case now.Sub(pc.CreatedAt) > s.preflightTimeout:
+		s.fail(ctx, pc, fmt.Sprintf("hosts did not pull the images within %s", s.preflightTimeout))
+		return
+	}
+
+	pc.UpdatedAt = now
+	if _, err := s.store.Update(ctx, pc); err != nil && !errors.Is(err, ErrPendingClusterChanged) && !errors.Is(err, ErrPendingClusterNotFound) {
+		slog.Error("failed to update pending cluster", "namespace", pc.ProjectID, "name", pc.Name, "error", err)
+	}
+}
+
+// fail marks the pending cluster failed with the given message
+func (s *Scheduler) fail(ctx context.Context, pc PendingCluster, message string) {
+	slog.Error("failed to provision pending cluster", "namespace", pc.ProjectID, "name", pc.Name, "error", message)
+
+	pc.State, pc.Error, pc.UpdatedAt = Failed, message, s.now().UTC()
+	if _, err := s.store.Update(ctx, pc); err != nil {
+		slog.Error("failed to update pending cluster", "namespace", pc.ProjectID, "name", pc.Name, "error", err)
+	}
+}
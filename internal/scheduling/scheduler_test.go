@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/preflight"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+const namespace = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+// fakeProvisioner records the provisioned clusters and their idempotency and fails with err if set
+type fakeProvisioner struct {
+	provisioned []string
+	idempotency []*Idempotency
+	err         error
+}
+
+func (p *fakeProvisioner) ProvisionCluster(_ context.Context, projectID string, spec api.ClusterSpec, idempotency *Idempotency) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.provisioned = append(p.provisioned, projectID+"/"+*spec.Name)
+	p.idempotency = append(p.idempotency, idempotency)
+	return nil
+}
+
+// fakePuller records the hosts instructed to pull images and returns the given status of each host
+type fakePuller struct {
+	pulls    []string
+	statuses map[string]preflight.HostStatus
+	err      error
+}
+
+func (p *fakePuller) PullImages(_ context.Context, _, hostID string, _ []string) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.pulls = append(p.pulls, hostID)
+	return nil
+}
+
+func (p *fakePuller) PullStatus(_ context.Context, _, hostID string) (preflight.HostStatus, error) {
+	status, ok := p.statuses[hostID]
+	if !ok {
+		return preflight.HostStatus{}, fmt.Errorf("gateway unavailable")
+	}
+	return status, nil
+}
+
+func clusterSpec(name string) api.ClusterSpec {
+	template := "baseline-v1.0.0"
+	return api.ClusterSpec{Name: &name, Template: &template, Nodes: []api.NodeSpec{{Id: "host-1", Role: api.All}}}
+}
+
+func TestScheduler(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	t.Run("pending clusters are listed the earliest due first", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+
+		later, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, Scheduled, later.State)
+		earlier, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-2"), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		pcs, err := s.List(context.Background(), namespace)
+		require.NoError(t, err)
+		require.Len(t, pcs, 2)
+		require.Equal(t, earlier.Name, pcs[0].Name)
+		require.Equal(t, later.Name, pcs[1].Name)
+
+		_, err = s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now.Add(time.Hour))
+		require.ErrorIs(t, err, ErrPendingClusterExists)
+	})
+
+	t.Run("provisionAt is not kept in the spec", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+		spec := clusterSpec("cluster-1")
+		provisionAt := now.Add(time.Hour)
+		spec.ProvisionAt = &provisionAt
+
+		_, err := s.Schedule(context.Background(), namespace, spec, provisionAt)
+		require.NoError(t, err)
+
+		pc, err := s.Get(context.Background(), namespace, "cluster-1")
+		require.NoError(t, err)
+		require.Nil(t, pc.Spec.ProvisionAt)
+		require.Equal(t, provisionAt, pc.ProvisionAt)
+	})
+
+	t.Run("reschedule replaces the spec and keeps the name", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+		_, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		spec := clusterSpec("other")
+		spec.Labels = &map[string]string{"site": "berlin"}
+		pc, err := s.Reschedule(context.Background(), namespace, "cluster-1", spec, now.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, "cluster-1", *pc.Spec.Name)
+		require.Equal(t, now.Add(3*time.Hour), pc.ProvisionAt)
+
+		stored, err := s.Get(context.Background(), namespace, "cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"site": "berlin"}, *stored.Spec.Labels)
+
+		_, err = s.Reschedule(context.Background(), namespace, "cluster-2", spec, now.Add(time.Hour))
+		require.ErrorIs(t, err, ErrPendingClusterNotFound)
+	})
+
+	t.Run("provisioning pending clusters can not be changed", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+		pc, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now.Add(time.Hour))
+		require.NoError(t, err)
+		pc.State = Provisioning
+		_, err = s.store.Update(context.Background(), pc)
+		require.NoError(t, err)
+
+		_, err = s.Reschedule(context.Background(), namespace, "cluster-1", clusterSpec("cluster-1"), now.Add(time.Hour))
+		require.ErrorIs(t, err, ErrProvisioning)
+		require.ErrorIs(t, s.Cancel(context.Background(), namespace, "cluster-1"), ErrProvisioning)
+	})
+
+	t.Run("cancel deletes the pending cluster", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+		_, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		require.NoError(t, s.Cancel(context.Background(), namespace, "cluster-1"))
+		_, err = s.Get(context.Background(), namespace, "cluster-1")
+		require.ErrorIs(t, err, ErrPendingClusterNotFound)
+		require.ErrorIs(t, s.Cancel(context.Background(), namespace, "cluster-1"), ErrPendingClusterNotFound)
+	})
+
+	t.Run("due pending clusters are provisioned and deleted", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+		_, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now.Add(-time.Minute))
+		require.NoError(t, err)
+		_, err = s.Schedule(context.Background(), namespace, clusterSpec("cluster-2"), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		provisioner := &fakeProvisioner{}
+		s.provisionDue(context.Background(), provisioner)
+		require.Equal(t, []string{namespace + "/cluster-1"}, provisioner.provisioned)
+
+		pcs, err := s.List(context.Background(), namespace)
+		require.NoError(t, err)
+		require.Len(t, pcs, 1)
+		require.Equal(t, "cluster-2", pcs[0].Name)
+		require.Equal(t, Scheduled, pcs[0].State)
+	})
+
+	t.Run("pending clusters are annotated with their idempotency and provisioned with it", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		s := NewScheduler(client, WithClock(clock))
+		_, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now, WithIdempotency("create-cluster-1", "digest"))
+		require.NoError(t, err)
+
+		obj, err := client.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Get(context.Background(), "pending-cluster-cluster-1", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			core.IdempotencyKeyAnnotationKey:    "create-cluster-1",
+			core.IdempotencyDigestAnnotationKey: "digest",
+		}, obj.GetAnnotations())
+
+		provisioner := &fakeProvisioner{}
+		s.provisionDue(context.Background(), provisioner)
+		require.Equal(t, []string{namespace + "/cluster-1"}, provisioner.provisioned)
+		require.Equal(t, []*Idempotency{{Key: "create-cluster-1", Digest: "digest"}}, provisioner.idempotency)
+	})
+
+	t.Run("pending clusters that can not be provisioned are marked failed", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+		_, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now)
+		require.NoError(t, err)
+
+		s.provisionDue(context.Background(), &fakeProvisioner{err: fmt.Errorf("quota exceeded")})
+
+		pc, err := s.Get(context.Background(), namespace, "cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, Failed, pc.State)
+		require.Equal(t, "quota exceeded", pc.Error)
+
+		// failed pending clusters are not retried until they are rescheduled
+		provisioner := &fakeProvisioner{}
+		s.provisionDue(context.Background(), provisioner)
+		require.Empty(t, provisioner.provisioned)
+
+		pc, err = s.Reschedule(context.Background(), namespace, "cluster-1", clusterSpec("cluster-1"), now)
+		require.NoError(t, err)
+		require.Equal(t, Scheduled, pc.State)
+		require.Empty(t, pc.Error)
+
+		s.provisionDue(context.Background(), provisioner)
+		require.Equal(t, []string{namespace + "/cluster-1"}, provisioner.provisioned)
+	})
+
+	t.Run("interrupted provisioning is marked failed", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock))
+		pc, err := s.Schedule(context.Background(), namespace, clusterSpec("cluster-1"), now.Add(-time.Hour))
+		require.NoError(t, err)
+		pc.State, pc.UpdatedAt = Provisioning, now.Add(-DefaultProvisioningTimeout-time.Second)
+		_, err = s.store.Update(context.Background(), pc)
+		require.NoError(t, err)
+
+		provisioner := &fakeProvisioner{}
+		s.provisionDue(context.Background(), provisioner)
+		require.Empty(t, provisioner.provisioned)
+
+		pc, err = s.Get(context.Background(), namespace, "cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, Failed, pc.State)
+		require.Contains(t, pc.Error, "provisioning was interrupted")
+	})
+	t.Run("pending clusters are scheduled once their hosts pulled the images", func(t *testing.T) {
+		images := []string{"registry.site.local:5000/rancher/mirrored-pause:3.6"}
+		puller := &fakePuller{statuses: map[string]preflight.HostStatus{}}
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock), WithImagePuller(puller))
+		spec := clusterSpec("cluster-1")
+		imagePreflight := true
+		spec.ImagePreflight = &imagePreflight
+
+		pc, err := s.SchedulePreflight(context.Background(), namespace, spec, now, images)
+		require.NoError(t, err)
+		require.Equal(t, Preflight, pc.State)
+		require.Nil(t, pc.Spec.ImagePreflight)
+		require.Equal(t, []string{"host-1"}, puller.pulls)
+		require.Equal(t, []preflight.HostStatus{{HostID: "host-1", State: preflight.Pulling, Total: 1}}, pc.Preflight)
+
+		// hosts whose status is not known yet keep pulling
+		provisioner := &fakeProvisioner{}
+		s.provisionDue(context.Background(), provisioner)
+		require.Empty(t, provisioner.provisioned)
+
+		_, err = s.Reschedule(context.Background(), namespace, "cluster-1", clusterSpec("cluster-1"), now)
+		require.ErrorIs(t, err, ErrPreflight)
+
+		puller.statuses["host-1"] = preflight.HostStatus{HostID: "host-1", State: preflight.Pulled, Pulled: 1, Total: 1}
+		s.provisionDue(context.Background(), provisioner)
+		pc, err = s.Get(context.Background(), namespace, "cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, Scheduled, pc.State)
+		require.Equal(t, []preflight.HostStatus{{HostID: "host-1", State: preflight.Pulled, Pulled: 1, Total: 1}}, pc.Preflight)
+
+		s.provisionDue(context.Background(), provisioner)
+		require.Equal(t, []string{namespace + "/cluster-1"}, provisioner.provisioned)
+	})
+
+	t.Run("pending clusters whose hosts failed to pull the images are marked failed", func(t *testing.T) {
+		puller := &fakePuller{statuses: map[string]preflight.HostStatus{
+			"host-1": {HostID: "host-1", State: preflight.Failed, Total: 1, Error: "manifest unknown"},
+		}}
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock), WithImagePuller(puller))
+		_, err := s.SchedulePreflight(context.Background(), namespace, clusterSpec("cluster-1"), now, []string{"registry.site.local:5000/k8s/pause:3.10"})
+		require.NoError(t, err)
+
+		s.provisionDue(context.Background(), &fakeProvisioner{})
+
+		pc, err := s.Get(context.Background(), namespace, "cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, Failed, pc.State)
+		require.Contains(t, pc.Error, "manifest unknown")
+	})
+
+	t.Run("pending clusters whose hosts do not pull the images in time are marked failed", func(t *testing.T) {
+		puller := &fakePuller{statuses: map[string]preflight.HostStatus{
+			"host-1": {HostID: "host-1", State: preflight.Pulling, Total: 1},
+		}}
+		created := now.Add(-DefaultPreflightTimeout - time.Second)
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(func() time.Time { return created }), WithImagePuller(puller))
+		_, err := s.SchedulePreflight(context.Background(), namespace, clusterSpec("cluster-1"), created, []string{"registry.site.local:5000/k8s/pause:3.10"})
+		require.NoError(t, err)
+
+		s.now = clock
+		s.provisionDue(context.Background(), &fakeProvisioner{})
+
+		pc, err := s.Get(context.Background(), namespace, "cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, Failed, pc.State)
+		require.Contains(t, pc.Error, "did not pull the images")
+	})
+
+	t.Run("pending clusters are not stored if the hosts can not be instructed", func(t *testing.T) {
+		s := NewScheduler(k8s.New().WithFakeClient(), WithClock(clock), WithImagePuller(&fakePuller{err: fmt.Errorf("gateway unavailable")}))
+		_, err := s.SchedulePreflight(context.Background(), namespace, clusterSpec("cluster-1"), now, []string{"registry.site.local:5000/k8s/pause:3.10"})
+		require.Error(t, err)
+
+		_, err = s.Get(context.Background(), namespace, "cluster-1")
+		require.ErrorIs(t, err, ErrPendingClusterNotFound)
+
+		_, err = NewScheduler(k8s.New().WithFakeClient()).SchedulePreflight(context.Background(), namespace, clusterSpec("cluster-1"), now, []string{"pause"})
+		require.ErrorIs(t, err, ErrPreflightDisabled)
+	})
+}
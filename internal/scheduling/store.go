@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const (
+	// PendingClusterLabelKey marks the ConfigMaps holding pending clusters, its value is the name of the cluster
+	PendingClusterLabelKey = core.ClusterOrchResourceGroup + "/pending-cluster"
+
+	pendingClusterDataKey = "pendingCluster"
+)
+
+var (
+	ErrPendingClusterNotFound = errors.New("pending cluster not found")
+	ErrPendingClusterExists   = errors.New("pending cluster already exists")
+	// ErrPendingClusterChanged is returned when the pending cluster was changed since it was read
+	ErrPendingClusterChanged = errors.New("pending cluster was changed concurrently")
+)
+
+// Store keeps each pending cluster as a ConfigMap in the namespace of its project, so pending clusters
+// survive restarts of cluster manager and are removed together with the project
+type Store struct {
+	k8s *k8s.Client
+}
+
+// NewStore creates a new Store
+func NewStore(k8sClient *k8s.Client) *Store {
+	return &Store{k8s: k8sClient}
+}
+
+// Create stores a new pending cluster
+func (s *Store) Create(ctx context.Context, pc PendingCluster) error {
+	obj, err := configMap(pc)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(pc.ProjectID).Create(ctx, obj, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return ErrPendingClusterExists
+	}
+	return err
+}
+
+// Update replaces the stored pending cluster, it fails with ErrPendingClusterChanged if the pending cluster was
+// changed since it was read
+func (s *Store) Update(ctx context.Context, pc PendingCluster) (PendingCluster, error) {
+	obj, err := configMap(pc)
+	if err != nil {
+		return PendingCluster{}, err
+	}
+	obj.SetResourceVersion(pc.resourceVersion)
+
+	updated, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(pc.ProjectID).Update(ctx, obj, metav1.UpdateOptions{})
+	switch {
+	case k8serrors.IsNotFound(err):
+		return PendingCluster{}, ErrPendingClusterNotFound
+	case k8serrors.IsConflict(err):
+		return PendingCluster{}, ErrPendingClusterChanged
+	case err != nil:
+		return PendingCluster{}, err
+	}
+	return pendingCluster(*updated)
+}
+
+// Get returns the pending cluster with the given name
+func (s *Store) Get(ctx context.Context, namespace, name string) (PendingCluster, error) {
+	obj, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Get(ctx, configMapName(name), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return PendingCluster{}, ErrPendingClusterNotFound
+	}
+	if err != nil {
+		return PendingCluster{}, err
+	}
+	if _, ok := obj.GetLabels()[PendingClusterLabelKey]; !ok {
+		return PendingCluster{}, ErrPendingClusterNotFound
+	}
+	return pendingCluster(*obj)
+}
+
+// List returns the pending clusters of the namespace, of all namespaces if namespace is empty
+func (s *Store) List(ctx context.Context, namespace string) ([]PendingCluster, error) {
+	list, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: PendingClusterLabelKey})
+	if err != nil {
+		return nil, err
+	}
+
+	pcs := make([]PendingCluster, 0, len(list.Items))
+	for _, item := range list.Items {
+		pc, err := pendingCluster(item)
+		if err != nil {
+			return nil, err
+		}
+		pcs = append(pcs, pc)
+	}
+	return pcs, nil
+}
+
+// Delete removes the pending cluster with the given name
+func (s *Store) Delete(ctx context.Context, namespace, name string) error {
+	err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Delete(ctx, configMapName(name), metav1.DeleteOptions{})
+	if k8serrors.IsNotFound(err) {
+		return ErrPendingClusterNotFound
+	}
+	return err
+}
+
+func configMapName(name string) string {
+	return "pending-cluster-" + name
+}
+
+// configMap returns the ConfigMap holding the pending cluster
+func configMap(pc PendingCluster) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(pc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pending cluster: %w", err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(configMapName(pc.Name))
+	obj.SetNamespace(pc.ProjectID)
+	obj.SetLabels(map[string]string{PendingClusterLabelKey: pc.Name})
+	// the pending cluster is found by a retry of the request it was created by like the objects created by requests
+	// with an Idempotency-Key
+	if pc.Idempotency != nil {
+		obj.SetAnnotations(map[string]string{
+			core.IdempotencyKeyAnnotationKey:    pc.Idempotency.Key,
+			core.IdempotencyDigestAnnotationKey: pc.Idempotency.Digest,
+		})
+	}
+	if err := unstructured.SetNestedStringMap(obj.Object, map[string]string{pendingClusterDataKey: string(data)}, "data"); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// pendingCluster returns the pending cluster held by the ConfigMap
+func pendingCluster(obj unstructured.Unstructured) (PendingCluster, error) {
+	data, _, err := unstructured.NestedString(obj.Object, "data", pendingClusterDataKey)
+	if err != nil {
+		return PendingCluster{}, err
+	}
+
+	var pc PendingCluster
+	if err := json.Unmarshal([]byte(data), &pc); err != nil {
+		return PendingCluster{}, fmt.Errorf("failed to unmarshal pending cluster %s: %w", obj.GetName(), err)
+	}
+	pc.resourceVersion = obj.GetResourceVersion()
+	return pc, nil
+}
@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package search periodically exports a normalized inventory of the clusters of all projects and their nodes to an
+// external search index, e.g. an OpenSearch ingestion pipeline, so that clusters can be searched platform-wide outside
+// the cluster-manager API, e.g. for the clusters running a given Kubernetes version at a given site
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/cluster"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/convert"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const (
+	// DefaultInterval is the default time between two exports of the inventory
+	DefaultInterval = 5 * time.Minute
+	// publishTimeout bounds the request publishing the inventory, so that an unreachable endpoint does not delay the
+	// next export
+	publishTimeout = 30 * time.Second
+	// siteKey is the host metadata key of the site of a node, see nodemetadata
+	siteKey = "site"
+)
+
+// NodeDocument is a node of an exported cluster
+type NodeDocument struct {
+	ID     string `json:"id"`
+	Role   string `json:"role"`
+	Status string `json:"status"`
+	// Metadata is the host metadata copied from the inventory, e.g. asset tag, site and rack
+	Metadata map[string]string `json:"metadata"`
+}
+
+// ClusterDocument is the normalized inventory of a cluster, one document is indexed per cluster
+type ClusterDocument struct {
+	// ID identifies the cluster across exports, so that the search index can replace the previous document
+	ID                string            `json:"id"`
+	ProjectID         string            `json:"projectId"`
+	Name              string            `json:"name"`
+	Template          string            `json:"template"`
+	KubernetesVersion string            `json:"kubernetesVersion"`
+	LifecyclePhase    string            `json:"lifecyclePhase"`
+	Labels            map[string]string `json:"labels"`
+	// Sites are the distinct sites of the nodes of the cluster, so that clusters can be searched by site without
+	// searching their nodes
+	Sites      []string       `json:"sites"`
+	Nodes      []NodeDocument `json:"nodes"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	ExportedAt time.Time      `json:"exportedAt"`
+}
+
+// Exporter publishes the inventory of the clusters of all projects to the endpoint of a search index
+type Exporter struct {
+	k8s      *k8s.Client
+	endpoint string
+	client   *http.Client
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewExporter creates a new Exporter reading the clusters with the given client and publishing their inventory to
+// the given endpoint
+func NewExporter(k8sClient *k8s.Client, endpoint string, options ...func(*Exporter)) *Exporter {
+	e := &Exporter{
+		k8s:      k8sClient,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: publishTimeout},
+		interval: DefaultInterval,
+		now:      time.Now,
+	}
+
+	for _, o := range options {
+		o(e)
+	}
+
+	return e
+}
+
+// WithInterval is a functional option for configuring the time between two exports of the inventory
+func WithInterval(interval time.Duration) func(*Exporter) {
+	return func(e *Exporter) {
+		e.interval = interval
+	}
+}
+
+// WithClock is a functional option for configuring an Exporter with the given clock
+func WithClock(now func() time.Time) func(*Exporter) {
+	return func(e *Exporter) {
+		e.now = now
+	}
+}
+
+// WithHTTPClient is a functional option for configuring the client the inventory is published with
+func WithHTTPClient(client *http.Client) func(*Exporter) {
+	return func(e *Exporter) {
+		e.client = client
+	}
+}
+
+// Run exports the inventory on start and then every interval until the context is canceled; failed exports are
+// logged and retried with the next export
+func (e *Exporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Export(ctx); err != nil {
+			slog.Error("failed to export cluster inventory", "endpoint", e.endpoint, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Export collects the inventory of the clusters of all projects and publishes it to the endpoint
+func (e *Exporter) Export(ctx context.Context) error {
+	documents, err := e.Collect(ctx)
+	if err != nil {
+		return err
+	}
+	if err := e.publish(ctx, documents); err != nil {
+		return err
+	}
+
+	slog.Debug("exported cluster inventory", "endpoint", e.endpoint, "clusters", len(documents))
+	return nil
+}
+
+// Collect returns the documents of the clusters of all projects; a cluster whose nodes can not be read is exported
+// without nodes rather than failing the export
+func (e *Exporter) Collect(ctx context.Context) ([]ClusterDocument, error) {
+	items, err := k8s.ListClusters(ctx, e.k8s.Dyn, "", k8slabels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	exportedAt := e.now().UTC()
+	documents := make([]ClusterDocument, 0, len(items))
+	for _, item := range items {
+		var c capi.Cluster
+		if err := convert.FromUnstructured(item, &c); err != nil {
+			slog.Warn("failed to convert cluster, skipping it in the inventory export", "namespace", item.GetNamespace(), "name", item.GetName(), "error", err)
+			continue
+		}
+
+		document := ClusterDocument{
+			ID:             c.Namespace + "/" + c.Name,
+			ProjectID:      c.Namespace,
+			Name:           c.Name,
+			Template:       cluster.Template(&c),
+			LifecyclePhase: c.Status.Phase,
+			Labels:         c.Labels,
+			Sites:          []string{},
+			Nodes:          []NodeDocument{},
+			CreatedAt:      c.CreationTimestamp.UTC(),
+			ExportedAt:     exportedAt,
+		}
+		if document.Labels == nil {
+			document.Labels = map[string]string{}
+		}
+		if c.Spec.Topology != nil {
+			document.KubernetesVersion = c.Spec.Topology.Version
+		}
+
+		nodes, err := cluster.Nodes(ctx, e.k8s, &c)
+		if err != nil {
+			slog.Warn("failed to get the nodes of the cluster, exporting it without nodes", "namespace", c.Namespace, "name", c.Name, "error", err)
+		}
+		for _, node := range nodes {
+			nodeDocument := NodeDocument{Metadata: map[string]string{}}
+			if node.Id != nil {
+				nodeDocument.ID = *node.Id
+			}
+			if node.Role != nil {
+				nodeDocument.Role = *node.Role
+			}
+			if node.Status != nil && node.Status.Condition != nil {
+				nodeDocument.Status = string(*node.Status.Condition)
+			}
+			if node.Metadata != nil {
+				nodeDocument.Metadata = *node.Metadata
+			}
+			if site := nodeDocument.Metadata[siteKey]; site != "" && !slices.Contains(document.Sites, site) {
+				document.Sites = append(document.Sites, site)
+			}
+			document.Nodes = append(document.Nodes, nodeDocument)
+		}
+		slices.Sort(document.Sites)
+
+		documents = append(documents, document)
+	}
+
+	return documents, nil
+}
+
+// publish posts the documents as a JSON array, the batch format of OpenSearch ingestion pipelines with an http source
+func (e *Exporter) publish(ctx context.Context, documents []ClusterDocument) error {
+	data, err := json.Marshal(documents)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create inventory export request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to publish cluster inventory: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to publish cluster inventory: %s: %s", resp.Status, body)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/nodemetadata"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+var exportedAt = time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+
+func toUnstructured(t *testing.T, obj any) runtime.Object {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: data}
+}
+
+// managementClient returns a client of a management cluster with the cluster edge-1 of version v1.30.6 and its
+// control plane node on host-1 at site santa-clara
+func managementClient(t *testing.T) *k8s.Client {
+	cluster := toUnstructured(t, &capi.Cluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: capi.GroupVersion.String(), Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: projectID, Name: "edge-1", Labels: map[string]string{"region": "us-west"}},
+		Spec:       capi.ClusterSpec{Topology: &capi.Topology{Class: "baseline-v2.0.0", Version: "v1.30.6"}},
+		Status:     capi.ClusterStatus{Phase: string(capi.ClusterPhaseProvisioned)},
+	})
+	machine := toUnstructured(t, &capi.Machine{
+		TypeMeta: metav1.TypeMeta{APIVersion: capi.GroupVersion.String(), Kind: "Machine"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: projectID, Name: "edge-1-machine", Labels: map[string]string{
+			capi.ClusterNameLabel: "edge-1",
+		}},
+		Spec:   capi.MachineSpec{InfrastructureRef: corev1.ObjectReference{Kind: "IntelMachine", Name: "edge-1-intelmachine"}},
+		Status: capi.MachineStatus{Phase: string(capi.MachinePhaseRunning)},
+	})
+	intelMachine := &unstructured.Unstructured{}
+	intelMachine.SetGroupVersionKind(k8s.IntelMachineResourceSchema.GroupVersion().WithKind("IntelMachine"))
+	intelMachine.SetNamespace(projectID)
+	intelMachine.SetName("edge-1-intelmachine")
+	intelMachine.SetAnnotations(map[string]string{
+		nodemetadata.HostIdAnnotationKey:       "host-1",
+		nodemetadata.AnnotationPrefix + "site": "santa-clara",
+	})
+
+	return k8s.New(fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		core.ClusterResourceSchema: "ClusterList",
+		core.MachineResourceSchema: "MachineList",
+	}, cluster, machine, intelMachine))
+}
+
+func TestCollect(t *testing.T) {
+	exporter := NewExporter(managementClient(t), "http://localhost", WithClock(func() time.Time { return exportedAt }))
+
+	documents, err := exporter.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+
+	document := documents[0]
+	assert.Equal(t, projectID+"/edge-1", document.ID)
+	assert.Equal(t, projectID, document.ProjectID)
+	assert.Equal(t, "edge-1", document.Name)
+	assert.Equal(t, "baseline-v2.0.0", document.Template)
+	assert.Equal(t, "v1.30.6", document.KubernetesVersion)
+	assert.Equal(t, "Provisioned", document.LifecyclePhase)
+	assert.Equal(t, map[string]string{"region": "us-west"}, document.Labels)
+	assert.Equal(t, []string{"santa-clara"}, document.Sites)
+	assert.Equal(t, []NodeDocument{{ID: "host-1", Role: "all", Status: "STATUS_CONDITION_READY", Metadata: map[string]string{"site": "santa-clara"}}}, document.Nodes)
+	assert.Equal(t, exportedAt, document.ExportedAt)
+}
+
+func TestExport(t *testing.T) {
+	t.Run("publishes the documents as a json array", func(t *testing.T) {
+		var documents []ClusterDocument
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/cluster-inventory", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&documents))
+		}))
+		defer server.Close()
+
+		exporter := NewExporter(managementClient(t), server.URL+"/cluster-inventory", WithClock(func() time.Time { return exportedAt }))
+		require.NoError(t, exporter.Export(context.Background()))
+		require.Len(t, documents, 1)
+		assert.Equal(t, "v1.30.6", documents[0].KubernetesVersion)
+		assert.Equal(t, []string{"santa-clara"}, documents[0].Sites)
+	})
+
+	t.Run("fails if the endpoint rejects the documents", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "pipeline is full", http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		err := NewExporter(managementClient(t), server.URL).Export(context.Background())
+		require.ErrorContains(t, err, "429 Too Many Requests: pipeline is full")
+	})
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package signature verifies the cosign signatures of cluster templates with trusted public keys. The signatures are
+// key-based blob signatures as created by `cosign sign-blob --key`: an ASN.1 ECDSA signature or an RSA PKCS #1 v1.5
+// signature of the SHA-256 digest of the payload, or an Ed25519 signature of the payload itself. Keyless signatures
+// with Fulcio certificates and Rekor transparency log entries are not supported.
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var (
+	// ErrUnsigned is returned when a payload has no signature
+	ErrUnsigned = errors.New("template is not signed")
+	// ErrInvalid is returned when a signature is not a signature of the payload by any of the trusted keys
+	ErrInvalid = errors.New("template signature is not valid for any trusted key")
+)
+
+// TrustedKeys are the public keys signatures are verified with, see ParseTrustedKeys
+type TrustedKeys []crypto.PublicKey
+
+// LoadTrustedKeys reads the trusted keys from the given PEM file, e.g. a mounted ConfigMap
+func LoadTrustedKeys(path string) (TrustedKeys, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trusted keys: %w", err)
+	}
+	return ParseTrustedKeys(data)
+}
+
+// ParseTrustedKeys parses the PEM encoded PKIX public keys, e.g. the cosign.pub files of `cosign generate-key-pair`
+func ParseTrustedKeys(data []byte) (TrustedKeys, error) {
+	var keys TrustedKeys
+	for i := 1; ; i++ {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("invalid trusted key %d: expected a PUBLIC KEY block but got %s", i, block.Type)
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key %d: %w", i, err)
+		}
+		switch key.(type) {
+		case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		default:
+			return nil, fmt.Errorf("invalid trusted key %d: unsupported key type %T", i, key)
+		}
+		keys = append(keys, key)
+	}
+	if strings.TrimSpace(string(data)) != "" {
+		return nil, errors.New("invalid trusted keys: expected PEM encoded public keys")
+	}
+	return keys, nil
+}
+
+// Verify checks the base64 encoded signature is a signature of the payload by one of the trusted keys
+func (k TrustedKeys) Verify(payload []byte, signature string) error {
+	if signature == "" {
+		return ErrUnsigned
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return fmt.Errorf("%w: signature is not base64 encoded", ErrInvalid)
+	}
+
+	digest := sha256.Sum256(payload)
+	for _, key := range k {
+		var valid bool
+		switch key := key.(type) {
+		case *ecdsa.PublicKey:
+			valid = ecdsa.VerifyASN1(key, digest[:], sig)
+		case ed25519.PublicKey:
+			valid = ed25519.Verify(key, payload, sig)
+		case *rsa.PublicKey:
+			valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil
+		}
+		if valid {
+			return nil
+		}
+	}
+	return ErrInvalid
+}
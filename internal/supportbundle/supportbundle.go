@@ -0,0 +1,352 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package supportbundle gathers everything needed to troubleshoot a single cluster into a tarball that operators can
+// attach to support tickets: the Cluster API objects of the cluster, their events, the recent operations on the
+// cluster and the cluster-manager logs relevant to it
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+)
+
+// LogSource provides the recent cluster-manager log records
+type LogSource interface {
+	Entries() []logger.Entry
+}
+
+// OperationSource provides the recent operations on a cluster
+type OperationSource interface {
+	List(ctx context.Context, namespace, cluster string) ([]operations.Operation, error)
+}
+
+// Manifest describes the content of a support bundle
+type Manifest struct {
+	ProjectID      string    `json:"projectId"`
+	Cluster        string    `json:"cluster"`
+	CreatedAt      time.Time `json:"createdAt"`
+	Objects        []string  `json:"objects"`
+	Events         int       `json:"events"`
+	Operations     int       `json:"operations"`
+	LogEntries     int       `json:"logEntries"`
+	CorrelationIDs []string  `json:"correlationIds"`
+	// Errors are the parts of the bundle that could not be gathered
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Collector gathers the support bundles of clusters
+type Collector struct {
+	k8s        *k8s.Client
+	logs       LogSource
+	operations OperationSource
+	now        func() time.Time
+}
+
+// NewCollector creates a new Collector reading the cluster objects with the given client
+func NewCollector(k8sClient *k8s.Client, options ...func(*Collector)) *Collector {
+	c := &Collector{
+		k8s: k8sClient,
+		now: time.Now,
+	}
+
+	for _, o := range options {
+		o(c)
+	}
+
+	return c
+}
+
+// WithLogSource is a functional option for including the logs relevant to the cluster in the bundles
+func WithLogSource(logs LogSource) func(*Collector) {
+	return func(c *Collector) {
+		c.logs = logs
+	}
+}
+
+// WithOperations is a functional option for including the recent operations on the cluster in the bundles
+func WithOperations(ops OperationSource) func(*Collector) {
+	return func(c *Collector) {
+		c.operations = ops
+	}
+}
+
+// WithClock is a functional option for configuring a Collector with the given clock
+func WithClock(now func() time.Time) func(*Collector) {
+	return func(c *Collector) {
+		c.now = now
+	}
+}
+
+// Collect writes the gzipped support bundle of the cluster in the project to w; it returns k8s.ErrClusterNotFound if
+// the cluster does not exist. Parts of the bundle that can not be gathered are listed in the errors of its manifest
+// rather than failing the bundle, since support bundles are most needed when something is broken
+func (c *Collector) Collect(ctx context.Context, projectID, clusterName string, w io.Writer) (Manifest, error) {
+	cluster, err := c.k8s.Dyn.Resource(core.ClusterResourceSchema).Namespace(projectID).Get(ctx, clusterName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return Manifest{}, k8s.ErrClusterNotFound
+	}
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	manifest := Manifest{
+		ProjectID:      projectID,
+		Cluster:        clusterName,
+		CreatedAt:      c.now().UTC(),
+		Objects:        []string{},
+		CorrelationIDs: []string{},
+	}
+	failed := func(format string, args ...any) {
+		message := fmt.Sprintf(format, args...)
+		slog.WarnContext(ctx, "incomplete support bundle", "namespace", projectID, "cluster", clusterName, "error", message)
+		manifest.Errors = append(manifest.Errors, message)
+	}
+
+	objects := c.objects(ctx, projectID, cluster, failed)
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	write := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), ModTime: manifest.CreatedAt}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	for _, obj := range objects {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return manifest, err
+		}
+		if err := write(fmt.Sprintf("objects/%s/%s.yaml", strings.ToLower(obj.GetKind()), obj.GetName()), data); err != nil {
+			return manifest, fmt.Errorf("failed to write object to support bundle: %w", err)
+		}
+		manifest.Objects = append(manifest.Objects, obj.GetKind()+"/"+obj.GetName())
+	}
+
+	events, err := c.events(ctx, projectID, objects)
+	if err != nil {
+		failed("failed to list events: %v", err)
+	}
+	data, err := yaml.Marshal(events)
+	if err != nil {
+		return manifest, err
+	}
+	if err := write("events.yaml", data); err != nil {
+		return manifest, fmt.Errorf("failed to write events to support bundle: %w", err)
+	}
+	manifest.Events = len(events)
+
+	if c.operations != nil {
+		ops, err := c.operations.List(ctx, projectID, clusterName)
+		if err != nil {
+			failed("failed to list operations: %v", err)
+		}
+		data, err := json.MarshalIndent(ops, "", "  ")
+		if err != nil {
+			return manifest, err
+		}
+		if err := write("operations.json", data); err != nil {
+			return manifest, fmt.Errorf("failed to write operations to support bundle: %w", err)
+		}
+		manifest.Operations = len(ops)
+	}
+
+	if c.logs != nil {
+		entries, correlationIDs := relevantLogs(c.logs.Entries(), projectID, clusterName)
+		var logs strings.Builder
+		for _, entry := range entries {
+			data, err := json.Marshal(entry)
+			if err != nil {
+				return manifest, err
+			}
+			logs.Write(data)
+			logs.WriteByte('\n')
+		}
+		if err := write("logs.jsonl", []byte(logs.String())); err != nil {
+			return manifest, fmt.Errorf("failed to write logs to support bundle: %w", err)
+		}
+		manifest.LogEntries = len(entries)
+		manifest.CorrelationIDs = correlationIDs
+	}
+
+	data, err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+	if err := write("manifest.json", data); err != nil {
+		return manifest, fmt.Errorf("failed to write manifest to support bundle: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return manifest, err
+	}
+	if err := gz.Close(); err != nil {
+		return manifest, err
+	}
+
+	slog.InfoContext(ctx, "collected support bundle", "namespace", projectID, "cluster", clusterName, "objects", len(manifest.Objects), "log_entries", manifest.LogEntries)
+	return manifest, nil
+}
+
+// objects returns the cluster, its machines and the provider objects they reference
+func (c *Collector) objects(ctx context.Context, namespace string, cluster *unstructured.Unstructured, failed func(string, ...any)) []unstructured.Unstructured {
+	objects := []unstructured.Unstructured{*cluster}
+	objects = append(objects, c.references(ctx, namespace, cluster, failed,
+		[]string{"spec", "controlPlaneRef"}, []string{"spec", "infrastructureRef"})...)
+
+	machines, err := c.k8s.Dyn.Resource(core.MachineResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("cluster.x-k8s.io/cluster-name=%s", cluster.GetName()),
+	})
+	if err != nil {
+		failed("failed to list machines: %v", err)
+		return objects
+	}
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.GetKind() == "" {
+			machine.SetKind("Machine")
+		}
+		objects = append(objects, *machine)
+		objects = append(objects, c.references(ctx, namespace, machine, failed,
+			[]string{"spec", "infrastructureRef"}, []string{"spec", "bootstrap", "configRef"})...)
+	}
+	return objects
+}
+
+// references returns the objects referenced by the given fields of the object
+func (c *Collector) references(ctx context.Context, namespace string, obj *unstructured.Unstructured, failed func(string, ...any), fields ...[]string) []unstructured.Unstructured {
+	var referenced []unstructured.Unstructured
+	for _, field := range fields {
+		ref, found, err := unstructured.NestedStringMap(obj.Object, field...)
+		if err != nil || !found || ref["kind"] == "" || ref["name"] == "" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref["apiVersion"])
+		if err != nil {
+			failed("invalid reference %s of %s %s: %v", strings.Join(field, "."), obj.GetKind(), obj.GetName(), err)
+			continue
+		}
+
+		// the Cluster API provider resources are named after their kinds
+		resource := gv.WithResource(strings.ToLower(ref["kind"]) + "s")
+		referencedObj, err := c.k8s.Dyn.Resource(resource).Namespace(namespace).Get(ctx, ref["name"], metav1.GetOptions{})
+		if err != nil {
+			failed("failed to get %s %s: %v", ref["kind"], ref["name"], err)
+			continue
+		}
+		referenced = append(referenced, *referencedObj)
+	}
+	return referenced
+}
+
+// events returns the events of the given objects, oldest first
+func (c *Collector) events(ctx context.Context, namespace string, objects []unstructured.Unstructured) ([]map[string]any, error) {
+	events := []map[string]any{}
+
+	list, err := c.k8s.Dyn.Resource(core.EventResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return events, err
+	}
+
+	involved := map[string]bool{}
+	for _, obj := range objects {
+		involved[obj.GetKind()+"/"+obj.GetName()] = true
+	}
+	var matched []unstructured.Unstructured
+	for _, event := range list.Items {
+		kind, _, _ := unstructured.NestedString(event.Object, "involvedObject", "kind")
+		name, _, _ := unstructured.NestedString(event.Object, "involvedObject", "name")
+		if involved[kind+"/"+name] {
+			matched = append(matched, event)
+		}
+	}
+
+	slices.SortStableFunc(matched, func(a, b unstructured.Unstructured) int {
+		return strings.Compare(eventTime(a), eventTime(b))
+	})
+	for _, event := range matched {
+		unstructured.RemoveNestedField(event.Object, "metadata", "managedFields")
+		events = append(events, event.Object)
+	}
+	return events, nil
+}
+
+// eventTime returns the time the event last occurred in RFC 3339, which sorts chronologically
+func eventTime(event unstructured.Unstructured) string {
+	for _, field := range []string{"lastTimestamp", "eventTime", "firstTimestamp"} {
+		if value, found, _ := unstructured.NestedString(event.Object, field); found && value != "" {
+			return value
+		}
+	}
+	return event.GetCreationTimestamp().UTC().Format(time.RFC3339)
+}
+
+// clusterAttrs are the log attributes the cluster-manager names clusters with
+var clusterAttrs = []string{"cluster", "cluster_name", "clusterName", "name"}
+
+// projectAttrs are the log attributes the cluster-manager names projects with
+var projectAttrs = []string{"namespace", "activeprojectid", "project_id"}
+
+// relevantLogs returns the log entries of the cluster, i.e. the entries that name the cluster or the requests to it and
+// the entries that share a correlation ID with those, and the correlation IDs of the cluster
+func relevantLogs(entries []logger.Entry, projectID, clusterName string) ([]logger.Entry, []string) {
+	clusterPath := regexp.MustCompile(`/clusters/` + regexp.QuoteMeta(clusterName) + `(/|$)`)
+	quotedName := "'" + clusterName + "'"
+
+	names := func(entry logger.Entry) bool {
+		for _, attr := range projectAttrs {
+			if project := entry.Attrs[attr]; project != "" && project != projectID {
+				return false
+			}
+		}
+		for _, attr := range clusterAttrs {
+			if entry.Attrs[attr] == clusterName {
+				return true
+			}
+		}
+		return clusterPath.MatchString(entry.Attrs["path"]) || strings.Contains(entry.Message, quotedName)
+	}
+
+	correlationIDs := []string{}
+	named := make([]bool, len(entries))
+	for i, entry := range entries {
+		if !names(entry) {
+			continue
+		}
+		named[i] = true
+		if id := entry.Attrs[logger.CorrelationIDKey]; id != "" && !slices.Contains(correlationIDs, id) {
+			correlationIDs = append(correlationIDs, id)
+		}
+	}
+
+	var relevant []logger.Entry
+	for i, entry := range entries {
+		if named[i] || slices.Contains(correlationIDs, entry.Attrs[logger.CorrelationIDKey]) {
+			relevant = append(relevant, entry)
+		}
+	}
+	return relevant, correlationIDs
+}
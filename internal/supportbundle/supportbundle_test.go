@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/logger"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/operations"
+)
+
+const projectID = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+var intelClusterResourceSchema = schema.GroupVersionResource{Group: "infrastructure.cluster.x-k8s.io", Version: "v1alpha1", Resource: "intelclusters"}
+
+type fakeLogSource []logger.Entry
+
+func (s fakeLogSource) Entries() []logger.Entry {
+	return s
+}
+
+type fakeOperations struct{}
+
+func (fakeOperations) List(_ context.Context, _, cluster string) ([]operations.Operation, error) {
+	return []operations.Operation{{ID: "op-1", Type: operations.Create, Cluster: cluster, State: operations.Running}}, nil
+}
+
+func newClient() *k8s.Client {
+	return k8s.New(fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		core.ClusterResourceSchema: "ClusterList",
+		core.MachineResourceSchema: "MachineList",
+		core.EventResourceSchema:   "EventList",
+		intelClusterResourceSchema: "IntelClusterList",
+	}))
+}
+
+func createObject(t *testing.T, client *k8s.Client, resource schema.GroupVersionResource, kind, name string, fields map[string]any) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resource.GroupVersion().String(),
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name, "namespace": projectID},
+	}}
+	for k, v := range fields {
+		obj.Object[k] = v
+	}
+	_, err := client.Dyn.Resource(resource).Namespace(projectID).Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func readBundle(t *testing.T, bundle io.Reader) map[string][]byte {
+	gz, err := gzip.NewReader(bundle)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	files := map[string][]byte{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = data
+	}
+	return files
+}
+
+func TestCollect(t *testing.T) {
+	client := newClient()
+	createObject(t, client, core.ClusterResourceSchema, "Cluster", "cluster-1", map[string]any{
+		"spec": map[string]any{
+			"infrastructureRef": map[string]any{"apiVersion": "infrastructure.cluster.x-k8s.io/v1alpha1", "kind": "IntelCluster", "name": "cluster-1"},
+			"controlPlaneRef":   map[string]any{"apiVersion": "controlplane.cluster.x-k8s.io/v1beta2", "kind": "KThreesControlPlane", "name": "cluster-1"},
+		},
+	})
+	createObject(t, client, intelClusterResourceSchema, "IntelCluster", "cluster-1", nil)
+	createObject(t, client, core.ClusterResourceSchema, "Cluster", "cluster-2", nil)
+	createObject(t, client, core.MachineResourceSchema, "Machine", "cluster-1-machine", map[string]any{
+		"metadata": map[string]any{"name": "cluster-1-machine", "namespace": projectID, "labels": map[string]any{"cluster.x-k8s.io/cluster-name": "cluster-1"}},
+	})
+	createObject(t, client, core.MachineResourceSchema, "Machine", "cluster-2-machine", map[string]any{
+		"metadata": map[string]any{"name": "cluster-2-machine", "namespace": projectID, "labels": map[string]any{"cluster.x-k8s.io/cluster-name": "cluster-2"}},
+	})
+	createObject(t, client, core.EventResourceSchema, "Event", "cluster-1.1", map[string]any{
+		"involvedObject": map[string]any{"kind": "Cluster", "name": "cluster-1"},
+		"lastTimestamp":  "2026-10-16T10:00:00Z",
+		"message":        "Cluster cluster-1 is Provisioned",
+	})
+	createObject(t, client, core.EventResourceSchema, "Event", "cluster-1-machine.1", map[string]any{
+		"involvedObject": map[string]any{"kind": "Machine", "name": "cluster-1-machine"},
+		"lastTimestamp":  "2026-10-16T09:00:00Z",
+	})
+	createObject(t, client, core.EventResourceSchema, "Event", "cluster-2.1", map[string]any{
+		"involvedObject": map[string]any{"kind": "Cluster", "name": "cluster-2"},
+	})
+
+	logs := fakeLogSource{
+		{Message: "received request", Attrs: map[string]string{"path": "/v2/clusters/cluster-1/nodes", "correlation_id": "req-1"}},
+		{Message: "failed to get machines", Attrs: map[string]string{"correlation_id": "req-1"}},
+		{Message: "received request", Attrs: map[string]string{"path": "/v2/clusters/cluster-10", "correlation_id": "req-2"}},
+		{Message: "cluster 'cluster-1' not found", Attrs: map[string]string{"namespace": "other-project"}},
+		{Message: "cluster created", Attrs: map[string]string{"namespace": projectID, "cluster": "cluster-1"}},
+	}
+	createdAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	collector := NewCollector(client, WithLogSource(logs), WithOperations(fakeOperations{}), WithClock(func() time.Time { return createdAt }))
+
+	var bundle bytes.Buffer
+	manifest, err := collector.Collect(context.Background(), projectID, "cluster-1", &bundle)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Cluster/cluster-1", "IntelCluster/cluster-1", "Machine/cluster-1-machine"}, manifest.Objects)
+	require.Equal(t, 2, manifest.Events)
+	require.Equal(t, 1, manifest.Operations)
+	require.Equal(t, 3, manifest.LogEntries)
+	require.Equal(t, []string{"req-1"}, manifest.CorrelationIDs)
+	// the control plane provider is not served by the fake client
+	require.Len(t, manifest.Errors, 1)
+	require.Contains(t, manifest.Errors[0], "KThreesControlPlane cluster-1")
+
+	files := readBundle(t, &bundle)
+	require.Contains(t, files, "objects/cluster/cluster-1.yaml")
+	require.Contains(t, files, "objects/intelcluster/cluster-1.yaml")
+	require.Contains(t, files, "objects/machine/cluster-1-machine.yaml")
+	require.NotContains(t, files, "objects/machine/cluster-2-machine.yaml")
+	require.Contains(t, string(files["events.yaml"]), "is Provisioned")
+	require.Less(t, strings.Index(string(files["events.yaml"]), "cluster-1-machine"), strings.Index(string(files["events.yaml"]), "is Provisioned"))
+	require.Contains(t, string(files["operations.json"]), "op-1")
+	require.Len(t, strings.Split(strings.TrimSpace(string(files["logs.jsonl"])), "\n"), 3)
+
+	var stored Manifest
+	require.NoError(t, json.Unmarshal(files["manifest.json"], &stored))
+	require.Equal(t, createdAt, stored.CreatedAt)
+	require.Equal(t, manifest.Objects, stored.Objects)
+}
+
+func TestCollectMissingCluster(t *testing.T) {
+	_, err := NewCollector(newClient()).Collect(context.Background(), projectID, "cluster-1", io.Discard)
+	require.True(t, errors.Is(err, k8s.ErrClusterNotFound))
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package supportmatrix provides the Kubernetes versions supported by the control plane provider releases, see
+// matrix.yaml for the matrix embedded in the binaries
+package supportmatrix
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/yaml"
+)
+
+// ErrUnsupportedVersion is returned when a Kubernetes version is outside the support windows of a provider
+var ErrUnsupportedVersion = errors.New("unsupported kubernetes version")
+
+//go:embed matrix.yaml
+var matrixYAML []byte
+
+// ProviderSupport is the support window of a control plane provider release, the bounds are Kubernetes minor versions
+type ProviderSupport struct {
+	// Provider is the control plane provider type, kubeadm or k3s
+	Provider string `json:"provider"`
+	// Release is the release of the control plane provider
+	Release string `json:"release"`
+	// MinKubernetesVersion is the oldest supported Kubernetes minor version, e.g. v1.29
+	MinKubernetesVersion string `json:"minKubernetesVersion"`
+	// MaxKubernetesVersion is the most recent supported Kubernetes minor version, e.g. v1.34
+	MaxKubernetesVersion string `json:"maxKubernetesVersion"`
+
+	min, max *version.Version
+}
+
+// Matrix is the support matrix of the control plane providers, see Default and Load
+type Matrix struct {
+	ControlPlaneProviders []ProviderSupport `json:"controlPlaneProviders"`
+}
+
+// Default returns the support matrix embedded in the binary
+var Default = sync.OnceValues(func() (*Matrix, error) {
+	return Parse(matrixYAML)
+})
+
+// Load reads the support matrix from the given YAML or JSON file, e.g. a mounted ConfigMap overriding the default
+func Load(path string) (*Matrix, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read support matrix: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates the support matrix
+func Parse(data []byte) (*Matrix, error) {
+	var matrix Matrix
+	if err := yaml.UnmarshalStrict(data, &matrix); err != nil {
+		return nil, fmt.Errorf("failed to parse support matrix: %w", err)
+	}
+
+	for i := range matrix.ControlPlaneProviders {
+		support := &matrix.ControlPlaneProviders[i]
+		if support.Provider == "" || support.Release == "" {
+			return nil, fmt.Errorf("invalid support matrix entry %d: provider and release are required", i)
+		}
+
+		var err error
+		if support.min, err = version.ParseGeneric(support.MinKubernetesVersion); err != nil {
+			return nil, fmt.Errorf("invalid minimum kubernetes version of %s %s: %w", support.Provider, support.Release, err)
+		}
+		if support.max, err = version.ParseGeneric(support.MaxKubernetesVersion); err != nil {
+			return nil, fmt.Errorf("invalid maximum kubernetes version of %s %s: %w", support.Provider, support.Release, err)
+		}
+		if support.max.LessThan(support.min) {
+			return nil, fmt.Errorf("invalid support window of %s %s: %s is older than %s", support.Provider, support.Release,
+				support.MaxKubernetesVersion, support.MinKubernetesVersion)
+		}
+	}
+	return &matrix, nil
+}
+
+// Check returns ErrUnsupportedVersion if the minor version of the Kubernetes version is not in the support window of
+// any release of the provider; patch versions and build metadata like +k3s1 are ignored
+func (m *Matrix) Check(provider, kubernetesVersion string) error {
+	v, err := version.ParseGeneric(kubernetesVersion)
+	if err != nil {
+		return fmt.Errorf("invalid kubernetes version %q: %w", kubernetesVersion, err)
+	}
+	minor := version.MajorMinor(v.Major(), v.Minor())
+
+	windows := []string{}
+	for _, support := range m.ControlPlaneProviders {
+		if support.Provider != provider {
+			continue
+		}
+		if !minor.LessThan(support.min) && !support.max.LessThan(minor) {
+			return nil
+		}
+		windows = append(windows, fmt.Sprintf("%s to %s (%s)", support.MinKubernetesVersion, support.MaxKubernetesVersion, support.Release))
+	}
+	if len(windows) == 0 {
+		return fmt.Errorf("%w: %s is not supported by the %s control plane provider", ErrUnsupportedVersion, kubernetesVersion, provider)
+	}
+	return fmt.Errorf("%w: %s is outside the support windows of the %s control plane provider: %v", ErrUnsupportedVersion,
+		kubernetesVersion, provider, windows)
+}
+
+// Window returns the oldest and most recent Kubernetes minor versions supported by any release of the provider, false
+// if the matrix has no release of the provider; the windows of the releases may have gaps
+func (m *Matrix) Window(provider string) (minVersion, maxVersion string, ok bool) {
+	var oldest, newest *ProviderSupport
+	for i, support := range m.ControlPlaneProviders {
+		if support.Provider != provider {
+			continue
+		}
+		if oldest == nil || support.min.LessThan(oldest.min) {
+			oldest = &m.ControlPlaneProviders[i]
+		}
+		if newest == nil || newest.max.LessThan(support.max) {
+			newest = &m.ControlPlaneProviders[i]
+		}
+	}
+	if oldest == nil {
+		return "", "", false
+	}
+	return oldest.MinKubernetesVersion, newest.MaxKubernetesVersion, true
+}
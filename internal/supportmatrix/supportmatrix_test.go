@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package supportmatrix
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault(t *testing.T) {
+	matrix, err := Default()
+	require.NoError(t, err)
+	require.NotEmpty(t, matrix.ControlPlaneProviders)
+
+	// the versions of the example templates are supported
+	require.NoError(t, matrix.Check("kubeadm", "v1.30.6"))
+	require.NoError(t, matrix.Check("k3s", "v1.33.5+k3s1"))
+}
+
+func TestCheck(t *testing.T) {
+	matrix, err := Parse([]byte(`
+controlPlaneProviders:
+  - provider: k3s
+    release: v0.3.0
+    minKubernetesVersion: v1.28
+    maxKubernetesVersion: v1.30
+  - provider: k3s
+    release: v0.4.0
+    minKubernetesVersion: v1.30
+    maxKubernetesVersion: v1.32
+`))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name              string
+		provider          string
+		kubernetesVersion string
+		wantErr           string
+	}{
+		{name: "oldest supported minor", provider: "k3s", kubernetesVersion: "v1.28.0+k3s1"},
+		{name: "most recent supported minor", provider: "k3s", kubernetesVersion: "v1.32.9+k3s2"},
+		{name: "too old", provider: "k3s", kubernetesVersion: "v1.27.15+k3s1", wantErr: "outside the support windows"},
+		{name: "too recent", provider: "k3s", kubernetesVersion: "v1.33.0+k3s1", wantErr: "v1.30 to v1.32 (v0.4.0)"},
+		{name: "unknown provider", provider: "kubeadm", kubernetesVersion: "v1.30.6", wantErr: "not supported by the kubeadm control plane provider"},
+		{name: "invalid version", provider: "k3s", kubernetesVersion: "latest", wantErr: "invalid kubernetes version"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := matrix.Check(tc.provider, tc.kubernetesVersion)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	matrix, err := Parse([]byte(`
+controlPlaneProviders:
+  - provider: k3s
+    release: v0.4.0
+    minKubernetesVersion: v1.30
+    maxKubernetesVersion: v1.32
+  - provider: k3s
+    release: v0.3.0
+    minKubernetesVersion: v1.28
+    maxKubernetesVersion: v1.30
+`))
+	require.NoError(t, err)
+
+	minVersion, maxVersion, ok := matrix.Window("k3s")
+	require.True(t, ok)
+	require.Equal(t, "v1.28", minVersion)
+	require.Equal(t, "v1.32", maxVersion)
+
+	_, _, ok = matrix.Window("kubeadm")
+	require.False(t, ok)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse([]byte("controlPlaneProviders:\n  - provider: k3s\n    release: v0.4.0\n    minKubernetesVersion: v1.32\n    maxKubernetesVersion: v1.30\n"))
+	require.ErrorContains(t, err, "invalid support window")
+
+	_, err = Parse([]byte("controlPlaneProviders:\n  - provider: k3s\n    minKubernetesVersion: v1.30\n    maxKubernetesVersion: v1.32\n"))
+	require.ErrorContains(t, err, "provider and release are required")
+
+	_, err = Parse([]byte("providers: []\n"))
+	require.ErrorContains(t, err, "failed to parse support matrix")
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "matrix.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`{"controlPlaneProviders":[{"provider":"kubeadm","release":"v1.12.0","minKubernetesVersion":"v1.31","maxKubernetesVersion":"v1.35"}]}`), 0o600))
+
+	matrix, err := Load(path)
+	require.NoError(t, err)
+	require.NoError(t, matrix.Check("kubeadm", "v1.35.0"))
+	require.ErrorIs(t, matrix.Check("kubeadm", "v1.30.6"), ErrUnsupportedVersion)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "failed to read support matrix")
+}
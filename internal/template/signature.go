@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+// SignaturePayload returns the payload the signature of a template signs: the template as JSON without its signature
+// and read-only fields. Encoding the template again makes the payload independent of the formatting of the imported
+// file, e.g. YAML or JSON with a different key order.
+func SignaturePayload(templateInfo api.TemplateInfo) ([]byte, error) {
+	templateInfo.Signature = nil
+	templateInfo.SignatureVerification = nil
+	templateInfo.LifecycleState = nil
+	templateInfo.ResourceVersion = nil
+	templateInfo.Status = nil
+	return json.Marshal(templateInfo)
+}
+
+// VerifySignature checks the signature of the cluster template is a signature of its signed payload by one of the
+// trusted keys and that the template is the one of the payload. The cluster labels, their propagation policy, the
+// sunset date and the lifecycle state may change after the template is imported and are not compared.
+func VerifySignature(clusterTemplate v1alpha1.ClusterTemplate, keys signature.TrustedKeys) error {
+	payload := clusterTemplate.Annotations[core.SignedPayloadAnnotationKey]
+	if err := keys.Verify([]byte(payload), clusterTemplate.Annotations[core.SignatureAnnotationKey]); err != nil {
+		return err
+	}
+
+	var signed api.TemplateInfo
+	if err := json.Unmarshal([]byte(payload), &signed); err != nil {
+		return fmt.Errorf("%w: invalid signed payload: %v", signature.ErrInvalid, err)
+	}
+	expected, err := FromTemplateInfoToClusterTemplate(signed)
+	if err != nil {
+		return fmt.Errorf("%w: invalid signed payload: %v", signature.ErrInvalid, err)
+	}
+	if expected.Name != clusterTemplate.Name {
+		return fmt.Errorf("%w: the signed payload is the template %s", signature.ErrInvalid, expected.Name)
+	}
+	if !equality.Semantic.DeepEqual(signedSpec(expected.Spec), signedSpec(clusterTemplate.Spec)) {
+		return fmt.Errorf("%w: the template differs from its signed payload", signature.ErrInvalid)
+	}
+	return nil
+}
+
+// signedSpec returns the spec without the fields that may change after the template is imported, with the defaults of
+// the CRD applied so that the spec of the payload compares equal to the spec stored by the API server
+func signedSpec(spec v1alpha1.ClusterTemplateSpec) v1alpha1.ClusterTemplateSpec {
+	spec.LifecycleState = ""
+	spec.ClusterLabels = nil
+	spec.LabelPropagationPolicy = ""
+	spec.SunsetDate = nil
+	if spec.ControlPlaneProviderType == "" {
+		spec.ControlPlaneProviderType = string(api.K3s)
+	}
+	return spec
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+package template
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
+)
+
+func TestVerifySignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	keys, err := signature.ParseTrustedKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	info := api.TemplateInfo{
+		Name:                 "baseline",
+		Version:              "v0.1.0",
+		KubernetesVersion:    "v1.33.5+k3s1",
+		Clusterconfiguration: &map[string]interface{}{"kind": "KThreesControlPlaneTemplate"},
+		Variables: &[]api.TemplateVariable{{
+			Name:    "maxPods",
+			Type:    api.Integer,
+			Patches: &[]api.TemplateVariablePatch{{Target: api.ControlPlane, Path: "/spec/maxPods"}},
+		}},
+	}
+	payload, err := SignaturePayload(info)
+	require.NoError(t, err)
+	digest := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	encoded := base64.StdEncoding.EncodeToString(sig)
+	info.Signature = &encoded
+
+	t.Run("signed template is verified", func(t *testing.T) {
+		clusterTemplate, err := FromTemplateInfoToClusterTemplate(info)
+		require.NoError(t, err)
+		require.Equal(t, string(payload), clusterTemplate.Annotations[core.SignedPayloadAnnotationKey])
+
+		// the API server applies the defaults of the CRD and the template is published and labeled later on
+		clusterTemplate.Spec.ControlPlaneProviderType = "k3s"
+		clusterTemplate.Spec.LifecycleState = "published"
+		clusterTemplate.Spec.ClusterLabels = map[string]string{"tier": "gold"}
+		require.NoError(t, VerifySignature(*clusterTemplate, keys))
+	})
+
+	t.Run("modified template is not verified", func(t *testing.T) {
+		clusterTemplate, err := FromTemplateInfoToClusterTemplate(info)
+		require.NoError(t, err)
+		clusterTemplate.Spec.KubernetesVersion = "v1.34.1+k3s1"
+		require.ErrorIs(t, VerifySignature(*clusterTemplate, keys), signature.ErrInvalid)
+	})
+
+	t.Run("signed payload of another template is not verified", func(t *testing.T) {
+		clusterTemplate, err := FromTemplateInfoToClusterTemplate(info)
+		require.NoError(t, err)
+		clusterTemplate.Name = "baseline-v0.2.0"
+		require.ErrorIs(t, VerifySignature(*clusterTemplate, keys), signature.ErrInvalid)
+	})
+
+	t.Run("unsigned template is not verified", func(t *testing.T) {
+		unsigned := info
+		unsigned.Signature = nil
+		clusterTemplate, err := FromTemplateInfoToClusterTemplate(unsigned)
+		require.NoError(t, err)
+		require.ErrorIs(t, VerifySignature(*clusterTemplate, keys), signature.ErrUnsigned)
+	})
+}
@@ -9,8 +9,11 @@ import (
 	"log/slog"
 	"os"
 	"regexp"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
@@ -34,6 +37,8 @@ func FromTemplateInfoToClusterTemplate(templateInfo api.TemplateInfo) (*v1alpha1
 		},
 		Spec: v1alpha1.ClusterTemplateSpec{
 			KubernetesVersion: templateInfo.KubernetesVersion,
+			// templates imported through the API start as drafts and must be published before use
+			LifecycleState: v1alpha1.TemplateDraft,
 		},
 	}
 
@@ -75,10 +80,103 @@ func FromTemplateInfoToClusterTemplate(templateInfo api.TemplateInfo) (*v1alpha1
 		}
 	}
 
+	if templateInfo.Signature != nil {
+		// the signed payload is kept next to the signature, so that the webhook can verify the signature and that
+		// the template matches the payload
+		payload, err := SignaturePayload(templateInfo)
+		if err != nil {
+			return nil, err
+		}
+		if clusterTemplate.ObjectMeta.Annotations == nil {
+			clusterTemplate.ObjectMeta.Annotations = map[string]string{}
+		}
+		clusterTemplate.ObjectMeta.Annotations[core.SignatureAnnotationKey] = *templateInfo.Signature
+		clusterTemplate.ObjectMeta.Annotations[core.SignedPayloadAnnotationKey] = string(payload)
+	}
+
 	if templateInfo.ClusterLabels != nil {
 		clusterTemplate.Spec.ClusterLabels = *templateInfo.ClusterLabels
 	}
 
+	if templateInfo.LabelPropagationPolicy != nil {
+		clusterTemplate.Spec.LabelPropagationPolicy = v1alpha1.LabelPropagationPolicy(*templateInfo.LabelPropagationPolicy)
+	}
+
+	if templateInfo.AirGapped != nil {
+		clusterTemplate.Spec.AirGapped = *templateInfo.AirGapped
+	}
+
+	if templateInfo.AirGap != nil {
+		clusterTemplate.Spec.AirGap = &v1alpha1.AirGapConfig{}
+		if templateInfo.AirGap.ArtifactURL != nil {
+			clusterTemplate.Spec.AirGap.ArtifactURL = *templateInfo.AirGap.ArtifactURL
+		}
+		if templateInfo.AirGap.ImageTarballs != nil {
+			clusterTemplate.Spec.AirGap.ImageTarballs = *templateInfo.AirGap.ImageTarballs
+		}
+		if templateInfo.AirGap.SystemDefaultRegistry != nil {
+			clusterTemplate.Spec.AirGap.SystemDefaultRegistry = *templateInfo.AirGap.SystemDefaultRegistry
+		}
+		if templateInfo.AirGap.InstallScriptPath != nil {
+			clusterTemplate.Spec.AirGap.InstallScriptPath = *templateInfo.AirGap.InstallScriptPath
+		}
+		if templateInfo.AirGap.ImageRepository != nil {
+			clusterTemplate.Spec.AirGap.ImageRepository = *templateInfo.AirGap.ImageRepository
+		}
+		if templateInfo.AirGap.CoreDNSImageRepository != nil {
+			clusterTemplate.Spec.AirGap.CoreDNSImageRepository = *templateInfo.AirGap.CoreDNSImageRepository
+		}
+		if templateInfo.AirGap.EtcdImageRepository != nil {
+			clusterTemplate.Spec.AirGap.EtcdImageRepository = *templateInfo.AirGap.EtcdImageRepository
+		}
+		if templateInfo.AirGap.RegistryMirrors != nil {
+			for _, mirror := range *templateInfo.AirGap.RegistryMirrors {
+				clusterTemplate.Spec.AirGap.RegistryMirrors = append(clusterTemplate.Spec.AirGap.RegistryMirrors, v1alpha1.RegistryMirror{
+					Registry: mirror.Registry,
+					Endpoint: mirror.Endpoint,
+				})
+			}
+		}
+		if templateInfo.AirGap.Images != nil {
+			clusterTemplate.Spec.AirGap.Images = *templateInfo.AirGap.Images
+		}
+	}
+
+	if templateInfo.SshAccess != nil {
+		clusterTemplate.Spec.SSHAccess = &v1alpha1.SSHAccessConfig{}
+		if templateInfo.SshAccess.User != nil {
+			clusterTemplate.Spec.SSHAccess.User = *templateInfo.SshAccess.User
+		}
+		if templateInfo.SshAccess.AuthorizedKeys != nil {
+			clusterTemplate.Spec.SSHAccess.AuthorizedKeys = *templateInfo.SshAccess.AuthorizedKeys
+		}
+		if templateInfo.SshAccess.TrustedUserCAKeys != nil {
+			clusterTemplate.Spec.SSHAccess.TrustedUserCAKeys = *templateInfo.SshAccess.TrustedUserCAKeys
+		}
+	}
+
+	clusterTemplate.Spec.ReservedResources = FromAPIReservedResources(templateInfo.ReservedResources)
+	clusterTemplate.Spec.MinNodeResources = fromAPIResourceReservation(templateInfo.MinNodeResources)
+
+	if templateInfo.RequireTrustedCompute != nil {
+		clusterTemplate.Spec.RequireTrustedCompute = *templateInfo.RequireTrustedCompute
+	}
+
+	clusterTemplate.Spec.VSphere = fromAPIVSphereConfig(templateInfo.Vsphere)
+	clusterTemplate.Spec.SingleNode = fromAPISingleNodeConfig(templateInfo.SingleNode)
+
+	clusterTemplate.Spec.Remediation, err = fromAPIRemediationConfig(templateInfo.Remediation)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterTemplate.Spec.Variables = fromAPITemplateVariables(templateInfo.Variables)
+
+	if templateInfo.SunsetDate != nil {
+		sunsetDate := v1.NewTime(*templateInfo.SunsetDate)
+		clusterTemplate.Spec.SunsetDate = &sunsetDate
+	}
+
 	return &clusterTemplate, nil
 }
 
@@ -90,11 +188,17 @@ func FromClusterTemplateToTemplateInfo(clusterTemplate v1alpha1.ClusterTemplate)
 		return nil, errors.New("invalid clusterTemplate name format")
 	}
 
+	lifecycleState := api.TemplateInfoLifecycleState(clusterTemplate.LifecycleState())
 	templateInfo := api.TemplateInfo{
 		Name:              name,
 		Version:           version,
 		KubernetesVersion: clusterTemplate.Spec.KubernetesVersion,
+		LifecycleState:    &lifecycleState,
+	}
+	if clusterTemplate.ResourceVersion != "" {
+		templateInfo.ResourceVersion = &clusterTemplate.ResourceVersion
 	}
+	templateInfo.Status = toAPITemplateStatus(clusterTemplate.Status)
 
 	if clusterTemplate.Spec.ClusterConfiguration != "" {
 		var clusterConfiguration map[string]interface{}
@@ -133,6 +237,79 @@ func FromClusterTemplateToTemplateInfo(clusterTemplate v1alpha1.ClusterTemplate)
 		templateInfo.ClusterLabels = &clusterTemplate.Spec.ClusterLabels
 	}
 
+	if clusterTemplate.Spec.LabelPropagationPolicy != "" {
+		policy := api.TemplateInfoLabelPropagationPolicy(clusterTemplate.Spec.LabelPropagationPolicy)
+		templateInfo.LabelPropagationPolicy = &policy
+	}
+
+	if clusterTemplate.Spec.AirGapped {
+		templateInfo.AirGapped = &clusterTemplate.Spec.AirGapped
+	}
+
+	if airGap := clusterTemplate.Spec.AirGap; airGap != nil {
+		templateInfo.AirGap = &api.AirGapConfig{}
+		if airGap.ArtifactURL != "" {
+			templateInfo.AirGap.ArtifactURL = &airGap.ArtifactURL
+		}
+		if airGap.ImageTarballs != nil {
+			templateInfo.AirGap.ImageTarballs = &airGap.ImageTarballs
+		}
+		if airGap.SystemDefaultRegistry != "" {
+			templateInfo.AirGap.SystemDefaultRegistry = &airGap.SystemDefaultRegistry
+		}
+		if airGap.InstallScriptPath != "" {
+			templateInfo.AirGap.InstallScriptPath = &airGap.InstallScriptPath
+		}
+		if airGap.ImageRepository != "" {
+			templateInfo.AirGap.ImageRepository = &airGap.ImageRepository
+		}
+		if airGap.CoreDNSImageRepository != "" {
+			templateInfo.AirGap.CoreDNSImageRepository = &airGap.CoreDNSImageRepository
+		}
+		if airGap.EtcdImageRepository != "" {
+			templateInfo.AirGap.EtcdImageRepository = &airGap.EtcdImageRepository
+		}
+		if airGap.RegistryMirrors != nil {
+			mirrors := make([]api.RegistryMirror, 0, len(airGap.RegistryMirrors))
+			for _, mirror := range airGap.RegistryMirrors {
+				mirrors = append(mirrors, api.RegistryMirror{Registry: mirror.Registry, Endpoint: mirror.Endpoint})
+			}
+			templateInfo.AirGap.RegistryMirrors = &mirrors
+		}
+		if airGap.Images != nil {
+			templateInfo.AirGap.Images = &airGap.Images
+		}
+	}
+
+	if sshAccess := clusterTemplate.Spec.SSHAccess; sshAccess != nil {
+		templateInfo.SshAccess = &api.SSHAccessConfig{}
+		if sshAccess.User != "" {
+			templateInfo.SshAccess.User = &sshAccess.User
+		}
+		if sshAccess.AuthorizedKeys != nil {
+			templateInfo.SshAccess.AuthorizedKeys = &sshAccess.AuthorizedKeys
+		}
+		if sshAccess.TrustedUserCAKeys != nil {
+			templateInfo.SshAccess.TrustedUserCAKeys = &sshAccess.TrustedUserCAKeys
+		}
+	}
+
+	templateInfo.ReservedResources = ToAPIReservedResources(clusterTemplate.Spec.ReservedResources)
+	templateInfo.MinNodeResources = toAPIResourceReservation(clusterTemplate.Spec.MinNodeResources)
+
+	if clusterTemplate.Spec.RequireTrustedCompute {
+		templateInfo.RequireTrustedCompute = &clusterTemplate.Spec.RequireTrustedCompute
+	}
+
+	templateInfo.Vsphere = toAPIVSphereConfig(clusterTemplate.Spec.VSphere)
+	templateInfo.SingleNode = toAPISingleNodeConfig(clusterTemplate.Spec.SingleNode)
+	templateInfo.Remediation = toAPIRemediationConfig(clusterTemplate.Spec.Remediation)
+	templateInfo.Variables = toAPITemplateVariables(clusterTemplate.Spec.Variables)
+
+	if sunsetDate := clusterTemplate.Spec.SunsetDate; sunsetDate != nil {
+		templateInfo.SunsetDate = &sunsetDate.Time
+	}
+
 	return &templateInfo, nil
 }
 
@@ -216,5 +393,342 @@ func readClusterTemplateData(filePath string) (*v1alpha1.ClusterTemplate, error)
 		return nil, fmt.Errorf("failed to construct cluster template from info: %w", err)
 	}
 
+	// default templates are shipped ready to use
+	template.Spec.LifecycleState = v1alpha1.TemplatePublished
+
 	return template, nil
 }
+
+// FromAPIReservedResources converts the reserved resources of the API to the reserved resources of a cluster template
+func FromAPIReservedResources(reserved *api.ReservedResources) *v1alpha1.ReservedResources {
+	if reserved == nil {
+		return nil
+	}
+	return &v1alpha1.ReservedResources{
+		System: fromAPIResourceReservation(reserved.System),
+		Kube:   fromAPIResourceReservation(reserved.Kube),
+	}
+}
+
+// ToAPIReservedResources converts the reserved resources of a cluster template to the reserved resources of the API
+func ToAPIReservedResources(reserved *v1alpha1.ReservedResources) *api.ReservedResources {
+	if reserved == nil {
+		return nil
+	}
+	return &api.ReservedResources{
+		System: toAPIResourceReservation(reserved.System),
+		Kube:   toAPIResourceReservation(reserved.Kube),
+	}
+}
+
+func fromAPIResourceReservation(reservation *api.ResourceReservation) *v1alpha1.ResourceReservation {
+	if reservation == nil {
+		return nil
+	}
+	converted := &v1alpha1.ResourceReservation{}
+	if reservation.Cpu != nil {
+		converted.CPU = *reservation.Cpu
+	}
+	if reservation.Memory != nil {
+		converted.Memory = *reservation.Memory
+	}
+	return converted
+}
+
+func toAPIResourceReservation(reservation *v1alpha1.ResourceReservation) *api.ResourceReservation {
+	if reservation == nil {
+		return nil
+	}
+	converted := &api.ResourceReservation{}
+	if reservation.CPU != "" {
+		converted.Cpu = &reservation.CPU
+	}
+	if reservation.Memory != "" {
+		converted.Memory = &reservation.Memory
+	}
+	return converted
+}
+
+func fromAPIVSphereConfig(config *api.VSphereConfig) *v1alpha1.VSphereConfig {
+	if config == nil {
+		return nil
+	}
+	converted := &v1alpha1.VSphereConfig{
+		Server:     config.Server,
+		Datacenter: config.Datacenter,
+		Network:    config.Network,
+		Template:   config.Template,
+	}
+	if config.Thumbprint != nil {
+		converted.Thumbprint = *config.Thumbprint
+	}
+	if config.CredentialsSecret != nil {
+		converted.CredentialsSecret = *config.CredentialsSecret
+	}
+	if config.Datastore != nil {
+		converted.Datastore = *config.Datastore
+	}
+	if config.Folder != nil {
+		converted.Folder = *config.Folder
+	}
+	if config.ResourcePool != nil {
+		converted.ResourcePool = *config.ResourcePool
+	}
+	return converted
+}
+
+func toAPIVSphereConfig(config *v1alpha1.VSphereConfig) *api.VSphereConfig {
+	if config == nil {
+		return nil
+	}
+	converted := &api.VSphereConfig{
+		Server:     config.Server,
+		Datacenter: config.Datacenter,
+		Network:    config.Network,
+		Template:   config.Template,
+	}
+	if config.Thumbprint != "" {
+		converted.Thumbprint = &config.Thumbprint
+	}
+	if config.CredentialsSecret != "" {
+		converted.CredentialsSecret = &config.CredentialsSecret
+	}
+	if config.Datastore != "" {
+		converted.Datastore = &config.Datastore
+	}
+	if config.Folder != "" {
+		converted.Folder = &config.Folder
+	}
+	if config.ResourcePool != "" {
+		converted.ResourcePool = &config.ResourcePool
+	}
+	return converted
+}
+
+func fromAPISingleNodeConfig(config *api.SingleNodeConfig) *v1alpha1.SingleNodeConfig {
+	if config == nil {
+		return nil
+	}
+	converted := &v1alpha1.SingleNodeConfig{}
+	if config.EtcdSnapshotDir != nil {
+		converted.EtcdSnapshotDir = *config.EtcdSnapshotDir
+	}
+	if config.EtcdSnapshotSchedule != nil {
+		converted.EtcdSnapshotSchedule = *config.EtcdSnapshotSchedule
+	}
+	if config.EtcdSnapshotRetention != nil {
+		converted.EtcdSnapshotRetention = *config.EtcdSnapshotRetention
+	}
+	if config.RejoinPolicy != nil {
+		converted.RejoinPolicy = v1alpha1.RejoinPolicy(*config.RejoinPolicy)
+	}
+	return converted
+}
+
+func toAPISingleNodeConfig(config *v1alpha1.SingleNodeConfig) *api.SingleNodeConfig {
+	if config == nil {
+		return nil
+	}
+	converted := &api.SingleNodeConfig{}
+	if config.EtcdSnapshotDir != "" {
+		converted.EtcdSnapshotDir = &config.EtcdSnapshotDir
+	}
+	if config.EtcdSnapshotSchedule != "" {
+		converted.EtcdSnapshotSchedule = &config.EtcdSnapshotSchedule
+	}
+	if config.EtcdSnapshotRetention != 0 {
+		converted.EtcdSnapshotRetention = &config.EtcdSnapshotRetention
+	}
+	if config.RejoinPolicy != "" {
+		rejoinPolicy := api.SingleNodeConfigRejoinPolicy(config.RejoinPolicy)
+		converted.RejoinPolicy = &rejoinPolicy
+	}
+	return converted
+}
+
+func fromAPIRemediationConfig(config *api.RemediationConfig) (*v1alpha1.RemediationConfig, error) {
+	if config == nil {
+		return nil, nil
+	}
+	converted := &v1alpha1.RemediationConfig{}
+	if config.NodeStartupTimeout != nil {
+		timeout, err := time.ParseDuration(*config.NodeStartupTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node startup timeout: %w", err)
+		}
+		converted.NodeStartupTimeout = &v1.Duration{Duration: timeout}
+	}
+	if config.UnhealthyConditions != nil {
+		for _, condition := range *config.UnhealthyConditions {
+			timeout, err := time.ParseDuration(condition.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timeout of unhealthy condition %s: %w", condition.Type, err)
+			}
+			converted.UnhealthyConditions = append(converted.UnhealthyConditions, v1alpha1.UnhealthyCondition{
+				Type:    corev1.NodeConditionType(condition.Type),
+				Status:  corev1.ConditionStatus(condition.Status),
+				Timeout: v1.Duration{Duration: timeout},
+			})
+		}
+	}
+	if config.MaxUnhealthy != nil {
+		maxUnhealthy := intstr.Parse(*config.MaxUnhealthy)
+		converted.MaxUnhealthy = &maxUnhealthy
+	}
+	return converted, nil
+}
+
+func toAPIRemediationConfig(config *v1alpha1.RemediationConfig) *api.RemediationConfig {
+	if config == nil {
+		return nil
+	}
+	converted := &api.RemediationConfig{}
+	if config.NodeStartupTimeout != nil {
+		timeout := config.NodeStartupTimeout.Duration.String()
+		converted.NodeStartupTimeout = &timeout
+	}
+	if len(config.UnhealthyConditions) > 0 {
+		conditions := make([]api.UnhealthyCondition, 0, len(config.UnhealthyConditions))
+		for _, condition := range config.UnhealthyConditions {
+			conditions = append(conditions, api.UnhealthyCondition{
+				Type:    string(condition.Type),
+				Status:  api.UnhealthyConditionStatus(condition.Status),
+				Timeout: condition.Timeout.Duration.String(),
+			})
+		}
+		converted.UnhealthyConditions = &conditions
+	}
+	if config.MaxUnhealthy != nil {
+		maxUnhealthy := config.MaxUnhealthy.String()
+		converted.MaxUnhealthy = &maxUnhealthy
+	}
+	return converted
+}
+
+func fromAPITemplateVariables(variables *[]api.TemplateVariable) []v1alpha1.TemplateVariable {
+	if variables == nil {
+		return nil
+	}
+	converted := make([]v1alpha1.TemplateVariable, 0, len(*variables))
+	for _, variable := range *variables {
+		templateVariable := v1alpha1.TemplateVariable{
+			Name:      variable.Name,
+			Type:      v1alpha1.TemplateVariableType(variable.Type),
+			Default:   variable.Default,
+			Minimum:   variable.Minimum,
+			Maximum:   variable.Maximum,
+			MinLength: variable.MinLength,
+			MaxLength: variable.MaxLength,
+		}
+		if variable.Description != nil {
+			templateVariable.Description = *variable.Description
+		}
+		if variable.Required != nil {
+			templateVariable.Required = *variable.Required
+		}
+		if variable.Enum != nil {
+			templateVariable.Enum = *variable.Enum
+		}
+		if variable.Pattern != nil {
+			templateVariable.Pattern = *variable.Pattern
+		}
+		if variable.Patches != nil {
+			for _, patch := range *variable.Patches {
+				templateVariablePatch := v1alpha1.TemplateVariablePatch{
+					Target: string(patch.Target),
+					Op:     "add",
+					Path:   patch.Path,
+				}
+				if patch.Op != nil {
+					templateVariablePatch.Op = string(*patch.Op)
+				}
+				if patch.ValueTemplate != nil {
+					templateVariablePatch.ValueTemplate = *patch.ValueTemplate
+				}
+				templateVariable.Patches = append(templateVariable.Patches, templateVariablePatch)
+			}
+		}
+		converted = append(converted, templateVariable)
+	}
+	return converted
+}
+
+func toAPITemplateVariables(variables []v1alpha1.TemplateVariable) *[]api.TemplateVariable {
+	if len(variables) == 0 {
+		return nil
+	}
+	converted := make([]api.TemplateVariable, 0, len(variables))
+	for _, variable := range variables {
+		apiVariable := api.TemplateVariable{
+			Name:      variable.Name,
+			Type:      api.TemplateVariableType(variable.Type),
+			Required:  &variable.Required,
+			Default:   variable.Default,
+			Minimum:   variable.Minimum,
+			Maximum:   variable.Maximum,
+			MinLength: variable.MinLength,
+			MaxLength: variable.MaxLength,
+		}
+		if variable.Description != "" {
+			apiVariable.Description = &variable.Description
+		}
+		if len(variable.Enum) > 0 {
+			apiVariable.Enum = &variable.Enum
+		}
+		if variable.Pattern != "" {
+			apiVariable.Pattern = &variable.Pattern
+		}
+		if len(variable.Patches) > 0 {
+			patches := make([]api.TemplateVariablePatch, 0, len(variable.Patches))
+			for _, patch := range variable.Patches {
+				op := api.TemplateVariablePatchOp(patch.Op)
+				apiPatch := api.TemplateVariablePatch{
+					Target: api.TemplateVariablePatchTarget(patch.Target),
+					Op:     &op,
+					Path:   patch.Path,
+				}
+				if patch.ValueTemplate != "" {
+					apiPatch.ValueTemplate = &patch.ValueTemplate
+				}
+				patches = append(patches, apiPatch)
+			}
+			apiVariable.Patches = &patches
+		}
+		converted = append(converted, apiVariable)
+	}
+	return &converted
+}
+
+// toAPITemplateStatus returns the readiness and the conditions of the template, nil if the template controller did not
+// reconcile it yet
+func toAPITemplateStatus(status v1alpha1.ClusterTemplateStatus) *api.TemplateStatus {
+	if len(status.Conditions) == 0 {
+		return nil
+	}
+	converted := &api.TemplateStatus{
+		Ready:      status.Ready,
+		Conditions: make([]api.TemplateCondition, 0, len(status.Conditions)),
+	}
+	for _, condition := range status.Conditions {
+		apiCondition := api.TemplateCondition{
+			Type:   string(condition.Type),
+			Status: api.TemplateConditionStatus(condition.Status),
+		}
+		if condition.Severity != "" {
+			severity := api.TemplateConditionSeverity(condition.Severity)
+			apiCondition.Severity = &severity
+		}
+		if condition.Reason != "" {
+			apiCondition.Reason = &condition.Reason
+		}
+		if condition.Message != "" {
+			apiCondition.Message = &condition.Message
+		}
+		if !condition.LastTransitionTime.IsZero() {
+			lastTransitionTime := condition.LastTransitionTime.Time
+			apiCondition.LastTransitionTime = &lastTransitionTime
+		}
+		converted.Conditions = append(converted.Conditions, apiCondition)
+	}
+	return converted
+}
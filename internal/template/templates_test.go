@@ -8,9 +8,13 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 
 	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
@@ -21,6 +25,7 @@ func TestFromTemplateInfoToClusterTemplate(t *testing.T) {
 	controlPlaneProviderType := "providerType1"
 	infraProviderType := "providerType2"
 	clusterLabels := map[string]string{"label1": "value1"}
+	labelPropagationPolicy := api.TemplateInfoLabelPropagationPolicyPropagate
 	templateInfo := api.TemplateInfo{
 		Name:                     "test-template",
 		Version:                  "v1",
@@ -29,6 +34,7 @@ func TestFromTemplateInfoToClusterTemplate(t *testing.T) {
 		Infraprovidertype:        (*api.TemplateInfoInfraprovidertype)(&infraProviderType),
 		KubernetesVersion:        "1.21",
 		ClusterLabels:            &clusterLabels,
+		LabelPropagationPolicy:   &labelPropagationPolicy,
 	}
 
 	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
@@ -40,6 +46,8 @@ func TestFromTemplateInfoToClusterTemplate(t *testing.T) {
 	require.Equal(t, "providerType2", clusterTemplate.Spec.InfraProviderType)
 	require.Equal(t, "1.21", clusterTemplate.Spec.KubernetesVersion)
 	require.Equal(t, clusterLabels, clusterTemplate.Spec.ClusterLabels)
+	require.Equal(t, v1alpha1.LabelPropagationPropagate, clusterTemplate.Spec.LabelPropagationPolicy)
+	require.Equal(t, v1alpha1.TemplateDraft, clusterTemplate.Spec.LifecycleState)
 }
 
 func TestFromTemplateInfoToClusterTemplateWithClusterNetwork(t *testing.T) {
@@ -145,6 +153,36 @@ func TestFromClusterTemplateToTemplateInfo(t *testing.T) {
 	require.Equal(t, "1.21", templateInfo.KubernetesVersion)
 	require.Nil(t, templateInfo.ClusterNetwork)
 	require.Equal(t, clusterLabels, *templateInfo.ClusterLabels)
+	require.Equal(t, api.TemplateInfoLifecycleStatePublished, *templateInfo.LifecycleState)
+	require.Nil(t, templateInfo.Status)
+}
+
+func TestFromClusterTemplateToTemplateInfoWithStatus(t *testing.T) {
+	transition := v1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	clusterTemplate := v1alpha1.ClusterTemplate{
+		ObjectMeta: v1.ObjectMeta{Name: "test-template-v1.0.0"},
+		Spec:       v1alpha1.ClusterTemplateSpec{KubernetesVersion: "1.21"},
+		Status: v1alpha1.ClusterTemplateStatus{
+			Conditions: capiv1beta1.Conditions{
+				{Type: capiv1beta1.ReadyCondition, Status: corev1.ConditionFalse, Severity: capiv1beta1.ConditionSeverityInfo, Reason: "secret missing/token not found", Message: "1 of 8 completed", LastTransitionTime: transition},
+				{Type: v1alpha1.ProvidersValidatedCondition, Status: corev1.ConditionTrue, LastTransitionTime: transition},
+				{Type: v1alpha1.ConfigRenderedCondition, Status: corev1.ConditionFalse, Severity: capiv1beta1.ConditionSeverityInfo, Reason: "secret missing/token not found", LastTransitionTime: transition},
+			},
+		},
+	}
+
+	templateInfo, err := FromClusterTemplateToTemplateInfo(clusterTemplate)
+	require.NoError(t, err)
+	require.NotNil(t, templateInfo.Status)
+	require.False(t, templateInfo.Status.Ready)
+	reason := "secret missing/token not found"
+	message := "1 of 8 completed"
+	info := api.Info
+	require.Equal(t, []api.TemplateCondition{
+		{Type: "Ready", Status: api.TemplateConditionStatusFalse, Severity: &info, Reason: &reason, Message: &message, LastTransitionTime: &transition.Time},
+		{Type: "ProvidersValidated", Status: api.TemplateConditionStatusTrue, LastTransitionTime: &transition.Time},
+		{Type: "ConfigRendered", Status: api.TemplateConditionStatusFalse, Severity: &info, Reason: &reason, LastTransitionTime: &transition.Time},
+	}, templateInfo.Status.Conditions)
 }
 
 func TestFromClusterTemplateToTemplateInfoWithClusterNetwork(t *testing.T) {
@@ -205,6 +243,292 @@ func TestFromClusterTemplateToTemplateInfoWithClusterNetwork(t *testing.T) {
 	})
 }
 
+func TestAirGapRoundTrip(t *testing.T) {
+	registry := "registry.site.local:5000"
+	artifactURL := "https://artifacts.site.local/k3s/v1.30.6+k3s1"
+	templateInfo := api.TemplateInfo{
+		Name:              "airgap",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		AirGap: &api.AirGapConfig{
+			ArtifactURL:           &artifactURL,
+			ImageTarballs:         &[]string{"k3s-airgap-images-amd64.tar.zst"},
+			SystemDefaultRegistry: &registry,
+		},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.Equal(t, &v1alpha1.AirGapConfig{
+		ArtifactURL:           "https://artifacts.site.local/k3s/v1.30.6+k3s1",
+		ImageTarballs:         []string{"k3s-airgap-images-amd64.tar.zst"},
+		SystemDefaultRegistry: "registry.site.local:5000",
+	}, clusterTemplate.Spec.AirGap)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.AirGap, roundTripped.AirGap)
+}
+
+func TestKubeadmAirGapRoundTrip(t *testing.T) {
+	airGapped := true
+	providerType := api.Kubeadm
+	imageRepository := "registry.site.local:5000/k8s"
+	coreDNSImageRepository := "registry.site.local:5000/k8s/coredns"
+	templateInfo := api.TemplateInfo{
+		Name:                     "airgap",
+		Version:                  "v1.0.0",
+		KubernetesVersion:        "v1.30.6",
+		Controlplaneprovidertype: &providerType,
+		AirGapped:                &airGapped,
+		AirGap: &api.AirGapConfig{
+			ImageRepository:        &imageRepository,
+			CoreDNSImageRepository: &coreDNSImageRepository,
+			RegistryMirrors:        &[]api.RegistryMirror{{Registry: "docker.io", Endpoint: "https://registry.site.local:5000"}},
+		},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.True(t, clusterTemplate.Spec.AirGapped)
+	require.Equal(t, &v1alpha1.AirGapConfig{
+		ImageRepository:        "registry.site.local:5000/k8s",
+		CoreDNSImageRepository: "registry.site.local:5000/k8s/coredns",
+		RegistryMirrors:        []v1alpha1.RegistryMirror{{Registry: "docker.io", Endpoint: "https://registry.site.local:5000"}},
+	}, clusterTemplate.Spec.AirGap)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.AirGapped, roundTripped.AirGapped)
+	require.Equal(t, templateInfo.AirGap, roundTripped.AirGap)
+}
+
+func TestSSHAccessRoundTrip(t *testing.T) {
+	user := "ops"
+	templateInfo := api.TemplateInfo{
+		Name:              "ssh",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		SshAccess: &api.SSHAccessConfig{
+			User:              &user,
+			AuthorizedKeys:    &[]string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl ops@example.com"},
+			TrustedUserCAKeys: &[]string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE ssh-ca@example.com"},
+		},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.Equal(t, &v1alpha1.SSHAccessConfig{
+		User:              "ops",
+		AuthorizedKeys:    []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl ops@example.com"},
+		TrustedUserCAKeys: []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE ssh-ca@example.com"},
+	}, clusterTemplate.Spec.SSHAccess)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.SshAccess, roundTripped.SshAccess)
+}
+
+func TestSunsetDateRoundTrip(t *testing.T) {
+	sunsetDate := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	templateInfo := api.TemplateInfo{
+		Name:              "sunset",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		SunsetDate:        &sunsetDate,
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.NotNil(t, clusterTemplate.Spec.SunsetDate)
+	require.True(t, sunsetDate.Equal(clusterTemplate.Spec.SunsetDate.Time))
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.SunsetDate, roundTripped.SunsetDate)
+}
+
+func TestReservedResourcesRoundTrip(t *testing.T) {
+	cpu, memory := "500m", "1Gi"
+	templateInfo := api.TemplateInfo{
+		Name:              "reserved",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		ReservedResources: &api.ReservedResources{
+			System: &api.ResourceReservation{Cpu: &cpu, Memory: &memory},
+			Kube:   &api.ResourceReservation{Memory: &memory},
+		},
+		MinNodeResources: &api.ResourceReservation{Cpu: &cpu},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.Equal(t, &v1alpha1.ReservedResources{
+		System: &v1alpha1.ResourceReservation{CPU: "500m", Memory: "1Gi"},
+		Kube:   &v1alpha1.ResourceReservation{Memory: "1Gi"},
+	}, clusterTemplate.Spec.ReservedResources)
+	require.Equal(t, "cpu=500m,memory=1Gi", clusterTemplate.Spec.ReservedResources.System.KubeletValue())
+	require.Equal(t, &v1alpha1.ResourceReservation{CPU: "500m"}, clusterTemplate.Spec.MinNodeResources)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.ReservedResources, roundTripped.ReservedResources)
+	require.Equal(t, templateInfo.MinNodeResources, roundTripped.MinNodeResources)
+}
+
+func TestVSphereRoundTrip(t *testing.T) {
+	folder := "edge/clusters"
+	infraProvider := api.Vsphere
+	templateInfo := api.TemplateInfo{
+		Name:              "vsphere",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		Infraprovidertype: &infraProvider,
+		Vsphere: &api.VSphereConfig{
+			Server:     "vcenter.site.local",
+			Datacenter: "edge-dc",
+			Folder:     &folder,
+			Network:    "VM Network",
+			Template:   "ubuntu-2204-kube-v1.30.6",
+		},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.Equal(t, "vsphere", clusterTemplate.Spec.InfraProviderType)
+	require.Equal(t, &v1alpha1.VSphereConfig{
+		Server:     "vcenter.site.local",
+		Datacenter: "edge-dc",
+		Folder:     "edge/clusters",
+		Network:    "VM Network",
+		Template:   "ubuntu-2204-kube-v1.30.6",
+	}, clusterTemplate.Spec.VSphere)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.Vsphere, roundTripped.Vsphere)
+}
+
+func TestSingleNodeRoundTrip(t *testing.T) {
+	snapshotDir := "/var/lib/edge-data/etcd-snapshots"
+	retention := int32(3)
+	rejoinPolicy := api.SingleNodeConfigRejoinPolicyRestore
+	templateInfo := api.TemplateInfo{
+		Name:              "single-node",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		SingleNode: &api.SingleNodeConfig{
+			EtcdSnapshotDir:       &snapshotDir,
+			EtcdSnapshotRetention: &retention,
+			RejoinPolicy:          &rejoinPolicy,
+		},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.Equal(t, &v1alpha1.SingleNodeConfig{
+		EtcdSnapshotDir:       "/var/lib/edge-data/etcd-snapshots",
+		EtcdSnapshotRetention: 3,
+		RejoinPolicy:          v1alpha1.RejoinPolicyRestore,
+	}, clusterTemplate.Spec.SingleNode)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.SingleNode, roundTripped.SingleNode)
+}
+
+func TestRemediationRoundTrip(t *testing.T) {
+	nodeStartupTimeout := "20m0s"
+	maxUnhealthy := "40%"
+	templateInfo := api.TemplateInfo{
+		Name:              "remediated",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		Remediation: &api.RemediationConfig{
+			NodeStartupTimeout: &nodeStartupTimeout,
+			UnhealthyConditions: &[]api.UnhealthyCondition{
+				{Type: "Ready", Status: api.UnhealthyConditionStatusFalse, Timeout: "5m0s"},
+				{Type: "DiskPressure", Status: api.UnhealthyConditionStatusTrue, Timeout: "1m30s"},
+			},
+			MaxUnhealthy: &maxUnhealthy,
+		},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	maxUnhealthyPercentage := intstr.FromString("40%")
+	require.Equal(t, &v1alpha1.RemediationConfig{
+		NodeStartupTimeout: &v1.Duration{Duration: 20 * time.Minute},
+		UnhealthyConditions: []v1alpha1.UnhealthyCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Timeout: v1.Duration{Duration: 5 * time.Minute}},
+			{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue, Timeout: v1.Duration{Duration: 90 * time.Second}},
+		},
+		MaxUnhealthy: &maxUnhealthyPercentage,
+	}, clusterTemplate.Spec.Remediation)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.Remediation, roundTripped.Remediation)
+
+	invalid := "5 minutes"
+	templateInfo.Remediation.NodeStartupTimeout = &invalid
+	_, err = FromTemplateInfoToClusterTemplate(templateInfo)
+	require.ErrorContains(t, err, "invalid node startup timeout")
+}
+
+func TestTemplateVariablesRoundTrip(t *testing.T) {
+	required := false
+	defaultValue := "110"
+	minimum, maximum := int64(10), int64(250)
+	op := api.Add
+	valueTemplate := "max-pods={{ .maxPods }}"
+	enumRequired := true
+	templateInfo := api.TemplateInfo{
+		Name:              "parameterized",
+		Version:           "v1.0.0",
+		KubernetesVersion: "v1.30.6+k3s1",
+		Variables: &[]api.TemplateVariable{
+			{
+				Name:     "maxPods",
+				Type:     api.Integer,
+				Required: &required,
+				Default:  &defaultValue,
+				Minimum:  &minimum,
+				Maximum:  &maximum,
+				Patches: &[]api.TemplateVariablePatch{
+					{Target: api.Workers, Op: &op, Path: "/spec/template/spec/agentConfig/kubeletArgs/-", ValueTemplate: &valueTemplate},
+				},
+			},
+			{
+				Name:     "profile",
+				Type:     api.Enum,
+				Required: &enumRequired,
+				Enum:     &[]string{"small", "large"},
+			},
+		},
+	}
+
+	clusterTemplate, err := FromTemplateInfoToClusterTemplate(templateInfo)
+	require.NoError(t, err)
+	require.Equal(t, []v1alpha1.TemplateVariable{
+		{
+			Name:    "maxPods",
+			Type:    v1alpha1.IntegerVariable,
+			Default: &defaultValue,
+			Minimum: &minimum,
+			Maximum: &maximum,
+			Patches: []v1alpha1.TemplateVariablePatch{
+				{Target: "workers", Op: "add", Path: "/spec/template/spec/agentConfig/kubeletArgs/-", ValueTemplate: valueTemplate},
+			},
+		},
+		{Name: "profile", Type: v1alpha1.EnumVariable, Required: true, Enum: []string{"small", "large"}},
+	}, clusterTemplate.Spec.Variables)
+
+	roundTripped, err := FromClusterTemplateToTemplateInfo(*clusterTemplate)
+	require.NoError(t, err)
+	require.Equal(t, templateInfo.Variables, roundTripped.Variables)
+}
+
 func TestFromClusterTemplateToTemplateInfoWithInvalidName(t *testing.T) {
 	clusterTemplate := v1alpha1.ClusterTemplate{
 		ObjectMeta: v1.ObjectMeta{
@@ -317,6 +641,7 @@ func TestReadDefaultTemplates(t *testing.T) {
 			var names []string
 			for _, tmpl := range templates {
 				names = append(names, tmpl.Name)
+				require.Equal(t, v1alpha1.TemplatePublished, tmpl.Spec.LifecycleState)
 			}
 			require.ElementsMatch(t, tt.wantNames, names)
 		})
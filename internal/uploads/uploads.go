@@ -0,0 +1,379 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package uploads
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const (
+	// UploadLabelKey marks the ConfigMaps holding an upload session and its chunks, its value is the session id
+	UploadLabelKey = core.ClusterOrchResourceGroup + "/template-upload"
+	// ChunkLabelKey marks the ConfigMaps holding the chunks of an upload, its value is the index of the chunk
+	ChunkLabelKey = core.ClusterOrchResourceGroup + "/template-upload-chunk"
+
+	sessionDataKey = "session"
+	chunkDataKey   = "chunk"
+)
+
+// MaxSize is the largest template that can be uploaded, the template is stored as a single ClusterTemplate which must
+// stay below the request size limit of etcd of 1.5 MiB; MaxChunkSize is the largest chunk, each chunk is kept in a
+// ConfigMap of its own, which must stay well below the size limit of kubernetes objects
+const (
+	MaxSize      = 1 << 20
+	MaxChunkSize = 512 << 10
+)
+
+// DefaultTTL is how long an upload session is kept after its last chunk was appended
+const DefaultTTL = time.Hour
+
+var (
+	ErrSessionNotFound  = errors.New("upload session not found")
+	ErrSizeExceeded     = errors.New("upload exceeds the maximum template size")
+	ErrOffsetMismatch   = errors.New("chunk offset does not match the received size")
+	ErrTooLarge         = errors.New("chunk exceeds the declared size of the upload")
+	ErrIncomplete       = errors.New("upload is incomplete")
+	ErrChecksumMismatch = errors.New("checksum of the upload does not match")
+)
+
+// Session is an upload of a template in chunks, which are assembled and verified against the declared size and
+// checksum once the upload is complete
+type Session struct {
+	ID        string    `json:"id"`
+	Size      int64     `json:"size"`
+	SHA256    string    `json:"sha256"`
+	Received  int64     `json:"received"`
+	Chunks    int       `json:"chunks"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Store keeps each upload session and each of its chunks as a ConfigMap in the namespace of its project, so uploads
+// can be resumed across restarts of cluster manager and are removed together with the project
+type Store struct {
+	k8s *k8s.Client
+	ttl time.Duration
+	now func() time.Time
+}
+
+// NewStore creates a new Store
+func NewStore(k8sClient *k8s.Client, options ...func(*Store)) *Store {
+	s := &Store{
+		k8s: k8sClient,
+		ttl: DefaultTTL,
+		now: time.Now,
+	}
+
+	for _, o := range options {
+		o(s)
+	}
+
+	return s
+}
+
+// WithTTL is a functional option for configuring how long a Store keeps inactive upload sessions
+func WithTTL(ttl time.Duration) func(*Store) {
+	return func(s *Store) {
+		s.ttl = ttl
+	}
+}
+
+// WithClock is a functional option for configuring a Store with the given clock
+func WithClock(now func() time.Time) func(*Store) {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// Create starts a new upload session of the given size and lowercase hex sha256 checksum; the expired sessions of the
+// namespace are deleted first. Uploads larger than MaxSize are rejected with ErrSizeExceeded.
+func (s *Store) Create(ctx context.Context, namespace string, size int64, checksum string) (Session, error) {
+	if size > MaxSize {
+		return Session{}, fmt.Errorf("%w: %d bytes exceed %d", ErrSizeExceeded, size, MaxSize)
+	}
+	s.deleteExpired(ctx, namespace)
+
+	now := s.now().UTC()
+	session := Session{
+		ID:        uuid.NewString(),
+		Size:      size,
+		SHA256:    checksum,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.ttl),
+	}
+
+	obj, err := sessionConfigMap(namespace, session)
+	if err != nil {
+		return Session{}, err
+	}
+	if _, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+		return Session{}, err
+	}
+	return session, nil
+}
+
+// Get returns the upload session with the given id; an expired session is deleted and not found
+func (s *Store) Get(ctx context.Context, namespace, id string) (Session, error) {
+	session, _, err := s.get(ctx, namespace, id)
+	return session, err
+}
+
+// Append stores the chunk of data at the given offset, which must be the size received so far: a client that lost
+// the response to an append gets the session to learn where to resume
+func (s *Store) Append(ctx context.Context, namespace, id string, offset int64, data []byte) (Session, error) {
+	session, resourceVersion, err := s.get(ctx, namespace, id)
+	if err != nil {
+		return Session{}, err
+	}
+	if offset != session.Received {
+		return Session{}, fmt.Errorf("%w: offset %d, received %d", ErrOffsetMismatch, offset, session.Received)
+	}
+	if session.Received+int64(len(data)) > session.Size {
+		return Session{}, fmt.Errorf("%w: %d bytes received of %d", ErrTooLarge, session.Received+int64(len(data)), session.Size)
+	}
+
+	// a concurrent append of the same chunk creates the chunk first or updates the session first, either fails
+	chunk := chunkConfigMap(namespace, id, session.Chunks, data)
+	_, err = s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Create(ctx, chunk, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return Session{}, fmt.Errorf("%w: chunk %d was appended concurrently", ErrOffsetMismatch, session.Chunks)
+	}
+	if err != nil {
+		return Session{}, err
+	}
+
+	session.Received += int64(len(data))
+	session.Chunks++
+	session.ExpiresAt = s.now().UTC().Add(s.ttl)
+
+	obj, err := sessionConfigMap(namespace, session)
+	if err != nil {
+		return Session{}, err
+	}
+	obj.SetResourceVersion(resourceVersion)
+	_, err = s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Update(ctx, obj, metav1.UpdateOptions{})
+	if err != nil {
+		// the chunk must not block the retry of the append
+		if err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Delete(ctx, chunk.GetName(), metav1.DeleteOptions{}); err != nil {
+			slog.Warn("failed to delete chunk of failed append", "namespace", namespace, "id", id, "chunk", chunk.GetName(), "error", err)
+		}
+	}
+	if k8serrors.IsConflict(err) {
+		return Session{}, fmt.Errorf("%w: the session was modified concurrently", ErrOffsetMismatch)
+	}
+	if err != nil {
+		return Session{}, err
+	}
+	return session, nil
+}
+
+// Assemble returns the uploaded data once all of it was received and it matches the checksum of the session
+func (s *Store) Assemble(ctx context.Context, namespace, id string) ([]byte, error) {
+	session, _, err := s.get(ctx, namespace, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.Received != session.Size {
+		return nil, fmt.Errorf("%w: %d bytes received of %d", ErrIncomplete, session.Received, session.Size)
+	}
+
+	selector := fmt.Sprintf("%s=%s,%s", UploadLabelKey, id, ChunkLabelKey)
+	list, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) != session.Chunks {
+		return nil, fmt.Errorf("%w: %d chunks stored of %d", ErrIncomplete, len(list.Items), session.Chunks)
+	}
+
+	chunks := make([][]byte, session.Chunks)
+	for _, item := range list.Items {
+		index, err := strconv.Atoi(item.GetLabels()[ChunkLabelKey])
+		if err != nil || index < 0 || index >= session.Chunks {
+			return nil, fmt.Errorf("invalid chunk %s of upload %s", item.GetName(), id)
+		}
+		if chunks[index], err = chunkData(item); err != nil {
+			return nil, err
+		}
+	}
+
+	data := make([]byte, 0, session.Size)
+	for _, chunk := range chunks {
+		data = append(data, chunk...)
+	}
+
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != session.SHA256 {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrChecksumMismatch, session.SHA256, hex.EncodeToString(sum[:]))
+	}
+	return data, nil
+}
+
+// Delete removes the upload session with the given id and its chunks
+func (s *Store) Delete(ctx context.Context, namespace, id string) error {
+	if _, _, err := s.get(ctx, namespace, id); err != nil {
+		return err
+	}
+	return s.delete(ctx, namespace, id)
+}
+
+// get returns the upload session with the given id and the resource version of its ConfigMap
+func (s *Store) get(ctx context.Context, namespace, id string) (Session, string, error) {
+	obj, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Get(ctx, sessionConfigMapName(id), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return Session{}, "", ErrSessionNotFound
+	}
+	if err != nil {
+		return Session{}, "", err
+	}
+	if obj.GetLabels()[UploadLabelKey] != id {
+		return Session{}, "", ErrSessionNotFound
+	}
+
+	session, err := sessionOf(*obj)
+	if err != nil {
+		return Session{}, "", err
+	}
+	if s.expired(session) {
+		if err := s.delete(ctx, namespace, id); err != nil {
+			slog.Warn("failed to delete expired upload session", "namespace", namespace, "id", id, "error", err)
+		}
+		return Session{}, "", ErrSessionNotFound
+	}
+	return session, obj.GetResourceVersion(), nil
+}
+
+// delete removes the ConfigMaps of the upload session, the chunks first so that none is left behind when it fails
+func (s *Store) delete(ctx context.Context, namespace, id string) error {
+	selector := fmt.Sprintf("%s=%s,%s", UploadLabelKey, id, ChunkLabelKey)
+	list, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	names = append(names, sessionConfigMapName(id))
+
+	for _, name := range names {
+		err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteExpired removes the expired upload sessions of the namespace, failures are only logged
+func (s *Store) deleteExpired(ctx context.Context, namespace string) {
+	selector := fmt.Sprintf("%s,!%s", UploadLabelKey, ChunkLabelKey)
+	list, err := s.k8s.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		slog.Warn("failed to list upload sessions", "namespace", namespace, "error", err)
+		return
+	}
+
+	for _, item := range list.Items {
+		session, err := sessionOf(item)
+		if err != nil || !s.expired(session) {
+			continue
+		}
+		if err := s.delete(ctx, namespace, session.ID); err != nil {
+			slog.Warn("failed to delete expired upload session", "namespace", namespace, "id", session.ID, "error", err)
+		}
+	}
+}
+
+// expired reports whether no chunk was appended to the session within its time to live
+func (s *Store) expired(session Session) bool {
+	return !s.now().Before(session.ExpiresAt)
+}
+
+func sessionConfigMapName(id string) string {
+	return "template-upload-" + id
+}
+
+func chunkConfigMapName(id string, index int) string {
+	return fmt.Sprintf("template-upload-%s-%d", id, index)
+}
+
+// sessionConfigMap returns the ConfigMap holding the upload session
+func sessionConfigMap(namespace string, session Session) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal upload session: %w", err)
+	}
+
+	obj := newConfigMap(namespace, sessionConfigMapName(session.ID), map[string]string{UploadLabelKey: session.ID})
+	if err := unstructured.SetNestedStringMap(obj.Object, map[string]string{sessionDataKey: string(data)}, "data"); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// sessionOf returns the upload session held by the ConfigMap
+func sessionOf(obj unstructured.Unstructured) (Session, error) {
+	data, _, err := unstructured.NestedString(obj.Object, "data", sessionDataKey)
+	if err != nil {
+		return Session{}, err
+	}
+
+	var session Session
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return Session{}, fmt.Errorf("failed to unmarshal upload session %s: %w", obj.GetName(), err)
+	}
+	return session, nil
+}
+
+// chunkConfigMap returns the ConfigMap holding the chunk of the upload with the given index
+func chunkConfigMap(namespace, id string, index int, data []byte) *unstructured.Unstructured {
+	obj := newConfigMap(namespace, chunkConfigMapName(id, index), map[string]string{
+		UploadLabelKey: id,
+		ChunkLabelKey:  strconv.Itoa(index),
+	})
+	obj.Object["binaryData"] = map[string]interface{}{chunkDataKey: base64.StdEncoding.EncodeToString(data)}
+	return obj
+}
+
+// chunkData returns the data of the chunk held by the ConfigMap
+func chunkData(obj unstructured.Unstructured) ([]byte, error) {
+	encoded, _, err := unstructured.NestedString(obj.Object, "binaryData", chunkDataKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode chunk %s: %w", obj.GetName(), err)
+	}
+	return data, nil
+}
+
+func newConfigMap(namespace, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(labels)
+	return obj
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package uploads
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/k8s"
+)
+
+const namespace = "655a6892-4280-4c37-97b1-31161ac0b99e"
+
+func checksum(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestStore(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	template := `{"name":"baseline","version":"v1.0.0"}`
+
+	t.Run("chunks are assembled once complete", func(t *testing.T) {
+		store := NewStore(k8s.New().WithFakeClient(), WithClock(clock))
+		session, err := store.Create(context.Background(), namespace, int64(len(template)), checksum(template))
+		require.NoError(t, err)
+		require.Equal(t, now.Add(DefaultTTL), session.ExpiresAt)
+
+		session, err = store.Append(context.Background(), namespace, session.ID, 0, []byte(template[:10]))
+		require.NoError(t, err)
+		require.EqualValues(t, 10, session.Received)
+
+		_, err = store.Assemble(context.Background(), namespace, session.ID)
+		require.ErrorIs(t, err, ErrIncomplete)
+
+		// a retried append of the first chunk does not match the received size
+		_, err = store.Append(context.Background(), namespace, session.ID, 0, []byte(template[:10]))
+		require.ErrorIs(t, err, ErrOffsetMismatch)
+
+		_, err = store.Append(context.Background(), namespace, session.ID, 10, []byte(template[10:]+"}"))
+		require.ErrorIs(t, err, ErrTooLarge)
+
+		session, err = store.Append(context.Background(), namespace, session.ID, 10, []byte(template[10:]))
+		require.NoError(t, err)
+		require.Equal(t, 2, session.Chunks)
+
+		stored, err := store.Get(context.Background(), namespace, session.ID)
+		require.NoError(t, err)
+		require.Equal(t, session, stored)
+
+		data, err := store.Assemble(context.Background(), namespace, session.ID)
+		require.NoError(t, err)
+		require.Equal(t, template, string(data))
+
+		require.NoError(t, store.Delete(context.Background(), namespace, session.ID))
+		_, err = store.Get(context.Background(), namespace, session.ID)
+		require.ErrorIs(t, err, ErrSessionNotFound)
+		require.ErrorIs(t, store.Delete(context.Background(), namespace, session.ID), ErrSessionNotFound)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		store := NewStore(k8s.New().WithFakeClient(), WithClock(clock))
+		session, err := store.Create(context.Background(), namespace, int64(len(template)), checksum("other"))
+		require.NoError(t, err)
+		_, err = store.Append(context.Background(), namespace, session.ID, 0, []byte(template))
+		require.NoError(t, err)
+
+		_, err = store.Assemble(context.Background(), namespace, session.ID)
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+
+	t.Run("size exceeds the maximum", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		store := NewStore(client, WithClock(clock))
+		_, err := store.Create(context.Background(), namespace, MaxSize+1, checksum(template))
+		require.ErrorIs(t, err, ErrSizeExceeded)
+
+		// no session was stored for the rejected upload
+		list, err := client.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Empty(t, list.Items)
+	})
+
+	t.Run("inactive sessions expire", func(t *testing.T) {
+		client := k8s.New().WithFakeClient()
+		store := NewStore(client, WithClock(clock), WithTTL(time.Minute))
+		expired, err := store.Create(context.Background(), namespace, int64(len(template)), checksum(template))
+		require.NoError(t, err)
+		_, err = store.Append(context.Background(), namespace, expired.ID, 0, []byte(template[:10]))
+		require.NoError(t, err)
+
+		now = now.Add(time.Minute)
+		_, err = store.Create(context.Background(), namespace, int64(len(template)), checksum(template))
+		require.NoError(t, err)
+
+		// the expired session and its chunk were deleted by the creation of the new session
+		list, err := client.Dyn.Resource(core.ConfigMapResourceSchema).Namespace(namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+
+		_, err = store.Append(context.Background(), namespace, expired.ID, 10, []byte(template[10:]))
+		require.ErrorIs(t, err, ErrSessionNotFound)
+	})
+}
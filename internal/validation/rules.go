@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation rolls out stricter validation rules of the webhook and the REST API on live fleets: a rule in
+// shadow mode logs and counts its violations instead of rejecting the request until its shadow period ends, so that
+// the violations can be fixed before the rule is enforced
+package validation
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+// Mode is how the violations of a validation rule are handled
+type Mode string
+
+const (
+	// Enforce rejects the requests violating the rule
+	Enforce Mode = "enforce"
+	// Shadow logs and counts the violations of the rule but accepts the requests
+	Shadow Mode = "shadow"
+)
+
+// names of the validation rules that can be shadowed
+const (
+	// KubernetesVersion checks the Kubernetes version of templates is supported by their control plane provider
+	KubernetesVersion = "kubernetes-version"
+	// AirGap checks the air-gap settings of templates
+	AirGap = "air-gap"
+	// SSHAccess checks the SSH users and keys of templates
+	SSHAccess = "ssh-access"
+	// ReservedResources checks the resources reserved by templates and clusters
+	ReservedResources = "reserved-resources"
+	// ClusterNetwork checks the pod and service networks of templates do not overlap the reserved infrastructure networks
+	ClusterNetwork = "cluster-network"
+	// TrustedCompute checks trusted compute templates use the Intel infra provider and their clusters attested hosts
+	TrustedCompute = "trusted-compute"
+	// TemplateSignature checks templates are signed by a trusted key if trusted keys are configured
+	TemplateSignature = "template-signature"
+)
+
+var knownRules = []string{KubernetesVersion, AirGap, SSHAccess, ReservedResources, ClusterNetwork, TrustedCompute, TemplateSignature}
+
+// Rules are the modes of the validation rules; rules that are not shadowed are enforced. The zero value and nil
+// enforce all rules.
+type Rules struct {
+	// shadowed are the ends of the shadow periods of the shadowed rules, the zero time shadows a rule until it is
+	// configured otherwise
+	shadowed map[string]time.Time
+	now      func() time.Time
+}
+
+// NewRules creates new Rules shadowing the given rules until the end of their shadow periods
+func NewRules(shadowed map[string]time.Time, options ...func(*Rules)) *Rules {
+	r := &Rules{shadowed: shadowed, now: time.Now}
+
+	for _, o := range options {
+		o(r)
+	}
+
+	return r
+}
+
+// WithClock is a functional option for configuring Rules with the given clock
+func WithClock(now func() time.Time) func(*Rules) {
+	return func(r *Rules) {
+		r.now = now
+	}
+}
+
+// ParseShadowed parses the shadowed rules of the comma separated list of rules, each optionally followed by the end
+// of its shadow period as an RFC 3339 time or date, e.g. "air-gap=2026-12-01,ssh-access"
+func ParseShadowed(value string) (map[string]time.Time, error) {
+	shadowed := map[string]time.Time{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		rule, end, hasEnd := strings.Cut(entry, "=")
+		if !slices.Contains(knownRules, rule) {
+			return nil, fmt.Errorf("unknown validation rule %q: expected one of %v", rule, knownRules)
+		}
+
+		var until time.Time
+		if hasEnd {
+			var err error
+			if until, err = time.Parse(time.RFC3339, end); err != nil {
+				if until, err = time.Parse(time.DateOnly, end); err != nil {
+					return nil, fmt.Errorf("invalid end of the shadow period of validation rule %q: %q", rule, end)
+				}
+			}
+		}
+		shadowed[rule] = until
+	}
+	return shadowed, nil
+}
+
+// Mode returns the current mode of the rule
+func (r *Rules) Mode(rule string) Mode {
+	if r == nil {
+		return Enforce
+	}
+	until, ok := r.shadowed[rule]
+	if !ok || (!until.IsZero() && !r.now().Before(until)) {
+		return Enforce
+	}
+	return Shadow
+}
+
+// Check counts the violation of the rule, if any, and returns it if the rule is enforced; violations of shadowed rules
+// are logged with the given attributes instead
+func (r *Rules) Check(rule string, violation error, attrs ...any) error {
+	if violation == nil {
+		return nil
+	}
+
+	mode := r.Mode(rule)
+	metrics.ValidationViolationCounter.WithLabelValues(rule, string(mode)).Inc()
+	if mode == Enforce {
+		return violation
+	}
+	slog.Warn("validation rule violated in shadow mode, the request is accepted", append([]any{"rule", rule, "violation", violation.Error()}, attrs...)...)
+	return nil
+}
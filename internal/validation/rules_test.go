@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+)
+
+func TestParseShadowed(t *testing.T) {
+	shadowed, err := ParseShadowed("air-gap=2026-12-01, ssh-access,reserved-resources=2026-12-01T12:00:00Z")
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Time{
+		AirGap:            time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+		SSHAccess:         {},
+		ReservedResources: time.Date(2026, 12, 1, 12, 0, 0, 0, time.UTC),
+	}, shadowed)
+
+	shadowed, err = ParseShadowed("")
+	require.NoError(t, err)
+	require.Empty(t, shadowed)
+
+	_, err = ParseShadowed("node-labels")
+	require.ErrorContains(t, err, "unknown validation rule")
+	_, err = ParseShadowed("air-gap=next-week")
+	require.ErrorContains(t, err, "invalid end of the shadow period")
+}
+
+func TestRules(t *testing.T) {
+	now := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	rules := NewRules(map[string]time.Time{AirGap: now.Add(time.Hour), SSHAccess: {}}, WithClock(func() time.Time { return now }))
+	violation := errors.New("invalid")
+
+	require.Equal(t, Shadow, rules.Mode(AirGap))
+	require.Equal(t, Shadow, rules.Mode(SSHAccess))
+	require.Equal(t, Enforce, rules.Mode(ReservedResources))
+	var unset *Rules
+	require.Equal(t, Enforce, unset.Mode(AirGap))
+
+	shadowed := testutil.ToFloat64(metrics.ValidationViolationCounter.WithLabelValues(AirGap, string(Shadow)))
+	require.NoError(t, rules.Check(AirGap, violation))
+	require.NoError(t, rules.Check(AirGap, nil))
+	require.Equal(t, shadowed+1, testutil.ToFloat64(metrics.ValidationViolationCounter.WithLabelValues(AirGap, string(Shadow))))
+	require.ErrorIs(t, rules.Check(ReservedResources, violation), violation)
+	require.ErrorIs(t, unset.Check(AirGap, violation), violation)
+
+	// the rule is enforced once its shadow period ends
+	now = now.Add(time.Hour)
+	require.Equal(t, Enforce, rules.Mode(AirGap))
+	require.ErrorIs(t, rules.Check(AirGap, violation), violation)
+	require.Equal(t, Shadow, rules.Mode(SSHAccess))
+}
@@ -5,21 +5,41 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"path"
 	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kthreescpv1beta2 "github.com/k3s-io/cluster-api-k3s/controlplane/api/v1beta2"
 	"github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
 	clusterv1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/common"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/labels"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/metrics"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	capiprovider "github.com/open-edge-platform/cluster-manager/v2/internal/providers"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
 	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	kubeadmcp "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta1"
@@ -30,11 +50,31 @@ import (
 // set up logging
 var clustertemplatelog = logf.Log.WithName("clustertemplate-resource")
 
+// imageTarballExtensions are the k3s airgap image archive formats the agent imports on startup
+var imageTarballExtensions = []string{".tar", ".tar.gz", ".tgz", ".tar.bz2", ".tar.lz4", ".tar.zst"}
+
+// sshKeyTypes are the public key algorithms sshd accepts in authorized keys and trusted user CA keys
+var sshKeyTypes = []string{
+	"ssh-ed25519", "ssh-rsa", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521",
+	"sk-ssh-ed25519@openssh.com", "sk-ecdsa-sha2-nistp256@openssh.com",
+}
+
+// minNodeStartupTimeout is the shortest node startup timeout a MachineHealthCheck accepts, besides 0s disabling it
+const minNodeStartupTimeout = 30 * time.Second
+
+// sshUserPattern matches the user names useradd accepts by default
+var sshUserPattern = regexp.MustCompile(`^[a-z_][a-z0-9_-]{0,31}$`)
+
 var controlPlaneTemplateTypes = map[string]func() interface{}{
 	string(api.Kubeadm): func() interface{} { return &kubeadmcp.KubeadmControlPlaneTemplate{} },
 	string(api.K3s):     func() interface{} { return &kthreescpv1beta2.KThreesControlPlaneTemplate{} },
 }
 
+func init() {
+	// the violations of the validation rules are served with the metrics of the manager
+	ctrlmetrics.Registry.MustRegister(metrics.ValidationViolationCounter)
+}
+
 // SetupClusterTemplateWebhookWithManager registers the webhook for ClusterTemplate in the manager.
 func (v *ClusterTemplateCustomValidator) SetupClusterTemplateWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&clusterv1alpha1.ClusterTemplate{}).
@@ -44,6 +84,14 @@ func (v *ClusterTemplateCustomValidator) SetupClusterTemplateWebhookWithManager(
 
 type ClusterTemplateCustomValidator struct {
 	client.Client
+	// SupportMatrix are the Kubernetes versions supported by the control plane providers, supportmatrix.Default if nil
+	SupportMatrix *supportmatrix.Matrix
+	// Rules are the modes of the validation rules, all rules are enforced if nil
+	Rules *validation.Rules
+	// ReservedNetworks are the networks of the orchestrator infrastructure the cluster networks must not overlap
+	ReservedNetworks network.Reserved
+	// TrustedKeys are the public keys templates must be signed with, signatures are not verified if empty
+	TrustedKeys signature.TrustedKeys
 }
 
 var _ webhook.CustomValidator = &ClusterTemplateCustomValidator{}
@@ -56,6 +104,11 @@ func (v *ClusterTemplateCustomValidator) ValidateCreate(ctx context.Context, obj
 	}
 	clustertemplatelog.Info("validation for ClusterTemplate upon creation", "name", clustertemplate.GetName())
 
+	return v.validateSpec(clustertemplate)
+}
+
+// validateSpec validates the spec of a created cluster template or of a template whose spec is updated
+func (v *ClusterTemplateCustomValidator) validateSpec(clustertemplate *clusterv1alpha1.ClusterTemplate) (admission.Warnings, error) {
 	providerType := clustertemplate.Spec.ControlPlaneProviderType
 	constructor, ok := controlPlaneTemplateTypes[providerType]
 	if !ok {
@@ -69,7 +122,347 @@ func (v *ClusterTemplateCustomValidator) ValidateCreate(ctx context.Context, obj
 		return nil, fmt.Errorf("failed to convert cluster configuration: %w", err)
 	}
 
-	return nil, nil
+	if err := capiprovider.ValidateSecretReferences(clustertemplate.Spec.ClusterConfiguration); err != nil {
+		slog.Error("invalid secret reference", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	var warnings admission.Warnings
+	name := clustertemplate.GetName()
+	if err := v.check(validation.TemplateSignature, v.verifySignature(clustertemplate), name, &warnings); err != nil {
+		slog.Error("template signature not verified", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := v.check(validation.KubernetesVersion, v.validateKubernetesVersion(providerType, clustertemplate.Spec.KubernetesVersion), name, &warnings); err != nil {
+		slog.Error("unsupported kubernetes version", "providerType", providerType, "error", err)
+		// a bad request is passed on by the API server, so the REST API rejects the import with 400 Bad Request
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := v.check(validation.AirGap, validateAirGap(providerType, clustertemplate.Spec.AirGapped, clustertemplate.Spec.AirGap), name, &warnings); err != nil {
+		slog.Error("invalid air-gap settings", "providerType", providerType, "error", err)
+		return nil, err
+	}
+
+	if err := v.check(validation.SSHAccess, validateSSHAccess(clustertemplate.Spec.SSHAccess), name, &warnings); err != nil {
+		slog.Error("invalid SSH access settings", "providerType", providerType, "error", err)
+		return nil, err
+	}
+
+	if err := v.check(validation.ReservedResources, capiprovider.ValidateReservedResources(clustertemplate.Spec.ReservedResources), name, &warnings); err != nil {
+		slog.Error("invalid reserved resources", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := capiprovider.ValidateMinNodeResources(clustertemplate.Spec.MinNodeResources); err != nil {
+		slog.Error("invalid minimum node resources", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := v.check(validation.ClusterNetwork, v.ReservedNetworks.Check(&clustertemplate.Spec.ClusterNetwork), name, &warnings); err != nil {
+		slog.Error("cluster network overlaps reserved networks", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := v.check(validation.TrustedCompute, validateTrustedCompute(clustertemplate.Spec.InfraProviderType, clustertemplate.Spec.RequireTrustedCompute), name, &warnings); err != nil {
+		slog.Error("invalid trusted compute settings", "infraProviderType", clustertemplate.Spec.InfraProviderType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateVSphere(clustertemplate.Spec.InfraProviderType, clustertemplate.Spec.VSphere); err != nil {
+		slog.Error("invalid vSphere settings", "infraProviderType", clustertemplate.Spec.InfraProviderType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := capiprovider.ValidateSingleNode(providerType, clustertemplate.Spec.SingleNode); err != nil {
+		slog.Error("invalid single-node settings", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateRemediation(clustertemplate.Spec.Remediation); err != nil {
+		slog.Error("invalid remediation settings", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateVariables(providerType, clustertemplate.Spec.InfraProviderType, clustertemplate.Spec.Variables); err != nil {
+		slog.Error("invalid template variables", "providerType", providerType, "error", err)
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	return warnings, nil
+}
+
+// check returns the violation of the rule if the rule is enforced; the violations of shadowed rules are returned to the
+// client as warnings instead
+func (v *ClusterTemplateCustomValidator) check(rule string, violation error, name string, warnings *admission.Warnings) error {
+	if err := v.Rules.Check(rule, violation, "name", name); err != nil || violation == nil {
+		return err
+	}
+	*warnings = append(*warnings, fmt.Sprintf("%v (validation rule %s is not enforced yet)", violation, rule))
+	return nil
+}
+
+// verifySignature checks the template is signed by one of the trusted keys and matches its signed payload, see
+// template.VerifySignature; templates are not verified if no trusted keys are configured
+func (v *ClusterTemplateCustomValidator) verifySignature(clustertemplate *clusterv1alpha1.ClusterTemplate) error {
+	if len(v.TrustedKeys) == 0 {
+		return nil
+	}
+	return template.VerifySignature(*clustertemplate, v.TrustedKeys)
+}
+
+// validateKubernetesVersion checks the Kubernetes version is in the support window of the control plane provider;
+// the version is required by the CRD, so templates without a version are left to the schema validation
+func (v *ClusterTemplateCustomValidator) validateKubernetesVersion(providerType, kubernetesVersion string) error {
+	if kubernetesVersion == "" {
+		return nil
+	}
+
+	matrix := v.SupportMatrix
+	if matrix == nil {
+		var err error
+		if matrix, err = supportmatrix.Default(); err != nil {
+			return fmt.Errorf("failed to get support matrix: %w", err)
+		}
+	}
+	return matrix.Check(providerType, kubernetesVersion)
+}
+
+// validateTrustedCompute checks trusted compute templates are only placed onto the hosts of the Intel infra provider,
+// the other infra providers have no attested hosts; the attestation of the hosts is checked when clusters are created
+func validateTrustedCompute(infraProviderType string, requireTrustedCompute bool) error {
+	if requireTrustedCompute && infraProviderType != string(api.Intel) {
+		return fmt.Errorf("trusted compute templates require the intel infra provider, but the infra provider is '%s'", infraProviderType)
+	}
+	return nil
+}
+
+// validateVSphere checks the templates of the vSphere infra provider place their virtual machines in vCenter, the other
+// infra providers have no virtual machines to place
+func validateVSphere(infraProviderType string, vsphere *clusterv1alpha1.VSphereConfig) error {
+	switch {
+	case infraProviderType == string(api.Vsphere) && vsphere == nil:
+		return fmt.Errorf("templates of the vsphere infra provider require vSphere settings")
+	case infraProviderType != string(api.Vsphere) && vsphere != nil:
+		return fmt.Errorf("vSphere settings require the vsphere infra provider, but the infra provider is '%s'", infraProviderType)
+	}
+	return nil
+}
+
+// validateRemediation checks the remediation settings are accepted by the MachineHealthChecks the topology of the
+// clusters renders them into
+func validateRemediation(remediation *clusterv1alpha1.RemediationConfig) error {
+	if remediation == nil {
+		return nil
+	}
+	if timeout := remediation.NodeStartupTimeout; timeout != nil && timeout.Duration != 0 && timeout.Duration < minNodeStartupTimeout {
+		return fmt.Errorf("the node startup timeout must be 0s or at least %s, but is %s", minNodeStartupTimeout, timeout.Duration)
+	}
+	for _, condition := range remediation.UnhealthyConditions {
+		if condition.Timeout.Duration < 0 {
+			return fmt.Errorf("the timeout of the unhealthy condition %s must not be negative", condition.Type)
+		}
+	}
+	if maxUnhealthy := remediation.MaxUnhealthy; maxUnhealthy != nil {
+		value, err := intstr.GetScaledValueFromIntOrPercent(maxUnhealthy, 100, false)
+		if err != nil {
+			return fmt.Errorf("invalid maximum of unhealthy machines: %w", err)
+		}
+		if value < 0 {
+			return fmt.Errorf("the maximum of unhealthy machines must not be negative, but is %s", maxUnhealthy.String())
+		}
+	}
+	return nil
+}
+
+// validateVariables checks the variables of the template can be declared on its ClusterClass next to the variables of
+// the providers and that their defaults and validations are consistent
+func validateVariables(providerType, infraProviderType string, variables []clusterv1alpha1.TemplateVariable) error {
+	if len(variables) == 0 {
+		return nil
+	}
+	cc := common.GetClusterClass(types.NamespacedName{})
+	if provider := capiprovider.GetCapiProvider(providerType, infraProviderType); provider != nil {
+		provider.AlterClusterClass(&cc)
+	}
+	declared := map[string]bool{}
+	for _, variable := range cc.Spec.Variables {
+		declared[variable.Name] = true
+	}
+
+	for _, variable := range variables {
+		if variable.Name == "" || strings.HasPrefix(variable.Name, "builtin") {
+			return fmt.Errorf("invalid variable name %q", variable.Name)
+		}
+		if declared[variable.Name] {
+			return fmt.Errorf("variable %s is declared twice or is a variable of the providers", variable.Name)
+		}
+		declared[variable.Name] = true
+
+		switch variable.Type {
+		case clusterv1alpha1.EnumVariable:
+			if len(variable.Enum) == 0 {
+				return fmt.Errorf("enum variable %s requires its values", variable.Name)
+			}
+		case clusterv1alpha1.StringVariable, clusterv1alpha1.IntegerVariable, clusterv1alpha1.BooleanVariable:
+			if len(variable.Enum) > 0 {
+				return fmt.Errorf("values are only allowed for enum variables, but variable %s is %s", variable.Name, variable.Type)
+			}
+		default:
+			return fmt.Errorf("variable %s has the unknown type %q", variable.Name, variable.Type)
+		}
+		if variable.Minimum != nil && variable.Maximum != nil && *variable.Minimum > *variable.Maximum {
+			return fmt.Errorf("the minimum of variable %s is greater than its maximum", variable.Name)
+		}
+		if variable.MinLength != nil && variable.MaxLength != nil && *variable.MinLength > *variable.MaxLength {
+			return fmt.Errorf("the minimum length of variable %s is greater than its maximum length", variable.Name)
+		}
+		if _, err := regexp.Compile(variable.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern of variable %s: %w", variable.Name, err)
+		}
+		if variable.Default != nil {
+			value, err := common.ParseVariableValue(variable, *variable.Default)
+			if err != nil {
+				return fmt.Errorf("invalid default: %w", err)
+			}
+			if err := common.ValidateVariableValue(variable, value); err != nil {
+				return fmt.Errorf("invalid default: %w", err)
+			}
+		}
+		for _, patch := range variable.Patches {
+			if patch.Target != "controlPlane" && patch.Target != "workers" {
+				return fmt.Errorf("the patch of variable %s must target controlPlane or workers, not %q", variable.Name, patch.Target)
+			}
+			if !strings.HasPrefix(patch.Path, "/") {
+				return fmt.Errorf("the path of the patch of variable %s must be a JSON pointer, but is %q", variable.Name, patch.Path)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAirGap checks the air-gap settings can be rendered into the k3s or kubeadm configuration of the nodes
+func validateAirGap(providerType string, airGapped bool, airGap *clusterv1alpha1.AirGapConfig) error {
+	if airGap == nil {
+		if airGapped {
+			return fmt.Errorf("air-gapped templates require air-gap settings")
+		}
+		return nil
+	}
+
+	switch providerType {
+	case string(api.K3s):
+		return validateK3sAirGap(airGap)
+	case string(api.Kubeadm):
+		if !airGapped {
+			return fmt.Errorf("air-gap settings of the kubeadm control plane provider require the template to be air-gapped")
+		}
+		return validateKubeadmAirGap(airGap)
+	}
+	return fmt.Errorf("air-gap settings are not supported by the %s control plane provider", providerType)
+}
+
+// validateK3sAirGap checks the air-gap settings of k3s: the artifacts the nodes preload and the system registry
+func validateK3sAirGap(airGap *clusterv1alpha1.AirGapConfig) error {
+	if airGap.ImageRepository != "" || airGap.CoreDNSImageRepository != "" || airGap.EtcdImageRepository != "" || len(airGap.RegistryMirrors) > 0 {
+		return fmt.Errorf("air-gap image repositories and registry mirrors are not supported by the k3s control plane provider")
+	}
+
+	artifactURL, err := url.Parse(airGap.ArtifactURL)
+	if err != nil || (artifactURL.Scheme != "http" && artifactURL.Scheme != "https") || artifactURL.Host == "" {
+		return fmt.Errorf("invalid air-gap artifact URL: %q", airGap.ArtifactURL)
+	}
+
+	for _, tarball := range airGap.ImageTarballs {
+		if !slices.ContainsFunc(imageTarballExtensions, func(ext string) bool { return strings.HasSuffix(tarball, ext) }) {
+			return fmt.Errorf("invalid air-gap image tarball %q: expected one of %v", tarball, imageTarballExtensions)
+		}
+	}
+
+	if registry := airGap.SystemDefaultRegistry; registry != "" && strings.ContainsAny(registry, "/ ") {
+		return fmt.Errorf("invalid air-gap system default registry %q: expected a host name with an optional port", registry)
+	}
+
+	if airGap.InstallScriptPath != "" && !path.IsAbs(airGap.InstallScriptPath) {
+		return fmt.Errorf("invalid air-gap install script path %q: expected an absolute path", airGap.InstallScriptPath)
+	}
+	return nil
+}
+
+// validateKubeadmAirGap checks the air-gap settings of kubeadm: the image repositories and the registry mirrors
+func validateKubeadmAirGap(airGap *clusterv1alpha1.AirGapConfig) error {
+	if airGap.ArtifactURL != "" || len(airGap.ImageTarballs) > 0 || airGap.SystemDefaultRegistry != "" || airGap.InstallScriptPath != "" {
+		return fmt.Errorf("air-gap artifacts and system default registry are not supported by the kubeadm control plane provider")
+	}
+
+	if airGap.ImageRepository == "" {
+		return fmt.Errorf("air-gapped kubeadm templates require an image repository")
+	}
+	for _, repository := range []string{airGap.ImageRepository, airGap.CoreDNSImageRepository, airGap.EtcdImageRepository} {
+		if strings.Contains(repository, "://") || strings.ContainsAny(repository, " ") {
+			return fmt.Errorf("invalid air-gap image repository %q: expected a registry host with an optional path", repository)
+		}
+	}
+
+	registries := map[string]bool{}
+	for _, mirror := range airGap.RegistryMirrors {
+		if mirror.Registry == "" || strings.ContainsAny(mirror.Registry, "/ ") {
+			return fmt.Errorf("invalid air-gap mirrored registry %q: expected a host name with an optional port", mirror.Registry)
+		}
+		if registries[mirror.Registry] {
+			return fmt.Errorf("invalid air-gap registry mirrors: registry %q is mirrored more than once", mirror.Registry)
+		}
+		registries[mirror.Registry] = true
+
+		endpoint, err := url.Parse(mirror.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("invalid air-gap registry mirror endpoint: %q", mirror.Endpoint)
+		}
+	}
+	return nil
+}
+
+// validateSSHAccess checks the SSH access settings are a valid user and public keys in the authorized_keys format
+func validateSSHAccess(sshAccess *clusterv1alpha1.SSHAccessConfig) error {
+	if sshAccess == nil {
+		return nil
+	}
+
+	if sshAccess.User != "" && (!sshUserPattern.MatchString(sshAccess.User) || sshAccess.User == "root") {
+		return fmt.Errorf("invalid SSH user %q: expected a non-root user name matching %s", sshAccess.User, sshUserPattern)
+	}
+
+	for _, key := range sshAccess.AuthorizedKeys {
+		if err := validateSSHPublicKey(key); err != nil {
+			return fmt.Errorf("invalid SSH authorized key: %w", err)
+		}
+	}
+	for _, key := range sshAccess.TrustedUserCAKeys {
+		if err := validateSSHPublicKey(key); err != nil {
+			return fmt.Errorf("invalid SSH trusted user CA key: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateSSHPublicKey checks the key is a single line starting with a supported key type followed by the key; the
+// options of authorized_keys are not supported so that templates can not restrict or extend the access of the keys
+func validateSSHPublicKey(key string) error {
+	if strings.ContainsAny(key, "\r\n") {
+		return fmt.Errorf("expected a single line")
+	}
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return fmt.Errorf("expected a key type followed by the base64 encoded key")
+	}
+	if !slices.Contains(sshKeyTypes, fields[0]) {
+		return fmt.Errorf("unsupported key type %q: expected one of %v", fields[0], sshKeyTypes)
+	}
+	if _, err := base64.StdEncoding.DecodeString(fields[1]); err != nil {
+		return fmt.Errorf("key is not base64 encoded: %w", err)
+	}
+	return nil
 }
 
 // ValidateUpdate validates modifications to ClusterTemplate
@@ -83,16 +476,63 @@ func (v *ClusterTemplateCustomValidator) ValidateUpdate(ctx context.Context, old
 	if !ok {
 		return nil, fmt.Errorf("expected a ClusterTemplate object for the oldObj but got %T", oldObj)
 	}
-	// ideally we'd just reject any updates to the ClusterTemplate but the controller makes updates to status etc
-	// there doesn't seem to be any way to differentiate requests even through a passed context key
-	if !reflect.DeepEqual(newTemplate.Spec, oldTemplate.Spec) {
-		return nil, fmt.Errorf("clusterTemplate spec immutable")
+	// the lifecycle state may only change along draft -> published -> deprecated; the cluster labels, their
+	// propagation policy and the sunset date are metadata that only affect the labels and the deprecation of clusters
+	// and may change as well. The other spec fields are rendered into the ClusterClass and may only change while no
+	// cluster uses the template, except for the provider types, which select the rendered resources.
+	newSpec, oldSpec := newTemplate.Spec, oldTemplate.Spec
+	newSpec.LifecycleState, oldSpec.LifecycleState = "", ""
+	newSpec.ClusterLabels, oldSpec.ClusterLabels = nil, nil
+	newSpec.LabelPropagationPolicy, oldSpec.LabelPropagationPolicy = "", ""
+	newSpec.SunsetDate, oldSpec.SunsetDate = nil, nil
+	var warnings admission.Warnings
+	if !reflect.DeepEqual(newSpec, oldSpec) {
+		if newSpec.ControlPlaneProviderType != oldSpec.ControlPlaneProviderType || newSpec.InfraProviderType != oldSpec.InfraProviderType {
+			return nil, k8serrors.NewBadRequest("clusterTemplate provider types are immutable")
+		}
+		// templates that were not rendered into a ClusterClass yet are not in use either
+		if err := v.templateNotInUse(ctx, oldTemplate); err != nil && !k8serrors.IsNotFound(err) {
+			if k8serrors.IsConflict(err) {
+				return nil, k8serrors.NewConflict(
+					schema.GroupResource{Group: v1alpha1.SchemeBuilder.GroupVersion.Group, Resource: "clustertemplates"},
+					newTemplate.Name,
+					fmt.Errorf("clusterTemplate spec immutable while the template is in use, only its metadata may change"),
+				)
+			}
+			return nil, err
+		}
+		var err error
+		if warnings, err = v.validateSpec(newTemplate); err != nil {
+			return nil, err
+		}
+	} else if signatureChanged(oldTemplate, newTemplate) {
+		// a new signature of an unchanged spec is verified as well, so that a template does not appear to be signed
+		if err := v.check(validation.TemplateSignature, v.verifySignature(newTemplate), newTemplate.GetName(), &warnings); err != nil {
+			slog.Error("template signature not verified", "name", newTemplate.GetName(), "error", err)
+			return nil, k8serrors.NewBadRequest(err.Error())
+		}
+	}
+	if !labels.Valid(newTemplate.Spec.ClusterLabels) {
+		return nil, k8serrors.NewBadRequest("clusterTemplate cluster labels are not valid Kubernetes labels")
+	}
+	if oldState, newState := oldTemplate.LifecycleState(), newTemplate.LifecycleState(); oldState != newState && !oldState.CanTransitionTo(newState) {
+		return nil, fmt.Errorf("clusterTemplate lifecycle state cannot change from %s to %s", oldState, newState)
 	}
 	clustertemplatelog.Info("validation for ClusterTemplate upon update", "name", newTemplate.GetName())
-	return nil, nil
+	return warnings, nil
 
 }
 
+// signatureChanged returns whether the signature or the signed payload of the template changed
+func signatureChanged(oldTemplate, newTemplate *clusterv1alpha1.ClusterTemplate) bool {
+	for _, key := range []string{core.SignatureAnnotationKey, core.SignedPayloadAnnotationKey} {
+		if oldTemplate.Annotations[key] != newTemplate.Annotations[key] {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateDelete validates ClusterTemplate deletion request by checking if the template is in use
 func (v *ClusterTemplateCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	clustertemplate, ok := obj.(*clusterv1alpha1.ClusterTemplate)
@@ -4,13 +4,35 @@
 package v1alpha1
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	capi "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	clusterv1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/core"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/network"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/signature"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/supportmatrix"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/template"
+	"github.com/open-edge-platform/cluster-manager/v2/internal/validation"
+	"github.com/open-edge-platform/cluster-manager/v2/pkg/api"
 )
 
 var _ = Describe("ClusterTemplate Webhook", func() {
@@ -68,6 +90,619 @@ var _ = Describe("ClusterTemplate Webhook", func() {
 			By("validating the k3s template")
 			_, err = validator.ValidateCreate(ctx, k3sTemplate)
 			Expect(err).To(BeNil(), "Expected k3s template to be valid")
+
+			By("reading the air-gapped k3s template from file")
+			airGapTemplate := &clusterv1alpha1.ClusterTemplate{}
+			airGapTemplateFile, err := os.ReadFile("../../../examples/cluster_v1alpha1_clustertemplate_k3s_airgap.yaml")
+			Expect(err).NotTo(HaveOccurred(), "Failed to read air-gapped k3s template file")
+			err = yaml.Unmarshal(airGapTemplateFile, airGapTemplate)
+			Expect(err).NotTo(HaveOccurred(), "Failed to unmarshal air-gapped k3s template")
+			Expect(airGapTemplate.Spec.AirGap).NotTo(BeNil())
+
+			By("validating the air-gapped k3s template")
+			_, err = validator.ValidateCreate(ctx, airGapTemplate)
+			Expect(err).To(BeNil(), "Expected air-gapped k3s template to be valid")
+
+			By("reading the air-gapped kubeadm template from file")
+			kubeadmAirGapTemplate := &clusterv1alpha1.ClusterTemplate{}
+			kubeadmAirGapTemplateFile, err := os.ReadFile("../../../examples/cluster_v1alpha1_clustertemplate_kubeadm_airgap.yaml")
+			Expect(err).NotTo(HaveOccurred(), "Failed to read air-gapped kubeadm template file")
+			err = yaml.Unmarshal(kubeadmAirGapTemplateFile, kubeadmAirGapTemplate)
+			Expect(err).NotTo(HaveOccurred(), "Failed to unmarshal air-gapped kubeadm template")
+			Expect(kubeadmAirGapTemplate.Spec.AirGapped).To(BeTrue())
+
+			By("validating the air-gapped kubeadm template")
+			_, err = validator.ValidateCreate(ctx, kubeadmAirGapTemplate)
+			Expect(err).To(BeNil(), "Expected air-gapped kubeadm template to be valid")
+		})
+
+		It("Should validate the air-gap settings", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+
+			By("admitting valid air-gap settings")
+			obj.Spec.AirGap = &clusterv1alpha1.AirGapConfig{
+				ArtifactURL:           "https://artifacts.site.local/k3s/v1.30.6+k3s1",
+				ImageTarballs:         []string{"k3s-airgap-images-amd64.tar.zst"},
+				SystemDefaultRegistry: "registry.site.local:5000",
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying an artifact URL without scheme")
+			obj.Spec.AirGap.ArtifactURL = "artifacts.site.local/k3s"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid air-gap artifact URL"))
+
+			By("denying an unsupported image tarball format")
+			obj.Spec.AirGap.ArtifactURL = "https://artifacts.site.local/k3s/v1.30.6+k3s1"
+			obj.Spec.AirGap.ImageTarballs = []string{"k3s-airgap-images-amd64.zip"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid air-gap image tarball"))
+
+			By("denying a registry with a scheme")
+			obj.Spec.AirGap.ImageTarballs = nil
+			obj.Spec.AirGap.SystemDefaultRegistry = "https://registry.site.local"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid air-gap system default registry"))
+
+			By("denying a relative install script path")
+			obj.Spec.AirGap.SystemDefaultRegistry = ""
+			obj.Spec.AirGap.InstallScriptPath = "install.sh"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid air-gap install script path"))
+
+			By("denying kubeadm settings with the k3s provider")
+			obj.Spec.AirGap.InstallScriptPath = ""
+			obj.Spec.AirGap.ImageRepository = "registry.site.local:5000/k8s"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not supported by the k3s control plane provider"))
+
+			By("denying k3s settings with the kubeadm provider")
+			obj.Spec.AirGap.ImageRepository = ""
+			obj.Spec.AirGapped = true
+			obj.Spec.ControlPlaneProviderType = "kubeadm"
+			obj.Spec.ClusterConfiguration = `{"kind":"KubeadmControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta1","spec":{"template":{"spec":{}}}}`
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not supported by the kubeadm control plane provider"))
+		})
+
+		It("Should validate the kubeadm air-gap settings", func() {
+			obj.Spec.ControlPlaneProviderType = "kubeadm"
+			obj.Spec.ClusterConfiguration = `{"kind":"KubeadmControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta1","spec":{"template":{"spec":{}}}}`
+
+			By("admitting valid air-gap settings")
+			obj.Spec.AirGapped = true
+			obj.Spec.AirGap = &clusterv1alpha1.AirGapConfig{
+				ImageRepository:     "registry.site.local:5000/k8s",
+				EtcdImageRepository: "registry.site.local:5000/etcd",
+				RegistryMirrors:     []clusterv1alpha1.RegistryMirror{{Registry: "docker.io", Endpoint: "https://registry.site.local:5000"}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying air-gap settings without the air-gapped flag")
+			obj.Spec.AirGapped = false
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("require the template to be air-gapped"))
+
+			By("denying the air-gapped flag without air-gap settings")
+			obj.Spec.AirGapped = true
+			airGap := obj.Spec.AirGap
+			obj.Spec.AirGap = nil
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("require air-gap settings"))
+
+			By("denying air-gap settings without image repository")
+			obj.Spec.AirGap = airGap
+			obj.Spec.AirGap.ImageRepository = ""
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("require an image repository"))
+
+			By("denying an image repository with a scheme")
+			obj.Spec.AirGap.ImageRepository = "https://registry.site.local:5000/k8s"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid air-gap image repository"))
+
+			By("denying a registry mirrored more than once")
+			obj.Spec.AirGap.ImageRepository = "registry.site.local:5000/k8s"
+			obj.Spec.AirGap.RegistryMirrors = append(obj.Spec.AirGap.RegistryMirrors, clusterv1alpha1.RegistryMirror{Registry: "docker.io", Endpoint: "https://mirror.site.local"})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("mirrored more than once"))
+
+			By("denying a mirror endpoint without scheme")
+			obj.Spec.AirGap.RegistryMirrors = []clusterv1alpha1.RegistryMirror{{Registry: "docker.io", Endpoint: "registry.site.local:5000"}}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid air-gap registry mirror endpoint"))
+		})
+
+		It("Should validate the SSH access settings", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+
+			By("admitting valid SSH access settings")
+			obj.Spec.SSHAccess = &clusterv1alpha1.SSHAccessConfig{
+				User:              "breakglass",
+				AuthorizedKeys:    []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGx1Y2t5LWJyZWFrLWdsYXNzLWtleQ== oncall@example.com"},
+				TrustedUserCAKeys: []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHNzaC11c2VyLWNhLWtleQ== user-ca"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying the root user")
+			obj.Spec.SSHAccess.User = "root"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid SSH user"))
+
+			By("denying an authorized key with an unsupported key type")
+			obj.Spec.SSHAccess.User = ""
+			obj.Spec.SSHAccess.AuthorizedKeys = []string{"ssh-dss AAAAB3NzaC1kc3M= legacy"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid SSH authorized key"))
+
+			By("denying a trusted user CA key spanning several lines")
+			obj.Spec.SSHAccess.AuthorizedKeys = nil
+			obj.Spec.SSHAccess.TrustedUserCAKeys = []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHNzaC11c2VyLWNhLWtleQ==\nssh-rsa AAAAB3NzaC1yc2E="}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid SSH trusted user CA key"))
+		})
+
+		It("Should admit violations of shadowed validation rules with a warning", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			obj.Spec.SSHAccess = &clusterv1alpha1.SSHAccessConfig{User: "root"}
+			now := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+			validator.Rules = validation.NewRules(map[string]time.Time{validation.SSHAccess: now.Add(24 * time.Hour)},
+				validation.WithClock(func() time.Time { return now }))
+
+			By("admitting the violation during the shadow period")
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("invalid SSH user"))
+			Expect(warnings[0]).To(ContainSubstring("ssh-access is not enforced yet"))
+
+			By("enforcing the other rules")
+			obj.Spec.AirGapped = true
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("air-gapped templates require air-gap settings"))
+
+			By("denying the violation after the shadow period")
+			obj.Spec.AirGapped = false
+			now = now.Add(48 * time.Hour)
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid SSH user"))
+		})
+
+		It("Should deny Kubernetes versions outside the support window of the provider", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			matrix, err := supportmatrix.Parse([]byte(`{"controlPlaneProviders":[{"provider":"k3s","release":"v0.4.0","minKubernetesVersion":"v1.30","maxKubernetesVersion":"v1.32"}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			validator.SupportMatrix = matrix
+
+			By("admitting a version in the support window")
+			obj.Spec.KubernetesVersion = "v1.32.9+k3s1"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying a version newer than the support window")
+			obj.Spec.KubernetesVersion = "v1.33.5+k3s1"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("outside the support windows of the k3s control plane provider"))
+
+			By("denying a version older than the support window")
+			obj.Spec.KubernetesVersion = "v1.29.10+k3s1"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("unsupported kubernetes version"))
+
+			By("denying a provider missing from the support matrix")
+			obj.Spec.ControlPlaneProviderType = "kubeadm"
+			obj.Spec.ClusterConfiguration = `{"kind":"KubeadmControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta1","spec":{"template":{"spec":{}}}}`
+			obj.Spec.KubernetesVersion = "v1.30.6"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not supported by the kubeadm control plane provider"))
+		})
+
+		It("Should deny reserved resources that are not quantities", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+
+			By("admitting CPU and memory quantities")
+			obj.Spec.ReservedResources = &clusterv1alpha1.ReservedResources{
+				System: &clusterv1alpha1.ResourceReservation{CPU: "500m", Memory: "1Gi"},
+				Kube:   &clusterv1alpha1.ResourceReservation{CPU: "0.25"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying an amount that is not a quantity")
+			obj.Spec.ReservedResources.Kube.Memory = "a lot"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`invalid kube reserved memory "a lot"`))
+
+			By("denying a negative amount")
+			obj.Spec.ReservedResources.Kube.Memory = "-1Gi"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("must not be negative"))
+		})
+
+		It("Should deny malformed secret references in the cluster configuration", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+
+			By("admitting references to a key of a Secret")
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{"kthreesConfigSpec":{"files":[{"path":"/etc/rancher/k3s/registries.yaml","content":"password: ${secret:registry-credentials/password}"}]}}}}}`
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying a reference without a key")
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{"kthreesConfigSpec":{"files":[{"path":"/etc/rancher/k3s/registries.yaml","content":"password: ${secret:registry-credentials}"}]}}}}}`
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`invalid secret reference "${secret:registry-credentials}"`))
+		})
+
+		It("Should deny minimum node resources that are not positive quantities", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+
+			By("admitting CPU and memory quantities")
+			obj.Spec.MinNodeResources = &clusterv1alpha1.ResourceReservation{CPU: "4", Memory: "8Gi"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying an amount that is not a quantity")
+			obj.Spec.MinNodeResources.Memory = "plenty"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`invalid minimum node memory "plenty"`))
+
+			By("denying a zero amount")
+			obj.Spec.MinNodeResources.Memory = "0"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("must be positive"))
+		})
+
+		It("Should deny cluster networks overlapping the reserved infrastructure networks", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			reserved, err := network.ParseReserved([]string{"10.0.0.0/16", "192.168.0.0/24"})
+			Expect(err).NotTo(HaveOccurred())
+			validator.ReservedNetworks = reserved
+
+			By("admitting networks next to the reserved networks")
+			obj.Spec.ClusterNetwork = clusterv1alpha1.ClusterNetwork{
+				Pods:     &clusterv1alpha1.NetworkRanges{CIDRBlocks: []string{"10.42.0.0/16"}},
+				Services: &clusterv1alpha1.NetworkRanges{CIDRBlocks: []string{"10.43.0.0/16"}},
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying a service network overlapping a gateway network")
+			obj.Spec.ClusterNetwork.Services.CIDRBlocks = []string{"192.168.0.0/16"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("service CIDR block 192.168.0.0/16 overlaps reserved infrastructure network 192.168.0.0/24"))
+		})
+
+		It("Should deny trusted compute templates of infra providers without attested hosts", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			obj.Spec.RequireTrustedCompute = true
+
+			By("admitting trusted compute templates of the intel infra provider")
+			obj.Spec.InfraProviderType = "intel"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying trusted compute templates of the docker infra provider")
+			obj.Spec.InfraProviderType = "docker"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("trusted compute templates require the intel infra provider, but the infra provider is 'docker'"))
+		})
+
+		It("Should deny templates that are not signed by a trusted key", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+			validator.TrustedKeys, err = signature.ParseTrustedKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+			Expect(err).NotTo(HaveOccurred())
+
+			info := api.TemplateInfo{
+				Name:                 "baseline",
+				Version:              "v1.0.0",
+				KubernetesVersion:    "v1.33.5+k3s1",
+				Clusterconfiguration: &map[string]interface{}{"kind": "KThreesControlPlaneTemplate", "apiVersion": "controlplane.cluster.x-k8s.io/v1beta2", "spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{}}}},
+			}
+			payload, err := template.SignaturePayload(info)
+			Expect(err).NotTo(HaveOccurred())
+			digest := sha256.Sum256(payload)
+			sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+			Expect(err).NotTo(HaveOccurred())
+			encoded := base64.StdEncoding.EncodeToString(sig)
+			info.Signature = &encoded
+
+			By("admitting signed templates")
+			signed, err := template.FromTemplateInfoToClusterTemplate(info)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = validator.ValidateCreate(ctx, signed)
+			Expect(err).To(BeNil())
+
+			By("denying signed templates whose spec was modified")
+			modified := signed.DeepCopy()
+			modified.Spec.RequireTrustedCompute = true
+			_, err = validator.ValidateCreate(ctx, modified)
+			Expect(err).ToNot(BeNil())
+			Expect(k8serrors.IsBadRequest(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("the template differs from its signed payload"))
+
+			By("denying a signature added to an unsigned template")
+			unsigned := signed.DeepCopy()
+			unsigned.Annotations = nil
+			forged := unsigned.DeepCopy()
+			forged.Annotations = map[string]string{core.SignatureAnnotationKey: encoded, core.SignedPayloadAnnotationKey: `{"name":"baseline"}`}
+			_, err = validator.ValidateUpdate(ctx, unsigned, forged)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not valid for any trusted key"))
+
+			By("denying unsigned templates")
+			_, err = validator.ValidateCreate(ctx, unsigned)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("template is not signed"))
+
+			By("admitting unsigned templates with a warning while the rule is shadowed")
+			validator.Rules = validation.NewRules(map[string]time.Time{validation.TemplateSignature: {}})
+			warnings, err := validator.ValidateCreate(ctx, unsigned)
+			Expect(err).To(BeNil())
+			Expect(warnings).To(ContainElement(ContainSubstring("validation rule template-signature is not enforced yet")))
+		})
+
+		It("Should require vSphere settings for the templates of the vsphere infra provider only", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			vsphere := &clusterv1alpha1.VSphereConfig{
+				Server:     "vcenter.site.local",
+				Datacenter: "edge-dc",
+				Network:    "VM Network",
+				Template:   "ubuntu-2204-kube-v1.30.6",
+			}
+
+			By("denying templates of the vsphere infra provider without vSphere settings")
+			obj.Spec.InfraProviderType = "vsphere"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("templates of the vsphere infra provider require vSphere settings"))
+
+			By("admitting templates of the vsphere infra provider with vSphere settings")
+			obj.Spec.VSphere = vsphere
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying vSphere settings of the other infra providers")
+			obj.Spec.InfraProviderType = "intel"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("vSphere settings require the vsphere infra provider, but the infra provider is 'intel'"))
+		})
+
+		It("Should deny remediation settings the MachineHealthChecks do not accept", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			maxUnhealthy := intstr.FromString("40%")
+			obj.Spec.Remediation = &clusterv1alpha1.RemediationConfig{
+				NodeStartupTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+				UnhealthyConditions: []clusterv1alpha1.UnhealthyCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: 5 * time.Minute}},
+				},
+				MaxUnhealthy: &maxUnhealthy,
+			}
+
+			By("admitting valid remediation settings")
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying a node startup timeout shorter than 30s")
+			obj.Spec.Remediation.NodeStartupTimeout = &metav1.Duration{Duration: 10 * time.Second}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the node startup timeout must be 0s or at least 30s, but is 10s"))
+
+			By("denying a maximum of unhealthy machines that is not a percentage")
+			obj.Spec.Remediation.NodeStartupTimeout = &metav1.Duration{}
+			invalid := intstr.FromString("half")
+			obj.Spec.Remediation.MaxUnhealthy = &invalid
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid maximum of unhealthy machines"))
+		})
+
+		It("Should deny single-node settings that cannot be rendered into the k3s configuration", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			obj.Spec.SingleNode = &clusterv1alpha1.SingleNodeConfig{
+				EtcdSnapshotDir:       "/var/lib/edge-data/etcd-snapshots",
+				EtcdSnapshotSchedule:  "0 */2 * * *",
+				EtcdSnapshotRetention: 3,
+				RejoinPolicy:          clusterv1alpha1.RejoinPolicyRestore,
+			}
+
+			By("admitting valid single-node settings")
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying a snapshot directory that needs quoting")
+			obj.Spec.SingleNode.EtcdSnapshotDir = "/var/lib/edge data"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid etcd snapshot directory"))
+
+			By("denying a schedule that is not a cron schedule")
+			obj.Spec.SingleNode.EtcdSnapshotDir = ""
+			obj.Spec.SingleNode.EtcdSnapshotSchedule = "every 2 hours"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid etcd snapshot schedule"))
+		})
+
+		It("Should deny template variables that cannot be declared on the ClusterClass", func() {
+			obj.Spec.ControlPlaneProviderType = "k3s"
+			obj.Spec.InfraProviderType = "docker"
+			obj.Spec.ClusterConfiguration = `{"kind":"KThreesControlPlaneTemplate","apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","spec":{"template":{"spec":{}}}}`
+			maxPodsDefault := "110"
+			minimum, maximum := int64(10), int64(250)
+			obj.Spec.Variables = []clusterv1alpha1.TemplateVariable{
+				{
+					Name:    "maxPods",
+					Type:    clusterv1alpha1.IntegerVariable,
+					Default: &maxPodsDefault,
+					Minimum: &minimum,
+					Maximum: &maximum,
+					Patches: []clusterv1alpha1.TemplateVariablePatch{
+						{Target: "controlPlane", Path: "/spec/template/spec/kthreesConfigSpec/agentConfig/kubeletArgs/-"},
+					},
+				},
+				{Name: "profile", Type: clusterv1alpha1.EnumVariable, Enum: []string{"small", "large"}},
+			}
+
+			By("admitting valid variables")
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(BeNil())
+
+			By("denying a default out of the bounds of the variable")
+			outOfBounds := "500"
+			obj.Spec.Variables[0].Default = &outOfBounds
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid default: variable maxPods must be at most 250, got 500"))
+
+			By("denying a variable of the providers")
+			obj.Spec.Variables[0].Default = &maxPodsDefault
+			obj.Spec.Variables[1].Name = "flannelBackend"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("variable flannelBackend is declared twice or is a variable of the providers"))
+
+			By("denying an enum variable without values")
+			obj.Spec.Variables[1] = clusterv1alpha1.TemplateVariable{Name: "profile", Type: clusterv1alpha1.EnumVariable}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("enum variable profile requires its values"))
+		})
+
+		It("Should only allow forward lifecycle state transitions on update", func() {
+			By("publishing a draft template")
+			oldObj.Spec.LifecycleState = clusterv1alpha1.TemplateDraft
+			obj.Spec.LifecycleState = clusterv1alpha1.TemplatePublished
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(BeNil())
+
+			By("deprecating a published template")
+			oldObj.Spec.LifecycleState = clusterv1alpha1.TemplatePublished
+			obj.Spec.LifecycleState = clusterv1alpha1.TemplateDeprecated
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(BeNil())
+
+			By("moving a deprecated template back to draft")
+			oldObj.Spec.LifecycleState = clusterv1alpha1.TemplateDeprecated
+			obj.Spec.LifecycleState = clusterv1alpha1.TemplateDraft
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("lifecycle state cannot change"))
+		})
+
+		It("Should allow changing the cluster labels and their propagation policy on update", func() {
+			By("adding cluster labels and propagating them")
+			obj.Spec.ClusterLabels = map[string]string{"region": "eu"}
+			obj.Spec.LabelPropagationPolicy = clusterv1alpha1.LabelPropagationPropagate
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(BeNil())
+
+			By("denying invalid cluster labels")
+			obj.Spec.ClusterLabels = map[string]string{"region": "not a label value"}
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not valid Kubernetes labels"))
+		})
+
+		It("Should only allow spec changes of ClusterTemplates not in use", func() {
+			scheme := apimachineryruntime.NewScheme()
+			Expect(capi.AddToScheme(scheme)).To(Succeed())
+			clusterClass := &capi.ClusterClass{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "baseline-v1.0.0"}}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(clusterClass).
+				WithIndex(&capi.Cluster{}, "spec.topology.classRef", IndexClusterInstances).Build()
+			validator.Client = fakeClient
+
+			oldObj.ObjectMeta = metav1.ObjectMeta{Namespace: "default", Name: "baseline-v1.0.0"}
+			oldObj.Spec = clusterv1alpha1.ClusterTemplateSpec{
+				ControlPlaneProviderType: "k3s",
+				InfraProviderType:        "docker",
+				KubernetesVersion:        "v1.32.4+k3s1",
+				ClusterConfiguration:     "{}",
+				LifecycleState:           clusterv1alpha1.TemplatePublished,
+			}
+			obj = oldObj.DeepCopy()
+
+			By("changing the kubernetes version of a template not in use")
+			obj.Spec.KubernetesVersion = "v1.33.5+k3s1"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(BeNil())
+
+			By("denying a change of the provider types")
+			changedProvider := obj.DeepCopy()
+			changedProvider.Spec.InfraProviderType = "intel"
+			_, err = validator.ValidateUpdate(ctx, oldObj, changedProvider)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("provider types are immutable"))
+
+			By("validating the changed spec like on creation")
+			invalid := obj.DeepCopy()
+			invalid.Spec.ClusterConfiguration = "{invalid-spec}}"
+			_, err = validator.ValidateUpdate(ctx, oldObj, invalid)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("failed to convert cluster configuration"))
+
+			By("denying spec changes of a template in use")
+			Expect(fakeClient.Create(ctx, &capi.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "edge-1"},
+				Spec:       capi.ClusterSpec{Topology: &capi.Topology{Class: "baseline-v1.0.0", Version: "v1.32.4+k3s1"}},
+			})).To(Succeed())
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).ToNot(BeNil())
+			Expect(k8serrors.IsConflict(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("clusterTemplate spec immutable while the template is in use"))
+
+			By("allowing metadata changes of a template in use")
+			metadataOnly := oldObj.DeepCopy()
+			metadataOnly.Spec.ClusterLabels = map[string]string{"region": "eu"}
+			sunsetDate := metav1.NewTime(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+			metadataOnly.Spec.SunsetDate = &sunsetDate
+			_, err = validator.ValidateUpdate(ctx, oldObj, metadataOnly)
+			Expect(err).To(BeNil())
 		})
 
 		It("Should only allow deletion of ClusterTemplates not in use", func() {})
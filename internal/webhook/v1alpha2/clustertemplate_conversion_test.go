@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	clusterv1alpha1 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha1"
+	clusterv1alpha2 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha2"
+)
+
+const clusterConfiguration = `{"apiVersion":"controlplane.cluster.x-k8s.io/v1beta2","kind":"KThreesControlPlaneTemplate","spec":{"template":{"spec":{}}}}`
+
+func hubTemplate() *clusterv1alpha1.ClusterTemplate {
+	sunset := metav1.Now().Rfc3339Copy()
+	return &clusterv1alpha1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "baseline-v1.0.0", Namespace: "tenant", Generation: 3},
+		Spec: clusterv1alpha1.ClusterTemplateSpec{
+			ControlPlaneProviderType: "k3s",
+			InfraProviderType:        "intel",
+			KubernetesVersion:        "v1.30.6+k3s1",
+			ClusterConfiguration:     clusterConfiguration,
+			ClusterNetwork:           clusterv1alpha1.ClusterNetwork{Pods: &clusterv1alpha1.NetworkRanges{CIDRBlocks: []string{"10.42.0.0/16"}}},
+			ClusterLabels:            map[string]string{"default-extension": "baseline"},
+			LabelPropagationPolicy:   clusterv1alpha1.LabelPropagationPropagate,
+			LifecycleState:           clusterv1alpha1.TemplateDeprecated,
+			SunsetDate:               &sunset,
+			AirGapped:                true,
+			AirGap:                   &clusterv1alpha1.AirGapConfig{ArtifactURL: "https://artifacts.site.local"},
+			SSHAccess:                &clusterv1alpha1.SSHAccessConfig{AuthorizedKeys: []string{"ssh-ed25519 AAAA"}},
+			ReservedResources:        &clusterv1alpha1.ReservedResources{System: &clusterv1alpha1.ResourceReservation{CPU: "500m"}},
+			MinNodeResources:         &clusterv1alpha1.ResourceReservation{CPU: "4", Memory: "8Gi"},
+			RequireTrustedCompute:    true,
+			VSphere:                  &clusterv1alpha1.VSphereConfig{Server: "vcenter.site.local"},
+			Remediation:              &clusterv1alpha1.RemediationConfig{UnhealthyConditions: []clusterv1alpha1.UnhealthyCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}}},
+			Variables:                []clusterv1alpha1.TemplateVariable{{Name: "maxPods", Type: clusterv1alpha1.IntegerVariable}},
+			SingleNode:               &clusterv1alpha1.SingleNodeConfig{EtcdSnapshotRetention: 3},
+		},
+		Status: clusterv1alpha1.ClusterTemplateStatus{Ready: true, ObservedGeneration: 3},
+	}
+}
+
+func TestClusterTemplateConversion(t *testing.T) {
+	t.Run("every field of the spec is converted", func(t *testing.T) {
+		// a field added to the v1alpha1 spec must be added to the v1alpha2 spec and its conversion too
+		spec := reflect.ValueOf(hubTemplate().Spec)
+		for i := range spec.NumField() {
+			require.False(t, spec.Field(i).IsZero(), "field %s of the test template is not set", spec.Type().Field(i).Name)
+			_, ok := reflect.TypeOf(clusterv1alpha2.ClusterTemplateSpec{}).FieldByName(spec.Type().Field(i).Name)
+			require.True(t, ok, "field %s is missing in v1alpha2", spec.Type().Field(i).Name)
+		}
+	})
+
+	t.Run("v1alpha1 round trip", func(t *testing.T) {
+		hub := hubTemplate()
+
+		var spoke clusterv1alpha2.ClusterTemplate
+		require.NoError(t, spoke.ConvertFrom(hub))
+		require.JSONEq(t, clusterConfiguration, string(spoke.Spec.ClusterConfiguration.Raw))
+		require.Equal(t, hub.Spec.Remediation, spoke.Spec.Remediation)
+
+		var converted clusterv1alpha1.ClusterTemplate
+		require.NoError(t, spoke.ConvertTo(&converted))
+		require.Equal(t, hub, &converted)
+	})
+
+	t.Run("v1alpha2 round trip", func(t *testing.T) {
+		spoke := &clusterv1alpha2.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "baseline-v2.0.0", Namespace: "tenant"},
+			Spec: clusterv1alpha2.ClusterTemplateSpec{
+				ControlPlaneProviderType: "kubeadm",
+				KubernetesVersion:        "v1.30.6",
+				ClusterConfiguration:     &runtime.RawExtension{Raw: []byte(clusterConfiguration)},
+			},
+		}
+
+		var hub clusterv1alpha1.ClusterTemplate
+		require.NoError(t, spoke.ConvertTo(&hub))
+		require.Equal(t, clusterConfiguration, hub.Spec.ClusterConfiguration)
+
+		var converted clusterv1alpha2.ClusterTemplate
+		require.NoError(t, converted.ConvertFrom(&hub))
+		require.Equal(t, spoke, &converted)
+	})
+
+	t.Run("no cluster configuration", func(t *testing.T) {
+		hub := hubTemplate()
+		hub.Spec.ClusterConfiguration = ""
+
+		var spoke clusterv1alpha2.ClusterTemplate
+		require.NoError(t, spoke.ConvertFrom(hub))
+		require.Nil(t, spoke.Spec.ClusterConfiguration)
+
+		var converted clusterv1alpha1.ClusterTemplate
+		require.NoError(t, spoke.ConvertTo(&converted))
+		require.Empty(t, converted.Spec.ClusterConfiguration)
+	})
+
+	t.Run("cluster configuration that is not JSON", func(t *testing.T) {
+		hub := hubTemplate()
+		hub.Spec.ClusterConfiguration = "{invalid-spec}}"
+
+		var spoke clusterv1alpha2.ClusterTemplate
+		require.ErrorContains(t, spoke.ConvertFrom(hub), "not valid JSON")
+	})
+
+	t.Run("the versions are convertible", func(t *testing.T) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, clusterv1alpha1.AddToScheme(scheme))
+		require.NoError(t, clusterv1alpha2.AddToScheme(scheme))
+
+		convertible, err := conversion.IsConvertible(scheme, &clusterv1alpha2.ClusterTemplate{})
+		require.NoError(t, err)
+		require.True(t, convertible)
+	})
+}
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1alpha2 "github.com/open-edge-platform/cluster-manager/v2/api/v1alpha2"
+)
+
+// SetupClusterTemplateWebhookWithManager registers the conversion webhook for ClusterTemplate in the manager. The
+// objects are stored as v1alpha1, whose webhook validates them; v1alpha2 objects are converted to v1alpha1 to be
+// validated, so v1alpha2 has no validating webhook of its own.
+func SetupClusterTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&clusterv1alpha2.ClusterTemplate{}).
+		Complete()
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
@@ -89,6 +90,18 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetV2AdminExportsProjectId request
+	GetV2AdminExportsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2AdminSupportBundlesProjectIdClustersName request
+	GetV2AdminSupportBundlesProjectIdClustersName(ctx context.Context, projectId openapi_types.UUID, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2Apichangelog request
+	GetV2Apichangelog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2AuthzSelf request
+	GetV2AuthzSelf(ctx context.Context, params *GetV2AuthzSelfParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2Clusters request
 	GetV2Clusters(ctx context.Context, params *GetV2ClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -97,6 +110,14 @@ type ClientInterface interface {
 
 	PostV2Clusters(ctx context.Context, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostV2ClustersImportWithBody request with any body
+	PostV2ClustersImportWithBody(ctx context.Context, params *PostV2ClustersImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ClustersImport(ctx context.Context, params *PostV2ClustersImportParams, body PostV2ClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ClustersNameSuggestion request
+	GetV2ClustersNameSuggestion(ctx context.Context, params *GetV2ClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2ClustersSummary request
 	GetV2ClustersSummary(ctx context.Context, params *GetV2ClustersSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -106,14 +127,68 @@ type ClientInterface interface {
 	// GetV2ClustersName request
 	GetV2ClustersName(ctx context.Context, name string, params *GetV2ClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2ClustersNameBackups request
+	GetV2ClustersNameBackups(ctx context.Context, name string, params *GetV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ClustersNameBackups request
+	PostV2ClustersNameBackups(ctx context.Context, name string, params *PostV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ClustersNameCloneWithBody request with any body
+	PostV2ClustersNameCloneWithBody(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ClustersNameClone(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, body PostV2ClustersNameCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2ClustersNameDeletion request
+	DeleteV2ClustersNameDeletion(ctx context.Context, name string, params *DeleteV2ClustersNameDeletionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ClustersNameEvents request
+	GetV2ClustersNameEvents(ctx context.Context, name string, params *GetV2ClustersNameEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV2ClustersNameExtensionsWithBody request with any body
+	PutV2ClustersNameExtensionsWithBody(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2ClustersNameExtensions(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, body PutV2ClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ClustersNameHealth request
+	GetV2ClustersNameHealth(ctx context.Context, name string, params *GetV2ClustersNameHealthParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2ClustersNameKubeconfigs request
+	DeleteV2ClustersNameKubeconfigs(ctx context.Context, name string, params *DeleteV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2ClustersNameKubeconfigs request
 	GetV2ClustersNameKubeconfigs(ctx context.Context, name string, params *GetV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchV2ClustersNameLabelsWithBody request with any body
+	PatchV2ClustersNameLabelsWithBody(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV2ClustersNameLabelsWithApplicationMergePatchPlusJSONBody(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, body PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2ClustersNameLabelsWithBody request with any body
 	PutV2ClustersNameLabelsWithBody(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutV2ClustersNameLabels(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteV2ClustersNameMaintenance request
+	DeleteV2ClustersNameMaintenance(ctx context.Context, name string, params *DeleteV2ClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV2ClustersNameMaintenanceWithBody request with any body
+	PutV2ClustersNameMaintenanceWithBody(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2ClustersNameMaintenance(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, body PutV2ClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ClustersNameNodepools request
+	GetV2ClustersNameNodepools(ctx context.Context, name string, params *GetV2ClustersNameNodepoolsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ClustersNameNodepoolsWithBody request with any body
+	PostV2ClustersNameNodepoolsWithBody(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ClustersNameNodepools(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, body PostV2ClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchV2ClustersNameNodepoolsPoolNameWithBody request with any body
+	PatchV2ClustersNameNodepoolsPoolNameWithBody(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV2ClustersNameNodepoolsPoolName(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, body PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2ClustersNameNodesWithBody request with any body
 	PutV2ClustersNameNodesWithBody(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -122,42 +197,150 @@ type ClientInterface interface {
 	// DeleteV2ClustersNameNodesNodeId request
 	DeleteV2ClustersNameNodesNodeId(ctx context.Context, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostV2ClustersNameNodesNodeIdCordonWithBody request with any body
+	PostV2ClustersNameNodesNodeIdCordonWithBody(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ClustersNameNodesNodeIdCordon(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, body PostV2ClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ClustersNameNodesNodeIdLogs request
+	GetV2ClustersNameNodesNodeIdLogs(ctx context.Context, name string, nodeId string, params *GetV2ClustersNameNodesNodeIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ClustersNameNodesNodeIdUncordon request
+	PostV2ClustersNameNodesNodeIdUncordon(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdUncordonParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ClustersNameRestoreWithBody request with any body
+	PostV2ClustersNameRestoreWithBody(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ClustersNameRestore(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, body PostV2ClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2ClustersNameTemplateWithBody request with any body
 	PutV2ClustersNameTemplateWithBody(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutV2ClustersNameTemplate(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2ClustersNameUpgrades request
+	GetV2ClustersNameUpgrades(ctx context.Context, name string, params *GetV2ClustersNameUpgradesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2ClustersNodeIdClusterdetail request
 	GetV2ClustersNodeIdClusterdetail(ctx context.Context, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2Docs request
+	GetV2Docs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2Extensions request
+	GetV2Extensions(ctx context.Context, params *GetV2ExtensionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2Healthz request
 	GetV2Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2OpenapiJson request
+	GetV2OpenapiJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2Operations request
+	GetV2Operations(ctx context.Context, params *GetV2OperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2OperationsId request
+	GetV2OperationsId(ctx context.Context, id openapi_types.UUID, params *GetV2OperationsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2PendingClusters request
+	GetV2PendingClusters(ctx context.Context, params *GetV2PendingClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2PendingClustersName request
+	DeleteV2PendingClustersName(ctx context.Context, name string, params *DeleteV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2PendingClustersName request
+	GetV2PendingClustersName(ctx context.Context, name string, params *GetV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV2PendingClustersNameWithBody request with any body
+	PutV2PendingClustersNameWithBody(ctx context.Context, name string, params *PutV2PendingClustersNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2PendingClustersName(ctx context.Context, name string, params *PutV2PendingClustersNameParams, body PutV2PendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameAuthzSelf request
+	GetV2ProjectsProjectNameAuthzSelf(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameAuthzSelfParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2ProjectsProjectNameClusters request
 	GetV2ProjectsProjectNameClusters(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostV2ProjectsProjectNameClustersWithBody request with any body
-	PostV2ProjectsProjectNameClustersWithBody(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostV2ProjectsProjectNameClustersWithBody(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameClusters(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameClustersImportWithBody request with any body
+	PostV2ProjectsProjectNameClustersImportWithBody(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameClustersImport(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostV2ProjectsProjectNameClusters(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetV2ProjectsProjectNameClustersNameSuggestion request
+	GetV2ProjectsProjectNameClustersNameSuggestion(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetV2ProjectsProjectNameClustersSummary request
 	GetV2ProjectsProjectNameClustersSummary(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteV2ProjectsProjectNameClustersName request
-	DeleteV2ProjectsProjectNameClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteV2ProjectsProjectNameClustersName(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetV2ProjectsProjectNameClustersName request
 	GetV2ProjectsProjectNameClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2ProjectsProjectNameClustersNameBackups request
+	GetV2ProjectsProjectNameClustersNameBackups(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameClustersNameBackups request
+	PostV2ProjectsProjectNameClustersNameBackups(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2ProjectsProjectNameClustersNameDeletion request
+	DeleteV2ProjectsProjectNameClustersNameDeletion(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameClustersNameEvents request
+	GetV2ProjectsProjectNameClustersNameEvents(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV2ProjectsProjectNameClustersNameExtensionsWithBody request with any body
+	PutV2ProjectsProjectNameClustersNameExtensionsWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2ProjectsProjectNameClustersNameExtensions(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameClustersNameHealth request
+	GetV2ProjectsProjectNameClustersNameHealth(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2ProjectsProjectNameClustersNameKubeconfigs request
+	DeleteV2ProjectsProjectNameClustersNameKubeconfigs(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2ProjectsProjectNameClustersNameKubeconfigs request
 	GetV2ProjectsProjectNameClustersNameKubeconfigs(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchV2ProjectsProjectNameClustersNameLabelsWithBody request with any body
+	PatchV2ProjectsProjectNameClustersNameLabelsWithBody(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV2ProjectsProjectNameClustersNameLabelsWithApplicationMergePatchPlusJSONBody(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, body PatchV2ProjectsProjectNameClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2ProjectsProjectNameClustersNameLabelsWithBody request with any body
 	PutV2ProjectsProjectNameClustersNameLabelsWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutV2ProjectsProjectNameClustersNameLabels(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteV2ProjectsProjectNameClustersNameMaintenance request
+	DeleteV2ProjectsProjectNameClustersNameMaintenance(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV2ProjectsProjectNameClustersNameMaintenanceWithBody request with any body
+	PutV2ProjectsProjectNameClustersNameMaintenanceWithBody(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2ProjectsProjectNameClustersNameMaintenance(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, body PutV2ProjectsProjectNameClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameClustersNameNodepools request
+	GetV2ProjectsProjectNameClustersNameNodepools(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameClustersNameNodepoolsWithBody request with any body
+	PostV2ProjectsProjectNameClustersNameNodepoolsWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameClustersNameNodepools(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBody request with any body
+	PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBody(ctx context.Context, projectName ProjectNamePath, name string, poolName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchV2ProjectsProjectNameClustersNameNodepoolsPoolName(ctx context.Context, projectName ProjectNamePath, name string, poolName string, body PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2ProjectsProjectNameClustersNameNodesWithBody request with any body
 	PutV2ProjectsProjectNameClustersNameNodesWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -166,14 +349,72 @@ type ClientInterface interface {
 	// DeleteV2ProjectsProjectNameClustersNameNodesNodeId request
 	DeleteV2ProjectsProjectNameClustersNameNodesNodeId(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBody request with any body
+	PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBody(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameClustersNameNodesNodeIdCordon(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, body PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordon request
+	PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordon(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameClustersNameRestoreWithBody request with any body
+	PostV2ProjectsProjectNameClustersNameRestoreWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameClustersNameRestore(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2ProjectsProjectNameClustersNameTemplateWithBody request with any body
 	PutV2ProjectsProjectNameClustersNameTemplateWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutV2ProjectsProjectNameClustersNameTemplate(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2ProjectsProjectNameClustersNameUpgrades request
+	GetV2ProjectsProjectNameClustersNameUpgrades(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2ProjectsProjectNameClustersNodeIdClusterdetail request
 	GetV2ProjectsProjectNameClustersNodeIdClusterdetail(ctx context.Context, projectName ProjectNamePath, nodeId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2ProjectsProjectNameOperations request
+	GetV2ProjectsProjectNameOperations(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameOperationsId request
+	GetV2ProjectsProjectNameOperationsId(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNamePendingClusters request
+	GetV2ProjectsProjectNamePendingClusters(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2ProjectsProjectNamePendingClustersName request
+	DeleteV2ProjectsProjectNamePendingClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNamePendingClustersName request
+	GetV2ProjectsProjectNamePendingClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV2ProjectsProjectNamePendingClustersNameWithBody request with any body
+	PutV2ProjectsProjectNamePendingClustersNameWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2ProjectsProjectNamePendingClustersName(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNamePendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameSummary request
+	GetV2ProjectsProjectNameSummary(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameTemplateUploadsWithBody request with any body
+	PostV2ProjectsProjectNameTemplateUploadsWithBody(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameTemplateUploads(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameTemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2ProjectsProjectNameTemplateUploadsId request
+	DeleteV2ProjectsProjectNameTemplateUploadsId(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameTemplateUploadsId request
+	GetV2ProjectsProjectNameTemplateUploadsId(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBody request with any body
+	PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBody(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameTemplateUploadsIdChunks(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, body PostV2ProjectsProjectNameTemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameTemplateUploadsIdCommit request
+	PostV2ProjectsProjectNameTemplateUploadsIdCommit(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2ProjectsProjectNameTemplates request
 	GetV2ProjectsProjectNameTemplates(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -182,6 +423,9 @@ type ClientInterface interface {
 
 	PostV2ProjectsProjectNameTemplates(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2ProjectsProjectNameTemplatesCompatibility request
+	GetV2ProjectsProjectNameTemplatesCompatibility(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2ProjectsProjectNameTemplatesNameDefaultWithBody request with any body
 	PutV2ProjectsProjectNameTemplatesNameDefaultWithBody(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -196,6 +440,59 @@ type ClientInterface interface {
 	// GetV2ProjectsProjectNameTemplatesNameVersion request
 	GetV2ProjectsProjectNameTemplatesNameVersion(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PutV2ProjectsProjectNameTemplatesNameVersionWithBody request with any body
+	PutV2ProjectsProjectNameTemplatesNameVersionWithBody(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2ProjectsProjectNameTemplatesNameVersion(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, body PutV2ProjectsProjectNameTemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBody request with any body
+	PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBody(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreview(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, body PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameTemplatesNameVersionClusters request
+	GetV2ProjectsProjectNameTemplatesNameVersionClusters(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameTemplatesNameVersionDeprecate request
+	PostV2ProjectsProjectNameTemplatesNameVersionDeprecate(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameTemplatesNameVersionExport request
+	GetV2ProjectsProjectNameTemplatesNameVersionExport(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2ProjectsProjectNameTemplatesNameVersionPublish request
+	PostV2ProjectsProjectNameTemplatesNameVersionPublish(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2ProjectsProjectNameWebhooksDestinations request
+	GetV2ProjectsProjectNameWebhooksDestinations(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2Readyz request
+	GetV2Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2Summary request
+	GetV2Summary(ctx context.Context, params *GetV2SummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2Supportmatrix request
+	GetV2Supportmatrix(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2TemplateUploadsWithBody request with any body
+	PostV2TemplateUploadsWithBody(ctx context.Context, params *PostV2TemplateUploadsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2TemplateUploads(ctx context.Context, params *PostV2TemplateUploadsParams, body PostV2TemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV2TemplateUploadsId request
+	DeleteV2TemplateUploadsId(ctx context.Context, id openapi_types.UUID, params *DeleteV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2TemplateUploadsId request
+	GetV2TemplateUploadsId(ctx context.Context, id openapi_types.UUID, params *GetV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2TemplateUploadsIdChunksWithBody request with any body
+	PostV2TemplateUploadsIdChunksWithBody(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2TemplateUploadsIdChunks(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, body PostV2TemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2TemplateUploadsIdCommit request
+	PostV2TemplateUploadsIdCommit(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdCommitParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV2Templates request
 	GetV2Templates(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -204,6 +501,9 @@ type ClientInterface interface {
 
 	PostV2Templates(ctx context.Context, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetV2TemplatesCompatibility request
+	GetV2TemplatesCompatibility(ctx context.Context, params *GetV2TemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutV2TemplatesNameDefaultWithBody request with any body
 	PutV2TemplatesNameDefaultWithBody(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -217,10 +517,35 @@ type ClientInterface interface {
 
 	// GetV2TemplatesNameVersion request
 	GetV2TemplatesNameVersion(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV2TemplatesNameVersionWithBody request with any body
+	PutV2TemplatesNameVersionWithBody(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutV2TemplatesNameVersion(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, body PutV2TemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2TemplatesNameVersionClusterlabelsPreviewWithBody request with any body
+	PostV2TemplatesNameVersionClusterlabelsPreviewWithBody(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV2TemplatesNameVersionClusterlabelsPreview(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, body PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2TemplatesNameVersionClusters request
+	GetV2TemplatesNameVersionClusters(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2TemplatesNameVersionDeprecate request
+	PostV2TemplatesNameVersionDeprecate(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2TemplatesNameVersionExport request
+	GetV2TemplatesNameVersionExport(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV2TemplatesNameVersionPublish request
+	PostV2TemplatesNameVersionPublish(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV2WebhooksDestinations request
+	GetV2WebhooksDestinations(ctx context.Context, params *GetV2WebhooksDestinationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetV2Clusters(ctx context.Context, params *GetV2ClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ClustersRequest(c.Server, params)
+func (c *Client) GetV2AdminExportsProjectId(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2AdminExportsProjectIdRequest(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
@@ -231,8 +556,8 @@ func (c *Client) GetV2Clusters(ctx context.Context, params *GetV2ClustersParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2ClustersWithBody(ctx context.Context, params *PostV2ClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2ClustersRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) GetV2AdminSupportBundlesProjectIdClustersName(ctx context.Context, projectId openapi_types.UUID, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2AdminSupportBundlesProjectIdClustersNameRequest(c.Server, projectId, name)
 	if err != nil {
 		return nil, err
 	}
@@ -243,8 +568,8 @@ func (c *Client) PostV2ClustersWithBody(ctx context.Context, params *PostV2Clust
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2Clusters(ctx context.Context, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2ClustersRequest(c.Server, params, body)
+func (c *Client) GetV2Apichangelog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ApichangelogRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -255,8 +580,8 @@ func (c *Client) PostV2Clusters(ctx context.Context, params *PostV2ClustersParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ClustersSummary(ctx context.Context, params *GetV2ClustersSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ClustersSummaryRequest(c.Server, params)
+func (c *Client) GetV2AuthzSelf(ctx context.Context, params *GetV2AuthzSelfParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2AuthzSelfRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -267,8 +592,8 @@ func (c *Client) GetV2ClustersSummary(ctx context.Context, params *GetV2Clusters
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV2ClustersName(ctx context.Context, name string, params *DeleteV2ClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV2ClustersNameRequest(c.Server, name, params)
+func (c *Client) GetV2Clusters(ctx context.Context, params *GetV2ClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -279,8 +604,8 @@ func (c *Client) DeleteV2ClustersName(ctx context.Context, name string, params *
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ClustersName(ctx context.Context, name string, params *GetV2ClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ClustersNameRequest(c.Server, name, params)
+func (c *Client) PostV2ClustersWithBody(ctx context.Context, params *PostV2ClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -291,8 +616,8 @@ func (c *Client) GetV2ClustersName(ctx context.Context, name string, params *Get
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ClustersNameKubeconfigs(ctx context.Context, name string, params *GetV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ClustersNameKubeconfigsRequest(c.Server, name, params)
+func (c *Client) PostV2Clusters(ctx context.Context, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -303,8 +628,8 @@ func (c *Client) GetV2ClustersNameKubeconfigs(ctx context.Context, name string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ClustersNameLabelsWithBody(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ClustersNameLabelsRequestWithBody(c.Server, name, params, contentType, body)
+func (c *Client) PostV2ClustersImportWithBody(ctx context.Context, params *PostV2ClustersImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersImportRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -315,8 +640,8 @@ func (c *Client) PutV2ClustersNameLabelsWithBody(ctx context.Context, name strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ClustersNameLabels(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ClustersNameLabelsRequest(c.Server, name, params, body)
+func (c *Client) PostV2ClustersImport(ctx context.Context, params *PostV2ClustersImportParams, body PostV2ClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersImportRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -327,8 +652,8 @@ func (c *Client) PutV2ClustersNameLabels(ctx context.Context, name string, param
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ClustersNameNodesWithBody(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ClustersNameNodesRequestWithBody(c.Server, name, params, contentType, body)
+func (c *Client) GetV2ClustersNameSuggestion(ctx context.Context, params *GetV2ClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameSuggestionRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -339,8 +664,8 @@ func (c *Client) PutV2ClustersNameNodesWithBody(ctx context.Context, name string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ClustersNameNodes(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ClustersNameNodesRequest(c.Server, name, params, body)
+func (c *Client) GetV2ClustersSummary(ctx context.Context, params *GetV2ClustersSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersSummaryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -351,8 +676,8 @@ func (c *Client) PutV2ClustersNameNodes(ctx context.Context, name string, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV2ClustersNameNodesNodeId(ctx context.Context, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV2ClustersNameNodesNodeIdRequest(c.Server, name, nodeId, params)
+func (c *Client) DeleteV2ClustersName(ctx context.Context, name string, params *DeleteV2ClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ClustersNameRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -363,8 +688,8 @@ func (c *Client) DeleteV2ClustersNameNodesNodeId(ctx context.Context, name strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ClustersNameTemplateWithBody(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ClustersNameTemplateRequestWithBody(c.Server, name, params, contentType, body)
+func (c *Client) GetV2ClustersName(ctx context.Context, name string, params *GetV2ClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -375,8 +700,8 @@ func (c *Client) PutV2ClustersNameTemplateWithBody(ctx context.Context, name str
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ClustersNameTemplate(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ClustersNameTemplateRequest(c.Server, name, params, body)
+func (c *Client) GetV2ClustersNameBackups(ctx context.Context, name string, params *GetV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameBackupsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -387,8 +712,8 @@ func (c *Client) PutV2ClustersNameTemplate(ctx context.Context, name string, par
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ClustersNodeIdClusterdetail(ctx context.Context, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ClustersNodeIdClusterdetailRequest(c.Server, nodeId, params)
+func (c *Client) PostV2ClustersNameBackups(ctx context.Context, name string, params *PostV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameBackupsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -399,8 +724,8 @@ func (c *Client) GetV2ClustersNodeIdClusterdetail(ctx context.Context, nodeId st
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2HealthzRequest(c.Server)
+func (c *Client) PostV2ClustersNameCloneWithBody(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameCloneRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -411,8 +736,8 @@ func (c *Client) GetV2Healthz(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameClusters(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameClustersRequest(c.Server, projectName, params)
+func (c *Client) PostV2ClustersNameClone(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, body PostV2ClustersNameCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameCloneRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -423,8 +748,8 @@ func (c *Client) GetV2ProjectsProjectNameClusters(ctx context.Context, projectNa
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2ProjectsProjectNameClustersWithBody(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2ProjectsProjectNameClustersRequestWithBody(c.Server, projectName, contentType, body)
+func (c *Client) DeleteV2ClustersNameDeletion(ctx context.Context, name string, params *DeleteV2ClustersNameDeletionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ClustersNameDeletionRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -435,8 +760,8 @@ func (c *Client) PostV2ProjectsProjectNameClustersWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2ProjectsProjectNameClusters(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2ProjectsProjectNameClustersRequest(c.Server, projectName, body)
+func (c *Client) GetV2ClustersNameEvents(ctx context.Context, name string, params *GetV2ClustersNameEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameEventsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -447,8 +772,8 @@ func (c *Client) PostV2ProjectsProjectNameClusters(ctx context.Context, projectN
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameClustersSummary(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameClustersSummaryRequest(c.Server, projectName)
+func (c *Client) PutV2ClustersNameExtensionsWithBody(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameExtensionsRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -459,8 +784,8 @@ func (c *Client) GetV2ProjectsProjectNameClustersSummary(ctx context.Context, pr
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV2ProjectsProjectNameClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV2ProjectsProjectNameClustersNameRequest(c.Server, projectName, name)
+func (c *Client) PutV2ClustersNameExtensions(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, body PutV2ClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameExtensionsRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -471,8 +796,8 @@ func (c *Client) DeleteV2ProjectsProjectNameClustersName(ctx context.Context, pr
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameClustersNameRequest(c.Server, projectName, name)
+func (c *Client) GetV2ClustersNameHealth(ctx context.Context, name string, params *GetV2ClustersNameHealthParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameHealthRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -483,8 +808,8 @@ func (c *Client) GetV2ProjectsProjectNameClustersName(ctx context.Context, proje
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameClustersNameKubeconfigs(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameClustersNameKubeconfigsRequest(c.Server, projectName, name, params)
+func (c *Client) DeleteV2ClustersNameKubeconfigs(ctx context.Context, name string, params *DeleteV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ClustersNameKubeconfigsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -495,8 +820,8 @@ func (c *Client) GetV2ProjectsProjectNameClustersNameKubeconfigs(ctx context.Con
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameClustersNameLabelsWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody(c.Server, projectName, name, contentType, body)
+func (c *Client) GetV2ClustersNameKubeconfigs(ctx context.Context, name string, params *GetV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameKubeconfigsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -507,8 +832,8 @@ func (c *Client) PutV2ProjectsProjectNameClustersNameLabelsWithBody(ctx context.
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameClustersNameLabels(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameClustersNameLabelsRequest(c.Server, projectName, name, body)
+func (c *Client) PatchV2ClustersNameLabelsWithBody(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ClustersNameLabelsRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -519,8 +844,8 @@ func (c *Client) PutV2ProjectsProjectNameClustersNameLabels(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameClustersNameNodesWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody(c.Server, projectName, name, contentType, body)
+func (c *Client) PatchV2ClustersNameLabelsWithApplicationMergePatchPlusJSONBody(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, body PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ClustersNameLabelsRequestWithApplicationMergePatchPlusJSONBody(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -531,8 +856,8 @@ func (c *Client) PutV2ProjectsProjectNameClustersNameNodesWithBody(ctx context.C
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameClustersNameNodes(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameClustersNameNodesRequest(c.Server, projectName, name, body)
+func (c *Client) PutV2ClustersNameLabelsWithBody(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameLabelsRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -543,8 +868,8 @@ func (c *Client) PutV2ProjectsProjectNameClustersNameNodes(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV2ProjectsProjectNameClustersNameNodesNodeId(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV2ProjectsProjectNameClustersNameNodesNodeIdRequest(c.Server, projectName, name, nodeId, params)
+func (c *Client) PutV2ClustersNameLabels(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameLabelsRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -555,8 +880,8 @@ func (c *Client) DeleteV2ProjectsProjectNameClustersNameNodesNodeId(ctx context.
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameClustersNameTemplateWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody(c.Server, projectName, name, contentType, body)
+func (c *Client) DeleteV2ClustersNameMaintenance(ctx context.Context, name string, params *DeleteV2ClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ClustersNameMaintenanceRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -567,8 +892,8 @@ func (c *Client) PutV2ProjectsProjectNameClustersNameTemplateWithBody(ctx contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameClustersNameTemplate(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameClustersNameTemplateRequest(c.Server, projectName, name, body)
+func (c *Client) PutV2ClustersNameMaintenanceWithBody(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameMaintenanceRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -579,8 +904,8 @@ func (c *Client) PutV2ProjectsProjectNameClustersNameTemplate(ctx context.Contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameClustersNodeIdClusterdetail(ctx context.Context, projectName ProjectNamePath, nodeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameClustersNodeIdClusterdetailRequest(c.Server, projectName, nodeId)
+func (c *Client) PutV2ClustersNameMaintenance(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, body PutV2ClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameMaintenanceRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -591,8 +916,8 @@ func (c *Client) GetV2ProjectsProjectNameClustersNodeIdClusterdetail(ctx context
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameTemplates(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameTemplatesRequest(c.Server, projectName, params)
+func (c *Client) GetV2ClustersNameNodepools(ctx context.Context, name string, params *GetV2ClustersNameNodepoolsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameNodepoolsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -603,8 +928,8 @@ func (c *Client) GetV2ProjectsProjectNameTemplates(ctx context.Context, projectN
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2ProjectsProjectNameTemplatesWithBody(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2ProjectsProjectNameTemplatesRequestWithBody(c.Server, projectName, params, contentType, body)
+func (c *Client) PostV2ClustersNameNodepoolsWithBody(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameNodepoolsRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -615,8 +940,8 @@ func (c *Client) PostV2ProjectsProjectNameTemplatesWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2ProjectsProjectNameTemplates(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2ProjectsProjectNameTemplatesRequest(c.Server, projectName, params, body)
+func (c *Client) PostV2ClustersNameNodepools(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, body PostV2ClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameNodepoolsRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -627,8 +952,8 @@ func (c *Client) PostV2ProjectsProjectNameTemplates(ctx context.Context, project
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameTemplatesNameDefaultWithBody(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody(c.Server, projectName, name, params, contentType, body)
+func (c *Client) PatchV2ClustersNameNodepoolsPoolNameWithBody(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ClustersNameNodepoolsPoolNameRequestWithBody(c.Server, name, poolName, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -639,8 +964,8 @@ func (c *Client) PutV2ProjectsProjectNameTemplatesNameDefaultWithBody(ctx contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2ProjectsProjectNameTemplatesNameDefault(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2ProjectsProjectNameTemplatesNameDefaultRequest(c.Server, projectName, name, params, body)
+func (c *Client) PatchV2ClustersNameNodepoolsPoolName(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, body PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ClustersNameNodepoolsPoolNameRequest(c.Server, name, poolName, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -651,8 +976,8 @@ func (c *Client) PutV2ProjectsProjectNameTemplatesNameDefault(ctx context.Contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersions(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest(c.Server, projectName, name, params)
+func (c *Client) PutV2ClustersNameNodesWithBody(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameNodesRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -663,8 +988,8 @@ func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersions(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV2ProjectsProjectNameTemplatesNameVersion(ctx context.Context, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest(c.Server, projectName, name, version, params)
+func (c *Client) PutV2ClustersNameNodes(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameNodesRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -675,8 +1000,8 @@ func (c *Client) DeleteV2ProjectsProjectNameTemplatesNameVersion(ctx context.Con
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersion(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2ProjectsProjectNameTemplatesNameVersionRequest(c.Server, projectName, name, version, params)
+func (c *Client) DeleteV2ClustersNameNodesNodeId(ctx context.Context, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ClustersNameNodesNodeIdRequest(c.Server, name, nodeId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -687,8 +1012,8 @@ func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersion(ctx context.Contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2Templates(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2TemplatesRequest(c.Server, params)
+func (c *Client) PostV2ClustersNameNodesNodeIdCordonWithBody(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameNodesNodeIdCordonRequestWithBody(c.Server, name, nodeId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -699,8 +1024,8 @@ func (c *Client) GetV2Templates(ctx context.Context, params *GetV2TemplatesParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2TemplatesWithBody(ctx context.Context, params *PostV2TemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2TemplatesRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) PostV2ClustersNameNodesNodeIdCordon(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, body PostV2ClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameNodesNodeIdCordonRequest(c.Server, name, nodeId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -711,8 +1036,8 @@ func (c *Client) PostV2TemplatesWithBody(ctx context.Context, params *PostV2Temp
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV2Templates(ctx context.Context, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV2TemplatesRequest(c.Server, params, body)
+func (c *Client) GetV2ClustersNameNodesNodeIdLogs(ctx context.Context, name string, nodeId string, params *GetV2ClustersNameNodesNodeIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameNodesNodeIdLogsRequest(c.Server, name, nodeId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -723,8 +1048,8 @@ func (c *Client) PostV2Templates(ctx context.Context, params *PostV2TemplatesPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2TemplatesNameDefaultWithBody(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2TemplatesNameDefaultRequestWithBody(c.Server, name, params, contentType, body)
+func (c *Client) PostV2ClustersNameNodesNodeIdUncordon(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdUncordonParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameNodesNodeIdUncordonRequest(c.Server, name, nodeId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -735,8 +1060,8 @@ func (c *Client) PutV2TemplatesNameDefaultWithBody(ctx context.Context, name str
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutV2TemplatesNameDefault(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutV2TemplatesNameDefaultRequest(c.Server, name, params, body)
+func (c *Client) PostV2ClustersNameRestoreWithBody(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameRestoreRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -747,8 +1072,8 @@ func (c *Client) PutV2TemplatesNameDefault(ctx context.Context, name string, par
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2TemplatesNameVersions(ctx context.Context, name string, params *GetV2TemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2TemplatesNameVersionsRequest(c.Server, name, params)
+func (c *Client) PostV2ClustersNameRestore(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, body PostV2ClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ClustersNameRestoreRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -759,8 +1084,8 @@ func (c *Client) GetV2TemplatesNameVersions(ctx context.Context, name string, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteV2TemplatesNameVersion(ctx context.Context, name string, version string, params *DeleteV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteV2TemplatesNameVersionRequest(c.Server, name, version, params)
+func (c *Client) PutV2ClustersNameTemplateWithBody(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameTemplateRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -771,8 +1096,8 @@ func (c *Client) DeleteV2TemplatesNameVersion(ctx context.Context, name string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetV2TemplatesNameVersion(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV2TemplatesNameVersionRequest(c.Server, name, version, params)
+func (c *Client) PutV2ClustersNameTemplate(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ClustersNameTemplateRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -783,1557 +1108,1417 @@ func (c *Client) GetV2TemplatesNameVersion(ctx context.Context, name string, ver
 	return c.Client.Do(req)
 }
 
-// NewGetV2ClustersRequest generates requests for GetV2Clusters
-func NewGetV2ClustersRequest(server string, params *GetV2ClustersParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ClustersNameUpgrades(ctx context.Context, name string, params *GetV2ClustersNameUpgradesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNameUpgradesRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV2ClustersNodeIdClusterdetail(ctx context.Context, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ClustersNodeIdClusterdetailRequest(c.Server, nodeId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetV2Docs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2DocsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostV2ClustersRequest calls the generic PostV2Clusters builder with application/json body
-func NewPostV2ClustersRequest(server string, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetV2Extensions(ctx context.Context, params *GetV2ExtensionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ExtensionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostV2ClustersRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostV2ClustersRequestWithBody generates requests for PostV2Clusters with any type of body
-func NewPostV2ClustersRequestWithBody(server string, params *PostV2ClustersParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2HealthzRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetV2OpenapiJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2OpenapiJsonRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2ClustersSummaryRequest generates requests for GetV2ClustersSummary
-func NewGetV2ClustersSummaryRequest(server string, params *GetV2ClustersSummaryParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2Operations(ctx context.Context, params *GetV2OperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2OperationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/summary")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV2OperationsId(ctx context.Context, id openapi_types.UUID, params *GetV2OperationsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2OperationsIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetV2PendingClusters(ctx context.Context, params *GetV2PendingClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2PendingClustersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteV2ClustersNameRequest generates requests for DeleteV2ClustersName
-func NewDeleteV2ClustersNameRequest(server string, name string, params *DeleteV2ClustersNameParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) DeleteV2PendingClustersName(ctx context.Context, name string, params *DeleteV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2PendingClustersNameRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2PendingClustersName(ctx context.Context, name string, params *GetV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2PendingClustersNameRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2PendingClustersNameWithBody(ctx context.Context, name string, params *PutV2PendingClustersNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2PendingClustersNameRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) PutV2PendingClustersName(ctx context.Context, name string, params *PutV2PendingClustersNameParams, body PutV2PendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2PendingClustersNameRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2ClustersNameRequest generates requests for GetV2ClustersName
-func NewGetV2ClustersNameRequest(server string, name string, params *GetV2ClustersNameParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) GetV2ProjectsProjectNameAuthzSelf(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameAuthzSelfParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameAuthzSelfRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ProjectsProjectNameClusters(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2ProjectsProjectNameClustersWithBody(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersRequestWithBody(c.Server, projectName, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+func (c *Client) PostV2ProjectsProjectNameClusters(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersRequest(c.Server, projectName, params, body)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetV2ClustersNameKubeconfigsRequest generates requests for GetV2ClustersNameKubeconfigs
-func NewGetV2ClustersNameKubeconfigsRequest(server string, name string, params *GetV2ClustersNameKubeconfigsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) PostV2ProjectsProjectNameClustersImportWithBody(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersImportRequestWithBody(c.Server, projectName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostV2ProjectsProjectNameClustersImport(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersImportRequest(c.Server, projectName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s/kubeconfigs", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV2ProjectsProjectNameClustersNameSuggestion(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameSuggestionRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetV2ProjectsProjectNameClustersSummary(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersSummaryRequest(c.Server, projectName)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
-		var headerParam1 string
-
-		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Authorization", headerParam1)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutV2ClustersNameLabelsRequest calls the generic PutV2ClustersNameLabels builder with application/json body
-func NewPutV2ClustersNameLabelsRequest(server string, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteV2ProjectsProjectNameClustersName(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNameClustersNameRequest(c.Server, projectName, name, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV2ClustersNameLabelsRequestWithBody(server, name, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ClustersNameLabelsRequestWithBody generates requests for PutV2ClustersNameLabels with any type of body
-func NewPutV2ClustersNameLabelsRequestWithBody(server string, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) GetV2ProjectsProjectNameClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ProjectsProjectNameClustersNameBackups(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameBackupsRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s/labels", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2ProjectsProjectNameClustersNameBackups(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameBackupsRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) DeleteV2ProjectsProjectNameClustersNameDeletion(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNameClustersNameDeletionRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutV2ClustersNameNodesRequest calls the generic PutV2ClustersNameNodes builder with application/json body
-func NewPutV2ClustersNameNodesRequest(server string, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetV2ProjectsProjectNameClustersNameEvents(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameEventsRequest(c.Server, projectName, name, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV2ClustersNameNodesRequestWithBody(server, name, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ClustersNameNodesRequestWithBody generates requests for PutV2ClustersNameNodes with any type of body
-func NewPutV2ClustersNameNodesRequestWithBody(server string, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) PutV2ProjectsProjectNameClustersNameExtensionsWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameExtensionsRequestWithBody(c.Server, projectName, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutV2ProjectsProjectNameClustersNameExtensions(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameExtensionsRequest(c.Server, projectName, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s/nodes", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV2ProjectsProjectNameClustersNameHealth(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameHealthRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) DeleteV2ProjectsProjectNameClustersNameKubeconfigs(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNameClustersNameKubeconfigsRequest(c.Server, projectName, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteV2ClustersNameNodesNodeIdRequest generates requests for DeleteV2ClustersNameNodesNodeId
-func NewDeleteV2ClustersNameNodesNodeIdRequest(server string, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) GetV2ProjectsProjectNameClustersNameKubeconfigs(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameKubeconfigsRequest(c.Server, projectName, name, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+func (c *Client) PatchV2ProjectsProjectNameClustersNameLabelsWithBody(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ProjectsProjectNameClustersNameLabelsRequestWithBody(c.Server, projectName, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchV2ProjectsProjectNameClustersNameLabelsWithApplicationMergePatchPlusJSONBody(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, body PatchV2ProjectsProjectNameClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ProjectsProjectNameClustersNameLabelsRequestWithApplicationMergePatchPlusJSONBody(c.Server, projectName, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s/nodes/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2ProjectsProjectNameClustersNameLabelsWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody(c.Server, projectName, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Force != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) PutV2ProjectsProjectNameClustersNameLabels(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameLabelsRequest(c.Server, projectName, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) DeleteV2ProjectsProjectNameClustersNameMaintenance(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNameClustersNameMaintenanceRequest(c.Server, projectName, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutV2ClustersNameTemplateRequest calls the generic PutV2ClustersNameTemplate builder with application/json body
-func NewPutV2ClustersNameTemplateRequest(server string, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutV2ProjectsProjectNameClustersNameMaintenanceWithBody(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameMaintenanceRequestWithBody(c.Server, projectName, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV2ClustersNameTemplateRequestWithBody(server, name, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ClustersNameTemplateRequestWithBody generates requests for PutV2ClustersNameTemplate with any type of body
-func NewPutV2ClustersNameTemplateRequestWithBody(server string, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) PutV2ProjectsProjectNameClustersNameMaintenance(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, body PutV2ProjectsProjectNameClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameMaintenanceRequest(c.Server, projectName, name, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ProjectsProjectNameClustersNameNodepools(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameNodepoolsRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s/template", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2ProjectsProjectNameClustersNameNodepoolsWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameNodepoolsRequestWithBody(c.Server, projectName, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) PostV2ProjectsProjectNameClustersNameNodepools(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameNodepoolsRequest(c.Server, projectName, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2ClustersNodeIdClusterdetailRequest generates requests for GetV2ClustersNodeIdClusterdetail
-func NewGetV2ClustersNodeIdClusterdetailRequest(server string, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+func (c *Client) PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBody(ctx context.Context, projectName ProjectNamePath, name string, poolName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameRequestWithBody(c.Server, projectName, name, poolName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchV2ProjectsProjectNameClustersNameNodepoolsPoolName(ctx context.Context, projectName ProjectNamePath, name string, poolName string, body PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameRequest(c.Server, projectName, name, poolName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/clusters/%s/clusterdetail", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2ProjectsProjectNameClustersNameNodesWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody(c.Server, projectName, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PutV2ProjectsProjectNameClustersNameNodes(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameNodesRequest(c.Server, projectName, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2HealthzRequest generates requests for GetV2Healthz
-func NewGetV2HealthzRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteV2ProjectsProjectNameClustersNameNodesNodeId(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNameClustersNameNodesNodeIdRequest(c.Server, projectName, name, nodeId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/healthz")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBody(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameNodesNodeIdCordonRequestWithBody(c.Server, projectName, name, nodeId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostV2ProjectsProjectNameClustersNameNodesNodeIdCordon(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, body PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameNodesNodeIdCordonRequest(c.Server, projectName, name, nodeId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetV2ProjectsProjectNameClustersRequest generates requests for GetV2ProjectsProjectNameClusters
-func NewGetV2ProjectsProjectNameClustersRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+func (c *Client) PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordon(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonRequest(c.Server, projectName, name, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) PostV2ProjectsProjectNameClustersNameRestoreWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameRestoreRequestWithBody(c.Server, projectName, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostV2ProjectsProjectNameClustersNameRestore(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameClustersNameRestoreRequest(c.Server, projectName, name, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PutV2ProjectsProjectNameClustersNameTemplateWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody(c.Server, projectName, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2ProjectsProjectNameClustersNameTemplate(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameClustersNameTemplateRequest(c.Server, projectName, name, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) GetV2ProjectsProjectNameClustersNameUpgrades(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNameUpgradesRequest(c.Server, projectName, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetV2ProjectsProjectNameClustersNodeIdClusterdetail(ctx context.Context, projectName ProjectNamePath, nodeId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameClustersNodeIdClusterdetailRequest(c.Server, projectName, nodeId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostV2ProjectsProjectNameClustersRequest calls the generic PostV2ProjectsProjectNameClusters builder with application/json body
-func NewPostV2ProjectsProjectNameClustersRequest(server string, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetV2ProjectsProjectNameOperations(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameOperationsRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostV2ProjectsProjectNameClustersRequestWithBody(server, projectName, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostV2ProjectsProjectNameClustersRequestWithBody generates requests for PostV2ProjectsProjectNameClusters with any type of body
-func NewPostV2ProjectsProjectNameClustersRequestWithBody(server string, projectName ProjectNamePath, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) GetV2ProjectsProjectNameOperationsId(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameOperationsIdRequest(c.Server, projectName, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ProjectsProjectNamePendingClusters(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNamePendingClustersRequest(c.Server, projectName)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteV2ProjectsProjectNamePendingClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNamePendingClustersNameRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2ProjectsProjectNameClustersSummaryRequest generates requests for GetV2ProjectsProjectNameClustersSummary
-func NewGetV2ProjectsProjectNameClustersSummaryRequest(server string, projectName ProjectNamePath) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) GetV2ProjectsProjectNamePendingClustersName(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNamePendingClustersNameRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutV2ProjectsProjectNamePendingClustersNameWithBody(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNamePendingClustersNameRequestWithBody(c.Server, projectName, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/summary", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2ProjectsProjectNamePendingClustersName(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNamePendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNamePendingClustersNameRequest(c.Server, projectName, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteV2ProjectsProjectNameClustersNameRequest generates requests for DeleteV2ProjectsProjectNameClustersName
-func NewDeleteV2ProjectsProjectNameClustersNameRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) GetV2ProjectsProjectNameSummary(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameSummaryRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostV2ProjectsProjectNameTemplateUploadsWithBody(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplateUploadsRequestWithBody(c.Server, projectName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2ProjectsProjectNameTemplateUploads(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameTemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplateUploadsRequest(c.Server, projectName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2ProjectsProjectNameClustersNameRequest generates requests for GetV2ProjectsProjectNameClustersName
-func NewGetV2ProjectsProjectNameClustersNameRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) DeleteV2ProjectsProjectNameTemplateUploadsId(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNameTemplateUploadsIdRequest(c.Server, projectName, id)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ProjectsProjectNameTemplateUploadsId(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameTemplateUploadsIdRequest(c.Server, projectName, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBody(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplateUploadsIdChunksRequestWithBody(c.Server, projectName, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2ProjectsProjectNameClustersNameKubeconfigsRequest generates requests for GetV2ProjectsProjectNameClustersNameKubeconfigs
-func NewGetV2ProjectsProjectNameClustersNameKubeconfigsRequest(server string, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) PostV2ProjectsProjectNameTemplateUploadsIdChunks(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, body PostV2ProjectsProjectNameTemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplateUploadsIdChunksRequest(c.Server, projectName, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostV2ProjectsProjectNameTemplateUploadsIdCommit(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplateUploadsIdCommitRequest(c.Server, projectName, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/kubeconfigs", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV2ProjectsProjectNameTemplates(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameTemplatesRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Authorization", headerParam0)
-
+func (c *Client) PostV2ProjectsProjectNameTemplatesWithBody(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplatesRequestWithBody(c.Server, projectName, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameClustersNameLabelsRequest calls the generic PutV2ProjectsProjectNameClustersNameLabels builder with application/json body
-func NewPutV2ProjectsProjectNameClustersNameLabelsRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostV2ProjectsProjectNameTemplates(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplatesRequest(c.Server, projectName, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody(server, projectName, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameLabels with any type of body
-func NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) GetV2ProjectsProjectNameTemplatesCompatibility(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameTemplatesCompatibilityRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutV2ProjectsProjectNameTemplatesNameDefaultWithBody(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody(c.Server, projectName, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/labels", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2ProjectsProjectNameTemplatesNameDefault(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameTemplatesNameDefaultRequest(c.Server, projectName, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameClustersNameNodesRequest calls the generic PutV2ProjectsProjectNameClustersNameNodes builder with application/json body
-func NewPutV2ProjectsProjectNameClustersNameNodesRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersions(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest(c.Server, projectName, name, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody(server, projectName, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameNodes with any type of body
-func NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) DeleteV2ProjectsProjectNameTemplatesNameVersion(ctx context.Context, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest(c.Server, projectName, name, version, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersion(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameTemplatesNameVersionRequest(c.Server, projectName, name, version, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodes", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2ProjectsProjectNameTemplatesNameVersionWithBody(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameTemplatesNameVersionRequestWithBody(c.Server, projectName, name, version, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteV2ProjectsProjectNameClustersNameNodesNodeIdRequest generates requests for DeleteV2ProjectsProjectNameClustersNameNodesNodeId
-func NewDeleteV2ProjectsProjectNameClustersNameNodesNodeIdRequest(server string, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) PutV2ProjectsProjectNameTemplatesNameVersion(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, body PutV2ProjectsProjectNameTemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2ProjectsProjectNameTemplatesNameVersionRequest(c.Server, projectName, name, version, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBody(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewRequestWithBody(c.Server, projectName, name, version, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+func (c *Client) PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreview(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, body PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewRequest(c.Server, projectName, name, version, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersionClusters(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameTemplatesNameVersionClustersRequest(c.Server, projectName, name, version, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodes/%s", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2ProjectsProjectNameTemplatesNameVersionDeprecate(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplatesNameVersionDeprecateRequest(c.Server, projectName, name, version, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Force != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetV2ProjectsProjectNameTemplatesNameVersionExport(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameTemplatesNameVersionExportRequest(c.Server, projectName, name, version, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameClustersNameTemplateRequest calls the generic PutV2ProjectsProjectNameClustersNameTemplate builder with application/json body
-func NewPutV2ProjectsProjectNameClustersNameTemplateRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostV2ProjectsProjectNameTemplatesNameVersionPublish(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2ProjectsProjectNameTemplatesNameVersionPublishRequest(c.Server, projectName, name, version, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody(server, projectName, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameTemplate with any type of body
-func NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) GetV2ProjectsProjectNameWebhooksDestinations(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ProjectsProjectNameWebhooksDestinationsRequest(c.Server, projectName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) GetV2Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2ReadyzRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2Summary(ctx context.Context, params *GetV2SummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2SummaryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/template", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV2Supportmatrix(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2SupportmatrixRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) PostV2TemplateUploadsWithBody(ctx context.Context, params *PostV2TemplateUploadsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplateUploadsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetV2ProjectsProjectNameClustersNodeIdClusterdetailRequest generates requests for GetV2ProjectsProjectNameClustersNodeIdClusterdetail
-func NewGetV2ProjectsProjectNameClustersNodeIdClusterdetailRequest(server string, projectName ProjectNamePath, nodeId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) PostV2TemplateUploads(ctx context.Context, params *PostV2TemplateUploadsParams, body PostV2TemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplateUploadsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteV2TemplateUploadsId(ctx context.Context, id openapi_types.UUID, params *DeleteV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2TemplateUploadsIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/clusterdetail", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV2TemplateUploadsId(ctx context.Context, id openapi_types.UUID, params *GetV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2TemplateUploadsIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetV2ProjectsProjectNameTemplatesRequest generates requests for GetV2ProjectsProjectNameTemplates
-func NewGetV2ProjectsProjectNameTemplatesRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) PostV2TemplateUploadsIdChunksWithBody(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplateUploadsIdChunksRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostV2TemplateUploadsIdChunks(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, body PostV2TemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplateUploadsIdChunksRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/templates", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostV2TemplateUploadsIdCommit(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdCommitParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplateUploadsIdCommitRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Default != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "default", runtime.ParamLocationQuery, *params.Default); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetV2Templates(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2TemplatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostV2ProjectsProjectNameTemplatesRequest calls the generic PostV2ProjectsProjectNameTemplates builder with application/json body
-func NewPostV2ProjectsProjectNameTemplatesRequest(server string, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostV2TemplatesWithBody(ctx context.Context, params *PostV2TemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplatesRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostV2ProjectsProjectNameTemplatesRequestWithBody(server, projectName, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostV2ProjectsProjectNameTemplatesRequestWithBody generates requests for PostV2ProjectsProjectNameTemplates with any type of body
-func NewPostV2ProjectsProjectNameTemplatesRequestWithBody(server string, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) PostV2Templates(ctx context.Context, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplatesRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV2TemplatesCompatibility(ctx context.Context, params *GetV2TemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2TemplatesCompatibilityRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/templates", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2TemplatesNameDefaultWithBody(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2TemplatesNameDefaultRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PutV2TemplatesNameDefault(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2TemplatesNameDefaultRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameTemplatesNameDefaultRequest calls the generic PutV2ProjectsProjectNameTemplatesNameDefault builder with application/json body
-func NewPutV2ProjectsProjectNameTemplatesNameDefaultRequest(server string, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetV2TemplatesNameVersions(ctx context.Context, name string, params *GetV2TemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2TemplatesNameVersionsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody(server, projectName, name, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody generates requests for PutV2ProjectsProjectNameTemplatesNameDefault with any type of body
-func NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody(server string, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) DeleteV2TemplatesNameVersion(ctx context.Context, name string, version string, params *DeleteV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV2TemplatesNameVersionRequest(c.Server, name, version, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) GetV2TemplatesNameVersion(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2TemplatesNameVersionRequest(c.Server, name, version, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutV2TemplatesNameVersionWithBody(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2TemplatesNameVersionRequestWithBody(c.Server, name, version, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/default", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutV2TemplatesNameVersion(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, body PutV2TemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV2TemplatesNameVersionRequest(c.Server, name, version, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) PostV2TemplatesNameVersionClusterlabelsPreviewWithBody(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplatesNameVersionClusterlabelsPreviewRequestWithBody(c.Server, name, version, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
+func (c *Client) PostV2TemplatesNameVersionClusterlabelsPreview(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, body PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplatesNameVersionClusterlabelsPreviewRequest(c.Server, name, version, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) GetV2TemplatesNameVersionClusters(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2TemplatesNameVersionClustersRequest(c.Server, name, version, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PostV2TemplatesNameVersionDeprecate(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplatesNameVersionDeprecateRequest(c.Server, name, version, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest generates requests for GetV2ProjectsProjectNameTemplatesNameVersions
-func NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest(server string, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams) (*http.Request, error) {
-	var err error
+func (c *Client) GetV2TemplatesNameVersionExport(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2TemplatesNameVersionExportRequest(c.Server, name, version, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PostV2TemplatesNameVersionPublish(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV2TemplatesNameVersionPublishRequest(c.Server, name, version, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *Client) GetV2WebhooksDestinations(ctx context.Context, params *GetV2WebhooksDestinationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV2WebhooksDestinationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+// NewGetV2AdminExportsProjectIdRequest generates requests for GetV2AdminExportsProjectId
+func NewGetV2AdminExportsProjectIdRequest(server string, projectId openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
 	if err != nil {
 		return nil, err
 	}
@@ -2343,7 +2528,7 @@ func NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest(server string, proj
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/versions", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v2/admin/exports/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2358,29 +2543,16 @@ func NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest(server string, proj
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest generates requests for DeleteV2ProjectsProjectNameTemplatesNameVersion
-func NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest(server string, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams) (*http.Request, error) {
+// NewGetV2AdminSupportBundlesProjectIdClustersNameRequest generates requests for GetV2AdminSupportBundlesProjectIdClustersName
+func NewGetV2AdminSupportBundlesProjectIdClustersNameRequest(server string, projectId openapi_types.UUID, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
 	if err != nil {
 		return nil, err
 	}
@@ -2392,19 +2564,12 @@ func NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest(server string, pr
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v2/admin/support-bundles/%s/clusters/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2414,58 +2579,51 @@ func NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest(server string, pr
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Activeprojectid", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewGetV2ProjectsProjectNameTemplatesNameVersionRequest generates requests for GetV2ProjectsProjectNameTemplatesNameVersion
-func NewGetV2ProjectsProjectNameTemplatesNameVersionRequest(server string, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams) (*http.Request, error) {
+// NewGetV2ApichangelogRequest generates requests for GetV2Apichangelog
+func NewGetV2ApichangelogRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/v2/apichangelog")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewGetV2AuthzSelfRequest generates requests for GetV2AuthzSelf
+func NewGetV2AuthzSelfRequest(server string, params *GetV2AuthzSelfParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/v2/authz/self")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2491,13 +2649,24 @@ func NewGetV2ProjectsProjectNameTemplatesNameVersionRequest(server string, proje
 
 		req.Header.Set("Activeprojectid", headerParam0)
 
+		if params.Authorization != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam1)
+		}
+
 	}
 
 	return req, nil
 }
 
-// NewGetV2TemplatesRequest generates requests for GetV2Templates
-func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*http.Request, error) {
+// NewGetV2ClustersRequest generates requests for GetV2Clusters
+func NewGetV2ClustersRequest(server string, params *GetV2ClustersParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2505,7 +2674,7 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates")
+	operationPath := fmt.Sprintf("/v2/clusters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2518,9 +2687,9 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Default != nil {
+		if params.PageSize != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "default", runtime.ParamLocationQuery, *params.Default); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2534,9 +2703,9 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 
 		}
 
-		if params.PageSize != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2550,9 +2719,9 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 
 		}
 
-		if params.Offset != nil {
+		if params.PageToken != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2622,19 +2791,19 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 	return req, nil
 }
 
-// NewPostV2TemplatesRequest calls the generic PostV2Templates builder with application/json body
-func NewPostV2TemplatesRequest(server string, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody) (*http.Request, error) {
+// NewPostV2ClustersRequest calls the generic PostV2Clusters builder with application/json body
+func NewPostV2ClustersRequest(server string, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostV2TemplatesRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPostV2ClustersRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPostV2TemplatesRequestWithBody generates requests for PostV2Templates with any type of body
-func NewPostV2TemplatesRequestWithBody(server string, params *PostV2TemplatesParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostV2ClustersRequestWithBody generates requests for PostV2Clusters with any type of body
+func NewPostV2ClustersRequestWithBody(server string, params *PostV2ClustersParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2642,7 +2811,7 @@ func NewPostV2TemplatesRequestWithBody(server string, params *PostV2TemplatesPar
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates")
+	operationPath := fmt.Sprintf("/v2/clusters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2652,6 +2821,28 @@ func NewPostV2TemplatesRequestWithBody(server string, params *PostV2TemplatesPar
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -2661,48 +2852,52 @@ func NewPostV2TemplatesRequestWithBody(server string, params *PostV2TemplatesPar
 
 	if params != nil {
 
-		var headerParam0 string
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
 		if err != nil {
 			return nil, err
 		}
 
-		req.Header.Set("Activeprojectid", headerParam0)
+		req.Header.Set("Activeprojectid", headerParam1)
 
 	}
 
 	return req, nil
 }
 
-// NewPutV2TemplatesNameDefaultRequest calls the generic PutV2TemplatesNameDefault builder with application/json body
-func NewPutV2TemplatesNameDefaultRequest(server string, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody) (*http.Request, error) {
+// NewPostV2ClustersImportRequest calls the generic PostV2ClustersImport builder with application/json body
+func NewPostV2ClustersImportRequest(server string, params *PostV2ClustersImportParams, body PostV2ClustersImportJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutV2TemplatesNameDefaultRequestWithBody(server, name, params, "application/json", bodyReader)
+	return NewPostV2ClustersImportRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPutV2TemplatesNameDefaultRequestWithBody generates requests for PutV2TemplatesNameDefault with any type of body
-func NewPutV2TemplatesNameDefaultRequestWithBody(server string, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostV2ClustersImportRequestWithBody generates requests for PostV2ClustersImport with any type of body
+func NewPostV2ClustersImportRequestWithBody(server string, params *PostV2ClustersImportParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates/%s/default", pathParam0)
+	operationPath := fmt.Sprintf("/v2/clusters/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2712,7 +2907,7 @@ func NewPutV2TemplatesNameDefaultRequestWithBody(server string, name string, par
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2735,23 +2930,78 @@ func NewPutV2TemplatesNameDefaultRequestWithBody(server string, name string, par
 	return req, nil
 }
 
-// NewGetV2TemplatesNameVersionsRequest generates requests for GetV2TemplatesNameVersions
-func NewGetV2TemplatesNameVersionsRequest(server string, name string, params *GetV2TemplatesNameVersionsParams) (*http.Request, error) {
+// NewGetV2ClustersNameSuggestionRequest generates requests for GetV2ClustersNameSuggestion
+func NewGetV2ClustersNameSuggestionRequest(server string, params *GetV2ClustersNameSuggestionParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	operationPath := fmt.Sprintf("/v2/clusters/name-suggestion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Base != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, *params.Base); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ClustersSummaryRequest generates requests for GetV2ClustersSummary
+func NewGetV2ClustersSummaryRequest(server string, params *GetV2ClustersSummaryParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates/%s/versions", pathParam0)
+	operationPath := fmt.Sprintf("/v2/clusters/summary")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2782,8 +3032,8 @@ func NewGetV2TemplatesNameVersionsRequest(server string, name string, params *Ge
 	return req, nil
 }
 
-// NewDeleteV2TemplatesNameVersionRequest generates requests for DeleteV2TemplatesNameVersion
-func NewDeleteV2TemplatesNameVersionRequest(server string, name string, version string, params *DeleteV2TemplatesNameVersionParams) (*http.Request, error) {
+// NewDeleteV2ClustersNameRequest generates requests for DeleteV2ClustersName
+func NewDeleteV2ClustersNameRequest(server string, name string, params *DeleteV2ClustersNameParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2793,19 +3043,12 @@ func NewDeleteV2TemplatesNameVersionRequest(server string, name string, version
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v2/clusters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2815,6 +3058,60 @@ func NewDeleteV2TemplatesNameVersionRequest(server string, name string, version
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ForceFinalize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "forceFinalize", runtime.ParamLocationQuery, *params.ForceFinalize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Purge != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purge", runtime.ParamLocationQuery, *params.Purge); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2836,8 +3133,8 @@ func NewDeleteV2TemplatesNameVersionRequest(server string, name string, version
 	return req, nil
 }
 
-// NewGetV2TemplatesNameVersionRequest generates requests for GetV2TemplatesNameVersion
-func NewGetV2TemplatesNameVersionRequest(server string, name string, version string, params *GetV2TemplatesNameVersionParams) (*http.Request, error) {
+// NewGetV2ClustersNameRequest generates requests for GetV2ClustersName
+func NewGetV2ClustersNameRequest(server string, name string, params *GetV2ClustersNameParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2847,19 +3144,12 @@ func NewGetV2TemplatesNameVersionRequest(server string, name string, version str
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v2/clusters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2890,1479 +3180,15974 @@ func NewGetV2TemplatesNameVersionRequest(server string, name string, version str
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetV2ClustersNameBackupsRequest generates requests for GetV2ClustersNameBackups
+func NewGetV2ClustersNameBackupsRequest(server string, name string, params *GetV2ClustersNameBackupsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/v2/clusters/%s/backups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetV2ClustersWithResponse request
-	GetV2ClustersWithResponse(ctx context.Context, params *GetV2ClustersParams, reqEditors ...RequestEditorFn) (*GetV2ClustersResponse, error)
+	if params != nil {
 
-	// PostV2ClustersWithBodyWithResponse request with any body
-	PostV2ClustersWithBodyWithResponse(ctx context.Context, params *PostV2ClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error)
+		var headerParam0 string
 
-	PostV2ClustersWithResponse(ctx context.Context, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
 
-	// GetV2ClustersSummaryWithResponse request
-	GetV2ClustersSummaryWithResponse(ctx context.Context, params *GetV2ClustersSummaryParams, reqEditors ...RequestEditorFn) (*GetV2ClustersSummaryResponse, error)
+		req.Header.Set("Activeprojectid", headerParam0)
 
-	// DeleteV2ClustersNameWithResponse request
-	DeleteV2ClustersNameWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameResponse, error)
+	}
 
-	// GetV2ClustersNameWithResponse request
-	GetV2ClustersNameWithResponse(ctx context.Context, name string, params *GetV2ClustersNameParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameResponse, error)
+	return req, nil
+}
 
-	// GetV2ClustersNameKubeconfigsWithResponse request
-	GetV2ClustersNameKubeconfigsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameKubeconfigsResponse, error)
+// NewPostV2ClustersNameBackupsRequest generates requests for PostV2ClustersNameBackups
+func NewPostV2ClustersNameBackupsRequest(server string, name string, params *PostV2ClustersNameBackupsParams) (*http.Request, error) {
+	var err error
 
-	// PutV2ClustersNameLabelsWithBodyWithResponse request with any body
-	PutV2ClustersNameLabelsWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error)
+	var pathParam0 string
 
-	PutV2ClustersNameLabelsWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutV2ClustersNameNodesWithBodyWithResponse request with any body
-	PutV2ClustersNameNodesWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PutV2ClustersNameNodesWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error)
+	operationPath := fmt.Sprintf("/v2/clusters/%s/backups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteV2ClustersNameNodesNodeIdWithResponse request
-	DeleteV2ClustersNameNodesNodeIdWithResponse(ctx context.Context, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameNodesNodeIdResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutV2ClustersNameTemplateWithBodyWithResponse request with any body
-	PutV2ClustersNameTemplateWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PutV2ClustersNameTemplateWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error)
+	if params != nil {
 
-	// GetV2ClustersNodeIdClusterdetailWithResponse request
-	GetV2ClustersNodeIdClusterdetailWithResponse(ctx context.Context, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNodeIdClusterdetailResponse, error)
+		var headerParam0 string
 
-	// GetV2HealthzWithResponse request
-	GetV2HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2HealthzResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
 
-	// GetV2ProjectsProjectNameClustersWithResponse request
-	GetV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersResponse, error)
+		req.Header.Set("Activeprojectid", headerParam0)
 
-	// PostV2ProjectsProjectNameClustersWithBodyWithResponse request with any body
-	PostV2ProjectsProjectNameClustersWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error)
+	}
 
-	PostV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error)
+	return req, nil
+}
 
-	// GetV2ProjectsProjectNameClustersSummaryWithResponse request
-	GetV2ProjectsProjectNameClustersSummaryWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersSummaryResponse, error)
+// NewPostV2ClustersNameCloneRequest calls the generic PostV2ClustersNameClone builder with application/json body
+func NewPostV2ClustersNameCloneRequest(server string, name string, params *PostV2ClustersNameCloneParams, body PostV2ClustersNameCloneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ClustersNameCloneRequestWithBody(server, name, params, "application/json", bodyReader)
+}
 
-	// DeleteV2ProjectsProjectNameClustersNameWithResponse request
-	DeleteV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameResponse, error)
+// NewPostV2ClustersNameCloneRequestWithBody generates requests for PostV2ClustersNameClone with any type of body
+func NewPostV2ClustersNameCloneRequestWithBody(server string, name string, params *PostV2ClustersNameCloneParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetV2ProjectsProjectNameClustersNameWithResponse request
-	GetV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameResponse, error)
+	var pathParam0 string
 
-	// GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse request
-	GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameKubeconfigsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse request with any body
-	PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PutV2ProjectsProjectNameClustersNameLabelsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error)
+	operationPath := fmt.Sprintf("/v2/clusters/%s/clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse request with any body
-	PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutV2ProjectsProjectNameClustersNameNodesWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse request
-	DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse request with any body
-	PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error)
+	if params != nil {
 
-	PutV2ProjectsProjectNameClustersNameTemplateWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error)
+		var headerParam0 string
 
-	// GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse request
-	GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse(ctx context.Context, projectName ProjectNamePath, nodeId string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
 
-	// GetV2ProjectsProjectNameTemplatesWithResponse request
-	GetV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesResponse, error)
+		req.Header.Set("Activeprojectid", headerParam0)
 
-	// PostV2ProjectsProjectNameTemplatesWithBodyWithResponse request with any body
-	PostV2ProjectsProjectNameTemplatesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error)
+	}
 
-	PostV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error)
+	return req, nil
+}
 
-	// PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse request with any body
-	PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error)
+// NewDeleteV2ClustersNameDeletionRequest generates requests for DeleteV2ClustersNameDeletion
+func NewDeleteV2ClustersNameDeletionRequest(server string, name string, params *DeleteV2ClustersNameDeletionParams) (*http.Request, error) {
+	var err error
 
-	PutV2ProjectsProjectNameTemplatesNameDefaultWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error)
+	var pathParam0 string
 
-	// GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse request
-	GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse request
-	DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameTemplatesNameVersionResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetV2ProjectsProjectNameTemplatesNameVersionWithResponse request
-	GetV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionResponse, error)
+	operationPath := fmt.Sprintf("/v2/clusters/%s/deletion", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetV2TemplatesWithResponse request
-	GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostV2TemplatesWithBodyWithResponse request with any body
-	PostV2TemplatesWithBodyWithResponse(ctx context.Context, params *PostV2TemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostV2TemplatesWithResponse(ctx context.Context, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+	if params != nil {
 
-	// PutV2TemplatesNameDefaultWithBodyWithResponse request with any body
-	PutV2TemplatesNameDefaultWithBodyWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error)
+		var headerParam0 string
 
-	PutV2TemplatesNameDefaultWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
 
-	// GetV2TemplatesNameVersionsWithResponse request
-	GetV2TemplatesNameVersionsWithResponse(ctx context.Context, name string, params *GetV2TemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionsResponse, error)
+		req.Header.Set("Activeprojectid", headerParam0)
 
-	// DeleteV2TemplatesNameVersionWithResponse request
-	DeleteV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *DeleteV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2TemplatesNameVersionResponse, error)
+	}
 
-	// GetV2TemplatesNameVersionWithResponse request
-	GetV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionResponse, error)
+	return req, nil
 }
 
-type GetV2ClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+// NewGetV2ClustersNameEventsRequest generates requests for GetV2ClustersNameEvents
+func NewGetV2ClustersNameEventsRequest(server string, name string, params *GetV2ClustersNameEventsParams) (*http.Request, error) {
+	var err error
 
-		// TotalElements The count of items in the entire list, regardless of pagination.
-		TotalElements int32 `json:"totalElements"`
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *N400BadRequest
-	JSON500 *N500InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r GetV2ClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v2/clusters/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostV2ClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *string
-	JSON400      *N400BadRequest
-	JSON500      *N500InternalServerError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostV2ClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostV2ClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2ClustersSummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClusterSummary
-	JSON400      *N400BadRequest
-	JSON500      *N500InternalServerError
-}
+	if params != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetV2ClustersSummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ClustersSummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteV2ClustersNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteV2ClustersNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPutV2ClustersNameExtensionsRequest calls the generic PutV2ClustersNameExtensions builder with application/json body
+func NewPutV2ClustersNameExtensionsRequest(server string, name string, params *PutV2ClustersNameExtensionsParams, body PutV2ClustersNameExtensionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ClustersNameExtensionsRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV2ClustersNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPutV2ClustersNameExtensionsRequestWithBody generates requests for PutV2ClustersNameExtensions with any type of body
+func NewPutV2ClustersNameExtensionsRequestWithBody(server string, name string, params *PutV2ClustersNameExtensionsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetV2ClustersNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClusterDetailInfo
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetV2ClustersNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ClustersNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2ClustersNameKubeconfigsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubeconfigInfo
-	JSON400      *N400BadRequest
-	JSON401      *N401Unauthorized
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	operationPath := fmt.Sprintf("/v2/clusters/%s/extensions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetV2ClustersNameKubeconfigsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ClustersNameKubeconfigsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutV2ClustersNameLabelsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PutV2ClustersNameLabelsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2ClustersNameLabelsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		var headerParam0 string
 
-type PutV2ClustersNameNodesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-	JSON501      *N501NotImplemented
-}
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
 
-// Status returns HTTPResponse.Status
-func (r PutV2ClustersNameNodesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		req.Header.Set("Activeprojectid", headerParam0)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2ClustersNameNodesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteV2ClustersNameNodesNodeIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteV2ClustersNameNodesNodeIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetV2ClustersNameHealthRequest generates requests for GetV2ClustersNameHealth
+func NewGetV2ClustersNameHealthRequest(server string, name string, params *GetV2ClustersNameHealthParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV2ClustersNameNodesNodeIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutV2ClustersNameTemplateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-	JSON501      *N501NotImplemented
-}
+	operationPath := fmt.Sprintf("/v2/clusters/%s/health", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutV2ClustersNameTemplateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2ClustersNameTemplateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2ClustersNodeIdClusterdetailResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClusterDetailInfo
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-}
+	if params != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetV2ClustersNodeIdClusterdetailResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		var headerParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ClustersNodeIdClusterdetailResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
 
-type GetV2HealthzResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *string
-	JSON500      *N500InternalServerError
-}
+		req.Header.Set("Activeprojectid", headerParam0)
 
-// Status returns HTTPResponse.Status
-func (r GetV2HealthzResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2HealthzResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type GetV2ProjectsProjectNameClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+// NewDeleteV2ClustersNameKubeconfigsRequest generates requests for DeleteV2ClustersNameKubeconfigs
+func NewDeleteV2ClustersNameKubeconfigsRequest(server string, name string, params *DeleteV2ClustersNameKubeconfigsParams) (*http.Request, error) {
+	var err error
 
-		// TotalElements The count of items in the entire list, regardless of pagination.
-		TotalElements int32 `json:"totalElements"`
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *N400BadRequest
-	JSON500 *N500InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v2/clusters/%s/kubeconfigs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostV2ProjectsProjectNameClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *string
-	JSON400      *N400BadRequest
-	JSON500      *N500InternalServerError
-}
-
-// Status returns HTTPResponse.Status
-func (r PostV2ProjectsProjectNameClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostV2ProjectsProjectNameClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2ProjectsProjectNameClustersSummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClusterSummary
-	JSON400      *N400BadRequest
-	JSON500      *N500InternalServerError
-}
+	if params != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameClustersSummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		var headerParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameClustersSummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
 
-type DeleteV2ProjectsProjectNameClustersNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+		req.Header.Set("Activeprojectid", headerParam0)
 
-// Status returns HTTPResponse.Status
-func (r DeleteV2ProjectsProjectNameClustersNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		var headerParam1 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV2ProjectsProjectNameClustersNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
 
-type GetV2ProjectsProjectNameClustersNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClusterDetailInfo
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+		req.Header.Set("Authorization", headerParam1)
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameClustersNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameClustersNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type GetV2ProjectsProjectNameClustersNameKubeconfigsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubeconfigInfo
-	JSON400      *N400BadRequest
-	JSON401      *N401Unauthorized
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+// NewGetV2ClustersNameKubeconfigsRequest generates requests for GetV2ClustersNameKubeconfigs
+func NewGetV2ClustersNameKubeconfigsRequest(server string, name string, params *GetV2ClustersNameKubeconfigsParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameClustersNameKubeconfigsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameClustersNameKubeconfigsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutV2ProjectsProjectNameClustersNameLabelsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutV2ProjectsProjectNameClustersNameLabelsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v2/clusters/%s/kubeconfigs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2ProjectsProjectNameClustersNameLabelsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutV2ProjectsProjectNameClustersNameNodesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r PutV2ProjectsProjectNameClustersNameNodesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.AuthType != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2ProjectsProjectNameClustersNameNodesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "authType", runtime.ParamLocationQuery, *params.AuthType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutV2ProjectsProjectNameClustersNameTemplateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-	JSON501      *N501NotImplemented
-}
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam1)
 
-// Status returns HTTPResponse.Status
-func (r PutV2ProjectsProjectNameClustersNameTemplateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2ProjectsProjectNameClustersNameTemplateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchV2ClustersNameLabelsRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchV2ClustersNameLabels builder with application/merge-patch+json body
+func NewPatchV2ClustersNameLabelsRequestWithApplicationMergePatchPlusJSONBody(server string, name string, params *PatchV2ClustersNameLabelsParams, body PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV2ClustersNameLabelsRequestWithBody(server, name, params, "application/merge-patch+json", bodyReader)
 }
 
-type GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClusterDetailInfo
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-}
+// NewPatchV2ClustersNameLabelsRequestWithBody generates requests for PatchV2ClustersNameLabels with any type of body
+func NewPatchV2ClustersNameLabelsRequestWithBody(server string, name string, params *PatchV2ClustersNameLabelsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2ProjectsProjectNameTemplatesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TemplateInfoList
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	operationPath := fmt.Sprintf("/v2/clusters/%s/labels", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameTemplatesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameTemplatesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostV2ProjectsProjectNameTemplatesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *string
-	JSON400      *N400BadRequest
-	JSON409      *N409Conflict
-	JSON500      *N500InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam1)
 
-// Status returns HTTPResponse.Status
-func (r PostV2ProjectsProjectNameTemplatesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostV2ProjectsProjectNameTemplatesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPutV2ClustersNameLabelsRequest calls the generic PutV2ClustersNameLabels builder with application/json body
+func NewPutV2ClustersNameLabelsRequest(server string, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ClustersNameLabelsRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-type PutV2ProjectsProjectNameTemplatesNameDefaultResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+// NewPutV2ClustersNameLabelsRequestWithBody generates requests for PutV2ClustersNameLabels with any type of body
+func NewPutV2ClustersNameLabelsRequestWithBody(server string, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PutV2ProjectsProjectNameTemplatesNameDefaultResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2ProjectsProjectNameTemplatesNameDefaultResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2ProjectsProjectNameTemplatesNameVersionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VersionList
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	operationPath := fmt.Sprintf("/v2/clusters/%s/labels", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameTemplatesNameVersionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameTemplatesNameVersionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteV2ProjectsProjectNameTemplatesNameVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON409      *N409Conflict
-	JSON500      *N500InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteV2ProjectsProjectNameTemplatesNameVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV2ProjectsProjectNameTemplatesNameVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetV2ProjectsProjectNameTemplatesNameVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TemplateInfo
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV2ProjectsProjectNameTemplatesNameVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteV2ClustersNameMaintenanceRequest generates requests for DeleteV2ClustersNameMaintenance
+func NewDeleteV2ClustersNameMaintenanceRequest(server string, name string, params *DeleteV2ClustersNameMaintenanceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2ProjectsProjectNameTemplatesNameVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2TemplatesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TemplateInfoList
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	operationPath := fmt.Sprintf("/v2/clusters/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetV2TemplatesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2TemplatesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostV2TemplatesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *string
-	JSON400      *N400BadRequest
-	JSON409      *N409Conflict
-	JSON500      *N500InternalServerError
-}
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		if params.Authorization != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam1)
+		}
 
-// Status returns HTTPResponse.Status
-func (r PostV2TemplatesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostV2TemplatesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPutV2ClustersNameMaintenanceRequest calls the generic PutV2ClustersNameMaintenance builder with application/json body
+func NewPutV2ClustersNameMaintenanceRequest(server string, name string, params *PutV2ClustersNameMaintenanceParams, body PutV2ClustersNameMaintenanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ClustersNameMaintenanceRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-type PutV2TemplatesNameDefaultResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+// NewPutV2ClustersNameMaintenanceRequestWithBody generates requests for PutV2ClustersNameMaintenance with any type of body
+func NewPutV2ClustersNameMaintenanceRequestWithBody(server string, name string, params *PutV2ClustersNameMaintenanceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PutV2TemplatesNameDefaultResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutV2TemplatesNameDefaultResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetV2TemplatesNameVersionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VersionList
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
-}
+	operationPath := fmt.Sprintf("/v2/clusters/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetV2TemplatesNameVersionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2TemplatesNameVersionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteV2TemplatesNameVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON409      *N409Conflict
-	JSON500      *N500InternalServerError
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		if params.Authorization != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam1)
+		}
+
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteV2TemplatesNameVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetV2ClustersNameNodepoolsRequest generates requests for GetV2ClustersNameNodepools
+func NewGetV2ClustersNameNodepoolsRequest(server string, name string, params *GetV2ClustersNameNodepoolsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodepools", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteV2TemplatesNameVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostV2ClustersNameNodepoolsRequest calls the generic PostV2ClustersNameNodepools builder with application/json body
+func NewPostV2ClustersNameNodepoolsRequest(server string, name string, params *PostV2ClustersNameNodepoolsParams, body PostV2ClustersNameNodepoolsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ClustersNameNodepoolsRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-type GetV2TemplatesNameVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TemplateInfo
-	JSON400      *N400BadRequest
-	JSON404      *N404NotFound
-	JSON500      *N500InternalServerError
+// NewPostV2ClustersNameNodepoolsRequestWithBody generates requests for PostV2ClustersNameNodepools with any type of body
+func NewPostV2ClustersNameNodepoolsRequestWithBody(server string, name string, params *PostV2ClustersNameNodepoolsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodepools", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetV2TemplatesNameVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPatchV2ClustersNameNodepoolsPoolNameRequest calls the generic PatchV2ClustersNameNodepoolsPoolName builder with application/json body
+func NewPatchV2ClustersNameNodepoolsPoolNameRequest(server string, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, body PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV2ClustersNameNodepoolsPoolNameRequestWithBody(server, name, poolName, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV2TemplatesNameVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchV2ClustersNameNodepoolsPoolNameRequestWithBody generates requests for PatchV2ClustersNameNodepoolsPoolName with any type of body
+func NewPatchV2ClustersNameNodepoolsPoolNameRequestWithBody(server string, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "poolName", runtime.ParamLocationPath, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodepools/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2ClustersNameNodesRequest calls the generic PutV2ClustersNameNodes builder with application/json body
+func NewPutV2ClustersNameNodesRequest(server string, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ClustersNameNodesRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPutV2ClustersNameNodesRequestWithBody generates requests for PutV2ClustersNameNodes with any type of body
+func NewPutV2ClustersNameNodesRequestWithBody(server string, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2ClustersNameNodesNodeIdRequest generates requests for DeleteV2ClustersNameNodesNodeId
+func NewDeleteV2ClustersNameNodesNodeIdRequest(server string, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ClustersNameNodesNodeIdCordonRequest calls the generic PostV2ClustersNameNodesNodeIdCordon builder with application/json body
+func NewPostV2ClustersNameNodesNodeIdCordonRequest(server string, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, body PostV2ClustersNameNodesNodeIdCordonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ClustersNameNodesNodeIdCordonRequestWithBody(server, name, nodeId, params, "application/json", bodyReader)
+}
+
+// NewPostV2ClustersNameNodesNodeIdCordonRequestWithBody generates requests for PostV2ClustersNameNodesNodeIdCordon with any type of body
+func NewPostV2ClustersNameNodesNodeIdCordonRequestWithBody(server string, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodes/%s/cordon", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ClustersNameNodesNodeIdLogsRequest generates requests for GetV2ClustersNameNodesNodeIdLogs
+func NewGetV2ClustersNameNodesNodeIdLogsRequest(server string, name string, nodeId string, params *GetV2ClustersNameNodesNodeIdLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodes/%s/logs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TailLines != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tailLines", runtime.ParamLocationQuery, *params.TailLines); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ClustersNameNodesNodeIdUncordonRequest generates requests for PostV2ClustersNameNodesNodeIdUncordon
+func NewPostV2ClustersNameNodesNodeIdUncordonRequest(server string, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdUncordonParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/nodes/%s/uncordon", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ClustersNameRestoreRequest calls the generic PostV2ClustersNameRestore builder with application/json body
+func NewPostV2ClustersNameRestoreRequest(server string, name string, params *PostV2ClustersNameRestoreParams, body PostV2ClustersNameRestoreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ClustersNameRestoreRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPostV2ClustersNameRestoreRequestWithBody generates requests for PostV2ClustersNameRestore with any type of body
+func NewPostV2ClustersNameRestoreRequestWithBody(server string, name string, params *PostV2ClustersNameRestoreParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2ClustersNameTemplateRequest calls the generic PutV2ClustersNameTemplate builder with application/json body
+func NewPutV2ClustersNameTemplateRequest(server string, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ClustersNameTemplateRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPutV2ClustersNameTemplateRequestWithBody generates requests for PutV2ClustersNameTemplate with any type of body
+func NewPutV2ClustersNameTemplateRequestWithBody(server string, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/template", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ClustersNameUpgradesRequest generates requests for GetV2ClustersNameUpgrades
+func NewGetV2ClustersNameUpgradesRequest(server string, name string, params *GetV2ClustersNameUpgradesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/upgrades", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ClustersNodeIdClusterdetailRequest generates requests for GetV2ClustersNodeIdClusterdetail
+func NewGetV2ClustersNodeIdClusterdetailRequest(server string, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/clusters/%s/clusterdetail", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2DocsRequest generates requests for GetV2Docs
+func NewGetV2DocsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/docs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ExtensionsRequest generates requests for GetV2Extensions
+func NewGetV2ExtensionsRequest(server string, params *GetV2ExtensionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/extensions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Provider != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "provider", runtime.ParamLocationQuery, *params.Provider); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2HealthzRequest generates requests for GetV2Healthz
+func NewGetV2HealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2OpenapiJsonRequest generates requests for GetV2OpenapiJson
+func NewGetV2OpenapiJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2OperationsRequest generates requests for GetV2Operations
+func NewGetV2OperationsRequest(server string, params *GetV2OperationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/operations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cluster != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cluster", runtime.ParamLocationQuery, *params.Cluster); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2OperationsIdRequest generates requests for GetV2OperationsId
+func NewGetV2OperationsIdRequest(server string, id openapi_types.UUID, params *GetV2OperationsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/operations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2PendingClustersRequest generates requests for GetV2PendingClusters
+func NewGetV2PendingClustersRequest(server string, params *GetV2PendingClustersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pending-clusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2PendingClustersNameRequest generates requests for DeleteV2PendingClustersName
+func NewDeleteV2PendingClustersNameRequest(server string, name string, params *DeleteV2PendingClustersNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pending-clusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2PendingClustersNameRequest generates requests for GetV2PendingClustersName
+func NewGetV2PendingClustersNameRequest(server string, name string, params *GetV2PendingClustersNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pending-clusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2PendingClustersNameRequest calls the generic PutV2PendingClustersName builder with application/json body
+func NewPutV2PendingClustersNameRequest(server string, name string, params *PutV2PendingClustersNameParams, body PutV2PendingClustersNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2PendingClustersNameRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPutV2PendingClustersNameRequestWithBody generates requests for PutV2PendingClustersName with any type of body
+func NewPutV2PendingClustersNameRequestWithBody(server string, name string, params *PutV2PendingClustersNameParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pending-clusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameAuthzSelfRequest generates requests for GetV2ProjectsProjectNameAuthzSelf
+func NewGetV2ProjectsProjectNameAuthzSelfRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameAuthzSelfParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/authz/self", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersRequest generates requests for GetV2ProjectsProjectNameClusters
+func NewGetV2ProjectsProjectNameClustersRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameClustersRequest calls the generic PostV2ProjectsProjectNameClusters builder with application/json body
+func NewPostV2ProjectsProjectNameClustersRequest(server string, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, body PostV2ProjectsProjectNameClustersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameClustersRequestWithBody(server, projectName, params, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameClustersRequestWithBody generates requests for PostV2ProjectsProjectNameClusters with any type of body
+func NewPostV2ProjectsProjectNameClustersRequestWithBody(server string, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameClustersImportRequest calls the generic PostV2ProjectsProjectNameClustersImport builder with application/json body
+func NewPostV2ProjectsProjectNameClustersImportRequest(server string, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameClustersImportRequestWithBody(server, projectName, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameClustersImportRequestWithBody generates requests for PostV2ProjectsProjectNameClustersImport with any type of body
+func NewPostV2ProjectsProjectNameClustersImportRequestWithBody(server string, projectName ProjectNamePath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/import", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameSuggestionRequest generates requests for GetV2ProjectsProjectNameClustersNameSuggestion
+func NewGetV2ProjectsProjectNameClustersNameSuggestionRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersNameSuggestionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/name-suggestion", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Base != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, *params.Base); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersSummaryRequest generates requests for GetV2ProjectsProjectNameClustersSummary
+func NewGetV2ProjectsProjectNameClustersSummaryRequest(server string, projectName ProjectNamePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/summary", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNameClustersNameRequest generates requests for DeleteV2ProjectsProjectNameClustersName
+func NewDeleteV2ProjectsProjectNameClustersNameRequest(server string, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ForceFinalize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "forceFinalize", runtime.ParamLocationQuery, *params.ForceFinalize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Purge != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purge", runtime.ParamLocationQuery, *params.Purge); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameRequest generates requests for GetV2ProjectsProjectNameClustersName
+func NewGetV2ProjectsProjectNameClustersNameRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameBackupsRequest generates requests for GetV2ProjectsProjectNameClustersNameBackups
+func NewGetV2ProjectsProjectNameClustersNameBackupsRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/backups", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameClustersNameBackupsRequest generates requests for PostV2ProjectsProjectNameClustersNameBackups
+func NewPostV2ProjectsProjectNameClustersNameBackupsRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/backups", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNameClustersNameDeletionRequest generates requests for DeleteV2ProjectsProjectNameClustersNameDeletion
+func NewDeleteV2ProjectsProjectNameClustersNameDeletionRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/deletion", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameEventsRequest generates requests for GetV2ProjectsProjectNameClustersNameEvents
+func NewGetV2ProjectsProjectNameClustersNameEventsRequest(server string, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/events", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNameClustersNameExtensionsRequest calls the generic PutV2ProjectsProjectNameClustersNameExtensions builder with application/json body
+func NewPutV2ProjectsProjectNameClustersNameExtensionsRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameExtensionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNameClustersNameExtensionsRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNameClustersNameExtensionsRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameExtensions with any type of body
+func NewPutV2ProjectsProjectNameClustersNameExtensionsRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/extensions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameHealthRequest generates requests for GetV2ProjectsProjectNameClustersNameHealth
+func NewGetV2ProjectsProjectNameClustersNameHealthRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/health", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNameClustersNameKubeconfigsRequest generates requests for DeleteV2ProjectsProjectNameClustersNameKubeconfigs
+func NewDeleteV2ProjectsProjectNameClustersNameKubeconfigsRequest(server string, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameKubeconfigsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/kubeconfigs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameKubeconfigsRequest generates requests for GetV2ProjectsProjectNameClustersNameKubeconfigs
+func NewGetV2ProjectsProjectNameClustersNameKubeconfigsRequest(server string, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/kubeconfigs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AuthType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "authType", runtime.ParamLocationQuery, *params.AuthType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPatchV2ProjectsProjectNameClustersNameLabelsRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchV2ProjectsProjectNameClustersNameLabels builder with application/merge-patch+json body
+func NewPatchV2ProjectsProjectNameClustersNameLabelsRequestWithApplicationMergePatchPlusJSONBody(server string, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, body PatchV2ProjectsProjectNameClustersNameLabelsApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV2ProjectsProjectNameClustersNameLabelsRequestWithBody(server, projectName, name, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchV2ProjectsProjectNameClustersNameLabelsRequestWithBody generates requests for PatchV2ProjectsProjectNameClustersNameLabels with any type of body
+func NewPatchV2ProjectsProjectNameClustersNameLabelsRequestWithBody(server string, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/labels", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNameClustersNameLabelsRequest calls the generic PutV2ProjectsProjectNameClustersNameLabels builder with application/json body
+func NewPutV2ProjectsProjectNameClustersNameLabelsRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameLabels with any type of body
+func NewPutV2ProjectsProjectNameClustersNameLabelsRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/labels", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNameClustersNameMaintenanceRequest generates requests for DeleteV2ProjectsProjectNameClustersNameMaintenance
+func NewDeleteV2ProjectsProjectNameClustersNameMaintenanceRequest(server string, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameMaintenanceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/maintenance", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNameClustersNameMaintenanceRequest calls the generic PutV2ProjectsProjectNameClustersNameMaintenance builder with application/json body
+func NewPutV2ProjectsProjectNameClustersNameMaintenanceRequest(server string, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, body PutV2ProjectsProjectNameClustersNameMaintenanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNameClustersNameMaintenanceRequestWithBody(server, projectName, name, params, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNameClustersNameMaintenanceRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameMaintenance with any type of body
+func NewPutV2ProjectsProjectNameClustersNameMaintenanceRequestWithBody(server string, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/maintenance", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameNodepoolsRequest generates requests for GetV2ProjectsProjectNameClustersNameNodepools
+func NewGetV2ProjectsProjectNameClustersNameNodepoolsRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodepools", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameClustersNameNodepoolsRequest calls the generic PostV2ProjectsProjectNameClustersNameNodepools builder with application/json body
+func NewPostV2ProjectsProjectNameClustersNameNodepoolsRequest(server string, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameNodepoolsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameClustersNameNodepoolsRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameClustersNameNodepoolsRequestWithBody generates requests for PostV2ProjectsProjectNameClustersNameNodepools with any type of body
+func NewPostV2ProjectsProjectNameClustersNameNodepoolsRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodepools", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameRequest calls the generic PatchV2ProjectsProjectNameClustersNameNodepoolsPoolName builder with application/json body
+func NewPatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameRequest(server string, projectName ProjectNamePath, name string, poolName string, body PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameRequestWithBody(server, projectName, name, poolName, "application/json", bodyReader)
+}
+
+// NewPatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameRequestWithBody generates requests for PatchV2ProjectsProjectNameClustersNameNodepoolsPoolName with any type of body
+func NewPatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameRequestWithBody(server string, projectName ProjectNamePath, name string, poolName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "poolName", runtime.ParamLocationPath, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodepools/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNameClustersNameNodesRequest calls the generic PutV2ProjectsProjectNameClustersNameNodes builder with application/json body
+func NewPutV2ProjectsProjectNameClustersNameNodesRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameNodes with any type of body
+func NewPutV2ProjectsProjectNameClustersNameNodesRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodes", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNameClustersNameNodesNodeIdRequest generates requests for DeleteV2ProjectsProjectNameClustersNameNodesNodeId
+func NewDeleteV2ProjectsProjectNameClustersNameNodesNodeIdRequest(server string, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodes/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameClustersNameNodesNodeIdCordonRequest calls the generic PostV2ProjectsProjectNameClustersNameNodesNodeIdCordon builder with application/json body
+func NewPostV2ProjectsProjectNameClustersNameNodesNodeIdCordonRequest(server string, projectName ProjectNamePath, name string, nodeId string, body PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameClustersNameNodesNodeIdCordonRequestWithBody(server, projectName, name, nodeId, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameClustersNameNodesNodeIdCordonRequestWithBody generates requests for PostV2ProjectsProjectNameClustersNameNodesNodeIdCordon with any type of body
+func NewPostV2ProjectsProjectNameClustersNameNodesNodeIdCordonRequestWithBody(server string, projectName ProjectNamePath, name string, nodeId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodes/%s/cordon", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonRequest generates requests for PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordon
+func NewPostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonRequest(server string, projectName ProjectNamePath, name string, nodeId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/nodes/%s/uncordon", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameClustersNameRestoreRequest calls the generic PostV2ProjectsProjectNameClustersNameRestore builder with application/json body
+func NewPostV2ProjectsProjectNameClustersNameRestoreRequest(server string, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameRestoreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameClustersNameRestoreRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameClustersNameRestoreRequestWithBody generates requests for PostV2ProjectsProjectNameClustersNameRestore with any type of body
+func NewPostV2ProjectsProjectNameClustersNameRestoreRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNameClustersNameTemplateRequest calls the generic PutV2ProjectsProjectNameClustersNameTemplate builder with application/json body
+func NewPutV2ProjectsProjectNameClustersNameTemplateRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody generates requests for PutV2ProjectsProjectNameClustersNameTemplate with any type of body
+func NewPutV2ProjectsProjectNameClustersNameTemplateRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/template", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNameUpgradesRequest generates requests for GetV2ProjectsProjectNameClustersNameUpgrades
+func NewGetV2ProjectsProjectNameClustersNameUpgradesRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/upgrades", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameClustersNodeIdClusterdetailRequest generates requests for GetV2ProjectsProjectNameClustersNodeIdClusterdetail
+func NewGetV2ProjectsProjectNameClustersNodeIdClusterdetailRequest(server string, projectName ProjectNamePath, nodeId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nodeId", runtime.ParamLocationPath, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/clusters/%s/clusterdetail", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameOperationsRequest generates requests for GetV2ProjectsProjectNameOperations
+func NewGetV2ProjectsProjectNameOperationsRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameOperationsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/operations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cluster != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cluster", runtime.ParamLocationQuery, *params.Cluster); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameOperationsIdRequest generates requests for GetV2ProjectsProjectNameOperationsId
+func NewGetV2ProjectsProjectNameOperationsIdRequest(server string, projectName ProjectNamePath, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/operations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNamePendingClustersRequest generates requests for GetV2ProjectsProjectNamePendingClusters
+func NewGetV2ProjectsProjectNamePendingClustersRequest(server string, projectName ProjectNamePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/pending-clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNamePendingClustersNameRequest generates requests for DeleteV2ProjectsProjectNamePendingClustersName
+func NewDeleteV2ProjectsProjectNamePendingClustersNameRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/pending-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNamePendingClustersNameRequest generates requests for GetV2ProjectsProjectNamePendingClustersName
+func NewGetV2ProjectsProjectNamePendingClustersNameRequest(server string, projectName ProjectNamePath, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/pending-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNamePendingClustersNameRequest calls the generic PutV2ProjectsProjectNamePendingClustersName builder with application/json body
+func NewPutV2ProjectsProjectNamePendingClustersNameRequest(server string, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNamePendingClustersNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNamePendingClustersNameRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNamePendingClustersNameRequestWithBody generates requests for PutV2ProjectsProjectNamePendingClustersName with any type of body
+func NewPutV2ProjectsProjectNamePendingClustersNameRequestWithBody(server string, projectName ProjectNamePath, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/pending-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameSummaryRequest generates requests for GetV2ProjectsProjectNameSummary
+func NewGetV2ProjectsProjectNameSummaryRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameSummaryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/summary", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameTemplateUploadsRequest calls the generic PostV2ProjectsProjectNameTemplateUploads builder with application/json body
+func NewPostV2ProjectsProjectNameTemplateUploadsRequest(server string, projectName ProjectNamePath, body PostV2ProjectsProjectNameTemplateUploadsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameTemplateUploadsRequestWithBody(server, projectName, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameTemplateUploadsRequestWithBody generates requests for PostV2ProjectsProjectNameTemplateUploads with any type of body
+func NewPostV2ProjectsProjectNameTemplateUploadsRequestWithBody(server string, projectName ProjectNamePath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/template-uploads", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNameTemplateUploadsIdRequest generates requests for DeleteV2ProjectsProjectNameTemplateUploadsId
+func NewDeleteV2ProjectsProjectNameTemplateUploadsIdRequest(server string, projectName ProjectNamePath, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/template-uploads/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameTemplateUploadsIdRequest generates requests for GetV2ProjectsProjectNameTemplateUploadsId
+func NewGetV2ProjectsProjectNameTemplateUploadsIdRequest(server string, projectName ProjectNamePath, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/template-uploads/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameTemplateUploadsIdChunksRequest calls the generic PostV2ProjectsProjectNameTemplateUploadsIdChunks builder with application/json body
+func NewPostV2ProjectsProjectNameTemplateUploadsIdChunksRequest(server string, projectName ProjectNamePath, id openapi_types.UUID, body PostV2ProjectsProjectNameTemplateUploadsIdChunksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameTemplateUploadsIdChunksRequestWithBody(server, projectName, id, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameTemplateUploadsIdChunksRequestWithBody generates requests for PostV2ProjectsProjectNameTemplateUploadsIdChunks with any type of body
+func NewPostV2ProjectsProjectNameTemplateUploadsIdChunksRequestWithBody(server string, projectName ProjectNamePath, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/template-uploads/%s/chunks", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameTemplateUploadsIdCommitRequest generates requests for PostV2ProjectsProjectNameTemplateUploadsIdCommit
+func NewPostV2ProjectsProjectNameTemplateUploadsIdCommitRequest(server string, projectName ProjectNamePath, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/template-uploads/%s/commit", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameTemplatesRequest generates requests for GetV2ProjectsProjectNameTemplates
+func NewGetV2ProjectsProjectNameTemplatesRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Default != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "default", runtime.ParamLocationQuery, *params.Default); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameTemplatesRequest calls the generic PostV2ProjectsProjectNameTemplates builder with application/json body
+func NewPostV2ProjectsProjectNameTemplatesRequest(server string, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameTemplatesRequestWithBody(server, projectName, params, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameTemplatesRequestWithBody generates requests for PostV2ProjectsProjectNameTemplates with any type of body
+func NewPostV2ProjectsProjectNameTemplatesRequestWithBody(server string, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameTemplatesCompatibilityRequest generates requests for GetV2ProjectsProjectNameTemplatesCompatibility
+func NewGetV2ProjectsProjectNameTemplatesCompatibilityRequest(server string, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesCompatibilityParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/compatibility", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNameTemplatesNameDefaultRequest calls the generic PutV2ProjectsProjectNameTemplatesNameDefault builder with application/json body
+func NewPutV2ProjectsProjectNameTemplatesNameDefaultRequest(server string, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody(server, projectName, name, params, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody generates requests for PutV2ProjectsProjectNameTemplatesNameDefault with any type of body
+func NewPutV2ProjectsProjectNameTemplatesNameDefaultRequestWithBody(server string, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/default", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest generates requests for GetV2ProjectsProjectNameTemplatesNameVersions
+func NewGetV2ProjectsProjectNameTemplatesNameVersionsRequest(server string, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/versions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest generates requests for DeleteV2ProjectsProjectNameTemplatesNameVersion
+func NewDeleteV2ProjectsProjectNameTemplatesNameVersionRequest(server string, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameTemplatesNameVersionRequest generates requests for GetV2ProjectsProjectNameTemplatesNameVersion
+func NewGetV2ProjectsProjectNameTemplatesNameVersionRequest(server string, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2ProjectsProjectNameTemplatesNameVersionRequest calls the generic PutV2ProjectsProjectNameTemplatesNameVersion builder with application/json body
+func NewPutV2ProjectsProjectNameTemplatesNameVersionRequest(server string, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, body PutV2ProjectsProjectNameTemplatesNameVersionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2ProjectsProjectNameTemplatesNameVersionRequestWithBody(server, projectName, name, version, params, "application/json", bodyReader)
+}
+
+// NewPutV2ProjectsProjectNameTemplatesNameVersionRequestWithBody generates requests for PutV2ProjectsProjectNameTemplatesNameVersion with any type of body
+func NewPutV2ProjectsProjectNameTemplatesNameVersionRequestWithBody(server string, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewRequest calls the generic PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreview builder with application/json body
+func NewPostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewRequest(server string, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, body PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewRequestWithBody(server, projectName, name, version, params, "application/json", bodyReader)
+}
+
+// NewPostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewRequestWithBody generates requests for PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreview with any type of body
+func NewPostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewRequestWithBody(server string, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s/clusterlabels/preview", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameTemplatesNameVersionClustersRequest generates requests for GetV2ProjectsProjectNameTemplatesNameVersionClusters
+func NewGetV2ProjectsProjectNameTemplatesNameVersionClustersRequest(server string, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionClustersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s/clusters", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		if params.Authorization != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameTemplatesNameVersionDeprecateRequest generates requests for PostV2ProjectsProjectNameTemplatesNameVersionDeprecate
+func NewPostV2ProjectsProjectNameTemplatesNameVersionDeprecateRequest(server string, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionDeprecateParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s/deprecate", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameTemplatesNameVersionExportRequest generates requests for GetV2ProjectsProjectNameTemplatesNameVersionExport
+func NewGetV2ProjectsProjectNameTemplatesNameVersionExportRequest(server string, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s/export", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2ProjectsProjectNameTemplatesNameVersionPublishRequest generates requests for PostV2ProjectsProjectNameTemplatesNameVersionPublish
+func NewPostV2ProjectsProjectNameTemplatesNameVersionPublishRequest(server string, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionPublishParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/templates/%s/%s/publish", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2ProjectsProjectNameWebhooksDestinationsRequest generates requests for GetV2ProjectsProjectNameWebhooksDestinations
+func NewGetV2ProjectsProjectNameWebhooksDestinationsRequest(server string, projectName ProjectNamePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/projects/%s/webhooks/destinations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2ReadyzRequest generates requests for GetV2Readyz
+func NewGetV2ReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV2SummaryRequest generates requests for GetV2Summary
+func NewGetV2SummaryRequest(server string, params *GetV2SummaryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2SupportmatrixRequest generates requests for GetV2Supportmatrix
+func NewGetV2SupportmatrixRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/supportmatrix")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV2TemplateUploadsRequest calls the generic PostV2TemplateUploads builder with application/json body
+func NewPostV2TemplateUploadsRequest(server string, params *PostV2TemplateUploadsParams, body PostV2TemplateUploadsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2TemplateUploadsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostV2TemplateUploadsRequestWithBody generates requests for PostV2TemplateUploads with any type of body
+func NewPostV2TemplateUploadsRequestWithBody(server string, params *PostV2TemplateUploadsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/template-uploads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2TemplateUploadsIdRequest generates requests for DeleteV2TemplateUploadsId
+func NewDeleteV2TemplateUploadsIdRequest(server string, id openapi_types.UUID, params *DeleteV2TemplateUploadsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/template-uploads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2TemplateUploadsIdRequest generates requests for GetV2TemplateUploadsId
+func NewGetV2TemplateUploadsIdRequest(server string, id openapi_types.UUID, params *GetV2TemplateUploadsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/template-uploads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2TemplateUploadsIdChunksRequest calls the generic PostV2TemplateUploadsIdChunks builder with application/json body
+func NewPostV2TemplateUploadsIdChunksRequest(server string, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, body PostV2TemplateUploadsIdChunksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2TemplateUploadsIdChunksRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostV2TemplateUploadsIdChunksRequestWithBody generates requests for PostV2TemplateUploadsIdChunks with any type of body
+func NewPostV2TemplateUploadsIdChunksRequestWithBody(server string, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/template-uploads/%s/chunks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2TemplateUploadsIdCommitRequest generates requests for PostV2TemplateUploadsIdCommit
+func NewPostV2TemplateUploadsIdCommitRequest(server string, id openapi_types.UUID, params *PostV2TemplateUploadsIdCommitParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/template-uploads/%s/commit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2TemplatesRequest generates requests for GetV2Templates
+func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Default != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "default", runtime.ParamLocationQuery, *params.Default); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2TemplatesRequest calls the generic PostV2Templates builder with application/json body
+func NewPostV2TemplatesRequest(server string, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2TemplatesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostV2TemplatesRequestWithBody generates requests for PostV2Templates with any type of body
+func NewPostV2TemplatesRequestWithBody(server string, params *PostV2TemplatesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2TemplatesCompatibilityRequest generates requests for GetV2TemplatesCompatibility
+func NewGetV2TemplatesCompatibilityRequest(server string, params *GetV2TemplatesCompatibilityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/compatibility")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2TemplatesNameDefaultRequest calls the generic PutV2TemplatesNameDefault builder with application/json body
+func NewPutV2TemplatesNameDefaultRequest(server string, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2TemplatesNameDefaultRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPutV2TemplatesNameDefaultRequestWithBody generates requests for PutV2TemplatesNameDefault with any type of body
+func NewPutV2TemplatesNameDefaultRequestWithBody(server string, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/default", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2TemplatesNameVersionsRequest generates requests for GetV2TemplatesNameVersions
+func NewGetV2TemplatesNameVersionsRequest(server string, name string, params *GetV2TemplatesNameVersionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteV2TemplatesNameVersionRequest generates requests for DeleteV2TemplatesNameVersion
+func NewDeleteV2TemplatesNameVersionRequest(server string, name string, version string, params *DeleteV2TemplatesNameVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2TemplatesNameVersionRequest generates requests for GetV2TemplatesNameVersion
+func NewGetV2TemplatesNameVersionRequest(server string, name string, version string, params *GetV2TemplatesNameVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutV2TemplatesNameVersionRequest calls the generic PutV2TemplatesNameVersion builder with application/json body
+func NewPutV2TemplatesNameVersionRequest(server string, name string, version string, params *PutV2TemplatesNameVersionParams, body PutV2TemplatesNameVersionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutV2TemplatesNameVersionRequestWithBody(server, name, version, params, "application/json", bodyReader)
+}
+
+// NewPutV2TemplatesNameVersionRequestWithBody generates requests for PutV2TemplatesNameVersion with any type of body
+func NewPutV2TemplatesNameVersionRequestWithBody(server string, name string, version string, params *PutV2TemplatesNameVersionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2TemplatesNameVersionClusterlabelsPreviewRequest calls the generic PostV2TemplatesNameVersionClusterlabelsPreview builder with application/json body
+func NewPostV2TemplatesNameVersionClusterlabelsPreviewRequest(server string, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, body PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2TemplatesNameVersionClusterlabelsPreviewRequestWithBody(server, name, version, params, "application/json", bodyReader)
+}
+
+// NewPostV2TemplatesNameVersionClusterlabelsPreviewRequestWithBody generates requests for PostV2TemplatesNameVersionClusterlabelsPreview with any type of body
+func NewPostV2TemplatesNameVersionClusterlabelsPreviewRequestWithBody(server string, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s/clusterlabels/preview", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2TemplatesNameVersionClustersRequest generates requests for GetV2TemplatesNameVersionClusters
+func NewGetV2TemplatesNameVersionClustersRequest(server string, name string, version string, params *GetV2TemplatesNameVersionClustersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s/clusters", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		if params.Authorization != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2TemplatesNameVersionDeprecateRequest generates requests for PostV2TemplatesNameVersionDeprecate
+func NewPostV2TemplatesNameVersionDeprecateRequest(server string, name string, version string, params *PostV2TemplatesNameVersionDeprecateParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s/deprecate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2TemplatesNameVersionExportRequest generates requests for GetV2TemplatesNameVersionExport
+func NewGetV2TemplatesNameVersionExportRequest(server string, name string, version string, params *GetV2TemplatesNameVersionExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s/export", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostV2TemplatesNameVersionPublishRequest generates requests for PostV2TemplatesNameVersionPublish
+func NewPostV2TemplatesNameVersionPublishRequest(server string, name string, version string, params *PostV2TemplatesNameVersionPublishParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/%s/publish", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetV2WebhooksDestinationsRequest generates requests for GetV2WebhooksDestinations
+func NewGetV2WebhooksDestinationsRequest(server string, params *GetV2WebhooksDestinationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/webhooks/destinations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Activeprojectid", runtime.ParamLocationHeader, params.Activeprojectid)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Activeprojectid", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetV2AdminExportsProjectIdWithResponse request
+	GetV2AdminExportsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV2AdminExportsProjectIdResponse, error)
+
+	// GetV2AdminSupportBundlesProjectIdClustersNameWithResponse request
+	GetV2AdminSupportBundlesProjectIdClustersNameWithResponse(ctx context.Context, projectId openapi_types.UUID, name string, reqEditors ...RequestEditorFn) (*GetV2AdminSupportBundlesProjectIdClustersNameResponse, error)
+
+	// GetV2ApichangelogWithResponse request
+	GetV2ApichangelogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2ApichangelogResponse, error)
+
+	// GetV2AuthzSelfWithResponse request
+	GetV2AuthzSelfWithResponse(ctx context.Context, params *GetV2AuthzSelfParams, reqEditors ...RequestEditorFn) (*GetV2AuthzSelfResponse, error)
+
+	// GetV2ClustersWithResponse request
+	GetV2ClustersWithResponse(ctx context.Context, params *GetV2ClustersParams, reqEditors ...RequestEditorFn) (*GetV2ClustersResponse, error)
+
+	// PostV2ClustersWithBodyWithResponse request with any body
+	PostV2ClustersWithBodyWithResponse(ctx context.Context, params *PostV2ClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error)
+
+	PostV2ClustersWithResponse(ctx context.Context, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error)
+
+	// PostV2ClustersImportWithBodyWithResponse request with any body
+	PostV2ClustersImportWithBodyWithResponse(ctx context.Context, params *PostV2ClustersImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersImportResponse, error)
+
+	PostV2ClustersImportWithResponse(ctx context.Context, params *PostV2ClustersImportParams, body PostV2ClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersImportResponse, error)
+
+	// GetV2ClustersNameSuggestionWithResponse request
+	GetV2ClustersNameSuggestionWithResponse(ctx context.Context, params *GetV2ClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameSuggestionResponse, error)
+
+	// GetV2ClustersSummaryWithResponse request
+	GetV2ClustersSummaryWithResponse(ctx context.Context, params *GetV2ClustersSummaryParams, reqEditors ...RequestEditorFn) (*GetV2ClustersSummaryResponse, error)
+
+	// DeleteV2ClustersNameWithResponse request
+	DeleteV2ClustersNameWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameResponse, error)
+
+	// GetV2ClustersNameWithResponse request
+	GetV2ClustersNameWithResponse(ctx context.Context, name string, params *GetV2ClustersNameParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameResponse, error)
+
+	// GetV2ClustersNameBackupsWithResponse request
+	GetV2ClustersNameBackupsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameBackupsResponse, error)
+
+	// PostV2ClustersNameBackupsWithResponse request
+	PostV2ClustersNameBackupsWithResponse(ctx context.Context, name string, params *PostV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*PostV2ClustersNameBackupsResponse, error)
+
+	// PostV2ClustersNameCloneWithBodyWithResponse request with any body
+	PostV2ClustersNameCloneWithBodyWithResponse(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameCloneResponse, error)
+
+	PostV2ClustersNameCloneWithResponse(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, body PostV2ClustersNameCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameCloneResponse, error)
+
+	// DeleteV2ClustersNameDeletionWithResponse request
+	DeleteV2ClustersNameDeletionWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameDeletionParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameDeletionResponse, error)
+
+	// GetV2ClustersNameEventsWithResponse request
+	GetV2ClustersNameEventsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameEventsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameEventsResponse, error)
+
+	// PutV2ClustersNameExtensionsWithBodyWithResponse request with any body
+	PutV2ClustersNameExtensionsWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameExtensionsResponse, error)
+
+	PutV2ClustersNameExtensionsWithResponse(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, body PutV2ClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameExtensionsResponse, error)
+
+	// GetV2ClustersNameHealthWithResponse request
+	GetV2ClustersNameHealthWithResponse(ctx context.Context, name string, params *GetV2ClustersNameHealthParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameHealthResponse, error)
+
+	// DeleteV2ClustersNameKubeconfigsWithResponse request
+	DeleteV2ClustersNameKubeconfigsWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameKubeconfigsResponse, error)
+
+	// GetV2ClustersNameKubeconfigsWithResponse request
+	GetV2ClustersNameKubeconfigsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameKubeconfigsResponse, error)
+
+	// PatchV2ClustersNameLabelsWithBodyWithResponse request with any body
+	PatchV2ClustersNameLabelsWithBodyWithResponse(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameLabelsResponse, error)
+
+	PatchV2ClustersNameLabelsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, body PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameLabelsResponse, error)
+
+	// PutV2ClustersNameLabelsWithBodyWithResponse request with any body
+	PutV2ClustersNameLabelsWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error)
+
+	PutV2ClustersNameLabelsWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error)
+
+	// DeleteV2ClustersNameMaintenanceWithResponse request
+	DeleteV2ClustersNameMaintenanceWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameMaintenanceResponse, error)
+
+	// PutV2ClustersNameMaintenanceWithBodyWithResponse request with any body
+	PutV2ClustersNameMaintenanceWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameMaintenanceResponse, error)
+
+	PutV2ClustersNameMaintenanceWithResponse(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, body PutV2ClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameMaintenanceResponse, error)
+
+	// GetV2ClustersNameNodepoolsWithResponse request
+	GetV2ClustersNameNodepoolsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameNodepoolsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameNodepoolsResponse, error)
+
+	// PostV2ClustersNameNodepoolsWithBodyWithResponse request with any body
+	PostV2ClustersNameNodepoolsWithBodyWithResponse(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodepoolsResponse, error)
+
+	PostV2ClustersNameNodepoolsWithResponse(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, body PostV2ClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodepoolsResponse, error)
+
+	// PatchV2ClustersNameNodepoolsPoolNameWithBodyWithResponse request with any body
+	PatchV2ClustersNameNodepoolsPoolNameWithBodyWithResponse(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameNodepoolsPoolNameResponse, error)
+
+	PatchV2ClustersNameNodepoolsPoolNameWithResponse(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, body PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameNodepoolsPoolNameResponse, error)
+
+	// PutV2ClustersNameNodesWithBodyWithResponse request with any body
+	PutV2ClustersNameNodesWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error)
+
+	PutV2ClustersNameNodesWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error)
+
+	// DeleteV2ClustersNameNodesNodeIdWithResponse request
+	DeleteV2ClustersNameNodesNodeIdWithResponse(ctx context.Context, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameNodesNodeIdResponse, error)
+
+	// PostV2ClustersNameNodesNodeIdCordonWithBodyWithResponse request with any body
+	PostV2ClustersNameNodesNodeIdCordonWithBodyWithResponse(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodesNodeIdCordonResponse, error)
+
+	PostV2ClustersNameNodesNodeIdCordonWithResponse(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, body PostV2ClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodesNodeIdCordonResponse, error)
+
+	// GetV2ClustersNameNodesNodeIdLogsWithResponse request
+	GetV2ClustersNameNodesNodeIdLogsWithResponse(ctx context.Context, name string, nodeId string, params *GetV2ClustersNameNodesNodeIdLogsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameNodesNodeIdLogsResponse, error)
+
+	// PostV2ClustersNameNodesNodeIdUncordonWithResponse request
+	PostV2ClustersNameNodesNodeIdUncordonWithResponse(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdUncordonParams, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodesNodeIdUncordonResponse, error)
+
+	// PostV2ClustersNameRestoreWithBodyWithResponse request with any body
+	PostV2ClustersNameRestoreWithBodyWithResponse(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameRestoreResponse, error)
+
+	PostV2ClustersNameRestoreWithResponse(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, body PostV2ClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameRestoreResponse, error)
+
+	// PutV2ClustersNameTemplateWithBodyWithResponse request with any body
+	PutV2ClustersNameTemplateWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error)
+
+	PutV2ClustersNameTemplateWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error)
+
+	// GetV2ClustersNameUpgradesWithResponse request
+	GetV2ClustersNameUpgradesWithResponse(ctx context.Context, name string, params *GetV2ClustersNameUpgradesParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameUpgradesResponse, error)
+
+	// GetV2ClustersNodeIdClusterdetailWithResponse request
+	GetV2ClustersNodeIdClusterdetailWithResponse(ctx context.Context, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNodeIdClusterdetailResponse, error)
+
+	// GetV2DocsWithResponse request
+	GetV2DocsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2DocsResponse, error)
+
+	// GetV2ExtensionsWithResponse request
+	GetV2ExtensionsWithResponse(ctx context.Context, params *GetV2ExtensionsParams, reqEditors ...RequestEditorFn) (*GetV2ExtensionsResponse, error)
+
+	// GetV2HealthzWithResponse request
+	GetV2HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2HealthzResponse, error)
+
+	// GetV2OpenapiJsonWithResponse request
+	GetV2OpenapiJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2OpenapiJsonResponse, error)
+
+	// GetV2OperationsWithResponse request
+	GetV2OperationsWithResponse(ctx context.Context, params *GetV2OperationsParams, reqEditors ...RequestEditorFn) (*GetV2OperationsResponse, error)
+
+	// GetV2OperationsIdWithResponse request
+	GetV2OperationsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetV2OperationsIdParams, reqEditors ...RequestEditorFn) (*GetV2OperationsIdResponse, error)
+
+	// GetV2PendingClustersWithResponse request
+	GetV2PendingClustersWithResponse(ctx context.Context, params *GetV2PendingClustersParams, reqEditors ...RequestEditorFn) (*GetV2PendingClustersResponse, error)
+
+	// DeleteV2PendingClustersNameWithResponse request
+	DeleteV2PendingClustersNameWithResponse(ctx context.Context, name string, params *DeleteV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2PendingClustersNameResponse, error)
+
+	// GetV2PendingClustersNameWithResponse request
+	GetV2PendingClustersNameWithResponse(ctx context.Context, name string, params *GetV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*GetV2PendingClustersNameResponse, error)
+
+	// PutV2PendingClustersNameWithBodyWithResponse request with any body
+	PutV2PendingClustersNameWithBodyWithResponse(ctx context.Context, name string, params *PutV2PendingClustersNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2PendingClustersNameResponse, error)
+
+	PutV2PendingClustersNameWithResponse(ctx context.Context, name string, params *PutV2PendingClustersNameParams, body PutV2PendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2PendingClustersNameResponse, error)
+
+	// GetV2ProjectsProjectNameAuthzSelfWithResponse request
+	GetV2ProjectsProjectNameAuthzSelfWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameAuthzSelfParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameAuthzSelfResponse, error)
+
+	// GetV2ProjectsProjectNameClustersWithResponse request
+	GetV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersResponse, error)
+
+	// PostV2ProjectsProjectNameClustersWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameClustersWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error)
+
+	PostV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error)
+
+	// PostV2ProjectsProjectNameClustersImportWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameClustersImportWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersImportResponse, error)
+
+	PostV2ProjectsProjectNameClustersImportWithResponse(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersImportResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameSuggestionWithResponse request
+	GetV2ProjectsProjectNameClustersNameSuggestionWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameSuggestionResponse, error)
+
+	// GetV2ProjectsProjectNameClustersSummaryWithResponse request
+	GetV2ProjectsProjectNameClustersSummaryWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersSummaryResponse, error)
+
+	// DeleteV2ProjectsProjectNameClustersNameWithResponse request
+	DeleteV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameWithResponse request
+	GetV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameBackupsWithResponse request
+	GetV2ProjectsProjectNameClustersNameBackupsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameBackupsResponse, error)
+
+	// PostV2ProjectsProjectNameClustersNameBackupsWithResponse request
+	PostV2ProjectsProjectNameClustersNameBackupsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameBackupsResponse, error)
+
+	// DeleteV2ProjectsProjectNameClustersNameDeletionWithResponse request
+	DeleteV2ProjectsProjectNameClustersNameDeletionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameDeletionResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameEventsWithResponse request
+	GetV2ProjectsProjectNameClustersNameEventsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameEventsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameEventsResponse, error)
+
+	// PutV2ProjectsProjectNameClustersNameExtensionsWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNameClustersNameExtensionsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameExtensionsResponse, error)
+
+	PutV2ProjectsProjectNameClustersNameExtensionsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameExtensionsResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameHealthWithResponse request
+	GetV2ProjectsProjectNameClustersNameHealthWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameHealthResponse, error)
+
+	// DeleteV2ProjectsProjectNameClustersNameKubeconfigsWithResponse request
+	DeleteV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse request
+	GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameKubeconfigsResponse, error)
+
+	// PatchV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse request with any body
+	PatchV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameLabelsResponse, error)
+
+	PatchV2ProjectsProjectNameClustersNameLabelsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, body PatchV2ProjectsProjectNameClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameLabelsResponse, error)
+
+	// PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error)
+
+	PutV2ProjectsProjectNameClustersNameLabelsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error)
+
+	// DeleteV2ProjectsProjectNameClustersNameMaintenanceWithResponse request
+	DeleteV2ProjectsProjectNameClustersNameMaintenanceWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse, error)
+
+	// PutV2ProjectsProjectNameClustersNameMaintenanceWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNameClustersNameMaintenanceWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameMaintenanceResponse, error)
+
+	PutV2ProjectsProjectNameClustersNameMaintenanceWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, body PutV2ProjectsProjectNameClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameMaintenanceResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameNodepoolsWithResponse request
+	GetV2ProjectsProjectNameClustersNameNodepoolsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameNodepoolsResponse, error)
+
+	// PostV2ProjectsProjectNameClustersNameNodepoolsWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameClustersNameNodepoolsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodepoolsResponse, error)
+
+	PostV2ProjectsProjectNameClustersNameNodepoolsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodepoolsResponse, error)
+
+	// PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBodyWithResponse request with any body
+	PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, poolName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse, error)
+
+	PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, poolName string, body PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse, error)
+
+	// PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error)
+
+	PutV2ProjectsProjectNameClustersNameNodesWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error)
+
+	// DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse request
+	DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse, error)
+
+	// PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse, error)
+
+	PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, body PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse, error)
+
+	// PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonWithResponse request
+	PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse, error)
+
+	// PostV2ProjectsProjectNameClustersNameRestoreWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameClustersNameRestoreWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameRestoreResponse, error)
+
+	PostV2ProjectsProjectNameClustersNameRestoreWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameRestoreResponse, error)
+
+	// PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error)
+
+	PutV2ProjectsProjectNameClustersNameTemplateWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNameUpgradesWithResponse request
+	GetV2ProjectsProjectNameClustersNameUpgradesWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameUpgradesResponse, error)
+
+	// GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse request
+	GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse(ctx context.Context, projectName ProjectNamePath, nodeId string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse, error)
+
+	// GetV2ProjectsProjectNameOperationsWithResponse request
+	GetV2ProjectsProjectNameOperationsWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameOperationsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameOperationsResponse, error)
+
+	// GetV2ProjectsProjectNameOperationsIdWithResponse request
+	GetV2ProjectsProjectNameOperationsIdWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameOperationsIdResponse, error)
+
+	// GetV2ProjectsProjectNamePendingClustersWithResponse request
+	GetV2ProjectsProjectNamePendingClustersWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNamePendingClustersResponse, error)
+
+	// DeleteV2ProjectsProjectNamePendingClustersNameWithResponse request
+	DeleteV2ProjectsProjectNamePendingClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNamePendingClustersNameResponse, error)
+
+	// GetV2ProjectsProjectNamePendingClustersNameWithResponse request
+	GetV2ProjectsProjectNamePendingClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNamePendingClustersNameResponse, error)
+
+	// PutV2ProjectsProjectNamePendingClustersNameWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNamePendingClustersNameWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNamePendingClustersNameResponse, error)
+
+	PutV2ProjectsProjectNamePendingClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNamePendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNamePendingClustersNameResponse, error)
+
+	// GetV2ProjectsProjectNameSummaryWithResponse request
+	GetV2ProjectsProjectNameSummaryWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameSummaryParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameSummaryResponse, error)
+
+	// PostV2ProjectsProjectNameTemplateUploadsWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameTemplateUploadsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsResponse, error)
+
+	PostV2ProjectsProjectNameTemplateUploadsWithResponse(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameTemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsResponse, error)
+
+	// DeleteV2ProjectsProjectNameTemplateUploadsIdWithResponse request
+	DeleteV2ProjectsProjectNameTemplateUploadsIdWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameTemplateUploadsIdResponse, error)
+
+	// GetV2ProjectsProjectNameTemplateUploadsIdWithResponse request
+	GetV2ProjectsProjectNameTemplateUploadsIdWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplateUploadsIdResponse, error)
+
+	// PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse, error)
+
+	PostV2ProjectsProjectNameTemplateUploadsIdChunksWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, body PostV2ProjectsProjectNameTemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse, error)
+
+	// PostV2ProjectsProjectNameTemplateUploadsIdCommitWithResponse request
+	PostV2ProjectsProjectNameTemplateUploadsIdCommitWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse, error)
+
+	// GetV2ProjectsProjectNameTemplatesWithResponse request
+	GetV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesResponse, error)
+
+	// PostV2ProjectsProjectNameTemplatesWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameTemplatesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error)
+
+	PostV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error)
+
+	// GetV2ProjectsProjectNameTemplatesCompatibilityWithResponse request
+	GetV2ProjectsProjectNameTemplatesCompatibilityWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesCompatibilityResponse, error)
+
+	// PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error)
+
+	PutV2ProjectsProjectNameTemplatesNameDefaultWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error)
+
+	// GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse request
+	GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionsResponse, error)
+
+	// DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse request
+	DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameTemplatesNameVersionResponse, error)
+
+	// GetV2ProjectsProjectNameTemplatesNameVersionWithResponse request
+	GetV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionResponse, error)
+
+	// PutV2ProjectsProjectNameTemplatesNameVersionWithBodyWithResponse request with any body
+	PutV2ProjectsProjectNameTemplatesNameVersionWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameVersionResponse, error)
+
+	PutV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, body PutV2ProjectsProjectNameTemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameVersionResponse, error)
+
+	// PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse request with any body
+	PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse, error)
+
+	PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, body PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse, error)
+
+	// GetV2ProjectsProjectNameTemplatesNameVersionClustersWithResponse request
+	GetV2ProjectsProjectNameTemplatesNameVersionClustersWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse, error)
+
+	// PostV2ProjectsProjectNameTemplatesNameVersionDeprecateWithResponse request
+	PostV2ProjectsProjectNameTemplatesNameVersionDeprecateWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse, error)
+
+	// GetV2ProjectsProjectNameTemplatesNameVersionExportWithResponse request
+	GetV2ProjectsProjectNameTemplatesNameVersionExportWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionExportResponse, error)
+
+	// PostV2ProjectsProjectNameTemplatesNameVersionPublishWithResponse request
+	PostV2ProjectsProjectNameTemplatesNameVersionPublishWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse, error)
+
+	// GetV2ProjectsProjectNameWebhooksDestinationsWithResponse request
+	GetV2ProjectsProjectNameWebhooksDestinationsWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameWebhooksDestinationsResponse, error)
+
+	// GetV2ReadyzWithResponse request
+	GetV2ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2ReadyzResponse, error)
+
+	// GetV2SummaryWithResponse request
+	GetV2SummaryWithResponse(ctx context.Context, params *GetV2SummaryParams, reqEditors ...RequestEditorFn) (*GetV2SummaryResponse, error)
+
+	// GetV2SupportmatrixWithResponse request
+	GetV2SupportmatrixWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2SupportmatrixResponse, error)
+
+	// PostV2TemplateUploadsWithBodyWithResponse request with any body
+	PostV2TemplateUploadsWithBodyWithResponse(ctx context.Context, params *PostV2TemplateUploadsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsResponse, error)
+
+	PostV2TemplateUploadsWithResponse(ctx context.Context, params *PostV2TemplateUploadsParams, body PostV2TemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsResponse, error)
+
+	// DeleteV2TemplateUploadsIdWithResponse request
+	DeleteV2TemplateUploadsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*DeleteV2TemplateUploadsIdResponse, error)
+
+	// GetV2TemplateUploadsIdWithResponse request
+	GetV2TemplateUploadsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*GetV2TemplateUploadsIdResponse, error)
+
+	// PostV2TemplateUploadsIdChunksWithBodyWithResponse request with any body
+	PostV2TemplateUploadsIdChunksWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsIdChunksResponse, error)
+
+	PostV2TemplateUploadsIdChunksWithResponse(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, body PostV2TemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsIdChunksResponse, error)
+
+	// PostV2TemplateUploadsIdCommitWithResponse request
+	PostV2TemplateUploadsIdCommitWithResponse(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdCommitParams, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsIdCommitResponse, error)
+
+	// GetV2TemplatesWithResponse request
+	GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error)
+
+	// PostV2TemplatesWithBodyWithResponse request with any body
+	PostV2TemplatesWithBodyWithResponse(ctx context.Context, params *PostV2TemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+
+	PostV2TemplatesWithResponse(ctx context.Context, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+
+	// GetV2TemplatesCompatibilityWithResponse request
+	GetV2TemplatesCompatibilityWithResponse(ctx context.Context, params *GetV2TemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesCompatibilityResponse, error)
+
+	// PutV2TemplatesNameDefaultWithBodyWithResponse request with any body
+	PutV2TemplatesNameDefaultWithBodyWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error)
+
+	PutV2TemplatesNameDefaultWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error)
+
+	// GetV2TemplatesNameVersionsWithResponse request
+	GetV2TemplatesNameVersionsWithResponse(ctx context.Context, name string, params *GetV2TemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionsResponse, error)
+
+	// DeleteV2TemplatesNameVersionWithResponse request
+	DeleteV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *DeleteV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2TemplatesNameVersionResponse, error)
+
+	// GetV2TemplatesNameVersionWithResponse request
+	GetV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionResponse, error)
+
+	// PutV2TemplatesNameVersionWithBodyWithResponse request with any body
+	PutV2TemplatesNameVersionWithBodyWithResponse(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameVersionResponse, error)
+
+	PutV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, body PutV2TemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameVersionResponse, error)
+
+	// PostV2TemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse request with any body
+	PostV2TemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionClusterlabelsPreviewResponse, error)
+
+	PostV2TemplatesNameVersionClusterlabelsPreviewWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, body PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionClusterlabelsPreviewResponse, error)
+
+	// GetV2TemplatesNameVersionClustersWithResponse request
+	GetV2TemplatesNameVersionClustersWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionClustersResponse, error)
+
+	// PostV2TemplatesNameVersionDeprecateWithResponse request
+	PostV2TemplatesNameVersionDeprecateWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionDeprecateResponse, error)
+
+	// GetV2TemplatesNameVersionExportWithResponse request
+	GetV2TemplatesNameVersionExportWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionExportResponse, error)
+
+	// PostV2TemplatesNameVersionPublishWithResponse request
+	PostV2TemplatesNameVersionPublishWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionPublishResponse, error)
+
+	// GetV2WebhooksDestinationsWithResponse request
+	GetV2WebhooksDestinationsWithResponse(ctx context.Context, params *GetV2WebhooksDestinationsParams, reqEditors ...RequestEditorFn) (*GetV2WebhooksDestinationsResponse, error)
+}
+
+type GetV2AdminExportsProjectIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2AdminExportsProjectIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2AdminExportsProjectIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2AdminSupportBundlesProjectIdClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2AdminSupportBundlesProjectIdClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ApichangelogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiChangelog
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ApichangelogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ApichangelogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2AuthzSelfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EffectivePermissions
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2AuthzSelfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2AuthzSelfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+
+		// NextPageToken The pageToken of the next page, absent on the last page.
+		NextPageToken *string `json:"nextPageToken,omitempty"`
+
+		// TotalElements The count of items in the entire list, regardless of pagination. It is an estimate while paging with pageToken through a listing filtered by labels.
+		TotalElements int32 `json:"totalElements"`
+	}
+	JSON400 *N400BadRequest
+	JSON500 *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterDryRun
+	JSON201      *string
+	JSON202      *PendingCluster
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON409      *N409Conflict
+	JSON422      *NodeValidationProblem
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameSuggestionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterNameSuggestion
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameSuggestionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameSuggestionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterSummary
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterDetailInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterBackupList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersNameBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClusterBackup
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersNameBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersNameBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersNameCloneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *string
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON422      *NodeValidationProblem
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersNameCloneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersNameCloneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ClustersNameDeletionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ClustersNameDeletionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ClustersNameDeletionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterEventList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ClustersNameExtensionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterExtensions
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ClustersNameExtensionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ClustersNameExtensionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterHealth
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ClustersNameKubeconfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON401      *N401Unauthorized
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ClustersNameKubeconfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ClustersNameKubeconfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameKubeconfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubeconfigInfo
+	JSON400      *N400BadRequest
+	JSON401      *N401Unauthorized
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameKubeconfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameKubeconfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV2ClustersNameLabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VersionedClusterLabels
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV2ClustersNameLabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV2ClustersNameLabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ClustersNameLabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ClustersNameLabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ClustersNameLabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ClustersNameMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ClustersNameMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ClustersNameMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ClustersNameMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterMaintenance
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ClustersNameMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ClustersNameMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameNodepoolsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodePoolList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameNodepoolsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameNodepoolsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersNameNodepoolsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NodePool
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersNameNodepoolsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersNameNodepoolsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV2ClustersNameNodepoolsPoolNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodePool
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV2ClustersNameNodepoolsPoolNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV2ClustersNameNodepoolsPoolNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ClustersNameNodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ClustersNameNodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ClustersNameNodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ClustersNameNodesNodeIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ClustersNameNodesNodeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ClustersNameNodesNodeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersNameNodesNodeIdCordonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeMaintenance
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersNameNodesNodeIdCordonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersNameNodesNodeIdCordonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameNodesNodeIdLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameNodesNodeIdLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameNodesNodeIdLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersNameNodesNodeIdUncordonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeMaintenance
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersNameNodesNodeIdUncordonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersNameNodesNodeIdUncordonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ClustersNameRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClusterBackup
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ClustersNameRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ClustersNameRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ClustersNameTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ClustersNameTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ClustersNameTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNameUpgradesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterUpgrades
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNameUpgradesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNameUpgradesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ClustersNodeIdClusterdetailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterDetailInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ClustersNodeIdClusterdetailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ClustersNodeIdClusterdetailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2DocsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2DocsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2DocsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ExtensionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExtensionCatalog
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ExtensionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ExtensionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2HealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+	JSON500      *N500InternalServerError
+	JSON503      *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2HealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2HealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2OpenapiJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2OpenapiJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2OpenapiJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2OperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OperationList
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2OperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2OperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2OperationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Operation
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2OperationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2OperationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2PendingClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingClusterList
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2PendingClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2PendingClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2PendingClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2PendingClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2PendingClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2PendingClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingCluster
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2PendingClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2PendingClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2PendingClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingCluster
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2PendingClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2PendingClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameAuthzSelfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EffectivePermissions
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameAuthzSelfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameAuthzSelfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+
+		// NextPageToken The pageToken of the next page, absent on the last page.
+		NextPageToken *string `json:"nextPageToken,omitempty"`
+
+		// TotalElements The count of items in the entire list, regardless of pagination. It is an estimate while paging with pageToken through a listing filtered by labels.
+		TotalElements int32 `json:"totalElements"`
+	}
+	JSON400 *N400BadRequest
+	JSON500 *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterDryRun
+	JSON201      *string
+	JSON202      *PendingCluster
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON409      *N409Conflict
+	JSON422      *NodeValidationProblem
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameClustersImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameClustersImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameClustersImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameSuggestionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterNameSuggestion
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameSuggestionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameSuggestionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterSummary
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNameClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNameClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNameClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterDetailInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterBackupList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameClustersNameBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClusterBackup
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameClustersNameBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameClustersNameBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNameClustersNameDeletionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNameClustersNameDeletionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNameClustersNameDeletionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterEventList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNameClustersNameExtensionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterExtensions
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNameClustersNameExtensionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNameClustersNameExtensionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterHealth
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON401      *N401Unauthorized
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameKubeconfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubeconfigInfo
+	JSON400      *N400BadRequest
+	JSON401      *N401Unauthorized
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameKubeconfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameKubeconfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV2ProjectsProjectNameClustersNameLabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VersionedClusterLabels
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV2ProjectsProjectNameClustersNameLabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV2ProjectsProjectNameClustersNameLabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNameClustersNameLabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNameClustersNameLabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNameClustersNameLabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNameClustersNameMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterMaintenance
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNameClustersNameMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNameClustersNameMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameNodepoolsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodePoolList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameNodepoolsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameNodepoolsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameClustersNameNodepoolsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NodePool
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameClustersNameNodepoolsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameClustersNameNodepoolsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodePool
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNameClustersNameNodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON403      *N403Forbidden
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNameClustersNameNodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNameClustersNameNodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeMaintenance
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeMaintenance
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameClustersNameRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClusterBackup
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameClustersNameRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameClustersNameRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNameClustersNameTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNameClustersNameTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNameClustersNameTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNameUpgradesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterUpgrades
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNameUpgradesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNameUpgradesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterDetailInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OperationList
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameOperationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Operation
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameOperationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameOperationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNamePendingClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingClusterList
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNamePendingClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNamePendingClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNamePendingClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNamePendingClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNamePendingClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNamePendingClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingCluster
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNamePendingClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNamePendingClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNamePendingClustersNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingCluster
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNamePendingClustersNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNamePendingClustersNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSummary
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameTemplateUploadsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TemplateUpload
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameTemplateUploadsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameTemplateUploadsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNameTemplateUploadsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNameTemplateUploadsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNameTemplateUploadsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameTemplateUploadsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateUpload
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameTemplateUploadsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameTemplateUploadsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateUpload
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *string
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfoList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *string
+	JSON400      *N400BadRequest
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameTemplatesCompatibilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateCompatibilityList
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameTemplatesCompatibilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameTemplatesCompatibilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNameTemplatesNameDefaultResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNameTemplatesNameDefaultResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNameTemplatesNameDefaultResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameTemplatesNameVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VersionList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameTemplatesNameVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameTemplatesNameVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2ProjectsProjectNameTemplatesNameVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2ProjectsProjectNameTemplatesNameVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2ProjectsProjectNameTemplatesNameVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameTemplatesNameVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameTemplatesNameVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameTemplatesNameVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2ProjectsProjectNameTemplatesNameVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2ProjectsProjectNameTemplatesNameVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2ProjectsProjectNameTemplatesNameVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LabelPropagationPreview
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateClusters
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameTemplatesNameVersionExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateBundle
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameTemplatesNameVersionExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameTemplatesNameVersionExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ProjectsProjectNameWebhooksDestinationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDestinationList
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ProjectsProjectNameWebhooksDestinationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ProjectsProjectNameWebhooksDestinationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2ReadyzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Readiness
+	JSON500      *N500InternalServerError
+	JSON503      *Readiness
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2ReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2ReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2SummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectSummary
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2SummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2SummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2SupportmatrixResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupportMatrix
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2SupportmatrixResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2SupportmatrixResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2TemplateUploadsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TemplateUpload
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2TemplateUploadsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplateUploadsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2TemplateUploadsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2TemplateUploadsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2TemplateUploadsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2TemplateUploadsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateUpload
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2TemplateUploadsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplateUploadsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2TemplateUploadsIdChunksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateUpload
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2TemplateUploadsIdChunksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplateUploadsIdChunksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2TemplateUploadsIdCommitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *string
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2TemplateUploadsIdCommitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplateUploadsIdCommitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2TemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfoList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2TemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2TemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *string
+	JSON400      *N400BadRequest
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2TemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2TemplatesCompatibilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateCompatibilityList
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2TemplatesCompatibilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplatesCompatibilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2TemplatesNameDefaultResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2TemplatesNameDefaultResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2TemplatesNameDefaultResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2TemplatesNameVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VersionList
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2TemplatesNameVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplatesNameVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV2TemplatesNameVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV2TemplatesNameVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV2TemplatesNameVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2TemplatesNameVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2TemplatesNameVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplatesNameVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutV2TemplatesNameVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV2TemplatesNameVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV2TemplatesNameVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2TemplatesNameVersionClusterlabelsPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LabelPropagationPreview
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2TemplatesNameVersionClusterlabelsPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplatesNameVersionClusterlabelsPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2TemplatesNameVersionClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateClusters
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2TemplatesNameVersionClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplatesNameVersionClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2TemplatesNameVersionDeprecateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2TemplatesNameVersionDeprecateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplatesNameVersionDeprecateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2TemplatesNameVersionExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateBundle
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2TemplatesNameVersionExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplatesNameVersionExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV2TemplatesNameVersionPublishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateInfo
+	JSON400      *N400BadRequest
+	JSON404      *N404NotFound
+	JSON409      *N409Conflict
+	JSON500      *N500InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV2TemplatesNameVersionPublishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplatesNameVersionPublishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV2WebhooksDestinationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDestinationList
+	JSON400      *N400BadRequest
+	JSON500      *N500InternalServerError
+	JSON501      *N501NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV2WebhooksDestinationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2WebhooksDestinationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetV2AdminExportsProjectIdWithResponse request returning *GetV2AdminExportsProjectIdResponse
+func (c *ClientWithResponses) GetV2AdminExportsProjectIdWithResponse(ctx context.Context, projectId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV2AdminExportsProjectIdResponse, error) {
+	rsp, err := c.GetV2AdminExportsProjectId(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2AdminExportsProjectIdResponse(rsp)
+}
+
+// GetV2AdminSupportBundlesProjectIdClustersNameWithResponse request returning *GetV2AdminSupportBundlesProjectIdClustersNameResponse
+func (c *ClientWithResponses) GetV2AdminSupportBundlesProjectIdClustersNameWithResponse(ctx context.Context, projectId openapi_types.UUID, name string, reqEditors ...RequestEditorFn) (*GetV2AdminSupportBundlesProjectIdClustersNameResponse, error) {
+	rsp, err := c.GetV2AdminSupportBundlesProjectIdClustersName(ctx, projectId, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2AdminSupportBundlesProjectIdClustersNameResponse(rsp)
+}
+
+// GetV2ApichangelogWithResponse request returning *GetV2ApichangelogResponse
+func (c *ClientWithResponses) GetV2ApichangelogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2ApichangelogResponse, error) {
+	rsp, err := c.GetV2Apichangelog(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ApichangelogResponse(rsp)
+}
+
+// GetV2AuthzSelfWithResponse request returning *GetV2AuthzSelfResponse
+func (c *ClientWithResponses) GetV2AuthzSelfWithResponse(ctx context.Context, params *GetV2AuthzSelfParams, reqEditors ...RequestEditorFn) (*GetV2AuthzSelfResponse, error) {
+	rsp, err := c.GetV2AuthzSelf(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2AuthzSelfResponse(rsp)
+}
+
+// GetV2ClustersWithResponse request returning *GetV2ClustersResponse
+func (c *ClientWithResponses) GetV2ClustersWithResponse(ctx context.Context, params *GetV2ClustersParams, reqEditors ...RequestEditorFn) (*GetV2ClustersResponse, error) {
+	rsp, err := c.GetV2Clusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersResponse(rsp)
+}
+
+// PostV2ClustersWithBodyWithResponse request with arbitrary body returning *PostV2ClustersResponse
+func (c *ClientWithResponses) PostV2ClustersWithBodyWithResponse(ctx context.Context, params *PostV2ClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error) {
+	rsp, err := c.PostV2ClustersWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ClustersWithResponse(ctx context.Context, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error) {
+	rsp, err := c.PostV2Clusters(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersResponse(rsp)
+}
+
+// PostV2ClustersImportWithBodyWithResponse request with arbitrary body returning *PostV2ClustersImportResponse
+func (c *ClientWithResponses) PostV2ClustersImportWithBodyWithResponse(ctx context.Context, params *PostV2ClustersImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersImportResponse, error) {
+	rsp, err := c.PostV2ClustersImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ClustersImportWithResponse(ctx context.Context, params *PostV2ClustersImportParams, body PostV2ClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersImportResponse, error) {
+	rsp, err := c.PostV2ClustersImport(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersImportResponse(rsp)
+}
+
+// GetV2ClustersNameSuggestionWithResponse request returning *GetV2ClustersNameSuggestionResponse
+func (c *ClientWithResponses) GetV2ClustersNameSuggestionWithResponse(ctx context.Context, params *GetV2ClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameSuggestionResponse, error) {
+	rsp, err := c.GetV2ClustersNameSuggestion(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameSuggestionResponse(rsp)
+}
+
+// GetV2ClustersSummaryWithResponse request returning *GetV2ClustersSummaryResponse
+func (c *ClientWithResponses) GetV2ClustersSummaryWithResponse(ctx context.Context, params *GetV2ClustersSummaryParams, reqEditors ...RequestEditorFn) (*GetV2ClustersSummaryResponse, error) {
+	rsp, err := c.GetV2ClustersSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersSummaryResponse(rsp)
+}
+
+// DeleteV2ClustersNameWithResponse request returning *DeleteV2ClustersNameResponse
+func (c *ClientWithResponses) DeleteV2ClustersNameWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameResponse, error) {
+	rsp, err := c.DeleteV2ClustersName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ClustersNameResponse(rsp)
+}
+
+// GetV2ClustersNameWithResponse request returning *GetV2ClustersNameResponse
+func (c *ClientWithResponses) GetV2ClustersNameWithResponse(ctx context.Context, name string, params *GetV2ClustersNameParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameResponse, error) {
+	rsp, err := c.GetV2ClustersName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameResponse(rsp)
+}
+
+// GetV2ClustersNameBackupsWithResponse request returning *GetV2ClustersNameBackupsResponse
+func (c *ClientWithResponses) GetV2ClustersNameBackupsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameBackupsResponse, error) {
+	rsp, err := c.GetV2ClustersNameBackups(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameBackupsResponse(rsp)
+}
+
+// PostV2ClustersNameBackupsWithResponse request returning *PostV2ClustersNameBackupsResponse
+func (c *ClientWithResponses) PostV2ClustersNameBackupsWithResponse(ctx context.Context, name string, params *PostV2ClustersNameBackupsParams, reqEditors ...RequestEditorFn) (*PostV2ClustersNameBackupsResponse, error) {
+	rsp, err := c.PostV2ClustersNameBackups(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameBackupsResponse(rsp)
+}
+
+// PostV2ClustersNameCloneWithBodyWithResponse request with arbitrary body returning *PostV2ClustersNameCloneResponse
+func (c *ClientWithResponses) PostV2ClustersNameCloneWithBodyWithResponse(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameCloneResponse, error) {
+	rsp, err := c.PostV2ClustersNameCloneWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameCloneResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ClustersNameCloneWithResponse(ctx context.Context, name string, params *PostV2ClustersNameCloneParams, body PostV2ClustersNameCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameCloneResponse, error) {
+	rsp, err := c.PostV2ClustersNameClone(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameCloneResponse(rsp)
+}
+
+// DeleteV2ClustersNameDeletionWithResponse request returning *DeleteV2ClustersNameDeletionResponse
+func (c *ClientWithResponses) DeleteV2ClustersNameDeletionWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameDeletionParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameDeletionResponse, error) {
+	rsp, err := c.DeleteV2ClustersNameDeletion(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ClustersNameDeletionResponse(rsp)
+}
+
+// GetV2ClustersNameEventsWithResponse request returning *GetV2ClustersNameEventsResponse
+func (c *ClientWithResponses) GetV2ClustersNameEventsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameEventsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameEventsResponse, error) {
+	rsp, err := c.GetV2ClustersNameEvents(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameEventsResponse(rsp)
+}
+
+// PutV2ClustersNameExtensionsWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameExtensionsResponse
+func (c *ClientWithResponses) PutV2ClustersNameExtensionsWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameExtensionsResponse, error) {
+	rsp, err := c.PutV2ClustersNameExtensionsWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameExtensionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ClustersNameExtensionsWithResponse(ctx context.Context, name string, params *PutV2ClustersNameExtensionsParams, body PutV2ClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameExtensionsResponse, error) {
+	rsp, err := c.PutV2ClustersNameExtensions(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameExtensionsResponse(rsp)
+}
+
+// GetV2ClustersNameHealthWithResponse request returning *GetV2ClustersNameHealthResponse
+func (c *ClientWithResponses) GetV2ClustersNameHealthWithResponse(ctx context.Context, name string, params *GetV2ClustersNameHealthParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameHealthResponse, error) {
+	rsp, err := c.GetV2ClustersNameHealth(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameHealthResponse(rsp)
+}
+
+// DeleteV2ClustersNameKubeconfigsWithResponse request returning *DeleteV2ClustersNameKubeconfigsResponse
+func (c *ClientWithResponses) DeleteV2ClustersNameKubeconfigsWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameKubeconfigsResponse, error) {
+	rsp, err := c.DeleteV2ClustersNameKubeconfigs(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ClustersNameKubeconfigsResponse(rsp)
+}
+
+// GetV2ClustersNameKubeconfigsWithResponse request returning *GetV2ClustersNameKubeconfigsResponse
+func (c *ClientWithResponses) GetV2ClustersNameKubeconfigsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameKubeconfigsResponse, error) {
+	rsp, err := c.GetV2ClustersNameKubeconfigs(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameKubeconfigsResponse(rsp)
+}
+
+// PatchV2ClustersNameLabelsWithBodyWithResponse request with arbitrary body returning *PatchV2ClustersNameLabelsResponse
+func (c *ClientWithResponses) PatchV2ClustersNameLabelsWithBodyWithResponse(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameLabelsResponse, error) {
+	rsp, err := c.PatchV2ClustersNameLabelsWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ClustersNameLabelsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV2ClustersNameLabelsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchV2ClustersNameLabelsParams, body PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameLabelsResponse, error) {
+	rsp, err := c.PatchV2ClustersNameLabelsWithApplicationMergePatchPlusJSONBody(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ClustersNameLabelsResponse(rsp)
+}
+
+// PutV2ClustersNameLabelsWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameLabelsResponse
+func (c *ClientWithResponses) PutV2ClustersNameLabelsWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error) {
+	rsp, err := c.PutV2ClustersNameLabelsWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameLabelsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ClustersNameLabelsWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error) {
+	rsp, err := c.PutV2ClustersNameLabels(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameLabelsResponse(rsp)
+}
+
+// DeleteV2ClustersNameMaintenanceWithResponse request returning *DeleteV2ClustersNameMaintenanceResponse
+func (c *ClientWithResponses) DeleteV2ClustersNameMaintenanceWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameMaintenanceResponse, error) {
+	rsp, err := c.DeleteV2ClustersNameMaintenance(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ClustersNameMaintenanceResponse(rsp)
+}
+
+// PutV2ClustersNameMaintenanceWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameMaintenanceResponse
+func (c *ClientWithResponses) PutV2ClustersNameMaintenanceWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameMaintenanceResponse, error) {
+	rsp, err := c.PutV2ClustersNameMaintenanceWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameMaintenanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ClustersNameMaintenanceWithResponse(ctx context.Context, name string, params *PutV2ClustersNameMaintenanceParams, body PutV2ClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameMaintenanceResponse, error) {
+	rsp, err := c.PutV2ClustersNameMaintenance(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameMaintenanceResponse(rsp)
+}
+
+// GetV2ClustersNameNodepoolsWithResponse request returning *GetV2ClustersNameNodepoolsResponse
+func (c *ClientWithResponses) GetV2ClustersNameNodepoolsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameNodepoolsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameNodepoolsResponse, error) {
+	rsp, err := c.GetV2ClustersNameNodepools(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameNodepoolsResponse(rsp)
+}
+
+// PostV2ClustersNameNodepoolsWithBodyWithResponse request with arbitrary body returning *PostV2ClustersNameNodepoolsResponse
+func (c *ClientWithResponses) PostV2ClustersNameNodepoolsWithBodyWithResponse(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodepoolsResponse, error) {
+	rsp, err := c.PostV2ClustersNameNodepoolsWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameNodepoolsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ClustersNameNodepoolsWithResponse(ctx context.Context, name string, params *PostV2ClustersNameNodepoolsParams, body PostV2ClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodepoolsResponse, error) {
+	rsp, err := c.PostV2ClustersNameNodepools(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameNodepoolsResponse(rsp)
+}
+
+// PatchV2ClustersNameNodepoolsPoolNameWithBodyWithResponse request with arbitrary body returning *PatchV2ClustersNameNodepoolsPoolNameResponse
+func (c *ClientWithResponses) PatchV2ClustersNameNodepoolsPoolNameWithBodyWithResponse(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameNodepoolsPoolNameResponse, error) {
+	rsp, err := c.PatchV2ClustersNameNodepoolsPoolNameWithBody(ctx, name, poolName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ClustersNameNodepoolsPoolNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV2ClustersNameNodepoolsPoolNameWithResponse(ctx context.Context, name string, poolName string, params *PatchV2ClustersNameNodepoolsPoolNameParams, body PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ClustersNameNodepoolsPoolNameResponse, error) {
+	rsp, err := c.PatchV2ClustersNameNodepoolsPoolName(ctx, name, poolName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ClustersNameNodepoolsPoolNameResponse(rsp)
+}
+
+// PutV2ClustersNameNodesWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameNodesResponse
+func (c *ClientWithResponses) PutV2ClustersNameNodesWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error) {
+	rsp, err := c.PutV2ClustersNameNodesWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameNodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ClustersNameNodesWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error) {
+	rsp, err := c.PutV2ClustersNameNodes(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameNodesResponse(rsp)
+}
+
+// DeleteV2ClustersNameNodesNodeIdWithResponse request returning *DeleteV2ClustersNameNodesNodeIdResponse
+func (c *ClientWithResponses) DeleteV2ClustersNameNodesNodeIdWithResponse(ctx context.Context, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameNodesNodeIdResponse, error) {
+	rsp, err := c.DeleteV2ClustersNameNodesNodeId(ctx, name, nodeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ClustersNameNodesNodeIdResponse(rsp)
+}
+
+// PostV2ClustersNameNodesNodeIdCordonWithBodyWithResponse request with arbitrary body returning *PostV2ClustersNameNodesNodeIdCordonResponse
+func (c *ClientWithResponses) PostV2ClustersNameNodesNodeIdCordonWithBodyWithResponse(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodesNodeIdCordonResponse, error) {
+	rsp, err := c.PostV2ClustersNameNodesNodeIdCordonWithBody(ctx, name, nodeId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameNodesNodeIdCordonResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ClustersNameNodesNodeIdCordonWithResponse(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdCordonParams, body PostV2ClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodesNodeIdCordonResponse, error) {
+	rsp, err := c.PostV2ClustersNameNodesNodeIdCordon(ctx, name, nodeId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameNodesNodeIdCordonResponse(rsp)
+}
+
+// GetV2ClustersNameNodesNodeIdLogsWithResponse request returning *GetV2ClustersNameNodesNodeIdLogsResponse
+func (c *ClientWithResponses) GetV2ClustersNameNodesNodeIdLogsWithResponse(ctx context.Context, name string, nodeId string, params *GetV2ClustersNameNodesNodeIdLogsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameNodesNodeIdLogsResponse, error) {
+	rsp, err := c.GetV2ClustersNameNodesNodeIdLogs(ctx, name, nodeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameNodesNodeIdLogsResponse(rsp)
+}
+
+// PostV2ClustersNameNodesNodeIdUncordonWithResponse request returning *PostV2ClustersNameNodesNodeIdUncordonResponse
+func (c *ClientWithResponses) PostV2ClustersNameNodesNodeIdUncordonWithResponse(ctx context.Context, name string, nodeId string, params *PostV2ClustersNameNodesNodeIdUncordonParams, reqEditors ...RequestEditorFn) (*PostV2ClustersNameNodesNodeIdUncordonResponse, error) {
+	rsp, err := c.PostV2ClustersNameNodesNodeIdUncordon(ctx, name, nodeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameNodesNodeIdUncordonResponse(rsp)
+}
+
+// PostV2ClustersNameRestoreWithBodyWithResponse request with arbitrary body returning *PostV2ClustersNameRestoreResponse
+func (c *ClientWithResponses) PostV2ClustersNameRestoreWithBodyWithResponse(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersNameRestoreResponse, error) {
+	rsp, err := c.PostV2ClustersNameRestoreWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameRestoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ClustersNameRestoreWithResponse(ctx context.Context, name string, params *PostV2ClustersNameRestoreParams, body PostV2ClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersNameRestoreResponse, error) {
+	rsp, err := c.PostV2ClustersNameRestore(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ClustersNameRestoreResponse(rsp)
+}
+
+// PutV2ClustersNameTemplateWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameTemplateResponse
+func (c *ClientWithResponses) PutV2ClustersNameTemplateWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error) {
+	rsp, err := c.PutV2ClustersNameTemplateWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ClustersNameTemplateWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error) {
+	rsp, err := c.PutV2ClustersNameTemplate(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ClustersNameTemplateResponse(rsp)
+}
+
+// GetV2ClustersNameUpgradesWithResponse request returning *GetV2ClustersNameUpgradesResponse
+func (c *ClientWithResponses) GetV2ClustersNameUpgradesWithResponse(ctx context.Context, name string, params *GetV2ClustersNameUpgradesParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameUpgradesResponse, error) {
+	rsp, err := c.GetV2ClustersNameUpgrades(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNameUpgradesResponse(rsp)
+}
+
+// GetV2ClustersNodeIdClusterdetailWithResponse request returning *GetV2ClustersNodeIdClusterdetailResponse
+func (c *ClientWithResponses) GetV2ClustersNodeIdClusterdetailWithResponse(ctx context.Context, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNodeIdClusterdetailResponse, error) {
+	rsp, err := c.GetV2ClustersNodeIdClusterdetail(ctx, nodeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ClustersNodeIdClusterdetailResponse(rsp)
+}
+
+// GetV2DocsWithResponse request returning *GetV2DocsResponse
+func (c *ClientWithResponses) GetV2DocsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2DocsResponse, error) {
+	rsp, err := c.GetV2Docs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2DocsResponse(rsp)
+}
+
+// GetV2ExtensionsWithResponse request returning *GetV2ExtensionsResponse
+func (c *ClientWithResponses) GetV2ExtensionsWithResponse(ctx context.Context, params *GetV2ExtensionsParams, reqEditors ...RequestEditorFn) (*GetV2ExtensionsResponse, error) {
+	rsp, err := c.GetV2Extensions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ExtensionsResponse(rsp)
+}
+
+// GetV2HealthzWithResponse request returning *GetV2HealthzResponse
+func (c *ClientWithResponses) GetV2HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2HealthzResponse, error) {
+	rsp, err := c.GetV2Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2HealthzResponse(rsp)
+}
+
+// GetV2OpenapiJsonWithResponse request returning *GetV2OpenapiJsonResponse
+func (c *ClientWithResponses) GetV2OpenapiJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2OpenapiJsonResponse, error) {
+	rsp, err := c.GetV2OpenapiJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2OpenapiJsonResponse(rsp)
+}
+
+// GetV2OperationsWithResponse request returning *GetV2OperationsResponse
+func (c *ClientWithResponses) GetV2OperationsWithResponse(ctx context.Context, params *GetV2OperationsParams, reqEditors ...RequestEditorFn) (*GetV2OperationsResponse, error) {
+	rsp, err := c.GetV2Operations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2OperationsResponse(rsp)
+}
+
+// GetV2OperationsIdWithResponse request returning *GetV2OperationsIdResponse
+func (c *ClientWithResponses) GetV2OperationsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetV2OperationsIdParams, reqEditors ...RequestEditorFn) (*GetV2OperationsIdResponse, error) {
+	rsp, err := c.GetV2OperationsId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2OperationsIdResponse(rsp)
+}
+
+// GetV2PendingClustersWithResponse request returning *GetV2PendingClustersResponse
+func (c *ClientWithResponses) GetV2PendingClustersWithResponse(ctx context.Context, params *GetV2PendingClustersParams, reqEditors ...RequestEditorFn) (*GetV2PendingClustersResponse, error) {
+	rsp, err := c.GetV2PendingClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2PendingClustersResponse(rsp)
+}
+
+// DeleteV2PendingClustersNameWithResponse request returning *DeleteV2PendingClustersNameResponse
+func (c *ClientWithResponses) DeleteV2PendingClustersNameWithResponse(ctx context.Context, name string, params *DeleteV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2PendingClustersNameResponse, error) {
+	rsp, err := c.DeleteV2PendingClustersName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2PendingClustersNameResponse(rsp)
+}
+
+// GetV2PendingClustersNameWithResponse request returning *GetV2PendingClustersNameResponse
+func (c *ClientWithResponses) GetV2PendingClustersNameWithResponse(ctx context.Context, name string, params *GetV2PendingClustersNameParams, reqEditors ...RequestEditorFn) (*GetV2PendingClustersNameResponse, error) {
+	rsp, err := c.GetV2PendingClustersName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2PendingClustersNameResponse(rsp)
+}
+
+// PutV2PendingClustersNameWithBodyWithResponse request with arbitrary body returning *PutV2PendingClustersNameResponse
+func (c *ClientWithResponses) PutV2PendingClustersNameWithBodyWithResponse(ctx context.Context, name string, params *PutV2PendingClustersNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2PendingClustersNameResponse, error) {
+	rsp, err := c.PutV2PendingClustersNameWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2PendingClustersNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2PendingClustersNameWithResponse(ctx context.Context, name string, params *PutV2PendingClustersNameParams, body PutV2PendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2PendingClustersNameResponse, error) {
+	rsp, err := c.PutV2PendingClustersName(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2PendingClustersNameResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameAuthzSelfWithResponse request returning *GetV2ProjectsProjectNameAuthzSelfResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameAuthzSelfWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameAuthzSelfParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameAuthzSelfResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameAuthzSelf(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameAuthzSelfResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersWithResponse request returning *GetV2ProjectsProjectNameClustersResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClusters(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameClustersWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameClustersResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersWithBody(ctx, projectName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameClustersParams, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClusters(ctx, projectName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameClustersImportWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameClustersImportResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersImportWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersImportResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersImportWithBody(ctx, projectName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersImportWithResponse(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersImportResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersImport(ctx, projectName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersImportResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameSuggestionWithResponse request returning *GetV2ProjectsProjectNameClustersNameSuggestionResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameSuggestionWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersNameSuggestionParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameSuggestionResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNameSuggestion(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameSuggestionResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersSummaryWithResponse request returning *GetV2ProjectsProjectNameClustersSummaryResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersSummaryWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersSummaryResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersSummary(ctx, projectName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersSummaryResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNameClustersNameWithResponse request returning *DeleteV2ProjectsProjectNameClustersNameResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNameClustersName(ctx, projectName, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNameClustersNameResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameWithResponse request returning *GetV2ProjectsProjectNameClustersNameResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersName(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameBackupsWithResponse request returning *GetV2ProjectsProjectNameClustersNameBackupsResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameBackupsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameBackupsResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNameBackups(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameBackupsResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameClustersNameBackupsWithResponse request returning *PostV2ProjectsProjectNameClustersNameBackupsResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameBackupsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameBackupsResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameBackups(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameBackupsResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNameClustersNameDeletionWithResponse request returning *DeleteV2ProjectsProjectNameClustersNameDeletionResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNameClustersNameDeletionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameDeletionResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNameClustersNameDeletion(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNameClustersNameDeletionResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameEventsWithResponse request returning *GetV2ProjectsProjectNameClustersNameEventsResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameEventsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameEventsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameEventsResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNameEvents(ctx, projectName, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameEventsResponse(rsp)
+}
+
+// PutV2ProjectsProjectNameClustersNameExtensionsWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameExtensionsResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameExtensionsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameExtensionsResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameExtensionsWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameExtensionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameExtensionsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameExtensionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameExtensionsResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameExtensions(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameExtensionsResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameHealthWithResponse request returning *GetV2ProjectsProjectNameClustersNameHealthResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameHealthWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameHealthResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNameHealth(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameHealthResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNameClustersNameKubeconfigsWithResponse request returning *DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNameClustersNameKubeconfigs(ctx, projectName, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse request returning *GetV2ProjectsProjectNameClustersNameKubeconfigsResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameKubeconfigsResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNameKubeconfigs(ctx, projectName, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameKubeconfigsResponse(rsp)
+}
+
+// PatchV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse request with arbitrary body returning *PatchV2ProjectsProjectNameClustersNameLabelsResponse
+func (c *ClientWithResponses) PatchV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameLabelsResponse, error) {
+	rsp, err := c.PatchV2ProjectsProjectNameClustersNameLabelsWithBody(ctx, projectName, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ProjectsProjectNameClustersNameLabelsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV2ProjectsProjectNameClustersNameLabelsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PatchV2ProjectsProjectNameClustersNameLabelsParams, body PatchV2ProjectsProjectNameClustersNameLabelsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameLabelsResponse, error) {
+	rsp, err := c.PatchV2ProjectsProjectNameClustersNameLabelsWithApplicationMergePatchPlusJSONBody(ctx, projectName, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ProjectsProjectNameClustersNameLabelsResponse(rsp)
+}
+
+// PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameLabelsResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameLabelsWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameLabelsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameLabelsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameLabels(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameLabelsResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNameClustersNameMaintenanceWithResponse request returning *DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNameClustersNameMaintenanceWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *DeleteV2ProjectsProjectNameClustersNameMaintenanceParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNameClustersNameMaintenance(ctx, projectName, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNameClustersNameMaintenanceResponse(rsp)
+}
+
+// PutV2ProjectsProjectNameClustersNameMaintenanceWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameMaintenanceResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameMaintenanceWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameMaintenanceResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameMaintenanceWithBody(ctx, projectName, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameMaintenanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameMaintenanceWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameClustersNameMaintenanceParams, body PutV2ProjectsProjectNameClustersNameMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameMaintenanceResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameMaintenance(ctx, projectName, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameMaintenanceResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameNodepoolsWithResponse request returning *GetV2ProjectsProjectNameClustersNameNodepoolsResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameNodepoolsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameNodepoolsResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNameNodepools(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameNodepoolsResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameClustersNameNodepoolsWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameClustersNameNodepoolsResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameNodepoolsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodepoolsResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameNodepoolsWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameNodepoolsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameNodepoolsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameNodepoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodepoolsResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameNodepools(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameNodepoolsResponse(rsp)
+}
+
+// PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBodyWithResponse request with arbitrary body returning *PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse
+func (c *ClientWithResponses) PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, poolName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse, error) {
+	rsp, err := c.PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithBody(ctx, projectName, name, poolName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, poolName string, body PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse, error) {
+	rsp, err := c.PatchV2ProjectsProjectNameClustersNameNodepoolsPoolName(ctx, projectName, name, poolName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse(rsp)
+}
+
+// PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameNodesResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameNodesWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameNodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameNodesWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameNodes(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameNodesResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse request returning *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNameClustersNameNodesNodeId(ctx, projectName, name, nodeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithBody(ctx, projectName, name, nodeId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, body PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameNodesNodeIdCordon(ctx, projectName, name, nodeId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonWithResponse request returning *PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordon(ctx, projectName, name, nodeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameClustersNameRestoreWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameClustersNameRestoreResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameRestoreWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameRestoreResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameRestoreWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameRestoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersNameRestoreWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PostV2ProjectsProjectNameClustersNameRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersNameRestoreResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameClustersNameRestore(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameClustersNameRestoreResponse(rsp)
+}
+
+// PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameTemplateResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameTemplateWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameTemplateWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameClustersNameTemplate(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNameUpgradesWithResponse request returning *GetV2ProjectsProjectNameClustersNameUpgradesResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameUpgradesWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameUpgradesResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNameUpgrades(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNameUpgradesResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse request returning *GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse(ctx context.Context, projectName ProjectNamePath, nodeId string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameClustersNodeIdClusterdetail(ctx, projectName, nodeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameOperationsWithResponse request returning *GetV2ProjectsProjectNameOperationsResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameOperationsWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameOperationsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameOperationsResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameOperations(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameOperationsResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameOperationsIdWithResponse request returning *GetV2ProjectsProjectNameOperationsIdResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameOperationsIdWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameOperationsIdResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameOperationsId(ctx, projectName, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameOperationsIdResponse(rsp)
+}
+
+// GetV2ProjectsProjectNamePendingClustersWithResponse request returning *GetV2ProjectsProjectNamePendingClustersResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNamePendingClustersWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNamePendingClustersResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNamePendingClusters(ctx, projectName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNamePendingClustersResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNamePendingClustersNameWithResponse request returning *DeleteV2ProjectsProjectNamePendingClustersNameResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNamePendingClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNamePendingClustersNameResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNamePendingClustersName(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNamePendingClustersNameResponse(rsp)
+}
+
+// GetV2ProjectsProjectNamePendingClustersNameWithResponse request returning *GetV2ProjectsProjectNamePendingClustersNameResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNamePendingClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNamePendingClustersNameResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNamePendingClustersName(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNamePendingClustersNameResponse(rsp)
+}
+
+// PutV2ProjectsProjectNamePendingClustersNameWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNamePendingClustersNameResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNamePendingClustersNameWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNamePendingClustersNameResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNamePendingClustersNameWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNamePendingClustersNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNamePendingClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNamePendingClustersNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNamePendingClustersNameResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNamePendingClustersName(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNamePendingClustersNameResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameSummaryWithResponse request returning *GetV2ProjectsProjectNameSummaryResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameSummaryWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameSummaryParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameSummaryResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameSummary(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameSummaryResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameTemplateUploadsWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameTemplateUploadsResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplateUploadsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplateUploadsWithBody(ctx, projectName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplateUploadsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplateUploadsWithResponse(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameTemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplateUploads(ctx, projectName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplateUploadsResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNameTemplateUploadsIdWithResponse request returning *DeleteV2ProjectsProjectNameTemplateUploadsIdResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNameTemplateUploadsIdWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameTemplateUploadsIdResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNameTemplateUploadsId(ctx, projectName, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNameTemplateUploadsIdResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameTemplateUploadsIdWithResponse request returning *GetV2ProjectsProjectNameTemplateUploadsIdResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplateUploadsIdWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplateUploadsIdResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameTemplateUploadsId(ctx, projectName, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameTemplateUploadsIdResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplateUploadsIdChunksWithBody(ctx, projectName, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplateUploadsIdChunksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplateUploadsIdChunksWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, body PostV2ProjectsProjectNameTemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplateUploadsIdChunks(ctx, projectName, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplateUploadsIdChunksResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameTemplateUploadsIdCommitWithResponse request returning *PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplateUploadsIdCommitWithResponse(ctx context.Context, projectName ProjectNamePath, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplateUploadsIdCommit(ctx, projectName, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplateUploadsIdCommitResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameTemplatesWithResponse request returning *GetV2ProjectsProjectNameTemplatesResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameTemplates(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameTemplatesResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameTemplatesWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameTemplatesResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplatesWithBody(ctx, projectName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplates(ctx, projectName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplatesResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameTemplatesCompatibilityWithResponse request returning *GetV2ProjectsProjectNameTemplatesCompatibilityResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesCompatibilityWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesCompatibilityResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameTemplatesCompatibility(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameTemplatesCompatibilityResponse(rsp)
+}
+
+// PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameTemplatesNameDefaultResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameTemplatesNameDefaultWithBody(ctx, projectName, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNameTemplatesNameDefaultWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameTemplatesNameDefault(ctx, projectName, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse request returning *GetV2ProjectsProjectNameTemplatesNameVersionsResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionsResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameTemplatesNameVersions(ctx, projectName, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameTemplatesNameVersionsResponse(rsp)
+}
+
+// DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse request returning *DeleteV2ProjectsProjectNameTemplatesNameVersionResponse
+func (c *ClientWithResponses) DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
+	rsp, err := c.DeleteV2ProjectsProjectNameTemplatesNameVersion(ctx, projectName, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2ProjectsProjectNameTemplatesNameVersionResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameTemplatesNameVersionWithResponse request returning *GetV2ProjectsProjectNameTemplatesNameVersionResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameTemplatesNameVersion(ctx, projectName, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameTemplatesNameVersionResponse(rsp)
+}
+
+// PutV2ProjectsProjectNameTemplatesNameVersionWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameTemplatesNameVersionResponse
+func (c *ClientWithResponses) PutV2ProjectsProjectNameTemplatesNameVersionWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameTemplatesNameVersionWithBody(ctx, projectName, name, version, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameTemplatesNameVersionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PutV2ProjectsProjectNameTemplatesNameVersionParams, body PutV2ProjectsProjectNameTemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
+	rsp, err := c.PutV2ProjectsProjectNameTemplatesNameVersion(ctx, projectName, name, version, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2ProjectsProjectNameTemplatesNameVersionResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithBody(ctx, projectName, name, version, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams, body PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreview(ctx, projectName, name, version, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameTemplatesNameVersionClustersWithResponse request returning *GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesNameVersionClustersWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameTemplatesNameVersionClusters(ctx, projectName, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameTemplatesNameVersionClustersResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameTemplatesNameVersionDeprecateWithResponse request returning *PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesNameVersionDeprecateWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplatesNameVersionDeprecate(ctx, projectName, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameTemplatesNameVersionExportWithResponse request returning *GetV2ProjectsProjectNameTemplatesNameVersionExportResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesNameVersionExportWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionExportResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameTemplatesNameVersionExport(ctx, projectName, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameTemplatesNameVersionExportResponse(rsp)
+}
+
+// PostV2ProjectsProjectNameTemplatesNameVersionPublishWithResponse request returning *PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse
+func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesNameVersionPublishWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *PostV2ProjectsProjectNameTemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse, error) {
+	rsp, err := c.PostV2ProjectsProjectNameTemplatesNameVersionPublish(ctx, projectName, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2ProjectsProjectNameTemplatesNameVersionPublishResponse(rsp)
+}
+
+// GetV2ProjectsProjectNameWebhooksDestinationsWithResponse request returning *GetV2ProjectsProjectNameWebhooksDestinationsResponse
+func (c *ClientWithResponses) GetV2ProjectsProjectNameWebhooksDestinationsWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameWebhooksDestinationsResponse, error) {
+	rsp, err := c.GetV2ProjectsProjectNameWebhooksDestinations(ctx, projectName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ProjectsProjectNameWebhooksDestinationsResponse(rsp)
+}
+
+// GetV2ReadyzWithResponse request returning *GetV2ReadyzResponse
+func (c *ClientWithResponses) GetV2ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2ReadyzResponse, error) {
+	rsp, err := c.GetV2Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2ReadyzResponse(rsp)
+}
+
+// GetV2SummaryWithResponse request returning *GetV2SummaryResponse
+func (c *ClientWithResponses) GetV2SummaryWithResponse(ctx context.Context, params *GetV2SummaryParams, reqEditors ...RequestEditorFn) (*GetV2SummaryResponse, error) {
+	rsp, err := c.GetV2Summary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2SummaryResponse(rsp)
+}
+
+// GetV2SupportmatrixWithResponse request returning *GetV2SupportmatrixResponse
+func (c *ClientWithResponses) GetV2SupportmatrixWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2SupportmatrixResponse, error) {
+	rsp, err := c.GetV2Supportmatrix(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2SupportmatrixResponse(rsp)
+}
+
+// PostV2TemplateUploadsWithBodyWithResponse request with arbitrary body returning *PostV2TemplateUploadsResponse
+func (c *ClientWithResponses) PostV2TemplateUploadsWithBodyWithResponse(ctx context.Context, params *PostV2TemplateUploadsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsResponse, error) {
+	rsp, err := c.PostV2TemplateUploadsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplateUploadsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2TemplateUploadsWithResponse(ctx context.Context, params *PostV2TemplateUploadsParams, body PostV2TemplateUploadsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsResponse, error) {
+	rsp, err := c.PostV2TemplateUploads(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplateUploadsResponse(rsp)
+}
+
+// DeleteV2TemplateUploadsIdWithResponse request returning *DeleteV2TemplateUploadsIdResponse
+func (c *ClientWithResponses) DeleteV2TemplateUploadsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*DeleteV2TemplateUploadsIdResponse, error) {
+	rsp, err := c.DeleteV2TemplateUploadsId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2TemplateUploadsIdResponse(rsp)
+}
+
+// GetV2TemplateUploadsIdWithResponse request returning *GetV2TemplateUploadsIdResponse
+func (c *ClientWithResponses) GetV2TemplateUploadsIdWithResponse(ctx context.Context, id openapi_types.UUID, params *GetV2TemplateUploadsIdParams, reqEditors ...RequestEditorFn) (*GetV2TemplateUploadsIdResponse, error) {
+	rsp, err := c.GetV2TemplateUploadsId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2TemplateUploadsIdResponse(rsp)
+}
+
+// PostV2TemplateUploadsIdChunksWithBodyWithResponse request with arbitrary body returning *PostV2TemplateUploadsIdChunksResponse
+func (c *ClientWithResponses) PostV2TemplateUploadsIdChunksWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsIdChunksResponse, error) {
+	rsp, err := c.PostV2TemplateUploadsIdChunksWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplateUploadsIdChunksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2TemplateUploadsIdChunksWithResponse(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdChunksParams, body PostV2TemplateUploadsIdChunksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsIdChunksResponse, error) {
+	rsp, err := c.PostV2TemplateUploadsIdChunks(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplateUploadsIdChunksResponse(rsp)
+}
+
+// PostV2TemplateUploadsIdCommitWithResponse request returning *PostV2TemplateUploadsIdCommitResponse
+func (c *ClientWithResponses) PostV2TemplateUploadsIdCommitWithResponse(ctx context.Context, id openapi_types.UUID, params *PostV2TemplateUploadsIdCommitParams, reqEditors ...RequestEditorFn) (*PostV2TemplateUploadsIdCommitResponse, error) {
+	rsp, err := c.PostV2TemplateUploadsIdCommit(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplateUploadsIdCommitResponse(rsp)
+}
+
+// GetV2TemplatesWithResponse request returning *GetV2TemplatesResponse
+func (c *ClientWithResponses) GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error) {
+	rsp, err := c.GetV2Templates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2TemplatesResponse(rsp)
+}
+
+// PostV2TemplatesWithBodyWithResponse request with arbitrary body returning *PostV2TemplatesResponse
+func (c *ClientWithResponses) PostV2TemplatesWithBodyWithResponse(ctx context.Context, params *PostV2TemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
+	rsp, err := c.PostV2TemplatesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2TemplatesWithResponse(ctx context.Context, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
+	rsp, err := c.PostV2Templates(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesResponse(rsp)
+}
+
+// GetV2TemplatesCompatibilityWithResponse request returning *GetV2TemplatesCompatibilityResponse
+func (c *ClientWithResponses) GetV2TemplatesCompatibilityWithResponse(ctx context.Context, params *GetV2TemplatesCompatibilityParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesCompatibilityResponse, error) {
+	rsp, err := c.GetV2TemplatesCompatibility(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2TemplatesCompatibilityResponse(rsp)
+}
+
+// PutV2TemplatesNameDefaultWithBodyWithResponse request with arbitrary body returning *PutV2TemplatesNameDefaultResponse
+func (c *ClientWithResponses) PutV2TemplatesNameDefaultWithBodyWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error) {
+	rsp, err := c.PutV2TemplatesNameDefaultWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2TemplatesNameDefaultResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2TemplatesNameDefaultWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error) {
+	rsp, err := c.PutV2TemplatesNameDefault(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2TemplatesNameDefaultResponse(rsp)
+}
+
+// GetV2TemplatesNameVersionsWithResponse request returning *GetV2TemplatesNameVersionsResponse
+func (c *ClientWithResponses) GetV2TemplatesNameVersionsWithResponse(ctx context.Context, name string, params *GetV2TemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionsResponse, error) {
+	rsp, err := c.GetV2TemplatesNameVersions(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2TemplatesNameVersionsResponse(rsp)
+}
+
+// DeleteV2TemplatesNameVersionWithResponse request returning *DeleteV2TemplatesNameVersionResponse
+func (c *ClientWithResponses) DeleteV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *DeleteV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2TemplatesNameVersionResponse, error) {
+	rsp, err := c.DeleteV2TemplatesNameVersion(ctx, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV2TemplatesNameVersionResponse(rsp)
+}
+
+// GetV2TemplatesNameVersionWithResponse request returning *GetV2TemplatesNameVersionResponse
+func (c *ClientWithResponses) GetV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionResponse, error) {
+	rsp, err := c.GetV2TemplatesNameVersion(ctx, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2TemplatesNameVersionResponse(rsp)
+}
+
+// PutV2TemplatesNameVersionWithBodyWithResponse request with arbitrary body returning *PutV2TemplatesNameVersionResponse
+func (c *ClientWithResponses) PutV2TemplatesNameVersionWithBodyWithResponse(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameVersionResponse, error) {
+	rsp, err := c.PutV2TemplatesNameVersionWithBody(ctx, name, version, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2TemplatesNameVersionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *PutV2TemplatesNameVersionParams, body PutV2TemplatesNameVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameVersionResponse, error) {
+	rsp, err := c.PutV2TemplatesNameVersion(ctx, name, version, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV2TemplatesNameVersionResponse(rsp)
+}
+
+// PostV2TemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse request with arbitrary body returning *PostV2TemplatesNameVersionClusterlabelsPreviewResponse
+func (c *ClientWithResponses) PostV2TemplatesNameVersionClusterlabelsPreviewWithBodyWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionClusterlabelsPreviewResponse, error) {
+	rsp, err := c.PostV2TemplatesNameVersionClusterlabelsPreviewWithBody(ctx, name, version, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesNameVersionClusterlabelsPreviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2TemplatesNameVersionClusterlabelsPreviewWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionClusterlabelsPreviewParams, body PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionClusterlabelsPreviewResponse, error) {
+	rsp, err := c.PostV2TemplatesNameVersionClusterlabelsPreview(ctx, name, version, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesNameVersionClusterlabelsPreviewResponse(rsp)
+}
+
+// GetV2TemplatesNameVersionClustersWithResponse request returning *GetV2TemplatesNameVersionClustersResponse
+func (c *ClientWithResponses) GetV2TemplatesNameVersionClustersWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionClustersParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionClustersResponse, error) {
+	rsp, err := c.GetV2TemplatesNameVersionClusters(ctx, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2TemplatesNameVersionClustersResponse(rsp)
+}
+
+// PostV2TemplatesNameVersionDeprecateWithResponse request returning *PostV2TemplatesNameVersionDeprecateResponse
+func (c *ClientWithResponses) PostV2TemplatesNameVersionDeprecateWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionDeprecateParams, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionDeprecateResponse, error) {
+	rsp, err := c.PostV2TemplatesNameVersionDeprecate(ctx, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesNameVersionDeprecateResponse(rsp)
+}
+
+// GetV2TemplatesNameVersionExportWithResponse request returning *GetV2TemplatesNameVersionExportResponse
+func (c *ClientWithResponses) GetV2TemplatesNameVersionExportWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionExportParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionExportResponse, error) {
+	rsp, err := c.GetV2TemplatesNameVersionExport(ctx, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2TemplatesNameVersionExportResponse(rsp)
+}
+
+// PostV2TemplatesNameVersionPublishWithResponse request returning *PostV2TemplatesNameVersionPublishResponse
+func (c *ClientWithResponses) PostV2TemplatesNameVersionPublishWithResponse(ctx context.Context, name string, version string, params *PostV2TemplatesNameVersionPublishParams, reqEditors ...RequestEditorFn) (*PostV2TemplatesNameVersionPublishResponse, error) {
+	rsp, err := c.PostV2TemplatesNameVersionPublish(ctx, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesNameVersionPublishResponse(rsp)
+}
+
+// GetV2WebhooksDestinationsWithResponse request returning *GetV2WebhooksDestinationsResponse
+func (c *ClientWithResponses) GetV2WebhooksDestinationsWithResponse(ctx context.Context, params *GetV2WebhooksDestinationsParams, reqEditors ...RequestEditorFn) (*GetV2WebhooksDestinationsResponse, error) {
+	rsp, err := c.GetV2WebhooksDestinations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV2WebhooksDestinationsResponse(rsp)
+}
+
+// ParseGetV2AdminExportsProjectIdResponse parses an HTTP response from a GetV2AdminExportsProjectIdWithResponse call
+func ParseGetV2AdminExportsProjectIdResponse(rsp *http.Response) (*GetV2AdminExportsProjectIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2AdminExportsProjectIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2AdminSupportBundlesProjectIdClustersNameResponse parses an HTTP response from a GetV2AdminSupportBundlesProjectIdClustersNameWithResponse call
+func ParseGetV2AdminSupportBundlesProjectIdClustersNameResponse(rsp *http.Response) (*GetV2AdminSupportBundlesProjectIdClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2AdminSupportBundlesProjectIdClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ApichangelogResponse parses an HTTP response from a GetV2ApichangelogWithResponse call
+func ParseGetV2ApichangelogResponse(rsp *http.Response) (*GetV2ApichangelogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ApichangelogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiChangelog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2AuthzSelfResponse parses an HTTP response from a GetV2AuthzSelfWithResponse call
+func ParseGetV2AuthzSelfResponse(rsp *http.Response) (*GetV2AuthzSelfResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2AuthzSelfResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EffectivePermissions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersResponse parses an HTTP response from a GetV2ClustersWithResponse call
+func ParseGetV2ClustersResponse(rsp *http.Response) (*GetV2ClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+
+			// NextPageToken The pageToken of the next page, absent on the last page.
+			NextPageToken *string `json:"nextPageToken,omitempty"`
+
+			// TotalElements The count of items in the entire list, regardless of pagination. It is an estimate while paging with pageToken through a listing filtered by labels.
+			TotalElements int32 `json:"totalElements"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersResponse parses an HTTP response from a PostV2ClustersWithResponse call
+func ParsePostV2ClustersResponse(rsp *http.Response) (*PostV2ClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterDryRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest PendingCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest NodeValidationProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersImportResponse parses an HTTP response from a PostV2ClustersImportWithResponse call
+func ParsePostV2ClustersImportResponse(rsp *http.Response) (*PostV2ClustersImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameSuggestionResponse parses an HTTP response from a GetV2ClustersNameSuggestionWithResponse call
+func ParseGetV2ClustersNameSuggestionResponse(rsp *http.Response) (*GetV2ClustersNameSuggestionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameSuggestionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterNameSuggestion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersSummaryResponse parses an HTTP response from a GetV2ClustersSummaryWithResponse call
+func ParseGetV2ClustersSummaryResponse(rsp *http.Response) (*GetV2ClustersSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ClustersNameResponse parses an HTTP response from a DeleteV2ClustersNameWithResponse call
+func ParseDeleteV2ClustersNameResponse(rsp *http.Response) (*DeleteV2ClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameResponse parses an HTTP response from a GetV2ClustersNameWithResponse call
+func ParseGetV2ClustersNameResponse(rsp *http.Response) (*GetV2ClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterDetailInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameBackupsResponse parses an HTTP response from a GetV2ClustersNameBackupsWithResponse call
+func ParseGetV2ClustersNameBackupsResponse(rsp *http.Response) (*GetV2ClustersNameBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterBackupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersNameBackupsResponse parses an HTTP response from a PostV2ClustersNameBackupsWithResponse call
+func ParsePostV2ClustersNameBackupsResponse(rsp *http.Response) (*PostV2ClustersNameBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersNameBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersNameCloneResponse parses an HTTP response from a PostV2ClustersNameCloneWithResponse call
+func ParsePostV2ClustersNameCloneResponse(rsp *http.Response) (*PostV2ClustersNameCloneResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersNameCloneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest NodeValidationProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ClustersNameDeletionResponse parses an HTTP response from a DeleteV2ClustersNameDeletionWithResponse call
+func ParseDeleteV2ClustersNameDeletionResponse(rsp *http.Response) (*DeleteV2ClustersNameDeletionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ClustersNameDeletionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameEventsResponse parses an HTTP response from a GetV2ClustersNameEventsWithResponse call
+func ParseGetV2ClustersNameEventsResponse(rsp *http.Response) (*GetV2ClustersNameEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/event-stream) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ClustersNameExtensionsResponse parses an HTTP response from a PutV2ClustersNameExtensionsWithResponse call
+func ParsePutV2ClustersNameExtensionsResponse(rsp *http.Response) (*PutV2ClustersNameExtensionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ClustersNameExtensionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterExtensions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameHealthResponse parses an HTTP response from a GetV2ClustersNameHealthWithResponse call
+func ParseGetV2ClustersNameHealthResponse(rsp *http.Response) (*GetV2ClustersNameHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ClustersNameKubeconfigsResponse parses an HTTP response from a DeleteV2ClustersNameKubeconfigsWithResponse call
+func ParseDeleteV2ClustersNameKubeconfigsResponse(rsp *http.Response) (*DeleteV2ClustersNameKubeconfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ClustersNameKubeconfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameKubeconfigsResponse parses an HTTP response from a GetV2ClustersNameKubeconfigsWithResponse call
+func ParseGetV2ClustersNameKubeconfigsResponse(rsp *http.Response) (*GetV2ClustersNameKubeconfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameKubeconfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubeconfigInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV2ClustersNameLabelsResponse parses an HTTP response from a PatchV2ClustersNameLabelsWithResponse call
+func ParsePatchV2ClustersNameLabelsResponse(rsp *http.Response) (*PatchV2ClustersNameLabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV2ClustersNameLabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VersionedClusterLabels
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ClustersNameLabelsResponse parses an HTTP response from a PutV2ClustersNameLabelsWithResponse call
+func ParsePutV2ClustersNameLabelsResponse(rsp *http.Response) (*PutV2ClustersNameLabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ClustersNameLabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ClustersNameMaintenanceResponse parses an HTTP response from a DeleteV2ClustersNameMaintenanceWithResponse call
+func ParseDeleteV2ClustersNameMaintenanceResponse(rsp *http.Response) (*DeleteV2ClustersNameMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ClustersNameMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ClustersNameMaintenanceResponse parses an HTTP response from a PutV2ClustersNameMaintenanceWithResponse call
+func ParsePutV2ClustersNameMaintenanceResponse(rsp *http.Response) (*PutV2ClustersNameMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ClustersNameMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterMaintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameNodepoolsResponse parses an HTTP response from a GetV2ClustersNameNodepoolsWithResponse call
+func ParseGetV2ClustersNameNodepoolsResponse(rsp *http.Response) (*GetV2ClustersNameNodepoolsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameNodepoolsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodePoolList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersNameNodepoolsResponse parses an HTTP response from a PostV2ClustersNameNodepoolsWithResponse call
+func ParsePostV2ClustersNameNodepoolsResponse(rsp *http.Response) (*PostV2ClustersNameNodepoolsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersNameNodepoolsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NodePool
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV2ClustersNameNodepoolsPoolNameResponse parses an HTTP response from a PatchV2ClustersNameNodepoolsPoolNameWithResponse call
+func ParsePatchV2ClustersNameNodepoolsPoolNameResponse(rsp *http.Response) (*PatchV2ClustersNameNodepoolsPoolNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV2ClustersNameNodepoolsPoolNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodePool
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ClustersNameNodesResponse parses an HTTP response from a PutV2ClustersNameNodesWithResponse call
+func ParsePutV2ClustersNameNodesResponse(rsp *http.Response) (*PutV2ClustersNameNodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ClustersNameNodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ClustersNameNodesNodeIdResponse parses an HTTP response from a DeleteV2ClustersNameNodesNodeIdWithResponse call
+func ParseDeleteV2ClustersNameNodesNodeIdResponse(rsp *http.Response) (*DeleteV2ClustersNameNodesNodeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ClustersNameNodesNodeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersNameNodesNodeIdCordonResponse parses an HTTP response from a PostV2ClustersNameNodesNodeIdCordonWithResponse call
+func ParsePostV2ClustersNameNodesNodeIdCordonResponse(rsp *http.Response) (*PostV2ClustersNameNodesNodeIdCordonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersNameNodesNodeIdCordonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeMaintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameNodesNodeIdLogsResponse parses an HTTP response from a GetV2ClustersNameNodesNodeIdLogsWithResponse call
+func ParseGetV2ClustersNameNodesNodeIdLogsResponse(rsp *http.Response) (*GetV2ClustersNameNodesNodeIdLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameNodesNodeIdLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersNameNodesNodeIdUncordonResponse parses an HTTP response from a PostV2ClustersNameNodesNodeIdUncordonWithResponse call
+func ParsePostV2ClustersNameNodesNodeIdUncordonResponse(rsp *http.Response) (*PostV2ClustersNameNodesNodeIdUncordonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersNameNodesNodeIdUncordonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeMaintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ClustersNameRestoreResponse parses an HTTP response from a PostV2ClustersNameRestoreWithResponse call
+func ParsePostV2ClustersNameRestoreResponse(rsp *http.Response) (*PostV2ClustersNameRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ClustersNameRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ClustersNameTemplateResponse parses an HTTP response from a PutV2ClustersNameTemplateWithResponse call
+func ParsePutV2ClustersNameTemplateResponse(rsp *http.Response) (*PutV2ClustersNameTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ClustersNameTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNameUpgradesResponse parses an HTTP response from a GetV2ClustersNameUpgradesWithResponse call
+func ParseGetV2ClustersNameUpgradesResponse(rsp *http.Response) (*GetV2ClustersNameUpgradesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNameUpgradesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterUpgrades
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ClustersNodeIdClusterdetailResponse parses an HTTP response from a GetV2ClustersNodeIdClusterdetailWithResponse call
+func ParseGetV2ClustersNodeIdClusterdetailResponse(rsp *http.Response) (*GetV2ClustersNodeIdClusterdetailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ClustersNodeIdClusterdetailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterDetailInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2DocsResponse parses an HTTP response from a GetV2DocsWithResponse call
+func ParseGetV2DocsResponse(rsp *http.Response) (*GetV2DocsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2DocsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ExtensionsResponse parses an HTTP response from a GetV2ExtensionsWithResponse call
+func ParseGetV2ExtensionsResponse(rsp *http.Response) (*GetV2ExtensionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ExtensionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExtensionCatalog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2HealthzResponse parses an HTTP response from a GetV2HealthzWithResponse call
+func ParseGetV2HealthzResponse(rsp *http.Response) (*GetV2HealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2HealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2OpenapiJsonResponse parses an HTTP response from a GetV2OpenapiJsonWithResponse call
+func ParseGetV2OpenapiJsonResponse(rsp *http.Response) (*GetV2OpenapiJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2OpenapiJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2OperationsResponse parses an HTTP response from a GetV2OperationsWithResponse call
+func ParseGetV2OperationsResponse(rsp *http.Response) (*GetV2OperationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2OperationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OperationList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2OperationsIdResponse parses an HTTP response from a GetV2OperationsIdWithResponse call
+func ParseGetV2OperationsIdResponse(rsp *http.Response) (*GetV2OperationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2OperationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2PendingClustersResponse parses an HTTP response from a GetV2PendingClustersWithResponse call
+func ParseGetV2PendingClustersResponse(rsp *http.Response) (*GetV2PendingClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2PendingClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingClusterList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2PendingClustersNameResponse parses an HTTP response from a DeleteV2PendingClustersNameWithResponse call
+func ParseDeleteV2PendingClustersNameResponse(rsp *http.Response) (*DeleteV2PendingClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2PendingClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2PendingClustersNameResponse parses an HTTP response from a GetV2PendingClustersNameWithResponse call
+func ParseGetV2PendingClustersNameResponse(rsp *http.Response) (*GetV2PendingClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2PendingClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2PendingClustersNameResponse parses an HTTP response from a PutV2PendingClustersNameWithResponse call
+func ParsePutV2PendingClustersNameResponse(rsp *http.Response) (*PutV2PendingClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2PendingClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameAuthzSelfResponse parses an HTTP response from a GetV2ProjectsProjectNameAuthzSelfWithResponse call
+func ParseGetV2ProjectsProjectNameAuthzSelfResponse(rsp *http.Response) (*GetV2ProjectsProjectNameAuthzSelfResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameAuthzSelfResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EffectivePermissions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersWithResponse call
+func ParseGetV2ProjectsProjectNameClustersResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+
+			// NextPageToken The pageToken of the next page, absent on the last page.
+			NextPageToken *string `json:"nextPageToken,omitempty"`
+
+			// TotalElements The count of items in the entire list, regardless of pagination. It is an estimate while paging with pageToken through a listing filtered by labels.
+			TotalElements int32 `json:"totalElements"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ProjectsProjectNameClustersResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersWithResponse call
+func ParsePostV2ProjectsProjectNameClustersResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ProjectsProjectNameClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterDryRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest PendingCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest NodeValidationProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ProjectsProjectNameClustersImportResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersImportWithResponse call
+func ParsePostV2ProjectsProjectNameClustersImportResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ProjectsProjectNameClustersImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameSuggestionResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameSuggestionWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameSuggestionResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameSuggestionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameSuggestionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterNameSuggestion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersSummaryResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersSummaryWithResponse call
+func ParseGetV2ProjectsProjectNameClustersSummaryResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ProjectsProjectNameClustersNameResponse parses an HTTP response from a DeleteV2ProjectsProjectNameClustersNameWithResponse call
+func ParseDeleteV2ProjectsProjectNameClustersNameResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ProjectsProjectNameClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterDetailInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameBackupsResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameBackupsWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameBackupsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterBackupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ProjectsProjectNameClustersNameBackupsResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersNameBackupsWithResponse call
+func ParsePostV2ProjectsProjectNameClustersNameBackupsResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersNameBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ProjectsProjectNameClustersNameBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ProjectsProjectNameClustersNameDeletionResponse parses an HTTP response from a DeleteV2ProjectsProjectNameClustersNameDeletionWithResponse call
+func ParseDeleteV2ProjectsProjectNameClustersNameDeletionResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameClustersNameDeletionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ProjectsProjectNameClustersNameDeletionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameEventsResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameEventsWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameEventsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/event-stream) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ProjectsProjectNameClustersNameExtensionsResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameExtensionsWithResponse call
+func ParsePutV2ProjectsProjectNameClustersNameExtensionsResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameExtensionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ProjectsProjectNameClustersNameExtensionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterExtensions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameHealthResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameHealthWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameHealthResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse parses an HTTP response from a DeleteV2ProjectsProjectNameClustersNameKubeconfigsWithResponse call
+func ParseDeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ProjectsProjectNameClustersNameKubeconfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameKubeconfigsResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameKubeconfigsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameKubeconfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameKubeconfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubeconfigInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV2ProjectsProjectNameClustersNameLabelsResponse parses an HTTP response from a PatchV2ProjectsProjectNameClustersNameLabelsWithResponse call
+func ParsePatchV2ProjectsProjectNameClustersNameLabelsResponse(rsp *http.Response) (*PatchV2ProjectsProjectNameClustersNameLabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV2ProjectsProjectNameClustersNameLabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VersionedClusterLabels
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ProjectsProjectNameClustersNameLabelsResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameLabelsWithResponse call
+func ParsePutV2ProjectsProjectNameClustersNameLabelsResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ProjectsProjectNameClustersNameLabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ProjectsProjectNameClustersNameMaintenanceResponse parses an HTTP response from a DeleteV2ProjectsProjectNameClustersNameMaintenanceWithResponse call
+func ParseDeleteV2ProjectsProjectNameClustersNameMaintenanceResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ProjectsProjectNameClustersNameMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ProjectsProjectNameClustersNameMaintenanceResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameMaintenanceWithResponse call
+func ParsePutV2ProjectsProjectNameClustersNameMaintenanceResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ProjectsProjectNameClustersNameMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterMaintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameNodepoolsResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameNodepoolsWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameNodepoolsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameNodepoolsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameNodepoolsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodePoolList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ProjectsProjectNameClustersNameNodepoolsResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersNameNodepoolsWithResponse call
+func ParsePostV2ProjectsProjectNameClustersNameNodepoolsResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersNameNodepoolsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ProjectsProjectNameClustersNameNodepoolsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NodePool
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse parses an HTTP response from a PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameWithResponse call
+func ParsePatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse(rsp *http.Response) (*PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodePool
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ProjectsProjectNameClustersNameNodesResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameNodesWithResponse call
+func ParsePutV2ProjectsProjectNameClustersNameNodesResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ProjectsProjectNameClustersNameNodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse parses an HTTP response from a DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse call
+func ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonWithResponse call
+func ParsePostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeMaintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonWithResponse call
+func ParsePostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ProjectsProjectNameClustersNameNodesNodeIdUncordonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeMaintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2ProjectsProjectNameClustersNameRestoreResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersNameRestoreWithResponse call
+func ParsePostV2ProjectsProjectNameClustersNameRestoreResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersNameRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2ProjectsProjectNameClustersNameRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV2ProjectsProjectNameClustersNameTemplateResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameTemplateWithResponse call
+func ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV2ProjectsProjectNameClustersNameTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNameUpgradesResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameUpgradesWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNameUpgradesResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameUpgradesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNameUpgradesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterUpgrades
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse call
+func ParseGetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterDetailInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2ProjectsProjectNameOperationsResponse parses an HTTP response from a GetV2ProjectsProjectNameOperationsWithResponse call
+func ParseGetV2ProjectsProjectNameOperationsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameOperationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2ProjectsProjectNameOperationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OperationList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return 0
-}
 
-// GetV2ClustersWithResponse request returning *GetV2ClustersResponse
-func (c *ClientWithResponses) GetV2ClustersWithResponse(ctx context.Context, params *GetV2ClustersParams, reqEditors ...RequestEditorFn) (*GetV2ClustersResponse, error) {
-	rsp, err := c.GetV2Clusters(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2ClustersResponse(rsp)
+	return response, nil
 }
 
-// PostV2ClustersWithBodyWithResponse request with arbitrary body returning *PostV2ClustersResponse
-func (c *ClientWithResponses) PostV2ClustersWithBodyWithResponse(ctx context.Context, params *PostV2ClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error) {
-	rsp, err := c.PostV2ClustersWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseGetV2ProjectsProjectNameOperationsIdResponse parses an HTTP response from a GetV2ProjectsProjectNameOperationsIdWithResponse call
+func ParseGetV2ProjectsProjectNameOperationsIdResponse(rsp *http.Response) (*GetV2ProjectsProjectNameOperationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostV2ClustersResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostV2ClustersWithResponse(ctx context.Context, params *PostV2ClustersParams, body PostV2ClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ClustersResponse, error) {
-	rsp, err := c.PostV2Clusters(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV2ProjectsProjectNameOperationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostV2ClustersResponse(rsp)
-}
 
-// GetV2ClustersSummaryWithResponse request returning *GetV2ClustersSummaryResponse
-func (c *ClientWithResponses) GetV2ClustersSummaryWithResponse(ctx context.Context, params *GetV2ClustersSummaryParams, reqEditors ...RequestEditorFn) (*GetV2ClustersSummaryResponse, error) {
-	rsp, err := c.GetV2ClustersSummary(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2ClustersSummaryResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// DeleteV2ClustersNameWithResponse request returning *DeleteV2ClustersNameResponse
-func (c *ClientWithResponses) DeleteV2ClustersNameWithResponse(ctx context.Context, name string, params *DeleteV2ClustersNameParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameResponse, error) {
-	rsp, err := c.DeleteV2ClustersName(ctx, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteV2ClustersNameResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetV2ClustersNameWithResponse request returning *GetV2ClustersNameResponse
-func (c *ClientWithResponses) GetV2ClustersNameWithResponse(ctx context.Context, name string, params *GetV2ClustersNameParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameResponse, error) {
-	rsp, err := c.GetV2ClustersName(ctx, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2ClustersNameResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
 
-// GetV2ClustersNameKubeconfigsWithResponse request returning *GetV2ClustersNameKubeconfigsResponse
-func (c *ClientWithResponses) GetV2ClustersNameKubeconfigsWithResponse(ctx context.Context, name string, params *GetV2ClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNameKubeconfigsResponse, error) {
-	rsp, err := c.GetV2ClustersNameKubeconfigs(ctx, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetV2ClustersNameKubeconfigsResponse(rsp)
+
+	return response, nil
 }
 
-// PutV2ClustersNameLabelsWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameLabelsResponse
-func (c *ClientWithResponses) PutV2ClustersNameLabelsWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error) {
-	rsp, err := c.PutV2ClustersNameLabelsWithBody(ctx, name, params, contentType, body, reqEditors...)
+// ParseGetV2ProjectsProjectNamePendingClustersResponse parses an HTTP response from a GetV2ProjectsProjectNamePendingClustersWithResponse call
+func ParseGetV2ProjectsProjectNamePendingClustersResponse(rsp *http.Response) (*GetV2ProjectsProjectNamePendingClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutV2ClustersNameLabelsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV2ClustersNameLabelsWithResponse(ctx context.Context, name string, params *PutV2ClustersNameLabelsParams, body PutV2ClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameLabelsResponse, error) {
-	rsp, err := c.PutV2ClustersNameLabels(ctx, name, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV2ProjectsProjectNamePendingClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutV2ClustersNameLabelsResponse(rsp)
-}
 
-// PutV2ClustersNameNodesWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameNodesResponse
-func (c *ClientWithResponses) PutV2ClustersNameNodesWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error) {
-	rsp, err := c.PutV2ClustersNameNodesWithBody(ctx, name, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingClusterList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParsePutV2ClustersNameNodesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutV2ClustersNameNodesWithResponse(ctx context.Context, name string, params *PutV2ClustersNameNodesParams, body PutV2ClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameNodesResponse, error) {
-	rsp, err := c.PutV2ClustersNameNodes(ctx, name, params, body, reqEditors...)
+// ParseDeleteV2ProjectsProjectNamePendingClustersNameResponse parses an HTTP response from a DeleteV2ProjectsProjectNamePendingClustersNameWithResponse call
+func ParseDeleteV2ProjectsProjectNamePendingClustersNameResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNamePendingClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutV2ClustersNameNodesResponse(rsp)
-}
 
-// DeleteV2ClustersNameNodesNodeIdWithResponse request returning *DeleteV2ClustersNameNodesNodeIdResponse
-func (c *ClientWithResponses) DeleteV2ClustersNameNodesNodeIdWithResponse(ctx context.Context, name string, nodeId string, params *DeleteV2ClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ClustersNameNodesNodeIdResponse, error) {
-	rsp, err := c.DeleteV2ClustersNameNodesNodeId(ctx, name, nodeId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteV2ProjectsProjectNamePendingClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteV2ClustersNameNodesNodeIdResponse(rsp)
-}
 
-// PutV2ClustersNameTemplateWithBodyWithResponse request with arbitrary body returning *PutV2ClustersNameTemplateResponse
-func (c *ClientWithResponses) PutV2ClustersNameTemplateWithBodyWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error) {
-	rsp, err := c.PutV2ClustersNameTemplateWithBody(ctx, name, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParsePutV2ClustersNameTemplateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutV2ClustersNameTemplateWithResponse(ctx context.Context, name string, params *PutV2ClustersNameTemplateParams, body PutV2ClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ClustersNameTemplateResponse, error) {
-	rsp, err := c.PutV2ClustersNameTemplate(ctx, name, params, body, reqEditors...)
+// ParseGetV2ProjectsProjectNamePendingClustersNameResponse parses an HTTP response from a GetV2ProjectsProjectNamePendingClustersNameWithResponse call
+func ParseGetV2ProjectsProjectNamePendingClustersNameResponse(rsp *http.Response) (*GetV2ProjectsProjectNamePendingClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutV2ClustersNameTemplateResponse(rsp)
-}
 
-// GetV2ClustersNodeIdClusterdetailWithResponse request returning *GetV2ClustersNodeIdClusterdetailResponse
-func (c *ClientWithResponses) GetV2ClustersNodeIdClusterdetailWithResponse(ctx context.Context, nodeId string, params *GetV2ClustersNodeIdClusterdetailParams, reqEditors ...RequestEditorFn) (*GetV2ClustersNodeIdClusterdetailResponse, error) {
-	rsp, err := c.GetV2ClustersNodeIdClusterdetail(ctx, nodeId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV2ProjectsProjectNamePendingClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetV2ClustersNodeIdClusterdetailResponse(rsp)
-}
 
-// GetV2HealthzWithResponse request returning *GetV2HealthzResponse
-func (c *ClientWithResponses) GetV2HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV2HealthzResponse, error) {
-	rsp, err := c.GetV2Healthz(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParseGetV2HealthzResponse(rsp)
-}
 
-// GetV2ProjectsProjectNameClustersWithResponse request returning *GetV2ProjectsProjectNameClustersResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameClustersParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameClusters(ctx, projectName, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2ProjectsProjectNameClustersResponse(rsp)
+	return response, nil
 }
 
-// PostV2ProjectsProjectNameClustersWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameClustersResponse
-func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error) {
-	rsp, err := c.PostV2ProjectsProjectNameClustersWithBody(ctx, projectName, contentType, body, reqEditors...)
+// ParsePutV2ProjectsProjectNamePendingClustersNameResponse parses an HTTP response from a PutV2ProjectsProjectNamePendingClustersNameWithResponse call
+func ParsePutV2ProjectsProjectNamePendingClustersNameResponse(rsp *http.Response) (*PutV2ProjectsProjectNamePendingClustersNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostV2ProjectsProjectNameClustersResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostV2ProjectsProjectNameClustersWithResponse(ctx context.Context, projectName ProjectNamePath, body PostV2ProjectsProjectNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameClustersResponse, error) {
-	rsp, err := c.PostV2ProjectsProjectNameClusters(ctx, projectName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutV2ProjectsProjectNamePendingClustersNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostV2ProjectsProjectNameClustersResponse(rsp)
-}
 
-// GetV2ProjectsProjectNameClustersSummaryWithResponse request returning *GetV2ProjectsProjectNameClustersSummaryResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersSummaryWithResponse(ctx context.Context, projectName ProjectNamePath, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersSummaryResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameClustersSummary(ctx, projectName, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2ProjectsProjectNameClustersSummaryResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// DeleteV2ProjectsProjectNameClustersNameWithResponse request returning *DeleteV2ProjectsProjectNameClustersNameResponse
-func (c *ClientWithResponses) DeleteV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameResponse, error) {
-	rsp, err := c.DeleteV2ProjectsProjectNameClustersName(ctx, projectName, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteV2ProjectsProjectNameClustersNameResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetV2ProjectsProjectNameClustersNameWithResponse request returning *GetV2ProjectsProjectNameClustersNameResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameWithResponse(ctx context.Context, projectName ProjectNamePath, name string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameClustersName(ctx, projectName, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2ProjectsProjectNameClustersNameResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse request returning *GetV2ProjectsProjectNameClustersNameKubeconfigsResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameClustersNameKubeconfigsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNameKubeconfigsResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameClustersNameKubeconfigs(ctx, projectName, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2ProjectsProjectNameClustersNameKubeconfigsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-// PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameLabelsResponse
-func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameLabelsWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameClustersNameLabelsWithBody(ctx, projectName, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutV2ProjectsProjectNameClustersNameLabelsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameLabelsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameClustersNameLabels(ctx, projectName, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutV2ProjectsProjectNameClustersNameLabelsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
 
-// PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameNodesResponse
-func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameNodesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameClustersNameNodesWithBody(ctx, projectName, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePutV2ProjectsProjectNameClustersNameNodesResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameNodesWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameClustersNameNodes(ctx, projectName, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutV2ProjectsProjectNameClustersNameNodesResponse(rsp)
+	return response, nil
 }
 
-// DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse request returning *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse
-func (c *ClientWithResponses) DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse(ctx context.Context, projectName ProjectNamePath, name string, nodeId string, params *DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse, error) {
-	rsp, err := c.DeleteV2ProjectsProjectNameClustersNameNodesNodeId(ctx, projectName, name, nodeId, params, reqEditors...)
+// ParseGetV2ProjectsProjectNameSummaryResponse parses an HTTP response from a GetV2ProjectsProjectNameSummaryWithResponse call
+func ParseGetV2ProjectsProjectNameSummaryResponse(rsp *http.Response) (*GetV2ProjectsProjectNameSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse(rsp)
-}
 
-// PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameClustersNameTemplateResponse
-func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameTemplateWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameClustersNameTemplateWithBody(ctx, projectName, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV2ProjectsProjectNameSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV2ProjectsProjectNameClustersNameTemplateWithResponse(ctx context.Context, projectName ProjectNamePath, name string, body PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameClustersNameTemplate(ctx, projectName, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse request returning *GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse(ctx context.Context, projectName ProjectNamePath, nodeId string, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameClustersNodeIdClusterdetail(ctx, projectName, nodeId, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse(rsp)
+
+	return response, nil
 }
 
-// GetV2ProjectsProjectNameTemplatesWithResponse request returning *GetV2ProjectsProjectNameTemplatesResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *GetV2ProjectsProjectNameTemplatesParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameTemplates(ctx, projectName, params, reqEditors...)
+// ParsePostV2ProjectsProjectNameTemplateUploadsResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplateUploadsWithResponse call
+func ParsePostV2ProjectsProjectNameTemplateUploadsResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplateUploadsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV2ProjectsProjectNameTemplatesResponse(rsp)
-}
 
-// PostV2ProjectsProjectNameTemplatesWithBodyWithResponse request with arbitrary body returning *PostV2ProjectsProjectNameTemplatesResponse
-func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error) {
-	rsp, err := c.PostV2ProjectsProjectNameTemplatesWithBody(ctx, projectName, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostV2ProjectsProjectNameTemplateUploadsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostV2ProjectsProjectNameTemplatesResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostV2ProjectsProjectNameTemplatesWithResponse(ctx context.Context, projectName ProjectNamePath, params *PostV2ProjectsProjectNameTemplatesParams, body PostV2ProjectsProjectNameTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2ProjectsProjectNameTemplatesResponse, error) {
-	rsp, err := c.PostV2ProjectsProjectNameTemplates(ctx, projectName, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TemplateUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParsePostV2ProjectsProjectNameTemplatesResponse(rsp)
+
+	return response, nil
 }
 
-// PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse request with arbitrary body returning *PutV2ProjectsProjectNameTemplatesNameDefaultResponse
-func (c *ClientWithResponses) PutV2ProjectsProjectNameTemplatesNameDefaultWithBodyWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameTemplatesNameDefaultWithBody(ctx, projectName, name, params, contentType, body, reqEditors...)
+// ParseDeleteV2ProjectsProjectNameTemplateUploadsIdResponse parses an HTTP response from a DeleteV2ProjectsProjectNameTemplateUploadsIdWithResponse call
+func ParseDeleteV2ProjectsProjectNameTemplateUploadsIdResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameTemplateUploadsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV2ProjectsProjectNameTemplatesNameDefaultWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *PutV2ProjectsProjectNameTemplatesNameDefaultParams, body PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error) {
-	rsp, err := c.PutV2ProjectsProjectNameTemplatesNameDefault(ctx, projectName, name, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteV2ProjectsProjectNameTemplateUploadsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp)
-}
 
-// GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse request returning *GetV2ProjectsProjectNameTemplatesNameVersionsResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse(ctx context.Context, projectName ProjectNamePath, name string, params *GetV2ProjectsProjectNameTemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionsResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameTemplatesNameVersions(ctx, projectName, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParseGetV2ProjectsProjectNameTemplatesNameVersionsResponse(rsp)
-}
 
-// DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse request returning *DeleteV2ProjectsProjectNameTemplatesNameVersionResponse
-func (c *ClientWithResponses) DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *DeleteV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
-	rsp, err := c.DeleteV2ProjectsProjectNameTemplatesNameVersion(ctx, projectName, name, version, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteV2ProjectsProjectNameTemplatesNameVersionResponse(rsp)
+	return response, nil
 }
 
-// GetV2ProjectsProjectNameTemplatesNameVersionWithResponse request returning *GetV2ProjectsProjectNameTemplatesNameVersionResponse
-func (c *ClientWithResponses) GetV2ProjectsProjectNameTemplatesNameVersionWithResponse(ctx context.Context, projectName ProjectNamePath, name string, version string, params *GetV2ProjectsProjectNameTemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
-	rsp, err := c.GetV2ProjectsProjectNameTemplatesNameVersion(ctx, projectName, name, version, params, reqEditors...)
+// ParseGetV2ProjectsProjectNameTemplateUploadsIdResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplateUploadsIdWithResponse call
+func ParseGetV2ProjectsProjectNameTemplateUploadsIdResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplateUploadsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV2ProjectsProjectNameTemplatesNameVersionResponse(rsp)
-}
 
-// GetV2TemplatesWithResponse request returning *GetV2TemplatesResponse
-func (c *ClientWithResponses) GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error) {
-	rsp, err := c.GetV2Templates(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetV2ProjectsProjectNameTemplateUploadsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetV2TemplatesResponse(rsp)
-}
 
-// PostV2TemplatesWithBodyWithResponse request with arbitrary body returning *PostV2TemplatesResponse
-func (c *ClientWithResponses) PostV2TemplatesWithBodyWithResponse(ctx context.Context, params *PostV2TemplatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
-	rsp, err := c.PostV2TemplatesWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParsePostV2TemplatesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostV2TemplatesWithResponse(ctx context.Context, params *PostV2TemplatesParams, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
-	rsp, err := c.PostV2Templates(ctx, params, body, reqEditors...)
+// ParsePostV2ProjectsProjectNameTemplateUploadsIdChunksResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplateUploadsIdChunksWithResponse call
+func ParsePostV2ProjectsProjectNameTemplateUploadsIdChunksResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostV2TemplatesResponse(rsp)
-}
 
-// PutV2TemplatesNameDefaultWithBodyWithResponse request with arbitrary body returning *PutV2TemplatesNameDefaultResponse
-func (c *ClientWithResponses) PutV2TemplatesNameDefaultWithBodyWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error) {
-	rsp, err := c.PutV2TemplatesNameDefaultWithBody(ctx, name, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostV2ProjectsProjectNameTemplateUploadsIdChunksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutV2TemplatesNameDefaultResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutV2TemplatesNameDefaultWithResponse(ctx context.Context, name string, params *PutV2TemplatesNameDefaultParams, body PutV2TemplatesNameDefaultJSONRequestBody, reqEditors ...RequestEditorFn) (*PutV2TemplatesNameDefaultResponse, error) {
-	rsp, err := c.PutV2TemplatesNameDefault(ctx, name, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParsePutV2TemplatesNameDefaultResponse(rsp)
+
+	return response, nil
 }
 
-// GetV2TemplatesNameVersionsWithResponse request returning *GetV2TemplatesNameVersionsResponse
-func (c *ClientWithResponses) GetV2TemplatesNameVersionsWithResponse(ctx context.Context, name string, params *GetV2TemplatesNameVersionsParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionsResponse, error) {
-	rsp, err := c.GetV2TemplatesNameVersions(ctx, name, params, reqEditors...)
+// ParsePostV2ProjectsProjectNameTemplateUploadsIdCommitResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplateUploadsIdCommitWithResponse call
+func ParsePostV2ProjectsProjectNameTemplateUploadsIdCommitResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV2TemplatesNameVersionsResponse(rsp)
-}
 
-// DeleteV2TemplatesNameVersionWithResponse request returning *DeleteV2TemplatesNameVersionResponse
-func (c *ClientWithResponses) DeleteV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *DeleteV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*DeleteV2TemplatesNameVersionResponse, error) {
-	rsp, err := c.DeleteV2TemplatesNameVersion(ctx, name, version, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostV2ProjectsProjectNameTemplateUploadsIdCommitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteV2TemplatesNameVersionResponse(rsp)
-}
 
-// GetV2TemplatesNameVersionWithResponse request returning *GetV2TemplatesNameVersionResponse
-func (c *ClientWithResponses) GetV2TemplatesNameVersionWithResponse(ctx context.Context, name string, version string, params *GetV2TemplatesNameVersionParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesNameVersionResponse, error) {
-	rsp, err := c.GetV2TemplatesNameVersion(ctx, name, version, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
-	return ParseGetV2TemplatesNameVersionResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetV2ClustersResponse parses an HTTP response from a GetV2ClustersWithResponse call
-func ParseGetV2ClustersResponse(rsp *http.Response) (*GetV2ClustersResponse, error) {
+// ParseGetV2ProjectsProjectNameTemplatesResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesWithResponse call
+func ParseGetV2ProjectsProjectNameTemplatesResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ClustersResponse{
+	response := &GetV2ProjectsProjectNameTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Clusters *[]ClusterInfo `json:"clusters,omitempty"`
-
-			// TotalElements The count of items in the entire list, regardless of pagination.
-			TotalElements int32 `json:"totalElements"`
-		}
+		var dest TemplateInfoList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4375,6 +19160,13 @@ func ParseGetV2ClustersResponse(rsp *http.Response) (*GetV2ClustersResponse, err
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4387,15 +19179,15 @@ func ParseGetV2ClustersResponse(rsp *http.Response) (*GetV2ClustersResponse, err
 	return response, nil
 }
 
-// ParsePostV2ClustersResponse parses an HTTP response from a PostV2ClustersWithResponse call
-func ParsePostV2ClustersResponse(rsp *http.Response) (*PostV2ClustersResponse, error) {
+// ParsePostV2ProjectsProjectNameTemplatesResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplatesWithResponse call
+func ParsePostV2ProjectsProjectNameTemplatesResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV2ClustersResponse{
+	response := &PostV2ProjectsProjectNameTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4415,6 +19207,13 @@ func ParsePostV2ClustersResponse(rsp *http.Response) (*PostV2ClustersResponse, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4427,34 +19226,27 @@ func ParsePostV2ClustersResponse(rsp *http.Response) (*PostV2ClustersResponse, e
 	return response, nil
 }
 
-// ParseGetV2ClustersSummaryResponse parses an HTTP response from a GetV2ClustersSummaryWithResponse call
-func ParseGetV2ClustersSummaryResponse(rsp *http.Response) (*GetV2ClustersSummaryResponse, error) {
+// ParseGetV2ProjectsProjectNameTemplatesCompatibilityResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesCompatibilityWithResponse call
+func ParseGetV2ProjectsProjectNameTemplatesCompatibilityResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesCompatibilityResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ClustersSummaryResponse{
+	response := &GetV2ProjectsProjectNameTemplatesCompatibilityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterSummary
+		var dest TemplateCompatibilityList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4467,15 +19259,15 @@ func ParseGetV2ClustersSummaryResponse(rsp *http.Response) (*GetV2ClustersSummar
 	return response, nil
 }
 
-// ParseDeleteV2ClustersNameResponse parses an HTTP response from a DeleteV2ClustersNameWithResponse call
-func ParseDeleteV2ClustersNameResponse(rsp *http.Response) (*DeleteV2ClustersNameResponse, error) {
+// ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse parses an HTTP response from a PutV2ProjectsProjectNameTemplatesNameDefaultWithResponse call
+func ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp *http.Response) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV2ClustersNameResponse{
+	response := &PutV2ProjectsProjectNameTemplatesNameDefaultResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4507,22 +19299,22 @@ func ParseDeleteV2ClustersNameResponse(rsp *http.Response) (*DeleteV2ClustersNam
 	return response, nil
 }
 
-// ParseGetV2ClustersNameResponse parses an HTTP response from a GetV2ClustersNameWithResponse call
-func ParseGetV2ClustersNameResponse(rsp *http.Response) (*GetV2ClustersNameResponse, error) {
+// ParseGetV2ProjectsProjectNameTemplatesNameVersionsResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse call
+func ParseGetV2ProjectsProjectNameTemplatesNameVersionsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesNameVersionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ClustersNameResponse{
+	response := &GetV2ProjectsProjectNameTemplatesNameVersionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterDetailInfo
+		var dest VersionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4554,27 +19346,20 @@ func ParseGetV2ClustersNameResponse(rsp *http.Response) (*GetV2ClustersNameRespo
 	return response, nil
 }
 
-// ParseGetV2ClustersNameKubeconfigsResponse parses an HTTP response from a GetV2ClustersNameKubeconfigsWithResponse call
-func ParseGetV2ClustersNameKubeconfigsResponse(rsp *http.Response) (*GetV2ClustersNameKubeconfigsResponse, error) {
+// ParseDeleteV2ProjectsProjectNameTemplatesNameVersionResponse parses an HTTP response from a DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse call
+func ParseDeleteV2ProjectsProjectNameTemplatesNameVersionResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ClustersNameKubeconfigsResponse{
+	response := &DeleteV2ProjectsProjectNameTemplatesNameVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubeconfigInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4582,19 +19367,19 @@ func ParseGetV2ClustersNameKubeconfigsResponse(rsp *http.Response) (*GetV2Cluste
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
@@ -4608,20 +19393,27 @@ func ParseGetV2ClustersNameKubeconfigsResponse(rsp *http.Response) (*GetV2Cluste
 	return response, nil
 }
 
-// ParsePutV2ClustersNameLabelsResponse parses an HTTP response from a PutV2ClustersNameLabelsWithResponse call
-func ParsePutV2ClustersNameLabelsResponse(rsp *http.Response) (*PutV2ClustersNameLabelsResponse, error) {
+// ParseGetV2ProjectsProjectNameTemplatesNameVersionResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesNameVersionWithResponse call
+func ParseGetV2ProjectsProjectNameTemplatesNameVersionResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2ClustersNameLabelsResponse{
+	response := &GetV2ProjectsProjectNameTemplatesNameVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4648,20 +19440,27 @@ func ParsePutV2ClustersNameLabelsResponse(rsp *http.Response) (*PutV2ClustersNam
 	return response, nil
 }
 
-// ParsePutV2ClustersNameNodesResponse parses an HTTP response from a PutV2ClustersNameNodesWithResponse call
-func ParsePutV2ClustersNameNodesResponse(rsp *http.Response) (*PutV2ClustersNameNodesResponse, error) {
+// ParsePutV2ProjectsProjectNameTemplatesNameVersionResponse parses an HTTP response from a PutV2ProjectsProjectNameTemplatesNameVersionWithResponse call
+func ParsePutV2ProjectsProjectNameTemplatesNameVersionResponse(rsp *http.Response) (*PutV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2ClustersNameNodesResponse{
+	response := &PutV2ProjectsProjectNameTemplatesNameVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4676,39 +19475,46 @@ func ParsePutV2ClustersNameNodesResponse(rsp *http.Response) (*PutV2ClustersName
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500InternalServerError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest N501NotImplemented
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON501 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV2ClustersNameNodesNodeIdResponse parses an HTTP response from a DeleteV2ClustersNameNodesNodeIdWithResponse call
-func ParseDeleteV2ClustersNameNodesNodeIdResponse(rsp *http.Response) (*DeleteV2ClustersNameNodesNodeIdResponse, error) {
+// ParsePostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewWithResponse call
+func ParsePostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV2ClustersNameNodesNodeIdResponse{
+	response := &PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LabelPropagationPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4735,20 +19541,27 @@ func ParseDeleteV2ClustersNameNodesNodeIdResponse(rsp *http.Response) (*DeleteV2
 	return response, nil
 }
 
-// ParsePutV2ClustersNameTemplateResponse parses an HTTP response from a PutV2ClustersNameTemplateWithResponse call
-func ParsePutV2ClustersNameTemplateResponse(rsp *http.Response) (*PutV2ClustersNameTemplateResponse, error) {
+// ParseGetV2ProjectsProjectNameTemplatesNameVersionClustersResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesNameVersionClustersWithResponse call
+func ParseGetV2ProjectsProjectNameTemplatesNameVersionClustersResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2ClustersNameTemplateResponse{
+	response := &GetV2ProjectsProjectNameTemplatesNameVersionClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateClusters
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4770,34 +19583,27 @@ func ParsePutV2ClustersNameTemplateResponse(rsp *http.Response) (*PutV2ClustersN
 		}
 		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest N501NotImplemented
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetV2ClustersNodeIdClusterdetailResponse parses an HTTP response from a GetV2ClustersNodeIdClusterdetailWithResponse call
-func ParseGetV2ClustersNodeIdClusterdetailResponse(rsp *http.Response) (*GetV2ClustersNodeIdClusterdetailResponse, error) {
+// ParsePostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplatesNameVersionDeprecateWithResponse call
+func ParsePostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ClustersNodeIdClusterdetailResponse{
+	response := &PostV2ProjectsProjectNameTemplatesNameVersionDeprecateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterDetailInfo
+		var dest TemplateInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4817,32 +19623,60 @@ func ParseGetV2ClustersNodeIdClusterdetailResponse(rsp *http.Response) (*GetV2Cl
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetV2HealthzResponse parses an HTTP response from a GetV2HealthzWithResponse call
-func ParseGetV2HealthzResponse(rsp *http.Response) (*GetV2HealthzResponse, error) {
+// ParseGetV2ProjectsProjectNameTemplatesNameVersionExportResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesNameVersionExportWithResponse call
+func ParseGetV2ProjectsProjectNameTemplatesNameVersionExportResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesNameVersionExportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2HealthzResponse{
+	response := &GetV2ProjectsProjectNameTemplatesNameVersionExportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest string
+		var dest TemplateBundle
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4855,27 +19689,22 @@ func ParseGetV2HealthzResponse(rsp *http.Response) (*GetV2HealthzResponse, error
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameClustersResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersWithResponse call
-func ParseGetV2ProjectsProjectNameClustersResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersResponse, error) {
+// ParsePostV2ProjectsProjectNameTemplatesNameVersionPublishResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplatesNameVersionPublishWithResponse call
+func ParsePostV2ProjectsProjectNameTemplatesNameVersionPublishResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameClustersResponse{
+	response := &PostV2ProjectsProjectNameTemplatesNameVersionPublishResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Clusters *[]ClusterInfo `json:"clusters,omitempty"`
-
-			// TotalElements The count of items in the entire list, regardless of pagination.
-			TotalElements int32 `json:"totalElements"`
-		}
+		var dest TemplateInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4888,6 +19717,20 @@ func ParseGetV2ProjectsProjectNameClustersResponse(rsp *http.Response) (*GetV2Pr
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4900,26 +19743,26 @@ func ParseGetV2ProjectsProjectNameClustersResponse(rsp *http.Response) (*GetV2Pr
 	return response, nil
 }
 
-// ParsePostV2ProjectsProjectNameClustersResponse parses an HTTP response from a PostV2ProjectsProjectNameClustersWithResponse call
-func ParsePostV2ProjectsProjectNameClustersResponse(rsp *http.Response) (*PostV2ProjectsProjectNameClustersResponse, error) {
+// ParseGetV2ProjectsProjectNameWebhooksDestinationsResponse parses an HTTP response from a GetV2ProjectsProjectNameWebhooksDestinationsWithResponse call
+func ParseGetV2ProjectsProjectNameWebhooksDestinationsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameWebhooksDestinationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV2ProjectsProjectNameClustersResponse{
+	response := &GetV2ProjectsProjectNameWebhooksDestinationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDestinationList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
@@ -4935,78 +19778,78 @@ func ParsePostV2ProjectsProjectNameClustersResponse(rsp *http.Response) (*PostV2
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameClustersSummaryResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersSummaryWithResponse call
-func ParseGetV2ProjectsProjectNameClustersSummaryResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersSummaryResponse, error) {
+// ParseGetV2ReadyzResponse parses an HTTP response from a GetV2ReadyzWithResponse call
+func ParseGetV2ReadyzResponse(rsp *http.Response) (*GetV2ReadyzResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameClustersSummaryResponse{
+	response := &GetV2ReadyzResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterSummary
+		var dest Readiness
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500InternalServerError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Readiness
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV2ProjectsProjectNameClustersNameResponse parses an HTTP response from a DeleteV2ProjectsProjectNameClustersNameWithResponse call
-func ParseDeleteV2ProjectsProjectNameClustersNameResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameClustersNameResponse, error) {
+// ParseGetV2SummaryResponse parses an HTTP response from a GetV2SummaryWithResponse call
+func ParseGetV2SummaryResponse(rsp *http.Response) (*GetV2SummaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV2ProjectsProjectNameClustersNameResponse{
+	response := &GetV2SummaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectSummary
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
@@ -5020,41 +19863,27 @@ func ParseDeleteV2ProjectsProjectNameClustersNameResponse(rsp *http.Response) (*
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameClustersNameResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameWithResponse call
-func ParseGetV2ProjectsProjectNameClustersNameResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameResponse, error) {
+// ParseGetV2SupportmatrixResponse parses an HTTP response from a GetV2SupportmatrixWithResponse call
+func ParseGetV2SupportmatrixResponse(rsp *http.Response) (*GetV2SupportmatrixResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameClustersNameResponse{
+	response := &GetV2SupportmatrixResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterDetailInfo
+		var dest SupportMatrix
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5067,26 +19896,26 @@ func ParseGetV2ProjectsProjectNameClustersNameResponse(rsp *http.Response) (*Get
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameClustersNameKubeconfigsResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNameKubeconfigsWithResponse call
-func ParseGetV2ProjectsProjectNameClustersNameKubeconfigsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNameKubeconfigsResponse, error) {
+// ParsePostV2TemplateUploadsResponse parses an HTTP response from a PostV2TemplateUploadsWithResponse call
+func ParsePostV2TemplateUploadsResponse(rsp *http.Response) (*PostV2TemplateUploadsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameClustersNameKubeconfigsResponse{
+	response := &PostV2TemplateUploadsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubeconfigInfo
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TemplateUpload
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
@@ -5095,41 +19924,34 @@ func ParseGetV2ProjectsProjectNameClustersNameKubeconfigsResponse(rsp *http.Resp
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500InternalServerError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePutV2ProjectsProjectNameClustersNameLabelsResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameLabelsWithResponse call
-func ParsePutV2ProjectsProjectNameClustersNameLabelsResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameLabelsResponse, error) {
+// ParseDeleteV2TemplateUploadsIdResponse parses an HTTP response from a DeleteV2TemplateUploadsIdWithResponse call
+func ParseDeleteV2TemplateUploadsIdResponse(rsp *http.Response) (*DeleteV2TemplateUploadsIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2ProjectsProjectNameClustersNameLabelsResponse{
+	response := &DeleteV2TemplateUploadsIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5156,25 +19978,39 @@ func ParsePutV2ProjectsProjectNameClustersNameLabelsResponse(rsp *http.Response)
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePutV2ProjectsProjectNameClustersNameNodesResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameNodesWithResponse call
-func ParsePutV2ProjectsProjectNameClustersNameNodesResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameNodesResponse, error) {
+// ParseGetV2TemplateUploadsIdResponse parses an HTTP response from a GetV2TemplateUploadsIdWithResponse call
+func ParseGetV2TemplateUploadsIdResponse(rsp *http.Response) (*GetV2TemplateUploadsIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2ProjectsProjectNameClustersNameNodesResponse{
+	response := &GetV2TemplateUploadsIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5196,25 +20032,39 @@ func ParsePutV2ProjectsProjectNameClustersNameNodesResponse(rsp *http.Response)
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse parses an HTTP response from a DeleteV2ProjectsProjectNameClustersNameNodesNodeIdWithResponse call
-func ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse, error) {
+// ParsePostV2TemplateUploadsIdChunksResponse parses an HTTP response from a PostV2TemplateUploadsIdChunksWithResponse call
+func ParsePostV2TemplateUploadsIdChunksResponse(rsp *http.Response) (*PostV2TemplateUploadsIdChunksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse{
+	response := &PostV2TemplateUploadsIdChunksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5229,6 +20079,13 @@ func ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse(rsp *http.R
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5236,25 +20093,39 @@ func ParseDeleteV2ProjectsProjectNameClustersNameNodesNodeIdResponse(rsp *http.R
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePutV2ProjectsProjectNameClustersNameTemplateResponse parses an HTTP response from a PutV2ProjectsProjectNameClustersNameTemplateWithResponse call
-func ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp *http.Response) (*PutV2ProjectsProjectNameClustersNameTemplateResponse, error) {
+// ParsePostV2TemplateUploadsIdCommitResponse parses an HTTP response from a PostV2TemplateUploadsIdCommitWithResponse call
+func ParsePostV2TemplateUploadsIdCommitResponse(rsp *http.Response) (*PostV2TemplateUploadsIdCommitResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2ProjectsProjectNameClustersNameTemplateResponse{
+	response := &PostV2TemplateUploadsIdCommitResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5269,6 +20140,13 @@ func ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp *http.Respons
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5288,22 +20166,22 @@ func ParsePutV2ProjectsProjectNameClustersNameTemplateResponse(rsp *http.Respons
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse parses an HTTP response from a GetV2ProjectsProjectNameClustersNodeIdClusterdetailWithResponse call
-func ParseGetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse(rsp *http.Response) (*GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse, error) {
+// ParseGetV2TemplatesResponse parses an HTTP response from a GetV2TemplatesWithResponse call
+func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse{
+	response := &GetV2TemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterDetailInfo
+		var dest TemplateInfoList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5323,31 +20201,38 @@ func ParseGetV2ProjectsProjectNameClustersNodeIdClusterdetailResponse(rsp *http.
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameTemplatesResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesWithResponse call
-func ParseGetV2ProjectsProjectNameTemplatesResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesResponse, error) {
+// ParsePostV2TemplatesResponse parses an HTTP response from a PostV2TemplatesWithResponse call
+func ParsePostV2TemplatesResponse(rsp *http.Response) (*PostV2TemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameTemplatesResponse{
+	response := &PostV2TemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateInfoList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
@@ -5356,12 +20241,12 @@ func ParseGetV2ProjectsProjectNameTemplatesResponse(rsp *http.Response) (*GetV2P
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
@@ -5375,40 +20260,26 @@ func ParseGetV2ProjectsProjectNameTemplatesResponse(rsp *http.Response) (*GetV2P
 	return response, nil
 }
 
-// ParsePostV2ProjectsProjectNameTemplatesResponse parses an HTTP response from a PostV2ProjectsProjectNameTemplatesWithResponse call
-func ParsePostV2ProjectsProjectNameTemplatesResponse(rsp *http.Response) (*PostV2ProjectsProjectNameTemplatesResponse, error) {
+// ParseGetV2TemplatesCompatibilityResponse parses an HTTP response from a GetV2TemplatesCompatibilityWithResponse call
+func ParseGetV2TemplatesCompatibilityResponse(rsp *http.Response) (*GetV2TemplatesCompatibilityResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV2ProjectsProjectNameTemplatesResponse{
+	response := &GetV2TemplatesCompatibilityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateCompatibilityList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
@@ -5422,15 +20293,15 @@ func ParsePostV2ProjectsProjectNameTemplatesResponse(rsp *http.Response) (*PostV
 	return response, nil
 }
 
-// ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse parses an HTTP response from a PutV2ProjectsProjectNameTemplatesNameDefaultWithResponse call
-func ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp *http.Response) (*PutV2ProjectsProjectNameTemplatesNameDefaultResponse, error) {
+// ParsePutV2TemplatesNameDefaultResponse parses an HTTP response from a PutV2TemplatesNameDefaultWithResponse call
+func ParsePutV2TemplatesNameDefaultResponse(rsp *http.Response) (*PutV2TemplatesNameDefaultResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2ProjectsProjectNameTemplatesNameDefaultResponse{
+	response := &PutV2TemplatesNameDefaultResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5450,6 +20321,13 @@ func ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp *http.Respons
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5462,15 +20340,15 @@ func ParsePutV2ProjectsProjectNameTemplatesNameDefaultResponse(rsp *http.Respons
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameTemplatesNameVersionsResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesNameVersionsWithResponse call
-func ParseGetV2ProjectsProjectNameTemplatesNameVersionsResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesNameVersionsResponse, error) {
+// ParseGetV2TemplatesNameVersionsResponse parses an HTTP response from a GetV2TemplatesNameVersionsWithResponse call
+func ParseGetV2TemplatesNameVersionsResponse(rsp *http.Response) (*GetV2TemplatesNameVersionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameTemplatesNameVersionsResponse{
+	response := &GetV2TemplatesNameVersionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5509,15 +20387,15 @@ func ParseGetV2ProjectsProjectNameTemplatesNameVersionsResponse(rsp *http.Respon
 	return response, nil
 }
 
-// ParseDeleteV2ProjectsProjectNameTemplatesNameVersionResponse parses an HTTP response from a DeleteV2ProjectsProjectNameTemplatesNameVersionWithResponse call
-func ParseDeleteV2ProjectsProjectNameTemplatesNameVersionResponse(rsp *http.Response) (*DeleteV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
+// ParseDeleteV2TemplatesNameVersionResponse parses an HTTP response from a DeleteV2TemplatesNameVersionWithResponse call
+func ParseDeleteV2TemplatesNameVersionResponse(rsp *http.Response) (*DeleteV2TemplatesNameVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV2ProjectsProjectNameTemplatesNameVersionResponse{
+	response := &DeleteV2TemplatesNameVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5556,15 +20434,15 @@ func ParseDeleteV2ProjectsProjectNameTemplatesNameVersionResponse(rsp *http.Resp
 	return response, nil
 }
 
-// ParseGetV2ProjectsProjectNameTemplatesNameVersionResponse parses an HTTP response from a GetV2ProjectsProjectNameTemplatesNameVersionWithResponse call
-func ParseGetV2ProjectsProjectNameTemplatesNameVersionResponse(rsp *http.Response) (*GetV2ProjectsProjectNameTemplatesNameVersionResponse, error) {
+// ParseGetV2TemplatesNameVersionResponse parses an HTTP response from a GetV2TemplatesNameVersionWithResponse call
+func ParseGetV2TemplatesNameVersionResponse(rsp *http.Response) (*GetV2TemplatesNameVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2ProjectsProjectNameTemplatesNameVersionResponse{
+	response := &GetV2TemplatesNameVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5603,22 +20481,22 @@ func ParseGetV2ProjectsProjectNameTemplatesNameVersionResponse(rsp *http.Respons
 	return response, nil
 }
 
-// ParseGetV2TemplatesResponse parses an HTTP response from a GetV2TemplatesWithResponse call
-func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, error) {
+// ParsePutV2TemplatesNameVersionResponse parses an HTTP response from a PutV2TemplatesNameVersionWithResponse call
+func ParsePutV2TemplatesNameVersionResponse(rsp *http.Response) (*PutV2TemplatesNameVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2TemplatesResponse{
+	response := &PutV2TemplatesNameVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateInfoList
+		var dest TemplateInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5638,6 +20516,13 @@ func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, e
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5650,26 +20535,26 @@ func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, e
 	return response, nil
 }
 
-// ParsePostV2TemplatesResponse parses an HTTP response from a PostV2TemplatesWithResponse call
-func ParsePostV2TemplatesResponse(rsp *http.Response) (*PostV2TemplatesResponse, error) {
+// ParsePostV2TemplatesNameVersionClusterlabelsPreviewResponse parses an HTTP response from a PostV2TemplatesNameVersionClusterlabelsPreviewWithResponse call
+func ParsePostV2TemplatesNameVersionClusterlabelsPreviewResponse(rsp *http.Response) (*PostV2TemplatesNameVersionClusterlabelsPreviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV2TemplatesResponse{
+	response := &PostV2TemplatesNameVersionClusterlabelsPreviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LabelPropagationPreview
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
@@ -5678,12 +20563,12 @@ func ParsePostV2TemplatesResponse(rsp *http.Response) (*PostV2TemplatesResponse,
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
@@ -5697,20 +20582,27 @@ func ParsePostV2TemplatesResponse(rsp *http.Response) (*PostV2TemplatesResponse,
 	return response, nil
 }
 
-// ParsePutV2TemplatesNameDefaultResponse parses an HTTP response from a PutV2TemplatesNameDefaultWithResponse call
-func ParsePutV2TemplatesNameDefaultResponse(rsp *http.Response) (*PutV2TemplatesNameDefaultResponse, error) {
+// ParseGetV2TemplatesNameVersionClustersResponse parses an HTTP response from a GetV2TemplatesNameVersionClustersWithResponse call
+func ParseGetV2TemplatesNameVersionClustersResponse(rsp *http.Response) (*GetV2TemplatesNameVersionClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutV2TemplatesNameDefaultResponse{
+	response := &GetV2TemplatesNameVersionClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateClusters
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5737,22 +20629,22 @@ func ParsePutV2TemplatesNameDefaultResponse(rsp *http.Response) (*PutV2Templates
 	return response, nil
 }
 
-// ParseGetV2TemplatesNameVersionsResponse parses an HTTP response from a GetV2TemplatesNameVersionsWithResponse call
-func ParseGetV2TemplatesNameVersionsResponse(rsp *http.Response) (*GetV2TemplatesNameVersionsResponse, error) {
+// ParsePostV2TemplatesNameVersionDeprecateResponse parses an HTTP response from a PostV2TemplatesNameVersionDeprecateWithResponse call
+func ParsePostV2TemplatesNameVersionDeprecateResponse(rsp *http.Response) (*PostV2TemplatesNameVersionDeprecateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2TemplatesNameVersionsResponse{
+	response := &PostV2TemplatesNameVersionDeprecateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VersionList
+		var dest TemplateInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5772,6 +20664,13 @@ func ParseGetV2TemplatesNameVersionsResponse(rsp *http.Response) (*GetV2Template
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5784,20 +20683,74 @@ func ParseGetV2TemplatesNameVersionsResponse(rsp *http.Response) (*GetV2Template
 	return response, nil
 }
 
-// ParseDeleteV2TemplatesNameVersionResponse parses an HTTP response from a DeleteV2TemplatesNameVersionWithResponse call
-func ParseDeleteV2TemplatesNameVersionResponse(rsp *http.Response) (*DeleteV2TemplatesNameVersionResponse, error) {
+// ParseGetV2TemplatesNameVersionExportResponse parses an HTTP response from a GetV2TemplatesNameVersionExportWithResponse call
+func ParseGetV2TemplatesNameVersionExportResponse(rsp *http.Response) (*GetV2TemplatesNameVersionExportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteV2TemplatesNameVersionResponse{
+	response := &GetV2TemplatesNameVersionExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateBundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2TemplatesNameVersionPublishResponse parses an HTTP response from a PostV2TemplatesNameVersionPublishWithResponse call
+func ParsePostV2TemplatesNameVersionPublishResponse(rsp *http.Response) (*PostV2TemplatesNameVersionPublishResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2TemplatesNameVersionPublishResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5831,22 +20784,22 @@ func ParseDeleteV2TemplatesNameVersionResponse(rsp *http.Response) (*DeleteV2Tem
 	return response, nil
 }
 
-// ParseGetV2TemplatesNameVersionResponse parses an HTTP response from a GetV2TemplatesNameVersionWithResponse call
-func ParseGetV2TemplatesNameVersionResponse(rsp *http.Response) (*GetV2TemplatesNameVersionResponse, error) {
+// ParseGetV2WebhooksDestinationsResponse parses an HTTP response from a GetV2WebhooksDestinationsWithResponse call
+func ParseGetV2WebhooksDestinationsResponse(rsp *http.Response) (*GetV2WebhooksDestinationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2TemplatesNameVersionResponse{
+	response := &GetV2WebhooksDestinationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateInfo
+		var dest WebhookDestinationList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5859,19 +20812,19 @@ func ParseGetV2TemplatesNameVersionResponse(rsp *http.Response) (*GetV2Templates
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500InternalServerError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest N501NotImplemented
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON501 = &dest
 
 	}
 
@@ -9,21 +9,41 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /v2/admin/exports/{projectId})
+	GetV2AdminExportsProjectId(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+
+	// (GET /v2/admin/support-bundles/{projectId}/clusters/{name})
+	GetV2AdminSupportBundlesProjectIdClustersName(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, name string)
+
+	// (GET /v2/apichangelog)
+	GetV2Apichangelog(w http.ResponseWriter, r *http.Request)
+
+	// (GET /v2/authz/self)
+	GetV2AuthzSelf(w http.ResponseWriter, r *http.Request, params GetV2AuthzSelfParams)
+
 	// (GET /v2/clusters)
 	GetV2Clusters(w http.ResponseWriter, r *http.Request, params GetV2ClustersParams)
 
 	// (POST /v2/clusters)
 	PostV2Clusters(w http.ResponseWriter, r *http.Request, params PostV2ClustersParams)
 
+	// (POST /v2/clusters/import)
+	PostV2ClustersImport(w http.ResponseWriter, r *http.Request, params PostV2ClustersImportParams)
+
+	// (GET /v2/clusters/name-suggestion)
+	GetV2ClustersNameSuggestion(w http.ResponseWriter, r *http.Request, params GetV2ClustersNameSuggestionParams)
+
 	// (GET /v2/clusters/summary)
 	GetV2ClustersSummary(w http.ResponseWriter, r *http.Request, params GetV2ClustersSummaryParams)
 
@@ -33,33 +53,144 @@ type ServerInterface interface {
 	// (GET /v2/clusters/{name})
 	GetV2ClustersName(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameParams)
 
+	// (GET /v2/clusters/{name}/backups)
+	GetV2ClustersNameBackups(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameBackupsParams)
+
+	// (POST /v2/clusters/{name}/backups)
+	PostV2ClustersNameBackups(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameBackupsParams)
+
+	// (POST /v2/clusters/{name}/clone)
+	PostV2ClustersNameClone(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameCloneParams)
+
+	// (DELETE /v2/clusters/{name}/deletion)
+	DeleteV2ClustersNameDeletion(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameDeletionParams)
+
+	// (GET /v2/clusters/{name}/events)
+	GetV2ClustersNameEvents(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameEventsParams)
+
+	// (PUT /v2/clusters/{name}/extensions)
+	PutV2ClustersNameExtensions(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameExtensionsParams)
+
+	// (GET /v2/clusters/{name}/health)
+	GetV2ClustersNameHealth(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameHealthParams)
+
+	// (DELETE /v2/clusters/{name}/kubeconfigs)
+	DeleteV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameKubeconfigsParams)
+
 	// (GET /v2/clusters/{name}/kubeconfigs)
 	GetV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameKubeconfigsParams)
 
+	// (PATCH /v2/clusters/{name}/labels)
+	PatchV2ClustersNameLabels(w http.ResponseWriter, r *http.Request, name string, params PatchV2ClustersNameLabelsParams)
+
 	// (PUT /v2/clusters/{name}/labels)
 	PutV2ClustersNameLabels(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameLabelsParams)
 
+	// (DELETE /v2/clusters/{name}/maintenance)
+	DeleteV2ClustersNameMaintenance(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameMaintenanceParams)
+
+	// (PUT /v2/clusters/{name}/maintenance)
+	PutV2ClustersNameMaintenance(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameMaintenanceParams)
+
+	// (GET /v2/clusters/{name}/nodepools)
+	GetV2ClustersNameNodepools(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameNodepoolsParams)
+
+	// (POST /v2/clusters/{name}/nodepools)
+	PostV2ClustersNameNodepools(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameNodepoolsParams)
+
+	// (PATCH /v2/clusters/{name}/nodepools/{poolName})
+	PatchV2ClustersNameNodepoolsPoolName(w http.ResponseWriter, r *http.Request, name string, poolName string, params PatchV2ClustersNameNodepoolsPoolNameParams)
+
 	// (PUT /v2/clusters/{name}/nodes)
 	PutV2ClustersNameNodes(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameNodesParams)
 
 	// (DELETE /v2/clusters/{name}/nodes/{nodeId})
 	DeleteV2ClustersNameNodesNodeId(w http.ResponseWriter, r *http.Request, name string, nodeId string, params DeleteV2ClustersNameNodesNodeIdParams)
 
+	// (POST /v2/clusters/{name}/nodes/{nodeId}/cordon)
+	PostV2ClustersNameNodesNodeIdCordon(w http.ResponseWriter, r *http.Request, name string, nodeId string, params PostV2ClustersNameNodesNodeIdCordonParams)
+
+	// (GET /v2/clusters/{name}/nodes/{nodeId}/logs)
+	GetV2ClustersNameNodesNodeIdLogs(w http.ResponseWriter, r *http.Request, name string, nodeId string, params GetV2ClustersNameNodesNodeIdLogsParams)
+
+	// (POST /v2/clusters/{name}/nodes/{nodeId}/uncordon)
+	PostV2ClustersNameNodesNodeIdUncordon(w http.ResponseWriter, r *http.Request, name string, nodeId string, params PostV2ClustersNameNodesNodeIdUncordonParams)
+
+	// (POST /v2/clusters/{name}/restore)
+	PostV2ClustersNameRestore(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameRestoreParams)
+
 	// (PUT /v2/clusters/{name}/template)
 	PutV2ClustersNameTemplate(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameTemplateParams)
 
+	// (GET /v2/clusters/{name}/upgrades)
+	GetV2ClustersNameUpgrades(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameUpgradesParams)
+
 	// (GET /v2/clusters/{nodeId}/clusterdetail)
 	GetV2ClustersNodeIdClusterdetail(w http.ResponseWriter, r *http.Request, nodeId string, params GetV2ClustersNodeIdClusterdetailParams)
 
+	// (GET /v2/docs)
+	GetV2Docs(w http.ResponseWriter, r *http.Request)
+
+	// (GET /v2/extensions)
+	GetV2Extensions(w http.ResponseWriter, r *http.Request, params GetV2ExtensionsParams)
+
 	// (GET /v2/healthz)
 	GetV2Healthz(w http.ResponseWriter, r *http.Request)
 
+	// (GET /v2/openapi.json)
+	GetV2OpenapiJson(w http.ResponseWriter, r *http.Request)
+
+	// (GET /v2/operations)
+	GetV2Operations(w http.ResponseWriter, r *http.Request, params GetV2OperationsParams)
+
+	// (GET /v2/operations/{id})
+	GetV2OperationsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetV2OperationsIdParams)
+
+	// (GET /v2/pending-clusters)
+	GetV2PendingClusters(w http.ResponseWriter, r *http.Request, params GetV2PendingClustersParams)
+
+	// (DELETE /v2/pending-clusters/{name})
+	DeleteV2PendingClustersName(w http.ResponseWriter, r *http.Request, name string, params DeleteV2PendingClustersNameParams)
+
+	// (GET /v2/pending-clusters/{name})
+	GetV2PendingClustersName(w http.ResponseWriter, r *http.Request, name string, params GetV2PendingClustersNameParams)
+
+	// (PUT /v2/pending-clusters/{name})
+	PutV2PendingClustersName(w http.ResponseWriter, r *http.Request, name string, params PutV2PendingClustersNameParams)
+
+	// (GET /v2/readyz)
+	GetV2Readyz(w http.ResponseWriter, r *http.Request)
+
+	// (GET /v2/summary)
+	GetV2Summary(w http.ResponseWriter, r *http.Request, params GetV2SummaryParams)
+
+	// (GET /v2/supportmatrix)
+	GetV2Supportmatrix(w http.ResponseWriter, r *http.Request)
+
+	// (POST /v2/template-uploads)
+	PostV2TemplateUploads(w http.ResponseWriter, r *http.Request, params PostV2TemplateUploadsParams)
+
+	// (DELETE /v2/template-uploads/{id})
+	DeleteV2TemplateUploadsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteV2TemplateUploadsIdParams)
+
+	// (GET /v2/template-uploads/{id})
+	GetV2TemplateUploadsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetV2TemplateUploadsIdParams)
+
+	// (POST /v2/template-uploads/{id}/chunks)
+	PostV2TemplateUploadsIdChunks(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostV2TemplateUploadsIdChunksParams)
+
+	// (POST /v2/template-uploads/{id}/commit)
+	PostV2TemplateUploadsIdCommit(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostV2TemplateUploadsIdCommitParams)
+
 	// (GET /v2/templates)
 	GetV2Templates(w http.ResponseWriter, r *http.Request, params GetV2TemplatesParams)
 
 	// (POST /v2/templates)
 	PostV2Templates(w http.ResponseWriter, r *http.Request, params PostV2TemplatesParams)
 
+	// (GET /v2/templates/compatibility)
+	GetV2TemplatesCompatibility(w http.ResponseWriter, r *http.Request, params GetV2TemplatesCompatibilityParams)
+
 	// (PUT /v2/templates/{name}/default)
 	PutV2TemplatesNameDefault(w http.ResponseWriter, r *http.Request, name string, params PutV2TemplatesNameDefaultParams)
 
@@ -71,6 +202,27 @@ type ServerInterface interface {
 
 	// (GET /v2/templates/{name}/{version})
 	GetV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request, name string, version string, params GetV2TemplatesNameVersionParams)
+
+	// (PUT /v2/templates/{name}/{version})
+	PutV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request, name string, version string, params PutV2TemplatesNameVersionParams)
+
+	// (POST /v2/templates/{name}/{version}/clusterlabels/preview)
+	PostV2TemplatesNameVersionClusterlabelsPreview(w http.ResponseWriter, r *http.Request, name string, version string, params PostV2TemplatesNameVersionClusterlabelsPreviewParams)
+
+	// (GET /v2/templates/{name}/{version}/clusters)
+	GetV2TemplatesNameVersionClusters(w http.ResponseWriter, r *http.Request, name string, version string, params GetV2TemplatesNameVersionClustersParams)
+
+	// (POST /v2/templates/{name}/{version}/deprecate)
+	PostV2TemplatesNameVersionDeprecate(w http.ResponseWriter, r *http.Request, name string, version string, params PostV2TemplatesNameVersionDeprecateParams)
+
+	// (GET /v2/templates/{name}/{version}/export)
+	GetV2TemplatesNameVersionExport(w http.ResponseWriter, r *http.Request, name string, version string, params GetV2TemplatesNameVersionExportParams)
+
+	// (POST /v2/templates/{name}/{version}/publish)
+	PostV2TemplatesNameVersionPublish(w http.ResponseWriter, r *http.Request, name string, version string, params PostV2TemplatesNameVersionPublishParams)
+
+	// (GET /v2/webhooks/destinations)
+	GetV2WebhooksDestinations(w http.ResponseWriter, r *http.Request, params GetV2WebhooksDestinationsParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -82,6 +234,154 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetV2AdminExportsProjectId operation middleware
+func (siw *ServerInterfaceWrapper) GetV2AdminExportsProjectId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2AdminExportsProjectId(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2AdminSupportBundlesProjectIdClustersName operation middleware
+func (siw *ServerInterfaceWrapper) GetV2AdminSupportBundlesProjectIdClustersName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2AdminSupportBundlesProjectIdClustersName(w, r, projectId, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Apichangelog operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Apichangelog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Apichangelog(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2AuthzSelf operation middleware
+func (siw *ServerInterfaceWrapper) GetV2AuthzSelf(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2AuthzSelfParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = &Authorization
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2AuthzSelf(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetV2Clusters operation middleware
 func (siw *ServerInterfaceWrapper) GetV2Clusters(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -109,6 +409,14 @@ func (siw *ServerInterfaceWrapper) GetV2Clusters(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// ------------- Optional query parameter "pageToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageToken", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "orderBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderBy", r.URL.Query(), &params.OrderBy)
@@ -172,8 +480,35 @@ func (siw *ServerInterfaceWrapper) PostV2Clusters(w http.ResponseWriter, r *http
 	// Parameter object where we will unmarshal all parameters from the context
 	var params PostV2ClustersParams
 
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	headers := r.Header
 
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	// ------------- Required header parameter "Activeprojectid" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
 		var Activeprojectid ActiveProjectIdHeader
@@ -208,8 +543,8 @@ func (siw *ServerInterfaceWrapper) PostV2Clusters(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV2ClustersSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetV2ClustersSummary(w http.ResponseWriter, r *http.Request) {
+// PostV2ClustersImport operation middleware
+func (siw *ServerInterfaceWrapper) PostV2ClustersImport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -217,7 +552,7 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersSummary(w http.ResponseWriter, r
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV2ClustersSummaryParams
+	var params PostV2ClustersImportParams
 
 	headers := r.Header
 
@@ -245,7 +580,7 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersSummary(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2ClustersSummary(w, r, params)
+		siw.Handler.PostV2ClustersImport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -255,26 +590,25 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersSummary(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteV2ClustersName operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV2ClustersName(w http.ResponseWriter, r *http.Request) {
+// GetV2ClustersNameSuggestion operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameSuggestion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ClustersNameSuggestionParams
+
+	// ------------- Optional query parameter "base" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "base", r.URL.Query(), &params.Base)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "base", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, HTTPScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteV2ClustersNameParams
-
 	headers := r.Header
 
 	// ------------- Required header parameter "Activeprojectid" -------------
@@ -301,7 +635,7 @@ func (siw *ServerInterfaceWrapper) DeleteV2ClustersName(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteV2ClustersName(w, r, name, params)
+		siw.Handler.GetV2ClustersNameSuggestion(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -311,25 +645,16 @@ func (siw *ServerInterfaceWrapper) DeleteV2ClustersName(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV2ClustersName operation middleware
-func (siw *ServerInterfaceWrapper) GetV2ClustersName(w http.ResponseWriter, r *http.Request) {
+// GetV2ClustersSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersSummary(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV2ClustersNameParams
+	var params GetV2ClustersSummaryParams
 
 	headers := r.Header
 
@@ -357,7 +682,7 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersName(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2ClustersName(w, r, name, params)
+		siw.Handler.GetV2ClustersSummary(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -367,8 +692,8 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersName(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV2ClustersNameKubeconfigs operation middleware
-func (siw *ServerInterfaceWrapper) GetV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request) {
+// DeleteV2ClustersName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2ClustersName(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -385,58 +710,59 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersNameKubeconfigs(w http.ResponseW
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV2ClustersNameKubeconfigsParams
+	var params DeleteV2ClustersNameParams
 
-	headers := r.Header
+	// ------------- Optional query parameter "force" -------------
 
-	// ------------- Required header parameter "Activeprojectid" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
-		var Activeprojectid ActiveProjectIdHeader
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
-			return
-		}
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
-			return
-		}
+	// ------------- Optional query parameter "forceFinalize" -------------
 
-		params.Activeprojectid = Activeprojectid
+	err = runtime.BindQueryParameter("form", true, false, "forceFinalize", r.URL.Query(), &params.ForceFinalize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "forceFinalize", Err: err})
+		return
+	}
 
-	} else {
-		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+	// ------------- Optional query parameter "purge" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "purge", r.URL.Query(), &params.Purge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purge", Err: err})
 		return
 	}
 
-	// ------------- Required header parameter "Authorization" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
-		var Authorization string
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
 		n := len(valueList)
 		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
 			return
 		}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
 			return
 		}
 
-		params.Authorization = Authorization
+		params.Activeprojectid = Activeprojectid
 
 	} else {
-		err := fmt.Errorf("Header parameter Authorization is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2ClustersNameKubeconfigs(w, r, name, params)
+		siw.Handler.DeleteV2ClustersName(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -446,8 +772,8 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersNameKubeconfigs(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutV2ClustersNameLabels operation middleware
-func (siw *ServerInterfaceWrapper) PutV2ClustersNameLabels(w http.ResponseWriter, r *http.Request) {
+// GetV2ClustersName operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersName(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -464,7 +790,7 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameLabels(w http.ResponseWriter
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PutV2ClustersNameLabelsParams
+	var params GetV2ClustersNameParams
 
 	headers := r.Header
 
@@ -492,7 +818,7 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameLabels(w http.ResponseWriter
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutV2ClustersNameLabels(w, r, name, params)
+		siw.Handler.GetV2ClustersName(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -502,8 +828,8 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameLabels(w http.ResponseWriter
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutV2ClustersNameNodes operation middleware
-func (siw *ServerInterfaceWrapper) PutV2ClustersNameNodes(w http.ResponseWriter, r *http.Request) {
+// GetV2ClustersNameBackups operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameBackups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -520,7 +846,7 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameNodes(w http.ResponseWriter,
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PutV2ClustersNameNodesParams
+	var params GetV2ClustersNameBackupsParams
 
 	headers := r.Header
 
@@ -548,7 +874,7 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameNodes(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutV2ClustersNameNodes(w, r, name, params)
+		siw.Handler.GetV2ClustersNameBackups(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -558,8 +884,8 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameNodes(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteV2ClustersNameNodesNodeId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameNodesNodeId(w http.ResponseWriter, r *http.Request) {
+// PostV2ClustersNameBackups operation middleware
+func (siw *ServerInterfaceWrapper) PostV2ClustersNameBackups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -573,27 +899,10 @@ func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameNodesNodeId(w http.Respon
 		return
 	}
 
-	// ------------- Path parameter "nodeId" -------------
-	var nodeId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteV2ClustersNameNodesNodeIdParams
-
-	// ------------- Optional query parameter "force" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
-		return
-	}
+	var params PostV2ClustersNameBackupsParams
 
 	headers := r.Header
 
@@ -621,7 +930,7 @@ func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameNodesNodeId(w http.Respon
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteV2ClustersNameNodesNodeId(w, r, name, nodeId, params)
+		siw.Handler.PostV2ClustersNameBackups(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -631,8 +940,8 @@ func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameNodesNodeId(w http.Respon
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutV2ClustersNameTemplate operation middleware
-func (siw *ServerInterfaceWrapper) PutV2ClustersNameTemplate(w http.ResponseWriter, r *http.Request) {
+// PostV2ClustersNameClone operation middleware
+func (siw *ServerInterfaceWrapper) PostV2ClustersNameClone(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -649,7 +958,7 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameTemplate(w http.ResponseWrit
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PutV2ClustersNameTemplateParams
+	var params PostV2ClustersNameCloneParams
 
 	headers := r.Header
 
@@ -677,7 +986,7 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameTemplate(w http.ResponseWrit
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutV2ClustersNameTemplate(w, r, name, params)
+		siw.Handler.PostV2ClustersNameClone(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -687,25 +996,25 @@ func (siw *ServerInterfaceWrapper) PutV2ClustersNameTemplate(w http.ResponseWrit
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV2ClustersNodeIdClusterdetail operation middleware
-func (siw *ServerInterfaceWrapper) GetV2ClustersNodeIdClusterdetail(w http.ResponseWriter, r *http.Request) {
+// DeleteV2ClustersNameDeletion operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameDeletion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "nodeId" -------------
-	var nodeId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV2ClustersNodeIdClusterdetailParams
+	var params DeleteV2ClustersNameDeletionParams
 
 	headers := r.Header
 
@@ -733,22 +1042,7 @@ func (siw *ServerInterfaceWrapper) GetV2ClustersNodeIdClusterdetail(w http.Respo
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2ClustersNodeIdClusterdetail(w, r, nodeId, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetV2Healthz operation middleware
-func (siw *ServerInterfaceWrapper) GetV2Healthz(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2Healthz(w, r)
+		siw.Handler.DeleteV2ClustersNameDeletion(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -758,54 +1052,31 @@ func (siw *ServerInterfaceWrapper) GetV2Healthz(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV2Templates operation middleware
-func (siw *ServerInterfaceWrapper) GetV2Templates(w http.ResponseWriter, r *http.Request) {
+// GetV2ClustersNameEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, HTTPScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV2TemplatesParams
-
-	// ------------- Optional query parameter "default" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "default", r.URL.Query(), &params.Default)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "default", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "orderBy" -------------
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderBy", r.URL.Query(), &params.OrderBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderBy", Err: err})
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ClustersNameEventsParams
 
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Optional query parameter "source" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
 		return
 	}
 
@@ -835,7 +1106,7 @@ func (siw *ServerInterfaceWrapper) GetV2Templates(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2Templates(w, r, params)
+		siw.Handler.GetV2ClustersNameEvents(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -845,16 +1116,25 @@ func (siw *ServerInterfaceWrapper) GetV2Templates(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV2Templates operation middleware
-func (siw *ServerInterfaceWrapper) PostV2Templates(w http.ResponseWriter, r *http.Request) {
+// PutV2ClustersNameExtensions operation middleware
+func (siw *ServerInterfaceWrapper) PutV2ClustersNameExtensions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostV2TemplatesParams
+	var params PutV2ClustersNameExtensionsParams
 
 	headers := r.Header
 
@@ -882,7 +1162,7 @@ func (siw *ServerInterfaceWrapper) PostV2Templates(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV2Templates(w, r, params)
+		siw.Handler.PutV2ClustersNameExtensions(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -892,8 +1172,8 @@ func (siw *ServerInterfaceWrapper) PostV2Templates(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutV2TemplatesNameDefault operation middleware
-func (siw *ServerInterfaceWrapper) PutV2TemplatesNameDefault(w http.ResponseWriter, r *http.Request) {
+// GetV2ClustersNameHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameHealth(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -910,7 +1190,7 @@ func (siw *ServerInterfaceWrapper) PutV2TemplatesNameDefault(w http.ResponseWrit
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PutV2TemplatesNameDefaultParams
+	var params GetV2ClustersNameHealthParams
 
 	headers := r.Header
 
@@ -938,7 +1218,7 @@ func (siw *ServerInterfaceWrapper) PutV2TemplatesNameDefault(w http.ResponseWrit
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutV2TemplatesNameDefault(w, r, name, params)
+		siw.Handler.GetV2ClustersNameHealth(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -948,8 +1228,8 @@ func (siw *ServerInterfaceWrapper) PutV2TemplatesNameDefault(w http.ResponseWrit
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV2TemplatesNameVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersions(w http.ResponseWriter, r *http.Request) {
+// DeleteV2ClustersNameKubeconfigs operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -966,7 +1246,7 @@ func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersions(w http.ResponseWri
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV2TemplatesNameVersionsParams
+	var params DeleteV2ClustersNameKubeconfigsParams
 
 	headers := r.Header
 
@@ -993,8 +1273,31 @@ func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersions(w http.ResponseWri
 		return
 	}
 
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2TemplatesNameVersions(w, r, name, params)
+		siw.Handler.DeleteV2ClustersNameKubeconfigs(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1004,8 +1307,8 @@ func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersions(w http.ResponseWri
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteV2TemplatesNameVersion operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request) {
+// GetV2ClustersNameKubeconfigs operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1019,20 +1322,19 @@ func (siw *ServerInterfaceWrapper) DeleteV2TemplatesNameVersion(w http.ResponseW
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version string
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ClustersNameKubeconfigsParams
+
+	// ------------- Optional query parameter "authType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "authType", r.URL.Query(), &params.AuthType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "authType", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, HTTPScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteV2TemplatesNameVersionParams
-
 	headers := r.Header
 
 	// ------------- Required header parameter "Activeprojectid" -------------
@@ -1058,8 +1360,31 @@ func (siw *ServerInterfaceWrapper) DeleteV2TemplatesNameVersion(w http.ResponseW
 		return
 	}
 
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteV2TemplatesNameVersion(w, r, name, version, params)
+		siw.Handler.GetV2ClustersNameKubeconfigs(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1069,8 +1394,8 @@ func (siw *ServerInterfaceWrapper) DeleteV2TemplatesNameVersion(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV2TemplatesNameVersion operation middleware
-func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request) {
+// PatchV2ClustersNameLabels operation middleware
+func (siw *ServerInterfaceWrapper) PatchV2ClustersNameLabels(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1084,22 +1409,32 @@ func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersion(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, HTTPScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV2TemplatesNameVersionParams
+	var params PatchV2ClustersNameLabelsParams
 
 	headers := r.Header
 
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch ClusterIfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	// ------------- Required header parameter "Activeprojectid" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
 		var Activeprojectid ActiveProjectIdHeader
@@ -1124,7 +1459,7 @@ func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersion(w http.ResponseWrit
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV2TemplatesNameVersion(w, r, name, version, params)
+		siw.Handler.PatchV2ClustersNameLabels(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1134,1086 +1469,8007 @@ func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersion(w http.ResponseWrit
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PutV2ClustersNameLabels operation middleware
+func (siw *ServerInterfaceWrapper) PutV2ClustersNameLabels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutV2ClustersNameLabelsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV2ClustersNameLabels(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteV2ClustersNameMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteV2ClustersNameMaintenanceParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = &Authorization
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV2ClustersNameMaintenance(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutV2ClustersNameMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) PutV2ClustersNameMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutV2ClustersNameMaintenanceParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = &Authorization
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV2ClustersNameMaintenance(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2ClustersNameNodepools operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameNodepools(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ClustersNameNodepoolsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2ClustersNameNodepools(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2ClustersNameNodepools operation middleware
+func (siw *ServerInterfaceWrapper) PostV2ClustersNameNodepools(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2ClustersNameNodepoolsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2ClustersNameNodepools(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchV2ClustersNameNodepoolsPoolName operation middleware
+func (siw *ServerInterfaceWrapper) PatchV2ClustersNameNodepoolsPoolName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "poolName" -------------
+	var poolName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "poolName", r.PathValue("poolName"), &poolName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "poolName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchV2ClustersNameNodepoolsPoolNameParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchV2ClustersNameNodepoolsPoolName(w, r, name, poolName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutV2ClustersNameNodes operation middleware
+func (siw *ServerInterfaceWrapper) PutV2ClustersNameNodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutV2ClustersNameNodesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV2ClustersNameNodes(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteV2ClustersNameNodesNodeId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2ClustersNameNodesNodeId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteV2ClustersNameNodesNodeIdParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV2ClustersNameNodesNodeId(w, r, name, nodeId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2ClustersNameNodesNodeIdCordon operation middleware
+func (siw *ServerInterfaceWrapper) PostV2ClustersNameNodesNodeIdCordon(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2ClustersNameNodesNodeIdCordonParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2ClustersNameNodesNodeIdCordon(w, r, name, nodeId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2ClustersNameNodesNodeIdLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameNodesNodeIdLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ClustersNameNodesNodeIdLogsParams
+
+	// ------------- Optional query parameter "tailLines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tailLines", r.URL.Query(), &params.TailLines)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tailLines", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2ClustersNameNodesNodeIdLogs(w, r, name, nodeId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2ClustersNameNodesNodeIdUncordon operation middleware
+func (siw *ServerInterfaceWrapper) PostV2ClustersNameNodesNodeIdUncordon(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2ClustersNameNodesNodeIdUncordonParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2ClustersNameNodesNodeIdUncordon(w, r, name, nodeId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2ClustersNameRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostV2ClustersNameRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2ClustersNameRestoreParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2ClustersNameRestore(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutV2ClustersNameTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PutV2ClustersNameTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutV2ClustersNameTemplateParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV2ClustersNameTemplate(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2ClustersNameUpgrades operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNameUpgrades(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ClustersNameUpgradesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2ClustersNameUpgrades(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2ClustersNodeIdClusterdetail operation middleware
+func (siw *ServerInterfaceWrapper) GetV2ClustersNodeIdClusterdetail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "nodeId" -------------
+	var nodeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeId", r.PathValue("nodeId"), &nodeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ClustersNodeIdClusterdetailParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2ClustersNodeIdClusterdetail(w, r, nodeId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Docs operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Docs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Docs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Extensions operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Extensions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2ExtensionsParams
+
+	// ------------- Optional query parameter "provider" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "provider", r.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Extensions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Healthz operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Healthz(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Healthz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2OpenapiJson operation middleware
+func (siw *ServerInterfaceWrapper) GetV2OpenapiJson(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2OpenapiJson(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Operations operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Operations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2OperationsParams
+
+	// ------------- Optional query parameter "cluster" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cluster", r.URL.Query(), &params.Cluster)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Operations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2OperationsId operation middleware
+func (siw *ServerInterfaceWrapper) GetV2OperationsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2OperationsIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2OperationsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2PendingClusters operation middleware
+func (siw *ServerInterfaceWrapper) GetV2PendingClusters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2PendingClustersParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2PendingClusters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteV2PendingClustersName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2PendingClustersName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteV2PendingClustersNameParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV2PendingClustersName(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2PendingClustersName operation middleware
+func (siw *ServerInterfaceWrapper) GetV2PendingClustersName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2PendingClustersNameParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2PendingClustersName(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutV2PendingClustersName operation middleware
+func (siw *ServerInterfaceWrapper) PutV2PendingClustersName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutV2PendingClustersNameParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV2PendingClustersName(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Readyz operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Readyz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Summary operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Summary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2SummaryParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Summary(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Supportmatrix operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Supportmatrix(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Supportmatrix(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2TemplateUploads operation middleware
+func (siw *ServerInterfaceWrapper) PostV2TemplateUploads(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2TemplateUploadsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2TemplateUploads(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteV2TemplateUploadsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2TemplateUploadsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteV2TemplateUploadsIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV2TemplateUploadsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2TemplateUploadsId operation middleware
+func (siw *ServerInterfaceWrapper) GetV2TemplateUploadsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2TemplateUploadsIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2TemplateUploadsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2TemplateUploadsIdChunks operation middleware
+func (siw *ServerInterfaceWrapper) PostV2TemplateUploadsIdChunks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2TemplateUploadsIdChunksParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2TemplateUploadsIdChunks(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2TemplateUploadsIdCommit operation middleware
+func (siw *ServerInterfaceWrapper) PostV2TemplateUploadsIdCommit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2TemplateUploadsIdCommitParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2TemplateUploadsIdCommit(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2Templates operation middleware
+func (siw *ServerInterfaceWrapper) GetV2Templates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2TemplatesParams
+
+	// ------------- Optional query parameter "default" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "default", r.URL.Query(), &params.Default)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "default", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2Templates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2Templates operation middleware
+func (siw *ServerInterfaceWrapper) PostV2Templates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2TemplatesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2Templates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2TemplatesCompatibility operation middleware
+func (siw *ServerInterfaceWrapper) GetV2TemplatesCompatibility(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2TemplatesCompatibilityParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2TemplatesCompatibility(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutV2TemplatesNameDefault operation middleware
+func (siw *ServerInterfaceWrapper) PutV2TemplatesNameDefault(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutV2TemplatesNameDefaultParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV2TemplatesNameDefault(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2TemplatesNameVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2TemplatesNameVersionsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2TemplatesNameVersions(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteV2TemplatesNameVersion operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteV2TemplatesNameVersionParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV2TemplatesNameVersion(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2TemplatesNameVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2TemplatesNameVersionParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2TemplatesNameVersion(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutV2TemplatesNameVersion operation middleware
+func (siw *ServerInterfaceWrapper) PutV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutV2TemplatesNameVersionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutV2TemplatesNameVersion(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2TemplatesNameVersionClusterlabelsPreview operation middleware
+func (siw *ServerInterfaceWrapper) PostV2TemplatesNameVersionClusterlabelsPreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2TemplatesNameVersionClusterlabelsPreviewParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2TemplatesNameVersionClusterlabelsPreview(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2TemplatesNameVersionClusters operation middleware
+func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersionClusters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2TemplatesNameVersionClustersParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = &Authorization
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2TemplatesNameVersionClusters(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2TemplatesNameVersionDeprecate operation middleware
+func (siw *ServerInterfaceWrapper) PostV2TemplatesNameVersionDeprecate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2TemplatesNameVersionDeprecateParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2TemplatesNameVersionDeprecate(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2TemplatesNameVersionExport operation middleware
+func (siw *ServerInterfaceWrapper) GetV2TemplatesNameVersionExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2TemplatesNameVersionExportParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2TemplatesNameVersionExport(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV2TemplatesNameVersionPublish operation middleware
+func (siw *ServerInterfaceWrapper) PostV2TemplatesNameVersionPublish(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV2TemplatesNameVersionPublishParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV2TemplatesNameVersionPublish(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV2WebhooksDestinations operation middleware
+func (siw *ServerInterfaceWrapper) GetV2WebhooksDestinations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, HTTPScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV2WebhooksDestinationsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Activeprojectid" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Activeprojectid")]; found {
+		var Activeprojectid ActiveProjectIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Activeprojectid", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Activeprojectid", valueList[0], &Activeprojectid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Activeprojectid", Err: err})
+			return
+		}
+
+		params.Activeprojectid = Activeprojectid
+
+	} else {
+		err := fmt.Errorf("Header parameter Activeprojectid is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Activeprojectid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV2WebhooksDestinations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       *http.ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m *http.ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m *http.ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/v2/admin/exports/{projectId}", wrapper.GetV2AdminExportsProjectId)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/admin/support-bundles/{projectId}/clusters/{name}", wrapper.GetV2AdminSupportBundlesProjectIdClustersName)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/apichangelog", wrapper.GetV2Apichangelog)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/authz/self", wrapper.GetV2AuthzSelf)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters", wrapper.GetV2Clusters)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters", wrapper.PostV2Clusters)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters/import", wrapper.PostV2ClustersImport)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/name-suggestion", wrapper.GetV2ClustersNameSuggestion)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/summary", wrapper.GetV2ClustersSummary)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/clusters/{name}", wrapper.DeleteV2ClustersName)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}", wrapper.GetV2ClustersName)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/backups", wrapper.GetV2ClustersNameBackups)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters/{name}/backups", wrapper.PostV2ClustersNameBackups)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters/{name}/clone", wrapper.PostV2ClustersNameClone)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/clusters/{name}/deletion", wrapper.DeleteV2ClustersNameDeletion)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/events", wrapper.GetV2ClustersNameEvents)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/extensions", wrapper.PutV2ClustersNameExtensions)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/health", wrapper.GetV2ClustersNameHealth)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/clusters/{name}/kubeconfigs", wrapper.DeleteV2ClustersNameKubeconfigs)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/kubeconfigs", wrapper.GetV2ClustersNameKubeconfigs)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v2/clusters/{name}/labels", wrapper.PatchV2ClustersNameLabels)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/labels", wrapper.PutV2ClustersNameLabels)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/clusters/{name}/maintenance", wrapper.DeleteV2ClustersNameMaintenance)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/maintenance", wrapper.PutV2ClustersNameMaintenance)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/nodepools", wrapper.GetV2ClustersNameNodepools)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters/{name}/nodepools", wrapper.PostV2ClustersNameNodepools)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v2/clusters/{name}/nodepools/{poolName}", wrapper.PatchV2ClustersNameNodepoolsPoolName)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/nodes", wrapper.PutV2ClustersNameNodes)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/clusters/{name}/nodes/{nodeId}", wrapper.DeleteV2ClustersNameNodesNodeId)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters/{name}/nodes/{nodeId}/cordon", wrapper.PostV2ClustersNameNodesNodeIdCordon)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/nodes/{nodeId}/logs", wrapper.GetV2ClustersNameNodesNodeIdLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters/{name}/nodes/{nodeId}/uncordon", wrapper.PostV2ClustersNameNodesNodeIdUncordon)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters/{name}/restore", wrapper.PostV2ClustersNameRestore)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/template", wrapper.PutV2ClustersNameTemplate)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/upgrades", wrapper.GetV2ClustersNameUpgrades)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{nodeId}/clusterdetail", wrapper.GetV2ClustersNodeIdClusterdetail)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/docs", wrapper.GetV2Docs)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/extensions", wrapper.GetV2Extensions)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/healthz", wrapper.GetV2Healthz)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/openapi.json", wrapper.GetV2OpenapiJson)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/operations", wrapper.GetV2Operations)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/operations/{id}", wrapper.GetV2OperationsId)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/pending-clusters", wrapper.GetV2PendingClusters)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/pending-clusters/{name}", wrapper.DeleteV2PendingClustersName)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/pending-clusters/{name}", wrapper.GetV2PendingClustersName)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/pending-clusters/{name}", wrapper.PutV2PendingClustersName)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/readyz", wrapper.GetV2Readyz)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/summary", wrapper.GetV2Summary)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/supportmatrix", wrapper.GetV2Supportmatrix)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/template-uploads", wrapper.PostV2TemplateUploads)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/template-uploads/{id}", wrapper.DeleteV2TemplateUploadsId)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/template-uploads/{id}", wrapper.GetV2TemplateUploadsId)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/template-uploads/{id}/chunks", wrapper.PostV2TemplateUploadsIdChunks)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/template-uploads/{id}/commit", wrapper.PostV2TemplateUploadsIdCommit)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/templates", wrapper.GetV2Templates)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/templates", wrapper.PostV2Templates)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/templates/compatibility", wrapper.GetV2TemplatesCompatibility)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/templates/{name}/default", wrapper.PutV2TemplatesNameDefault)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/templates/{name}/versions", wrapper.GetV2TemplatesNameVersions)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v2/templates/{name}/{version}", wrapper.DeleteV2TemplatesNameVersion)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/templates/{name}/{version}", wrapper.GetV2TemplatesNameVersion)
+	m.HandleFunc("PUT "+options.BaseURL+"/v2/templates/{name}/{version}", wrapper.PutV2TemplatesNameVersion)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/templates/{name}/{version}/clusterlabels/preview", wrapper.PostV2TemplatesNameVersionClusterlabelsPreview)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/templates/{name}/{version}/clusters", wrapper.GetV2TemplatesNameVersionClusters)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/templates/{name}/{version}/deprecate", wrapper.PostV2TemplatesNameVersionDeprecate)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/templates/{name}/{version}/export", wrapper.GetV2TemplatesNameVersionExport)
+	m.HandleFunc("POST "+options.BaseURL+"/v2/templates/{name}/{version}/publish", wrapper.PostV2TemplatesNameVersionPublish)
+	m.HandleFunc("GET "+options.BaseURL+"/v2/webhooks/destinations", wrapper.GetV2WebhooksDestinations)
+
+	return m
+}
+
+type N400BadRequestJSONResponse ProblemDetails
+
+type N401UnauthorizedJSONResponse ProblemDetails
+
+type N403ForbiddenJSONResponse ProblemDetails
+
+type N404NotFoundJSONResponse ProblemDetails
+
+type N409ConflictJSONResponse ProblemDetails
+
+type N500InternalServerErrorJSONResponse ProblemDetails
+
+type N501NotImplementedJSONResponse ProblemDetails
+
+type GetV2AdminExportsProjectIdRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+}
+
+type GetV2AdminExportsProjectIdResponseObject interface {
+	VisitGetV2AdminExportsProjectIdResponse(w http.ResponseWriter) error
+}
+
+type GetV2AdminExportsProjectId200ApplicationgzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV2AdminExportsProjectId200ApplicationgzipResponse) VisitGetV2AdminExportsProjectIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV2AdminExportsProjectId400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2AdminExportsProjectId400JSONResponse) VisitGetV2AdminExportsProjectIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AdminExportsProjectId404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2AdminExportsProjectId404JSONResponse) VisitGetV2AdminExportsProjectIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AdminExportsProjectId500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2AdminExportsProjectId500JSONResponse) VisitGetV2AdminExportsProjectIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AdminExportsProjectId501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2AdminExportsProjectId501JSONResponse) VisitGetV2AdminExportsProjectIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersNameRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+	Name      string             `json:"name"`
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersNameResponseObject interface {
+	VisitGetV2AdminSupportBundlesProjectIdClustersNameResponse(w http.ResponseWriter) error
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersName200ApplicationgzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV2AdminSupportBundlesProjectIdClustersName200ApplicationgzipResponse) VisitGetV2AdminSupportBundlesProjectIdClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2AdminSupportBundlesProjectIdClustersName400JSONResponse) VisitGetV2AdminSupportBundlesProjectIdClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2AdminSupportBundlesProjectIdClustersName404JSONResponse) VisitGetV2AdminSupportBundlesProjectIdClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersName500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2AdminSupportBundlesProjectIdClustersName500JSONResponse) VisitGetV2AdminSupportBundlesProjectIdClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AdminSupportBundlesProjectIdClustersName501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2AdminSupportBundlesProjectIdClustersName501JSONResponse) VisitGetV2AdminSupportBundlesProjectIdClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ApichangelogRequestObject struct {
+}
+
+type GetV2ApichangelogResponseObject interface {
+	VisitGetV2ApichangelogResponse(w http.ResponseWriter) error
+}
+
+type GetV2Apichangelog200JSONResponse ApiChangelog
+
+func (response GetV2Apichangelog200JSONResponse) VisitGetV2ApichangelogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Apichangelog500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Apichangelog500JSONResponse) VisitGetV2ApichangelogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AuthzSelfRequestObject struct {
+	Params GetV2AuthzSelfParams
+}
+
+type GetV2AuthzSelfResponseObject interface {
+	VisitGetV2AuthzSelfResponse(w http.ResponseWriter) error
+}
+
+type GetV2AuthzSelf200JSONResponse EffectivePermissions
+
+func (response GetV2AuthzSelf200JSONResponse) VisitGetV2AuthzSelfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AuthzSelf400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2AuthzSelf400JSONResponse) VisitGetV2AuthzSelfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2AuthzSelf500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2AuthzSelf500JSONResponse) VisitGetV2AuthzSelfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersRequestObject struct {
+	Params GetV2ClustersParams
+}
+
+type GetV2ClustersResponseObject interface {
+	VisitGetV2ClustersResponse(w http.ResponseWriter) error
+}
+
+type GetV2Clusters200JSONResponse struct {
+	Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+
+	// NextPageToken The pageToken of the next page, absent on the last page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// TotalElements The count of items in the entire list, regardless of pagination. It is an estimate while paging with pageToken through a listing filtered by labels.
+	TotalElements int32 `json:"totalElements"`
+}
+
+func (response GetV2Clusters200JSONResponse) VisitGetV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Clusters400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2Clusters400JSONResponse) VisitGetV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Clusters500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Clusters500JSONResponse) VisitGetV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersRequestObject struct {
+	Params PostV2ClustersParams
+	Body   *PostV2ClustersJSONRequestBody
+}
+
+type PostV2ClustersResponseObject interface {
+	VisitPostV2ClustersResponse(w http.ResponseWriter) error
+}
+
+type PostV2Clusters200JSONResponse ClusterDryRun
+
+func (response PostV2Clusters200JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Clusters201JSONResponse string
+
+func (response PostV2Clusters201JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Clusters202JSONResponse PendingCluster
+
+func (response PostV2Clusters202JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Clusters400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2Clusters400JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Clusters403JSONResponse struct{ N403ForbiddenJSONResponse }
+
+func (response PostV2Clusters403JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Clusters409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2Clusters409JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Clusters422JSONResponse NodeValidationProblem
+
+func (response PostV2Clusters422JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Clusters500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2Clusters500JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersImportRequestObject struct {
+	Params PostV2ClustersImportParams
+	Body   *PostV2ClustersImportJSONRequestBody
+}
+
+type PostV2ClustersImportResponseObject interface {
+	VisitPostV2ClustersImportResponse(w http.ResponseWriter) error
+}
+
+type PostV2ClustersImport200JSONResponse string
+
+func (response PostV2ClustersImport200JSONResponse) VisitPostV2ClustersImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersImport400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2ClustersImport400JSONResponse) VisitPostV2ClustersImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersImport404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2ClustersImport404JSONResponse) VisitPostV2ClustersImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersImport409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2ClustersImport409JSONResponse) VisitPostV2ClustersImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersImport500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2ClustersImport500JSONResponse) VisitPostV2ClustersImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameSuggestionRequestObject struct {
+	Params GetV2ClustersNameSuggestionParams
+}
+
+type GetV2ClustersNameSuggestionResponseObject interface {
+	VisitGetV2ClustersNameSuggestionResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameSuggestion200JSONResponse ClusterNameSuggestion
+
+func (response GetV2ClustersNameSuggestion200JSONResponse) VisitGetV2ClustersNameSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameSuggestion400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameSuggestion400JSONResponse) VisitGetV2ClustersNameSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameSuggestion500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameSuggestion500JSONResponse) VisitGetV2ClustersNameSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersSummaryRequestObject struct {
+	Params GetV2ClustersSummaryParams
+}
+
+type GetV2ClustersSummaryResponseObject interface {
+	VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersSummary200JSONResponse ClusterSummary
+
+func (response GetV2ClustersSummary200JSONResponse) VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersSummary400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersSummary400JSONResponse) VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersSummary500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersSummary500JSONResponse) VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteV2ClustersNameParams
+}
+
+type DeleteV2ClustersNameResponseObject interface {
+	VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2ClustersName202Response struct {
+}
+
+func (response DeleteV2ClustersName202Response) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type DeleteV2ClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2ClustersName400JSONResponse) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2ClustersName404JSONResponse) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersName409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response DeleteV2ClustersName409JSONResponse) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersName500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2ClustersName500JSONResponse) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2ClustersNameParams
+}
+
+type GetV2ClustersNameResponseObject interface {
+	VisitGetV2ClustersNameResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersName200JSONResponse ClusterDetailInfo
+
+func (response GetV2ClustersName200JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersName400JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersName404JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersName500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersName500JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameBackupsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2ClustersNameBackupsParams
+}
+
+type GetV2ClustersNameBackupsResponseObject interface {
+	VisitGetV2ClustersNameBackupsResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameBackups200JSONResponse ClusterBackupList
+
+func (response GetV2ClustersNameBackups200JSONResponse) VisitGetV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameBackups400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameBackups400JSONResponse) VisitGetV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameBackups404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNameBackups404JSONResponse) VisitGetV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameBackups500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameBackups500JSONResponse) VisitGetV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameBackupsRequestObject struct {
+	Name   string `json:"name"`
+	Params PostV2ClustersNameBackupsParams
+}
+
+type PostV2ClustersNameBackupsResponseObject interface {
+	VisitPostV2ClustersNameBackupsResponse(w http.ResponseWriter) error
+}
+
+type PostV2ClustersNameBackups202JSONResponse ClusterBackup
+
+func (response PostV2ClustersNameBackups202JSONResponse) VisitPostV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameBackups400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2ClustersNameBackups400JSONResponse) VisitPostV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameBackups404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2ClustersNameBackups404JSONResponse) VisitPostV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameBackups500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2ClustersNameBackups500JSONResponse) VisitPostV2ClustersNameBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameCloneRequestObject struct {
+	Name   string `json:"name"`
+	Params PostV2ClustersNameCloneParams
+	Body   *PostV2ClustersNameCloneJSONRequestBody
+}
+
+type PostV2ClustersNameCloneResponseObject interface {
+	VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error
+}
+
+type PostV2ClustersNameClone201JSONResponse string
+
+func (response PostV2ClustersNameClone201JSONResponse) VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameClone400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2ClustersNameClone400JSONResponse) VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameClone403JSONResponse struct{ N403ForbiddenJSONResponse }
+
+func (response PostV2ClustersNameClone403JSONResponse) VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameClone404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2ClustersNameClone404JSONResponse) VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameClone409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2ClustersNameClone409JSONResponse) VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameClone422JSONResponse NodeValidationProblem
+
+func (response PostV2ClustersNameClone422JSONResponse) VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameClone500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2ClustersNameClone500JSONResponse) VisitPostV2ClustersNameCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameDeletionRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteV2ClustersNameDeletionParams
+}
+
+type DeleteV2ClustersNameDeletionResponseObject interface {
+	VisitDeleteV2ClustersNameDeletionResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2ClustersNameDeletion204Response struct {
+}
+
+func (response DeleteV2ClustersNameDeletion204Response) VisitDeleteV2ClustersNameDeletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteV2ClustersNameDeletion400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2ClustersNameDeletion400JSONResponse) VisitDeleteV2ClustersNameDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameDeletion404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2ClustersNameDeletion404JSONResponse) VisitDeleteV2ClustersNameDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameDeletion409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response DeleteV2ClustersNameDeletion409JSONResponse) VisitDeleteV2ClustersNameDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameDeletion500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2ClustersNameDeletion500JSONResponse) VisitDeleteV2ClustersNameDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameEventsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2ClustersNameEventsParams
+}
+
+type GetV2ClustersNameEventsResponseObject interface {
+	VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameEvents200JSONResponse ClusterEventList
+
+func (response GetV2ClustersNameEvents200JSONResponse) VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV2ClustersNameEvents200TexteventStreamResponse) VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV2ClustersNameEvents400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameEvents400JSONResponse) VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameEvents404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNameEvents404JSONResponse) VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameEvents500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameEvents500JSONResponse) VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameEvents501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2ClustersNameEvents501JSONResponse) VisitGetV2ClustersNameEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameExtensionsRequestObject struct {
+	Name   string `json:"name"`
+	Params PutV2ClustersNameExtensionsParams
+	Body   *PutV2ClustersNameExtensionsJSONRequestBody
+}
+
+type PutV2ClustersNameExtensionsResponseObject interface {
+	VisitPutV2ClustersNameExtensionsResponse(w http.ResponseWriter) error
+}
+
+type PutV2ClustersNameExtensions200JSONResponse ClusterExtensions
+
+func (response PutV2ClustersNameExtensions200JSONResponse) VisitPutV2ClustersNameExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameExtensions400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2ClustersNameExtensions400JSONResponse) VisitPutV2ClustersNameExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameExtensions404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2ClustersNameExtensions404JSONResponse) VisitPutV2ClustersNameExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameExtensions500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2ClustersNameExtensions500JSONResponse) VisitPutV2ClustersNameExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameHealthRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2ClustersNameHealthParams
+}
+
+type GetV2ClustersNameHealthResponseObject interface {
+	VisitGetV2ClustersNameHealthResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameHealth200JSONResponse ClusterHealth
+
+func (response GetV2ClustersNameHealth200JSONResponse) VisitGetV2ClustersNameHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameHealth400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameHealth400JSONResponse) VisitGetV2ClustersNameHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameHealth404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNameHealth404JSONResponse) VisitGetV2ClustersNameHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameHealth500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameHealth500JSONResponse) VisitGetV2ClustersNameHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameHealth501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2ClustersNameHealth501JSONResponse) VisitGetV2ClustersNameHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameKubeconfigsRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteV2ClustersNameKubeconfigsParams
+}
+
+type DeleteV2ClustersNameKubeconfigsResponseObject interface {
+	VisitDeleteV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2ClustersNameKubeconfigs204Response struct {
+}
+
+func (response DeleteV2ClustersNameKubeconfigs204Response) VisitDeleteV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteV2ClustersNameKubeconfigs400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2ClustersNameKubeconfigs400JSONResponse) VisitDeleteV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameKubeconfigs401JSONResponse struct{ N401UnauthorizedJSONResponse }
+
+func (response DeleteV2ClustersNameKubeconfigs401JSONResponse) VisitDeleteV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameKubeconfigs404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2ClustersNameKubeconfigs404JSONResponse) VisitDeleteV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameKubeconfigs500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2ClustersNameKubeconfigs500JSONResponse) VisitDeleteV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameKubeconfigs501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response DeleteV2ClustersNameKubeconfigs501JSONResponse) VisitDeleteV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameKubeconfigsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2ClustersNameKubeconfigsParams
+}
+
+type GetV2ClustersNameKubeconfigsResponseObject interface {
+	VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameKubeconfigs200JSONResponse KubeconfigInfo
+
+func (response GetV2ClustersNameKubeconfigs200JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameKubeconfigs400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameKubeconfigs400JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameKubeconfigs401JSONResponse struct{ N401UnauthorizedJSONResponse }
+
+func (response GetV2ClustersNameKubeconfigs401JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameKubeconfigs404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNameKubeconfigs404JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameKubeconfigs500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameKubeconfigs500JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameLabelsRequestObject struct {
+	Name   string `json:"name"`
+	Params PatchV2ClustersNameLabelsParams
+	Body   *PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchV2ClustersNameLabelsResponseObject interface {
+	VisitPatchV2ClustersNameLabelsResponse(w http.ResponseWriter) error
+}
+
+type PatchV2ClustersNameLabels200JSONResponse VersionedClusterLabels
+
+func (response PatchV2ClustersNameLabels200JSONResponse) VisitPatchV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameLabels400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PatchV2ClustersNameLabels400JSONResponse) VisitPatchV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameLabels404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PatchV2ClustersNameLabels404JSONResponse) VisitPatchV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameLabels409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PatchV2ClustersNameLabels409JSONResponse) VisitPatchV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameLabels500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PatchV2ClustersNameLabels500JSONResponse) VisitPatchV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameLabelsRequestObject struct {
+	Name   string `json:"name"`
+	Params PutV2ClustersNameLabelsParams
+	Body   *PutV2ClustersNameLabelsJSONRequestBody
+}
+
+type PutV2ClustersNameLabelsResponseObject interface {
+	VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error
+}
+
+type PutV2ClustersNameLabels200Response struct {
+}
+
+func (response PutV2ClustersNameLabels200Response) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutV2ClustersNameLabels400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2ClustersNameLabels400JSONResponse) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameLabels404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2ClustersNameLabels404JSONResponse) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameLabels500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2ClustersNameLabels500JSONResponse) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameMaintenanceRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteV2ClustersNameMaintenanceParams
+}
+
+type DeleteV2ClustersNameMaintenanceResponseObject interface {
+	VisitDeleteV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2ClustersNameMaintenance204Response struct {
+}
+
+func (response DeleteV2ClustersNameMaintenance204Response) VisitDeleteV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteV2ClustersNameMaintenance400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2ClustersNameMaintenance400JSONResponse) VisitDeleteV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameMaintenance404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2ClustersNameMaintenance404JSONResponse) VisitDeleteV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameMaintenance409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response DeleteV2ClustersNameMaintenance409JSONResponse) VisitDeleteV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameMaintenance500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2ClustersNameMaintenance500JSONResponse) VisitDeleteV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameMaintenanceRequestObject struct {
+	Name   string `json:"name"`
+	Params PutV2ClustersNameMaintenanceParams
+	Body   *PutV2ClustersNameMaintenanceJSONRequestBody
+}
+
+type PutV2ClustersNameMaintenanceResponseObject interface {
+	VisitPutV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type PutV2ClustersNameMaintenance200JSONResponse ClusterMaintenance
+
+func (response PutV2ClustersNameMaintenance200JSONResponse) VisitPutV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameMaintenance400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2ClustersNameMaintenance400JSONResponse) VisitPutV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameMaintenance404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2ClustersNameMaintenance404JSONResponse) VisitPutV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameMaintenance409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PutV2ClustersNameMaintenance409JSONResponse) VisitPutV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameMaintenance500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2ClustersNameMaintenance500JSONResponse) VisitPutV2ClustersNameMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodepoolsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2ClustersNameNodepoolsParams
+}
+
+type GetV2ClustersNameNodepoolsResponseObject interface {
+	VisitGetV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameNodepools200JSONResponse NodePoolList
+
+func (response GetV2ClustersNameNodepools200JSONResponse) VisitGetV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodepools400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameNodepools400JSONResponse) VisitGetV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodepools404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNameNodepools404JSONResponse) VisitGetV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodepools500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameNodepools500JSONResponse) VisitGetV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodepoolsRequestObject struct {
+	Name   string `json:"name"`
+	Params PostV2ClustersNameNodepoolsParams
+	Body   *PostV2ClustersNameNodepoolsJSONRequestBody
+}
+
+type PostV2ClustersNameNodepoolsResponseObject interface {
+	VisitPostV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error
+}
+
+type PostV2ClustersNameNodepools201JSONResponse NodePool
+
+func (response PostV2ClustersNameNodepools201JSONResponse) VisitPostV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodepools400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2ClustersNameNodepools400JSONResponse) VisitPostV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodepools403JSONResponse struct{ N403ForbiddenJSONResponse }
+
+func (response PostV2ClustersNameNodepools403JSONResponse) VisitPostV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodepools404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2ClustersNameNodepools404JSONResponse) VisitPostV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodepools409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2ClustersNameNodepools409JSONResponse) VisitPostV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodepools500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2ClustersNameNodepools500JSONResponse) VisitPostV2ClustersNameNodepoolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameNodepoolsPoolNameRequestObject struct {
+	Name     string `json:"name"`
+	PoolName string `json:"poolName"`
+	Params   PatchV2ClustersNameNodepoolsPoolNameParams
+	Body     *PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody
+}
+
+type PatchV2ClustersNameNodepoolsPoolNameResponseObject interface {
+	VisitPatchV2ClustersNameNodepoolsPoolNameResponse(w http.ResponseWriter) error
+}
+
+type PatchV2ClustersNameNodepoolsPoolName200JSONResponse NodePool
+
+func (response PatchV2ClustersNameNodepoolsPoolName200JSONResponse) VisitPatchV2ClustersNameNodepoolsPoolNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameNodepoolsPoolName400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PatchV2ClustersNameNodepoolsPoolName400JSONResponse) VisitPatchV2ClustersNameNodepoolsPoolNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameNodepoolsPoolName403JSONResponse struct{ N403ForbiddenJSONResponse }
+
+func (response PatchV2ClustersNameNodepoolsPoolName403JSONResponse) VisitPatchV2ClustersNameNodepoolsPoolNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameNodepoolsPoolName404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PatchV2ClustersNameNodepoolsPoolName404JSONResponse) VisitPatchV2ClustersNameNodepoolsPoolNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV2ClustersNameNodepoolsPoolName500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PatchV2ClustersNameNodepoolsPoolName500JSONResponse) VisitPatchV2ClustersNameNodepoolsPoolNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameNodesRequestObject struct {
+	Name   string `json:"name"`
+	Params PutV2ClustersNameNodesParams
+	Body   *PutV2ClustersNameNodesJSONRequestBody
+}
+
+type PutV2ClustersNameNodesResponseObject interface {
+	VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error
+}
+
+type PutV2ClustersNameNodes200Response struct {
+}
+
+func (response PutV2ClustersNameNodes200Response) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutV2ClustersNameNodes400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2ClustersNameNodes400JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameNodes403JSONResponse struct{ N403ForbiddenJSONResponse }
+
+func (response PutV2ClustersNameNodes403JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameNodes404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2ClustersNameNodes404JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameNodes500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2ClustersNameNodes500JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameNodesNodeIdRequestObject struct {
+	Name   string `json:"name"`
+	NodeId string `json:"nodeId"`
+	Params DeleteV2ClustersNameNodesNodeIdParams
+}
+
+type DeleteV2ClustersNameNodesNodeIdResponseObject interface {
+	VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2ClustersNameNodesNodeId200Response struct {
+}
+
+func (response DeleteV2ClustersNameNodesNodeId200Response) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteV2ClustersNameNodesNodeId400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2ClustersNameNodesNodeId400JSONResponse) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameNodesNodeId404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2ClustersNameNodesNodeId404JSONResponse) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameNodesNodeId409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response DeleteV2ClustersNameNodesNodeId409JSONResponse) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2ClustersNameNodesNodeId500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2ClustersNameNodesNodeId500JSONResponse) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdCordonRequestObject struct {
+	Name   string `json:"name"`
+	NodeId string `json:"nodeId"`
+	Params PostV2ClustersNameNodesNodeIdCordonParams
+	Body   *PostV2ClustersNameNodesNodeIdCordonJSONRequestBody
+}
+
+type PostV2ClustersNameNodesNodeIdCordonResponseObject interface {
+	VisitPostV2ClustersNameNodesNodeIdCordonResponse(w http.ResponseWriter) error
+}
+
+type PostV2ClustersNameNodesNodeIdCordon200JSONResponse NodeMaintenance
+
+func (response PostV2ClustersNameNodesNodeIdCordon200JSONResponse) VisitPostV2ClustersNameNodesNodeIdCordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdCordon400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdCordon400JSONResponse) VisitPostV2ClustersNameNodesNodeIdCordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdCordon404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdCordon404JSONResponse) VisitPostV2ClustersNameNodesNodeIdCordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdCordon409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdCordon409JSONResponse) VisitPostV2ClustersNameNodesNodeIdCordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdCordon500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2ClustersNameNodesNodeIdCordon500JSONResponse) VisitPostV2ClustersNameNodesNodeIdCordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdCordon501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdCordon501JSONResponse) VisitPostV2ClustersNameNodesNodeIdCordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodesNodeIdLogsRequestObject struct {
+	Name   string `json:"name"`
+	NodeId string `json:"nodeId"`
+	Params GetV2ClustersNameNodesNodeIdLogsParams
+}
+
+type GetV2ClustersNameNodesNodeIdLogsResponseObject interface {
+	VisitGetV2ClustersNameNodesNodeIdLogsResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameNodesNodeIdLogs200TextResponse string
+
+func (response GetV2ClustersNameNodesNodeIdLogs200TextResponse) VisitGetV2ClustersNameNodesNodeIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetV2ClustersNameNodesNodeIdLogs400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameNodesNodeIdLogs400JSONResponse) VisitGetV2ClustersNameNodesNodeIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodesNodeIdLogs404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNameNodesNodeIdLogs404JSONResponse) VisitGetV2ClustersNameNodesNodeIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodesNodeIdLogs500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameNodesNodeIdLogs500JSONResponse) VisitGetV2ClustersNameNodesNodeIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameNodesNodeIdLogs501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2ClustersNameNodesNodeIdLogs501JSONResponse) VisitGetV2ClustersNameNodesNodeIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdUncordonRequestObject struct {
+	Name   string `json:"name"`
+	NodeId string `json:"nodeId"`
+	Params PostV2ClustersNameNodesNodeIdUncordonParams
+}
+
+type PostV2ClustersNameNodesNodeIdUncordonResponseObject interface {
+	VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w http.ResponseWriter) error
+}
+
+type PostV2ClustersNameNodesNodeIdUncordon200JSONResponse NodeMaintenance
+
+func (response PostV2ClustersNameNodesNodeIdUncordon200JSONResponse) VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdUncordon400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdUncordon400JSONResponse) VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdUncordon404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdUncordon404JSONResponse) VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdUncordon409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdUncordon409JSONResponse) VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdUncordon500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2ClustersNameNodesNodeIdUncordon500JSONResponse) VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameNodesNodeIdUncordon501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response PostV2ClustersNameNodesNodeIdUncordon501JSONResponse) VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameRestoreRequestObject struct {
+	Name   string `json:"name"`
+	Params PostV2ClustersNameRestoreParams
+	Body   *PostV2ClustersNameRestoreJSONRequestBody
+}
+
+type PostV2ClustersNameRestoreResponseObject interface {
+	VisitPostV2ClustersNameRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostV2ClustersNameRestore202JSONResponse ClusterBackup
+
+func (response PostV2ClustersNameRestore202JSONResponse) VisitPostV2ClustersNameRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameRestore400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2ClustersNameRestore400JSONResponse) VisitPostV2ClustersNameRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameRestore404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2ClustersNameRestore404JSONResponse) VisitPostV2ClustersNameRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameRestore409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2ClustersNameRestore409JSONResponse) VisitPostV2ClustersNameRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2ClustersNameRestore500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2ClustersNameRestore500JSONResponse) VisitPostV2ClustersNameRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameTemplateRequestObject struct {
+	Name   string `json:"name"`
+	Params PutV2ClustersNameTemplateParams
+	Body   *PutV2ClustersNameTemplateJSONRequestBody
+}
+
+type PutV2ClustersNameTemplateResponseObject interface {
+	VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error
+}
+
+type PutV2ClustersNameTemplate202Response struct {
+}
+
+func (response PutV2ClustersNameTemplate202Response) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type PutV2ClustersNameTemplate400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2ClustersNameTemplate400JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameTemplate404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2ClustersNameTemplate404JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameTemplate500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2ClustersNameTemplate500JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2ClustersNameTemplate501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response PutV2ClustersNameTemplate501JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameUpgradesRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2ClustersNameUpgradesParams
+}
+
+type GetV2ClustersNameUpgradesResponseObject interface {
+	VisitGetV2ClustersNameUpgradesResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNameUpgrades200JSONResponse ClusterUpgrades
+
+func (response GetV2ClustersNameUpgrades200JSONResponse) VisitGetV2ClustersNameUpgradesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameUpgrades400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNameUpgrades400JSONResponse) VisitGetV2ClustersNameUpgradesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameUpgrades404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNameUpgrades404JSONResponse) VisitGetV2ClustersNameUpgradesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNameUpgrades500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2ClustersNameUpgrades500JSONResponse) VisitGetV2ClustersNameUpgradesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNodeIdClusterdetailRequestObject struct {
+	NodeId string `json:"nodeId"`
+	Params GetV2ClustersNodeIdClusterdetailParams
+}
+
+type GetV2ClustersNodeIdClusterdetailResponseObject interface {
+	VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error
+}
+
+type GetV2ClustersNodeIdClusterdetail200JSONResponse ClusterDetailInfo
+
+func (response GetV2ClustersNodeIdClusterdetail200JSONResponse) VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNodeIdClusterdetail400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2ClustersNodeIdClusterdetail400JSONResponse) VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ClustersNodeIdClusterdetail404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2ClustersNodeIdClusterdetail404JSONResponse) VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2DocsRequestObject struct {
+}
+
+type GetV2DocsResponseObject interface {
+	VisitGetV2DocsResponse(w http.ResponseWriter) error
+}
+
+type GetV2Docs200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV2Docs200TexthtmlResponse) VisitGetV2DocsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV2Docs500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Docs500JSONResponse) VisitGetV2DocsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Docs501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2Docs501JSONResponse) VisitGetV2DocsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ExtensionsRequestObject struct {
+	Params GetV2ExtensionsParams
+}
+
+type GetV2ExtensionsResponseObject interface {
+	VisitGetV2ExtensionsResponse(w http.ResponseWriter) error
+}
+
+type GetV2Extensions200JSONResponse ExtensionCatalog
+
+func (response GetV2Extensions200JSONResponse) VisitGetV2ExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Extensions500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Extensions500JSONResponse) VisitGetV2ExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2HealthzRequestObject struct {
+}
+
+type GetV2HealthzResponseObject interface {
+	VisitGetV2HealthzResponse(w http.ResponseWriter) error
+}
+
+type GetV2Healthz200JSONResponse string
+
+func (response GetV2Healthz200JSONResponse) VisitGetV2HealthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Healthz500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Healthz500JSONResponse) VisitGetV2HealthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Healthz503JSONResponse ProblemDetails
+
+func (response GetV2Healthz503JSONResponse) VisitGetV2HealthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OpenapiJsonRequestObject struct {
+}
+
+type GetV2OpenapiJsonResponseObject interface {
+	VisitGetV2OpenapiJsonResponse(w http.ResponseWriter) error
+}
+
+type GetV2OpenapiJson200JSONResponse map[string]interface{}
+
+func (response GetV2OpenapiJson200JSONResponse) VisitGetV2OpenapiJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OpenapiJson500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2OpenapiJson500JSONResponse) VisitGetV2OpenapiJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OperationsRequestObject struct {
+	Params GetV2OperationsParams
+}
+
+type GetV2OperationsResponseObject interface {
+	VisitGetV2OperationsResponse(w http.ResponseWriter) error
+}
+
+type GetV2Operations200JSONResponse OperationList
+
+func (response GetV2Operations200JSONResponse) VisitGetV2OperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Operations400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2Operations400JSONResponse) VisitGetV2OperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Operations500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Operations500JSONResponse) VisitGetV2OperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Operations501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2Operations501JSONResponse) VisitGetV2OperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OperationsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetV2OperationsIdParams
+}
+
+type GetV2OperationsIdResponseObject interface {
+	VisitGetV2OperationsIdResponse(w http.ResponseWriter) error
+}
+
+type GetV2OperationsId200JSONResponse Operation
+
+func (response GetV2OperationsId200JSONResponse) VisitGetV2OperationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OperationsId400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2OperationsId400JSONResponse) VisitGetV2OperationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OperationsId404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2OperationsId404JSONResponse) VisitGetV2OperationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OperationsId500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2OperationsId500JSONResponse) VisitGetV2OperationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2OperationsId501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2OperationsId501JSONResponse) VisitGetV2OperationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClustersRequestObject struct {
+	Params GetV2PendingClustersParams
+}
+
+type GetV2PendingClustersResponseObject interface {
+	VisitGetV2PendingClustersResponse(w http.ResponseWriter) error
+}
+
+type GetV2PendingClusters200JSONResponse PendingClusterList
+
+func (response GetV2PendingClusters200JSONResponse) VisitGetV2PendingClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClusters400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2PendingClusters400JSONResponse) VisitGetV2PendingClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClusters500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2PendingClusters500JSONResponse) VisitGetV2PendingClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClusters501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2PendingClusters501JSONResponse) VisitGetV2PendingClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2PendingClustersNameRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteV2PendingClustersNameParams
+}
+
+type DeleteV2PendingClustersNameResponseObject interface {
+	VisitDeleteV2PendingClustersNameResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2PendingClustersName204Response struct {
+}
+
+func (response DeleteV2PendingClustersName204Response) VisitDeleteV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteV2PendingClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2PendingClustersName400JSONResponse) VisitDeleteV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2PendingClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2PendingClustersName404JSONResponse) VisitDeleteV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2PendingClustersName409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response DeleteV2PendingClustersName409JSONResponse) VisitDeleteV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2PendingClustersName500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2PendingClustersName500JSONResponse) VisitDeleteV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2PendingClustersName501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response DeleteV2PendingClustersName501JSONResponse) VisitDeleteV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClustersNameRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2PendingClustersNameParams
+}
+
+type GetV2PendingClustersNameResponseObject interface {
+	VisitGetV2PendingClustersNameResponse(w http.ResponseWriter) error
+}
+
+type GetV2PendingClustersName200JSONResponse PendingCluster
+
+func (response GetV2PendingClustersName200JSONResponse) VisitGetV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2PendingClustersName400JSONResponse) VisitGetV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2PendingClustersName404JSONResponse) VisitGetV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClustersName500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2PendingClustersName500JSONResponse) VisitGetV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2PendingClustersName501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2PendingClustersName501JSONResponse) VisitGetV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2PendingClustersNameRequestObject struct {
+	Name   string `json:"name"`
+	Params PutV2PendingClustersNameParams
+	Body   *PutV2PendingClustersNameJSONRequestBody
+}
+
+type PutV2PendingClustersNameResponseObject interface {
+	VisitPutV2PendingClustersNameResponse(w http.ResponseWriter) error
+}
+
+type PutV2PendingClustersName200JSONResponse PendingCluster
+
+func (response PutV2PendingClustersName200JSONResponse) VisitPutV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2PendingClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2PendingClustersName400JSONResponse) VisitPutV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2PendingClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2PendingClustersName404JSONResponse) VisitPutV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2PendingClustersName409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PutV2PendingClustersName409JSONResponse) VisitPutV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2PendingClustersName500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2PendingClustersName500JSONResponse) VisitPutV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2PendingClustersName501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response PutV2PendingClustersName501JSONResponse) VisitPutV2PendingClustersNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2ReadyzRequestObject struct {
+}
+
+type GetV2ReadyzResponseObject interface {
+	VisitGetV2ReadyzResponse(w http.ResponseWriter) error
+}
+
+type GetV2Readyz200JSONResponse Readiness
+
+func (response GetV2Readyz200JSONResponse) VisitGetV2ReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Readyz500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Readyz500JSONResponse) VisitGetV2ReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Readyz503JSONResponse Readiness
+
+func (response GetV2Readyz503JSONResponse) VisitGetV2ReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2SummaryRequestObject struct {
+	Params GetV2SummaryParams
+}
+
+type GetV2SummaryResponseObject interface {
+	VisitGetV2SummaryResponse(w http.ResponseWriter) error
+}
+
+type GetV2Summary200JSONResponse ProjectSummary
+
+func (response GetV2Summary200JSONResponse) VisitGetV2SummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Summary500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Summary500JSONResponse) VisitGetV2SummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2SupportmatrixRequestObject struct {
+}
+
+type GetV2SupportmatrixResponseObject interface {
+	VisitGetV2SupportmatrixResponse(w http.ResponseWriter) error
+}
+
+type GetV2Supportmatrix200JSONResponse SupportMatrix
+
+func (response GetV2Supportmatrix200JSONResponse) VisitGetV2SupportmatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Supportmatrix500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Supportmatrix500JSONResponse) VisitGetV2SupportmatrixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsRequestObject struct {
+	Params PostV2TemplateUploadsParams
+	Body   *PostV2TemplateUploadsJSONRequestBody
+}
+
+type PostV2TemplateUploadsResponseObject interface {
+	VisitPostV2TemplateUploadsResponse(w http.ResponseWriter) error
+}
+
+type PostV2TemplateUploads201JSONResponse TemplateUpload
+
+func (response PostV2TemplateUploads201JSONResponse) VisitPostV2TemplateUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploads400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2TemplateUploads400JSONResponse) VisitPostV2TemplateUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploads500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2TemplateUploads500JSONResponse) VisitPostV2TemplateUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploads501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response PostV2TemplateUploads501JSONResponse) VisitPostV2TemplateUploadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplateUploadsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteV2TemplateUploadsIdParams
+}
+
+type DeleteV2TemplateUploadsIdResponseObject interface {
+	VisitDeleteV2TemplateUploadsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2TemplateUploadsId204Response struct {
+}
+
+func (response DeleteV2TemplateUploadsId204Response) VisitDeleteV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteV2TemplateUploadsId400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2TemplateUploadsId400JSONResponse) VisitDeleteV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplateUploadsId404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2TemplateUploadsId404JSONResponse) VisitDeleteV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplateUploadsId500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2TemplateUploadsId500JSONResponse) VisitDeleteV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplateUploadsId501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response DeleteV2TemplateUploadsId501JSONResponse) VisitDeleteV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplateUploadsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetV2TemplateUploadsIdParams
+}
+
+type GetV2TemplateUploadsIdResponseObject interface {
+	VisitGetV2TemplateUploadsIdResponse(w http.ResponseWriter) error
+}
+
+type GetV2TemplateUploadsId200JSONResponse TemplateUpload
+
+func (response GetV2TemplateUploadsId200JSONResponse) VisitGetV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplateUploadsId400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2TemplateUploadsId400JSONResponse) VisitGetV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplateUploadsId404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2TemplateUploadsId404JSONResponse) VisitGetV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplateUploadsId500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2TemplateUploadsId500JSONResponse) VisitGetV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplateUploadsId501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2TemplateUploadsId501JSONResponse) VisitGetV2TemplateUploadsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdChunksRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostV2TemplateUploadsIdChunksParams
+	Body   *PostV2TemplateUploadsIdChunksJSONRequestBody
+}
+
+type PostV2TemplateUploadsIdChunksResponseObject interface {
+	VisitPostV2TemplateUploadsIdChunksResponse(w http.ResponseWriter) error
+}
+
+type PostV2TemplateUploadsIdChunks200JSONResponse TemplateUpload
+
+func (response PostV2TemplateUploadsIdChunks200JSONResponse) VisitPostV2TemplateUploadsIdChunksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdChunks400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2TemplateUploadsIdChunks400JSONResponse) VisitPostV2TemplateUploadsIdChunksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdChunks404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2TemplateUploadsIdChunks404JSONResponse) VisitPostV2TemplateUploadsIdChunksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdChunks409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2TemplateUploadsIdChunks409JSONResponse) VisitPostV2TemplateUploadsIdChunksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdChunks500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2TemplateUploadsIdChunks500JSONResponse) VisitPostV2TemplateUploadsIdChunksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdChunks501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response PostV2TemplateUploadsIdChunks501JSONResponse) VisitPostV2TemplateUploadsIdChunksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdCommitRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostV2TemplateUploadsIdCommitParams
+}
+
+type PostV2TemplateUploadsIdCommitResponseObject interface {
+	VisitPostV2TemplateUploadsIdCommitResponse(w http.ResponseWriter) error
+}
+
+type PostV2TemplateUploadsIdCommit201JSONResponse string
+
+func (response PostV2TemplateUploadsIdCommit201JSONResponse) VisitPostV2TemplateUploadsIdCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdCommit400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2TemplateUploadsIdCommit400JSONResponse) VisitPostV2TemplateUploadsIdCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdCommit404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2TemplateUploadsIdCommit404JSONResponse) VisitPostV2TemplateUploadsIdCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdCommit409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2TemplateUploadsIdCommit409JSONResponse) VisitPostV2TemplateUploadsIdCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdCommit500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2TemplateUploadsIdCommit500JSONResponse) VisitPostV2TemplateUploadsIdCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplateUploadsIdCommit501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response PostV2TemplateUploadsIdCommit501JSONResponse) VisitPostV2TemplateUploadsIdCommitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesRequestObject struct {
+	Params GetV2TemplatesParams
+}
+
+type GetV2TemplatesResponseObject interface {
+	VisitGetV2TemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetV2Templates200JSONResponse TemplateInfoList
+
+func (response GetV2Templates200JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Templates400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2Templates400JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Templates404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2Templates404JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2Templates500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2Templates500JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesRequestObject struct {
+	Params PostV2TemplatesParams
+	Body   *PostV2TemplatesJSONRequestBody
+}
+
+type PostV2TemplatesResponseObject interface {
+	VisitPostV2TemplatesResponse(w http.ResponseWriter) error
+}
+
+type PostV2Templates201JSONResponse string
+
+func (response PostV2Templates201JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Templates400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2Templates400JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Templates409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2Templates409JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2Templates500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2Templates500JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesCompatibilityRequestObject struct {
+	Params GetV2TemplatesCompatibilityParams
+}
+
+type GetV2TemplatesCompatibilityResponseObject interface {
+	VisitGetV2TemplatesCompatibilityResponse(w http.ResponseWriter) error
+}
+
+type GetV2TemplatesCompatibility200JSONResponse TemplateCompatibilityList
+
+func (response GetV2TemplatesCompatibility200JSONResponse) VisitGetV2TemplatesCompatibilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesCompatibility500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2TemplatesCompatibility500JSONResponse) VisitGetV2TemplatesCompatibilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameDefaultRequestObject struct {
+	Name   string `json:"name"`
+	Params PutV2TemplatesNameDefaultParams
+	Body   *PutV2TemplatesNameDefaultJSONRequestBody
+}
+
+type PutV2TemplatesNameDefaultResponseObject interface {
+	VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error
+}
+
+type PutV2TemplatesNameDefault200Response struct {
+}
+
+func (response PutV2TemplatesNameDefault200Response) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutV2TemplatesNameDefault400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2TemplatesNameDefault400JSONResponse) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameDefault404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2TemplatesNameDefault404JSONResponse) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameDefault409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PutV2TemplatesNameDefault409JSONResponse) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameDefault500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2TemplatesNameDefault500JSONResponse) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersionsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetV2TemplatesNameVersionsParams
+}
+
+type GetV2TemplatesNameVersionsResponseObject interface {
+	VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetV2TemplatesNameVersions200JSONResponse VersionList
+
+func (response GetV2TemplatesNameVersions200JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersions400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2TemplatesNameVersions400JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersions404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2TemplatesNameVersions404JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersions500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2TemplatesNameVersions500JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplatesNameVersionRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  DeleteV2TemplatesNameVersionParams
+}
+
+type DeleteV2TemplatesNameVersionResponseObject interface {
+	VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error
+}
+
+type DeleteV2TemplatesNameVersion204Response struct {
+}
+
+func (response DeleteV2TemplatesNameVersion204Response) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteV2TemplatesNameVersion400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response DeleteV2TemplatesNameVersion400JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplatesNameVersion404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response DeleteV2TemplatesNameVersion404JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplatesNameVersion409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response DeleteV2TemplatesNameVersion409JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV2TemplatesNameVersion500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response DeleteV2TemplatesNameVersion500JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersionRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  GetV2TemplatesNameVersionParams
+}
+
+type GetV2TemplatesNameVersionResponseObject interface {
+	VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error
+}
+
+type GetV2TemplatesNameVersion200JSONResponse TemplateInfo
+
+func (response GetV2TemplatesNameVersion200JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersion400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2TemplatesNameVersion400JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersion404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2TemplatesNameVersion404JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersion500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2TemplatesNameVersion500JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameVersionRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  PutV2TemplatesNameVersionParams
+	Body    *PutV2TemplatesNameVersionJSONRequestBody
+}
+
+type PutV2TemplatesNameVersionResponseObject interface {
+	VisitPutV2TemplatesNameVersionResponse(w http.ResponseWriter) error
+}
+
+type PutV2TemplatesNameVersion200JSONResponse TemplateInfo
+
+func (response PutV2TemplatesNameVersion200JSONResponse) VisitPutV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameVersion400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PutV2TemplatesNameVersion400JSONResponse) VisitPutV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameVersion404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PutV2TemplatesNameVersion404JSONResponse) VisitPutV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameVersion409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PutV2TemplatesNameVersion409JSONResponse) VisitPutV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutV2TemplatesNameVersion500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PutV2TemplatesNameVersion500JSONResponse) VisitPutV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionClusterlabelsPreviewRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  PostV2TemplatesNameVersionClusterlabelsPreviewParams
+	Body    *PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody
+}
+
+type PostV2TemplatesNameVersionClusterlabelsPreviewResponseObject interface {
+	VisitPostV2TemplatesNameVersionClusterlabelsPreviewResponse(w http.ResponseWriter) error
+}
+
+type PostV2TemplatesNameVersionClusterlabelsPreview200JSONResponse LabelPropagationPreview
+
+func (response PostV2TemplatesNameVersionClusterlabelsPreview200JSONResponse) VisitPostV2TemplatesNameVersionClusterlabelsPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionClusterlabelsPreview400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2TemplatesNameVersionClusterlabelsPreview400JSONResponse) VisitPostV2TemplatesNameVersionClusterlabelsPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionClusterlabelsPreview404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2TemplatesNameVersionClusterlabelsPreview404JSONResponse) VisitPostV2TemplatesNameVersionClusterlabelsPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionClusterlabelsPreview500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2TemplatesNameVersionClusterlabelsPreview500JSONResponse) VisitPostV2TemplatesNameVersionClusterlabelsPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersionClustersRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  GetV2TemplatesNameVersionClustersParams
+}
+
+type GetV2TemplatesNameVersionClustersResponseObject interface {
+	VisitGetV2TemplatesNameVersionClustersResponse(w http.ResponseWriter) error
+}
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type GetV2TemplatesNameVersionClusters200JSONResponse TemplateClusters
+
+func (response GetV2TemplatesNameVersionClusters200JSONResponse) VisitGetV2TemplatesNameVersionClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type GetV2TemplatesNameVersionClusters400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2TemplatesNameVersionClusters400JSONResponse) VisitGetV2TemplatesNameVersionClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type GetV2TemplatesNameVersionClusters404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2TemplatesNameVersionClusters404JSONResponse) VisitGetV2TemplatesNameVersionClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type GetV2TemplatesNameVersionClusters500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+func (response GetV2TemplatesNameVersionClusters500JSONResponse) VisitGetV2TemplatesNameVersionClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredParamError struct {
-	ParamName string
+type PostV2TemplatesNameVersionDeprecateRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  PostV2TemplatesNameVersionDeprecateParams
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type PostV2TemplatesNameVersionDeprecateResponseObject interface {
+	VisitPostV2TemplatesNameVersionDeprecateResponse(w http.ResponseWriter) error
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type PostV2TemplatesNameVersionDeprecate200JSONResponse TemplateInfo
+
+func (response PostV2TemplatesNameVersionDeprecate200JSONResponse) VisitPostV2TemplatesNameVersionDeprecateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type PostV2TemplatesNameVersionDeprecate400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2TemplatesNameVersionDeprecate400JSONResponse) VisitPostV2TemplatesNameVersionDeprecateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type PostV2TemplatesNameVersionDeprecate404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2TemplatesNameVersionDeprecate404JSONResponse) VisitPostV2TemplatesNameVersionDeprecateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type PostV2TemplatesNameVersionDeprecate409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2TemplatesNameVersionDeprecate409JSONResponse) VisitPostV2TemplatesNameVersionDeprecateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type PostV2TemplatesNameVersionDeprecate500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+func (response PostV2TemplatesNameVersionDeprecate500JSONResponse) VisitPostV2TemplatesNameVersionDeprecateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersionExportRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  GetV2TemplatesNameVersionExportParams
+}
+
+type GetV2TemplatesNameVersionExportResponseObject interface {
+	VisitGetV2TemplatesNameVersionExportResponse(w http.ResponseWriter) error
+}
+
+type GetV2TemplatesNameVersionExport200JSONResponse TemplateBundle
+
+func (response GetV2TemplatesNameVersionExport200JSONResponse) VisitGetV2TemplatesNameVersionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersionExport400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2TemplatesNameVersionExport400JSONResponse) VisitGetV2TemplatesNameVersionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersionExport404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response GetV2TemplatesNameVersionExport404JSONResponse) VisitGetV2TemplatesNameVersionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2TemplatesNameVersionExport500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response GetV2TemplatesNameVersionExport500JSONResponse) VisitGetV2TemplatesNameVersionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionPublishRequestObject struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  PostV2TemplatesNameVersionPublishParams
+}
+
+type PostV2TemplatesNameVersionPublishResponseObject interface {
+	VisitPostV2TemplatesNameVersionPublishResponse(w http.ResponseWriter) error
+}
+
+type PostV2TemplatesNameVersionPublish200JSONResponse TemplateInfo
+
+func (response PostV2TemplatesNameVersionPublish200JSONResponse) VisitPostV2TemplatesNameVersionPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionPublish400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response PostV2TemplatesNameVersionPublish400JSONResponse) VisitPostV2TemplatesNameVersionPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionPublish404JSONResponse struct{ N404NotFoundJSONResponse }
+
+func (response PostV2TemplatesNameVersionPublish404JSONResponse) VisitPostV2TemplatesNameVersionPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionPublish409JSONResponse struct{ N409ConflictJSONResponse }
+
+func (response PostV2TemplatesNameVersionPublish409JSONResponse) VisitPostV2TemplatesNameVersionPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV2TemplatesNameVersionPublish500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
+}
+
+func (response PostV2TemplatesNameVersionPublish500JSONResponse) VisitPostV2TemplatesNameVersionPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2WebhooksDestinationsRequestObject struct {
+	Params GetV2WebhooksDestinationsParams
+}
+
+type GetV2WebhooksDestinationsResponseObject interface {
+	VisitGetV2WebhooksDestinationsResponse(w http.ResponseWriter) error
+}
+
+type GetV2WebhooksDestinations200JSONResponse WebhookDestinationList
+
+func (response GetV2WebhooksDestinations200JSONResponse) VisitGetV2WebhooksDestinationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2WebhooksDestinations400JSONResponse struct{ N400BadRequestJSONResponse }
+
+func (response GetV2WebhooksDestinations400JSONResponse) VisitGetV2WebhooksDestinationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2WebhooksDestinations500JSONResponse struct {
+	N500InternalServerErrorJSONResponse
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+func (response GetV2WebhooksDestinations500JSONResponse) VisitGetV2WebhooksDestinationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV2WebhooksDestinations501JSONResponse struct{ N501NotImplementedJSONResponse }
+
+func (response GetV2WebhooksDestinations501JSONResponse) VisitGetV2WebhooksDestinationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /v2/admin/exports/{projectId})
+	GetV2AdminExportsProjectId(ctx context.Context, request GetV2AdminExportsProjectIdRequestObject) (GetV2AdminExportsProjectIdResponseObject, error)
+
+	// (GET /v2/admin/support-bundles/{projectId}/clusters/{name})
+	GetV2AdminSupportBundlesProjectIdClustersName(ctx context.Context, request GetV2AdminSupportBundlesProjectIdClustersNameRequestObject) (GetV2AdminSupportBundlesProjectIdClustersNameResponseObject, error)
+
+	// (GET /v2/apichangelog)
+	GetV2Apichangelog(ctx context.Context, request GetV2ApichangelogRequestObject) (GetV2ApichangelogResponseObject, error)
+
+	// (GET /v2/authz/self)
+	GetV2AuthzSelf(ctx context.Context, request GetV2AuthzSelfRequestObject) (GetV2AuthzSelfResponseObject, error)
+
+	// (GET /v2/clusters)
+	GetV2Clusters(ctx context.Context, request GetV2ClustersRequestObject) (GetV2ClustersResponseObject, error)
+
+	// (POST /v2/clusters)
+	PostV2Clusters(ctx context.Context, request PostV2ClustersRequestObject) (PostV2ClustersResponseObject, error)
+
+	// (POST /v2/clusters/import)
+	PostV2ClustersImport(ctx context.Context, request PostV2ClustersImportRequestObject) (PostV2ClustersImportResponseObject, error)
+
+	// (GET /v2/clusters/name-suggestion)
+	GetV2ClustersNameSuggestion(ctx context.Context, request GetV2ClustersNameSuggestionRequestObject) (GetV2ClustersNameSuggestionResponseObject, error)
+
+	// (GET /v2/clusters/summary)
+	GetV2ClustersSummary(ctx context.Context, request GetV2ClustersSummaryRequestObject) (GetV2ClustersSummaryResponseObject, error)
+
+	// (DELETE /v2/clusters/{name})
+	DeleteV2ClustersName(ctx context.Context, request DeleteV2ClustersNameRequestObject) (DeleteV2ClustersNameResponseObject, error)
+
+	// (GET /v2/clusters/{name})
+	GetV2ClustersName(ctx context.Context, request GetV2ClustersNameRequestObject) (GetV2ClustersNameResponseObject, error)
+
+	// (GET /v2/clusters/{name}/backups)
+	GetV2ClustersNameBackups(ctx context.Context, request GetV2ClustersNameBackupsRequestObject) (GetV2ClustersNameBackupsResponseObject, error)
+
+	// (POST /v2/clusters/{name}/backups)
+	PostV2ClustersNameBackups(ctx context.Context, request PostV2ClustersNameBackupsRequestObject) (PostV2ClustersNameBackupsResponseObject, error)
+
+	// (POST /v2/clusters/{name}/clone)
+	PostV2ClustersNameClone(ctx context.Context, request PostV2ClustersNameCloneRequestObject) (PostV2ClustersNameCloneResponseObject, error)
+
+	// (DELETE /v2/clusters/{name}/deletion)
+	DeleteV2ClustersNameDeletion(ctx context.Context, request DeleteV2ClustersNameDeletionRequestObject) (DeleteV2ClustersNameDeletionResponseObject, error)
+
+	// (GET /v2/clusters/{name}/events)
+	GetV2ClustersNameEvents(ctx context.Context, request GetV2ClustersNameEventsRequestObject) (GetV2ClustersNameEventsResponseObject, error)
+
+	// (PUT /v2/clusters/{name}/extensions)
+	PutV2ClustersNameExtensions(ctx context.Context, request PutV2ClustersNameExtensionsRequestObject) (PutV2ClustersNameExtensionsResponseObject, error)
+
+	// (GET /v2/clusters/{name}/health)
+	GetV2ClustersNameHealth(ctx context.Context, request GetV2ClustersNameHealthRequestObject) (GetV2ClustersNameHealthResponseObject, error)
+
+	// (DELETE /v2/clusters/{name}/kubeconfigs)
+	DeleteV2ClustersNameKubeconfigs(ctx context.Context, request DeleteV2ClustersNameKubeconfigsRequestObject) (DeleteV2ClustersNameKubeconfigsResponseObject, error)
+
+	// (GET /v2/clusters/{name}/kubeconfigs)
+	GetV2ClustersNameKubeconfigs(ctx context.Context, request GetV2ClustersNameKubeconfigsRequestObject) (GetV2ClustersNameKubeconfigsResponseObject, error)
+
+	// (PATCH /v2/clusters/{name}/labels)
+	PatchV2ClustersNameLabels(ctx context.Context, request PatchV2ClustersNameLabelsRequestObject) (PatchV2ClustersNameLabelsResponseObject, error)
+
+	// (PUT /v2/clusters/{name}/labels)
+	PutV2ClustersNameLabels(ctx context.Context, request PutV2ClustersNameLabelsRequestObject) (PutV2ClustersNameLabelsResponseObject, error)
+
+	// (DELETE /v2/clusters/{name}/maintenance)
+	DeleteV2ClustersNameMaintenance(ctx context.Context, request DeleteV2ClustersNameMaintenanceRequestObject) (DeleteV2ClustersNameMaintenanceResponseObject, error)
+
+	// (PUT /v2/clusters/{name}/maintenance)
+	PutV2ClustersNameMaintenance(ctx context.Context, request PutV2ClustersNameMaintenanceRequestObject) (PutV2ClustersNameMaintenanceResponseObject, error)
+
+	// (GET /v2/clusters/{name}/nodepools)
+	GetV2ClustersNameNodepools(ctx context.Context, request GetV2ClustersNameNodepoolsRequestObject) (GetV2ClustersNameNodepoolsResponseObject, error)
+
+	// (POST /v2/clusters/{name}/nodepools)
+	PostV2ClustersNameNodepools(ctx context.Context, request PostV2ClustersNameNodepoolsRequestObject) (PostV2ClustersNameNodepoolsResponseObject, error)
+
+	// (PATCH /v2/clusters/{name}/nodepools/{poolName})
+	PatchV2ClustersNameNodepoolsPoolName(ctx context.Context, request PatchV2ClustersNameNodepoolsPoolNameRequestObject) (PatchV2ClustersNameNodepoolsPoolNameResponseObject, error)
+
+	// (PUT /v2/clusters/{name}/nodes)
+	PutV2ClustersNameNodes(ctx context.Context, request PutV2ClustersNameNodesRequestObject) (PutV2ClustersNameNodesResponseObject, error)
+
+	// (DELETE /v2/clusters/{name}/nodes/{nodeId})
+	DeleteV2ClustersNameNodesNodeId(ctx context.Context, request DeleteV2ClustersNameNodesNodeIdRequestObject) (DeleteV2ClustersNameNodesNodeIdResponseObject, error)
+
+	// (POST /v2/clusters/{name}/nodes/{nodeId}/cordon)
+	PostV2ClustersNameNodesNodeIdCordon(ctx context.Context, request PostV2ClustersNameNodesNodeIdCordonRequestObject) (PostV2ClustersNameNodesNodeIdCordonResponseObject, error)
+
+	// (GET /v2/clusters/{name}/nodes/{nodeId}/logs)
+	GetV2ClustersNameNodesNodeIdLogs(ctx context.Context, request GetV2ClustersNameNodesNodeIdLogsRequestObject) (GetV2ClustersNameNodesNodeIdLogsResponseObject, error)
+
+	// (POST /v2/clusters/{name}/nodes/{nodeId}/uncordon)
+	PostV2ClustersNameNodesNodeIdUncordon(ctx context.Context, request PostV2ClustersNameNodesNodeIdUncordonRequestObject) (PostV2ClustersNameNodesNodeIdUncordonResponseObject, error)
+
+	// (POST /v2/clusters/{name}/restore)
+	PostV2ClustersNameRestore(ctx context.Context, request PostV2ClustersNameRestoreRequestObject) (PostV2ClustersNameRestoreResponseObject, error)
+
+	// (PUT /v2/clusters/{name}/template)
+	PutV2ClustersNameTemplate(ctx context.Context, request PutV2ClustersNameTemplateRequestObject) (PutV2ClustersNameTemplateResponseObject, error)
+
+	// (GET /v2/clusters/{name}/upgrades)
+	GetV2ClustersNameUpgrades(ctx context.Context, request GetV2ClustersNameUpgradesRequestObject) (GetV2ClustersNameUpgradesResponseObject, error)
+
+	// (GET /v2/clusters/{nodeId}/clusterdetail)
+	GetV2ClustersNodeIdClusterdetail(ctx context.Context, request GetV2ClustersNodeIdClusterdetailRequestObject) (GetV2ClustersNodeIdClusterdetailResponseObject, error)
+
+	// (GET /v2/docs)
+	GetV2Docs(ctx context.Context, request GetV2DocsRequestObject) (GetV2DocsResponseObject, error)
+
+	// (GET /v2/extensions)
+	GetV2Extensions(ctx context.Context, request GetV2ExtensionsRequestObject) (GetV2ExtensionsResponseObject, error)
+
+	// (GET /v2/healthz)
+	GetV2Healthz(ctx context.Context, request GetV2HealthzRequestObject) (GetV2HealthzResponseObject, error)
+
+	// (GET /v2/openapi.json)
+	GetV2OpenapiJson(ctx context.Context, request GetV2OpenapiJsonRequestObject) (GetV2OpenapiJsonResponseObject, error)
+
+	// (GET /v2/operations)
+	GetV2Operations(ctx context.Context, request GetV2OperationsRequestObject) (GetV2OperationsResponseObject, error)
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// (GET /v2/operations/{id})
+	GetV2OperationsId(ctx context.Context, request GetV2OperationsIdRequestObject) (GetV2OperationsIdResponseObject, error)
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	// (GET /v2/pending-clusters)
+	GetV2PendingClusters(ctx context.Context, request GetV2PendingClustersRequestObject) (GetV2PendingClustersResponseObject, error)
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       *http.ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// (DELETE /v2/pending-clusters/{name})
+	DeleteV2PendingClustersName(ctx context.Context, request DeleteV2PendingClustersNameRequestObject) (DeleteV2PendingClustersNameResponseObject, error)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m *http.ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	// (GET /v2/pending-clusters/{name})
+	GetV2PendingClustersName(ctx context.Context, request GetV2PendingClustersNameRequestObject) (GetV2PendingClustersNameResponseObject, error)
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m *http.ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	// (PUT /v2/pending-clusters/{name})
+	PutV2PendingClustersName(ctx context.Context, request PutV2PendingClustersNameRequestObject) (PutV2PendingClustersNameResponseObject, error)
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	// (GET /v2/readyz)
+	GetV2Readyz(ctx context.Context, request GetV2ReadyzRequestObject) (GetV2ReadyzResponseObject, error)
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+	// (GET /v2/summary)
+	GetV2Summary(ctx context.Context, request GetV2SummaryRequestObject) (GetV2SummaryResponseObject, error)
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// (GET /v2/supportmatrix)
+	GetV2Supportmatrix(ctx context.Context, request GetV2SupportmatrixRequestObject) (GetV2SupportmatrixResponseObject, error)
 
-	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters", wrapper.GetV2Clusters)
-	m.HandleFunc("POST "+options.BaseURL+"/v2/clusters", wrapper.PostV2Clusters)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/summary", wrapper.GetV2ClustersSummary)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v2/clusters/{name}", wrapper.DeleteV2ClustersName)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}", wrapper.GetV2ClustersName)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{name}/kubeconfigs", wrapper.GetV2ClustersNameKubeconfigs)
-	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/labels", wrapper.PutV2ClustersNameLabels)
-	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/nodes", wrapper.PutV2ClustersNameNodes)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v2/clusters/{name}/nodes/{nodeId}", wrapper.DeleteV2ClustersNameNodesNodeId)
-	m.HandleFunc("PUT "+options.BaseURL+"/v2/clusters/{name}/template", wrapper.PutV2ClustersNameTemplate)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/clusters/{nodeId}/clusterdetail", wrapper.GetV2ClustersNodeIdClusterdetail)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/healthz", wrapper.GetV2Healthz)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/templates", wrapper.GetV2Templates)
-	m.HandleFunc("POST "+options.BaseURL+"/v2/templates", wrapper.PostV2Templates)
-	m.HandleFunc("PUT "+options.BaseURL+"/v2/templates/{name}/default", wrapper.PutV2TemplatesNameDefault)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/templates/{name}/versions", wrapper.GetV2TemplatesNameVersions)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v2/templates/{name}/{version}", wrapper.DeleteV2TemplatesNameVersion)
-	m.HandleFunc("GET "+options.BaseURL+"/v2/templates/{name}/{version}", wrapper.GetV2TemplatesNameVersion)
+	// (POST /v2/template-uploads)
+	PostV2TemplateUploads(ctx context.Context, request PostV2TemplateUploadsRequestObject) (PostV2TemplateUploadsResponseObject, error)
 
-	return m
-}
+	// (DELETE /v2/template-uploads/{id})
+	DeleteV2TemplateUploadsId(ctx context.Context, request DeleteV2TemplateUploadsIdRequestObject) (DeleteV2TemplateUploadsIdResponseObject, error)
 
-type N400BadRequestJSONResponse ProblemDetails
+	// (GET /v2/template-uploads/{id})
+	GetV2TemplateUploadsId(ctx context.Context, request GetV2TemplateUploadsIdRequestObject) (GetV2TemplateUploadsIdResponseObject, error)
 
-type N401UnauthorizedJSONResponse ProblemDetails
+	// (POST /v2/template-uploads/{id}/chunks)
+	PostV2TemplateUploadsIdChunks(ctx context.Context, request PostV2TemplateUploadsIdChunksRequestObject) (PostV2TemplateUploadsIdChunksResponseObject, error)
 
-type N404NotFoundJSONResponse ProblemDetails
+	// (POST /v2/template-uploads/{id}/commit)
+	PostV2TemplateUploadsIdCommit(ctx context.Context, request PostV2TemplateUploadsIdCommitRequestObject) (PostV2TemplateUploadsIdCommitResponseObject, error)
 
-type N409ConflictJSONResponse ProblemDetails
+	// (GET /v2/templates)
+	GetV2Templates(ctx context.Context, request GetV2TemplatesRequestObject) (GetV2TemplatesResponseObject, error)
 
-type N500InternalServerErrorJSONResponse ProblemDetails
+	// (POST /v2/templates)
+	PostV2Templates(ctx context.Context, request PostV2TemplatesRequestObject) (PostV2TemplatesResponseObject, error)
 
-type N501NotImplementedJSONResponse ProblemDetails
+	// (GET /v2/templates/compatibility)
+	GetV2TemplatesCompatibility(ctx context.Context, request GetV2TemplatesCompatibilityRequestObject) (GetV2TemplatesCompatibilityResponseObject, error)
 
-type GetV2ClustersRequestObject struct {
-	Params GetV2ClustersParams
-}
+	// (PUT /v2/templates/{name}/default)
+	PutV2TemplatesNameDefault(ctx context.Context, request PutV2TemplatesNameDefaultRequestObject) (PutV2TemplatesNameDefaultResponseObject, error)
 
-type GetV2ClustersResponseObject interface {
-	VisitGetV2ClustersResponse(w http.ResponseWriter) error
-}
+	// (GET /v2/templates/{name}/versions)
+	GetV2TemplatesNameVersions(ctx context.Context, request GetV2TemplatesNameVersionsRequestObject) (GetV2TemplatesNameVersionsResponseObject, error)
 
-type GetV2Clusters200JSONResponse struct {
-	Clusters *[]ClusterInfo `json:"clusters,omitempty"`
+	// (DELETE /v2/templates/{name}/{version})
+	DeleteV2TemplatesNameVersion(ctx context.Context, request DeleteV2TemplatesNameVersionRequestObject) (DeleteV2TemplatesNameVersionResponseObject, error)
 
-	// TotalElements The count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
-}
+	// (GET /v2/templates/{name}/{version})
+	GetV2TemplatesNameVersion(ctx context.Context, request GetV2TemplatesNameVersionRequestObject) (GetV2TemplatesNameVersionResponseObject, error)
 
-func (response GetV2Clusters200JSONResponse) VisitGetV2ClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (PUT /v2/templates/{name}/{version})
+	PutV2TemplatesNameVersion(ctx context.Context, request PutV2TemplatesNameVersionRequestObject) (PutV2TemplatesNameVersionResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /v2/templates/{name}/{version}/clusterlabels/preview)
+	PostV2TemplatesNameVersionClusterlabelsPreview(ctx context.Context, request PostV2TemplatesNameVersionClusterlabelsPreviewRequestObject) (PostV2TemplatesNameVersionClusterlabelsPreviewResponseObject, error)
 
-type GetV2Clusters400JSONResponse struct{ N400BadRequestJSONResponse }
+	// (GET /v2/templates/{name}/{version}/clusters)
+	GetV2TemplatesNameVersionClusters(ctx context.Context, request GetV2TemplatesNameVersionClustersRequestObject) (GetV2TemplatesNameVersionClustersResponseObject, error)
 
-func (response GetV2Clusters400JSONResponse) VisitGetV2ClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (POST /v2/templates/{name}/{version}/deprecate)
+	PostV2TemplatesNameVersionDeprecate(ctx context.Context, request PostV2TemplatesNameVersionDeprecateRequestObject) (PostV2TemplatesNameVersionDeprecateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /v2/templates/{name}/{version}/export)
+	GetV2TemplatesNameVersionExport(ctx context.Context, request GetV2TemplatesNameVersionExportRequestObject) (GetV2TemplatesNameVersionExportResponseObject, error)
 
-type GetV2Clusters500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	// (POST /v2/templates/{name}/{version}/publish)
+	PostV2TemplatesNameVersionPublish(ctx context.Context, request PostV2TemplatesNameVersionPublishRequestObject) (PostV2TemplatesNameVersionPublishResponseObject, error)
+
+	// (GET /v2/webhooks/destinations)
+	GetV2WebhooksDestinations(ctx context.Context, request GetV2WebhooksDestinationsRequestObject) (GetV2WebhooksDestinationsResponseObject, error)
 }
 
-func (response GetV2Clusters500JSONResponse) VisitGetV2ClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-	return json.NewEncoder(w).Encode(response)
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type PostV2ClustersRequestObject struct {
-	Params PostV2ClustersParams
-	Body   *PostV2ClustersJSONRequestBody
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-type PostV2ClustersResponseObject interface {
-	VisitPostV2ClustersResponse(w http.ResponseWriter) error
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type PostV2Clusters201JSONResponse string
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-func (response PostV2Clusters201JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetV2AdminExportsProjectId operation middleware
+func (sh *strictHandler) GetV2AdminExportsProjectId(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	var request GetV2AdminExportsProjectIdRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ProjectId = projectId
 
-type PostV2Clusters400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2AdminExportsProjectId(ctx, request.(GetV2AdminExportsProjectIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2AdminExportsProjectId")
+	}
 
-func (response PostV2Clusters400JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2AdminExportsProjectIdResponseObject); ok {
+		if err := validResponse.VisitGetV2AdminExportsProjectIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostV2Clusters500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+// GetV2AdminSupportBundlesProjectIdClustersName operation middleware
+func (sh *strictHandler) GetV2AdminSupportBundlesProjectIdClustersName(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, name string) {
+	var request GetV2AdminSupportBundlesProjectIdClustersNameRequestObject
 
-func (response PostV2Clusters500JSONResponse) VisitPostV2ClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.ProjectId = projectId
+	request.Name = name
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2AdminSupportBundlesProjectIdClustersName(ctx, request.(GetV2AdminSupportBundlesProjectIdClustersNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2AdminSupportBundlesProjectIdClustersName")
+	}
 
-type GetV2ClustersSummaryRequestObject struct {
-	Params GetV2ClustersSummaryParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetV2ClustersSummaryResponseObject interface {
-	VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2AdminSupportBundlesProjectIdClustersNameResponseObject); ok {
+		if err := validResponse.VisitGetV2AdminSupportBundlesProjectIdClustersNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersSummary200JSONResponse ClusterSummary
+// GetV2Apichangelog operation middleware
+func (sh *strictHandler) GetV2Apichangelog(w http.ResponseWriter, r *http.Request) {
+	var request GetV2ApichangelogRequestObject
 
-func (response GetV2ClustersSummary200JSONResponse) VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Apichangelog(ctx, request.(GetV2ApichangelogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Apichangelog")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ApichangelogResponseObject); ok {
+		if err := validResponse.VisitGetV2ApichangelogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersSummary400JSONResponse struct{ N400BadRequestJSONResponse }
+// GetV2AuthzSelf operation middleware
+func (sh *strictHandler) GetV2AuthzSelf(w http.ResponseWriter, r *http.Request, params GetV2AuthzSelfParams) {
+	var request GetV2AuthzSelfRequestObject
 
-func (response GetV2ClustersSummary400JSONResponse) VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2AuthzSelf(ctx, request.(GetV2AuthzSelfRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2AuthzSelf")
+	}
 
-type GetV2ClustersSummary500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2AuthzSelfResponseObject); ok {
+		if err := validResponse.VisitGetV2AuthzSelfResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetV2ClustersSummary500JSONResponse) VisitGetV2ClustersSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetV2Clusters operation middleware
+func (sh *strictHandler) GetV2Clusters(w http.ResponseWriter, r *http.Request, params GetV2ClustersParams) {
+	var request GetV2ClustersRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type DeleteV2ClustersNameRequestObject struct {
-	Name   string `json:"name"`
-	Params DeleteV2ClustersNameParams
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Clusters(ctx, request.(GetV2ClustersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Clusters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteV2ClustersNameResponseObject interface {
-	VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error
-}
+// PostV2Clusters operation middleware
+func (sh *strictHandler) PostV2Clusters(w http.ResponseWriter, r *http.Request, params PostV2ClustersParams) {
+	var request PostV2ClustersRequestObject
 
-type DeleteV2ClustersName204Response struct {
-}
+	request.Params = params
 
-func (response DeleteV2ClustersName204Response) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var body PostV2ClustersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteV2ClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2Clusters(ctx, request.(PostV2ClustersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2Clusters")
+	}
 
-func (response DeleteV2ClustersName400JSONResponse) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteV2ClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+// PostV2ClustersImport operation middleware
+func (sh *strictHandler) PostV2ClustersImport(w http.ResponseWriter, r *http.Request, params PostV2ClustersImportParams) {
+	var request PostV2ClustersImportRequestObject
 
-func (response DeleteV2ClustersName404JSONResponse) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostV2ClustersImportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteV2ClustersName500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2ClustersImport(ctx, request.(PostV2ClustersImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2ClustersImport")
+	}
 
-func (response DeleteV2ClustersName500JSONResponse) VisitDeleteV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersImportResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersNameRequestObject struct {
-	Name   string `json:"name"`
-	Params GetV2ClustersNameParams
-}
+// GetV2ClustersNameSuggestion operation middleware
+func (sh *strictHandler) GetV2ClustersNameSuggestion(w http.ResponseWriter, r *http.Request, params GetV2ClustersNameSuggestionParams) {
+	var request GetV2ClustersNameSuggestionRequestObject
 
-type GetV2ClustersNameResponseObject interface {
-	VisitGetV2ClustersNameResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetV2ClustersName200JSONResponse ClusterDetailInfo
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameSuggestion(ctx, request.(GetV2ClustersNameSuggestionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameSuggestion")
+	}
 
-func (response GetV2ClustersName200JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameSuggestionResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameSuggestionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersName400JSONResponse struct{ N400BadRequestJSONResponse }
-
-func (response GetV2ClustersName400JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetV2ClustersSummary operation middleware
+func (sh *strictHandler) GetV2ClustersSummary(w http.ResponseWriter, r *http.Request, params GetV2ClustersSummaryParams) {
+	var request GetV2ClustersSummaryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetV2ClustersName404JSONResponse struct{ N404NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersSummary(ctx, request.(GetV2ClustersSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersSummary")
+	}
 
-func (response GetV2ClustersName404JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersSummaryResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersName500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+// DeleteV2ClustersName operation middleware
+func (sh *strictHandler) DeleteV2ClustersName(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameParams) {
+	var request DeleteV2ClustersNameRequestObject
 
-func (response GetV2ClustersName500JSONResponse) VisitGetV2ClustersNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV2ClustersName(ctx, request.(DeleteV2ClustersNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV2ClustersName")
+	}
 
-type GetV2ClustersNameKubeconfigsRequestObject struct {
-	Name   string `json:"name"`
-	Params GetV2ClustersNameKubeconfigsParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetV2ClustersNameKubeconfigsResponseObject interface {
-	VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV2ClustersNameResponseObject); ok {
+		if err := validResponse.VisitDeleteV2ClustersNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersNameKubeconfigs200JSONResponse KubeconfigInfo
-
-func (response GetV2ClustersNameKubeconfigs200JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetV2ClustersName operation middleware
+func (sh *strictHandler) GetV2ClustersName(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameParams) {
+	var request GetV2ClustersNameRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
+	request.Params = params
 
-type GetV2ClustersNameKubeconfigs400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersName(ctx, request.(GetV2ClustersNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersName")
+	}
 
-func (response GetV2ClustersNameKubeconfigs400JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersNameKubeconfigs401JSONResponse struct{ N401UnauthorizedJSONResponse }
-
-func (response GetV2ClustersNameKubeconfigs401JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetV2ClustersNameBackups operation middleware
+func (sh *strictHandler) GetV2ClustersNameBackups(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameBackupsParams) {
+	var request GetV2ClustersNameBackupsRequestObject
 
-type GetV2ClustersNameKubeconfigs404JSONResponse struct{ N404NotFoundJSONResponse }
+	request.Name = name
+	request.Params = params
 
-func (response GetV2ClustersNameKubeconfigs404JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameBackups(ctx, request.(GetV2ClustersNameBackupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameBackups")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetV2ClustersNameKubeconfigs500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameBackupsResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameBackupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetV2ClustersNameKubeconfigs500JSONResponse) VisitGetV2ClustersNameKubeconfigsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostV2ClustersNameBackups operation middleware
+func (sh *strictHandler) PostV2ClustersNameBackups(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameBackupsParams) {
+	var request PostV2ClustersNameBackupsRequestObject
 
-type PutV2ClustersNameLabelsRequestObject struct {
-	Name   string `json:"name"`
-	Params PutV2ClustersNameLabelsParams
-	Body   *PutV2ClustersNameLabelsJSONRequestBody
-}
+	request.Name = name
+	request.Params = params
 
-type PutV2ClustersNameLabelsResponseObject interface {
-	VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2ClustersNameBackups(ctx, request.(PostV2ClustersNameBackupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2ClustersNameBackups")
+	}
 
-type PutV2ClustersNameLabels200Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response PutV2ClustersNameLabels200Response) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersNameBackupsResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersNameBackupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2ClustersNameLabels400JSONResponse struct{ N400BadRequestJSONResponse }
+// PostV2ClustersNameClone operation middleware
+func (sh *strictHandler) PostV2ClustersNameClone(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameCloneParams) {
+	var request PostV2ClustersNameCloneRequestObject
 
-func (response PutV2ClustersNameLabels400JSONResponse) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostV2ClustersNameCloneJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PutV2ClustersNameLabels404JSONResponse struct{ N404NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2ClustersNameClone(ctx, request.(PostV2ClustersNameCloneRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2ClustersNameClone")
+	}
 
-func (response PutV2ClustersNameLabels404JSONResponse) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersNameCloneResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersNameCloneResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2ClustersNameLabels500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+// DeleteV2ClustersNameDeletion operation middleware
+func (sh *strictHandler) DeleteV2ClustersNameDeletion(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameDeletionParams) {
+	var request DeleteV2ClustersNameDeletionRequestObject
 
-func (response PutV2ClustersNameLabels500JSONResponse) VisitPutV2ClustersNameLabelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV2ClustersNameDeletion(ctx, request.(DeleteV2ClustersNameDeletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV2ClustersNameDeletion")
+	}
 
-type PutV2ClustersNameNodesRequestObject struct {
-	Name   string `json:"name"`
-	Params PutV2ClustersNameNodesParams
-	Body   *PutV2ClustersNameNodesJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PutV2ClustersNameNodesResponseObject interface {
-	VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV2ClustersNameDeletionResponseObject); ok {
+		if err := validResponse.VisitDeleteV2ClustersNameDeletionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2ClustersNameNodes200Response struct {
-}
+// GetV2ClustersNameEvents operation middleware
+func (sh *strictHandler) GetV2ClustersNameEvents(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameEventsParams) {
+	var request GetV2ClustersNameEventsRequestObject
 
-func (response PutV2ClustersNameNodes200Response) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.Name = name
+	request.Params = params
 
-type PutV2ClustersNameNodes400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameEvents(ctx, request.(GetV2ClustersNameEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameEvents")
+	}
 
-func (response PutV2ClustersNameNodes400JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameEventsResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2ClustersNameNodes404JSONResponse struct{ N404NotFoundJSONResponse }
+// PutV2ClustersNameExtensions operation middleware
+func (sh *strictHandler) PutV2ClustersNameExtensions(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameExtensionsParams) {
+	var request PutV2ClustersNameExtensionsRequestObject
 
-func (response PutV2ClustersNameNodes404JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PutV2ClustersNameExtensionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PutV2ClustersNameNodes500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV2ClustersNameExtensions(ctx, request.(PutV2ClustersNameExtensionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV2ClustersNameExtensions")
+	}
 
-func (response PutV2ClustersNameNodes500JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutV2ClustersNameExtensionsResponseObject); ok {
+		if err := validResponse.VisitPutV2ClustersNameExtensionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2ClustersNameNodes501JSONResponse struct{ N501NotImplementedJSONResponse }
-
-func (response PutV2ClustersNameNodes501JSONResponse) VisitPutV2ClustersNameNodesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetV2ClustersNameHealth operation middleware
+func (sh *strictHandler) GetV2ClustersNameHealth(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameHealthParams) {
+	var request GetV2ClustersNameHealthRequestObject
 
-type DeleteV2ClustersNameNodesNodeIdRequestObject struct {
-	Name   string `json:"name"`
-	NodeId string `json:"nodeId"`
-	Params DeleteV2ClustersNameNodesNodeIdParams
-}
+	request.Name = name
+	request.Params = params
 
-type DeleteV2ClustersNameNodesNodeIdResponseObject interface {
-	VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameHealth(ctx, request.(GetV2ClustersNameHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameHealth")
+	}
 
-type DeleteV2ClustersNameNodesNodeId200Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response DeleteV2ClustersNameNodesNodeId200Response) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameHealthResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteV2ClustersNameNodesNodeId400JSONResponse struct{ N400BadRequestJSONResponse }
-
-func (response DeleteV2ClustersNameNodesNodeId400JSONResponse) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// DeleteV2ClustersNameKubeconfigs operation middleware
+func (sh *strictHandler) DeleteV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameKubeconfigsParams) {
+	var request DeleteV2ClustersNameKubeconfigsRequestObject
 
-type DeleteV2ClustersNameNodesNodeId404JSONResponse struct{ N404NotFoundJSONResponse }
+	request.Name = name
+	request.Params = params
 
-func (response DeleteV2ClustersNameNodesNodeId404JSONResponse) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV2ClustersNameKubeconfigs(ctx, request.(DeleteV2ClustersNameKubeconfigsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV2ClustersNameKubeconfigs")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteV2ClustersNameNodesNodeId500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV2ClustersNameKubeconfigsResponseObject); ok {
+		if err := validResponse.VisitDeleteV2ClustersNameKubeconfigsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteV2ClustersNameNodesNodeId500JSONResponse) VisitDeleteV2ClustersNameNodesNodeIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetV2ClustersNameKubeconfigs operation middleware
+func (sh *strictHandler) GetV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameKubeconfigsParams) {
+	var request GetV2ClustersNameKubeconfigsRequestObject
 
-type PutV2ClustersNameTemplateRequestObject struct {
-	Name   string `json:"name"`
-	Params PutV2ClustersNameTemplateParams
-	Body   *PutV2ClustersNameTemplateJSONRequestBody
-}
+	request.Name = name
+	request.Params = params
 
-type PutV2ClustersNameTemplateResponseObject interface {
-	VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameKubeconfigs(ctx, request.(GetV2ClustersNameKubeconfigsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameKubeconfigs")
+	}
 
-type PutV2ClustersNameTemplate202Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response PutV2ClustersNameTemplate202Response) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(202)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameKubeconfigsResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameKubeconfigsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2ClustersNameTemplate400JSONResponse struct{ N400BadRequestJSONResponse }
+// PatchV2ClustersNameLabels operation middleware
+func (sh *strictHandler) PatchV2ClustersNameLabels(w http.ResponseWriter, r *http.Request, name string, params PatchV2ClustersNameLabelsParams) {
+	var request PatchV2ClustersNameLabelsRequestObject
 
-func (response PutV2ClustersNameTemplate400JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PutV2ClustersNameTemplate404JSONResponse struct{ N404NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchV2ClustersNameLabels(ctx, request.(PatchV2ClustersNameLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchV2ClustersNameLabels")
+	}
 
-func (response PutV2ClustersNameTemplate404JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchV2ClustersNameLabelsResponseObject); ok {
+		if err := validResponse.VisitPatchV2ClustersNameLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2ClustersNameTemplate500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+// PutV2ClustersNameLabels operation middleware
+func (sh *strictHandler) PutV2ClustersNameLabels(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameLabelsParams) {
+	var request PutV2ClustersNameLabelsRequestObject
 
-func (response PutV2ClustersNameTemplate500JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PutV2ClustersNameLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PutV2ClustersNameTemplate501JSONResponse struct{ N501NotImplementedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV2ClustersNameLabels(ctx, request.(PutV2ClustersNameLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV2ClustersNameLabels")
+	}
 
-func (response PutV2ClustersNameTemplate501JSONResponse) VisitPutV2ClustersNameTemplateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutV2ClustersNameLabelsResponseObject); ok {
+		if err := validResponse.VisitPutV2ClustersNameLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersNodeIdClusterdetailRequestObject struct {
-	NodeId string `json:"nodeId"`
-	Params GetV2ClustersNodeIdClusterdetailParams
-}
+// DeleteV2ClustersNameMaintenance operation middleware
+func (sh *strictHandler) DeleteV2ClustersNameMaintenance(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameMaintenanceParams) {
+	var request DeleteV2ClustersNameMaintenanceRequestObject
 
-type GetV2ClustersNodeIdClusterdetailResponseObject interface {
-	VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error
-}
+	request.Name = name
+	request.Params = params
 
-type GetV2ClustersNodeIdClusterdetail200JSONResponse ClusterDetailInfo
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV2ClustersNameMaintenance(ctx, request.(DeleteV2ClustersNameMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV2ClustersNameMaintenance")
+	}
 
-func (response GetV2ClustersNodeIdClusterdetail200JSONResponse) VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV2ClustersNameMaintenanceResponseObject); ok {
+		if err := validResponse.VisitDeleteV2ClustersNameMaintenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2ClustersNodeIdClusterdetail400JSONResponse struct{ N400BadRequestJSONResponse }
-
-func (response GetV2ClustersNodeIdClusterdetail400JSONResponse) VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PutV2ClustersNameMaintenance operation middleware
+func (sh *strictHandler) PutV2ClustersNameMaintenance(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameMaintenanceParams) {
+	var request PutV2ClustersNameMaintenanceRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
+	request.Params = params
 
-type GetV2ClustersNodeIdClusterdetail404JSONResponse struct{ N404NotFoundJSONResponse }
+	var body PutV2ClustersNameMaintenanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetV2ClustersNodeIdClusterdetail404JSONResponse) VisitGetV2ClustersNodeIdClusterdetailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV2ClustersNameMaintenance(ctx, request.(PutV2ClustersNameMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV2ClustersNameMaintenance")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetV2HealthzRequestObject struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutV2ClustersNameMaintenanceResponseObject); ok {
+		if err := validResponse.VisitPutV2ClustersNameMaintenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2HealthzResponseObject interface {
-	VisitGetV2HealthzResponse(w http.ResponseWriter) error
-}
+// GetV2ClustersNameNodepools operation middleware
+func (sh *strictHandler) GetV2ClustersNameNodepools(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameNodepoolsParams) {
+	var request GetV2ClustersNameNodepoolsRequestObject
 
-type GetV2Healthz200JSONResponse string
+	request.Name = name
+	request.Params = params
 
-func (response GetV2Healthz200JSONResponse) VisitGetV2HealthzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameNodepools(ctx, request.(GetV2ClustersNameNodepoolsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameNodepools")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetV2Healthz500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameNodepoolsResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameNodepoolsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetV2Healthz500JSONResponse) VisitGetV2HealthzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostV2ClustersNameNodepools operation middleware
+func (sh *strictHandler) PostV2ClustersNameNodepools(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameNodepoolsParams) {
+	var request PostV2ClustersNameNodepoolsRequestObject
 
-type GetV2TemplatesRequestObject struct {
-	Params GetV2TemplatesParams
-}
+	request.Name = name
+	request.Params = params
 
-type GetV2TemplatesResponseObject interface {
-	VisitGetV2TemplatesResponse(w http.ResponseWriter) error
-}
+	var body PostV2ClustersNameNodepoolsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetV2Templates200JSONResponse TemplateInfoList
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2ClustersNameNodepools(ctx, request.(PostV2ClustersNameNodepoolsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2ClustersNameNodepools")
+	}
 
-func (response GetV2Templates200JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersNameNodepoolsResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersNameNodepoolsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2Templates400JSONResponse struct{ N400BadRequestJSONResponse }
+// PatchV2ClustersNameNodepoolsPoolName operation middleware
+func (sh *strictHandler) PatchV2ClustersNameNodepoolsPoolName(w http.ResponseWriter, r *http.Request, name string, poolName string, params PatchV2ClustersNameNodepoolsPoolNameParams) {
+	var request PatchV2ClustersNameNodepoolsPoolNameRequestObject
 
-func (response GetV2Templates400JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Name = name
+	request.PoolName = poolName
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetV2Templates404JSONResponse struct{ N404NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchV2ClustersNameNodepoolsPoolName(ctx, request.(PatchV2ClustersNameNodepoolsPoolNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchV2ClustersNameNodepoolsPoolName")
+	}
 
-func (response GetV2Templates404JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchV2ClustersNameNodepoolsPoolNameResponseObject); ok {
+		if err := validResponse.VisitPatchV2ClustersNameNodepoolsPoolNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2Templates500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+// PutV2ClustersNameNodes operation middleware
+func (sh *strictHandler) PutV2ClustersNameNodes(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameNodesParams) {
+	var request PutV2ClustersNameNodesRequestObject
 
-func (response GetV2Templates500JSONResponse) VisitGetV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PutV2ClustersNameNodesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostV2TemplatesRequestObject struct {
-	Params PostV2TemplatesParams
-	Body   *PostV2TemplatesJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV2ClustersNameNodes(ctx, request.(PutV2ClustersNameNodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV2ClustersNameNodes")
+	}
 
-type PostV2TemplatesResponseObject interface {
-	VisitPostV2TemplatesResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostV2Templates201JSONResponse string
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutV2ClustersNameNodesResponseObject); ok {
+		if err := validResponse.VisitPutV2ClustersNameNodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response PostV2Templates201JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// DeleteV2ClustersNameNodesNodeId operation middleware
+func (sh *strictHandler) DeleteV2ClustersNameNodesNodeId(w http.ResponseWriter, r *http.Request, name string, nodeId string, params DeleteV2ClustersNameNodesNodeIdParams) {
+	var request DeleteV2ClustersNameNodesNodeIdRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
+	request.NodeId = nodeId
+	request.Params = params
 
-type PostV2Templates400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV2ClustersNameNodesNodeId(ctx, request.(DeleteV2ClustersNameNodesNodeIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV2ClustersNameNodesNodeId")
+	}
 
-func (response PostV2Templates400JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV2ClustersNameNodesNodeIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV2ClustersNameNodesNodeIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostV2Templates409JSONResponse struct{ N409ConflictJSONResponse }
+// PostV2ClustersNameNodesNodeIdCordon operation middleware
+func (sh *strictHandler) PostV2ClustersNameNodesNodeIdCordon(w http.ResponseWriter, r *http.Request, name string, nodeId string, params PostV2ClustersNameNodesNodeIdCordonParams) {
+	var request PostV2ClustersNameNodesNodeIdCordonRequestObject
 
-func (response PostV2Templates409JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.Name = name
+	request.NodeId = nodeId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostV2ClustersNameNodesNodeIdCordonJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostV2Templates500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2ClustersNameNodesNodeIdCordon(ctx, request.(PostV2ClustersNameNodesNodeIdCordonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2ClustersNameNodesNodeIdCordon")
+	}
 
-func (response PostV2Templates500JSONResponse) VisitPostV2TemplatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersNameNodesNodeIdCordonResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersNameNodesNodeIdCordonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2TemplatesNameDefaultRequestObject struct {
-	Name   string `json:"name"`
-	Params PutV2TemplatesNameDefaultParams
-	Body   *PutV2TemplatesNameDefaultJSONRequestBody
-}
+// GetV2ClustersNameNodesNodeIdLogs operation middleware
+func (sh *strictHandler) GetV2ClustersNameNodesNodeIdLogs(w http.ResponseWriter, r *http.Request, name string, nodeId string, params GetV2ClustersNameNodesNodeIdLogsParams) {
+	var request GetV2ClustersNameNodesNodeIdLogsRequestObject
 
-type PutV2TemplatesNameDefaultResponseObject interface {
-	VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error
-}
+	request.Name = name
+	request.NodeId = nodeId
+	request.Params = params
 
-type PutV2TemplatesNameDefault200Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameNodesNodeIdLogs(ctx, request.(GetV2ClustersNameNodesNodeIdLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameNodesNodeIdLogs")
+	}
 
-func (response PutV2TemplatesNameDefault200Response) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PutV2TemplatesNameDefault400JSONResponse struct{ N400BadRequestJSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameNodesNodeIdLogsResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameNodesNodeIdLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response PutV2TemplatesNameDefault400JSONResponse) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PostV2ClustersNameNodesNodeIdUncordon operation middleware
+func (sh *strictHandler) PostV2ClustersNameNodesNodeIdUncordon(w http.ResponseWriter, r *http.Request, name string, nodeId string, params PostV2ClustersNameNodesNodeIdUncordonParams) {
+	var request PostV2ClustersNameNodesNodeIdUncordonRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
+	request.NodeId = nodeId
+	request.Params = params
 
-type PutV2TemplatesNameDefault404JSONResponse struct{ N404NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2ClustersNameNodesNodeIdUncordon(ctx, request.(PostV2ClustersNameNodesNodeIdUncordonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2ClustersNameNodesNodeIdUncordon")
+	}
 
-func (response PutV2TemplatesNameDefault404JSONResponse) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersNameNodesNodeIdUncordonResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersNameNodesNodeIdUncordonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutV2TemplatesNameDefault500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
-}
+// PostV2ClustersNameRestore operation middleware
+func (sh *strictHandler) PostV2ClustersNameRestore(w http.ResponseWriter, r *http.Request, name string, params PostV2ClustersNameRestoreParams) {
+	var request PostV2ClustersNameRestoreRequestObject
 
-func (response PutV2TemplatesNameDefault500JSONResponse) VisitPutV2TemplatesNameDefaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostV2ClustersNameRestoreJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetV2TemplatesNameVersionsRequestObject struct {
-	Name   string `json:"name"`
-	Params GetV2TemplatesNameVersionsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2ClustersNameRestore(ctx, request.(PostV2ClustersNameRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2ClustersNameRestore")
+	}
 
-type GetV2TemplatesNameVersionsResponseObject interface {
-	VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2ClustersNameRestoreResponseObject); ok {
+		if err := validResponse.VisitPostV2ClustersNameRestoreResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2TemplatesNameVersions200JSONResponse VersionList
+// PutV2ClustersNameTemplate operation middleware
+func (sh *strictHandler) PutV2ClustersNameTemplate(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameTemplateParams) {
+	var request PutV2ClustersNameTemplateRequestObject
 
-func (response GetV2TemplatesNameVersions200JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PutV2ClustersNameTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetV2TemplatesNameVersions400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV2ClustersNameTemplate(ctx, request.(PutV2ClustersNameTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV2ClustersNameTemplate")
+	}
 
-func (response GetV2TemplatesNameVersions400JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutV2ClustersNameTemplateResponseObject); ok {
+		if err := validResponse.VisitPutV2ClustersNameTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2TemplatesNameVersions404JSONResponse struct{ N404NotFoundJSONResponse }
+// GetV2ClustersNameUpgrades operation middleware
+func (sh *strictHandler) GetV2ClustersNameUpgrades(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameUpgradesParams) {
+	var request GetV2ClustersNameUpgradesRequestObject
 
-func (response GetV2TemplatesNameVersions404JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNameUpgrades(ctx, request.(GetV2ClustersNameUpgradesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNameUpgrades")
+	}
 
-type GetV2TemplatesNameVersions500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNameUpgradesResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNameUpgradesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetV2TemplatesNameVersions500JSONResponse) VisitGetV2TemplatesNameVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetV2ClustersNodeIdClusterdetail operation middleware
+func (sh *strictHandler) GetV2ClustersNodeIdClusterdetail(w http.ResponseWriter, r *http.Request, nodeId string, params GetV2ClustersNodeIdClusterdetailParams) {
+	var request GetV2ClustersNodeIdClusterdetailRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.NodeId = nodeId
+	request.Params = params
 
-type DeleteV2TemplatesNameVersionRequestObject struct {
-	Name    string `json:"name"`
-	Version string `json:"version"`
-	Params  DeleteV2TemplatesNameVersionParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2ClustersNodeIdClusterdetail(ctx, request.(GetV2ClustersNodeIdClusterdetailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2ClustersNodeIdClusterdetail")
+	}
 
-type DeleteV2TemplatesNameVersionResponseObject interface {
-	VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteV2TemplatesNameVersion204Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ClustersNodeIdClusterdetailResponseObject); ok {
+		if err := validResponse.VisitGetV2ClustersNodeIdClusterdetailResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteV2TemplatesNameVersion204Response) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// GetV2Docs operation middleware
+func (sh *strictHandler) GetV2Docs(w http.ResponseWriter, r *http.Request) {
+	var request GetV2DocsRequestObject
 
-type DeleteV2TemplatesNameVersion400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Docs(ctx, request.(GetV2DocsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Docs")
+	}
 
-func (response DeleteV2TemplatesNameVersion400JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2DocsResponseObject); ok {
+		if err := validResponse.VisitGetV2DocsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteV2TemplatesNameVersion404JSONResponse struct{ N404NotFoundJSONResponse }
+// GetV2Extensions operation middleware
+func (sh *strictHandler) GetV2Extensions(w http.ResponseWriter, r *http.Request, params GetV2ExtensionsParams) {
+	var request GetV2ExtensionsRequestObject
 
-func (response DeleteV2TemplatesNameVersion404JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Extensions(ctx, request.(GetV2ExtensionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Extensions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ExtensionsResponseObject); ok {
+		if err := validResponse.VisitGetV2ExtensionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteV2TemplatesNameVersion409JSONResponse struct{ N409ConflictJSONResponse }
+// GetV2Healthz operation middleware
+func (sh *strictHandler) GetV2Healthz(w http.ResponseWriter, r *http.Request) {
+	var request GetV2HealthzRequestObject
 
-func (response DeleteV2TemplatesNameVersion409JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Healthz(ctx, request.(GetV2HealthzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Healthz")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteV2TemplatesNameVersion500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2HealthzResponseObject); ok {
+		if err := validResponse.VisitGetV2HealthzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteV2TemplatesNameVersion500JSONResponse) VisitDeleteV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetV2OpenapiJson operation middleware
+func (sh *strictHandler) GetV2OpenapiJson(w http.ResponseWriter, r *http.Request) {
+	var request GetV2OpenapiJsonRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2OpenapiJson(ctx, request.(GetV2OpenapiJsonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2OpenapiJson")
+	}
 
-type GetV2TemplatesNameVersionRequestObject struct {
-	Name    string `json:"name"`
-	Version string `json:"version"`
-	Params  GetV2TemplatesNameVersionParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetV2TemplatesNameVersionResponseObject interface {
-	VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2OpenapiJsonResponseObject); ok {
+		if err := validResponse.VisitGetV2OpenapiJsonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2TemplatesNameVersion200JSONResponse TemplateInfo
-
-func (response GetV2TemplatesNameVersion200JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetV2Operations operation middleware
+func (sh *strictHandler) GetV2Operations(w http.ResponseWriter, r *http.Request, params GetV2OperationsParams) {
+	var request GetV2OperationsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetV2TemplatesNameVersion400JSONResponse struct{ N400BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Operations(ctx, request.(GetV2OperationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Operations")
+	}
 
-func (response GetV2TemplatesNameVersion400JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2OperationsResponseObject); ok {
+		if err := validResponse.VisitGetV2OperationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetV2TemplatesNameVersion404JSONResponse struct{ N404NotFoundJSONResponse }
+// GetV2OperationsId operation middleware
+func (sh *strictHandler) GetV2OperationsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetV2OperationsIdParams) {
+	var request GetV2OperationsIdRequestObject
 
-func (response GetV2TemplatesNameVersion404JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2OperationsId(ctx, request.(GetV2OperationsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2OperationsId")
+	}
 
-type GetV2TemplatesNameVersion500JSONResponse struct {
-	N500InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2OperationsIdResponseObject); ok {
+		if err := validResponse.VisitGetV2OperationsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetV2TemplatesNameVersion500JSONResponse) VisitGetV2TemplatesNameVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetV2PendingClusters operation middleware
+func (sh *strictHandler) GetV2PendingClusters(w http.ResponseWriter, r *http.Request, params GetV2PendingClustersParams) {
+	var request GetV2PendingClustersRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2PendingClusters(ctx, request.(GetV2PendingClustersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2PendingClusters")
+	}
 
-	// (GET /v2/clusters)
-	GetV2Clusters(ctx context.Context, request GetV2ClustersRequestObject) (GetV2ClustersResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /v2/clusters)
-	PostV2Clusters(ctx context.Context, request PostV2ClustersRequestObject) (PostV2ClustersResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2PendingClustersResponseObject); ok {
+		if err := validResponse.VisitGetV2PendingClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /v2/clusters/summary)
-	GetV2ClustersSummary(ctx context.Context, request GetV2ClustersSummaryRequestObject) (GetV2ClustersSummaryResponseObject, error)
+// DeleteV2PendingClustersName operation middleware
+func (sh *strictHandler) DeleteV2PendingClustersName(w http.ResponseWriter, r *http.Request, name string, params DeleteV2PendingClustersNameParams) {
+	var request DeleteV2PendingClustersNameRequestObject
 
-	// (DELETE /v2/clusters/{name})
-	DeleteV2ClustersName(ctx context.Context, request DeleteV2ClustersNameRequestObject) (DeleteV2ClustersNameResponseObject, error)
+	request.Name = name
+	request.Params = params
 
-	// (GET /v2/clusters/{name})
-	GetV2ClustersName(ctx context.Context, request GetV2ClustersNameRequestObject) (GetV2ClustersNameResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV2PendingClustersName(ctx, request.(DeleteV2PendingClustersNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV2PendingClustersName")
+	}
 
-	// (GET /v2/clusters/{name}/kubeconfigs)
-	GetV2ClustersNameKubeconfigs(ctx context.Context, request GetV2ClustersNameKubeconfigsRequestObject) (GetV2ClustersNameKubeconfigsResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /v2/clusters/{name}/labels)
-	PutV2ClustersNameLabels(ctx context.Context, request PutV2ClustersNameLabelsRequestObject) (PutV2ClustersNameLabelsResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV2PendingClustersNameResponseObject); ok {
+		if err := validResponse.VisitDeleteV2PendingClustersNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (PUT /v2/clusters/{name}/nodes)
-	PutV2ClustersNameNodes(ctx context.Context, request PutV2ClustersNameNodesRequestObject) (PutV2ClustersNameNodesResponseObject, error)
+// GetV2PendingClustersName operation middleware
+func (sh *strictHandler) GetV2PendingClustersName(w http.ResponseWriter, r *http.Request, name string, params GetV2PendingClustersNameParams) {
+	var request GetV2PendingClustersNameRequestObject
 
-	// (DELETE /v2/clusters/{name}/nodes/{nodeId})
-	DeleteV2ClustersNameNodesNodeId(ctx context.Context, request DeleteV2ClustersNameNodesNodeIdRequestObject) (DeleteV2ClustersNameNodesNodeIdResponseObject, error)
+	request.Name = name
+	request.Params = params
 
-	// (PUT /v2/clusters/{name}/template)
-	PutV2ClustersNameTemplate(ctx context.Context, request PutV2ClustersNameTemplateRequestObject) (PutV2ClustersNameTemplateResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2PendingClustersName(ctx, request.(GetV2PendingClustersNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2PendingClustersName")
+	}
 
-	// (GET /v2/clusters/{nodeId}/clusterdetail)
-	GetV2ClustersNodeIdClusterdetail(ctx context.Context, request GetV2ClustersNodeIdClusterdetailRequestObject) (GetV2ClustersNodeIdClusterdetailResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /v2/healthz)
-	GetV2Healthz(ctx context.Context, request GetV2HealthzRequestObject) (GetV2HealthzResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2PendingClustersNameResponseObject); ok {
+		if err := validResponse.VisitGetV2PendingClustersNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /v2/templates)
-	GetV2Templates(ctx context.Context, request GetV2TemplatesRequestObject) (GetV2TemplatesResponseObject, error)
+// PutV2PendingClustersName operation middleware
+func (sh *strictHandler) PutV2PendingClustersName(w http.ResponseWriter, r *http.Request, name string, params PutV2PendingClustersNameParams) {
+	var request PutV2PendingClustersNameRequestObject
 
-	// (POST /v2/templates)
-	PostV2Templates(ctx context.Context, request PostV2TemplatesRequestObject) (PostV2TemplatesResponseObject, error)
+	request.Name = name
+	request.Params = params
 
-	// (PUT /v2/templates/{name}/default)
-	PutV2TemplatesNameDefault(ctx context.Context, request PutV2TemplatesNameDefaultRequestObject) (PutV2TemplatesNameDefaultResponseObject, error)
+	var body PutV2PendingClustersNameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /v2/templates/{name}/versions)
-	GetV2TemplatesNameVersions(ctx context.Context, request GetV2TemplatesNameVersionsRequestObject) (GetV2TemplatesNameVersionsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutV2PendingClustersName(ctx, request.(PutV2PendingClustersNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutV2PendingClustersName")
+	}
 
-	// (DELETE /v2/templates/{name}/{version})
-	DeleteV2TemplatesNameVersion(ctx context.Context, request DeleteV2TemplatesNameVersionRequestObject) (DeleteV2TemplatesNameVersionResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /v2/templates/{name}/{version})
-	GetV2TemplatesNameVersion(ctx context.Context, request GetV2TemplatesNameVersionRequestObject) (GetV2TemplatesNameVersionResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutV2PendingClustersNameResponseObject); ok {
+		if err := validResponse.VisitPutV2PendingClustersNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetV2Readyz operation middleware
+func (sh *strictHandler) GetV2Readyz(w http.ResponseWriter, r *http.Request) {
+	var request GetV2ReadyzRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Readyz(ctx, request.(GetV2ReadyzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Readyz")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2ReadyzResponseObject); ok {
+		if err := validResponse.VisitGetV2ReadyzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// GetV2Summary operation middleware
+func (sh *strictHandler) GetV2Summary(w http.ResponseWriter, r *http.Request, params GetV2SummaryParams) {
+	var request GetV2SummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2Summary(ctx, request.(GetV2SummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2Summary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-// GetV2Clusters operation middleware
-func (sh *strictHandler) GetV2Clusters(w http.ResponseWriter, r *http.Request, params GetV2ClustersParams) {
-	var request GetV2ClustersRequestObject
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2SummaryResponseObject); ok {
+		if err := validResponse.VisitGetV2SummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	request.Params = params
+// GetV2Supportmatrix operation middleware
+func (sh *strictHandler) GetV2Supportmatrix(w http.ResponseWriter, r *http.Request) {
+	var request GetV2SupportmatrixRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2Clusters(ctx, request.(GetV2ClustersRequestObject))
+		return sh.ssi.GetV2Supportmatrix(ctx, request.(GetV2SupportmatrixRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2Clusters")
+		handler = middleware(handler, "GetV2Supportmatrix")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2ClustersResponseObject); ok {
-		if err := validResponse.VisitGetV2ClustersResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2SupportmatrixResponseObject); ok {
+		if err := validResponse.VisitGetV2SupportmatrixResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2221,13 +9477,13 @@ func (sh *strictHandler) GetV2Clusters(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// PostV2Clusters operation middleware
-func (sh *strictHandler) PostV2Clusters(w http.ResponseWriter, r *http.Request, params PostV2ClustersParams) {
-	var request PostV2ClustersRequestObject
+// PostV2TemplateUploads operation middleware
+func (sh *strictHandler) PostV2TemplateUploads(w http.ResponseWriter, r *http.Request, params PostV2TemplateUploadsParams) {
+	var request PostV2TemplateUploadsRequestObject
 
 	request.Params = params
 
-	var body PostV2ClustersJSONRequestBody
+	var body PostV2TemplateUploadsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2235,18 +9491,18 @@ func (sh *strictHandler) PostV2Clusters(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV2Clusters(ctx, request.(PostV2ClustersRequestObject))
+		return sh.ssi.PostV2TemplateUploads(ctx, request.(PostV2TemplateUploadsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV2Clusters")
+		handler = middleware(handler, "PostV2TemplateUploads")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV2ClustersResponseObject); ok {
-		if err := validResponse.VisitPostV2ClustersResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV2TemplateUploadsResponseObject); ok {
+		if err := validResponse.VisitPostV2TemplateUploadsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2254,25 +9510,26 @@ func (sh *strictHandler) PostV2Clusters(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetV2ClustersSummary operation middleware
-func (sh *strictHandler) GetV2ClustersSummary(w http.ResponseWriter, r *http.Request, params GetV2ClustersSummaryParams) {
-	var request GetV2ClustersSummaryRequestObject
+// DeleteV2TemplateUploadsId operation middleware
+func (sh *strictHandler) DeleteV2TemplateUploadsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteV2TemplateUploadsIdParams) {
+	var request DeleteV2TemplateUploadsIdRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2ClustersSummary(ctx, request.(GetV2ClustersSummaryRequestObject))
+		return sh.ssi.DeleteV2TemplateUploadsId(ctx, request.(DeleteV2TemplateUploadsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2ClustersSummary")
+		handler = middleware(handler, "DeleteV2TemplateUploadsId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2ClustersSummaryResponseObject); ok {
-		if err := validResponse.VisitGetV2ClustersSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteV2TemplateUploadsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV2TemplateUploadsIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2280,26 +9537,26 @@ func (sh *strictHandler) GetV2ClustersSummary(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// DeleteV2ClustersName operation middleware
-func (sh *strictHandler) DeleteV2ClustersName(w http.ResponseWriter, r *http.Request, name string, params DeleteV2ClustersNameParams) {
-	var request DeleteV2ClustersNameRequestObject
+// GetV2TemplateUploadsId operation middleware
+func (sh *strictHandler) GetV2TemplateUploadsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetV2TemplateUploadsIdParams) {
+	var request GetV2TemplateUploadsIdRequestObject
 
-	request.Name = name
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV2ClustersName(ctx, request.(DeleteV2ClustersNameRequestObject))
+		return sh.ssi.GetV2TemplateUploadsId(ctx, request.(GetV2TemplateUploadsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV2ClustersName")
+		handler = middleware(handler, "GetV2TemplateUploadsId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteV2ClustersNameResponseObject); ok {
-		if err := validResponse.VisitDeleteV2ClustersNameResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2TemplateUploadsIdResponseObject); ok {
+		if err := validResponse.VisitGetV2TemplateUploadsIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2307,26 +9564,33 @@ func (sh *strictHandler) DeleteV2ClustersName(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetV2ClustersName operation middleware
-func (sh *strictHandler) GetV2ClustersName(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameParams) {
-	var request GetV2ClustersNameRequestObject
+// PostV2TemplateUploadsIdChunks operation middleware
+func (sh *strictHandler) PostV2TemplateUploadsIdChunks(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostV2TemplateUploadsIdChunksParams) {
+	var request PostV2TemplateUploadsIdChunksRequestObject
 
-	request.Name = name
+	request.Id = id
 	request.Params = params
 
+	var body PostV2TemplateUploadsIdChunksJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2ClustersName(ctx, request.(GetV2ClustersNameRequestObject))
+		return sh.ssi.PostV2TemplateUploadsIdChunks(ctx, request.(PostV2TemplateUploadsIdChunksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2ClustersName")
+		handler = middleware(handler, "PostV2TemplateUploadsIdChunks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2ClustersNameResponseObject); ok {
-		if err := validResponse.VisitGetV2ClustersNameResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV2TemplateUploadsIdChunksResponseObject); ok {
+		if err := validResponse.VisitPostV2TemplateUploadsIdChunksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2334,26 +9598,26 @@ func (sh *strictHandler) GetV2ClustersName(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetV2ClustersNameKubeconfigs operation middleware
-func (sh *strictHandler) GetV2ClustersNameKubeconfigs(w http.ResponseWriter, r *http.Request, name string, params GetV2ClustersNameKubeconfigsParams) {
-	var request GetV2ClustersNameKubeconfigsRequestObject
+// PostV2TemplateUploadsIdCommit operation middleware
+func (sh *strictHandler) PostV2TemplateUploadsIdCommit(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostV2TemplateUploadsIdCommitParams) {
+	var request PostV2TemplateUploadsIdCommitRequestObject
 
-	request.Name = name
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2ClustersNameKubeconfigs(ctx, request.(GetV2ClustersNameKubeconfigsRequestObject))
+		return sh.ssi.PostV2TemplateUploadsIdCommit(ctx, request.(PostV2TemplateUploadsIdCommitRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2ClustersNameKubeconfigs")
+		handler = middleware(handler, "PostV2TemplateUploadsIdCommit")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2ClustersNameKubeconfigsResponseObject); ok {
-		if err := validResponse.VisitGetV2ClustersNameKubeconfigsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV2TemplateUploadsIdCommitResponseObject); ok {
+		if err := validResponse.VisitPostV2TemplateUploadsIdCommitResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2361,33 +9625,25 @@ func (sh *strictHandler) GetV2ClustersNameKubeconfigs(w http.ResponseWriter, r *
 	}
 }
 
-// PutV2ClustersNameLabels operation middleware
-func (sh *strictHandler) PutV2ClustersNameLabels(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameLabelsParams) {
-	var request PutV2ClustersNameLabelsRequestObject
+// GetV2Templates operation middleware
+func (sh *strictHandler) GetV2Templates(w http.ResponseWriter, r *http.Request, params GetV2TemplatesParams) {
+	var request GetV2TemplatesRequestObject
 
-	request.Name = name
 	request.Params = params
 
-	var body PutV2ClustersNameLabelsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutV2ClustersNameLabels(ctx, request.(PutV2ClustersNameLabelsRequestObject))
+		return sh.ssi.GetV2Templates(ctx, request.(GetV2TemplatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutV2ClustersNameLabels")
+		handler = middleware(handler, "GetV2Templates")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutV2ClustersNameLabelsResponseObject); ok {
-		if err := validResponse.VisitPutV2ClustersNameLabelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2TemplatesResponseObject); ok {
+		if err := validResponse.VisitGetV2TemplatesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2395,14 +9651,13 @@ func (sh *strictHandler) PutV2ClustersNameLabels(w http.ResponseWriter, r *http.
 	}
 }
 
-// PutV2ClustersNameNodes operation middleware
-func (sh *strictHandler) PutV2ClustersNameNodes(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameNodesParams) {
-	var request PutV2ClustersNameNodesRequestObject
+// PostV2Templates operation middleware
+func (sh *strictHandler) PostV2Templates(w http.ResponseWriter, r *http.Request, params PostV2TemplatesParams) {
+	var request PostV2TemplatesRequestObject
 
-	request.Name = name
 	request.Params = params
 
-	var body PutV2ClustersNameNodesJSONRequestBody
+	var body PostV2TemplatesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2410,18 +9665,18 @@ func (sh *strictHandler) PutV2ClustersNameNodes(w http.ResponseWriter, r *http.R
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutV2ClustersNameNodes(ctx, request.(PutV2ClustersNameNodesRequestObject))
+		return sh.ssi.PostV2Templates(ctx, request.(PostV2TemplatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutV2ClustersNameNodes")
+		handler = middleware(handler, "PostV2Templates")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutV2ClustersNameNodesResponseObject); ok {
-		if err := validResponse.VisitPutV2ClustersNameNodesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV2TemplatesResponseObject); ok {
+		if err := validResponse.VisitPostV2TemplatesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2429,27 +9684,25 @@ func (sh *strictHandler) PutV2ClustersNameNodes(w http.ResponseWriter, r *http.R
 	}
 }
 
-// DeleteV2ClustersNameNodesNodeId operation middleware
-func (sh *strictHandler) DeleteV2ClustersNameNodesNodeId(w http.ResponseWriter, r *http.Request, name string, nodeId string, params DeleteV2ClustersNameNodesNodeIdParams) {
-	var request DeleteV2ClustersNameNodesNodeIdRequestObject
+// GetV2TemplatesCompatibility operation middleware
+func (sh *strictHandler) GetV2TemplatesCompatibility(w http.ResponseWriter, r *http.Request, params GetV2TemplatesCompatibilityParams) {
+	var request GetV2TemplatesCompatibilityRequestObject
 
-	request.Name = name
-	request.NodeId = nodeId
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV2ClustersNameNodesNodeId(ctx, request.(DeleteV2ClustersNameNodesNodeIdRequestObject))
+		return sh.ssi.GetV2TemplatesCompatibility(ctx, request.(GetV2TemplatesCompatibilityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV2ClustersNameNodesNodeId")
+		handler = middleware(handler, "GetV2TemplatesCompatibility")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteV2ClustersNameNodesNodeIdResponseObject); ok {
-		if err := validResponse.VisitDeleteV2ClustersNameNodesNodeIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2TemplatesCompatibilityResponseObject); ok {
+		if err := validResponse.VisitGetV2TemplatesCompatibilityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2457,14 +9710,14 @@ func (sh *strictHandler) DeleteV2ClustersNameNodesNodeId(w http.ResponseWriter,
 	}
 }
 
-// PutV2ClustersNameTemplate operation middleware
-func (sh *strictHandler) PutV2ClustersNameTemplate(w http.ResponseWriter, r *http.Request, name string, params PutV2ClustersNameTemplateParams) {
-	var request PutV2ClustersNameTemplateRequestObject
+// PutV2TemplatesNameDefault operation middleware
+func (sh *strictHandler) PutV2TemplatesNameDefault(w http.ResponseWriter, r *http.Request, name string, params PutV2TemplatesNameDefaultParams) {
+	var request PutV2TemplatesNameDefaultRequestObject
 
 	request.Name = name
 	request.Params = params
 
-	var body PutV2ClustersNameTemplateJSONRequestBody
+	var body PutV2TemplatesNameDefaultJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2472,18 +9725,18 @@ func (sh *strictHandler) PutV2ClustersNameTemplate(w http.ResponseWriter, r *htt
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutV2ClustersNameTemplate(ctx, request.(PutV2ClustersNameTemplateRequestObject))
+		return sh.ssi.PutV2TemplatesNameDefault(ctx, request.(PutV2TemplatesNameDefaultRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutV2ClustersNameTemplate")
+		handler = middleware(handler, "PutV2TemplatesNameDefault")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutV2ClustersNameTemplateResponseObject); ok {
-		if err := validResponse.VisitPutV2ClustersNameTemplateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutV2TemplatesNameDefaultResponseObject); ok {
+		if err := validResponse.VisitPutV2TemplatesNameDefaultResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2491,26 +9744,26 @@ func (sh *strictHandler) PutV2ClustersNameTemplate(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetV2ClustersNodeIdClusterdetail operation middleware
-func (sh *strictHandler) GetV2ClustersNodeIdClusterdetail(w http.ResponseWriter, r *http.Request, nodeId string, params GetV2ClustersNodeIdClusterdetailParams) {
-	var request GetV2ClustersNodeIdClusterdetailRequestObject
+// GetV2TemplatesNameVersions operation middleware
+func (sh *strictHandler) GetV2TemplatesNameVersions(w http.ResponseWriter, r *http.Request, name string, params GetV2TemplatesNameVersionsParams) {
+	var request GetV2TemplatesNameVersionsRequestObject
 
-	request.NodeId = nodeId
+	request.Name = name
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2ClustersNodeIdClusterdetail(ctx, request.(GetV2ClustersNodeIdClusterdetailRequestObject))
+		return sh.ssi.GetV2TemplatesNameVersions(ctx, request.(GetV2TemplatesNameVersionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2ClustersNodeIdClusterdetail")
+		handler = middleware(handler, "GetV2TemplatesNameVersions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2ClustersNodeIdClusterdetailResponseObject); ok {
-		if err := validResponse.VisitGetV2ClustersNodeIdClusterdetailResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2TemplatesNameVersionsResponseObject); ok {
+		if err := validResponse.VisitGetV2TemplatesNameVersionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2518,23 +9771,27 @@ func (sh *strictHandler) GetV2ClustersNodeIdClusterdetail(w http.ResponseWriter,
 	}
 }
 
-// GetV2Healthz operation middleware
-func (sh *strictHandler) GetV2Healthz(w http.ResponseWriter, r *http.Request) {
-	var request GetV2HealthzRequestObject
+// DeleteV2TemplatesNameVersion operation middleware
+func (sh *strictHandler) DeleteV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request, name string, version string, params DeleteV2TemplatesNameVersionParams) {
+	var request DeleteV2TemplatesNameVersionRequestObject
+
+	request.Name = name
+	request.Version = version
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2Healthz(ctx, request.(GetV2HealthzRequestObject))
+		return sh.ssi.DeleteV2TemplatesNameVersion(ctx, request.(DeleteV2TemplatesNameVersionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2Healthz")
+		handler = middleware(handler, "DeleteV2TemplatesNameVersion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2HealthzResponseObject); ok {
-		if err := validResponse.VisitGetV2HealthzResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteV2TemplatesNameVersionResponseObject); ok {
+		if err := validResponse.VisitDeleteV2TemplatesNameVersionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2542,25 +9799,27 @@ func (sh *strictHandler) GetV2Healthz(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetV2Templates operation middleware
-func (sh *strictHandler) GetV2Templates(w http.ResponseWriter, r *http.Request, params GetV2TemplatesParams) {
-	var request GetV2TemplatesRequestObject
+// GetV2TemplatesNameVersion operation middleware
+func (sh *strictHandler) GetV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request, name string, version string, params GetV2TemplatesNameVersionParams) {
+	var request GetV2TemplatesNameVersionRequestObject
 
+	request.Name = name
+	request.Version = version
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2Templates(ctx, request.(GetV2TemplatesRequestObject))
+		return sh.ssi.GetV2TemplatesNameVersion(ctx, request.(GetV2TemplatesNameVersionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2Templates")
+		handler = middleware(handler, "GetV2TemplatesNameVersion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2TemplatesResponseObject); ok {
-		if err := validResponse.VisitGetV2TemplatesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2TemplatesNameVersionResponseObject); ok {
+		if err := validResponse.VisitGetV2TemplatesNameVersionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2568,13 +9827,15 @@ func (sh *strictHandler) GetV2Templates(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// PostV2Templates operation middleware
-func (sh *strictHandler) PostV2Templates(w http.ResponseWriter, r *http.Request, params PostV2TemplatesParams) {
-	var request PostV2TemplatesRequestObject
+// PutV2TemplatesNameVersion operation middleware
+func (sh *strictHandler) PutV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request, name string, version string, params PutV2TemplatesNameVersionParams) {
+	var request PutV2TemplatesNameVersionRequestObject
 
+	request.Name = name
+	request.Version = version
 	request.Params = params
 
-	var body PostV2TemplatesJSONRequestBody
+	var body PutV2TemplatesNameVersionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2582,18 +9843,18 @@ func (sh *strictHandler) PostV2Templates(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV2Templates(ctx, request.(PostV2TemplatesRequestObject))
+		return sh.ssi.PutV2TemplatesNameVersion(ctx, request.(PutV2TemplatesNameVersionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV2Templates")
+		handler = middleware(handler, "PutV2TemplatesNameVersion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV2TemplatesResponseObject); ok {
-		if err := validResponse.VisitPostV2TemplatesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutV2TemplatesNameVersionResponseObject); ok {
+		if err := validResponse.VisitPutV2TemplatesNameVersionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2601,14 +9862,15 @@ func (sh *strictHandler) PostV2Templates(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// PutV2TemplatesNameDefault operation middleware
-func (sh *strictHandler) PutV2TemplatesNameDefault(w http.ResponseWriter, r *http.Request, name string, params PutV2TemplatesNameDefaultParams) {
-	var request PutV2TemplatesNameDefaultRequestObject
+// PostV2TemplatesNameVersionClusterlabelsPreview operation middleware
+func (sh *strictHandler) PostV2TemplatesNameVersionClusterlabelsPreview(w http.ResponseWriter, r *http.Request, name string, version string, params PostV2TemplatesNameVersionClusterlabelsPreviewParams) {
+	var request PostV2TemplatesNameVersionClusterlabelsPreviewRequestObject
 
 	request.Name = name
+	request.Version = version
 	request.Params = params
 
-	var body PutV2TemplatesNameDefaultJSONRequestBody
+	var body PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2616,18 +9878,18 @@ func (sh *strictHandler) PutV2TemplatesNameDefault(w http.ResponseWriter, r *htt
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutV2TemplatesNameDefault(ctx, request.(PutV2TemplatesNameDefaultRequestObject))
+		return sh.ssi.PostV2TemplatesNameVersionClusterlabelsPreview(ctx, request.(PostV2TemplatesNameVersionClusterlabelsPreviewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutV2TemplatesNameDefault")
+		handler = middleware(handler, "PostV2TemplatesNameVersionClusterlabelsPreview")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutV2TemplatesNameDefaultResponseObject); ok {
-		if err := validResponse.VisitPutV2TemplatesNameDefaultResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV2TemplatesNameVersionClusterlabelsPreviewResponseObject); ok {
+		if err := validResponse.VisitPostV2TemplatesNameVersionClusterlabelsPreviewResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2635,26 +9897,27 @@ func (sh *strictHandler) PutV2TemplatesNameDefault(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetV2TemplatesNameVersions operation middleware
-func (sh *strictHandler) GetV2TemplatesNameVersions(w http.ResponseWriter, r *http.Request, name string, params GetV2TemplatesNameVersionsParams) {
-	var request GetV2TemplatesNameVersionsRequestObject
+// GetV2TemplatesNameVersionClusters operation middleware
+func (sh *strictHandler) GetV2TemplatesNameVersionClusters(w http.ResponseWriter, r *http.Request, name string, version string, params GetV2TemplatesNameVersionClustersParams) {
+	var request GetV2TemplatesNameVersionClustersRequestObject
 
 	request.Name = name
+	request.Version = version
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2TemplatesNameVersions(ctx, request.(GetV2TemplatesNameVersionsRequestObject))
+		return sh.ssi.GetV2TemplatesNameVersionClusters(ctx, request.(GetV2TemplatesNameVersionClustersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2TemplatesNameVersions")
+		handler = middleware(handler, "GetV2TemplatesNameVersionClusters")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2TemplatesNameVersionsResponseObject); ok {
-		if err := validResponse.VisitGetV2TemplatesNameVersionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2TemplatesNameVersionClustersResponseObject); ok {
+		if err := validResponse.VisitGetV2TemplatesNameVersionClustersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2662,27 +9925,27 @@ func (sh *strictHandler) GetV2TemplatesNameVersions(w http.ResponseWriter, r *ht
 	}
 }
 
-// DeleteV2TemplatesNameVersion operation middleware
-func (sh *strictHandler) DeleteV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request, name string, version string, params DeleteV2TemplatesNameVersionParams) {
-	var request DeleteV2TemplatesNameVersionRequestObject
+// PostV2TemplatesNameVersionDeprecate operation middleware
+func (sh *strictHandler) PostV2TemplatesNameVersionDeprecate(w http.ResponseWriter, r *http.Request, name string, version string, params PostV2TemplatesNameVersionDeprecateParams) {
+	var request PostV2TemplatesNameVersionDeprecateRequestObject
 
 	request.Name = name
 	request.Version = version
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV2TemplatesNameVersion(ctx, request.(DeleteV2TemplatesNameVersionRequestObject))
+		return sh.ssi.PostV2TemplatesNameVersionDeprecate(ctx, request.(PostV2TemplatesNameVersionDeprecateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV2TemplatesNameVersion")
+		handler = middleware(handler, "PostV2TemplatesNameVersionDeprecate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteV2TemplatesNameVersionResponseObject); ok {
-		if err := validResponse.VisitDeleteV2TemplatesNameVersionResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV2TemplatesNameVersionDeprecateResponseObject); ok {
+		if err := validResponse.VisitPostV2TemplatesNameVersionDeprecateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2690,27 +9953,81 @@ func (sh *strictHandler) DeleteV2TemplatesNameVersion(w http.ResponseWriter, r *
 	}
 }
 
-// GetV2TemplatesNameVersion operation middleware
-func (sh *strictHandler) GetV2TemplatesNameVersion(w http.ResponseWriter, r *http.Request, name string, version string, params GetV2TemplatesNameVersionParams) {
-	var request GetV2TemplatesNameVersionRequestObject
+// GetV2TemplatesNameVersionExport operation middleware
+func (sh *strictHandler) GetV2TemplatesNameVersionExport(w http.ResponseWriter, r *http.Request, name string, version string, params GetV2TemplatesNameVersionExportParams) {
+	var request GetV2TemplatesNameVersionExportRequestObject
 
 	request.Name = name
 	request.Version = version
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV2TemplatesNameVersion(ctx, request.(GetV2TemplatesNameVersionRequestObject))
+		return sh.ssi.GetV2TemplatesNameVersionExport(ctx, request.(GetV2TemplatesNameVersionExportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV2TemplatesNameVersion")
+		handler = middleware(handler, "GetV2TemplatesNameVersionExport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV2TemplatesNameVersionResponseObject); ok {
-		if err := validResponse.VisitGetV2TemplatesNameVersionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV2TemplatesNameVersionExportResponseObject); ok {
+		if err := validResponse.VisitGetV2TemplatesNameVersionExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV2TemplatesNameVersionPublish operation middleware
+func (sh *strictHandler) PostV2TemplatesNameVersionPublish(w http.ResponseWriter, r *http.Request, name string, version string, params PostV2TemplatesNameVersionPublishParams) {
+	var request PostV2TemplatesNameVersionPublishRequestObject
+
+	request.Name = name
+	request.Version = version
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV2TemplatesNameVersionPublish(ctx, request.(PostV2TemplatesNameVersionPublishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV2TemplatesNameVersionPublish")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV2TemplatesNameVersionPublishResponseObject); ok {
+		if err := validResponse.VisitPostV2TemplatesNameVersionPublishResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV2WebhooksDestinations operation middleware
+func (sh *strictHandler) GetV2WebhooksDestinations(w http.ResponseWriter, r *http.Request, params GetV2WebhooksDestinationsParams) {
+	var request GetV2WebhooksDestinationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV2WebhooksDestinations(ctx, request.(GetV2WebhooksDestinationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV2WebhooksDestinations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV2WebhooksDestinationsResponseObject); ok {
+		if err := validResponse.VisitGetV2WebhooksDestinationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
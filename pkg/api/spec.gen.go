@@ -18,89 +18,504 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e1cbN7f3V9E77VqB1GOPDSEJ78ripJC0ftICB0h7nidwsuSZbVtlRppKGohD/d3P",
-	"kjRXe8YeA3YguH802KPL1ta+66fxjeWyIGQUqBTW7o0VYo4DkMD1p7euJFdwzNlf4Mqu9ytgD7h6AF9w",
-	"EPpg7Vo7L17gnVevO/Z255Vjb7tbL+3XL3tte6vd3mlj1+m9fg1WwyLU2rWGpn/DojhQfc3woRmeeFbD",
-	"4vB3RDh41q7kETQs4Q4hwGrGPuMBltauFUW6pRyFagghOaEDazxuWDGZhziAYyyHRTIl4MDGCSGhep6S",
-	"EWYdZ5IQYimBq/7/+wnbXx379cXGJzv+63ny1ebexvl5c2aDzec/lqxgrOYWIaMCNPO3Hcf+GXsn8HcE",
-	"QqpvXEYlUP0nDkOfuFgSRlt/CUbVdxmlP3LoW7vWD61sc1vmqWgdc9bzITgAiYkvzLweCJeTUI1m7VpH",
-	"PcUORCgK8chn2ENEIMokCjkLgfsjpDYj8rEEDzGuH3EwHyVDcggoADlkXtMaN6xtp21/pDiSQ8bJV8XX",
-	"lS3kbSSHQGU8PCLUCJH+W6CACEHoQK2A0Cvsk4TebfuQyfcsoquk9ZAhDoJF3AVFXF9Nj7DU3Px40o1J",
-	"e23vM9r3ibtKeYglELks8j292z1QsuCCEOApOVFEuhHnQCUSEktArK+/TJakyX/hOHaXKhXC/inwK+Dv",
-	"OGd8hSs5G2rCr4gHXHE5ptkfoYjing9KfIeYeuovTb1ZuBfpJ1iJkCEfgaZcL6qtxKWr7EwAVK5UwM8y",
-	"IpUqhsBT6VbbRDKimtpExiOriff9SEjgZvAu7TNt4bSCS2IMkFoEZ/6xjymcAPZG8wj+BShw4p5KLCOh",
-	"mENon2MheeTKiN9yjMuoB5yCBPEHcEEMAydsZ8PycQ98kXvEtBHTj0gf3JHrw/EQC1h4fuMkSqakzINf",
-	"AfvG1Sw2JvMMi4mEQMzrfsg80Ds0blgB/tI1fdqO46ROBHOOR+p5It3xXIsSJiEIlSEvWfC4Mc3aWIjW",
-	"4rN68fnvCFNJ5KgQ5rS1gJAgCrR8NKyAUPMpExVlwgbA7ywsM+Tht5SbRYnIuIw9jyhLhf3jQosSc22C",
-	"LhVyaJusx0BX2I9ANJGeCV3CKGknEOaAtDfX8QgWKMRcZv7IWHRj5FUoGuAvvwEdqF3Y2WrkY7wf/9Fh",
-	"3lv7Pypqy/5s2iaWix+UhHKTZtrwQ1N2CaOWJh6FmHCB5BBLRMFETi7TEYr2QlKGYrfVysS3SVjLY65o",
-	"uYy6EErRYlfArwhct64ZvyR0YF8TObTNboiWYXbrBzGiEn+xMfVsd4g5diVwW4C0Gpnc3FgeFU0R9Zoe",
-	"CzChrUsY2R1r19Kk2p2mGrnpMSmshqWetdNnbWtaEMaZKJyG4D4CQWiuJWEpkpDZv+Vwd3FHqgWyhiPN",
-	"e8K5tN93KpjPQj/Fi7yotrenURBgPprWM0ji62l1olHQA67UwTWDxBKoRJNQE9+aWF7pRpp9Eyq3OlaZ",
-	"MyH0mLMBByFuNWHI2QCEiNOHDe2alLsmdNDywAdJ6GCzJik8iRQWo0J3qzmFZBL7MfsrFqyblExYc4aI",
-	"XlJ2TW/FzLjvAvs3IXLF5SUcbcQCVdjsjNIZEnoWa1N5pJjYiImMGAdpHplqY85UWT0swCcUirb7hQl7",
-	"ko/txpLrNg3rKgssiyuIF59Sj+KWScpsdkWt8dnV/zT/3fzPs8L6rpxmu+lMe6bK1V1tOP98atuvL87P",
-	"veeb5+fNmZ83bA+uNvdq2B9TGEuWWbbNB9DHkS/va5ub6FDXlAwN6HoIFAmQygbodp6ZrqESXXyFia+T",
-	"d0LRL+/OUOuq3UoG0sp2Z4m5lWuqlIqzCWloom5frU45ewhCOWrE8Y1UAUoiMtfE91EPUCRMMBOzoFlL",
-	"YooeazExmS8fswSjmDRM8eItGpgG2lRFApmealVF2SHUIy6WxpvN8vFmpm7aXLl6EAIPoGz2YRRgaivr",
-	"piUoJiLuMBEUtp3OdkXgYn9WQtHa/f9v9v7r//3QOI8cZ8vV/4fnG5vo4qcfYxt6RP1RUliekhhJAhAS",
-	"B2EZpR8p+dJAH8/2UdrM6IWSlZjuayyQj4VEUaiD3oLljwiVO9vVdBRdQbFJfrcTbjZye5KnvUwKPkQ9",
-	"UCEtGZRbBuKVpsiXabea9YhDkCoCPsF0ACX5p0s8/rPP3MtSSfSJ0LZ4v3twgnq6mTIpOoUwX1ImdX1N",
-	"8TUNOHMCsbG3+0nZg5t2Y2t8ft7cvNkaZ1+0ksdKuToX5s+tT47dudgstSCzQ9QJJcyt7UJxIqkZVfC6",
-	"uPhfmZC58rNXMCpDJqTdfgF9r9Nxy+jkzC8vcYhadYVEY/usYlOTqL3WUj5+7B4k7kRRXjSQO7Cz3em4",
-	"W/ZO5wXYL5yX2O65r7Dd8zpbWw44L+ElzFpibHXVLvi+GplGgeK++RRXvEIfaxejRBG4UohUCXX7efbU",
-	"nISpGct0aaIqPMWUSmtnQvpMfWvo06QxLY1FY+OTWoNmji+nZ2/PPp5+7h4edPffnnWPDj9/PDw9frff",
-	"fd99d2A1Sp6/Ozk5Oil90j38fHxy9MvJu9PT8ucHv70rY/Zcu5sTwLIipilQ6HSquKr9o8ODbryoD4dH",
-	"fx5mZGWPTt69Pfh32YPDo7PKZ8cnR390T7tHh93DX8oH/f3oD/Vsvmzp9YuKsmfB49SQh9nxXZyK2POr",
-	"O6sotbz1fXYtVLykkiOGRAgu6Y8QTg39VAWGqQALS4ndoSnD4CS9Qi4HXUa6JnI4EaqeDUEkQzyE+o0x",
-	"UTZ8kUBNBGp5EDCrcd+lnZg3sdOdZ+YnWmf9jYePzNFVoZx9Y+GQpGX7gnltxp2bX+zLV5qjV+0eSKxy",
-	"20tCPWvX+nA25ABiP3cMcZalkQFI7GGJs8Qkyw6U64odTr4MlHx3KZOB+2SQeCahDzT3s2jFF6eYKmvh",
-	"Mxf7yhVZDavdedl0mk6zbTUsR//lWBdj/V8Zg3MLTmr1plHeE11uiZzFVWKGPWUI1PcX89SkoIvbzuud",
-	"yVRoqrs+lKmmRoWRec/oMfcSTMlAPSgjqPSIZkk5796uvbGxt5v77h/1vySO11FZ8rdurkao3X7z+ebm",
-	"nu7000b+yU9moMJXum15UbO8ZPrNihoPq/xQJi8Xc3zVb8QAaMKJM4bSosUsI1ZW58gVi/Nz1apITw5U",
-	"CPlLitJMYv+dOdOvqDq6LKLau+n5k0oTUEk4aM/XQBwGmHs+CKHahXhAaJrW1CkUTrE63oZyLl8VH6Zs",
-	"yUlVx9l+NdfuzM2Gavin8vIcNQ1QwRE1EKGuH3mEDlDIPISph5SNJy7kM8TpSkXIvPkHEYU8VbkbM/Ki",
-	"HUuO3tRYbsSJHJ2qPmbIX8/OjtW/PcAc+Ptkj//155kVg0K0/9NPsz1XkYs5zCOxakyKGxHIY26kxBF5",
-	"0CcUhKlHaHLTo7qE0b9jigfAUafpoJN3p2fo7XG3qYsHUmdnJe1yir9rdZrtZkexi4VAcUisXWur6TS3",
-	"LG2GhnqprQAkJ67+ewBymupfQIpSqhKKUMhZAHIIuhKkB1NEpuiarmdG+T2eaAK213GchRBAJTDACTje",
-	"hxg8VSUc6fStKoRVXiys3U/KXOKBMNUcs4gL1aR11UnCtDn8w76fnjw8ywPrSjn1Ryd3lJBHmH4qs2Ax",
-	"giF3xmFMmWSIg4w4Lab0eaL3QjyAU/IV3nScBOv5dwR8lAN7xi2sPLIzDV86ziIIinFjkv4u9eBLUn7o",
-	"Ey6kJj5HO+pKXbX2AyYkwv41HglT1CVUWaC/IupKU/CNM41nCcnPkF5LveWfR47T2WH9vgD5pl3FDfO8",
-	"nBcLL15tHuMecI2q7CeOhxMQTXRuYeGeW9qOnuuO6kNyRJ+e43f7iDKqIZAmYSPgNdLOxLCqeU7P6WkU",
-	"hoyrnKxPwPfE7jm1kVqW+ncqRFBfFuEu6psituecZpw16atwgSoPMK0FgnGZXyDqjfTk+vNI7WXa2fBE",
-	"GTK1xskt0w9/Hr0511uC9DpNlB5vw+TMp4zHBmxy6ulJcwcFplhMWfwgz99mPdoSuu7ClKz3Qlwx4mIZ",
-	"V1QixaZ1QYynYspJYt8TPz43zdGLlSKy+HRON0ikZmVCF0S+JKEPn83801yO6eqN0uKE5lFqL0IOffIF",
-	"nVt9xs4txLh59CGlDgnWl9da99rNzsvmi0oBMFPFu/Cmz9hzdHSSW+fn2De/uerogYyICEIHKf2f1eSf",
-	"BWDuDj8b0iqXlJ5lXw+ZgHR58YKGWKA+Y/VpraKGRXIeQe9THmtoc57PMV/r82yG4MZbPEtuL+4YXpSW",
-	"6OpDZvIIz8eSn0wBGVKKLkqhamXh1nadcGvihsZ9RGlJXJYGTBfjqZCpbPSsSav80o4SpJCJEoeyr0ub",
-	"Iit2Tsdwx0wUg7gYPvczMzibe4G75xGD4+nrMB2nvYy4+gFt9EQE3hIZrKtuJG66kK/gxbaL5EBcM+Ly",
-	"BEF2R2NTZ3vjmZ6S5k1u7I0y/2OzoT7IkrO6A/29KHgf02t6I03bbC/Te2yFjdyenuRO/N42I87rlrtL",
-	"tbRNmp3mF9m3QK5azsd7V4jcDZz71okHtEf3okiN/I3O8rucdN4lzkWK6rc4haxS9laGZalRmoo7PxMo",
-	"66byEVBWnUhhItEqgzAlxR9ycy9RoCdQPvcvze063SZum35zNcgz/6FqQlLpmqkKBuQWg9lK0uvyK97x",
-	"TpjD3VnkZOv5WdehkUHR/evPMwOkywf/pnBdV/UyPMLTsUINK4xKLMxHDQwUk07RcKgk7o8mLEl8s2up",
-	"CUA8x7iYyikOjsuNV0mSGS/Pz67bxJBIJCLXBSH6ke+Pmt+Db60Q+vRmzFrmy2VeM6iGyB9qRt5e4u92",
-	"M2nqnHO8sBLohX4vOqAv3NfpP3Ur/xbq07pR/3S9W6ZqmvMoGaNe4qalTYN3Pes2G42IiN8D8D3auieU",
-	"RxRo3NmGl69f9ndsr9fp2NvbL8Du7Tg79nan88rb7rfdTs+rWEcmSnVea3NzsWdASv239vuLm1djeyP/",
-	"eXtsJ5j25Kt2Z/xpfLFXsYSisP4Z3+PRb4lRVKA+4y4gL6dC4A1AS3L1MWe5ju7psd6ocSsOO3WD8rPO",
-	"PvZFBg3uMeYDpjNCyjwscO1gYwdbYgFTlOx8P5vDZi4xuCwCrsq8aWe2kU3v8hlnml4kJwJh14XQvGtl",
-	"7VILOmM0NPnG0xWv2VWQhN2mrUZHZC9v6o1meNVi9UO32i/M+9RKeqtzpY/TTSU2fqhfxvL1DsCxeIT4",
-	"RkyFaP4aT/OoYWNmEWh/CO5lpvHxi/RE6yb3Sr3xXSFlKYwwBaygePgKDsdCKnIvBFwy/mzOwp8oLG0B",
-	"rqzRag8arTZvJx8giG0xkleAbVuQh2vI20ohb/N25xEg4RZfwkoBcguTt8bNrXFzCxcLY9myhctC8Gzs",
-	"E3ybbGfyXdILoOeSrakRrRpY3exwdY20W7Jo1MtdFgfjVWPx7jOhWQP3lq/6NSXkLqg+1OcsqCsTybnR",
-	"DLF4ChjAyv2+LR7wPvVyDR58mMr8mCCE9QzO8pCF960PaxjiWqGeFhKxpgbfFqD4OK3bbaCJi5gifbI8",
-	"xxStcYwP2s0vpj63hDo+Be0x2L97Vp41IvK716Z7RT4uIn81c9s1THKdMa3BkrPAkrfS9qViKGtSdHto",
-	"5Xfp0GeAKu/br68RmI8agXlX7788kOa9FpLWiM6Ve/3HjeuskPz0J0LmH+mlTYu4REIRTgS5thyfpdPO",
-	"gSJO+/+BoodRf5T/DZTMHOZIq3DeiateyH03bo2RfIg4x2+NLLS+JZzL+mZomrrvwNVviX0CJ1npD2Oh",
-	"zB7cJyb/3uAt3SDUwMeESvNW/CqjVwloyVu9ZcSW84PKhwRp2XZe1+mW+7HvbySQNT1okrrl4L7fSpAb",
-	"d/kVuTvmh6v+5bkauSMRWXyARVXcMEujozkKrT4cpHHFMnS79J3rNau+T8NvLKimMVS5RuCbtNQKlLqA",
-	"AEt3qGIbrH9Sl7iRj3NpeQrjv31srD78kVC5xNAj/+L6ddSxNtZLNdYLaulNrHy1jmBwUlpxc9VBzoIZ",
-	"SjjjpKVMDx8omvDRhFKzYIllu1fAJc7eyUXMqbWiRG5tTtfmdKnmdGqxsYBPrnfR31ou4cNVTnVqVDHv",
-	"/vO6iau4c4my3FSsqgRZPJBMKdyLu806ZlxxpbKK0qd5b7t0/d/1De3V3aTOePsA70xXEbeC29GVfHkQ",
-	"96Bvf1+5mBXPu7Cc/Nj7ldN0mp2tSh6V30ZOryCb3ne8gpzl++YOcrqSmZeQZ9F4b9eNi0ytuG88g5Jv",
-	"e7P4CZ+FLDFern2CUXFosT6heDgnFDNqnKs4c1gfICx0gFB+ZrA+IPgWxrRKS1ZQ8p+Ta65L+g/YeT7J",
-	"Qvy9V9wrS+zrevqdRPzWhfP6JmldFl+bpHUxe0nF7OJbRJNfI/90Mc5eKDpl+BK1FYiDr29pSYYCTPFA",
-	"RSFu9n6keFnpG5OmeTlnrIn3IOjAx9UvdfJK5sm/w2DhqSZvMEzTn9OkuqO7Q3Av1ehKMMwbaOMX0CbS",
-	"s/97+orabMLCG1zHF+P/CwAA///GYX8WCaYAAA==",
+	"H4sIAAAAAAAC/+y9C3fbNrYv/lXw1527+hhJfuTRJllZvamTtp42ia/ttOdMndsDkZCEMUVwANCOmvF3",
+	"/y/sDYAgCUqUX0kan7PWNBZJPDY2Njb247ffDxKxKETOcq0Gj98PCirpgmkm4a9nieZn7ECKf7FE76c/",
+	"MZoyaR6wd3RRZGzwePDwwQP68NtHu6P7u99uj+4n974ZPfpmsjO6t7PzcIcm25NHj9hgOOD54PFgjt8P",
+	"BzldmG+x+QKb5+lgOJDs3yWXLB081rJkw4FK5mxBTY9TIRdUDx4PyhLe1MvCNKG05PlscHExHOxlpdJM",
+	"7k9fUp3Mq7GmTCWSF5oLM4ZDpkQpE0bOmFRc5ERMiZ4zkuDXhCoimS5lzlLCc2Ibfc405dl+PhVjaRv4",
+	"Fb9/Al+bcTOlCTdfm9mwlJxzPSf3tx+RPZFPM55owutdnVNFFiLlU85SoniesMEwoOzJYGf33v0HD08G",
+	"XfTbn45groOQUAv67heWz/R88Pjh/Rid9lO2KIRmebL8mS276PQzWzrSuMklc6FYTiZLOwvOcj0kbDwb",
+	"E0revNl//oRQQzxp5uM+AiqY9xVdMHLKlpa8yjatyky7joTkM57TrCJnrjSjqXmeSEY1z2f44sTQmNAZ",
+	"5TkRkkwpz8yzFslrBN2ePKS708lk9IDeT0b3J9+y0SP6cDraSb9JdqcP0ntsd6eT1BXRRj+zZRfFdx88",
+	"GA4WPHd/70QX4HIcqtmiyKhmTRY9tr9fE3f6bj4Qe1pp84ou2AHV87q00YwuRtR1WJjnvrui+nClJCmo",
+	"1kya7//f73T05/bo0dsvfx/Zf33tfvrquy9PTsYrX/jq679FBNGF6VsVIlcMZOj97e3R9zQ9xDUwvyQi",
+	"1yyHf9KiyHhCzcpv/UuZ5X8fjPRvkk0Hjwf/a6uS0Vv4VG0dSDHJ2AIFk8J+63z0GjcJz0lBl5mgqVn/",
+	"XGhSSFEwmS2JkamlWevUbCLzSDL8UwvghQXTc5GOBxfDwf3tndGbnJZ6LiT/k6W3OJFnpZ6zXNvmCc/x",
+	"LIB/K7LgSpm9LyTh+RnNuBvvvdEPQk54mrL8Fgd7XN9vhqg0y8Q5S62onLCElooRrsm5KLOUsHcJYymh",
+	"5N+l0NTtdsvNdi73R6+E/kGU+W3S/ZUgTpyYqUxN94RqGN6bw307tEcjL2xvb2iH7kgCChoiT4BkCVMK",
+	"pSIcUaWULNdEaapZdZrhlGD4D7a3R/u5ZjKn2RGTZ0y+kFLIW+aXQooznjJpqGzHnC1JmdNJxsxWnNM8",
+	"zZgdPU48LeEJNdsBh08YjBwmtWPYZd/IzAXLNUtveT52kEasFEz6nWqWiVeDGoO4ty2Dtsnlj7Qw3MRn",
+	"7WNxP1eaZpkip/cUmUqxIIprNspEQjNCpeZTmmg1NHKgKM17hlyn5YTRdEH4gs5Y+zPJZtwIbqaGoa5h",
+	"vkSyMj32bb85/GWIDR1TOTFDGRK1VNqQY0rLTB9ia0tC8xSao1l2BDMwBxkxVF+aRTu9p55gQ4esEIpr",
+	"IZdDkgjJnr862m/+znSSNn6EDuzYly+5WXcVNI9zfuImDavNlHk0EXo+HgwHeAJojidUMME22b+nyuz2",
+	"XxxdDPU8SYiCPUPmQnndzCzPhOdULsmXp/fUVyE1CLZMvrR/j9X8qzE5tEe10SxP76lxTc2Ya12ox1tb",
+	"foXHZgRjWL+t03tq62xnfG97/PDvp/eU0d5CZWz7/rfD8LiHtr57vLXVPraHgzj9Y+qZe+a5q+K3PWwE",
+	"SQ/sNiaWO2ABGqten6pb0WCGjx9sb29vnX6rtszw0lzVZ/hgZzcykwjHbDgN08L1z6HP2Pm6cR9IfkY1",
+	"8+xP5KqJGKEnRUaKjOYslAINrsMPb2AqTlS0J2L2CeVyRgtLaW1fJYVkRl0z4hPPsVykTA0J43qON1Q6",
+	"USIrNWxMZSQeVcQowwoVOH6Gh0O1r2sz+930PcK+R0iTEV2kD++PNZXjP5UevB0OuGYL1bzdwIZaeb0B",
+	"uuzjt7vb/jGVki49USLUAH6FtZS6eeF53L2UXCt3nVaw7CjinaBqifmxu9ArsqBLf5rqOVuAfGTQCFAe",
+	"VWCOwk2RCZsKCWfwklBpz2xzxTKKnWJ2RAeSTTM+m8McbFdHBUsa9F+50w0zjmjBUbY+tvKta02A93ov",
+	"yc52bE2aR1Vk15kDzJ+MNVke8mhdUGyJQm9Vkr6+uxoP6xvq/vajh5F5NI689jCPqjVf4DvANpTnTKaB",
+	"WLDcI6akKCcZTwIOQekwCIi9Sh86rI1oPftH9YUeQs4ICxy+4XhspSbOekmuxvH44F7s/m1/QROLGfOz",
+	"gu/NaT5jcHmuaQ61Ub+PMB7cH9vz++n4+MBeLh1XsTwtBM/1EyIWXGujxOODBPp2+qMqWMKnPLHKr/uq",
+	"Nv0fXxzHDvhiLWdf4xi2zna3vPKrYsPBH94PWF4ujEygacrSwXCAfZl/payQLDH38cFwINlCnLF08NZ9",
+	"Ga5ZZez4HZ/WtfK3q1Y1E7P2wkqWMapigvrZwb4zTKkhWQiliWQJyzWZcql0343zrOCH2MfgorlNGhPy",
+	"Y+mYhmunNQmkJPyz75gso1+0d66dc5sgv9atdM8O9vE8QFE5FWP7JZlylnl2f12w3JDS8RLwSY2Ddse7",
+	"4+3ButV2wxr62caotPdq/3WBnNgav33gBrb3ar9hEm9fGKYZzXOWfU+TU5ansTsDPHDt2Ndd007Ft3x/",
+	"9i6jZvzmmB3NzgfDwTmXbFZSmY5y0GUGw0EucjZ4G5In8lIPWbZHkzn7jcpFWbSHba/iM8mUJ8c5vOv+",
+	"Sszn8C/JaKpAEYBjOgUpPDSKmdkKPHydK5JyZe7yaZuU1syj4qNJRJl7dSjYa9mSUPCdODOROdaohvGY",
+	"EbOUlAVuSNOl953wXN/brSjFc81mTOLBlCcsspS/zRkondV0xNQYtKqOuSL48ZCcz3kyN/JQBbQbV/1N",
+	"hMgYzU1/OMqD3rNXwVTP50J1rEA1zl7zbmwmvxit8XkCRXcX7hPD9chWDTGEj8EuHZ2nsV+7RZ6YrQOr",
+	"F+y+yF3V7ALN0me65htLqWYjzRcs+pFkdMNPjOqgo1LvtzlDbbihl3tTltLmwgpvqJwWai50dCoLphSd",
+	"sVgPS08Rw8yU2w3UaiLvTdmyiDZQzO354WTSActT82w4OCzzHP+152g+GA5+gMFEzmKw/ZuZrztrLM8c",
+	"2rfNDuR/dszCPHGzWElL97Afq8El333i1Pj6aqJSv/YQytHlEjK6I+ra/fILV7q9Z3Cx+h/dtSbXahSu",
+	"9RWD28tEzgKfTX14GZ0wvNPTNOWGvjQ7qL0Rurnu1YxQf/sPuJ2ejf5pvEjVP8ejt19Xf739W2yB6wv6",
+	"C4yCLJicgc3bOm1wcNUhbq6oTpoMiWRFRhNnrKu/61y01lPhzX32cc7OQ6nkz+L3A/MWDFRINtq+vzuI",
+	"nb39tmnQyZDMWM4kOKSs0zmnCzP0QmQ8WTZcJebUZYtCg0XqZugP+6FjDuZRdBJGd7EPFmB1mDBC4f34",
+	"GvW+fL4SKTPGhdYVfxssAu7vNZsB57RiKzxnGYsfAjGFKbVvm7+pn5KzhRj1GF9hqCMsaDLnOVPNMAzQ",
+	"XYbmpxyNPDW5hCblqaRKyzLRpWQVuSnYxs1lSdVaFHnCQHPBnrjpI6cZ/5NJVCPwo4iOZvs+MF2vW5Nj",
+	"RmUqzvMjTTWI9aqTOP2CQTRI4DU6dMzC4MiS6Rp/dFwrq3vLVMiE/WA7eabjgzDnv1VrF5SbA69JHNNB",
+	"UQQ3YjtIrojSPMvsuuezIVHMK8DQuWsK/UDnEL1g/UM1JW2lJlJf7Y1XwbHZoZtfxyYuFxPDKtNOvly1",
+	"KG2t2k90LeHDbdOMEdqYXK0LdDWKGClW7n0X9xTRa4Ntcchouly3Kj+ynEmemEUpFR5nGdPrmdLRokCt",
+	"rKIVV/hvNMFqUuaaZ4Tr4AGEBWhV+yahecIyFwPz/MUvL45fEGOwsR2prffmRLrYch910t3cQV7n2dIF",
+	"mUQO7Ep29tBfvKiFT8181euI3DUaVpMzjbDj3hhO7NdE5JuJi/o+u9yyGgO2zJlm6tfKaNLqudKhWsyX",
+	"8SlLlknGDpxivlH/hq81y80q96T7y+CLQFOJnv8/MZrp+bqGW4PyqkPvox12XeRoby6Zu3LZvjYdmGR4",
+	"f3ZhZz1s3s0PsJUw7mytmczxqftuaG2s5nbPcnbGZO01XoWijckRM8e/JlQRF2JGtCAF/iPQavNsaU6i",
+	"XExEuiQsU8y3Um8d7z4LRnOzscd9drZzTkX45GKFPJXLw7JDk8KXldGa4KZehVBCkAv++MQcPHMjBbmy",
+	"P0U0lgkHSdmhcpiAl+wlHgPf2zeJ/QQIgR4ve52oa17u9Biiqk3KPGNK1QMHS2VVL+ioqag5dq3Jpfg1",
+	"qkb6ipbNDWCXcV07bUKESuley9ziOmycpq63YUXlFQfoizMbc9OwopOfvZAkzLxT05eHbZ13GKgk0hPR",
+	"/xjTWstcr1NzDLvjWtlBJBB/lPa0GvL8TGRnLMVQw57CFkjy2hOq0wBkRjovFzQHUx/EQgUveCuGaS1q",
+	"DZGMqq5bi5oLqT1JSZmnTCpNc+wGv6z1YK/EJ9b0swc772QQ7dgQdY1Kg9TOqOog+Upd2HmOIu0vC9YY",
+	"9snglWk0OxkYvjkZ/EalUfqiQ296koL+PTmrBWst/7ptEDf1wDg3tvRAg1FDz8ohVIzaJX+rTWgXiZtw",
+	"C1Hq9g475Xkab8o8ceuAzXr+sXK3g3U6NI/GwkDH9uVVRH+nWa6c16fpuQUXtH+lPQ//yOiSmVhWUcFe",
+	"SJk/zmhWAs+hlg2tjpj/Fo7iml9rQhXLeM7aRpp6FEMjTLttr/mjYbD5z/9bbb5pELFFgRWUrHS+OhXX",
+	"Gq8D/aXM59DK0uzDMpeMJnMjbcYrDU29tUUc4iEE0MQOyUKKSfwe+tucof5zLuQpxImHl0/8rr9wMrJ6",
+	"eQQBCgciVesOoEKkyulfEPhiYxsMb6uCJqy6cUs0xVsDD02XEOvpXSHxG7jySnF9FG4tOHqzgN7Yi2kZ",
+	"boai1KSQTKlSosXJvBiOEcZuvrGNDUnKZhICt/g0aBJOl6opIeOteAYZVv9smlvmVJFc2LaFtP9oGCWA",
+	"NAGHNRtpBk3b9XXOD9s1xBDgdAbDgR8R/Ns3HXWBqOtb/fii+sG4PnrtkgORrt4kDQFhWSfYOm5f1qbY",
+	"ZvnmAFcIlv0FDOUSDoY2Wa3ANEa36haE8lmNCTgLIP/KvodMBwkTYGGHEMIq+M5dPjD2XN6cTT3q04Bg",
+	"mMZ586QKvjtlyy08eArKpd0AOcNPEgEJIuafLni4skiMudhKRWLiafOEFVptiTMmzzg73zLij+ezkdn7",
+	"I3sZ28KF2Ppfaplr+m5E83SUzKmkiWZypJiu+0FO2XK0M3g8gLGNdjZwg7wKXCDhtcTbHU3creGV8ZXP",
+	"zR5rEl5uG4xmnzTu8U/c0e+vjVUaGXjrvVuNqrpZvlRMRebUZq7rztOKuDBXbdQbMoH+FextN2Uu+7+l",
+	"scfoZS0FcAdYhS/KhY1mXfAc/9qOHRVXMo6tuE2AnDISmc58hMSmIryfKATZhiagCasEo5DWYuajxOOu",
+	"Wclm2BwrR+dM6UvKpMrq4dt2/xpVz1ozMsJVQgreL54crTzjmtvUy40smD7K/CrY2Pboc+lKBf4z0xm4",
+	"VaV3zRinayP4WnMma9HUa4zi8WAHu7yRKb5dwzXqEzjub/m0/4iP9DRXY1VOxqkwboUtc8Lv+hN+d2xa",
+	"HqdCq8Gwx+l/0WSFA8iPvnp8SV5mGejj1tZ5k6ulhZFClQB64raqYto8NGNpOtRX6EhIt93BY/PdxTrr",
+	"a7Z2j72se3/aGydwDxl3AGtYXs/nghSlBjdiHr48BNcE3ATP58u2OajL3ti0BcCtumy2Hg/o4p2zaHlH",
+	"u5vtd3E3MjTelXniydLwm6yeQWPxoAs3K29R7LeWnbFYfcnOY+QJIp00T06Zl4cFxCynpBDnZvJCno6b",
+	"2RMP1yM91L3w62ZrztujcjZjKq5RxE9p+0UVPwS353icI4RNrbUmQYzVAb7bcfrZllZM5rCKg2xzFBi+",
+	"baRkK/LHhfFWAZtNpfsSwa9rLXVuNCviTFthohsHhypN5UYDb1B/bUylpXrnZpn4GOXVUXiexnYVmouk",
+	"hSPY+l1v+1wxaghi64ybZtpsv3Vc23jbuAVzvtaZUOVFXAwbtzlIkY/oXq+89SoSK/uELEqlycK7oitb",
+	"l02Q+4nP5iZ8/4xyOLHrrShUeWhORJpWAfD3zGn7IJZjF+sjPG/vRfx4/v70ILg97cRuTxvHoDQiMjtC",
+	"UjxoUC09c1lFCx6HbQJF2TuuNJy9Cc2t6dKF+JzPecbqfcHrqiPr0l9YOlIqb8am0iMv1mePBh6aweMp",
+	"zVTLfX0QyWX0fzXyaCmXoxmF6D1/u3L5rdbXb99Ec3KV6lo7PKuE19oCmWdwN6OKUB+j1QitKNzMLBYH",
+	"RmoZgzr2b7Nuq/lgDpUJyrItukVTTzCuQ5WFmSPa2nHWVSdcEQboGSmwTM0elRnOsL3Ec1M+e+Pr53Id",
+	"qwwftxou/ioMFa8fIo3MO/KbkKdMBr4ka/+ZS1HO5n7PkkKIrBWrauhPuA6E4ZXDyqOxZ0YoRIM44Yoi",
+	"KslRGx3VY7I/tdGaVkxMS11KNiS6r3hBOaLnLO8WGON6WuX27sPRzs5oe+d4e/fx9vbj7e1/buDcvI5Y",
+	"udC6fttm7+HgjEpuRKPaLF7qVxBljsV8Iy1wuskS7x/kCHoEYB3QJxSIYitmW/LPIPkhEAMGsZh37eMg",
+	"2Md3+6T6Z937CTYIespsiL89RBsmiAV9h57DXcPThRRTbh4MMipnLCInNs2YQPtxZ+iXY150MLrk8qJU",
+	"85YxF2NQFFFaMrpYnZrw4RwQN+M/WGF9PyoXC4rACXV6MIfetcrrHERMW87hOaJnwZKw3sFvBzb35VId",
+	"msQZphR2Sb70QpLnsy2XTPFVz6FIt2ybjQI+69mFFppmlvwdE4ZXIh327KHMT3Nxnl+KmPbbDdavsafr",
+	"03MUHVqGqi12NdIVIiAE5exryQn9Lf6MWB869WB7zXXl+o+QriDvPWcecKeBfdOf77AqZo5fnP3X+L/H",
+	"//yiNr+z7fHOeHsDB/fZl9v/+X1n9OjtyUn69VcnJ+OVf385StnZV9/9rW8+q5vmimV+U0CETHuFoz7Z",
+	"NlsHUcAdaK/j/lAmx8FnYBwocXReXQSw3JRJH97kQXVd5+qUnQ+JVbI8dK9r9IkN+sZ4IsnsqYsgOnB6",
+	"Vd27i5DZcHLBUm7YYcFzIV1narPMlBUxCcEQa9MWUeKdYwRshxALKWFbMrRoRjWkXLJEZ8tacH5vFduy",
+	"jY3FXetyDIRBm6+CCVnGWM+vERekxZ38+br4NgIi0loJ2+dxv5Xt0WAZTG+TaGK3jdctRHPAQY9Rogfa",
+	"2YELREC7RXsBFvRdD+K/DAB3qtfrGyuwjdi0aZ8uYLF06lJ3Z3zvftRixfMeI3qdpUxd52B2H0VFnv0q",
+	"cujEAShs2HrV9Ok9Fb/TefigJkIiPIhf1P1wGufX/R6YPcG3FQmixB7GuSLGa9YofF16x5i8glhSHDV6",
+	"PhXTHuXTXqyGEB7vbdk8Jz++OCZbZztbriE1vg4V5lLml0415bihnoAhwnqv4YQbWhueZkq7l8g5zzIy",
+	"AXcoGOxid8tOFaZ+sd9Mb/lbbxSoGGO8mE4ZlnFgErCyoyhQkADh8cqQFcQpq/L1aJYxiVDWqrLqWozq",
+	"1rUUjsPu2wKmz9QuCG0zLJr3uxuBZNm1jYjz3MS0m02UALJwpKUfmfYhyPalpmcj3nrGle4eoGvWteFs",
+	"0Vw6RE+MEobf8aLf3Y3j2fX9kNrWa7e2oLkBJu1uD4OSh6QsUrNKZnRpjdbrerD64IouDvAN27aFuWs1",
+	"X9MU293g+Lrp/wbHX2WEDh3dA5sprElcxYh224xHCTlg2GT81hhbXB3n0OaStxctQuTo5g/zezbDboyf",
+	"Fb+6dJ/gEGim+0Qy0xmp3uEqzCjqOh06FQC1kQbg0MI6xpLQHD2J0fH8PrDAwEbxvqeuHjRYzWHlYu1R",
+	"TaOwjKyW0dVLw/WNrh1j0HhsdHWzXcSsOcMXnFkTv2yfDSZpNqFayHUjx572/eurojieYaboyGeK2kHY",
+	"DxoOtJ3t3fsdTp7RH0a52Hr85Ol3/+f/+1/Dk3J7+14C/8u+/vIr8vbvf+uVHM4XTGm6KGIjfZPzd0Py",
+	"5niP+NdQv9JzP24TzAVBMig/6vlXJc/1w/vd46jbuOqvhAseJnI6Iodjj3HBT0JpwGo2DuguXjiuJuIC",
+	"AkoPvNNwWNc90hT8wW2m6bDruuAd22Q9sakO5Owbbi2WebCfRvc0trHOIml7j3VIlCBTKrtz0yK8bNox",
+	"anblI7cdAOx0pBMLk4SPhq4eEnrGaY5fx2hT11xttzEKgXG0JxW0sIvdQfcuA6xdBUcVT3vXe4wZqyMz",
+	"ft3h8VWt1LyeXodmsP+BZMaf3RmypDoto222x+PJRtBaYxI6hISs4t77B7pvYvZozitmdsuac/cxhJHY",
+	"AVJULzZA2iobYqWPwfwgmsN950B5/N9hYiKAwA4H/tl6LOSOwQ+rhYqxlU3RdjwVXUhIzjK6YwuJASLm",
+	"3DXZKJpDMB5KURpv5VwIHWZtmp1a85F3gnm8Wnt3j+B6uEcgi5rp22VegSb5l7giE6gzFJEDEyG00pIW",
+	"h3F/UwgW698lKa2qGlkimV6ch6Wt13vI9vVT9q+6H56L5NTEDUM3borOFi1gdJUSFpmiWY9SspddisYv",
+	"5lC2L9kwq8qy5WZnMaearLH68GkgpAulW4vqF4dosX5uQQ0VofRo5wGbpru7yXp0tR6r25xaI0Asuqyb",
+	"JT6uoJmPw23cKedUrWqKfAlRhxbbdUgOAo/rkNhY3iHB8N2vagQMX111N/k5igjxc4AGEeGJqptwrVd1",
+	"Y19Zvz3Wc2DsuKsFgMfaDyA4Q7tDHoaEuhDQOcViJFNhTEcRLHFXo+03IWMJ4/BzowuICDWqjN3+QyLZ",
+	"jMo0q3AouSQJVWwzF1NwRWjZ3TFmlmTwPPBD45Ce2N3Ip3iewRmHr2Z8wcHlGVjIbUmmuFpYSDbl7yL8",
+	"Db/HSAFh5XBw+shaxbWRjmlHfHZ7zTFu+tAj5jcUG57K7zORnEZvfhlXWPBk//khmcBrhOcYv4M/5kJT",
+	"h6Tn1yO4gH353ePfjR33/c7w3sXJyfir9/cuqh+23GNjFN19i/+89/v2aPftV2tibWNha02nTjW3t4YS",
+	"ImV7QqYiD6Lom8L53CG4cnPrMC+z9IlRttFBinRFVsDHyqsD7S2QSsrz9SG/L844Ar0zxE0IYnZh7dEo",
+	"y6V5SlKuZAlfkkmZzphWPhwwGHKXgdDnx/zG81ScxymQiQAurDYargh7V2CdUayqU+beSWBZFIO2zTH5",
+	"+sh8YC+6CO2qiWSgQDwhVCPw/e79RgGZ3fmgdof/Etji7xDTAP/66rsvc/WfUv1nof6j/rP4z/yrr/7+",
+	"t567wScu74kcI+RWgr+swJ6K5YDYS3M1l2NZrkR6qt58yRZCLg8slsigZzEQ2+fblVO1sBixJHckQSdM",
+	"v3teYwJQb1N2Bo5/n9TjcE3MiBwreNQSIiRZwAQ9WspGUavNJYuI+M5cdR9Ctcbgm1OP/AkXiYo4q6nb",
+	"GbJmKbLueo13Brwtmn97SvoRmF2kZcl6h1tda88gsq430st2bGNVaFfnS6YN4+AA8THiLUEnlwkGC6Fu",
+	"wn93R315vM4OG0REua9Kq6abKetOl1uzIcJb7AWUYqLmJnYFQAIYtmuHJKLgYdUNnhsPFpSsBJWnwm+x",
+	"9W9NToGySjhVGKg7G4KmMiSSJqdfNZALaHI6eDyQO7uQM4oI8zTXdJRkVNJoPL9kGGrUA3LXLNlh8Lr5",
+	"WmRsjczuY7A2BO86VVZmJ7sbVoUOT8MweHdq+5yWoMZOmNR8Doe2w2SLG1RbZ/yLLiy7tZ1UZkizpi4Z",
+	"i7X0I7iq0sslJ5u29jsGyNNwFOEZ1Amt1q9ABk4gjo0WbbrMDSukpU3FX3WBbhKnh6vR0iCYQbPHLsl0",
+	"IER2Bzrhr+PVPQxWwdki4U4GQoudMbmsVWgwvuJGEoF5PDISY1zPfZoV5eDx4JIwK94xbcw7/N9lqNlH",
+	"MVhmRTk6hyQhtSEwVGfQ7yapTG/e7D9X4farmz+BbLVKqx1gwBX+sDejaoENDs0WxMpSCcW66XDpBcOC",
+	"yO2uL5iE0iY8oWNiHF6EJgkrtItdcqNpwCZ71bKi6EP28P7ubnJv9HD3ARs92P6GjibJt3Q0SXfv3dtm",
+	"29+wb1j95vH7+7ffmSsHHU2fjX54+/7bi9GX4d/3L0buPut+2tm9+P3i7XfrL68NDXY4OJdcs8qjBzJi",
+	"faryeZhHxvM4T+/GcoVXoisZ40uselewxfCVfrurt6J/bBqt0+rBdj8XvKfWKmEZR6rN7dPNgDnNF/2A",
+	"at3bGLdyJ7A/vMC+tq117y+3taLce1hXvxvXB7yaJXOWnDb9QeHxZ50p9v5i7/ThRw0HFm+5lsA0bL6I",
+	"YeP3M9s0Q4FDQPDQdvHGXRBfgafP/O+RplKXhcl+NfdWIclvlJvA2R+EDAkUHuO1ZjZBAnIoQEA5lmvm",
+	"i+5ZN3o//bojFCGmsbZBaoeIaGz/shY85Rdkwsy5jnXHupBmw3u2v8PVStOsM9fFMUR4GtdZQpWltlH7",
+	"Hv9t1hEZq9lwBzTLBk1RdiiyGrOPSS0Z3DQCER+GhjbgHIPaZBmrz+dIDaX8FsyIJDWsS6R/ieAGY3+1",
+	"etlzBlE/LMXv8X3TkXdhhO1CZ95hX4vqj4fGK4/YDM1QOEiqIp3hN0+gwLXpwJXwT4XZxSGzIDlDohht",
+	"CCZl3tIJQO54dofP1plHwfQCK9elDaA4bPEVg6DqEAvolTjCm5gZlnHVMFn76ZV48Y4lpWY9RgnYBXWt",
+	"ND/jKafjRCzgvGoX8l5TAh5O/HqThWSK5fpyh/gfa0/xJmY9M3vb0q2L2r/6jO8f0MXeb0fXrQCdN3+U",
+	"7ptpb9WI8AxYG0WJLGV7Wj/PAykmGVvErO5pRCArbTGDqhMeQuPMJQnxdVhVMDij+aykM/9mFe/XHx4L",
+	"WndfDhGwh/9pNMPEmC6C1LhncN8a/eI6nTOaVnEZVnO8VFFFa9mF22WF/gNpGE6tvUzpxDazXVy6VGKU",
+	"VTbyGDXLwZvpvhL6BxuLY/783v6b56qcTnnCWa73irLxC3qIeteJd2OKzeq1SwiJOn1FPhs5Ce8hR9wX",
+	"AbAeJpk2K0K2c0aqKj5rsMl8Kb4gZYXQRBsnIrqYP1jB5DVxqtVwV8DM8bTWX1nytCMYpAN8wDhnp7RJ",
+	"oJmoipAcVKE6j1swZtYZ11GhxGuKjlGlB8FTZZIwhvUCpt0geKsTeVs5IA0sGLsoqEgYvbOwJRc6sn2b",
+	"+wq/94kYVQpndKzWK31pwL5q6YZB4Sinm1cMFva0cifGLRN+lfsfbr7FfrYJGzW117VJK1QTlNYhnAVm",
+	"fEyZlCy14YYBblBX9th17rto5YvADNi3Ds9w4DHGOrQQBCIzAdD1APg2zFqtUKoboC/V2YQ0632yxQL0",
+	"L9YCN/W8Jdo7Vo/QYgcg1RXj7ubVCD+NIME1A4iHxLo8Ysw0rDNe5YyKSBBz3agC5bmOcseTJuQUWsh8",
+	"ddE8cVBD7R7C20vFN8OBH/8gWAcUoSvE5lVFUZiCZBferuhlBFJdHsSlUlF7Z4NCLbXvesqnRnGXztTn",
+	"Faiu/mZU4bqusBBVr+9Jqua/CFGY8vOvp9P4dxCkp2qL1zMcIQ8L6gdNRdcF7xNYt1fd5rXiibU+wodE",
+	"MlWIXDGoWEShZQvr5qHszNaoqrtj3vjulihYTgs+/hcWm/uIbioXcXKbf3YG9yQrAZMioEb16Nme8TTu",
+	"6++XHoCry0PQo7Ve4yyYrMVYA3Na/TKMliZCkkDbfFK1UAfiBo4AvFsjjnNrAa4eQ/uR4pyrr4+NUKLV",
+	"aLU9iQ1Nfb/sV9ekHf8VKMBqDeCJy35ujHNIlLe8OajvXnLVpQq/gQ20FuskyGpu8FdVB6tOjHBqb7t3",
+	"ixtHeDD0zqNykMgrFrJ+R+hC07rXUS7GtBALNamMxvalutn4wQP68NtHu6P7u99uj+4n974ZPfpmsjO6",
+	"t7PzcIcm25NHj1gfYBDb+5oMJZOhwfNO5DnpHrsRAwilrACdUjweXIgQ+EpiJWRpMjcgRYuyWMdbe8Gr",
+	"VRpNx/is1oXdYkIYdGV1LyM21DJPWOqcOdSn3LiwYfPknMqFUQKLzaL85fr0Fksvl6UEsQjg8nSnQx9o",
+	"AOwnvnwIRP2Sx68tR1yzERxgZAGvoOkEMv4TD5fdXi+Wp4XgMdDJN4e/+OMaWqw7PRyKr29acc3GMILH",
+	"D7a3G3gmu9v3v61ZieHz7x5vbcU1H2yzIwwytOzg0Fjqp4gcS3MiCjzMiCuBVo09hYyvMRebGsVby2XH",
+	"OazoGF8878Lb80mkHRU1q5LN0w7vTTPup31IOWHWQmOrF42E1ryTrXY2O4caU/VBIXFjjtaCychYK9dR",
+	"JJzw3ZvueGLnLJem4YTl2mqP1eBXE8ptAD8AU5H3jNmooFzU0wrtZNM6UP7O9vb/rjPO/e3/3YjjgbSB",
+	"/90dAFX37K7IiqB+RAu6tHi0onLFeQhk5SZlIde5bk5hW5GUKwd0y1BmNme2aEIcL64nNSLwy+6tSAIw",
+	"Sk6YBbCgAPPt5latsrstLxHbwJAKBC51mSsaKVufH6QjkjcW4VNI8gPNFGBJkgf9U57ftGayDpT/Irr5",
+	"IwDQDQ3l4A3sFpvI4HCFMqwtE0RonDJWqEpHgZwe58+wdVBTyhYiV0PC0hkjdMYg3iNPw1yyaq6RCtXl",
+	"hPWAqIap4NS8SRBHcKmPOwjXerFtRMwJXTgFr0FHu3WCif/blge0qKLt2SdFWb+oP9jeXtRPiXu70Sum",
+	"6bH+6c6PfO2XsXkfHf1krptKdZ0V30tGT0czqIt5dPQThC2qqrRH9N7SeSQM8Sda6rmQ/E8Xcy8k1nBK",
+	"DG2m4PNXRPFZjpcISrQswei496xNxaoxU6ovoqwc/eQ0E+iM50H/f8BPuECYEWQEYiZm8BqKNDO0RqUO",
+	"peYjlu4+eLDziDx79uzZ3r1Xf9K9neyfz/d3Xh2/eGB+23/98t//zk9//VMuto/SHx++eS3+/fMvik5m",
+	"Pz3YeyROf+Pb6Xw3e/Tjz//IiCjU/7HtGx96V+WPnYf3vr2/1pe+KjJqOLC0fKOY3HvWTbK9ZzWqodXc",
+	"rkl7sQD63wW0OiFRSJ4nvKCB0hB8cxmS/jh59GLvt8WLP6cPf/i/E/n9Px+df5Op+f+d/1ucazn55fkP",
+	"5/flfz1798/yBTENJvQmqBqrjxKvTmaIDBNvcjzCQitNwZg8FXJY3zQFVepc2IxhVaaifuRMzKaEPdlA",
+	"L/S/D1rx1H+8tSHUf4zevt8e3tu56JlseMTzWcYw7TQuI/CNEZwai8BG10sgAGNBzA58L8tcRVQsl1Li",
+	"8hyUrfFSe8nexiDukKVDjOtROS3UXGgkuglP1ix38gsvMSlXp9ADJZKBVT/FqG3JjE6k8BLqlKKmuQ5f",
+	"sgnnXrNdMWkMDWfvKAD0ityFWr1uBS7B4XxPdQQwRS5YOkmP7Hyf8whDPgdYYHNeiWmTAD6gqotiY7Jv",
+	"Y9snZsXMjY9KjUluIDtdKQwf0e4QOzxVnWiApdbC0S6gb53Rt86o3Mr4ZEvSPJkzuXV6T23h7XcrnWz5",
+	"kda3gf/KqCUjkw22ZSY28q83r2IPartl6/dno3/iXhn/sTV6GwcZD2l9yDTL4zpDFYjboK2hVH2yD2qz",
+	"eLCqMla9snC0NlY4Ph/n1dYGpci9w8oxRX2k9UGigviQzEXZLF2yTb7eeki+Nv/fxDPbjl68zdp3wTj8",
+	"ZhiquR9TAeE2zHBMheCC+xNYCup0jMlvZsvZAnDDKty0GaHBlXspsMVZVFXcGDVKECWQzz1rx+QDbHfz",
+	"gxdVT/C3XOTt0jF+EgV4/Nh5ndpBETsfz4A/gXEzh6iAagWqZ33kegO/bhVMmgdgC0dydPzs+M3RH/uv",
+	"nu/vPTvef/3qjzevjg5e7O3/sP/i+WAYef7i8PD1YfTJ/qs/Dg5f/3j44ugo/vz5Ly9ikYlroe6CpMfu",
+	"zPEwAMP2vff61fN9O6mfX73+7dVg2H50+OLZ8/+OPXj1+rjz2cHh61/3j/Zfv9p/9WO80ZevfzXP+gRi",
+	"rkjkr4H89eEHPHleUi35uyixWmDhGwCZr4AaX2vpj/Ycs30dMyoNXumR7ozfqQkCbd/vNOs00sF8GJmx",
+	"9rgiKO7XITlxLuWTgXX9h2YueJ8ZYWHedF/7V43ImPIcnILWi++w04xqYr9g6ckgRMoIaxg7Vz6OwYUQ",
+	"Bf/s8Nw7f8f3pTEhd3o79kCv3Kgo017wJeJvxv0ew6rigyWYKlhibgpVPrchAkoj0EOgKFeSlanVKiCi",
+	"meUJ6pJPCAeQYJfo5zrygzDL4E6L1wuutY+ymFPUIieM5cGYl0xH3XthtFgf55ZPv44WUIgzdc0d1VWj",
+	"3VJutHFt7hvJiXrFzv1a4ohihUPCbHo2wy9ZOTpnSq8vc9WYcA/SbeTJW1lAlAJi+XqHXswXGlx67T+j",
+	"BT/X+ols572dkJ2DhPJSNJkHZVEp7gOaL8MZ2OfupmLR3RB6XXkYBai/bnRTpaULkDT9mLOstzm0yxUb",
+	"ocOayk+XIAbNMjdXdcXJ+sXe2V0bR7PSpeopIRYF1XzCM66Xnac0HGDunuhCTKPQOF3A1L3q/MDxeNAN",
+	"fH0MuBtTSUmVdNNdq6LZpUO/TsRiwnN7k97Mlwqdr6dDfYz953+zVZRarR8528Bq73CkO452T2ddaKBj",
+	"dJsXYuBb73rW4FlsXAqGupIrqwf2hIjqxDavuVktQHW1J3izrXBa11ZcxsxTbFJg5sNMMV6ypmfFKjuP",
+	"eJ2U0dnueDtWU6Ze7KjdfKwmV+ykbQqDIJ5+WJnWalWx4CwL6149IZpKxJgrteIpq5EU94JavSCgj08z",
+	"Opth1vk5y7JN4Yz7VnFaU06rU8THxN1KKdIS4GvqRUXPoHhsbS2GbKPor1rjvWm1bsCdKHkZVfpY0lzB",
+	"c+M6rzvWoB7vNtbj3d60Hu9lIfjYGZP2eG9Y2u0TvIECplnl2Q4vgS9sXUhXwm04gIvH2zqc61QMhqvQ",
+	"pFxrFv8PHNuD4eBNhTRWteYe9gAJRD/CIctTJll6DSCBq6tKUS5/pGsjxJ7BWzg00yZ+VcQO271+sS+V",
+	"g8fdbqHYYM6086J67RM7cxWs1NCGjqARACkx9rnHtUh/8JxUPQW19P2gjNjCDGb/pbGhGlZyHXNl+q6J",
+	"eIvxGYG//qgums+w4hMCz2thDQfmoHWor61S8YD4SbWmydxq8FUCTteCgrtJuSY+hkLzzdo2RhaxhdnQ",
+	"11yD3tLGIvCu20aNt6vvsaJBWSVsBpOhBfcqVu18G9uPx+9Gp98CRc92JkxT4ww5BTDrwc/Hc8mYCg2M",
+	"Qf3DEFYQUyy86hJmDIWqkPvtVLuGp3zm4BvQ91Q5QnWmjmgOWP7GYWC8AoPhYGf3G6MVjXcGw8E2/Gt7",
+	"8PYC/i9G4JV3NgfXgOUBnURuFf5ZvU1qe/H+9qOHa93dHTcnNxojybJgPBjlOBj6B2eqmDMZz1qMXptu",
+	"qKbud49HX3753ePgt/+Y/3HldN4i6hX+G143LfR+/6uvv/rqO/jo71+GT/6ODdV+gnejEm1VDQtHcFtc",
+	"In7rw4ocTWtVh+EN67RUBS2sG5u940rXEslqwpBr61bzXw4JTVNrncYBpGHdjAD2JxiTGhL2zkjDOuKT",
+	"WlE5BDzNQVr2BuU2gtLvHS6BX9zzpmPAi337L5yUIwlVJJV0aqPdsERI5ELj7izKHhftsoJ+/5jWqqpp",
+	"oBX5wnNgtl/r6VrwHDGOgtC/SwTWuUS0a8Hqu2wF7w1wUtvxzpX6eIyxTuZKUWp2KUUOgG2wGQhlLHUt",
+	"CGXfFyFVvpx01rQnwQ7xXVELYFMlcRoWx+xSjIZZMKpKACQUQkMskM04NVRXFVL9el1NxuJB1zBF4wNs",
+	"Bf7oNIP82lFj2X049BLKK56197gycUN8yo2ae8QQYp0qsj8dvaQ6mZvNAy8smzW5Mqj2nYuJSJeEZYr5",
+	"hkwTNihzwWhurmg1mg12du/df/Cwjw/ZhL1RbeFeGuGRVLGH9wnLE5ECh5h3if+gnRxc47T/Cd4fTTIx",
+	"IaPRKVv+D8hB+NBG3BV0aRiu2dzjhmBX5B9Hr19V1w2tgqFgAD5NR0C1KWdZCknejPxPskh0Fsgt293/",
+	"WOO30RKN1pRWrdno/Bd7z4+eDckLG7gnJDk8elaFuHnTV6PAHWogoRDxETd/33r796fvt4e7kfC0GLqk",
+	"H9GvTGJoYZRDa9kzXasDZe3OoJ2aLLDbH6bVOGKx7qWsORFzUf8EUwdR+627al1foDcBqiFi5sKi9xL6",
+	"ygfnrUV/bobxma/VHKN/137cCBPujTntjs8q018BKt7z6Gn8HLh4ijANgKjqpKbSPMtIqVoO3cAXXp2X",
+	"rvC/jeCv2LCKzsOmgk907aB3pj7PBG4iz6sv6oaXusVoZ3d07zIWozMqOWRTRKymS3Or9y/0zcJRzMlB",
+	"jm51uMouLUxAklGJ+kzVcL1EETrvwfvuW4oEZ1r4toqKk6Ur92EkOMb7bmQI/NUOaH1I89mN3yJGKTvr",
+	"UN7dRWfNrH49gtfc/omn0J95I+9pr6Lqod0rbodN42XXV400Vqk98BaEfW20nq6hVfjBzp37ImMLlusO",
+	"30HiXLrQv+NJlmsuGZh9mgWXCjrjuVeb+uAbdJK6q6boUQ1OxSk+ikhr5Yx4micNbcZuqiw8Tir4kdoh",
+	"JZkxKjmIpv71UPZatVAqzaTKjU+ZVL86jN0hqZtrYbeHsgGLpadDH6ONCVF+GERBajn/08ruxcaSYGXF",
+	"lDXpssaXX1+R1qHPJPPLNCRK1HX1CasLV143k9bO5XqqbXdl257VWXxCfGG0sRiokWJQMJ+U8AYmtYWR",
+	"DMm8zGOZ0+xdwSVTz/SK5FDXtn2XlDlsJ5oLa3Mo81Nz7NICADjS/tUSeiKISZYwfra+vu1kqWGj4du2",
+	"pC1G2orpVLGq5gJ7p3HcTTHw8H68Au6c7j54GO/fn534ks0WLxe9XPuK/8nWNcv/bCuoPMfZ9hp/DOsL",
+	"OvYTC2g8DHhiPS/uGSLGGBK5ArxTftDInG0mdHbZNhEmjVuVb9QW23qws/sz/75GBEOWBlToo0fbD3bX",
+	"GjqRReLjKAS6Bb3WjzyfNyQnH7OxvVn8yZqMGF2qVdjajWWz4xsiudYvTWdVuJpT35Y9gy8Q0aRLVKza",
+	"AyYGSkLVgzl712cj1O1GU6ig9/D+xd822yMrt8bQc8kOecm/H7o0vupFRWzMP+Q34w0qKAffXqwq6+L+",
+	"tw++ebg686KxfPUNt3L5nKv4ihA93aFtQSRHDyCZ1eEhIS5CswOrS1QxIjvgA1nv6K38Nr1i4fwNYc1d",
+	"qSGP0MiGLkF0fCKgQBfypzfCt8xe0EQztBALywE+NLRtHrqRQLEQMMsABUgrTHCws7M9uIQ3p/UBmhii",
+	"IwYViOYAcOVHtjqubo3OvlFhUqSHpRx1lAjH0eNk9nuyq79WRzmxn9e6qoqLPNju13F1mrS65vmNTNUJ",
+	"m67+ek61R1frCwD5LdWAilAFSxDuPQ8GU1kD7OfOFuAzCS2aIa3t1Q4g+XogaX3bLOi7A5FG0l6fjf4Z",
+	"xBeYzNeHu/Hzxo6tPX+wqdqnLkSjTvEoZZq5JC0LSUNwYEar60dMa6YeqtGmxTgo305qklxIYuUTs44o",
+	"43hbNt1uftyU5CIfYRlYGP+l7TIHZqTrM6P9erSLVszKjEpzv5D2qrF6vyDHLEy3Hfjp7ihZV6r2kEHI",
+	"RT+Z/YTwWS5kFYvpx4PYgbareLhsE97XW7Ldrhv6t63MrgVVVW/1As+El/qcmLh2Kzg9vg0rq6sP1q7q",
+	"6FcPp9GCDh17JziYQ2nVBBBu1K5I07D0AvxlwYWibuCC6s7pCkNkWZdQKfpHGmnFRshtudHiX60gkS1A",
+	"QcG/tyywyjM5U1ujumyKYlFhmGrIL2Hum68nEQ/4AIoedyptP4rQL5enTLqlcFPGJfFqoxc/T1YJO+U/",
+	"p8r8hUlUXGGl6ZaQHhUiVU/fvydjK7HJxcV6vRDJYpcxxt8R9Jw1MEBrUIC4Bgwg1QQB8hBA7XvSNUdN",
+	"rgVx8nn04ZwsdJHFawrr4vgJ1tfkwbVhMXWkVwS5FPXR1sdxaE1im0Ci1QNDK5pFOQQDm1087JVqH4Sb",
+	"8iXPhTw65YU12mdMH52yc0jPtn0eUMCeLHPvh/p5hXX/8uUQ6i6G1kqc7TEQdCAlFwFg7hmXuqRZG+Rs",
+	"rXOpXjsR2kJvSENZi2KopyzXnGbqiCUyZoDB333JMpubNhdZ6gRXqZiEe6hRDx1kCtHCAfhoQdykIegR",
+	"IUOqnj0BkHD1YH8I2mlLs/bNjGqaQCddmrOsag8bYenP1urL+DKEAUe8sV0Q2SIZrDVvmU4QFOASo4MP",
+	"u9ikjlQQ/WSlDXQqsnRzouFXnWOKdZRXkaub9GQ/W7E2Is9ZYkPoYG88/2nvoL5Ov74kLhR27VI5F4Ur",
+	"h7vJYN23CD+4CXUwojViy01TGUC0uo2ErzcyjJCLA1TO9ZPtti6tnmhjTvU6HPFlyrC6sxQNU0s5KXNd",
+	"jnZ3t++PjOg2dqp72+OHPQY/LxeTQkahTI9+ejbaIdUbEeyrDpqS48ZrPIhGgVx1pWsBKXvP1HoJ1bRH",
+	"4nLX5Fa1RYarU9HtaRV3NZ/VH8aQthCZtTfQ1s72di/UQzsslq5JkV+fsbAm2QDwvOpRte1629W4No2b",
+	"q6ps4Hc2FMY8wmoH65fXTrHdd2w5f2OTuRCnz5nS1j3ephkkOh9IfkY1e9UlSMPoqrRqDfRRM5DsjOGx",
+	"LApTfnlICmzQbPOM56cWTJiiyGEqfpeG4Pr1KL3BAIaEkoyhY/SLr8dfoPGAQWI7UeUEMyQaWMNCnKpx",
+	"CBsXu0/yPGfpAQDkxTH0MDBw5FxYRioY58icqnmlYakS1iJA2jOKk4iIDNWmbak0sTXyhjCh4HUvIqxa",
+	"ZvE6uapQ+vi0EhkbgA7Y4hWNNTg+PjgC7OPIItTIe//+vbUuE5tEAV0NowzYj5m7ImL8C/2TE9uN9yv7",
+	"0c6diQYge12jliQztAgnhnsLkdoYLHnGE0b29p8fkkkmorjo5o69FvkfezyEsFynB/CEbfphFKZDsaQ0",
+	"aYoGd2yBTRoWMf+dMCqZ/MHZov/x27GtOIO5OfC02nFzrYsBZM1wG7nU9ERxRVKRlHCfSdkUTn3geBiu",
+	"zzJwhH6J8Zpkd7xNDl8cHRtkHpA2XGNqYvu9IC7r8WB3bH4xbmOsAzJ4PLg33h7fs8YJmOrWgmnJE/j3",
+	"LHaz+ZFpFR2VGxEppFgwPWelIrYxM0hfRms/xVZe2o6GA1fPBDrd3d52WBAMVRRIxsDY2K1/2bRTpFAM",
+	"HKp17r3+2Uz5wfZ2F3P47rcebG+P9nPNZE6zI9A1MBU1ZIvB49/NDqYzZba7o9Zb84opsQKAGlvsnREA",
+	"auu9rzpw0UnQ5+I8h4B8m8liviQTgDMKYYc8Lik0SIKWA4BrE15l0ZJQI7PtGNlJZn9yyK3UVE5MZJG/",
+	"EXtIN6e0DitD/xBxnSB/Eze4LVZbpsbMJGaxSh+ttf5195mhywsky0FQimGDtTfjr699FUDBcwro8j25",
+	"4X4fbri/vT36vopJgM/u9/ns/sgXl7wy55nvd/p8v2M63TcHlREnLEXpZtkUqD94C8ZkSRcMffK/v9+8",
+	"yAY3RCzQKGRzEovacrqjEAPHImsVj5i6eFvbQNbaNEL+rW0kl6Wptt6bAfTdWLZFtyOqoAOCzTTYONxg",
+	"G2ylEDPN5sa2KqtjZDEze03BXw5oxG8bRUQDzD5Pwxebohe2oWQZO6O5bnjPwoPYDBXeVXMq4QeSCClZ",
+	"Br2S/ed+IosxQROWIqo0ydyqLgEk1hNxKGarNr2FbECAtmrv2zmoV+j9uZMDd3IAr4ThYOId5Y5luvrY",
+	"JP3vEmn8lawqOOaGZWK2XmGyhp2RZDTFknDuWycijNTwosQVSsHzFlOeMGvVZaSlwzAHJMg6dZhopj0f",
+	"1hRCGtkfyZRL1Xlih5O7opK2Erui4Hu+n5vT3/wWONgnz63SDcMNdLdSz//cUiybrl/MQFabP7U4ZZUp",
+	"BHDWEGbNR/jXUfeGhBmfJA2vuXTBLBB3WCvPJiRXGOyGQYYezjfJuCECRHvPHToQTaqR2cG4Yk8V9FvB",
+	"pIvgiq2+ocWRIcUNLv0LqFjPz9gBkwsOYRTq2oX19XGOXQPHNS0hGuuiemXrGU7VScmfoDLiwAg8kHFY",
+	"KbGScvXuQvFWycfv4cpJTsrt7XvJP347hn+wMF4K76hdAiyMz+zmd6M2uDe/ACOPXHjrSIR39qrqdg0K",
+	"RYDWbMhbFQuKeTFaEMl0KfMmOno16O8KOmNH/E/2dHfbHRT/LplcVjR0b9TI5yMxTMRPByp5NLS5VbUu",
+	"T9k779sxohQGH4zdgr3SDIQvzc7pUlnnXE4Skf+rzGGrVlL/CzfkLwjMpd/0zbrvPsRo66c7XdTw0dgR",
+	"Wmw8+WObk3BAZ+w4lH6FZGdclMokLrGhRZrRPC8xvQlKB7vZgsyb8szpuEKmTH6/BLolNHflgkMMRZsW",
+	"MSZvcvyQpa5dPCn9H/7xZOnt3hBjJhmMDR5U3myQqfBCtPwhnSH4BAadb7IshaPQU7b8x9n+v8Ty5U+r",
+	"GBbera1SREdqLwbQzlDXjpjlWnLj6TwZUJWcDDwSM/4hISqG28gZE9U4JbnAQhQWwNgoGO5jjnw7PslP",
+	"KhA2q5U8PslHYMU2/20l/5kfnXMaE83MLx7bAqpenuQVPdFyrxKLBN0SSUpIHU7QwdPC30usfWM/RpoM",
+	"bHhRc6Essz09AeITmCe6TTpCpo+EtKd/s+t2p4CygQ0h6H8u7IOQvuN+Y3PjugpRqq83ogqyy+DiIs6v",
+	"9u3NuPUH2JgNSkKKvr32WolgueammI58CZH+FnJ8hHYzb2gLqvEi1eA5F/lXpiXvuf0SSqGgbwYrOr9j",
+	"KbxS2D6q501XWbSuL0vrzaDkGr8/ZcuLaGvwAu7i8MuT3JFZ5O5nd4uoC9Rnr56DOEDwjCqGzg0Ty4c6",
+	"XAYnw0OlYHyS/2Yftz4ckmk1DiuG4/07rBARCGVMc8EpKpaxRAtZF9RAi1YNeTNKYJyGXLGE+APH1N5H",
+	"+HuffJRGgojDnT5jwaKw/OxpIUX3Nsfunp54z/XTZrOGOJYFXGsoDRZlpnmRsXVTmSz9esCG9mdvIdmU",
+	"vyMng6kQBpffVv8IaK/EVJ/DQbEz3v1m/GD9NEwPT6dCfE1eHwab8g9733x6tgsN4QwwecmO/w/T+R+K",
+	"UZnM/8ChrV8dTIbx2wknNKeKTIXoP9au0YhSrxvQD57GId8DnS1d+9NshZS1S7xKyL694jWtO29rE5TY",
+	"nmnyNb2xI3uxqVaab6xKSScKzKW53WoKH0QjhG44I98p+DlhSvMFZmjxjOE7M1cQzc1Fz6UoZ3ML8Ghe",
+	"aCupfbP8awGWtVm2Pcwf65Xa3xSv7Tb91vjeY6EWmOmvAjg2o/EW7th9ZqtyoP5R6lK26x2FmZdOKwgt",
+	"9EV1hhNXklHzBYKpMppiLPq/S6Fp09vgDPwpMw2zPOFtRDyIBzP5qSxFlRJjp4JeLcpdKpeHZd4a/plD",
+	"RYDePLYDdXFY57Q673Jx7sfk/Bhc14CpYTx4z2Wpm2LbInAg1AYmAUjgVwydGMaq60etglHX8GNIxk8Z",
+	"oW5U1mxm3zajUytn4Yu3mD9DlIeO6x0S96nG+PiYtMY34tfsDrCzi+Faft9P2aIQmuXJ8me2DNjdTvh7",
+	"kS43kvg9xDkAhyLw543ZAG1Xz5FoEUl1HCxeYBetreKwxYjg4AuX1DA5rowHDL4YDna3dzaaSU+/0u72",
+	"7rUR6AAljaVTF4VCMWUr4mHQgBcPoLTrUOKNr+QCu9fns3ujH4Sc8DRlOX71qM9Xj0YmDyDjGId0f/f6",
+	"iGnyaCw6jMEqlWKSsUWMpiYyT4W5HyowSJXKErfp1yUIjI53kBk/YzlkZ5vvxzd1cDZMuVtYPMp0c7MH",
+	"6j70g7qPg18N3d9BvbHgpLPXXZFX6QGTJfmR69eFqrwaeLIhPl3qtSY95wpinkjI8RBLWCrWxv2qJeg9",
+	"sY3CIoYZIVT7d4b+FqTnbv2g8KE5TGbO9WPjER1crD0l1xeMWnUuIjEHNyrNbR/XIM8/Qu/6pSTLbWxH",
+	"oxSMVDmbMeWClKOeliN8JVBQ8R5pxpQta1bznC54PnO+woYm6YrZYjFhc+mYLIM2hYwor40mTLk44nEy",
+	"AJxQ8pppCLfGBMxXfIqltvweocaZwyRPiCqn5kZuU4+9+cGoW+6RwkGu8SSZIJGjioY9/EqToLSMpT5L",
+	"YQhWAsGES1kI1UTBeOIMt+CF+sL++sW4Q90zPQ1WRB9c9029x05vkOtzuv41tx/ity03cXA6yDfvmucS",
+	"AbbXMOmR7erm19f19DkvbBX8h4b7SPwf/F6/K+JXAZQqiEsLCud+w0s4EXmg8mEnAaYhl/6ybmM0fODt",
+	"GwSbmwqZOJCeYaBHRq71qaRgD0cnclOlsNLcDcGKU/iGTCk32oYEQwPUiKprR1xV36FxwySxzKQ5Nx/7",
+	"OqzWs2LfqBV49T4T69AI7Mdi5pLsuFbeP+JLwoYlXms0+cFWiq3RxpegpqYxo1yb/wal9wTRjEoCJW/N",
+	"oypPLk8btW5dCRqTwoOI3XMA+kNDh7J45ZAom0tGkzkANxh7kLXBZGIJQfkOJMPObCZpwkjBJLcZDUUp",
+	"Z750f2sq3DqcTba+vTC4prpJX3dHRRYgJK9bWuqcfx5lOxwqns21NXLgkDRPmCvyQ56/+OXF8QsS2WZb",
+	"jcXVc5YHm6jpIgtcMBW/WON93Qf3tD7dtoTFTVzXBDY3IqURUeA0EdhHZvSuELuqwuLiNiBLlO+AmVdZ",
+	"guCFjQ1B6yaDxPTFlpviBHkg3CHDLkCidnFos3BKS4xu5tO2BdQgWTvpEKT4oayDBx5mgojmfhqTPeQ7",
+	"V5G00kud2kozwBf1ffRcBSdS1q6Ge/HaVyXGYpLP5prQc7oMzVLtzRoXMJchX3MnricfiLBVZIMXNiNX",
+	"W8Xd7QIMtRMPkvuGTvDj6RIKyYafLXbQfLb3zuGagNVGtkP/KL7NA/QvZwBmmvLMVhj/5MP1b1QX/pRC",
+	"5JuKhNE+y6JHeqF9sZ2oMyQ5O2dKrwxeD5n3e9vlzfMw9gSpu3c8/Bfg4S7Tt1lnRcqiKVTN+UQRmCsn",
+	"TCcpUTkt1Fxob8M2Z1tHyWrMMgMWQqufDYBa5slcilyUKluuPxyDfRNCPNhiOGEdTXsHMB+Yi22xzka9",
+	"ci/t3sxe6nJ3WTIFasP4r7C5OoQmoMBcm0fnk956rTAOInJrv7F3NykWrRMDLb7CbJYMMSZoEA6huGZ1",
+	"9AIChVVzZtDqEhvpgwHv5+F1yHmwIAJhsiQHr4+Oa9fnNurY0IN/WU/RsAIobJd38DCGQweUhDGaviDE",
+	"sIb2BBUIecoqr9Teq30iCl80o06XJ2ENQ9u1D5+QjCyYnIFpQgsQO/gmUqIZqFJv2SeIIn4RjBID723o",
+	"c0JsmwC71EPw7GVYMfEG/WPQhZcKFxdN/m97za45dqAJTe7Yy8nwD+Cuv60b0Z2P/zLngrs+r7KC74GJ",
+	"T9XtDO0N2zQcPA5tFGaj1kwT1YW78tk3TEWnrNBOVFS9ti0WlTHJWn6qJXDWyX5WweeOGK1ten+N8aEZ",
+	"lt8gBUh6P5TP177w2d9dEbKh24OvJaOLqLUFUV59IVGqLGzeSLFcWyiIMXmW4z/BkFequY3z9CVHG56A",
+	"YRumvWHVVbW6Xm5MdhQ2WBQViaenQR6csdzjNIJkABxks63OsM/WLfwFEq+H6x5H5HpyxDmxULknA6Ii",
+	"hO5DYZPNVs3zZLDBRIfti9uw7oHy+KuV1V1kac1O0WGItT+P7A+Wzb5rLUyHfRbfixtoK3jhCsPd/hC0",
+	"+/aDBCkAQ1hbyXCg2TuNMx/h8m7uFIeZQat3gB93AjwqwN9plisHnvdZXaZjaOx7Vlgi7pglTczgal6g",
+	"qSm+jC/Togi95LaOsP1JNQCIzMUYfc8glEZVT3CfRB2x+hHCOSeM0DPKM4BJ8Up41GzWujM3BbdijPz4",
+	"Au/lFQNE7pxl88TyL9/svTPop9etc/umB9A+kmvc0VD0LRYNUSUUWZ6WWbb8KxvjsBLBegcGvle/Xjqz",
+	"DCA22zjiQqRRL0chxaQKDHFl+4P7kwuK9mDeZEY1O6dLg2xvY7Mlwyxcd09jS2f2pcroJ67AHHRGuCHO",
+	"Gc3C4djN/aR5dTPN2HuaG2lgjKaqFlqzXj38Cal688xuO7rTEO40hMjmDkDuVhlVDtmZOLUnZ/AJ4UqV",
+	"bbOR39LOVALAzsbpUn3rtuWCpmB7gmg5H6LkbCQ1j86xw53y/VogUEzM+xdLArfQ6/3ne9WZ6QK5cyLy",
+	"KtwQBEWsxkQ4TMCbskGLoigc0Gjwih2TmNbiauGTBn0A/gVbdPKpRk7bfSM801CFsMWEGSw01xtm3kNh",
+	"0NTiPjrgeaKFeFJr11vlgSrsHUuCWZMiK2c8JzOmwd5vO0A6LhTLzszdGTjALInlYciDqRMZltrCTirC",
+	"ta21+jNbJpmgp/2sWj8HDNnHsHVFIbbT57Od0Zu8AiT7pKVfSN7eMTRfhGCYJq6ROR0XrPdutwOX0bzB",
+	"qkRI72sKwyNXsGKP47POJmtNLGb1TBd4kLa3uTG3wGhPBjh8RShBfGs7Cz/u6iNyfPyLMbEIniYjM5OT",
+	"QTXTQFbqjMArmTDbrGP3aQEb0G4+n3hS22E12/PSir2pZGA587FiIK+C8DsnHpw8DSYA4mIDS031qfrO",
+	"kNTUi3rqp99hr3EvdlhstEV/cgYb93fV7C2bayrWuqHgrE9H5qwQHB+j5uQg7VaqTqM/jMZE3sbqovXG",
+	"JuwezvVjFTpVrSqI8lkZcuLFL98UqS+0UTn0G14IkNlQN/IlkzNGoJAmUWxBzVGgyJeHP+yRb+49evjV",
+	"40hkAPSMYKRwmglZwdDaN21AdF5mmZXGiEcLgPFZZhU5+7J5AZ2ER7WE2sr/Z4szWkfF/nT0kgLKVjA2",
+	"0wjkWGCNmVbMOgasz+0tF721vkJNUFK8YQoy/dQP2F9cbZrNmM2l3k5h6BujNkAExgjo8PdL3XZx2Lba",
+	"7e0alzoKG63BLwjW1S3pBzUsfUz+11J3b/zGVq+ghNYYOX+pai7dmIEzXPle/PfpsMct2h0XlJsloXnC",
+	"VpkmXuSpgxv375OFSCMlDx63kxJbZkagdJ4ImfpALiJZIvKEZ5zquElYMlUurNxvDsV8LNNaykafe/DL",
+	"YPZ9AzxiFGiMlOXpXVDHZ6E7fRCY7Q6hfVBq1U7IafGrjVsFFJ+M5jlLSSHOGVbjB1hPG0DZdyO7bbzK",
+	"edBni0+WNaQXqKNdqmDDlwqC5bgtb4sRc66VcJqoJZpxVeiv3sDpGLVJG5xKmQd5s4F4sGmyjSHbQk1E",
+	"Ulvsj+b2Wy4JTaDyJnzY48xsyqIbOziDjjaKS92+wYH0AKCKsPL4Dg+mfpqbzVoIkfXIhMK6+bC9oUJu",
+	"5GrXw0L4ynd4g+xiOjEVgO9SoP7qeRjPUrAJN3kT4P7XsGY7uL/Om9cvTh1b3kRUf/9+2zKzIluQTHJ9",
+	"95mPO+T/QwjbrffmP68ccsznpPxWY5wV5Qj3rYoP19Ho2oZshvXl7yP7r6/dT199d3kjp83kUt72KCTR",
+	"lAeRuy3RVK19nwM0YgP0YuqgTqCbElc439tW+TYSWtdvhLk1oXXL8ucu5NSrDZUpHq+sbZ2B7NViPfE1",
+	"ldCMkbKIBIOaS2aw3xX5l/CASvi7Cff3nPvEvuVSjnjeQuTK2FTb4DjwLPe4Fr6CVb68SOgFtG86QVjm",
+	"NSj7nSif8R2tAs9OI373bm+v3dtb781/bJXnzfHokDNdG50AbuC/49qiiuVgIQKX2jm38LethKBKWAdx",
+	"qAgSTTOWQjDEk6qMQtLadoE7Lorl1sK3gwGHcHYO6swbnxwYGQS+Gq5jZzxx8/M2mUKkJOVKlkA+MinT",
+	"GdNq6E1Mri8zOF/3cg0yXi9LM2zjV7AUg012UGDSbtfQufNhfX7m5qCm7n32zaNvpg9H6WR3d3T//gM2",
+	"mjzcfji6v7v7bXp/upPsTtKOeVR82DWTcLDv3373+/boER1Nn41+ePv+24vRl+Hf9y9GX72/dxH+tLN7",
+	"8fvF2+86prAO9CyEfUPBxtKZ3Q4RfL2ewHoNmXojOHtve4nzLRRan9tdsS3guIejmwulw8OkvpqfJqd3",
+	"o43A8q8/slc7VMCLY2OzIYjxyCXVAzGra1QV0JiL6nis6jmAywdwSvGIC89el0biz8YNT1ZCFTlnWQbN",
+	"05oB/5znqTj3vZmBs9y6cOuuG3MWZC8xAbbGMDz30mEkZDJnSkuqhRybXrJxIhahf3uEPY5MLxav34zU",
+	"hY1DL81c5yootPI618bRUBhYyl31ATMhO2jMDtkzVXYqqFP/aeUI72vVtIoE8tENmguwgw/kJDID6OEh",
+	"clSsaPjX14OuPUen37GViVkfiD0htNmHBTHvNz2mUTkHiq3ZL527TwuSskk5c3d7yHeRjChdJqe1ajSP",
+	"bXeiTEc859qMwoGjvjn8pYVsVhcuFhIhHLjINeV5lRL6XCSnTNov8FYF7ztwoirFNBDObia2e+XrQfXw",
+	"r9nd/ovoF4XvC8/5+1fG88qFD2PtUQd7BQ98pynPfjGNPt3Z7qo57N+Ja1A729uNOtm1Stk7keJ068PR",
+	"Id2/yCj/+CuL3CXpfSKa6LCqMlIHwHbCIsTWwsOoHnBye5psQLndB7eZ39iQEU6hubvgfKYXnDeWAXpc",
+	"cUSerLm+PLabLnZzaMGFhfuTq1Ajx6RNrElpGs8xk96WGOq6FlXJWxvo5W76g49HOw7vGHf68XXpx5Ip",
+	"LeQdhqrNXgdi4J4HmOJ2rhAo+tTjEKcOcbcJK3yMsKSmvSuDFsdAijGJlmB17g7/BJn4MfS6mNv532zs",
+	"pu1koyv5LYMou3X7kCjKH3vokIMVuvPeNwJwGvJiRaHLpp/82JH0Rvef68UlLV/0rUniJuZmZLUbj4z8",
+	"6WKO39ZhWxYzSVPWwwJVlJOMQ7q+o3YrL8LKd9tmSrQYkxdnTC7dTwGKo03oJOqUnbcKfS94OpIiy0Tp",
+	"kDjUKdY5qp8qC54L6ZpCyCaT55+ZMU9plgFugBBkSiWZsDl36Cl1bEhnhJYsEYsFpBkRs8vhAPVzBU3Y",
+	"kYuIZu8EsGI0X/RBUHrjqH7z6QC+q7sQ778kFJJzfuIvKVTmWb2Z7asE34W8HF9fyCh5PrZlDR+jt6TW",
+	"7+dWeuj2GPITtWVYfk1FsgKL2WxxPBSOzulsxiR5s28diFhEPEDeKVhufnClCZBpHWoPXnGCRlxVR1uK",
+	"wdspWG5cCQGiz2iEP41owUdmtGSa0VnHDnhuZtPPbD7Xi+xSVvOPQXswhH4uktI8AhJUakQdnbVfNbPq",
+	"myuilKqq1m8HUOmwWtk4EmkVLFoHL+1Y8Rq86EpfEdyKayCtqj88qkaooWrXn97rQpJ239T8QLcJNeRJ",
+	"skc1zcSNsfJKbRatjX+u50H7KXlpGevwxdExSBbbgsUkRwEC0kJW8Qcm7Ph8zjNmpIeNsQS8zCYcuWnQ",
+	"fmxr4jreB7w+16IxuLAuHPaf7JRup+z/1cXMvWvjKFv9A0/4TvCR2OJoQeY0TzN/8VPAN4olpeR6OXj8",
+	"+9uKi5DABOJGKk4SBctpwcduyKvZyR1D98Y7JLUSEs1teGBVVYxmLDdL7MDNLIclAcJBgdO26pj3KDND",
+	"YuIXgTAo1O+v9EqDQMG3THOqMtfbrvA6JmmezF3Mj+EK0wHN7ZcB1hpUDRIJVFxNyYIpRWddd5nXSK1/",
+	"qKub42macvOIZgfS9KQ5U05DsUwsJkYxugUBs+LA80ToceBlIp+NZJlDJKWTAFUDQ7IQShPJEpa7MEuy",
+	"54231YsedskWkD1n7LR7QdzwblDm+15uJGf4Y1B5Ajpepx4f0REwUgQZJlhyMQ1SbgJnd0wHsI8HH+IG",
+	"Wg156z1PL666KYhppEqacP6HoRVTxkJjPRbmZcgS0HRRrN0N++mt7Ie76Jcb3kDXcRHmqy/BHh+mLHm6",
+	"gvNdFXx3GelhOcUv/PUFrz+MyowzpUlaspX1cQ/wc6/93iBD17v6y0r567fVdDGHNbP3LfrW4BSfYnlQ",
+	"/z0I1Zww87sP1WTpRjXZGqwVLx9+/cjVn2m8w3AzObEaL6bX0t2UZLg77+6gZspotEqR0cRdUQuWeN9a",
+	"GEyOYGPu+htlelPCZIreieYLXAXhZBhIRprVWKFrV52ILQq9JKJWrQXH8gyMwY6MMFb3kQ03nZa6lOyy",
+	"AnghUj7lLO1wtHdt4Rvzs2Myeme2+V9WUPyFguUKFBcGHQf/BSApWwZu/c8txbLpenU0uG1qV5bEx4rR",
+	"LGPSAECLc+U2gXW2sJTYPoeEmSLYNIgKg/Ighch4sgxrU1uoAg8GD1e8uKVqzlOMWaVJNTg7Hmvtg2Eh",
+	"6EHBpFHYuw5HS6WDikYGO/LPI0OgmzSKT6cMpTqTC64663R9QA064C67miOViIKlI5pxeplzLCDygTmm",
+	"Pgx85+r90e+yFtbA+aLmFvdVcJpboT8DBve3tdlGNoOH5OVigjlSADiyKsNozcS/K+iMHfE/2dPdrtwi",
+	"90Y8tWi3kVgUpBVtR9KKWkav/Txl75yYgesuzCmYEtnHgK0MzKM0O6dLhaW9eU4Skf+rzEEyVL69L9yQ",
+	"vyAwlytRxXDa7kMxnSqmn+50EQmfx0m0MU1AbWHv9AGdseNQDBeSnXFRKmLGB3l6RjzxvMQIKqNxeCKA",
+	"5J3yTDO0kwmZMvn9EsgZ3AXFYgI4H/AdzsKgg+CHLHXtYhCV/8M/niw9eJexXlLJYGzw4OegHrES+ELN",
+	"XetmRWeY6owVn65htQpHuKds+Y+z/X+J5cufVrH3sS3Q0u0ni64RkNQQ3U6E5VpyBoWOqTKlcwzNTuBD",
+	"84dk5MzUtDf/W5rX9qckF5g74QXI0H/MkcvHJ/lJfoQlqACPhWWpenySj0CzNf+tygBbvH7zo3MEY0ld",
+	"84svPX0wp4qd5BWZQfxRlaCK1haCSkgdTtAsrukc/gboJf8x0mQwHMAce66fZc2nJ7AmBKY/uBhWO6gV",
+	"GuKyOlsjao+FUF+3FEpxk1zYByHZx1cashvuVUhYfX0dNESeg2M9Kq7w7c1Y/gfY9A26U1VBB1hpY1nv",
+	"pjiXfAlOVGdSQ/tZs16fcs9t4T2Rf2Va8gHBX7J3NNFkgdVIuDmEWAqvFLaP6nkzq9pWbodRHFQXvHoz",
+	"tnrD+1O2vIi2Bi+gKAi/PMkdmUXufrakawjrZ6+eYxIVBjG18IowtsBBuLjzIdRlxif5b/Zx68MhmVbj",
+	"cGVdov0XVClUviuBbzYdtVNULGOJFrJ+CAAtaldnOAG40sA4DeFkCfEHjqm9vfD3qi0HxOqJ4hfeMGzG",
+	"czY62xlvj7fxvoGVB/2isPzsaSHFxkIBR/H0ZOB6e9rszdDMcobrBGXHosw0LzK2boaTpV8m2Of+uC8k",
+	"m/J35GQwFeJkQITER8GSKDHV53AI7Yx3vxk/uPTsTMdPp0J8TV4fBlv4Dxvx/PRsF9rHiWHWj53WH2ZM",
+	"fyhGZTL/A0e8fi3P50IFmw/nOaeKTIW48hS6BilKvW6cP/gVCTcPrIpdhStTeIUEx3dvNMyrCAIv3g/C",
+	"q1IvmENXNwlictcgHQ4HNX03ntVRNNVh841VhelEsVy7gwiAGAobp9Igy3CghabZCzSsqMHjlbAOMFMP",
+	"fZNrboXUkEg2ozIFFD0xNZ3xHAjp7ys5YUrzBdXMRonBO1ZDr+biYIeoF9Ft5XocXnR5ru/tDmL3iMDy",
+	"+3tjlm97R838Va0P3QBRcFaooDh2l30LNPaGlbhmEB42a9NgQiOehk2DNWRCB/ZhgE2hGq3gNgDL1k1L",
+	"5fKwzLF1XL96CG0tSQbrcZirM0t9Ox0plVewR7SR5OByg6SEgiG5C6j148z4KSPUDdRlY+HbQdiLnWEz",
+	"+8epMvCnLf252Py2iMRchUWHb2wGRjdcy5H7KVsUQrM8Wf7MlhvXkftoLfsuAwOJ1hGeGXKtW/dwcYct",
+	"loWE5nClCZ/ajWBhzCB1b1Os/54BsNeZyLve9dEAi638WFA3KHSRUR0KoA8B5XsJl8n93d1rhWL4FQUN",
+	"F7mNDY7R9CehdA1NLyxLWSpL3EA6uapJVLkYS4y2Kywi9fg2jrl+5uotviiE1JsnNPc/FfehB1Rj3lnF",
+	"JCwY5di1ila2HiBzKxY581ewyZL8yPXrQoW4IYbLMZkjLGSFQdJ17GRwvJYWG9kOYC+jqHVRf697Yhu1",
+	"UINVDg/V/p2hvxVVoZTCJXrOnBtM1SqZ+sL69eKFdtYrMqbXHa9I35v169o+rkH+f4SYXh8WdeBq29fo",
+	"GSNVzmZMIf26UuDwlVA3NZ9izOuyZu7P6cL8bV2tYtqhwW7sljL/PqpG2sNJNQlKt9k5shTG7dIfIGtc",
+	"FqJV4u2Js9SCS+sLV6u/K9DZ9LQqyvk2854svRrk+vxuWT13gCoXCyqX/f2uBL+AYAGf5qc0DUy/18Dt",
+	"R3ZYN88orqc7DungkPURsiuKMXi7b+QOv1eL1cJ65p7LUmau6UTkgbLoajd4MGEu7XtmsqAp2lfW1FVo",
+	"4bPCp2Ghhc66ETz3IEszaY5Ae2u2vhVfKQXe5/nsZFB5TaxLA4fPdVi31xg0zIdBju0cjBXOa0JmkiaM",
+	"FExygX0WpZxVKYtuesGQwcS/oPLUqti+qfiI636cznFrsL95hL9wWCvimFefa5ubONIIy0VA8pVd7g6s",
+	"/H6sfyOY+T0KAqAnL4eEP9lm2TxtQHOqYaSSTwNbOGR4cxwXRVXqo2YuM6X+HQ8TOtUMRXtYpAAMZIbg",
+	"osm7V6P0D3bOaynuXrx2yse4S/LZXBN6TpehYYTq5oaIb9jrIxFs+1WkgRc2LODQF8/JTy4AblqPSOdI",
+	"GBN8d7eVHokHrfLWNxL4dtMpCh8lQsynEa75yQAf9RNiW4hR2QdWH1+MgGp23ayHJGfnTOnVKXurd8H3",
+	"dng3vxmwp79giefPejN02XLNaitSFn15GQ5QegoqWI7osiqnhZoL7a21gGIShavBOwzuoCvjyaoWWm0b",
+	"X9bCC5oPzC2tuIQ1duXuu2VIV0u5Txej8trMpFZqO+2vb7ase38D6d2KFnwc6teGVWtqdQwMvXGVMfgc",
+	"uBPC4VR2XPjGXfAxMA9BazbK013N0c8d4frk7jaHGs6nSR0z8Gp0d6r0nbYV27fszAVYxZ0bWjK6iN40",
+	"EHWLJHOazzCYFOGdRorlmmC7Y/Isx38abixKgKA1Ad7szBoNGoakYbu0asNKYbttML8bRfeVB4N0lCiN",
+	"veY0yHqoYG2DOEwcfrOXzkCdPorjC6R0D+cMDtJ17ih5MsCpnwyIiqxKn+UweQvV1E8GG8x92FYmht64",
+	"VIVJpUwGJieRpTVtu8OoYX8e2R8qHnUP7A+WWb9rLWKHtQPfi5s7LDHNoHKT8/N79UPQ7tsP4p8CTrF6",
+	"/xCxKGHmI1z3zd0YMDNo9S75/+6M2PyMqAGGfiaXtBhEwp4VrjWM0FhRj/6GNwQTWHNyhAimNxiKEvRz",
+	"y5UtOwbQPhprVG9o83j0pR+2BvjHdStDhNT1pjR8rx6I56KqzHE4sgFXrvhtnd8BlWNS+QvPhTw1aaLB",
+	"Paqzau+YHNr6j1QyzGpy9zW2dFYDqowqYf7jQDcZ4YZ+ZzQLh2Ohh580r3CmGXtfcyMNbBlUkTIHTFgD",
+	"yzm8brM5Ypbegr3QdnR3wt+d8Jue8GaPJyKf8plaZcA5ZGfi1J5/wSeEK1W2Y3W9dHDWF0oyRo35r/rW",
+	"7fAFTSHgFwIzvLPemV1albAA98T3a6vKYk6FmWRloHy9/3yvupm4iJOc2EgHPXcyhySSpSzXnFaRo+Ew",
+	"AezExoII4xnHgQSv2DGJaT0kikrmxukFC4QHQotO1NXIabtvRL0Yqni0fNcbJkUKiIAR5zkm59vKflqI",
+	"J7V2KyR+QxX2jiXBrEmRlTOekxnTxkviOkA6LhTLzkw2PHCAWRLL2hB7XCcyLLXCjGJFuCsC8zNbJpmg",
+	"p5c2lP0c8Ogt4Nzt9PlsZ/QmrwBy/prSspf7+QsVboUpz5gr+A6xsE4SAAfSvMHGBGCwzZelDAs7rGDT",
+	"az+l69y11kRjFt0MyBaxaAkMY66BuZ0McLKKUDJBfBycs59lQLfj41+MiUbwNBmZeZ8MKroEUldnBF7J",
+	"hNmwHfsY8Mu128Y+1q62V2uG8aUVoFPJwEznAzRA8gVxLU7QOMkcTMDXBu2ooN006FSfqu8MSY+XBXvq",
+	"p99h1nEvdhh2tMUJcXYd93fV7C1bdSrWuqHAhk9HVH162plDS1qpno3+MFoZefv3jjLIvRC1uodzawhb",
+	"Th3EBJrPyNhDdTLvLsPYsO4gdVCA/+Po9SvykskZIwemEaLYgppzQW1kBDKfrj2ifsFV2XSHuDyj6UvT",
+	"y8YprQszuRFQ6O+XupbisGGKt21WsoA1LK0NZV1yp11fcxragtsf1qT0sfpSV1UujW+Za7aL+g1xgzbR",
+	"kGV6Me6nw1cfl6kyKGe/ygTxIk+VxSby75OFLcnTM5DkcTvZo2WyhFXyJeKHvsZonvCMUx23QEumyoUF",
+	"I26O0Xws01p48yUvwmGR+75BIzFqNQYPtVPvhNtnYyL8IEivHcfGQalV7zAwnrfY2SatAhJDRvPcvC3O",
+	"AXRFngJIm1ZEcc36bn238Ve5LvoIhcmylpDPFSloqQIRUSoANeALTMlBZAPXSjhNvJybcVVYft4m6tPl",
+	"eN76CGCSKudMID1s7lZjyAumpdFfJQX7o57T3H7LJaGJLmmGH17u0G5Krxs7uYOOvJD6IF7NcMbrcUUi",
+	"3H0nlDdXJ8wWL4TIeuQRGAFg4UMIfHI1j34fa+MrP7obZD/TyYEQ2V0GweeRQfAsBStzk50Bgfry8Sl9",
+	"ovLr7Hz9Et1xcj8BvnND/bbFdkVjXsGZXt+d7nLYT5+5vN96b/7zyuESfB6KfDXGWVGOUAKo+HAdda5t",
+	"yGZYX/4+sv/62v301XeXM7aiTg07Vg2dzcTIK6MU1ZT2mpCrVv2K8Xi9TLFe4B3UqXlTgg+Jc9v660bi",
+	"7/pNWrcm/j4+Sfa5B9qCKlMBwOF9fRM9huzV0kiwAZXQzNheI2X8zd07kCmK/Et48BP83aRLeIZ/Yt9y",
+	"+WA8b0HGZGyqbcQieNYvd1t+BcxweeHSCwTadIIgpGsQoDsx6uKywRaQsoEeNQrdSYnrkBJb781/9tPL",
+	"IjABP7s2Vu2mDsAjX/MZ4IRyMMRB1No5t2CQrWyu6swIgo0RT5VmLIXgkicVPnjS2sZB7qUHSFoJ6AQD",
+	"DvGbYLObJuz3DuMGXoLKhahw8AVTRJT60oZ62LyvgLqDTfZN4CRoV4y4c0V+rtb666ijnVfc2DWTcLDv",
+	"3373+/boER1Nn41+ePv+24vRl+Hf9y9GX72/dxH+tLN78fvF2+86prAOXCmEkEKJxdKZ3RQRCK+rYXc1",
+	"ZOiNQHm9vYpU30IvxOdzjW2LQ+7hseZC6fDMqK/6p7kjussdwMJHz+xOt1j9MF/ptwJnmY2ah6DQI4eg",
+	"AFSubnNVgGguMPkHwOE89Dl41gCgEA/P8Ox1uUL+HDafsjOeOM3BO5UKkZKUK1nC9MmkTAHomSpyzrIM",
+	"mqc1p8g5z1Nx7nszA2e59a3XPWTmSMleYkJyjZN47sXLSMhkzpSWVAs5Nr1k40QswoiEEfY4Ynnq0KtD",
+	"gC3opZmoXgXZVuEAtXG4o96qIizlDovbTMgOGpN69uYsOQ1AFt2nVYTCFSy3VkVBnrtBQwZ28IF8cWYA",
+	"PRxxjrAVWe/KHV8pf+BK559j77sT8LM7Ad/kyZXPQGHOipXn22P4MX60tMCDAjhV+9yJbMy34lOLX1nm",
+	"mE+7RNHfdW5W2RJXE9yOVIOPR3yG59KdAL1FASqZ0kKyz8h8HJUeh0gGe4XUSboJzBiYXKhHxUsd2FsT",
+	"5O4YPFXQ0ZUh9GKQeZgsR7DuYYcljEz8GC6rAlpa3Wwwlu1kI+XvliH93FJ+SEy/v4Aj3pXb+cw9WKE7",
+	"uyF4HIWuOx3i2FH+Rney68XlMl70xQd3VPDTRx3MV5r7dLE0P6LzvyxmkqasR9RjUU4yDom+bkFaEdj2",
+	"fLFtGh/emLw4Y3Lpfgrw42xdYaJO2XmrKOSCpyMpskyUDg1AnWJ5gfqptuC5kK4pRKAxGcKZGfOUZhlk",
+	"HAtBplSSCZtzh+BQR6Vz5hbJErFYQFoDMVIDDnA/V1DpHbmIaPZOAK9C88X1A8K8cWt086HHvqu78M/P",
+	"D9nFORHwlxSg9VfLBfsqwXchZ6CqJjBZ9nEVb74j0PhYG+TnVmjgtln7E7UVreZ8z3Y9Dr9M5LORLPM8",
+	"rPVcNTAkC6G0OUBYbp2Mq2ON3E2xagIMPgZfGj6k5Jyx0/6b43U1lxvcC76XG8kQ+ItDgDUoZW7vtfrY",
+	"FSeIaRDK5qwHHS5m+3jwEZ0o1Uy23vP04qqbi5hGqsghZxoZEmYWHpQ3a0wxL0MQjqaL4jqOnGpX7ae3",
+	"sq/usPg+kWONrz7SfFZrWfJ08x3kquc53azH5Qy/qGyB3RVtzM+MyowzpUlask1r29QLn9/oeVPv6u7Q",
+	"ud6inE0u61GcMyzI0WC5dVbyMTlo8ihiPEpGJsz87kvgm2v+1eplNHg0Xozs+iEA77xPa+H/NuWay4ql",
+	"m64/V+/u7ty+s7R0m/YPWZHRhCnP4t7i6EUeGCr5wod6bbhNDID1FC0wzS+5CsIIMIAAHJGmwbA9sigV",
+	"CFy2KPSSiBpWNw7ymS22ifSFSbiPbHTctNSlZJcV9QuRwqz6ezO6Nv2NOTMw66UzreUvK1o+ryCJXnXs",
+	"0WNgS++Uis4Y1gLzirZijMBpVdWf73eU3UK9etvb2nr1N2uBHw78LWLzI+JZovkZsxPZTx1O4fDa9WTn",
+	"AhqVhYH9uY5Uy844lCNNobQBSeZlbpDP+aIQUiNnuYH4MnNaCJJROWPWZmgjP5yDdE1+luJ/Mn8SqTnd",
+	"ffCQJCZuWJULdzL4LrEefpJRCdUYyVSKXD/BGk9mqGi/BKh0WhToQwObzcHro2OyAXXBZrTl2rSj88Pg",
+	"ylLkis2LxcLEoR8xhZ5DlytiCV+fA88JzclclJKwdwWXbIPAGef9fmN552ZOp3ovQdDMTYJd1Dv9nG7m",
+	"m8kLbwXtulU/mwDyfY3R8VuikEHRBtoZgGa2icuBqnbkRjfmBp/G7J0fxX35E776XmptnxA6hQxxp9+j",
+	"ZHLxCoybnE+Q5Jpl1jIjplObJIf4khCg2P8m3YMVtj8VIXJ3j/4U7d+rdILrDhP8cDTohOWCHe6VQIeE",
+	"cCnxgZqeFQj2JWzV3dy10wQraQLxUs3EfCt3wABgS+aAAnZ/+5HLj59Dkq0iGF0MeRBNsWUjxBSdMvR/",
+	"Sr5RHHJLNu0hU9yGWgVd3fTl/2OUh5/X5X/VjeEzED5KscUkc4HIeA1rXgY3kUBDEyBpfoEWF2iCVFhZ",
+	"3V8o/VXU3z/NH3jTqytPQ6KgrpbCSgpCkv9+9vIXe6G14wkAR3xGV+wGeSW5g/xwxetVc1nuNvsH2uxr",
+	"XOymQJt/9YtalKMxDjhe31jFXls6qo14MWMOPgd3EOBJBOxdDa0jYsh+shksxTCOEf+OL8oFycvFhEnM",
+	"mGQLhRcPXcq8K2ipoDN2xP/sgMbY3R4ObNNVOR38qwKY4rlmMyZjQ9vPU/bOySOMxTPjWj8sVJPig9p4",
+	"FKB3yZSZne0Gw3ItOVOd/ZvXv1/WBrA25fcHnlkPi2+fTKiqEA2m8IJDF0i7OsfXVvb99hb0HhNh+7ng",
+	"D3vzN6nkwQe1g3cpBftohA5yIcQKobf2EN28JNF+yhaF0CxPlj+z5cYliS7PitdhQ73pQ/6jywCM83XP",
+	"gxjGQjWf8IzrHi642utG0DJIOPIHokvPCc/pwDfnR7hX63bjg7z5+Y0LylqHqyXmZybF+jKazYDzR/z7",
+	"Dzbk1qH+KgjO0FWWZnVxNCd8xnN29UiYB9uXhWv+8uRkvPKFr76+XAIsV4HfUXXpudV2HpN9cIcWHAq5",
+	"UNV+3dKy2v+CcE2Upst6++dG3w6prhqfIi4HM5mALppmT+RJKSXLNUnmNJ9V37SGgR9LTjNTUxOGkQui",
+	"z0XQH6JeMRm28ATMbE6CoxHOaLKIRUlzW/kZeiepYIgR5TAGDLE0X2yAKOt3svnjub8w3MRpa1tff+hu",
+	"f/rup5s9OK08c9mw62+07s16nuuC6gQsuZQUVGqelBkNkrBNJ1e89Jo/fnWjvPlqjnfXibtT7eZPtQ13",
+	"6Xu7+XohMVNnVk2qfWhBZ7o2YQ9Xf7gP76Ljr7rLVojayOqFFsQ1K7mJOB3ckoXmTpzeidMbFaetyVoG",
+	"b87XB57DbjJPvzj7r/F/j//5RY0SZ9vjnfF2nA5nwdbpkaJ+9uX2f37fGT16e3KSfv3Vycl45d+XuQDR",
+	"tvEiDCw2U4Z8ArRiHLzB+McVJ8yltP5Komxoqrtk2fDrttH9lQXfX87m12RZh0GC1Zy2CsnOODu/M9Hc",
+	"Sd9rkb5RJ8cBMply1p6CznyF3pydN6uy1yPy25kf6AWJidS9kLdtr5dwoqxv8SYF72Wr3V/LIKDXg2qJ",
+	"3JQ/W630qnK221j0iwdsc6/6upX23tK+3HSkQa3gXHWlW89tQBE0+F7d3YA+yBl86wXo7879v8q5f2kZ",
+	"mbJCsuRSCLV3+ucdH/bVP587NjMWgDbYas3nYv2XJpo+F4CfxSQgryqsnGhhVJOu03Uj7dIPbHB3yf5L",
+	"XbLZu0JI3an6vYDHaq2KZxzulCiWTUeGFbBw4qTM04yt0f6whyvpfr6JG+fM72FGd3rf3dl3d/bdug5m",
+	"z8M7DeyOC2/QAmiVLnOcpZJO9Vrl66ZULjuSO4XrE1S4ztlkLsSp2kqZ0jzvizAdvg0/iFJPDGmIbZAk",
+	"NMu6gT3Jgi4NP0J6nCm8cNxslEpGFjSns6qWkJmS2bqEpia7xZa0VEPblxaE5ktMBA7bgqYkmxre748r",
+	"8JslzPOQLjfI4ba/oLs7BNFLxlJLRtPln33wsGjKc6Y8m7rt8xL4TpLDF0fH5NnBPuaOI99rLKA+tdAl",
+	"JgEUKrTzUwaebayN9yci1yogHkbA0nRJzuc8Y/glTeaQ3nlO5QJBihx0htJU6sd+hH50AWh7tiQUVAW3",
+	"n5QL1g2LqnNpu6GKKGE2grIl/EwRL4uJ1+oG90+j3Vy7xP6fywmTOdNMAWXsDKvardijuXFlWdWK77Nj",
+	"Ax7ikt3g/jp0i31TmQfm+3u3M9zjGmthgV3DXlqQOc3TClRLwb5TLCklpKf8/rbahVh6l0Dt3eqYuAKY",
+	"XBB3joUnvQ+mMAw1p4phvTgogwo/4mbB9GmtWpEkqtp4Vdamb7VUgEM5Z4shBI+XOuB9oEjFjNVm7GDA",
+	"vyKK3fXD1b31XFIUQuoF1ZK/W88rgczwK2ubwNOd0WTeKJbk6ksTyTJGFRt76VwFf9sSgu3mjTqieGrx",
+	"QrAnW/vUyTkuqy5QQbA4ISb7CjIIOzklnPsN8ovt6CV2dGvsElMerw4q2MVQtwEt+EEABK8LKfBWIQHv",
+	"8P8+Zb16gw18bSh/m4L53SH3XW09rwLbd9PofHdQfB8t01yXGfojQuO7Xti9j3vK1wi+90lj7N0B6t1h",
+	"bN2cPnRp2LxPVHhcEjzvE8TIuwPE+yts1kvD3q1WV28a1q6SAbXJfGc/e2qa/DjQ77pG6hDwnu5uf6QY",
+	"eRZThWbgJKHZuYFKAWc3z41h8V9lnpjGKoPyF27IXxCYS8/5m0js3YeoPj3d2f7Q2HzkZEBVcjIA6XoC",
+	"H5o/JCNnNOOp+d/SvLY/JbnIQVZ6X+zQf8yRVgEJYKtRlWBxn/aGU4CwFoL4LRFrw/y9NET3H+PYB8MB",
+	"jKVFWwsj+PQEaEdgQIOLYUWvlmXQnyDNvtu9hug6AJaTC/sgJMS45+DcwK5ClurrzeiCKwsS84OCMYb8",
+	"sSgzzYuM/YHvtclhv58sG4AsfhMWkk35O3IymApxMjBnPzxyVvyz7fH2ePdeJ42wfUuip1MhviavD93X",
+	"T+3XuGpoEbYj/cP08odiVCbzP3AMnYMPvA1zoQK1w459ThWZCrHBGLsGJEq9bkw/VAQNNSAgqiXiuP9I",
+	"VvDTHb7mTcax3qCNpjcqJirY7k+4htNMCR+UQxXo4bghTwZ7uKyj42XBHpNwZZd0kZ0MhoSNZ+M6W4Kv",
+	"BkOrCUZvOxPBjy/WwQDYcO91Cv0dOOdfBpyzzwXghuA2HxvlAMJeuFYxd7K5YuYRZ7KPA4q6rjEGiOdT",
+	"Sf1vblyx950tLBGLCc/tXhm2nIWNBNfmjd02UhYzSVOI9gS7G7pKc1QL7UOijc9UK/eNFpmxy9FO3/fn",
+	"BSB6k2K6xdkfDN+zAYFyh+65KbrnHaDnlQA979A7P8oI817H8e2BeK45j+5AOj/iw+6zhNa8dgzNtSE1",
+	"dwiZl2LxS0Nhmug7sLo+SxJW6Nit2FioU3Gegw+tHmCI1+txf7l2h5Z5J9fuciw/FoxLB2tZ2bJ9/G7l",
+	"2EaLMfo18tR/C4E2oPPAh2by12yNw+bO5yLD3DtIt7P6OeYg5cJDxJWKNT3yVWIQJnn4hCe7m/YyqpQN",
+	"m89TJlmKt5EnPsPJmkxKxYboKDVfL5imKdU0GM6Q8DEbu5xCR/thaOKwGHbDKmrfg90VIuPJ0g9Xlbli",
+	"mqR+Dp3XFh/JhJTyFhjDGYrkcAHCAeILGZ+yZJkYcurgQhfGIJyyQg/NhHFRMSfWxsdaSBLC8rQQPNfg",
+	"d7XrwXWfi9EdwOldKvDVL2q3CFl6dzLe4Y924Y/aMFX2jittrv0tsEY45rge+rQWkJU23S8wvEXwHvHM",
+	"td2eizJLzSFKU2MKF06oV6mv9kWwjpvzzEhxMmEJNYK8VKZFccakFCkzn0u2ECYiFgLfRB52bQ8KbM80",
+	"BceeIw1L28a9L1STTPZIcPmQWRM5FI87Y2t0za51kN0Br/7FgVevJP83glK18qyeeY5b55xJth5q1fZe",
+	"+aP8EwqgmIqc5uLc6JDJvOq22sTTKYaZT9hUQIJexjRG0ngdy/xpEuP2aJaZj2mWiXPEPAlQI1gDT8Ko",
+	"2jOGoTuJKHNdSzRuzCuGd28+VOaMc4FIkNHqKn71v+TfgcN+5Bf+O0jXO1XqanBiHxl26+fs/r1Dbo0g",
+	"t14LWOsdMusnbR24AtZqN7xqZSqtXra3sJpZccZyJiuFi+uGcdRuTtuoDV3y1leeAw5YDcXIKIhCJnNm",
+	"IcM6IBbUZTw6dhib+3PusGDv/Dp3Z+CHVrluH6r1TuG6A2pt61rXomHdAbF+TPrV7UCrfpyAqnfoqTeW",
+	"KO1Ie53B6HWQyPeDn46PDwxa5EWFF9kKHnOLrohkGajrWiCDhS6dSiBX5sbhhm2dlhOWiHzKZyYjEYMR",
+	"nE223c/P/u1LdJU0MQZb4w92et/WC5FlpnFzmR7JMs/DnvzmCbqqmundR1xIVE16runbIFj6Q7umB4sB",
+	"y3qX8XNN8+a0TBxZ4tInaNn83nvAqUhKs12cl3DvpcfvDZo82CfP7Yu9BuybBxwL17bFLVWa6rJCD451",
+	"WENZvXh78f8PADF0nsTN/wIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
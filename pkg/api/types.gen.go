@@ -4,6 +4,8 @@
 package api
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -11,13 +13,109 @@ const (
 	HTTPScopes = "HTTP.Scopes"
 )
 
+// Defines values for ApiChangeType.
+const (
+	ApiChangeTypeAdded      ApiChangeType = "added"
+	ApiChangeTypeChanged    ApiChangeType = "changed"
+	ApiChangeTypeDeprecated ApiChangeType = "deprecated"
+	ApiChangeTypeRemoved    ApiChangeType = "removed"
+)
+
+// Defines values for CNIOptionsFlannelBackend.
+const (
+	CNIOptionsFlannelBackendHostGw          CNIOptionsFlannelBackend = "host-gw"
+	CNIOptionsFlannelBackendNone            CNIOptionsFlannelBackend = "none"
+	CNIOptionsFlannelBackendVxlan           CNIOptionsFlannelBackend = "vxlan"
+	CNIOptionsFlannelBackendWireguardNative CNIOptionsFlannelBackend = "wireguard-native"
+)
+
+// Defines values for ClusterBackupPhase.
+const (
+	ClusterBackupPhaseCompleted ClusterBackupPhase = "Completed"
+	ClusterBackupPhaseFailed    ClusterBackupPhase = "Failed"
+	ClusterBackupPhasePending   ClusterBackupPhase = "Pending"
+	ClusterBackupPhaseRunning   ClusterBackupPhase = "Running"
+)
+
+// Defines values for ClusterHealthStatus.
+const (
+	ClusterHealthStatusDegraded    ClusterHealthStatus = "degraded"
+	ClusterHealthStatusHealthy     ClusterHealthStatus = "healthy"
+	ClusterHealthStatusUnhealthy   ClusterHealthStatus = "unhealthy"
+	ClusterHealthStatusUnreachable ClusterHealthStatus = "unreachable"
+)
+
+// Defines values for ClusterRestorePhase.
+const (
+	ClusterRestorePhaseCompleted ClusterRestorePhase = "Completed"
+	ClusterRestorePhaseFailed    ClusterRestorePhase = "Failed"
+	ClusterRestorePhaseRunning   ClusterRestorePhase = "Running"
+)
+
+// Defines values for LabelPropagationPreviewLabelPropagationPolicy.
+const (
+	LabelPropagationPreviewLabelPropagationPolicyNone      LabelPropagationPreviewLabelPropagationPolicy = "none"
+	LabelPropagationPreviewLabelPropagationPolicyPropagate LabelPropagationPreviewLabelPropagationPolicy = "propagate"
+)
+
+// Defines values for NodeRemediationState.
+const (
+	NodeRemediationStateHealthy     NodeRemediationState = "healthy"
+	NodeRemediationStateRemediating NodeRemediationState = "remediating"
+	NodeRemediationStateUnhealthy   NodeRemediationState = "unhealthy"
+)
+
 // Defines values for NodeSpecRole.
 const (
 	All          NodeSpecRole = "all"
 	Controlplane NodeSpecRole = "controlplane"
+	Etcd         NodeSpecRole = "etcd"
 	Worker       NodeSpecRole = "worker"
 )
 
+// Defines values for NodeTaintEffect.
+const (
+	NoExecute        NodeTaintEffect = "NoExecute"
+	NoSchedule       NodeTaintEffect = "NoSchedule"
+	PreferNoSchedule NodeTaintEffect = "PreferNoSchedule"
+)
+
+// Defines values for NodeValidationReasonType.
+const (
+	HostBound          NodeValidationReasonType = "hostBound"
+	HostNotFound       NodeValidationReasonType = "hostNotFound"
+	InsufficientCpu    NodeValidationReasonType = "insufficientCpu"
+	InsufficientMemory NodeValidationReasonType = "insufficientMemory"
+)
+
+// Defines values for OperationState.
+const (
+	OperationStateFailed    OperationState = "failed"
+	OperationStateRunning   OperationState = "running"
+	OperationStateSucceeded OperationState = "succeeded"
+)
+
+// Defines values for OperationType.
+const (
+	Create  OperationType = "create"
+	Delete  OperationType = "delete"
+	Upgrade OperationType = "upgrade"
+)
+
+// Defines values for PendingClusterState.
+const (
+	PendingClusterStateFailed       PendingClusterState = "failed"
+	PendingClusterStatePreflight    PendingClusterState = "preflight"
+	PendingClusterStateProvisioning PendingClusterState = "provisioning"
+	PendingClusterStateScheduled    PendingClusterState = "scheduled"
+)
+
+// Defines values for SingleNodeConfigRejoinPolicy.
+const (
+	SingleNodeConfigRejoinPolicyNone    SingleNodeConfigRejoinPolicy = "none"
+	SingleNodeConfigRejoinPolicyRestore SingleNodeConfigRejoinPolicy = "restore"
+)
+
 // Defines values for StatusIndicator.
 const (
 	STATUSINDICATIONERROR       StatusIndicator = "STATUS_INDICATION_ERROR"
@@ -35,6 +133,27 @@ const (
 	STATUSCONDITIONUNKNOWN      StatusInfoCondition = "STATUS_CONDITION_UNKNOWN"
 )
 
+// Defines values for TeardownState.
+const (
+	Deleted  TeardownState = "deleted"
+	Deleting TeardownState = "deleting"
+	Pending  TeardownState = "pending"
+)
+
+// Defines values for TemplateConditionSeverity.
+const (
+	Error   TemplateConditionSeverity = "Error"
+	Info    TemplateConditionSeverity = "Info"
+	Warning TemplateConditionSeverity = "Warning"
+)
+
+// Defines values for TemplateConditionStatus.
+const (
+	TemplateConditionStatusFalse   TemplateConditionStatus = "False"
+	TemplateConditionStatusTrue    TemplateConditionStatus = "True"
+	TemplateConditionStatusUnknown TemplateConditionStatus = "Unknown"
+)
+
 // Defines values for TemplateInfoControlplaneprovidertype.
 const (
 	K3s     TemplateInfoControlplaneprovidertype = "k3s"
@@ -43,15 +162,252 @@ const (
 
 // Defines values for TemplateInfoInfraprovidertype.
 const (
-	Docker TemplateInfoInfraprovidertype = "docker"
-	Intel  TemplateInfoInfraprovidertype = "intel"
+	Docker  TemplateInfoInfraprovidertype = "docker"
+	Intel   TemplateInfoInfraprovidertype = "intel"
+	Vsphere TemplateInfoInfraprovidertype = "vsphere"
+)
+
+// Defines values for TemplateInfoLabelPropagationPolicy.
+const (
+	TemplateInfoLabelPropagationPolicyNone      TemplateInfoLabelPropagationPolicy = "none"
+	TemplateInfoLabelPropagationPolicyPropagate TemplateInfoLabelPropagationPolicy = "propagate"
+)
+
+// Defines values for TemplateInfoLifecycleState.
+const (
+	TemplateInfoLifecycleStateDeprecated TemplateInfoLifecycleState = "deprecated"
+	TemplateInfoLifecycleStateDraft      TemplateInfoLifecycleState = "draft"
+	TemplateInfoLifecycleStatePublished  TemplateInfoLifecycleState = "published"
+)
+
+// Defines values for TemplateInfoSignatureVerification.
+const (
+	Invalid  TemplateInfoSignatureVerification = "invalid"
+	Unsigned TemplateInfoSignatureVerification = "unsigned"
+	Verified TemplateInfoSignatureVerification = "verified"
+)
+
+// Defines values for TemplateVariableType.
+const (
+	Boolean TemplateVariableType = "boolean"
+	Enum    TemplateVariableType = "enum"
+	Integer TemplateVariableType = "integer"
+	String  TemplateVariableType = "string"
+)
+
+// Defines values for TemplateVariablePatchOp.
+const (
+	Add     TemplateVariablePatchOp = "add"
+	Replace TemplateVariablePatchOp = "replace"
 )
 
+// Defines values for TemplateVariablePatchTarget.
+const (
+	ControlPlane TemplateVariablePatchTarget = "controlPlane"
+	Workers      TemplateVariablePatchTarget = "workers"
+)
+
+// Defines values for UnhealthyConditionStatus.
+const (
+	UnhealthyConditionStatusFalse   UnhealthyConditionStatus = "False"
+	UnhealthyConditionStatusTrue    UnhealthyConditionStatus = "True"
+	UnhealthyConditionStatusUnknown UnhealthyConditionStatus = "Unknown"
+)
+
+// Defines values for UpgradeWarningType.
+const (
+	ControlPlaneMinorSkip        UpgradeWarningType = "controlPlaneMinorSkip"
+	KubeletSkew                  UpgradeWarningType = "kubeletSkew"
+	NoUpgradePath                UpgradeWarningType = "noUpgradePath"
+	UnsupportedKubernetesVersion UpgradeWarningType = "unsupportedKubernetesVersion"
+)
+
+// Defines values for GetV2ClustersNameEventsParamsSource.
+const (
+	GetV2ClustersNameEventsParamsSourceKubernetes GetV2ClustersNameEventsParamsSource = "kubernetes"
+	GetV2ClustersNameEventsParamsSourceStatus     GetV2ClustersNameEventsParamsSource = "status"
+)
+
+// Defines values for GetV2ClustersNameKubeconfigsParamsAuthType.
+const (
+	GetV2ClustersNameKubeconfigsParamsAuthTypeOidcExec GetV2ClustersNameKubeconfigsParamsAuthType = "oidc-exec"
+	GetV2ClustersNameKubeconfigsParamsAuthTypeToken    GetV2ClustersNameKubeconfigsParamsAuthType = "token"
+)
+
+// Defines values for GetV2ProjectsProjectNameClustersNameEventsParamsSource.
+const (
+	GetV2ProjectsProjectNameClustersNameEventsParamsSourceKubernetes GetV2ProjectsProjectNameClustersNameEventsParamsSource = "kubernetes"
+	GetV2ProjectsProjectNameClustersNameEventsParamsSourceStatus     GetV2ProjectsProjectNameClustersNameEventsParamsSource = "status"
+)
+
+// Defines values for GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthType.
+const (
+	GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthTypeOidcExec GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthType = "oidc-exec"
+	GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthTypeToken    GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthType = "token"
+)
+
+// AirGapConfig Installs k3s from site-local artifacts, or pulls the kubeadm images from site-local registries, instead of the internet. artifactURL, imageTarballs, systemDefaultRegistry and installScriptPath apply to k3s; imageRepository, coreDNSImageRepository, etcdImageRepository and registryMirrors apply to kubeadm; images applies to both.
+type AirGapConfig struct {
+	// ArtifactURL Base URL of the site artifact server hosting the k3s binary (k3s) and install script (install.sh). Required by k3s.
+	ArtifactURL *string `json:"artifactURL,omitempty"`
+
+	// CoreDNSImageRepository Repository kubeadm pulls the CoreDNS image from. Defaults to imageRepository.
+	CoreDNSImageRepository *string `json:"coreDNSImageRepository,omitempty"`
+
+	// EtcdImageRepository Repository kubeadm pulls the etcd image from. Defaults to imageRepository.
+	EtcdImageRepository *string `json:"etcdImageRepository,omitempty"`
+
+	// ImageRepository Private registry repository kubeadm pulls the control plane images from. Required by kubeadm.
+	ImageRepository *string `json:"imageRepository,omitempty"`
+
+	// ImageTarballs k3s airgap image tarballs preloaded on the nodes, either as absolute URLs or as paths relative to artifactURL.
+	ImageTarballs *[]string `json:"imageTarballs,omitempty"`
+
+	// Images Image report of the template: the control plane images its clusters pull from the site-local registries. Clusters may request them to be pulled onto their hosts before they are provisioned, see imagePreflight of ClusterSpec.
+	Images *[]string `json:"images,omitempty"`
+
+	// InstallScriptPath Path of the install script on the nodes. Defaults to /opt/install.sh.
+	InstallScriptPath *string `json:"installScriptPath,omitempty"`
+
+	// RegistryMirrors Site-local mirrors containerd pulls the images of public registries from.
+	RegistryMirrors *[]RegistryMirror `json:"registryMirrors,omitempty"`
+
+	// SystemDefaultRegistry Private registry k3s pulls its system images from.
+	SystemDefaultRegistry *string `json:"systemDefaultRegistry,omitempty"`
+}
+
+// ApiChange defines model for ApiChange.
+type ApiChange struct {
+	Description string `json:"description"`
+
+	// Method HTTP method of the endpoint; omitted if the change is not specific to an endpoint
+	Method *string `json:"method,omitempty"`
+
+	// Path Path of the endpoint; omitted if the change is not specific to an endpoint
+	Path *string       `json:"path,omitempty"`
+	Type ApiChangeType `json:"type"`
+}
+
+// ApiChangeType defines model for ApiChange.Type.
+type ApiChangeType string
+
+// ApiChangelog defines model for ApiChangelog.
+type ApiChangelog struct {
+	// Releases API versions, most recent first
+	Releases []ApiRelease `json:"releases"`
+}
+
+// ApiRelease defines model for ApiRelease.
+type ApiRelease struct {
+	Changes []ApiChange `json:"changes"`
+
+	// Version Version of the API, see the info.version field of the OpenAPI specification
+	Version string `json:"version"`
+}
+
+// CNIOptions Options of the CNI of the cluster.
+type CNIOptions struct {
+	// FlannelBackend Backend of the flannel CNI of k3s.
+	FlannelBackend *CNIOptionsFlannelBackend `json:"flannelBackend,omitempty"`
+}
+
+// CNIOptionsFlannelBackend Backend of the flannel CNI of k3s.
+type CNIOptionsFlannelBackend string
+
+// CacheWarmup The progress of the warmup of the cache the reads are served from, absent if the cache is disabled.
+type CacheWarmup struct {
+	// Projects The count of the most recently active projects that are warmed up first.
+	Projects int32 `json:"projects"`
+
+	// Synced Whether the cache of all projects is synced, which ends the warmup.
+	Synced bool `json:"synced"`
+
+	// WarmedProjects The count of these projects whose reads are served from the cache.
+	WarmedProjects int32 `json:"warmedProjects"`
+}
+
+// ClusterBackup defines model for ClusterBackup.
+type ClusterBackup struct {
+	// ClusterName The name of the backed up cluster.
+	ClusterName string     `json:"clusterName"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+
+	// Location Where the control plane provider stored the snapshot.
+	Location *string `json:"location,omitempty"`
+
+	// Message Why the backup failed.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the backup.
+	Name  string             `json:"name"`
+	Phase ClusterBackupPhase `json:"phase"`
+
+	// Restore The last restore of the cluster from the backup.
+	Restore *ClusterRestore `json:"restore,omitempty"`
+
+	// Size The size of the snapshot.
+	Size *string `json:"size,omitempty"`
+
+	// SnapshotName The name of the etcd snapshot on the control plane nodes.
+	SnapshotName *string `json:"snapshotName,omitempty"`
+}
+
+// ClusterBackupPhase defines model for ClusterBackup.Phase.
+type ClusterBackupPhase string
+
+// ClusterBackupList defines model for ClusterBackupList.
+type ClusterBackupList struct {
+	Backups []ClusterBackup `json:"backups"`
+}
+
+// ClusterCloneRequest defines model for ClusterCloneRequest.
+type ClusterCloneRequest struct {
+	// Labels Labels merged into the labels of the cloned cluster, replacing the labels of the same key, e.g. the site of the new cluster.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Name The name of the new cluster, generated by the naming policy of the project if empty.
+	Name *string `json:"name,omitempty"`
+
+	// Nodes The nodes of the new cluster, none of them may be a node of the cloned cluster.
+	Nodes []NodeSpec `json:"nodes"`
+}
+
+// ClusterDeletion The progress of the deletion of a cluster. Cluster API deletes the machines of the cluster first, then its control plane and infrastructure cluster, and removes the cluster once all of their finalizers are removed.
+type ClusterDeletion struct {
+	// ControlPlane The state of the teardown of the control plane or infrastructure cluster of a deleting cluster, "pending" until Cluster API deletes it, "deleting" until its finalizers are removed and "deleted" once it is removed.
+	ControlPlane *TeardownState `json:"controlPlane,omitempty"`
+
+	// Finalizers The finalizers of the cluster that are not removed yet.
+	Finalizers *[]string `json:"finalizers,omitempty"`
+
+	// ForceFinalizeAt The time the remaining finalizers are stripped if the cluster is still deleting, set if the force finalization was requested.
+	ForceFinalizeAt *time.Time `json:"forceFinalizeAt,omitempty"`
+
+	// Infrastructure The state of the teardown of the control plane or infrastructure cluster of a deleting cluster, "pending" until Cluster API deletes it, "deleting" until its finalizers are removed and "deleted" once it is removed.
+	Infrastructure *TeardownState `json:"infrastructure,omitempty"`
+
+	// MachinesRemaining The number of machines of the cluster that are not removed yet.
+	MachinesRemaining int `json:"machinesRemaining"`
+
+	// RequestedAt The time the deletion of the cluster was requested.
+	RequestedAt time.Time `json:"requestedAt"`
+}
+
 // ClusterDetailInfo defines model for ClusterDetailInfo.
 type ClusterDetailInfo struct {
 	// ControlPlaneReady A generic status object.
 	ControlPlaneReady *GenericStatus `json:"controlPlaneReady,omitempty"`
 
+	// DeleteAt The time the cluster pending deletion is deleted, set until it is deleted or its deletion is canceled with DELETE /v2/clusters/{name}/deletion.
+	DeleteAt *time.Time `json:"deleteAt,omitempty"`
+
+	// Deletion The progress of the deletion of a cluster. Cluster API deletes the machines of the cluster first, then its control plane and infrastructure cluster, and removes the cluster once all of their finalizers are removed.
+	Deletion *ClusterDeletion `json:"deletion,omitempty"`
+
+	// DependsOn Names of the clusters this cluster depends on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
 	// InfrastructureReady A generic status object.
 	InfrastructureReady *GenericStatus          `json:"infrastructureReady,omitempty"`
 	KubernetesVersion   *string                 `json:"kubernetesVersion,omitempty"`
@@ -59,7 +415,10 @@ type ClusterDetailInfo struct {
 
 	// LifecyclePhase A generic status object.
 	LifecyclePhase *GenericStatus `json:"lifecyclePhase,omitempty"`
-	Name           *string        `json:"name,omitempty"`
+
+	// Maintenance The maintenance mode of a cluster, who put it in maintenance, when and why.
+	Maintenance *ClusterMaintenance `json:"maintenance,omitempty"`
+	Name        *string             `json:"name,omitempty"`
 
 	// NodeHealth A generic status object.
 	NodeHealth *GenericStatus `json:"nodeHealth,omitempty"`
@@ -67,7 +426,96 @@ type ClusterDetailInfo struct {
 
 	// ProviderStatus A generic status object.
 	ProviderStatus *GenericStatus `json:"providerStatus,omitempty"`
-	Template       *string        `json:"template,omitempty"`
+
+	// ReservedResources CPU and memory the kubelet of every node keeps from the pods for the system daemons, edge agents and Kubernetes components.
+	ReservedResources *ReservedResources `json:"reservedResources,omitempty"`
+
+	// ResourceVersion Version of the cluster resource, changes whenever the cluster is modified. Send it as If-Match to patch the labels only if nobody else modified the cluster in the meantime.
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+	Template        *string `json:"template,omitempty"`
+}
+
+// ClusterDryRun The objects a create request would create; nothing is created.
+type ClusterDryRun struct {
+	// Bindings The IntelMachineBindings binding the hosts to the control plane machines, empty unless the template uses the Intel infrastructure provider.
+	Bindings []map[string]interface{} `json:"bindings"`
+
+	// Cluster The Cluster API Cluster.
+	Cluster map[string]interface{} `json:"cluster"`
+}
+
+// ClusterEvent A Kubernetes event of a cluster, its control plane, machines or provider machines.
+type ClusterEvent struct {
+	// Count The number of times the event occurred.
+	Count *int32 `json:"count,omitempty"`
+
+	// InvolvedObject The object a cluster event is about.
+	InvolvedObject ClusterEventObject `json:"involvedObject"`
+
+	// Message The human readable description of the event.
+	Message string `json:"message"`
+
+	// Reason The short, machine understandable reason of the event, e.g. "FailedCreate".
+	Reason string `json:"reason"`
+
+	// Time The time the event last occurred.
+	Time time.Time `json:"time"`
+
+	// Type The type of the event, "Normal" or "Warning".
+	Type string `json:"type"`
+}
+
+// ClusterEventList defines model for ClusterEventList.
+type ClusterEventList struct {
+	Events *[]ClusterEvent `json:"events,omitempty"`
+}
+
+// ClusterEventObject The object a cluster event is about.
+type ClusterEventObject struct {
+	// Kind The kind of the object, e.g. "Machine".
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// ClusterExtensions defines model for ClusterExtensions.
+type ClusterExtensions struct {
+	// DefaultExtension Extension deployed to the cluster, the value of its default-extension label
+	DefaultExtension string `json:"defaultExtension"`
+}
+
+// ClusterHealth defines model for ClusterHealth.
+type ClusterHealth struct {
+	// Message Why the cluster is unhealthy or unreachable.
+	Message *string            `json:"message,omitempty"`
+	Nodes   []NodeHealthReport `json:"nodes"`
+
+	// ProbedAt When the workload cluster was probed.
+	ProbedAt time.Time `json:"probedAt"`
+
+	// ReadySystemPods The number of pods in the kube-system namespace that are running and ready, or completed.
+	ReadySystemPods int `json:"readySystemPods"`
+
+	// Status healthy if all nodes are ready without pressure and all kube-system pods are healthy, degraded if nodes are under pressure or kube-system pods are unhealthy, unhealthy if the cluster has no nodes or nodes that are not ready, unreachable if the cluster could not be probed.
+	Status ClusterHealthStatus `json:"status"`
+
+	// SystemPods The number of pods in the kube-system namespace.
+	SystemPods    int               `json:"systemPods"`
+	UnhealthyPods []PodHealthReport `json:"unhealthyPods"`
+}
+
+// ClusterHealthStatus healthy if all nodes are ready without pressure and all kube-system pods are healthy, degraded if nodes are under pressure or kube-system pods are unhealthy, unhealthy if the cluster has no nodes or nodes that are not ready, unreachable if the cluster could not be probed.
+type ClusterHealthStatus string
+
+// ClusterImport defines model for ClusterImport.
+type ClusterImport struct {
+	// Labels Labels added to the cluster; they are key/value pairs that need to conform to https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Name Name of the Cluster API cluster to import.
+	Name string `json:"name"`
+
+	// Template Template of the cluster; defaults to the template whose ClusterClass the cluster uses.
+	Template *string `json:"template,omitempty"`
 }
 
 // ClusterInfo defines model for ClusterInfo.
@@ -92,19 +540,122 @@ type ClusterInfo struct {
 	ProviderStatus *GenericStatus `json:"providerStatus,omitempty"`
 }
 
+// ClusterLabelPropagation defines model for ClusterLabelPropagation.
+type ClusterLabelPropagation struct {
+	// Labels Labels that would be added to or changed on the cluster.
+	Labels map[string]string `json:"labels"`
+
+	// Name Name of the cluster
+	Name string `json:"name"`
+
+	// OverriddenLabels Keys of the new template labels that are kept on the cluster because users overrode or removed them.
+	OverriddenLabels []string `json:"overriddenLabels"`
+}
+
 // ClusterLabels defines model for ClusterLabels.
 type ClusterLabels struct {
 	// Labels Labels are key/value pairs that need to conform to https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
 	Labels *map[string]string `json:"labels,omitempty"`
 }
 
+// ClusterLabelsPatch defines model for ClusterLabelsPatch.
+type ClusterLabelsPatch struct {
+	// Labels Labels to add or change; labels set to null are removed.
+	Labels map[string]*string `json:"labels"`
+}
+
+// ClusterMaintenance The maintenance mode of a cluster, who put it in maintenance, when and why.
+type ClusterMaintenance struct {
+	// Reason Why the cluster was put in maintenance.
+	Reason string `json:"reason"`
+
+	// Since The time the cluster was put in maintenance.
+	Since time.Time `json:"since"`
+
+	// User The user who put the cluster in maintenance.
+	User string `json:"user"`
+}
+
+// ClusterMaintenanceRequest defines model for ClusterMaintenanceRequest.
+type ClusterMaintenanceRequest struct {
+	// Reason Why the cluster is put in maintenance, e.g. the ticket of the planned power work.
+	Reason string `json:"reason"`
+}
+
+// ClusterNameSuggestion defines model for ClusterNameSuggestion.
+type ClusterNameSuggestion struct {
+	// Name Suggested cluster name.
+	Name string `json:"name"`
+
+	// Policy Naming policy the cluster names of the project have to follow.
+	Policy NamingPolicy `json:"policy"`
+}
+
+// ClusterRestore The last restore of the cluster from the backup.
+type ClusterRestore struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// Message Why the restore failed.
+	Message   *string             `json:"message,omitempty"`
+	Phase     ClusterRestorePhase `json:"phase"`
+	StartedAt *time.Time          `json:"startedAt,omitempty"`
+}
+
+// ClusterRestorePhase defines model for ClusterRestore.Phase.
+type ClusterRestorePhase string
+
+// ClusterRestoreRequest defines model for ClusterRestoreRequest.
+type ClusterRestoreRequest struct {
+	// Backup The name of the completed backup of the cluster to restore.
+	Backup string `json:"backup"`
+}
+
 // ClusterSpec defines model for ClusterSpec.
 type ClusterSpec struct {
+	// ClusterNetwork Cluster network configuration, including pod and service CIDR blocks.
+	ClusterNetwork *ClusterNetwork `json:"clusterNetwork,omitempty"`
+
+	// Cni Options of the CNI of the cluster.
+	Cni *CNIOptions `json:"cni,omitempty"`
+
+	// ControlPlaneReplicas Number of control plane nodes; must match the number of nodes. Highly available control planes need an odd count of 3 or 5 nodes. Defaults to the number of nodes.
+	ControlPlaneReplicas *int32 `json:"controlPlaneReplicas,omitempty"`
+
+	// DependsOn Names of the clusters of the project this cluster depends on, e.g. a local registry cluster. The clusters must exist and cannot be deleted while this cluster exists.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
+	// ImagePreflight Pulls the images of the image report of the air-gapped template onto the hosts of the nodes before the cluster is provisioned. The cluster is kept as a pending cluster in the preflight state until all hosts pulled the images, see /v2/pending-clusters; only supported if image preflight is enabled and the template lists images.
+	ImagePreflight *bool `json:"imagePreflight,omitempty"`
+
 	// Labels Labels are key/value pairs that need to conform to https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
-	Labels   *map[string]string `json:"labels,omitempty"`
-	Name     *string            `json:"name,omitempty"`
-	Nodes    []NodeSpec         `json:"nodes"`
-	Template *string            `json:"template,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   *string            `json:"name,omitempty"`
+
+	// Nodes Nodes of the control plane of the cluster. Worker nodes are added through the node pools of the cluster once it exists.
+	Nodes []NodeSpec `json:"nodes"`
+
+	// ProvisionAt Time to provision the cluster at. If it is in the future, the cluster is kept as a pending cluster until then, see /v2/pending-clusters.
+	ProvisionAt *time.Time `json:"provisionAt,omitempty"`
+
+	// ReservedResources CPU and memory the kubelet of every node keeps from the pods for the system daemons, edge agents and Kubernetes components.
+	ReservedResources *ReservedResources `json:"reservedResources,omitempty"`
+	Template          *string            `json:"template,omitempty"`
+
+	// Variables Values of the variables of the template by name. Strings, integers and booleans are validated against the type and validation of the variables; variables that are not set take their default.
+	Variables *map[string]interface{} `json:"variables,omitempty"`
+}
+
+// ClusterStatusEvent A cluster status change pushed on the cluster events stream.
+type ClusterStatusEvent struct {
+	// ControlPlaneReady A generic status object.
+	ControlPlaneReady *GenericStatus `json:"controlPlaneReady,omitempty"`
+
+	// InfrastructureReady A generic status object.
+	InfrastructureReady *GenericStatus `json:"infrastructureReady,omitempty"`
+
+	// LifecyclePhase A generic status object.
+	LifecyclePhase *GenericStatus `json:"lifecyclePhase,omitempty"`
+	Name           *string        `json:"name,omitempty"`
 }
 
 // ClusterSummary defines model for ClusterSummary.
@@ -134,6 +685,46 @@ type ClusterTemplateInfo struct {
 	Version string `json:"version"`
 }
 
+// ClusterUpgrade defines model for ClusterUpgrade.
+type ClusterUpgrade struct {
+	// KubernetesVersion The Kubernetes version of the template.
+	KubernetesVersion string `json:"kubernetesVersion"`
+
+	// Path The templates to upgrade through in order without creating version skew, ending with the template; empty if there are no published templates for the intermediate minor versions.
+	Path []string `json:"path"`
+
+	// Template The template to upgrade to.
+	Template string `json:"template"`
+
+	// Warnings The version skew upgrading to the template directly would create.
+	Warnings []UpgradeWarning `json:"warnings"`
+}
+
+// ClusterUpgrades defines model for ClusterUpgrades.
+type ClusterUpgrades struct {
+	// CurrentKubernetesVersion The Kubernetes version of the template of the cluster.
+	CurrentKubernetesVersion *string `json:"currentKubernetesVersion,omitempty"`
+
+	// CurrentTemplate The template of the cluster.
+	CurrentTemplate string           `json:"currentTemplate"`
+	Upgrades        []ClusterUpgrade `json:"upgrades"`
+}
+
+// ControlPlaneProviderSupport defines model for ControlPlaneProviderSupport.
+type ControlPlaneProviderSupport struct {
+	// MaxKubernetesVersion Most recent Kubernetes minor version supported by the provider release
+	MaxKubernetesVersion string `json:"maxKubernetesVersion"`
+
+	// MinKubernetesVersion Oldest Kubernetes minor version supported by the provider release
+	MinKubernetesVersion string `json:"minKubernetesVersion"`
+
+	// Provider Control plane provider type
+	Provider string `json:"provider"`
+
+	// Release Release of the control plane provider
+	Release string `json:"release"`
+}
+
 // DefaultTemplateInfo defines model for DefaultTemplateInfo.
 type DefaultTemplateInfo struct {
 	// Name Name of the template. Not required when setting the default, is available in GET /v1/templates.
@@ -143,6 +734,49 @@ type DefaultTemplateInfo struct {
 	Version string `json:"version"`
 }
 
+// EffectivePermissions The operations the token of the caller allows in the project.
+type EffectivePermissions struct {
+	// CreateClusters Create clusters.
+	CreateClusters bool `json:"createClusters"`
+
+	// DeleteClusters Delete clusters.
+	DeleteClusters bool `json:"deleteClusters"`
+
+	// DownloadKubeconfigs Get the kubeconfigs of the clusters.
+	DownloadKubeconfigs bool `json:"downloadKubeconfigs"`
+
+	// ListClusters Get the clusters and their nodes, health and events.
+	ListClusters bool `json:"listClusters"`
+
+	// ListTemplates Get the cluster templates.
+	ListTemplates bool `json:"listTemplates"`
+
+	// ManageTemplates Import, update and delete cluster templates.
+	ManageTemplates bool `json:"manageTemplates"`
+
+	// PublishTemplates Publish and deprecate cluster template versions.
+	PublishTemplates bool `json:"publishTemplates"`
+
+	// UpdateClusters Update the labels, nodes, node pools and template of the clusters.
+	UpdateClusters bool `json:"updateClusters"`
+}
+
+// Extension defines model for Extension.
+type Extension struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Value of the default-extension label of the clusters the extension is deployed to
+	Name string `json:"name"`
+
+	// Providers Control plane provider types whose clusters the extension can be deployed to
+	Providers []string `json:"providers"`
+}
+
+// ExtensionCatalog defines model for ExtensionCatalog.
+type ExtensionCatalog struct {
+	Extensions []Extension `json:"extensions"`
+}
+
 // GenericStatus A generic status object.
 type GenericStatus struct {
 	// Indicator The status indicator.
@@ -155,42 +789,440 @@ type GenericStatus struct {
 	Timestamp *uint64 `json:"timestamp,omitempty"`
 }
 
+// HostImagePullStatus The status of the pull of the images of the template onto a host.
+type HostImagePullStatus struct {
+	// Error Why the images could not be pulled onto the host.
+	Error  *string `json:"error,omitempty"`
+	HostId string  `json:"hostId"`
+
+	// Pulled The number of images pulled onto the host so far.
+	Pulled int `json:"pulled"`
+
+	// State pulling until all images are pulled onto the host, then pulled, or failed if an image could not be pulled.
+	State string `json:"state"`
+
+	// Total The number of images to pull onto the host.
+	Total int `json:"total"`
+}
+
 // KubeconfigInfo defines model for KubeconfigInfo.
 type KubeconfigInfo struct {
 	Id         *string `json:"id,omitempty"`
 	Kubeconfig *string `json:"kubeconfig,omitempty"`
 }
 
-// NetworkRanges defines model for NetworkRanges.
-type NetworkRanges struct {
-	// CidrBlocks A list of CIDR blocks in valid CIDR notation.
+// LabelPropagationPreview defines model for LabelPropagationPreview.
+type LabelPropagationPreview struct {
+	// Clusters Clusters of the template whose labels would change or are kept because users overrode or removed them.
+	Clusters []ClusterLabelPropagation `json:"clusters"`
+
+	// LabelPropagationPolicy Label propagation policy of the template; the labels are only propagated with propagate.
+	LabelPropagationPolicy LabelPropagationPreviewLabelPropagationPolicy `json:"labelPropagationPolicy"`
+}
+
+// LabelPropagationPreviewLabelPropagationPolicy Label propagation policy of the template; the labels are only propagated with propagate.
+type LabelPropagationPreviewLabelPropagationPolicy string
+
+// MachineInfo Cluster API and provider machines backing the node, to troubleshoot nodes that fail to provision.
+type MachineInfo struct {
+	// BindingName Name of the IntelMachineBinding of the host to the cluster, unset until the host is bound
+	BindingName *string `json:"bindingName,omitempty"`
+
+	// BootstrapReady Whether the bootstrap data of the machine is ready
+	BootstrapReady *bool `json:"bootstrapReady,omitempty"`
+
+	// ContainerName Name of the container of the DockerMachine, unset for the other providers
+	ContainerName *string `json:"containerName,omitempty"`
+
+	// FailureMessage Last failure reported by the machine or its provider machine
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// HostId Host the IntelMachine is bound to, unset for the other providers
+	HostId *string `json:"hostId,omitempty"`
+
+	// InfrastructureReady Whether the provider machine is provisioned
+	InfrastructureReady *bool `json:"infrastructureReady,omitempty"`
+
+	// Name Name of the Cluster API machine
+	Name *string `json:"name,omitempty"`
+
+	// Phase Phase of the Cluster API machine (e.g. Pending, Provisioning, Running, Failed)
+	Phase *string `json:"phase,omitempty"`
+
+	// ProviderKind Kind of the provider machine
+	ProviderKind *string `json:"providerKind,omitempty"`
+
+	// ProviderMachineName Name of the provider machine
+	ProviderMachineName *string `json:"providerMachineName,omitempty"`
+}
+
+// NamingPolicy Naming policy the cluster names of the project have to follow.
+type NamingPolicy struct {
+	// ForbiddenWords Words cluster names must not contain, regardless of their case.
+	ForbiddenWords *[]string `json:"forbiddenWords,omitempty"`
+
+	// MaxLength Maximum length of cluster names; unset if only the length limit of Kubernetes applies.
+	MaxLength *int `json:"maxLength,omitempty"`
+
+	// Prefix Prefix cluster names must start with, e.g. a site code.
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// NetworkRanges defines model for NetworkRanges.
+type NetworkRanges struct {
+	// CidrBlocks A list of CIDR blocks in valid CIDR notation.
 	CidrBlocks []string `json:"cidrBlocks"`
 }
 
+// NodeCordonRequest How a node is cordoned; an empty object only cordons the node.
+type NodeCordonRequest struct {
+	// Drain Evicts the pods of the node within their pod disruption budgets once it is cordoned.
+	Drain *bool `json:"drain,omitempty"`
+
+	// MaintenanceWindow How long the host of the node is expected to be unavailable, e.g. while its OS is updated and it reboots; at most 24h.
+	MaintenanceWindow *string `json:"maintenanceWindow,omitempty"`
+}
+
+// NodeHealthCondition defines model for NodeHealthCondition.
+type NodeHealthCondition struct {
+	Message *string `json:"message,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+	Status  string  `json:"status"`
+	Type    string  `json:"type"`
+}
+
+// NodeHealthReport defines model for NodeHealthReport.
+type NodeHealthReport struct {
+	// Conditions The conditions of the node that deviate from the healthy state, e.g. not ready or memory pressure.
+	Conditions []NodeHealthCondition `json:"conditions"`
+	Name       string                `json:"name"`
+	Ready      bool                  `json:"ready"`
+}
+
+// NodeHealthSummary defines model for NodeHealthSummary.
+type NodeHealthSummary struct {
+	// Healthy The number of nodes whose node healthy condition is true.
+	Healthy int32 `json:"healthy"`
+
+	// Unhealthy The number of nodes whose node healthy condition is false.
+	Unhealthy int32 `json:"unhealthy"`
+
+	// Unknown The number of nodes without a node healthy condition yet or whose health is unknown.
+	Unknown int32 `json:"unknown"`
+}
+
 // NodeInfo defines model for NodeInfo.
 type NodeInfo struct {
 	// Id Host resource id
-	Id     *string     `json:"id,omitempty"`
-	Role   *string     `json:"role,omitempty"`
-	Status *StatusInfo `json:"status,omitempty"`
+	Id *string `json:"id,omitempty"`
+
+	// Machine Cluster API and provider machines backing the node, to troubleshoot nodes that fail to provision.
+	Machine *MachineInfo `json:"machine,omitempty"`
+
+	// Metadata Host metadata copied from the inventory, limited to the allowed keys (e.g. asset tag, site, rack)
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// Remediation Health check of the machine of the node by the MachineHealthCheck of the cluster, unset if the machine is not checked.
+	Remediation *NodeRemediation `json:"remediation,omitempty"`
+	Role        *string          `json:"role,omitempty"`
+	Status      *StatusInfo      `json:"status,omitempty"`
 }
 
+// NodeMaintenance Whether a node of a cluster is cordoned and the end of the maintenance window of its host.
+type NodeMaintenance struct {
+	// MaintenanceWindowEnd The end of the maintenance window of the host, set while the node is cordoned for a maintenance.
+	MaintenanceWindowEnd *time.Time `json:"maintenanceWindowEnd,omitempty"`
+
+	// NodeId The id of the host of the node.
+	NodeId string `json:"nodeId"`
+
+	// NodeName The name of the node in the workload cluster.
+	NodeName string `json:"nodeName"`
+
+	// Unschedulable Whether the node is cordoned.
+	Unschedulable bool `json:"unschedulable"`
+}
+
+// NodePool defines model for NodePool.
+type NodePool struct {
+	// Labels Kubernetes node labels applied to every node of the pool.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Name Name of the node pool, unique within the cluster
+	Name string `json:"name"`
+
+	// Nodes UUIDs of the hosts backing the pool. Required by infrastructure providers binding machines to hosts, in which case it must have one host per replica. Only accepted when the pool is created.
+	Nodes *[]string `json:"nodes,omitempty"`
+
+	// Replicas Number of worker nodes in the pool.
+	Replicas int32 `json:"replicas"`
+
+	// Taints Kubernetes taints applied to every node of the pool.
+	Taints *[]NodeTaint `json:"taints,omitempty"`
+}
+
+// NodePoolList defines model for NodePoolList.
+type NodePoolList struct {
+	NodePools *[]NodePool `json:"nodePools,omitempty"`
+}
+
+// NodePoolUpdate defines model for NodePoolUpdate.
+type NodePoolUpdate struct {
+	// Labels Kubernetes node labels applied to every node of the pool.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Replicas Number of worker nodes in the pool.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Taints Kubernetes taints applied to every node of the pool.
+	Taints *[]NodeTaint `json:"taints,omitempty"`
+}
+
+// NodeRemediation Health check of the machine of the node by the MachineHealthCheck of the cluster, unset if the machine is not checked.
+type NodeRemediation struct {
+	Message *string `json:"message,omitempty"`
+
+	// Reason Reason of the state, e.g. UnhealthyNode, NodeStartupTimeout or WaitingForRemediation
+	Reason *string `json:"reason,omitempty"`
+
+	// Since Time the node entered the state
+	Since *time.Time `json:"since,omitempty"`
+
+	// State Whether the node is healthy, unhealthy, or unhealthy and its machine being replaced.
+	State *NodeRemediationState `json:"state,omitempty"`
+}
+
+// NodeRemediationState Whether the node is healthy, unhealthy, or unhealthy and its machine being replaced.
+type NodeRemediationState string
+
 // NodeSpec defines model for NodeSpec.
 type NodeSpec struct {
 	// Id UUID of the host.
-	Id   string       `json:"id"`
+	Id string `json:"id"`
+
+	// Role Role of the node. Nodes of the role all and controlplane run the control plane and its etcd members, worker nodes join the worker node pool. Dedicated etcd nodes run only the etcd members and are only supported by control plane providers running etcd apart from the control plane; k3s and kubeadm do not.
 	Role NodeSpecRole `json:"role"`
 }
 
-// NodeSpecRole defines model for NodeSpec.Role.
+// NodeSpecRole Role of the node. Nodes of the role all and controlplane run the control plane and its etcd members, worker nodes join the worker node pool. Dedicated etcd nodes run only the etcd members and are only supported by control plane providers running etcd apart from the control plane; k3s and kubeadm do not.
 type NodeSpecRole string
 
+// NodeTaint defines model for NodeTaint.
+type NodeTaint struct {
+	Effect NodeTaintEffect `json:"effect"`
+	Key    string          `json:"key"`
+	Value  *string         `json:"value,omitempty"`
+}
+
+// NodeTaintEffect defines model for NodeTaint.Effect.
+type NodeTaintEffect string
+
+// NodeValidationFailure defines model for NodeValidationFailure.
+type NodeValidationFailure struct {
+	// Id The id of the node.
+	Id      string                 `json:"id"`
+	Reasons []NodeValidationReason `json:"reasons"`
+}
+
+// NodeValidationProblem defines model for NodeValidationProblem.
+type NodeValidationProblem struct {
+	// Code stable code of the error, independent of the language of the message
+	Code *string `json:"code,omitempty"`
+
+	// Message error message, localized according to the Accept-Language header of the request
+	Message *string `json:"message,omitempty"`
+
+	// Nodes The nodes whose hosts cannot be used for the cluster.
+	Nodes []NodeValidationFailure `json:"nodes"`
+}
+
+// NodeValidationReason defines model for NodeValidationReason.
+type NodeValidationReason struct {
+	Message string                   `json:"message"`
+	Type    NodeValidationReasonType `json:"type"`
+}
+
+// NodeValidationReasonType defines model for NodeValidationReason.Type.
+type NodeValidationReasonType string
+
+// Operation A long-running cluster operation, e.g. the creation of a cluster.
+type Operation struct {
+	// Cluster The name of the cluster the operation acts upon.
+	Cluster     string     `json:"cluster"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+
+	// Error Why the operation failed.
+	Error *string            `json:"error,omitempty"`
+	Id    openapi_types.UUID `json:"id"`
+
+	// Progress How far the operation got, e.g. "Provisioned: control plane not ready".
+	Progress *string        `json:"progress,omitempty"`
+	State    OperationState `json:"state"`
+
+	// Template The cluster template the cluster is created from or upgraded to.
+	Template  *string       `json:"template,omitempty"`
+	Type      OperationType `json:"type"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// OperationState defines model for Operation.State.
+type OperationState string
+
+// OperationType defines model for Operation.Type.
+type OperationType string
+
+// OperationList defines model for OperationList.
+type OperationList struct {
+	Operations *[]Operation `json:"operations,omitempty"`
+}
+
+// PendingCluster A cluster whose provisioning is deferred until provisionAt.
+type PendingCluster struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Why the cluster could not be created.
+	Error *string `json:"error,omitempty"`
+	Name  string  `json:"name"`
+
+	// Preflight The image pull status of the hosts of the nodes, set if the cluster requested image preflight.
+	Preflight   *[]HostImagePullStatus `json:"preflight,omitempty"`
+	ProvisionAt time.Time              `json:"provisionAt"`
+	Spec        ClusterSpec            `json:"spec"`
+
+	// State preflight until the hosts pulled the images of the template, scheduled until provisionAt, provisioning while the cluster is created and failed if it could not be created; pending clusters are deleted once their cluster is created.
+	State     PendingClusterState `json:"state"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+}
+
+// PendingClusterState preflight until the hosts pulled the images of the template, scheduled until provisionAt, provisioning while the cluster is created and failed if it could not be created; pending clusters are deleted once their cluster is created.
+type PendingClusterState string
+
+// PendingClusterList defines model for PendingClusterList.
+type PendingClusterList struct {
+	PendingClusters *[]PendingCluster `json:"pendingClusters,omitempty"`
+}
+
+// PodHealthReport defines model for PodHealthReport.
+type PodHealthReport struct {
+	Name     string  `json:"name"`
+	Phase    string  `json:"phase"`
+	Reason   *string `json:"reason,omitempty"`
+	Restarts int32   `json:"restarts"`
+}
+
 // ProblemDetails defines model for ProblemDetails.
 type ProblemDetails struct {
-	// Message error message
+	// Code stable code of the error, independent of the language of the message; every error response has a code, the codes are enumerated by GET /v2/openapi.json
+	Code *string `json:"code,omitempty"`
+
+	// Message error message, localized according to the Accept-Language header of the request
 	Message *string `json:"message,omitempty"`
 }
 
+// ProjectSummary defines model for ProjectSummary.
+type ProjectSummary struct {
+	// Clusters The number of clusters of the project.
+	Clusters int32 `json:"clusters"`
+
+	// ClustersByPhase The number of clusters per Cluster API phase, e.g. Provisioning or Provisioned; clusters in maintenance are counted in the Maintenance phase.
+	ClustersByPhase map[string]int32 `json:"clustersByPhase"`
+
+	// Nodes The number of nodes of the clusters of the project.
+	Nodes         int32             `json:"nodes"`
+	NodesByHealth NodeHealthSummary `json:"nodesByHealth"`
+
+	// Templates The template versions of the project, sorted by name.
+	Templates []TemplateUsage `json:"templates"`
+}
+
+// ProjectTemplateClusters defines model for ProjectTemplateClusters.
+type ProjectTemplateClusters struct {
+	// Clusters Count of the clusters of the project created from the template
+	Clusters int `json:"clusters"`
+
+	// ProjectId ID of the project
+	ProjectId string `json:"projectId"`
+}
+
+// Readiness The readiness of the server with the details of its checks.
+type Readiness struct {
+	// CacheWarmup The progress of the warmup of the cache the reads are served from, absent if the cache is disabled.
+	CacheWarmup *CacheWarmup `json:"cacheWarmup,omitempty"`
+
+	// Failures The failed checks; the cache not being synced is not a failure while it is warmed up.
+	Failures *[]string `json:"failures,omitempty"`
+
+	// Ready Whether the server is ready to handle requests.
+	Ready bool `json:"ready"`
+}
+
+// RegistryMirror Site-local mirror of a public registry.
+type RegistryMirror struct {
+	// Endpoint URL of the mirror.
+	Endpoint string `json:"endpoint"`
+
+	// Registry Host name of the mirrored registry with an optional port.
+	Registry string `json:"registry"`
+}
+
+// RemediationConfig When the machines of the control plane and of the node pools of the clusters created with the template are unhealthy and replaced. Cluster API remediates the machines with a MachineHealthCheck per control plane and node pool.
+type RemediationConfig struct {
+	// MaxUnhealthy Number or percentage of unhealthy machines of the control plane or of a node pool above which no machine is remediated. Defaults to 100%.
+	MaxUnhealthy *string `json:"maxUnhealthy,omitempty"`
+
+	// NodeStartupTimeout How long a machine may take to join the cluster as a node before it is remediated. 0s disables the check. Defaults to 10m.
+	NodeStartupTimeout *string `json:"nodeStartupTimeout,omitempty"`
+
+	// UnhealthyConditions Node conditions marking a node unhealthy once they last longer than their timeout. Defaults to Ready Unknown or False for 5m.
+	UnhealthyConditions *[]UnhealthyCondition `json:"unhealthyConditions,omitempty"`
+}
+
+// ReservedResources CPU and memory the kubelet of every node keeps from the pods for the system daemons, edge agents and Kubernetes components.
+type ReservedResources struct {
+	// Kube An amount of CPU and memory in the Kubernetes quantity format.
+	Kube *ResourceReservation `json:"kube,omitempty"`
+
+	// System An amount of CPU and memory in the Kubernetes quantity format.
+	System *ResourceReservation `json:"system,omitempty"`
+}
+
+// ResourceReservation An amount of CPU and memory in the Kubernetes quantity format.
+type ResourceReservation struct {
+	Cpu    *string `json:"cpu,omitempty"`
+	Memory *string `json:"memory,omitempty"`
+}
+
+// SSHAccessConfig Break-glass SSH access to the nodes of the clusters created with the template, with authorized keys or user certificates signed by a trusted CA.
+type SSHAccessConfig struct {
+	// AuthorizedKeys SSH public keys in authorized_keys format that may log in as the user.
+	AuthorizedKeys *[]string `json:"authorizedKeys,omitempty"`
+
+	// TrustedUserCAKeys SSH CA public keys whose signed user certificates are accepted for the principals of the certificates.
+	TrustedUserCAKeys *[]string `json:"trustedUserCAKeys,omitempty"`
+
+	// User User the authorized keys are installed for, created with passwordless sudo. Defaults to breakglass.
+	User *string `json:"user,omitempty"`
+}
+
+// SingleNodeConfig Single-node mode of the clusters created with the template, whose only node runs the control plane and the workloads. The control plane is not tainted, etcd snapshots are written to the local disk and a reimaged host rejoins its cluster according to the rejoin policy. Clusters created with the template have exactly one node. Only supported by the k3s control plane provider.
+type SingleNodeConfig struct {
+	// EtcdSnapshotDir Directory of the local disk the etcd snapshots are written to. It must be on a partition that is kept when the host is reimaged for the node to rejoin its cluster. Defaults to /var/lib/rancher/k3s/server/db/snapshots.
+	EtcdSnapshotDir *string `json:"etcdSnapshotDir,omitempty"`
+
+	// EtcdSnapshotRetention Number of etcd snapshots kept. Defaults to 5.
+	EtcdSnapshotRetention *int32 `json:"etcdSnapshotRetention,omitempty"`
+
+	// EtcdSnapshotSchedule Cron schedule of the etcd snapshots. Defaults to every 6 hours.
+	EtcdSnapshotSchedule *string `json:"etcdSnapshotSchedule,omitempty"`
+
+	// RejoinPolicy What a reimaged host does when it bootstraps its node again. With restore, the state of the cluster is restored from the latest local etcd snapshot so that the host rejoins its cluster with its workloads; with none, the cluster is bootstrapped anew. Defaults to restore.
+	RejoinPolicy *SingleNodeConfigRejoinPolicy `json:"rejoinPolicy,omitempty"`
+}
+
+// SingleNodeConfigRejoinPolicy What a reimaged host does when it bootstraps its node again. With restore, the state of the cluster is restored from the latest local etcd snapshot so that the host rejoins its cluster with its workloads; with none, the cluster is bootstrapped anew. Defaults to restore.
+type SingleNodeConfigRejoinPolicy string
+
 // StatusIndicator The status indicator.
 type StatusIndicator string
 
@@ -201,43 +1233,379 @@ type StatusInfo struct {
 	Timestamp *string              `json:"timestamp,omitempty"`
 }
 
-// StatusInfoCondition defines model for StatusInfo.Condition.
-type StatusInfoCondition string
+// StatusInfoCondition defines model for StatusInfo.Condition.
+type StatusInfoCondition string
+
+// SupportMatrix defines model for SupportMatrix.
+type SupportMatrix struct {
+	ControlPlaneProviders []ControlPlaneProviderSupport `json:"controlPlaneProviders"`
+}
+
+// TeardownState The state of the teardown of the control plane or infrastructure cluster of a deleting cluster, "pending" until Cluster API deletes it, "deleting" until its finalizers are removed and "deleted" once it is removed.
+type TeardownState string
+
+// TemplateBundle defines model for TemplateBundle.
+type TemplateBundle struct {
+	// ClusterClass ClusterClass generated from the template, without cluster specific metadata and status. It is included for reference only; importing the template generates it again. Omitted if it has not been generated yet.
+	ClusterClass *map[string]interface{} `json:"clusterClass,omitempty"`
+	Template     TemplateInfo            `json:"template"`
+}
+
+// TemplateClusterLabels defines model for TemplateClusterLabels.
+type TemplateClusterLabels struct {
+	// ClusterLabels New cluster labels of the template.
+	ClusterLabels map[string]string `json:"cluster-labels"`
+}
+
+// TemplateClusters defines model for TemplateClusters.
+type TemplateClusters struct {
+	// Clusters Names of the clusters of the active project created from the template, sorted by name.
+	Clusters []string `json:"clusters"`
+
+	// Projects Count of the clusters created from the template in each project that has any, sorted by project. Only set for callers allowed to administrate the platform.
+	Projects *[]ProjectTemplateClusters `json:"projects,omitempty"`
+
+	// TotalClusters Count of the clusters created from the template in all projects. Only set for callers allowed to administrate the platform.
+	TotalClusters *int `json:"totalClusters,omitempty"`
+}
+
+// TemplateCompatibility defines model for TemplateCompatibility.
+type TemplateCompatibility struct {
+	// Controlplaneprovidertype The control plane provider type of the template.
+	Controlplaneprovidertype string `json:"controlplaneprovidertype"`
+
+	// InfraProviders The infra providers the control plane provider of the template can be combined with.
+	InfraProviders []string `json:"infraProviders"`
+
+	// Infraprovidertype The infra provider type of the template.
+	Infraprovidertype string `json:"infraprovidertype"`
+
+	// KubernetesVersion The Kubernetes version of the template.
+	KubernetesVersion string `json:"kubernetesVersion"`
+
+	// KubernetesVersionSupported Whether the Kubernetes version is in a support window of the control plane provider.
+	KubernetesVersionSupported bool `json:"kubernetesVersionSupported"`
+
+	// MaxKubernetesVersion The most recent Kubernetes minor version supported by a release of the control plane provider; omitted if the support matrix has no release of the provider.
+	MaxKubernetesVersion *string `json:"maxKubernetesVersion,omitempty"`
+
+	// MinKubernetesVersion The oldest Kubernetes minor version supported by a release of the control plane provider; omitted if the support matrix has no release of the provider.
+	MinKubernetesVersion *string `json:"minKubernetesVersion,omitempty"`
+
+	// Template The template version.
+	Template string `json:"template"`
+
+	// Upgrades The published templates the clusters of the template can be upgraded to, with the version skew each would create; targets outside the support windows of the control plane provider are flagged as well.
+	Upgrades []ClusterUpgrade `json:"upgrades"`
+}
+
+// TemplateCompatibilityList defines model for TemplateCompatibilityList.
+type TemplateCompatibilityList struct {
+	Templates []TemplateCompatibility `json:"templates"`
+}
+
+// TemplateCondition defines model for TemplateCondition.
+type TemplateCondition struct {
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+	Message            *string    `json:"message,omitempty"`
+	Reason             *string    `json:"reason,omitempty"`
+
+	// Severity Severity of a false condition.
+	Severity *TemplateConditionSeverity `json:"severity,omitempty"`
+	Status   TemplateConditionStatus    `json:"status"`
+	Type     string                     `json:"type"`
+}
+
+// TemplateConditionSeverity Severity of a false condition.
+type TemplateConditionSeverity string
+
+// TemplateConditionStatus defines model for TemplateCondition.Status.
+type TemplateConditionStatus string
+
+// TemplateInfo defines model for TemplateInfo.
+type TemplateInfo struct {
+	// AirGap Installs k3s from site-local artifacts, or pulls the kubeadm images from site-local registries, instead of the internet. artifactURL, imageTarballs, systemDefaultRegistry and installScriptPath apply to k3s; imageRepository, coreDNSImageRepository, etcdImageRepository and registryMirrors apply to kubeadm; images applies to both.
+	AirGap *AirGapConfig `json:"airGap,omitempty"`
+
+	// AirGapped Clusters created with the template are installed without internet access from the airGap settings, which are required. kubeadm clusters are only installed air-gapped with the flag; k3s clusters whenever airGap is set.
+	AirGapped *bool `json:"airGapped,omitempty"`
+
+	// ClusterLabels Allows users to specify a list of key/value pairs to be attached to a cluster created with the template. These pairs need to conform to https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
+	ClusterLabels *map[string]string `json:"cluster-labels,omitempty"`
+
+	// ClusterNetwork Cluster network configuration, including pod and service CIDR blocks.
+	ClusterNetwork           *ClusterNetwork                       `json:"clusterNetwork,omitempty"`
+	Clusterconfiguration     *map[string]interface{}               `json:"clusterconfiguration,omitempty"`
+	Controlplaneprovidertype *TemplateInfoControlplaneprovidertype `json:"controlplaneprovidertype,omitempty"`
+	Description              *string                               `json:"description,omitempty"`
+	Infraprovidertype        *TemplateInfoInfraprovidertype        `json:"infraprovidertype,omitempty"`
+	KubernetesVersion        string                                `json:"kubernetesVersion"`
+
+	// LabelPropagationPolicy Whether changes of the cluster labels of the template are propagated to the existing clusters created with it. With propagate, added and changed labels are applied to the clusters, except for the labels users overrode or removed on a cluster.
+	LabelPropagationPolicy *TemplateInfoLabelPropagationPolicy `json:"labelPropagationPolicy,omitempty"`
+
+	// LifecycleState Lifecycle state of the template. Templates are created as drafts and only published templates can be used to create clusters.
+	LifecycleState *TemplateInfoLifecycleState `json:"lifecycleState,omitempty"`
+
+	// MinNodeResources An amount of CPU and memory in the Kubernetes quantity format.
+	MinNodeResources *ResourceReservation `json:"minNodeResources,omitempty"`
+	Name             string               `json:"name"`
+
+	// Remediation When the machines of the control plane and of the node pools of the clusters created with the template are unhealthy and replaced. Cluster API remediates the machines with a MachineHealthCheck per control plane and node pool.
+	Remediation *RemediationConfig `json:"remediation,omitempty"`
+
+	// RequireTrustedCompute Clusters created with the template run trusted compute workloads. It requires the intel infra provider and clusters can only be created on hosts whose measured boot passed the attestation.
+	RequireTrustedCompute *bool `json:"requireTrustedCompute,omitempty"`
+
+	// ReservedResources CPU and memory the kubelet of every node keeps from the pods for the system daemons, edge agents and Kubernetes components.
+	ReservedResources *ReservedResources `json:"reservedResources,omitempty"`
+
+	// ResourceVersion Version of the template resource, changes whenever the template is modified. Send it as If-Match to modify the template only if nobody else modified it in the meantime.
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+	// Signature Base64 encoded cosign signature of the template, created with `cosign sign-blob --key` over the signed payload of the template: the template as JSON without its signature and read-only fields, see `cmctl templates payload`. Only key-based signatures with ECDSA, Ed25519 or RSA keys are supported.
+	Signature *string `json:"signature,omitempty"`
+
+	// SignatureVerification Whether the signature of the template was verified with the trusted keys of the cluster manager. Omitted if no trusted keys are configured.
+	SignatureVerification *TemplateInfoSignatureVerification `json:"signatureVerification,omitempty"`
+
+	// SingleNode Single-node mode of the clusters created with the template, whose only node runs the control plane and the workloads. The control plane is not tainted, etcd snapshots are written to the local disk and a reimaged host rejoins its cluster according to the rejoin policy. Clusters created with the template have exactly one node. Only supported by the k3s control plane provider.
+	SingleNode *SingleNodeConfig `json:"singleNode,omitempty"`
+
+	// SshAccess Break-glass SSH access to the nodes of the clusters created with the template, with authorized keys or user certificates signed by a trusted CA.
+	SshAccess *SSHAccessConfig `json:"sshAccess,omitempty"`
+
+	// Status Status of the resources rendered from the template by the template controller. Omitted until the template was reconciled.
+	Status *TemplateStatus `json:"status,omitempty"`
+
+	// SunsetDate Date after which clusters still using the template once it is deprecated are no longer supported. Clusters using deprecated templates are flagged with the TemplateDeprecated condition.
+	SunsetDate *time.Time `json:"sunsetDate,omitempty"`
+
+	// Variables Typed variables the clusters created with the template set in their spec. They are declared as variables of the ClusterClass and set in the control plane and worker templates by their patches.
+	Variables *[]TemplateVariable `json:"variables,omitempty"`
+	Version   string              `json:"version"`
+
+	// Vsphere vCenter placement of the virtual machines of the clusters created with the template. Required by the vsphere infra provider.
+	Vsphere *VSphereConfig `json:"vsphere,omitempty"`
+}
+
+// TemplateInfoControlplaneprovidertype defines model for TemplateInfo.Controlplaneprovidertype.
+type TemplateInfoControlplaneprovidertype string
+
+// TemplateInfoInfraprovidertype defines model for TemplateInfo.Infraprovidertype.
+type TemplateInfoInfraprovidertype string
+
+// TemplateInfoLabelPropagationPolicy Whether changes of the cluster labels of the template are propagated to the existing clusters created with it. With propagate, added and changed labels are applied to the clusters, except for the labels users overrode or removed on a cluster.
+type TemplateInfoLabelPropagationPolicy string
+
+// TemplateInfoLifecycleState Lifecycle state of the template. Templates are created as drafts and only published templates can be used to create clusters.
+type TemplateInfoLifecycleState string
+
+// TemplateInfoSignatureVerification Whether the signature of the template was verified with the trusted keys of the cluster manager. Omitted if no trusted keys are configured.
+type TemplateInfoSignatureVerification string
+
+// TemplateInfoList defines model for TemplateInfoList.
+type TemplateInfoList struct {
+	DefaultTemplateInfo *DefaultTemplateInfo `json:"defaultTemplateInfo,omitempty"`
+	TemplateInfoList    *[]TemplateInfo      `json:"templateInfoList,omitempty"`
+
+	// TotalElements The count of items in the entire list, regardless of pagination.
+	TotalElements *int32 `json:"totalElements,omitempty"`
+}
+
+// TemplateStatus Status of the resources rendered from the template by the template controller. Omitted until the template was reconciled.
+type TemplateStatus struct {
+	// Conditions Conditions of the template, e.g. ProvidersValidated, ConfigRendered and ClusterClassCreated, and the Ready condition summarizing them.
+	Conditions []TemplateCondition `json:"conditions"`
+
+	// Ready Whether all the resources of the template were rendered, so clusters can be created with it.
+	Ready bool `json:"ready"`
+}
+
+// TemplateUpload A session uploading a template in chunks.
+type TemplateUpload struct {
+	// ExpiresAt When the session expires unless another chunk is appended.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Received The number of bytes received so far, the offset of the next chunk.
+	Received int64 `json:"received"`
+
+	// Sha256 The declared sha256 checksum of the template.
+	Sha256 string `json:"sha256"`
+
+	// Size The declared size of the template in bytes.
+	Size int64 `json:"size"`
+}
+
+// TemplateUploadChunk A chunk of a template upload.
+type TemplateUploadChunk struct {
+	// Data The base64 encoded chunk of at most 512KiB.
+	Data []byte `json:"data"`
+
+	// Offset The position of the chunk in the template, i.e. the size received so far.
+	Offset int64 `json:"offset"`
+}
+
+// TemplateUploadRequest The template to be uploaded in chunks.
+type TemplateUploadRequest struct {
+	// Sha256 The lowercase hex sha256 checksum of the template.
+	Sha256 string `json:"sha256"`
+
+	// Size The size of the template in bytes, at most 1 MiB, as the template is stored as a single object.
+	Size int64 `json:"size"`
+}
+
+// TemplateUsage defines model for TemplateUsage.
+type TemplateUsage struct {
+	// Clusters The number of clusters created from the template version.
+	Clusters int32 `json:"clusters"`
+
+	// Template The name of the template version, e.g. baseline-v1.0.0.
+	Template string `json:"template"`
+}
+
+// TemplateVariable Typed variable of a template whose value is set per cluster.
+type TemplateVariable struct {
+	// Default Value of the clusters that do not set the variable, in its string form.
+	Default     *string `json:"default,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Enum Values of an enum variable.
+	Enum *[]string `json:"enum,omitempty"`
+
+	// MaxLength Maximum length of the value of a string variable.
+	MaxLength *int64 `json:"maxLength,omitempty"`
+
+	// Maximum Maximum of the value of an integer variable.
+	Maximum *int64 `json:"maximum,omitempty"`
+
+	// MinLength Minimum length of the value of a string variable.
+	MinLength *int64 `json:"minLength,omitempty"`
+
+	// Minimum Minimum of the value of an integer variable.
+	Minimum *int64 `json:"minimum,omitempty"`
+
+	// Name Name of the variable in the cluster spec and in the value templates of the patches. It must not be a variable of the control plane and infra providers.
+	Name string `json:"name"`
+
+	// Patches JSON patches setting the value of the variable in the control plane or worker templates of the clusters. The patches of variables that are neither required nor defaulted only apply to the clusters setting a non-empty value.
+	Patches *[]TemplateVariablePatch `json:"patches,omitempty"`
+
+	// Pattern Regular expression the value of a string variable must match.
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Required Rejects clusters that do not set the variable; ignored if the variable has a default.
+	Required *bool                `json:"required,omitempty"`
+	Type     TemplateVariableType `json:"type"`
+}
+
+// TemplateVariableType defines model for TemplateVariable.Type.
+type TemplateVariableType string
+
+// TemplateVariablePatch JSON patch of the control plane template or of the bootstrap template of the worker node pools setting the value of a template variable.
+type TemplateVariablePatch struct {
+	Op *TemplateVariablePatchOp `json:"op,omitempty"`
+
+	// Path JSON pointer of the patched field.
+	Path   string                      `json:"path"`
+	Target TemplateVariablePatchTarget `json:"target"`
+
+	// ValueTemplate Go template rendering the patched value from the variables; the value of the variable is patched as is if it is empty.
+	ValueTemplate *string `json:"valueTemplate,omitempty"`
+}
+
+// TemplateVariablePatchOp defines model for TemplateVariablePatch.Op.
+type TemplateVariablePatchOp string
+
+// TemplateVariablePatchTarget defines model for TemplateVariablePatch.Target.
+type TemplateVariablePatchTarget string
+
+// UnhealthyCondition Node condition marking a node unhealthy once it lasts longer than the timeout.
+type UnhealthyCondition struct {
+	Status UnhealthyConditionStatus `json:"status"`
+
+	// Timeout How long the node condition may last before the node is unhealthy.
+	Timeout string `json:"timeout"`
+
+	// Type Type of the node condition.
+	Type string `json:"type"`
+}
+
+// UnhealthyConditionStatus defines model for UnhealthyCondition.Status.
+type UnhealthyConditionStatus string
+
+// UpgradeWarning defines model for UpgradeWarning.
+type UpgradeWarning struct {
+	Message string             `json:"message"`
+	Type    UpgradeWarningType `json:"type"`
+}
+
+// UpgradeWarningType defines model for UpgradeWarning.Type.
+type UpgradeWarningType string
+
+// VSphereConfig vCenter placement of the virtual machines of the clusters created with the template. Required by the vsphere infra provider.
+type VSphereConfig struct {
+	// CredentialsSecret Secret in the project holding the username and password to log in to vCenter with. The credentials of the vSphere provider are used if it is empty.
+	CredentialsSecret *string `json:"credentialsSecret,omitempty"`
+
+	// Datacenter Name or inventory path of the datacenter the virtual machines are created in.
+	Datacenter string `json:"datacenter"`
+
+	// Datastore Name or inventory path of the datastore of the virtual machines. Defaults to the datastore of the template.
+	Datastore *string `json:"datastore,omitempty"`
+
+	// Folder Name or inventory path of the folder of the virtual machines.
+	Folder *string `json:"folder,omitempty"`
+
+	// Network Name or inventory path of the network the virtual machines are connected to with DHCP.
+	Network string `json:"network"`
+
+	// ResourcePool Name or inventory path of the resource pool of the virtual machines.
+	ResourcePool *string `json:"resourcePool,omitempty"`
+
+	// Server Address of the vCenter server.
+	Server string `json:"server"`
+
+	// Template Name or inventory path of the virtual machine template the virtual machines are cloned from.
+	Template string `json:"template"`
+
+	// Thumbprint SHA-1 thumbprint of the certificate of the vCenter server. The certificate is verified against the trusted CAs if it is empty.
+	Thumbprint *string `json:"thumbprint,omitempty"`
+}
+
+// VersionList defines model for VersionList.
+type VersionList struct {
+	VersionList *[]string `json:"versionList,omitempty"`
+}
 
-// TemplateInfo defines model for TemplateInfo.
-type TemplateInfo struct {
-	// ClusterLabels Allows users to specify a list of key/value pairs to be attached to a cluster created with the template. These pairs need to conform to https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
-	ClusterLabels *map[string]string `json:"cluster-labels,omitempty"`
+// VersionedClusterLabels defines model for VersionedClusterLabels.
+type VersionedClusterLabels struct {
+	// Labels User labels of the cluster.
+	Labels map[string]string `json:"labels"`
 
-	// ClusterNetwork Cluster network configuration, including pod and service CIDR blocks.
-	ClusterNetwork           *ClusterNetwork                       `json:"clusterNetwork,omitempty"`
-	Clusterconfiguration     *map[string]interface{}               `json:"clusterconfiguration,omitempty"`
-	Controlplaneprovidertype *TemplateInfoControlplaneprovidertype `json:"controlplaneprovidertype,omitempty"`
-	Description              *string                               `json:"description,omitempty"`
-	Infraprovidertype        *TemplateInfoInfraprovidertype        `json:"infraprovidertype,omitempty"`
-	KubernetesVersion        string                                `json:"kubernetesVersion"`
-	Name                     string                                `json:"name"`
-	Version                  string                                `json:"version"`
+	// ResourceVersion Version of the cluster resource after the update.
+	ResourceVersion string `json:"resourceVersion"`
 }
 
-// TemplateInfoControlplaneprovidertype defines model for TemplateInfo.Controlplaneprovidertype.
-type TemplateInfoControlplaneprovidertype string
+// WebhookDestination defines model for WebhookDestination.
+type WebhookDestination struct {
+	// AllowPrivateNetwork Whether the destination may resolve to loopback, private or link-local addresses.
+	AllowPrivateNetwork bool `json:"allowPrivateNetwork"`
 
-// TemplateInfoInfraprovidertype defines model for TemplateInfo.Infraprovidertype.
-type TemplateInfoInfraprovidertype string
+	// Host Host name of the destination, a leading '*.' matches any subdomain.
+	Host string `json:"host"`
 
-// TemplateInfoList defines model for TemplateInfoList.
-type TemplateInfoList struct {
-	DefaultTemplateInfo *DefaultTemplateInfo `json:"defaultTemplateInfo,omitempty"`
-	TemplateInfoList    *[]TemplateInfo      `json:"templateInfoList,omitempty"`
+	// PinnedPublicKeys Base64-encoded SHA-256 hashes of the subject public key info of the certificates the destination must present, any certificate trusted by the system is accepted if empty.
+	PinnedPublicKeys *[]string `json:"pinnedPublicKeys,omitempty"`
 
-	// TotalElements The count of items in the entire list, regardless of pagination.
-	TotalElements *int32 `json:"totalElements,omitempty"`
+	// Port HTTPS port of the destination.
+	Port int `json:"port"`
 }
 
-// VersionList defines model for VersionList.
-type VersionList struct {
-	VersionList *[]string `json:"versionList,omitempty"`
+// WebhookDestinationList defines model for WebhookDestinationList.
+type WebhookDestinationList struct {
+	Destinations *[]WebhookDestination `json:"destinations,omitempty"`
 }
 
 // ClusterNetwork Cluster network configuration, including pod and service CIDR blocks.
@@ -249,6 +1617,15 @@ type ClusterNetwork struct {
 // ActiveProjectIdHeader defines model for ActiveProjectIdHeader.
 type ActiveProjectIdHeader = openapi_types.UUID
 
+// ClusterIfMatchHeader defines model for ClusterIfMatchHeader.
+type ClusterIfMatchHeader = string
+
+// IdempotencyKeyHeader defines model for IdempotencyKeyHeader.
+type IdempotencyKeyHeader = string
+
+// IfMatchHeader defines model for IfMatchHeader.
+type IfMatchHeader = string
+
 // ProjectNamePath defines model for ProjectNamePath.
 type ProjectNamePath = string
 
@@ -258,6 +1635,9 @@ type N400BadRequest = ProblemDetails
 // N401Unauthorized defines model for 401-Unauthorized.
 type N401Unauthorized = ProblemDetails
 
+// N403Forbidden defines model for 403-Forbidden.
+type N403Forbidden = ProblemDetails
+
 // N404NotFound defines model for 404-NotFound.
 type N404NotFound = ProblemDetails
 
@@ -270,6 +1650,12 @@ type N500InternalServerError = ProblemDetails
 // N501NotImplemented defines model for 501-NotImplemented.
 type N501NotImplemented = ProblemDetails
 
+// GetV2AuthzSelfParams defines parameters for GetV2AuthzSelf.
+type GetV2AuthzSelfParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+	Authorization   *string               `json:"Authorization,omitempty"`
+}
+
 // GetV2ClustersParams defines parameters for GetV2Clusters.
 type GetV2ClustersParams struct {
 	// PageSize The maximum number of items to return.
@@ -278,6 +1664,9 @@ type GetV2ClustersParams struct {
 	// Offset Index of the first item to return. It is almost always used in conjunction with the 'pageSize' query.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
+	// PageToken The nextPageToken of the previous page, to continue listing with the same filter and orderBy. It cannot be combined with offset. Unfiltered listings and listings filtered by labels only are paged by Kubernetes, so only the clusters of the page are loaded.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
 	// OrderBy The ordering of the entries. "asc" and "desc" are valid values. If none is specified, "asc" is used.
 	//
 	// Supported fields:
@@ -293,13 +1682,36 @@ type GetV2ClustersParams struct {
 	// - name
 	// - kubernetesVersion
 	// - providerStatus
-	// - lifecyclePhase
+	// - lifecyclePhase (e.g. pending-delete for the clusters pending deletion)
+	// - template (exact match, indexed)
+	// - phase (exact match of the cluster phase, e.g. Provisioned, indexed)
+	// - labels.{key} (exact match of the label value, indexed)
+	//
+	// Filters on indexed fields combined with AND are served from the cluster cache without listing all clusters.
+	// Without the cluster cache, filters on labels combined with AND are passed to Kubernetes as a label selector, so only the matching clusters are listed.
 	Filter          *string               `form:"filter,omitempty" json:"filter,omitempty"`
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
 // PostV2ClustersParams defines parameters for PostV2Clusters.
 type PostV2ClustersParams struct {
+	// DryRun When set to true, validates and renders the cluster like a create request and returns the objects it would create without creating them.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IdempotencyKey Key of the request chosen by the client, e.g. a UUID; a retried request with the same key returns the result of the original request instead of creating the object again or failing with 409 Conflict
+	IdempotencyKey  *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ClustersImportParams defines parameters for PostV2ClustersImport.
+type PostV2ClustersImportParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ClustersNameSuggestionParams defines parameters for GetV2ClustersNameSuggestion.
+type GetV2ClustersNameSuggestionParams struct {
+	// Base The base of the suggested name, e.g. the purpose of the cluster; defaults to 'cluster'.
+	Base            *string               `form:"base,omitempty" json:"base,omitempty"`
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
@@ -310,6 +1722,14 @@ type GetV2ClustersSummaryParams struct {
 
 // DeleteV2ClustersNameParams defines parameters for DeleteV2ClustersName.
 type DeleteV2ClustersNameParams struct {
+	// Force When set to true, deletes the cluster without draining its nodes first.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// ForceFinalize When set to true, the finalizers of the cluster and of its machines, control plane and infrastructure cluster are stripped if the cluster is still deleting after the force delete timeout of the deployment. Can be set for a cluster that is already deleting.
+	ForceFinalize *bool `form:"forceFinalize,omitempty" json:"forceFinalize,omitempty"`
+
+	// Purge When set to true, deletes the cluster right away instead of at the end of the deletion grace period of the deployment. Can be set for a cluster that is pending deletion.
+	Purge           *bool                 `form:"purge,omitempty" json:"purge,omitempty"`
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
@@ -318,10 +1738,68 @@ type GetV2ClustersNameParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
+// GetV2ClustersNameBackupsParams defines parameters for GetV2ClustersNameBackups.
+type GetV2ClustersNameBackupsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ClustersNameBackupsParams defines parameters for PostV2ClustersNameBackups.
+type PostV2ClustersNameBackupsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ClustersNameCloneParams defines parameters for PostV2ClustersNameClone.
+type PostV2ClustersNameCloneParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// DeleteV2ClustersNameDeletionParams defines parameters for DeleteV2ClustersNameDeletion.
+type DeleteV2ClustersNameDeletionParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ClustersNameEventsParams defines parameters for GetV2ClustersNameEvents.
+type GetV2ClustersNameEventsParams struct {
+	// Source The source of the events. "status" streams the cluster status changes as server-sent events, "kubernetes" lists the Kubernetes events of the cluster, its control plane, machines and provider machines, oldest first.
+	Source          *GetV2ClustersNameEventsParamsSource `form:"source,omitempty" json:"source,omitempty"`
+	Activeprojectid ActiveProjectIdHeader                `json:"Activeprojectid"`
+}
+
+// GetV2ClustersNameEventsParamsSource defines parameters for GetV2ClustersNameEvents.
+type GetV2ClustersNameEventsParamsSource string
+
+// PutV2ClustersNameExtensionsParams defines parameters for PutV2ClustersNameExtensions.
+type PutV2ClustersNameExtensionsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ClustersNameHealthParams defines parameters for GetV2ClustersNameHealth.
+type GetV2ClustersNameHealthParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// DeleteV2ClustersNameKubeconfigsParams defines parameters for DeleteV2ClustersNameKubeconfigs.
+type DeleteV2ClustersNameKubeconfigsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+	Authorization   string                `json:"Authorization"`
+}
+
 // GetV2ClustersNameKubeconfigsParams defines parameters for GetV2ClustersNameKubeconfigs.
 type GetV2ClustersNameKubeconfigsParams struct {
+	// AuthType The authentication of the kubeconfig. "token" embeds a bearer token with the kubeconfig TTL, "oidc-exec" configures the kubectl oidc-login exec credential plugin to get tokens from the OIDC provider, so that they are refreshed by the client instead of downloading the kubeconfig again.
+	AuthType        *GetV2ClustersNameKubeconfigsParamsAuthType `form:"authType,omitempty" json:"authType,omitempty"`
+	Activeprojectid ActiveProjectIdHeader                       `json:"Activeprojectid"`
+	Authorization   string                                      `json:"Authorization"`
+}
+
+// GetV2ClustersNameKubeconfigsParamsAuthType defines parameters for GetV2ClustersNameKubeconfigs.
+type GetV2ClustersNameKubeconfigsParamsAuthType string
+
+// PatchV2ClustersNameLabelsParams defines parameters for PatchV2ClustersNameLabels.
+type PatchV2ClustersNameLabelsParams struct {
+	// IfMatch Resource version of the cluster as returned in ClusterDetailInfo.resourceVersion; the request is rejected with 409 Conflict if the cluster was modified since
+	IfMatch         *ClusterIfMatchHeader `json:"If-Match,omitempty"`
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
-	Authorization   string                `json:"Authorization"`
 }
 
 // PutV2ClustersNameLabelsParams defines parameters for PutV2ClustersNameLabels.
@@ -329,6 +1807,33 @@ type PutV2ClustersNameLabelsParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
+// DeleteV2ClustersNameMaintenanceParams defines parameters for DeleteV2ClustersNameMaintenance.
+type DeleteV2ClustersNameMaintenanceParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+	Authorization   *string               `json:"Authorization,omitempty"`
+}
+
+// PutV2ClustersNameMaintenanceParams defines parameters for PutV2ClustersNameMaintenance.
+type PutV2ClustersNameMaintenanceParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+	Authorization   *string               `json:"Authorization,omitempty"`
+}
+
+// GetV2ClustersNameNodepoolsParams defines parameters for GetV2ClustersNameNodepools.
+type GetV2ClustersNameNodepoolsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ClustersNameNodepoolsParams defines parameters for PostV2ClustersNameNodepools.
+type PostV2ClustersNameNodepoolsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PatchV2ClustersNameNodepoolsPoolNameParams defines parameters for PatchV2ClustersNameNodepoolsPoolName.
+type PatchV2ClustersNameNodepoolsPoolNameParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
 // PutV2ClustersNameNodesJSONBody defines parameters for PutV2ClustersNameNodes.
 type PutV2ClustersNameNodesJSONBody = []NodeSpec
 
@@ -339,21 +1844,91 @@ type PutV2ClustersNameNodesParams struct {
 
 // DeleteV2ClustersNameNodesNodeIdParams defines parameters for DeleteV2ClustersNameNodesNodeId.
 type DeleteV2ClustersNameNodesNodeIdParams struct {
-	// Force When set to true, force deletes the edge node.
+	// Force When set to true, force deletes the edge node without draining it first.
 	Force           *bool                 `form:"force,omitempty" json:"force,omitempty"`
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
+// PostV2ClustersNameNodesNodeIdCordonParams defines parameters for PostV2ClustersNameNodesNodeIdCordon.
+type PostV2ClustersNameNodesNodeIdCordonParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ClustersNameNodesNodeIdLogsParams defines parameters for GetV2ClustersNameNodesNodeIdLogs.
+type GetV2ClustersNameNodesNodeIdLogsParams struct {
+	// TailLines The count of the last lines of the logs to return.
+	TailLines       *int                  `form:"tailLines,omitempty" json:"tailLines,omitempty"`
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ClustersNameNodesNodeIdUncordonParams defines parameters for PostV2ClustersNameNodesNodeIdUncordon.
+type PostV2ClustersNameNodesNodeIdUncordonParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ClustersNameRestoreParams defines parameters for PostV2ClustersNameRestore.
+type PostV2ClustersNameRestoreParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
 // PutV2ClustersNameTemplateParams defines parameters for PutV2ClustersNameTemplate.
 type PutV2ClustersNameTemplateParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
+// GetV2ClustersNameUpgradesParams defines parameters for GetV2ClustersNameUpgrades.
+type GetV2ClustersNameUpgradesParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
 // GetV2ClustersNodeIdClusterdetailParams defines parameters for GetV2ClustersNodeIdClusterdetail.
 type GetV2ClustersNodeIdClusterdetailParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
+// GetV2ExtensionsParams defines parameters for GetV2Extensions.
+type GetV2ExtensionsParams struct {
+	// Provider Only the extensions available for the control plane provider type
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
+}
+
+// GetV2OperationsParams defines parameters for GetV2Operations.
+type GetV2OperationsParams struct {
+	// Cluster Only returns the operations of the given cluster.
+	Cluster         *string               `form:"cluster,omitempty" json:"cluster,omitempty"`
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2OperationsIdParams defines parameters for GetV2OperationsId.
+type GetV2OperationsIdParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2PendingClustersParams defines parameters for GetV2PendingClusters.
+type GetV2PendingClustersParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// DeleteV2PendingClustersNameParams defines parameters for DeleteV2PendingClustersName.
+type DeleteV2PendingClustersNameParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2PendingClustersNameParams defines parameters for GetV2PendingClustersName.
+type GetV2PendingClustersNameParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PutV2PendingClustersNameParams defines parameters for PutV2PendingClustersName.
+type PutV2PendingClustersNameParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ProjectsProjectNameAuthzSelfParams defines parameters for GetV2ProjectsProjectNameAuthzSelf.
+type GetV2ProjectsProjectNameAuthzSelfParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
 // GetV2ProjectsProjectNameClustersParams defines parameters for GetV2ProjectsProjectNameClusters.
 type GetV2ProjectsProjectNameClustersParams struct {
 	// PageSize The maximum number of items to return.
@@ -362,6 +1937,9 @@ type GetV2ProjectsProjectNameClustersParams struct {
 	// Offset Index of the first item to return. It is almost always used in conjunction with the 'pageSize' query.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
+	// PageToken The nextPageToken of the previous page, to continue listing with the same filter and orderBy. It cannot be combined with offset. Unfiltered listings and listings filtered by labels only are paged by Kubernetes, so only the clusters of the page are loaded.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
 	// OrderBy The ordering of the entries. "asc" and "desc" are valid values. If none is specified, "asc" is used.
 	//
 	// Supported fields:
@@ -377,13 +1955,81 @@ type GetV2ProjectsProjectNameClustersParams struct {
 	// - name
 	// - kubernetesVersion
 	// - providerStatus
-	// - lifecyclePhase
+	// - lifecyclePhase (e.g. pending-delete for the clusters pending deletion)
+	// - template (exact match, indexed)
+	// - phase (exact match of the cluster phase, e.g. Provisioned, indexed)
+	// - labels.{key} (exact match of the label value, indexed)
+	//
+	// Filters on indexed fields combined with AND are served from the cluster cache without listing all clusters.
+	// Without the cluster cache, filters on labels combined with AND are passed to Kubernetes as a label selector, so only the matching clusters are listed.
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
+// PostV2ProjectsProjectNameClustersParams defines parameters for PostV2ProjectsProjectNameClusters.
+type PostV2ProjectsProjectNameClustersParams struct {
+	// DryRun When set to true, validates and renders the cluster like a create request and returns the objects it would create without creating them.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IdempotencyKey Key of the request chosen by the client, e.g. a UUID; a retried request with the same key returns the result of the original request instead of creating the object again or failing with 409 Conflict
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetV2ProjectsProjectNameClustersNameSuggestionParams defines parameters for GetV2ProjectsProjectNameClustersNameSuggestion.
+type GetV2ProjectsProjectNameClustersNameSuggestionParams struct {
+	// Base The base of the suggested name, e.g. the purpose of the cluster; defaults to 'cluster'.
+	Base *string `form:"base,omitempty" json:"base,omitempty"`
+}
+
+// DeleteV2ProjectsProjectNameClustersNameParams defines parameters for DeleteV2ProjectsProjectNameClustersName.
+type DeleteV2ProjectsProjectNameClustersNameParams struct {
+	// Force When set to true, deletes the cluster without draining its nodes first.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// ForceFinalize When set to true, the finalizers of the cluster and of its machines, control plane and infrastructure cluster are stripped if the cluster is still deleting after the force delete timeout of the deployment.
+	ForceFinalize *bool `form:"forceFinalize,omitempty" json:"forceFinalize,omitempty"`
+
+	// Purge When set to true, deletes the cluster right away instead of at the end of the deletion grace period of the deployment.
+	Purge *bool `form:"purge,omitempty" json:"purge,omitempty"`
+}
+
+// GetV2ProjectsProjectNameClustersNameEventsParams defines parameters for GetV2ProjectsProjectNameClustersNameEvents.
+type GetV2ProjectsProjectNameClustersNameEventsParams struct {
+	// Source The source of the events. "status" streams the cluster status changes as server-sent events, "kubernetes" lists the Kubernetes events of the cluster, its control plane, machines and provider machines, oldest first.
+	Source *GetV2ProjectsProjectNameClustersNameEventsParamsSource `form:"source,omitempty" json:"source,omitempty"`
+}
+
+// GetV2ProjectsProjectNameClustersNameEventsParamsSource defines parameters for GetV2ProjectsProjectNameClustersNameEvents.
+type GetV2ProjectsProjectNameClustersNameEventsParamsSource string
+
+// DeleteV2ProjectsProjectNameClustersNameKubeconfigsParams defines parameters for DeleteV2ProjectsProjectNameClustersNameKubeconfigs.
+type DeleteV2ProjectsProjectNameClustersNameKubeconfigsParams struct {
+	Authorization string `json:"Authorization"`
+}
+
 // GetV2ProjectsProjectNameClustersNameKubeconfigsParams defines parameters for GetV2ProjectsProjectNameClustersNameKubeconfigs.
 type GetV2ProjectsProjectNameClustersNameKubeconfigsParams struct {
-	Authorization string `json:"Authorization"`
+	// AuthType The authentication of the kubeconfig. "token" embeds a bearer token with the kubeconfig TTL, "oidc-exec" configures the kubectl oidc-login exec credential plugin to get tokens from the OIDC provider, so that they are refreshed by the client instead of downloading the kubeconfig again.
+	AuthType      *GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthType `form:"authType,omitempty" json:"authType,omitempty"`
+	Authorization string                                                         `json:"Authorization"`
+}
+
+// GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthType defines parameters for GetV2ProjectsProjectNameClustersNameKubeconfigs.
+type GetV2ProjectsProjectNameClustersNameKubeconfigsParamsAuthType string
+
+// PatchV2ProjectsProjectNameClustersNameLabelsParams defines parameters for PatchV2ProjectsProjectNameClustersNameLabels.
+type PatchV2ProjectsProjectNameClustersNameLabelsParams struct {
+	// IfMatch Resource version of the cluster as returned in ClusterDetailInfo.resourceVersion; the request is rejected with 409 Conflict if the cluster was modified since
+	IfMatch *ClusterIfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// DeleteV2ProjectsProjectNameClustersNameMaintenanceParams defines parameters for DeleteV2ProjectsProjectNameClustersNameMaintenance.
+type DeleteV2ProjectsProjectNameClustersNameMaintenanceParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutV2ProjectsProjectNameClustersNameMaintenanceParams defines parameters for PutV2ProjectsProjectNameClustersNameMaintenance.
+type PutV2ProjectsProjectNameClustersNameMaintenanceParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
 }
 
 // PutV2ProjectsProjectNameClustersNameNodesJSONBody defines parameters for PutV2ProjectsProjectNameClustersNameNodes.
@@ -391,10 +2037,21 @@ type PutV2ProjectsProjectNameClustersNameNodesJSONBody = []NodeSpec
 
 // DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams defines parameters for DeleteV2ProjectsProjectNameClustersNameNodesNodeId.
 type DeleteV2ProjectsProjectNameClustersNameNodesNodeIdParams struct {
-	// Force When set to true, force deletes the edge node.
+	// Force When set to true, force deletes the edge node without draining it first.
 	Force *bool `form:"force,omitempty" json:"force,omitempty"`
 }
 
+// GetV2ProjectsProjectNameOperationsParams defines parameters for GetV2ProjectsProjectNameOperations.
+type GetV2ProjectsProjectNameOperationsParams struct {
+	// Cluster Only returns the operations of the given cluster.
+	Cluster *string `form:"cluster,omitempty" json:"cluster,omitempty"`
+}
+
+// GetV2ProjectsProjectNameSummaryParams defines parameters for GetV2ProjectsProjectNameSummary.
+type GetV2ProjectsProjectNameSummaryParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
 // GetV2ProjectsProjectNameTemplatesParams defines parameters for GetV2ProjectsProjectNameTemplates.
 type GetV2ProjectsProjectNameTemplatesParams struct {
 	// Default When set to true, gets only the default template information
@@ -416,6 +2073,13 @@ type GetV2ProjectsProjectNameTemplatesParams struct {
 
 // PostV2ProjectsProjectNameTemplatesParams defines parameters for PostV2ProjectsProjectNameTemplates.
 type PostV2ProjectsProjectNameTemplatesParams struct {
+	// IdempotencyKey Key of the request chosen by the client, e.g. a UUID; a retried request with the same key returns the result of the original request instead of creating the object again or failing with 409 Conflict
+	IdempotencyKey  *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ProjectsProjectNameTemplatesCompatibilityParams defines parameters for GetV2ProjectsProjectNameTemplatesCompatibility.
+type GetV2ProjectsProjectNameTemplatesCompatibilityParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
@@ -439,6 +2103,69 @@ type GetV2ProjectsProjectNameTemplatesNameVersionParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
+// PutV2ProjectsProjectNameTemplatesNameVersionParams defines parameters for PutV2ProjectsProjectNameTemplatesNameVersion.
+type PutV2ProjectsProjectNameTemplatesNameVersionParams struct {
+	// IfMatch Resource version of the template as returned in TemplateInfo.resourceVersion; the request is rejected with 409 Conflict if the template was modified since
+	IfMatch         *IfMatchHeader        `json:"If-Match,omitempty"`
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams defines parameters for PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreview.
+type PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ProjectsProjectNameTemplatesNameVersionClustersParams defines parameters for GetV2ProjectsProjectNameTemplatesNameVersionClusters.
+type GetV2ProjectsProjectNameTemplatesNameVersionClustersParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+	Authorization   *string               `json:"Authorization,omitempty"`
+}
+
+// PostV2ProjectsProjectNameTemplatesNameVersionDeprecateParams defines parameters for PostV2ProjectsProjectNameTemplatesNameVersionDeprecate.
+type PostV2ProjectsProjectNameTemplatesNameVersionDeprecateParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2ProjectsProjectNameTemplatesNameVersionExportParams defines parameters for GetV2ProjectsProjectNameTemplatesNameVersionExport.
+type GetV2ProjectsProjectNameTemplatesNameVersionExportParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2ProjectsProjectNameTemplatesNameVersionPublishParams defines parameters for PostV2ProjectsProjectNameTemplatesNameVersionPublish.
+type PostV2ProjectsProjectNameTemplatesNameVersionPublishParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2SummaryParams defines parameters for GetV2Summary.
+type GetV2SummaryParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2TemplateUploadsParams defines parameters for PostV2TemplateUploads.
+type PostV2TemplateUploadsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// DeleteV2TemplateUploadsIdParams defines parameters for DeleteV2TemplateUploadsId.
+type DeleteV2TemplateUploadsIdParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2TemplateUploadsIdParams defines parameters for GetV2TemplateUploadsId.
+type GetV2TemplateUploadsIdParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2TemplateUploadsIdChunksParams defines parameters for PostV2TemplateUploadsIdChunks.
+type PostV2TemplateUploadsIdChunksParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2TemplateUploadsIdCommitParams defines parameters for PostV2TemplateUploadsIdCommit.
+type PostV2TemplateUploadsIdCommitParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
 // GetV2TemplatesParams defines parameters for GetV2Templates.
 type GetV2TemplatesParams struct {
 	// Default When set to true, gets only the default template information
@@ -460,12 +2187,22 @@ type GetV2TemplatesParams struct {
 
 // PostV2TemplatesParams defines parameters for PostV2Templates.
 type PostV2TemplatesParams struct {
+	// IdempotencyKey Key of the request chosen by the client, e.g. a UUID; a retried request with the same key returns the result of the original request instead of creating the object again or failing with 409 Conflict
+	IdempotencyKey  *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2TemplatesCompatibilityParams defines parameters for GetV2TemplatesCompatibility.
+type GetV2TemplatesCompatibilityParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
 // PutV2TemplatesNameDefaultParams defines parameters for PutV2TemplatesNameDefault.
 type PutV2TemplatesNameDefaultParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+
+	// IfMatch Resource version of the template as returned in TemplateInfo.resourceVersion; the request is rejected with 409 Conflict if the template was modified since
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
 }
 
 // GetV2TemplatesNameVersionsParams defines parameters for GetV2TemplatesNameVersions.
@@ -483,38 +2220,163 @@ type GetV2TemplatesNameVersionParams struct {
 	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
 }
 
+// PutV2TemplatesNameVersionParams defines parameters for PutV2TemplatesNameVersion.
+type PutV2TemplatesNameVersionParams struct {
+	// IfMatch Resource version of the template as returned in TemplateInfo.resourceVersion; the request is rejected with 409 Conflict if the template was modified since
+	IfMatch         *IfMatchHeader        `json:"If-Match,omitempty"`
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2TemplatesNameVersionClusterlabelsPreviewParams defines parameters for PostV2TemplatesNameVersionClusterlabelsPreview.
+type PostV2TemplatesNameVersionClusterlabelsPreviewParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// GetV2TemplatesNameVersionClustersParams defines parameters for GetV2TemplatesNameVersionClusters.
+type GetV2TemplatesNameVersionClustersParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+	Authorization   *string               `json:"Authorization,omitempty"`
+}
+
+// PostV2TemplatesNameVersionDeprecateParams defines parameters for PostV2TemplatesNameVersionDeprecate.
+type PostV2TemplatesNameVersionDeprecateParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+
+	// IfMatch Resource version of the template as returned in TemplateInfo.resourceVersion; the request is rejected with 409 Conflict if the template was modified since
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// GetV2TemplatesNameVersionExportParams defines parameters for GetV2TemplatesNameVersionExport.
+type GetV2TemplatesNameVersionExportParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
+// PostV2TemplatesNameVersionPublishParams defines parameters for PostV2TemplatesNameVersionPublish.
+type PostV2TemplatesNameVersionPublishParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+
+	// IfMatch Resource version of the template as returned in TemplateInfo.resourceVersion; the request is rejected with 409 Conflict if the template was modified since
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// GetV2WebhooksDestinationsParams defines parameters for GetV2WebhooksDestinations.
+type GetV2WebhooksDestinationsParams struct {
+	Activeprojectid ActiveProjectIdHeader `json:"Activeprojectid"`
+}
+
 // PostV2ClustersJSONRequestBody defines body for PostV2Clusters for application/json ContentType.
 type PostV2ClustersJSONRequestBody = ClusterSpec
 
+// PostV2ClustersImportJSONRequestBody defines body for PostV2ClustersImport for application/json ContentType.
+type PostV2ClustersImportJSONRequestBody = ClusterImport
+
+// PostV2ClustersNameCloneJSONRequestBody defines body for PostV2ClustersNameClone for application/json ContentType.
+type PostV2ClustersNameCloneJSONRequestBody = ClusterCloneRequest
+
+// PutV2ClustersNameExtensionsJSONRequestBody defines body for PutV2ClustersNameExtensions for application/json ContentType.
+type PutV2ClustersNameExtensionsJSONRequestBody = ClusterExtensions
+
+// PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody defines body for PatchV2ClustersNameLabels for application/merge-patch+json ContentType.
+type PatchV2ClustersNameLabelsApplicationMergePatchPlusJSONRequestBody = ClusterLabelsPatch
+
 // PutV2ClustersNameLabelsJSONRequestBody defines body for PutV2ClustersNameLabels for application/json ContentType.
 type PutV2ClustersNameLabelsJSONRequestBody = ClusterLabels
 
+// PutV2ClustersNameMaintenanceJSONRequestBody defines body for PutV2ClustersNameMaintenance for application/json ContentType.
+type PutV2ClustersNameMaintenanceJSONRequestBody = ClusterMaintenanceRequest
+
+// PostV2ClustersNameNodepoolsJSONRequestBody defines body for PostV2ClustersNameNodepools for application/json ContentType.
+type PostV2ClustersNameNodepoolsJSONRequestBody = NodePool
+
+// PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody defines body for PatchV2ClustersNameNodepoolsPoolName for application/json ContentType.
+type PatchV2ClustersNameNodepoolsPoolNameJSONRequestBody = NodePoolUpdate
+
 // PutV2ClustersNameNodesJSONRequestBody defines body for PutV2ClustersNameNodes for application/json ContentType.
 type PutV2ClustersNameNodesJSONRequestBody = PutV2ClustersNameNodesJSONBody
 
+// PostV2ClustersNameNodesNodeIdCordonJSONRequestBody defines body for PostV2ClustersNameNodesNodeIdCordon for application/json ContentType.
+type PostV2ClustersNameNodesNodeIdCordonJSONRequestBody = NodeCordonRequest
+
+// PostV2ClustersNameRestoreJSONRequestBody defines body for PostV2ClustersNameRestore for application/json ContentType.
+type PostV2ClustersNameRestoreJSONRequestBody = ClusterRestoreRequest
+
 // PutV2ClustersNameTemplateJSONRequestBody defines body for PutV2ClustersNameTemplate for application/json ContentType.
 type PutV2ClustersNameTemplateJSONRequestBody = ClusterTemplateInfo
 
+// PutV2PendingClustersNameJSONRequestBody defines body for PutV2PendingClustersName for application/json ContentType.
+type PutV2PendingClustersNameJSONRequestBody = ClusterSpec
+
 // PostV2ProjectsProjectNameClustersJSONRequestBody defines body for PostV2ProjectsProjectNameClusters for application/json ContentType.
 type PostV2ProjectsProjectNameClustersJSONRequestBody = ClusterSpec
 
+// PostV2ProjectsProjectNameClustersImportJSONRequestBody defines body for PostV2ProjectsProjectNameClustersImport for application/json ContentType.
+type PostV2ProjectsProjectNameClustersImportJSONRequestBody = ClusterImport
+
+// PutV2ProjectsProjectNameClustersNameExtensionsJSONRequestBody defines body for PutV2ProjectsProjectNameClustersNameExtensions for application/json ContentType.
+type PutV2ProjectsProjectNameClustersNameExtensionsJSONRequestBody = ClusterExtensions
+
+// PatchV2ProjectsProjectNameClustersNameLabelsApplicationMergePatchPlusJSONRequestBody defines body for PatchV2ProjectsProjectNameClustersNameLabels for application/merge-patch+json ContentType.
+type PatchV2ProjectsProjectNameClustersNameLabelsApplicationMergePatchPlusJSONRequestBody = ClusterLabelsPatch
+
 // PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody defines body for PutV2ProjectsProjectNameClustersNameLabels for application/json ContentType.
 type PutV2ProjectsProjectNameClustersNameLabelsJSONRequestBody = ClusterLabels
 
+// PutV2ProjectsProjectNameClustersNameMaintenanceJSONRequestBody defines body for PutV2ProjectsProjectNameClustersNameMaintenance for application/json ContentType.
+type PutV2ProjectsProjectNameClustersNameMaintenanceJSONRequestBody = ClusterMaintenanceRequest
+
+// PostV2ProjectsProjectNameClustersNameNodepoolsJSONRequestBody defines body for PostV2ProjectsProjectNameClustersNameNodepools for application/json ContentType.
+type PostV2ProjectsProjectNameClustersNameNodepoolsJSONRequestBody = NodePool
+
+// PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameJSONRequestBody defines body for PatchV2ProjectsProjectNameClustersNameNodepoolsPoolName for application/json ContentType.
+type PatchV2ProjectsProjectNameClustersNameNodepoolsPoolNameJSONRequestBody = NodePoolUpdate
+
 // PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody defines body for PutV2ProjectsProjectNameClustersNameNodes for application/json ContentType.
 type PutV2ProjectsProjectNameClustersNameNodesJSONRequestBody = PutV2ProjectsProjectNameClustersNameNodesJSONBody
 
+// PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonJSONRequestBody defines body for PostV2ProjectsProjectNameClustersNameNodesNodeIdCordon for application/json ContentType.
+type PostV2ProjectsProjectNameClustersNameNodesNodeIdCordonJSONRequestBody = NodeCordonRequest
+
+// PostV2ProjectsProjectNameClustersNameRestoreJSONRequestBody defines body for PostV2ProjectsProjectNameClustersNameRestore for application/json ContentType.
+type PostV2ProjectsProjectNameClustersNameRestoreJSONRequestBody = ClusterRestoreRequest
+
 // PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody defines body for PutV2ProjectsProjectNameClustersNameTemplate for application/json ContentType.
 type PutV2ProjectsProjectNameClustersNameTemplateJSONRequestBody = ClusterTemplateInfo
 
+// PutV2ProjectsProjectNamePendingClustersNameJSONRequestBody defines body for PutV2ProjectsProjectNamePendingClustersName for application/json ContentType.
+type PutV2ProjectsProjectNamePendingClustersNameJSONRequestBody = ClusterSpec
+
+// PostV2ProjectsProjectNameTemplateUploadsJSONRequestBody defines body for PostV2ProjectsProjectNameTemplateUploads for application/json ContentType.
+type PostV2ProjectsProjectNameTemplateUploadsJSONRequestBody = TemplateUploadRequest
+
+// PostV2ProjectsProjectNameTemplateUploadsIdChunksJSONRequestBody defines body for PostV2ProjectsProjectNameTemplateUploadsIdChunks for application/json ContentType.
+type PostV2ProjectsProjectNameTemplateUploadsIdChunksJSONRequestBody = TemplateUploadChunk
+
 // PostV2ProjectsProjectNameTemplatesJSONRequestBody defines body for PostV2ProjectsProjectNameTemplates for application/json ContentType.
 type PostV2ProjectsProjectNameTemplatesJSONRequestBody = TemplateInfo
 
 // PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody defines body for PutV2ProjectsProjectNameTemplatesNameDefault for application/json ContentType.
 type PutV2ProjectsProjectNameTemplatesNameDefaultJSONRequestBody = DefaultTemplateInfo
 
+// PutV2ProjectsProjectNameTemplatesNameVersionJSONRequestBody defines body for PutV2ProjectsProjectNameTemplatesNameVersion for application/json ContentType.
+type PutV2ProjectsProjectNameTemplatesNameVersionJSONRequestBody = TemplateInfo
+
+// PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewJSONRequestBody defines body for PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreview for application/json ContentType.
+type PostV2ProjectsProjectNameTemplatesNameVersionClusterlabelsPreviewJSONRequestBody = TemplateClusterLabels
+
+// PostV2TemplateUploadsJSONRequestBody defines body for PostV2TemplateUploads for application/json ContentType.
+type PostV2TemplateUploadsJSONRequestBody = TemplateUploadRequest
+
+// PostV2TemplateUploadsIdChunksJSONRequestBody defines body for PostV2TemplateUploadsIdChunks for application/json ContentType.
+type PostV2TemplateUploadsIdChunksJSONRequestBody = TemplateUploadChunk
+
 // PostV2TemplatesJSONRequestBody defines body for PostV2Templates for application/json ContentType.
 type PostV2TemplatesJSONRequestBody = TemplateInfo
 
 // PutV2TemplatesNameDefaultJSONRequestBody defines body for PutV2TemplatesNameDefault for application/json ContentType.
 type PutV2TemplatesNameDefaultJSONRequestBody = DefaultTemplateInfo
+
+// PutV2TemplatesNameVersionJSONRequestBody defines body for PutV2TemplatesNameVersion for application/json ContentType.
+type PutV2TemplatesNameVersionJSONRequestBody = TemplateInfo
+
+// PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody defines body for PostV2TemplatesNameVersionClusterlabelsPreview for application/json ContentType.
+type PostV2TemplatesNameVersionClusterlabelsPreviewJSONRequestBody = TemplateClusterLabels
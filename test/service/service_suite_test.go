@@ -101,6 +101,13 @@ var _ = BeforeSuite(func() {
 	Expect(resp.StatusCode()).To(Equal(201))
 	fmt.Println("Created baseline template for tenant", testTenantID.String())
 
+	// templates are imported as drafts, publish it so it can be used to create clusters
+	publishParams := api.PostV2TemplatesNameVersionPublishParams{Activeprojectid: testTenantID}
+	publishResp, err := cli.PostV2TemplatesNameVersionPublishWithResponse(context.Background(), template.Name, template.Version, &publishParams)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(publishResp.StatusCode()).To(Equal(200))
+	fmt.Println("Published baseline template for tenant", testTenantID.String())
+
 	// label the baseline template with the default=true
 	templateName := fmt.Sprintf("%s-%v", template.Name, template.Version)
 	fmt.Printf("Labeling %v template with default=true for tenant %v", templateName, testTenantID.String())
@@ -1127,6 +1134,7 @@ func createAuthenticatedClient() (*api.ClientWithResponses, error) {
 func getTokenFromClusterKeycloak() (string, error) {
 	// include project-specific roles that OPA expects
 	roles := []string{
+		fmt.Sprintf("%s_cl-tpl-admin", testTenantID.String()),
 		fmt.Sprintf("%s_cl-tpl-rw", testTenantID.String()),
 		fmt.Sprintf("%s_cl-tpl-r", testTenantID.String()),
 		fmt.Sprintf("%s_cl-rw", testTenantID.String()),